@@ -70,7 +70,19 @@ func provideCleanup(
 	schedulerSnapshot *service.SchedulerSnapshotService,
 	tokenRefresh *service.TokenRefreshService,
 	accountExpiry *service.AccountExpiryService,
+	accountHealthProbe *service.AccountHealthProbeService,
+	groupPool *service.GroupPoolService,
+	accountAvailability *service.AccountAvailabilityService,
+	balanceLedger *service.BalanceLedgerService,
+	creditLot *service.CreditLotService,
+	payment *service.PaymentService,
+	subscriptionPlan *service.SubscriptionPlanService,
+	statement *service.StatementService,
+	referral *service.ReferralService,
+	userNotification *service.UserNotificationService,
+	priceOverride *service.ModelPriceOverrideService,
 	usageCleanup *service.UsageCleanupService,
+	usageRerate *service.UsageRerateService,
 	pricing *service.PricingService,
 	emailQueue *service.EmailQueueService,
 	billingCache *service.BillingCacheService,
@@ -130,6 +142,10 @@ func provideCleanup(
 				}
 				return nil
 			}},
+			{"UsageRerateService", func() error {
+				usageRerate.Stop()
+				return nil
+			}},
 			{"TokenRefreshService", func() error {
 				tokenRefresh.Stop()
 				return nil
@@ -138,6 +154,50 @@ func provideCleanup(
 				accountExpiry.Stop()
 				return nil
 			}},
+			{"AccountHealthProbeService", func() error {
+				accountHealthProbe.Stop()
+				return nil
+			}},
+			{"GroupPoolService", func() error {
+				groupPool.Stop()
+				return nil
+			}},
+			{"AccountAvailabilityService", func() error {
+				accountAvailability.Stop()
+				return nil
+			}},
+			{"BalanceLedgerService", func() error {
+				balanceLedger.Stop()
+				return nil
+			}},
+			{"CreditLotService", func() error {
+				creditLot.Stop()
+				return nil
+			}},
+			{"PaymentService", func() error {
+				payment.Stop()
+				return nil
+			}},
+			{"SubscriptionPlanService", func() error {
+				subscriptionPlan.Stop()
+				return nil
+			}},
+			{"StatementService", func() error {
+				statement.Stop()
+				return nil
+			}},
+			{"ReferralService", func() error {
+				referral.Stop()
+				return nil
+			}},
+			{"UserNotificationService", func() error {
+				userNotification.Stop()
+				return nil
+			}},
+			{"ModelPriceOverrideService", func() error {
+				priceOverride.Stop()
+				return nil
+			}},
 			{"PricingService", func() error {
 				pricing.Stop()
 				return nil
@@ -54,34 +54,58 @@ func initializeApplication(buildInfo handler.BuildInfo) (*Application, error) {
 	promoCodeRepository := repository.NewPromoCodeRepository(client)
 	billingCache := repository.NewBillingCache(redisClient)
 	userSubscriptionRepository := repository.NewUserSubscriptionRepository(client)
-	billingCacheService := service.NewBillingCacheService(billingCache, userRepository, userSubscriptionRepository, configConfig)
+	usageLogRepository := repository.NewUsageLogRepository(client, db)
+	billingCacheService := service.NewBillingCacheService(billingCache, userRepository, userSubscriptionRepository, usageLogRepository, configConfig)
 	apiKeyRepository := repository.NewAPIKeyRepository(client)
 	groupRepository := repository.NewGroupRepository(client, db)
 	apiKeyCache := repository.NewAPIKeyCache(redisClient)
 	apiKeyService := service.NewAPIKeyService(apiKeyRepository, userRepository, groupRepository, userSubscriptionRepository, apiKeyCache, configConfig)
 	apiKeyAuthCacheInvalidator := service.ProvideAPIKeyAuthCacheInvalidator(apiKeyService)
-	promoService := service.NewPromoService(promoCodeRepository, userRepository, billingCacheService, client, apiKeyAuthCacheInvalidator)
-	authService := service.NewAuthService(userRepository, configConfig, settingService, emailService, turnstileService, emailQueueService, promoService)
+	subscriptionEventRepository := repository.NewSubscriptionEventRepository(db)
+	subscriptionService := service.NewSubscriptionService(groupRepository, userSubscriptionRepository, userRepository, subscriptionEventRepository, client, billingCacheService, apiKeyAuthCacheInvalidator)
+	promoService := service.NewPromoService(promoCodeRepository, userRepository, billingCacheService, client, apiKeyAuthCacheInvalidator, subscriptionService, groupRepository)
+	referralRepository := repository.NewReferralRepository(db)
+	timingWheelService, err := service.ProvideTimingWheelService()
+	if err != nil {
+		return nil, err
+	}
+	referralService := service.ProvideReferralService(referralRepository, userRepository, billingCacheService, apiKeyAuthCacheInvalidator, client, configConfig, timingWheelService, db)
+	authService := service.NewAuthService(userRepository, configConfig, settingService, emailService, turnstileService, emailQueueService, promoService, referralService)
 	userService := service.NewUserService(userRepository, apiKeyAuthCacheInvalidator)
 	authHandler := handler.NewAuthHandler(configConfig, authService, userService, settingService, promoService)
-	userHandler := handler.NewUserHandler(userService)
-	apiKeyHandler := handler.NewAPIKeyHandler(apiKeyService)
-	usageLogRepository := repository.NewUsageLogRepository(client, db)
+	balanceTransactionRepository := repository.NewBalanceTransactionRepository(db)
+	opsRepository := repository.NewOpsRepository(db)
+	balanceLedgerService := service.ProvideBalanceLedgerService(balanceTransactionRepository, opsRepository, timingWheelService, db)
+	userNotificationRepository := repository.NewUserNotificationRepository(db)
+	userNotificationWebhookSender := repository.NewUserNotificationWebhookSender()
+	userNotificationService := service.ProvideUserNotificationService(userNotificationRepository, userNotificationWebhookSender, userRepository, emailQueueService, settingService)
+	creditLotRepository := repository.NewCreditLotRepository(client, db)
+	creditLotService := service.ProvideCreditLotService(creditLotRepository, billingCacheService, apiKeyAuthCacheInvalidator, timingWheelService, db)
+	userHandler := handler.NewUserHandler(userService, balanceLedgerService, userNotificationService, creditLotService)
+	apiKeyHandler := handler.NewAPIKeyHandler(apiKeyService, billingCacheService)
 	usageService := service.NewUsageService(usageLogRepository, userRepository, client, apiKeyAuthCacheInvalidator)
 	usageHandler := handler.NewUsageHandler(usageService, apiKeyService)
 	redeemCodeRepository := repository.NewRedeemCodeRepository(client)
-	subscriptionService := service.NewSubscriptionService(groupRepository, userSubscriptionRepository, billingCacheService)
 	redeemCache := repository.NewRedeemCache(redisClient)
 	redeemService := service.NewRedeemService(redeemCodeRepository, userRepository, subscriptionService, redeemCache, billingCacheService, client, apiKeyAuthCacheInvalidator)
 	redeemHandler := handler.NewRedeemHandler(redeemService)
-	subscriptionHandler := handler.NewSubscriptionHandler(subscriptionService)
+	subscriptionPlanRepository := repository.NewSubscriptionPlanRepository(db)
+	subscriptionPlanService := service.ProvideSubscriptionPlanService(subscriptionPlanRepository, groupRepository, userSubscriptionRepository, userRepository, client, billingCacheService, apiKeyAuthCacheInvalidator, userNotificationService, timingWheelService, db)
+	subscriptionHandler := handler.NewSubscriptionHandler(subscriptionService, subscriptionPlanService)
+	paymentOrderRepository := repository.NewPaymentOrderRepository(db)
+	paymentProviders := repository.ProvidePaymentProviders(configConfig)
+	paymentService := service.ProvidePaymentService(paymentOrderRepository, userRepository, paymentProviders, configConfig, client, billingCacheService, apiKeyAuthCacheInvalidator, promoService, timingWheelService, db)
+	paymentHandler := handler.NewPaymentHandler(paymentService)
+	statementRepository := repository.NewStatementRepository(db)
+	statementService := service.ProvideStatementService(statementRepository, userRepository, settingService, emailQueueService, configConfig, timingWheelService, db)
+	statementHandler := handler.NewStatementHandler(statementService)
+	organizationRepository := repository.NewOrganizationRepository(db)
+	organizationService := service.ProvideOrganizationService(organizationRepository, userRepository, groupRepository, userSubscriptionRepository, apiKeyService, subscriptionPlanService, billingCacheService, client)
+	organizationHandler := handler.NewOrganizationHandler(organizationService)
+	referralHandler := handler.NewReferralHandler(referralService)
 	dashboardAggregationRepository := repository.NewDashboardAggregationRepository(db)
 	dashboardStatsCache := repository.NewDashboardCache(redisClient, configConfig)
 	dashboardService := service.NewDashboardService(usageLogRepository, dashboardAggregationRepository, dashboardStatsCache, configConfig)
-	timingWheelService, err := service.ProvideTimingWheelService()
-	if err != nil {
-		return nil, err
-	}
 	dashboardAggregationService := service.ProvideDashboardAggregationService(dashboardAggregationRepository, timingWheelService, configConfig)
 	dashboardHandler := admin.NewDashboardHandler(dashboardService, dashboardAggregationService)
 	schedulerCache := repository.NewSchedulerCache(redisClient)
@@ -90,38 +114,62 @@ func initializeApplication(buildInfo handler.BuildInfo) (*Application, error) {
 	proxyExitInfoProber := repository.NewProxyExitInfoProber(configConfig)
 	proxyLatencyCache := repository.NewProxyLatencyCache(redisClient)
 	adminService := service.NewAdminService(userRepository, groupRepository, accountRepository, proxyRepository, apiKeyRepository, redeemCodeRepository, billingCacheService, proxyExitInfoProber, proxyLatencyCache, apiKeyAuthCacheInvalidator)
-	adminUserHandler := admin.NewUserHandler(adminService)
-	groupHandler := admin.NewGroupHandler(adminService)
+	adminUserHandler := admin.NewUserHandler(adminService, balanceLedgerService)
+	accountPoolRepository := repository.NewAccountPoolRepository(db)
+	concurrencyCache := repository.ProvideConcurrencyCache(redisClient, configConfig)
+	concurrencyService := service.ProvideConcurrencyService(concurrencyCache, accountRepository, configConfig)
+	gatewayCache := repository.NewGatewayCache(redisClient)
+	schedulerOutboxRepository := repository.NewSchedulerOutboxRepository(db)
+	schedulerSnapshotService := service.ProvideSchedulerSnapshotService(schedulerCache, schedulerOutboxRepository, accountRepository, groupRepository, configConfig)
+	pricingRemoteClient := repository.ProvidePricingRemoteClient(configConfig)
+	pricingService, err := service.ProvidePricingService(configConfig, pricingRemoteClient)
+	if err != nil {
+		return nil, err
+	}
+	modelPriceOverrideRepository := repository.NewModelPriceOverrideRepository(db)
+	modelPriceOverrideService := service.ProvideModelPriceOverrideService(modelPriceOverrideRepository, groupRepository, timingWheelService)
+	billingService := service.NewBillingService(configConfig, pricingService, modelPriceOverrideService)
+	geminiQuotaService := service.NewGeminiQuotaService(configConfig, settingRepository)
+	tempUnschedCache := repository.NewTempUnschedCache(redisClient)
+	timeoutCounterCache := repository.NewTimeoutCounterCache(redisClient)
+	geminiTokenCache := repository.NewGeminiTokenCache(redisClient)
+	compositeTokenCacheInvalidator := service.NewCompositeTokenCacheInvalidator(geminiTokenCache)
+	rateLimitService := service.ProvideRateLimitService(accountRepository, usageLogRepository, configConfig, geminiQuotaService, tempUnschedCache, timeoutCounterCache, settingService, compositeTokenCacheInvalidator)
+	identityCache := repository.NewIdentityCache(redisClient)
+	identityService := service.NewIdentityService(identityCache)
+	httpUpstream := repository.NewHTTPUpstream(configConfig)
+	deferredService := service.ProvideDeferredService(accountRepository, timingWheelService)
 	claudeOAuthClient := repository.NewClaudeOAuthClient()
 	oAuthService := service.NewOAuthService(proxyRepository, claudeOAuthClient)
+	claudeTokenProvider := service.NewClaudeTokenProvider(accountRepository, geminiTokenCache, oAuthService)
+	sessionLimitCache := repository.ProvideSessionLimitCache(redisClient, configConfig)
+	accountSpendCache := repository.NewAccountSpendCache(redisClient)
+	accountSpendCapService := service.NewAccountSpendCapService(accountRepository, usageLogRepository, accountSpendCache, tempUnschedCache, opsRepository)
+	gatewayService := service.NewGatewayService(accountRepository, groupRepository, usageLogRepository, userRepository, userSubscriptionRepository, gatewayCache, configConfig, schedulerSnapshotService, concurrencyService, billingService, rateLimitService, billingCacheService, identityService, httpUpstream, deferredService, claudeTokenProvider, sessionLimitCache, accountSpendCapService, userNotificationService)
 	openAIOAuthClient := repository.NewOpenAIOAuthClient()
 	openAIOAuthService := service.NewOpenAIOAuthService(proxyRepository, openAIOAuthClient)
+	openAITokenProvider := service.NewOpenAITokenProvider(accountRepository, geminiTokenCache, openAIOAuthService)
+	openAIGatewayService := service.NewOpenAIGatewayService(accountRepository, usageLogRepository, userRepository, userSubscriptionRepository, gatewayCache, configConfig, schedulerSnapshotService, concurrencyService, billingService, rateLimitService, billingCacheService, httpUpstream, deferredService, openAITokenProvider, accountSpendCapService, userNotificationService)
 	geminiOAuthClient := repository.NewGeminiOAuthClient(configConfig)
 	geminiCliCodeAssistClient := repository.NewGeminiCliCodeAssistClient()
 	geminiOAuthService := service.NewGeminiOAuthService(proxyRepository, geminiOAuthClient, geminiCliCodeAssistClient, configConfig)
+	geminiTokenProvider := service.NewGeminiTokenProvider(accountRepository, geminiTokenCache, geminiOAuthService)
 	antigravityOAuthService := service.NewAntigravityOAuthService(proxyRepository)
-	geminiQuotaService := service.NewGeminiQuotaService(configConfig, settingRepository)
-	tempUnschedCache := repository.NewTempUnschedCache(redisClient)
-	timeoutCounterCache := repository.NewTimeoutCounterCache(redisClient)
-	geminiTokenCache := repository.NewGeminiTokenCache(redisClient)
-	compositeTokenCacheInvalidator := service.NewCompositeTokenCacheInvalidator(geminiTokenCache)
-	rateLimitService := service.ProvideRateLimitService(accountRepository, usageLogRepository, configConfig, geminiQuotaService, tempUnschedCache, timeoutCounterCache, settingService, compositeTokenCacheInvalidator)
-	httpUpstream := repository.NewHTTPUpstream(configConfig)
+	antigravityTokenProvider := service.NewAntigravityTokenProvider(accountRepository, geminiTokenCache, antigravityOAuthService)
+	antigravityGatewayService := service.NewAntigravityGatewayService(accountRepository, gatewayCache, antigravityTokenProvider, rateLimitService, httpUpstream, settingService)
+	geminiMessagesCompatService := service.NewGeminiMessagesCompatService(accountRepository, groupRepository, gatewayCache, schedulerSnapshotService, geminiTokenProvider, rateLimitService, httpUpstream, antigravityGatewayService, configConfig)
+	opsService := service.NewOpsService(opsRepository, settingRepository, configConfig, accountRepository, concurrencyService, gatewayService, openAIGatewayService, geminiMessagesCompatService, antigravityGatewayService)
+	groupPoolService := service.ProvideGroupPoolService(groupRepository, accountPoolRepository, opsService, apiKeyAuthCacheInvalidator, timingWheelService, db, configConfig)
+	groupHandler := admin.NewGroupHandler(adminService, groupPoolService)
 	claudeUsageFetcher := repository.NewClaudeUsageFetcher(httpUpstream)
 	antigravityQuotaFetcher := service.NewAntigravityQuotaFetcher(proxyRepository)
 	usageCache := service.NewUsageCache()
-	identityCache := repository.NewIdentityCache(redisClient)
 	accountUsageService := service.NewAccountUsageService(accountRepository, usageLogRepository, claudeUsageFetcher, geminiQuotaService, antigravityQuotaFetcher, usageCache, identityCache)
-	geminiTokenProvider := service.NewGeminiTokenProvider(accountRepository, geminiTokenCache, geminiOAuthService)
-	gatewayCache := repository.NewGatewayCache(redisClient)
-	antigravityTokenProvider := service.NewAntigravityTokenProvider(accountRepository, geminiTokenCache, antigravityOAuthService)
-	antigravityGatewayService := service.NewAntigravityGatewayService(accountRepository, gatewayCache, antigravityTokenProvider, rateLimitService, httpUpstream, settingService)
 	accountTestService := service.NewAccountTestService(accountRepository, geminiTokenProvider, antigravityGatewayService, httpUpstream, configConfig)
-	concurrencyCache := repository.ProvideConcurrencyCache(redisClient, configConfig)
-	concurrencyService := service.ProvideConcurrencyService(concurrencyCache, accountRepository, configConfig)
 	crsSyncService := service.NewCRSSyncService(accountRepository, proxyRepository, oAuthService, openAIOAuthService, geminiOAuthService, configConfig)
-	sessionLimitCache := repository.ProvideSessionLimitCache(redisClient, configConfig)
-	accountHandler := admin.NewAccountHandler(adminService, oAuthService, openAIOAuthService, geminiOAuthService, antigravityOAuthService, rateLimitService, accountUsageService, accountTestService, concurrencyService, crsSyncService, sessionLimitCache, compositeTokenCacheInvalidator)
+	accountProbeRepository := repository.NewAccountProbeRepository(db)
+	accountHealthProbeService := service.ProvideAccountHealthProbeService(accountRepository, accountProbeRepository, accountTestService, rateLimitService, timingWheelService, db, configConfig)
+	accountHandler := admin.NewAccountHandler(adminService, oAuthService, openAIOAuthService, geminiOAuthService, antigravityOAuthService, rateLimitService, accountUsageService, accountTestService, concurrencyService, crsSyncService, sessionLimitCache, compositeTokenCacheInvalidator, accountHealthProbeService, accountSpendCapService)
 	oAuthHandler := admin.NewOAuthHandler(oAuthService)
 	openAIOAuthHandler := admin.NewOpenAIOAuthHandler(openAIOAuthService, adminService)
 	geminiOAuthHandler := admin.NewGeminiOAuthHandler(geminiOAuthService)
@@ -129,23 +177,6 @@ func initializeApplication(buildInfo handler.BuildInfo) (*Application, error) {
 	proxyHandler := admin.NewProxyHandler(adminService)
 	adminRedeemHandler := admin.NewRedeemHandler(adminService)
 	promoHandler := admin.NewPromoHandler(promoService)
-	opsRepository := repository.NewOpsRepository(db)
-	schedulerOutboxRepository := repository.NewSchedulerOutboxRepository(db)
-	schedulerSnapshotService := service.ProvideSchedulerSnapshotService(schedulerCache, schedulerOutboxRepository, accountRepository, groupRepository, configConfig)
-	pricingRemoteClient := repository.ProvidePricingRemoteClient(configConfig)
-	pricingService, err := service.ProvidePricingService(configConfig, pricingRemoteClient)
-	if err != nil {
-		return nil, err
-	}
-	billingService := service.NewBillingService(configConfig, pricingService)
-	identityService := service.NewIdentityService(identityCache)
-	deferredService := service.ProvideDeferredService(accountRepository, timingWheelService)
-	claudeTokenProvider := service.NewClaudeTokenProvider(accountRepository, geminiTokenCache, oAuthService)
-	gatewayService := service.NewGatewayService(accountRepository, groupRepository, usageLogRepository, userRepository, userSubscriptionRepository, gatewayCache, configConfig, schedulerSnapshotService, concurrencyService, billingService, rateLimitService, billingCacheService, identityService, httpUpstream, deferredService, claudeTokenProvider, sessionLimitCache)
-	openAITokenProvider := service.NewOpenAITokenProvider(accountRepository, geminiTokenCache, openAIOAuthService)
-	openAIGatewayService := service.NewOpenAIGatewayService(accountRepository, usageLogRepository, userRepository, userSubscriptionRepository, gatewayCache, configConfig, schedulerSnapshotService, concurrencyService, billingService, rateLimitService, billingCacheService, httpUpstream, deferredService, openAITokenProvider)
-	geminiMessagesCompatService := service.NewGeminiMessagesCompatService(accountRepository, groupRepository, gatewayCache, schedulerSnapshotService, geminiTokenProvider, rateLimitService, httpUpstream, antigravityGatewayService, configConfig)
-	opsService := service.NewOpsService(opsRepository, settingRepository, configConfig, accountRepository, concurrencyService, gatewayService, openAIGatewayService, geminiMessagesCompatService, antigravityGatewayService)
 	settingHandler := admin.NewSettingHandler(settingService, emailService, turnstileService, opsService)
 	opsHandler := admin.NewOpsHandler(opsService)
 	updateCache := repository.NewUpdateCache(redisClient)
@@ -161,11 +192,27 @@ func initializeApplication(buildInfo handler.BuildInfo) (*Application, error) {
 	userAttributeValueRepository := repository.NewUserAttributeValueRepository(client)
 	userAttributeService := service.NewUserAttributeService(userAttributeDefinitionRepository, userAttributeValueRepository)
 	userAttributeHandler := admin.NewUserAttributeHandler(userAttributeService)
-	adminHandlers := handler.ProvideAdminHandlers(dashboardHandler, adminUserHandler, groupHandler, accountHandler, oAuthHandler, openAIOAuthHandler, geminiOAuthHandler, antigravityOAuthHandler, proxyHandler, adminRedeemHandler, promoHandler, settingHandler, opsHandler, systemHandler, adminSubscriptionHandler, adminUsageHandler, userAttributeHandler)
-	gatewayHandler := handler.NewGatewayHandler(gatewayService, geminiMessagesCompatService, antigravityGatewayService, userService, concurrencyService, billingCacheService, configConfig)
-	openAIGatewayHandler := handler.NewOpenAIGatewayHandler(openAIGatewayService, concurrencyService, billingCacheService, configConfig)
+	adminPaymentHandler := admin.NewPaymentHandler(paymentService)
+	adminStatementHandler := admin.NewStatementHandler(statementService)
+	priceOverrideHandler := admin.NewPriceOverrideHandler(modelPriceOverrideService)
+	usageRerateRepository := repository.NewUsageRerateRepository(db)
+	usageRerateService := service.ProvideUsageRerateService(usageRerateRepository, billingService, groupRepository, userRepository, client, billingCacheService, apiKeyAuthCacheInvalidator, timingWheelService, dashboardAggregationService, configConfig)
+	usageRerateHandler := admin.NewUsageRerateHandler(usageRerateService)
+	subscriptionPlanHandler := admin.NewSubscriptionPlanHandler(subscriptionPlanService)
+	adminOrganizationHandler := admin.NewOrganizationHandler(organizationService)
+	adminReferralHandler := admin.NewReferralHandler(referralService)
+	redeemBatchRepository := repository.NewRedeemBatchRepository(db)
+	redeemBatchService := service.NewRedeemBatchService(redeemBatchRepository, redeemCodeRepository, groupRepository, client)
+	redeemBatchHandler := admin.NewRedeemBatchHandler(redeemBatchService)
+	profitRepository := repository.NewProfitRepository(db)
+	profitService := service.NewProfitService(profitRepository, accountRepository, dashboardAggregationRepository, configConfig)
+	profitHandler := admin.NewProfitHandler(profitService)
+	adminHandlers := handler.ProvideAdminHandlers(dashboardHandler, adminUserHandler, groupHandler, accountHandler, oAuthHandler, openAIOAuthHandler, geminiOAuthHandler, antigravityOAuthHandler, proxyHandler, adminRedeemHandler, promoHandler, settingHandler, opsHandler, systemHandler, adminSubscriptionHandler, adminUsageHandler, userAttributeHandler, adminPaymentHandler, adminStatementHandler, priceOverrideHandler, usageRerateHandler, subscriptionPlanHandler, adminOrganizationHandler, adminReferralHandler, redeemBatchHandler, profitHandler)
+	balanceReservationService := service.NewBalanceReservationService(billingCache, billingCacheService, billingService, timingWheelService, configConfig)
+	gatewayHandler := handler.NewGatewayHandler(gatewayService, geminiMessagesCompatService, antigravityGatewayService, userService, concurrencyService, billingCacheService, balanceReservationService, configConfig)
+	openAIGatewayHandler := handler.NewOpenAIGatewayHandler(openAIGatewayService, concurrencyService, billingCacheService, balanceReservationService, configConfig)
 	handlerSettingHandler := handler.ProvideSettingHandler(settingService, buildInfo)
-	handlers := handler.ProvideHandlers(authHandler, userHandler, apiKeyHandler, usageHandler, redeemHandler, subscriptionHandler, adminHandlers, gatewayHandler, openAIGatewayHandler, handlerSettingHandler)
+	handlers := handler.ProvideHandlers(authHandler, userHandler, apiKeyHandler, usageHandler, redeemHandler, subscriptionHandler, paymentHandler, statementHandler, organizationHandler, referralHandler, adminHandlers, gatewayHandler, openAIGatewayHandler, handlerSettingHandler)
 	jwtAuthMiddleware := middleware.NewJWTAuthMiddleware(authService, userService)
 	adminAuthMiddleware := middleware.NewAdminAuthMiddleware(authService, userService, settingService)
 	apiKeyAuthMiddleware := middleware.NewAPIKeyAuthMiddleware(apiKeyService, subscriptionService, configConfig)
@@ -178,7 +225,9 @@ func initializeApplication(buildInfo handler.BuildInfo) (*Application, error) {
 	opsScheduledReportService := service.ProvideOpsScheduledReportService(opsService, userService, emailService, redisClient, configConfig)
 	tokenRefreshService := service.ProvideTokenRefreshService(accountRepository, oAuthService, openAIOAuthService, geminiOAuthService, antigravityOAuthService, compositeTokenCacheInvalidator, configConfig)
 	accountExpiryService := service.ProvideAccountExpiryService(accountRepository)
-	v := provideCleanup(client, redisClient, opsMetricsCollector, opsAggregationService, opsAlertEvaluatorService, opsCleanupService, opsScheduledReportService, schedulerSnapshotService, tokenRefreshService, accountExpiryService, usageCleanupService, pricingService, emailQueueService, billingCacheService, oAuthService, openAIOAuthService, geminiOAuthService, antigravityOAuthService)
+	accountAvailabilityRepository := repository.NewAccountAvailabilityRepository(db)
+	accountAvailabilityService := service.ProvideAccountAvailabilityService(accountAvailabilityRepository, timingWheelService, db)
+	v := provideCleanup(client, redisClient, opsMetricsCollector, opsAggregationService, opsAlertEvaluatorService, opsCleanupService, opsScheduledReportService, schedulerSnapshotService, tokenRefreshService, accountExpiryService, accountHealthProbeService, groupPoolService, accountAvailabilityService, balanceLedgerService, creditLotService, paymentService, subscriptionPlanService, statementService, referralService, userNotificationService, modelPriceOverrideService, usageCleanupService, usageRerateService, pricingService, emailQueueService, billingCacheService, oAuthService, openAIOAuthService, geminiOAuthService, antigravityOAuthService)
 	application := &Application{
 		Server:  httpServer,
 		Cleanup: v,
@@ -211,7 +260,19 @@ func provideCleanup(
 	schedulerSnapshot *service.SchedulerSnapshotService,
 	tokenRefresh *service.TokenRefreshService,
 	accountExpiry *service.AccountExpiryService,
+	accountHealthProbe *service.AccountHealthProbeService,
+	groupPool *service.GroupPoolService,
+	accountAvailability *service.AccountAvailabilityService,
+	balanceLedger *service.BalanceLedgerService,
+	creditLot *service.CreditLotService,
+	payment *service.PaymentService,
+	subscriptionPlan *service.SubscriptionPlanService,
+	statement *service.StatementService,
+	referral *service.ReferralService,
+	userNotification *service.UserNotificationService,
+	priceOverride *service.ModelPriceOverrideService,
 	usageCleanup *service.UsageCleanupService,
+	usageRerate *service.UsageRerateService,
 	pricing *service.PricingService,
 	emailQueue *service.EmailQueueService,
 	billingCache *service.BillingCacheService,
@@ -270,6 +331,10 @@ func provideCleanup(
 				}
 				return nil
 			}},
+			{"UsageRerateService", func() error {
+				usageRerate.Stop()
+				return nil
+			}},
 			{"TokenRefreshService", func() error {
 				tokenRefresh.Stop()
 				return nil
@@ -278,6 +343,50 @@ func provideCleanup(
 				accountExpiry.Stop()
 				return nil
 			}},
+			{"AccountHealthProbeService", func() error {
+				accountHealthProbe.Stop()
+				return nil
+			}},
+			{"GroupPoolService", func() error {
+				groupPool.Stop()
+				return nil
+			}},
+			{"AccountAvailabilityService", func() error {
+				accountAvailability.Stop()
+				return nil
+			}},
+			{"BalanceLedgerService", func() error {
+				balanceLedger.Stop()
+				return nil
+			}},
+			{"CreditLotService", func() error {
+				creditLot.Stop()
+				return nil
+			}},
+			{"PaymentService", func() error {
+				payment.Stop()
+				return nil
+			}},
+			{"SubscriptionPlanService", func() error {
+				subscriptionPlan.Stop()
+				return nil
+			}},
+			{"StatementService", func() error {
+				statement.Stop()
+				return nil
+			}},
+			{"ReferralService", func() error {
+				referral.Stop()
+				return nil
+			}},
+			{"UserNotificationService", func() error {
+				userNotification.Stop()
+				return nil
+			}},
+			{"ModelPriceOverrideService", func() error {
+				priceOverride.Stop()
+				return nil
+			}},
 			{"PricingService", func() error {
 				pricing.Stop()
 				return nil
@@ -65,6 +65,10 @@ const (
 	FieldSessionWindowEnd = "session_window_end"
 	// FieldSessionWindowStatus holds the string denoting the session_window_status field in the database.
 	FieldSessionWindowStatus = "session_window_status"
+	// FieldAvailabilityWindows holds the string denoting the availability_windows field in the database.
+	FieldAvailabilityWindows = "availability_windows"
+	// FieldAvailabilityTimezone holds the string denoting the availability_timezone field in the database.
+	FieldAvailabilityTimezone = "availability_timezone"
 	// EdgeGroups holds the string denoting the groups edge name in mutations.
 	EdgeGroups = "groups"
 	// EdgeProxy holds the string denoting the proxy edge name in mutations.
@@ -131,6 +135,8 @@ var Columns = []string{
 	FieldSessionWindowStart,
 	FieldSessionWindowEnd,
 	FieldSessionWindowStatus,
+	FieldAvailabilityWindows,
+	FieldAvailabilityTimezone,
 }
 
 var (
@@ -189,6 +195,8 @@ var (
 	DefaultSchedulable bool
 	// SessionWindowStatusValidator is a validator for the "session_window_status" field. It is called by the builders before save.
 	SessionWindowStatusValidator func(string) error
+	// AvailabilityTimezoneValidator is a validator for the "availability_timezone" field. It is called by the builders before save.
+	AvailabilityTimezoneValidator func(string) error
 )
 
 // OrderOption defines the ordering options for the Account queries.
@@ -314,6 +322,11 @@ func BySessionWindowStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSessionWindowStatus, opts...).ToFunc()
 }
 
+// ByAvailabilityTimezone orders the results by the availability_timezone field.
+func ByAvailabilityTimezone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvailabilityTimezone, opts...).ToFunc()
+}
+
 // ByGroupsCount orders the results by groups count.
 func ByGroupsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
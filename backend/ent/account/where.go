@@ -170,6 +170,11 @@ func SessionWindowStatus(v string) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldSessionWindowStatus, v))
 }
 
+// AvailabilityTimezone applies equality check predicate on the "availability_timezone" field. It's identical to AvailabilityTimezoneEQ.
+func AvailabilityTimezone(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldAvailabilityTimezone, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldCreatedAt, v))
@@ -1305,6 +1310,91 @@ func SessionWindowStatusContainsFold(v string) predicate.Account {
 	return predicate.Account(sql.FieldContainsFold(FieldSessionWindowStatus, v))
 }
 
+// AvailabilityWindowsIsNil applies the IsNil predicate on the "availability_windows" field.
+func AvailabilityWindowsIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldAvailabilityWindows))
+}
+
+// AvailabilityWindowsNotNil applies the NotNil predicate on the "availability_windows" field.
+func AvailabilityWindowsNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldAvailabilityWindows))
+}
+
+// AvailabilityTimezoneEQ applies the EQ predicate on the "availability_timezone" field.
+func AvailabilityTimezoneEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldAvailabilityTimezone, v))
+}
+
+// AvailabilityTimezoneNEQ applies the NEQ predicate on the "availability_timezone" field.
+func AvailabilityTimezoneNEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldAvailabilityTimezone, v))
+}
+
+// AvailabilityTimezoneIn applies the In predicate on the "availability_timezone" field.
+func AvailabilityTimezoneIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldAvailabilityTimezone, vs...))
+}
+
+// AvailabilityTimezoneNotIn applies the NotIn predicate on the "availability_timezone" field.
+func AvailabilityTimezoneNotIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldAvailabilityTimezone, vs...))
+}
+
+// AvailabilityTimezoneGT applies the GT predicate on the "availability_timezone" field.
+func AvailabilityTimezoneGT(v string) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldAvailabilityTimezone, v))
+}
+
+// AvailabilityTimezoneGTE applies the GTE predicate on the "availability_timezone" field.
+func AvailabilityTimezoneGTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldAvailabilityTimezone, v))
+}
+
+// AvailabilityTimezoneLT applies the LT predicate on the "availability_timezone" field.
+func AvailabilityTimezoneLT(v string) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldAvailabilityTimezone, v))
+}
+
+// AvailabilityTimezoneLTE applies the LTE predicate on the "availability_timezone" field.
+func AvailabilityTimezoneLTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldAvailabilityTimezone, v))
+}
+
+// AvailabilityTimezoneContains applies the Contains predicate on the "availability_timezone" field.
+func AvailabilityTimezoneContains(v string) predicate.Account {
+	return predicate.Account(sql.FieldContains(FieldAvailabilityTimezone, v))
+}
+
+// AvailabilityTimezoneHasPrefix applies the HasPrefix predicate on the "availability_timezone" field.
+func AvailabilityTimezoneHasPrefix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasPrefix(FieldAvailabilityTimezone, v))
+}
+
+// AvailabilityTimezoneHasSuffix applies the HasSuffix predicate on the "availability_timezone" field.
+func AvailabilityTimezoneHasSuffix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasSuffix(FieldAvailabilityTimezone, v))
+}
+
+// AvailabilityTimezoneIsNil applies the IsNil predicate on the "availability_timezone" field.
+func AvailabilityTimezoneIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldAvailabilityTimezone))
+}
+
+// AvailabilityTimezoneNotNil applies the NotNil predicate on the "availability_timezone" field.
+func AvailabilityTimezoneNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldAvailabilityTimezone))
+}
+
+// AvailabilityTimezoneEqualFold applies the EqualFold predicate on the "availability_timezone" field.
+func AvailabilityTimezoneEqualFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldEqualFold(FieldAvailabilityTimezone, v))
+}
+
+// AvailabilityTimezoneContainsFold applies the ContainsFold predicate on the "availability_timezone" field.
+func AvailabilityTimezoneContainsFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldContainsFold(FieldAvailabilityTimezone, v))
+}
+
 // HasGroups applies the HasEdge predicate on the "groups" edge.
 func HasGroups() predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
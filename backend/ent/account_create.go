@@ -335,6 +335,26 @@ func (_c *AccountCreate) SetNillableSessionWindowStatus(v *string) *AccountCreat
 	return _c
 }
 
+// SetAvailabilityWindows sets the "availability_windows" field.
+func (_c *AccountCreate) SetAvailabilityWindows(v []string) *AccountCreate {
+	_c.mutation.SetAvailabilityWindows(v)
+	return _c
+}
+
+// SetAvailabilityTimezone sets the "availability_timezone" field.
+func (_c *AccountCreate) SetAvailabilityTimezone(v string) *AccountCreate {
+	_c.mutation.SetAvailabilityTimezone(v)
+	return _c
+}
+
+// SetNillableAvailabilityTimezone sets the "availability_timezone" field if the given value is not nil.
+func (_c *AccountCreate) SetNillableAvailabilityTimezone(v *string) *AccountCreate {
+	if v != nil {
+		_c.SetAvailabilityTimezone(*v)
+	}
+	return _c
+}
+
 // AddGroupIDs adds the "groups" edge to the Group entity by IDs.
 func (_c *AccountCreate) AddGroupIDs(ids ...int64) *AccountCreate {
 	_c.mutation.AddGroupIDs(ids...)
@@ -528,6 +548,11 @@ func (_c *AccountCreate) check() error {
 			return &ValidationError{Name: "session_window_status", err: fmt.Errorf(`ent: validator failed for field "Account.session_window_status": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.AvailabilityTimezone(); ok {
+		if err := account.AvailabilityTimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "availability_timezone", err: fmt.Errorf(`ent: validator failed for field "Account.availability_timezone": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -651,6 +676,14 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		_spec.SetField(account.FieldSessionWindowStatus, field.TypeString, value)
 		_node.SessionWindowStatus = &value
 	}
+	if value, ok := _c.mutation.AvailabilityWindows(); ok {
+		_spec.SetField(account.FieldAvailabilityWindows, field.TypeJSON, value)
+		_node.AvailabilityWindows = value
+	}
+	if value, ok := _c.mutation.AvailabilityTimezone(); ok {
+		_spec.SetField(account.FieldAvailabilityTimezone, field.TypeString, value)
+		_node.AvailabilityTimezone = &value
+	}
 	if nodes := _c.mutation.GroupsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -1134,6 +1167,42 @@ func (u *AccountUpsert) ClearSessionWindowStatus() *AccountUpsert {
 	return u
 }
 
+// SetAvailabilityWindows sets the "availability_windows" field.
+func (u *AccountUpsert) SetAvailabilityWindows(v []string) *AccountUpsert {
+	u.Set(account.FieldAvailabilityWindows, v)
+	return u
+}
+
+// UpdateAvailabilityWindows sets the "availability_windows" field to the value that was provided on create.
+func (u *AccountUpsert) UpdateAvailabilityWindows() *AccountUpsert {
+	u.SetExcluded(account.FieldAvailabilityWindows)
+	return u
+}
+
+// ClearAvailabilityWindows clears the value of the "availability_windows" field.
+func (u *AccountUpsert) ClearAvailabilityWindows() *AccountUpsert {
+	u.SetNull(account.FieldAvailabilityWindows)
+	return u
+}
+
+// SetAvailabilityTimezone sets the "availability_timezone" field.
+func (u *AccountUpsert) SetAvailabilityTimezone(v string) *AccountUpsert {
+	u.Set(account.FieldAvailabilityTimezone, v)
+	return u
+}
+
+// UpdateAvailabilityTimezone sets the "availability_timezone" field to the value that was provided on create.
+func (u *AccountUpsert) UpdateAvailabilityTimezone() *AccountUpsert {
+	u.SetExcluded(account.FieldAvailabilityTimezone)
+	return u
+}
+
+// ClearAvailabilityTimezone clears the value of the "availability_timezone" field.
+func (u *AccountUpsert) ClearAvailabilityTimezone() *AccountUpsert {
+	u.SetNull(account.FieldAvailabilityTimezone)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -1620,6 +1689,48 @@ func (u *AccountUpsertOne) ClearSessionWindowStatus() *AccountUpsertOne {
 	})
 }
 
+// SetAvailabilityWindows sets the "availability_windows" field.
+func (u *AccountUpsertOne) SetAvailabilityWindows(v []string) *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetAvailabilityWindows(v)
+	})
+}
+
+// UpdateAvailabilityWindows sets the "availability_windows" field to the value that was provided on create.
+func (u *AccountUpsertOne) UpdateAvailabilityWindows() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateAvailabilityWindows()
+	})
+}
+
+// ClearAvailabilityWindows clears the value of the "availability_windows" field.
+func (u *AccountUpsertOne) ClearAvailabilityWindows() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearAvailabilityWindows()
+	})
+}
+
+// SetAvailabilityTimezone sets the "availability_timezone" field.
+func (u *AccountUpsertOne) SetAvailabilityTimezone(v string) *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetAvailabilityTimezone(v)
+	})
+}
+
+// UpdateAvailabilityTimezone sets the "availability_timezone" field to the value that was provided on create.
+func (u *AccountUpsertOne) UpdateAvailabilityTimezone() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateAvailabilityTimezone()
+	})
+}
+
+// ClearAvailabilityTimezone clears the value of the "availability_timezone" field.
+func (u *AccountUpsertOne) ClearAvailabilityTimezone() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearAvailabilityTimezone()
+	})
+}
+
 // Exec executes the query.
 func (u *AccountUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -2272,6 +2383,48 @@ func (u *AccountUpsertBulk) ClearSessionWindowStatus() *AccountUpsertBulk {
 	})
 }
 
+// SetAvailabilityWindows sets the "availability_windows" field.
+func (u *AccountUpsertBulk) SetAvailabilityWindows(v []string) *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetAvailabilityWindows(v)
+	})
+}
+
+// UpdateAvailabilityWindows sets the "availability_windows" field to the value that was provided on create.
+func (u *AccountUpsertBulk) UpdateAvailabilityWindows() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateAvailabilityWindows()
+	})
+}
+
+// ClearAvailabilityWindows clears the value of the "availability_windows" field.
+func (u *AccountUpsertBulk) ClearAvailabilityWindows() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearAvailabilityWindows()
+	})
+}
+
+// SetAvailabilityTimezone sets the "availability_timezone" field.
+func (u *AccountUpsertBulk) SetAvailabilityTimezone(v string) *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetAvailabilityTimezone(v)
+	})
+}
+
+// UpdateAvailabilityTimezone sets the "availability_timezone" field to the value that was provided on create.
+func (u *AccountUpsertBulk) UpdateAvailabilityTimezone() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateAvailabilityTimezone()
+	})
+}
+
+// ClearAvailabilityTimezone clears the value of the "availability_timezone" field.
+func (u *AccountUpsertBulk) ClearAvailabilityTimezone() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearAvailabilityTimezone()
+	})
+}
+
 // Exec executes the query.
 func (u *AccountUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/Wei-Shaw/sub2api/ent/account"
 	"github.com/Wei-Shaw/sub2api/ent/group"
@@ -436,6 +437,44 @@ func (_u *AccountUpdate) ClearSessionWindowStatus() *AccountUpdate {
 	return _u
 }
 
+// SetAvailabilityWindows sets the "availability_windows" field.
+func (_u *AccountUpdate) SetAvailabilityWindows(v []string) *AccountUpdate {
+	_u.mutation.SetAvailabilityWindows(v)
+	return _u
+}
+
+// AppendAvailabilityWindows appends value to the "availability_windows" field.
+func (_u *AccountUpdate) AppendAvailabilityWindows(v []string) *AccountUpdate {
+	_u.mutation.AppendAvailabilityWindows(v)
+	return _u
+}
+
+// ClearAvailabilityWindows clears the value of the "availability_windows" field.
+func (_u *AccountUpdate) ClearAvailabilityWindows() *AccountUpdate {
+	_u.mutation.ClearAvailabilityWindows()
+	return _u
+}
+
+// SetAvailabilityTimezone sets the "availability_timezone" field.
+func (_u *AccountUpdate) SetAvailabilityTimezone(v string) *AccountUpdate {
+	_u.mutation.SetAvailabilityTimezone(v)
+	return _u
+}
+
+// SetNillableAvailabilityTimezone sets the "availability_timezone" field if the given value is not nil.
+func (_u *AccountUpdate) SetNillableAvailabilityTimezone(v *string) *AccountUpdate {
+	if v != nil {
+		_u.SetAvailabilityTimezone(*v)
+	}
+	return _u
+}
+
+// ClearAvailabilityTimezone clears the value of the "availability_timezone" field.
+func (_u *AccountUpdate) ClearAvailabilityTimezone() *AccountUpdate {
+	_u.mutation.ClearAvailabilityTimezone()
+	return _u
+}
+
 // AddGroupIDs adds the "groups" edge to the Group entity by IDs.
 func (_u *AccountUpdate) AddGroupIDs(ids ...int64) *AccountUpdate {
 	_u.mutation.AddGroupIDs(ids...)
@@ -593,6 +632,11 @@ func (_u *AccountUpdate) check() error {
 			return &ValidationError{Name: "session_window_status", err: fmt.Errorf(`ent: validator failed for field "Account.session_window_status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.AvailabilityTimezone(); ok {
+		if err := account.AvailabilityTimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "availability_timezone", err: fmt.Errorf(`ent: validator failed for field "Account.availability_timezone": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -719,6 +763,23 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.SessionWindowStatusCleared() {
 		_spec.ClearField(account.FieldSessionWindowStatus, field.TypeString)
 	}
+	if value, ok := _u.mutation.AvailabilityWindows(); ok {
+		_spec.SetField(account.FieldAvailabilityWindows, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAvailabilityWindows(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, account.FieldAvailabilityWindows, value)
+		})
+	}
+	if _u.mutation.AvailabilityWindowsCleared() {
+		_spec.ClearField(account.FieldAvailabilityWindows, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AvailabilityTimezone(); ok {
+		_spec.SetField(account.FieldAvailabilityTimezone, field.TypeString, value)
+	}
+	if _u.mutation.AvailabilityTimezoneCleared() {
+		_spec.ClearField(account.FieldAvailabilityTimezone, field.TypeString)
+	}
 	if _u.mutation.GroupsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -1275,6 +1336,44 @@ func (_u *AccountUpdateOne) ClearSessionWindowStatus() *AccountUpdateOne {
 	return _u
 }
 
+// SetAvailabilityWindows sets the "availability_windows" field.
+func (_u *AccountUpdateOne) SetAvailabilityWindows(v []string) *AccountUpdateOne {
+	_u.mutation.SetAvailabilityWindows(v)
+	return _u
+}
+
+// AppendAvailabilityWindows appends value to the "availability_windows" field.
+func (_u *AccountUpdateOne) AppendAvailabilityWindows(v []string) *AccountUpdateOne {
+	_u.mutation.AppendAvailabilityWindows(v)
+	return _u
+}
+
+// ClearAvailabilityWindows clears the value of the "availability_windows" field.
+func (_u *AccountUpdateOne) ClearAvailabilityWindows() *AccountUpdateOne {
+	_u.mutation.ClearAvailabilityWindows()
+	return _u
+}
+
+// SetAvailabilityTimezone sets the "availability_timezone" field.
+func (_u *AccountUpdateOne) SetAvailabilityTimezone(v string) *AccountUpdateOne {
+	_u.mutation.SetAvailabilityTimezone(v)
+	return _u
+}
+
+// SetNillableAvailabilityTimezone sets the "availability_timezone" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableAvailabilityTimezone(v *string) *AccountUpdateOne {
+	if v != nil {
+		_u.SetAvailabilityTimezone(*v)
+	}
+	return _u
+}
+
+// ClearAvailabilityTimezone clears the value of the "availability_timezone" field.
+func (_u *AccountUpdateOne) ClearAvailabilityTimezone() *AccountUpdateOne {
+	_u.mutation.ClearAvailabilityTimezone()
+	return _u
+}
+
 // AddGroupIDs adds the "groups" edge to the Group entity by IDs.
 func (_u *AccountUpdateOne) AddGroupIDs(ids ...int64) *AccountUpdateOne {
 	_u.mutation.AddGroupIDs(ids...)
@@ -1445,6 +1544,11 @@ func (_u *AccountUpdateOne) check() error {
 			return &ValidationError{Name: "session_window_status", err: fmt.Errorf(`ent: validator failed for field "Account.session_window_status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.AvailabilityTimezone(); ok {
+		if err := account.AvailabilityTimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "availability_timezone", err: fmt.Errorf(`ent: validator failed for field "Account.availability_timezone": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1588,6 +1692,23 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 	if _u.mutation.SessionWindowStatusCleared() {
 		_spec.ClearField(account.FieldSessionWindowStatus, field.TypeString)
 	}
+	if value, ok := _u.mutation.AvailabilityWindows(); ok {
+		_spec.SetField(account.FieldAvailabilityWindows, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAvailabilityWindows(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, account.FieldAvailabilityWindows, value)
+		})
+	}
+	if _u.mutation.AvailabilityWindowsCleared() {
+		_spec.ClearField(account.FieldAvailabilityWindows, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AvailabilityTimezone(); ok {
+		_spec.SetField(account.FieldAvailabilityTimezone, field.TypeString, value)
+	}
+	if _u.mutation.AvailabilityTimezoneCleared() {
+		_spec.ClearField(account.FieldAvailabilityTimezone, field.TypeString)
+	}
 	if _u.mutation.GroupsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -25,6 +25,11 @@ func Priority(v int) predicate.AccountGroup {
 	return predicate.AccountGroup(sql.FieldEQ(FieldPriority, v))
 }
 
+// Pool applies equality check predicate on the "pool" field. It's identical to PoolEQ.
+func Pool(v string) predicate.AccountGroup {
+	return predicate.AccountGroup(sql.FieldEQ(FieldPool, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.AccountGroup {
 	return predicate.AccountGroup(sql.FieldEQ(FieldCreatedAt, v))
@@ -110,6 +115,71 @@ func PriorityLTE(v int) predicate.AccountGroup {
 	return predicate.AccountGroup(sql.FieldLTE(FieldPriority, v))
 }
 
+// PoolEQ applies the EQ predicate on the "pool" field.
+func PoolEQ(v string) predicate.AccountGroup {
+	return predicate.AccountGroup(sql.FieldEQ(FieldPool, v))
+}
+
+// PoolNEQ applies the NEQ predicate on the "pool" field.
+func PoolNEQ(v string) predicate.AccountGroup {
+	return predicate.AccountGroup(sql.FieldNEQ(FieldPool, v))
+}
+
+// PoolIn applies the In predicate on the "pool" field.
+func PoolIn(vs ...string) predicate.AccountGroup {
+	return predicate.AccountGroup(sql.FieldIn(FieldPool, vs...))
+}
+
+// PoolNotIn applies the NotIn predicate on the "pool" field.
+func PoolNotIn(vs ...string) predicate.AccountGroup {
+	return predicate.AccountGroup(sql.FieldNotIn(FieldPool, vs...))
+}
+
+// PoolGT applies the GT predicate on the "pool" field.
+func PoolGT(v string) predicate.AccountGroup {
+	return predicate.AccountGroup(sql.FieldGT(FieldPool, v))
+}
+
+// PoolGTE applies the GTE predicate on the "pool" field.
+func PoolGTE(v string) predicate.AccountGroup {
+	return predicate.AccountGroup(sql.FieldGTE(FieldPool, v))
+}
+
+// PoolLT applies the LT predicate on the "pool" field.
+func PoolLT(v string) predicate.AccountGroup {
+	return predicate.AccountGroup(sql.FieldLT(FieldPool, v))
+}
+
+// PoolLTE applies the LTE predicate on the "pool" field.
+func PoolLTE(v string) predicate.AccountGroup {
+	return predicate.AccountGroup(sql.FieldLTE(FieldPool, v))
+}
+
+// PoolContains applies the Contains predicate on the "pool" field.
+func PoolContains(v string) predicate.AccountGroup {
+	return predicate.AccountGroup(sql.FieldContains(FieldPool, v))
+}
+
+// PoolHasPrefix applies the HasPrefix predicate on the "pool" field.
+func PoolHasPrefix(v string) predicate.AccountGroup {
+	return predicate.AccountGroup(sql.FieldHasPrefix(FieldPool, v))
+}
+
+// PoolHasSuffix applies the HasSuffix predicate on the "pool" field.
+func PoolHasSuffix(v string) predicate.AccountGroup {
+	return predicate.AccountGroup(sql.FieldHasSuffix(FieldPool, v))
+}
+
+// PoolEqualFold applies the EqualFold predicate on the "pool" field.
+func PoolEqualFold(v string) predicate.AccountGroup {
+	return predicate.AccountGroup(sql.FieldEqualFold(FieldPool, v))
+}
+
+// PoolContainsFold applies the ContainsFold predicate on the "pool" field.
+func PoolContainsFold(v string) predicate.AccountGroup {
+	return predicate.AccountGroup(sql.FieldContainsFold(FieldPool, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.AccountGroup {
 	return predicate.AccountGroup(sql.FieldEQ(FieldCreatedAt, v))
@@ -50,6 +50,20 @@ func (_c *AccountGroupCreate) SetNillablePriority(v *int) *AccountGroupCreate {
 	return _c
 }
 
+// SetPool sets the "pool" field.
+func (_c *AccountGroupCreate) SetPool(v string) *AccountGroupCreate {
+	_c.mutation.SetPool(v)
+	return _c
+}
+
+// SetNillablePool sets the "pool" field if the given value is not nil.
+func (_c *AccountGroupCreate) SetNillablePool(v *string) *AccountGroupCreate {
+	if v != nil {
+		_c.SetPool(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *AccountGroupCreate) SetCreatedAt(v time.Time) *AccountGroupCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -113,6 +127,10 @@ func (_c *AccountGroupCreate) defaults() {
 		v := accountgroup.DefaultPriority
 		_c.mutation.SetPriority(v)
 	}
+	if _, ok := _c.mutation.Pool(); !ok {
+		v := accountgroup.DefaultPool
+		_c.mutation.SetPool(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := accountgroup.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -130,6 +148,14 @@ func (_c *AccountGroupCreate) check() error {
 	if _, ok := _c.mutation.Priority(); !ok {
 		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "AccountGroup.priority"`)}
 	}
+	if _, ok := _c.mutation.Pool(); !ok {
+		return &ValidationError{Name: "pool", err: errors.New(`ent: missing required field "AccountGroup.pool"`)}
+	}
+	if v, ok := _c.mutation.Pool(); ok {
+		if err := accountgroup.PoolValidator(v); err != nil {
+			return &ValidationError{Name: "pool", err: fmt.Errorf(`ent: validator failed for field "AccountGroup.pool": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AccountGroup.created_at"`)}
 	}
@@ -166,6 +192,10 @@ func (_c *AccountGroupCreate) createSpec() (*AccountGroup, *sqlgraph.CreateSpec)
 		_spec.SetField(accountgroup.FieldPriority, field.TypeInt, value)
 		_node.Priority = value
 	}
+	if value, ok := _c.mutation.Pool(); ok {
+		_spec.SetField(accountgroup.FieldPool, field.TypeString, value)
+		_node.Pool = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(accountgroup.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -298,6 +328,18 @@ func (u *AccountGroupUpsert) AddPriority(v int) *AccountGroupUpsert {
 	return u
 }
 
+// SetPool sets the "pool" field.
+func (u *AccountGroupUpsert) SetPool(v string) *AccountGroupUpsert {
+	u.Set(accountgroup.FieldPool, v)
+	return u
+}
+
+// UpdatePool sets the "pool" field to the value that was provided on create.
+func (u *AccountGroupUpsert) UpdatePool() *AccountGroupUpsert {
+	u.SetExcluded(accountgroup.FieldPool)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -392,6 +434,20 @@ func (u *AccountGroupUpsertOne) UpdatePriority() *AccountGroupUpsertOne {
 	})
 }
 
+// SetPool sets the "pool" field.
+func (u *AccountGroupUpsertOne) SetPool(v string) *AccountGroupUpsertOne {
+	return u.Update(func(s *AccountGroupUpsert) {
+		s.SetPool(v)
+	})
+}
+
+// UpdatePool sets the "pool" field to the value that was provided on create.
+func (u *AccountGroupUpsertOne) UpdatePool() *AccountGroupUpsertOne {
+	return u.Update(func(s *AccountGroupUpsert) {
+		s.UpdatePool()
+	})
+}
+
 // Exec executes the query.
 func (u *AccountGroupUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -629,6 +685,20 @@ func (u *AccountGroupUpsertBulk) UpdatePriority() *AccountGroupUpsertBulk {
 	})
 }
 
+// SetPool sets the "pool" field.
+func (u *AccountGroupUpsertBulk) SetPool(v string) *AccountGroupUpsertBulk {
+	return u.Update(func(s *AccountGroupUpsert) {
+		s.SetPool(v)
+	})
+}
+
+// UpdatePool sets the "pool" field to the value that was provided on create.
+func (u *AccountGroupUpsertBulk) UpdatePool() *AccountGroupUpsertBulk {
+	return u.Update(func(s *AccountGroupUpsert) {
+		s.UpdatePool()
+	})
+}
+
 // Exec executes the query.
 func (u *AccountGroupUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
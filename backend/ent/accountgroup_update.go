@@ -78,6 +78,20 @@ func (_u *AccountGroupUpdate) AddPriority(v int) *AccountGroupUpdate {
 	return _u
 }
 
+// SetPool sets the "pool" field.
+func (_u *AccountGroupUpdate) SetPool(v string) *AccountGroupUpdate {
+	_u.mutation.SetPool(v)
+	return _u
+}
+
+// SetNillablePool sets the "pool" field if the given value is not nil.
+func (_u *AccountGroupUpdate) SetNillablePool(v *string) *AccountGroupUpdate {
+	if v != nil {
+		_u.SetPool(*v)
+	}
+	return _u
+}
+
 // SetAccount sets the "account" edge to the Account entity.
 func (_u *AccountGroupUpdate) SetAccount(v *Account) *AccountGroupUpdate {
 	return _u.SetAccountID(v.ID)
@@ -134,6 +148,11 @@ func (_u *AccountGroupUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *AccountGroupUpdate) check() error {
+	if v, ok := _u.mutation.Pool(); ok {
+		if err := accountgroup.PoolValidator(v); err != nil {
+			return &ValidationError{Name: "pool", err: fmt.Errorf(`ent: validator failed for field "AccountGroup.pool": %w`, err)}
+		}
+	}
 	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "AccountGroup.account"`)
 	}
@@ -161,6 +180,9 @@ func (_u *AccountGroupUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if value, ok := _u.mutation.AddedPriority(); ok {
 		_spec.AddField(accountgroup.FieldPriority, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Pool(); ok {
+		_spec.SetField(accountgroup.FieldPool, field.TypeString, value)
+	}
 	if _u.mutation.AccountCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -288,6 +310,20 @@ func (_u *AccountGroupUpdateOne) AddPriority(v int) *AccountGroupUpdateOne {
 	return _u
 }
 
+// SetPool sets the "pool" field.
+func (_u *AccountGroupUpdateOne) SetPool(v string) *AccountGroupUpdateOne {
+	_u.mutation.SetPool(v)
+	return _u
+}
+
+// SetNillablePool sets the "pool" field if the given value is not nil.
+func (_u *AccountGroupUpdateOne) SetNillablePool(v *string) *AccountGroupUpdateOne {
+	if v != nil {
+		_u.SetPool(*v)
+	}
+	return _u
+}
+
 // SetAccount sets the "account" edge to the Account entity.
 func (_u *AccountGroupUpdateOne) SetAccount(v *Account) *AccountGroupUpdateOne {
 	return _u.SetAccountID(v.ID)
@@ -357,6 +393,11 @@ func (_u *AccountGroupUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *AccountGroupUpdateOne) check() error {
+	if v, ok := _u.mutation.Pool(); ok {
+		if err := accountgroup.PoolValidator(v); err != nil {
+			return &ValidationError{Name: "pool", err: fmt.Errorf(`ent: validator failed for field "AccountGroup.pool": %w`, err)}
+		}
+	}
 	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "AccountGroup.account"`)
 	}
@@ -403,6 +444,9 @@ func (_u *AccountGroupUpdateOne) sqlSave(ctx context.Context) (_node *AccountGro
 	if value, ok := _u.mutation.AddedPriority(); ok {
 		_spec.AddField(accountgroup.FieldPriority, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Pool(); ok {
+		_spec.SetField(accountgroup.FieldPool, field.TypeString, value)
+	}
 	if _u.mutation.AccountCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
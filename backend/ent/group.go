@@ -11,6 +11,8 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/Wei-Shaw/sub2api/ent/group"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/usagequota"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/volumetier"
 )
 
 // Group is the model entity for the Group schema.
@@ -60,6 +62,28 @@ type Group struct {
 	ModelRouting map[string][]int64 `json:"model_routing,omitempty"`
 	// 是否启用模型路由配置
 	ModelRoutingEnabled bool `json:"model_routing_enabled,omitempty"`
+	// 是否启用分组内子池流量分配
+	PoolSplitEnabled bool `json:"pool_split_enabled,omitempty"`
+	// 子池流量权重：池标签 -> 百分比
+	PoolWeights map[string]int `json:"pool_weights,omitempty"`
+	// 灰度池错误率阈值（0-1），超过后自动回滚其流量
+	CanaryMaxErrorRate *float64 `json:"canary_max_error_rate,omitempty"`
+	// 无可用账号或切换次数耗尽时按顺序尝试的降级分组ID列表
+	CapacityFallbackGroupIds []int64 `json:"capacity_fallback_group_ids,omitempty"`
+	// 作为降级分组承接请求时的模型映射：请求模型 -> 本分组模型
+	FallbackModelMapping map[string]string `json:"fallback_model_mapping,omitempty"`
+	// 按用户近 30 天消费分档的折扣倍率，叠加在分组倍率之上
+	VolumeTiers []volumetier.Tier `json:"volume_tiers,omitempty"`
+	// 订阅限额用尽后是否按超额倍率从余额扣费
+	OverageEnabled bool `json:"overage_enabled,omitempty"`
+	// 超额部分的计费倍率（作用于原始费用）
+	OverageRateMultiplier float64 `json:"overage_rate_multiplier,omitempty"`
+	// 滚动窗口时长（小时），0 表示不启用
+	RollingWindowHours int `json:"rolling_window_hours,omitempty"`
+	// 滚动窗口内的消费上限（USD，按原始费用计）
+	RollingWindowLimitUsd *float64 `json:"rolling_window_limit_usd,omitempty"`
+	// 按日/周/月窗口与模型模式限制请求数和输入/输出 Token 数
+	UsageQuotas []usagequota.Quota `json:"usage_quotas,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the GroupQuery when eager-loading is set.
 	Edges        GroupEdges `json:"edges"`
@@ -166,13 +190,13 @@ func (*Group) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case group.FieldModelRouting:
+		case group.FieldModelRouting, group.FieldPoolWeights, group.FieldCapacityFallbackGroupIds, group.FieldFallbackModelMapping, group.FieldVolumeTiers, group.FieldUsageQuotas:
 			values[i] = new([]byte)
-		case group.FieldIsExclusive, group.FieldClaudeCodeOnly, group.FieldModelRoutingEnabled:
+		case group.FieldIsExclusive, group.FieldClaudeCodeOnly, group.FieldModelRoutingEnabled, group.FieldPoolSplitEnabled, group.FieldOverageEnabled:
 			values[i] = new(sql.NullBool)
-		case group.FieldRateMultiplier, group.FieldDailyLimitUsd, group.FieldWeeklyLimitUsd, group.FieldMonthlyLimitUsd, group.FieldImagePrice1k, group.FieldImagePrice2k, group.FieldImagePrice4k:
+		case group.FieldRateMultiplier, group.FieldDailyLimitUsd, group.FieldWeeklyLimitUsd, group.FieldMonthlyLimitUsd, group.FieldImagePrice1k, group.FieldImagePrice2k, group.FieldImagePrice4k, group.FieldCanaryMaxErrorRate, group.FieldOverageRateMultiplier, group.FieldRollingWindowLimitUsd:
 			values[i] = new(sql.NullFloat64)
-		case group.FieldID, group.FieldDefaultValidityDays, group.FieldFallbackGroupID:
+		case group.FieldID, group.FieldDefaultValidityDays, group.FieldFallbackGroupID, group.FieldRollingWindowHours:
 			values[i] = new(sql.NullInt64)
 		case group.FieldName, group.FieldDescription, group.FieldStatus, group.FieldPlatform, group.FieldSubscriptionType:
 			values[i] = new(sql.NullString)
@@ -336,6 +360,84 @@ func (_m *Group) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ModelRoutingEnabled = value.Bool
 			}
+		case group.FieldPoolSplitEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field pool_split_enabled", values[i])
+			} else if value.Valid {
+				_m.PoolSplitEnabled = value.Bool
+			}
+		case group.FieldPoolWeights:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field pool_weights", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PoolWeights); err != nil {
+					return fmt.Errorf("unmarshal field pool_weights: %w", err)
+				}
+			}
+		case group.FieldCanaryMaxErrorRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field canary_max_error_rate", values[i])
+			} else if value.Valid {
+				_m.CanaryMaxErrorRate = new(float64)
+				*_m.CanaryMaxErrorRate = value.Float64
+			}
+		case group.FieldCapacityFallbackGroupIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field capacity_fallback_group_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.CapacityFallbackGroupIds); err != nil {
+					return fmt.Errorf("unmarshal field capacity_fallback_group_ids: %w", err)
+				}
+			}
+		case group.FieldFallbackModelMapping:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field fallback_model_mapping", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.FallbackModelMapping); err != nil {
+					return fmt.Errorf("unmarshal field fallback_model_mapping: %w", err)
+				}
+			}
+		case group.FieldVolumeTiers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field volume_tiers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.VolumeTiers); err != nil {
+					return fmt.Errorf("unmarshal field volume_tiers: %w", err)
+				}
+			}
+		case group.FieldOverageEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field overage_enabled", values[i])
+			} else if value.Valid {
+				_m.OverageEnabled = value.Bool
+			}
+		case group.FieldOverageRateMultiplier:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field overage_rate_multiplier", values[i])
+			} else if value.Valid {
+				_m.OverageRateMultiplier = value.Float64
+			}
+		case group.FieldRollingWindowHours:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rolling_window_hours", values[i])
+			} else if value.Valid {
+				_m.RollingWindowHours = int(value.Int64)
+			}
+		case group.FieldRollingWindowLimitUsd:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field rolling_window_limit_usd", values[i])
+			} else if value.Valid {
+				_m.RollingWindowLimitUsd = new(float64)
+				*_m.RollingWindowLimitUsd = value.Float64
+			}
+		case group.FieldUsageQuotas:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field usage_quotas", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.UsageQuotas); err != nil {
+					return fmt.Errorf("unmarshal field usage_quotas: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -492,6 +594,43 @@ func (_m *Group) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("model_routing_enabled=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ModelRoutingEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("pool_split_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PoolSplitEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("pool_weights=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PoolWeights))
+	builder.WriteString(", ")
+	if v := _m.CanaryMaxErrorRate; v != nil {
+		builder.WriteString("canary_max_error_rate=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("capacity_fallback_group_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CapacityFallbackGroupIds))
+	builder.WriteString(", ")
+	builder.WriteString("fallback_model_mapping=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FallbackModelMapping))
+	builder.WriteString(", ")
+	builder.WriteString("volume_tiers=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VolumeTiers))
+	builder.WriteString(", ")
+	builder.WriteString("overage_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OverageEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("overage_rate_multiplier=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OverageRateMultiplier))
+	builder.WriteString(", ")
+	builder.WriteString("rolling_window_hours=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RollingWindowHours))
+	builder.WriteString(", ")
+	if v := _m.RollingWindowLimitUsd; v != nil {
+		builder.WriteString("rolling_window_limit_usd=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("usage_quotas=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UsageQuotas))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -57,6 +57,28 @@ const (
 	FieldModelRouting = "model_routing"
 	// FieldModelRoutingEnabled holds the string denoting the model_routing_enabled field in the database.
 	FieldModelRoutingEnabled = "model_routing_enabled"
+	// FieldPoolSplitEnabled holds the string denoting the pool_split_enabled field in the database.
+	FieldPoolSplitEnabled = "pool_split_enabled"
+	// FieldPoolWeights holds the string denoting the pool_weights field in the database.
+	FieldPoolWeights = "pool_weights"
+	// FieldCanaryMaxErrorRate holds the string denoting the canary_max_error_rate field in the database.
+	FieldCanaryMaxErrorRate = "canary_max_error_rate"
+	// FieldCapacityFallbackGroupIds holds the string denoting the capacity_fallback_group_ids field in the database.
+	FieldCapacityFallbackGroupIds = "capacity_fallback_group_ids"
+	// FieldFallbackModelMapping holds the string denoting the fallback_model_mapping field in the database.
+	FieldFallbackModelMapping = "fallback_model_mapping"
+	// FieldVolumeTiers holds the string denoting the volume_tiers field in the database.
+	FieldVolumeTiers = "volume_tiers"
+	// FieldOverageEnabled holds the string denoting the overage_enabled field in the database.
+	FieldOverageEnabled = "overage_enabled"
+	// FieldOverageRateMultiplier holds the string denoting the overage_rate_multiplier field in the database.
+	FieldOverageRateMultiplier = "overage_rate_multiplier"
+	// FieldRollingWindowHours holds the string denoting the rolling_window_hours field in the database.
+	FieldRollingWindowHours = "rolling_window_hours"
+	// FieldRollingWindowLimitUsd holds the string denoting the rolling_window_limit_usd field in the database.
+	FieldRollingWindowLimitUsd = "rolling_window_limit_usd"
+	// FieldUsageQuotas holds the string denoting the usage_quotas field in the database.
+	FieldUsageQuotas = "usage_quotas"
 	// EdgeAPIKeys holds the string denoting the api_keys edge name in mutations.
 	EdgeAPIKeys = "api_keys"
 	// EdgeRedeemCodes holds the string denoting the redeem_codes edge name in mutations.
@@ -153,6 +175,17 @@ var Columns = []string{
 	FieldFallbackGroupID,
 	FieldModelRouting,
 	FieldModelRoutingEnabled,
+	FieldPoolSplitEnabled,
+	FieldPoolWeights,
+	FieldCanaryMaxErrorRate,
+	FieldCapacityFallbackGroupIds,
+	FieldFallbackModelMapping,
+	FieldVolumeTiers,
+	FieldOverageEnabled,
+	FieldOverageRateMultiplier,
+	FieldRollingWindowHours,
+	FieldRollingWindowLimitUsd,
+	FieldUsageQuotas,
 }
 
 var (
@@ -212,6 +245,14 @@ var (
 	DefaultClaudeCodeOnly bool
 	// DefaultModelRoutingEnabled holds the default value on creation for the "model_routing_enabled" field.
 	DefaultModelRoutingEnabled bool
+	// DefaultPoolSplitEnabled holds the default value on creation for the "pool_split_enabled" field.
+	DefaultPoolSplitEnabled bool
+	// DefaultOverageEnabled holds the default value on creation for the "overage_enabled" field.
+	DefaultOverageEnabled bool
+	// DefaultOverageRateMultiplier holds the default value on creation for the "overage_rate_multiplier" field.
+	DefaultOverageRateMultiplier float64
+	// DefaultRollingWindowHours holds the default value on creation for the "rolling_window_hours" field.
+	DefaultRollingWindowHours int
 )
 
 // OrderOption defines the ordering options for the Group queries.
@@ -322,6 +363,36 @@ func ByModelRoutingEnabled(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldModelRoutingEnabled, opts...).ToFunc()
 }
 
+// ByPoolSplitEnabled orders the results by the pool_split_enabled field.
+func ByPoolSplitEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPoolSplitEnabled, opts...).ToFunc()
+}
+
+// ByCanaryMaxErrorRate orders the results by the canary_max_error_rate field.
+func ByCanaryMaxErrorRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCanaryMaxErrorRate, opts...).ToFunc()
+}
+
+// ByOverageEnabled orders the results by the overage_enabled field.
+func ByOverageEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOverageEnabled, opts...).ToFunc()
+}
+
+// ByOverageRateMultiplier orders the results by the overage_rate_multiplier field.
+func ByOverageRateMultiplier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOverageRateMultiplier, opts...).ToFunc()
+}
+
+// ByRollingWindowHours orders the results by the rolling_window_hours field.
+func ByRollingWindowHours(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRollingWindowHours, opts...).ToFunc()
+}
+
+// ByRollingWindowLimitUsd orders the results by the rolling_window_limit_usd field.
+func ByRollingWindowLimitUsd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRollingWindowLimitUsd, opts...).ToFunc()
+}
+
 // ByAPIKeysCount orders the results by api_keys count.
 func ByAPIKeysCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -155,6 +155,36 @@ func ModelRoutingEnabled(v bool) predicate.Group {
 	return predicate.Group(sql.FieldEQ(FieldModelRoutingEnabled, v))
 }
 
+// PoolSplitEnabled applies equality check predicate on the "pool_split_enabled" field. It's identical to PoolSplitEnabledEQ.
+func PoolSplitEnabled(v bool) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldPoolSplitEnabled, v))
+}
+
+// CanaryMaxErrorRate applies equality check predicate on the "canary_max_error_rate" field. It's identical to CanaryMaxErrorRateEQ.
+func CanaryMaxErrorRate(v float64) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldCanaryMaxErrorRate, v))
+}
+
+// OverageEnabled applies equality check predicate on the "overage_enabled" field. It's identical to OverageEnabledEQ.
+func OverageEnabled(v bool) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldOverageEnabled, v))
+}
+
+// OverageRateMultiplier applies equality check predicate on the "overage_rate_multiplier" field. It's identical to OverageRateMultiplierEQ.
+func OverageRateMultiplier(v float64) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldOverageRateMultiplier, v))
+}
+
+// RollingWindowHours applies equality check predicate on the "rolling_window_hours" field. It's identical to RollingWindowHoursEQ.
+func RollingWindowHours(v int) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldRollingWindowHours, v))
+}
+
+// RollingWindowLimitUsd applies equality check predicate on the "rolling_window_limit_usd" field. It's identical to RollingWindowLimitUsdEQ.
+func RollingWindowLimitUsd(v float64) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldRollingWindowLimitUsd, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Group {
 	return predicate.Group(sql.FieldEQ(FieldCreatedAt, v))
@@ -1090,6 +1120,256 @@ func ModelRoutingEnabledNEQ(v bool) predicate.Group {
 	return predicate.Group(sql.FieldNEQ(FieldModelRoutingEnabled, v))
 }
 
+// PoolSplitEnabledEQ applies the EQ predicate on the "pool_split_enabled" field.
+func PoolSplitEnabledEQ(v bool) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldPoolSplitEnabled, v))
+}
+
+// PoolSplitEnabledNEQ applies the NEQ predicate on the "pool_split_enabled" field.
+func PoolSplitEnabledNEQ(v bool) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldPoolSplitEnabled, v))
+}
+
+// PoolWeightsIsNil applies the IsNil predicate on the "pool_weights" field.
+func PoolWeightsIsNil() predicate.Group {
+	return predicate.Group(sql.FieldIsNull(FieldPoolWeights))
+}
+
+// PoolWeightsNotNil applies the NotNil predicate on the "pool_weights" field.
+func PoolWeightsNotNil() predicate.Group {
+	return predicate.Group(sql.FieldNotNull(FieldPoolWeights))
+}
+
+// CanaryMaxErrorRateEQ applies the EQ predicate on the "canary_max_error_rate" field.
+func CanaryMaxErrorRateEQ(v float64) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldCanaryMaxErrorRate, v))
+}
+
+// CanaryMaxErrorRateNEQ applies the NEQ predicate on the "canary_max_error_rate" field.
+func CanaryMaxErrorRateNEQ(v float64) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldCanaryMaxErrorRate, v))
+}
+
+// CanaryMaxErrorRateIn applies the In predicate on the "canary_max_error_rate" field.
+func CanaryMaxErrorRateIn(vs ...float64) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldCanaryMaxErrorRate, vs...))
+}
+
+// CanaryMaxErrorRateNotIn applies the NotIn predicate on the "canary_max_error_rate" field.
+func CanaryMaxErrorRateNotIn(vs ...float64) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldCanaryMaxErrorRate, vs...))
+}
+
+// CanaryMaxErrorRateGT applies the GT predicate on the "canary_max_error_rate" field.
+func CanaryMaxErrorRateGT(v float64) predicate.Group {
+	return predicate.Group(sql.FieldGT(FieldCanaryMaxErrorRate, v))
+}
+
+// CanaryMaxErrorRateGTE applies the GTE predicate on the "canary_max_error_rate" field.
+func CanaryMaxErrorRateGTE(v float64) predicate.Group {
+	return predicate.Group(sql.FieldGTE(FieldCanaryMaxErrorRate, v))
+}
+
+// CanaryMaxErrorRateLT applies the LT predicate on the "canary_max_error_rate" field.
+func CanaryMaxErrorRateLT(v float64) predicate.Group {
+	return predicate.Group(sql.FieldLT(FieldCanaryMaxErrorRate, v))
+}
+
+// CanaryMaxErrorRateLTE applies the LTE predicate on the "canary_max_error_rate" field.
+func CanaryMaxErrorRateLTE(v float64) predicate.Group {
+	return predicate.Group(sql.FieldLTE(FieldCanaryMaxErrorRate, v))
+}
+
+// CanaryMaxErrorRateIsNil applies the IsNil predicate on the "canary_max_error_rate" field.
+func CanaryMaxErrorRateIsNil() predicate.Group {
+	return predicate.Group(sql.FieldIsNull(FieldCanaryMaxErrorRate))
+}
+
+// CanaryMaxErrorRateNotNil applies the NotNil predicate on the "canary_max_error_rate" field.
+func CanaryMaxErrorRateNotNil() predicate.Group {
+	return predicate.Group(sql.FieldNotNull(FieldCanaryMaxErrorRate))
+}
+
+// CapacityFallbackGroupIdsIsNil applies the IsNil predicate on the "capacity_fallback_group_ids" field.
+func CapacityFallbackGroupIdsIsNil() predicate.Group {
+	return predicate.Group(sql.FieldIsNull(FieldCapacityFallbackGroupIds))
+}
+
+// CapacityFallbackGroupIdsNotNil applies the NotNil predicate on the "capacity_fallback_group_ids" field.
+func CapacityFallbackGroupIdsNotNil() predicate.Group {
+	return predicate.Group(sql.FieldNotNull(FieldCapacityFallbackGroupIds))
+}
+
+// FallbackModelMappingIsNil applies the IsNil predicate on the "fallback_model_mapping" field.
+func FallbackModelMappingIsNil() predicate.Group {
+	return predicate.Group(sql.FieldIsNull(FieldFallbackModelMapping))
+}
+
+// FallbackModelMappingNotNil applies the NotNil predicate on the "fallback_model_mapping" field.
+func FallbackModelMappingNotNil() predicate.Group {
+	return predicate.Group(sql.FieldNotNull(FieldFallbackModelMapping))
+}
+
+// VolumeTiersIsNil applies the IsNil predicate on the "volume_tiers" field.
+func VolumeTiersIsNil() predicate.Group {
+	return predicate.Group(sql.FieldIsNull(FieldVolumeTiers))
+}
+
+// VolumeTiersNotNil applies the NotNil predicate on the "volume_tiers" field.
+func VolumeTiersNotNil() predicate.Group {
+	return predicate.Group(sql.FieldNotNull(FieldVolumeTiers))
+}
+
+// OverageEnabledEQ applies the EQ predicate on the "overage_enabled" field.
+func OverageEnabledEQ(v bool) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldOverageEnabled, v))
+}
+
+// OverageEnabledNEQ applies the NEQ predicate on the "overage_enabled" field.
+func OverageEnabledNEQ(v bool) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldOverageEnabled, v))
+}
+
+// OverageRateMultiplierEQ applies the EQ predicate on the "overage_rate_multiplier" field.
+func OverageRateMultiplierEQ(v float64) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldOverageRateMultiplier, v))
+}
+
+// OverageRateMultiplierNEQ applies the NEQ predicate on the "overage_rate_multiplier" field.
+func OverageRateMultiplierNEQ(v float64) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldOverageRateMultiplier, v))
+}
+
+// OverageRateMultiplierIn applies the In predicate on the "overage_rate_multiplier" field.
+func OverageRateMultiplierIn(vs ...float64) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldOverageRateMultiplier, vs...))
+}
+
+// OverageRateMultiplierNotIn applies the NotIn predicate on the "overage_rate_multiplier" field.
+func OverageRateMultiplierNotIn(vs ...float64) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldOverageRateMultiplier, vs...))
+}
+
+// OverageRateMultiplierGT applies the GT predicate on the "overage_rate_multiplier" field.
+func OverageRateMultiplierGT(v float64) predicate.Group {
+	return predicate.Group(sql.FieldGT(FieldOverageRateMultiplier, v))
+}
+
+// OverageRateMultiplierGTE applies the GTE predicate on the "overage_rate_multiplier" field.
+func OverageRateMultiplierGTE(v float64) predicate.Group {
+	return predicate.Group(sql.FieldGTE(FieldOverageRateMultiplier, v))
+}
+
+// OverageRateMultiplierLT applies the LT predicate on the "overage_rate_multiplier" field.
+func OverageRateMultiplierLT(v float64) predicate.Group {
+	return predicate.Group(sql.FieldLT(FieldOverageRateMultiplier, v))
+}
+
+// OverageRateMultiplierLTE applies the LTE predicate on the "overage_rate_multiplier" field.
+func OverageRateMultiplierLTE(v float64) predicate.Group {
+	return predicate.Group(sql.FieldLTE(FieldOverageRateMultiplier, v))
+}
+
+// RollingWindowHoursEQ applies the EQ predicate on the "rolling_window_hours" field.
+func RollingWindowHoursEQ(v int) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldRollingWindowHours, v))
+}
+
+// RollingWindowHoursNEQ applies the NEQ predicate on the "rolling_window_hours" field.
+func RollingWindowHoursNEQ(v int) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldRollingWindowHours, v))
+}
+
+// RollingWindowHoursIn applies the In predicate on the "rolling_window_hours" field.
+func RollingWindowHoursIn(vs ...int) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldRollingWindowHours, vs...))
+}
+
+// RollingWindowHoursNotIn applies the NotIn predicate on the "rolling_window_hours" field.
+func RollingWindowHoursNotIn(vs ...int) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldRollingWindowHours, vs...))
+}
+
+// RollingWindowHoursGT applies the GT predicate on the "rolling_window_hours" field.
+func RollingWindowHoursGT(v int) predicate.Group {
+	return predicate.Group(sql.FieldGT(FieldRollingWindowHours, v))
+}
+
+// RollingWindowHoursGTE applies the GTE predicate on the "rolling_window_hours" field.
+func RollingWindowHoursGTE(v int) predicate.Group {
+	return predicate.Group(sql.FieldGTE(FieldRollingWindowHours, v))
+}
+
+// RollingWindowHoursLT applies the LT predicate on the "rolling_window_hours" field.
+func RollingWindowHoursLT(v int) predicate.Group {
+	return predicate.Group(sql.FieldLT(FieldRollingWindowHours, v))
+}
+
+// RollingWindowHoursLTE applies the LTE predicate on the "rolling_window_hours" field.
+func RollingWindowHoursLTE(v int) predicate.Group {
+	return predicate.Group(sql.FieldLTE(FieldRollingWindowHours, v))
+}
+
+// RollingWindowLimitUsdEQ applies the EQ predicate on the "rolling_window_limit_usd" field.
+func RollingWindowLimitUsdEQ(v float64) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldRollingWindowLimitUsd, v))
+}
+
+// RollingWindowLimitUsdNEQ applies the NEQ predicate on the "rolling_window_limit_usd" field.
+func RollingWindowLimitUsdNEQ(v float64) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldRollingWindowLimitUsd, v))
+}
+
+// RollingWindowLimitUsdIn applies the In predicate on the "rolling_window_limit_usd" field.
+func RollingWindowLimitUsdIn(vs ...float64) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldRollingWindowLimitUsd, vs...))
+}
+
+// RollingWindowLimitUsdNotIn applies the NotIn predicate on the "rolling_window_limit_usd" field.
+func RollingWindowLimitUsdNotIn(vs ...float64) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldRollingWindowLimitUsd, vs...))
+}
+
+// RollingWindowLimitUsdGT applies the GT predicate on the "rolling_window_limit_usd" field.
+func RollingWindowLimitUsdGT(v float64) predicate.Group {
+	return predicate.Group(sql.FieldGT(FieldRollingWindowLimitUsd, v))
+}
+
+// RollingWindowLimitUsdGTE applies the GTE predicate on the "rolling_window_limit_usd" field.
+func RollingWindowLimitUsdGTE(v float64) predicate.Group {
+	return predicate.Group(sql.FieldGTE(FieldRollingWindowLimitUsd, v))
+}
+
+// RollingWindowLimitUsdLT applies the LT predicate on the "rolling_window_limit_usd" field.
+func RollingWindowLimitUsdLT(v float64) predicate.Group {
+	return predicate.Group(sql.FieldLT(FieldRollingWindowLimitUsd, v))
+}
+
+// RollingWindowLimitUsdLTE applies the LTE predicate on the "rolling_window_limit_usd" field.
+func RollingWindowLimitUsdLTE(v float64) predicate.Group {
+	return predicate.Group(sql.FieldLTE(FieldRollingWindowLimitUsd, v))
+}
+
+// RollingWindowLimitUsdIsNil applies the IsNil predicate on the "rolling_window_limit_usd" field.
+func RollingWindowLimitUsdIsNil() predicate.Group {
+	return predicate.Group(sql.FieldIsNull(FieldRollingWindowLimitUsd))
+}
+
+// RollingWindowLimitUsdNotNil applies the NotNil predicate on the "rolling_window_limit_usd" field.
+func RollingWindowLimitUsdNotNil() predicate.Group {
+	return predicate.Group(sql.FieldNotNull(FieldRollingWindowLimitUsd))
+}
+
+// UsageQuotasIsNil applies the IsNil predicate on the "usage_quotas" field.
+func UsageQuotasIsNil() predicate.Group {
+	return predicate.Group(sql.FieldIsNull(FieldUsageQuotas))
+}
+
+// UsageQuotasNotNil applies the NotNil predicate on the "usage_quotas" field.
+func UsageQuotasNotNil() predicate.Group {
+	return predicate.Group(sql.FieldNotNull(FieldUsageQuotas))
+}
+
 // HasAPIKeys applies the HasEdge predicate on the "api_keys" edge.
 func HasAPIKeys() predicate.Group {
 	return predicate.Group(func(s *sql.Selector) {
@@ -18,6 +18,8 @@ import (
 	"github.com/Wei-Shaw/sub2api/ent/usagelog"
 	"github.com/Wei-Shaw/sub2api/ent/user"
 	"github.com/Wei-Shaw/sub2api/ent/usersubscription"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/usagequota"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/volumetier"
 )
 
 // GroupCreate is the builder for creating a Group entity.
@@ -306,6 +308,120 @@ func (_c *GroupCreate) SetNillableModelRoutingEnabled(v *bool) *GroupCreate {
 	return _c
 }
 
+// SetPoolSplitEnabled sets the "pool_split_enabled" field.
+func (_c *GroupCreate) SetPoolSplitEnabled(v bool) *GroupCreate {
+	_c.mutation.SetPoolSplitEnabled(v)
+	return _c
+}
+
+// SetNillablePoolSplitEnabled sets the "pool_split_enabled" field if the given value is not nil.
+func (_c *GroupCreate) SetNillablePoolSplitEnabled(v *bool) *GroupCreate {
+	if v != nil {
+		_c.SetPoolSplitEnabled(*v)
+	}
+	return _c
+}
+
+// SetPoolWeights sets the "pool_weights" field.
+func (_c *GroupCreate) SetPoolWeights(v map[string]int) *GroupCreate {
+	_c.mutation.SetPoolWeights(v)
+	return _c
+}
+
+// SetCanaryMaxErrorRate sets the "canary_max_error_rate" field.
+func (_c *GroupCreate) SetCanaryMaxErrorRate(v float64) *GroupCreate {
+	_c.mutation.SetCanaryMaxErrorRate(v)
+	return _c
+}
+
+// SetNillableCanaryMaxErrorRate sets the "canary_max_error_rate" field if the given value is not nil.
+func (_c *GroupCreate) SetNillableCanaryMaxErrorRate(v *float64) *GroupCreate {
+	if v != nil {
+		_c.SetCanaryMaxErrorRate(*v)
+	}
+	return _c
+}
+
+// SetCapacityFallbackGroupIds sets the "capacity_fallback_group_ids" field.
+func (_c *GroupCreate) SetCapacityFallbackGroupIds(v []int64) *GroupCreate {
+	_c.mutation.SetCapacityFallbackGroupIds(v)
+	return _c
+}
+
+// SetFallbackModelMapping sets the "fallback_model_mapping" field.
+func (_c *GroupCreate) SetFallbackModelMapping(v map[string]string) *GroupCreate {
+	_c.mutation.SetFallbackModelMapping(v)
+	return _c
+}
+
+// SetVolumeTiers sets the "volume_tiers" field.
+func (_c *GroupCreate) SetVolumeTiers(v []volumetier.Tier) *GroupCreate {
+	_c.mutation.SetVolumeTiers(v)
+	return _c
+}
+
+// SetOverageEnabled sets the "overage_enabled" field.
+func (_c *GroupCreate) SetOverageEnabled(v bool) *GroupCreate {
+	_c.mutation.SetOverageEnabled(v)
+	return _c
+}
+
+// SetNillableOverageEnabled sets the "overage_enabled" field if the given value is not nil.
+func (_c *GroupCreate) SetNillableOverageEnabled(v *bool) *GroupCreate {
+	if v != nil {
+		_c.SetOverageEnabled(*v)
+	}
+	return _c
+}
+
+// SetOverageRateMultiplier sets the "overage_rate_multiplier" field.
+func (_c *GroupCreate) SetOverageRateMultiplier(v float64) *GroupCreate {
+	_c.mutation.SetOverageRateMultiplier(v)
+	return _c
+}
+
+// SetNillableOverageRateMultiplier sets the "overage_rate_multiplier" field if the given value is not nil.
+func (_c *GroupCreate) SetNillableOverageRateMultiplier(v *float64) *GroupCreate {
+	if v != nil {
+		_c.SetOverageRateMultiplier(*v)
+	}
+	return _c
+}
+
+// SetRollingWindowHours sets the "rolling_window_hours" field.
+func (_c *GroupCreate) SetRollingWindowHours(v int) *GroupCreate {
+	_c.mutation.SetRollingWindowHours(v)
+	return _c
+}
+
+// SetNillableRollingWindowHours sets the "rolling_window_hours" field if the given value is not nil.
+func (_c *GroupCreate) SetNillableRollingWindowHours(v *int) *GroupCreate {
+	if v != nil {
+		_c.SetRollingWindowHours(*v)
+	}
+	return _c
+}
+
+// SetRollingWindowLimitUsd sets the "rolling_window_limit_usd" field.
+func (_c *GroupCreate) SetRollingWindowLimitUsd(v float64) *GroupCreate {
+	_c.mutation.SetRollingWindowLimitUsd(v)
+	return _c
+}
+
+// SetNillableRollingWindowLimitUsd sets the "rolling_window_limit_usd" field if the given value is not nil.
+func (_c *GroupCreate) SetNillableRollingWindowLimitUsd(v *float64) *GroupCreate {
+	if v != nil {
+		_c.SetRollingWindowLimitUsd(*v)
+	}
+	return _c
+}
+
+// SetUsageQuotas sets the "usage_quotas" field.
+func (_c *GroupCreate) SetUsageQuotas(v []usagequota.Quota) *GroupCreate {
+	_c.mutation.SetUsageQuotas(v)
+	return _c
+}
+
 // AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
 func (_c *GroupCreate) AddAPIKeyIDs(ids ...int64) *GroupCreate {
 	_c.mutation.AddAPIKeyIDs(ids...)
@@ -479,6 +595,22 @@ func (_c *GroupCreate) defaults() error {
 		v := group.DefaultModelRoutingEnabled
 		_c.mutation.SetModelRoutingEnabled(v)
 	}
+	if _, ok := _c.mutation.PoolSplitEnabled(); !ok {
+		v := group.DefaultPoolSplitEnabled
+		_c.mutation.SetPoolSplitEnabled(v)
+	}
+	if _, ok := _c.mutation.OverageEnabled(); !ok {
+		v := group.DefaultOverageEnabled
+		_c.mutation.SetOverageEnabled(v)
+	}
+	if _, ok := _c.mutation.OverageRateMultiplier(); !ok {
+		v := group.DefaultOverageRateMultiplier
+		_c.mutation.SetOverageRateMultiplier(v)
+	}
+	if _, ok := _c.mutation.RollingWindowHours(); !ok {
+		v := group.DefaultRollingWindowHours
+		_c.mutation.SetRollingWindowHours(v)
+	}
 	return nil
 }
 
@@ -537,6 +669,18 @@ func (_c *GroupCreate) check() error {
 	if _, ok := _c.mutation.ModelRoutingEnabled(); !ok {
 		return &ValidationError{Name: "model_routing_enabled", err: errors.New(`ent: missing required field "Group.model_routing_enabled"`)}
 	}
+	if _, ok := _c.mutation.PoolSplitEnabled(); !ok {
+		return &ValidationError{Name: "pool_split_enabled", err: errors.New(`ent: missing required field "Group.pool_split_enabled"`)}
+	}
+	if _, ok := _c.mutation.OverageEnabled(); !ok {
+		return &ValidationError{Name: "overage_enabled", err: errors.New(`ent: missing required field "Group.overage_enabled"`)}
+	}
+	if _, ok := _c.mutation.OverageRateMultiplier(); !ok {
+		return &ValidationError{Name: "overage_rate_multiplier", err: errors.New(`ent: missing required field "Group.overage_rate_multiplier"`)}
+	}
+	if _, ok := _c.mutation.RollingWindowHours(); !ok {
+		return &ValidationError{Name: "rolling_window_hours", err: errors.New(`ent: missing required field "Group.rolling_window_hours"`)}
+	}
 	return nil
 }
 
@@ -648,6 +792,50 @@ func (_c *GroupCreate) createSpec() (*Group, *sqlgraph.CreateSpec) {
 		_spec.SetField(group.FieldModelRoutingEnabled, field.TypeBool, value)
 		_node.ModelRoutingEnabled = value
 	}
+	if value, ok := _c.mutation.PoolSplitEnabled(); ok {
+		_spec.SetField(group.FieldPoolSplitEnabled, field.TypeBool, value)
+		_node.PoolSplitEnabled = value
+	}
+	if value, ok := _c.mutation.PoolWeights(); ok {
+		_spec.SetField(group.FieldPoolWeights, field.TypeJSON, value)
+		_node.PoolWeights = value
+	}
+	if value, ok := _c.mutation.CanaryMaxErrorRate(); ok {
+		_spec.SetField(group.FieldCanaryMaxErrorRate, field.TypeFloat64, value)
+		_node.CanaryMaxErrorRate = &value
+	}
+	if value, ok := _c.mutation.CapacityFallbackGroupIds(); ok {
+		_spec.SetField(group.FieldCapacityFallbackGroupIds, field.TypeJSON, value)
+		_node.CapacityFallbackGroupIds = value
+	}
+	if value, ok := _c.mutation.FallbackModelMapping(); ok {
+		_spec.SetField(group.FieldFallbackModelMapping, field.TypeJSON, value)
+		_node.FallbackModelMapping = value
+	}
+	if value, ok := _c.mutation.VolumeTiers(); ok {
+		_spec.SetField(group.FieldVolumeTiers, field.TypeJSON, value)
+		_node.VolumeTiers = value
+	}
+	if value, ok := _c.mutation.OverageEnabled(); ok {
+		_spec.SetField(group.FieldOverageEnabled, field.TypeBool, value)
+		_node.OverageEnabled = value
+	}
+	if value, ok := _c.mutation.OverageRateMultiplier(); ok {
+		_spec.SetField(group.FieldOverageRateMultiplier, field.TypeFloat64, value)
+		_node.OverageRateMultiplier = value
+	}
+	if value, ok := _c.mutation.RollingWindowHours(); ok {
+		_spec.SetField(group.FieldRollingWindowHours, field.TypeInt, value)
+		_node.RollingWindowHours = value
+	}
+	if value, ok := _c.mutation.RollingWindowLimitUsd(); ok {
+		_spec.SetField(group.FieldRollingWindowLimitUsd, field.TypeFloat64, value)
+		_node.RollingWindowLimitUsd = &value
+	}
+	if value, ok := _c.mutation.UsageQuotas(); ok {
+		_spec.SetField(group.FieldUsageQuotas, field.TypeJSON, value)
+		_node.UsageQuotas = value
+	}
 	if nodes := _c.mutation.APIKeysIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1158,6 +1346,204 @@ func (u *GroupUpsert) UpdateModelRoutingEnabled() *GroupUpsert {
 	return u
 }
 
+// SetPoolSplitEnabled sets the "pool_split_enabled" field.
+func (u *GroupUpsert) SetPoolSplitEnabled(v bool) *GroupUpsert {
+	u.Set(group.FieldPoolSplitEnabled, v)
+	return u
+}
+
+// UpdatePoolSplitEnabled sets the "pool_split_enabled" field to the value that was provided on create.
+func (u *GroupUpsert) UpdatePoolSplitEnabled() *GroupUpsert {
+	u.SetExcluded(group.FieldPoolSplitEnabled)
+	return u
+}
+
+// SetPoolWeights sets the "pool_weights" field.
+func (u *GroupUpsert) SetPoolWeights(v map[string]int) *GroupUpsert {
+	u.Set(group.FieldPoolWeights, v)
+	return u
+}
+
+// UpdatePoolWeights sets the "pool_weights" field to the value that was provided on create.
+func (u *GroupUpsert) UpdatePoolWeights() *GroupUpsert {
+	u.SetExcluded(group.FieldPoolWeights)
+	return u
+}
+
+// ClearPoolWeights clears the value of the "pool_weights" field.
+func (u *GroupUpsert) ClearPoolWeights() *GroupUpsert {
+	u.SetNull(group.FieldPoolWeights)
+	return u
+}
+
+// SetCanaryMaxErrorRate sets the "canary_max_error_rate" field.
+func (u *GroupUpsert) SetCanaryMaxErrorRate(v float64) *GroupUpsert {
+	u.Set(group.FieldCanaryMaxErrorRate, v)
+	return u
+}
+
+// UpdateCanaryMaxErrorRate sets the "canary_max_error_rate" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateCanaryMaxErrorRate() *GroupUpsert {
+	u.SetExcluded(group.FieldCanaryMaxErrorRate)
+	return u
+}
+
+// AddCanaryMaxErrorRate adds v to the "canary_max_error_rate" field.
+func (u *GroupUpsert) AddCanaryMaxErrorRate(v float64) *GroupUpsert {
+	u.Add(group.FieldCanaryMaxErrorRate, v)
+	return u
+}
+
+// ClearCanaryMaxErrorRate clears the value of the "canary_max_error_rate" field.
+func (u *GroupUpsert) ClearCanaryMaxErrorRate() *GroupUpsert {
+	u.SetNull(group.FieldCanaryMaxErrorRate)
+	return u
+}
+
+// SetCapacityFallbackGroupIds sets the "capacity_fallback_group_ids" field.
+func (u *GroupUpsert) SetCapacityFallbackGroupIds(v []int64) *GroupUpsert {
+	u.Set(group.FieldCapacityFallbackGroupIds, v)
+	return u
+}
+
+// UpdateCapacityFallbackGroupIds sets the "capacity_fallback_group_ids" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateCapacityFallbackGroupIds() *GroupUpsert {
+	u.SetExcluded(group.FieldCapacityFallbackGroupIds)
+	return u
+}
+
+// ClearCapacityFallbackGroupIds clears the value of the "capacity_fallback_group_ids" field.
+func (u *GroupUpsert) ClearCapacityFallbackGroupIds() *GroupUpsert {
+	u.SetNull(group.FieldCapacityFallbackGroupIds)
+	return u
+}
+
+// SetFallbackModelMapping sets the "fallback_model_mapping" field.
+func (u *GroupUpsert) SetFallbackModelMapping(v map[string]string) *GroupUpsert {
+	u.Set(group.FieldFallbackModelMapping, v)
+	return u
+}
+
+// UpdateFallbackModelMapping sets the "fallback_model_mapping" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateFallbackModelMapping() *GroupUpsert {
+	u.SetExcluded(group.FieldFallbackModelMapping)
+	return u
+}
+
+// ClearFallbackModelMapping clears the value of the "fallback_model_mapping" field.
+func (u *GroupUpsert) ClearFallbackModelMapping() *GroupUpsert {
+	u.SetNull(group.FieldFallbackModelMapping)
+	return u
+}
+
+// SetVolumeTiers sets the "volume_tiers" field.
+func (u *GroupUpsert) SetVolumeTiers(v []volumetier.Tier) *GroupUpsert {
+	u.Set(group.FieldVolumeTiers, v)
+	return u
+}
+
+// UpdateVolumeTiers sets the "volume_tiers" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateVolumeTiers() *GroupUpsert {
+	u.SetExcluded(group.FieldVolumeTiers)
+	return u
+}
+
+// ClearVolumeTiers clears the value of the "volume_tiers" field.
+func (u *GroupUpsert) ClearVolumeTiers() *GroupUpsert {
+	u.SetNull(group.FieldVolumeTiers)
+	return u
+}
+
+// SetOverageEnabled sets the "overage_enabled" field.
+func (u *GroupUpsert) SetOverageEnabled(v bool) *GroupUpsert {
+	u.Set(group.FieldOverageEnabled, v)
+	return u
+}
+
+// UpdateOverageEnabled sets the "overage_enabled" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateOverageEnabled() *GroupUpsert {
+	u.SetExcluded(group.FieldOverageEnabled)
+	return u
+}
+
+// SetOverageRateMultiplier sets the "overage_rate_multiplier" field.
+func (u *GroupUpsert) SetOverageRateMultiplier(v float64) *GroupUpsert {
+	u.Set(group.FieldOverageRateMultiplier, v)
+	return u
+}
+
+// UpdateOverageRateMultiplier sets the "overage_rate_multiplier" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateOverageRateMultiplier() *GroupUpsert {
+	u.SetExcluded(group.FieldOverageRateMultiplier)
+	return u
+}
+
+// AddOverageRateMultiplier adds v to the "overage_rate_multiplier" field.
+func (u *GroupUpsert) AddOverageRateMultiplier(v float64) *GroupUpsert {
+	u.Add(group.FieldOverageRateMultiplier, v)
+	return u
+}
+
+// SetRollingWindowHours sets the "rolling_window_hours" field.
+func (u *GroupUpsert) SetRollingWindowHours(v int) *GroupUpsert {
+	u.Set(group.FieldRollingWindowHours, v)
+	return u
+}
+
+// UpdateRollingWindowHours sets the "rolling_window_hours" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateRollingWindowHours() *GroupUpsert {
+	u.SetExcluded(group.FieldRollingWindowHours)
+	return u
+}
+
+// AddRollingWindowHours adds v to the "rolling_window_hours" field.
+func (u *GroupUpsert) AddRollingWindowHours(v int) *GroupUpsert {
+	u.Add(group.FieldRollingWindowHours, v)
+	return u
+}
+
+// SetRollingWindowLimitUsd sets the "rolling_window_limit_usd" field.
+func (u *GroupUpsert) SetRollingWindowLimitUsd(v float64) *GroupUpsert {
+	u.Set(group.FieldRollingWindowLimitUsd, v)
+	return u
+}
+
+// UpdateRollingWindowLimitUsd sets the "rolling_window_limit_usd" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateRollingWindowLimitUsd() *GroupUpsert {
+	u.SetExcluded(group.FieldRollingWindowLimitUsd)
+	return u
+}
+
+// AddRollingWindowLimitUsd adds v to the "rolling_window_limit_usd" field.
+func (u *GroupUpsert) AddRollingWindowLimitUsd(v float64) *GroupUpsert {
+	u.Add(group.FieldRollingWindowLimitUsd, v)
+	return u
+}
+
+// ClearRollingWindowLimitUsd clears the value of the "rolling_window_limit_usd" field.
+func (u *GroupUpsert) ClearRollingWindowLimitUsd() *GroupUpsert {
+	u.SetNull(group.FieldRollingWindowLimitUsd)
+	return u
+}
+
+// SetUsageQuotas sets the "usage_quotas" field.
+func (u *GroupUpsert) SetUsageQuotas(v []usagequota.Quota) *GroupUpsert {
+	u.Set(group.FieldUsageQuotas, v)
+	return u
+}
+
+// UpdateUsageQuotas sets the "usage_quotas" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateUsageQuotas() *GroupUpsert {
+	u.SetExcluded(group.FieldUsageQuotas)
+	return u
+}
+
+// ClearUsageQuotas clears the value of the "usage_quotas" field.
+func (u *GroupUpsert) ClearUsageQuotas() *GroupUpsert {
+	u.SetNull(group.FieldUsageQuotas)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -1616,6 +2002,237 @@ func (u *GroupUpsertOne) UpdateModelRoutingEnabled() *GroupUpsertOne {
 	})
 }
 
+// SetPoolSplitEnabled sets the "pool_split_enabled" field.
+func (u *GroupUpsertOne) SetPoolSplitEnabled(v bool) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetPoolSplitEnabled(v)
+	})
+}
+
+// UpdatePoolSplitEnabled sets the "pool_split_enabled" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdatePoolSplitEnabled() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdatePoolSplitEnabled()
+	})
+}
+
+// SetPoolWeights sets the "pool_weights" field.
+func (u *GroupUpsertOne) SetPoolWeights(v map[string]int) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetPoolWeights(v)
+	})
+}
+
+// UpdatePoolWeights sets the "pool_weights" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdatePoolWeights() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdatePoolWeights()
+	})
+}
+
+// ClearPoolWeights clears the value of the "pool_weights" field.
+func (u *GroupUpsertOne) ClearPoolWeights() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.ClearPoolWeights()
+	})
+}
+
+// SetCanaryMaxErrorRate sets the "canary_max_error_rate" field.
+func (u *GroupUpsertOne) SetCanaryMaxErrorRate(v float64) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetCanaryMaxErrorRate(v)
+	})
+}
+
+// AddCanaryMaxErrorRate adds v to the "canary_max_error_rate" field.
+func (u *GroupUpsertOne) AddCanaryMaxErrorRate(v float64) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.AddCanaryMaxErrorRate(v)
+	})
+}
+
+// UpdateCanaryMaxErrorRate sets the "canary_max_error_rate" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateCanaryMaxErrorRate() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateCanaryMaxErrorRate()
+	})
+}
+
+// ClearCanaryMaxErrorRate clears the value of the "canary_max_error_rate" field.
+func (u *GroupUpsertOne) ClearCanaryMaxErrorRate() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.ClearCanaryMaxErrorRate()
+	})
+}
+
+// SetCapacityFallbackGroupIds sets the "capacity_fallback_group_ids" field.
+func (u *GroupUpsertOne) SetCapacityFallbackGroupIds(v []int64) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetCapacityFallbackGroupIds(v)
+	})
+}
+
+// UpdateCapacityFallbackGroupIds sets the "capacity_fallback_group_ids" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateCapacityFallbackGroupIds() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateCapacityFallbackGroupIds()
+	})
+}
+
+// ClearCapacityFallbackGroupIds clears the value of the "capacity_fallback_group_ids" field.
+func (u *GroupUpsertOne) ClearCapacityFallbackGroupIds() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.ClearCapacityFallbackGroupIds()
+	})
+}
+
+// SetFallbackModelMapping sets the "fallback_model_mapping" field.
+func (u *GroupUpsertOne) SetFallbackModelMapping(v map[string]string) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetFallbackModelMapping(v)
+	})
+}
+
+// UpdateFallbackModelMapping sets the "fallback_model_mapping" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateFallbackModelMapping() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateFallbackModelMapping()
+	})
+}
+
+// ClearFallbackModelMapping clears the value of the "fallback_model_mapping" field.
+func (u *GroupUpsertOne) ClearFallbackModelMapping() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.ClearFallbackModelMapping()
+	})
+}
+
+// SetVolumeTiers sets the "volume_tiers" field.
+func (u *GroupUpsertOne) SetVolumeTiers(v []volumetier.Tier) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetVolumeTiers(v)
+	})
+}
+
+// UpdateVolumeTiers sets the "volume_tiers" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateVolumeTiers() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateVolumeTiers()
+	})
+}
+
+// ClearVolumeTiers clears the value of the "volume_tiers" field.
+func (u *GroupUpsertOne) ClearVolumeTiers() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.ClearVolumeTiers()
+	})
+}
+
+// SetOverageEnabled sets the "overage_enabled" field.
+func (u *GroupUpsertOne) SetOverageEnabled(v bool) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetOverageEnabled(v)
+	})
+}
+
+// UpdateOverageEnabled sets the "overage_enabled" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateOverageEnabled() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateOverageEnabled()
+	})
+}
+
+// SetOverageRateMultiplier sets the "overage_rate_multiplier" field.
+func (u *GroupUpsertOne) SetOverageRateMultiplier(v float64) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetOverageRateMultiplier(v)
+	})
+}
+
+// AddOverageRateMultiplier adds v to the "overage_rate_multiplier" field.
+func (u *GroupUpsertOne) AddOverageRateMultiplier(v float64) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.AddOverageRateMultiplier(v)
+	})
+}
+
+// UpdateOverageRateMultiplier sets the "overage_rate_multiplier" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateOverageRateMultiplier() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateOverageRateMultiplier()
+	})
+}
+
+// SetRollingWindowHours sets the "rolling_window_hours" field.
+func (u *GroupUpsertOne) SetRollingWindowHours(v int) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetRollingWindowHours(v)
+	})
+}
+
+// AddRollingWindowHours adds v to the "rolling_window_hours" field.
+func (u *GroupUpsertOne) AddRollingWindowHours(v int) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.AddRollingWindowHours(v)
+	})
+}
+
+// UpdateRollingWindowHours sets the "rolling_window_hours" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateRollingWindowHours() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateRollingWindowHours()
+	})
+}
+
+// SetRollingWindowLimitUsd sets the "rolling_window_limit_usd" field.
+func (u *GroupUpsertOne) SetRollingWindowLimitUsd(v float64) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetRollingWindowLimitUsd(v)
+	})
+}
+
+// AddRollingWindowLimitUsd adds v to the "rolling_window_limit_usd" field.
+func (u *GroupUpsertOne) AddRollingWindowLimitUsd(v float64) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.AddRollingWindowLimitUsd(v)
+	})
+}
+
+// UpdateRollingWindowLimitUsd sets the "rolling_window_limit_usd" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateRollingWindowLimitUsd() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateRollingWindowLimitUsd()
+	})
+}
+
+// ClearRollingWindowLimitUsd clears the value of the "rolling_window_limit_usd" field.
+func (u *GroupUpsertOne) ClearRollingWindowLimitUsd() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.ClearRollingWindowLimitUsd()
+	})
+}
+
+// SetUsageQuotas sets the "usage_quotas" field.
+func (u *GroupUpsertOne) SetUsageQuotas(v []usagequota.Quota) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetUsageQuotas(v)
+	})
+}
+
+// UpdateUsageQuotas sets the "usage_quotas" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateUsageQuotas() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateUsageQuotas()
+	})
+}
+
+// ClearUsageQuotas clears the value of the "usage_quotas" field.
+func (u *GroupUpsertOne) ClearUsageQuotas() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.ClearUsageQuotas()
+	})
+}
+
 // Exec executes the query.
 func (u *GroupUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -2240,6 +2857,237 @@ func (u *GroupUpsertBulk) UpdateModelRoutingEnabled() *GroupUpsertBulk {
 	})
 }
 
+// SetPoolSplitEnabled sets the "pool_split_enabled" field.
+func (u *GroupUpsertBulk) SetPoolSplitEnabled(v bool) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetPoolSplitEnabled(v)
+	})
+}
+
+// UpdatePoolSplitEnabled sets the "pool_split_enabled" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdatePoolSplitEnabled() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdatePoolSplitEnabled()
+	})
+}
+
+// SetPoolWeights sets the "pool_weights" field.
+func (u *GroupUpsertBulk) SetPoolWeights(v map[string]int) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetPoolWeights(v)
+	})
+}
+
+// UpdatePoolWeights sets the "pool_weights" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdatePoolWeights() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdatePoolWeights()
+	})
+}
+
+// ClearPoolWeights clears the value of the "pool_weights" field.
+func (u *GroupUpsertBulk) ClearPoolWeights() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.ClearPoolWeights()
+	})
+}
+
+// SetCanaryMaxErrorRate sets the "canary_max_error_rate" field.
+func (u *GroupUpsertBulk) SetCanaryMaxErrorRate(v float64) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetCanaryMaxErrorRate(v)
+	})
+}
+
+// AddCanaryMaxErrorRate adds v to the "canary_max_error_rate" field.
+func (u *GroupUpsertBulk) AddCanaryMaxErrorRate(v float64) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.AddCanaryMaxErrorRate(v)
+	})
+}
+
+// UpdateCanaryMaxErrorRate sets the "canary_max_error_rate" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateCanaryMaxErrorRate() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateCanaryMaxErrorRate()
+	})
+}
+
+// ClearCanaryMaxErrorRate clears the value of the "canary_max_error_rate" field.
+func (u *GroupUpsertBulk) ClearCanaryMaxErrorRate() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.ClearCanaryMaxErrorRate()
+	})
+}
+
+// SetCapacityFallbackGroupIds sets the "capacity_fallback_group_ids" field.
+func (u *GroupUpsertBulk) SetCapacityFallbackGroupIds(v []int64) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetCapacityFallbackGroupIds(v)
+	})
+}
+
+// UpdateCapacityFallbackGroupIds sets the "capacity_fallback_group_ids" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateCapacityFallbackGroupIds() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateCapacityFallbackGroupIds()
+	})
+}
+
+// ClearCapacityFallbackGroupIds clears the value of the "capacity_fallback_group_ids" field.
+func (u *GroupUpsertBulk) ClearCapacityFallbackGroupIds() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.ClearCapacityFallbackGroupIds()
+	})
+}
+
+// SetFallbackModelMapping sets the "fallback_model_mapping" field.
+func (u *GroupUpsertBulk) SetFallbackModelMapping(v map[string]string) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetFallbackModelMapping(v)
+	})
+}
+
+// UpdateFallbackModelMapping sets the "fallback_model_mapping" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateFallbackModelMapping() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateFallbackModelMapping()
+	})
+}
+
+// ClearFallbackModelMapping clears the value of the "fallback_model_mapping" field.
+func (u *GroupUpsertBulk) ClearFallbackModelMapping() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.ClearFallbackModelMapping()
+	})
+}
+
+// SetVolumeTiers sets the "volume_tiers" field.
+func (u *GroupUpsertBulk) SetVolumeTiers(v []volumetier.Tier) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetVolumeTiers(v)
+	})
+}
+
+// UpdateVolumeTiers sets the "volume_tiers" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateVolumeTiers() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateVolumeTiers()
+	})
+}
+
+// ClearVolumeTiers clears the value of the "volume_tiers" field.
+func (u *GroupUpsertBulk) ClearVolumeTiers() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.ClearVolumeTiers()
+	})
+}
+
+// SetOverageEnabled sets the "overage_enabled" field.
+func (u *GroupUpsertBulk) SetOverageEnabled(v bool) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetOverageEnabled(v)
+	})
+}
+
+// UpdateOverageEnabled sets the "overage_enabled" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateOverageEnabled() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateOverageEnabled()
+	})
+}
+
+// SetOverageRateMultiplier sets the "overage_rate_multiplier" field.
+func (u *GroupUpsertBulk) SetOverageRateMultiplier(v float64) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetOverageRateMultiplier(v)
+	})
+}
+
+// AddOverageRateMultiplier adds v to the "overage_rate_multiplier" field.
+func (u *GroupUpsertBulk) AddOverageRateMultiplier(v float64) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.AddOverageRateMultiplier(v)
+	})
+}
+
+// UpdateOverageRateMultiplier sets the "overage_rate_multiplier" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateOverageRateMultiplier() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateOverageRateMultiplier()
+	})
+}
+
+// SetRollingWindowHours sets the "rolling_window_hours" field.
+func (u *GroupUpsertBulk) SetRollingWindowHours(v int) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetRollingWindowHours(v)
+	})
+}
+
+// AddRollingWindowHours adds v to the "rolling_window_hours" field.
+func (u *GroupUpsertBulk) AddRollingWindowHours(v int) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.AddRollingWindowHours(v)
+	})
+}
+
+// UpdateRollingWindowHours sets the "rolling_window_hours" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateRollingWindowHours() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateRollingWindowHours()
+	})
+}
+
+// SetRollingWindowLimitUsd sets the "rolling_window_limit_usd" field.
+func (u *GroupUpsertBulk) SetRollingWindowLimitUsd(v float64) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetRollingWindowLimitUsd(v)
+	})
+}
+
+// AddRollingWindowLimitUsd adds v to the "rolling_window_limit_usd" field.
+func (u *GroupUpsertBulk) AddRollingWindowLimitUsd(v float64) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.AddRollingWindowLimitUsd(v)
+	})
+}
+
+// UpdateRollingWindowLimitUsd sets the "rolling_window_limit_usd" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateRollingWindowLimitUsd() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateRollingWindowLimitUsd()
+	})
+}
+
+// ClearRollingWindowLimitUsd clears the value of the "rolling_window_limit_usd" field.
+func (u *GroupUpsertBulk) ClearRollingWindowLimitUsd() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.ClearRollingWindowLimitUsd()
+	})
+}
+
+// SetUsageQuotas sets the "usage_quotas" field.
+func (u *GroupUpsertBulk) SetUsageQuotas(v []usagequota.Quota) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetUsageQuotas(v)
+	})
+}
+
+// UpdateUsageQuotas sets the "usage_quotas" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateUsageQuotas() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateUsageQuotas()
+	})
+}
+
+// ClearUsageQuotas clears the value of the "usage_quotas" field.
+func (u *GroupUpsertBulk) ClearUsageQuotas() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.ClearUsageQuotas()
+	})
+}
+
 // Exec executes the query.
 func (u *GroupUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
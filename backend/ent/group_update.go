@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/Wei-Shaw/sub2api/ent/account"
 	"github.com/Wei-Shaw/sub2api/ent/apikey"
@@ -19,6 +20,8 @@ import (
 	"github.com/Wei-Shaw/sub2api/ent/usagelog"
 	"github.com/Wei-Shaw/sub2api/ent/user"
 	"github.com/Wei-Shaw/sub2api/ent/usersubscription"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/usagequota"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/volumetier"
 )
 
 // GroupUpdate is the builder for updating Group entities.
@@ -421,6 +424,208 @@ func (_u *GroupUpdate) SetNillableModelRoutingEnabled(v *bool) *GroupUpdate {
 	return _u
 }
 
+// SetPoolSplitEnabled sets the "pool_split_enabled" field.
+func (_u *GroupUpdate) SetPoolSplitEnabled(v bool) *GroupUpdate {
+	_u.mutation.SetPoolSplitEnabled(v)
+	return _u
+}
+
+// SetNillablePoolSplitEnabled sets the "pool_split_enabled" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillablePoolSplitEnabled(v *bool) *GroupUpdate {
+	if v != nil {
+		_u.SetPoolSplitEnabled(*v)
+	}
+	return _u
+}
+
+// SetPoolWeights sets the "pool_weights" field.
+func (_u *GroupUpdate) SetPoolWeights(v map[string]int) *GroupUpdate {
+	_u.mutation.SetPoolWeights(v)
+	return _u
+}
+
+// ClearPoolWeights clears the value of the "pool_weights" field.
+func (_u *GroupUpdate) ClearPoolWeights() *GroupUpdate {
+	_u.mutation.ClearPoolWeights()
+	return _u
+}
+
+// SetCanaryMaxErrorRate sets the "canary_max_error_rate" field.
+func (_u *GroupUpdate) SetCanaryMaxErrorRate(v float64) *GroupUpdate {
+	_u.mutation.ResetCanaryMaxErrorRate()
+	_u.mutation.SetCanaryMaxErrorRate(v)
+	return _u
+}
+
+// SetNillableCanaryMaxErrorRate sets the "canary_max_error_rate" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillableCanaryMaxErrorRate(v *float64) *GroupUpdate {
+	if v != nil {
+		_u.SetCanaryMaxErrorRate(*v)
+	}
+	return _u
+}
+
+// AddCanaryMaxErrorRate adds value to the "canary_max_error_rate" field.
+func (_u *GroupUpdate) AddCanaryMaxErrorRate(v float64) *GroupUpdate {
+	_u.mutation.AddCanaryMaxErrorRate(v)
+	return _u
+}
+
+// ClearCanaryMaxErrorRate clears the value of the "canary_max_error_rate" field.
+func (_u *GroupUpdate) ClearCanaryMaxErrorRate() *GroupUpdate {
+	_u.mutation.ClearCanaryMaxErrorRate()
+	return _u
+}
+
+// SetCapacityFallbackGroupIds sets the "capacity_fallback_group_ids" field.
+func (_u *GroupUpdate) SetCapacityFallbackGroupIds(v []int64) *GroupUpdate {
+	_u.mutation.SetCapacityFallbackGroupIds(v)
+	return _u
+}
+
+// AppendCapacityFallbackGroupIds appends value to the "capacity_fallback_group_ids" field.
+func (_u *GroupUpdate) AppendCapacityFallbackGroupIds(v []int64) *GroupUpdate {
+	_u.mutation.AppendCapacityFallbackGroupIds(v)
+	return _u
+}
+
+// ClearCapacityFallbackGroupIds clears the value of the "capacity_fallback_group_ids" field.
+func (_u *GroupUpdate) ClearCapacityFallbackGroupIds() *GroupUpdate {
+	_u.mutation.ClearCapacityFallbackGroupIds()
+	return _u
+}
+
+// SetFallbackModelMapping sets the "fallback_model_mapping" field.
+func (_u *GroupUpdate) SetFallbackModelMapping(v map[string]string) *GroupUpdate {
+	_u.mutation.SetFallbackModelMapping(v)
+	return _u
+}
+
+// ClearFallbackModelMapping clears the value of the "fallback_model_mapping" field.
+func (_u *GroupUpdate) ClearFallbackModelMapping() *GroupUpdate {
+	_u.mutation.ClearFallbackModelMapping()
+	return _u
+}
+
+// SetVolumeTiers sets the "volume_tiers" field.
+func (_u *GroupUpdate) SetVolumeTiers(v []volumetier.Tier) *GroupUpdate {
+	_u.mutation.SetVolumeTiers(v)
+	return _u
+}
+
+// AppendVolumeTiers appends value to the "volume_tiers" field.
+func (_u *GroupUpdate) AppendVolumeTiers(v []volumetier.Tier) *GroupUpdate {
+	_u.mutation.AppendVolumeTiers(v)
+	return _u
+}
+
+// ClearVolumeTiers clears the value of the "volume_tiers" field.
+func (_u *GroupUpdate) ClearVolumeTiers() *GroupUpdate {
+	_u.mutation.ClearVolumeTiers()
+	return _u
+}
+
+// SetOverageEnabled sets the "overage_enabled" field.
+func (_u *GroupUpdate) SetOverageEnabled(v bool) *GroupUpdate {
+	_u.mutation.SetOverageEnabled(v)
+	return _u
+}
+
+// SetNillableOverageEnabled sets the "overage_enabled" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillableOverageEnabled(v *bool) *GroupUpdate {
+	if v != nil {
+		_u.SetOverageEnabled(*v)
+	}
+	return _u
+}
+
+// SetOverageRateMultiplier sets the "overage_rate_multiplier" field.
+func (_u *GroupUpdate) SetOverageRateMultiplier(v float64) *GroupUpdate {
+	_u.mutation.ResetOverageRateMultiplier()
+	_u.mutation.SetOverageRateMultiplier(v)
+	return _u
+}
+
+// SetNillableOverageRateMultiplier sets the "overage_rate_multiplier" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillableOverageRateMultiplier(v *float64) *GroupUpdate {
+	if v != nil {
+		_u.SetOverageRateMultiplier(*v)
+	}
+	return _u
+}
+
+// AddOverageRateMultiplier adds value to the "overage_rate_multiplier" field.
+func (_u *GroupUpdate) AddOverageRateMultiplier(v float64) *GroupUpdate {
+	_u.mutation.AddOverageRateMultiplier(v)
+	return _u
+}
+
+// SetRollingWindowHours sets the "rolling_window_hours" field.
+func (_u *GroupUpdate) SetRollingWindowHours(v int) *GroupUpdate {
+	_u.mutation.ResetRollingWindowHours()
+	_u.mutation.SetRollingWindowHours(v)
+	return _u
+}
+
+// SetNillableRollingWindowHours sets the "rolling_window_hours" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillableRollingWindowHours(v *int) *GroupUpdate {
+	if v != nil {
+		_u.SetRollingWindowHours(*v)
+	}
+	return _u
+}
+
+// AddRollingWindowHours adds value to the "rolling_window_hours" field.
+func (_u *GroupUpdate) AddRollingWindowHours(v int) *GroupUpdate {
+	_u.mutation.AddRollingWindowHours(v)
+	return _u
+}
+
+// SetRollingWindowLimitUsd sets the "rolling_window_limit_usd" field.
+func (_u *GroupUpdate) SetRollingWindowLimitUsd(v float64) *GroupUpdate {
+	_u.mutation.ResetRollingWindowLimitUsd()
+	_u.mutation.SetRollingWindowLimitUsd(v)
+	return _u
+}
+
+// SetNillableRollingWindowLimitUsd sets the "rolling_window_limit_usd" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillableRollingWindowLimitUsd(v *float64) *GroupUpdate {
+	if v != nil {
+		_u.SetRollingWindowLimitUsd(*v)
+	}
+	return _u
+}
+
+// AddRollingWindowLimitUsd adds value to the "rolling_window_limit_usd" field.
+func (_u *GroupUpdate) AddRollingWindowLimitUsd(v float64) *GroupUpdate {
+	_u.mutation.AddRollingWindowLimitUsd(v)
+	return _u
+}
+
+// ClearRollingWindowLimitUsd clears the value of the "rolling_window_limit_usd" field.
+func (_u *GroupUpdate) ClearRollingWindowLimitUsd() *GroupUpdate {
+	_u.mutation.ClearRollingWindowLimitUsd()
+	return _u
+}
+
+// SetUsageQuotas sets the "usage_quotas" field.
+func (_u *GroupUpdate) SetUsageQuotas(v []usagequota.Quota) *GroupUpdate {
+	_u.mutation.SetUsageQuotas(v)
+	return _u
+}
+
+// AppendUsageQuotas appends value to the "usage_quotas" field.
+func (_u *GroupUpdate) AppendUsageQuotas(v []usagequota.Quota) *GroupUpdate {
+	_u.mutation.AppendUsageQuotas(v)
+	return _u
+}
+
+// ClearUsageQuotas clears the value of the "usage_quotas" field.
+func (_u *GroupUpdate) ClearUsageQuotas() *GroupUpdate {
+	_u.mutation.ClearUsageQuotas()
+	return _u
+}
+
 // AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
 func (_u *GroupUpdate) AddAPIKeyIDs(ids ...int64) *GroupUpdate {
 	_u.mutation.AddAPIKeyIDs(ids...)
@@ -838,6 +1043,87 @@ func (_u *GroupUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.ModelRoutingEnabled(); ok {
 		_spec.SetField(group.FieldModelRoutingEnabled, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.PoolSplitEnabled(); ok {
+		_spec.SetField(group.FieldPoolSplitEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PoolWeights(); ok {
+		_spec.SetField(group.FieldPoolWeights, field.TypeJSON, value)
+	}
+	if _u.mutation.PoolWeightsCleared() {
+		_spec.ClearField(group.FieldPoolWeights, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CanaryMaxErrorRate(); ok {
+		_spec.SetField(group.FieldCanaryMaxErrorRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCanaryMaxErrorRate(); ok {
+		_spec.AddField(group.FieldCanaryMaxErrorRate, field.TypeFloat64, value)
+	}
+	if _u.mutation.CanaryMaxErrorRateCleared() {
+		_spec.ClearField(group.FieldCanaryMaxErrorRate, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CapacityFallbackGroupIds(); ok {
+		_spec.SetField(group.FieldCapacityFallbackGroupIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCapacityFallbackGroupIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, group.FieldCapacityFallbackGroupIds, value)
+		})
+	}
+	if _u.mutation.CapacityFallbackGroupIdsCleared() {
+		_spec.ClearField(group.FieldCapacityFallbackGroupIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.FallbackModelMapping(); ok {
+		_spec.SetField(group.FieldFallbackModelMapping, field.TypeJSON, value)
+	}
+	if _u.mutation.FallbackModelMappingCleared() {
+		_spec.ClearField(group.FieldFallbackModelMapping, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.VolumeTiers(); ok {
+		_spec.SetField(group.FieldVolumeTiers, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedVolumeTiers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, group.FieldVolumeTiers, value)
+		})
+	}
+	if _u.mutation.VolumeTiersCleared() {
+		_spec.ClearField(group.FieldVolumeTiers, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.OverageEnabled(); ok {
+		_spec.SetField(group.FieldOverageEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.OverageRateMultiplier(); ok {
+		_spec.SetField(group.FieldOverageRateMultiplier, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOverageRateMultiplier(); ok {
+		_spec.AddField(group.FieldOverageRateMultiplier, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.RollingWindowHours(); ok {
+		_spec.SetField(group.FieldRollingWindowHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRollingWindowHours(); ok {
+		_spec.AddField(group.FieldRollingWindowHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RollingWindowLimitUsd(); ok {
+		_spec.SetField(group.FieldRollingWindowLimitUsd, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRollingWindowLimitUsd(); ok {
+		_spec.AddField(group.FieldRollingWindowLimitUsd, field.TypeFloat64, value)
+	}
+	if _u.mutation.RollingWindowLimitUsdCleared() {
+		_spec.ClearField(group.FieldRollingWindowLimitUsd, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.UsageQuotas(); ok {
+		_spec.SetField(group.FieldUsageQuotas, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedUsageQuotas(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, group.FieldUsageQuotas, value)
+		})
+	}
+	if _u.mutation.UsageQuotasCleared() {
+		_spec.ClearField(group.FieldUsageQuotas, field.TypeJSON)
+	}
 	if _u.mutation.APIKeysCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1539,6 +1825,208 @@ func (_u *GroupUpdateOne) SetNillableModelRoutingEnabled(v *bool) *GroupUpdateOn
 	return _u
 }
 
+// SetPoolSplitEnabled sets the "pool_split_enabled" field.
+func (_u *GroupUpdateOne) SetPoolSplitEnabled(v bool) *GroupUpdateOne {
+	_u.mutation.SetPoolSplitEnabled(v)
+	return _u
+}
+
+// SetNillablePoolSplitEnabled sets the "pool_split_enabled" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillablePoolSplitEnabled(v *bool) *GroupUpdateOne {
+	if v != nil {
+		_u.SetPoolSplitEnabled(*v)
+	}
+	return _u
+}
+
+// SetPoolWeights sets the "pool_weights" field.
+func (_u *GroupUpdateOne) SetPoolWeights(v map[string]int) *GroupUpdateOne {
+	_u.mutation.SetPoolWeights(v)
+	return _u
+}
+
+// ClearPoolWeights clears the value of the "pool_weights" field.
+func (_u *GroupUpdateOne) ClearPoolWeights() *GroupUpdateOne {
+	_u.mutation.ClearPoolWeights()
+	return _u
+}
+
+// SetCanaryMaxErrorRate sets the "canary_max_error_rate" field.
+func (_u *GroupUpdateOne) SetCanaryMaxErrorRate(v float64) *GroupUpdateOne {
+	_u.mutation.ResetCanaryMaxErrorRate()
+	_u.mutation.SetCanaryMaxErrorRate(v)
+	return _u
+}
+
+// SetNillableCanaryMaxErrorRate sets the "canary_max_error_rate" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableCanaryMaxErrorRate(v *float64) *GroupUpdateOne {
+	if v != nil {
+		_u.SetCanaryMaxErrorRate(*v)
+	}
+	return _u
+}
+
+// AddCanaryMaxErrorRate adds value to the "canary_max_error_rate" field.
+func (_u *GroupUpdateOne) AddCanaryMaxErrorRate(v float64) *GroupUpdateOne {
+	_u.mutation.AddCanaryMaxErrorRate(v)
+	return _u
+}
+
+// ClearCanaryMaxErrorRate clears the value of the "canary_max_error_rate" field.
+func (_u *GroupUpdateOne) ClearCanaryMaxErrorRate() *GroupUpdateOne {
+	_u.mutation.ClearCanaryMaxErrorRate()
+	return _u
+}
+
+// SetCapacityFallbackGroupIds sets the "capacity_fallback_group_ids" field.
+func (_u *GroupUpdateOne) SetCapacityFallbackGroupIds(v []int64) *GroupUpdateOne {
+	_u.mutation.SetCapacityFallbackGroupIds(v)
+	return _u
+}
+
+// AppendCapacityFallbackGroupIds appends value to the "capacity_fallback_group_ids" field.
+func (_u *GroupUpdateOne) AppendCapacityFallbackGroupIds(v []int64) *GroupUpdateOne {
+	_u.mutation.AppendCapacityFallbackGroupIds(v)
+	return _u
+}
+
+// ClearCapacityFallbackGroupIds clears the value of the "capacity_fallback_group_ids" field.
+func (_u *GroupUpdateOne) ClearCapacityFallbackGroupIds() *GroupUpdateOne {
+	_u.mutation.ClearCapacityFallbackGroupIds()
+	return _u
+}
+
+// SetFallbackModelMapping sets the "fallback_model_mapping" field.
+func (_u *GroupUpdateOne) SetFallbackModelMapping(v map[string]string) *GroupUpdateOne {
+	_u.mutation.SetFallbackModelMapping(v)
+	return _u
+}
+
+// ClearFallbackModelMapping clears the value of the "fallback_model_mapping" field.
+func (_u *GroupUpdateOne) ClearFallbackModelMapping() *GroupUpdateOne {
+	_u.mutation.ClearFallbackModelMapping()
+	return _u
+}
+
+// SetVolumeTiers sets the "volume_tiers" field.
+func (_u *GroupUpdateOne) SetVolumeTiers(v []volumetier.Tier) *GroupUpdateOne {
+	_u.mutation.SetVolumeTiers(v)
+	return _u
+}
+
+// AppendVolumeTiers appends value to the "volume_tiers" field.
+func (_u *GroupUpdateOne) AppendVolumeTiers(v []volumetier.Tier) *GroupUpdateOne {
+	_u.mutation.AppendVolumeTiers(v)
+	return _u
+}
+
+// ClearVolumeTiers clears the value of the "volume_tiers" field.
+func (_u *GroupUpdateOne) ClearVolumeTiers() *GroupUpdateOne {
+	_u.mutation.ClearVolumeTiers()
+	return _u
+}
+
+// SetOverageEnabled sets the "overage_enabled" field.
+func (_u *GroupUpdateOne) SetOverageEnabled(v bool) *GroupUpdateOne {
+	_u.mutation.SetOverageEnabled(v)
+	return _u
+}
+
+// SetNillableOverageEnabled sets the "overage_enabled" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableOverageEnabled(v *bool) *GroupUpdateOne {
+	if v != nil {
+		_u.SetOverageEnabled(*v)
+	}
+	return _u
+}
+
+// SetOverageRateMultiplier sets the "overage_rate_multiplier" field.
+func (_u *GroupUpdateOne) SetOverageRateMultiplier(v float64) *GroupUpdateOne {
+	_u.mutation.ResetOverageRateMultiplier()
+	_u.mutation.SetOverageRateMultiplier(v)
+	return _u
+}
+
+// SetNillableOverageRateMultiplier sets the "overage_rate_multiplier" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableOverageRateMultiplier(v *float64) *GroupUpdateOne {
+	if v != nil {
+		_u.SetOverageRateMultiplier(*v)
+	}
+	return _u
+}
+
+// AddOverageRateMultiplier adds value to the "overage_rate_multiplier" field.
+func (_u *GroupUpdateOne) AddOverageRateMultiplier(v float64) *GroupUpdateOne {
+	_u.mutation.AddOverageRateMultiplier(v)
+	return _u
+}
+
+// SetRollingWindowHours sets the "rolling_window_hours" field.
+func (_u *GroupUpdateOne) SetRollingWindowHours(v int) *GroupUpdateOne {
+	_u.mutation.ResetRollingWindowHours()
+	_u.mutation.SetRollingWindowHours(v)
+	return _u
+}
+
+// SetNillableRollingWindowHours sets the "rolling_window_hours" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableRollingWindowHours(v *int) *GroupUpdateOne {
+	if v != nil {
+		_u.SetRollingWindowHours(*v)
+	}
+	return _u
+}
+
+// AddRollingWindowHours adds value to the "rolling_window_hours" field.
+func (_u *GroupUpdateOne) AddRollingWindowHours(v int) *GroupUpdateOne {
+	_u.mutation.AddRollingWindowHours(v)
+	return _u
+}
+
+// SetRollingWindowLimitUsd sets the "rolling_window_limit_usd" field.
+func (_u *GroupUpdateOne) SetRollingWindowLimitUsd(v float64) *GroupUpdateOne {
+	_u.mutation.ResetRollingWindowLimitUsd()
+	_u.mutation.SetRollingWindowLimitUsd(v)
+	return _u
+}
+
+// SetNillableRollingWindowLimitUsd sets the "rolling_window_limit_usd" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableRollingWindowLimitUsd(v *float64) *GroupUpdateOne {
+	if v != nil {
+		_u.SetRollingWindowLimitUsd(*v)
+	}
+	return _u
+}
+
+// AddRollingWindowLimitUsd adds value to the "rolling_window_limit_usd" field.
+func (_u *GroupUpdateOne) AddRollingWindowLimitUsd(v float64) *GroupUpdateOne {
+	_u.mutation.AddRollingWindowLimitUsd(v)
+	return _u
+}
+
+// ClearRollingWindowLimitUsd clears the value of the "rolling_window_limit_usd" field.
+func (_u *GroupUpdateOne) ClearRollingWindowLimitUsd() *GroupUpdateOne {
+	_u.mutation.ClearRollingWindowLimitUsd()
+	return _u
+}
+
+// SetUsageQuotas sets the "usage_quotas" field.
+func (_u *GroupUpdateOne) SetUsageQuotas(v []usagequota.Quota) *GroupUpdateOne {
+	_u.mutation.SetUsageQuotas(v)
+	return _u
+}
+
+// AppendUsageQuotas appends value to the "usage_quotas" field.
+func (_u *GroupUpdateOne) AppendUsageQuotas(v []usagequota.Quota) *GroupUpdateOne {
+	_u.mutation.AppendUsageQuotas(v)
+	return _u
+}
+
+// ClearUsageQuotas clears the value of the "usage_quotas" field.
+func (_u *GroupUpdateOne) ClearUsageQuotas() *GroupUpdateOne {
+	_u.mutation.ClearUsageQuotas()
+	return _u
+}
+
 // AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
 func (_u *GroupUpdateOne) AddAPIKeyIDs(ids ...int64) *GroupUpdateOne {
 	_u.mutation.AddAPIKeyIDs(ids...)
@@ -1986,6 +2474,87 @@ func (_u *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error)
 	if value, ok := _u.mutation.ModelRoutingEnabled(); ok {
 		_spec.SetField(group.FieldModelRoutingEnabled, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.PoolSplitEnabled(); ok {
+		_spec.SetField(group.FieldPoolSplitEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PoolWeights(); ok {
+		_spec.SetField(group.FieldPoolWeights, field.TypeJSON, value)
+	}
+	if _u.mutation.PoolWeightsCleared() {
+		_spec.ClearField(group.FieldPoolWeights, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CanaryMaxErrorRate(); ok {
+		_spec.SetField(group.FieldCanaryMaxErrorRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCanaryMaxErrorRate(); ok {
+		_spec.AddField(group.FieldCanaryMaxErrorRate, field.TypeFloat64, value)
+	}
+	if _u.mutation.CanaryMaxErrorRateCleared() {
+		_spec.ClearField(group.FieldCanaryMaxErrorRate, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CapacityFallbackGroupIds(); ok {
+		_spec.SetField(group.FieldCapacityFallbackGroupIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCapacityFallbackGroupIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, group.FieldCapacityFallbackGroupIds, value)
+		})
+	}
+	if _u.mutation.CapacityFallbackGroupIdsCleared() {
+		_spec.ClearField(group.FieldCapacityFallbackGroupIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.FallbackModelMapping(); ok {
+		_spec.SetField(group.FieldFallbackModelMapping, field.TypeJSON, value)
+	}
+	if _u.mutation.FallbackModelMappingCleared() {
+		_spec.ClearField(group.FieldFallbackModelMapping, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.VolumeTiers(); ok {
+		_spec.SetField(group.FieldVolumeTiers, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedVolumeTiers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, group.FieldVolumeTiers, value)
+		})
+	}
+	if _u.mutation.VolumeTiersCleared() {
+		_spec.ClearField(group.FieldVolumeTiers, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.OverageEnabled(); ok {
+		_spec.SetField(group.FieldOverageEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.OverageRateMultiplier(); ok {
+		_spec.SetField(group.FieldOverageRateMultiplier, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOverageRateMultiplier(); ok {
+		_spec.AddField(group.FieldOverageRateMultiplier, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.RollingWindowHours(); ok {
+		_spec.SetField(group.FieldRollingWindowHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRollingWindowHours(); ok {
+		_spec.AddField(group.FieldRollingWindowHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RollingWindowLimitUsd(); ok {
+		_spec.SetField(group.FieldRollingWindowLimitUsd, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRollingWindowLimitUsd(); ok {
+		_spec.AddField(group.FieldRollingWindowLimitUsd, field.TypeFloat64, value)
+	}
+	if _u.mutation.RollingWindowLimitUsdCleared() {
+		_spec.ClearField(group.FieldRollingWindowLimitUsd, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.UsageQuotas(); ok {
+		_spec.SetField(group.FieldUsageQuotas, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedUsageQuotas(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, group.FieldUsageQuotas, value)
+		})
+	}
+	if _u.mutation.UsageQuotasCleared() {
+		_spec.ClearField(group.FieldUsageQuotas, field.TypeJSON)
+	}
 	if _u.mutation.APIKeysCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
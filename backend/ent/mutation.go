@@ -29,6 +29,8 @@ import (
 	"github.com/Wei-Shaw/sub2api/ent/userattributedefinition"
 	"github.com/Wei-Shaw/sub2api/ent/userattributevalue"
 	"github.com/Wei-Shaw/sub2api/ent/usersubscription"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/usagequota"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/volumetier"
 )
 
 const (
@@ -61,30 +63,42 @@ const (
 // APIKeyMutation represents an operation that mutates the APIKey nodes in the graph.
 type APIKeyMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int64
-	created_at         *time.Time
-	updated_at         *time.Time
-	deleted_at         *time.Time
-	key                *string
-	name               *string
-	status             *string
-	ip_whitelist       *[]string
-	appendip_whitelist []string
-	ip_blacklist       *[]string
-	appendip_blacklist []string
-	clearedFields      map[string]struct{}
-	user               *int64
-	cleareduser        bool
-	group              *int64
-	clearedgroup       bool
-	usage_logs         map[int64]struct{}
-	removedusage_logs  map[int64]struct{}
-	clearedusage_logs  bool
-	done               bool
-	oldValue           func(context.Context) (*APIKey, error)
-	predicates         []predicate.APIKey
+	op                       Op
+	typ                      string
+	id                       *int64
+	created_at               *time.Time
+	updated_at               *time.Time
+	deleted_at               *time.Time
+	key                      *string
+	name                     *string
+	status                   *string
+	ip_whitelist             *[]string
+	appendip_whitelist       []string
+	ip_blacklist             *[]string
+	appendip_blacklist       []string
+	daily_limit_usd          *float64
+	adddaily_limit_usd       *float64
+	monthly_limit_usd        *float64
+	addmonthly_limit_usd     *float64
+	total_limit_usd          *float64
+	addtotal_limit_usd       *float64
+	daily_request_limit      *int64
+	adddaily_request_limit   *int64
+	monthly_request_limit    *int64
+	addmonthly_request_limit *int64
+	organization_id          *int64
+	addorganization_id       *int64
+	clearedFields            map[string]struct{}
+	user                     *int64
+	cleareduser              bool
+	group                    *int64
+	clearedgroup             bool
+	usage_logs               map[int64]struct{}
+	removedusage_logs        map[int64]struct{}
+	clearedusage_logs        bool
+	done                     bool
+	oldValue                 func(context.Context) (*APIKey, error)
+	predicates               []predicate.APIKey
 }
 
 var _ ent.Mutation = (*APIKeyMutation)(nil)
@@ -629,171 +643,591 @@ func (m *APIKeyMutation) ResetIPBlacklist() {
 	delete(m.clearedFields, apikey.FieldIPBlacklist)
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *APIKeyMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[apikey.FieldUserID] = struct{}{}
+// SetDailyLimitUsd sets the "daily_limit_usd" field.
+func (m *APIKeyMutation) SetDailyLimitUsd(f float64) {
+	m.daily_limit_usd = &f
+	m.adddaily_limit_usd = nil
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *APIKeyMutation) UserCleared() bool {
-	return m.cleareduser
+// DailyLimitUsd returns the value of the "daily_limit_usd" field in the mutation.
+func (m *APIKeyMutation) DailyLimitUsd() (r float64, exists bool) {
+	v := m.daily_limit_usd
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *APIKeyMutation) UserIDs() (ids []int64) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// OldDailyLimitUsd returns the old "daily_limit_usd" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldDailyLimitUsd(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDailyLimitUsd is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDailyLimitUsd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDailyLimitUsd: %w", err)
+	}
+	return oldValue.DailyLimitUsd, nil
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *APIKeyMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// AddDailyLimitUsd adds f to the "daily_limit_usd" field.
+func (m *APIKeyMutation) AddDailyLimitUsd(f float64) {
+	if m.adddaily_limit_usd != nil {
+		*m.adddaily_limit_usd += f
+	} else {
+		m.adddaily_limit_usd = &f
+	}
 }
 
-// ClearGroup clears the "group" edge to the Group entity.
-func (m *APIKeyMutation) ClearGroup() {
-	m.clearedgroup = true
-	m.clearedFields[apikey.FieldGroupID] = struct{}{}
+// AddedDailyLimitUsd returns the value that was added to the "daily_limit_usd" field in this mutation.
+func (m *APIKeyMutation) AddedDailyLimitUsd() (r float64, exists bool) {
+	v := m.adddaily_limit_usd
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// GroupCleared reports if the "group" edge to the Group entity was cleared.
-func (m *APIKeyMutation) GroupCleared() bool {
-	return m.GroupIDCleared() || m.clearedgroup
+// ClearDailyLimitUsd clears the value of the "daily_limit_usd" field.
+func (m *APIKeyMutation) ClearDailyLimitUsd() {
+	m.daily_limit_usd = nil
+	m.adddaily_limit_usd = nil
+	m.clearedFields[apikey.FieldDailyLimitUsd] = struct{}{}
 }
 
-// GroupIDs returns the "group" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// GroupID instead. It exists only for internal usage by the builders.
-func (m *APIKeyMutation) GroupIDs() (ids []int64) {
-	if id := m.group; id != nil {
-		ids = append(ids, *id)
+// DailyLimitUsdCleared returns if the "daily_limit_usd" field was cleared in this mutation.
+func (m *APIKeyMutation) DailyLimitUsdCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldDailyLimitUsd]
+	return ok
+}
+
+// ResetDailyLimitUsd resets all changes to the "daily_limit_usd" field.
+func (m *APIKeyMutation) ResetDailyLimitUsd() {
+	m.daily_limit_usd = nil
+	m.adddaily_limit_usd = nil
+	delete(m.clearedFields, apikey.FieldDailyLimitUsd)
+}
+
+// SetMonthlyLimitUsd sets the "monthly_limit_usd" field.
+func (m *APIKeyMutation) SetMonthlyLimitUsd(f float64) {
+	m.monthly_limit_usd = &f
+	m.addmonthly_limit_usd = nil
+}
+
+// MonthlyLimitUsd returns the value of the "monthly_limit_usd" field in the mutation.
+func (m *APIKeyMutation) MonthlyLimitUsd() (r float64, exists bool) {
+	v := m.monthly_limit_usd
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetGroup resets all changes to the "group" edge.
-func (m *APIKeyMutation) ResetGroup() {
-	m.group = nil
-	m.clearedgroup = false
+// OldMonthlyLimitUsd returns the old "monthly_limit_usd" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldMonthlyLimitUsd(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMonthlyLimitUsd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMonthlyLimitUsd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMonthlyLimitUsd: %w", err)
+	}
+	return oldValue.MonthlyLimitUsd, nil
 }
 
-// AddUsageLogIDs adds the "usage_logs" edge to the UsageLog entity by ids.
-func (m *APIKeyMutation) AddUsageLogIDs(ids ...int64) {
-	if m.usage_logs == nil {
-		m.usage_logs = make(map[int64]struct{})
+// AddMonthlyLimitUsd adds f to the "monthly_limit_usd" field.
+func (m *APIKeyMutation) AddMonthlyLimitUsd(f float64) {
+	if m.addmonthly_limit_usd != nil {
+		*m.addmonthly_limit_usd += f
+	} else {
+		m.addmonthly_limit_usd = &f
 	}
-	for i := range ids {
-		m.usage_logs[ids[i]] = struct{}{}
+}
+
+// AddedMonthlyLimitUsd returns the value that was added to the "monthly_limit_usd" field in this mutation.
+func (m *APIKeyMutation) AddedMonthlyLimitUsd() (r float64, exists bool) {
+	v := m.addmonthly_limit_usd
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearUsageLogs clears the "usage_logs" edge to the UsageLog entity.
-func (m *APIKeyMutation) ClearUsageLogs() {
-	m.clearedusage_logs = true
+// ClearMonthlyLimitUsd clears the value of the "monthly_limit_usd" field.
+func (m *APIKeyMutation) ClearMonthlyLimitUsd() {
+	m.monthly_limit_usd = nil
+	m.addmonthly_limit_usd = nil
+	m.clearedFields[apikey.FieldMonthlyLimitUsd] = struct{}{}
 }
 
-// UsageLogsCleared reports if the "usage_logs" edge to the UsageLog entity was cleared.
-func (m *APIKeyMutation) UsageLogsCleared() bool {
-	return m.clearedusage_logs
+// MonthlyLimitUsdCleared returns if the "monthly_limit_usd" field was cleared in this mutation.
+func (m *APIKeyMutation) MonthlyLimitUsdCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldMonthlyLimitUsd]
+	return ok
 }
 
-// RemoveUsageLogIDs removes the "usage_logs" edge to the UsageLog entity by IDs.
-func (m *APIKeyMutation) RemoveUsageLogIDs(ids ...int64) {
-	if m.removedusage_logs == nil {
-		m.removedusage_logs = make(map[int64]struct{})
-	}
-	for i := range ids {
-		delete(m.usage_logs, ids[i])
-		m.removedusage_logs[ids[i]] = struct{}{}
-	}
+// ResetMonthlyLimitUsd resets all changes to the "monthly_limit_usd" field.
+func (m *APIKeyMutation) ResetMonthlyLimitUsd() {
+	m.monthly_limit_usd = nil
+	m.addmonthly_limit_usd = nil
+	delete(m.clearedFields, apikey.FieldMonthlyLimitUsd)
 }
 
-// RemovedUsageLogs returns the removed IDs of the "usage_logs" edge to the UsageLog entity.
-func (m *APIKeyMutation) RemovedUsageLogsIDs() (ids []int64) {
-	for id := range m.removedusage_logs {
-		ids = append(ids, id)
-	}
-	return
+// SetTotalLimitUsd sets the "total_limit_usd" field.
+func (m *APIKeyMutation) SetTotalLimitUsd(f float64) {
+	m.total_limit_usd = &f
+	m.addtotal_limit_usd = nil
 }
 
-// UsageLogsIDs returns the "usage_logs" edge IDs in the mutation.
-func (m *APIKeyMutation) UsageLogsIDs() (ids []int64) {
-	for id := range m.usage_logs {
-		ids = append(ids, id)
+// TotalLimitUsd returns the value of the "total_limit_usd" field in the mutation.
+func (m *APIKeyMutation) TotalLimitUsd() (r float64, exists bool) {
+	v := m.total_limit_usd
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetUsageLogs resets all changes to the "usage_logs" edge.
-func (m *APIKeyMutation) ResetUsageLogs() {
-	m.usage_logs = nil
-	m.clearedusage_logs = false
-	m.removedusage_logs = nil
+// OldTotalLimitUsd returns the old "total_limit_usd" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldTotalLimitUsd(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalLimitUsd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalLimitUsd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalLimitUsd: %w", err)
+	}
+	return oldValue.TotalLimitUsd, nil
 }
 
-// Where appends a list predicates to the APIKeyMutation builder.
-func (m *APIKeyMutation) Where(ps ...predicate.APIKey) {
-	m.predicates = append(m.predicates, ps...)
+// AddTotalLimitUsd adds f to the "total_limit_usd" field.
+func (m *APIKeyMutation) AddTotalLimitUsd(f float64) {
+	if m.addtotal_limit_usd != nil {
+		*m.addtotal_limit_usd += f
+	} else {
+		m.addtotal_limit_usd = &f
+	}
 }
 
-// WhereP appends storage-level predicates to the APIKeyMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *APIKeyMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.APIKey, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddedTotalLimitUsd returns the value that was added to the "total_limit_usd" field in this mutation.
+func (m *APIKeyMutation) AddedTotalLimitUsd() (r float64, exists bool) {
+	v := m.addtotal_limit_usd
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *APIKeyMutation) Op() Op {
-	return m.op
+// ClearTotalLimitUsd clears the value of the "total_limit_usd" field.
+func (m *APIKeyMutation) ClearTotalLimitUsd() {
+	m.total_limit_usd = nil
+	m.addtotal_limit_usd = nil
+	m.clearedFields[apikey.FieldTotalLimitUsd] = struct{}{}
 }
 
-// SetOp allows setting the mutation operation.
-func (m *APIKeyMutation) SetOp(op Op) {
-	m.op = op
+// TotalLimitUsdCleared returns if the "total_limit_usd" field was cleared in this mutation.
+func (m *APIKeyMutation) TotalLimitUsdCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldTotalLimitUsd]
+	return ok
 }
 
-// Type returns the node type of this mutation (APIKey).
-func (m *APIKeyMutation) Type() string {
-	return m.typ
+// ResetTotalLimitUsd resets all changes to the "total_limit_usd" field.
+func (m *APIKeyMutation) ResetTotalLimitUsd() {
+	m.total_limit_usd = nil
+	m.addtotal_limit_usd = nil
+	delete(m.clearedFields, apikey.FieldTotalLimitUsd)
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *APIKeyMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.created_at != nil {
-		fields = append(fields, apikey.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, apikey.FieldUpdatedAt)
+// SetDailyRequestLimit sets the "daily_request_limit" field.
+func (m *APIKeyMutation) SetDailyRequestLimit(i int64) {
+	m.daily_request_limit = &i
+	m.adddaily_request_limit = nil
+}
+
+// DailyRequestLimit returns the value of the "daily_request_limit" field in the mutation.
+func (m *APIKeyMutation) DailyRequestLimit() (r int64, exists bool) {
+	v := m.daily_request_limit
+	if v == nil {
+		return
 	}
-	if m.deleted_at != nil {
-		fields = append(fields, apikey.FieldDeletedAt)
+	return *v, true
+}
+
+// OldDailyRequestLimit returns the old "daily_request_limit" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldDailyRequestLimit(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDailyRequestLimit is only allowed on UpdateOne operations")
 	}
-	if m.user != nil {
-		fields = append(fields, apikey.FieldUserID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDailyRequestLimit requires an ID field in the mutation")
 	}
-	if m.key != nil {
-		fields = append(fields, apikey.FieldKey)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDailyRequestLimit: %w", err)
 	}
-	if m.name != nil {
-		fields = append(fields, apikey.FieldName)
+	return oldValue.DailyRequestLimit, nil
+}
+
+// AddDailyRequestLimit adds i to the "daily_request_limit" field.
+func (m *APIKeyMutation) AddDailyRequestLimit(i int64) {
+	if m.adddaily_request_limit != nil {
+		*m.adddaily_request_limit += i
+	} else {
+		m.adddaily_request_limit = &i
 	}
-	if m.group != nil {
-		fields = append(fields, apikey.FieldGroupID)
+}
+
+// AddedDailyRequestLimit returns the value that was added to the "daily_request_limit" field in this mutation.
+func (m *APIKeyMutation) AddedDailyRequestLimit() (r int64, exists bool) {
+	v := m.adddaily_request_limit
+	if v == nil {
+		return
 	}
-	if m.status != nil {
+	return *v, true
+}
+
+// ClearDailyRequestLimit clears the value of the "daily_request_limit" field.
+func (m *APIKeyMutation) ClearDailyRequestLimit() {
+	m.daily_request_limit = nil
+	m.adddaily_request_limit = nil
+	m.clearedFields[apikey.FieldDailyRequestLimit] = struct{}{}
+}
+
+// DailyRequestLimitCleared returns if the "daily_request_limit" field was cleared in this mutation.
+func (m *APIKeyMutation) DailyRequestLimitCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldDailyRequestLimit]
+	return ok
+}
+
+// ResetDailyRequestLimit resets all changes to the "daily_request_limit" field.
+func (m *APIKeyMutation) ResetDailyRequestLimit() {
+	m.daily_request_limit = nil
+	m.adddaily_request_limit = nil
+	delete(m.clearedFields, apikey.FieldDailyRequestLimit)
+}
+
+// SetMonthlyRequestLimit sets the "monthly_request_limit" field.
+func (m *APIKeyMutation) SetMonthlyRequestLimit(i int64) {
+	m.monthly_request_limit = &i
+	m.addmonthly_request_limit = nil
+}
+
+// MonthlyRequestLimit returns the value of the "monthly_request_limit" field in the mutation.
+func (m *APIKeyMutation) MonthlyRequestLimit() (r int64, exists bool) {
+	v := m.monthly_request_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMonthlyRequestLimit returns the old "monthly_request_limit" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldMonthlyRequestLimit(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMonthlyRequestLimit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMonthlyRequestLimit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMonthlyRequestLimit: %w", err)
+	}
+	return oldValue.MonthlyRequestLimit, nil
+}
+
+// AddMonthlyRequestLimit adds i to the "monthly_request_limit" field.
+func (m *APIKeyMutation) AddMonthlyRequestLimit(i int64) {
+	if m.addmonthly_request_limit != nil {
+		*m.addmonthly_request_limit += i
+	} else {
+		m.addmonthly_request_limit = &i
+	}
+}
+
+// AddedMonthlyRequestLimit returns the value that was added to the "monthly_request_limit" field in this mutation.
+func (m *APIKeyMutation) AddedMonthlyRequestLimit() (r int64, exists bool) {
+	v := m.addmonthly_request_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMonthlyRequestLimit clears the value of the "monthly_request_limit" field.
+func (m *APIKeyMutation) ClearMonthlyRequestLimit() {
+	m.monthly_request_limit = nil
+	m.addmonthly_request_limit = nil
+	m.clearedFields[apikey.FieldMonthlyRequestLimit] = struct{}{}
+}
+
+// MonthlyRequestLimitCleared returns if the "monthly_request_limit" field was cleared in this mutation.
+func (m *APIKeyMutation) MonthlyRequestLimitCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldMonthlyRequestLimit]
+	return ok
+}
+
+// ResetMonthlyRequestLimit resets all changes to the "monthly_request_limit" field.
+func (m *APIKeyMutation) ResetMonthlyRequestLimit() {
+	m.monthly_request_limit = nil
+	m.addmonthly_request_limit = nil
+	delete(m.clearedFields, apikey.FieldMonthlyRequestLimit)
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (m *APIKeyMutation) SetOrganizationID(i int64) {
+	m.organization_id = &i
+	m.addorganization_id = nil
+}
+
+// OrganizationID returns the value of the "organization_id" field in the mutation.
+func (m *APIKeyMutation) OrganizationID() (r int64, exists bool) {
+	v := m.organization_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganizationID returns the old "organization_id" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldOrganizationID(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrganizationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrganizationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationID: %w", err)
+	}
+	return oldValue.OrganizationID, nil
+}
+
+// AddOrganizationID adds i to the "organization_id" field.
+func (m *APIKeyMutation) AddOrganizationID(i int64) {
+	if m.addorganization_id != nil {
+		*m.addorganization_id += i
+	} else {
+		m.addorganization_id = &i
+	}
+}
+
+// AddedOrganizationID returns the value that was added to the "organization_id" field in this mutation.
+func (m *APIKeyMutation) AddedOrganizationID() (r int64, exists bool) {
+	v := m.addorganization_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (m *APIKeyMutation) ClearOrganizationID() {
+	m.organization_id = nil
+	m.addorganization_id = nil
+	m.clearedFields[apikey.FieldOrganizationID] = struct{}{}
+}
+
+// OrganizationIDCleared returns if the "organization_id" field was cleared in this mutation.
+func (m *APIKeyMutation) OrganizationIDCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldOrganizationID]
+	return ok
+}
+
+// ResetOrganizationID resets all changes to the "organization_id" field.
+func (m *APIKeyMutation) ResetOrganizationID() {
+	m.organization_id = nil
+	m.addorganization_id = nil
+	delete(m.clearedFields, apikey.FieldOrganizationID)
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *APIKeyMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[apikey.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *APIKeyMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *APIKeyMutation) UserIDs() (ids []int64) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *APIKeyMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (m *APIKeyMutation) ClearGroup() {
+	m.clearedgroup = true
+	m.clearedFields[apikey.FieldGroupID] = struct{}{}
+}
+
+// GroupCleared reports if the "group" edge to the Group entity was cleared.
+func (m *APIKeyMutation) GroupCleared() bool {
+	return m.GroupIDCleared() || m.clearedgroup
+}
+
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *APIKeyMutation) GroupIDs() (ids []int64) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGroup resets all changes to the "group" edge.
+func (m *APIKeyMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
+}
+
+// AddUsageLogIDs adds the "usage_logs" edge to the UsageLog entity by ids.
+func (m *APIKeyMutation) AddUsageLogIDs(ids ...int64) {
+	if m.usage_logs == nil {
+		m.usage_logs = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.usage_logs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUsageLogs clears the "usage_logs" edge to the UsageLog entity.
+func (m *APIKeyMutation) ClearUsageLogs() {
+	m.clearedusage_logs = true
+}
+
+// UsageLogsCleared reports if the "usage_logs" edge to the UsageLog entity was cleared.
+func (m *APIKeyMutation) UsageLogsCleared() bool {
+	return m.clearedusage_logs
+}
+
+// RemoveUsageLogIDs removes the "usage_logs" edge to the UsageLog entity by IDs.
+func (m *APIKeyMutation) RemoveUsageLogIDs(ids ...int64) {
+	if m.removedusage_logs == nil {
+		m.removedusage_logs = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.usage_logs, ids[i])
+		m.removedusage_logs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUsageLogs returns the removed IDs of the "usage_logs" edge to the UsageLog entity.
+func (m *APIKeyMutation) RemovedUsageLogsIDs() (ids []int64) {
+	for id := range m.removedusage_logs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UsageLogsIDs returns the "usage_logs" edge IDs in the mutation.
+func (m *APIKeyMutation) UsageLogsIDs() (ids []int64) {
+	for id := range m.usage_logs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUsageLogs resets all changes to the "usage_logs" edge.
+func (m *APIKeyMutation) ResetUsageLogs() {
+	m.usage_logs = nil
+	m.clearedusage_logs = false
+	m.removedusage_logs = nil
+}
+
+// Where appends a list predicates to the APIKeyMutation builder.
+func (m *APIKeyMutation) Where(ps ...predicate.APIKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the APIKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *APIKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.APIKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *APIKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *APIKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (APIKey).
+func (m *APIKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *APIKeyMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.created_at != nil {
+		fields = append(fields, apikey.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, apikey.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, apikey.FieldDeletedAt)
+	}
+	if m.user != nil {
+		fields = append(fields, apikey.FieldUserID)
+	}
+	if m.key != nil {
+		fields = append(fields, apikey.FieldKey)
+	}
+	if m.name != nil {
+		fields = append(fields, apikey.FieldName)
+	}
+	if m.group != nil {
+		fields = append(fields, apikey.FieldGroupID)
+	}
+	if m.status != nil {
 		fields = append(fields, apikey.FieldStatus)
 	}
 	if m.ip_whitelist != nil {
@@ -802,6 +1236,24 @@ func (m *APIKeyMutation) Fields() []string {
 	if m.ip_blacklist != nil {
 		fields = append(fields, apikey.FieldIPBlacklist)
 	}
+	if m.daily_limit_usd != nil {
+		fields = append(fields, apikey.FieldDailyLimitUsd)
+	}
+	if m.monthly_limit_usd != nil {
+		fields = append(fields, apikey.FieldMonthlyLimitUsd)
+	}
+	if m.total_limit_usd != nil {
+		fields = append(fields, apikey.FieldTotalLimitUsd)
+	}
+	if m.daily_request_limit != nil {
+		fields = append(fields, apikey.FieldDailyRequestLimit)
+	}
+	if m.monthly_request_limit != nil {
+		fields = append(fields, apikey.FieldMonthlyRequestLimit)
+	}
+	if m.organization_id != nil {
+		fields = append(fields, apikey.FieldOrganizationID)
+	}
 	return fields
 }
 
@@ -830,6 +1282,18 @@ func (m *APIKeyMutation) Field(name string) (ent.Value, bool) {
 		return m.IPWhitelist()
 	case apikey.FieldIPBlacklist:
 		return m.IPBlacklist()
+	case apikey.FieldDailyLimitUsd:
+		return m.DailyLimitUsd()
+	case apikey.FieldMonthlyLimitUsd:
+		return m.MonthlyLimitUsd()
+	case apikey.FieldTotalLimitUsd:
+		return m.TotalLimitUsd()
+	case apikey.FieldDailyRequestLimit:
+		return m.DailyRequestLimit()
+	case apikey.FieldMonthlyRequestLimit:
+		return m.MonthlyRequestLimit()
+	case apikey.FieldOrganizationID:
+		return m.OrganizationID()
 	}
 	return nil, false
 }
@@ -859,6 +1323,18 @@ func (m *APIKeyMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldIPWhitelist(ctx)
 	case apikey.FieldIPBlacklist:
 		return m.OldIPBlacklist(ctx)
+	case apikey.FieldDailyLimitUsd:
+		return m.OldDailyLimitUsd(ctx)
+	case apikey.FieldMonthlyLimitUsd:
+		return m.OldMonthlyLimitUsd(ctx)
+	case apikey.FieldTotalLimitUsd:
+		return m.OldTotalLimitUsd(ctx)
+	case apikey.FieldDailyRequestLimit:
+		return m.OldDailyRequestLimit(ctx)
+	case apikey.FieldMonthlyRequestLimit:
+		return m.OldMonthlyRequestLimit(ctx)
+	case apikey.FieldOrganizationID:
+		return m.OldOrganizationID(ctx)
 	}
 	return nil, fmt.Errorf("unknown APIKey field %s", name)
 }
@@ -938,6 +1414,48 @@ func (m *APIKeyMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetIPBlacklist(v)
 		return nil
+	case apikey.FieldDailyLimitUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDailyLimitUsd(v)
+		return nil
+	case apikey.FieldMonthlyLimitUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMonthlyLimitUsd(v)
+		return nil
+	case apikey.FieldTotalLimitUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalLimitUsd(v)
+		return nil
+	case apikey.FieldDailyRequestLimit:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDailyRequestLimit(v)
+		return nil
+	case apikey.FieldMonthlyRequestLimit:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMonthlyRequestLimit(v)
+		return nil
+	case apikey.FieldOrganizationID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationID(v)
+		return nil
 	}
 	return fmt.Errorf("unknown APIKey field %s", name)
 }
@@ -946,6 +1464,24 @@ func (m *APIKeyMutation) SetField(name string, value ent.Value) error {
 // this mutation.
 func (m *APIKeyMutation) AddedFields() []string {
 	var fields []string
+	if m.adddaily_limit_usd != nil {
+		fields = append(fields, apikey.FieldDailyLimitUsd)
+	}
+	if m.addmonthly_limit_usd != nil {
+		fields = append(fields, apikey.FieldMonthlyLimitUsd)
+	}
+	if m.addtotal_limit_usd != nil {
+		fields = append(fields, apikey.FieldTotalLimitUsd)
+	}
+	if m.adddaily_request_limit != nil {
+		fields = append(fields, apikey.FieldDailyRequestLimit)
+	}
+	if m.addmonthly_request_limit != nil {
+		fields = append(fields, apikey.FieldMonthlyRequestLimit)
+	}
+	if m.addorganization_id != nil {
+		fields = append(fields, apikey.FieldOrganizationID)
+	}
 	return fields
 }
 
@@ -954,6 +1490,18 @@ func (m *APIKeyMutation) AddedFields() []string {
 // was not set, or was not defined in the schema.
 func (m *APIKeyMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
+	case apikey.FieldDailyLimitUsd:
+		return m.AddedDailyLimitUsd()
+	case apikey.FieldMonthlyLimitUsd:
+		return m.AddedMonthlyLimitUsd()
+	case apikey.FieldTotalLimitUsd:
+		return m.AddedTotalLimitUsd()
+	case apikey.FieldDailyRequestLimit:
+		return m.AddedDailyRequestLimit()
+	case apikey.FieldMonthlyRequestLimit:
+		return m.AddedMonthlyRequestLimit()
+	case apikey.FieldOrganizationID:
+		return m.AddedOrganizationID()
 	}
 	return nil, false
 }
@@ -963,6 +1511,48 @@ func (m *APIKeyMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *APIKeyMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case apikey.FieldDailyLimitUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDailyLimitUsd(v)
+		return nil
+	case apikey.FieldMonthlyLimitUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMonthlyLimitUsd(v)
+		return nil
+	case apikey.FieldTotalLimitUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalLimitUsd(v)
+		return nil
+	case apikey.FieldDailyRequestLimit:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDailyRequestLimit(v)
+		return nil
+	case apikey.FieldMonthlyRequestLimit:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMonthlyRequestLimit(v)
+		return nil
+	case apikey.FieldOrganizationID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOrganizationID(v)
+		return nil
 	}
 	return fmt.Errorf("unknown APIKey numeric field %s", name)
 }
@@ -983,6 +1573,24 @@ func (m *APIKeyMutation) ClearedFields() []string {
 	if m.FieldCleared(apikey.FieldIPBlacklist) {
 		fields = append(fields, apikey.FieldIPBlacklist)
 	}
+	if m.FieldCleared(apikey.FieldDailyLimitUsd) {
+		fields = append(fields, apikey.FieldDailyLimitUsd)
+	}
+	if m.FieldCleared(apikey.FieldMonthlyLimitUsd) {
+		fields = append(fields, apikey.FieldMonthlyLimitUsd)
+	}
+	if m.FieldCleared(apikey.FieldTotalLimitUsd) {
+		fields = append(fields, apikey.FieldTotalLimitUsd)
+	}
+	if m.FieldCleared(apikey.FieldDailyRequestLimit) {
+		fields = append(fields, apikey.FieldDailyRequestLimit)
+	}
+	if m.FieldCleared(apikey.FieldMonthlyRequestLimit) {
+		fields = append(fields, apikey.FieldMonthlyRequestLimit)
+	}
+	if m.FieldCleared(apikey.FieldOrganizationID) {
+		fields = append(fields, apikey.FieldOrganizationID)
+	}
 	return fields
 }
 
@@ -1009,6 +1617,24 @@ func (m *APIKeyMutation) ClearField(name string) error {
 	case apikey.FieldIPBlacklist:
 		m.ClearIPBlacklist()
 		return nil
+	case apikey.FieldDailyLimitUsd:
+		m.ClearDailyLimitUsd()
+		return nil
+	case apikey.FieldMonthlyLimitUsd:
+		m.ClearMonthlyLimitUsd()
+		return nil
+	case apikey.FieldTotalLimitUsd:
+		m.ClearTotalLimitUsd()
+		return nil
+	case apikey.FieldDailyRequestLimit:
+		m.ClearDailyRequestLimit()
+		return nil
+	case apikey.FieldMonthlyRequestLimit:
+		m.ClearMonthlyRequestLimit()
+		return nil
+	case apikey.FieldOrganizationID:
+		m.ClearOrganizationID()
+		return nil
 	}
 	return fmt.Errorf("unknown APIKey nullable field %s", name)
 }
@@ -1047,6 +1673,24 @@ func (m *APIKeyMutation) ResetField(name string) error {
 	case apikey.FieldIPBlacklist:
 		m.ResetIPBlacklist()
 		return nil
+	case apikey.FieldDailyLimitUsd:
+		m.ResetDailyLimitUsd()
+		return nil
+	case apikey.FieldMonthlyLimitUsd:
+		m.ResetMonthlyLimitUsd()
+		return nil
+	case apikey.FieldTotalLimitUsd:
+		m.ResetTotalLimitUsd()
+		return nil
+	case apikey.FieldDailyRequestLimit:
+		m.ResetDailyRequestLimit()
+		return nil
+	case apikey.FieldMonthlyRequestLimit:
+		m.ResetMonthlyRequestLimit()
+		return nil
+	case apikey.FieldOrganizationID:
+		m.ResetOrganizationID()
+		return nil
 	}
 	return fmt.Errorf("unknown APIKey field %s", name)
 }
@@ -1174,48 +1818,51 @@ func (m *APIKeyMutation) ResetEdge(name string) error {
 // AccountMutation represents an operation that mutates the Account nodes in the graph.
 type AccountMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *int64
-	created_at            *time.Time
-	updated_at            *time.Time
-	deleted_at            *time.Time
-	name                  *string
-	notes                 *string
-	platform              *string
-	_type                 *string
-	credentials           *map[string]interface{}
-	extra                 *map[string]interface{}
-	concurrency           *int
-	addconcurrency        *int
-	priority              *int
-	addpriority           *int
-	rate_multiplier       *float64
-	addrate_multiplier    *float64
-	status                *string
-	error_message         *string
-	last_used_at          *time.Time
-	expires_at            *time.Time
-	auto_pause_on_expired *bool
-	schedulable           *bool
-	rate_limited_at       *time.Time
-	rate_limit_reset_at   *time.Time
-	overload_until        *time.Time
-	session_window_start  *time.Time
-	session_window_end    *time.Time
-	session_window_status *string
-	clearedFields         map[string]struct{}
-	groups                map[int64]struct{}
-	removedgroups         map[int64]struct{}
-	clearedgroups         bool
-	proxy                 *int64
-	clearedproxy          bool
-	usage_logs            map[int64]struct{}
-	removedusage_logs     map[int64]struct{}
-	clearedusage_logs     bool
-	done                  bool
-	oldValue              func(context.Context) (*Account, error)
-	predicates            []predicate.Account
+	op                         Op
+	typ                        string
+	id                         *int64
+	created_at                 *time.Time
+	updated_at                 *time.Time
+	deleted_at                 *time.Time
+	name                       *string
+	notes                      *string
+	platform                   *string
+	_type                      *string
+	credentials                *map[string]interface{}
+	extra                      *map[string]interface{}
+	concurrency                *int
+	addconcurrency             *int
+	priority                   *int
+	addpriority                *int
+	rate_multiplier            *float64
+	addrate_multiplier         *float64
+	status                     *string
+	error_message              *string
+	last_used_at               *time.Time
+	expires_at                 *time.Time
+	auto_pause_on_expired      *bool
+	schedulable                *bool
+	rate_limited_at            *time.Time
+	rate_limit_reset_at        *time.Time
+	overload_until             *time.Time
+	session_window_start       *time.Time
+	session_window_end         *time.Time
+	session_window_status      *string
+	availability_windows       *[]string
+	appendavailability_windows []string
+	availability_timezone      *string
+	clearedFields              map[string]struct{}
+	groups                     map[int64]struct{}
+	removedgroups              map[int64]struct{}
+	clearedgroups              bool
+	proxy                      *int64
+	clearedproxy               bool
+	usage_logs                 map[int64]struct{}
+	removedusage_logs          map[int64]struct{}
+	clearedusage_logs          bool
+	done                       bool
+	oldValue                   func(context.Context) (*Account, error)
+	predicates                 []predicate.Account
 }
 
 var _ ent.Mutation = (*AccountMutation)(nil)
@@ -2432,6 +3079,120 @@ func (m *AccountMutation) ResetSessionWindowStatus() {
 	delete(m.clearedFields, account.FieldSessionWindowStatus)
 }
 
+// SetAvailabilityWindows sets the "availability_windows" field.
+func (m *AccountMutation) SetAvailabilityWindows(s []string) {
+	m.availability_windows = &s
+	m.appendavailability_windows = nil
+}
+
+// AvailabilityWindows returns the value of the "availability_windows" field in the mutation.
+func (m *AccountMutation) AvailabilityWindows() (r []string, exists bool) {
+	v := m.availability_windows
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvailabilityWindows returns the old "availability_windows" field's value of the Account entity.
+// If the Account object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccountMutation) OldAvailabilityWindows(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvailabilityWindows is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvailabilityWindows requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvailabilityWindows: %w", err)
+	}
+	return oldValue.AvailabilityWindows, nil
+}
+
+// AppendAvailabilityWindows adds s to the "availability_windows" field.
+func (m *AccountMutation) AppendAvailabilityWindows(s []string) {
+	m.appendavailability_windows = append(m.appendavailability_windows, s...)
+}
+
+// AppendedAvailabilityWindows returns the list of values that were appended to the "availability_windows" field in this mutation.
+func (m *AccountMutation) AppendedAvailabilityWindows() ([]string, bool) {
+	if len(m.appendavailability_windows) == 0 {
+		return nil, false
+	}
+	return m.appendavailability_windows, true
+}
+
+// ClearAvailabilityWindows clears the value of the "availability_windows" field.
+func (m *AccountMutation) ClearAvailabilityWindows() {
+	m.availability_windows = nil
+	m.appendavailability_windows = nil
+	m.clearedFields[account.FieldAvailabilityWindows] = struct{}{}
+}
+
+// AvailabilityWindowsCleared returns if the "availability_windows" field was cleared in this mutation.
+func (m *AccountMutation) AvailabilityWindowsCleared() bool {
+	_, ok := m.clearedFields[account.FieldAvailabilityWindows]
+	return ok
+}
+
+// ResetAvailabilityWindows resets all changes to the "availability_windows" field.
+func (m *AccountMutation) ResetAvailabilityWindows() {
+	m.availability_windows = nil
+	m.appendavailability_windows = nil
+	delete(m.clearedFields, account.FieldAvailabilityWindows)
+}
+
+// SetAvailabilityTimezone sets the "availability_timezone" field.
+func (m *AccountMutation) SetAvailabilityTimezone(s string) {
+	m.availability_timezone = &s
+}
+
+// AvailabilityTimezone returns the value of the "availability_timezone" field in the mutation.
+func (m *AccountMutation) AvailabilityTimezone() (r string, exists bool) {
+	v := m.availability_timezone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvailabilityTimezone returns the old "availability_timezone" field's value of the Account entity.
+// If the Account object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccountMutation) OldAvailabilityTimezone(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvailabilityTimezone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvailabilityTimezone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvailabilityTimezone: %w", err)
+	}
+	return oldValue.AvailabilityTimezone, nil
+}
+
+// ClearAvailabilityTimezone clears the value of the "availability_timezone" field.
+func (m *AccountMutation) ClearAvailabilityTimezone() {
+	m.availability_timezone = nil
+	m.clearedFields[account.FieldAvailabilityTimezone] = struct{}{}
+}
+
+// AvailabilityTimezoneCleared returns if the "availability_timezone" field was cleared in this mutation.
+func (m *AccountMutation) AvailabilityTimezoneCleared() bool {
+	_, ok := m.clearedFields[account.FieldAvailabilityTimezone]
+	return ok
+}
+
+// ResetAvailabilityTimezone resets all changes to the "availability_timezone" field.
+func (m *AccountMutation) ResetAvailabilityTimezone() {
+	m.availability_timezone = nil
+	delete(m.clearedFields, account.FieldAvailabilityTimezone)
+}
+
 // AddGroupIDs adds the "groups" edge to the Group entity by ids.
 func (m *AccountMutation) AddGroupIDs(ids ...int64) {
 	if m.groups == nil {
@@ -2601,7 +3362,7 @@ func (m *AccountMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *AccountMutation) Fields() []string {
-	fields := make([]string, 0, 25)
+	fields := make([]string, 0, 27)
 	if m.created_at != nil {
 		fields = append(fields, account.FieldCreatedAt)
 	}
@@ -2677,6 +3438,12 @@ func (m *AccountMutation) Fields() []string {
 	if m.session_window_status != nil {
 		fields = append(fields, account.FieldSessionWindowStatus)
 	}
+	if m.availability_windows != nil {
+		fields = append(fields, account.FieldAvailabilityWindows)
+	}
+	if m.availability_timezone != nil {
+		fields = append(fields, account.FieldAvailabilityTimezone)
+	}
 	return fields
 }
 
@@ -2735,6 +3502,10 @@ func (m *AccountMutation) Field(name string) (ent.Value, bool) {
 		return m.SessionWindowEnd()
 	case account.FieldSessionWindowStatus:
 		return m.SessionWindowStatus()
+	case account.FieldAvailabilityWindows:
+		return m.AvailabilityWindows()
+	case account.FieldAvailabilityTimezone:
+		return m.AvailabilityTimezone()
 	}
 	return nil, false
 }
@@ -2794,6 +3565,10 @@ func (m *AccountMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldSessionWindowEnd(ctx)
 	case account.FieldSessionWindowStatus:
 		return m.OldSessionWindowStatus(ctx)
+	case account.FieldAvailabilityWindows:
+		return m.OldAvailabilityWindows(ctx)
+	case account.FieldAvailabilityTimezone:
+		return m.OldAvailabilityTimezone(ctx)
 	}
 	return nil, fmt.Errorf("unknown Account field %s", name)
 }
@@ -2978,6 +3753,20 @@ func (m *AccountMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetSessionWindowStatus(v)
 		return nil
+	case account.FieldAvailabilityWindows:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvailabilityWindows(v)
+		return nil
+	case account.FieldAvailabilityTimezone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvailabilityTimezone(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Account field %s", name)
 }
@@ -3083,6 +3872,12 @@ func (m *AccountMutation) ClearedFields() []string {
 	if m.FieldCleared(account.FieldSessionWindowStatus) {
 		fields = append(fields, account.FieldSessionWindowStatus)
 	}
+	if m.FieldCleared(account.FieldAvailabilityWindows) {
+		fields = append(fields, account.FieldAvailabilityWindows)
+	}
+	if m.FieldCleared(account.FieldAvailabilityTimezone) {
+		fields = append(fields, account.FieldAvailabilityTimezone)
+	}
 	return fields
 }
 
@@ -3133,6 +3928,12 @@ func (m *AccountMutation) ClearField(name string) error {
 	case account.FieldSessionWindowStatus:
 		m.ClearSessionWindowStatus()
 		return nil
+	case account.FieldAvailabilityWindows:
+		m.ClearAvailabilityWindows()
+		return nil
+	case account.FieldAvailabilityTimezone:
+		m.ClearAvailabilityTimezone()
+		return nil
 	}
 	return fmt.Errorf("unknown Account nullable field %s", name)
 }
@@ -3216,6 +4017,12 @@ func (m *AccountMutation) ResetField(name string) error {
 	case account.FieldSessionWindowStatus:
 		m.ResetSessionWindowStatus()
 		return nil
+	case account.FieldAvailabilityWindows:
+		m.ResetAvailabilityWindows()
+		return nil
+	case account.FieldAvailabilityTimezone:
+		m.ResetAvailabilityTimezone()
+		return nil
 	}
 	return fmt.Errorf("unknown Account field %s", name)
 }
@@ -3355,6 +4162,7 @@ type AccountGroupMutation struct {
 	typ            string
 	priority       *int
 	addpriority    *int
+	pool           *string
 	created_at     *time.Time
 	clearedFields  map[string]struct{}
 	account        *int64
@@ -3481,6 +4289,25 @@ func (m *AccountGroupMutation) ResetPriority() {
 	m.addpriority = nil
 }
 
+// SetPool sets the "pool" field.
+func (m *AccountGroupMutation) SetPool(s string) {
+	m.pool = &s
+}
+
+// Pool returns the value of the "pool" field in the mutation.
+func (m *AccountGroupMutation) Pool() (r string, exists bool) {
+	v := m.pool
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPool resets all changes to the "pool" field.
+func (m *AccountGroupMutation) ResetPool() {
+	m.pool = nil
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *AccountGroupMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -3588,7 +4415,7 @@ func (m *AccountGroupMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *AccountGroupMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+	fields := make([]string, 0, 5)
 	if m.account != nil {
 		fields = append(fields, accountgroup.FieldAccountID)
 	}
@@ -3598,6 +4425,9 @@ func (m *AccountGroupMutation) Fields() []string {
 	if m.priority != nil {
 		fields = append(fields, accountgroup.FieldPriority)
 	}
+	if m.pool != nil {
+		fields = append(fields, accountgroup.FieldPool)
+	}
 	if m.created_at != nil {
 		fields = append(fields, accountgroup.FieldCreatedAt)
 	}
@@ -3615,6 +4445,8 @@ func (m *AccountGroupMutation) Field(name string) (ent.Value, bool) {
 		return m.GroupID()
 	case accountgroup.FieldPriority:
 		return m.Priority()
+	case accountgroup.FieldPool:
+		return m.Pool()
 	case accountgroup.FieldCreatedAt:
 		return m.CreatedAt()
 	}
@@ -3654,6 +4486,13 @@ func (m *AccountGroupMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetPriority(v)
 		return nil
+	case accountgroup.FieldPool:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPool(v)
+		return nil
 	case accountgroup.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -3734,6 +4573,9 @@ func (m *AccountGroupMutation) ResetField(name string) error {
 	case accountgroup.FieldPriority:
 		m.ResetPriority()
 		return nil
+	case accountgroup.FieldPool:
+		m.ResetPool()
+		return nil
 	case accountgroup.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
@@ -3836,61 +4678,79 @@ func (m *AccountGroupMutation) ResetEdge(name string) error {
 // GroupMutation represents an operation that mutates the Group nodes in the graph.
 type GroupMutation struct {
 	config
-	op                       Op
-	typ                      string
-	id                       *int64
-	created_at               *time.Time
-	updated_at               *time.Time
-	deleted_at               *time.Time
-	name                     *string
-	description              *string
-	rate_multiplier          *float64
-	addrate_multiplier       *float64
-	is_exclusive             *bool
-	status                   *string
-	platform                 *string
-	subscription_type        *string
-	daily_limit_usd          *float64
-	adddaily_limit_usd       *float64
-	weekly_limit_usd         *float64
-	addweekly_limit_usd      *float64
-	monthly_limit_usd        *float64
-	addmonthly_limit_usd     *float64
-	default_validity_days    *int
-	adddefault_validity_days *int
-	image_price_1k           *float64
-	addimage_price_1k        *float64
-	image_price_2k           *float64
-	addimage_price_2k        *float64
-	image_price_4k           *float64
-	addimage_price_4k        *float64
-	claude_code_only         *bool
-	fallback_group_id        *int64
-	addfallback_group_id     *int64
-	model_routing            *map[string][]int64
-	model_routing_enabled    *bool
-	clearedFields            map[string]struct{}
-	api_keys                 map[int64]struct{}
-	removedapi_keys          map[int64]struct{}
-	clearedapi_keys          bool
-	redeem_codes             map[int64]struct{}
-	removedredeem_codes      map[int64]struct{}
-	clearedredeem_codes      bool
-	subscriptions            map[int64]struct{}
-	removedsubscriptions     map[int64]struct{}
-	clearedsubscriptions     bool
-	usage_logs               map[int64]struct{}
-	removedusage_logs        map[int64]struct{}
-	clearedusage_logs        bool
-	accounts                 map[int64]struct{}
-	removedaccounts          map[int64]struct{}
-	clearedaccounts          bool
-	allowed_users            map[int64]struct{}
-	removedallowed_users     map[int64]struct{}
-	clearedallowed_users     bool
-	done                     bool
-	oldValue                 func(context.Context) (*Group, error)
-	predicates               []predicate.Group
+	op                                Op
+	typ                               string
+	id                                *int64
+	created_at                        *time.Time
+	updated_at                        *time.Time
+	deleted_at                        *time.Time
+	name                              *string
+	description                       *string
+	rate_multiplier                   *float64
+	addrate_multiplier                *float64
+	is_exclusive                      *bool
+	status                            *string
+	platform                          *string
+	subscription_type                 *string
+	daily_limit_usd                   *float64
+	adddaily_limit_usd                *float64
+	weekly_limit_usd                  *float64
+	addweekly_limit_usd               *float64
+	monthly_limit_usd                 *float64
+	addmonthly_limit_usd              *float64
+	default_validity_days             *int
+	adddefault_validity_days          *int
+	image_price_1k                    *float64
+	addimage_price_1k                 *float64
+	image_price_2k                    *float64
+	addimage_price_2k                 *float64
+	image_price_4k                    *float64
+	addimage_price_4k                 *float64
+	claude_code_only                  *bool
+	fallback_group_id                 *int64
+	addfallback_group_id              *int64
+	model_routing                     *map[string][]int64
+	model_routing_enabled             *bool
+	pool_split_enabled                *bool
+	pool_weights                      *map[string]int
+	canary_max_error_rate             *float64
+	addcanary_max_error_rate          *float64
+	capacity_fallback_group_ids       *[]int64
+	appendcapacity_fallback_group_ids []int64
+	fallback_model_mapping            *map[string]string
+	volume_tiers                      *[]volumetier.Tier
+	appendvolume_tiers                []volumetier.Tier
+	overage_enabled                   *bool
+	overage_rate_multiplier           *float64
+	addoverage_rate_multiplier        *float64
+	rolling_window_hours              *int
+	addrolling_window_hours           *int
+	rolling_window_limit_usd          *float64
+	addrolling_window_limit_usd       *float64
+	usage_quotas                      *[]usagequota.Quota
+	appendusage_quotas                []usagequota.Quota
+	clearedFields                     map[string]struct{}
+	api_keys                          map[int64]struct{}
+	removedapi_keys                   map[int64]struct{}
+	clearedapi_keys                   bool
+	redeem_codes                      map[int64]struct{}
+	removedredeem_codes               map[int64]struct{}
+	clearedredeem_codes               bool
+	subscriptions                     map[int64]struct{}
+	removedsubscriptions              map[int64]struct{}
+	clearedsubscriptions              bool
+	usage_logs                        map[int64]struct{}
+	removedusage_logs                 map[int64]struct{}
+	clearedusage_logs                 bool
+	accounts                          map[int64]struct{}
+	removedaccounts                   map[int64]struct{}
+	clearedaccounts                   bool
+	allowed_users                     map[int64]struct{}
+	removedallowed_users              map[int64]struct{}
+	clearedallowed_users              bool
+	done                              bool
+	oldValue                          func(context.Context) (*Group, error)
+	predicates                        []predicate.Group
 }
 
 var _ ent.Mutation = (*GroupMutation)(nil)
@@ -5064,478 +5924,3385 @@ func (m *GroupMutation) ResetModelRoutingEnabled() {
 	m.model_routing_enabled = nil
 }
 
-// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by ids.
-func (m *GroupMutation) AddAPIKeyIDs(ids ...int64) {
-	if m.api_keys == nil {
-		m.api_keys = make(map[int64]struct{})
-	}
-	for i := range ids {
-		m.api_keys[ids[i]] = struct{}{}
-	}
-}
-
-// ClearAPIKeys clears the "api_keys" edge to the APIKey entity.
-func (m *GroupMutation) ClearAPIKeys() {
-	m.clearedapi_keys = true
+// SetPoolSplitEnabled sets the "pool_split_enabled" field.
+func (m *GroupMutation) SetPoolSplitEnabled(b bool) {
+	m.pool_split_enabled = &b
 }
 
-// APIKeysCleared reports if the "api_keys" edge to the APIKey entity was cleared.
-func (m *GroupMutation) APIKeysCleared() bool {
-	return m.clearedapi_keys
+// PoolSplitEnabled returns the value of the "pool_split_enabled" field in the mutation.
+func (m *GroupMutation) PoolSplitEnabled() (r bool, exists bool) {
+	v := m.pool_split_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveAPIKeyIDs removes the "api_keys" edge to the APIKey entity by IDs.
-func (m *GroupMutation) RemoveAPIKeyIDs(ids ...int64) {
-	if m.removedapi_keys == nil {
-		m.removedapi_keys = make(map[int64]struct{})
+// OldPoolSplitEnabled returns the old "pool_split_enabled" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldPoolSplitEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPoolSplitEnabled is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.api_keys, ids[i])
-		m.removedapi_keys[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPoolSplitEnabled requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPoolSplitEnabled: %w", err)
+	}
+	return oldValue.PoolSplitEnabled, nil
 }
 
-// RemovedAPIKeys returns the removed IDs of the "api_keys" edge to the APIKey entity.
-func (m *GroupMutation) RemovedAPIKeysIDs() (ids []int64) {
-	for id := range m.removedapi_keys {
-		ids = append(ids, id)
-	}
-	return
+// ResetPoolSplitEnabled resets all changes to the "pool_split_enabled" field.
+func (m *GroupMutation) ResetPoolSplitEnabled() {
+	m.pool_split_enabled = nil
 }
 
-// APIKeysIDs returns the "api_keys" edge IDs in the mutation.
-func (m *GroupMutation) APIKeysIDs() (ids []int64) {
-	for id := range m.api_keys {
-		ids = append(ids, id)
-	}
-	return
+// SetPoolWeights sets the "pool_weights" field.
+func (m *GroupMutation) SetPoolWeights(value map[string]int) {
+	m.pool_weights = &value
 }
 
-// ResetAPIKeys resets all changes to the "api_keys" edge.
-func (m *GroupMutation) ResetAPIKeys() {
-	m.api_keys = nil
-	m.clearedapi_keys = false
-	m.removedapi_keys = nil
+// PoolWeights returns the value of the "pool_weights" field in the mutation.
+func (m *GroupMutation) PoolWeights() (r map[string]int, exists bool) {
+	v := m.pool_weights
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddRedeemCodeIDs adds the "redeem_codes" edge to the RedeemCode entity by ids.
-func (m *GroupMutation) AddRedeemCodeIDs(ids ...int64) {
-	if m.redeem_codes == nil {
-		m.redeem_codes = make(map[int64]struct{})
+// OldPoolWeights returns the old "pool_weights" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldPoolWeights(ctx context.Context) (v map[string]int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPoolWeights is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.redeem_codes[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPoolWeights requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPoolWeights: %w", err)
 	}
+	return oldValue.PoolWeights, nil
 }
 
-// ClearRedeemCodes clears the "redeem_codes" edge to the RedeemCode entity.
-func (m *GroupMutation) ClearRedeemCodes() {
-	m.clearedredeem_codes = true
+// ClearPoolWeights clears the value of the "pool_weights" field.
+func (m *GroupMutation) ClearPoolWeights() {
+	m.pool_weights = nil
+	m.clearedFields[group.FieldPoolWeights] = struct{}{}
 }
 
-// RedeemCodesCleared reports if the "redeem_codes" edge to the RedeemCode entity was cleared.
-func (m *GroupMutation) RedeemCodesCleared() bool {
-	return m.clearedredeem_codes
+// PoolWeightsCleared returns if the "pool_weights" field was cleared in this mutation.
+func (m *GroupMutation) PoolWeightsCleared() bool {
+	_, ok := m.clearedFields[group.FieldPoolWeights]
+	return ok
 }
 
-// RemoveRedeemCodeIDs removes the "redeem_codes" edge to the RedeemCode entity by IDs.
-func (m *GroupMutation) RemoveRedeemCodeIDs(ids ...int64) {
-	if m.removedredeem_codes == nil {
-		m.removedredeem_codes = make(map[int64]struct{})
+// ResetPoolWeights resets all changes to the "pool_weights" field.
+func (m *GroupMutation) ResetPoolWeights() {
+	m.pool_weights = nil
+	delete(m.clearedFields, group.FieldPoolWeights)
+}
+
+// SetCanaryMaxErrorRate sets the "canary_max_error_rate" field.
+func (m *GroupMutation) SetCanaryMaxErrorRate(f float64) {
+	m.canary_max_error_rate = &f
+	m.addcanary_max_error_rate = nil
+}
+
+// CanaryMaxErrorRate returns the value of the "canary_max_error_rate" field in the mutation.
+func (m *GroupMutation) CanaryMaxErrorRate() (r float64, exists bool) {
+	v := m.canary_max_error_rate
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.redeem_codes, ids[i])
-		m.removedredeem_codes[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldCanaryMaxErrorRate returns the old "canary_max_error_rate" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldCanaryMaxErrorRate(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCanaryMaxErrorRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCanaryMaxErrorRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCanaryMaxErrorRate: %w", err)
 	}
+	return oldValue.CanaryMaxErrorRate, nil
 }
 
-// RemovedRedeemCodes returns the removed IDs of the "redeem_codes" edge to the RedeemCode entity.
-func (m *GroupMutation) RemovedRedeemCodesIDs() (ids []int64) {
-	for id := range m.removedredeem_codes {
-		ids = append(ids, id)
+// AddCanaryMaxErrorRate adds f to the "canary_max_error_rate" field.
+func (m *GroupMutation) AddCanaryMaxErrorRate(f float64) {
+	if m.addcanary_max_error_rate != nil {
+		*m.addcanary_max_error_rate += f
+	} else {
+		m.addcanary_max_error_rate = &f
 	}
-	return
 }
 
-// RedeemCodesIDs returns the "redeem_codes" edge IDs in the mutation.
-func (m *GroupMutation) RedeemCodesIDs() (ids []int64) {
-	for id := range m.redeem_codes {
-		ids = append(ids, id)
+// AddedCanaryMaxErrorRate returns the value that was added to the "canary_max_error_rate" field in this mutation.
+func (m *GroupMutation) AddedCanaryMaxErrorRate() (r float64, exists bool) {
+	v := m.addcanary_max_error_rate
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetRedeemCodes resets all changes to the "redeem_codes" edge.
-func (m *GroupMutation) ResetRedeemCodes() {
-	m.redeem_codes = nil
-	m.clearedredeem_codes = false
-	m.removedredeem_codes = nil
+// ClearCanaryMaxErrorRate clears the value of the "canary_max_error_rate" field.
+func (m *GroupMutation) ClearCanaryMaxErrorRate() {
+	m.canary_max_error_rate = nil
+	m.addcanary_max_error_rate = nil
+	m.clearedFields[group.FieldCanaryMaxErrorRate] = struct{}{}
 }
 
-// AddSubscriptionIDs adds the "subscriptions" edge to the UserSubscription entity by ids.
-func (m *GroupMutation) AddSubscriptionIDs(ids ...int64) {
-	if m.subscriptions == nil {
-		m.subscriptions = make(map[int64]struct{})
-	}
-	for i := range ids {
-		m.subscriptions[ids[i]] = struct{}{}
-	}
+// CanaryMaxErrorRateCleared returns if the "canary_max_error_rate" field was cleared in this mutation.
+func (m *GroupMutation) CanaryMaxErrorRateCleared() bool {
+	_, ok := m.clearedFields[group.FieldCanaryMaxErrorRate]
+	return ok
 }
 
-// ClearSubscriptions clears the "subscriptions" edge to the UserSubscription entity.
-func (m *GroupMutation) ClearSubscriptions() {
-	m.clearedsubscriptions = true
+// ResetCanaryMaxErrorRate resets all changes to the "canary_max_error_rate" field.
+func (m *GroupMutation) ResetCanaryMaxErrorRate() {
+	m.canary_max_error_rate = nil
+	m.addcanary_max_error_rate = nil
+	delete(m.clearedFields, group.FieldCanaryMaxErrorRate)
 }
 
-// SubscriptionsCleared reports if the "subscriptions" edge to the UserSubscription entity was cleared.
-func (m *GroupMutation) SubscriptionsCleared() bool {
-	return m.clearedsubscriptions
+// SetCapacityFallbackGroupIds sets the "capacity_fallback_group_ids" field.
+func (m *GroupMutation) SetCapacityFallbackGroupIds(i []int64) {
+	m.capacity_fallback_group_ids = &i
+	m.appendcapacity_fallback_group_ids = nil
 }
 
-// RemoveSubscriptionIDs removes the "subscriptions" edge to the UserSubscription entity by IDs.
-func (m *GroupMutation) RemoveSubscriptionIDs(ids ...int64) {
-	if m.removedsubscriptions == nil {
-		m.removedsubscriptions = make(map[int64]struct{})
-	}
-	for i := range ids {
-		delete(m.subscriptions, ids[i])
-		m.removedsubscriptions[ids[i]] = struct{}{}
+// CapacityFallbackGroupIds returns the value of the "capacity_fallback_group_ids" field in the mutation.
+func (m *GroupMutation) CapacityFallbackGroupIds() (r []int64, exists bool) {
+	v := m.capacity_fallback_group_ids
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedSubscriptions returns the removed IDs of the "subscriptions" edge to the UserSubscription entity.
-func (m *GroupMutation) RemovedSubscriptionsIDs() (ids []int64) {
-	for id := range m.removedsubscriptions {
-		ids = append(ids, id)
+// OldCapacityFallbackGroupIds returns the old "capacity_fallback_group_ids" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldCapacityFallbackGroupIds(ctx context.Context) (v []int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCapacityFallbackGroupIds is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCapacityFallbackGroupIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCapacityFallbackGroupIds: %w", err)
+	}
+	return oldValue.CapacityFallbackGroupIds, nil
 }
 
-// SubscriptionsIDs returns the "subscriptions" edge IDs in the mutation.
-func (m *GroupMutation) SubscriptionsIDs() (ids []int64) {
-	for id := range m.subscriptions {
-		ids = append(ids, id)
+// AppendCapacityFallbackGroupIds adds i to the "capacity_fallback_group_ids" field.
+func (m *GroupMutation) AppendCapacityFallbackGroupIds(i []int64) {
+	m.appendcapacity_fallback_group_ids = append(m.appendcapacity_fallback_group_ids, i...)
+}
+
+// AppendedCapacityFallbackGroupIds returns the list of values that were appended to the "capacity_fallback_group_ids" field in this mutation.
+func (m *GroupMutation) AppendedCapacityFallbackGroupIds() ([]int64, bool) {
+	if len(m.appendcapacity_fallback_group_ids) == 0 {
+		return nil, false
 	}
-	return
+	return m.appendcapacity_fallback_group_ids, true
 }
 
-// ResetSubscriptions resets all changes to the "subscriptions" edge.
-func (m *GroupMutation) ResetSubscriptions() {
-	m.subscriptions = nil
-	m.clearedsubscriptions = false
-	m.removedsubscriptions = nil
+// ClearCapacityFallbackGroupIds clears the value of the "capacity_fallback_group_ids" field.
+func (m *GroupMutation) ClearCapacityFallbackGroupIds() {
+	m.capacity_fallback_group_ids = nil
+	m.appendcapacity_fallback_group_ids = nil
+	m.clearedFields[group.FieldCapacityFallbackGroupIds] = struct{}{}
 }
 
-// AddUsageLogIDs adds the "usage_logs" edge to the UsageLog entity by ids.
-func (m *GroupMutation) AddUsageLogIDs(ids ...int64) {
-	if m.usage_logs == nil {
-		m.usage_logs = make(map[int64]struct{})
-	}
-	for i := range ids {
-		m.usage_logs[ids[i]] = struct{}{}
-	}
+// CapacityFallbackGroupIdsCleared returns if the "capacity_fallback_group_ids" field was cleared in this mutation.
+func (m *GroupMutation) CapacityFallbackGroupIdsCleared() bool {
+	_, ok := m.clearedFields[group.FieldCapacityFallbackGroupIds]
+	return ok
 }
 
-// ClearUsageLogs clears the "usage_logs" edge to the UsageLog entity.
-func (m *GroupMutation) ClearUsageLogs() {
-	m.clearedusage_logs = true
+// ResetCapacityFallbackGroupIds resets all changes to the "capacity_fallback_group_ids" field.
+func (m *GroupMutation) ResetCapacityFallbackGroupIds() {
+	m.capacity_fallback_group_ids = nil
+	m.appendcapacity_fallback_group_ids = nil
+	delete(m.clearedFields, group.FieldCapacityFallbackGroupIds)
 }
 
-// UsageLogsCleared reports if the "usage_logs" edge to the UsageLog entity was cleared.
-func (m *GroupMutation) UsageLogsCleared() bool {
-	return m.clearedusage_logs
+// SetFallbackModelMapping sets the "fallback_model_mapping" field.
+func (m *GroupMutation) SetFallbackModelMapping(value map[string]string) {
+	m.fallback_model_mapping = &value
 }
 
-// RemoveUsageLogIDs removes the "usage_logs" edge to the UsageLog entity by IDs.
-func (m *GroupMutation) RemoveUsageLogIDs(ids ...int64) {
-	if m.removedusage_logs == nil {
-		m.removedusage_logs = make(map[int64]struct{})
-	}
-	for i := range ids {
-		delete(m.usage_logs, ids[i])
-		m.removedusage_logs[ids[i]] = struct{}{}
+// FallbackModelMapping returns the value of the "fallback_model_mapping" field in the mutation.
+func (m *GroupMutation) FallbackModelMapping() (r map[string]string, exists bool) {
+	v := m.fallback_model_mapping
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedUsageLogs returns the removed IDs of the "usage_logs" edge to the UsageLog entity.
-func (m *GroupMutation) RemovedUsageLogsIDs() (ids []int64) {
-	for id := range m.removedusage_logs {
-		ids = append(ids, id)
+// OldFallbackModelMapping returns the old "fallback_model_mapping" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldFallbackModelMapping(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFallbackModelMapping is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFallbackModelMapping requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFallbackModelMapping: %w", err)
+	}
+	return oldValue.FallbackModelMapping, nil
 }
 
-// UsageLogsIDs returns the "usage_logs" edge IDs in the mutation.
-func (m *GroupMutation) UsageLogsIDs() (ids []int64) {
-	for id := range m.usage_logs {
-		ids = append(ids, id)
-	}
-	return
+// ClearFallbackModelMapping clears the value of the "fallback_model_mapping" field.
+func (m *GroupMutation) ClearFallbackModelMapping() {
+	m.fallback_model_mapping = nil
+	m.clearedFields[group.FieldFallbackModelMapping] = struct{}{}
 }
 
-// ResetUsageLogs resets all changes to the "usage_logs" edge.
-func (m *GroupMutation) ResetUsageLogs() {
-	m.usage_logs = nil
-	m.clearedusage_logs = false
-	m.removedusage_logs = nil
+// FallbackModelMappingCleared returns if the "fallback_model_mapping" field was cleared in this mutation.
+func (m *GroupMutation) FallbackModelMappingCleared() bool {
+	_, ok := m.clearedFields[group.FieldFallbackModelMapping]
+	return ok
 }
 
-// AddAccountIDs adds the "accounts" edge to the Account entity by ids.
-func (m *GroupMutation) AddAccountIDs(ids ...int64) {
-	if m.accounts == nil {
-		m.accounts = make(map[int64]struct{})
-	}
-	for i := range ids {
-		m.accounts[ids[i]] = struct{}{}
-	}
+// ResetFallbackModelMapping resets all changes to the "fallback_model_mapping" field.
+func (m *GroupMutation) ResetFallbackModelMapping() {
+	m.fallback_model_mapping = nil
+	delete(m.clearedFields, group.FieldFallbackModelMapping)
 }
 
-// ClearAccounts clears the "accounts" edge to the Account entity.
-func (m *GroupMutation) ClearAccounts() {
-	m.clearedaccounts = true
+// SetVolumeTiers sets the "volume_tiers" field.
+func (m *GroupMutation) SetVolumeTiers(v []volumetier.Tier) {
+	m.volume_tiers = &v
+	m.appendvolume_tiers = nil
 }
 
-// AccountsCleared reports if the "accounts" edge to the Account entity was cleared.
-func (m *GroupMutation) AccountsCleared() bool {
-	return m.clearedaccounts
+// VolumeTiers returns the value of the "volume_tiers" field in the mutation.
+func (m *GroupMutation) VolumeTiers() (r []volumetier.Tier, exists bool) {
+	v := m.volume_tiers
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveAccountIDs removes the "accounts" edge to the Account entity by IDs.
-func (m *GroupMutation) RemoveAccountIDs(ids ...int64) {
-	if m.removedaccounts == nil {
-		m.removedaccounts = make(map[int64]struct{})
+// OldVolumeTiers returns the old "volume_tiers" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldVolumeTiers(ctx context.Context) (v []volumetier.Tier, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVolumeTiers is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.accounts, ids[i])
-		m.removedaccounts[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVolumeTiers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVolumeTiers: %w", err)
 	}
+	return oldValue.VolumeTiers, nil
 }
 
-// RemovedAccounts returns the removed IDs of the "accounts" edge to the Account entity.
-func (m *GroupMutation) RemovedAccountsIDs() (ids []int64) {
-	for id := range m.removedaccounts {
-		ids = append(ids, id)
-	}
-	return
+// AppendVolumeTiers adds v to the "volume_tiers" field.
+func (m *GroupMutation) AppendVolumeTiers(v []volumetier.Tier) {
+	m.appendvolume_tiers = append(m.appendvolume_tiers, v...)
 }
 
-// AccountsIDs returns the "accounts" edge IDs in the mutation.
-func (m *GroupMutation) AccountsIDs() (ids []int64) {
-	for id := range m.accounts {
-		ids = append(ids, id)
+// AppendedVolumeTiers returns the list of values that were appended to the "volume_tiers" field in this mutation.
+func (m *GroupMutation) AppendedVolumeTiers() ([]volumetier.Tier, bool) {
+	if len(m.appendvolume_tiers) == 0 {
+		return nil, false
 	}
-	return
+	return m.appendvolume_tiers, true
 }
 
-// ResetAccounts resets all changes to the "accounts" edge.
-func (m *GroupMutation) ResetAccounts() {
-	m.accounts = nil
-	m.clearedaccounts = false
-	m.removedaccounts = nil
+// ClearVolumeTiers clears the value of the "volume_tiers" field.
+func (m *GroupMutation) ClearVolumeTiers() {
+	m.volume_tiers = nil
+	m.appendvolume_tiers = nil
+	m.clearedFields[group.FieldVolumeTiers] = struct{}{}
 }
 
-// AddAllowedUserIDs adds the "allowed_users" edge to the User entity by ids.
-func (m *GroupMutation) AddAllowedUserIDs(ids ...int64) {
-	if m.allowed_users == nil {
-		m.allowed_users = make(map[int64]struct{})
-	}
-	for i := range ids {
-		m.allowed_users[ids[i]] = struct{}{}
-	}
+// VolumeTiersCleared returns if the "volume_tiers" field was cleared in this mutation.
+func (m *GroupMutation) VolumeTiersCleared() bool {
+	_, ok := m.clearedFields[group.FieldVolumeTiers]
+	return ok
 }
 
-// ClearAllowedUsers clears the "allowed_users" edge to the User entity.
-func (m *GroupMutation) ClearAllowedUsers() {
-	m.clearedallowed_users = true
+// ResetVolumeTiers resets all changes to the "volume_tiers" field.
+func (m *GroupMutation) ResetVolumeTiers() {
+	m.volume_tiers = nil
+	m.appendvolume_tiers = nil
+	delete(m.clearedFields, group.FieldVolumeTiers)
 }
 
-// AllowedUsersCleared reports if the "allowed_users" edge to the User entity was cleared.
-func (m *GroupMutation) AllowedUsersCleared() bool {
-	return m.clearedallowed_users
+// SetOverageEnabled sets the "overage_enabled" field.
+func (m *GroupMutation) SetOverageEnabled(b bool) {
+	m.overage_enabled = &b
 }
 
-// RemoveAllowedUserIDs removes the "allowed_users" edge to the User entity by IDs.
-func (m *GroupMutation) RemoveAllowedUserIDs(ids ...int64) {
-	if m.removedallowed_users == nil {
-		m.removedallowed_users = make(map[int64]struct{})
-	}
-	for i := range ids {
-		delete(m.allowed_users, ids[i])
-		m.removedallowed_users[ids[i]] = struct{}{}
+// OverageEnabled returns the value of the "overage_enabled" field in the mutation.
+func (m *GroupMutation) OverageEnabled() (r bool, exists bool) {
+	v := m.overage_enabled
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedAllowedUsers returns the removed IDs of the "allowed_users" edge to the User entity.
-func (m *GroupMutation) RemovedAllowedUsersIDs() (ids []int64) {
-	for id := range m.removedallowed_users {
-		ids = append(ids, id)
+// OldOverageEnabled returns the old "overage_enabled" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldOverageEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOverageEnabled is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOverageEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOverageEnabled: %w", err)
+	}
+	return oldValue.OverageEnabled, nil
 }
 
-// AllowedUsersIDs returns the "allowed_users" edge IDs in the mutation.
-func (m *GroupMutation) AllowedUsersIDs() (ids []int64) {
-	for id := range m.allowed_users {
-		ids = append(ids, id)
+// ResetOverageEnabled resets all changes to the "overage_enabled" field.
+func (m *GroupMutation) ResetOverageEnabled() {
+	m.overage_enabled = nil
+}
+
+// SetOverageRateMultiplier sets the "overage_rate_multiplier" field.
+func (m *GroupMutation) SetOverageRateMultiplier(f float64) {
+	m.overage_rate_multiplier = &f
+	m.addoverage_rate_multiplier = nil
+}
+
+// OverageRateMultiplier returns the value of the "overage_rate_multiplier" field in the mutation.
+func (m *GroupMutation) OverageRateMultiplier() (r float64, exists bool) {
+	v := m.overage_rate_multiplier
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetAllowedUsers resets all changes to the "allowed_users" edge.
-func (m *GroupMutation) ResetAllowedUsers() {
-	m.allowed_users = nil
-	m.clearedallowed_users = false
-	m.removedallowed_users = nil
+// OldOverageRateMultiplier returns the old "overage_rate_multiplier" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldOverageRateMultiplier(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOverageRateMultiplier is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOverageRateMultiplier requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOverageRateMultiplier: %w", err)
+	}
+	return oldValue.OverageRateMultiplier, nil
 }
 
-// Where appends a list predicates to the GroupMutation builder.
-func (m *GroupMutation) Where(ps ...predicate.Group) {
-	m.predicates = append(m.predicates, ps...)
+// AddOverageRateMultiplier adds f to the "overage_rate_multiplier" field.
+func (m *GroupMutation) AddOverageRateMultiplier(f float64) {
+	if m.addoverage_rate_multiplier != nil {
+		*m.addoverage_rate_multiplier += f
+	} else {
+		m.addoverage_rate_multiplier = &f
+	}
 }
 
-// WhereP appends storage-level predicates to the GroupMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Group, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddedOverageRateMultiplier returns the value that was added to the "overage_rate_multiplier" field in this mutation.
+func (m *GroupMutation) AddedOverageRateMultiplier() (r float64, exists bool) {
+	v := m.addoverage_rate_multiplier
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *GroupMutation) Op() Op {
-	return m.op
+// ResetOverageRateMultiplier resets all changes to the "overage_rate_multiplier" field.
+func (m *GroupMutation) ResetOverageRateMultiplier() {
+	m.overage_rate_multiplier = nil
+	m.addoverage_rate_multiplier = nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *GroupMutation) SetOp(op Op) {
-	m.op = op
+// SetRollingWindowHours sets the "rolling_window_hours" field.
+func (m *GroupMutation) SetRollingWindowHours(i int) {
+	m.rolling_window_hours = &i
+	m.addrolling_window_hours = nil
 }
 
-// Type returns the node type of this mutation (Group).
-func (m *GroupMutation) Type() string {
-	return m.typ
+// RollingWindowHours returns the value of the "rolling_window_hours" field in the mutation.
+func (m *GroupMutation) RollingWindowHours() (r int, exists bool) {
+	v := m.rolling_window_hours
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *GroupMutation) Fields() []string {
-	fields := make([]string, 0, 21)
-	if m.created_at != nil {
-		fields = append(fields, group.FieldCreatedAt)
+// OldRollingWindowHours returns the old "rolling_window_hours" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldRollingWindowHours(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRollingWindowHours is only allowed on UpdateOne operations")
 	}
-	if m.updated_at != nil {
-		fields = append(fields, group.FieldUpdatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRollingWindowHours requires an ID field in the mutation")
 	}
-	if m.deleted_at != nil {
-		fields = append(fields, group.FieldDeletedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRollingWindowHours: %w", err)
 	}
-	if m.name != nil {
-		fields = append(fields, group.FieldName)
+	return oldValue.RollingWindowHours, nil
+}
+
+// AddRollingWindowHours adds i to the "rolling_window_hours" field.
+func (m *GroupMutation) AddRollingWindowHours(i int) {
+	if m.addrolling_window_hours != nil {
+		*m.addrolling_window_hours += i
+	} else {
+		m.addrolling_window_hours = &i
 	}
-	if m.description != nil {
-		fields = append(fields, group.FieldDescription)
+}
+
+// AddedRollingWindowHours returns the value that was added to the "rolling_window_hours" field in this mutation.
+func (m *GroupMutation) AddedRollingWindowHours() (r int, exists bool) {
+	v := m.addrolling_window_hours
+	if v == nil {
+		return
 	}
-	if m.rate_multiplier != nil {
-		fields = append(fields, group.FieldRateMultiplier)
+	return *v, true
+}
+
+// ResetRollingWindowHours resets all changes to the "rolling_window_hours" field.
+func (m *GroupMutation) ResetRollingWindowHours() {
+	m.rolling_window_hours = nil
+	m.addrolling_window_hours = nil
+}
+
+// SetRollingWindowLimitUsd sets the "rolling_window_limit_usd" field.
+func (m *GroupMutation) SetRollingWindowLimitUsd(f float64) {
+	m.rolling_window_limit_usd = &f
+	m.addrolling_window_limit_usd = nil
+}
+
+// RollingWindowLimitUsd returns the value of the "rolling_window_limit_usd" field in the mutation.
+func (m *GroupMutation) RollingWindowLimitUsd() (r float64, exists bool) {
+	v := m.rolling_window_limit_usd
+	if v == nil {
+		return
 	}
-	if m.is_exclusive != nil {
-		fields = append(fields, group.FieldIsExclusive)
+	return *v, true
+}
+
+// OldRollingWindowLimitUsd returns the old "rolling_window_limit_usd" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldRollingWindowLimitUsd(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRollingWindowLimitUsd is only allowed on UpdateOne operations")
 	}
-	if m.status != nil {
-		fields = append(fields, group.FieldStatus)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRollingWindowLimitUsd requires an ID field in the mutation")
 	}
-	if m.platform != nil {
-		fields = append(fields, group.FieldPlatform)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRollingWindowLimitUsd: %w", err)
 	}
-	if m.subscription_type != nil {
-		fields = append(fields, group.FieldSubscriptionType)
+	return oldValue.RollingWindowLimitUsd, nil
+}
+
+// AddRollingWindowLimitUsd adds f to the "rolling_window_limit_usd" field.
+func (m *GroupMutation) AddRollingWindowLimitUsd(f float64) {
+	if m.addrolling_window_limit_usd != nil {
+		*m.addrolling_window_limit_usd += f
+	} else {
+		m.addrolling_window_limit_usd = &f
 	}
-	if m.daily_limit_usd != nil {
-		fields = append(fields, group.FieldDailyLimitUsd)
+}
+
+// AddedRollingWindowLimitUsd returns the value that was added to the "rolling_window_limit_usd" field in this mutation.
+func (m *GroupMutation) AddedRollingWindowLimitUsd() (r float64, exists bool) {
+	v := m.addrolling_window_limit_usd
+	if v == nil {
+		return
 	}
-	if m.weekly_limit_usd != nil {
-		fields = append(fields, group.FieldWeeklyLimitUsd)
+	return *v, true
+}
+
+// ClearRollingWindowLimitUsd clears the value of the "rolling_window_limit_usd" field.
+func (m *GroupMutation) ClearRollingWindowLimitUsd() {
+	m.rolling_window_limit_usd = nil
+	m.addrolling_window_limit_usd = nil
+	m.clearedFields[group.FieldRollingWindowLimitUsd] = struct{}{}
+}
+
+// RollingWindowLimitUsdCleared returns if the "rolling_window_limit_usd" field was cleared in this mutation.
+func (m *GroupMutation) RollingWindowLimitUsdCleared() bool {
+	_, ok := m.clearedFields[group.FieldRollingWindowLimitUsd]
+	return ok
+}
+
+// ResetRollingWindowLimitUsd resets all changes to the "rolling_window_limit_usd" field.
+func (m *GroupMutation) ResetRollingWindowLimitUsd() {
+	m.rolling_window_limit_usd = nil
+	m.addrolling_window_limit_usd = nil
+	delete(m.clearedFields, group.FieldRollingWindowLimitUsd)
+}
+
+// SetUsageQuotas sets the "usage_quotas" field.
+func (m *GroupMutation) SetUsageQuotas(u []usagequota.Quota) {
+	m.usage_quotas = &u
+	m.appendusage_quotas = nil
+}
+
+// UsageQuotas returns the value of the "usage_quotas" field in the mutation.
+func (m *GroupMutation) UsageQuotas() (r []usagequota.Quota, exists bool) {
+	v := m.usage_quotas
+	if v == nil {
+		return
 	}
-	if m.monthly_limit_usd != nil {
-		fields = append(fields, group.FieldMonthlyLimitUsd)
+	return *v, true
+}
+
+// OldUsageQuotas returns the old "usage_quotas" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldUsageQuotas(ctx context.Context) (v []usagequota.Quota, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsageQuotas is only allowed on UpdateOne operations")
 	}
-	if m.default_validity_days != nil {
-		fields = append(fields, group.FieldDefaultValidityDays)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsageQuotas requires an ID field in the mutation")
 	}
-	if m.image_price_1k != nil {
-		fields = append(fields, group.FieldImagePrice1k)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsageQuotas: %w", err)
 	}
-	if m.image_price_2k != nil {
-		fields = append(fields, group.FieldImagePrice2k)
+	return oldValue.UsageQuotas, nil
+}
+
+// AppendUsageQuotas adds u to the "usage_quotas" field.
+func (m *GroupMutation) AppendUsageQuotas(u []usagequota.Quota) {
+	m.appendusage_quotas = append(m.appendusage_quotas, u...)
+}
+
+// AppendedUsageQuotas returns the list of values that were appended to the "usage_quotas" field in this mutation.
+func (m *GroupMutation) AppendedUsageQuotas() ([]usagequota.Quota, bool) {
+	if len(m.appendusage_quotas) == 0 {
+		return nil, false
 	}
-	if m.image_price_4k != nil {
-		fields = append(fields, group.FieldImagePrice4k)
+	return m.appendusage_quotas, true
+}
+
+// ClearUsageQuotas clears the value of the "usage_quotas" field.
+func (m *GroupMutation) ClearUsageQuotas() {
+	m.usage_quotas = nil
+	m.appendusage_quotas = nil
+	m.clearedFields[group.FieldUsageQuotas] = struct{}{}
+}
+
+// UsageQuotasCleared returns if the "usage_quotas" field was cleared in this mutation.
+func (m *GroupMutation) UsageQuotasCleared() bool {
+	_, ok := m.clearedFields[group.FieldUsageQuotas]
+	return ok
+}
+
+// ResetUsageQuotas resets all changes to the "usage_quotas" field.
+func (m *GroupMutation) ResetUsageQuotas() {
+	m.usage_quotas = nil
+	m.appendusage_quotas = nil
+	delete(m.clearedFields, group.FieldUsageQuotas)
+}
+
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by ids.
+func (m *GroupMutation) AddAPIKeyIDs(ids ...int64) {
+	if m.api_keys == nil {
+		m.api_keys = make(map[int64]struct{})
 	}
-	if m.claude_code_only != nil {
-		fields = append(fields, group.FieldClaudeCodeOnly)
+	for i := range ids {
+		m.api_keys[ids[i]] = struct{}{}
 	}
-	if m.fallback_group_id != nil {
-		fields = append(fields, group.FieldFallbackGroupID)
+}
+
+// ClearAPIKeys clears the "api_keys" edge to the APIKey entity.
+func (m *GroupMutation) ClearAPIKeys() {
+	m.clearedapi_keys = true
+}
+
+// APIKeysCleared reports if the "api_keys" edge to the APIKey entity was cleared.
+func (m *GroupMutation) APIKeysCleared() bool {
+	return m.clearedapi_keys
+}
+
+// RemoveAPIKeyIDs removes the "api_keys" edge to the APIKey entity by IDs.
+func (m *GroupMutation) RemoveAPIKeyIDs(ids ...int64) {
+	if m.removedapi_keys == nil {
+		m.removedapi_keys = make(map[int64]struct{})
 	}
-	if m.model_routing != nil {
-		fields = append(fields, group.FieldModelRouting)
+	for i := range ids {
+		delete(m.api_keys, ids[i])
+		m.removedapi_keys[ids[i]] = struct{}{}
 	}
-	if m.model_routing_enabled != nil {
-		fields = append(fields, group.FieldModelRoutingEnabled)
+}
+
+// RemovedAPIKeys returns the removed IDs of the "api_keys" edge to the APIKey entity.
+func (m *GroupMutation) RemovedAPIKeysIDs() (ids []int64) {
+	for id := range m.removedapi_keys {
+		ids = append(ids, id)
 	}
-	return fields
+	return
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *GroupMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case group.FieldCreatedAt:
-		return m.CreatedAt()
-	case group.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case group.FieldDeletedAt:
-		return m.DeletedAt()
-	case group.FieldName:
-		return m.Name()
-	case group.FieldDescription:
-		return m.Description()
-	case group.FieldRateMultiplier:
-		return m.RateMultiplier()
-	case group.FieldIsExclusive:
-		return m.IsExclusive()
-	case group.FieldStatus:
-		return m.Status()
-	case group.FieldPlatform:
-		return m.Platform()
-	case group.FieldSubscriptionType:
-		return m.SubscriptionType()
-	case group.FieldDailyLimitUsd:
-		return m.DailyLimitUsd()
-	case group.FieldWeeklyLimitUsd:
-		return m.WeeklyLimitUsd()
-	case group.FieldMonthlyLimitUsd:
-		return m.MonthlyLimitUsd()
-	case group.FieldDefaultValidityDays:
-		return m.DefaultValidityDays()
-	case group.FieldImagePrice1k:
-		return m.ImagePrice1k()
-	case group.FieldImagePrice2k:
-		return m.ImagePrice2k()
-	case group.FieldImagePrice4k:
-		return m.ImagePrice4k()
-	case group.FieldClaudeCodeOnly:
-		return m.ClaudeCodeOnly()
-	case group.FieldFallbackGroupID:
+// APIKeysIDs returns the "api_keys" edge IDs in the mutation.
+func (m *GroupMutation) APIKeysIDs() (ids []int64) {
+	for id := range m.api_keys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAPIKeys resets all changes to the "api_keys" edge.
+func (m *GroupMutation) ResetAPIKeys() {
+	m.api_keys = nil
+	m.clearedapi_keys = false
+	m.removedapi_keys = nil
+}
+
+// AddRedeemCodeIDs adds the "redeem_codes" edge to the RedeemCode entity by ids.
+func (m *GroupMutation) AddRedeemCodeIDs(ids ...int64) {
+	if m.redeem_codes == nil {
+		m.redeem_codes = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.redeem_codes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRedeemCodes clears the "redeem_codes" edge to the RedeemCode entity.
+func (m *GroupMutation) ClearRedeemCodes() {
+	m.clearedredeem_codes = true
+}
+
+// RedeemCodesCleared reports if the "redeem_codes" edge to the RedeemCode entity was cleared.
+func (m *GroupMutation) RedeemCodesCleared() bool {
+	return m.clearedredeem_codes
+}
+
+// RemoveRedeemCodeIDs removes the "redeem_codes" edge to the RedeemCode entity by IDs.
+func (m *GroupMutation) RemoveRedeemCodeIDs(ids ...int64) {
+	if m.removedredeem_codes == nil {
+		m.removedredeem_codes = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.redeem_codes, ids[i])
+		m.removedredeem_codes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRedeemCodes returns the removed IDs of the "redeem_codes" edge to the RedeemCode entity.
+func (m *GroupMutation) RemovedRedeemCodesIDs() (ids []int64) {
+	for id := range m.removedredeem_codes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RedeemCodesIDs returns the "redeem_codes" edge IDs in the mutation.
+func (m *GroupMutation) RedeemCodesIDs() (ids []int64) {
+	for id := range m.redeem_codes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRedeemCodes resets all changes to the "redeem_codes" edge.
+func (m *GroupMutation) ResetRedeemCodes() {
+	m.redeem_codes = nil
+	m.clearedredeem_codes = false
+	m.removedredeem_codes = nil
+}
+
+// AddSubscriptionIDs adds the "subscriptions" edge to the UserSubscription entity by ids.
+func (m *GroupMutation) AddSubscriptionIDs(ids ...int64) {
+	if m.subscriptions == nil {
+		m.subscriptions = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.subscriptions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSubscriptions clears the "subscriptions" edge to the UserSubscription entity.
+func (m *GroupMutation) ClearSubscriptions() {
+	m.clearedsubscriptions = true
+}
+
+// SubscriptionsCleared reports if the "subscriptions" edge to the UserSubscription entity was cleared.
+func (m *GroupMutation) SubscriptionsCleared() bool {
+	return m.clearedsubscriptions
+}
+
+// RemoveSubscriptionIDs removes the "subscriptions" edge to the UserSubscription entity by IDs.
+func (m *GroupMutation) RemoveSubscriptionIDs(ids ...int64) {
+	if m.removedsubscriptions == nil {
+		m.removedsubscriptions = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.subscriptions, ids[i])
+		m.removedsubscriptions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSubscriptions returns the removed IDs of the "subscriptions" edge to the UserSubscription entity.
+func (m *GroupMutation) RemovedSubscriptionsIDs() (ids []int64) {
+	for id := range m.removedsubscriptions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SubscriptionsIDs returns the "subscriptions" edge IDs in the mutation.
+func (m *GroupMutation) SubscriptionsIDs() (ids []int64) {
+	for id := range m.subscriptions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSubscriptions resets all changes to the "subscriptions" edge.
+func (m *GroupMutation) ResetSubscriptions() {
+	m.subscriptions = nil
+	m.clearedsubscriptions = false
+	m.removedsubscriptions = nil
+}
+
+// AddUsageLogIDs adds the "usage_logs" edge to the UsageLog entity by ids.
+func (m *GroupMutation) AddUsageLogIDs(ids ...int64) {
+	if m.usage_logs == nil {
+		m.usage_logs = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.usage_logs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUsageLogs clears the "usage_logs" edge to the UsageLog entity.
+func (m *GroupMutation) ClearUsageLogs() {
+	m.clearedusage_logs = true
+}
+
+// UsageLogsCleared reports if the "usage_logs" edge to the UsageLog entity was cleared.
+func (m *GroupMutation) UsageLogsCleared() bool {
+	return m.clearedusage_logs
+}
+
+// RemoveUsageLogIDs removes the "usage_logs" edge to the UsageLog entity by IDs.
+func (m *GroupMutation) RemoveUsageLogIDs(ids ...int64) {
+	if m.removedusage_logs == nil {
+		m.removedusage_logs = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.usage_logs, ids[i])
+		m.removedusage_logs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUsageLogs returns the removed IDs of the "usage_logs" edge to the UsageLog entity.
+func (m *GroupMutation) RemovedUsageLogsIDs() (ids []int64) {
+	for id := range m.removedusage_logs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UsageLogsIDs returns the "usage_logs" edge IDs in the mutation.
+func (m *GroupMutation) UsageLogsIDs() (ids []int64) {
+	for id := range m.usage_logs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUsageLogs resets all changes to the "usage_logs" edge.
+func (m *GroupMutation) ResetUsageLogs() {
+	m.usage_logs = nil
+	m.clearedusage_logs = false
+	m.removedusage_logs = nil
+}
+
+// AddAccountIDs adds the "accounts" edge to the Account entity by ids.
+func (m *GroupMutation) AddAccountIDs(ids ...int64) {
+	if m.accounts == nil {
+		m.accounts = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.accounts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAccounts clears the "accounts" edge to the Account entity.
+func (m *GroupMutation) ClearAccounts() {
+	m.clearedaccounts = true
+}
+
+// AccountsCleared reports if the "accounts" edge to the Account entity was cleared.
+func (m *GroupMutation) AccountsCleared() bool {
+	return m.clearedaccounts
+}
+
+// RemoveAccountIDs removes the "accounts" edge to the Account entity by IDs.
+func (m *GroupMutation) RemoveAccountIDs(ids ...int64) {
+	if m.removedaccounts == nil {
+		m.removedaccounts = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.accounts, ids[i])
+		m.removedaccounts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAccounts returns the removed IDs of the "accounts" edge to the Account entity.
+func (m *GroupMutation) RemovedAccountsIDs() (ids []int64) {
+	for id := range m.removedaccounts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AccountsIDs returns the "accounts" edge IDs in the mutation.
+func (m *GroupMutation) AccountsIDs() (ids []int64) {
+	for id := range m.accounts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAccounts resets all changes to the "accounts" edge.
+func (m *GroupMutation) ResetAccounts() {
+	m.accounts = nil
+	m.clearedaccounts = false
+	m.removedaccounts = nil
+}
+
+// AddAllowedUserIDs adds the "allowed_users" edge to the User entity by ids.
+func (m *GroupMutation) AddAllowedUserIDs(ids ...int64) {
+	if m.allowed_users == nil {
+		m.allowed_users = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.allowed_users[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAllowedUsers clears the "allowed_users" edge to the User entity.
+func (m *GroupMutation) ClearAllowedUsers() {
+	m.clearedallowed_users = true
+}
+
+// AllowedUsersCleared reports if the "allowed_users" edge to the User entity was cleared.
+func (m *GroupMutation) AllowedUsersCleared() bool {
+	return m.clearedallowed_users
+}
+
+// RemoveAllowedUserIDs removes the "allowed_users" edge to the User entity by IDs.
+func (m *GroupMutation) RemoveAllowedUserIDs(ids ...int64) {
+	if m.removedallowed_users == nil {
+		m.removedallowed_users = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.allowed_users, ids[i])
+		m.removedallowed_users[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAllowedUsers returns the removed IDs of the "allowed_users" edge to the User entity.
+func (m *GroupMutation) RemovedAllowedUsersIDs() (ids []int64) {
+	for id := range m.removedallowed_users {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AllowedUsersIDs returns the "allowed_users" edge IDs in the mutation.
+func (m *GroupMutation) AllowedUsersIDs() (ids []int64) {
+	for id := range m.allowed_users {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAllowedUsers resets all changes to the "allowed_users" edge.
+func (m *GroupMutation) ResetAllowedUsers() {
+	m.allowed_users = nil
+	m.clearedallowed_users = false
+	m.removedallowed_users = nil
+}
+
+// Where appends a list predicates to the GroupMutation builder.
+func (m *GroupMutation) Where(ps ...predicate.Group) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GroupMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Group, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GroupMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GroupMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Group).
+func (m *GroupMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GroupMutation) Fields() []string {
+	fields := make([]string, 0, 32)
+	if m.created_at != nil {
+		fields = append(fields, group.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, group.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, group.FieldDeletedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, group.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, group.FieldDescription)
+	}
+	if m.rate_multiplier != nil {
+		fields = append(fields, group.FieldRateMultiplier)
+	}
+	if m.is_exclusive != nil {
+		fields = append(fields, group.FieldIsExclusive)
+	}
+	if m.status != nil {
+		fields = append(fields, group.FieldStatus)
+	}
+	if m.platform != nil {
+		fields = append(fields, group.FieldPlatform)
+	}
+	if m.subscription_type != nil {
+		fields = append(fields, group.FieldSubscriptionType)
+	}
+	if m.daily_limit_usd != nil {
+		fields = append(fields, group.FieldDailyLimitUsd)
+	}
+	if m.weekly_limit_usd != nil {
+		fields = append(fields, group.FieldWeeklyLimitUsd)
+	}
+	if m.monthly_limit_usd != nil {
+		fields = append(fields, group.FieldMonthlyLimitUsd)
+	}
+	if m.default_validity_days != nil {
+		fields = append(fields, group.FieldDefaultValidityDays)
+	}
+	if m.image_price_1k != nil {
+		fields = append(fields, group.FieldImagePrice1k)
+	}
+	if m.image_price_2k != nil {
+		fields = append(fields, group.FieldImagePrice2k)
+	}
+	if m.image_price_4k != nil {
+		fields = append(fields, group.FieldImagePrice4k)
+	}
+	if m.claude_code_only != nil {
+		fields = append(fields, group.FieldClaudeCodeOnly)
+	}
+	if m.fallback_group_id != nil {
+		fields = append(fields, group.FieldFallbackGroupID)
+	}
+	if m.model_routing != nil {
+		fields = append(fields, group.FieldModelRouting)
+	}
+	if m.model_routing_enabled != nil {
+		fields = append(fields, group.FieldModelRoutingEnabled)
+	}
+	if m.pool_split_enabled != nil {
+		fields = append(fields, group.FieldPoolSplitEnabled)
+	}
+	if m.pool_weights != nil {
+		fields = append(fields, group.FieldPoolWeights)
+	}
+	if m.canary_max_error_rate != nil {
+		fields = append(fields, group.FieldCanaryMaxErrorRate)
+	}
+	if m.capacity_fallback_group_ids != nil {
+		fields = append(fields, group.FieldCapacityFallbackGroupIds)
+	}
+	if m.fallback_model_mapping != nil {
+		fields = append(fields, group.FieldFallbackModelMapping)
+	}
+	if m.volume_tiers != nil {
+		fields = append(fields, group.FieldVolumeTiers)
+	}
+	if m.overage_enabled != nil {
+		fields = append(fields, group.FieldOverageEnabled)
+	}
+	if m.overage_rate_multiplier != nil {
+		fields = append(fields, group.FieldOverageRateMultiplier)
+	}
+	if m.rolling_window_hours != nil {
+		fields = append(fields, group.FieldRollingWindowHours)
+	}
+	if m.rolling_window_limit_usd != nil {
+		fields = append(fields, group.FieldRollingWindowLimitUsd)
+	}
+	if m.usage_quotas != nil {
+		fields = append(fields, group.FieldUsageQuotas)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GroupMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case group.FieldCreatedAt:
+		return m.CreatedAt()
+	case group.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case group.FieldDeletedAt:
+		return m.DeletedAt()
+	case group.FieldName:
+		return m.Name()
+	case group.FieldDescription:
+		return m.Description()
+	case group.FieldRateMultiplier:
+		return m.RateMultiplier()
+	case group.FieldIsExclusive:
+		return m.IsExclusive()
+	case group.FieldStatus:
+		return m.Status()
+	case group.FieldPlatform:
+		return m.Platform()
+	case group.FieldSubscriptionType:
+		return m.SubscriptionType()
+	case group.FieldDailyLimitUsd:
+		return m.DailyLimitUsd()
+	case group.FieldWeeklyLimitUsd:
+		return m.WeeklyLimitUsd()
+	case group.FieldMonthlyLimitUsd:
+		return m.MonthlyLimitUsd()
+	case group.FieldDefaultValidityDays:
+		return m.DefaultValidityDays()
+	case group.FieldImagePrice1k:
+		return m.ImagePrice1k()
+	case group.FieldImagePrice2k:
+		return m.ImagePrice2k()
+	case group.FieldImagePrice4k:
+		return m.ImagePrice4k()
+	case group.FieldClaudeCodeOnly:
+		return m.ClaudeCodeOnly()
+	case group.FieldFallbackGroupID:
 		return m.FallbackGroupID()
 	case group.FieldModelRouting:
-		return m.ModelRouting()
+		return m.ModelRouting()
+	case group.FieldModelRoutingEnabled:
+		return m.ModelRoutingEnabled()
+	case group.FieldPoolSplitEnabled:
+		return m.PoolSplitEnabled()
+	case group.FieldPoolWeights:
+		return m.PoolWeights()
+	case group.FieldCanaryMaxErrorRate:
+		return m.CanaryMaxErrorRate()
+	case group.FieldCapacityFallbackGroupIds:
+		return m.CapacityFallbackGroupIds()
+	case group.FieldFallbackModelMapping:
+		return m.FallbackModelMapping()
+	case group.FieldVolumeTiers:
+		return m.VolumeTiers()
+	case group.FieldOverageEnabled:
+		return m.OverageEnabled()
+	case group.FieldOverageRateMultiplier:
+		return m.OverageRateMultiplier()
+	case group.FieldRollingWindowHours:
+		return m.RollingWindowHours()
+	case group.FieldRollingWindowLimitUsd:
+		return m.RollingWindowLimitUsd()
+	case group.FieldUsageQuotas:
+		return m.UsageQuotas()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case group.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case group.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case group.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case group.FieldName:
+		return m.OldName(ctx)
+	case group.FieldDescription:
+		return m.OldDescription(ctx)
+	case group.FieldRateMultiplier:
+		return m.OldRateMultiplier(ctx)
+	case group.FieldIsExclusive:
+		return m.OldIsExclusive(ctx)
+	case group.FieldStatus:
+		return m.OldStatus(ctx)
+	case group.FieldPlatform:
+		return m.OldPlatform(ctx)
+	case group.FieldSubscriptionType:
+		return m.OldSubscriptionType(ctx)
+	case group.FieldDailyLimitUsd:
+		return m.OldDailyLimitUsd(ctx)
+	case group.FieldWeeklyLimitUsd:
+		return m.OldWeeklyLimitUsd(ctx)
+	case group.FieldMonthlyLimitUsd:
+		return m.OldMonthlyLimitUsd(ctx)
+	case group.FieldDefaultValidityDays:
+		return m.OldDefaultValidityDays(ctx)
+	case group.FieldImagePrice1k:
+		return m.OldImagePrice1k(ctx)
+	case group.FieldImagePrice2k:
+		return m.OldImagePrice2k(ctx)
+	case group.FieldImagePrice4k:
+		return m.OldImagePrice4k(ctx)
+	case group.FieldClaudeCodeOnly:
+		return m.OldClaudeCodeOnly(ctx)
+	case group.FieldFallbackGroupID:
+		return m.OldFallbackGroupID(ctx)
+	case group.FieldModelRouting:
+		return m.OldModelRouting(ctx)
+	case group.FieldModelRoutingEnabled:
+		return m.OldModelRoutingEnabled(ctx)
+	case group.FieldPoolSplitEnabled:
+		return m.OldPoolSplitEnabled(ctx)
+	case group.FieldPoolWeights:
+		return m.OldPoolWeights(ctx)
+	case group.FieldCanaryMaxErrorRate:
+		return m.OldCanaryMaxErrorRate(ctx)
+	case group.FieldCapacityFallbackGroupIds:
+		return m.OldCapacityFallbackGroupIds(ctx)
+	case group.FieldFallbackModelMapping:
+		return m.OldFallbackModelMapping(ctx)
+	case group.FieldVolumeTiers:
+		return m.OldVolumeTiers(ctx)
+	case group.FieldOverageEnabled:
+		return m.OldOverageEnabled(ctx)
+	case group.FieldOverageRateMultiplier:
+		return m.OldOverageRateMultiplier(ctx)
+	case group.FieldRollingWindowHours:
+		return m.OldRollingWindowHours(ctx)
+	case group.FieldRollingWindowLimitUsd:
+		return m.OldRollingWindowLimitUsd(ctx)
+	case group.FieldUsageQuotas:
+		return m.OldUsageQuotas(ctx)
+	}
+	return nil, fmt.Errorf("unknown Group field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case group.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case group.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case group.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case group.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case group.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case group.FieldRateMultiplier:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRateMultiplier(v)
+		return nil
+	case group.FieldIsExclusive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsExclusive(v)
+		return nil
+	case group.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case group.FieldPlatform:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlatform(v)
+		return nil
+	case group.FieldSubscriptionType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubscriptionType(v)
+		return nil
+	case group.FieldDailyLimitUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDailyLimitUsd(v)
+		return nil
+	case group.FieldWeeklyLimitUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWeeklyLimitUsd(v)
+		return nil
+	case group.FieldMonthlyLimitUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMonthlyLimitUsd(v)
+		return nil
+	case group.FieldDefaultValidityDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefaultValidityDays(v)
+		return nil
+	case group.FieldImagePrice1k:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImagePrice1k(v)
+		return nil
+	case group.FieldImagePrice2k:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImagePrice2k(v)
+		return nil
+	case group.FieldImagePrice4k:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImagePrice4k(v)
+		return nil
+	case group.FieldClaudeCodeOnly:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaudeCodeOnly(v)
+		return nil
+	case group.FieldFallbackGroupID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFallbackGroupID(v)
+		return nil
+	case group.FieldModelRouting:
+		v, ok := value.(map[string][]int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelRouting(v)
+		return nil
+	case group.FieldModelRoutingEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelRoutingEnabled(v)
+		return nil
+	case group.FieldPoolSplitEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPoolSplitEnabled(v)
+		return nil
+	case group.FieldPoolWeights:
+		v, ok := value.(map[string]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPoolWeights(v)
+		return nil
+	case group.FieldCanaryMaxErrorRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCanaryMaxErrorRate(v)
+		return nil
+	case group.FieldCapacityFallbackGroupIds:
+		v, ok := value.([]int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCapacityFallbackGroupIds(v)
+		return nil
+	case group.FieldFallbackModelMapping:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFallbackModelMapping(v)
+		return nil
+	case group.FieldVolumeTiers:
+		v, ok := value.([]volumetier.Tier)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVolumeTiers(v)
+		return nil
+	case group.FieldOverageEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOverageEnabled(v)
+		return nil
+	case group.FieldOverageRateMultiplier:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOverageRateMultiplier(v)
+		return nil
+	case group.FieldRollingWindowHours:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRollingWindowHours(v)
+		return nil
+	case group.FieldRollingWindowLimitUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRollingWindowLimitUsd(v)
+		return nil
+	case group.FieldUsageQuotas:
+		v, ok := value.([]usagequota.Quota)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsageQuotas(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Group field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GroupMutation) AddedFields() []string {
+	var fields []string
+	if m.addrate_multiplier != nil {
+		fields = append(fields, group.FieldRateMultiplier)
+	}
+	if m.adddaily_limit_usd != nil {
+		fields = append(fields, group.FieldDailyLimitUsd)
+	}
+	if m.addweekly_limit_usd != nil {
+		fields = append(fields, group.FieldWeeklyLimitUsd)
+	}
+	if m.addmonthly_limit_usd != nil {
+		fields = append(fields, group.FieldMonthlyLimitUsd)
+	}
+	if m.adddefault_validity_days != nil {
+		fields = append(fields, group.FieldDefaultValidityDays)
+	}
+	if m.addimage_price_1k != nil {
+		fields = append(fields, group.FieldImagePrice1k)
+	}
+	if m.addimage_price_2k != nil {
+		fields = append(fields, group.FieldImagePrice2k)
+	}
+	if m.addimage_price_4k != nil {
+		fields = append(fields, group.FieldImagePrice4k)
+	}
+	if m.addfallback_group_id != nil {
+		fields = append(fields, group.FieldFallbackGroupID)
+	}
+	if m.addcanary_max_error_rate != nil {
+		fields = append(fields, group.FieldCanaryMaxErrorRate)
+	}
+	if m.addoverage_rate_multiplier != nil {
+		fields = append(fields, group.FieldOverageRateMultiplier)
+	}
+	if m.addrolling_window_hours != nil {
+		fields = append(fields, group.FieldRollingWindowHours)
+	}
+	if m.addrolling_window_limit_usd != nil {
+		fields = append(fields, group.FieldRollingWindowLimitUsd)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GroupMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case group.FieldRateMultiplier:
+		return m.AddedRateMultiplier()
+	case group.FieldDailyLimitUsd:
+		return m.AddedDailyLimitUsd()
+	case group.FieldWeeklyLimitUsd:
+		return m.AddedWeeklyLimitUsd()
+	case group.FieldMonthlyLimitUsd:
+		return m.AddedMonthlyLimitUsd()
+	case group.FieldDefaultValidityDays:
+		return m.AddedDefaultValidityDays()
+	case group.FieldImagePrice1k:
+		return m.AddedImagePrice1k()
+	case group.FieldImagePrice2k:
+		return m.AddedImagePrice2k()
+	case group.FieldImagePrice4k:
+		return m.AddedImagePrice4k()
+	case group.FieldFallbackGroupID:
+		return m.AddedFallbackGroupID()
+	case group.FieldCanaryMaxErrorRate:
+		return m.AddedCanaryMaxErrorRate()
+	case group.FieldOverageRateMultiplier:
+		return m.AddedOverageRateMultiplier()
+	case group.FieldRollingWindowHours:
+		return m.AddedRollingWindowHours()
+	case group.FieldRollingWindowLimitUsd:
+		return m.AddedRollingWindowLimitUsd()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case group.FieldRateMultiplier:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRateMultiplier(v)
+		return nil
+	case group.FieldDailyLimitUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDailyLimitUsd(v)
+		return nil
+	case group.FieldWeeklyLimitUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWeeklyLimitUsd(v)
+		return nil
+	case group.FieldMonthlyLimitUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMonthlyLimitUsd(v)
+		return nil
+	case group.FieldDefaultValidityDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDefaultValidityDays(v)
+		return nil
+	case group.FieldImagePrice1k:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddImagePrice1k(v)
+		return nil
+	case group.FieldImagePrice2k:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddImagePrice2k(v)
+		return nil
+	case group.FieldImagePrice4k:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddImagePrice4k(v)
+		return nil
+	case group.FieldFallbackGroupID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFallbackGroupID(v)
+		return nil
+	case group.FieldCanaryMaxErrorRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCanaryMaxErrorRate(v)
+		return nil
+	case group.FieldOverageRateMultiplier:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOverageRateMultiplier(v)
+		return nil
+	case group.FieldRollingWindowHours:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRollingWindowHours(v)
+		return nil
+	case group.FieldRollingWindowLimitUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRollingWindowLimitUsd(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Group numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GroupMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(group.FieldDeletedAt) {
+		fields = append(fields, group.FieldDeletedAt)
+	}
+	if m.FieldCleared(group.FieldDescription) {
+		fields = append(fields, group.FieldDescription)
+	}
+	if m.FieldCleared(group.FieldDailyLimitUsd) {
+		fields = append(fields, group.FieldDailyLimitUsd)
+	}
+	if m.FieldCleared(group.FieldWeeklyLimitUsd) {
+		fields = append(fields, group.FieldWeeklyLimitUsd)
+	}
+	if m.FieldCleared(group.FieldMonthlyLimitUsd) {
+		fields = append(fields, group.FieldMonthlyLimitUsd)
+	}
+	if m.FieldCleared(group.FieldImagePrice1k) {
+		fields = append(fields, group.FieldImagePrice1k)
+	}
+	if m.FieldCleared(group.FieldImagePrice2k) {
+		fields = append(fields, group.FieldImagePrice2k)
+	}
+	if m.FieldCleared(group.FieldImagePrice4k) {
+		fields = append(fields, group.FieldImagePrice4k)
+	}
+	if m.FieldCleared(group.FieldFallbackGroupID) {
+		fields = append(fields, group.FieldFallbackGroupID)
+	}
+	if m.FieldCleared(group.FieldModelRouting) {
+		fields = append(fields, group.FieldModelRouting)
+	}
+	if m.FieldCleared(group.FieldPoolWeights) {
+		fields = append(fields, group.FieldPoolWeights)
+	}
+	if m.FieldCleared(group.FieldCanaryMaxErrorRate) {
+		fields = append(fields, group.FieldCanaryMaxErrorRate)
+	}
+	if m.FieldCleared(group.FieldCapacityFallbackGroupIds) {
+		fields = append(fields, group.FieldCapacityFallbackGroupIds)
+	}
+	if m.FieldCleared(group.FieldFallbackModelMapping) {
+		fields = append(fields, group.FieldFallbackModelMapping)
+	}
+	if m.FieldCleared(group.FieldVolumeTiers) {
+		fields = append(fields, group.FieldVolumeTiers)
+	}
+	if m.FieldCleared(group.FieldRollingWindowLimitUsd) {
+		fields = append(fields, group.FieldRollingWindowLimitUsd)
+	}
+	if m.FieldCleared(group.FieldUsageQuotas) {
+		fields = append(fields, group.FieldUsageQuotas)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GroupMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GroupMutation) ClearField(name string) error {
+	switch name {
+	case group.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case group.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case group.FieldDailyLimitUsd:
+		m.ClearDailyLimitUsd()
+		return nil
+	case group.FieldWeeklyLimitUsd:
+		m.ClearWeeklyLimitUsd()
+		return nil
+	case group.FieldMonthlyLimitUsd:
+		m.ClearMonthlyLimitUsd()
+		return nil
+	case group.FieldImagePrice1k:
+		m.ClearImagePrice1k()
+		return nil
+	case group.FieldImagePrice2k:
+		m.ClearImagePrice2k()
+		return nil
+	case group.FieldImagePrice4k:
+		m.ClearImagePrice4k()
+		return nil
+	case group.FieldFallbackGroupID:
+		m.ClearFallbackGroupID()
+		return nil
+	case group.FieldModelRouting:
+		m.ClearModelRouting()
+		return nil
+	case group.FieldPoolWeights:
+		m.ClearPoolWeights()
+		return nil
+	case group.FieldCanaryMaxErrorRate:
+		m.ClearCanaryMaxErrorRate()
+		return nil
+	case group.FieldCapacityFallbackGroupIds:
+		m.ClearCapacityFallbackGroupIds()
+		return nil
+	case group.FieldFallbackModelMapping:
+		m.ClearFallbackModelMapping()
+		return nil
+	case group.FieldVolumeTiers:
+		m.ClearVolumeTiers()
+		return nil
+	case group.FieldRollingWindowLimitUsd:
+		m.ClearRollingWindowLimitUsd()
+		return nil
+	case group.FieldUsageQuotas:
+		m.ClearUsageQuotas()
+		return nil
+	}
+	return fmt.Errorf("unknown Group nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GroupMutation) ResetField(name string) error {
+	switch name {
+	case group.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case group.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case group.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case group.FieldName:
+		m.ResetName()
+		return nil
+	case group.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case group.FieldRateMultiplier:
+		m.ResetRateMultiplier()
+		return nil
+	case group.FieldIsExclusive:
+		m.ResetIsExclusive()
+		return nil
+	case group.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case group.FieldPlatform:
+		m.ResetPlatform()
+		return nil
+	case group.FieldSubscriptionType:
+		m.ResetSubscriptionType()
+		return nil
+	case group.FieldDailyLimitUsd:
+		m.ResetDailyLimitUsd()
+		return nil
+	case group.FieldWeeklyLimitUsd:
+		m.ResetWeeklyLimitUsd()
+		return nil
+	case group.FieldMonthlyLimitUsd:
+		m.ResetMonthlyLimitUsd()
+		return nil
+	case group.FieldDefaultValidityDays:
+		m.ResetDefaultValidityDays()
+		return nil
+	case group.FieldImagePrice1k:
+		m.ResetImagePrice1k()
+		return nil
+	case group.FieldImagePrice2k:
+		m.ResetImagePrice2k()
+		return nil
+	case group.FieldImagePrice4k:
+		m.ResetImagePrice4k()
+		return nil
+	case group.FieldClaudeCodeOnly:
+		m.ResetClaudeCodeOnly()
+		return nil
+	case group.FieldFallbackGroupID:
+		m.ResetFallbackGroupID()
+		return nil
+	case group.FieldModelRouting:
+		m.ResetModelRouting()
+		return nil
 	case group.FieldModelRoutingEnabled:
-		return m.ModelRoutingEnabled()
+		m.ResetModelRoutingEnabled()
+		return nil
+	case group.FieldPoolSplitEnabled:
+		m.ResetPoolSplitEnabled()
+		return nil
+	case group.FieldPoolWeights:
+		m.ResetPoolWeights()
+		return nil
+	case group.FieldCanaryMaxErrorRate:
+		m.ResetCanaryMaxErrorRate()
+		return nil
+	case group.FieldCapacityFallbackGroupIds:
+		m.ResetCapacityFallbackGroupIds()
+		return nil
+	case group.FieldFallbackModelMapping:
+		m.ResetFallbackModelMapping()
+		return nil
+	case group.FieldVolumeTiers:
+		m.ResetVolumeTiers()
+		return nil
+	case group.FieldOverageEnabled:
+		m.ResetOverageEnabled()
+		return nil
+	case group.FieldOverageRateMultiplier:
+		m.ResetOverageRateMultiplier()
+		return nil
+	case group.FieldRollingWindowHours:
+		m.ResetRollingWindowHours()
+		return nil
+	case group.FieldRollingWindowLimitUsd:
+		m.ResetRollingWindowLimitUsd()
+		return nil
+	case group.FieldUsageQuotas:
+		m.ResetUsageQuotas()
+		return nil
+	}
+	return fmt.Errorf("unknown Group field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GroupMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.api_keys != nil {
+		edges = append(edges, group.EdgeAPIKeys)
+	}
+	if m.redeem_codes != nil {
+		edges = append(edges, group.EdgeRedeemCodes)
+	}
+	if m.subscriptions != nil {
+		edges = append(edges, group.EdgeSubscriptions)
+	}
+	if m.usage_logs != nil {
+		edges = append(edges, group.EdgeUsageLogs)
+	}
+	if m.accounts != nil {
+		edges = append(edges, group.EdgeAccounts)
+	}
+	if m.allowed_users != nil {
+		edges = append(edges, group.EdgeAllowedUsers)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GroupMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case group.EdgeAPIKeys:
+		ids := make([]ent.Value, 0, len(m.api_keys))
+		for id := range m.api_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeRedeemCodes:
+		ids := make([]ent.Value, 0, len(m.redeem_codes))
+		for id := range m.redeem_codes {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeSubscriptions:
+		ids := make([]ent.Value, 0, len(m.subscriptions))
+		for id := range m.subscriptions {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeUsageLogs:
+		ids := make([]ent.Value, 0, len(m.usage_logs))
+		for id := range m.usage_logs {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeAccounts:
+		ids := make([]ent.Value, 0, len(m.accounts))
+		for id := range m.accounts {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeAllowedUsers:
+		ids := make([]ent.Value, 0, len(m.allowed_users))
+		for id := range m.allowed_users {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GroupMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedapi_keys != nil {
+		edges = append(edges, group.EdgeAPIKeys)
+	}
+	if m.removedredeem_codes != nil {
+		edges = append(edges, group.EdgeRedeemCodes)
+	}
+	if m.removedsubscriptions != nil {
+		edges = append(edges, group.EdgeSubscriptions)
+	}
+	if m.removedusage_logs != nil {
+		edges = append(edges, group.EdgeUsageLogs)
+	}
+	if m.removedaccounts != nil {
+		edges = append(edges, group.EdgeAccounts)
+	}
+	if m.removedallowed_users != nil {
+		edges = append(edges, group.EdgeAllowedUsers)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GroupMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case group.EdgeAPIKeys:
+		ids := make([]ent.Value, 0, len(m.removedapi_keys))
+		for id := range m.removedapi_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeRedeemCodes:
+		ids := make([]ent.Value, 0, len(m.removedredeem_codes))
+		for id := range m.removedredeem_codes {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeSubscriptions:
+		ids := make([]ent.Value, 0, len(m.removedsubscriptions))
+		for id := range m.removedsubscriptions {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeUsageLogs:
+		ids := make([]ent.Value, 0, len(m.removedusage_logs))
+		for id := range m.removedusage_logs {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeAccounts:
+		ids := make([]ent.Value, 0, len(m.removedaccounts))
+		for id := range m.removedaccounts {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeAllowedUsers:
+		ids := make([]ent.Value, 0, len(m.removedallowed_users))
+		for id := range m.removedallowed_users {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GroupMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedapi_keys {
+		edges = append(edges, group.EdgeAPIKeys)
+	}
+	if m.clearedredeem_codes {
+		edges = append(edges, group.EdgeRedeemCodes)
+	}
+	if m.clearedsubscriptions {
+		edges = append(edges, group.EdgeSubscriptions)
+	}
+	if m.clearedusage_logs {
+		edges = append(edges, group.EdgeUsageLogs)
+	}
+	if m.clearedaccounts {
+		edges = append(edges, group.EdgeAccounts)
+	}
+	if m.clearedallowed_users {
+		edges = append(edges, group.EdgeAllowedUsers)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GroupMutation) EdgeCleared(name string) bool {
+	switch name {
+	case group.EdgeAPIKeys:
+		return m.clearedapi_keys
+	case group.EdgeRedeemCodes:
+		return m.clearedredeem_codes
+	case group.EdgeSubscriptions:
+		return m.clearedsubscriptions
+	case group.EdgeUsageLogs:
+		return m.clearedusage_logs
+	case group.EdgeAccounts:
+		return m.clearedaccounts
+	case group.EdgeAllowedUsers:
+		return m.clearedallowed_users
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GroupMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Group unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GroupMutation) ResetEdge(name string) error {
+	switch name {
+	case group.EdgeAPIKeys:
+		m.ResetAPIKeys()
+		return nil
+	case group.EdgeRedeemCodes:
+		m.ResetRedeemCodes()
+		return nil
+	case group.EdgeSubscriptions:
+		m.ResetSubscriptions()
+		return nil
+	case group.EdgeUsageLogs:
+		m.ResetUsageLogs()
+		return nil
+	case group.EdgeAccounts:
+		m.ResetAccounts()
+		return nil
+	case group.EdgeAllowedUsers:
+		m.ResetAllowedUsers()
+		return nil
+	}
+	return fmt.Errorf("unknown Group edge %s", name)
+}
+
+// PromoCodeMutation represents an operation that mutates the PromoCode nodes in the graph.
+type PromoCodeMutation struct {
+	config
+	op                          Op
+	typ                         string
+	id                          *int64
+	code                        *string
+	bonus_amount                *float64
+	addbonus_amount             *float64
+	credit_validity_days        *int
+	addcredit_validity_days     *int
+	max_uses                    *int
+	addmax_uses                 *int
+	used_count                  *int
+	addused_count               *int
+	status                      *string
+	expires_at                  *time.Time
+	notes                       *string
+	scope                       *string
+	bonus_percent               *float64
+	addbonus_percent            *float64
+	max_bonus_amount            *float64
+	addmax_bonus_amount         *float64
+	trial_group_id              *int64
+	addtrial_group_id           *int64
+	trial_days                  *int
+	addtrial_days               *int
+	new_user_days               *int
+	addnew_user_days            *int
+	allowed_email_domains       *[]string
+	appendallowed_email_domains []string
+	stackable                   *bool
+	max_uses_per_user           *int
+	addmax_uses_per_user        *int
+	created_at                  *time.Time
+	updated_at                  *time.Time
+	clearedFields               map[string]struct{}
+	usage_records               map[int64]struct{}
+	removedusage_records        map[int64]struct{}
+	clearedusage_records        bool
+	done                        bool
+	oldValue                    func(context.Context) (*PromoCode, error)
+	predicates                  []predicate.PromoCode
+}
+
+var _ ent.Mutation = (*PromoCodeMutation)(nil)
+
+// promocodeOption allows management of the mutation configuration using functional options.
+type promocodeOption func(*PromoCodeMutation)
+
+// newPromoCodeMutation creates new mutation for the PromoCode entity.
+func newPromoCodeMutation(c config, op Op, opts ...promocodeOption) *PromoCodeMutation {
+	m := &PromoCodeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePromoCode,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPromoCodeID sets the ID field of the mutation.
+func withPromoCodeID(id int64) promocodeOption {
+	return func(m *PromoCodeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PromoCode
+		)
+		m.oldValue = func(ctx context.Context) (*PromoCode, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PromoCode.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPromoCode sets the old PromoCode of the mutation.
+func withPromoCode(node *PromoCode) promocodeOption {
+	return func(m *PromoCodeMutation) {
+		m.oldValue = func(context.Context) (*PromoCode, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PromoCodeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PromoCodeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PromoCodeMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PromoCodeMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PromoCode.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCode sets the "code" field.
+func (m *PromoCodeMutation) SetCode(s string) {
+	m.code = &s
+}
+
+// Code returns the value of the "code" field in the mutation.
+func (m *PromoCodeMutation) Code() (r string, exists bool) {
+	v := m.code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCode returns the old "code" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+	}
+	return oldValue.Code, nil
+}
+
+// ResetCode resets all changes to the "code" field.
+func (m *PromoCodeMutation) ResetCode() {
+	m.code = nil
+}
+
+// SetBonusAmount sets the "bonus_amount" field.
+func (m *PromoCodeMutation) SetBonusAmount(f float64) {
+	m.bonus_amount = &f
+	m.addbonus_amount = nil
+}
+
+// BonusAmount returns the value of the "bonus_amount" field in the mutation.
+func (m *PromoCodeMutation) BonusAmount() (r float64, exists bool) {
+	v := m.bonus_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBonusAmount returns the old "bonus_amount" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldBonusAmount(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBonusAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBonusAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBonusAmount: %w", err)
+	}
+	return oldValue.BonusAmount, nil
+}
+
+// AddBonusAmount adds f to the "bonus_amount" field.
+func (m *PromoCodeMutation) AddBonusAmount(f float64) {
+	if m.addbonus_amount != nil {
+		*m.addbonus_amount += f
+	} else {
+		m.addbonus_amount = &f
+	}
+}
+
+// AddedBonusAmount returns the value that was added to the "bonus_amount" field in this mutation.
+func (m *PromoCodeMutation) AddedBonusAmount() (r float64, exists bool) {
+	v := m.addbonus_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBonusAmount resets all changes to the "bonus_amount" field.
+func (m *PromoCodeMutation) ResetBonusAmount() {
+	m.bonus_amount = nil
+	m.addbonus_amount = nil
+}
+
+// SetCreditValidityDays sets the "credit_validity_days" field.
+func (m *PromoCodeMutation) SetCreditValidityDays(i int) {
+	m.credit_validity_days = &i
+	m.addcredit_validity_days = nil
+}
+
+// CreditValidityDays returns the value of the "credit_validity_days" field in the mutation.
+func (m *PromoCodeMutation) CreditValidityDays() (r int, exists bool) {
+	v := m.credit_validity_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreditValidityDays returns the old "credit_validity_days" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldCreditValidityDays(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreditValidityDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreditValidityDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreditValidityDays: %w", err)
+	}
+	return oldValue.CreditValidityDays, nil
+}
+
+// AddCreditValidityDays adds i to the "credit_validity_days" field.
+func (m *PromoCodeMutation) AddCreditValidityDays(i int) {
+	if m.addcredit_validity_days != nil {
+		*m.addcredit_validity_days += i
+	} else {
+		m.addcredit_validity_days = &i
+	}
+}
+
+// AddedCreditValidityDays returns the value that was added to the "credit_validity_days" field in this mutation.
+func (m *PromoCodeMutation) AddedCreditValidityDays() (r int, exists bool) {
+	v := m.addcredit_validity_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreditValidityDays resets all changes to the "credit_validity_days" field.
+func (m *PromoCodeMutation) ResetCreditValidityDays() {
+	m.credit_validity_days = nil
+	m.addcredit_validity_days = nil
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (m *PromoCodeMutation) SetMaxUses(i int) {
+	m.max_uses = &i
+	m.addmax_uses = nil
+}
+
+// MaxUses returns the value of the "max_uses" field in the mutation.
+func (m *PromoCodeMutation) MaxUses() (r int, exists bool) {
+	v := m.max_uses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxUses returns the old "max_uses" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldMaxUses(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxUses is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxUses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxUses: %w", err)
+	}
+	return oldValue.MaxUses, nil
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (m *PromoCodeMutation) AddMaxUses(i int) {
+	if m.addmax_uses != nil {
+		*m.addmax_uses += i
+	} else {
+		m.addmax_uses = &i
+	}
+}
+
+// AddedMaxUses returns the value that was added to the "max_uses" field in this mutation.
+func (m *PromoCodeMutation) AddedMaxUses() (r int, exists bool) {
+	v := m.addmax_uses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxUses resets all changes to the "max_uses" field.
+func (m *PromoCodeMutation) ResetMaxUses() {
+	m.max_uses = nil
+	m.addmax_uses = nil
+}
+
+// SetUsedCount sets the "used_count" field.
+func (m *PromoCodeMutation) SetUsedCount(i int) {
+	m.used_count = &i
+	m.addused_count = nil
+}
+
+// UsedCount returns the value of the "used_count" field in the mutation.
+func (m *PromoCodeMutation) UsedCount() (r int, exists bool) {
+	v := m.used_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsedCount returns the old "used_count" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldUsedCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsedCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsedCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsedCount: %w", err)
+	}
+	return oldValue.UsedCount, nil
+}
+
+// AddUsedCount adds i to the "used_count" field.
+func (m *PromoCodeMutation) AddUsedCount(i int) {
+	if m.addused_count != nil {
+		*m.addused_count += i
+	} else {
+		m.addused_count = &i
+	}
+}
+
+// AddedUsedCount returns the value that was added to the "used_count" field in this mutation.
+func (m *PromoCodeMutation) AddedUsedCount() (r int, exists bool) {
+	v := m.addused_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUsedCount resets all changes to the "used_count" field.
+func (m *PromoCodeMutation) ResetUsedCount() {
+	m.used_count = nil
+	m.addused_count = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *PromoCodeMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *PromoCodeMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *PromoCodeMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *PromoCodeMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *PromoCodeMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *PromoCodeMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[promocode.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *PromoCodeMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[promocode.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *PromoCodeMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, promocode.FieldExpiresAt)
+}
+
+// SetNotes sets the "notes" field.
+func (m *PromoCodeMutation) SetNotes(s string) {
+	m.notes = &s
+}
+
+// Notes returns the value of the "notes" field in the mutation.
+func (m *PromoCodeMutation) Notes() (r string, exists bool) {
+	v := m.notes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNotes returns the old "notes" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldNotes(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotes: %w", err)
+	}
+	return oldValue.Notes, nil
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (m *PromoCodeMutation) ClearNotes() {
+	m.notes = nil
+	m.clearedFields[promocode.FieldNotes] = struct{}{}
+}
+
+// NotesCleared returns if the "notes" field was cleared in this mutation.
+func (m *PromoCodeMutation) NotesCleared() bool {
+	_, ok := m.clearedFields[promocode.FieldNotes]
+	return ok
+}
+
+// ResetNotes resets all changes to the "notes" field.
+func (m *PromoCodeMutation) ResetNotes() {
+	m.notes = nil
+	delete(m.clearedFields, promocode.FieldNotes)
+}
+
+// SetScope sets the "scope" field.
+func (m *PromoCodeMutation) SetScope(s string) {
+	m.scope = &s
+}
+
+// Scope returns the value of the "scope" field in the mutation.
+func (m *PromoCodeMutation) Scope() (r string, exists bool) {
+	v := m.scope
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScope returns the old "scope" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldScope(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScope is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScope requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScope: %w", err)
+	}
+	return oldValue.Scope, nil
+}
+
+// ResetScope resets all changes to the "scope" field.
+func (m *PromoCodeMutation) ResetScope() {
+	m.scope = nil
+}
+
+// SetBonusPercent sets the "bonus_percent" field.
+func (m *PromoCodeMutation) SetBonusPercent(f float64) {
+	m.bonus_percent = &f
+	m.addbonus_percent = nil
+}
+
+// BonusPercent returns the value of the "bonus_percent" field in the mutation.
+func (m *PromoCodeMutation) BonusPercent() (r float64, exists bool) {
+	v := m.bonus_percent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBonusPercent returns the old "bonus_percent" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldBonusPercent(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBonusPercent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBonusPercent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBonusPercent: %w", err)
+	}
+	return oldValue.BonusPercent, nil
+}
+
+// AddBonusPercent adds f to the "bonus_percent" field.
+func (m *PromoCodeMutation) AddBonusPercent(f float64) {
+	if m.addbonus_percent != nil {
+		*m.addbonus_percent += f
+	} else {
+		m.addbonus_percent = &f
+	}
+}
+
+// AddedBonusPercent returns the value that was added to the "bonus_percent" field in this mutation.
+func (m *PromoCodeMutation) AddedBonusPercent() (r float64, exists bool) {
+	v := m.addbonus_percent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBonusPercent resets all changes to the "bonus_percent" field.
+func (m *PromoCodeMutation) ResetBonusPercent() {
+	m.bonus_percent = nil
+	m.addbonus_percent = nil
+}
+
+// SetMaxBonusAmount sets the "max_bonus_amount" field.
+func (m *PromoCodeMutation) SetMaxBonusAmount(f float64) {
+	m.max_bonus_amount = &f
+	m.addmax_bonus_amount = nil
+}
+
+// MaxBonusAmount returns the value of the "max_bonus_amount" field in the mutation.
+func (m *PromoCodeMutation) MaxBonusAmount() (r float64, exists bool) {
+	v := m.max_bonus_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxBonusAmount returns the old "max_bonus_amount" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldMaxBonusAmount(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxBonusAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxBonusAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxBonusAmount: %w", err)
+	}
+	return oldValue.MaxBonusAmount, nil
+}
+
+// AddMaxBonusAmount adds f to the "max_bonus_amount" field.
+func (m *PromoCodeMutation) AddMaxBonusAmount(f float64) {
+	if m.addmax_bonus_amount != nil {
+		*m.addmax_bonus_amount += f
+	} else {
+		m.addmax_bonus_amount = &f
+	}
+}
+
+// AddedMaxBonusAmount returns the value that was added to the "max_bonus_amount" field in this mutation.
+func (m *PromoCodeMutation) AddedMaxBonusAmount() (r float64, exists bool) {
+	v := m.addmax_bonus_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxBonusAmount clears the value of the "max_bonus_amount" field.
+func (m *PromoCodeMutation) ClearMaxBonusAmount() {
+	m.max_bonus_amount = nil
+	m.addmax_bonus_amount = nil
+	m.clearedFields[promocode.FieldMaxBonusAmount] = struct{}{}
+}
+
+// MaxBonusAmountCleared returns if the "max_bonus_amount" field was cleared in this mutation.
+func (m *PromoCodeMutation) MaxBonusAmountCleared() bool {
+	_, ok := m.clearedFields[promocode.FieldMaxBonusAmount]
+	return ok
+}
+
+// ResetMaxBonusAmount resets all changes to the "max_bonus_amount" field.
+func (m *PromoCodeMutation) ResetMaxBonusAmount() {
+	m.max_bonus_amount = nil
+	m.addmax_bonus_amount = nil
+	delete(m.clearedFields, promocode.FieldMaxBonusAmount)
+}
+
+// SetTrialGroupID sets the "trial_group_id" field.
+func (m *PromoCodeMutation) SetTrialGroupID(i int64) {
+	m.trial_group_id = &i
+	m.addtrial_group_id = nil
+}
+
+// TrialGroupID returns the value of the "trial_group_id" field in the mutation.
+func (m *PromoCodeMutation) TrialGroupID() (r int64, exists bool) {
+	v := m.trial_group_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrialGroupID returns the old "trial_group_id" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldTrialGroupID(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrialGroupID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrialGroupID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrialGroupID: %w", err)
+	}
+	return oldValue.TrialGroupID, nil
+}
+
+// AddTrialGroupID adds i to the "trial_group_id" field.
+func (m *PromoCodeMutation) AddTrialGroupID(i int64) {
+	if m.addtrial_group_id != nil {
+		*m.addtrial_group_id += i
+	} else {
+		m.addtrial_group_id = &i
+	}
+}
+
+// AddedTrialGroupID returns the value that was added to the "trial_group_id" field in this mutation.
+func (m *PromoCodeMutation) AddedTrialGroupID() (r int64, exists bool) {
+	v := m.addtrial_group_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTrialGroupID clears the value of the "trial_group_id" field.
+func (m *PromoCodeMutation) ClearTrialGroupID() {
+	m.trial_group_id = nil
+	m.addtrial_group_id = nil
+	m.clearedFields[promocode.FieldTrialGroupID] = struct{}{}
+}
+
+// TrialGroupIDCleared returns if the "trial_group_id" field was cleared in this mutation.
+func (m *PromoCodeMutation) TrialGroupIDCleared() bool {
+	_, ok := m.clearedFields[promocode.FieldTrialGroupID]
+	return ok
+}
+
+// ResetTrialGroupID resets all changes to the "trial_group_id" field.
+func (m *PromoCodeMutation) ResetTrialGroupID() {
+	m.trial_group_id = nil
+	m.addtrial_group_id = nil
+	delete(m.clearedFields, promocode.FieldTrialGroupID)
+}
+
+// SetTrialDays sets the "trial_days" field.
+func (m *PromoCodeMutation) SetTrialDays(i int) {
+	m.trial_days = &i
+	m.addtrial_days = nil
+}
+
+// TrialDays returns the value of the "trial_days" field in the mutation.
+func (m *PromoCodeMutation) TrialDays() (r int, exists bool) {
+	v := m.trial_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrialDays returns the old "trial_days" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldTrialDays(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrialDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrialDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrialDays: %w", err)
+	}
+	return oldValue.TrialDays, nil
+}
+
+// AddTrialDays adds i to the "trial_days" field.
+func (m *PromoCodeMutation) AddTrialDays(i int) {
+	if m.addtrial_days != nil {
+		*m.addtrial_days += i
+	} else {
+		m.addtrial_days = &i
+	}
+}
+
+// AddedTrialDays returns the value that was added to the "trial_days" field in this mutation.
+func (m *PromoCodeMutation) AddedTrialDays() (r int, exists bool) {
+	v := m.addtrial_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTrialDays resets all changes to the "trial_days" field.
+func (m *PromoCodeMutation) ResetTrialDays() {
+	m.trial_days = nil
+	m.addtrial_days = nil
+}
+
+// SetNewUserDays sets the "new_user_days" field.
+func (m *PromoCodeMutation) SetNewUserDays(i int) {
+	m.new_user_days = &i
+	m.addnew_user_days = nil
+}
+
+// NewUserDays returns the value of the "new_user_days" field in the mutation.
+func (m *PromoCodeMutation) NewUserDays() (r int, exists bool) {
+	v := m.new_user_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNewUserDays returns the old "new_user_days" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldNewUserDays(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNewUserDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNewUserDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNewUserDays: %w", err)
+	}
+	return oldValue.NewUserDays, nil
+}
+
+// AddNewUserDays adds i to the "new_user_days" field.
+func (m *PromoCodeMutation) AddNewUserDays(i int) {
+	if m.addnew_user_days != nil {
+		*m.addnew_user_days += i
+	} else {
+		m.addnew_user_days = &i
+	}
+}
+
+// AddedNewUserDays returns the value that was added to the "new_user_days" field in this mutation.
+func (m *PromoCodeMutation) AddedNewUserDays() (r int, exists bool) {
+	v := m.addnew_user_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNewUserDays resets all changes to the "new_user_days" field.
+func (m *PromoCodeMutation) ResetNewUserDays() {
+	m.new_user_days = nil
+	m.addnew_user_days = nil
+}
+
+// SetAllowedEmailDomains sets the "allowed_email_domains" field.
+func (m *PromoCodeMutation) SetAllowedEmailDomains(s []string) {
+	m.allowed_email_domains = &s
+	m.appendallowed_email_domains = nil
+}
+
+// AllowedEmailDomains returns the value of the "allowed_email_domains" field in the mutation.
+func (m *PromoCodeMutation) AllowedEmailDomains() (r []string, exists bool) {
+	v := m.allowed_email_domains
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAllowedEmailDomains returns the old "allowed_email_domains" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldAllowedEmailDomains(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAllowedEmailDomains is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAllowedEmailDomains requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAllowedEmailDomains: %w", err)
+	}
+	return oldValue.AllowedEmailDomains, nil
+}
+
+// AppendAllowedEmailDomains adds s to the "allowed_email_domains" field.
+func (m *PromoCodeMutation) AppendAllowedEmailDomains(s []string) {
+	m.appendallowed_email_domains = append(m.appendallowed_email_domains, s...)
+}
+
+// AppendedAllowedEmailDomains returns the list of values that were appended to the "allowed_email_domains" field in this mutation.
+func (m *PromoCodeMutation) AppendedAllowedEmailDomains() ([]string, bool) {
+	if len(m.appendallowed_email_domains) == 0 {
+		return nil, false
+	}
+	return m.appendallowed_email_domains, true
+}
+
+// ClearAllowedEmailDomains clears the value of the "allowed_email_domains" field.
+func (m *PromoCodeMutation) ClearAllowedEmailDomains() {
+	m.allowed_email_domains = nil
+	m.appendallowed_email_domains = nil
+	m.clearedFields[promocode.FieldAllowedEmailDomains] = struct{}{}
+}
+
+// AllowedEmailDomainsCleared returns if the "allowed_email_domains" field was cleared in this mutation.
+func (m *PromoCodeMutation) AllowedEmailDomainsCleared() bool {
+	_, ok := m.clearedFields[promocode.FieldAllowedEmailDomains]
+	return ok
+}
+
+// ResetAllowedEmailDomains resets all changes to the "allowed_email_domains" field.
+func (m *PromoCodeMutation) ResetAllowedEmailDomains() {
+	m.allowed_email_domains = nil
+	m.appendallowed_email_domains = nil
+	delete(m.clearedFields, promocode.FieldAllowedEmailDomains)
+}
+
+// SetStackable sets the "stackable" field.
+func (m *PromoCodeMutation) SetStackable(b bool) {
+	m.stackable = &b
+}
+
+// Stackable returns the value of the "stackable" field in the mutation.
+func (m *PromoCodeMutation) Stackable() (r bool, exists bool) {
+	v := m.stackable
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStackable returns the old "stackable" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldStackable(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStackable is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStackable requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStackable: %w", err)
+	}
+	return oldValue.Stackable, nil
+}
+
+// ResetStackable resets all changes to the "stackable" field.
+func (m *PromoCodeMutation) ResetStackable() {
+	m.stackable = nil
+}
+
+// SetMaxUsesPerUser sets the "max_uses_per_user" field.
+func (m *PromoCodeMutation) SetMaxUsesPerUser(i int) {
+	m.max_uses_per_user = &i
+	m.addmax_uses_per_user = nil
+}
+
+// MaxUsesPerUser returns the value of the "max_uses_per_user" field in the mutation.
+func (m *PromoCodeMutation) MaxUsesPerUser() (r int, exists bool) {
+	v := m.max_uses_per_user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxUsesPerUser returns the old "max_uses_per_user" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldMaxUsesPerUser(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxUsesPerUser is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxUsesPerUser requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxUsesPerUser: %w", err)
+	}
+	return oldValue.MaxUsesPerUser, nil
+}
+
+// AddMaxUsesPerUser adds i to the "max_uses_per_user" field.
+func (m *PromoCodeMutation) AddMaxUsesPerUser(i int) {
+	if m.addmax_uses_per_user != nil {
+		*m.addmax_uses_per_user += i
+	} else {
+		m.addmax_uses_per_user = &i
+	}
+}
+
+// AddedMaxUsesPerUser returns the value that was added to the "max_uses_per_user" field in this mutation.
+func (m *PromoCodeMutation) AddedMaxUsesPerUser() (r int, exists bool) {
+	v := m.addmax_uses_per_user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxUsesPerUser resets all changes to the "max_uses_per_user" field.
+func (m *PromoCodeMutation) ResetMaxUsesPerUser() {
+	m.max_uses_per_user = nil
+	m.addmax_uses_per_user = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PromoCodeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PromoCodeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PromoCodeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PromoCodeMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PromoCodeMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the PromoCode entity.
+// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PromoCodeMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddUsageRecordIDs adds the "usage_records" edge to the PromoCodeUsage entity by ids.
+func (m *PromoCodeMutation) AddUsageRecordIDs(ids ...int64) {
+	if m.usage_records == nil {
+		m.usage_records = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.usage_records[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUsageRecords clears the "usage_records" edge to the PromoCodeUsage entity.
+func (m *PromoCodeMutation) ClearUsageRecords() {
+	m.clearedusage_records = true
+}
+
+// UsageRecordsCleared reports if the "usage_records" edge to the PromoCodeUsage entity was cleared.
+func (m *PromoCodeMutation) UsageRecordsCleared() bool {
+	return m.clearedusage_records
+}
+
+// RemoveUsageRecordIDs removes the "usage_records" edge to the PromoCodeUsage entity by IDs.
+func (m *PromoCodeMutation) RemoveUsageRecordIDs(ids ...int64) {
+	if m.removedusage_records == nil {
+		m.removedusage_records = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.usage_records, ids[i])
+		m.removedusage_records[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUsageRecords returns the removed IDs of the "usage_records" edge to the PromoCodeUsage entity.
+func (m *PromoCodeMutation) RemovedUsageRecordsIDs() (ids []int64) {
+	for id := range m.removedusage_records {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UsageRecordsIDs returns the "usage_records" edge IDs in the mutation.
+func (m *PromoCodeMutation) UsageRecordsIDs() (ids []int64) {
+	for id := range m.usage_records {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUsageRecords resets all changes to the "usage_records" edge.
+func (m *PromoCodeMutation) ResetUsageRecords() {
+	m.usage_records = nil
+	m.clearedusage_records = false
+	m.removedusage_records = nil
+}
+
+// Where appends a list predicates to the PromoCodeMutation builder.
+func (m *PromoCodeMutation) Where(ps ...predicate.PromoCode) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PromoCodeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PromoCodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PromoCode, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PromoCodeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PromoCodeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PromoCode).
+func (m *PromoCodeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PromoCodeMutation) Fields() []string {
+	fields := make([]string, 0, 19)
+	if m.code != nil {
+		fields = append(fields, promocode.FieldCode)
+	}
+	if m.bonus_amount != nil {
+		fields = append(fields, promocode.FieldBonusAmount)
+	}
+	if m.credit_validity_days != nil {
+		fields = append(fields, promocode.FieldCreditValidityDays)
+	}
+	if m.max_uses != nil {
+		fields = append(fields, promocode.FieldMaxUses)
+	}
+	if m.used_count != nil {
+		fields = append(fields, promocode.FieldUsedCount)
+	}
+	if m.status != nil {
+		fields = append(fields, promocode.FieldStatus)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, promocode.FieldExpiresAt)
+	}
+	if m.notes != nil {
+		fields = append(fields, promocode.FieldNotes)
+	}
+	if m.scope != nil {
+		fields = append(fields, promocode.FieldScope)
+	}
+	if m.bonus_percent != nil {
+		fields = append(fields, promocode.FieldBonusPercent)
+	}
+	if m.max_bonus_amount != nil {
+		fields = append(fields, promocode.FieldMaxBonusAmount)
+	}
+	if m.trial_group_id != nil {
+		fields = append(fields, promocode.FieldTrialGroupID)
+	}
+	if m.trial_days != nil {
+		fields = append(fields, promocode.FieldTrialDays)
+	}
+	if m.new_user_days != nil {
+		fields = append(fields, promocode.FieldNewUserDays)
+	}
+	if m.allowed_email_domains != nil {
+		fields = append(fields, promocode.FieldAllowedEmailDomains)
+	}
+	if m.stackable != nil {
+		fields = append(fields, promocode.FieldStackable)
+	}
+	if m.max_uses_per_user != nil {
+		fields = append(fields, promocode.FieldMaxUsesPerUser)
+	}
+	if m.created_at != nil {
+		fields = append(fields, promocode.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, promocode.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PromoCodeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case promocode.FieldCode:
+		return m.Code()
+	case promocode.FieldBonusAmount:
+		return m.BonusAmount()
+	case promocode.FieldCreditValidityDays:
+		return m.CreditValidityDays()
+	case promocode.FieldMaxUses:
+		return m.MaxUses()
+	case promocode.FieldUsedCount:
+		return m.UsedCount()
+	case promocode.FieldStatus:
+		return m.Status()
+	case promocode.FieldExpiresAt:
+		return m.ExpiresAt()
+	case promocode.FieldNotes:
+		return m.Notes()
+	case promocode.FieldScope:
+		return m.Scope()
+	case promocode.FieldBonusPercent:
+		return m.BonusPercent()
+	case promocode.FieldMaxBonusAmount:
+		return m.MaxBonusAmount()
+	case promocode.FieldTrialGroupID:
+		return m.TrialGroupID()
+	case promocode.FieldTrialDays:
+		return m.TrialDays()
+	case promocode.FieldNewUserDays:
+		return m.NewUserDays()
+	case promocode.FieldAllowedEmailDomains:
+		return m.AllowedEmailDomains()
+	case promocode.FieldStackable:
+		return m.Stackable()
+	case promocode.FieldMaxUsesPerUser:
+		return m.MaxUsesPerUser()
+	case promocode.FieldCreatedAt:
+		return m.CreatedAt()
+	case promocode.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -5543,240 +9310,225 @@ func (m *GroupMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *GroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PromoCodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case group.FieldCreatedAt:
+	case promocode.FieldCode:
+		return m.OldCode(ctx)
+	case promocode.FieldBonusAmount:
+		return m.OldBonusAmount(ctx)
+	case promocode.FieldCreditValidityDays:
+		return m.OldCreditValidityDays(ctx)
+	case promocode.FieldMaxUses:
+		return m.OldMaxUses(ctx)
+	case promocode.FieldUsedCount:
+		return m.OldUsedCount(ctx)
+	case promocode.FieldStatus:
+		return m.OldStatus(ctx)
+	case promocode.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case promocode.FieldNotes:
+		return m.OldNotes(ctx)
+	case promocode.FieldScope:
+		return m.OldScope(ctx)
+	case promocode.FieldBonusPercent:
+		return m.OldBonusPercent(ctx)
+	case promocode.FieldMaxBonusAmount:
+		return m.OldMaxBonusAmount(ctx)
+	case promocode.FieldTrialGroupID:
+		return m.OldTrialGroupID(ctx)
+	case promocode.FieldTrialDays:
+		return m.OldTrialDays(ctx)
+	case promocode.FieldNewUserDays:
+		return m.OldNewUserDays(ctx)
+	case promocode.FieldAllowedEmailDomains:
+		return m.OldAllowedEmailDomains(ctx)
+	case promocode.FieldStackable:
+		return m.OldStackable(ctx)
+	case promocode.FieldMaxUsesPerUser:
+		return m.OldMaxUsesPerUser(ctx)
+	case promocode.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case group.FieldUpdatedAt:
+	case promocode.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case group.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
-	case group.FieldName:
-		return m.OldName(ctx)
-	case group.FieldDescription:
-		return m.OldDescription(ctx)
-	case group.FieldRateMultiplier:
-		return m.OldRateMultiplier(ctx)
-	case group.FieldIsExclusive:
-		return m.OldIsExclusive(ctx)
-	case group.FieldStatus:
-		return m.OldStatus(ctx)
-	case group.FieldPlatform:
-		return m.OldPlatform(ctx)
-	case group.FieldSubscriptionType:
-		return m.OldSubscriptionType(ctx)
-	case group.FieldDailyLimitUsd:
-		return m.OldDailyLimitUsd(ctx)
-	case group.FieldWeeklyLimitUsd:
-		return m.OldWeeklyLimitUsd(ctx)
-	case group.FieldMonthlyLimitUsd:
-		return m.OldMonthlyLimitUsd(ctx)
-	case group.FieldDefaultValidityDays:
-		return m.OldDefaultValidityDays(ctx)
-	case group.FieldImagePrice1k:
-		return m.OldImagePrice1k(ctx)
-	case group.FieldImagePrice2k:
-		return m.OldImagePrice2k(ctx)
-	case group.FieldImagePrice4k:
-		return m.OldImagePrice4k(ctx)
-	case group.FieldClaudeCodeOnly:
-		return m.OldClaudeCodeOnly(ctx)
-	case group.FieldFallbackGroupID:
-		return m.OldFallbackGroupID(ctx)
-	case group.FieldModelRouting:
-		return m.OldModelRouting(ctx)
-	case group.FieldModelRoutingEnabled:
-		return m.OldModelRoutingEnabled(ctx)
 	}
-	return nil, fmt.Errorf("unknown Group field %s", name)
+	return nil, fmt.Errorf("unknown PromoCode field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GroupMutation) SetField(name string, value ent.Value) error {
+func (m *PromoCodeMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case group.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case group.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case group.FieldDeletedAt:
-		v, ok := value.(time.Time)
+	case promocode.FieldCode:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetCode(v)
 		return nil
-	case group.FieldName:
-		v, ok := value.(string)
+	case promocode.FieldBonusAmount:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetBonusAmount(v)
 		return nil
-	case group.FieldDescription:
-		v, ok := value.(string)
+	case promocode.FieldCreditValidityDays:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetCreditValidityDays(v)
 		return nil
-	case group.FieldRateMultiplier:
-		v, ok := value.(float64)
+	case promocode.FieldMaxUses:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRateMultiplier(v)
+		m.SetMaxUses(v)
 		return nil
-	case group.FieldIsExclusive:
-		v, ok := value.(bool)
+	case promocode.FieldUsedCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsExclusive(v)
+		m.SetUsedCount(v)
 		return nil
-	case group.FieldStatus:
+	case promocode.FieldStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetStatus(v)
 		return nil
-	case group.FieldPlatform:
-		v, ok := value.(string)
+	case promocode.FieldExpiresAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPlatform(v)
+		m.SetExpiresAt(v)
 		return nil
-	case group.FieldSubscriptionType:
+	case promocode.FieldNotes:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSubscriptionType(v)
+		m.SetNotes(v)
 		return nil
-	case group.FieldDailyLimitUsd:
-		v, ok := value.(float64)
+	case promocode.FieldScope:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDailyLimitUsd(v)
+		m.SetScope(v)
 		return nil
-	case group.FieldWeeklyLimitUsd:
+	case promocode.FieldBonusPercent:
 		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetWeeklyLimitUsd(v)
+		m.SetBonusPercent(v)
 		return nil
-	case group.FieldMonthlyLimitUsd:
+	case promocode.FieldMaxBonusAmount:
 		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMonthlyLimitUsd(v)
+		m.SetMaxBonusAmount(v)
 		return nil
-	case group.FieldDefaultValidityDays:
-		v, ok := value.(int)
+	case promocode.FieldTrialGroupID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDefaultValidityDays(v)
+		m.SetTrialGroupID(v)
 		return nil
-	case group.FieldImagePrice1k:
-		v, ok := value.(float64)
+	case promocode.FieldTrialDays:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetImagePrice1k(v)
+		m.SetTrialDays(v)
 		return nil
-	case group.FieldImagePrice2k:
-		v, ok := value.(float64)
+	case promocode.FieldNewUserDays:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetImagePrice2k(v)
+		m.SetNewUserDays(v)
 		return nil
-	case group.FieldImagePrice4k:
-		v, ok := value.(float64)
+	case promocode.FieldAllowedEmailDomains:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetImagePrice4k(v)
+		m.SetAllowedEmailDomains(v)
 		return nil
-	case group.FieldClaudeCodeOnly:
+	case promocode.FieldStackable:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClaudeCodeOnly(v)
+		m.SetStackable(v)
 		return nil
-	case group.FieldFallbackGroupID:
-		v, ok := value.(int64)
+	case promocode.FieldMaxUsesPerUser:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFallbackGroupID(v)
+		m.SetMaxUsesPerUser(v)
 		return nil
-	case group.FieldModelRouting:
-		v, ok := value.(map[string][]int64)
+	case promocode.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetModelRouting(v)
-		return nil
-	case group.FieldModelRoutingEnabled:
-		v, ok := value.(bool)
+		m.SetCreatedAt(v)
+		return nil
+	case promocode.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetModelRoutingEnabled(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Group field %s", name)
+	return fmt.Errorf("unknown PromoCode field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *GroupMutation) AddedFields() []string {
+func (m *PromoCodeMutation) AddedFields() []string {
 	var fields []string
-	if m.addrate_multiplier != nil {
-		fields = append(fields, group.FieldRateMultiplier)
+	if m.addbonus_amount != nil {
+		fields = append(fields, promocode.FieldBonusAmount)
 	}
-	if m.adddaily_limit_usd != nil {
-		fields = append(fields, group.FieldDailyLimitUsd)
+	if m.addcredit_validity_days != nil {
+		fields = append(fields, promocode.FieldCreditValidityDays)
 	}
-	if m.addweekly_limit_usd != nil {
-		fields = append(fields, group.FieldWeeklyLimitUsd)
+	if m.addmax_uses != nil {
+		fields = append(fields, promocode.FieldMaxUses)
 	}
-	if m.addmonthly_limit_usd != nil {
-		fields = append(fields, group.FieldMonthlyLimitUsd)
+	if m.addused_count != nil {
+		fields = append(fields, promocode.FieldUsedCount)
 	}
-	if m.adddefault_validity_days != nil {
-		fields = append(fields, group.FieldDefaultValidityDays)
+	if m.addbonus_percent != nil {
+		fields = append(fields, promocode.FieldBonusPercent)
 	}
-	if m.addimage_price_1k != nil {
-		fields = append(fields, group.FieldImagePrice1k)
+	if m.addmax_bonus_amount != nil {
+		fields = append(fields, promocode.FieldMaxBonusAmount)
 	}
-	if m.addimage_price_2k != nil {
-		fields = append(fields, group.FieldImagePrice2k)
+	if m.addtrial_group_id != nil {
+		fields = append(fields, promocode.FieldTrialGroupID)
 	}
-	if m.addimage_price_4k != nil {
-		fields = append(fields, group.FieldImagePrice4k)
+	if m.addtrial_days != nil {
+		fields = append(fields, promocode.FieldTrialDays)
 	}
-	if m.addfallback_group_id != nil {
-		fields = append(fields, group.FieldFallbackGroupID)
+	if m.addnew_user_days != nil {
+		fields = append(fields, promocode.FieldNewUserDays)
+	}
+	if m.addmax_uses_per_user != nil {
+		fields = append(fields, promocode.FieldMaxUsesPerUser)
 	}
 	return fields
 }
@@ -5784,26 +9536,28 @@ func (m *GroupMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *GroupMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PromoCodeMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case group.FieldRateMultiplier:
-		return m.AddedRateMultiplier()
-	case group.FieldDailyLimitUsd:
-		return m.AddedDailyLimitUsd()
-	case group.FieldWeeklyLimitUsd:
-		return m.AddedWeeklyLimitUsd()
-	case group.FieldMonthlyLimitUsd:
-		return m.AddedMonthlyLimitUsd()
-	case group.FieldDefaultValidityDays:
-		return m.AddedDefaultValidityDays()
-	case group.FieldImagePrice1k:
-		return m.AddedImagePrice1k()
-	case group.FieldImagePrice2k:
-		return m.AddedImagePrice2k()
-	case group.FieldImagePrice4k:
-		return m.AddedImagePrice4k()
-	case group.FieldFallbackGroupID:
-		return m.AddedFallbackGroupID()
+	case promocode.FieldBonusAmount:
+		return m.AddedBonusAmount()
+	case promocode.FieldCreditValidityDays:
+		return m.AddedCreditValidityDays()
+	case promocode.FieldMaxUses:
+		return m.AddedMaxUses()
+	case promocode.FieldUsedCount:
+		return m.AddedUsedCount()
+	case promocode.FieldBonusPercent:
+		return m.AddedBonusPercent()
+	case promocode.FieldMaxBonusAmount:
+		return m.AddedMaxBonusAmount()
+	case promocode.FieldTrialGroupID:
+		return m.AddedTrialGroupID()
+	case promocode.FieldTrialDays:
+		return m.AddedTrialDays()
+	case promocode.FieldNewUserDays:
+		return m.AddedNewUserDays()
+	case promocode.FieldMaxUsesPerUser:
+		return m.AddedMaxUsesPerUser()
 	}
 	return nil, false
 }
@@ -5811,289 +9565,215 @@ func (m *GroupMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GroupMutation) AddField(name string, value ent.Value) error {
+func (m *PromoCodeMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case group.FieldRateMultiplier:
+	case promocode.FieldBonusAmount:
 		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddRateMultiplier(v)
+		m.AddBonusAmount(v)
 		return nil
-	case group.FieldDailyLimitUsd:
-		v, ok := value.(float64)
+	case promocode.FieldCreditValidityDays:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddDailyLimitUsd(v)
+		m.AddCreditValidityDays(v)
 		return nil
-	case group.FieldWeeklyLimitUsd:
-		v, ok := value.(float64)
+	case promocode.FieldMaxUses:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddWeeklyLimitUsd(v)
+		m.AddMaxUses(v)
 		return nil
-	case group.FieldMonthlyLimitUsd:
-		v, ok := value.(float64)
+	case promocode.FieldUsedCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddMonthlyLimitUsd(v)
+		m.AddUsedCount(v)
 		return nil
-	case group.FieldDefaultValidityDays:
-		v, ok := value.(int)
+	case promocode.FieldBonusPercent:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddDefaultValidityDays(v)
+		m.AddBonusPercent(v)
 		return nil
-	case group.FieldImagePrice1k:
+	case promocode.FieldMaxBonusAmount:
 		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddImagePrice1k(v)
+		m.AddMaxBonusAmount(v)
 		return nil
-	case group.FieldImagePrice2k:
-		v, ok := value.(float64)
+	case promocode.FieldTrialGroupID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddImagePrice2k(v)
+		m.AddTrialGroupID(v)
 		return nil
-	case group.FieldImagePrice4k:
-		v, ok := value.(float64)
+	case promocode.FieldTrialDays:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddImagePrice4k(v)
+		m.AddTrialDays(v)
 		return nil
-	case group.FieldFallbackGroupID:
-		v, ok := value.(int64)
+	case promocode.FieldNewUserDays:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddFallbackGroupID(v)
+		m.AddNewUserDays(v)
+		return nil
+	case promocode.FieldMaxUsesPerUser:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxUsesPerUser(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Group numeric field %s", name)
+	return fmt.Errorf("unknown PromoCode numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *GroupMutation) ClearedFields() []string {
+func (m *PromoCodeMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(group.FieldDeletedAt) {
-		fields = append(fields, group.FieldDeletedAt)
-	}
-	if m.FieldCleared(group.FieldDescription) {
-		fields = append(fields, group.FieldDescription)
-	}
-	if m.FieldCleared(group.FieldDailyLimitUsd) {
-		fields = append(fields, group.FieldDailyLimitUsd)
-	}
-	if m.FieldCleared(group.FieldWeeklyLimitUsd) {
-		fields = append(fields, group.FieldWeeklyLimitUsd)
-	}
-	if m.FieldCleared(group.FieldMonthlyLimitUsd) {
-		fields = append(fields, group.FieldMonthlyLimitUsd)
-	}
-	if m.FieldCleared(group.FieldImagePrice1k) {
-		fields = append(fields, group.FieldImagePrice1k)
+	if m.FieldCleared(promocode.FieldExpiresAt) {
+		fields = append(fields, promocode.FieldExpiresAt)
 	}
-	if m.FieldCleared(group.FieldImagePrice2k) {
-		fields = append(fields, group.FieldImagePrice2k)
+	if m.FieldCleared(promocode.FieldNotes) {
+		fields = append(fields, promocode.FieldNotes)
 	}
-	if m.FieldCleared(group.FieldImagePrice4k) {
-		fields = append(fields, group.FieldImagePrice4k)
+	if m.FieldCleared(promocode.FieldMaxBonusAmount) {
+		fields = append(fields, promocode.FieldMaxBonusAmount)
 	}
-	if m.FieldCleared(group.FieldFallbackGroupID) {
-		fields = append(fields, group.FieldFallbackGroupID)
+	if m.FieldCleared(promocode.FieldTrialGroupID) {
+		fields = append(fields, promocode.FieldTrialGroupID)
 	}
-	if m.FieldCleared(group.FieldModelRouting) {
-		fields = append(fields, group.FieldModelRouting)
+	if m.FieldCleared(promocode.FieldAllowedEmailDomains) {
+		fields = append(fields, promocode.FieldAllowedEmailDomains)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *GroupMutation) FieldCleared(name string) bool {
+func (m *PromoCodeMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *GroupMutation) ClearField(name string) error {
+func (m *PromoCodeMutation) ClearField(name string) error {
 	switch name {
-	case group.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
-	case group.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case group.FieldDailyLimitUsd:
-		m.ClearDailyLimitUsd()
-		return nil
-	case group.FieldWeeklyLimitUsd:
-		m.ClearWeeklyLimitUsd()
-		return nil
-	case group.FieldMonthlyLimitUsd:
-		m.ClearMonthlyLimitUsd()
-		return nil
-	case group.FieldImagePrice1k:
-		m.ClearImagePrice1k()
+	case promocode.FieldExpiresAt:
+		m.ClearExpiresAt()
 		return nil
-	case group.FieldImagePrice2k:
-		m.ClearImagePrice2k()
+	case promocode.FieldNotes:
+		m.ClearNotes()
 		return nil
-	case group.FieldImagePrice4k:
-		m.ClearImagePrice4k()
+	case promocode.FieldMaxBonusAmount:
+		m.ClearMaxBonusAmount()
 		return nil
-	case group.FieldFallbackGroupID:
-		m.ClearFallbackGroupID()
+	case promocode.FieldTrialGroupID:
+		m.ClearTrialGroupID()
 		return nil
-	case group.FieldModelRouting:
-		m.ClearModelRouting()
+	case promocode.FieldAllowedEmailDomains:
+		m.ClearAllowedEmailDomains()
 		return nil
 	}
-	return fmt.Errorf("unknown Group nullable field %s", name)
+	return fmt.Errorf("unknown PromoCode nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *GroupMutation) ResetField(name string) error {
+func (m *PromoCodeMutation) ResetField(name string) error {
 	switch name {
-	case group.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case group.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case group.FieldDeletedAt:
-		m.ResetDeletedAt()
+	case promocode.FieldCode:
+		m.ResetCode()
 		return nil
-	case group.FieldName:
-		m.ResetName()
+	case promocode.FieldBonusAmount:
+		m.ResetBonusAmount()
 		return nil
-	case group.FieldDescription:
-		m.ResetDescription()
+	case promocode.FieldCreditValidityDays:
+		m.ResetCreditValidityDays()
 		return nil
-	case group.FieldRateMultiplier:
-		m.ResetRateMultiplier()
+	case promocode.FieldMaxUses:
+		m.ResetMaxUses()
 		return nil
-	case group.FieldIsExclusive:
-		m.ResetIsExclusive()
+	case promocode.FieldUsedCount:
+		m.ResetUsedCount()
 		return nil
-	case group.FieldStatus:
+	case promocode.FieldStatus:
 		m.ResetStatus()
 		return nil
-	case group.FieldPlatform:
-		m.ResetPlatform()
+	case promocode.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
-	case group.FieldSubscriptionType:
-		m.ResetSubscriptionType()
+	case promocode.FieldNotes:
+		m.ResetNotes()
 		return nil
-	case group.FieldDailyLimitUsd:
-		m.ResetDailyLimitUsd()
+	case promocode.FieldScope:
+		m.ResetScope()
 		return nil
-	case group.FieldWeeklyLimitUsd:
-		m.ResetWeeklyLimitUsd()
+	case promocode.FieldBonusPercent:
+		m.ResetBonusPercent()
 		return nil
-	case group.FieldMonthlyLimitUsd:
-		m.ResetMonthlyLimitUsd()
+	case promocode.FieldMaxBonusAmount:
+		m.ResetMaxBonusAmount()
 		return nil
-	case group.FieldDefaultValidityDays:
-		m.ResetDefaultValidityDays()
+	case promocode.FieldTrialGroupID:
+		m.ResetTrialGroupID()
 		return nil
-	case group.FieldImagePrice1k:
-		m.ResetImagePrice1k()
+	case promocode.FieldTrialDays:
+		m.ResetTrialDays()
 		return nil
-	case group.FieldImagePrice2k:
-		m.ResetImagePrice2k()
+	case promocode.FieldNewUserDays:
+		m.ResetNewUserDays()
 		return nil
-	case group.FieldImagePrice4k:
-		m.ResetImagePrice4k()
+	case promocode.FieldAllowedEmailDomains:
+		m.ResetAllowedEmailDomains()
 		return nil
-	case group.FieldClaudeCodeOnly:
-		m.ResetClaudeCodeOnly()
+	case promocode.FieldStackable:
+		m.ResetStackable()
 		return nil
-	case group.FieldFallbackGroupID:
-		m.ResetFallbackGroupID()
+	case promocode.FieldMaxUsesPerUser:
+		m.ResetMaxUsesPerUser()
 		return nil
-	case group.FieldModelRouting:
-		m.ResetModelRouting()
+	case promocode.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case group.FieldModelRoutingEnabled:
-		m.ResetModelRoutingEnabled()
+	case promocode.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Group field %s", name)
+	return fmt.Errorf("unknown PromoCode field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GroupMutation) AddedEdges() []string {
-	edges := make([]string, 0, 6)
-	if m.api_keys != nil {
-		edges = append(edges, group.EdgeAPIKeys)
-	}
-	if m.redeem_codes != nil {
-		edges = append(edges, group.EdgeRedeemCodes)
-	}
-	if m.subscriptions != nil {
-		edges = append(edges, group.EdgeSubscriptions)
-	}
-	if m.usage_logs != nil {
-		edges = append(edges, group.EdgeUsageLogs)
-	}
-	if m.accounts != nil {
-		edges = append(edges, group.EdgeAccounts)
-	}
-	if m.allowed_users != nil {
-		edges = append(edges, group.EdgeAllowedUsers)
+func (m *PromoCodeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.usage_records != nil {
+		edges = append(edges, promocode.EdgeUsageRecords)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *GroupMutation) AddedIDs(name string) []ent.Value {
+func (m *PromoCodeMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case group.EdgeAPIKeys:
-		ids := make([]ent.Value, 0, len(m.api_keys))
-		for id := range m.api_keys {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeRedeemCodes:
-		ids := make([]ent.Value, 0, len(m.redeem_codes))
-		for id := range m.redeem_codes {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeSubscriptions:
-		ids := make([]ent.Value, 0, len(m.subscriptions))
-		for id := range m.subscriptions {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeUsageLogs:
-		ids := make([]ent.Value, 0, len(m.usage_logs))
-		for id := range m.usage_logs {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeAccounts:
-		ids := make([]ent.Value, 0, len(m.accounts))
-		for id := range m.accounts {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeAllowedUsers:
-		ids := make([]ent.Value, 0, len(m.allowed_users))
-		for id := range m.allowed_users {
+	case promocode.EdgeUsageRecords:
+		ids := make([]ent.Value, 0, len(m.usage_records))
+		for id := range m.usage_records {
 			ids = append(ids, id)
 		}
 		return ids
@@ -6102,66 +9782,21 @@ func (m *GroupMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GroupMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 6)
-	if m.removedapi_keys != nil {
-		edges = append(edges, group.EdgeAPIKeys)
-	}
-	if m.removedredeem_codes != nil {
-		edges = append(edges, group.EdgeRedeemCodes)
-	}
-	if m.removedsubscriptions != nil {
-		edges = append(edges, group.EdgeSubscriptions)
-	}
-	if m.removedusage_logs != nil {
-		edges = append(edges, group.EdgeUsageLogs)
-	}
-	if m.removedaccounts != nil {
-		edges = append(edges, group.EdgeAccounts)
-	}
-	if m.removedallowed_users != nil {
-		edges = append(edges, group.EdgeAllowedUsers)
+func (m *PromoCodeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedusage_records != nil {
+		edges = append(edges, promocode.EdgeUsageRecords)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *GroupMutation) RemovedIDs(name string) []ent.Value {
+func (m *PromoCodeMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case group.EdgeAPIKeys:
-		ids := make([]ent.Value, 0, len(m.removedapi_keys))
-		for id := range m.removedapi_keys {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeRedeemCodes:
-		ids := make([]ent.Value, 0, len(m.removedredeem_codes))
-		for id := range m.removedredeem_codes {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeSubscriptions:
-		ids := make([]ent.Value, 0, len(m.removedsubscriptions))
-		for id := range m.removedsubscriptions {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeUsageLogs:
-		ids := make([]ent.Value, 0, len(m.removedusage_logs))
-		for id := range m.removedusage_logs {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeAccounts:
-		ids := make([]ent.Value, 0, len(m.removedaccounts))
-		for id := range m.removedaccounts {
-			ids = append(ids, id)
-		}
-		return ids
-	case group.EdgeAllowedUsers:
-		ids := make([]ent.Value, 0, len(m.removedallowed_users))
-		for id := range m.removedallowed_users {
+	case promocode.EdgeUsageRecords:
+		ids := make([]ent.Value, 0, len(m.removedusage_records))
+		for id := range m.removedusage_records {
 			ids = append(ids, id)
 		}
 		return ids
@@ -6170,121 +9805,81 @@ func (m *GroupMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GroupMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 6)
-	if m.clearedapi_keys {
-		edges = append(edges, group.EdgeAPIKeys)
-	}
-	if m.clearedredeem_codes {
-		edges = append(edges, group.EdgeRedeemCodes)
-	}
-	if m.clearedsubscriptions {
-		edges = append(edges, group.EdgeSubscriptions)
-	}
-	if m.clearedusage_logs {
-		edges = append(edges, group.EdgeUsageLogs)
-	}
-	if m.clearedaccounts {
-		edges = append(edges, group.EdgeAccounts)
-	}
-	if m.clearedallowed_users {
-		edges = append(edges, group.EdgeAllowedUsers)
+func (m *PromoCodeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedusage_records {
+		edges = append(edges, promocode.EdgeUsageRecords)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *GroupMutation) EdgeCleared(name string) bool {
+func (m *PromoCodeMutation) EdgeCleared(name string) bool {
 	switch name {
-	case group.EdgeAPIKeys:
-		return m.clearedapi_keys
-	case group.EdgeRedeemCodes:
-		return m.clearedredeem_codes
-	case group.EdgeSubscriptions:
-		return m.clearedsubscriptions
-	case group.EdgeUsageLogs:
-		return m.clearedusage_logs
-	case group.EdgeAccounts:
-		return m.clearedaccounts
-	case group.EdgeAllowedUsers:
-		return m.clearedallowed_users
+	case promocode.EdgeUsageRecords:
+		return m.clearedusage_records
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *GroupMutation) ClearEdge(name string) error {
+func (m *PromoCodeMutation) ClearEdge(name string) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Group unique edge %s", name)
+	return fmt.Errorf("unknown PromoCode unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *GroupMutation) ResetEdge(name string) error {
+func (m *PromoCodeMutation) ResetEdge(name string) error {
 	switch name {
-	case group.EdgeAPIKeys:
-		m.ResetAPIKeys()
-		return nil
-	case group.EdgeRedeemCodes:
-		m.ResetRedeemCodes()
-		return nil
-	case group.EdgeSubscriptions:
-		m.ResetSubscriptions()
-		return nil
-	case group.EdgeUsageLogs:
-		m.ResetUsageLogs()
-		return nil
-	case group.EdgeAccounts:
-		m.ResetAccounts()
-		return nil
-	case group.EdgeAllowedUsers:
-		m.ResetAllowedUsers()
+	case promocode.EdgeUsageRecords:
+		m.ResetUsageRecords()
 		return nil
 	}
-	return fmt.Errorf("unknown Group edge %s", name)
+	return fmt.Errorf("unknown PromoCode edge %s", name)
 }
 
-// PromoCodeMutation represents an operation that mutates the PromoCode nodes in the graph.
-type PromoCodeMutation struct {
+// PromoCodeUsageMutation represents an operation that mutates the PromoCodeUsage nodes in the graph.
+type PromoCodeUsageMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *int64
-	code                 *string
-	bonus_amount         *float64
-	addbonus_amount      *float64
-	max_uses             *int
-	addmax_uses          *int
-	used_count           *int
-	addused_count        *int
-	status               *string
-	expires_at           *time.Time
-	notes                *string
-	created_at           *time.Time
-	updated_at           *time.Time
-	clearedFields        map[string]struct{}
-	usage_records        map[int64]struct{}
-	removedusage_records map[int64]struct{}
-	clearedusage_records bool
-	done                 bool
-	oldValue             func(context.Context) (*PromoCode, error)
-	predicates           []predicate.PromoCode
+	op                  Op
+	typ                 string
+	id                  *int64
+	bonus_amount        *float64
+	addbonus_amount     *float64
+	topup_amount        *float64
+	addtopup_amount     *float64
+	payment_order_id    *int64
+	addpayment_order_id *int64
+	trial_group_id      *int64
+	addtrial_group_id   *int64
+	trial_days          *int
+	addtrial_days       *int
+	used_at             *time.Time
+	clearedFields       map[string]struct{}
+	promo_code          *int64
+	clearedpromo_code   bool
+	user                *int64
+	cleareduser         bool
+	done                bool
+	oldValue            func(context.Context) (*PromoCodeUsage, error)
+	predicates          []predicate.PromoCodeUsage
 }
 
-var _ ent.Mutation = (*PromoCodeMutation)(nil)
+var _ ent.Mutation = (*PromoCodeUsageMutation)(nil)
 
-// promocodeOption allows management of the mutation configuration using functional options.
-type promocodeOption func(*PromoCodeMutation)
+// promocodeusageOption allows management of the mutation configuration using functional options.
+type promocodeusageOption func(*PromoCodeUsageMutation)
 
-// newPromoCodeMutation creates new mutation for the PromoCode entity.
-func newPromoCodeMutation(c config, op Op, opts ...promocodeOption) *PromoCodeMutation {
-	m := &PromoCodeMutation{
+// newPromoCodeUsageMutation creates new mutation for the PromoCodeUsage entity.
+func newPromoCodeUsageMutation(c config, op Op, opts ...promocodeusageOption) *PromoCodeUsageMutation {
+	m := &PromoCodeUsageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePromoCode,
+		typ:           TypePromoCodeUsage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6293,20 +9888,20 @@ func newPromoCodeMutation(c config, op Op, opts ...promocodeOption) *PromoCodeMu
 	return m
 }
 
-// withPromoCodeID sets the ID field of the mutation.
-func withPromoCodeID(id int64) promocodeOption {
-	return func(m *PromoCodeMutation) {
+// withPromoCodeUsageID sets the ID field of the mutation.
+func withPromoCodeUsageID(id int64) promocodeusageOption {
+	return func(m *PromoCodeUsageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PromoCode
+			value *PromoCodeUsage
 		)
-		m.oldValue = func(ctx context.Context) (*PromoCode, error) {
+		m.oldValue = func(ctx context.Context) (*PromoCodeUsage, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PromoCode.Get(ctx, id)
+					value, err = m.Client().PromoCodeUsage.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6315,10 +9910,10 @@ func withPromoCodeID(id int64) promocodeOption {
 	}
 }
 
-// withPromoCode sets the old PromoCode of the mutation.
-func withPromoCode(node *PromoCode) promocodeOption {
-	return func(m *PromoCodeMutation) {
-		m.oldValue = func(context.Context) (*PromoCode, error) {
+// withPromoCodeUsage sets the old PromoCodeUsage of the mutation.
+func withPromoCodeUsage(node *PromoCodeUsage) promocodeusageOption {
+	return func(m *PromoCodeUsageMutation) {
+		m.oldValue = func(context.Context) (*PromoCodeUsage, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6327,7 +9922,7 @@ func withPromoCode(node *PromoCode) promocodeOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PromoCodeMutation) Client() *Client {
+func (m PromoCodeUsageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6335,7 +9930,7 @@ func (m PromoCodeMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PromoCodeMutation) Tx() (*Tx, error) {
+func (m PromoCodeUsageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6346,7 +9941,7 @@ func (m PromoCodeMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PromoCodeMutation) ID() (id int64, exists bool) {
+func (m *PromoCodeUsageMutation) ID() (id int64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6357,7 +9952,7 @@ func (m *PromoCodeMutation) ID() (id int64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PromoCodeMutation) IDs(ctx context.Context) ([]int64, error) {
+func (m *PromoCodeUsageMutation) IDs(ctx context.Context) ([]int64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6366,56 +9961,92 @@ func (m *PromoCodeMutation) IDs(ctx context.Context) ([]int64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PromoCode.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().PromoCodeUsage.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCode sets the "code" field.
-func (m *PromoCodeMutation) SetCode(s string) {
-	m.code = &s
+// SetPromoCodeID sets the "promo_code_id" field.
+func (m *PromoCodeUsageMutation) SetPromoCodeID(i int64) {
+	m.promo_code = &i
+}
+
+// PromoCodeID returns the value of the "promo_code_id" field in the mutation.
+func (m *PromoCodeUsageMutation) PromoCodeID() (r int64, exists bool) {
+	v := m.promo_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPromoCodeID returns the old "promo_code_id" field's value of the PromoCodeUsage entity.
+// If the PromoCodeUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeUsageMutation) OldPromoCodeID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPromoCodeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPromoCodeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromoCodeID: %w", err)
+	}
+	return oldValue.PromoCodeID, nil
+}
+
+// ResetPromoCodeID resets all changes to the "promo_code_id" field.
+func (m *PromoCodeUsageMutation) ResetPromoCodeID() {
+	m.promo_code = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PromoCodeUsageMutation) SetUserID(i int64) {
+	m.user = &i
 }
 
-// Code returns the value of the "code" field in the mutation.
-func (m *PromoCodeMutation) Code() (r string, exists bool) {
-	v := m.code
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PromoCodeUsageMutation) UserID() (r int64, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCode returns the old "code" field's value of the PromoCode entity.
-// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the PromoCodeUsage entity.
+// If the PromoCodeUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PromoCodeMutation) OldCode(ctx context.Context) (v string, err error) {
+func (m *PromoCodeUsageMutation) OldUserID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCode requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Code, nil
+	return oldValue.UserID, nil
 }
 
-// ResetCode resets all changes to the "code" field.
-func (m *PromoCodeMutation) ResetCode() {
-	m.code = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PromoCodeUsageMutation) ResetUserID() {
+	m.user = nil
 }
 
 // SetBonusAmount sets the "bonus_amount" field.
-func (m *PromoCodeMutation) SetBonusAmount(f float64) {
+func (m *PromoCodeUsageMutation) SetBonusAmount(f float64) {
 	m.bonus_amount = &f
 	m.addbonus_amount = nil
 }
 
 // BonusAmount returns the value of the "bonus_amount" field in the mutation.
-func (m *PromoCodeMutation) BonusAmount() (r float64, exists bool) {
+func (m *PromoCodeUsageMutation) BonusAmount() (r float64, exists bool) {
 	v := m.bonus_amount
 	if v == nil {
 		return
@@ -6423,10 +10054,10 @@ func (m *PromoCodeMutation) BonusAmount() (r float64, exists bool) {
 	return *v, true
 }
 
-// OldBonusAmount returns the old "bonus_amount" field's value of the PromoCode entity.
-// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// OldBonusAmount returns the old "bonus_amount" field's value of the PromoCodeUsage entity.
+// If the PromoCodeUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PromoCodeMutation) OldBonusAmount(ctx context.Context) (v float64, err error) {
+func (m *PromoCodeUsageMutation) OldBonusAmount(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldBonusAmount is only allowed on UpdateOne operations")
 	}
@@ -6441,7 +10072,7 @@ func (m *PromoCodeMutation) OldBonusAmount(ctx context.Context) (v float64, err
 }
 
 // AddBonusAmount adds f to the "bonus_amount" field.
-func (m *PromoCodeMutation) AddBonusAmount(f float64) {
+func (m *PromoCodeUsageMutation) AddBonusAmount(f float64) {
 	if m.addbonus_amount != nil {
 		*m.addbonus_amount += f
 	} else {
@@ -6450,7 +10081,7 @@ func (m *PromoCodeMutation) AddBonusAmount(f float64) {
 }
 
 // AddedBonusAmount returns the value that was added to the "bonus_amount" field in this mutation.
-func (m *PromoCodeMutation) AddedBonusAmount() (r float64, exists bool) {
+func (m *PromoCodeUsageMutation) AddedBonusAmount() (r float64, exists bool) {
 	v := m.addbonus_amount
 	if v == nil {
 		return
@@ -6459,1132 +10090,1379 @@ func (m *PromoCodeMutation) AddedBonusAmount() (r float64, exists bool) {
 }
 
 // ResetBonusAmount resets all changes to the "bonus_amount" field.
-func (m *PromoCodeMutation) ResetBonusAmount() {
+func (m *PromoCodeUsageMutation) ResetBonusAmount() {
 	m.bonus_amount = nil
 	m.addbonus_amount = nil
 }
 
-// SetMaxUses sets the "max_uses" field.
-func (m *PromoCodeMutation) SetMaxUses(i int) {
-	m.max_uses = &i
-	m.addmax_uses = nil
+// SetTopupAmount sets the "topup_amount" field.
+func (m *PromoCodeUsageMutation) SetTopupAmount(f float64) {
+	m.topup_amount = &f
+	m.addtopup_amount = nil
 }
 
-// MaxUses returns the value of the "max_uses" field in the mutation.
-func (m *PromoCodeMutation) MaxUses() (r int, exists bool) {
-	v := m.max_uses
+// TopupAmount returns the value of the "topup_amount" field in the mutation.
+func (m *PromoCodeUsageMutation) TopupAmount() (r float64, exists bool) {
+	v := m.topup_amount
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMaxUses returns the old "max_uses" field's value of the PromoCode entity.
-// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// OldTopupAmount returns the old "topup_amount" field's value of the PromoCodeUsage entity.
+// If the PromoCodeUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PromoCodeMutation) OldMaxUses(ctx context.Context) (v int, err error) {
+func (m *PromoCodeUsageMutation) OldTopupAmount(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMaxUses is only allowed on UpdateOne operations")
+		return v, errors.New("OldTopupAmount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMaxUses requires an ID field in the mutation")
+		return v, errors.New("OldTopupAmount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMaxUses: %w", err)
+		return v, fmt.Errorf("querying old value for OldTopupAmount: %w", err)
 	}
-	return oldValue.MaxUses, nil
+	return oldValue.TopupAmount, nil
 }
 
-// AddMaxUses adds i to the "max_uses" field.
-func (m *PromoCodeMutation) AddMaxUses(i int) {
-	if m.addmax_uses != nil {
-		*m.addmax_uses += i
+// AddTopupAmount adds f to the "topup_amount" field.
+func (m *PromoCodeUsageMutation) AddTopupAmount(f float64) {
+	if m.addtopup_amount != nil {
+		*m.addtopup_amount += f
 	} else {
-		m.addmax_uses = &i
+		m.addtopup_amount = &f
 	}
 }
 
-// AddedMaxUses returns the value that was added to the "max_uses" field in this mutation.
-func (m *PromoCodeMutation) AddedMaxUses() (r int, exists bool) {
-	v := m.addmax_uses
+// AddedTopupAmount returns the value that was added to the "topup_amount" field in this mutation.
+func (m *PromoCodeUsageMutation) AddedTopupAmount() (r float64, exists bool) {
+	v := m.addtopup_amount
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetMaxUses resets all changes to the "max_uses" field.
-func (m *PromoCodeMutation) ResetMaxUses() {
-	m.max_uses = nil
-	m.addmax_uses = nil
+// ResetTopupAmount resets all changes to the "topup_amount" field.
+func (m *PromoCodeUsageMutation) ResetTopupAmount() {
+	m.topup_amount = nil
+	m.addtopup_amount = nil
 }
 
-// SetUsedCount sets the "used_count" field.
-func (m *PromoCodeMutation) SetUsedCount(i int) {
-	m.used_count = &i
-	m.addused_count = nil
+// SetPaymentOrderID sets the "payment_order_id" field.
+func (m *PromoCodeUsageMutation) SetPaymentOrderID(i int64) {
+	m.payment_order_id = &i
+	m.addpayment_order_id = nil
 }
 
-// UsedCount returns the value of the "used_count" field in the mutation.
-func (m *PromoCodeMutation) UsedCount() (r int, exists bool) {
-	v := m.used_count
+// PaymentOrderID returns the value of the "payment_order_id" field in the mutation.
+func (m *PromoCodeUsageMutation) PaymentOrderID() (r int64, exists bool) {
+	v := m.payment_order_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsedCount returns the old "used_count" field's value of the PromoCode entity.
-// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
+// OldPaymentOrderID returns the old "payment_order_id" field's value of the PromoCodeUsage entity.
+// If the PromoCodeUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PromoCodeMutation) OldUsedCount(ctx context.Context) (v int, err error) {
+func (m *PromoCodeUsageMutation) OldPaymentOrderID(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsedCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldPaymentOrderID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsedCount requires an ID field in the mutation")
+		return v, errors.New("OldPaymentOrderID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsedCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldPaymentOrderID: %w", err)
 	}
-	return oldValue.UsedCount, nil
+	return oldValue.PaymentOrderID, nil
+}
+
+// AddPaymentOrderID adds i to the "payment_order_id" field.
+func (m *PromoCodeUsageMutation) AddPaymentOrderID(i int64) {
+	if m.addpayment_order_id != nil {
+		*m.addpayment_order_id += i
+	} else {
+		m.addpayment_order_id = &i
+	}
+}
+
+// AddedPaymentOrderID returns the value that was added to the "payment_order_id" field in this mutation.
+func (m *PromoCodeUsageMutation) AddedPaymentOrderID() (r int64, exists bool) {
+	v := m.addpayment_order_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPaymentOrderID clears the value of the "payment_order_id" field.
+func (m *PromoCodeUsageMutation) ClearPaymentOrderID() {
+	m.payment_order_id = nil
+	m.addpayment_order_id = nil
+	m.clearedFields[promocodeusage.FieldPaymentOrderID] = struct{}{}
+}
+
+// PaymentOrderIDCleared returns if the "payment_order_id" field was cleared in this mutation.
+func (m *PromoCodeUsageMutation) PaymentOrderIDCleared() bool {
+	_, ok := m.clearedFields[promocodeusage.FieldPaymentOrderID]
+	return ok
+}
+
+// ResetPaymentOrderID resets all changes to the "payment_order_id" field.
+func (m *PromoCodeUsageMutation) ResetPaymentOrderID() {
+	m.payment_order_id = nil
+	m.addpayment_order_id = nil
+	delete(m.clearedFields, promocodeusage.FieldPaymentOrderID)
+}
+
+// SetTrialGroupID sets the "trial_group_id" field.
+func (m *PromoCodeUsageMutation) SetTrialGroupID(i int64) {
+	m.trial_group_id = &i
+	m.addtrial_group_id = nil
+}
+
+// TrialGroupID returns the value of the "trial_group_id" field in the mutation.
+func (m *PromoCodeUsageMutation) TrialGroupID() (r int64, exists bool) {
+	v := m.trial_group_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrialGroupID returns the old "trial_group_id" field's value of the PromoCodeUsage entity.
+// If the PromoCodeUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeUsageMutation) OldTrialGroupID(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrialGroupID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrialGroupID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrialGroupID: %w", err)
+	}
+	return oldValue.TrialGroupID, nil
+}
+
+// AddTrialGroupID adds i to the "trial_group_id" field.
+func (m *PromoCodeUsageMutation) AddTrialGroupID(i int64) {
+	if m.addtrial_group_id != nil {
+		*m.addtrial_group_id += i
+	} else {
+		m.addtrial_group_id = &i
+	}
+}
+
+// AddedTrialGroupID returns the value that was added to the "trial_group_id" field in this mutation.
+func (m *PromoCodeUsageMutation) AddedTrialGroupID() (r int64, exists bool) {
+	v := m.addtrial_group_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTrialGroupID clears the value of the "trial_group_id" field.
+func (m *PromoCodeUsageMutation) ClearTrialGroupID() {
+	m.trial_group_id = nil
+	m.addtrial_group_id = nil
+	m.clearedFields[promocodeusage.FieldTrialGroupID] = struct{}{}
+}
+
+// TrialGroupIDCleared returns if the "trial_group_id" field was cleared in this mutation.
+func (m *PromoCodeUsageMutation) TrialGroupIDCleared() bool {
+	_, ok := m.clearedFields[promocodeusage.FieldTrialGroupID]
+	return ok
+}
+
+// ResetTrialGroupID resets all changes to the "trial_group_id" field.
+func (m *PromoCodeUsageMutation) ResetTrialGroupID() {
+	m.trial_group_id = nil
+	m.addtrial_group_id = nil
+	delete(m.clearedFields, promocodeusage.FieldTrialGroupID)
+}
+
+// SetTrialDays sets the "trial_days" field.
+func (m *PromoCodeUsageMutation) SetTrialDays(i int) {
+	m.trial_days = &i
+	m.addtrial_days = nil
+}
+
+// TrialDays returns the value of the "trial_days" field in the mutation.
+func (m *PromoCodeUsageMutation) TrialDays() (r int, exists bool) {
+	v := m.trial_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrialDays returns the old "trial_days" field's value of the PromoCodeUsage entity.
+// If the PromoCodeUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeUsageMutation) OldTrialDays(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrialDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrialDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrialDays: %w", err)
+	}
+	return oldValue.TrialDays, nil
+}
+
+// AddTrialDays adds i to the "trial_days" field.
+func (m *PromoCodeUsageMutation) AddTrialDays(i int) {
+	if m.addtrial_days != nil {
+		*m.addtrial_days += i
+	} else {
+		m.addtrial_days = &i
+	}
+}
+
+// AddedTrialDays returns the value that was added to the "trial_days" field in this mutation.
+func (m *PromoCodeUsageMutation) AddedTrialDays() (r int, exists bool) {
+	v := m.addtrial_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTrialDays resets all changes to the "trial_days" field.
+func (m *PromoCodeUsageMutation) ResetTrialDays() {
+	m.trial_days = nil
+	m.addtrial_days = nil
+}
+
+// SetUsedAt sets the "used_at" field.
+func (m *PromoCodeUsageMutation) SetUsedAt(t time.Time) {
+	m.used_at = &t
+}
+
+// UsedAt returns the value of the "used_at" field in the mutation.
+func (m *PromoCodeUsageMutation) UsedAt() (r time.Time, exists bool) {
+	v := m.used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsedAt returns the old "used_at" field's value of the PromoCodeUsage entity.
+// If the PromoCodeUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PromoCodeUsageMutation) OldUsedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsedAt: %w", err)
+	}
+	return oldValue.UsedAt, nil
+}
+
+// ResetUsedAt resets all changes to the "used_at" field.
+func (m *PromoCodeUsageMutation) ResetUsedAt() {
+	m.used_at = nil
+}
+
+// ClearPromoCode clears the "promo_code" edge to the PromoCode entity.
+func (m *PromoCodeUsageMutation) ClearPromoCode() {
+	m.clearedpromo_code = true
+	m.clearedFields[promocodeusage.FieldPromoCodeID] = struct{}{}
+}
+
+// PromoCodeCleared reports if the "promo_code" edge to the PromoCode entity was cleared.
+func (m *PromoCodeUsageMutation) PromoCodeCleared() bool {
+	return m.clearedpromo_code
+}
+
+// PromoCodeIDs returns the "promo_code" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PromoCodeID instead. It exists only for internal usage by the builders.
+func (m *PromoCodeUsageMutation) PromoCodeIDs() (ids []int64) {
+	if id := m.promo_code; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPromoCode resets all changes to the "promo_code" edge.
+func (m *PromoCodeUsageMutation) ResetPromoCode() {
+	m.promo_code = nil
+	m.clearedpromo_code = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *PromoCodeUsageMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[promocodeusage.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *PromoCodeUsageMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *PromoCodeUsageMutation) UserIDs() (ids []int64) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *PromoCodeUsageMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// AddUsedCount adds i to the "used_count" field.
-func (m *PromoCodeMutation) AddUsedCount(i int) {
-	if m.addused_count != nil {
-		*m.addused_count += i
-	} else {
-		m.addused_count = &i
-	}
+// Where appends a list predicates to the PromoCodeUsageMutation builder.
+func (m *PromoCodeUsageMutation) Where(ps ...predicate.PromoCodeUsage) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// AddedUsedCount returns the value that was added to the "used_count" field in this mutation.
-func (m *PromoCodeMutation) AddedUsedCount() (r int, exists bool) {
-	v := m.addused_count
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the PromoCodeUsageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PromoCodeUsageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PromoCodeUsage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// ResetUsedCount resets all changes to the "used_count" field.
-func (m *PromoCodeMutation) ResetUsedCount() {
-	m.used_count = nil
-	m.addused_count = nil
+// Op returns the operation name.
+func (m *PromoCodeUsageMutation) Op() Op {
+	return m.op
 }
 
-// SetStatus sets the "status" field.
-func (m *PromoCodeMutation) SetStatus(s string) {
-	m.status = &s
+// SetOp allows setting the mutation operation.
+func (m *PromoCodeUsageMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *PromoCodeMutation) Status() (r string, exists bool) {
-	v := m.status
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (PromoCodeUsage).
+func (m *PromoCodeUsageMutation) Type() string {
+	return m.typ
 }
 
-// OldStatus returns the old "status" field's value of the PromoCode entity.
-// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PromoCodeMutation) OldStatus(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PromoCodeUsageMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.promo_code != nil {
+		fields = append(fields, promocodeusage.FieldPromoCodeID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+	if m.user != nil {
+		fields = append(fields, promocodeusage.FieldUserID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	if m.bonus_amount != nil {
+		fields = append(fields, promocodeusage.FieldBonusAmount)
 	}
-	return oldValue.Status, nil
+	if m.topup_amount != nil {
+		fields = append(fields, promocodeusage.FieldTopupAmount)
+	}
+	if m.payment_order_id != nil {
+		fields = append(fields, promocodeusage.FieldPaymentOrderID)
+	}
+	if m.trial_group_id != nil {
+		fields = append(fields, promocodeusage.FieldTrialGroupID)
+	}
+	if m.trial_days != nil {
+		fields = append(fields, promocodeusage.FieldTrialDays)
+	}
+	if m.used_at != nil {
+		fields = append(fields, promocodeusage.FieldUsedAt)
+	}
+	return fields
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *PromoCodeMutation) ResetStatus() {
-	m.status = nil
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PromoCodeUsageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case promocodeusage.FieldPromoCodeID:
+		return m.PromoCodeID()
+	case promocodeusage.FieldUserID:
+		return m.UserID()
+	case promocodeusage.FieldBonusAmount:
+		return m.BonusAmount()
+	case promocodeusage.FieldTopupAmount:
+		return m.TopupAmount()
+	case promocodeusage.FieldPaymentOrderID:
+		return m.PaymentOrderID()
+	case promocodeusage.FieldTrialGroupID:
+		return m.TrialGroupID()
+	case promocodeusage.FieldTrialDays:
+		return m.TrialDays()
+	case promocodeusage.FieldUsedAt:
+		return m.UsedAt()
+	}
+	return nil, false
 }
 
-// SetExpiresAt sets the "expires_at" field.
-func (m *PromoCodeMutation) SetExpiresAt(t time.Time) {
-	m.expires_at = &t
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PromoCodeUsageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case promocodeusage.FieldPromoCodeID:
+		return m.OldPromoCodeID(ctx)
+	case promocodeusage.FieldUserID:
+		return m.OldUserID(ctx)
+	case promocodeusage.FieldBonusAmount:
+		return m.OldBonusAmount(ctx)
+	case promocodeusage.FieldTopupAmount:
+		return m.OldTopupAmount(ctx)
+	case promocodeusage.FieldPaymentOrderID:
+		return m.OldPaymentOrderID(ctx)
+	case promocodeusage.FieldTrialGroupID:
+		return m.OldTrialGroupID(ctx)
+	case promocodeusage.FieldTrialDays:
+		return m.OldTrialDays(ctx)
+	case promocodeusage.FieldUsedAt:
+		return m.OldUsedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PromoCodeUsage field %s", name)
 }
 
-// ExpiresAt returns the value of the "expires_at" field in the mutation.
-func (m *PromoCodeMutation) ExpiresAt() (r time.Time, exists bool) {
-	v := m.expires_at
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PromoCodeUsageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case promocodeusage.FieldPromoCodeID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromoCodeID(v)
+		return nil
+	case promocodeusage.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case promocodeusage.FieldBonusAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBonusAmount(v)
+		return nil
+	case promocodeusage.FieldTopupAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTopupAmount(v)
+		return nil
+	case promocodeusage.FieldPaymentOrderID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPaymentOrderID(v)
+		return nil
+	case promocodeusage.FieldTrialGroupID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrialGroupID(v)
+		return nil
+	case promocodeusage.FieldTrialDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrialDays(v)
+		return nil
+	case promocodeusage.FieldUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsedAt(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown PromoCodeUsage field %s", name)
 }
 
-// OldExpiresAt returns the old "expires_at" field's value of the PromoCode entity.
-// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PromoCodeMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PromoCodeUsageMutation) AddedFields() []string {
+	var fields []string
+	if m.addbonus_amount != nil {
+		fields = append(fields, promocodeusage.FieldBonusAmount)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	if m.addtopup_amount != nil {
+		fields = append(fields, promocodeusage.FieldTopupAmount)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	if m.addpayment_order_id != nil {
+		fields = append(fields, promocodeusage.FieldPaymentOrderID)
 	}
-	return oldValue.ExpiresAt, nil
-}
-
-// ClearExpiresAt clears the value of the "expires_at" field.
-func (m *PromoCodeMutation) ClearExpiresAt() {
-	m.expires_at = nil
-	m.clearedFields[promocode.FieldExpiresAt] = struct{}{}
-}
-
-// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
-func (m *PromoCodeMutation) ExpiresAtCleared() bool {
-	_, ok := m.clearedFields[promocode.FieldExpiresAt]
-	return ok
-}
-
-// ResetExpiresAt resets all changes to the "expires_at" field.
-func (m *PromoCodeMutation) ResetExpiresAt() {
-	m.expires_at = nil
-	delete(m.clearedFields, promocode.FieldExpiresAt)
+	if m.addtrial_group_id != nil {
+		fields = append(fields, promocodeusage.FieldTrialGroupID)
+	}
+	if m.addtrial_days != nil {
+		fields = append(fields, promocodeusage.FieldTrialDays)
+	}
+	return fields
 }
 
-// SetNotes sets the "notes" field.
-func (m *PromoCodeMutation) SetNotes(s string) {
-	m.notes = &s
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PromoCodeUsageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case promocodeusage.FieldBonusAmount:
+		return m.AddedBonusAmount()
+	case promocodeusage.FieldTopupAmount:
+		return m.AddedTopupAmount()
+	case promocodeusage.FieldPaymentOrderID:
+		return m.AddedPaymentOrderID()
+	case promocodeusage.FieldTrialGroupID:
+		return m.AddedTrialGroupID()
+	case promocodeusage.FieldTrialDays:
+		return m.AddedTrialDays()
+	}
+	return nil, false
 }
 
-// Notes returns the value of the "notes" field in the mutation.
-func (m *PromoCodeMutation) Notes() (r string, exists bool) {
-	v := m.notes
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PromoCodeUsageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case promocodeusage.FieldBonusAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBonusAmount(v)
+		return nil
+	case promocodeusage.FieldTopupAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTopupAmount(v)
+		return nil
+	case promocodeusage.FieldPaymentOrderID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPaymentOrderID(v)
+		return nil
+	case promocodeusage.FieldTrialGroupID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTrialGroupID(v)
+		return nil
+	case promocodeusage.FieldTrialDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTrialDays(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown PromoCodeUsage numeric field %s", name)
 }
 
-// OldNotes returns the old "notes" field's value of the PromoCode entity.
-// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PromoCodeMutation) OldNotes(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNotes is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNotes requires an ID field in the mutation")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PromoCodeUsageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(promocodeusage.FieldPaymentOrderID) {
+		fields = append(fields, promocodeusage.FieldPaymentOrderID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNotes: %w", err)
+	if m.FieldCleared(promocodeusage.FieldTrialGroupID) {
+		fields = append(fields, promocodeusage.FieldTrialGroupID)
 	}
-	return oldValue.Notes, nil
-}
-
-// ClearNotes clears the value of the "notes" field.
-func (m *PromoCodeMutation) ClearNotes() {
-	m.notes = nil
-	m.clearedFields[promocode.FieldNotes] = struct{}{}
+	return fields
 }
 
-// NotesCleared returns if the "notes" field was cleared in this mutation.
-func (m *PromoCodeMutation) NotesCleared() bool {
-	_, ok := m.clearedFields[promocode.FieldNotes]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PromoCodeUsageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetNotes resets all changes to the "notes" field.
-func (m *PromoCodeMutation) ResetNotes() {
-	m.notes = nil
-	delete(m.clearedFields, promocode.FieldNotes)
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (m *PromoCodeMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PromoCodeUsageMutation) ClearField(name string) error {
+	switch name {
+	case promocodeusage.FieldPaymentOrderID:
+		m.ClearPaymentOrderID()
+		return nil
+	case promocodeusage.FieldTrialGroupID:
+		m.ClearTrialGroupID()
+		return nil
+	}
+	return fmt.Errorf("unknown PromoCodeUsage nullable field %s", name)
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PromoCodeMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PromoCodeUsageMutation) ResetField(name string) error {
+	switch name {
+	case promocodeusage.FieldPromoCodeID:
+		m.ResetPromoCodeID()
+		return nil
+	case promocodeusage.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case promocodeusage.FieldBonusAmount:
+		m.ResetBonusAmount()
+		return nil
+	case promocodeusage.FieldTopupAmount:
+		m.ResetTopupAmount()
+		return nil
+	case promocodeusage.FieldPaymentOrderID:
+		m.ResetPaymentOrderID()
+		return nil
+	case promocodeusage.FieldTrialGroupID:
+		m.ResetTrialGroupID()
+		return nil
+	case promocodeusage.FieldTrialDays:
+		m.ResetTrialDays()
+		return nil
+	case promocodeusage.FieldUsedAt:
+		m.ResetUsedAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown PromoCodeUsage field %s", name)
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the PromoCode entity.
-// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PromoCodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PromoCodeUsageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.promo_code != nil {
+		edges = append(edges, promocodeusage.EdgePromoCode)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	if m.user != nil {
+		edges = append(edges, promocodeusage.EdgeUser)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PromoCodeMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (m *PromoCodeMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+	return edges
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PromoCodeMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PromoCodeUsageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case promocodeusage.EdgePromoCode:
+		if id := m.promo_code; id != nil {
+			return []ent.Value{*id}
+		}
+	case promocodeusage.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	return *v, true
+	return nil
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the PromoCode entity.
-// If the PromoCode object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PromoCodeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PromoCodeUsageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PromoCodeMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PromoCodeUsageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// AddUsageRecordIDs adds the "usage_records" edge to the PromoCodeUsage entity by ids.
-func (m *PromoCodeMutation) AddUsageRecordIDs(ids ...int64) {
-	if m.usage_records == nil {
-		m.usage_records = make(map[int64]struct{})
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PromoCodeUsageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedpromo_code {
+		edges = append(edges, promocodeusage.EdgePromoCode)
 	}
-	for i := range ids {
-		m.usage_records[ids[i]] = struct{}{}
+	if m.cleareduser {
+		edges = append(edges, promocodeusage.EdgeUser)
 	}
+	return edges
 }
 
-// ClearUsageRecords clears the "usage_records" edge to the PromoCodeUsage entity.
-func (m *PromoCodeMutation) ClearUsageRecords() {
-	m.clearedusage_records = true
-}
-
-// UsageRecordsCleared reports if the "usage_records" edge to the PromoCodeUsage entity was cleared.
-func (m *PromoCodeMutation) UsageRecordsCleared() bool {
-	return m.clearedusage_records
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PromoCodeUsageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case promocodeusage.EdgePromoCode:
+		return m.clearedpromo_code
+	case promocodeusage.EdgeUser:
+		return m.cleareduser
+	}
+	return false
 }
 
-// RemoveUsageRecordIDs removes the "usage_records" edge to the PromoCodeUsage entity by IDs.
-func (m *PromoCodeMutation) RemoveUsageRecordIDs(ids ...int64) {
-	if m.removedusage_records == nil {
-		m.removedusage_records = make(map[int64]struct{})
-	}
-	for i := range ids {
-		delete(m.usage_records, ids[i])
-		m.removedusage_records[ids[i]] = struct{}{}
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PromoCodeUsageMutation) ClearEdge(name string) error {
+	switch name {
+	case promocodeusage.EdgePromoCode:
+		m.ClearPromoCode()
+		return nil
+	case promocodeusage.EdgeUser:
+		m.ClearUser()
+		return nil
 	}
+	return fmt.Errorf("unknown PromoCodeUsage unique edge %s", name)
 }
 
-// RemovedUsageRecords returns the removed IDs of the "usage_records" edge to the PromoCodeUsage entity.
-func (m *PromoCodeMutation) RemovedUsageRecordsIDs() (ids []int64) {
-	for id := range m.removedusage_records {
-		ids = append(ids, id)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PromoCodeUsageMutation) ResetEdge(name string) error {
+	switch name {
+	case promocodeusage.EdgePromoCode:
+		m.ResetPromoCode()
+		return nil
+	case promocodeusage.EdgeUser:
+		m.ResetUser()
+		return nil
 	}
-	return
+	return fmt.Errorf("unknown PromoCodeUsage edge %s", name)
 }
 
-// UsageRecordsIDs returns the "usage_records" edge IDs in the mutation.
-func (m *PromoCodeMutation) UsageRecordsIDs() (ids []int64) {
-	for id := range m.usage_records {
-		ids = append(ids, id)
-	}
-	return
+// ProxyMutation represents an operation that mutates the Proxy nodes in the graph.
+type ProxyMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int64
+	created_at      *time.Time
+	updated_at      *time.Time
+	deleted_at      *time.Time
+	name            *string
+	protocol        *string
+	host            *string
+	port            *int
+	addport         *int
+	username        *string
+	password        *string
+	status          *string
+	clearedFields   map[string]struct{}
+	accounts        map[int64]struct{}
+	removedaccounts map[int64]struct{}
+	clearedaccounts bool
+	done            bool
+	oldValue        func(context.Context) (*Proxy, error)
+	predicates      []predicate.Proxy
 }
 
-// ResetUsageRecords resets all changes to the "usage_records" edge.
-func (m *PromoCodeMutation) ResetUsageRecords() {
-	m.usage_records = nil
-	m.clearedusage_records = false
-	m.removedusage_records = nil
-}
+var _ ent.Mutation = (*ProxyMutation)(nil)
 
-// Where appends a list predicates to the PromoCodeMutation builder.
-func (m *PromoCodeMutation) Where(ps ...predicate.PromoCode) {
-	m.predicates = append(m.predicates, ps...)
-}
+// proxyOption allows management of the mutation configuration using functional options.
+type proxyOption func(*ProxyMutation)
 
-// WhereP appends storage-level predicates to the PromoCodeMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PromoCodeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PromoCode, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// newProxyMutation creates new mutation for the Proxy entity.
+func newProxyMutation(c config, op Op, opts ...proxyOption) *ProxyMutation {
+	m := &ProxyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProxy,
+		clearedFields: make(map[string]struct{}),
 	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *PromoCodeMutation) Op() Op {
-	return m.op
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// SetOp allows setting the mutation operation.
-func (m *PromoCodeMutation) SetOp(op Op) {
-	m.op = op
+// withProxyID sets the ID field of the mutation.
+func withProxyID(id int64) proxyOption {
+	return func(m *ProxyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Proxy
+		)
+		m.oldValue = func(ctx context.Context) (*Proxy, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Proxy.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// Type returns the node type of this mutation (PromoCode).
-func (m *PromoCodeMutation) Type() string {
-	return m.typ
+// withProxy sets the old Proxy of the mutation.
+func withProxy(node *Proxy) proxyOption {
+	return func(m *ProxyMutation) {
+		m.oldValue = func(context.Context) (*Proxy, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *PromoCodeMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m.code != nil {
-		fields = append(fields, promocode.FieldCode)
-	}
-	if m.bonus_amount != nil {
-		fields = append(fields, promocode.FieldBonusAmount)
-	}
-	if m.max_uses != nil {
-		fields = append(fields, promocode.FieldMaxUses)
-	}
-	if m.used_count != nil {
-		fields = append(fields, promocode.FieldUsedCount)
-	}
-	if m.status != nil {
-		fields = append(fields, promocode.FieldStatus)
-	}
-	if m.expires_at != nil {
-		fields = append(fields, promocode.FieldExpiresAt)
-	}
-	if m.notes != nil {
-		fields = append(fields, promocode.FieldNotes)
-	}
-	if m.created_at != nil {
-		fields = append(fields, promocode.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, promocode.FieldUpdatedAt)
-	}
-	return fields
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProxyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *PromoCodeMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case promocode.FieldCode:
-		return m.Code()
-	case promocode.FieldBonusAmount:
-		return m.BonusAmount()
-	case promocode.FieldMaxUses:
-		return m.MaxUses()
-	case promocode.FieldUsedCount:
-		return m.UsedCount()
-	case promocode.FieldStatus:
-		return m.Status()
-	case promocode.FieldExpiresAt:
-		return m.ExpiresAt()
-	case promocode.FieldNotes:
-		return m.Notes()
-	case promocode.FieldCreatedAt:
-		return m.CreatedAt()
-	case promocode.FieldUpdatedAt:
-		return m.UpdatedAt()
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProxyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return nil, false
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *PromoCodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case promocode.FieldCode:
-		return m.OldCode(ctx)
-	case promocode.FieldBonusAmount:
-		return m.OldBonusAmount(ctx)
-	case promocode.FieldMaxUses:
-		return m.OldMaxUses(ctx)
-	case promocode.FieldUsedCount:
-		return m.OldUsedCount(ctx)
-	case promocode.FieldStatus:
-		return m.OldStatus(ctx)
-	case promocode.FieldExpiresAt:
-		return m.OldExpiresAt(ctx)
-	case promocode.FieldNotes:
-		return m.OldNotes(ctx)
-	case promocode.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case promocode.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProxyMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown PromoCode field %s", name)
+	return *m.id, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PromoCodeMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case promocode.FieldCode:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCode(v)
-		return nil
-	case promocode.FieldBonusAmount:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBonusAmount(v)
-		return nil
-	case promocode.FieldMaxUses:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMaxUses(v)
-		return nil
-	case promocode.FieldUsedCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUsedCount(v)
-		return nil
-	case promocode.FieldStatus:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case promocode.FieldExpiresAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExpiresAt(v)
-		return nil
-	case promocode.FieldNotes:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNotes(v)
-		return nil
-	case promocode.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case promocode.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProxyMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
 		}
-		m.SetUpdatedAt(v)
-		return nil
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Proxy.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ProxyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProxyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown PromoCode field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *PromoCodeMutation) AddedFields() []string {
-	var fields []string
-	if m.addbonus_amount != nil {
-		fields = append(fields, promocode.FieldBonusAmount)
+// OldCreatedAt returns the old "created_at" field's value of the Proxy entity.
+// If the Proxy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProxyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	if m.addmax_uses != nil {
-		fields = append(fields, promocode.FieldMaxUses)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	if m.addused_count != nil {
-		fields = append(fields, promocode.FieldUsedCount)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return fields
+	return oldValue.CreatedAt, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *PromoCodeMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case promocode.FieldBonusAmount:
-		return m.AddedBonusAmount()
-	case promocode.FieldMaxUses:
-		return m.AddedMaxUses()
-	case promocode.FieldUsedCount:
-		return m.AddedUsedCount()
-	}
-	return nil, false
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProxyMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PromoCodeMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case promocode.FieldBonusAmount:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddBonusAmount(v)
-		return nil
-	case promocode.FieldMaxUses:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMaxUses(v)
-		return nil
-	case promocode.FieldUsedCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddUsedCount(v)
-		return nil
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ProxyMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ProxyMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown PromoCode numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *PromoCodeMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(promocode.FieldExpiresAt) {
-		fields = append(fields, promocode.FieldExpiresAt)
+// OldUpdatedAt returns the old "updated_at" field's value of the Proxy entity.
+// If the Proxy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProxyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(promocode.FieldNotes) {
-		fields = append(fields, promocode.FieldNotes)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *PromoCodeMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ProxyMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *PromoCodeMutation) ClearField(name string) error {
-	switch name {
-	case promocode.FieldExpiresAt:
-		m.ClearExpiresAt()
-		return nil
-	case promocode.FieldNotes:
-		m.ClearNotes()
-		return nil
-	}
-	return fmt.Errorf("unknown PromoCode nullable field %s", name)
+// SetDeletedAt sets the "deleted_at" field.
+func (m *ProxyMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *PromoCodeMutation) ResetField(name string) error {
-	switch name {
-	case promocode.FieldCode:
-		m.ResetCode()
-		return nil
-	case promocode.FieldBonusAmount:
-		m.ResetBonusAmount()
-		return nil
-	case promocode.FieldMaxUses:
-		m.ResetMaxUses()
-		return nil
-	case promocode.FieldUsedCount:
-		m.ResetUsedCount()
-		return nil
-	case promocode.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case promocode.FieldExpiresAt:
-		m.ResetExpiresAt()
-		return nil
-	case promocode.FieldNotes:
-		m.ResetNotes()
-		return nil
-	case promocode.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case promocode.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *ProxyMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown PromoCode field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PromoCodeMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.usage_records != nil {
-		edges = append(edges, promocode.EdgeUsageRecords)
+// OldDeletedAt returns the old "deleted_at" field's value of the Proxy entity.
+// If the Proxy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProxyMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
-	return edges
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *PromoCodeMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case promocode.EdgeUsageRecords:
-		ids := make([]ent.Value, 0, len(m.usage_records))
-		for id := range m.usage_records {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *ProxyMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[proxy.FieldDeletedAt] = struct{}{}
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PromoCodeMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedusage_records != nil {
-		edges = append(edges, promocode.EdgeUsageRecords)
-	}
-	return edges
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *ProxyMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[proxy.FieldDeletedAt]
+	return ok
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *PromoCodeMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case promocode.EdgeUsageRecords:
-		ids := make([]ent.Value, 0, len(m.removedusage_records))
-		for id := range m.removedusage_records {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *ProxyMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, proxy.FieldDeletedAt)
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PromoCodeMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedusage_records {
-		edges = append(edges, promocode.EdgeUsageRecords)
-	}
-	return edges
+// SetName sets the "name" field.
+func (m *ProxyMutation) SetName(s string) {
+	m.name = &s
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *PromoCodeMutation) EdgeCleared(name string) bool {
-	switch name {
-	case promocode.EdgeUsageRecords:
-		return m.clearedusage_records
+// Name returns the value of the "name" field in the mutation.
+func (m *ProxyMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
 	}
-	return false
+	return *v, true
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *PromoCodeMutation) ClearEdge(name string) error {
-	switch name {
+// OldName returns the old "name" field's value of the Proxy entity.
+// If the Proxy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProxyMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown PromoCode unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *PromoCodeMutation) ResetEdge(name string) error {
-	switch name {
-	case promocode.EdgeUsageRecords:
-		m.ResetUsageRecords()
-		return nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
-	return fmt.Errorf("unknown PromoCode edge %s", name)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
 }
 
-// PromoCodeUsageMutation represents an operation that mutates the PromoCodeUsage nodes in the graph.
-type PromoCodeUsageMutation struct {
-	config
-	op                Op
-	typ               string
-	id                *int64
-	bonus_amount      *float64
-	addbonus_amount   *float64
-	used_at           *time.Time
-	clearedFields     map[string]struct{}
-	promo_code        *int64
-	clearedpromo_code bool
-	user              *int64
-	cleareduser       bool
-	done              bool
-	oldValue          func(context.Context) (*PromoCodeUsage, error)
-	predicates        []predicate.PromoCodeUsage
+// ResetName resets all changes to the "name" field.
+func (m *ProxyMutation) ResetName() {
+	m.name = nil
 }
 
-var _ ent.Mutation = (*PromoCodeUsageMutation)(nil)
-
-// promocodeusageOption allows management of the mutation configuration using functional options.
-type promocodeusageOption func(*PromoCodeUsageMutation)
-
-// newPromoCodeUsageMutation creates new mutation for the PromoCodeUsage entity.
-func newPromoCodeUsageMutation(c config, op Op, opts ...promocodeusageOption) *PromoCodeUsageMutation {
-	m := &PromoCodeUsageMutation{
-		config:        c,
-		op:            op,
-		typ:           TypePromoCodeUsage,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// SetProtocol sets the "protocol" field.
+func (m *ProxyMutation) SetProtocol(s string) {
+	m.protocol = &s
 }
 
-// withPromoCodeUsageID sets the ID field of the mutation.
-func withPromoCodeUsageID(id int64) promocodeusageOption {
-	return func(m *PromoCodeUsageMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *PromoCodeUsage
-		)
-		m.oldValue = func(ctx context.Context) (*PromoCodeUsage, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().PromoCodeUsage.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// Protocol returns the value of the "protocol" field in the mutation.
+func (m *ProxyMutation) Protocol() (r string, exists bool) {
+	v := m.protocol
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// withPromoCodeUsage sets the old PromoCodeUsage of the mutation.
-func withPromoCodeUsage(node *PromoCodeUsage) promocodeusageOption {
-	return func(m *PromoCodeUsageMutation) {
-		m.oldValue = func(context.Context) (*PromoCodeUsage, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// OldProtocol returns the old "protocol" field's value of the Proxy entity.
+// If the Proxy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProxyMutation) OldProtocol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProtocol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProtocol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProtocol: %w", err)
 	}
+	return oldValue.Protocol, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PromoCodeUsageMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetProtocol resets all changes to the "protocol" field.
+func (m *ProxyMutation) ResetProtocol() {
+	m.protocol = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m PromoCodeUsageMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// SetHost sets the "host" field.
+func (m *ProxyMutation) SetHost(s string) {
+	m.host = &s
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *PromoCodeUsageMutation) ID() (id int64, exists bool) {
-	if m.id == nil {
+// Host returns the value of the "host" field in the mutation.
+func (m *ProxyMutation) Host() (r string, exists bool) {
+	v := m.host
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *PromoCodeUsageMutation) IDs(ctx context.Context) ([]int64, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int64{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PromoCodeUsage.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldHost returns the old "host" field's value of the Proxy entity.
+// If the Proxy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProxyMutation) OldHost(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHost: %w", err)
 	}
+	return oldValue.Host, nil
 }
 
-// SetPromoCodeID sets the "promo_code_id" field.
-func (m *PromoCodeUsageMutation) SetPromoCodeID(i int64) {
-	m.promo_code = &i
+// ResetHost resets all changes to the "host" field.
+func (m *ProxyMutation) ResetHost() {
+	m.host = nil
 }
 
-// PromoCodeID returns the value of the "promo_code_id" field in the mutation.
-func (m *PromoCodeUsageMutation) PromoCodeID() (r int64, exists bool) {
-	v := m.promo_code
+// SetPort sets the "port" field.
+func (m *ProxyMutation) SetPort(i int) {
+	m.port = &i
+	m.addport = nil
+}
+
+// Port returns the value of the "port" field in the mutation.
+func (m *ProxyMutation) Port() (r int, exists bool) {
+	v := m.port
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPromoCodeID returns the old "promo_code_id" field's value of the PromoCodeUsage entity.
-// If the PromoCodeUsage object wasn't provided to the builder, the object is fetched from the database.
+// OldPort returns the old "port" field's value of the Proxy entity.
+// If the Proxy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PromoCodeUsageMutation) OldPromoCodeID(ctx context.Context) (v int64, err error) {
+func (m *ProxyMutation) OldPort(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPromoCodeID is only allowed on UpdateOne operations")
+		return v, errors.New("OldPort is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPromoCodeID requires an ID field in the mutation")
+		return v, errors.New("OldPort requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPromoCodeID: %w", err)
+		return v, fmt.Errorf("querying old value for OldPort: %w", err)
 	}
-	return oldValue.PromoCodeID, nil
+	return oldValue.Port, nil
 }
 
-// ResetPromoCodeID resets all changes to the "promo_code_id" field.
-func (m *PromoCodeUsageMutation) ResetPromoCodeID() {
-	m.promo_code = nil
+// AddPort adds i to the "port" field.
+func (m *ProxyMutation) AddPort(i int) {
+	if m.addport != nil {
+		*m.addport += i
+	} else {
+		m.addport = &i
+	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *PromoCodeUsageMutation) SetUserID(i int64) {
-	m.user = &i
+// AddedPort returns the value that was added to the "port" field in this mutation.
+func (m *ProxyMutation) AddedPort() (r int, exists bool) {
+	v := m.addport
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *PromoCodeUsageMutation) UserID() (r int64, exists bool) {
-	v := m.user
+// ResetPort resets all changes to the "port" field.
+func (m *ProxyMutation) ResetPort() {
+	m.port = nil
+	m.addport = nil
+}
+
+// SetUsername sets the "username" field.
+func (m *ProxyMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *ProxyMutation) Username() (r string, exists bool) {
+	v := m.username
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the PromoCodeUsage entity.
-// If the PromoCodeUsage object wasn't provided to the builder, the object is fetched from the database.
+// OldUsername returns the old "username" field's value of the Proxy entity.
+// If the Proxy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PromoCodeUsageMutation) OldUserID(ctx context.Context) (v int64, err error) {
+func (m *ProxyMutation) OldUsername(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldUsername requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.Username, nil
+}
+
+// ClearUsername clears the value of the "username" field.
+func (m *ProxyMutation) ClearUsername() {
+	m.username = nil
+	m.clearedFields[proxy.FieldUsername] = struct{}{}
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *PromoCodeUsageMutation) ResetUserID() {
-	m.user = nil
+// UsernameCleared returns if the "username" field was cleared in this mutation.
+func (m *ProxyMutation) UsernameCleared() bool {
+	_, ok := m.clearedFields[proxy.FieldUsername]
+	return ok
 }
 
-// SetBonusAmount sets the "bonus_amount" field.
-func (m *PromoCodeUsageMutation) SetBonusAmount(f float64) {
-	m.bonus_amount = &f
-	m.addbonus_amount = nil
+// ResetUsername resets all changes to the "username" field.
+func (m *ProxyMutation) ResetUsername() {
+	m.username = nil
+	delete(m.clearedFields, proxy.FieldUsername)
 }
 
-// BonusAmount returns the value of the "bonus_amount" field in the mutation.
-func (m *PromoCodeUsageMutation) BonusAmount() (r float64, exists bool) {
-	v := m.bonus_amount
+// SetPassword sets the "password" field.
+func (m *ProxyMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *ProxyMutation) Password() (r string, exists bool) {
+	v := m.password
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBonusAmount returns the old "bonus_amount" field's value of the PromoCodeUsage entity.
-// If the PromoCodeUsage object wasn't provided to the builder, the object is fetched from the database.
+// OldPassword returns the old "password" field's value of the Proxy entity.
+// If the Proxy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PromoCodeUsageMutation) OldBonusAmount(ctx context.Context) (v float64, err error) {
+func (m *ProxyMutation) OldPassword(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBonusAmount is only allowed on UpdateOne operations")
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBonusAmount requires an ID field in the mutation")
+		return v, errors.New("OldPassword requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBonusAmount: %w", err)
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
 	}
-	return oldValue.BonusAmount, nil
+	return oldValue.Password, nil
 }
 
-// AddBonusAmount adds f to the "bonus_amount" field.
-func (m *PromoCodeUsageMutation) AddBonusAmount(f float64) {
-	if m.addbonus_amount != nil {
-		*m.addbonus_amount += f
-	} else {
-		m.addbonus_amount = &f
-	}
+// ClearPassword clears the value of the "password" field.
+func (m *ProxyMutation) ClearPassword() {
+	m.password = nil
+	m.clearedFields[proxy.FieldPassword] = struct{}{}
 }
 
-// AddedBonusAmount returns the value that was added to the "bonus_amount" field in this mutation.
-func (m *PromoCodeUsageMutation) AddedBonusAmount() (r float64, exists bool) {
-	v := m.addbonus_amount
-	if v == nil {
-		return
-	}
-	return *v, true
+// PasswordCleared returns if the "password" field was cleared in this mutation.
+func (m *ProxyMutation) PasswordCleared() bool {
+	_, ok := m.clearedFields[proxy.FieldPassword]
+	return ok
 }
 
-// ResetBonusAmount resets all changes to the "bonus_amount" field.
-func (m *PromoCodeUsageMutation) ResetBonusAmount() {
-	m.bonus_amount = nil
-	m.addbonus_amount = nil
+// ResetPassword resets all changes to the "password" field.
+func (m *ProxyMutation) ResetPassword() {
+	m.password = nil
+	delete(m.clearedFields, proxy.FieldPassword)
 }
 
-// SetUsedAt sets the "used_at" field.
-func (m *PromoCodeUsageMutation) SetUsedAt(t time.Time) {
-	m.used_at = &t
+// SetStatus sets the "status" field.
+func (m *ProxyMutation) SetStatus(s string) {
+	m.status = &s
 }
 
-// UsedAt returns the value of the "used_at" field in the mutation.
-func (m *PromoCodeUsageMutation) UsedAt() (r time.Time, exists bool) {
-	v := m.used_at
+// Status returns the value of the "status" field in the mutation.
+func (m *ProxyMutation) Status() (r string, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsedAt returns the old "used_at" field's value of the PromoCodeUsage entity.
-// If the PromoCodeUsage object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the Proxy entity.
+// If the Proxy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PromoCodeUsageMutation) OldUsedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ProxyMutation) OldStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsedAt requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.UsedAt, nil
-}
-
-// ResetUsedAt resets all changes to the "used_at" field.
-func (m *PromoCodeUsageMutation) ResetUsedAt() {
-	m.used_at = nil
+	return oldValue.Status, nil
 }
 
-// ClearPromoCode clears the "promo_code" edge to the PromoCode entity.
-func (m *PromoCodeUsageMutation) ClearPromoCode() {
-	m.clearedpromo_code = true
-	m.clearedFields[promocodeusage.FieldPromoCodeID] = struct{}{}
+// ResetStatus resets all changes to the "status" field.
+func (m *ProxyMutation) ResetStatus() {
+	m.status = nil
 }
 
-// PromoCodeCleared reports if the "promo_code" edge to the PromoCode entity was cleared.
-func (m *PromoCodeUsageMutation) PromoCodeCleared() bool {
-	return m.clearedpromo_code
+// AddAccountIDs adds the "accounts" edge to the Account entity by ids.
+func (m *ProxyMutation) AddAccountIDs(ids ...int64) {
+	if m.accounts == nil {
+		m.accounts = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.accounts[ids[i]] = struct{}{}
+	}
 }
 
-// PromoCodeIDs returns the "promo_code" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PromoCodeID instead. It exists only for internal usage by the builders.
-func (m *PromoCodeUsageMutation) PromoCodeIDs() (ids []int64) {
-	if id := m.promo_code; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ClearAccounts clears the "accounts" edge to the Account entity.
+func (m *ProxyMutation) ClearAccounts() {
+	m.clearedaccounts = true
 }
 
-// ResetPromoCode resets all changes to the "promo_code" edge.
-func (m *PromoCodeUsageMutation) ResetPromoCode() {
-	m.promo_code = nil
-	m.clearedpromo_code = false
+// AccountsCleared reports if the "accounts" edge to the Account entity was cleared.
+func (m *ProxyMutation) AccountsCleared() bool {
+	return m.clearedaccounts
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *PromoCodeUsageMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[promocodeusage.FieldUserID] = struct{}{}
+// RemoveAccountIDs removes the "accounts" edge to the Account entity by IDs.
+func (m *ProxyMutation) RemoveAccountIDs(ids ...int64) {
+	if m.removedaccounts == nil {
+		m.removedaccounts = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.accounts, ids[i])
+		m.removedaccounts[ids[i]] = struct{}{}
+	}
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *PromoCodeUsageMutation) UserCleared() bool {
-	return m.cleareduser
+// RemovedAccounts returns the removed IDs of the "accounts" edge to the Account entity.
+func (m *ProxyMutation) RemovedAccountsIDs() (ids []int64) {
+	for id := range m.removedaccounts {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *PromoCodeUsageMutation) UserIDs() (ids []int64) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// AccountsIDs returns the "accounts" edge IDs in the mutation.
+func (m *ProxyMutation) AccountsIDs() (ids []int64) {
+	for id := range m.accounts {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *PromoCodeUsageMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetAccounts resets all changes to the "accounts" edge.
+func (m *ProxyMutation) ResetAccounts() {
+	m.accounts = nil
+	m.clearedaccounts = false
+	m.removedaccounts = nil
 }
 
-// Where appends a list predicates to the PromoCodeUsageMutation builder.
-func (m *PromoCodeUsageMutation) Where(ps ...predicate.PromoCodeUsage) {
+// Where appends a list predicates to the ProxyMutation builder.
+func (m *ProxyMutation) Where(ps ...predicate.Proxy) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PromoCodeUsageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ProxyMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PromoCodeUsageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PromoCodeUsage, len(ps))
+func (m *ProxyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Proxy, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7592,36 +11470,54 @@ func (m *PromoCodeUsageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PromoCodeUsageMutation) Op() Op {
+func (m *ProxyMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PromoCodeUsageMutation) SetOp(op Op) {
+func (m *ProxyMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PromoCodeUsage).
-func (m *PromoCodeUsageMutation) Type() string {
+// Type returns the node type of this mutation (Proxy).
+func (m *ProxyMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PromoCodeUsageMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.promo_code != nil {
-		fields = append(fields, promocodeusage.FieldPromoCodeID)
+func (m *ProxyMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.created_at != nil {
+		fields = append(fields, proxy.FieldCreatedAt)
 	}
-	if m.user != nil {
-		fields = append(fields, promocodeusage.FieldUserID)
+	if m.updated_at != nil {
+		fields = append(fields, proxy.FieldUpdatedAt)
 	}
-	if m.bonus_amount != nil {
-		fields = append(fields, promocodeusage.FieldBonusAmount)
+	if m.deleted_at != nil {
+		fields = append(fields, proxy.FieldDeletedAt)
 	}
-	if m.used_at != nil {
-		fields = append(fields, promocodeusage.FieldUsedAt)
+	if m.name != nil {
+		fields = append(fields, proxy.FieldName)
+	}
+	if m.protocol != nil {
+		fields = append(fields, proxy.FieldProtocol)
+	}
+	if m.host != nil {
+		fields = append(fields, proxy.FieldHost)
+	}
+	if m.port != nil {
+		fields = append(fields, proxy.FieldPort)
+	}
+	if m.username != nil {
+		fields = append(fields, proxy.FieldUsername)
+	}
+	if m.password != nil {
+		fields = append(fields, proxy.FieldPassword)
+	}
+	if m.status != nil {
+		fields = append(fields, proxy.FieldStatus)
 	}
 	return fields
 }
@@ -7629,16 +11525,28 @@ func (m *PromoCodeUsageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PromoCodeUsageMutation) Field(name string) (ent.Value, bool) {
+func (m *ProxyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case promocodeusage.FieldPromoCodeID:
-		return m.PromoCodeID()
-	case promocodeusage.FieldUserID:
-		return m.UserID()
-	case promocodeusage.FieldBonusAmount:
-		return m.BonusAmount()
-	case promocodeusage.FieldUsedAt:
-		return m.UsedAt()
+	case proxy.FieldCreatedAt:
+		return m.CreatedAt()
+	case proxy.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case proxy.FieldDeletedAt:
+		return m.DeletedAt()
+	case proxy.FieldName:
+		return m.Name()
+	case proxy.FieldProtocol:
+		return m.Protocol()
+	case proxy.FieldHost:
+		return m.Host()
+	case proxy.FieldPort:
+		return m.Port()
+	case proxy.FieldUsername:
+		return m.Username()
+	case proxy.FieldPassword:
+		return m.Password()
+	case proxy.FieldStatus:
+		return m.Status()
 	}
 	return nil, false
 }
@@ -7646,63 +11554,117 @@ func (m *PromoCodeUsageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PromoCodeUsageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ProxyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case promocodeusage.FieldPromoCodeID:
-		return m.OldPromoCodeID(ctx)
-	case promocodeusage.FieldUserID:
-		return m.OldUserID(ctx)
-	case promocodeusage.FieldBonusAmount:
-		return m.OldBonusAmount(ctx)
-	case promocodeusage.FieldUsedAt:
-		return m.OldUsedAt(ctx)
+	case proxy.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case proxy.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case proxy.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case proxy.FieldName:
+		return m.OldName(ctx)
+	case proxy.FieldProtocol:
+		return m.OldProtocol(ctx)
+	case proxy.FieldHost:
+		return m.OldHost(ctx)
+	case proxy.FieldPort:
+		return m.OldPort(ctx)
+	case proxy.FieldUsername:
+		return m.OldUsername(ctx)
+	case proxy.FieldPassword:
+		return m.OldPassword(ctx)
+	case proxy.FieldStatus:
+		return m.OldStatus(ctx)
 	}
-	return nil, fmt.Errorf("unknown PromoCodeUsage field %s", name)
+	return nil, fmt.Errorf("unknown Proxy field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PromoCodeUsageMutation) SetField(name string, value ent.Value) error {
+func (m *ProxyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case promocodeusage.FieldPromoCodeID:
-		v, ok := value.(int64)
+	case proxy.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPromoCodeID(v)
+		m.SetCreatedAt(v)
 		return nil
-	case promocodeusage.FieldUserID:
-		v, ok := value.(int64)
+	case proxy.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetUpdatedAt(v)
 		return nil
-	case promocodeusage.FieldBonusAmount:
-		v, ok := value.(float64)
+	case proxy.FieldDeletedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBonusAmount(v)
+		m.SetDeletedAt(v)
 		return nil
-	case promocodeusage.FieldUsedAt:
-		v, ok := value.(time.Time)
+	case proxy.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUsedAt(v)
+		m.SetName(v)
+		return nil
+	case proxy.FieldProtocol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProtocol(v)
+		return nil
+	case proxy.FieldHost:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHost(v)
+		return nil
+	case proxy.FieldPort:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPort(v)
+		return nil
+	case proxy.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case proxy.FieldPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassword(v)
+		return nil
+	case proxy.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PromoCodeUsage field %s", name)
+	return fmt.Errorf("unknown Proxy field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PromoCodeUsageMutation) AddedFields() []string {
+func (m *ProxyMutation) AddedFields() []string {
 	var fields []string
-	if m.addbonus_amount != nil {
-		fields = append(fields, promocodeusage.FieldBonusAmount)
+	if m.addport != nil {
+		fields = append(fields, proxy.FieldPort)
 	}
 	return fields
 }
@@ -7710,10 +11672,10 @@ func (m *PromoCodeUsageMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PromoCodeUsageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ProxyMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case promocodeusage.FieldBonusAmount:
-		return m.AddedBonusAmount()
+	case proxy.FieldPort:
+		return m.AddedPort()
 	}
 	return nil, false
 }
@@ -7721,187 +11683,226 @@ func (m *PromoCodeUsageMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PromoCodeUsageMutation) AddField(name string, value ent.Value) error {
+func (m *ProxyMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case promocodeusage.FieldBonusAmount:
-		v, ok := value.(float64)
+	case proxy.FieldPort:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddBonusAmount(v)
+		m.AddPort(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PromoCodeUsage numeric field %s", name)
+	return fmt.Errorf("unknown Proxy numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PromoCodeUsageMutation) ClearedFields() []string {
-	return nil
+func (m *ProxyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(proxy.FieldDeletedAt) {
+		fields = append(fields, proxy.FieldDeletedAt)
+	}
+	if m.FieldCleared(proxy.FieldUsername) {
+		fields = append(fields, proxy.FieldUsername)
+	}
+	if m.FieldCleared(proxy.FieldPassword) {
+		fields = append(fields, proxy.FieldPassword)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PromoCodeUsageMutation) FieldCleared(name string) bool {
+func (m *ProxyMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PromoCodeUsageMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown PromoCodeUsage nullable field %s", name)
+func (m *ProxyMutation) ClearField(name string) error {
+	switch name {
+	case proxy.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case proxy.FieldUsername:
+		m.ClearUsername()
+		return nil
+	case proxy.FieldPassword:
+		m.ClearPassword()
+		return nil
+	}
+	return fmt.Errorf("unknown Proxy nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PromoCodeUsageMutation) ResetField(name string) error {
+func (m *ProxyMutation) ResetField(name string) error {
 	switch name {
-	case promocodeusage.FieldPromoCodeID:
-		m.ResetPromoCodeID()
+	case proxy.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case promocodeusage.FieldUserID:
-		m.ResetUserID()
+	case proxy.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
-	case promocodeusage.FieldBonusAmount:
-		m.ResetBonusAmount()
+	case proxy.FieldDeletedAt:
+		m.ResetDeletedAt()
 		return nil
-	case promocodeusage.FieldUsedAt:
-		m.ResetUsedAt()
+	case proxy.FieldName:
+		m.ResetName()
+		return nil
+	case proxy.FieldProtocol:
+		m.ResetProtocol()
+		return nil
+	case proxy.FieldHost:
+		m.ResetHost()
+		return nil
+	case proxy.FieldPort:
+		m.ResetPort()
+		return nil
+	case proxy.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case proxy.FieldPassword:
+		m.ResetPassword()
+		return nil
+	case proxy.FieldStatus:
+		m.ResetStatus()
 		return nil
 	}
-	return fmt.Errorf("unknown PromoCodeUsage field %s", name)
+	return fmt.Errorf("unknown Proxy field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PromoCodeUsageMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.promo_code != nil {
-		edges = append(edges, promocodeusage.EdgePromoCode)
-	}
-	if m.user != nil {
-		edges = append(edges, promocodeusage.EdgeUser)
+func (m *ProxyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.accounts != nil {
+		edges = append(edges, proxy.EdgeAccounts)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PromoCodeUsageMutation) AddedIDs(name string) []ent.Value {
+func (m *ProxyMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case promocodeusage.EdgePromoCode:
-		if id := m.promo_code; id != nil {
-			return []ent.Value{*id}
-		}
-	case promocodeusage.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
+	case proxy.EdgeAccounts:
+		ids := make([]ent.Value, 0, len(m.accounts))
+		for id := range m.accounts {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PromoCodeUsageMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *ProxyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedaccounts != nil {
+		edges = append(edges, proxy.EdgeAccounts)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PromoCodeUsageMutation) RemovedIDs(name string) []ent.Value {
+func (m *ProxyMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case proxy.EdgeAccounts:
+		ids := make([]ent.Value, 0, len(m.removedaccounts))
+		for id := range m.removedaccounts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PromoCodeUsageMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedpromo_code {
-		edges = append(edges, promocodeusage.EdgePromoCode)
-	}
-	if m.cleareduser {
-		edges = append(edges, promocodeusage.EdgeUser)
+func (m *ProxyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedaccounts {
+		edges = append(edges, proxy.EdgeAccounts)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PromoCodeUsageMutation) EdgeCleared(name string) bool {
+func (m *ProxyMutation) EdgeCleared(name string) bool {
 	switch name {
-	case promocodeusage.EdgePromoCode:
-		return m.clearedpromo_code
-	case promocodeusage.EdgeUser:
-		return m.cleareduser
+	case proxy.EdgeAccounts:
+		return m.clearedaccounts
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PromoCodeUsageMutation) ClearEdge(name string) error {
+func (m *ProxyMutation) ClearEdge(name string) error {
 	switch name {
-	case promocodeusage.EdgePromoCode:
-		m.ClearPromoCode()
-		return nil
-	case promocodeusage.EdgeUser:
-		m.ClearUser()
-		return nil
 	}
-	return fmt.Errorf("unknown PromoCodeUsage unique edge %s", name)
+	return fmt.Errorf("unknown Proxy unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PromoCodeUsageMutation) ResetEdge(name string) error {
+func (m *ProxyMutation) ResetEdge(name string) error {
 	switch name {
-	case promocodeusage.EdgePromoCode:
-		m.ResetPromoCode()
-		return nil
-	case promocodeusage.EdgeUser:
-		m.ResetUser()
+	case proxy.EdgeAccounts:
+		m.ResetAccounts()
 		return nil
 	}
-	return fmt.Errorf("unknown PromoCodeUsage edge %s", name)
+	return fmt.Errorf("unknown Proxy edge %s", name)
 }
 
-// ProxyMutation represents an operation that mutates the Proxy nodes in the graph.
-type ProxyMutation struct {
+// RedeemCodeMutation represents an operation that mutates the RedeemCode nodes in the graph.
+type RedeemCodeMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int64
-	created_at      *time.Time
-	updated_at      *time.Time
-	deleted_at      *time.Time
-	name            *string
-	protocol        *string
-	host            *string
-	port            *int
-	addport         *int
-	username        *string
-	password        *string
-	status          *string
-	clearedFields   map[string]struct{}
-	accounts        map[int64]struct{}
-	removedaccounts map[int64]struct{}
-	clearedaccounts bool
-	done            bool
-	oldValue        func(context.Context) (*Proxy, error)
-	predicates      []predicate.Proxy
+	op                      Op
+	typ                     string
+	id                      *int64
+	code                    *string
+	_type                   *string
+	value                   *float64
+	addvalue                *float64
+	status                  *string
+	used_at                 *time.Time
+	notes                   *string
+	created_at              *time.Time
+	validity_days           *int
+	addvalidity_days        *int
+	credit_validity_days    *int
+	addcredit_validity_days *int
+	batch_id                *int64
+	addbatch_id             *int64
+	max_redemptions         *int
+	addmax_redemptions      *int
+	redeemed_count          *int
+	addredeemed_count       *int
+	clearedFields           map[string]struct{}
+	user                    *int64
+	cleareduser             bool
+	group                   *int64
+	clearedgroup            bool
+	done                    bool
+	oldValue                func(context.Context) (*RedeemCode, error)
+	predicates              []predicate.RedeemCode
 }
 
-var _ ent.Mutation = (*ProxyMutation)(nil)
+var _ ent.Mutation = (*RedeemCodeMutation)(nil)
 
-// proxyOption allows management of the mutation configuration using functional options.
-type proxyOption func(*ProxyMutation)
+// redeemcodeOption allows management of the mutation configuration using functional options.
+type redeemcodeOption func(*RedeemCodeMutation)
 
-// newProxyMutation creates new mutation for the Proxy entity.
-func newProxyMutation(c config, op Op, opts ...proxyOption) *ProxyMutation {
-	m := &ProxyMutation{
+// newRedeemCodeMutation creates new mutation for the RedeemCode entity.
+func newRedeemCodeMutation(c config, op Op, opts ...redeemcodeOption) *RedeemCodeMutation {
+	m := &RedeemCodeMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeProxy,
+		typ:           TypeRedeemCode,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7910,20 +11911,20 @@ func newProxyMutation(c config, op Op, opts ...proxyOption) *ProxyMutation {
 	return m
 }
 
-// withProxyID sets the ID field of the mutation.
-func withProxyID(id int64) proxyOption {
-	return func(m *ProxyMutation) {
+// withRedeemCodeID sets the ID field of the mutation.
+func withRedeemCodeID(id int64) redeemcodeOption {
+	return func(m *RedeemCodeMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Proxy
+			value *RedeemCode
 		)
-		m.oldValue = func(ctx context.Context) (*Proxy, error) {
+		m.oldValue = func(ctx context.Context) (*RedeemCode, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Proxy.Get(ctx, id)
+					value, err = m.Client().RedeemCode.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7932,545 +11933,829 @@ func withProxyID(id int64) proxyOption {
 	}
 }
 
-// withProxy sets the old Proxy of the mutation.
-func withProxy(node *Proxy) proxyOption {
-	return func(m *ProxyMutation) {
-		m.oldValue = func(context.Context) (*Proxy, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// withRedeemCode sets the old RedeemCode of the mutation.
+func withRedeemCode(node *RedeemCode) redeemcodeOption {
+	return func(m *RedeemCodeMutation) {
+		m.oldValue = func(context.Context) (*RedeemCode, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RedeemCodeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RedeemCodeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RedeemCodeMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RedeemCodeMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RedeemCode.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCode sets the "code" field.
+func (m *RedeemCodeMutation) SetCode(s string) {
+	m.code = &s
+}
+
+// Code returns the value of the "code" field in the mutation.
+func (m *RedeemCodeMutation) Code() (r string, exists bool) {
+	v := m.code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCode returns the old "code" field's value of the RedeemCode entity.
+// If the RedeemCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RedeemCodeMutation) OldCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+	}
+	return oldValue.Code, nil
+}
+
+// ResetCode resets all changes to the "code" field.
+func (m *RedeemCodeMutation) ResetCode() {
+	m.code = nil
+}
+
+// SetType sets the "type" field.
+func (m *RedeemCodeMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *RedeemCodeMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the RedeemCode entity.
+// If the RedeemCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RedeemCodeMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *RedeemCodeMutation) ResetType() {
+	m._type = nil
+}
+
+// SetValue sets the "value" field.
+func (m *RedeemCodeMutation) SetValue(f float64) {
+	m.value = &f
+	m.addvalue = nil
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *RedeemCodeMutation) Value() (r float64, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the RedeemCode entity.
+// If the RedeemCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RedeemCodeMutation) OldValue(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// AddValue adds f to the "value" field.
+func (m *RedeemCodeMutation) AddValue(f float64) {
+	if m.addvalue != nil {
+		*m.addvalue += f
+	} else {
+		m.addvalue = &f
+	}
+}
+
+// AddedValue returns the value that was added to the "value" field in this mutation.
+func (m *RedeemCodeMutation) AddedValue() (r float64, exists bool) {
+	v := m.addvalue
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProxyMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetValue resets all changes to the "value" field.
+func (m *RedeemCodeMutation) ResetValue() {
+	m.value = nil
+	m.addvalue = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m ProxyMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// SetStatus sets the "status" field.
+func (m *RedeemCodeMutation) SetStatus(s string) {
+	m.status = &s
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *ProxyMutation) ID() (id int64, exists bool) {
-	if m.id == nil {
+// Status returns the value of the "status" field in the mutation.
+func (m *RedeemCodeMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *ProxyMutation) IDs(ctx context.Context) ([]int64, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int64{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Proxy.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldStatus returns the old "status" field's value of the RedeemCode entity.
+// If the RedeemCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RedeemCodeMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
+	return oldValue.Status, nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ProxyMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ResetStatus resets all changes to the "status" field.
+func (m *RedeemCodeMutation) ResetStatus() {
+	m.status = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ProxyMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// SetUsedBy sets the "used_by" field.
+func (m *RedeemCodeMutation) SetUsedBy(i int64) {
+	m.user = &i
+}
+
+// UsedBy returns the value of the "used_by" field in the mutation.
+func (m *RedeemCodeMutation) UsedBy() (r int64, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Proxy entity.
-// If the Proxy object wasn't provided to the builder, the object is fetched from the database.
+// OldUsedBy returns the old "used_by" field's value of the RedeemCode entity.
+// If the RedeemCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProxyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *RedeemCodeMutation) OldUsedBy(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUsedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldUsedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUsedBy: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.UsedBy, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ProxyMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearUsedBy clears the value of the "used_by" field.
+func (m *RedeemCodeMutation) ClearUsedBy() {
+	m.user = nil
+	m.clearedFields[redeemcode.FieldUsedBy] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ProxyMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// UsedByCleared returns if the "used_by" field was cleared in this mutation.
+func (m *RedeemCodeMutation) UsedByCleared() bool {
+	_, ok := m.clearedFields[redeemcode.FieldUsedBy]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ProxyMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetUsedBy resets all changes to the "used_by" field.
+func (m *RedeemCodeMutation) ResetUsedBy() {
+	m.user = nil
+	delete(m.clearedFields, redeemcode.FieldUsedBy)
+}
+
+// SetUsedAt sets the "used_at" field.
+func (m *RedeemCodeMutation) SetUsedAt(t time.Time) {
+	m.used_at = &t
+}
+
+// UsedAt returns the value of the "used_at" field in the mutation.
+func (m *RedeemCodeMutation) UsedAt() (r time.Time, exists bool) {
+	v := m.used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Proxy entity.
-// If the Proxy object wasn't provided to the builder, the object is fetched from the database.
+// OldUsedAt returns the old "used_at" field's value of the RedeemCode entity.
+// If the RedeemCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProxyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *RedeemCodeMutation) OldUsedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUsedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUsedAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.UsedAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ProxyMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearUsedAt clears the value of the "used_at" field.
+func (m *RedeemCodeMutation) ClearUsedAt() {
+	m.used_at = nil
+	m.clearedFields[redeemcode.FieldUsedAt] = struct{}{}
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *ProxyMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// UsedAtCleared returns if the "used_at" field was cleared in this mutation.
+func (m *RedeemCodeMutation) UsedAtCleared() bool {
+	_, ok := m.clearedFields[redeemcode.FieldUsedAt]
+	return ok
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *ProxyMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// ResetUsedAt resets all changes to the "used_at" field.
+func (m *RedeemCodeMutation) ResetUsedAt() {
+	m.used_at = nil
+	delete(m.clearedFields, redeemcode.FieldUsedAt)
+}
+
+// SetNotes sets the "notes" field.
+func (m *RedeemCodeMutation) SetNotes(s string) {
+	m.notes = &s
+}
+
+// Notes returns the value of the "notes" field in the mutation.
+func (m *RedeemCodeMutation) Notes() (r string, exists bool) {
+	v := m.notes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Proxy entity.
-// If the Proxy object wasn't provided to the builder, the object is fetched from the database.
+// OldNotes returns the old "notes" field's value of the RedeemCode entity.
+// If the RedeemCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProxyMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *RedeemCodeMutation) OldNotes(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldNotes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldNotes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldNotes: %w", err)
 	}
-	return oldValue.DeletedAt, nil
+	return oldValue.Notes, nil
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *ProxyMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[proxy.FieldDeletedAt] = struct{}{}
+// ClearNotes clears the value of the "notes" field.
+func (m *RedeemCodeMutation) ClearNotes() {
+	m.notes = nil
+	m.clearedFields[redeemcode.FieldNotes] = struct{}{}
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *ProxyMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[proxy.FieldDeletedAt]
+// NotesCleared returns if the "notes" field was cleared in this mutation.
+func (m *RedeemCodeMutation) NotesCleared() bool {
+	_, ok := m.clearedFields[redeemcode.FieldNotes]
 	return ok
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *ProxyMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, proxy.FieldDeletedAt)
+// ResetNotes resets all changes to the "notes" field.
+func (m *RedeemCodeMutation) ResetNotes() {
+	m.notes = nil
+	delete(m.clearedFields, redeemcode.FieldNotes)
 }
 
-// SetName sets the "name" field.
-func (m *ProxyMutation) SetName(s string) {
-	m.name = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *RedeemCodeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ProxyMutation) Name() (r string, exists bool) {
-	v := m.name
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RedeemCodeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Proxy entity.
-// If the Proxy object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the RedeemCode entity.
+// If the RedeemCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProxyMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *RedeemCodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *ProxyMutation) ResetName() {
-	m.name = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RedeemCodeMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetProtocol sets the "protocol" field.
-func (m *ProxyMutation) SetProtocol(s string) {
-	m.protocol = &s
+// SetGroupID sets the "group_id" field.
+func (m *RedeemCodeMutation) SetGroupID(i int64) {
+	m.group = &i
 }
 
-// Protocol returns the value of the "protocol" field in the mutation.
-func (m *ProxyMutation) Protocol() (r string, exists bool) {
-	v := m.protocol
+// GroupID returns the value of the "group_id" field in the mutation.
+func (m *RedeemCodeMutation) GroupID() (r int64, exists bool) {
+	v := m.group
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProtocol returns the old "protocol" field's value of the Proxy entity.
-// If the Proxy object wasn't provided to the builder, the object is fetched from the database.
+// OldGroupID returns the old "group_id" field's value of the RedeemCode entity.
+// If the RedeemCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProxyMutation) OldProtocol(ctx context.Context) (v string, err error) {
+func (m *RedeemCodeMutation) OldGroupID(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProtocol is only allowed on UpdateOne operations")
+		return v, errors.New("OldGroupID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProtocol requires an ID field in the mutation")
+		return v, errors.New("OldGroupID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProtocol: %w", err)
+		return v, fmt.Errorf("querying old value for OldGroupID: %w", err)
 	}
-	return oldValue.Protocol, nil
+	return oldValue.GroupID, nil
 }
 
-// ResetProtocol resets all changes to the "protocol" field.
-func (m *ProxyMutation) ResetProtocol() {
-	m.protocol = nil
+// ClearGroupID clears the value of the "group_id" field.
+func (m *RedeemCodeMutation) ClearGroupID() {
+	m.group = nil
+	m.clearedFields[redeemcode.FieldGroupID] = struct{}{}
 }
 
-// SetHost sets the "host" field.
-func (m *ProxyMutation) SetHost(s string) {
-	m.host = &s
+// GroupIDCleared returns if the "group_id" field was cleared in this mutation.
+func (m *RedeemCodeMutation) GroupIDCleared() bool {
+	_, ok := m.clearedFields[redeemcode.FieldGroupID]
+	return ok
 }
 
-// Host returns the value of the "host" field in the mutation.
-func (m *ProxyMutation) Host() (r string, exists bool) {
-	v := m.host
+// ResetGroupID resets all changes to the "group_id" field.
+func (m *RedeemCodeMutation) ResetGroupID() {
+	m.group = nil
+	delete(m.clearedFields, redeemcode.FieldGroupID)
+}
+
+// SetValidityDays sets the "validity_days" field.
+func (m *RedeemCodeMutation) SetValidityDays(i int) {
+	m.validity_days = &i
+	m.addvalidity_days = nil
+}
+
+// ValidityDays returns the value of the "validity_days" field in the mutation.
+func (m *RedeemCodeMutation) ValidityDays() (r int, exists bool) {
+	v := m.validity_days
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHost returns the old "host" field's value of the Proxy entity.
-// If the Proxy object wasn't provided to the builder, the object is fetched from the database.
+// OldValidityDays returns the old "validity_days" field's value of the RedeemCode entity.
+// If the RedeemCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProxyMutation) OldHost(ctx context.Context) (v string, err error) {
+func (m *RedeemCodeMutation) OldValidityDays(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHost is only allowed on UpdateOne operations")
+		return v, errors.New("OldValidityDays is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHost requires an ID field in the mutation")
+		return v, errors.New("OldValidityDays requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHost: %w", err)
+		return v, fmt.Errorf("querying old value for OldValidityDays: %w", err)
 	}
-	return oldValue.Host, nil
+	return oldValue.ValidityDays, nil
 }
 
-// ResetHost resets all changes to the "host" field.
-func (m *ProxyMutation) ResetHost() {
-	m.host = nil
+// AddValidityDays adds i to the "validity_days" field.
+func (m *RedeemCodeMutation) AddValidityDays(i int) {
+	if m.addvalidity_days != nil {
+		*m.addvalidity_days += i
+	} else {
+		m.addvalidity_days = &i
+	}
 }
 
-// SetPort sets the "port" field.
-func (m *ProxyMutation) SetPort(i int) {
-	m.port = &i
-	m.addport = nil
+// AddedValidityDays returns the value that was added to the "validity_days" field in this mutation.
+func (m *RedeemCodeMutation) AddedValidityDays() (r int, exists bool) {
+	v := m.addvalidity_days
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Port returns the value of the "port" field in the mutation.
-func (m *ProxyMutation) Port() (r int, exists bool) {
-	v := m.port
+// ResetValidityDays resets all changes to the "validity_days" field.
+func (m *RedeemCodeMutation) ResetValidityDays() {
+	m.validity_days = nil
+	m.addvalidity_days = nil
+}
+
+// SetCreditValidityDays sets the "credit_validity_days" field.
+func (m *RedeemCodeMutation) SetCreditValidityDays(i int) {
+	m.credit_validity_days = &i
+	m.addcredit_validity_days = nil
+}
+
+// CreditValidityDays returns the value of the "credit_validity_days" field in the mutation.
+func (m *RedeemCodeMutation) CreditValidityDays() (r int, exists bool) {
+	v := m.credit_validity_days
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPort returns the old "port" field's value of the Proxy entity.
-// If the Proxy object wasn't provided to the builder, the object is fetched from the database.
+// OldCreditValidityDays returns the old "credit_validity_days" field's value of the RedeemCode entity.
+// If the RedeemCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProxyMutation) OldPort(ctx context.Context) (v int, err error) {
+func (m *RedeemCodeMutation) OldCreditValidityDays(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPort is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreditValidityDays is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPort requires an ID field in the mutation")
+		return v, errors.New("OldCreditValidityDays requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPort: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreditValidityDays: %w", err)
 	}
-	return oldValue.Port, nil
+	return oldValue.CreditValidityDays, nil
 }
 
-// AddPort adds i to the "port" field.
-func (m *ProxyMutation) AddPort(i int) {
-	if m.addport != nil {
-		*m.addport += i
+// AddCreditValidityDays adds i to the "credit_validity_days" field.
+func (m *RedeemCodeMutation) AddCreditValidityDays(i int) {
+	if m.addcredit_validity_days != nil {
+		*m.addcredit_validity_days += i
 	} else {
-		m.addport = &i
+		m.addcredit_validity_days = &i
 	}
 }
 
-// AddedPort returns the value that was added to the "port" field in this mutation.
-func (m *ProxyMutation) AddedPort() (r int, exists bool) {
-	v := m.addport
+// AddedCreditValidityDays returns the value that was added to the "credit_validity_days" field in this mutation.
+func (m *RedeemCodeMutation) AddedCreditValidityDays() (r int, exists bool) {
+	v := m.addcredit_validity_days
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetPort resets all changes to the "port" field.
-func (m *ProxyMutation) ResetPort() {
-	m.port = nil
-	m.addport = nil
+// ResetCreditValidityDays resets all changes to the "credit_validity_days" field.
+func (m *RedeemCodeMutation) ResetCreditValidityDays() {
+	m.credit_validity_days = nil
+	m.addcredit_validity_days = nil
 }
 
-// SetUsername sets the "username" field.
-func (m *ProxyMutation) SetUsername(s string) {
-	m.username = &s
+// SetBatchID sets the "batch_id" field.
+func (m *RedeemCodeMutation) SetBatchID(i int64) {
+	m.batch_id = &i
+	m.addbatch_id = nil
 }
 
-// Username returns the value of the "username" field in the mutation.
-func (m *ProxyMutation) Username() (r string, exists bool) {
-	v := m.username
+// BatchID returns the value of the "batch_id" field in the mutation.
+func (m *RedeemCodeMutation) BatchID() (r int64, exists bool) {
+	v := m.batch_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsername returns the old "username" field's value of the Proxy entity.
-// If the Proxy object wasn't provided to the builder, the object is fetched from the database.
+// OldBatchID returns the old "batch_id" field's value of the RedeemCode entity.
+// If the RedeemCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProxyMutation) OldUsername(ctx context.Context) (v *string, err error) {
+func (m *RedeemCodeMutation) OldBatchID(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+		return v, errors.New("OldBatchID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsername requires an ID field in the mutation")
+		return v, errors.New("OldBatchID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+		return v, fmt.Errorf("querying old value for OldBatchID: %w", err)
 	}
-	return oldValue.Username, nil
+	return oldValue.BatchID, nil
 }
 
-// ClearUsername clears the value of the "username" field.
-func (m *ProxyMutation) ClearUsername() {
-	m.username = nil
-	m.clearedFields[proxy.FieldUsername] = struct{}{}
+// AddBatchID adds i to the "batch_id" field.
+func (m *RedeemCodeMutation) AddBatchID(i int64) {
+	if m.addbatch_id != nil {
+		*m.addbatch_id += i
+	} else {
+		m.addbatch_id = &i
+	}
 }
 
-// UsernameCleared returns if the "username" field was cleared in this mutation.
-func (m *ProxyMutation) UsernameCleared() bool {
-	_, ok := m.clearedFields[proxy.FieldUsername]
+// AddedBatchID returns the value that was added to the "batch_id" field in this mutation.
+func (m *RedeemCodeMutation) AddedBatchID() (r int64, exists bool) {
+	v := m.addbatch_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearBatchID clears the value of the "batch_id" field.
+func (m *RedeemCodeMutation) ClearBatchID() {
+	m.batch_id = nil
+	m.addbatch_id = nil
+	m.clearedFields[redeemcode.FieldBatchID] = struct{}{}
+}
+
+// BatchIDCleared returns if the "batch_id" field was cleared in this mutation.
+func (m *RedeemCodeMutation) BatchIDCleared() bool {
+	_, ok := m.clearedFields[redeemcode.FieldBatchID]
 	return ok
 }
 
-// ResetUsername resets all changes to the "username" field.
-func (m *ProxyMutation) ResetUsername() {
-	m.username = nil
-	delete(m.clearedFields, proxy.FieldUsername)
+// ResetBatchID resets all changes to the "batch_id" field.
+func (m *RedeemCodeMutation) ResetBatchID() {
+	m.batch_id = nil
+	m.addbatch_id = nil
+	delete(m.clearedFields, redeemcode.FieldBatchID)
 }
 
-// SetPassword sets the "password" field.
-func (m *ProxyMutation) SetPassword(s string) {
-	m.password = &s
+// SetMaxRedemptions sets the "max_redemptions" field.
+func (m *RedeemCodeMutation) SetMaxRedemptions(i int) {
+	m.max_redemptions = &i
+	m.addmax_redemptions = nil
 }
 
-// Password returns the value of the "password" field in the mutation.
-func (m *ProxyMutation) Password() (r string, exists bool) {
-	v := m.password
+// MaxRedemptions returns the value of the "max_redemptions" field in the mutation.
+func (m *RedeemCodeMutation) MaxRedemptions() (r int, exists bool) {
+	v := m.max_redemptions
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPassword returns the old "password" field's value of the Proxy entity.
-// If the Proxy object wasn't provided to the builder, the object is fetched from the database.
+// OldMaxRedemptions returns the old "max_redemptions" field's value of the RedeemCode entity.
+// If the RedeemCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProxyMutation) OldPassword(ctx context.Context) (v *string, err error) {
+func (m *RedeemCodeMutation) OldMaxRedemptions(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+		return v, errors.New("OldMaxRedemptions is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
+		return v, errors.New("OldMaxRedemptions requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+		return v, fmt.Errorf("querying old value for OldMaxRedemptions: %w", err)
 	}
-	return oldValue.Password, nil
+	return oldValue.MaxRedemptions, nil
 }
 
-// ClearPassword clears the value of the "password" field.
-func (m *ProxyMutation) ClearPassword() {
-	m.password = nil
-	m.clearedFields[proxy.FieldPassword] = struct{}{}
+// AddMaxRedemptions adds i to the "max_redemptions" field.
+func (m *RedeemCodeMutation) AddMaxRedemptions(i int) {
+	if m.addmax_redemptions != nil {
+		*m.addmax_redemptions += i
+	} else {
+		m.addmax_redemptions = &i
+	}
 }
 
-// PasswordCleared returns if the "password" field was cleared in this mutation.
-func (m *ProxyMutation) PasswordCleared() bool {
-	_, ok := m.clearedFields[proxy.FieldPassword]
-	return ok
+// AddedMaxRedemptions returns the value that was added to the "max_redemptions" field in this mutation.
+func (m *RedeemCodeMutation) AddedMaxRedemptions() (r int, exists bool) {
+	v := m.addmax_redemptions
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetPassword resets all changes to the "password" field.
-func (m *ProxyMutation) ResetPassword() {
-	m.password = nil
-	delete(m.clearedFields, proxy.FieldPassword)
+// ResetMaxRedemptions resets all changes to the "max_redemptions" field.
+func (m *RedeemCodeMutation) ResetMaxRedemptions() {
+	m.max_redemptions = nil
+	m.addmax_redemptions = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *ProxyMutation) SetStatus(s string) {
-	m.status = &s
+// SetRedeemedCount sets the "redeemed_count" field.
+func (m *RedeemCodeMutation) SetRedeemedCount(i int) {
+	m.redeemed_count = &i
+	m.addredeemed_count = nil
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *ProxyMutation) Status() (r string, exists bool) {
-	v := m.status
+// RedeemedCount returns the value of the "redeemed_count" field in the mutation.
+func (m *RedeemCodeMutation) RedeemedCount() (r int, exists bool) {
+	v := m.redeemed_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Proxy entity.
-// If the Proxy object wasn't provided to the builder, the object is fetched from the database.
+// OldRedeemedCount returns the old "redeemed_count" field's value of the RedeemCode entity.
+// If the RedeemCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProxyMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *RedeemCodeMutation) OldRedeemedCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldRedeemedCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldRedeemedCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldRedeemedCount: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.RedeemedCount, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *ProxyMutation) ResetStatus() {
-	m.status = nil
+// AddRedeemedCount adds i to the "redeemed_count" field.
+func (m *RedeemCodeMutation) AddRedeemedCount(i int) {
+	if m.addredeemed_count != nil {
+		*m.addredeemed_count += i
+	} else {
+		m.addredeemed_count = &i
+	}
 }
 
-// AddAccountIDs adds the "accounts" edge to the Account entity by ids.
-func (m *ProxyMutation) AddAccountIDs(ids ...int64) {
-	if m.accounts == nil {
-		m.accounts = make(map[int64]struct{})
+// AddedRedeemedCount returns the value that was added to the "redeemed_count" field in this mutation.
+func (m *RedeemCodeMutation) AddedRedeemedCount() (r int, exists bool) {
+	v := m.addredeemed_count
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.accounts[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// ResetRedeemedCount resets all changes to the "redeemed_count" field.
+func (m *RedeemCodeMutation) ResetRedeemedCount() {
+	m.redeemed_count = nil
+	m.addredeemed_count = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *RedeemCodeMutation) SetUserID(id int64) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *RedeemCodeMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[redeemcode.FieldUsedBy] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *RedeemCodeMutation) UserCleared() bool {
+	return m.UsedByCleared() || m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *RedeemCodeMutation) UserID() (id int64, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
+	return
 }
 
-// ClearAccounts clears the "accounts" edge to the Account entity.
-func (m *ProxyMutation) ClearAccounts() {
-	m.clearedaccounts = true
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *RedeemCodeMutation) UserIDs() (ids []int64) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// AccountsCleared reports if the "accounts" edge to the Account entity was cleared.
-func (m *ProxyMutation) AccountsCleared() bool {
-	return m.clearedaccounts
+// ResetUser resets all changes to the "user" edge.
+func (m *RedeemCodeMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// RemoveAccountIDs removes the "accounts" edge to the Account entity by IDs.
-func (m *ProxyMutation) RemoveAccountIDs(ids ...int64) {
-	if m.removedaccounts == nil {
-		m.removedaccounts = make(map[int64]struct{})
-	}
-	for i := range ids {
-		delete(m.accounts, ids[i])
-		m.removedaccounts[ids[i]] = struct{}{}
-	}
+// ClearGroup clears the "group" edge to the Group entity.
+func (m *RedeemCodeMutation) ClearGroup() {
+	m.clearedgroup = true
+	m.clearedFields[redeemcode.FieldGroupID] = struct{}{}
 }
 
-// RemovedAccounts returns the removed IDs of the "accounts" edge to the Account entity.
-func (m *ProxyMutation) RemovedAccountsIDs() (ids []int64) {
-	for id := range m.removedaccounts {
-		ids = append(ids, id)
-	}
-	return
+// GroupCleared reports if the "group" edge to the Group entity was cleared.
+func (m *RedeemCodeMutation) GroupCleared() bool {
+	return m.GroupIDCleared() || m.clearedgroup
 }
 
-// AccountsIDs returns the "accounts" edge IDs in the mutation.
-func (m *ProxyMutation) AccountsIDs() (ids []int64) {
-	for id := range m.accounts {
-		ids = append(ids, id)
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *RedeemCodeMutation) GroupIDs() (ids []int64) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetAccounts resets all changes to the "accounts" edge.
-func (m *ProxyMutation) ResetAccounts() {
-	m.accounts = nil
-	m.clearedaccounts = false
-	m.removedaccounts = nil
+// ResetGroup resets all changes to the "group" edge.
+func (m *RedeemCodeMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
 }
 
-// Where appends a list predicates to the ProxyMutation builder.
-func (m *ProxyMutation) Where(ps ...predicate.Proxy) {
+// Where appends a list predicates to the RedeemCodeMutation builder.
+func (m *RedeemCodeMutation) Where(ps ...predicate.RedeemCode) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ProxyMutation builder. Using this method,
+// WhereP appends storage-level predicates to the RedeemCodeMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProxyMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Proxy, len(ps))
+func (m *RedeemCodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RedeemCode, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -8478,54 +12763,66 @@ func (m *ProxyMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ProxyMutation) Op() Op {
+func (m *RedeemCodeMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ProxyMutation) SetOp(op Op) {
+func (m *RedeemCodeMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Proxy).
-func (m *ProxyMutation) Type() string {
+// Type returns the node type of this mutation (RedeemCode).
+func (m *RedeemCodeMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ProxyMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.created_at != nil {
-		fields = append(fields, proxy.FieldCreatedAt)
+func (m *RedeemCodeMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.code != nil {
+		fields = append(fields, redeemcode.FieldCode)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, proxy.FieldUpdatedAt)
+	if m._type != nil {
+		fields = append(fields, redeemcode.FieldType)
 	}
-	if m.deleted_at != nil {
-		fields = append(fields, proxy.FieldDeletedAt)
+	if m.value != nil {
+		fields = append(fields, redeemcode.FieldValue)
 	}
-	if m.name != nil {
-		fields = append(fields, proxy.FieldName)
+	if m.status != nil {
+		fields = append(fields, redeemcode.FieldStatus)
 	}
-	if m.protocol != nil {
-		fields = append(fields, proxy.FieldProtocol)
+	if m.user != nil {
+		fields = append(fields, redeemcode.FieldUsedBy)
 	}
-	if m.host != nil {
-		fields = append(fields, proxy.FieldHost)
+	if m.used_at != nil {
+		fields = append(fields, redeemcode.FieldUsedAt)
 	}
-	if m.port != nil {
-		fields = append(fields, proxy.FieldPort)
+	if m.notes != nil {
+		fields = append(fields, redeemcode.FieldNotes)
 	}
-	if m.username != nil {
-		fields = append(fields, proxy.FieldUsername)
+	if m.created_at != nil {
+		fields = append(fields, redeemcode.FieldCreatedAt)
 	}
-	if m.password != nil {
-		fields = append(fields, proxy.FieldPassword)
+	if m.group != nil {
+		fields = append(fields, redeemcode.FieldGroupID)
 	}
-	if m.status != nil {
-		fields = append(fields, proxy.FieldStatus)
+	if m.validity_days != nil {
+		fields = append(fields, redeemcode.FieldValidityDays)
+	}
+	if m.credit_validity_days != nil {
+		fields = append(fields, redeemcode.FieldCreditValidityDays)
+	}
+	if m.batch_id != nil {
+		fields = append(fields, redeemcode.FieldBatchID)
+	}
+	if m.max_redemptions != nil {
+		fields = append(fields, redeemcode.FieldMaxRedemptions)
+	}
+	if m.redeemed_count != nil {
+		fields = append(fields, redeemcode.FieldRedeemedCount)
 	}
 	return fields
 }
@@ -8533,28 +12830,36 @@ func (m *ProxyMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ProxyMutation) Field(name string) (ent.Value, bool) {
+func (m *RedeemCodeMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case proxy.FieldCreatedAt:
-		return m.CreatedAt()
-	case proxy.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case proxy.FieldDeletedAt:
-		return m.DeletedAt()
-	case proxy.FieldName:
-		return m.Name()
-	case proxy.FieldProtocol:
-		return m.Protocol()
-	case proxy.FieldHost:
-		return m.Host()
-	case proxy.FieldPort:
-		return m.Port()
-	case proxy.FieldUsername:
-		return m.Username()
-	case proxy.FieldPassword:
-		return m.Password()
-	case proxy.FieldStatus:
+	case redeemcode.FieldCode:
+		return m.Code()
+	case redeemcode.FieldType:
+		return m.GetType()
+	case redeemcode.FieldValue:
+		return m.Value()
+	case redeemcode.FieldStatus:
 		return m.Status()
+	case redeemcode.FieldUsedBy:
+		return m.UsedBy()
+	case redeemcode.FieldUsedAt:
+		return m.UsedAt()
+	case redeemcode.FieldNotes:
+		return m.Notes()
+	case redeemcode.FieldCreatedAt:
+		return m.CreatedAt()
+	case redeemcode.FieldGroupID:
+		return m.GroupID()
+	case redeemcode.FieldValidityDays:
+		return m.ValidityDays()
+	case redeemcode.FieldCreditValidityDays:
+		return m.CreditValidityDays()
+	case redeemcode.FieldBatchID:
+		return m.BatchID()
+	case redeemcode.FieldMaxRedemptions:
+		return m.MaxRedemptions()
+	case redeemcode.FieldRedeemedCount:
+		return m.RedeemedCount()
 	}
 	return nil, false
 }
@@ -8562,117 +12867,168 @@ func (m *ProxyMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ProxyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RedeemCodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case proxy.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case proxy.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case proxy.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
-	case proxy.FieldName:
-		return m.OldName(ctx)
-	case proxy.FieldProtocol:
-		return m.OldProtocol(ctx)
-	case proxy.FieldHost:
-		return m.OldHost(ctx)
-	case proxy.FieldPort:
-		return m.OldPort(ctx)
-	case proxy.FieldUsername:
-		return m.OldUsername(ctx)
-	case proxy.FieldPassword:
-		return m.OldPassword(ctx)
-	case proxy.FieldStatus:
+	case redeemcode.FieldCode:
+		return m.OldCode(ctx)
+	case redeemcode.FieldType:
+		return m.OldType(ctx)
+	case redeemcode.FieldValue:
+		return m.OldValue(ctx)
+	case redeemcode.FieldStatus:
 		return m.OldStatus(ctx)
+	case redeemcode.FieldUsedBy:
+		return m.OldUsedBy(ctx)
+	case redeemcode.FieldUsedAt:
+		return m.OldUsedAt(ctx)
+	case redeemcode.FieldNotes:
+		return m.OldNotes(ctx)
+	case redeemcode.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case redeemcode.FieldGroupID:
+		return m.OldGroupID(ctx)
+	case redeemcode.FieldValidityDays:
+		return m.OldValidityDays(ctx)
+	case redeemcode.FieldCreditValidityDays:
+		return m.OldCreditValidityDays(ctx)
+	case redeemcode.FieldBatchID:
+		return m.OldBatchID(ctx)
+	case redeemcode.FieldMaxRedemptions:
+		return m.OldMaxRedemptions(ctx)
+	case redeemcode.FieldRedeemedCount:
+		return m.OldRedeemedCount(ctx)
 	}
-	return nil, fmt.Errorf("unknown Proxy field %s", name)
+	return nil, fmt.Errorf("unknown RedeemCode field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProxyMutation) SetField(name string, value ent.Value) error {
+func (m *RedeemCodeMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case proxy.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case redeemcode.FieldCode:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetCode(v)
 		return nil
-	case proxy.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case redeemcode.FieldType:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetType(v)
 		return nil
-	case proxy.FieldDeletedAt:
-		v, ok := value.(time.Time)
+	case redeemcode.FieldValue:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetValue(v)
 		return nil
-	case proxy.FieldName:
+	case redeemcode.FieldStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetStatus(v)
 		return nil
-	case proxy.FieldProtocol:
-		v, ok := value.(string)
+	case redeemcode.FieldUsedBy:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProtocol(v)
+		m.SetUsedBy(v)
 		return nil
-	case proxy.FieldHost:
+	case redeemcode.FieldUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsedAt(v)
+		return nil
+	case redeemcode.FieldNotes:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHost(v)
+		m.SetNotes(v)
+		return nil
+	case redeemcode.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case redeemcode.FieldGroupID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroupID(v)
+		return nil
+	case redeemcode.FieldValidityDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidityDays(v)
 		return nil
-	case proxy.FieldPort:
+	case redeemcode.FieldCreditValidityDays:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPort(v)
+		m.SetCreditValidityDays(v)
 		return nil
-	case proxy.FieldUsername:
-		v, ok := value.(string)
+	case redeemcode.FieldBatchID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUsername(v)
+		m.SetBatchID(v)
 		return nil
-	case proxy.FieldPassword:
-		v, ok := value.(string)
+	case redeemcode.FieldMaxRedemptions:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPassword(v)
+		m.SetMaxRedemptions(v)
 		return nil
-	case proxy.FieldStatus:
-		v, ok := value.(string)
+	case redeemcode.FieldRedeemedCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetRedeemedCount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Proxy field %s", name)
+	return fmt.Errorf("unknown RedeemCode field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ProxyMutation) AddedFields() []string {
+func (m *RedeemCodeMutation) AddedFields() []string {
 	var fields []string
-	if m.addport != nil {
-		fields = append(fields, proxy.FieldPort)
+	if m.addvalue != nil {
+		fields = append(fields, redeemcode.FieldValue)
+	}
+	if m.addvalidity_days != nil {
+		fields = append(fields, redeemcode.FieldValidityDays)
+	}
+	if m.addcredit_validity_days != nil {
+		fields = append(fields, redeemcode.FieldCreditValidityDays)
+	}
+	if m.addbatch_id != nil {
+		fields = append(fields, redeemcode.FieldBatchID)
+	}
+	if m.addmax_redemptions != nil {
+		fields = append(fields, redeemcode.FieldMaxRedemptions)
+	}
+	if m.addredeemed_count != nil {
+		fields = append(fields, redeemcode.FieldRedeemedCount)
 	}
 	return fields
 }
@@ -8680,10 +13036,20 @@ func (m *ProxyMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ProxyMutation) AddedField(name string) (ent.Value, bool) {
+func (m *RedeemCodeMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case proxy.FieldPort:
-		return m.AddedPort()
+	case redeemcode.FieldValue:
+		return m.AddedValue()
+	case redeemcode.FieldValidityDays:
+		return m.AddedValidityDays()
+	case redeemcode.FieldCreditValidityDays:
+		return m.AddedCreditValidityDays()
+	case redeemcode.FieldBatchID:
+		return m.AddedBatchID()
+	case redeemcode.FieldMaxRedemptions:
+		return m.AddedMaxRedemptions()
+	case redeemcode.FieldRedeemedCount:
+		return m.AddedRedeemedCount()
 	}
 	return nil, false
 }
@@ -8691,218 +13057,274 @@ func (m *ProxyMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProxyMutation) AddField(name string, value ent.Value) error {
+func (m *RedeemCodeMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case proxy.FieldPort:
+	case redeemcode.FieldValue:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddValue(v)
+		return nil
+	case redeemcode.FieldValidityDays:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddPort(v)
+		m.AddValidityDays(v)
+		return nil
+	case redeemcode.FieldCreditValidityDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreditValidityDays(v)
+		return nil
+	case redeemcode.FieldBatchID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBatchID(v)
+		return nil
+	case redeemcode.FieldMaxRedemptions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxRedemptions(v)
+		return nil
+	case redeemcode.FieldRedeemedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRedeemedCount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Proxy numeric field %s", name)
+	return fmt.Errorf("unknown RedeemCode numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ProxyMutation) ClearedFields() []string {
+func (m *RedeemCodeMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(proxy.FieldDeletedAt) {
-		fields = append(fields, proxy.FieldDeletedAt)
+	if m.FieldCleared(redeemcode.FieldUsedBy) {
+		fields = append(fields, redeemcode.FieldUsedBy)
 	}
-	if m.FieldCleared(proxy.FieldUsername) {
-		fields = append(fields, proxy.FieldUsername)
+	if m.FieldCleared(redeemcode.FieldUsedAt) {
+		fields = append(fields, redeemcode.FieldUsedAt)
 	}
-	if m.FieldCleared(proxy.FieldPassword) {
-		fields = append(fields, proxy.FieldPassword)
+	if m.FieldCleared(redeemcode.FieldNotes) {
+		fields = append(fields, redeemcode.FieldNotes)
+	}
+	if m.FieldCleared(redeemcode.FieldGroupID) {
+		fields = append(fields, redeemcode.FieldGroupID)
+	}
+	if m.FieldCleared(redeemcode.FieldBatchID) {
+		fields = append(fields, redeemcode.FieldBatchID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ProxyMutation) FieldCleared(name string) bool {
+func (m *RedeemCodeMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ProxyMutation) ClearField(name string) error {
+func (m *RedeemCodeMutation) ClearField(name string) error {
 	switch name {
-	case proxy.FieldDeletedAt:
-		m.ClearDeletedAt()
+	case redeemcode.FieldUsedBy:
+		m.ClearUsedBy()
 		return nil
-	case proxy.FieldUsername:
-		m.ClearUsername()
+	case redeemcode.FieldUsedAt:
+		m.ClearUsedAt()
 		return nil
-	case proxy.FieldPassword:
-		m.ClearPassword()
+	case redeemcode.FieldNotes:
+		m.ClearNotes()
+		return nil
+	case redeemcode.FieldGroupID:
+		m.ClearGroupID()
+		return nil
+	case redeemcode.FieldBatchID:
+		m.ClearBatchID()
 		return nil
 	}
-	return fmt.Errorf("unknown Proxy nullable field %s", name)
+	return fmt.Errorf("unknown RedeemCode nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ProxyMutation) ResetField(name string) error {
+func (m *RedeemCodeMutation) ResetField(name string) error {
 	switch name {
-	case proxy.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case redeemcode.FieldCode:
+		m.ResetCode()
 		return nil
-	case proxy.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case redeemcode.FieldType:
+		m.ResetType()
 		return nil
-	case proxy.FieldDeletedAt:
-		m.ResetDeletedAt()
+	case redeemcode.FieldValue:
+		m.ResetValue()
 		return nil
-	case proxy.FieldName:
-		m.ResetName()
+	case redeemcode.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case proxy.FieldProtocol:
-		m.ResetProtocol()
+	case redeemcode.FieldUsedBy:
+		m.ResetUsedBy()
 		return nil
-	case proxy.FieldHost:
-		m.ResetHost()
+	case redeemcode.FieldUsedAt:
+		m.ResetUsedAt()
 		return nil
-	case proxy.FieldPort:
-		m.ResetPort()
+	case redeemcode.FieldNotes:
+		m.ResetNotes()
 		return nil
-	case proxy.FieldUsername:
-		m.ResetUsername()
+	case redeemcode.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case proxy.FieldPassword:
-		m.ResetPassword()
+	case redeemcode.FieldGroupID:
+		m.ResetGroupID()
 		return nil
-	case proxy.FieldStatus:
-		m.ResetStatus()
+	case redeemcode.FieldValidityDays:
+		m.ResetValidityDays()
+		return nil
+	case redeemcode.FieldCreditValidityDays:
+		m.ResetCreditValidityDays()
+		return nil
+	case redeemcode.FieldBatchID:
+		m.ResetBatchID()
+		return nil
+	case redeemcode.FieldMaxRedemptions:
+		m.ResetMaxRedemptions()
+		return nil
+	case redeemcode.FieldRedeemedCount:
+		m.ResetRedeemedCount()
 		return nil
 	}
-	return fmt.Errorf("unknown Proxy field %s", name)
+	return fmt.Errorf("unknown RedeemCode field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProxyMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.accounts != nil {
-		edges = append(edges, proxy.EdgeAccounts)
+func (m *RedeemCodeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, redeemcode.EdgeUser)
+	}
+	if m.group != nil {
+		edges = append(edges, redeemcode.EdgeGroup)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ProxyMutation) AddedIDs(name string) []ent.Value {
+func (m *RedeemCodeMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case proxy.EdgeAccounts:
-		ids := make([]ent.Value, 0, len(m.accounts))
-		for id := range m.accounts {
-			ids = append(ids, id)
+	case redeemcode.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case redeemcode.EdgeGroup:
+		if id := m.group; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProxyMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedaccounts != nil {
-		edges = append(edges, proxy.EdgeAccounts)
-	}
+func (m *RedeemCodeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ProxyMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case proxy.EdgeAccounts:
-		ids := make([]ent.Value, 0, len(m.removedaccounts))
-		for id := range m.removedaccounts {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *RedeemCodeMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProxyMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedaccounts {
-		edges = append(edges, proxy.EdgeAccounts)
+func (m *RedeemCodeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, redeemcode.EdgeUser)
+	}
+	if m.clearedgroup {
+		edges = append(edges, redeemcode.EdgeGroup)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ProxyMutation) EdgeCleared(name string) bool {
+func (m *RedeemCodeMutation) EdgeCleared(name string) bool {
 	switch name {
-	case proxy.EdgeAccounts:
-		return m.clearedaccounts
+	case redeemcode.EdgeUser:
+		return m.cleareduser
+	case redeemcode.EdgeGroup:
+		return m.clearedgroup
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ProxyMutation) ClearEdge(name string) error {
+func (m *RedeemCodeMutation) ClearEdge(name string) error {
 	switch name {
+	case redeemcode.EdgeUser:
+		m.ClearUser()
+		return nil
+	case redeemcode.EdgeGroup:
+		m.ClearGroup()
+		return nil
 	}
-	return fmt.Errorf("unknown Proxy unique edge %s", name)
+	return fmt.Errorf("unknown RedeemCode unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ProxyMutation) ResetEdge(name string) error {
+func (m *RedeemCodeMutation) ResetEdge(name string) error {
 	switch name {
-	case proxy.EdgeAccounts:
-		m.ResetAccounts()
+	case redeemcode.EdgeUser:
+		m.ResetUser()
+		return nil
+	case redeemcode.EdgeGroup:
+		m.ResetGroup()
 		return nil
 	}
-	return fmt.Errorf("unknown Proxy edge %s", name)
+	return fmt.Errorf("unknown RedeemCode edge %s", name)
 }
 
-// RedeemCodeMutation represents an operation that mutates the RedeemCode nodes in the graph.
-type RedeemCodeMutation struct {
-	config
-	op               Op
-	typ              string
-	id               *int64
-	code             *string
-	_type            *string
-	value            *float64
-	addvalue         *float64
-	status           *string
-	used_at          *time.Time
-	notes            *string
-	created_at       *time.Time
-	validity_days    *int
-	addvalidity_days *int
-	clearedFields    map[string]struct{}
-	user             *int64
-	cleareduser      bool
-	group            *int64
-	clearedgroup     bool
-	done             bool
-	oldValue         func(context.Context) (*RedeemCode, error)
-	predicates       []predicate.RedeemCode
+// SettingMutation represents an operation that mutates the Setting nodes in the graph.
+type SettingMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	key           *string
+	value         *string
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Setting, error)
+	predicates    []predicate.Setting
 }
 
-var _ ent.Mutation = (*RedeemCodeMutation)(nil)
+var _ ent.Mutation = (*SettingMutation)(nil)
 
-// redeemcodeOption allows management of the mutation configuration using functional options.
-type redeemcodeOption func(*RedeemCodeMutation)
+// settingOption allows management of the mutation configuration using functional options.
+type settingOption func(*SettingMutation)
 
-// newRedeemCodeMutation creates new mutation for the RedeemCode entity.
-func newRedeemCodeMutation(c config, op Op, opts ...redeemcodeOption) *RedeemCodeMutation {
-	m := &RedeemCodeMutation{
+// newSettingMutation creates new mutation for the Setting entity.
+func newSettingMutation(c config, op Op, opts ...settingOption) *SettingMutation {
+	m := &SettingMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRedeemCode,
+		typ:           TypeSetting,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -8911,20 +13333,20 @@ func newRedeemCodeMutation(c config, op Op, opts ...redeemcodeOption) *RedeemCod
 	return m
 }
 
-// withRedeemCodeID sets the ID field of the mutation.
-func withRedeemCodeID(id int64) redeemcodeOption {
-	return func(m *RedeemCodeMutation) {
+// withSettingID sets the ID field of the mutation.
+func withSettingID(id int64) settingOption {
+	return func(m *SettingMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *RedeemCode
+			value *Setting
 		)
-		m.oldValue = func(ctx context.Context) (*RedeemCode, error) {
+		m.oldValue = func(ctx context.Context) (*Setting, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().RedeemCode.Get(ctx, id)
+					value, err = m.Client().Setting.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -8933,10 +13355,10 @@ func withRedeemCodeID(id int64) redeemcodeOption {
 	}
 }
 
-// withRedeemCode sets the old RedeemCode of the mutation.
-func withRedeemCode(node *RedeemCode) redeemcodeOption {
-	return func(m *RedeemCodeMutation) {
-		m.oldValue = func(context.Context) (*RedeemCode, error) {
+// withSetting sets the old Setting of the mutation.
+func withSetting(node *Setting) settingOption {
+	return func(m *SettingMutation) {
+		m.oldValue = func(context.Context) (*Setting, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -8945,7 +13367,7 @@ func withRedeemCode(node *RedeemCode) redeemcodeOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RedeemCodeMutation) Client() *Client {
+func (m SettingMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -8953,7 +13375,7 @@ func (m RedeemCodeMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RedeemCodeMutation) Tx() (*Tx, error) {
+func (m SettingMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -8964,7 +13386,7 @@ func (m RedeemCodeMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RedeemCodeMutation) ID() (id int64, exists bool) {
+func (m *SettingMutation) ID() (id int64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -8975,7 +13397,7 @@ func (m *RedeemCodeMutation) ID() (id int64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RedeemCodeMutation) IDs(ctx context.Context) ([]int64, error) {
+func (m *SettingMutation) IDs(ctx context.Context) ([]int64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -8984,1191 +13406,1004 @@ func (m *RedeemCodeMutation) IDs(ctx context.Context) ([]int64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().RedeemCode.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Setting.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCode sets the "code" field.
-func (m *RedeemCodeMutation) SetCode(s string) {
-	m.code = &s
-}
-
-// Code returns the value of the "code" field in the mutation.
-func (m *RedeemCodeMutation) Code() (r string, exists bool) {
-	v := m.code
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCode returns the old "code" field's value of the RedeemCode entity.
-// If the RedeemCode object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RedeemCodeMutation) OldCode(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCode is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCode requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCode: %w", err)
-	}
-	return oldValue.Code, nil
-}
-
-// ResetCode resets all changes to the "code" field.
-func (m *RedeemCodeMutation) ResetCode() {
-	m.code = nil
-}
-
-// SetType sets the "type" field.
-func (m *RedeemCodeMutation) SetType(s string) {
-	m._type = &s
-}
-
-// GetType returns the value of the "type" field in the mutation.
-func (m *RedeemCodeMutation) GetType() (r string, exists bool) {
-	v := m._type
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldType returns the old "type" field's value of the RedeemCode entity.
-// If the RedeemCode object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RedeemCodeMutation) OldType(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
-	}
-	return oldValue.Type, nil
-}
-
-// ResetType resets all changes to the "type" field.
-func (m *RedeemCodeMutation) ResetType() {
-	m._type = nil
-}
-
-// SetValue sets the "value" field.
-func (m *RedeemCodeMutation) SetValue(f float64) {
-	m.value = &f
-	m.addvalue = nil
-}
-
-// Value returns the value of the "value" field in the mutation.
-func (m *RedeemCodeMutation) Value() (r float64, exists bool) {
-	v := m.value
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldValue returns the old "value" field's value of the RedeemCode entity.
-// If the RedeemCode object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RedeemCodeMutation) OldValue(ctx context.Context) (v float64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
-	}
-	return oldValue.Value, nil
-}
-
-// AddValue adds f to the "value" field.
-func (m *RedeemCodeMutation) AddValue(f float64) {
-	if m.addvalue != nil {
-		*m.addvalue += f
-	} else {
-		m.addvalue = &f
-	}
-}
-
-// AddedValue returns the value that was added to the "value" field in this mutation.
-func (m *RedeemCodeMutation) AddedValue() (r float64, exists bool) {
-	v := m.addvalue
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetValue resets all changes to the "value" field.
-func (m *RedeemCodeMutation) ResetValue() {
-	m.value = nil
-	m.addvalue = nil
-}
-
-// SetStatus sets the "status" field.
-func (m *RedeemCodeMutation) SetStatus(s string) {
-	m.status = &s
-}
-
-// Status returns the value of the "status" field in the mutation.
-func (m *RedeemCodeMutation) Status() (r string, exists bool) {
-	v := m.status
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldStatus returns the old "status" field's value of the RedeemCode entity.
-// If the RedeemCode object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RedeemCodeMutation) OldStatus(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
-	}
-	return oldValue.Status, nil
-}
-
-// ResetStatus resets all changes to the "status" field.
-func (m *RedeemCodeMutation) ResetStatus() {
-	m.status = nil
-}
-
-// SetUsedBy sets the "used_by" field.
-func (m *RedeemCodeMutation) SetUsedBy(i int64) {
-	m.user = &i
+// SetKey sets the "key" field.
+func (m *SettingMutation) SetKey(s string) {
+	m.key = &s
 }
 
-// UsedBy returns the value of the "used_by" field in the mutation.
-func (m *RedeemCodeMutation) UsedBy() (r int64, exists bool) {
-	v := m.user
+// Key returns the value of the "key" field in the mutation.
+func (m *SettingMutation) Key() (r string, exists bool) {
+	v := m.key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsedBy returns the old "used_by" field's value of the RedeemCode entity.
-// If the RedeemCode object wasn't provided to the builder, the object is fetched from the database.
+// OldKey returns the old "key" field's value of the Setting entity.
+// If the Setting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RedeemCodeMutation) OldUsedBy(ctx context.Context) (v *int64, err error) {
+func (m *SettingMutation) OldKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsedBy requires an ID field in the mutation")
+		return v, errors.New("OldKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsedBy: %w", err)
-	}
-	return oldValue.UsedBy, nil
-}
-
-// ClearUsedBy clears the value of the "used_by" field.
-func (m *RedeemCodeMutation) ClearUsedBy() {
-	m.user = nil
-	m.clearedFields[redeemcode.FieldUsedBy] = struct{}{}
-}
-
-// UsedByCleared returns if the "used_by" field was cleared in this mutation.
-func (m *RedeemCodeMutation) UsedByCleared() bool {
-	_, ok := m.clearedFields[redeemcode.FieldUsedBy]
-	return ok
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
 }
 
-// ResetUsedBy resets all changes to the "used_by" field.
-func (m *RedeemCodeMutation) ResetUsedBy() {
-	m.user = nil
-	delete(m.clearedFields, redeemcode.FieldUsedBy)
+// ResetKey resets all changes to the "key" field.
+func (m *SettingMutation) ResetKey() {
+	m.key = nil
 }
 
-// SetUsedAt sets the "used_at" field.
-func (m *RedeemCodeMutation) SetUsedAt(t time.Time) {
-	m.used_at = &t
+// SetValue sets the "value" field.
+func (m *SettingMutation) SetValue(s string) {
+	m.value = &s
 }
 
-// UsedAt returns the value of the "used_at" field in the mutation.
-func (m *RedeemCodeMutation) UsedAt() (r time.Time, exists bool) {
-	v := m.used_at
+// Value returns the value of the "value" field in the mutation.
+func (m *SettingMutation) Value() (r string, exists bool) {
+	v := m.value
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsedAt returns the old "used_at" field's value of the RedeemCode entity.
-// If the RedeemCode object wasn't provided to the builder, the object is fetched from the database.
+// OldValue returns the old "value" field's value of the Setting entity.
+// If the Setting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RedeemCodeMutation) OldUsedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *SettingMutation) OldValue(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsedAt requires an ID field in the mutation")
+		return v, errors.New("OldValue requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
 	}
-	return oldValue.UsedAt, nil
-}
-
-// ClearUsedAt clears the value of the "used_at" field.
-func (m *RedeemCodeMutation) ClearUsedAt() {
-	m.used_at = nil
-	m.clearedFields[redeemcode.FieldUsedAt] = struct{}{}
-}
-
-// UsedAtCleared returns if the "used_at" field was cleared in this mutation.
-func (m *RedeemCodeMutation) UsedAtCleared() bool {
-	_, ok := m.clearedFields[redeemcode.FieldUsedAt]
-	return ok
+	return oldValue.Value, nil
 }
 
-// ResetUsedAt resets all changes to the "used_at" field.
-func (m *RedeemCodeMutation) ResetUsedAt() {
-	m.used_at = nil
-	delete(m.clearedFields, redeemcode.FieldUsedAt)
+// ResetValue resets all changes to the "value" field.
+func (m *SettingMutation) ResetValue() {
+	m.value = nil
 }
 
-// SetNotes sets the "notes" field.
-func (m *RedeemCodeMutation) SetNotes(s string) {
-	m.notes = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SettingMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Notes returns the value of the "notes" field in the mutation.
-func (m *RedeemCodeMutation) Notes() (r string, exists bool) {
-	v := m.notes
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SettingMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNotes returns the old "notes" field's value of the RedeemCode entity.
-// If the RedeemCode object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Setting entity.
+// If the Setting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RedeemCodeMutation) OldNotes(ctx context.Context) (v *string, err error) {
+func (m *SettingMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNotes is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNotes requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNotes: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Notes, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearNotes clears the value of the "notes" field.
-func (m *RedeemCodeMutation) ClearNotes() {
-	m.notes = nil
-	m.clearedFields[redeemcode.FieldNotes] = struct{}{}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SettingMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// NotesCleared returns if the "notes" field was cleared in this mutation.
-func (m *RedeemCodeMutation) NotesCleared() bool {
-	_, ok := m.clearedFields[redeemcode.FieldNotes]
-	return ok
+// Where appends a list predicates to the SettingMutation builder.
+func (m *SettingMutation) Where(ps ...predicate.Setting) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ResetNotes resets all changes to the "notes" field.
-func (m *RedeemCodeMutation) ResetNotes() {
-	m.notes = nil
-	delete(m.clearedFields, redeemcode.FieldNotes)
+// WhereP appends storage-level predicates to the SettingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SettingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Setting, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *RedeemCodeMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// Op returns the operation name.
+func (m *SettingMutation) Op() Op {
+	return m.op
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *RedeemCodeMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// SetOp allows setting the mutation operation.
+func (m *SettingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Setting).
+func (m *SettingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SettingMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.key != nil {
+		fields = append(fields, setting.FieldKey)
 	}
-	return *v, true
+	if m.value != nil {
+		fields = append(fields, setting.FieldValue)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, setting.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the RedeemCode entity.
-// If the RedeemCode object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RedeemCodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SettingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case setting.FieldKey:
+		return m.Key()
+	case setting.FieldValue:
+		return m.Value()
+	case setting.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case setting.FieldKey:
+		return m.OldKey(ctx)
+	case setting.FieldValue:
+		return m.OldValue(ctx)
+	case setting.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	return nil, fmt.Errorf("unknown Setting field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SettingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case setting.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case setting.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case setting.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return oldValue.CreatedAt, nil
+	return fmt.Errorf("unknown Setting field %s", name)
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *RedeemCodeMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SettingMutation) AddedFields() []string {
+	return nil
 }
 
-// SetGroupID sets the "group_id" field.
-func (m *RedeemCodeMutation) SetGroupID(i int64) {
-	m.group = &i
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SettingMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// GroupID returns the value of the "group_id" field in the mutation.
-func (m *RedeemCodeMutation) GroupID() (r int64, exists bool) {
-	v := m.group
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SettingMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown Setting numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SettingMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SettingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SettingMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Setting nullable field %s", name)
 }
 
-// OldGroupID returns the old "group_id" field's value of the RedeemCode entity.
-// If the RedeemCode object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RedeemCodeMutation) OldGroupID(ctx context.Context) (v *int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGroupID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGroupID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGroupID: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SettingMutation) ResetField(name string) error {
+	switch name {
+	case setting.FieldKey:
+		m.ResetKey()
+		return nil
+	case setting.FieldValue:
+		m.ResetValue()
+		return nil
+	case setting.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return oldValue.GroupID, nil
+	return fmt.Errorf("unknown Setting field %s", name)
 }
 
-// ClearGroupID clears the value of the "group_id" field.
-func (m *RedeemCodeMutation) ClearGroupID() {
-	m.group = nil
-	m.clearedFields[redeemcode.FieldGroupID] = struct{}{}
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SettingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// GroupIDCleared returns if the "group_id" field was cleared in this mutation.
-func (m *RedeemCodeMutation) GroupIDCleared() bool {
-	_, ok := m.clearedFields[redeemcode.FieldGroupID]
-	return ok
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SettingMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetGroupID resets all changes to the "group_id" field.
-func (m *RedeemCodeMutation) ResetGroupID() {
-	m.group = nil
-	delete(m.clearedFields, redeemcode.FieldGroupID)
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SettingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetValidityDays sets the "validity_days" field.
-func (m *RedeemCodeMutation) SetValidityDays(i int) {
-	m.validity_days = &i
-	m.addvalidity_days = nil
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SettingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ValidityDays returns the value of the "validity_days" field in the mutation.
-func (m *RedeemCodeMutation) ValidityDays() (r int, exists bool) {
-	v := m.validity_days
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SettingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldValidityDays returns the old "validity_days" field's value of the RedeemCode entity.
-// If the RedeemCode object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RedeemCodeMutation) OldValidityDays(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValidityDays is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValidityDays requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValidityDays: %w", err)
-	}
-	return oldValue.ValidityDays, nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SettingMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// AddValidityDays adds i to the "validity_days" field.
-func (m *RedeemCodeMutation) AddValidityDays(i int) {
-	if m.addvalidity_days != nil {
-		*m.addvalidity_days += i
-	} else {
-		m.addvalidity_days = &i
-	}
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SettingMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Setting unique edge %s", name)
 }
 
-// AddedValidityDays returns the value that was added to the "validity_days" field in this mutation.
-func (m *RedeemCodeMutation) AddedValidityDays() (r int, exists bool) {
-	v := m.addvalidity_days
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SettingMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Setting edge %s", name)
 }
 
-// ResetValidityDays resets all changes to the "validity_days" field.
-func (m *RedeemCodeMutation) ResetValidityDays() {
-	m.validity_days = nil
-	m.addvalidity_days = nil
+// UsageCleanupTaskMutation represents an operation that mutates the UsageCleanupTask nodes in the graph.
+type UsageCleanupTaskMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int64
+	created_at      *time.Time
+	updated_at      *time.Time
+	status          *string
+	filters         *json.RawMessage
+	appendfilters   json.RawMessage
+	created_by      *int64
+	addcreated_by   *int64
+	deleted_rows    *int64
+	adddeleted_rows *int64
+	error_message   *string
+	canceled_by     *int64
+	addcanceled_by  *int64
+	canceled_at     *time.Time
+	started_at      *time.Time
+	finished_at     *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*UsageCleanupTask, error)
+	predicates      []predicate.UsageCleanupTask
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *RedeemCodeMutation) SetUserID(id int64) {
-	m.user = &id
-}
+var _ ent.Mutation = (*UsageCleanupTaskMutation)(nil)
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *RedeemCodeMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[redeemcode.FieldUsedBy] = struct{}{}
-}
+// usagecleanuptaskOption allows management of the mutation configuration using functional options.
+type usagecleanuptaskOption func(*UsageCleanupTaskMutation)
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *RedeemCodeMutation) UserCleared() bool {
-	return m.UsedByCleared() || m.cleareduser
+// newUsageCleanupTaskMutation creates new mutation for the UsageCleanupTask entity.
+func newUsageCleanupTaskMutation(c config, op Op, opts ...usagecleanuptaskOption) *UsageCleanupTaskMutation {
+	m := &UsageCleanupTaskMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUsageCleanupTask,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *RedeemCodeMutation) UserID() (id int64, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// withUsageCleanupTaskID sets the ID field of the mutation.
+func withUsageCleanupTaskID(id int64) usagecleanuptaskOption {
+	return func(m *UsageCleanupTaskMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *UsageCleanupTask
+		)
+		m.oldValue = func(ctx context.Context) (*UsageCleanupTask, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().UsageCleanupTask.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *RedeemCodeMutation) UserIDs() (ids []int64) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// withUsageCleanupTask sets the old UsageCleanupTask of the mutation.
+func withUsageCleanupTask(node *UsageCleanupTask) usagecleanuptaskOption {
+	return func(m *UsageCleanupTaskMutation) {
+		m.oldValue = func(context.Context) (*UsageCleanupTask, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *RedeemCodeMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UsageCleanupTaskMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// ClearGroup clears the "group" edge to the Group entity.
-func (m *RedeemCodeMutation) ClearGroup() {
-	m.clearedgroup = true
-	m.clearedFields[redeemcode.FieldGroupID] = struct{}{}
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UsageCleanupTaskMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// GroupCleared reports if the "group" edge to the Group entity was cleared.
-func (m *RedeemCodeMutation) GroupCleared() bool {
-	return m.GroupIDCleared() || m.clearedgroup
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UsageCleanupTaskMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// GroupIDs returns the "group" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// GroupID instead. It exists only for internal usage by the builders.
-func (m *RedeemCodeMutation) GroupIDs() (ids []int64) {
-	if id := m.group; id != nil {
-		ids = append(ids, *id)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UsageCleanupTaskMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().UsageCleanupTask.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return
 }
 
-// ResetGroup resets all changes to the "group" edge.
-func (m *RedeemCodeMutation) ResetGroup() {
-	m.group = nil
-	m.clearedgroup = false
+// SetCreatedAt sets the "created_at" field.
+func (m *UsageCleanupTaskMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Where appends a list predicates to the RedeemCodeMutation builder.
-func (m *RedeemCodeMutation) Where(ps ...predicate.RedeemCode) {
-	m.predicates = append(m.predicates, ps...)
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UsageCleanupTaskMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// WhereP appends storage-level predicates to the RedeemCodeMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RedeemCodeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.RedeemCode, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// OldCreatedAt returns the old "created_at" field's value of the UsageCleanupTask entity.
+// If the UsageCleanupTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageCleanupTaskMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	m.Where(p...)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// Op returns the operation name.
-func (m *RedeemCodeMutation) Op() Op {
-	return m.op
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UsageCleanupTaskMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *RedeemCodeMutation) SetOp(op Op) {
-	m.op = op
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UsageCleanupTaskMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Type returns the node type of this mutation (RedeemCode).
-func (m *RedeemCodeMutation) Type() string {
-	return m.typ
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UsageCleanupTaskMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *RedeemCodeMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.code != nil {
-		fields = append(fields, redeemcode.FieldCode)
-	}
-	if m._type != nil {
-		fields = append(fields, redeemcode.FieldType)
-	}
-	if m.value != nil {
-		fields = append(fields, redeemcode.FieldValue)
-	}
-	if m.status != nil {
-		fields = append(fields, redeemcode.FieldStatus)
-	}
-	if m.user != nil {
-		fields = append(fields, redeemcode.FieldUsedBy)
-	}
-	if m.used_at != nil {
-		fields = append(fields, redeemcode.FieldUsedAt)
-	}
-	if m.notes != nil {
-		fields = append(fields, redeemcode.FieldNotes)
-	}
-	if m.created_at != nil {
-		fields = append(fields, redeemcode.FieldCreatedAt)
+// OldUpdatedAt returns the old "updated_at" field's value of the UsageCleanupTask entity.
+// If the UsageCleanupTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageCleanupTaskMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	if m.group != nil {
-		fields = append(fields, redeemcode.FieldGroupID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	if m.validity_days != nil {
-		fields = append(fields, redeemcode.FieldValidityDays)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return fields
+	return oldValue.UpdatedAt, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *RedeemCodeMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case redeemcode.FieldCode:
-		return m.Code()
-	case redeemcode.FieldType:
-		return m.GetType()
-	case redeemcode.FieldValue:
-		return m.Value()
-	case redeemcode.FieldStatus:
-		return m.Status()
-	case redeemcode.FieldUsedBy:
-		return m.UsedBy()
-	case redeemcode.FieldUsedAt:
-		return m.UsedAt()
-	case redeemcode.FieldNotes:
-		return m.Notes()
-	case redeemcode.FieldCreatedAt:
-		return m.CreatedAt()
-	case redeemcode.FieldGroupID:
-		return m.GroupID()
-	case redeemcode.FieldValidityDays:
-		return m.ValidityDays()
-	}
-	return nil, false
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UsageCleanupTaskMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *RedeemCodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case redeemcode.FieldCode:
-		return m.OldCode(ctx)
-	case redeemcode.FieldType:
-		return m.OldType(ctx)
-	case redeemcode.FieldValue:
-		return m.OldValue(ctx)
-	case redeemcode.FieldStatus:
-		return m.OldStatus(ctx)
-	case redeemcode.FieldUsedBy:
-		return m.OldUsedBy(ctx)
-	case redeemcode.FieldUsedAt:
-		return m.OldUsedAt(ctx)
-	case redeemcode.FieldNotes:
-		return m.OldNotes(ctx)
-	case redeemcode.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case redeemcode.FieldGroupID:
-		return m.OldGroupID(ctx)
-	case redeemcode.FieldValidityDays:
-		return m.OldValidityDays(ctx)
+// SetStatus sets the "status" field.
+func (m *UsageCleanupTaskMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *UsageCleanupTaskMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown RedeemCode field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *RedeemCodeMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case redeemcode.FieldCode:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCode(v)
-		return nil
-	case redeemcode.FieldType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case redeemcode.FieldValue:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetValue(v)
-		return nil
-	case redeemcode.FieldStatus:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case redeemcode.FieldUsedBy:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUsedBy(v)
-		return nil
-	case redeemcode.FieldUsedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUsedAt(v)
-		return nil
-	case redeemcode.FieldNotes:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNotes(v)
-		return nil
-	case redeemcode.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case redeemcode.FieldGroupID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetGroupID(v)
-		return nil
-	case redeemcode.FieldValidityDays:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetValidityDays(v)
-		return nil
+// OldStatus returns the old "status" field's value of the UsageCleanupTask entity.
+// If the UsageCleanupTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageCleanupTaskMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown RedeemCode field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *RedeemCodeMutation) AddedFields() []string {
-	var fields []string
-	if m.addvalue != nil {
-		fields = append(fields, redeemcode.FieldValue)
-	}
-	if m.addvalidity_days != nil {
-		fields = append(fields, redeemcode.FieldValidityDays)
+// ResetStatus resets all changes to the "status" field.
+func (m *UsageCleanupTaskMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetFilters sets the "filters" field.
+func (m *UsageCleanupTaskMutation) SetFilters(jm json.RawMessage) {
+	m.filters = &jm
+	m.appendfilters = nil
+}
+
+// Filters returns the value of the "filters" field in the mutation.
+func (m *UsageCleanupTaskMutation) Filters() (r json.RawMessage, exists bool) {
+	v := m.filters
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *RedeemCodeMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case redeemcode.FieldValue:
-		return m.AddedValue()
-	case redeemcode.FieldValidityDays:
-		return m.AddedValidityDays()
+// OldFilters returns the old "filters" field's value of the UsageCleanupTask entity.
+// If the UsageCleanupTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageCleanupTaskMutation) OldFilters(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilters is only allowed on UpdateOne operations")
 	}
-	return nil, false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilters requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilters: %w", err)
+	}
+	return oldValue.Filters, nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *RedeemCodeMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case redeemcode.FieldValue:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddValue(v)
-		return nil
-	case redeemcode.FieldValidityDays:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddValidityDays(v)
-		return nil
+// AppendFilters adds jm to the "filters" field.
+func (m *UsageCleanupTaskMutation) AppendFilters(jm json.RawMessage) {
+	m.appendfilters = append(m.appendfilters, jm...)
+}
+
+// AppendedFilters returns the list of values that were appended to the "filters" field in this mutation.
+func (m *UsageCleanupTaskMutation) AppendedFilters() (json.RawMessage, bool) {
+	if len(m.appendfilters) == 0 {
+		return nil, false
 	}
-	return fmt.Errorf("unknown RedeemCode numeric field %s", name)
+	return m.appendfilters, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *RedeemCodeMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(redeemcode.FieldUsedBy) {
-		fields = append(fields, redeemcode.FieldUsedBy)
+// ResetFilters resets all changes to the "filters" field.
+func (m *UsageCleanupTaskMutation) ResetFilters() {
+	m.filters = nil
+	m.appendfilters = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *UsageCleanupTaskMutation) SetCreatedBy(i int64) {
+	m.created_by = &i
+	m.addcreated_by = nil
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *UsageCleanupTaskMutation) CreatedBy() (r int64, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(redeemcode.FieldUsedAt) {
-		fields = append(fields, redeemcode.FieldUsedAt)
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the UsageCleanupTask entity.
+// If the UsageCleanupTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageCleanupTaskMutation) OldCreatedBy(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(redeemcode.FieldNotes) {
-		fields = append(fields, redeemcode.FieldNotes)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
 	}
-	if m.FieldCleared(redeemcode.FieldGroupID) {
-		fields = append(fields, redeemcode.FieldGroupID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
 	}
-	return fields
+	return oldValue.CreatedBy, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *RedeemCodeMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// AddCreatedBy adds i to the "created_by" field.
+func (m *UsageCleanupTaskMutation) AddCreatedBy(i int64) {
+	if m.addcreated_by != nil {
+		*m.addcreated_by += i
+	} else {
+		m.addcreated_by = &i
+	}
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *RedeemCodeMutation) ClearField(name string) error {
-	switch name {
-	case redeemcode.FieldUsedBy:
-		m.ClearUsedBy()
-		return nil
-	case redeemcode.FieldUsedAt:
-		m.ClearUsedAt()
-		return nil
-	case redeemcode.FieldNotes:
-		m.ClearNotes()
-		return nil
-	case redeemcode.FieldGroupID:
-		m.ClearGroupID()
-		return nil
+// AddedCreatedBy returns the value that was added to the "created_by" field in this mutation.
+func (m *UsageCleanupTaskMutation) AddedCreatedBy() (r int64, exists bool) {
+	v := m.addcreated_by
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown RedeemCode nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *RedeemCodeMutation) ResetField(name string) error {
-	switch name {
-	case redeemcode.FieldCode:
-		m.ResetCode()
-		return nil
-	case redeemcode.FieldType:
-		m.ResetType()
-		return nil
-	case redeemcode.FieldValue:
-		m.ResetValue()
-		return nil
-	case redeemcode.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case redeemcode.FieldUsedBy:
-		m.ResetUsedBy()
-		return nil
-	case redeemcode.FieldUsedAt:
-		m.ResetUsedAt()
-		return nil
-	case redeemcode.FieldNotes:
-		m.ResetNotes()
-		return nil
-	case redeemcode.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case redeemcode.FieldGroupID:
-		m.ResetGroupID()
-		return nil
-	case redeemcode.FieldValidityDays:
-		m.ResetValidityDays()
-		return nil
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *UsageCleanupTaskMutation) ResetCreatedBy() {
+	m.created_by = nil
+	m.addcreated_by = nil
+}
+
+// SetDeletedRows sets the "deleted_rows" field.
+func (m *UsageCleanupTaskMutation) SetDeletedRows(i int64) {
+	m.deleted_rows = &i
+	m.adddeleted_rows = nil
+}
+
+// DeletedRows returns the value of the "deleted_rows" field in the mutation.
+func (m *UsageCleanupTaskMutation) DeletedRows() (r int64, exists bool) {
+	v := m.deleted_rows
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown RedeemCode field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RedeemCodeMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.user != nil {
-		edges = append(edges, redeemcode.EdgeUser)
+// OldDeletedRows returns the old "deleted_rows" field's value of the UsageCleanupTask entity.
+// If the UsageCleanupTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageCleanupTaskMutation) OldDeletedRows(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedRows is only allowed on UpdateOne operations")
 	}
-	if m.group != nil {
-		edges = append(edges, redeemcode.EdgeGroup)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedRows requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedRows: %w", err)
+	}
+	return oldValue.DeletedRows, nil
+}
+
+// AddDeletedRows adds i to the "deleted_rows" field.
+func (m *UsageCleanupTaskMutation) AddDeletedRows(i int64) {
+	if m.adddeleted_rows != nil {
+		*m.adddeleted_rows += i
+	} else {
+		m.adddeleted_rows = &i
 	}
-	return edges
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *RedeemCodeMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case redeemcode.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case redeemcode.EdgeGroup:
-		if id := m.group; id != nil {
-			return []ent.Value{*id}
-		}
+// AddedDeletedRows returns the value that was added to the "deleted_rows" field in this mutation.
+func (m *UsageCleanupTaskMutation) AddedDeletedRows() (r int64, exists bool) {
+	v := m.adddeleted_rows
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RedeemCodeMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	return edges
+// ResetDeletedRows resets all changes to the "deleted_rows" field.
+func (m *UsageCleanupTaskMutation) ResetDeletedRows() {
+	m.deleted_rows = nil
+	m.adddeleted_rows = nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *RedeemCodeMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// SetErrorMessage sets the "error_message" field.
+func (m *UsageCleanupTaskMutation) SetErrorMessage(s string) {
+	m.error_message = &s
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RedeemCodeMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareduser {
-		edges = append(edges, redeemcode.EdgeUser)
-	}
-	if m.clearedgroup {
-		edges = append(edges, redeemcode.EdgeGroup)
+// ErrorMessage returns the value of the "error_message" field in the mutation.
+func (m *UsageCleanupTaskMutation) ErrorMessage() (r string, exists bool) {
+	v := m.error_message
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *RedeemCodeMutation) EdgeCleared(name string) bool {
-	switch name {
-	case redeemcode.EdgeUser:
-		return m.cleareduser
-	case redeemcode.EdgeGroup:
-		return m.clearedgroup
+// OldErrorMessage returns the old "error_message" field's value of the UsageCleanupTask entity.
+// If the UsageCleanupTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageCleanupTaskMutation) OldErrorMessage(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
 	}
-	return false
-}
-
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *RedeemCodeMutation) ClearEdge(name string) error {
-	switch name {
-	case redeemcode.EdgeUser:
-		m.ClearUser()
-		return nil
-	case redeemcode.EdgeGroup:
-		m.ClearGroup()
-		return nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
 	}
-	return fmt.Errorf("unknown RedeemCode unique edge %s", name)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+	}
+	return oldValue.ErrorMessage, nil
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *RedeemCodeMutation) ResetEdge(name string) error {
-	switch name {
-	case redeemcode.EdgeUser:
-		m.ResetUser()
-		return nil
-	case redeemcode.EdgeGroup:
-		m.ResetGroup()
-		return nil
-	}
-	return fmt.Errorf("unknown RedeemCode edge %s", name)
+// ClearErrorMessage clears the value of the "error_message" field.
+func (m *UsageCleanupTaskMutation) ClearErrorMessage() {
+	m.error_message = nil
+	m.clearedFields[usagecleanuptask.FieldErrorMessage] = struct{}{}
 }
 
-// SettingMutation represents an operation that mutates the Setting nodes in the graph.
-type SettingMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *int64
-	key           *string
-	value         *string
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Setting, error)
-	predicates    []predicate.Setting
+// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
+func (m *UsageCleanupTaskMutation) ErrorMessageCleared() bool {
+	_, ok := m.clearedFields[usagecleanuptask.FieldErrorMessage]
+	return ok
 }
 
-var _ ent.Mutation = (*SettingMutation)(nil)
+// ResetErrorMessage resets all changes to the "error_message" field.
+func (m *UsageCleanupTaskMutation) ResetErrorMessage() {
+	m.error_message = nil
+	delete(m.clearedFields, usagecleanuptask.FieldErrorMessage)
+}
 
-// settingOption allows management of the mutation configuration using functional options.
-type settingOption func(*SettingMutation)
+// SetCanceledBy sets the "canceled_by" field.
+func (m *UsageCleanupTaskMutation) SetCanceledBy(i int64) {
+	m.canceled_by = &i
+	m.addcanceled_by = nil
+}
 
-// newSettingMutation creates new mutation for the Setting entity.
-func newSettingMutation(c config, op Op, opts ...settingOption) *SettingMutation {
-	m := &SettingMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeSetting,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// CanceledBy returns the value of the "canceled_by" field in the mutation.
+func (m *UsageCleanupTaskMutation) CanceledBy() (r int64, exists bool) {
+	v := m.canceled_by
+	if v == nil {
+		return
 	}
-	return m
+	return *v, true
 }
 
-// withSettingID sets the ID field of the mutation.
-func withSettingID(id int64) settingOption {
-	return func(m *SettingMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Setting
-		)
-		m.oldValue = func(ctx context.Context) (*Setting, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Setting.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// OldCanceledBy returns the old "canceled_by" field's value of the UsageCleanupTask entity.
+// If the UsageCleanupTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageCleanupTaskMutation) OldCanceledBy(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCanceledBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCanceledBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCanceledBy: %w", err)
 	}
+	return oldValue.CanceledBy, nil
 }
 
-// withSetting sets the old Setting of the mutation.
-func withSetting(node *Setting) settingOption {
-	return func(m *SettingMutation) {
-		m.oldValue = func(context.Context) (*Setting, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// AddCanceledBy adds i to the "canceled_by" field.
+func (m *UsageCleanupTaskMutation) AddCanceledBy(i int64) {
+	if m.addcanceled_by != nil {
+		*m.addcanceled_by += i
+	} else {
+		m.addcanceled_by = &i
 	}
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SettingMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// AddedCanceledBy returns the value that was added to the "canceled_by" field in this mutation.
+func (m *UsageCleanupTaskMutation) AddedCanceledBy() (r int64, exists bool) {
+	v := m.addcanceled_by
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m SettingMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ClearCanceledBy clears the value of the "canceled_by" field.
+func (m *UsageCleanupTaskMutation) ClearCanceledBy() {
+	m.canceled_by = nil
+	m.addcanceled_by = nil
+	m.clearedFields[usagecleanuptask.FieldCanceledBy] = struct{}{}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *SettingMutation) ID() (id int64, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// CanceledByCleared returns if the "canceled_by" field was cleared in this mutation.
+func (m *UsageCleanupTaskMutation) CanceledByCleared() bool {
+	_, ok := m.clearedFields[usagecleanuptask.FieldCanceledBy]
+	return ok
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *SettingMutation) IDs(ctx context.Context) ([]int64, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int64{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Setting.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetCanceledBy resets all changes to the "canceled_by" field.
+func (m *UsageCleanupTaskMutation) ResetCanceledBy() {
+	m.canceled_by = nil
+	m.addcanceled_by = nil
+	delete(m.clearedFields, usagecleanuptask.FieldCanceledBy)
 }
 
-// SetKey sets the "key" field.
-func (m *SettingMutation) SetKey(s string) {
-	m.key = &s
+// SetCanceledAt sets the "canceled_at" field.
+func (m *UsageCleanupTaskMutation) SetCanceledAt(t time.Time) {
+	m.canceled_at = &t
 }
 
-// Key returns the value of the "key" field in the mutation.
-func (m *SettingMutation) Key() (r string, exists bool) {
-	v := m.key
+// CanceledAt returns the value of the "canceled_at" field in the mutation.
+func (m *UsageCleanupTaskMutation) CanceledAt() (r time.Time, exists bool) {
+	v := m.canceled_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKey returns the old "key" field's value of the Setting entity.
-// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// OldCanceledAt returns the old "canceled_at" field's value of the UsageCleanupTask entity.
+// If the UsageCleanupTask object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingMutation) OldKey(ctx context.Context) (v string, err error) {
+func (m *UsageCleanupTaskMutation) OldCanceledAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldCanceledAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKey requires an ID field in the mutation")
+		return v, errors.New("OldCanceledAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldCanceledAt: %w", err)
 	}
-	return oldValue.Key, nil
+	return oldValue.CanceledAt, nil
 }
 
-// ResetKey resets all changes to the "key" field.
-func (m *SettingMutation) ResetKey() {
-	m.key = nil
+// ClearCanceledAt clears the value of the "canceled_at" field.
+func (m *UsageCleanupTaskMutation) ClearCanceledAt() {
+	m.canceled_at = nil
+	m.clearedFields[usagecleanuptask.FieldCanceledAt] = struct{}{}
 }
 
-// SetValue sets the "value" field.
-func (m *SettingMutation) SetValue(s string) {
-	m.value = &s
+// CanceledAtCleared returns if the "canceled_at" field was cleared in this mutation.
+func (m *UsageCleanupTaskMutation) CanceledAtCleared() bool {
+	_, ok := m.clearedFields[usagecleanuptask.FieldCanceledAt]
+	return ok
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *SettingMutation) Value() (r string, exists bool) {
-	v := m.value
+// ResetCanceledAt resets all changes to the "canceled_at" field.
+func (m *UsageCleanupTaskMutation) ResetCanceledAt() {
+	m.canceled_at = nil
+	delete(m.clearedFields, usagecleanuptask.FieldCanceledAt)
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *UsageCleanupTaskMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *UsageCleanupTaskMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the Setting entity.
-// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// OldStartedAt returns the old "started_at" field's value of the UsageCleanupTask entity.
+// If the UsageCleanupTask object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingMutation) OldValue(ctx context.Context) (v string, err error) {
+func (m *UsageCleanupTaskMutation) OldStartedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.StartedAt, nil
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *SettingMutation) ResetValue() {
-	m.value = nil
+// ClearStartedAt clears the value of the "started_at" field.
+func (m *UsageCleanupTaskMutation) ClearStartedAt() {
+	m.started_at = nil
+	m.clearedFields[usagecleanuptask.FieldStartedAt] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *SettingMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
+func (m *UsageCleanupTaskMutation) StartedAtCleared() bool {
+	_, ok := m.clearedFields[usagecleanuptask.FieldStartedAt]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *SettingMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *UsageCleanupTaskMutation) ResetStartedAt() {
+	m.started_at = nil
+	delete(m.clearedFields, usagecleanuptask.FieldStartedAt)
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *UsageCleanupTaskMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *UsageCleanupTaskMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Setting entity.
-// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// OldFinishedAt returns the old "finished_at" field's value of the UsageCleanupTask entity.
+// If the UsageCleanupTask object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UsageCleanupTaskMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.FinishedAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *SettingMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *UsageCleanupTaskMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[usagecleanuptask.FieldFinishedAt] = struct{}{}
 }
 
-// Where appends a list predicates to the SettingMutation builder.
-func (m *SettingMutation) Where(ps ...predicate.Setting) {
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *UsageCleanupTaskMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[usagecleanuptask.FieldFinishedAt]
+	return ok
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *UsageCleanupTaskMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, usagecleanuptask.FieldFinishedAt)
+}
+
+// Where appends a list predicates to the UsageCleanupTaskMutation builder.
+func (m *UsageCleanupTaskMutation) Where(ps ...predicate.UsageCleanupTask) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SettingMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UsageCleanupTaskMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SettingMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Setting, len(ps))
+func (m *UsageCleanupTaskMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UsageCleanupTask, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -10176,33 +14411,57 @@ func (m *SettingMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SettingMutation) Op() Op {
+func (m *UsageCleanupTaskMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SettingMutation) SetOp(op Op) {
+func (m *UsageCleanupTaskMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Setting).
-func (m *SettingMutation) Type() string {
+// Type returns the node type of this mutation (UsageCleanupTask).
+func (m *UsageCleanupTaskMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SettingMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.key != nil {
-		fields = append(fields, setting.FieldKey)
-	}
-	if m.value != nil {
-		fields = append(fields, setting.FieldValue)
+func (m *UsageCleanupTaskMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.created_at != nil {
+		fields = append(fields, usagecleanuptask.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, setting.FieldUpdatedAt)
+		fields = append(fields, usagecleanuptask.FieldUpdatedAt)
+	}
+	if m.status != nil {
+		fields = append(fields, usagecleanuptask.FieldStatus)
+	}
+	if m.filters != nil {
+		fields = append(fields, usagecleanuptask.FieldFilters)
+	}
+	if m.created_by != nil {
+		fields = append(fields, usagecleanuptask.FieldCreatedBy)
+	}
+	if m.deleted_rows != nil {
+		fields = append(fields, usagecleanuptask.FieldDeletedRows)
+	}
+	if m.error_message != nil {
+		fields = append(fields, usagecleanuptask.FieldErrorMessage)
+	}
+	if m.canceled_by != nil {
+		fields = append(fields, usagecleanuptask.FieldCanceledBy)
+	}
+	if m.canceled_at != nil {
+		fields = append(fields, usagecleanuptask.FieldCanceledAt)
+	}
+	if m.started_at != nil {
+		fields = append(fields, usagecleanuptask.FieldStartedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, usagecleanuptask.FieldFinishedAt)
 	}
 	return fields
 }
@@ -10210,14 +14469,30 @@ func (m *SettingMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SettingMutation) Field(name string) (ent.Value, bool) {
+func (m *UsageCleanupTaskMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case setting.FieldKey:
-		return m.Key()
-	case setting.FieldValue:
-		return m.Value()
-	case setting.FieldUpdatedAt:
+	case usagecleanuptask.FieldCreatedAt:
+		return m.CreatedAt()
+	case usagecleanuptask.FieldUpdatedAt:
 		return m.UpdatedAt()
+	case usagecleanuptask.FieldStatus:
+		return m.Status()
+	case usagecleanuptask.FieldFilters:
+		return m.Filters()
+	case usagecleanuptask.FieldCreatedBy:
+		return m.CreatedBy()
+	case usagecleanuptask.FieldDeletedRows:
+		return m.DeletedRows()
+	case usagecleanuptask.FieldErrorMessage:
+		return m.ErrorMessage()
+	case usagecleanuptask.FieldCanceledBy:
+		return m.CanceledBy()
+	case usagecleanuptask.FieldCanceledAt:
+		return m.CanceledAt()
+	case usagecleanuptask.FieldStartedAt:
+		return m.StartedAt()
+	case usagecleanuptask.FieldFinishedAt:
+		return m.FinishedAt()
 	}
 	return nil, false
 }
@@ -10225,192 +14500,425 @@ func (m *SettingMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UsageCleanupTaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case setting.FieldKey:
-		return m.OldKey(ctx)
-	case setting.FieldValue:
-		return m.OldValue(ctx)
-	case setting.FieldUpdatedAt:
+	case usagecleanuptask.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case usagecleanuptask.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
+	case usagecleanuptask.FieldStatus:
+		return m.OldStatus(ctx)
+	case usagecleanuptask.FieldFilters:
+		return m.OldFilters(ctx)
+	case usagecleanuptask.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case usagecleanuptask.FieldDeletedRows:
+		return m.OldDeletedRows(ctx)
+	case usagecleanuptask.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case usagecleanuptask.FieldCanceledBy:
+		return m.OldCanceledBy(ctx)
+	case usagecleanuptask.FieldCanceledAt:
+		return m.OldCanceledAt(ctx)
+	case usagecleanuptask.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case usagecleanuptask.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Setting field %s", name)
+	return nil, fmt.Errorf("unknown UsageCleanupTask field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SettingMutation) SetField(name string, value ent.Value) error {
+func (m *UsageCleanupTaskMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case setting.FieldKey:
+	case usagecleanuptask.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case usagecleanuptask.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case usagecleanuptask.FieldStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKey(v)
+		m.SetStatus(v)
 		return nil
-	case setting.FieldValue:
+	case usagecleanuptask.FieldFilters:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilters(v)
+		return nil
+	case usagecleanuptask.FieldCreatedBy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case usagecleanuptask.FieldDeletedRows:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedRows(v)
+		return nil
+	case usagecleanuptask.FieldErrorMessage:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetErrorMessage(v)
 		return nil
-	case setting.FieldUpdatedAt:
+	case usagecleanuptask.FieldCanceledBy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCanceledBy(v)
+		return nil
+	case usagecleanuptask.FieldCanceledAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCanceledAt(v)
+		return nil
+	case usagecleanuptask.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case usagecleanuptask.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Setting field %s", name)
+	return fmt.Errorf("unknown UsageCleanupTask field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SettingMutation) AddedFields() []string {
-	return nil
+func (m *UsageCleanupTaskMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreated_by != nil {
+		fields = append(fields, usagecleanuptask.FieldCreatedBy)
+	}
+	if m.adddeleted_rows != nil {
+		fields = append(fields, usagecleanuptask.FieldDeletedRows)
+	}
+	if m.addcanceled_by != nil {
+		fields = append(fields, usagecleanuptask.FieldCanceledBy)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SettingMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UsageCleanupTaskMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case usagecleanuptask.FieldCreatedBy:
+		return m.AddedCreatedBy()
+	case usagecleanuptask.FieldDeletedRows:
+		return m.AddedDeletedRows()
+	case usagecleanuptask.FieldCanceledBy:
+		return m.AddedCanceledBy()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SettingMutation) AddField(name string, value ent.Value) error {
+func (m *UsageCleanupTaskMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case usagecleanuptask.FieldCreatedBy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedBy(v)
+		return nil
+	case usagecleanuptask.FieldDeletedRows:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeletedRows(v)
+		return nil
+	case usagecleanuptask.FieldCanceledBy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCanceledBy(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Setting numeric field %s", name)
+	return fmt.Errorf("unknown UsageCleanupTask numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SettingMutation) ClearedFields() []string {
-	return nil
+func (m *UsageCleanupTaskMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(usagecleanuptask.FieldErrorMessage) {
+		fields = append(fields, usagecleanuptask.FieldErrorMessage)
+	}
+	if m.FieldCleared(usagecleanuptask.FieldCanceledBy) {
+		fields = append(fields, usagecleanuptask.FieldCanceledBy)
+	}
+	if m.FieldCleared(usagecleanuptask.FieldCanceledAt) {
+		fields = append(fields, usagecleanuptask.FieldCanceledAt)
+	}
+	if m.FieldCleared(usagecleanuptask.FieldStartedAt) {
+		fields = append(fields, usagecleanuptask.FieldStartedAt)
+	}
+	if m.FieldCleared(usagecleanuptask.FieldFinishedAt) {
+		fields = append(fields, usagecleanuptask.FieldFinishedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SettingMutation) FieldCleared(name string) bool {
+func (m *UsageCleanupTaskMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SettingMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Setting nullable field %s", name)
+func (m *UsageCleanupTaskMutation) ClearField(name string) error {
+	switch name {
+	case usagecleanuptask.FieldErrorMessage:
+		m.ClearErrorMessage()
+		return nil
+	case usagecleanuptask.FieldCanceledBy:
+		m.ClearCanceledBy()
+		return nil
+	case usagecleanuptask.FieldCanceledAt:
+		m.ClearCanceledAt()
+		return nil
+	case usagecleanuptask.FieldStartedAt:
+		m.ClearStartedAt()
+		return nil
+	case usagecleanuptask.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown UsageCleanupTask nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SettingMutation) ResetField(name string) error {
+func (m *UsageCleanupTaskMutation) ResetField(name string) error {
 	switch name {
-	case setting.FieldKey:
-		m.ResetKey()
-		return nil
-	case setting.FieldValue:
-		m.ResetValue()
+	case usagecleanuptask.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case setting.FieldUpdatedAt:
+	case usagecleanuptask.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
+	case usagecleanuptask.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case usagecleanuptask.FieldFilters:
+		m.ResetFilters()
+		return nil
+	case usagecleanuptask.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case usagecleanuptask.FieldDeletedRows:
+		m.ResetDeletedRows()
+		return nil
+	case usagecleanuptask.FieldErrorMessage:
+		m.ResetErrorMessage()
+		return nil
+	case usagecleanuptask.FieldCanceledBy:
+		m.ResetCanceledBy()
+		return nil
+	case usagecleanuptask.FieldCanceledAt:
+		m.ResetCanceledAt()
+		return nil
+	case usagecleanuptask.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case usagecleanuptask.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown Setting field %s", name)
+	return fmt.Errorf("unknown UsageCleanupTask field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SettingMutation) AddedEdges() []string {
+func (m *UsageCleanupTaskMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SettingMutation) AddedIDs(name string) []ent.Value {
+func (m *UsageCleanupTaskMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SettingMutation) RemovedEdges() []string {
+func (m *UsageCleanupTaskMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SettingMutation) RemovedIDs(name string) []ent.Value {
+func (m *UsageCleanupTaskMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SettingMutation) ClearedEdges() []string {
+func (m *UsageCleanupTaskMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SettingMutation) EdgeCleared(name string) bool {
+func (m *UsageCleanupTaskMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SettingMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Setting unique edge %s", name)
+func (m *UsageCleanupTaskMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown UsageCleanupTask unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SettingMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Setting edge %s", name)
+func (m *UsageCleanupTaskMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown UsageCleanupTask edge %s", name)
 }
 
-// UsageCleanupTaskMutation represents an operation that mutates the UsageCleanupTask nodes in the graph.
-type UsageCleanupTaskMutation struct {
+// UsageLogMutation represents an operation that mutates the UsageLog nodes in the graph.
+type UsageLogMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int64
-	created_at      *time.Time
-	updated_at      *time.Time
-	status          *string
-	filters         *json.RawMessage
-	appendfilters   json.RawMessage
-	created_by      *int64
-	addcreated_by   *int64
-	deleted_rows    *int64
-	adddeleted_rows *int64
-	error_message   *string
-	canceled_by     *int64
-	addcanceled_by  *int64
-	canceled_at     *time.Time
-	started_at      *time.Time
-	finished_at     *time.Time
-	clearedFields   map[string]struct{}
-	done            bool
-	oldValue        func(context.Context) (*UsageCleanupTask, error)
-	predicates      []predicate.UsageCleanupTask
+	op                           Op
+	typ                          string
+	id                           *int64
+	request_id                   *string
+	model                        *string
+	original_group_id            *int64
+	addoriginal_group_id         *int64
+	fallback_hop                 *int
+	addfallback_hop              *int
+	organization_id              *int64
+	addorganization_id           *int64
+	billing_user_id              *int64
+	addbilling_user_id           *int64
+	input_tokens                 *int
+	addinput_tokens              *int
+	output_tokens                *int
+	addoutput_tokens             *int
+	cache_creation_tokens        *int
+	addcache_creation_tokens     *int
+	cache_read_tokens            *int
+	addcache_read_tokens         *int
+	cache_creation_5m_tokens     *int
+	addcache_creation_5m_tokens  *int
+	cache_creation_1h_tokens     *int
+	addcache_creation_1h_tokens  *int
+	input_cost                   *float64
+	addinput_cost                *float64
+	output_cost                  *float64
+	addoutput_cost               *float64
+	cache_creation_cost          *float64
+	addcache_creation_cost       *float64
+	cache_read_cost              *float64
+	addcache_read_cost           *float64
+	total_cost                   *float64
+	addtotal_cost                *float64
+	actual_cost                  *float64
+	addactual_cost               *float64
+	rate_multiplier              *float64
+	addrate_multiplier           *float64
+	pricing_version              *string
+	input_unit_price             *float64
+	addinput_unit_price          *float64
+	output_unit_price            *float64
+	addoutput_unit_price         *float64
+	cache_creation_unit_price    *float64
+	addcache_creation_unit_price *float64
+	cache_read_unit_price        *float64
+	addcache_read_unit_price     *float64
+	image_unit_price             *float64
+	addimage_unit_price          *float64
+	volume_tier_min_spend        *float64
+	addvolume_tier_min_spend     *float64
+	volume_tier_multiplier       *float64
+	addvolume_tier_multiplier    *float64
+	subscription_cost            *float64
+	addsubscription_cost         *float64
+	overage_cost                 *float64
+	addoverage_cost              *float64
+	account_rate_multiplier      *float64
+	addaccount_rate_multiplier   *float64
+	billing_type                 *int8
+	addbilling_type              *int8
+	stream                       *bool
+	duration_ms                  *int
+	addduration_ms               *int
+	first_token_ms               *int
+	addfirst_token_ms            *int
+	user_agent                   *string
+	ip_address                   *string
+	image_count                  *int
+	addimage_count               *int
+	image_size                   *string
+	created_at                   *time.Time
+	clearedFields                map[string]struct{}
+	user                         *int64
+	cleareduser                  bool
+	api_key                      *int64
+	clearedapi_key               bool
+	account                      *int64
+	clearedaccount               bool
+	group                        *int64
+	clearedgroup                 bool
+	subscription                 *int64
+	clearedsubscription          bool
+	done                         bool
+	oldValue                     func(context.Context) (*UsageLog, error)
+	predicates                   []predicate.UsageLog
 }
 
-var _ ent.Mutation = (*UsageCleanupTaskMutation)(nil)
+var _ ent.Mutation = (*UsageLogMutation)(nil)
 
-// usagecleanuptaskOption allows management of the mutation configuration using functional options.
-type usagecleanuptaskOption func(*UsageCleanupTaskMutation)
+// usagelogOption allows management of the mutation configuration using functional options.
+type usagelogOption func(*UsageLogMutation)
 
-// newUsageCleanupTaskMutation creates new mutation for the UsageCleanupTask entity.
-func newUsageCleanupTaskMutation(c config, op Op, opts ...usagecleanuptaskOption) *UsageCleanupTaskMutation {
-	m := &UsageCleanupTaskMutation{
+// newUsageLogMutation creates new mutation for the UsageLog entity.
+func newUsageLogMutation(c config, op Op, opts ...usagelogOption) *UsageLogMutation {
+	m := &UsageLogMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUsageCleanupTask,
+		typ:           TypeUsageLog,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -10419,20 +14927,20 @@ func newUsageCleanupTaskMutation(c config, op Op, opts ...usagecleanuptaskOption
 	return m
 }
 
-// withUsageCleanupTaskID sets the ID field of the mutation.
-func withUsageCleanupTaskID(id int64) usagecleanuptaskOption {
-	return func(m *UsageCleanupTaskMutation) {
+// withUsageLogID sets the ID field of the mutation.
+func withUsageLogID(id int64) usagelogOption {
+	return func(m *UsageLogMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *UsageCleanupTask
+			value *UsageLog
 		)
-		m.oldValue = func(ctx context.Context) (*UsageCleanupTask, error) {
+		m.oldValue = func(ctx context.Context) (*UsageLog, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().UsageCleanupTask.Get(ctx, id)
+					value, err = m.Client().UsageLog.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -10441,10 +14949,10 @@ func withUsageCleanupTaskID(id int64) usagecleanuptaskOption {
 	}
 }
 
-// withUsageCleanupTask sets the old UsageCleanupTask of the mutation.
-func withUsageCleanupTask(node *UsageCleanupTask) usagecleanuptaskOption {
-	return func(m *UsageCleanupTaskMutation) {
-		m.oldValue = func(context.Context) (*UsageCleanupTask, error) {
+// withUsageLog sets the old UsageLog of the mutation.
+func withUsageLog(node *UsageLog) usagelogOption {
+	return func(m *UsageLogMutation) {
+		m.oldValue = func(context.Context) (*UsageLog, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -10453,7 +14961,7 @@ func withUsageCleanupTask(node *UsageCleanupTask) usagecleanuptaskOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UsageCleanupTaskMutation) Client() *Client {
+func (m UsageLogMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -10461,7 +14969,7 @@ func (m UsageCleanupTaskMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UsageCleanupTaskMutation) Tx() (*Tx, error) {
+func (m UsageLogMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -10472,7 +14980,7 @@ func (m UsageCleanupTaskMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UsageCleanupTaskMutation) ID() (id int64, exists bool) {
+func (m *UsageLogMutation) ID() (id int64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -10483,7 +14991,7 @@ func (m *UsageCleanupTaskMutation) ID() (id int64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UsageCleanupTaskMutation) IDs(ctx context.Context) ([]int64, error) {
+func (m *UsageLogMutation) IDs(ctx context.Context) ([]int64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -10492,2137 +15000,1961 @@ func (m *UsageCleanupTaskMutation) IDs(ctx context.Context) ([]int64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().UsageCleanupTask.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().UsageLog.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *UsageCleanupTaskMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UsageCleanupTaskMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCreatedAt returns the old "created_at" field's value of the UsageCleanupTask entity.
-// If the UsageCleanupTask object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageCleanupTaskMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UsageCleanupTaskMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (m *UsageCleanupTaskMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
-}
-
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UsageCleanupTaskMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUpdatedAt returns the old "updated_at" field's value of the UsageCleanupTask entity.
-// If the UsageCleanupTask object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageCleanupTaskMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UsageCleanupTaskMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// SetStatus sets the "status" field.
-func (m *UsageCleanupTaskMutation) SetStatus(s string) {
-	m.status = &s
-}
-
-// Status returns the value of the "status" field in the mutation.
-func (m *UsageCleanupTaskMutation) Status() (r string, exists bool) {
-	v := m.status
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldStatus returns the old "status" field's value of the UsageCleanupTask entity.
-// If the UsageCleanupTask object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageCleanupTaskMutation) OldStatus(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
-	}
-	return oldValue.Status, nil
-}
-
-// ResetStatus resets all changes to the "status" field.
-func (m *UsageCleanupTaskMutation) ResetStatus() {
-	m.status = nil
-}
-
-// SetFilters sets the "filters" field.
-func (m *UsageCleanupTaskMutation) SetFilters(jm json.RawMessage) {
-	m.filters = &jm
-	m.appendfilters = nil
-}
-
-// Filters returns the value of the "filters" field in the mutation.
-func (m *UsageCleanupTaskMutation) Filters() (r json.RawMessage, exists bool) {
-	v := m.filters
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldFilters returns the old "filters" field's value of the UsageCleanupTask entity.
-// If the UsageCleanupTask object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageCleanupTaskMutation) OldFilters(ctx context.Context) (v json.RawMessage, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFilters is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFilters requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFilters: %w", err)
-	}
-	return oldValue.Filters, nil
-}
-
-// AppendFilters adds jm to the "filters" field.
-func (m *UsageCleanupTaskMutation) AppendFilters(jm json.RawMessage) {
-	m.appendfilters = append(m.appendfilters, jm...)
-}
-
-// AppendedFilters returns the list of values that were appended to the "filters" field in this mutation.
-func (m *UsageCleanupTaskMutation) AppendedFilters() (json.RawMessage, bool) {
-	if len(m.appendfilters) == 0 {
-		return nil, false
-	}
-	return m.appendfilters, true
-}
-
-// ResetFilters resets all changes to the "filters" field.
-func (m *UsageCleanupTaskMutation) ResetFilters() {
-	m.filters = nil
-	m.appendfilters = nil
-}
-
-// SetCreatedBy sets the "created_by" field.
-func (m *UsageCleanupTaskMutation) SetCreatedBy(i int64) {
-	m.created_by = &i
-	m.addcreated_by = nil
-}
-
-// CreatedBy returns the value of the "created_by" field in the mutation.
-func (m *UsageCleanupTaskMutation) CreatedBy() (r int64, exists bool) {
-	v := m.created_by
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCreatedBy returns the old "created_by" field's value of the UsageCleanupTask entity.
-// If the UsageCleanupTask object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageCleanupTaskMutation) OldCreatedBy(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
-	}
-	return oldValue.CreatedBy, nil
-}
-
-// AddCreatedBy adds i to the "created_by" field.
-func (m *UsageCleanupTaskMutation) AddCreatedBy(i int64) {
-	if m.addcreated_by != nil {
-		*m.addcreated_by += i
-	} else {
-		m.addcreated_by = &i
-	}
-}
-
-// AddedCreatedBy returns the value that was added to the "created_by" field in this mutation.
-func (m *UsageCleanupTaskMutation) AddedCreatedBy() (r int64, exists bool) {
-	v := m.addcreated_by
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetCreatedBy resets all changes to the "created_by" field.
-func (m *UsageCleanupTaskMutation) ResetCreatedBy() {
-	m.created_by = nil
-	m.addcreated_by = nil
-}
-
-// SetDeletedRows sets the "deleted_rows" field.
-func (m *UsageCleanupTaskMutation) SetDeletedRows(i int64) {
-	m.deleted_rows = &i
-	m.adddeleted_rows = nil
+// SetUserID sets the "user_id" field.
+func (m *UsageLogMutation) SetUserID(i int64) {
+	m.user = &i
 }
-
-// DeletedRows returns the value of the "deleted_rows" field in the mutation.
-func (m *UsageCleanupTaskMutation) DeletedRows() (r int64, exists bool) {
-	v := m.deleted_rows
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UsageLogMutation) UserID() (r int64, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedRows returns the old "deleted_rows" field's value of the UsageCleanupTask entity.
-// If the UsageCleanupTask object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageCleanupTaskMutation) OldDeletedRows(ctx context.Context) (v int64, err error) {
+func (m *UsageLogMutation) OldUserID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedRows is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedRows requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedRows: %w", err)
-	}
-	return oldValue.DeletedRows, nil
-}
-
-// AddDeletedRows adds i to the "deleted_rows" field.
-func (m *UsageCleanupTaskMutation) AddDeletedRows(i int64) {
-	if m.adddeleted_rows != nil {
-		*m.adddeleted_rows += i
-	} else {
-		m.adddeleted_rows = &i
-	}
-}
-
-// AddedDeletedRows returns the value that was added to the "deleted_rows" field in this mutation.
-func (m *UsageCleanupTaskMutation) AddedDeletedRows() (r int64, exists bool) {
-	v := m.adddeleted_rows
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return *v, true
+	return oldValue.UserID, nil
 }
 
-// ResetDeletedRows resets all changes to the "deleted_rows" field.
-func (m *UsageCleanupTaskMutation) ResetDeletedRows() {
-	m.deleted_rows = nil
-	m.adddeleted_rows = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UsageLogMutation) ResetUserID() {
+	m.user = nil
 }
 
-// SetErrorMessage sets the "error_message" field.
-func (m *UsageCleanupTaskMutation) SetErrorMessage(s string) {
-	m.error_message = &s
+// SetAPIKeyID sets the "api_key_id" field.
+func (m *UsageLogMutation) SetAPIKeyID(i int64) {
+	m.api_key = &i
 }
 
-// ErrorMessage returns the value of the "error_message" field in the mutation.
-func (m *UsageCleanupTaskMutation) ErrorMessage() (r string, exists bool) {
-	v := m.error_message
+// APIKeyID returns the value of the "api_key_id" field in the mutation.
+func (m *UsageLogMutation) APIKeyID() (r int64, exists bool) {
+	v := m.api_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldErrorMessage returns the old "error_message" field's value of the UsageCleanupTask entity.
-// If the UsageCleanupTask object wasn't provided to the builder, the object is fetched from the database.
+// OldAPIKeyID returns the old "api_key_id" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageCleanupTaskMutation) OldErrorMessage(ctx context.Context) (v *string, err error) {
+func (m *UsageLogMutation) OldAPIKeyID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+		return v, errors.New("OldAPIKeyID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+		return v, errors.New("OldAPIKeyID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldAPIKeyID: %w", err)
 	}
-	return oldValue.ErrorMessage, nil
-}
-
-// ClearErrorMessage clears the value of the "error_message" field.
-func (m *UsageCleanupTaskMutation) ClearErrorMessage() {
-	m.error_message = nil
-	m.clearedFields[usagecleanuptask.FieldErrorMessage] = struct{}{}
-}
-
-// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
-func (m *UsageCleanupTaskMutation) ErrorMessageCleared() bool {
-	_, ok := m.clearedFields[usagecleanuptask.FieldErrorMessage]
-	return ok
+	return oldValue.APIKeyID, nil
 }
 
-// ResetErrorMessage resets all changes to the "error_message" field.
-func (m *UsageCleanupTaskMutation) ResetErrorMessage() {
-	m.error_message = nil
-	delete(m.clearedFields, usagecleanuptask.FieldErrorMessage)
+// ResetAPIKeyID resets all changes to the "api_key_id" field.
+func (m *UsageLogMutation) ResetAPIKeyID() {
+	m.api_key = nil
 }
 
-// SetCanceledBy sets the "canceled_by" field.
-func (m *UsageCleanupTaskMutation) SetCanceledBy(i int64) {
-	m.canceled_by = &i
-	m.addcanceled_by = nil
+// SetAccountID sets the "account_id" field.
+func (m *UsageLogMutation) SetAccountID(i int64) {
+	m.account = &i
 }
 
-// CanceledBy returns the value of the "canceled_by" field in the mutation.
-func (m *UsageCleanupTaskMutation) CanceledBy() (r int64, exists bool) {
-	v := m.canceled_by
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *UsageLogMutation) AccountID() (r int64, exists bool) {
+	v := m.account
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCanceledBy returns the old "canceled_by" field's value of the UsageCleanupTask entity.
-// If the UsageCleanupTask object wasn't provided to the builder, the object is fetched from the database.
+// OldAccountID returns the old "account_id" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageCleanupTaskMutation) OldCanceledBy(ctx context.Context) (v *int64, err error) {
+func (m *UsageLogMutation) OldAccountID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCanceledBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCanceledBy requires an ID field in the mutation")
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCanceledBy: %w", err)
-	}
-	return oldValue.CanceledBy, nil
-}
-
-// AddCanceledBy adds i to the "canceled_by" field.
-func (m *UsageCleanupTaskMutation) AddCanceledBy(i int64) {
-	if m.addcanceled_by != nil {
-		*m.addcanceled_by += i
-	} else {
-		m.addcanceled_by = &i
-	}
-}
-
-// AddedCanceledBy returns the value that was added to the "canceled_by" field in this mutation.
-func (m *UsageCleanupTaskMutation) AddedCanceledBy() (r int64, exists bool) {
-	v := m.addcanceled_by
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
 	}
-	return *v, true
-}
-
-// ClearCanceledBy clears the value of the "canceled_by" field.
-func (m *UsageCleanupTaskMutation) ClearCanceledBy() {
-	m.canceled_by = nil
-	m.addcanceled_by = nil
-	m.clearedFields[usagecleanuptask.FieldCanceledBy] = struct{}{}
-}
-
-// CanceledByCleared returns if the "canceled_by" field was cleared in this mutation.
-func (m *UsageCleanupTaskMutation) CanceledByCleared() bool {
-	_, ok := m.clearedFields[usagecleanuptask.FieldCanceledBy]
-	return ok
+	return oldValue.AccountID, nil
 }
 
-// ResetCanceledBy resets all changes to the "canceled_by" field.
-func (m *UsageCleanupTaskMutation) ResetCanceledBy() {
-	m.canceled_by = nil
-	m.addcanceled_by = nil
-	delete(m.clearedFields, usagecleanuptask.FieldCanceledBy)
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *UsageLogMutation) ResetAccountID() {
+	m.account = nil
 }
 
-// SetCanceledAt sets the "canceled_at" field.
-func (m *UsageCleanupTaskMutation) SetCanceledAt(t time.Time) {
-	m.canceled_at = &t
+// SetRequestID sets the "request_id" field.
+func (m *UsageLogMutation) SetRequestID(s string) {
+	m.request_id = &s
 }
 
-// CanceledAt returns the value of the "canceled_at" field in the mutation.
-func (m *UsageCleanupTaskMutation) CanceledAt() (r time.Time, exists bool) {
-	v := m.canceled_at
+// RequestID returns the value of the "request_id" field in the mutation.
+func (m *UsageLogMutation) RequestID() (r string, exists bool) {
+	v := m.request_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCanceledAt returns the old "canceled_at" field's value of the UsageCleanupTask entity.
-// If the UsageCleanupTask object wasn't provided to the builder, the object is fetched from the database.
+// OldRequestID returns the old "request_id" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageCleanupTaskMutation) OldCanceledAt(ctx context.Context) (v *time.Time, err error) {
+func (m *UsageLogMutation) OldRequestID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCanceledAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRequestID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCanceledAt requires an ID field in the mutation")
+		return v, errors.New("OldRequestID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCanceledAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRequestID: %w", err)
 	}
-	return oldValue.CanceledAt, nil
-}
-
-// ClearCanceledAt clears the value of the "canceled_at" field.
-func (m *UsageCleanupTaskMutation) ClearCanceledAt() {
-	m.canceled_at = nil
-	m.clearedFields[usagecleanuptask.FieldCanceledAt] = struct{}{}
-}
-
-// CanceledAtCleared returns if the "canceled_at" field was cleared in this mutation.
-func (m *UsageCleanupTaskMutation) CanceledAtCleared() bool {
-	_, ok := m.clearedFields[usagecleanuptask.FieldCanceledAt]
-	return ok
+	return oldValue.RequestID, nil
 }
 
-// ResetCanceledAt resets all changes to the "canceled_at" field.
-func (m *UsageCleanupTaskMutation) ResetCanceledAt() {
-	m.canceled_at = nil
-	delete(m.clearedFields, usagecleanuptask.FieldCanceledAt)
+// ResetRequestID resets all changes to the "request_id" field.
+func (m *UsageLogMutation) ResetRequestID() {
+	m.request_id = nil
 }
 
-// SetStartedAt sets the "started_at" field.
-func (m *UsageCleanupTaskMutation) SetStartedAt(t time.Time) {
-	m.started_at = &t
+// SetModel sets the "model" field.
+func (m *UsageLogMutation) SetModel(s string) {
+	m.model = &s
 }
 
-// StartedAt returns the value of the "started_at" field in the mutation.
-func (m *UsageCleanupTaskMutation) StartedAt() (r time.Time, exists bool) {
-	v := m.started_at
+// Model returns the value of the "model" field in the mutation.
+func (m *UsageLogMutation) Model() (r string, exists bool) {
+	v := m.model
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartedAt returns the old "started_at" field's value of the UsageCleanupTask entity.
-// If the UsageCleanupTask object wasn't provided to the builder, the object is fetched from the database.
+// OldModel returns the old "model" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageCleanupTaskMutation) OldStartedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *UsageLogMutation) OldModel(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+		return v, errors.New("OldModel requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
 	}
-	return oldValue.StartedAt, nil
-}
-
-// ClearStartedAt clears the value of the "started_at" field.
-func (m *UsageCleanupTaskMutation) ClearStartedAt() {
-	m.started_at = nil
-	m.clearedFields[usagecleanuptask.FieldStartedAt] = struct{}{}
-}
-
-// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
-func (m *UsageCleanupTaskMutation) StartedAtCleared() bool {
-	_, ok := m.clearedFields[usagecleanuptask.FieldStartedAt]
-	return ok
+	return oldValue.Model, nil
 }
 
-// ResetStartedAt resets all changes to the "started_at" field.
-func (m *UsageCleanupTaskMutation) ResetStartedAt() {
-	m.started_at = nil
-	delete(m.clearedFields, usagecleanuptask.FieldStartedAt)
+// ResetModel resets all changes to the "model" field.
+func (m *UsageLogMutation) ResetModel() {
+	m.model = nil
 }
 
-// SetFinishedAt sets the "finished_at" field.
-func (m *UsageCleanupTaskMutation) SetFinishedAt(t time.Time) {
-	m.finished_at = &t
+// SetGroupID sets the "group_id" field.
+func (m *UsageLogMutation) SetGroupID(i int64) {
+	m.group = &i
 }
 
-// FinishedAt returns the value of the "finished_at" field in the mutation.
-func (m *UsageCleanupTaskMutation) FinishedAt() (r time.Time, exists bool) {
-	v := m.finished_at
+// GroupID returns the value of the "group_id" field in the mutation.
+func (m *UsageLogMutation) GroupID() (r int64, exists bool) {
+	v := m.group
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFinishedAt returns the old "finished_at" field's value of the UsageCleanupTask entity.
-// If the UsageCleanupTask object wasn't provided to the builder, the object is fetched from the database.
+// OldGroupID returns the old "group_id" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageCleanupTaskMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *UsageLogMutation) OldGroupID(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldGroupID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+		return v, errors.New("OldGroupID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldGroupID: %w", err)
 	}
-	return oldValue.FinishedAt, nil
+	return oldValue.GroupID, nil
 }
 
-// ClearFinishedAt clears the value of the "finished_at" field.
-func (m *UsageCleanupTaskMutation) ClearFinishedAt() {
-	m.finished_at = nil
-	m.clearedFields[usagecleanuptask.FieldFinishedAt] = struct{}{}
+// ClearGroupID clears the value of the "group_id" field.
+func (m *UsageLogMutation) ClearGroupID() {
+	m.group = nil
+	m.clearedFields[usagelog.FieldGroupID] = struct{}{}
 }
 
-// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
-func (m *UsageCleanupTaskMutation) FinishedAtCleared() bool {
-	_, ok := m.clearedFields[usagecleanuptask.FieldFinishedAt]
+// GroupIDCleared returns if the "group_id" field was cleared in this mutation.
+func (m *UsageLogMutation) GroupIDCleared() bool {
+	_, ok := m.clearedFields[usagelog.FieldGroupID]
 	return ok
 }
 
-// ResetFinishedAt resets all changes to the "finished_at" field.
-func (m *UsageCleanupTaskMutation) ResetFinishedAt() {
-	m.finished_at = nil
-	delete(m.clearedFields, usagecleanuptask.FieldFinishedAt)
-}
-
-// Where appends a list predicates to the UsageCleanupTaskMutation builder.
-func (m *UsageCleanupTaskMutation) Where(ps ...predicate.UsageCleanupTask) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the UsageCleanupTaskMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UsageCleanupTaskMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.UsageCleanupTask, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *UsageCleanupTaskMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *UsageCleanupTaskMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (UsageCleanupTask).
-func (m *UsageCleanupTaskMutation) Type() string {
-	return m.typ
-}
-
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *UsageCleanupTaskMutation) Fields() []string {
-	fields := make([]string, 0, 11)
-	if m.created_at != nil {
-		fields = append(fields, usagecleanuptask.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, usagecleanuptask.FieldUpdatedAt)
-	}
-	if m.status != nil {
-		fields = append(fields, usagecleanuptask.FieldStatus)
-	}
-	if m.filters != nil {
-		fields = append(fields, usagecleanuptask.FieldFilters)
-	}
-	if m.created_by != nil {
-		fields = append(fields, usagecleanuptask.FieldCreatedBy)
-	}
-	if m.deleted_rows != nil {
-		fields = append(fields, usagecleanuptask.FieldDeletedRows)
-	}
-	if m.error_message != nil {
-		fields = append(fields, usagecleanuptask.FieldErrorMessage)
-	}
-	if m.canceled_by != nil {
-		fields = append(fields, usagecleanuptask.FieldCanceledBy)
-	}
-	if m.canceled_at != nil {
-		fields = append(fields, usagecleanuptask.FieldCanceledAt)
-	}
-	if m.started_at != nil {
-		fields = append(fields, usagecleanuptask.FieldStartedAt)
-	}
-	if m.finished_at != nil {
-		fields = append(fields, usagecleanuptask.FieldFinishedAt)
-	}
-	return fields
+// ResetGroupID resets all changes to the "group_id" field.
+func (m *UsageLogMutation) ResetGroupID() {
+	m.group = nil
+	delete(m.clearedFields, usagelog.FieldGroupID)
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *UsageCleanupTaskMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case usagecleanuptask.FieldCreatedAt:
-		return m.CreatedAt()
-	case usagecleanuptask.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case usagecleanuptask.FieldStatus:
-		return m.Status()
-	case usagecleanuptask.FieldFilters:
-		return m.Filters()
-	case usagecleanuptask.FieldCreatedBy:
-		return m.CreatedBy()
-	case usagecleanuptask.FieldDeletedRows:
-		return m.DeletedRows()
-	case usagecleanuptask.FieldErrorMessage:
-		return m.ErrorMessage()
-	case usagecleanuptask.FieldCanceledBy:
-		return m.CanceledBy()
-	case usagecleanuptask.FieldCanceledAt:
-		return m.CanceledAt()
-	case usagecleanuptask.FieldStartedAt:
-		return m.StartedAt()
-	case usagecleanuptask.FieldFinishedAt:
-		return m.FinishedAt()
-	}
-	return nil, false
+// SetSubscriptionID sets the "subscription_id" field.
+func (m *UsageLogMutation) SetSubscriptionID(i int64) {
+	m.subscription = &i
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *UsageCleanupTaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case usagecleanuptask.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case usagecleanuptask.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case usagecleanuptask.FieldStatus:
-		return m.OldStatus(ctx)
-	case usagecleanuptask.FieldFilters:
-		return m.OldFilters(ctx)
-	case usagecleanuptask.FieldCreatedBy:
-		return m.OldCreatedBy(ctx)
-	case usagecleanuptask.FieldDeletedRows:
-		return m.OldDeletedRows(ctx)
-	case usagecleanuptask.FieldErrorMessage:
-		return m.OldErrorMessage(ctx)
-	case usagecleanuptask.FieldCanceledBy:
-		return m.OldCanceledBy(ctx)
-	case usagecleanuptask.FieldCanceledAt:
-		return m.OldCanceledAt(ctx)
-	case usagecleanuptask.FieldStartedAt:
-		return m.OldStartedAt(ctx)
-	case usagecleanuptask.FieldFinishedAt:
-		return m.OldFinishedAt(ctx)
+// SubscriptionID returns the value of the "subscription_id" field in the mutation.
+func (m *UsageLogMutation) SubscriptionID() (r int64, exists bool) {
+	v := m.subscription
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown UsageCleanupTask field %s", name)
-}
-
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *UsageCleanupTaskMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case usagecleanuptask.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case usagecleanuptask.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case usagecleanuptask.FieldStatus:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case usagecleanuptask.FieldFilters:
-		v, ok := value.(json.RawMessage)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFilters(v)
-		return nil
-	case usagecleanuptask.FieldCreatedBy:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedBy(v)
-		return nil
-	case usagecleanuptask.FieldDeletedRows:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeletedRows(v)
-		return nil
-	case usagecleanuptask.FieldErrorMessage:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetErrorMessage(v)
-		return nil
-	case usagecleanuptask.FieldCanceledBy:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCanceledBy(v)
-		return nil
-	case usagecleanuptask.FieldCanceledAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCanceledAt(v)
-		return nil
-	case usagecleanuptask.FieldStartedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStartedAt(v)
-		return nil
-	case usagecleanuptask.FieldFinishedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFinishedAt(v)
-		return nil
+	return *v, true
+}
+
+// OldSubscriptionID returns the old "subscription_id" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageLogMutation) OldSubscriptionID(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubscriptionID is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown UsageCleanupTask field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubscriptionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubscriptionID: %w", err)
+	}
+	return oldValue.SubscriptionID, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *UsageCleanupTaskMutation) AddedFields() []string {
-	var fields []string
-	if m.addcreated_by != nil {
-		fields = append(fields, usagecleanuptask.FieldCreatedBy)
+// ClearSubscriptionID clears the value of the "subscription_id" field.
+func (m *UsageLogMutation) ClearSubscriptionID() {
+	m.subscription = nil
+	m.clearedFields[usagelog.FieldSubscriptionID] = struct{}{}
+}
+
+// SubscriptionIDCleared returns if the "subscription_id" field was cleared in this mutation.
+func (m *UsageLogMutation) SubscriptionIDCleared() bool {
+	_, ok := m.clearedFields[usagelog.FieldSubscriptionID]
+	return ok
+}
+
+// ResetSubscriptionID resets all changes to the "subscription_id" field.
+func (m *UsageLogMutation) ResetSubscriptionID() {
+	m.subscription = nil
+	delete(m.clearedFields, usagelog.FieldSubscriptionID)
+}
+
+// SetOriginalGroupID sets the "original_group_id" field.
+func (m *UsageLogMutation) SetOriginalGroupID(i int64) {
+	m.original_group_id = &i
+	m.addoriginal_group_id = nil
+}
+
+// OriginalGroupID returns the value of the "original_group_id" field in the mutation.
+func (m *UsageLogMutation) OriginalGroupID() (r int64, exists bool) {
+	v := m.original_group_id
+	if v == nil {
+		return
 	}
-	if m.adddeleted_rows != nil {
-		fields = append(fields, usagecleanuptask.FieldDeletedRows)
+	return *v, true
+}
+
+// OldOriginalGroupID returns the old "original_group_id" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageLogMutation) OldOriginalGroupID(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOriginalGroupID is only allowed on UpdateOne operations")
 	}
-	if m.addcanceled_by != nil {
-		fields = append(fields, usagecleanuptask.FieldCanceledBy)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOriginalGroupID requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOriginalGroupID: %w", err)
+	}
+	return oldValue.OriginalGroupID, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *UsageCleanupTaskMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case usagecleanuptask.FieldCreatedBy:
-		return m.AddedCreatedBy()
-	case usagecleanuptask.FieldDeletedRows:
-		return m.AddedDeletedRows()
-	case usagecleanuptask.FieldCanceledBy:
-		return m.AddedCanceledBy()
+// AddOriginalGroupID adds i to the "original_group_id" field.
+func (m *UsageLogMutation) AddOriginalGroupID(i int64) {
+	if m.addoriginal_group_id != nil {
+		*m.addoriginal_group_id += i
+	} else {
+		m.addoriginal_group_id = &i
 	}
-	return nil, false
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *UsageCleanupTaskMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case usagecleanuptask.FieldCreatedBy:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCreatedBy(v)
-		return nil
-	case usagecleanuptask.FieldDeletedRows:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDeletedRows(v)
-		return nil
-	case usagecleanuptask.FieldCanceledBy:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCanceledBy(v)
-		return nil
+// AddedOriginalGroupID returns the value that was added to the "original_group_id" field in this mutation.
+func (m *UsageLogMutation) AddedOriginalGroupID() (r int64, exists bool) {
+	v := m.addoriginal_group_id
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown UsageCleanupTask numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *UsageCleanupTaskMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(usagecleanuptask.FieldErrorMessage) {
-		fields = append(fields, usagecleanuptask.FieldErrorMessage)
+// ClearOriginalGroupID clears the value of the "original_group_id" field.
+func (m *UsageLogMutation) ClearOriginalGroupID() {
+	m.original_group_id = nil
+	m.addoriginal_group_id = nil
+	m.clearedFields[usagelog.FieldOriginalGroupID] = struct{}{}
+}
+
+// OriginalGroupIDCleared returns if the "original_group_id" field was cleared in this mutation.
+func (m *UsageLogMutation) OriginalGroupIDCleared() bool {
+	_, ok := m.clearedFields[usagelog.FieldOriginalGroupID]
+	return ok
+}
+
+// ResetOriginalGroupID resets all changes to the "original_group_id" field.
+func (m *UsageLogMutation) ResetOriginalGroupID() {
+	m.original_group_id = nil
+	m.addoriginal_group_id = nil
+	delete(m.clearedFields, usagelog.FieldOriginalGroupID)
+}
+
+// SetFallbackHop sets the "fallback_hop" field.
+func (m *UsageLogMutation) SetFallbackHop(i int) {
+	m.fallback_hop = &i
+	m.addfallback_hop = nil
+}
+
+// FallbackHop returns the value of the "fallback_hop" field in the mutation.
+func (m *UsageLogMutation) FallbackHop() (r int, exists bool) {
+	v := m.fallback_hop
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(usagecleanuptask.FieldCanceledBy) {
-		fields = append(fields, usagecleanuptask.FieldCanceledBy)
+	return *v, true
+}
+
+// OldFallbackHop returns the old "fallback_hop" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageLogMutation) OldFallbackHop(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFallbackHop is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(usagecleanuptask.FieldCanceledAt) {
-		fields = append(fields, usagecleanuptask.FieldCanceledAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFallbackHop requires an ID field in the mutation")
 	}
-	if m.FieldCleared(usagecleanuptask.FieldStartedAt) {
-		fields = append(fields, usagecleanuptask.FieldStartedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFallbackHop: %w", err)
 	}
-	if m.FieldCleared(usagecleanuptask.FieldFinishedAt) {
-		fields = append(fields, usagecleanuptask.FieldFinishedAt)
+	return oldValue.FallbackHop, nil
+}
+
+// AddFallbackHop adds i to the "fallback_hop" field.
+func (m *UsageLogMutation) AddFallbackHop(i int) {
+	if m.addfallback_hop != nil {
+		*m.addfallback_hop += i
+	} else {
+		m.addfallback_hop = &i
 	}
-	return fields
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *UsageCleanupTaskMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// AddedFallbackHop returns the value that was added to the "fallback_hop" field in this mutation.
+func (m *UsageLogMutation) AddedFallbackHop() (r int, exists bool) {
+	v := m.addfallback_hop
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFallbackHop resets all changes to the "fallback_hop" field.
+func (m *UsageLogMutation) ResetFallbackHop() {
+	m.fallback_hop = nil
+	m.addfallback_hop = nil
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (m *UsageLogMutation) SetOrganizationID(i int64) {
+	m.organization_id = &i
+	m.addorganization_id = nil
+}
+
+// OrganizationID returns the value of the "organization_id" field in the mutation.
+func (m *UsageLogMutation) OrganizationID() (r int64, exists bool) {
+	v := m.organization_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganizationID returns the old "organization_id" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageLogMutation) OldOrganizationID(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrganizationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrganizationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationID: %w", err)
+	}
+	return oldValue.OrganizationID, nil
+}
+
+// AddOrganizationID adds i to the "organization_id" field.
+func (m *UsageLogMutation) AddOrganizationID(i int64) {
+	if m.addorganization_id != nil {
+		*m.addorganization_id += i
+	} else {
+		m.addorganization_id = &i
+	}
+}
+
+// AddedOrganizationID returns the value that was added to the "organization_id" field in this mutation.
+func (m *UsageLogMutation) AddedOrganizationID() (r int64, exists bool) {
+	v := m.addorganization_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (m *UsageLogMutation) ClearOrganizationID() {
+	m.organization_id = nil
+	m.addorganization_id = nil
+	m.clearedFields[usagelog.FieldOrganizationID] = struct{}{}
+}
+
+// OrganizationIDCleared returns if the "organization_id" field was cleared in this mutation.
+func (m *UsageLogMutation) OrganizationIDCleared() bool {
+	_, ok := m.clearedFields[usagelog.FieldOrganizationID]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *UsageCleanupTaskMutation) ClearField(name string) error {
-	switch name {
-	case usagecleanuptask.FieldErrorMessage:
-		m.ClearErrorMessage()
-		return nil
-	case usagecleanuptask.FieldCanceledBy:
-		m.ClearCanceledBy()
-		return nil
-	case usagecleanuptask.FieldCanceledAt:
-		m.ClearCanceledAt()
-		return nil
-	case usagecleanuptask.FieldStartedAt:
-		m.ClearStartedAt()
-		return nil
-	case usagecleanuptask.FieldFinishedAt:
-		m.ClearFinishedAt()
-		return nil
+// ResetOrganizationID resets all changes to the "organization_id" field.
+func (m *UsageLogMutation) ResetOrganizationID() {
+	m.organization_id = nil
+	m.addorganization_id = nil
+	delete(m.clearedFields, usagelog.FieldOrganizationID)
+}
+
+// SetBillingUserID sets the "billing_user_id" field.
+func (m *UsageLogMutation) SetBillingUserID(i int64) {
+	m.billing_user_id = &i
+	m.addbilling_user_id = nil
+}
+
+// BillingUserID returns the value of the "billing_user_id" field in the mutation.
+func (m *UsageLogMutation) BillingUserID() (r int64, exists bool) {
+	v := m.billing_user_id
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown UsageCleanupTask nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *UsageCleanupTaskMutation) ResetField(name string) error {
-	switch name {
-	case usagecleanuptask.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case usagecleanuptask.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case usagecleanuptask.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case usagecleanuptask.FieldFilters:
-		m.ResetFilters()
-		return nil
-	case usagecleanuptask.FieldCreatedBy:
-		m.ResetCreatedBy()
-		return nil
-	case usagecleanuptask.FieldDeletedRows:
-		m.ResetDeletedRows()
-		return nil
-	case usagecleanuptask.FieldErrorMessage:
-		m.ResetErrorMessage()
-		return nil
-	case usagecleanuptask.FieldCanceledBy:
-		m.ResetCanceledBy()
-		return nil
-	case usagecleanuptask.FieldCanceledAt:
-		m.ResetCanceledAt()
-		return nil
-	case usagecleanuptask.FieldStartedAt:
-		m.ResetStartedAt()
-		return nil
-	case usagecleanuptask.FieldFinishedAt:
-		m.ResetFinishedAt()
-		return nil
+// OldBillingUserID returns the old "billing_user_id" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageLogMutation) OldBillingUserID(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBillingUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBillingUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBillingUserID: %w", err)
 	}
-	return fmt.Errorf("unknown UsageCleanupTask field %s", name)
+	return oldValue.BillingUserID, nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UsageCleanupTaskMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// AddBillingUserID adds i to the "billing_user_id" field.
+func (m *UsageLogMutation) AddBillingUserID(i int64) {
+	if m.addbilling_user_id != nil {
+		*m.addbilling_user_id += i
+	} else {
+		m.addbilling_user_id = &i
+	}
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *UsageCleanupTaskMutation) AddedIDs(name string) []ent.Value {
-	return nil
+// AddedBillingUserID returns the value that was added to the "billing_user_id" field in this mutation.
+func (m *UsageLogMutation) AddedBillingUserID() (r int64, exists bool) {
+	v := m.addbilling_user_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UsageCleanupTaskMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ClearBillingUserID clears the value of the "billing_user_id" field.
+func (m *UsageLogMutation) ClearBillingUserID() {
+	m.billing_user_id = nil
+	m.addbilling_user_id = nil
+	m.clearedFields[usagelog.FieldBillingUserID] = struct{}{}
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *UsageCleanupTaskMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// BillingUserIDCleared returns if the "billing_user_id" field was cleared in this mutation.
+func (m *UsageLogMutation) BillingUserIDCleared() bool {
+	_, ok := m.clearedFields[usagelog.FieldBillingUserID]
+	return ok
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UsageCleanupTaskMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ResetBillingUserID resets all changes to the "billing_user_id" field.
+func (m *UsageLogMutation) ResetBillingUserID() {
+	m.billing_user_id = nil
+	m.addbilling_user_id = nil
+	delete(m.clearedFields, usagelog.FieldBillingUserID)
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *UsageCleanupTaskMutation) EdgeCleared(name string) bool {
-	return false
+// SetInputTokens sets the "input_tokens" field.
+func (m *UsageLogMutation) SetInputTokens(i int) {
+	m.input_tokens = &i
+	m.addinput_tokens = nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *UsageCleanupTaskMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown UsageCleanupTask unique edge %s", name)
+// InputTokens returns the value of the "input_tokens" field in the mutation.
+func (m *UsageLogMutation) InputTokens() (r int, exists bool) {
+	v := m.input_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *UsageCleanupTaskMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown UsageCleanupTask edge %s", name)
+// OldInputTokens returns the old "input_tokens" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageLogMutation) OldInputTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInputTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInputTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInputTokens: %w", err)
+	}
+	return oldValue.InputTokens, nil
 }
 
-// UsageLogMutation represents an operation that mutates the UsageLog nodes in the graph.
-type UsageLogMutation struct {
-	config
-	op                          Op
-	typ                         string
-	id                          *int64
-	request_id                  *string
-	model                       *string
-	input_tokens                *int
-	addinput_tokens             *int
-	output_tokens               *int
-	addoutput_tokens            *int
-	cache_creation_tokens       *int
-	addcache_creation_tokens    *int
-	cache_read_tokens           *int
-	addcache_read_tokens        *int
-	cache_creation_5m_tokens    *int
-	addcache_creation_5m_tokens *int
-	cache_creation_1h_tokens    *int
-	addcache_creation_1h_tokens *int
-	input_cost                  *float64
-	addinput_cost               *float64
-	output_cost                 *float64
-	addoutput_cost              *float64
-	cache_creation_cost         *float64
-	addcache_creation_cost      *float64
-	cache_read_cost             *float64
-	addcache_read_cost          *float64
-	total_cost                  *float64
-	addtotal_cost               *float64
-	actual_cost                 *float64
-	addactual_cost              *float64
-	rate_multiplier             *float64
-	addrate_multiplier          *float64
-	account_rate_multiplier     *float64
-	addaccount_rate_multiplier  *float64
-	billing_type                *int8
-	addbilling_type             *int8
-	stream                      *bool
-	duration_ms                 *int
-	addduration_ms              *int
-	first_token_ms              *int
-	addfirst_token_ms           *int
-	user_agent                  *string
-	ip_address                  *string
-	image_count                 *int
-	addimage_count              *int
-	image_size                  *string
-	created_at                  *time.Time
-	clearedFields               map[string]struct{}
-	user                        *int64
-	cleareduser                 bool
-	api_key                     *int64
-	clearedapi_key              bool
-	account                     *int64
-	clearedaccount              bool
-	group                       *int64
-	clearedgroup                bool
-	subscription                *int64
-	clearedsubscription         bool
-	done                        bool
-	oldValue                    func(context.Context) (*UsageLog, error)
-	predicates                  []predicate.UsageLog
+// AddInputTokens adds i to the "input_tokens" field.
+func (m *UsageLogMutation) AddInputTokens(i int) {
+	if m.addinput_tokens != nil {
+		*m.addinput_tokens += i
+	} else {
+		m.addinput_tokens = &i
+	}
 }
 
-var _ ent.Mutation = (*UsageLogMutation)(nil)
+// AddedInputTokens returns the value that was added to the "input_tokens" field in this mutation.
+func (m *UsageLogMutation) AddedInputTokens() (r int, exists bool) {
+	v := m.addinput_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// usagelogOption allows management of the mutation configuration using functional options.
-type usagelogOption func(*UsageLogMutation)
+// ResetInputTokens resets all changes to the "input_tokens" field.
+func (m *UsageLogMutation) ResetInputTokens() {
+	m.input_tokens = nil
+	m.addinput_tokens = nil
+}
 
-// newUsageLogMutation creates new mutation for the UsageLog entity.
-func newUsageLogMutation(c config, op Op, opts ...usagelogOption) *UsageLogMutation {
-	m := &UsageLogMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeUsageLog,
-		clearedFields: make(map[string]struct{}),
+// SetOutputTokens sets the "output_tokens" field.
+func (m *UsageLogMutation) SetOutputTokens(i int) {
+	m.output_tokens = &i
+	m.addoutput_tokens = nil
+}
+
+// OutputTokens returns the value of the "output_tokens" field in the mutation.
+func (m *UsageLogMutation) OutputTokens() (r int, exists bool) {
+	v := m.output_tokens
+	if v == nil {
+		return
 	}
-	for _, opt := range opts {
-		opt(m)
+	return *v, true
+}
+
+// OldOutputTokens returns the old "output_tokens" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageLogMutation) OldOutputTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOutputTokens is only allowed on UpdateOne operations")
 	}
-	return m
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOutputTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutputTokens: %w", err)
+	}
+	return oldValue.OutputTokens, nil
 }
 
-// withUsageLogID sets the ID field of the mutation.
-func withUsageLogID(id int64) usagelogOption {
-	return func(m *UsageLogMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *UsageLog
-		)
-		m.oldValue = func(ctx context.Context) (*UsageLog, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().UsageLog.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// AddOutputTokens adds i to the "output_tokens" field.
+func (m *UsageLogMutation) AddOutputTokens(i int) {
+	if m.addoutput_tokens != nil {
+		*m.addoutput_tokens += i
+	} else {
+		m.addoutput_tokens = &i
 	}
 }
 
-// withUsageLog sets the old UsageLog of the mutation.
-func withUsageLog(node *UsageLog) usagelogOption {
-	return func(m *UsageLogMutation) {
-		m.oldValue = func(context.Context) (*UsageLog, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// AddedOutputTokens returns the value that was added to the "output_tokens" field in this mutation.
+func (m *UsageLogMutation) AddedOutputTokens() (r int, exists bool) {
+	v := m.addoutput_tokens
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UsageLogMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetOutputTokens resets all changes to the "output_tokens" field.
+func (m *UsageLogMutation) ResetOutputTokens() {
+	m.output_tokens = nil
+	m.addoutput_tokens = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m UsageLogMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// SetCacheCreationTokens sets the "cache_creation_tokens" field.
+func (m *UsageLogMutation) SetCacheCreationTokens(i int) {
+	m.cache_creation_tokens = &i
+	m.addcache_creation_tokens = nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *UsageLogMutation) ID() (id int64, exists bool) {
-	if m.id == nil {
+// CacheCreationTokens returns the value of the "cache_creation_tokens" field in the mutation.
+func (m *UsageLogMutation) CacheCreationTokens() (r int, exists bool) {
+	v := m.cache_creation_tokens
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *UsageLogMutation) IDs(ctx context.Context) ([]int64, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int64{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().UsageLog.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldCacheCreationTokens returns the old "cache_creation_tokens" field's value of the UsageLog entity.
+// If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageLogMutation) OldCacheCreationTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCacheCreationTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCacheCreationTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCacheCreationTokens: %w", err)
+	}
+	return oldValue.CacheCreationTokens, nil
+}
+
+// AddCacheCreationTokens adds i to the "cache_creation_tokens" field.
+func (m *UsageLogMutation) AddCacheCreationTokens(i int) {
+	if m.addcache_creation_tokens != nil {
+		*m.addcache_creation_tokens += i
+	} else {
+		m.addcache_creation_tokens = &i
+	}
+}
+
+// AddedCacheCreationTokens returns the value that was added to the "cache_creation_tokens" field in this mutation.
+func (m *UsageLogMutation) AddedCacheCreationTokens() (r int, exists bool) {
+	v := m.addcache_creation_tokens
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// SetUserID sets the "user_id" field.
-func (m *UsageLogMutation) SetUserID(i int64) {
-	m.user = &i
+// ResetCacheCreationTokens resets all changes to the "cache_creation_tokens" field.
+func (m *UsageLogMutation) ResetCacheCreationTokens() {
+	m.cache_creation_tokens = nil
+	m.addcache_creation_tokens = nil
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *UsageLogMutation) UserID() (r int64, exists bool) {
-	v := m.user
+// SetCacheReadTokens sets the "cache_read_tokens" field.
+func (m *UsageLogMutation) SetCacheReadTokens(i int) {
+	m.cache_read_tokens = &i
+	m.addcache_read_tokens = nil
+}
+
+// CacheReadTokens returns the value of the "cache_read_tokens" field in the mutation.
+func (m *UsageLogMutation) CacheReadTokens() (r int, exists bool) {
+	v := m.cache_read_tokens
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the UsageLog entity.
+// OldCacheReadTokens returns the old "cache_read_tokens" field's value of the UsageLog entity.
 // If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldUserID(ctx context.Context) (v int64, err error) {
+func (m *UsageLogMutation) OldCacheReadTokens(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCacheReadTokens is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldCacheReadTokens requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCacheReadTokens: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.CacheReadTokens, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *UsageLogMutation) ResetUserID() {
-	m.user = nil
+// AddCacheReadTokens adds i to the "cache_read_tokens" field.
+func (m *UsageLogMutation) AddCacheReadTokens(i int) {
+	if m.addcache_read_tokens != nil {
+		*m.addcache_read_tokens += i
+	} else {
+		m.addcache_read_tokens = &i
+	}
 }
 
-// SetAPIKeyID sets the "api_key_id" field.
-func (m *UsageLogMutation) SetAPIKeyID(i int64) {
-	m.api_key = &i
+// AddedCacheReadTokens returns the value that was added to the "cache_read_tokens" field in this mutation.
+func (m *UsageLogMutation) AddedCacheReadTokens() (r int, exists bool) {
+	v := m.addcache_read_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// APIKeyID returns the value of the "api_key_id" field in the mutation.
-func (m *UsageLogMutation) APIKeyID() (r int64, exists bool) {
-	v := m.api_key
+// ResetCacheReadTokens resets all changes to the "cache_read_tokens" field.
+func (m *UsageLogMutation) ResetCacheReadTokens() {
+	m.cache_read_tokens = nil
+	m.addcache_read_tokens = nil
+}
+
+// SetCacheCreation5mTokens sets the "cache_creation_5m_tokens" field.
+func (m *UsageLogMutation) SetCacheCreation5mTokens(i int) {
+	m.cache_creation_5m_tokens = &i
+	m.addcache_creation_5m_tokens = nil
+}
+
+// CacheCreation5mTokens returns the value of the "cache_creation_5m_tokens" field in the mutation.
+func (m *UsageLogMutation) CacheCreation5mTokens() (r int, exists bool) {
+	v := m.cache_creation_5m_tokens
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAPIKeyID returns the old "api_key_id" field's value of the UsageLog entity.
+// OldCacheCreation5mTokens returns the old "cache_creation_5m_tokens" field's value of the UsageLog entity.
 // If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldAPIKeyID(ctx context.Context) (v int64, err error) {
+func (m *UsageLogMutation) OldCacheCreation5mTokens(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAPIKeyID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCacheCreation5mTokens is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAPIKeyID requires an ID field in the mutation")
+		return v, errors.New("OldCacheCreation5mTokens requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAPIKeyID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCacheCreation5mTokens: %w", err)
 	}
-	return oldValue.APIKeyID, nil
+	return oldValue.CacheCreation5mTokens, nil
 }
 
-// ResetAPIKeyID resets all changes to the "api_key_id" field.
-func (m *UsageLogMutation) ResetAPIKeyID() {
-	m.api_key = nil
+// AddCacheCreation5mTokens adds i to the "cache_creation_5m_tokens" field.
+func (m *UsageLogMutation) AddCacheCreation5mTokens(i int) {
+	if m.addcache_creation_5m_tokens != nil {
+		*m.addcache_creation_5m_tokens += i
+	} else {
+		m.addcache_creation_5m_tokens = &i
+	}
 }
 
-// SetAccountID sets the "account_id" field.
-func (m *UsageLogMutation) SetAccountID(i int64) {
-	m.account = &i
+// AddedCacheCreation5mTokens returns the value that was added to the "cache_creation_5m_tokens" field in this mutation.
+func (m *UsageLogMutation) AddedCacheCreation5mTokens() (r int, exists bool) {
+	v := m.addcache_creation_5m_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AccountID returns the value of the "account_id" field in the mutation.
-func (m *UsageLogMutation) AccountID() (r int64, exists bool) {
-	v := m.account
+// ResetCacheCreation5mTokens resets all changes to the "cache_creation_5m_tokens" field.
+func (m *UsageLogMutation) ResetCacheCreation5mTokens() {
+	m.cache_creation_5m_tokens = nil
+	m.addcache_creation_5m_tokens = nil
+}
+
+// SetCacheCreation1hTokens sets the "cache_creation_1h_tokens" field.
+func (m *UsageLogMutation) SetCacheCreation1hTokens(i int) {
+	m.cache_creation_1h_tokens = &i
+	m.addcache_creation_1h_tokens = nil
+}
+
+// CacheCreation1hTokens returns the value of the "cache_creation_1h_tokens" field in the mutation.
+func (m *UsageLogMutation) CacheCreation1hTokens() (r int, exists bool) {
+	v := m.cache_creation_1h_tokens
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAccountID returns the old "account_id" field's value of the UsageLog entity.
+// OldCacheCreation1hTokens returns the old "cache_creation_1h_tokens" field's value of the UsageLog entity.
 // If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldAccountID(ctx context.Context) (v int64, err error) {
+func (m *UsageLogMutation) OldCacheCreation1hTokens(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCacheCreation1hTokens is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAccountID requires an ID field in the mutation")
+		return v, errors.New("OldCacheCreation1hTokens requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCacheCreation1hTokens: %w", err)
 	}
-	return oldValue.AccountID, nil
+	return oldValue.CacheCreation1hTokens, nil
 }
 
-// ResetAccountID resets all changes to the "account_id" field.
-func (m *UsageLogMutation) ResetAccountID() {
-	m.account = nil
+// AddCacheCreation1hTokens adds i to the "cache_creation_1h_tokens" field.
+func (m *UsageLogMutation) AddCacheCreation1hTokens(i int) {
+	if m.addcache_creation_1h_tokens != nil {
+		*m.addcache_creation_1h_tokens += i
+	} else {
+		m.addcache_creation_1h_tokens = &i
+	}
 }
 
-// SetRequestID sets the "request_id" field.
-func (m *UsageLogMutation) SetRequestID(s string) {
-	m.request_id = &s
+// AddedCacheCreation1hTokens returns the value that was added to the "cache_creation_1h_tokens" field in this mutation.
+func (m *UsageLogMutation) AddedCacheCreation1hTokens() (r int, exists bool) {
+	v := m.addcache_creation_1h_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RequestID returns the value of the "request_id" field in the mutation.
-func (m *UsageLogMutation) RequestID() (r string, exists bool) {
-	v := m.request_id
+// ResetCacheCreation1hTokens resets all changes to the "cache_creation_1h_tokens" field.
+func (m *UsageLogMutation) ResetCacheCreation1hTokens() {
+	m.cache_creation_1h_tokens = nil
+	m.addcache_creation_1h_tokens = nil
+}
+
+// SetInputCost sets the "input_cost" field.
+func (m *UsageLogMutation) SetInputCost(f float64) {
+	m.input_cost = &f
+	m.addinput_cost = nil
+}
+
+// InputCost returns the value of the "input_cost" field in the mutation.
+func (m *UsageLogMutation) InputCost() (r float64, exists bool) {
+	v := m.input_cost
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRequestID returns the old "request_id" field's value of the UsageLog entity.
+// OldInputCost returns the old "input_cost" field's value of the UsageLog entity.
 // If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldRequestID(ctx context.Context) (v string, err error) {
+func (m *UsageLogMutation) OldInputCost(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRequestID is only allowed on UpdateOne operations")
+		return v, errors.New("OldInputCost is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRequestID requires an ID field in the mutation")
+		return v, errors.New("OldInputCost requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRequestID: %w", err)
+		return v, fmt.Errorf("querying old value for OldInputCost: %w", err)
 	}
-	return oldValue.RequestID, nil
+	return oldValue.InputCost, nil
 }
 
-// ResetRequestID resets all changes to the "request_id" field.
-func (m *UsageLogMutation) ResetRequestID() {
-	m.request_id = nil
+// AddInputCost adds f to the "input_cost" field.
+func (m *UsageLogMutation) AddInputCost(f float64) {
+	if m.addinput_cost != nil {
+		*m.addinput_cost += f
+	} else {
+		m.addinput_cost = &f
+	}
 }
 
-// SetModel sets the "model" field.
-func (m *UsageLogMutation) SetModel(s string) {
-	m.model = &s
+// AddedInputCost returns the value that was added to the "input_cost" field in this mutation.
+func (m *UsageLogMutation) AddedInputCost() (r float64, exists bool) {
+	v := m.addinput_cost
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Model returns the value of the "model" field in the mutation.
-func (m *UsageLogMutation) Model() (r string, exists bool) {
-	v := m.model
+// ResetInputCost resets all changes to the "input_cost" field.
+func (m *UsageLogMutation) ResetInputCost() {
+	m.input_cost = nil
+	m.addinput_cost = nil
+}
+
+// SetOutputCost sets the "output_cost" field.
+func (m *UsageLogMutation) SetOutputCost(f float64) {
+	m.output_cost = &f
+	m.addoutput_cost = nil
+}
+
+// OutputCost returns the value of the "output_cost" field in the mutation.
+func (m *UsageLogMutation) OutputCost() (r float64, exists bool) {
+	v := m.output_cost
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldModel returns the old "model" field's value of the UsageLog entity.
+// OldOutputCost returns the old "output_cost" field's value of the UsageLog entity.
 // If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldModel(ctx context.Context) (v string, err error) {
+func (m *UsageLogMutation) OldOutputCost(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+		return v, errors.New("OldOutputCost is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModel requires an ID field in the mutation")
+		return v, errors.New("OldOutputCost requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+		return v, fmt.Errorf("querying old value for OldOutputCost: %w", err)
 	}
-	return oldValue.Model, nil
+	return oldValue.OutputCost, nil
 }
 
-// ResetModel resets all changes to the "model" field.
-func (m *UsageLogMutation) ResetModel() {
-	m.model = nil
+// AddOutputCost adds f to the "output_cost" field.
+func (m *UsageLogMutation) AddOutputCost(f float64) {
+	if m.addoutput_cost != nil {
+		*m.addoutput_cost += f
+	} else {
+		m.addoutput_cost = &f
+	}
+}
+
+// AddedOutputCost returns the value that was added to the "output_cost" field in this mutation.
+func (m *UsageLogMutation) AddedOutputCost() (r float64, exists bool) {
+	v := m.addoutput_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOutputCost resets all changes to the "output_cost" field.
+func (m *UsageLogMutation) ResetOutputCost() {
+	m.output_cost = nil
+	m.addoutput_cost = nil
 }
 
-// SetGroupID sets the "group_id" field.
-func (m *UsageLogMutation) SetGroupID(i int64) {
-	m.group = &i
+// SetCacheCreationCost sets the "cache_creation_cost" field.
+func (m *UsageLogMutation) SetCacheCreationCost(f float64) {
+	m.cache_creation_cost = &f
+	m.addcache_creation_cost = nil
 }
 
-// GroupID returns the value of the "group_id" field in the mutation.
-func (m *UsageLogMutation) GroupID() (r int64, exists bool) {
-	v := m.group
+// CacheCreationCost returns the value of the "cache_creation_cost" field in the mutation.
+func (m *UsageLogMutation) CacheCreationCost() (r float64, exists bool) {
+	v := m.cache_creation_cost
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGroupID returns the old "group_id" field's value of the UsageLog entity.
+// OldCacheCreationCost returns the old "cache_creation_cost" field's value of the UsageLog entity.
 // If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldGroupID(ctx context.Context) (v *int64, err error) {
+func (m *UsageLogMutation) OldCacheCreationCost(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGroupID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCacheCreationCost is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGroupID requires an ID field in the mutation")
+		return v, errors.New("OldCacheCreationCost requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGroupID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCacheCreationCost: %w", err)
 	}
-	return oldValue.GroupID, nil
+	return oldValue.CacheCreationCost, nil
 }
 
-// ClearGroupID clears the value of the "group_id" field.
-func (m *UsageLogMutation) ClearGroupID() {
-	m.group = nil
-	m.clearedFields[usagelog.FieldGroupID] = struct{}{}
+// AddCacheCreationCost adds f to the "cache_creation_cost" field.
+func (m *UsageLogMutation) AddCacheCreationCost(f float64) {
+	if m.addcache_creation_cost != nil {
+		*m.addcache_creation_cost += f
+	} else {
+		m.addcache_creation_cost = &f
+	}
 }
 
-// GroupIDCleared returns if the "group_id" field was cleared in this mutation.
-func (m *UsageLogMutation) GroupIDCleared() bool {
-	_, ok := m.clearedFields[usagelog.FieldGroupID]
-	return ok
+// AddedCacheCreationCost returns the value that was added to the "cache_creation_cost" field in this mutation.
+func (m *UsageLogMutation) AddedCacheCreationCost() (r float64, exists bool) {
+	v := m.addcache_creation_cost
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetGroupID resets all changes to the "group_id" field.
-func (m *UsageLogMutation) ResetGroupID() {
-	m.group = nil
-	delete(m.clearedFields, usagelog.FieldGroupID)
+// ResetCacheCreationCost resets all changes to the "cache_creation_cost" field.
+func (m *UsageLogMutation) ResetCacheCreationCost() {
+	m.cache_creation_cost = nil
+	m.addcache_creation_cost = nil
 }
 
-// SetSubscriptionID sets the "subscription_id" field.
-func (m *UsageLogMutation) SetSubscriptionID(i int64) {
-	m.subscription = &i
+// SetCacheReadCost sets the "cache_read_cost" field.
+func (m *UsageLogMutation) SetCacheReadCost(f float64) {
+	m.cache_read_cost = &f
+	m.addcache_read_cost = nil
 }
 
-// SubscriptionID returns the value of the "subscription_id" field in the mutation.
-func (m *UsageLogMutation) SubscriptionID() (r int64, exists bool) {
-	v := m.subscription
+// CacheReadCost returns the value of the "cache_read_cost" field in the mutation.
+func (m *UsageLogMutation) CacheReadCost() (r float64, exists bool) {
+	v := m.cache_read_cost
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSubscriptionID returns the old "subscription_id" field's value of the UsageLog entity.
+// OldCacheReadCost returns the old "cache_read_cost" field's value of the UsageLog entity.
 // If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldSubscriptionID(ctx context.Context) (v *int64, err error) {
+func (m *UsageLogMutation) OldCacheReadCost(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSubscriptionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCacheReadCost is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSubscriptionID requires an ID field in the mutation")
+		return v, errors.New("OldCacheReadCost requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSubscriptionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCacheReadCost: %w", err)
 	}
-	return oldValue.SubscriptionID, nil
+	return oldValue.CacheReadCost, nil
 }
 
-// ClearSubscriptionID clears the value of the "subscription_id" field.
-func (m *UsageLogMutation) ClearSubscriptionID() {
-	m.subscription = nil
-	m.clearedFields[usagelog.FieldSubscriptionID] = struct{}{}
+// AddCacheReadCost adds f to the "cache_read_cost" field.
+func (m *UsageLogMutation) AddCacheReadCost(f float64) {
+	if m.addcache_read_cost != nil {
+		*m.addcache_read_cost += f
+	} else {
+		m.addcache_read_cost = &f
+	}
 }
 
-// SubscriptionIDCleared returns if the "subscription_id" field was cleared in this mutation.
-func (m *UsageLogMutation) SubscriptionIDCleared() bool {
-	_, ok := m.clearedFields[usagelog.FieldSubscriptionID]
-	return ok
+// AddedCacheReadCost returns the value that was added to the "cache_read_cost" field in this mutation.
+func (m *UsageLogMutation) AddedCacheReadCost() (r float64, exists bool) {
+	v := m.addcache_read_cost
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetSubscriptionID resets all changes to the "subscription_id" field.
-func (m *UsageLogMutation) ResetSubscriptionID() {
-	m.subscription = nil
-	delete(m.clearedFields, usagelog.FieldSubscriptionID)
+// ResetCacheReadCost resets all changes to the "cache_read_cost" field.
+func (m *UsageLogMutation) ResetCacheReadCost() {
+	m.cache_read_cost = nil
+	m.addcache_read_cost = nil
 }
 
-// SetInputTokens sets the "input_tokens" field.
-func (m *UsageLogMutation) SetInputTokens(i int) {
-	m.input_tokens = &i
-	m.addinput_tokens = nil
+// SetTotalCost sets the "total_cost" field.
+func (m *UsageLogMutation) SetTotalCost(f float64) {
+	m.total_cost = &f
+	m.addtotal_cost = nil
 }
 
-// InputTokens returns the value of the "input_tokens" field in the mutation.
-func (m *UsageLogMutation) InputTokens() (r int, exists bool) {
-	v := m.input_tokens
+// TotalCost returns the value of the "total_cost" field in the mutation.
+func (m *UsageLogMutation) TotalCost() (r float64, exists bool) {
+	v := m.total_cost
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInputTokens returns the old "input_tokens" field's value of the UsageLog entity.
+// OldTotalCost returns the old "total_cost" field's value of the UsageLog entity.
 // If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldInputTokens(ctx context.Context) (v int, err error) {
+func (m *UsageLogMutation) OldTotalCost(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInputTokens is only allowed on UpdateOne operations")
+		return v, errors.New("OldTotalCost is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInputTokens requires an ID field in the mutation")
+		return v, errors.New("OldTotalCost requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInputTokens: %w", err)
+		return v, fmt.Errorf("querying old value for OldTotalCost: %w", err)
 	}
-	return oldValue.InputTokens, nil
+	return oldValue.TotalCost, nil
 }
 
-// AddInputTokens adds i to the "input_tokens" field.
-func (m *UsageLogMutation) AddInputTokens(i int) {
-	if m.addinput_tokens != nil {
-		*m.addinput_tokens += i
+// AddTotalCost adds f to the "total_cost" field.
+func (m *UsageLogMutation) AddTotalCost(f float64) {
+	if m.addtotal_cost != nil {
+		*m.addtotal_cost += f
 	} else {
-		m.addinput_tokens = &i
+		m.addtotal_cost = &f
 	}
 }
 
-// AddedInputTokens returns the value that was added to the "input_tokens" field in this mutation.
-func (m *UsageLogMutation) AddedInputTokens() (r int, exists bool) {
-	v := m.addinput_tokens
+// AddedTotalCost returns the value that was added to the "total_cost" field in this mutation.
+func (m *UsageLogMutation) AddedTotalCost() (r float64, exists bool) {
+	v := m.addtotal_cost
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetInputTokens resets all changes to the "input_tokens" field.
-func (m *UsageLogMutation) ResetInputTokens() {
-	m.input_tokens = nil
-	m.addinput_tokens = nil
+// ResetTotalCost resets all changes to the "total_cost" field.
+func (m *UsageLogMutation) ResetTotalCost() {
+	m.total_cost = nil
+	m.addtotal_cost = nil
 }
 
-// SetOutputTokens sets the "output_tokens" field.
-func (m *UsageLogMutation) SetOutputTokens(i int) {
-	m.output_tokens = &i
-	m.addoutput_tokens = nil
+// SetActualCost sets the "actual_cost" field.
+func (m *UsageLogMutation) SetActualCost(f float64) {
+	m.actual_cost = &f
+	m.addactual_cost = nil
 }
 
-// OutputTokens returns the value of the "output_tokens" field in the mutation.
-func (m *UsageLogMutation) OutputTokens() (r int, exists bool) {
-	v := m.output_tokens
+// ActualCost returns the value of the "actual_cost" field in the mutation.
+func (m *UsageLogMutation) ActualCost() (r float64, exists bool) {
+	v := m.actual_cost
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOutputTokens returns the old "output_tokens" field's value of the UsageLog entity.
+// OldActualCost returns the old "actual_cost" field's value of the UsageLog entity.
 // If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldOutputTokens(ctx context.Context) (v int, err error) {
+func (m *UsageLogMutation) OldActualCost(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOutputTokens is only allowed on UpdateOne operations")
+		return v, errors.New("OldActualCost is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOutputTokens requires an ID field in the mutation")
+		return v, errors.New("OldActualCost requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOutputTokens: %w", err)
+		return v, fmt.Errorf("querying old value for OldActualCost: %w", err)
 	}
-	return oldValue.OutputTokens, nil
+	return oldValue.ActualCost, nil
 }
 
-// AddOutputTokens adds i to the "output_tokens" field.
-func (m *UsageLogMutation) AddOutputTokens(i int) {
-	if m.addoutput_tokens != nil {
-		*m.addoutput_tokens += i
+// AddActualCost adds f to the "actual_cost" field.
+func (m *UsageLogMutation) AddActualCost(f float64) {
+	if m.addactual_cost != nil {
+		*m.addactual_cost += f
 	} else {
-		m.addoutput_tokens = &i
+		m.addactual_cost = &f
 	}
 }
 
-// AddedOutputTokens returns the value that was added to the "output_tokens" field in this mutation.
-func (m *UsageLogMutation) AddedOutputTokens() (r int, exists bool) {
-	v := m.addoutput_tokens
+// AddedActualCost returns the value that was added to the "actual_cost" field in this mutation.
+func (m *UsageLogMutation) AddedActualCost() (r float64, exists bool) {
+	v := m.addactual_cost
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetOutputTokens resets all changes to the "output_tokens" field.
-func (m *UsageLogMutation) ResetOutputTokens() {
-	m.output_tokens = nil
-	m.addoutput_tokens = nil
+// ResetActualCost resets all changes to the "actual_cost" field.
+func (m *UsageLogMutation) ResetActualCost() {
+	m.actual_cost = nil
+	m.addactual_cost = nil
 }
 
-// SetCacheCreationTokens sets the "cache_creation_tokens" field.
-func (m *UsageLogMutation) SetCacheCreationTokens(i int) {
-	m.cache_creation_tokens = &i
-	m.addcache_creation_tokens = nil
+// SetRateMultiplier sets the "rate_multiplier" field.
+func (m *UsageLogMutation) SetRateMultiplier(f float64) {
+	m.rate_multiplier = &f
+	m.addrate_multiplier = nil
 }
 
-// CacheCreationTokens returns the value of the "cache_creation_tokens" field in the mutation.
-func (m *UsageLogMutation) CacheCreationTokens() (r int, exists bool) {
-	v := m.cache_creation_tokens
+// RateMultiplier returns the value of the "rate_multiplier" field in the mutation.
+func (m *UsageLogMutation) RateMultiplier() (r float64, exists bool) {
+	v := m.rate_multiplier
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCacheCreationTokens returns the old "cache_creation_tokens" field's value of the UsageLog entity.
+// OldRateMultiplier returns the old "rate_multiplier" field's value of the UsageLog entity.
 // If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldCacheCreationTokens(ctx context.Context) (v int, err error) {
+func (m *UsageLogMutation) OldRateMultiplier(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCacheCreationTokens is only allowed on UpdateOne operations")
+		return v, errors.New("OldRateMultiplier is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCacheCreationTokens requires an ID field in the mutation")
+		return v, errors.New("OldRateMultiplier requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCacheCreationTokens: %w", err)
+		return v, fmt.Errorf("querying old value for OldRateMultiplier: %w", err)
 	}
-	return oldValue.CacheCreationTokens, nil
+	return oldValue.RateMultiplier, nil
 }
 
-// AddCacheCreationTokens adds i to the "cache_creation_tokens" field.
-func (m *UsageLogMutation) AddCacheCreationTokens(i int) {
-	if m.addcache_creation_tokens != nil {
-		*m.addcache_creation_tokens += i
+// AddRateMultiplier adds f to the "rate_multiplier" field.
+func (m *UsageLogMutation) AddRateMultiplier(f float64) {
+	if m.addrate_multiplier != nil {
+		*m.addrate_multiplier += f
 	} else {
-		m.addcache_creation_tokens = &i
+		m.addrate_multiplier = &f
 	}
 }
 
-// AddedCacheCreationTokens returns the value that was added to the "cache_creation_tokens" field in this mutation.
-func (m *UsageLogMutation) AddedCacheCreationTokens() (r int, exists bool) {
-	v := m.addcache_creation_tokens
+// AddedRateMultiplier returns the value that was added to the "rate_multiplier" field in this mutation.
+func (m *UsageLogMutation) AddedRateMultiplier() (r float64, exists bool) {
+	v := m.addrate_multiplier
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetCacheCreationTokens resets all changes to the "cache_creation_tokens" field.
-func (m *UsageLogMutation) ResetCacheCreationTokens() {
-	m.cache_creation_tokens = nil
-	m.addcache_creation_tokens = nil
+// ResetRateMultiplier resets all changes to the "rate_multiplier" field.
+func (m *UsageLogMutation) ResetRateMultiplier() {
+	m.rate_multiplier = nil
+	m.addrate_multiplier = nil
 }
 
-// SetCacheReadTokens sets the "cache_read_tokens" field.
-func (m *UsageLogMutation) SetCacheReadTokens(i int) {
-	m.cache_read_tokens = &i
-	m.addcache_read_tokens = nil
+// SetPricingVersion sets the "pricing_version" field.
+func (m *UsageLogMutation) SetPricingVersion(s string) {
+	m.pricing_version = &s
 }
 
-// CacheReadTokens returns the value of the "cache_read_tokens" field in the mutation.
-func (m *UsageLogMutation) CacheReadTokens() (r int, exists bool) {
-	v := m.cache_read_tokens
+// PricingVersion returns the value of the "pricing_version" field in the mutation.
+func (m *UsageLogMutation) PricingVersion() (r string, exists bool) {
+	v := m.pricing_version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCacheReadTokens returns the old "cache_read_tokens" field's value of the UsageLog entity.
+// OldPricingVersion returns the old "pricing_version" field's value of the UsageLog entity.
 // If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldCacheReadTokens(ctx context.Context) (v int, err error) {
+func (m *UsageLogMutation) OldPricingVersion(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCacheReadTokens is only allowed on UpdateOne operations")
+		return v, errors.New("OldPricingVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCacheReadTokens requires an ID field in the mutation")
+		return v, errors.New("OldPricingVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCacheReadTokens: %w", err)
+		return v, fmt.Errorf("querying old value for OldPricingVersion: %w", err)
 	}
-	return oldValue.CacheReadTokens, nil
+	return oldValue.PricingVersion, nil
 }
 
-// AddCacheReadTokens adds i to the "cache_read_tokens" field.
-func (m *UsageLogMutation) AddCacheReadTokens(i int) {
-	if m.addcache_read_tokens != nil {
-		*m.addcache_read_tokens += i
-	} else {
-		m.addcache_read_tokens = &i
-	}
+// ClearPricingVersion clears the value of the "pricing_version" field.
+func (m *UsageLogMutation) ClearPricingVersion() {
+	m.pricing_version = nil
+	m.clearedFields[usagelog.FieldPricingVersion] = struct{}{}
 }
 
-// AddedCacheReadTokens returns the value that was added to the "cache_read_tokens" field in this mutation.
-func (m *UsageLogMutation) AddedCacheReadTokens() (r int, exists bool) {
-	v := m.addcache_read_tokens
-	if v == nil {
-		return
-	}
-	return *v, true
+// PricingVersionCleared returns if the "pricing_version" field was cleared in this mutation.
+func (m *UsageLogMutation) PricingVersionCleared() bool {
+	_, ok := m.clearedFields[usagelog.FieldPricingVersion]
+	return ok
 }
 
-// ResetCacheReadTokens resets all changes to the "cache_read_tokens" field.
-func (m *UsageLogMutation) ResetCacheReadTokens() {
-	m.cache_read_tokens = nil
-	m.addcache_read_tokens = nil
+// ResetPricingVersion resets all changes to the "pricing_version" field.
+func (m *UsageLogMutation) ResetPricingVersion() {
+	m.pricing_version = nil
+	delete(m.clearedFields, usagelog.FieldPricingVersion)
 }
 
-// SetCacheCreation5mTokens sets the "cache_creation_5m_tokens" field.
-func (m *UsageLogMutation) SetCacheCreation5mTokens(i int) {
-	m.cache_creation_5m_tokens = &i
-	m.addcache_creation_5m_tokens = nil
+// SetInputUnitPrice sets the "input_unit_price" field.
+func (m *UsageLogMutation) SetInputUnitPrice(f float64) {
+	m.input_unit_price = &f
+	m.addinput_unit_price = nil
 }
 
-// CacheCreation5mTokens returns the value of the "cache_creation_5m_tokens" field in the mutation.
-func (m *UsageLogMutation) CacheCreation5mTokens() (r int, exists bool) {
-	v := m.cache_creation_5m_tokens
+// InputUnitPrice returns the value of the "input_unit_price" field in the mutation.
+func (m *UsageLogMutation) InputUnitPrice() (r float64, exists bool) {
+	v := m.input_unit_price
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCacheCreation5mTokens returns the old "cache_creation_5m_tokens" field's value of the UsageLog entity.
+// OldInputUnitPrice returns the old "input_unit_price" field's value of the UsageLog entity.
 // If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldCacheCreation5mTokens(ctx context.Context) (v int, err error) {
+func (m *UsageLogMutation) OldInputUnitPrice(ctx context.Context) (v *float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCacheCreation5mTokens is only allowed on UpdateOne operations")
+		return v, errors.New("OldInputUnitPrice is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCacheCreation5mTokens requires an ID field in the mutation")
+		return v, errors.New("OldInputUnitPrice requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCacheCreation5mTokens: %w", err)
+		return v, fmt.Errorf("querying old value for OldInputUnitPrice: %w", err)
 	}
-	return oldValue.CacheCreation5mTokens, nil
+	return oldValue.InputUnitPrice, nil
 }
 
-// AddCacheCreation5mTokens adds i to the "cache_creation_5m_tokens" field.
-func (m *UsageLogMutation) AddCacheCreation5mTokens(i int) {
-	if m.addcache_creation_5m_tokens != nil {
-		*m.addcache_creation_5m_tokens += i
+// AddInputUnitPrice adds f to the "input_unit_price" field.
+func (m *UsageLogMutation) AddInputUnitPrice(f float64) {
+	if m.addinput_unit_price != nil {
+		*m.addinput_unit_price += f
 	} else {
-		m.addcache_creation_5m_tokens = &i
+		m.addinput_unit_price = &f
 	}
 }
 
-// AddedCacheCreation5mTokens returns the value that was added to the "cache_creation_5m_tokens" field in this mutation.
-func (m *UsageLogMutation) AddedCacheCreation5mTokens() (r int, exists bool) {
-	v := m.addcache_creation_5m_tokens
+// AddedInputUnitPrice returns the value that was added to the "input_unit_price" field in this mutation.
+func (m *UsageLogMutation) AddedInputUnitPrice() (r float64, exists bool) {
+	v := m.addinput_unit_price
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetCacheCreation5mTokens resets all changes to the "cache_creation_5m_tokens" field.
-func (m *UsageLogMutation) ResetCacheCreation5mTokens() {
-	m.cache_creation_5m_tokens = nil
-	m.addcache_creation_5m_tokens = nil
+// ClearInputUnitPrice clears the value of the "input_unit_price" field.
+func (m *UsageLogMutation) ClearInputUnitPrice() {
+	m.input_unit_price = nil
+	m.addinput_unit_price = nil
+	m.clearedFields[usagelog.FieldInputUnitPrice] = struct{}{}
 }
 
-// SetCacheCreation1hTokens sets the "cache_creation_1h_tokens" field.
-func (m *UsageLogMutation) SetCacheCreation1hTokens(i int) {
-	m.cache_creation_1h_tokens = &i
-	m.addcache_creation_1h_tokens = nil
+// InputUnitPriceCleared returns if the "input_unit_price" field was cleared in this mutation.
+func (m *UsageLogMutation) InputUnitPriceCleared() bool {
+	_, ok := m.clearedFields[usagelog.FieldInputUnitPrice]
+	return ok
 }
 
-// CacheCreation1hTokens returns the value of the "cache_creation_1h_tokens" field in the mutation.
-func (m *UsageLogMutation) CacheCreation1hTokens() (r int, exists bool) {
-	v := m.cache_creation_1h_tokens
+// ResetInputUnitPrice resets all changes to the "input_unit_price" field.
+func (m *UsageLogMutation) ResetInputUnitPrice() {
+	m.input_unit_price = nil
+	m.addinput_unit_price = nil
+	delete(m.clearedFields, usagelog.FieldInputUnitPrice)
+}
+
+// SetOutputUnitPrice sets the "output_unit_price" field.
+func (m *UsageLogMutation) SetOutputUnitPrice(f float64) {
+	m.output_unit_price = &f
+	m.addoutput_unit_price = nil
+}
+
+// OutputUnitPrice returns the value of the "output_unit_price" field in the mutation.
+func (m *UsageLogMutation) OutputUnitPrice() (r float64, exists bool) {
+	v := m.output_unit_price
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCacheCreation1hTokens returns the old "cache_creation_1h_tokens" field's value of the UsageLog entity.
+// OldOutputUnitPrice returns the old "output_unit_price" field's value of the UsageLog entity.
 // If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldCacheCreation1hTokens(ctx context.Context) (v int, err error) {
+func (m *UsageLogMutation) OldOutputUnitPrice(ctx context.Context) (v *float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCacheCreation1hTokens is only allowed on UpdateOne operations")
+		return v, errors.New("OldOutputUnitPrice is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCacheCreation1hTokens requires an ID field in the mutation")
+		return v, errors.New("OldOutputUnitPrice requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCacheCreation1hTokens: %w", err)
+		return v, fmt.Errorf("querying old value for OldOutputUnitPrice: %w", err)
 	}
-	return oldValue.CacheCreation1hTokens, nil
+	return oldValue.OutputUnitPrice, nil
 }
 
-// AddCacheCreation1hTokens adds i to the "cache_creation_1h_tokens" field.
-func (m *UsageLogMutation) AddCacheCreation1hTokens(i int) {
-	if m.addcache_creation_1h_tokens != nil {
-		*m.addcache_creation_1h_tokens += i
+// AddOutputUnitPrice adds f to the "output_unit_price" field.
+func (m *UsageLogMutation) AddOutputUnitPrice(f float64) {
+	if m.addoutput_unit_price != nil {
+		*m.addoutput_unit_price += f
 	} else {
-		m.addcache_creation_1h_tokens = &i
+		m.addoutput_unit_price = &f
 	}
 }
 
-// AddedCacheCreation1hTokens returns the value that was added to the "cache_creation_1h_tokens" field in this mutation.
-func (m *UsageLogMutation) AddedCacheCreation1hTokens() (r int, exists bool) {
-	v := m.addcache_creation_1h_tokens
+// AddedOutputUnitPrice returns the value that was added to the "output_unit_price" field in this mutation.
+func (m *UsageLogMutation) AddedOutputUnitPrice() (r float64, exists bool) {
+	v := m.addoutput_unit_price
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetCacheCreation1hTokens resets all changes to the "cache_creation_1h_tokens" field.
-func (m *UsageLogMutation) ResetCacheCreation1hTokens() {
-	m.cache_creation_1h_tokens = nil
-	m.addcache_creation_1h_tokens = nil
+// ClearOutputUnitPrice clears the value of the "output_unit_price" field.
+func (m *UsageLogMutation) ClearOutputUnitPrice() {
+	m.output_unit_price = nil
+	m.addoutput_unit_price = nil
+	m.clearedFields[usagelog.FieldOutputUnitPrice] = struct{}{}
 }
 
-// SetInputCost sets the "input_cost" field.
-func (m *UsageLogMutation) SetInputCost(f float64) {
-	m.input_cost = &f
-	m.addinput_cost = nil
+// OutputUnitPriceCleared returns if the "output_unit_price" field was cleared in this mutation.
+func (m *UsageLogMutation) OutputUnitPriceCleared() bool {
+	_, ok := m.clearedFields[usagelog.FieldOutputUnitPrice]
+	return ok
 }
 
-// InputCost returns the value of the "input_cost" field in the mutation.
-func (m *UsageLogMutation) InputCost() (r float64, exists bool) {
-	v := m.input_cost
+// ResetOutputUnitPrice resets all changes to the "output_unit_price" field.
+func (m *UsageLogMutation) ResetOutputUnitPrice() {
+	m.output_unit_price = nil
+	m.addoutput_unit_price = nil
+	delete(m.clearedFields, usagelog.FieldOutputUnitPrice)
+}
+
+// SetCacheCreationUnitPrice sets the "cache_creation_unit_price" field.
+func (m *UsageLogMutation) SetCacheCreationUnitPrice(f float64) {
+	m.cache_creation_unit_price = &f
+	m.addcache_creation_unit_price = nil
+}
+
+// CacheCreationUnitPrice returns the value of the "cache_creation_unit_price" field in the mutation.
+func (m *UsageLogMutation) CacheCreationUnitPrice() (r float64, exists bool) {
+	v := m.cache_creation_unit_price
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInputCost returns the old "input_cost" field's value of the UsageLog entity.
+// OldCacheCreationUnitPrice returns the old "cache_creation_unit_price" field's value of the UsageLog entity.
 // If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldInputCost(ctx context.Context) (v float64, err error) {
+func (m *UsageLogMutation) OldCacheCreationUnitPrice(ctx context.Context) (v *float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInputCost is only allowed on UpdateOne operations")
+		return v, errors.New("OldCacheCreationUnitPrice is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInputCost requires an ID field in the mutation")
+		return v, errors.New("OldCacheCreationUnitPrice requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInputCost: %w", err)
+		return v, fmt.Errorf("querying old value for OldCacheCreationUnitPrice: %w", err)
 	}
-	return oldValue.InputCost, nil
+	return oldValue.CacheCreationUnitPrice, nil
 }
 
-// AddInputCost adds f to the "input_cost" field.
-func (m *UsageLogMutation) AddInputCost(f float64) {
-	if m.addinput_cost != nil {
-		*m.addinput_cost += f
+// AddCacheCreationUnitPrice adds f to the "cache_creation_unit_price" field.
+func (m *UsageLogMutation) AddCacheCreationUnitPrice(f float64) {
+	if m.addcache_creation_unit_price != nil {
+		*m.addcache_creation_unit_price += f
 	} else {
-		m.addinput_cost = &f
+		m.addcache_creation_unit_price = &f
 	}
 }
 
-// AddedInputCost returns the value that was added to the "input_cost" field in this mutation.
-func (m *UsageLogMutation) AddedInputCost() (r float64, exists bool) {
-	v := m.addinput_cost
+// AddedCacheCreationUnitPrice returns the value that was added to the "cache_creation_unit_price" field in this mutation.
+func (m *UsageLogMutation) AddedCacheCreationUnitPrice() (r float64, exists bool) {
+	v := m.addcache_creation_unit_price
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetInputCost resets all changes to the "input_cost" field.
-func (m *UsageLogMutation) ResetInputCost() {
-	m.input_cost = nil
-	m.addinput_cost = nil
+// ClearCacheCreationUnitPrice clears the value of the "cache_creation_unit_price" field.
+func (m *UsageLogMutation) ClearCacheCreationUnitPrice() {
+	m.cache_creation_unit_price = nil
+	m.addcache_creation_unit_price = nil
+	m.clearedFields[usagelog.FieldCacheCreationUnitPrice] = struct{}{}
 }
 
-// SetOutputCost sets the "output_cost" field.
-func (m *UsageLogMutation) SetOutputCost(f float64) {
-	m.output_cost = &f
-	m.addoutput_cost = nil
+// CacheCreationUnitPriceCleared returns if the "cache_creation_unit_price" field was cleared in this mutation.
+func (m *UsageLogMutation) CacheCreationUnitPriceCleared() bool {
+	_, ok := m.clearedFields[usagelog.FieldCacheCreationUnitPrice]
+	return ok
 }
 
-// OutputCost returns the value of the "output_cost" field in the mutation.
-func (m *UsageLogMutation) OutputCost() (r float64, exists bool) {
-	v := m.output_cost
+// ResetCacheCreationUnitPrice resets all changes to the "cache_creation_unit_price" field.
+func (m *UsageLogMutation) ResetCacheCreationUnitPrice() {
+	m.cache_creation_unit_price = nil
+	m.addcache_creation_unit_price = nil
+	delete(m.clearedFields, usagelog.FieldCacheCreationUnitPrice)
+}
+
+// SetCacheReadUnitPrice sets the "cache_read_unit_price" field.
+func (m *UsageLogMutation) SetCacheReadUnitPrice(f float64) {
+	m.cache_read_unit_price = &f
+	m.addcache_read_unit_price = nil
+}
+
+// CacheReadUnitPrice returns the value of the "cache_read_unit_price" field in the mutation.
+func (m *UsageLogMutation) CacheReadUnitPrice() (r float64, exists bool) {
+	v := m.cache_read_unit_price
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOutputCost returns the old "output_cost" field's value of the UsageLog entity.
+// OldCacheReadUnitPrice returns the old "cache_read_unit_price" field's value of the UsageLog entity.
 // If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldOutputCost(ctx context.Context) (v float64, err error) {
+func (m *UsageLogMutation) OldCacheReadUnitPrice(ctx context.Context) (v *float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOutputCost is only allowed on UpdateOne operations")
+		return v, errors.New("OldCacheReadUnitPrice is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOutputCost requires an ID field in the mutation")
+		return v, errors.New("OldCacheReadUnitPrice requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOutputCost: %w", err)
+		return v, fmt.Errorf("querying old value for OldCacheReadUnitPrice: %w", err)
 	}
-	return oldValue.OutputCost, nil
+	return oldValue.CacheReadUnitPrice, nil
 }
 
-// AddOutputCost adds f to the "output_cost" field.
-func (m *UsageLogMutation) AddOutputCost(f float64) {
-	if m.addoutput_cost != nil {
-		*m.addoutput_cost += f
+// AddCacheReadUnitPrice adds f to the "cache_read_unit_price" field.
+func (m *UsageLogMutation) AddCacheReadUnitPrice(f float64) {
+	if m.addcache_read_unit_price != nil {
+		*m.addcache_read_unit_price += f
 	} else {
-		m.addoutput_cost = &f
+		m.addcache_read_unit_price = &f
 	}
 }
 
-// AddedOutputCost returns the value that was added to the "output_cost" field in this mutation.
-func (m *UsageLogMutation) AddedOutputCost() (r float64, exists bool) {
-	v := m.addoutput_cost
+// AddedCacheReadUnitPrice returns the value that was added to the "cache_read_unit_price" field in this mutation.
+func (m *UsageLogMutation) AddedCacheReadUnitPrice() (r float64, exists bool) {
+	v := m.addcache_read_unit_price
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetOutputCost resets all changes to the "output_cost" field.
-func (m *UsageLogMutation) ResetOutputCost() {
-	m.output_cost = nil
-	m.addoutput_cost = nil
+// ClearCacheReadUnitPrice clears the value of the "cache_read_unit_price" field.
+func (m *UsageLogMutation) ClearCacheReadUnitPrice() {
+	m.cache_read_unit_price = nil
+	m.addcache_read_unit_price = nil
+	m.clearedFields[usagelog.FieldCacheReadUnitPrice] = struct{}{}
 }
 
-// SetCacheCreationCost sets the "cache_creation_cost" field.
-func (m *UsageLogMutation) SetCacheCreationCost(f float64) {
-	m.cache_creation_cost = &f
-	m.addcache_creation_cost = nil
+// CacheReadUnitPriceCleared returns if the "cache_read_unit_price" field was cleared in this mutation.
+func (m *UsageLogMutation) CacheReadUnitPriceCleared() bool {
+	_, ok := m.clearedFields[usagelog.FieldCacheReadUnitPrice]
+	return ok
 }
 
-// CacheCreationCost returns the value of the "cache_creation_cost" field in the mutation.
-func (m *UsageLogMutation) CacheCreationCost() (r float64, exists bool) {
-	v := m.cache_creation_cost
+// ResetCacheReadUnitPrice resets all changes to the "cache_read_unit_price" field.
+func (m *UsageLogMutation) ResetCacheReadUnitPrice() {
+	m.cache_read_unit_price = nil
+	m.addcache_read_unit_price = nil
+	delete(m.clearedFields, usagelog.FieldCacheReadUnitPrice)
+}
+
+// SetImageUnitPrice sets the "image_unit_price" field.
+func (m *UsageLogMutation) SetImageUnitPrice(f float64) {
+	m.image_unit_price = &f
+	m.addimage_unit_price = nil
+}
+
+// ImageUnitPrice returns the value of the "image_unit_price" field in the mutation.
+func (m *UsageLogMutation) ImageUnitPrice() (r float64, exists bool) {
+	v := m.image_unit_price
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCacheCreationCost returns the old "cache_creation_cost" field's value of the UsageLog entity.
+// OldImageUnitPrice returns the old "image_unit_price" field's value of the UsageLog entity.
 // If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldCacheCreationCost(ctx context.Context) (v float64, err error) {
+func (m *UsageLogMutation) OldImageUnitPrice(ctx context.Context) (v *float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCacheCreationCost is only allowed on UpdateOne operations")
+		return v, errors.New("OldImageUnitPrice is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCacheCreationCost requires an ID field in the mutation")
+		return v, errors.New("OldImageUnitPrice requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCacheCreationCost: %w", err)
+		return v, fmt.Errorf("querying old value for OldImageUnitPrice: %w", err)
 	}
-	return oldValue.CacheCreationCost, nil
+	return oldValue.ImageUnitPrice, nil
 }
 
-// AddCacheCreationCost adds f to the "cache_creation_cost" field.
-func (m *UsageLogMutation) AddCacheCreationCost(f float64) {
-	if m.addcache_creation_cost != nil {
-		*m.addcache_creation_cost += f
+// AddImageUnitPrice adds f to the "image_unit_price" field.
+func (m *UsageLogMutation) AddImageUnitPrice(f float64) {
+	if m.addimage_unit_price != nil {
+		*m.addimage_unit_price += f
 	} else {
-		m.addcache_creation_cost = &f
+		m.addimage_unit_price = &f
 	}
 }
 
-// AddedCacheCreationCost returns the value that was added to the "cache_creation_cost" field in this mutation.
-func (m *UsageLogMutation) AddedCacheCreationCost() (r float64, exists bool) {
-	v := m.addcache_creation_cost
+// AddedImageUnitPrice returns the value that was added to the "image_unit_price" field in this mutation.
+func (m *UsageLogMutation) AddedImageUnitPrice() (r float64, exists bool) {
+	v := m.addimage_unit_price
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetCacheCreationCost resets all changes to the "cache_creation_cost" field.
-func (m *UsageLogMutation) ResetCacheCreationCost() {
-	m.cache_creation_cost = nil
-	m.addcache_creation_cost = nil
+// ClearImageUnitPrice clears the value of the "image_unit_price" field.
+func (m *UsageLogMutation) ClearImageUnitPrice() {
+	m.image_unit_price = nil
+	m.addimage_unit_price = nil
+	m.clearedFields[usagelog.FieldImageUnitPrice] = struct{}{}
 }
 
-// SetCacheReadCost sets the "cache_read_cost" field.
-func (m *UsageLogMutation) SetCacheReadCost(f float64) {
-	m.cache_read_cost = &f
-	m.addcache_read_cost = nil
+// ImageUnitPriceCleared returns if the "image_unit_price" field was cleared in this mutation.
+func (m *UsageLogMutation) ImageUnitPriceCleared() bool {
+	_, ok := m.clearedFields[usagelog.FieldImageUnitPrice]
+	return ok
 }
 
-// CacheReadCost returns the value of the "cache_read_cost" field in the mutation.
-func (m *UsageLogMutation) CacheReadCost() (r float64, exists bool) {
-	v := m.cache_read_cost
+// ResetImageUnitPrice resets all changes to the "image_unit_price" field.
+func (m *UsageLogMutation) ResetImageUnitPrice() {
+	m.image_unit_price = nil
+	m.addimage_unit_price = nil
+	delete(m.clearedFields, usagelog.FieldImageUnitPrice)
+}
+
+// SetVolumeTierMinSpend sets the "volume_tier_min_spend" field.
+func (m *UsageLogMutation) SetVolumeTierMinSpend(f float64) {
+	m.volume_tier_min_spend = &f
+	m.addvolume_tier_min_spend = nil
+}
+
+// VolumeTierMinSpend returns the value of the "volume_tier_min_spend" field in the mutation.
+func (m *UsageLogMutation) VolumeTierMinSpend() (r float64, exists bool) {
+	v := m.volume_tier_min_spend
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCacheReadCost returns the old "cache_read_cost" field's value of the UsageLog entity.
+// OldVolumeTierMinSpend returns the old "volume_tier_min_spend" field's value of the UsageLog entity.
 // If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldCacheReadCost(ctx context.Context) (v float64, err error) {
+func (m *UsageLogMutation) OldVolumeTierMinSpend(ctx context.Context) (v *float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCacheReadCost is only allowed on UpdateOne operations")
+		return v, errors.New("OldVolumeTierMinSpend is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCacheReadCost requires an ID field in the mutation")
+		return v, errors.New("OldVolumeTierMinSpend requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCacheReadCost: %w", err)
+		return v, fmt.Errorf("querying old value for OldVolumeTierMinSpend: %w", err)
 	}
-	return oldValue.CacheReadCost, nil
+	return oldValue.VolumeTierMinSpend, nil
 }
 
-// AddCacheReadCost adds f to the "cache_read_cost" field.
-func (m *UsageLogMutation) AddCacheReadCost(f float64) {
-	if m.addcache_read_cost != nil {
-		*m.addcache_read_cost += f
+// AddVolumeTierMinSpend adds f to the "volume_tier_min_spend" field.
+func (m *UsageLogMutation) AddVolumeTierMinSpend(f float64) {
+	if m.addvolume_tier_min_spend != nil {
+		*m.addvolume_tier_min_spend += f
 	} else {
-		m.addcache_read_cost = &f
+		m.addvolume_tier_min_spend = &f
 	}
 }
 
-// AddedCacheReadCost returns the value that was added to the "cache_read_cost" field in this mutation.
-func (m *UsageLogMutation) AddedCacheReadCost() (r float64, exists bool) {
-	v := m.addcache_read_cost
+// AddedVolumeTierMinSpend returns the value that was added to the "volume_tier_min_spend" field in this mutation.
+func (m *UsageLogMutation) AddedVolumeTierMinSpend() (r float64, exists bool) {
+	v := m.addvolume_tier_min_spend
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetCacheReadCost resets all changes to the "cache_read_cost" field.
-func (m *UsageLogMutation) ResetCacheReadCost() {
-	m.cache_read_cost = nil
-	m.addcache_read_cost = nil
+// ClearVolumeTierMinSpend clears the value of the "volume_tier_min_spend" field.
+func (m *UsageLogMutation) ClearVolumeTierMinSpend() {
+	m.volume_tier_min_spend = nil
+	m.addvolume_tier_min_spend = nil
+	m.clearedFields[usagelog.FieldVolumeTierMinSpend] = struct{}{}
 }
 
-// SetTotalCost sets the "total_cost" field.
-func (m *UsageLogMutation) SetTotalCost(f float64) {
-	m.total_cost = &f
-	m.addtotal_cost = nil
+// VolumeTierMinSpendCleared returns if the "volume_tier_min_spend" field was cleared in this mutation.
+func (m *UsageLogMutation) VolumeTierMinSpendCleared() bool {
+	_, ok := m.clearedFields[usagelog.FieldVolumeTierMinSpend]
+	return ok
 }
 
-// TotalCost returns the value of the "total_cost" field in the mutation.
-func (m *UsageLogMutation) TotalCost() (r float64, exists bool) {
-	v := m.total_cost
+// ResetVolumeTierMinSpend resets all changes to the "volume_tier_min_spend" field.
+func (m *UsageLogMutation) ResetVolumeTierMinSpend() {
+	m.volume_tier_min_spend = nil
+	m.addvolume_tier_min_spend = nil
+	delete(m.clearedFields, usagelog.FieldVolumeTierMinSpend)
+}
+
+// SetVolumeTierMultiplier sets the "volume_tier_multiplier" field.
+func (m *UsageLogMutation) SetVolumeTierMultiplier(f float64) {
+	m.volume_tier_multiplier = &f
+	m.addvolume_tier_multiplier = nil
+}
+
+// VolumeTierMultiplier returns the value of the "volume_tier_multiplier" field in the mutation.
+func (m *UsageLogMutation) VolumeTierMultiplier() (r float64, exists bool) {
+	v := m.volume_tier_multiplier
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTotalCost returns the old "total_cost" field's value of the UsageLog entity.
+// OldVolumeTierMultiplier returns the old "volume_tier_multiplier" field's value of the UsageLog entity.
 // If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldTotalCost(ctx context.Context) (v float64, err error) {
+func (m *UsageLogMutation) OldVolumeTierMultiplier(ctx context.Context) (v *float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotalCost is only allowed on UpdateOne operations")
+		return v, errors.New("OldVolumeTierMultiplier is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotalCost requires an ID field in the mutation")
+		return v, errors.New("OldVolumeTierMultiplier requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotalCost: %w", err)
+		return v, fmt.Errorf("querying old value for OldVolumeTierMultiplier: %w", err)
 	}
-	return oldValue.TotalCost, nil
+	return oldValue.VolumeTierMultiplier, nil
 }
 
-// AddTotalCost adds f to the "total_cost" field.
-func (m *UsageLogMutation) AddTotalCost(f float64) {
-	if m.addtotal_cost != nil {
-		*m.addtotal_cost += f
+// AddVolumeTierMultiplier adds f to the "volume_tier_multiplier" field.
+func (m *UsageLogMutation) AddVolumeTierMultiplier(f float64) {
+	if m.addvolume_tier_multiplier != nil {
+		*m.addvolume_tier_multiplier += f
 	} else {
-		m.addtotal_cost = &f
+		m.addvolume_tier_multiplier = &f
 	}
 }
 
-// AddedTotalCost returns the value that was added to the "total_cost" field in this mutation.
-func (m *UsageLogMutation) AddedTotalCost() (r float64, exists bool) {
-	v := m.addtotal_cost
+// AddedVolumeTierMultiplier returns the value that was added to the "volume_tier_multiplier" field in this mutation.
+func (m *UsageLogMutation) AddedVolumeTierMultiplier() (r float64, exists bool) {
+	v := m.addvolume_tier_multiplier
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetTotalCost resets all changes to the "total_cost" field.
-func (m *UsageLogMutation) ResetTotalCost() {
-	m.total_cost = nil
-	m.addtotal_cost = nil
+// ClearVolumeTierMultiplier clears the value of the "volume_tier_multiplier" field.
+func (m *UsageLogMutation) ClearVolumeTierMultiplier() {
+	m.volume_tier_multiplier = nil
+	m.addvolume_tier_multiplier = nil
+	m.clearedFields[usagelog.FieldVolumeTierMultiplier] = struct{}{}
 }
 
-// SetActualCost sets the "actual_cost" field.
-func (m *UsageLogMutation) SetActualCost(f float64) {
-	m.actual_cost = &f
-	m.addactual_cost = nil
+// VolumeTierMultiplierCleared returns if the "volume_tier_multiplier" field was cleared in this mutation.
+func (m *UsageLogMutation) VolumeTierMultiplierCleared() bool {
+	_, ok := m.clearedFields[usagelog.FieldVolumeTierMultiplier]
+	return ok
 }
 
-// ActualCost returns the value of the "actual_cost" field in the mutation.
-func (m *UsageLogMutation) ActualCost() (r float64, exists bool) {
-	v := m.actual_cost
+// ResetVolumeTierMultiplier resets all changes to the "volume_tier_multiplier" field.
+func (m *UsageLogMutation) ResetVolumeTierMultiplier() {
+	m.volume_tier_multiplier = nil
+	m.addvolume_tier_multiplier = nil
+	delete(m.clearedFields, usagelog.FieldVolumeTierMultiplier)
+}
+
+// SetSubscriptionCost sets the "subscription_cost" field.
+func (m *UsageLogMutation) SetSubscriptionCost(f float64) {
+	m.subscription_cost = &f
+	m.addsubscription_cost = nil
+}
+
+// SubscriptionCost returns the value of the "subscription_cost" field in the mutation.
+func (m *UsageLogMutation) SubscriptionCost() (r float64, exists bool) {
+	v := m.subscription_cost
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActualCost returns the old "actual_cost" field's value of the UsageLog entity.
+// OldSubscriptionCost returns the old "subscription_cost" field's value of the UsageLog entity.
 // If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldActualCost(ctx context.Context) (v float64, err error) {
+func (m *UsageLogMutation) OldSubscriptionCost(ctx context.Context) (v *float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActualCost is only allowed on UpdateOne operations")
+		return v, errors.New("OldSubscriptionCost is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActualCost requires an ID field in the mutation")
+		return v, errors.New("OldSubscriptionCost requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActualCost: %w", err)
+		return v, fmt.Errorf("querying old value for OldSubscriptionCost: %w", err)
 	}
-	return oldValue.ActualCost, nil
+	return oldValue.SubscriptionCost, nil
 }
 
-// AddActualCost adds f to the "actual_cost" field.
-func (m *UsageLogMutation) AddActualCost(f float64) {
-	if m.addactual_cost != nil {
-		*m.addactual_cost += f
+// AddSubscriptionCost adds f to the "subscription_cost" field.
+func (m *UsageLogMutation) AddSubscriptionCost(f float64) {
+	if m.addsubscription_cost != nil {
+		*m.addsubscription_cost += f
 	} else {
-		m.addactual_cost = &f
+		m.addsubscription_cost = &f
 	}
 }
 
-// AddedActualCost returns the value that was added to the "actual_cost" field in this mutation.
-func (m *UsageLogMutation) AddedActualCost() (r float64, exists bool) {
-	v := m.addactual_cost
+// AddedSubscriptionCost returns the value that was added to the "subscription_cost" field in this mutation.
+func (m *UsageLogMutation) AddedSubscriptionCost() (r float64, exists bool) {
+	v := m.addsubscription_cost
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetActualCost resets all changes to the "actual_cost" field.
-func (m *UsageLogMutation) ResetActualCost() {
-	m.actual_cost = nil
-	m.addactual_cost = nil
+// ClearSubscriptionCost clears the value of the "subscription_cost" field.
+func (m *UsageLogMutation) ClearSubscriptionCost() {
+	m.subscription_cost = nil
+	m.addsubscription_cost = nil
+	m.clearedFields[usagelog.FieldSubscriptionCost] = struct{}{}
 }
 
-// SetRateMultiplier sets the "rate_multiplier" field.
-func (m *UsageLogMutation) SetRateMultiplier(f float64) {
-	m.rate_multiplier = &f
-	m.addrate_multiplier = nil
+// SubscriptionCostCleared returns if the "subscription_cost" field was cleared in this mutation.
+func (m *UsageLogMutation) SubscriptionCostCleared() bool {
+	_, ok := m.clearedFields[usagelog.FieldSubscriptionCost]
+	return ok
 }
 
-// RateMultiplier returns the value of the "rate_multiplier" field in the mutation.
-func (m *UsageLogMutation) RateMultiplier() (r float64, exists bool) {
-	v := m.rate_multiplier
+// ResetSubscriptionCost resets all changes to the "subscription_cost" field.
+func (m *UsageLogMutation) ResetSubscriptionCost() {
+	m.subscription_cost = nil
+	m.addsubscription_cost = nil
+	delete(m.clearedFields, usagelog.FieldSubscriptionCost)
+}
+
+// SetOverageCost sets the "overage_cost" field.
+func (m *UsageLogMutation) SetOverageCost(f float64) {
+	m.overage_cost = &f
+	m.addoverage_cost = nil
+}
+
+// OverageCost returns the value of the "overage_cost" field in the mutation.
+func (m *UsageLogMutation) OverageCost() (r float64, exists bool) {
+	v := m.overage_cost
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRateMultiplier returns the old "rate_multiplier" field's value of the UsageLog entity.
+// OldOverageCost returns the old "overage_cost" field's value of the UsageLog entity.
 // If the UsageLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsageLogMutation) OldRateMultiplier(ctx context.Context) (v float64, err error) {
+func (m *UsageLogMutation) OldOverageCost(ctx context.Context) (v *float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRateMultiplier is only allowed on UpdateOne operations")
+		return v, errors.New("OldOverageCost is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRateMultiplier requires an ID field in the mutation")
+		return v, errors.New("OldOverageCost requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRateMultiplier: %w", err)
+		return v, fmt.Errorf("querying old value for OldOverageCost: %w", err)
 	}
-	return oldValue.RateMultiplier, nil
+	return oldValue.OverageCost, nil
 }
 
-// AddRateMultiplier adds f to the "rate_multiplier" field.
-func (m *UsageLogMutation) AddRateMultiplier(f float64) {
-	if m.addrate_multiplier != nil {
-		*m.addrate_multiplier += f
+// AddOverageCost adds f to the "overage_cost" field.
+func (m *UsageLogMutation) AddOverageCost(f float64) {
+	if m.addoverage_cost != nil {
+		*m.addoverage_cost += f
 	} else {
-		m.addrate_multiplier = &f
+		m.addoverage_cost = &f
 	}
 }
 
-// AddedRateMultiplier returns the value that was added to the "rate_multiplier" field in this mutation.
-func (m *UsageLogMutation) AddedRateMultiplier() (r float64, exists bool) {
-	v := m.addrate_multiplier
+// AddedOverageCost returns the value that was added to the "overage_cost" field in this mutation.
+func (m *UsageLogMutation) AddedOverageCost() (r float64, exists bool) {
+	v := m.addoverage_cost
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetRateMultiplier resets all changes to the "rate_multiplier" field.
-func (m *UsageLogMutation) ResetRateMultiplier() {
-	m.rate_multiplier = nil
-	m.addrate_multiplier = nil
+// ClearOverageCost clears the value of the "overage_cost" field.
+func (m *UsageLogMutation) ClearOverageCost() {
+	m.overage_cost = nil
+	m.addoverage_cost = nil
+	m.clearedFields[usagelog.FieldOverageCost] = struct{}{}
+}
+
+// OverageCostCleared returns if the "overage_cost" field was cleared in this mutation.
+func (m *UsageLogMutation) OverageCostCleared() bool {
+	_, ok := m.clearedFields[usagelog.FieldOverageCost]
+	return ok
+}
+
+// ResetOverageCost resets all changes to the "overage_cost" field.
+func (m *UsageLogMutation) ResetOverageCost() {
+	m.overage_cost = nil
+	m.addoverage_cost = nil
+	delete(m.clearedFields, usagelog.FieldOverageCost)
 }
 
 // SetAccountRateMultiplier sets the "account_rate_multiplier" field.
@@ -13335,7 +17667,7 @@ func (m *UsageLogMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UsageLogMutation) Fields() []string {
-	fields := make([]string, 0, 30)
+	fields := make([]string, 0, 44)
 	if m.user != nil {
 		fields = append(fields, usagelog.FieldUserID)
 	}
@@ -13357,6 +17689,18 @@ func (m *UsageLogMutation) Fields() []string {
 	if m.subscription != nil {
 		fields = append(fields, usagelog.FieldSubscriptionID)
 	}
+	if m.original_group_id != nil {
+		fields = append(fields, usagelog.FieldOriginalGroupID)
+	}
+	if m.fallback_hop != nil {
+		fields = append(fields, usagelog.FieldFallbackHop)
+	}
+	if m.organization_id != nil {
+		fields = append(fields, usagelog.FieldOrganizationID)
+	}
+	if m.billing_user_id != nil {
+		fields = append(fields, usagelog.FieldBillingUserID)
+	}
 	if m.input_tokens != nil {
 		fields = append(fields, usagelog.FieldInputTokens)
 	}
@@ -13396,6 +17740,36 @@ func (m *UsageLogMutation) Fields() []string {
 	if m.rate_multiplier != nil {
 		fields = append(fields, usagelog.FieldRateMultiplier)
 	}
+	if m.pricing_version != nil {
+		fields = append(fields, usagelog.FieldPricingVersion)
+	}
+	if m.input_unit_price != nil {
+		fields = append(fields, usagelog.FieldInputUnitPrice)
+	}
+	if m.output_unit_price != nil {
+		fields = append(fields, usagelog.FieldOutputUnitPrice)
+	}
+	if m.cache_creation_unit_price != nil {
+		fields = append(fields, usagelog.FieldCacheCreationUnitPrice)
+	}
+	if m.cache_read_unit_price != nil {
+		fields = append(fields, usagelog.FieldCacheReadUnitPrice)
+	}
+	if m.image_unit_price != nil {
+		fields = append(fields, usagelog.FieldImageUnitPrice)
+	}
+	if m.volume_tier_min_spend != nil {
+		fields = append(fields, usagelog.FieldVolumeTierMinSpend)
+	}
+	if m.volume_tier_multiplier != nil {
+		fields = append(fields, usagelog.FieldVolumeTierMultiplier)
+	}
+	if m.subscription_cost != nil {
+		fields = append(fields, usagelog.FieldSubscriptionCost)
+	}
+	if m.overage_cost != nil {
+		fields = append(fields, usagelog.FieldOverageCost)
+	}
 	if m.account_rate_multiplier != nil {
 		fields = append(fields, usagelog.FieldAccountRateMultiplier)
 	}
@@ -13448,6 +17822,14 @@ func (m *UsageLogMutation) Field(name string) (ent.Value, bool) {
 		return m.GroupID()
 	case usagelog.FieldSubscriptionID:
 		return m.SubscriptionID()
+	case usagelog.FieldOriginalGroupID:
+		return m.OriginalGroupID()
+	case usagelog.FieldFallbackHop:
+		return m.FallbackHop()
+	case usagelog.FieldOrganizationID:
+		return m.OrganizationID()
+	case usagelog.FieldBillingUserID:
+		return m.BillingUserID()
 	case usagelog.FieldInputTokens:
 		return m.InputTokens()
 	case usagelog.FieldOutputTokens:
@@ -13474,6 +17856,26 @@ func (m *UsageLogMutation) Field(name string) (ent.Value, bool) {
 		return m.ActualCost()
 	case usagelog.FieldRateMultiplier:
 		return m.RateMultiplier()
+	case usagelog.FieldPricingVersion:
+		return m.PricingVersion()
+	case usagelog.FieldInputUnitPrice:
+		return m.InputUnitPrice()
+	case usagelog.FieldOutputUnitPrice:
+		return m.OutputUnitPrice()
+	case usagelog.FieldCacheCreationUnitPrice:
+		return m.CacheCreationUnitPrice()
+	case usagelog.FieldCacheReadUnitPrice:
+		return m.CacheReadUnitPrice()
+	case usagelog.FieldImageUnitPrice:
+		return m.ImageUnitPrice()
+	case usagelog.FieldVolumeTierMinSpend:
+		return m.VolumeTierMinSpend()
+	case usagelog.FieldVolumeTierMultiplier:
+		return m.VolumeTierMultiplier()
+	case usagelog.FieldSubscriptionCost:
+		return m.SubscriptionCost()
+	case usagelog.FieldOverageCost:
+		return m.OverageCost()
 	case usagelog.FieldAccountRateMultiplier:
 		return m.AccountRateMultiplier()
 	case usagelog.FieldBillingType:
@@ -13517,6 +17919,14 @@ func (m *UsageLogMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldGroupID(ctx)
 	case usagelog.FieldSubscriptionID:
 		return m.OldSubscriptionID(ctx)
+	case usagelog.FieldOriginalGroupID:
+		return m.OldOriginalGroupID(ctx)
+	case usagelog.FieldFallbackHop:
+		return m.OldFallbackHop(ctx)
+	case usagelog.FieldOrganizationID:
+		return m.OldOrganizationID(ctx)
+	case usagelog.FieldBillingUserID:
+		return m.OldBillingUserID(ctx)
 	case usagelog.FieldInputTokens:
 		return m.OldInputTokens(ctx)
 	case usagelog.FieldOutputTokens:
@@ -13543,6 +17953,26 @@ func (m *UsageLogMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldActualCost(ctx)
 	case usagelog.FieldRateMultiplier:
 		return m.OldRateMultiplier(ctx)
+	case usagelog.FieldPricingVersion:
+		return m.OldPricingVersion(ctx)
+	case usagelog.FieldInputUnitPrice:
+		return m.OldInputUnitPrice(ctx)
+	case usagelog.FieldOutputUnitPrice:
+		return m.OldOutputUnitPrice(ctx)
+	case usagelog.FieldCacheCreationUnitPrice:
+		return m.OldCacheCreationUnitPrice(ctx)
+	case usagelog.FieldCacheReadUnitPrice:
+		return m.OldCacheReadUnitPrice(ctx)
+	case usagelog.FieldImageUnitPrice:
+		return m.OldImageUnitPrice(ctx)
+	case usagelog.FieldVolumeTierMinSpend:
+		return m.OldVolumeTierMinSpend(ctx)
+	case usagelog.FieldVolumeTierMultiplier:
+		return m.OldVolumeTierMultiplier(ctx)
+	case usagelog.FieldSubscriptionCost:
+		return m.OldSubscriptionCost(ctx)
+	case usagelog.FieldOverageCost:
+		return m.OldOverageCost(ctx)
 	case usagelog.FieldAccountRateMultiplier:
 		return m.OldAccountRateMultiplier(ctx)
 	case usagelog.FieldBillingType:
@@ -13621,6 +18051,34 @@ func (m *UsageLogMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetSubscriptionID(v)
 		return nil
+	case usagelog.FieldOriginalGroupID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOriginalGroupID(v)
+		return nil
+	case usagelog.FieldFallbackHop:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFallbackHop(v)
+		return nil
+	case usagelog.FieldOrganizationID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationID(v)
+		return nil
+	case usagelog.FieldBillingUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBillingUserID(v)
+		return nil
 	case usagelog.FieldInputTokens:
 		v, ok := value.(int)
 		if !ok {
@@ -13712,6 +18170,76 @@ func (m *UsageLogMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetRateMultiplier(v)
 		return nil
+	case usagelog.FieldPricingVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPricingVersion(v)
+		return nil
+	case usagelog.FieldInputUnitPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInputUnitPrice(v)
+		return nil
+	case usagelog.FieldOutputUnitPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutputUnitPrice(v)
+		return nil
+	case usagelog.FieldCacheCreationUnitPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCacheCreationUnitPrice(v)
+		return nil
+	case usagelog.FieldCacheReadUnitPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCacheReadUnitPrice(v)
+		return nil
+	case usagelog.FieldImageUnitPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImageUnitPrice(v)
+		return nil
+	case usagelog.FieldVolumeTierMinSpend:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVolumeTierMinSpend(v)
+		return nil
+	case usagelog.FieldVolumeTierMultiplier:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVolumeTierMultiplier(v)
+		return nil
+	case usagelog.FieldSubscriptionCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubscriptionCost(v)
+		return nil
+	case usagelog.FieldOverageCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOverageCost(v)
+		return nil
 	case usagelog.FieldAccountRateMultiplier:
 		v, ok := value.(float64)
 		if !ok {
@@ -13790,6 +18318,18 @@ func (m *UsageLogMutation) SetField(name string, value ent.Value) error {
 // this mutation.
 func (m *UsageLogMutation) AddedFields() []string {
 	var fields []string
+	if m.addoriginal_group_id != nil {
+		fields = append(fields, usagelog.FieldOriginalGroupID)
+	}
+	if m.addfallback_hop != nil {
+		fields = append(fields, usagelog.FieldFallbackHop)
+	}
+	if m.addorganization_id != nil {
+		fields = append(fields, usagelog.FieldOrganizationID)
+	}
+	if m.addbilling_user_id != nil {
+		fields = append(fields, usagelog.FieldBillingUserID)
+	}
 	if m.addinput_tokens != nil {
 		fields = append(fields, usagelog.FieldInputTokens)
 	}
@@ -13829,6 +18369,33 @@ func (m *UsageLogMutation) AddedFields() []string {
 	if m.addrate_multiplier != nil {
 		fields = append(fields, usagelog.FieldRateMultiplier)
 	}
+	if m.addinput_unit_price != nil {
+		fields = append(fields, usagelog.FieldInputUnitPrice)
+	}
+	if m.addoutput_unit_price != nil {
+		fields = append(fields, usagelog.FieldOutputUnitPrice)
+	}
+	if m.addcache_creation_unit_price != nil {
+		fields = append(fields, usagelog.FieldCacheCreationUnitPrice)
+	}
+	if m.addcache_read_unit_price != nil {
+		fields = append(fields, usagelog.FieldCacheReadUnitPrice)
+	}
+	if m.addimage_unit_price != nil {
+		fields = append(fields, usagelog.FieldImageUnitPrice)
+	}
+	if m.addvolume_tier_min_spend != nil {
+		fields = append(fields, usagelog.FieldVolumeTierMinSpend)
+	}
+	if m.addvolume_tier_multiplier != nil {
+		fields = append(fields, usagelog.FieldVolumeTierMultiplier)
+	}
+	if m.addsubscription_cost != nil {
+		fields = append(fields, usagelog.FieldSubscriptionCost)
+	}
+	if m.addoverage_cost != nil {
+		fields = append(fields, usagelog.FieldOverageCost)
+	}
 	if m.addaccount_rate_multiplier != nil {
 		fields = append(fields, usagelog.FieldAccountRateMultiplier)
 	}
@@ -13852,6 +18419,14 @@ func (m *UsageLogMutation) AddedFields() []string {
 // was not set, or was not defined in the schema.
 func (m *UsageLogMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
+	case usagelog.FieldOriginalGroupID:
+		return m.AddedOriginalGroupID()
+	case usagelog.FieldFallbackHop:
+		return m.AddedFallbackHop()
+	case usagelog.FieldOrganizationID:
+		return m.AddedOrganizationID()
+	case usagelog.FieldBillingUserID:
+		return m.AddedBillingUserID()
 	case usagelog.FieldInputTokens:
 		return m.AddedInputTokens()
 	case usagelog.FieldOutputTokens:
@@ -13878,6 +18453,24 @@ func (m *UsageLogMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedActualCost()
 	case usagelog.FieldRateMultiplier:
 		return m.AddedRateMultiplier()
+	case usagelog.FieldInputUnitPrice:
+		return m.AddedInputUnitPrice()
+	case usagelog.FieldOutputUnitPrice:
+		return m.AddedOutputUnitPrice()
+	case usagelog.FieldCacheCreationUnitPrice:
+		return m.AddedCacheCreationUnitPrice()
+	case usagelog.FieldCacheReadUnitPrice:
+		return m.AddedCacheReadUnitPrice()
+	case usagelog.FieldImageUnitPrice:
+		return m.AddedImageUnitPrice()
+	case usagelog.FieldVolumeTierMinSpend:
+		return m.AddedVolumeTierMinSpend()
+	case usagelog.FieldVolumeTierMultiplier:
+		return m.AddedVolumeTierMultiplier()
+	case usagelog.FieldSubscriptionCost:
+		return m.AddedSubscriptionCost()
+	case usagelog.FieldOverageCost:
+		return m.AddedOverageCost()
 	case usagelog.FieldAccountRateMultiplier:
 		return m.AddedAccountRateMultiplier()
 	case usagelog.FieldBillingType:
@@ -13897,6 +18490,34 @@ func (m *UsageLogMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *UsageLogMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case usagelog.FieldOriginalGroupID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOriginalGroupID(v)
+		return nil
+	case usagelog.FieldFallbackHop:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFallbackHop(v)
+		return nil
+	case usagelog.FieldOrganizationID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOrganizationID(v)
+		return nil
+	case usagelog.FieldBillingUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBillingUserID(v)
+		return nil
 	case usagelog.FieldInputTokens:
 		v, ok := value.(int)
 		if !ok {
@@ -13988,6 +18609,69 @@ func (m *UsageLogMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddRateMultiplier(v)
 		return nil
+	case usagelog.FieldInputUnitPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInputUnitPrice(v)
+		return nil
+	case usagelog.FieldOutputUnitPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOutputUnitPrice(v)
+		return nil
+	case usagelog.FieldCacheCreationUnitPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCacheCreationUnitPrice(v)
+		return nil
+	case usagelog.FieldCacheReadUnitPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCacheReadUnitPrice(v)
+		return nil
+	case usagelog.FieldImageUnitPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddImageUnitPrice(v)
+		return nil
+	case usagelog.FieldVolumeTierMinSpend:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVolumeTierMinSpend(v)
+		return nil
+	case usagelog.FieldVolumeTierMultiplier:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVolumeTierMultiplier(v)
+		return nil
+	case usagelog.FieldSubscriptionCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSubscriptionCost(v)
+		return nil
+	case usagelog.FieldOverageCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOverageCost(v)
+		return nil
 	case usagelog.FieldAccountRateMultiplier:
 		v, ok := value.(float64)
 		if !ok {
@@ -14037,6 +18721,45 @@ func (m *UsageLogMutation) ClearedFields() []string {
 	if m.FieldCleared(usagelog.FieldSubscriptionID) {
 		fields = append(fields, usagelog.FieldSubscriptionID)
 	}
+	if m.FieldCleared(usagelog.FieldOriginalGroupID) {
+		fields = append(fields, usagelog.FieldOriginalGroupID)
+	}
+	if m.FieldCleared(usagelog.FieldOrganizationID) {
+		fields = append(fields, usagelog.FieldOrganizationID)
+	}
+	if m.FieldCleared(usagelog.FieldBillingUserID) {
+		fields = append(fields, usagelog.FieldBillingUserID)
+	}
+	if m.FieldCleared(usagelog.FieldPricingVersion) {
+		fields = append(fields, usagelog.FieldPricingVersion)
+	}
+	if m.FieldCleared(usagelog.FieldInputUnitPrice) {
+		fields = append(fields, usagelog.FieldInputUnitPrice)
+	}
+	if m.FieldCleared(usagelog.FieldOutputUnitPrice) {
+		fields = append(fields, usagelog.FieldOutputUnitPrice)
+	}
+	if m.FieldCleared(usagelog.FieldCacheCreationUnitPrice) {
+		fields = append(fields, usagelog.FieldCacheCreationUnitPrice)
+	}
+	if m.FieldCleared(usagelog.FieldCacheReadUnitPrice) {
+		fields = append(fields, usagelog.FieldCacheReadUnitPrice)
+	}
+	if m.FieldCleared(usagelog.FieldImageUnitPrice) {
+		fields = append(fields, usagelog.FieldImageUnitPrice)
+	}
+	if m.FieldCleared(usagelog.FieldVolumeTierMinSpend) {
+		fields = append(fields, usagelog.FieldVolumeTierMinSpend)
+	}
+	if m.FieldCleared(usagelog.FieldVolumeTierMultiplier) {
+		fields = append(fields, usagelog.FieldVolumeTierMultiplier)
+	}
+	if m.FieldCleared(usagelog.FieldSubscriptionCost) {
+		fields = append(fields, usagelog.FieldSubscriptionCost)
+	}
+	if m.FieldCleared(usagelog.FieldOverageCost) {
+		fields = append(fields, usagelog.FieldOverageCost)
+	}
 	if m.FieldCleared(usagelog.FieldAccountRateMultiplier) {
 		fields = append(fields, usagelog.FieldAccountRateMultiplier)
 	}
@@ -14075,6 +18798,45 @@ func (m *UsageLogMutation) ClearField(name string) error {
 	case usagelog.FieldSubscriptionID:
 		m.ClearSubscriptionID()
 		return nil
+	case usagelog.FieldOriginalGroupID:
+		m.ClearOriginalGroupID()
+		return nil
+	case usagelog.FieldOrganizationID:
+		m.ClearOrganizationID()
+		return nil
+	case usagelog.FieldBillingUserID:
+		m.ClearBillingUserID()
+		return nil
+	case usagelog.FieldPricingVersion:
+		m.ClearPricingVersion()
+		return nil
+	case usagelog.FieldInputUnitPrice:
+		m.ClearInputUnitPrice()
+		return nil
+	case usagelog.FieldOutputUnitPrice:
+		m.ClearOutputUnitPrice()
+		return nil
+	case usagelog.FieldCacheCreationUnitPrice:
+		m.ClearCacheCreationUnitPrice()
+		return nil
+	case usagelog.FieldCacheReadUnitPrice:
+		m.ClearCacheReadUnitPrice()
+		return nil
+	case usagelog.FieldImageUnitPrice:
+		m.ClearImageUnitPrice()
+		return nil
+	case usagelog.FieldVolumeTierMinSpend:
+		m.ClearVolumeTierMinSpend()
+		return nil
+	case usagelog.FieldVolumeTierMultiplier:
+		m.ClearVolumeTierMultiplier()
+		return nil
+	case usagelog.FieldSubscriptionCost:
+		m.ClearSubscriptionCost()
+		return nil
+	case usagelog.FieldOverageCost:
+		m.ClearOverageCost()
+		return nil
 	case usagelog.FieldAccountRateMultiplier:
 		m.ClearAccountRateMultiplier()
 		return nil
@@ -14122,6 +18884,18 @@ func (m *UsageLogMutation) ResetField(name string) error {
 	case usagelog.FieldSubscriptionID:
 		m.ResetSubscriptionID()
 		return nil
+	case usagelog.FieldOriginalGroupID:
+		m.ResetOriginalGroupID()
+		return nil
+	case usagelog.FieldFallbackHop:
+		m.ResetFallbackHop()
+		return nil
+	case usagelog.FieldOrganizationID:
+		m.ResetOrganizationID()
+		return nil
+	case usagelog.FieldBillingUserID:
+		m.ResetBillingUserID()
+		return nil
 	case usagelog.FieldInputTokens:
 		m.ResetInputTokens()
 		return nil
@@ -14155,11 +18929,41 @@ func (m *UsageLogMutation) ResetField(name string) error {
 	case usagelog.FieldTotalCost:
 		m.ResetTotalCost()
 		return nil
-	case usagelog.FieldActualCost:
-		m.ResetActualCost()
+	case usagelog.FieldActualCost:
+		m.ResetActualCost()
+		return nil
+	case usagelog.FieldRateMultiplier:
+		m.ResetRateMultiplier()
+		return nil
+	case usagelog.FieldPricingVersion:
+		m.ResetPricingVersion()
+		return nil
+	case usagelog.FieldInputUnitPrice:
+		m.ResetInputUnitPrice()
+		return nil
+	case usagelog.FieldOutputUnitPrice:
+		m.ResetOutputUnitPrice()
+		return nil
+	case usagelog.FieldCacheCreationUnitPrice:
+		m.ResetCacheCreationUnitPrice()
+		return nil
+	case usagelog.FieldCacheReadUnitPrice:
+		m.ResetCacheReadUnitPrice()
+		return nil
+	case usagelog.FieldImageUnitPrice:
+		m.ResetImageUnitPrice()
+		return nil
+	case usagelog.FieldVolumeTierMinSpend:
+		m.ResetVolumeTierMinSpend()
+		return nil
+	case usagelog.FieldVolumeTierMultiplier:
+		m.ResetVolumeTierMultiplier()
+		return nil
+	case usagelog.FieldSubscriptionCost:
+		m.ResetSubscriptionCost()
 		return nil
-	case usagelog.FieldRateMultiplier:
-		m.ResetRateMultiplier()
+	case usagelog.FieldOverageCost:
+		m.ResetOverageCost()
 		return nil
 	case usagelog.FieldAccountRateMultiplier:
 		m.ResetAccountRateMultiplier()
@@ -17733,1345 +22537,1770 @@ func (m *UserAttributeValueMutation) UserID() (r int64, exists bool) {
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the UserAttributeValue entity.
-// If the UserAttributeValue object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserAttributeValueMutation) OldUserID(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+// OldUserID returns the old "user_id" field's value of the UserAttributeValue entity.
+// If the UserAttributeValue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserAttributeValueMutation) OldUserID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UserAttributeValueMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetAttributeID sets the "attribute_id" field.
+func (m *UserAttributeValueMutation) SetAttributeID(i int64) {
+	m.definition = &i
+}
+
+// AttributeID returns the value of the "attribute_id" field in the mutation.
+func (m *UserAttributeValueMutation) AttributeID() (r int64, exists bool) {
+	v := m.definition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttributeID returns the old "attribute_id" field's value of the UserAttributeValue entity.
+// If the UserAttributeValue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserAttributeValueMutation) OldAttributeID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttributeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttributeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttributeID: %w", err)
+	}
+	return oldValue.AttributeID, nil
+}
+
+// ResetAttributeID resets all changes to the "attribute_id" field.
+func (m *UserAttributeValueMutation) ResetAttributeID() {
+	m.definition = nil
+}
+
+// SetValue sets the "value" field.
+func (m *UserAttributeValueMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *UserAttributeValueMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the UserAttributeValue entity.
+// If the UserAttributeValue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserAttributeValueMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *UserAttributeValueMutation) ResetValue() {
+	m.value = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *UserAttributeValueMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[userattributevalue.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *UserAttributeValueMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *UserAttributeValueMutation) UserIDs() (ids []int64) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *UserAttributeValueMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetDefinitionID sets the "definition" edge to the UserAttributeDefinition entity by id.
+func (m *UserAttributeValueMutation) SetDefinitionID(id int64) {
+	m.definition = &id
+}
+
+// ClearDefinition clears the "definition" edge to the UserAttributeDefinition entity.
+func (m *UserAttributeValueMutation) ClearDefinition() {
+	m.cleareddefinition = true
+	m.clearedFields[userattributevalue.FieldAttributeID] = struct{}{}
+}
+
+// DefinitionCleared reports if the "definition" edge to the UserAttributeDefinition entity was cleared.
+func (m *UserAttributeValueMutation) DefinitionCleared() bool {
+	return m.cleareddefinition
+}
+
+// DefinitionID returns the "definition" edge ID in the mutation.
+func (m *UserAttributeValueMutation) DefinitionID() (id int64, exists bool) {
+	if m.definition != nil {
+		return *m.definition, true
+	}
+	return
+}
+
+// DefinitionIDs returns the "definition" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DefinitionID instead. It exists only for internal usage by the builders.
+func (m *UserAttributeValueMutation) DefinitionIDs() (ids []int64) {
+	if id := m.definition; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDefinition resets all changes to the "definition" edge.
+func (m *UserAttributeValueMutation) ResetDefinition() {
+	m.definition = nil
+	m.cleareddefinition = false
+}
+
+// Where appends a list predicates to the UserAttributeValueMutation builder.
+func (m *UserAttributeValueMutation) Where(ps ...predicate.UserAttributeValue) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserAttributeValueMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserAttributeValueMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserAttributeValue, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserAttributeValueMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserAttributeValueMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (UserAttributeValue).
+func (m *UserAttributeValueMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserAttributeValueMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, userattributevalue.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, userattributevalue.FieldUpdatedAt)
+	}
+	if m.user != nil {
+		fields = append(fields, userattributevalue.FieldUserID)
+	}
+	if m.definition != nil {
+		fields = append(fields, userattributevalue.FieldAttributeID)
+	}
+	if m.value != nil {
+		fields = append(fields, userattributevalue.FieldValue)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserAttributeValueMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case userattributevalue.FieldCreatedAt:
+		return m.CreatedAt()
+	case userattributevalue.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case userattributevalue.FieldUserID:
+		return m.UserID()
+	case userattributevalue.FieldAttributeID:
+		return m.AttributeID()
+	case userattributevalue.FieldValue:
+		return m.Value()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserAttributeValueMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case userattributevalue.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case userattributevalue.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case userattributevalue.FieldUserID:
+		return m.OldUserID(ctx)
+	case userattributevalue.FieldAttributeID:
+		return m.OldAttributeID(ctx)
+	case userattributevalue.FieldValue:
+		return m.OldValue(ctx)
+	}
+	return nil, fmt.Errorf("unknown UserAttributeValue field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserAttributeValueMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case userattributevalue.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case userattributevalue.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case userattributevalue.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case userattributevalue.FieldAttributeID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttributeID(v)
+		return nil
+	case userattributevalue.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
 	}
-	return oldValue.UserID, nil
+	return fmt.Errorf("unknown UserAttributeValue field %s", name)
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *UserAttributeValueMutation) ResetUserID() {
-	m.user = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserAttributeValueMutation) AddedFields() []string {
+	var fields []string
+	return fields
 }
 
-// SetAttributeID sets the "attribute_id" field.
-func (m *UserAttributeValueMutation) SetAttributeID(i int64) {
-	m.definition = &i
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserAttributeValueMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
 }
 
-// AttributeID returns the value of the "attribute_id" field in the mutation.
-func (m *UserAttributeValueMutation) AttributeID() (r int64, exists bool) {
-	v := m.definition
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserAttributeValueMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown UserAttributeValue numeric field %s", name)
 }
 
-// OldAttributeID returns the old "attribute_id" field's value of the UserAttributeValue entity.
-// If the UserAttributeValue object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserAttributeValueMutation) OldAttributeID(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAttributeID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAttributeID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAttributeID: %w", err)
-	}
-	return oldValue.AttributeID, nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserAttributeValueMutation) ClearedFields() []string {
+	return nil
 }
 
-// ResetAttributeID resets all changes to the "attribute_id" field.
-func (m *UserAttributeValueMutation) ResetAttributeID() {
-	m.definition = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserAttributeValueMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetValue sets the "value" field.
-func (m *UserAttributeValueMutation) SetValue(s string) {
-	m.value = &s
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserAttributeValueMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UserAttributeValue nullable field %s", name)
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *UserAttributeValueMutation) Value() (r string, exists bool) {
-	v := m.value
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserAttributeValueMutation) ResetField(name string) error {
+	switch name {
+	case userattributevalue.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case userattributevalue.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case userattributevalue.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case userattributevalue.FieldAttributeID:
+		m.ResetAttributeID()
+		return nil
+	case userattributevalue.FieldValue:
+		m.ResetValue()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown UserAttributeValue field %s", name)
 }
 
-// OldValue returns the old "value" field's value of the UserAttributeValue entity.
-// If the UserAttributeValue object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserAttributeValueMutation) OldValue(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserAttributeValueMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, userattributevalue.EdgeUser)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	if m.definition != nil {
+		edges = append(edges, userattributevalue.EdgeDefinition)
 	}
-	return oldValue.Value, nil
-}
-
-// ResetValue resets all changes to the "value" field.
-func (m *UserAttributeValueMutation) ResetValue() {
-	m.value = nil
-}
-
-// ClearUser clears the "user" edge to the User entity.
-func (m *UserAttributeValueMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[userattributevalue.FieldUserID] = struct{}{}
-}
-
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *UserAttributeValueMutation) UserCleared() bool {
-	return m.cleareduser
+	return edges
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *UserAttributeValueMutation) UserIDs() (ids []int64) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserAttributeValueMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case userattributevalue.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case userattributevalue.EdgeDefinition:
+		if id := m.definition; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	return
+	return nil
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *UserAttributeValueMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserAttributeValueMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
 }
 
-// SetDefinitionID sets the "definition" edge to the UserAttributeDefinition entity by id.
-func (m *UserAttributeValueMutation) SetDefinitionID(id int64) {
-	m.definition = &id
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserAttributeValueMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ClearDefinition clears the "definition" edge to the UserAttributeDefinition entity.
-func (m *UserAttributeValueMutation) ClearDefinition() {
-	m.cleareddefinition = true
-	m.clearedFields[userattributevalue.FieldAttributeID] = struct{}{}
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserAttributeValueMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, userattributevalue.EdgeUser)
+	}
+	if m.cleareddefinition {
+		edges = append(edges, userattributevalue.EdgeDefinition)
+	}
+	return edges
 }
 
-// DefinitionCleared reports if the "definition" edge to the UserAttributeDefinition entity was cleared.
-func (m *UserAttributeValueMutation) DefinitionCleared() bool {
-	return m.cleareddefinition
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserAttributeValueMutation) EdgeCleared(name string) bool {
+	switch name {
+	case userattributevalue.EdgeUser:
+		return m.cleareduser
+	case userattributevalue.EdgeDefinition:
+		return m.cleareddefinition
+	}
+	return false
 }
 
-// DefinitionID returns the "definition" edge ID in the mutation.
-func (m *UserAttributeValueMutation) DefinitionID() (id int64, exists bool) {
-	if m.definition != nil {
-		return *m.definition, true
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserAttributeValueMutation) ClearEdge(name string) error {
+	switch name {
+	case userattributevalue.EdgeUser:
+		m.ClearUser()
+		return nil
+	case userattributevalue.EdgeDefinition:
+		m.ClearDefinition()
+		return nil
 	}
-	return
+	return fmt.Errorf("unknown UserAttributeValue unique edge %s", name)
 }
 
-// DefinitionIDs returns the "definition" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DefinitionID instead. It exists only for internal usage by the builders.
-func (m *UserAttributeValueMutation) DefinitionIDs() (ids []int64) {
-	if id := m.definition; id != nil {
-		ids = append(ids, *id)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserAttributeValueMutation) ResetEdge(name string) error {
+	switch name {
+	case userattributevalue.EdgeUser:
+		m.ResetUser()
+		return nil
+	case userattributevalue.EdgeDefinition:
+		m.ResetDefinition()
+		return nil
 	}
-	return
+	return fmt.Errorf("unknown UserAttributeValue edge %s", name)
 }
 
-// ResetDefinition resets all changes to the "definition" edge.
-func (m *UserAttributeValueMutation) ResetDefinition() {
-	m.definition = nil
-	m.cleareddefinition = false
+// UserSubscriptionMutation represents an operation that mutates the UserSubscription nodes in the graph.
+type UserSubscriptionMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int64
+	created_at              *time.Time
+	updated_at              *time.Time
+	deleted_at              *time.Time
+	starts_at               *time.Time
+	expires_at              *time.Time
+	status                  *string
+	daily_window_start      *time.Time
+	weekly_window_start     *time.Time
+	monthly_window_start    *time.Time
+	daily_usage_usd         *float64
+	adddaily_usage_usd      *float64
+	weekly_usage_usd        *float64
+	addweekly_usage_usd     *float64
+	monthly_usage_usd       *float64
+	addmonthly_usage_usd    *float64
+	assigned_at             *time.Time
+	notes                   *string
+	plan_id                 *int64
+	addplan_id              *int64
+	auto_renew              *bool
+	daily_limit_usd         *float64
+	adddaily_limit_usd      *float64
+	weekly_limit_usd        *float64
+	addweekly_limit_usd     *float64
+	monthly_limit_usd       *float64
+	addmonthly_limit_usd    *float64
+	quota_usage             *map[string]usagequota.Counter
+	paused_at               *time.Time
+	clearedFields           map[string]struct{}
+	user                    *int64
+	cleareduser             bool
+	group                   *int64
+	clearedgroup            bool
+	assigned_by_user        *int64
+	clearedassigned_by_user bool
+	usage_logs              map[int64]struct{}
+	removedusage_logs       map[int64]struct{}
+	clearedusage_logs       bool
+	done                    bool
+	oldValue                func(context.Context) (*UserSubscription, error)
+	predicates              []predicate.UserSubscription
 }
 
-// Where appends a list predicates to the UserAttributeValueMutation builder.
-func (m *UserAttributeValueMutation) Where(ps ...predicate.UserAttributeValue) {
-	m.predicates = append(m.predicates, ps...)
-}
+var _ ent.Mutation = (*UserSubscriptionMutation)(nil)
 
-// WhereP appends storage-level predicates to the UserAttributeValueMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserAttributeValueMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.UserAttributeValue, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// usersubscriptionOption allows management of the mutation configuration using functional options.
+type usersubscriptionOption func(*UserSubscriptionMutation)
+
+// newUserSubscriptionMutation creates new mutation for the UserSubscription entity.
+func newUserSubscriptionMutation(c config, op Op, opts ...usersubscriptionOption) *UserSubscriptionMutation {
+	m := &UserSubscriptionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUserSubscription,
+		clearedFields: make(map[string]struct{}),
 	}
-	m.Where(p...)
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// Op returns the operation name.
-func (m *UserAttributeValueMutation) Op() Op {
-	return m.op
+// withUserSubscriptionID sets the ID field of the mutation.
+func withUserSubscriptionID(id int64) usersubscriptionOption {
+	return func(m *UserSubscriptionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *UserSubscription
+		)
+		m.oldValue = func(ctx context.Context) (*UserSubscription, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().UserSubscription.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// SetOp allows setting the mutation operation.
-func (m *UserAttributeValueMutation) SetOp(op Op) {
-	m.op = op
+// withUserSubscription sets the old UserSubscription of the mutation.
+func withUserSubscription(node *UserSubscription) usersubscriptionOption {
+	return func(m *UserSubscriptionMutation) {
+		m.oldValue = func(context.Context) (*UserSubscription, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// Type returns the node type of this mutation (UserAttributeValue).
-func (m *UserAttributeValueMutation) Type() string {
-	return m.typ
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserSubscriptionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *UserAttributeValueMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.created_at != nil {
-		fields = append(fields, userattributevalue.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, userattributevalue.FieldUpdatedAt)
-	}
-	if m.user != nil {
-		fields = append(fields, userattributevalue.FieldUserID)
-	}
-	if m.definition != nil {
-		fields = append(fields, userattributevalue.FieldAttributeID)
-	}
-	if m.value != nil {
-		fields = append(fields, userattributevalue.FieldValue)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserSubscriptionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return fields
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *UserAttributeValueMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case userattributevalue.FieldCreatedAt:
-		return m.CreatedAt()
-	case userattributevalue.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case userattributevalue.FieldUserID:
-		return m.UserID()
-	case userattributevalue.FieldAttributeID:
-		return m.AttributeID()
-	case userattributevalue.FieldValue:
-		return m.Value()
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserSubscriptionMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return nil, false
+	return *m.id, true
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *UserAttributeValueMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case userattributevalue.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case userattributevalue.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case userattributevalue.FieldUserID:
-		return m.OldUserID(ctx)
-	case userattributevalue.FieldAttributeID:
-		return m.OldAttributeID(ctx)
-	case userattributevalue.FieldValue:
-		return m.OldValue(ctx)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserSubscriptionMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().UserSubscription.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return nil, fmt.Errorf("unknown UserAttributeValue field %s", name)
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *UserAttributeValueMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case userattributevalue.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case userattributevalue.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case userattributevalue.FieldUserID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserID(v)
-		return nil
-	case userattributevalue.FieldAttributeID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAttributeID(v)
-		return nil
-	case userattributevalue.FieldValue:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetValue(v)
-		return nil
-	}
-	return fmt.Errorf("unknown UserAttributeValue field %s", name)
+// SetCreatedAt sets the "created_at" field.
+func (m *UserSubscriptionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *UserAttributeValueMutation) AddedFields() []string {
-	var fields []string
-	return fields
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserSubscriptionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *UserAttributeValueMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
+// OldCreatedAt returns the old "created_at" field's value of the UserSubscription entity.
+// If the UserSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserSubscriptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return nil, false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *UserAttributeValueMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserSubscriptionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserSubscriptionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserSubscriptionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown UserAttributeValue numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *UserAttributeValueMutation) ClearedFields() []string {
-	return nil
+// OldUpdatedAt returns the old "updated_at" field's value of the UserSubscription entity.
+// If the UserSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserSubscriptionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *UserAttributeValueMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserSubscriptionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *UserAttributeValueMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown UserAttributeValue nullable field %s", name)
+// SetDeletedAt sets the "deleted_at" field.
+func (m *UserSubscriptionMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *UserAttributeValueMutation) ResetField(name string) error {
-	switch name {
-	case userattributevalue.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case userattributevalue.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case userattributevalue.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case userattributevalue.FieldAttributeID:
-		m.ResetAttributeID()
-		return nil
-	case userattributevalue.FieldValue:
-		m.ResetValue()
-		return nil
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *UserSubscriptionMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown UserAttributeValue field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserAttributeValueMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.user != nil {
-		edges = append(edges, userattributevalue.EdgeUser)
+// OldDeletedAt returns the old "deleted_at" field's value of the UserSubscription entity.
+// If the UserSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserSubscriptionMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
-	if m.definition != nil {
-		edges = append(edges, userattributevalue.EdgeDefinition)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *UserAttributeValueMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case userattributevalue.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case userattributevalue.EdgeDefinition:
-		if id := m.definition; id != nil {
-			return []ent.Value{*id}
-		}
-	}
-	return nil
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *UserSubscriptionMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[usersubscription.FieldDeletedAt] = struct{}{}
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserAttributeValueMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	return edges
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *UserSubscriptionMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[usersubscription.FieldDeletedAt]
+	return ok
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *UserAttributeValueMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *UserSubscriptionMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, usersubscription.FieldDeletedAt)
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserAttributeValueMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareduser {
-		edges = append(edges, userattributevalue.EdgeUser)
-	}
-	if m.cleareddefinition {
-		edges = append(edges, userattributevalue.EdgeDefinition)
+// SetUserID sets the "user_id" field.
+func (m *UserSubscriptionMutation) SetUserID(i int64) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UserSubscriptionMutation) UserID() (r int64, exists bool) {
+	v := m.user
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *UserAttributeValueMutation) EdgeCleared(name string) bool {
-	switch name {
-	case userattributevalue.EdgeUser:
-		return m.cleareduser
-	case userattributevalue.EdgeDefinition:
-		return m.cleareddefinition
+// OldUserID returns the old "user_id" field's value of the UserSubscription entity.
+// If the UserSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserSubscriptionMutation) OldUserID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
-	return false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *UserAttributeValueMutation) ClearEdge(name string) error {
-	switch name {
-	case userattributevalue.EdgeUser:
-		m.ClearUser()
-		return nil
-	case userattributevalue.EdgeDefinition:
-		m.ClearDefinition()
-		return nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UserSubscriptionMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetGroupID sets the "group_id" field.
+func (m *UserSubscriptionMutation) SetGroupID(i int64) {
+	m.group = &i
+}
+
+// GroupID returns the value of the "group_id" field in the mutation.
+func (m *UserSubscriptionMutation) GroupID() (r int64, exists bool) {
+	v := m.group
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown UserAttributeValue unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *UserAttributeValueMutation) ResetEdge(name string) error {
-	switch name {
-	case userattributevalue.EdgeUser:
-		m.ResetUser()
-		return nil
-	case userattributevalue.EdgeDefinition:
-		m.ResetDefinition()
-		return nil
+// OldGroupID returns the old "group_id" field's value of the UserSubscription entity.
+// If the UserSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserSubscriptionMutation) OldGroupID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroupID is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown UserAttributeValue edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroupID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroupID: %w", err)
+	}
+	return oldValue.GroupID, nil
 }
 
-// UserSubscriptionMutation represents an operation that mutates the UserSubscription nodes in the graph.
-type UserSubscriptionMutation struct {
-	config
-	op                      Op
-	typ                     string
-	id                      *int64
-	created_at              *time.Time
-	updated_at              *time.Time
-	deleted_at              *time.Time
-	starts_at               *time.Time
-	expires_at              *time.Time
-	status                  *string
-	daily_window_start      *time.Time
-	weekly_window_start     *time.Time
-	monthly_window_start    *time.Time
-	daily_usage_usd         *float64
-	adddaily_usage_usd      *float64
-	weekly_usage_usd        *float64
-	addweekly_usage_usd     *float64
-	monthly_usage_usd       *float64
-	addmonthly_usage_usd    *float64
-	assigned_at             *time.Time
-	notes                   *string
-	clearedFields           map[string]struct{}
-	user                    *int64
-	cleareduser             bool
-	group                   *int64
-	clearedgroup            bool
-	assigned_by_user        *int64
-	clearedassigned_by_user bool
-	usage_logs              map[int64]struct{}
-	removedusage_logs       map[int64]struct{}
-	clearedusage_logs       bool
-	done                    bool
-	oldValue                func(context.Context) (*UserSubscription, error)
-	predicates              []predicate.UserSubscription
+// ResetGroupID resets all changes to the "group_id" field.
+func (m *UserSubscriptionMutation) ResetGroupID() {
+	m.group = nil
 }
 
-var _ ent.Mutation = (*UserSubscriptionMutation)(nil)
+// SetStartsAt sets the "starts_at" field.
+func (m *UserSubscriptionMutation) SetStartsAt(t time.Time) {
+	m.starts_at = &t
+}
 
-// usersubscriptionOption allows management of the mutation configuration using functional options.
-type usersubscriptionOption func(*UserSubscriptionMutation)
+// StartsAt returns the value of the "starts_at" field in the mutation.
+func (m *UserSubscriptionMutation) StartsAt() (r time.Time, exists bool) {
+	v := m.starts_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newUserSubscriptionMutation creates new mutation for the UserSubscription entity.
-func newUserSubscriptionMutation(c config, op Op, opts ...usersubscriptionOption) *UserSubscriptionMutation {
-	m := &UserSubscriptionMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeUserSubscription,
-		clearedFields: make(map[string]struct{}),
+// OldStartsAt returns the old "starts_at" field's value of the UserSubscription entity.
+// If the UserSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserSubscriptionMutation) OldStartsAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartsAt is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartsAt requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartsAt: %w", err)
+	}
+	return oldValue.StartsAt, nil
 }
 
-// withUserSubscriptionID sets the ID field of the mutation.
-func withUserSubscriptionID(id int64) usersubscriptionOption {
-	return func(m *UserSubscriptionMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *UserSubscription
-		)
-		m.oldValue = func(ctx context.Context) (*UserSubscription, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().UserSubscription.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// ResetStartsAt resets all changes to the "starts_at" field.
+func (m *UserSubscriptionMutation) ResetStartsAt() {
+	m.starts_at = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *UserSubscriptionMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *UserSubscriptionMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// withUserSubscription sets the old UserSubscription of the mutation.
-func withUserSubscription(node *UserSubscription) usersubscriptionOption {
-	return func(m *UserSubscriptionMutation) {
-		m.oldValue = func(context.Context) (*UserSubscription, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// OldExpiresAt returns the old "expires_at" field's value of the UserSubscription entity.
+// If the UserSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserSubscriptionMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
+	return oldValue.ExpiresAt, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserSubscriptionMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *UserSubscriptionMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m UserSubscriptionMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// SetStatus sets the "status" field.
+func (m *UserSubscriptionMutation) SetStatus(s string) {
+	m.status = &s
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *UserSubscriptionMutation) ID() (id int64, exists bool) {
-	if m.id == nil {
+// Status returns the value of the "status" field in the mutation.
+func (m *UserSubscriptionMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *UserSubscriptionMutation) IDs(ctx context.Context) ([]int64, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int64{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().UserSubscription.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldStatus returns the old "status" field's value of the UserSubscription entity.
+// If the UserSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserSubscriptionMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
+	return oldValue.Status, nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *UserSubscriptionMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ResetStatus resets all changes to the "status" field.
+func (m *UserSubscriptionMutation) ResetStatus() {
+	m.status = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserSubscriptionMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// SetDailyWindowStart sets the "daily_window_start" field.
+func (m *UserSubscriptionMutation) SetDailyWindowStart(t time.Time) {
+	m.daily_window_start = &t
+}
+
+// DailyWindowStart returns the value of the "daily_window_start" field in the mutation.
+func (m *UserSubscriptionMutation) DailyWindowStart() (r time.Time, exists bool) {
+	v := m.daily_window_start
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the UserSubscription entity.
+// OldDailyWindowStart returns the old "daily_window_start" field's value of the UserSubscription entity.
 // If the UserSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserSubscriptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserSubscriptionMutation) OldDailyWindowStart(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDailyWindowStart is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldDailyWindowStart requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDailyWindowStart: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.DailyWindowStart, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserSubscriptionMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearDailyWindowStart clears the value of the "daily_window_start" field.
+func (m *UserSubscriptionMutation) ClearDailyWindowStart() {
+	m.daily_window_start = nil
+	m.clearedFields[usersubscription.FieldDailyWindowStart] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *UserSubscriptionMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// DailyWindowStartCleared returns if the "daily_window_start" field was cleared in this mutation.
+func (m *UserSubscriptionMutation) DailyWindowStartCleared() bool {
+	_, ok := m.clearedFields[usersubscription.FieldDailyWindowStart]
+	return ok
+}
+
+// ResetDailyWindowStart resets all changes to the "daily_window_start" field.
+func (m *UserSubscriptionMutation) ResetDailyWindowStart() {
+	m.daily_window_start = nil
+	delete(m.clearedFields, usersubscription.FieldDailyWindowStart)
+}
+
+// SetWeeklyWindowStart sets the "weekly_window_start" field.
+func (m *UserSubscriptionMutation) SetWeeklyWindowStart(t time.Time) {
+	m.weekly_window_start = &t
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserSubscriptionMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// WeeklyWindowStart returns the value of the "weekly_window_start" field in the mutation.
+func (m *UserSubscriptionMutation) WeeklyWindowStart() (r time.Time, exists bool) {
+	v := m.weekly_window_start
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the UserSubscription entity.
+// OldWeeklyWindowStart returns the old "weekly_window_start" field's value of the UserSubscription entity.
 // If the UserSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserSubscriptionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserSubscriptionMutation) OldWeeklyWindowStart(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldWeeklyWindowStart is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldWeeklyWindowStart requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldWeeklyWindowStart: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.WeeklyWindowStart, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserSubscriptionMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearWeeklyWindowStart clears the value of the "weekly_window_start" field.
+func (m *UserSubscriptionMutation) ClearWeeklyWindowStart() {
+	m.weekly_window_start = nil
+	m.clearedFields[usersubscription.FieldWeeklyWindowStart] = struct{}{}
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *UserSubscriptionMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// WeeklyWindowStartCleared returns if the "weekly_window_start" field was cleared in this mutation.
+func (m *UserSubscriptionMutation) WeeklyWindowStartCleared() bool {
+	_, ok := m.clearedFields[usersubscription.FieldWeeklyWindowStart]
+	return ok
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *UserSubscriptionMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// ResetWeeklyWindowStart resets all changes to the "weekly_window_start" field.
+func (m *UserSubscriptionMutation) ResetWeeklyWindowStart() {
+	m.weekly_window_start = nil
+	delete(m.clearedFields, usersubscription.FieldWeeklyWindowStart)
+}
+
+// SetMonthlyWindowStart sets the "monthly_window_start" field.
+func (m *UserSubscriptionMutation) SetMonthlyWindowStart(t time.Time) {
+	m.monthly_window_start = &t
+}
+
+// MonthlyWindowStart returns the value of the "monthly_window_start" field in the mutation.
+func (m *UserSubscriptionMutation) MonthlyWindowStart() (r time.Time, exists bool) {
+	v := m.monthly_window_start
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the UserSubscription entity.
+// OldMonthlyWindowStart returns the old "monthly_window_start" field's value of the UserSubscription entity.
 // If the UserSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserSubscriptionMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *UserSubscriptionMutation) OldMonthlyWindowStart(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldMonthlyWindowStart is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldMonthlyWindowStart requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldMonthlyWindowStart: %w", err)
 	}
-	return oldValue.DeletedAt, nil
+	return oldValue.MonthlyWindowStart, nil
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *UserSubscriptionMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[usersubscription.FieldDeletedAt] = struct{}{}
+// ClearMonthlyWindowStart clears the value of the "monthly_window_start" field.
+func (m *UserSubscriptionMutation) ClearMonthlyWindowStart() {
+	m.monthly_window_start = nil
+	m.clearedFields[usersubscription.FieldMonthlyWindowStart] = struct{}{}
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *UserSubscriptionMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[usersubscription.FieldDeletedAt]
+// MonthlyWindowStartCleared returns if the "monthly_window_start" field was cleared in this mutation.
+func (m *UserSubscriptionMutation) MonthlyWindowStartCleared() bool {
+	_, ok := m.clearedFields[usersubscription.FieldMonthlyWindowStart]
 	return ok
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *UserSubscriptionMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, usersubscription.FieldDeletedAt)
+// ResetMonthlyWindowStart resets all changes to the "monthly_window_start" field.
+func (m *UserSubscriptionMutation) ResetMonthlyWindowStart() {
+	m.monthly_window_start = nil
+	delete(m.clearedFields, usersubscription.FieldMonthlyWindowStart)
 }
 
-// SetUserID sets the "user_id" field.
-func (m *UserSubscriptionMutation) SetUserID(i int64) {
-	m.user = &i
+// SetDailyUsageUsd sets the "daily_usage_usd" field.
+func (m *UserSubscriptionMutation) SetDailyUsageUsd(f float64) {
+	m.daily_usage_usd = &f
+	m.adddaily_usage_usd = nil
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *UserSubscriptionMutation) UserID() (r int64, exists bool) {
-	v := m.user
+// DailyUsageUsd returns the value of the "daily_usage_usd" field in the mutation.
+func (m *UserSubscriptionMutation) DailyUsageUsd() (r float64, exists bool) {
+	v := m.daily_usage_usd
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the UserSubscription entity.
+// OldDailyUsageUsd returns the old "daily_usage_usd" field's value of the UserSubscription entity.
 // If the UserSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserSubscriptionMutation) OldUserID(ctx context.Context) (v int64, err error) {
+func (m *UserSubscriptionMutation) OldDailyUsageUsd(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDailyUsageUsd is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldDailyUsageUsd requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDailyUsageUsd: %w", err)
 	}
-	return oldValue.UserID, nil
-}
-
-// ResetUserID resets all changes to the "user_id" field.
-func (m *UserSubscriptionMutation) ResetUserID() {
-	m.user = nil
+	return oldValue.DailyUsageUsd, nil
 }
 
-// SetGroupID sets the "group_id" field.
-func (m *UserSubscriptionMutation) SetGroupID(i int64) {
-	m.group = &i
+// AddDailyUsageUsd adds f to the "daily_usage_usd" field.
+func (m *UserSubscriptionMutation) AddDailyUsageUsd(f float64) {
+	if m.adddaily_usage_usd != nil {
+		*m.adddaily_usage_usd += f
+	} else {
+		m.adddaily_usage_usd = &f
+	}
 }
 
-// GroupID returns the value of the "group_id" field in the mutation.
-func (m *UserSubscriptionMutation) GroupID() (r int64, exists bool) {
-	v := m.group
+// AddedDailyUsageUsd returns the value that was added to the "daily_usage_usd" field in this mutation.
+func (m *UserSubscriptionMutation) AddedDailyUsageUsd() (r float64, exists bool) {
+	v := m.adddaily_usage_usd
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGroupID returns the old "group_id" field's value of the UserSubscription entity.
-// If the UserSubscription object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserSubscriptionMutation) OldGroupID(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGroupID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGroupID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGroupID: %w", err)
-	}
-	return oldValue.GroupID, nil
-}
-
-// ResetGroupID resets all changes to the "group_id" field.
-func (m *UserSubscriptionMutation) ResetGroupID() {
-	m.group = nil
+// ResetDailyUsageUsd resets all changes to the "daily_usage_usd" field.
+func (m *UserSubscriptionMutation) ResetDailyUsageUsd() {
+	m.daily_usage_usd = nil
+	m.adddaily_usage_usd = nil
 }
 
-// SetStartsAt sets the "starts_at" field.
-func (m *UserSubscriptionMutation) SetStartsAt(t time.Time) {
-	m.starts_at = &t
+// SetWeeklyUsageUsd sets the "weekly_usage_usd" field.
+func (m *UserSubscriptionMutation) SetWeeklyUsageUsd(f float64) {
+	m.weekly_usage_usd = &f
+	m.addweekly_usage_usd = nil
 }
 
-// StartsAt returns the value of the "starts_at" field in the mutation.
-func (m *UserSubscriptionMutation) StartsAt() (r time.Time, exists bool) {
-	v := m.starts_at
+// WeeklyUsageUsd returns the value of the "weekly_usage_usd" field in the mutation.
+func (m *UserSubscriptionMutation) WeeklyUsageUsd() (r float64, exists bool) {
+	v := m.weekly_usage_usd
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartsAt returns the old "starts_at" field's value of the UserSubscription entity.
+// OldWeeklyUsageUsd returns the old "weekly_usage_usd" field's value of the UserSubscription entity.
 // If the UserSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserSubscriptionMutation) OldStartsAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserSubscriptionMutation) OldWeeklyUsageUsd(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartsAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldWeeklyUsageUsd is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartsAt requires an ID field in the mutation")
+		return v, errors.New("OldWeeklyUsageUsd requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartsAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldWeeklyUsageUsd: %w", err)
 	}
-	return oldValue.StartsAt, nil
+	return oldValue.WeeklyUsageUsd, nil
 }
 
-// ResetStartsAt resets all changes to the "starts_at" field.
-func (m *UserSubscriptionMutation) ResetStartsAt() {
-	m.starts_at = nil
+// AddWeeklyUsageUsd adds f to the "weekly_usage_usd" field.
+func (m *UserSubscriptionMutation) AddWeeklyUsageUsd(f float64) {
+	if m.addweekly_usage_usd != nil {
+		*m.addweekly_usage_usd += f
+	} else {
+		m.addweekly_usage_usd = &f
+	}
 }
 
-// SetExpiresAt sets the "expires_at" field.
-func (m *UserSubscriptionMutation) SetExpiresAt(t time.Time) {
-	m.expires_at = &t
+// AddedWeeklyUsageUsd returns the value that was added to the "weekly_usage_usd" field in this mutation.
+func (m *UserSubscriptionMutation) AddedWeeklyUsageUsd() (r float64, exists bool) {
+	v := m.addweekly_usage_usd
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ExpiresAt returns the value of the "expires_at" field in the mutation.
-func (m *UserSubscriptionMutation) ExpiresAt() (r time.Time, exists bool) {
-	v := m.expires_at
+// ResetWeeklyUsageUsd resets all changes to the "weekly_usage_usd" field.
+func (m *UserSubscriptionMutation) ResetWeeklyUsageUsd() {
+	m.weekly_usage_usd = nil
+	m.addweekly_usage_usd = nil
+}
+
+// SetMonthlyUsageUsd sets the "monthly_usage_usd" field.
+func (m *UserSubscriptionMutation) SetMonthlyUsageUsd(f float64) {
+	m.monthly_usage_usd = &f
+	m.addmonthly_usage_usd = nil
+}
+
+// MonthlyUsageUsd returns the value of the "monthly_usage_usd" field in the mutation.
+func (m *UserSubscriptionMutation) MonthlyUsageUsd() (r float64, exists bool) {
+	v := m.monthly_usage_usd
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiresAt returns the old "expires_at" field's value of the UserSubscription entity.
+// OldMonthlyUsageUsd returns the old "monthly_usage_usd" field's value of the UserSubscription entity.
 // If the UserSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserSubscriptionMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserSubscriptionMutation) OldMonthlyUsageUsd(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldMonthlyUsageUsd is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+		return v, errors.New("OldMonthlyUsageUsd requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldMonthlyUsageUsd: %w", err)
 	}
-	return oldValue.ExpiresAt, nil
+	return oldValue.MonthlyUsageUsd, nil
 }
 
-// ResetExpiresAt resets all changes to the "expires_at" field.
-func (m *UserSubscriptionMutation) ResetExpiresAt() {
-	m.expires_at = nil
+// AddMonthlyUsageUsd adds f to the "monthly_usage_usd" field.
+func (m *UserSubscriptionMutation) AddMonthlyUsageUsd(f float64) {
+	if m.addmonthly_usage_usd != nil {
+		*m.addmonthly_usage_usd += f
+	} else {
+		m.addmonthly_usage_usd = &f
+	}
 }
 
-// SetStatus sets the "status" field.
-func (m *UserSubscriptionMutation) SetStatus(s string) {
-	m.status = &s
+// AddedMonthlyUsageUsd returns the value that was added to the "monthly_usage_usd" field in this mutation.
+func (m *UserSubscriptionMutation) AddedMonthlyUsageUsd() (r float64, exists bool) {
+	v := m.addmonthly_usage_usd
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMonthlyUsageUsd resets all changes to the "monthly_usage_usd" field.
+func (m *UserSubscriptionMutation) ResetMonthlyUsageUsd() {
+	m.monthly_usage_usd = nil
+	m.addmonthly_usage_usd = nil
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *UserSubscriptionMutation) Status() (r string, exists bool) {
-	v := m.status
+// SetAssignedBy sets the "assigned_by" field.
+func (m *UserSubscriptionMutation) SetAssignedBy(i int64) {
+	m.assigned_by_user = &i
+}
+
+// AssignedBy returns the value of the "assigned_by" field in the mutation.
+func (m *UserSubscriptionMutation) AssignedBy() (r int64, exists bool) {
+	v := m.assigned_by_user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the UserSubscription entity.
+// OldAssignedBy returns the old "assigned_by" field's value of the UserSubscription entity.
 // If the UserSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserSubscriptionMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *UserSubscriptionMutation) OldAssignedBy(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldAssignedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldAssignedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldAssignedBy: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.AssignedBy, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *UserSubscriptionMutation) ResetStatus() {
-	m.status = nil
+// ClearAssignedBy clears the value of the "assigned_by" field.
+func (m *UserSubscriptionMutation) ClearAssignedBy() {
+	m.assigned_by_user = nil
+	m.clearedFields[usersubscription.FieldAssignedBy] = struct{}{}
 }
 
-// SetDailyWindowStart sets the "daily_window_start" field.
-func (m *UserSubscriptionMutation) SetDailyWindowStart(t time.Time) {
-	m.daily_window_start = &t
+// AssignedByCleared returns if the "assigned_by" field was cleared in this mutation.
+func (m *UserSubscriptionMutation) AssignedByCleared() bool {
+	_, ok := m.clearedFields[usersubscription.FieldAssignedBy]
+	return ok
 }
 
-// DailyWindowStart returns the value of the "daily_window_start" field in the mutation.
-func (m *UserSubscriptionMutation) DailyWindowStart() (r time.Time, exists bool) {
-	v := m.daily_window_start
+// ResetAssignedBy resets all changes to the "assigned_by" field.
+func (m *UserSubscriptionMutation) ResetAssignedBy() {
+	m.assigned_by_user = nil
+	delete(m.clearedFields, usersubscription.FieldAssignedBy)
+}
+
+// SetAssignedAt sets the "assigned_at" field.
+func (m *UserSubscriptionMutation) SetAssignedAt(t time.Time) {
+	m.assigned_at = &t
+}
+
+// AssignedAt returns the value of the "assigned_at" field in the mutation.
+func (m *UserSubscriptionMutation) AssignedAt() (r time.Time, exists bool) {
+	v := m.assigned_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDailyWindowStart returns the old "daily_window_start" field's value of the UserSubscription entity.
+// OldAssignedAt returns the old "assigned_at" field's value of the UserSubscription entity.
 // If the UserSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserSubscriptionMutation) OldDailyWindowStart(ctx context.Context) (v *time.Time, err error) {
+func (m *UserSubscriptionMutation) OldAssignedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDailyWindowStart is only allowed on UpdateOne operations")
+		return v, errors.New("OldAssignedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDailyWindowStart requires an ID field in the mutation")
+		return v, errors.New("OldAssignedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDailyWindowStart: %w", err)
+		return v, fmt.Errorf("querying old value for OldAssignedAt: %w", err)
 	}
-	return oldValue.DailyWindowStart, nil
-}
-
-// ClearDailyWindowStart clears the value of the "daily_window_start" field.
-func (m *UserSubscriptionMutation) ClearDailyWindowStart() {
-	m.daily_window_start = nil
-	m.clearedFields[usersubscription.FieldDailyWindowStart] = struct{}{}
-}
-
-// DailyWindowStartCleared returns if the "daily_window_start" field was cleared in this mutation.
-func (m *UserSubscriptionMutation) DailyWindowStartCleared() bool {
-	_, ok := m.clearedFields[usersubscription.FieldDailyWindowStart]
-	return ok
+	return oldValue.AssignedAt, nil
 }
 
-// ResetDailyWindowStart resets all changes to the "daily_window_start" field.
-func (m *UserSubscriptionMutation) ResetDailyWindowStart() {
-	m.daily_window_start = nil
-	delete(m.clearedFields, usersubscription.FieldDailyWindowStart)
+// ResetAssignedAt resets all changes to the "assigned_at" field.
+func (m *UserSubscriptionMutation) ResetAssignedAt() {
+	m.assigned_at = nil
 }
 
-// SetWeeklyWindowStart sets the "weekly_window_start" field.
-func (m *UserSubscriptionMutation) SetWeeklyWindowStart(t time.Time) {
-	m.weekly_window_start = &t
+// SetNotes sets the "notes" field.
+func (m *UserSubscriptionMutation) SetNotes(s string) {
+	m.notes = &s
 }
 
-// WeeklyWindowStart returns the value of the "weekly_window_start" field in the mutation.
-func (m *UserSubscriptionMutation) WeeklyWindowStart() (r time.Time, exists bool) {
-	v := m.weekly_window_start
+// Notes returns the value of the "notes" field in the mutation.
+func (m *UserSubscriptionMutation) Notes() (r string, exists bool) {
+	v := m.notes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWeeklyWindowStart returns the old "weekly_window_start" field's value of the UserSubscription entity.
+// OldNotes returns the old "notes" field's value of the UserSubscription entity.
 // If the UserSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserSubscriptionMutation) OldWeeklyWindowStart(ctx context.Context) (v *time.Time, err error) {
+func (m *UserSubscriptionMutation) OldNotes(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWeeklyWindowStart is only allowed on UpdateOne operations")
+		return v, errors.New("OldNotes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWeeklyWindowStart requires an ID field in the mutation")
+		return v, errors.New("OldNotes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWeeklyWindowStart: %w", err)
+		return v, fmt.Errorf("querying old value for OldNotes: %w", err)
 	}
-	return oldValue.WeeklyWindowStart, nil
+	return oldValue.Notes, nil
 }
 
-// ClearWeeklyWindowStart clears the value of the "weekly_window_start" field.
-func (m *UserSubscriptionMutation) ClearWeeklyWindowStart() {
-	m.weekly_window_start = nil
-	m.clearedFields[usersubscription.FieldWeeklyWindowStart] = struct{}{}
+// ClearNotes clears the value of the "notes" field.
+func (m *UserSubscriptionMutation) ClearNotes() {
+	m.notes = nil
+	m.clearedFields[usersubscription.FieldNotes] = struct{}{}
 }
 
-// WeeklyWindowStartCleared returns if the "weekly_window_start" field was cleared in this mutation.
-func (m *UserSubscriptionMutation) WeeklyWindowStartCleared() bool {
-	_, ok := m.clearedFields[usersubscription.FieldWeeklyWindowStart]
+// NotesCleared returns if the "notes" field was cleared in this mutation.
+func (m *UserSubscriptionMutation) NotesCleared() bool {
+	_, ok := m.clearedFields[usersubscription.FieldNotes]
 	return ok
 }
 
-// ResetWeeklyWindowStart resets all changes to the "weekly_window_start" field.
-func (m *UserSubscriptionMutation) ResetWeeklyWindowStart() {
-	m.weekly_window_start = nil
-	delete(m.clearedFields, usersubscription.FieldWeeklyWindowStart)
+// ResetNotes resets all changes to the "notes" field.
+func (m *UserSubscriptionMutation) ResetNotes() {
+	m.notes = nil
+	delete(m.clearedFields, usersubscription.FieldNotes)
 }
 
-// SetMonthlyWindowStart sets the "monthly_window_start" field.
-func (m *UserSubscriptionMutation) SetMonthlyWindowStart(t time.Time) {
-	m.monthly_window_start = &t
+// SetPlanID sets the "plan_id" field.
+func (m *UserSubscriptionMutation) SetPlanID(i int64) {
+	m.plan_id = &i
+	m.addplan_id = nil
 }
 
-// MonthlyWindowStart returns the value of the "monthly_window_start" field in the mutation.
-func (m *UserSubscriptionMutation) MonthlyWindowStart() (r time.Time, exists bool) {
-	v := m.monthly_window_start
+// PlanID returns the value of the "plan_id" field in the mutation.
+func (m *UserSubscriptionMutation) PlanID() (r int64, exists bool) {
+	v := m.plan_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMonthlyWindowStart returns the old "monthly_window_start" field's value of the UserSubscription entity.
+// OldPlanID returns the old "plan_id" field's value of the UserSubscription entity.
 // If the UserSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserSubscriptionMutation) OldMonthlyWindowStart(ctx context.Context) (v *time.Time, err error) {
+func (m *UserSubscriptionMutation) OldPlanID(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMonthlyWindowStart is only allowed on UpdateOne operations")
+		return v, errors.New("OldPlanID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMonthlyWindowStart requires an ID field in the mutation")
+		return v, errors.New("OldPlanID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMonthlyWindowStart: %w", err)
+		return v, fmt.Errorf("querying old value for OldPlanID: %w", err)
 	}
-	return oldValue.MonthlyWindowStart, nil
+	return oldValue.PlanID, nil
 }
 
-// ClearMonthlyWindowStart clears the value of the "monthly_window_start" field.
-func (m *UserSubscriptionMutation) ClearMonthlyWindowStart() {
-	m.monthly_window_start = nil
-	m.clearedFields[usersubscription.FieldMonthlyWindowStart] = struct{}{}
+// AddPlanID adds i to the "plan_id" field.
+func (m *UserSubscriptionMutation) AddPlanID(i int64) {
+	if m.addplan_id != nil {
+		*m.addplan_id += i
+	} else {
+		m.addplan_id = &i
+	}
 }
 
-// MonthlyWindowStartCleared returns if the "monthly_window_start" field was cleared in this mutation.
-func (m *UserSubscriptionMutation) MonthlyWindowStartCleared() bool {
-	_, ok := m.clearedFields[usersubscription.FieldMonthlyWindowStart]
+// AddedPlanID returns the value that was added to the "plan_id" field in this mutation.
+func (m *UserSubscriptionMutation) AddedPlanID() (r int64, exists bool) {
+	v := m.addplan_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPlanID clears the value of the "plan_id" field.
+func (m *UserSubscriptionMutation) ClearPlanID() {
+	m.plan_id = nil
+	m.addplan_id = nil
+	m.clearedFields[usersubscription.FieldPlanID] = struct{}{}
+}
+
+// PlanIDCleared returns if the "plan_id" field was cleared in this mutation.
+func (m *UserSubscriptionMutation) PlanIDCleared() bool {
+	_, ok := m.clearedFields[usersubscription.FieldPlanID]
 	return ok
 }
 
-// ResetMonthlyWindowStart resets all changes to the "monthly_window_start" field.
-func (m *UserSubscriptionMutation) ResetMonthlyWindowStart() {
-	m.monthly_window_start = nil
-	delete(m.clearedFields, usersubscription.FieldMonthlyWindowStart)
+// ResetPlanID resets all changes to the "plan_id" field.
+func (m *UserSubscriptionMutation) ResetPlanID() {
+	m.plan_id = nil
+	m.addplan_id = nil
+	delete(m.clearedFields, usersubscription.FieldPlanID)
 }
 
-// SetDailyUsageUsd sets the "daily_usage_usd" field.
-func (m *UserSubscriptionMutation) SetDailyUsageUsd(f float64) {
-	m.daily_usage_usd = &f
-	m.adddaily_usage_usd = nil
+// SetAutoRenew sets the "auto_renew" field.
+func (m *UserSubscriptionMutation) SetAutoRenew(b bool) {
+	m.auto_renew = &b
 }
 
-// DailyUsageUsd returns the value of the "daily_usage_usd" field in the mutation.
-func (m *UserSubscriptionMutation) DailyUsageUsd() (r float64, exists bool) {
-	v := m.daily_usage_usd
+// AutoRenew returns the value of the "auto_renew" field in the mutation.
+func (m *UserSubscriptionMutation) AutoRenew() (r bool, exists bool) {
+	v := m.auto_renew
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDailyUsageUsd returns the old "daily_usage_usd" field's value of the UserSubscription entity.
+// OldAutoRenew returns the old "auto_renew" field's value of the UserSubscription entity.
 // If the UserSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserSubscriptionMutation) OldDailyUsageUsd(ctx context.Context) (v float64, err error) {
+func (m *UserSubscriptionMutation) OldAutoRenew(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDailyUsageUsd is only allowed on UpdateOne operations")
+		return v, errors.New("OldAutoRenew is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDailyUsageUsd requires an ID field in the mutation")
+		return v, errors.New("OldAutoRenew requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDailyUsageUsd: %w", err)
-	}
-	return oldValue.DailyUsageUsd, nil
-}
-
-// AddDailyUsageUsd adds f to the "daily_usage_usd" field.
-func (m *UserSubscriptionMutation) AddDailyUsageUsd(f float64) {
-	if m.adddaily_usage_usd != nil {
-		*m.adddaily_usage_usd += f
-	} else {
-		m.adddaily_usage_usd = &f
-	}
-}
-
-// AddedDailyUsageUsd returns the value that was added to the "daily_usage_usd" field in this mutation.
-func (m *UserSubscriptionMutation) AddedDailyUsageUsd() (r float64, exists bool) {
-	v := m.adddaily_usage_usd
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldAutoRenew: %w", err)
 	}
-	return *v, true
+	return oldValue.AutoRenew, nil
 }
 
-// ResetDailyUsageUsd resets all changes to the "daily_usage_usd" field.
-func (m *UserSubscriptionMutation) ResetDailyUsageUsd() {
-	m.daily_usage_usd = nil
-	m.adddaily_usage_usd = nil
+// ResetAutoRenew resets all changes to the "auto_renew" field.
+func (m *UserSubscriptionMutation) ResetAutoRenew() {
+	m.auto_renew = nil
 }
 
-// SetWeeklyUsageUsd sets the "weekly_usage_usd" field.
-func (m *UserSubscriptionMutation) SetWeeklyUsageUsd(f float64) {
-	m.weekly_usage_usd = &f
-	m.addweekly_usage_usd = nil
+// SetDailyLimitUsd sets the "daily_limit_usd" field.
+func (m *UserSubscriptionMutation) SetDailyLimitUsd(f float64) {
+	m.daily_limit_usd = &f
+	m.adddaily_limit_usd = nil
 }
 
-// WeeklyUsageUsd returns the value of the "weekly_usage_usd" field in the mutation.
-func (m *UserSubscriptionMutation) WeeklyUsageUsd() (r float64, exists bool) {
-	v := m.weekly_usage_usd
+// DailyLimitUsd returns the value of the "daily_limit_usd" field in the mutation.
+func (m *UserSubscriptionMutation) DailyLimitUsd() (r float64, exists bool) {
+	v := m.daily_limit_usd
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWeeklyUsageUsd returns the old "weekly_usage_usd" field's value of the UserSubscription entity.
+// OldDailyLimitUsd returns the old "daily_limit_usd" field's value of the UserSubscription entity.
 // If the UserSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserSubscriptionMutation) OldWeeklyUsageUsd(ctx context.Context) (v float64, err error) {
+func (m *UserSubscriptionMutation) OldDailyLimitUsd(ctx context.Context) (v *float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWeeklyUsageUsd is only allowed on UpdateOne operations")
+		return v, errors.New("OldDailyLimitUsd is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWeeklyUsageUsd requires an ID field in the mutation")
+		return v, errors.New("OldDailyLimitUsd requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWeeklyUsageUsd: %w", err)
+		return v, fmt.Errorf("querying old value for OldDailyLimitUsd: %w", err)
 	}
-	return oldValue.WeeklyUsageUsd, nil
+	return oldValue.DailyLimitUsd, nil
 }
 
-// AddWeeklyUsageUsd adds f to the "weekly_usage_usd" field.
-func (m *UserSubscriptionMutation) AddWeeklyUsageUsd(f float64) {
-	if m.addweekly_usage_usd != nil {
-		*m.addweekly_usage_usd += f
+// AddDailyLimitUsd adds f to the "daily_limit_usd" field.
+func (m *UserSubscriptionMutation) AddDailyLimitUsd(f float64) {
+	if m.adddaily_limit_usd != nil {
+		*m.adddaily_limit_usd += f
 	} else {
-		m.addweekly_usage_usd = &f
+		m.adddaily_limit_usd = &f
 	}
 }
 
-// AddedWeeklyUsageUsd returns the value that was added to the "weekly_usage_usd" field in this mutation.
-func (m *UserSubscriptionMutation) AddedWeeklyUsageUsd() (r float64, exists bool) {
-	v := m.addweekly_usage_usd
+// AddedDailyLimitUsd returns the value that was added to the "daily_limit_usd" field in this mutation.
+func (m *UserSubscriptionMutation) AddedDailyLimitUsd() (r float64, exists bool) {
+	v := m.adddaily_limit_usd
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetWeeklyUsageUsd resets all changes to the "weekly_usage_usd" field.
-func (m *UserSubscriptionMutation) ResetWeeklyUsageUsd() {
-	m.weekly_usage_usd = nil
-	m.addweekly_usage_usd = nil
+// ClearDailyLimitUsd clears the value of the "daily_limit_usd" field.
+func (m *UserSubscriptionMutation) ClearDailyLimitUsd() {
+	m.daily_limit_usd = nil
+	m.adddaily_limit_usd = nil
+	m.clearedFields[usersubscription.FieldDailyLimitUsd] = struct{}{}
 }
 
-// SetMonthlyUsageUsd sets the "monthly_usage_usd" field.
-func (m *UserSubscriptionMutation) SetMonthlyUsageUsd(f float64) {
-	m.monthly_usage_usd = &f
-	m.addmonthly_usage_usd = nil
+// DailyLimitUsdCleared returns if the "daily_limit_usd" field was cleared in this mutation.
+func (m *UserSubscriptionMutation) DailyLimitUsdCleared() bool {
+	_, ok := m.clearedFields[usersubscription.FieldDailyLimitUsd]
+	return ok
+}
+
+// ResetDailyLimitUsd resets all changes to the "daily_limit_usd" field.
+func (m *UserSubscriptionMutation) ResetDailyLimitUsd() {
+	m.daily_limit_usd = nil
+	m.adddaily_limit_usd = nil
+	delete(m.clearedFields, usersubscription.FieldDailyLimitUsd)
+}
+
+// SetWeeklyLimitUsd sets the "weekly_limit_usd" field.
+func (m *UserSubscriptionMutation) SetWeeklyLimitUsd(f float64) {
+	m.weekly_limit_usd = &f
+	m.addweekly_limit_usd = nil
 }
 
-// MonthlyUsageUsd returns the value of the "monthly_usage_usd" field in the mutation.
-func (m *UserSubscriptionMutation) MonthlyUsageUsd() (r float64, exists bool) {
-	v := m.monthly_usage_usd
+// WeeklyLimitUsd returns the value of the "weekly_limit_usd" field in the mutation.
+func (m *UserSubscriptionMutation) WeeklyLimitUsd() (r float64, exists bool) {
+	v := m.weekly_limit_usd
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMonthlyUsageUsd returns the old "monthly_usage_usd" field's value of the UserSubscription entity.
+// OldWeeklyLimitUsd returns the old "weekly_limit_usd" field's value of the UserSubscription entity.
 // If the UserSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserSubscriptionMutation) OldMonthlyUsageUsd(ctx context.Context) (v float64, err error) {
+func (m *UserSubscriptionMutation) OldWeeklyLimitUsd(ctx context.Context) (v *float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMonthlyUsageUsd is only allowed on UpdateOne operations")
+		return v, errors.New("OldWeeklyLimitUsd is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMonthlyUsageUsd requires an ID field in the mutation")
+		return v, errors.New("OldWeeklyLimitUsd requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMonthlyUsageUsd: %w", err)
+		return v, fmt.Errorf("querying old value for OldWeeklyLimitUsd: %w", err)
 	}
-	return oldValue.MonthlyUsageUsd, nil
+	return oldValue.WeeklyLimitUsd, nil
 }
 
-// AddMonthlyUsageUsd adds f to the "monthly_usage_usd" field.
-func (m *UserSubscriptionMutation) AddMonthlyUsageUsd(f float64) {
-	if m.addmonthly_usage_usd != nil {
-		*m.addmonthly_usage_usd += f
+// AddWeeklyLimitUsd adds f to the "weekly_limit_usd" field.
+func (m *UserSubscriptionMutation) AddWeeklyLimitUsd(f float64) {
+	if m.addweekly_limit_usd != nil {
+		*m.addweekly_limit_usd += f
 	} else {
-		m.addmonthly_usage_usd = &f
+		m.addweekly_limit_usd = &f
 	}
 }
 
-// AddedMonthlyUsageUsd returns the value that was added to the "monthly_usage_usd" field in this mutation.
-func (m *UserSubscriptionMutation) AddedMonthlyUsageUsd() (r float64, exists bool) {
-	v := m.addmonthly_usage_usd
+// AddedWeeklyLimitUsd returns the value that was added to the "weekly_limit_usd" field in this mutation.
+func (m *UserSubscriptionMutation) AddedWeeklyLimitUsd() (r float64, exists bool) {
+	v := m.addweekly_limit_usd
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetMonthlyUsageUsd resets all changes to the "monthly_usage_usd" field.
-func (m *UserSubscriptionMutation) ResetMonthlyUsageUsd() {
-	m.monthly_usage_usd = nil
-	m.addmonthly_usage_usd = nil
+// ClearWeeklyLimitUsd clears the value of the "weekly_limit_usd" field.
+func (m *UserSubscriptionMutation) ClearWeeklyLimitUsd() {
+	m.weekly_limit_usd = nil
+	m.addweekly_limit_usd = nil
+	m.clearedFields[usersubscription.FieldWeeklyLimitUsd] = struct{}{}
 }
 
-// SetAssignedBy sets the "assigned_by" field.
-func (m *UserSubscriptionMutation) SetAssignedBy(i int64) {
-	m.assigned_by_user = &i
+// WeeklyLimitUsdCleared returns if the "weekly_limit_usd" field was cleared in this mutation.
+func (m *UserSubscriptionMutation) WeeklyLimitUsdCleared() bool {
+	_, ok := m.clearedFields[usersubscription.FieldWeeklyLimitUsd]
+	return ok
 }
 
-// AssignedBy returns the value of the "assigned_by" field in the mutation.
-func (m *UserSubscriptionMutation) AssignedBy() (r int64, exists bool) {
-	v := m.assigned_by_user
+// ResetWeeklyLimitUsd resets all changes to the "weekly_limit_usd" field.
+func (m *UserSubscriptionMutation) ResetWeeklyLimitUsd() {
+	m.weekly_limit_usd = nil
+	m.addweekly_limit_usd = nil
+	delete(m.clearedFields, usersubscription.FieldWeeklyLimitUsd)
+}
+
+// SetMonthlyLimitUsd sets the "monthly_limit_usd" field.
+func (m *UserSubscriptionMutation) SetMonthlyLimitUsd(f float64) {
+	m.monthly_limit_usd = &f
+	m.addmonthly_limit_usd = nil
+}
+
+// MonthlyLimitUsd returns the value of the "monthly_limit_usd" field in the mutation.
+func (m *UserSubscriptionMutation) MonthlyLimitUsd() (r float64, exists bool) {
+	v := m.monthly_limit_usd
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAssignedBy returns the old "assigned_by" field's value of the UserSubscription entity.
+// OldMonthlyLimitUsd returns the old "monthly_limit_usd" field's value of the UserSubscription entity.
 // If the UserSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserSubscriptionMutation) OldAssignedBy(ctx context.Context) (v *int64, err error) {
+func (m *UserSubscriptionMutation) OldMonthlyLimitUsd(ctx context.Context) (v *float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAssignedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldMonthlyLimitUsd is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAssignedBy requires an ID field in the mutation")
+		return v, errors.New("OldMonthlyLimitUsd requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAssignedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldMonthlyLimitUsd: %w", err)
 	}
-	return oldValue.AssignedBy, nil
+	return oldValue.MonthlyLimitUsd, nil
 }
 
-// ClearAssignedBy clears the value of the "assigned_by" field.
-func (m *UserSubscriptionMutation) ClearAssignedBy() {
-	m.assigned_by_user = nil
-	m.clearedFields[usersubscription.FieldAssignedBy] = struct{}{}
+// AddMonthlyLimitUsd adds f to the "monthly_limit_usd" field.
+func (m *UserSubscriptionMutation) AddMonthlyLimitUsd(f float64) {
+	if m.addmonthly_limit_usd != nil {
+		*m.addmonthly_limit_usd += f
+	} else {
+		m.addmonthly_limit_usd = &f
+	}
 }
 
-// AssignedByCleared returns if the "assigned_by" field was cleared in this mutation.
-func (m *UserSubscriptionMutation) AssignedByCleared() bool {
-	_, ok := m.clearedFields[usersubscription.FieldAssignedBy]
+// AddedMonthlyLimitUsd returns the value that was added to the "monthly_limit_usd" field in this mutation.
+func (m *UserSubscriptionMutation) AddedMonthlyLimitUsd() (r float64, exists bool) {
+	v := m.addmonthly_limit_usd
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMonthlyLimitUsd clears the value of the "monthly_limit_usd" field.
+func (m *UserSubscriptionMutation) ClearMonthlyLimitUsd() {
+	m.monthly_limit_usd = nil
+	m.addmonthly_limit_usd = nil
+	m.clearedFields[usersubscription.FieldMonthlyLimitUsd] = struct{}{}
+}
+
+// MonthlyLimitUsdCleared returns if the "monthly_limit_usd" field was cleared in this mutation.
+func (m *UserSubscriptionMutation) MonthlyLimitUsdCleared() bool {
+	_, ok := m.clearedFields[usersubscription.FieldMonthlyLimitUsd]
 	return ok
 }
 
-// ResetAssignedBy resets all changes to the "assigned_by" field.
-func (m *UserSubscriptionMutation) ResetAssignedBy() {
-	m.assigned_by_user = nil
-	delete(m.clearedFields, usersubscription.FieldAssignedBy)
+// ResetMonthlyLimitUsd resets all changes to the "monthly_limit_usd" field.
+func (m *UserSubscriptionMutation) ResetMonthlyLimitUsd() {
+	m.monthly_limit_usd = nil
+	m.addmonthly_limit_usd = nil
+	delete(m.clearedFields, usersubscription.FieldMonthlyLimitUsd)
 }
 
-// SetAssignedAt sets the "assigned_at" field.
-func (m *UserSubscriptionMutation) SetAssignedAt(t time.Time) {
-	m.assigned_at = &t
+// SetQuotaUsage sets the "quota_usage" field.
+func (m *UserSubscriptionMutation) SetQuotaUsage(value map[string]usagequota.Counter) {
+	m.quota_usage = &value
 }
 
-// AssignedAt returns the value of the "assigned_at" field in the mutation.
-func (m *UserSubscriptionMutation) AssignedAt() (r time.Time, exists bool) {
-	v := m.assigned_at
+// QuotaUsage returns the value of the "quota_usage" field in the mutation.
+func (m *UserSubscriptionMutation) QuotaUsage() (r map[string]usagequota.Counter, exists bool) {
+	v := m.quota_usage
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAssignedAt returns the old "assigned_at" field's value of the UserSubscription entity.
+// OldQuotaUsage returns the old "quota_usage" field's value of the UserSubscription entity.
 // If the UserSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserSubscriptionMutation) OldAssignedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserSubscriptionMutation) OldQuotaUsage(ctx context.Context) (v map[string]usagequota.Counter, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAssignedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldQuotaUsage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAssignedAt requires an ID field in the mutation")
+		return v, errors.New("OldQuotaUsage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAssignedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldQuotaUsage: %w", err)
 	}
-	return oldValue.AssignedAt, nil
+	return oldValue.QuotaUsage, nil
 }
 
-// ResetAssignedAt resets all changes to the "assigned_at" field.
-func (m *UserSubscriptionMutation) ResetAssignedAt() {
-	m.assigned_at = nil
+// ClearQuotaUsage clears the value of the "quota_usage" field.
+func (m *UserSubscriptionMutation) ClearQuotaUsage() {
+	m.quota_usage = nil
+	m.clearedFields[usersubscription.FieldQuotaUsage] = struct{}{}
 }
 
-// SetNotes sets the "notes" field.
-func (m *UserSubscriptionMutation) SetNotes(s string) {
-	m.notes = &s
+// QuotaUsageCleared returns if the "quota_usage" field was cleared in this mutation.
+func (m *UserSubscriptionMutation) QuotaUsageCleared() bool {
+	_, ok := m.clearedFields[usersubscription.FieldQuotaUsage]
+	return ok
 }
 
-// Notes returns the value of the "notes" field in the mutation.
-func (m *UserSubscriptionMutation) Notes() (r string, exists bool) {
-	v := m.notes
+// ResetQuotaUsage resets all changes to the "quota_usage" field.
+func (m *UserSubscriptionMutation) ResetQuotaUsage() {
+	m.quota_usage = nil
+	delete(m.clearedFields, usersubscription.FieldQuotaUsage)
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (m *UserSubscriptionMutation) SetPausedAt(t time.Time) {
+	m.paused_at = &t
+}
+
+// PausedAt returns the value of the "paused_at" field in the mutation.
+func (m *UserSubscriptionMutation) PausedAt() (r time.Time, exists bool) {
+	v := m.paused_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNotes returns the old "notes" field's value of the UserSubscription entity.
+// OldPausedAt returns the old "paused_at" field's value of the UserSubscription entity.
 // If the UserSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserSubscriptionMutation) OldNotes(ctx context.Context) (v *string, err error) {
+func (m *UserSubscriptionMutation) OldPausedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNotes is only allowed on UpdateOne operations")
+		return v, errors.New("OldPausedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNotes requires an ID field in the mutation")
+		return v, errors.New("OldPausedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNotes: %w", err)
+		return v, fmt.Errorf("querying old value for OldPausedAt: %w", err)
 	}
-	return oldValue.Notes, nil
+	return oldValue.PausedAt, nil
 }
 
-// ClearNotes clears the value of the "notes" field.
-func (m *UserSubscriptionMutation) ClearNotes() {
-	m.notes = nil
-	m.clearedFields[usersubscription.FieldNotes] = struct{}{}
+// ClearPausedAt clears the value of the "paused_at" field.
+func (m *UserSubscriptionMutation) ClearPausedAt() {
+	m.paused_at = nil
+	m.clearedFields[usersubscription.FieldPausedAt] = struct{}{}
 }
 
-// NotesCleared returns if the "notes" field was cleared in this mutation.
-func (m *UserSubscriptionMutation) NotesCleared() bool {
-	_, ok := m.clearedFields[usersubscription.FieldNotes]
+// PausedAtCleared returns if the "paused_at" field was cleared in this mutation.
+func (m *UserSubscriptionMutation) PausedAtCleared() bool {
+	_, ok := m.clearedFields[usersubscription.FieldPausedAt]
 	return ok
 }
 
-// ResetNotes resets all changes to the "notes" field.
-func (m *UserSubscriptionMutation) ResetNotes() {
-	m.notes = nil
-	delete(m.clearedFields, usersubscription.FieldNotes)
+// ResetPausedAt resets all changes to the "paused_at" field.
+func (m *UserSubscriptionMutation) ResetPausedAt() {
+	m.paused_at = nil
+	delete(m.clearedFields, usersubscription.FieldPausedAt)
 }
 
 // ClearUser clears the "user" edge to the User entity.
@@ -19256,7 +24485,7 @@ func (m *UserSubscriptionMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserSubscriptionMutation) Fields() []string {
-	fields := make([]string, 0, 17)
+	fields := make([]string, 0, 24)
 	if m.created_at != nil {
 		fields = append(fields, usersubscription.FieldCreatedAt)
 	}
@@ -19308,6 +24537,27 @@ func (m *UserSubscriptionMutation) Fields() []string {
 	if m.notes != nil {
 		fields = append(fields, usersubscription.FieldNotes)
 	}
+	if m.plan_id != nil {
+		fields = append(fields, usersubscription.FieldPlanID)
+	}
+	if m.auto_renew != nil {
+		fields = append(fields, usersubscription.FieldAutoRenew)
+	}
+	if m.daily_limit_usd != nil {
+		fields = append(fields, usersubscription.FieldDailyLimitUsd)
+	}
+	if m.weekly_limit_usd != nil {
+		fields = append(fields, usersubscription.FieldWeeklyLimitUsd)
+	}
+	if m.monthly_limit_usd != nil {
+		fields = append(fields, usersubscription.FieldMonthlyLimitUsd)
+	}
+	if m.quota_usage != nil {
+		fields = append(fields, usersubscription.FieldQuotaUsage)
+	}
+	if m.paused_at != nil {
+		fields = append(fields, usersubscription.FieldPausedAt)
+	}
 	return fields
 }
 
@@ -19350,6 +24600,20 @@ func (m *UserSubscriptionMutation) Field(name string) (ent.Value, bool) {
 		return m.AssignedAt()
 	case usersubscription.FieldNotes:
 		return m.Notes()
+	case usersubscription.FieldPlanID:
+		return m.PlanID()
+	case usersubscription.FieldAutoRenew:
+		return m.AutoRenew()
+	case usersubscription.FieldDailyLimitUsd:
+		return m.DailyLimitUsd()
+	case usersubscription.FieldWeeklyLimitUsd:
+		return m.WeeklyLimitUsd()
+	case usersubscription.FieldMonthlyLimitUsd:
+		return m.MonthlyLimitUsd()
+	case usersubscription.FieldQuotaUsage:
+		return m.QuotaUsage()
+	case usersubscription.FieldPausedAt:
+		return m.PausedAt()
 	}
 	return nil, false
 }
@@ -19393,6 +24657,20 @@ func (m *UserSubscriptionMutation) OldField(ctx context.Context, name string) (e
 		return m.OldAssignedAt(ctx)
 	case usersubscription.FieldNotes:
 		return m.OldNotes(ctx)
+	case usersubscription.FieldPlanID:
+		return m.OldPlanID(ctx)
+	case usersubscription.FieldAutoRenew:
+		return m.OldAutoRenew(ctx)
+	case usersubscription.FieldDailyLimitUsd:
+		return m.OldDailyLimitUsd(ctx)
+	case usersubscription.FieldWeeklyLimitUsd:
+		return m.OldWeeklyLimitUsd(ctx)
+	case usersubscription.FieldMonthlyLimitUsd:
+		return m.OldMonthlyLimitUsd(ctx)
+	case usersubscription.FieldQuotaUsage:
+		return m.OldQuotaUsage(ctx)
+	case usersubscription.FieldPausedAt:
+		return m.OldPausedAt(ctx)
 	}
 	return nil, fmt.Errorf("unknown UserSubscription field %s", name)
 }
@@ -19521,6 +24799,55 @@ func (m *UserSubscriptionMutation) SetField(name string, value ent.Value) error
 		}
 		m.SetNotes(v)
 		return nil
+	case usersubscription.FieldPlanID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlanID(v)
+		return nil
+	case usersubscription.FieldAutoRenew:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAutoRenew(v)
+		return nil
+	case usersubscription.FieldDailyLimitUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDailyLimitUsd(v)
+		return nil
+	case usersubscription.FieldWeeklyLimitUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWeeklyLimitUsd(v)
+		return nil
+	case usersubscription.FieldMonthlyLimitUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMonthlyLimitUsd(v)
+		return nil
+	case usersubscription.FieldQuotaUsage:
+		v, ok := value.(map[string]usagequota.Counter)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuotaUsage(v)
+		return nil
+	case usersubscription.FieldPausedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPausedAt(v)
+		return nil
 	}
 	return fmt.Errorf("unknown UserSubscription field %s", name)
 }
@@ -19538,6 +24865,18 @@ func (m *UserSubscriptionMutation) AddedFields() []string {
 	if m.addmonthly_usage_usd != nil {
 		fields = append(fields, usersubscription.FieldMonthlyUsageUsd)
 	}
+	if m.addplan_id != nil {
+		fields = append(fields, usersubscription.FieldPlanID)
+	}
+	if m.adddaily_limit_usd != nil {
+		fields = append(fields, usersubscription.FieldDailyLimitUsd)
+	}
+	if m.addweekly_limit_usd != nil {
+		fields = append(fields, usersubscription.FieldWeeklyLimitUsd)
+	}
+	if m.addmonthly_limit_usd != nil {
+		fields = append(fields, usersubscription.FieldMonthlyLimitUsd)
+	}
 	return fields
 }
 
@@ -19552,6 +24891,14 @@ func (m *UserSubscriptionMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedWeeklyUsageUsd()
 	case usersubscription.FieldMonthlyUsageUsd:
 		return m.AddedMonthlyUsageUsd()
+	case usersubscription.FieldPlanID:
+		return m.AddedPlanID()
+	case usersubscription.FieldDailyLimitUsd:
+		return m.AddedDailyLimitUsd()
+	case usersubscription.FieldWeeklyLimitUsd:
+		return m.AddedWeeklyLimitUsd()
+	case usersubscription.FieldMonthlyLimitUsd:
+		return m.AddedMonthlyLimitUsd()
 	}
 	return nil, false
 }
@@ -19582,6 +24929,34 @@ func (m *UserSubscriptionMutation) AddField(name string, value ent.Value) error
 		}
 		m.AddMonthlyUsageUsd(v)
 		return nil
+	case usersubscription.FieldPlanID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPlanID(v)
+		return nil
+	case usersubscription.FieldDailyLimitUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDailyLimitUsd(v)
+		return nil
+	case usersubscription.FieldWeeklyLimitUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWeeklyLimitUsd(v)
+		return nil
+	case usersubscription.FieldMonthlyLimitUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMonthlyLimitUsd(v)
+		return nil
 	}
 	return fmt.Errorf("unknown UserSubscription numeric field %s", name)
 }
@@ -19608,6 +24983,24 @@ func (m *UserSubscriptionMutation) ClearedFields() []string {
 	if m.FieldCleared(usersubscription.FieldNotes) {
 		fields = append(fields, usersubscription.FieldNotes)
 	}
+	if m.FieldCleared(usersubscription.FieldPlanID) {
+		fields = append(fields, usersubscription.FieldPlanID)
+	}
+	if m.FieldCleared(usersubscription.FieldDailyLimitUsd) {
+		fields = append(fields, usersubscription.FieldDailyLimitUsd)
+	}
+	if m.FieldCleared(usersubscription.FieldWeeklyLimitUsd) {
+		fields = append(fields, usersubscription.FieldWeeklyLimitUsd)
+	}
+	if m.FieldCleared(usersubscription.FieldMonthlyLimitUsd) {
+		fields = append(fields, usersubscription.FieldMonthlyLimitUsd)
+	}
+	if m.FieldCleared(usersubscription.FieldQuotaUsage) {
+		fields = append(fields, usersubscription.FieldQuotaUsage)
+	}
+	if m.FieldCleared(usersubscription.FieldPausedAt) {
+		fields = append(fields, usersubscription.FieldPausedAt)
+	}
 	return fields
 }
 
@@ -19640,6 +25033,24 @@ func (m *UserSubscriptionMutation) ClearField(name string) error {
 	case usersubscription.FieldNotes:
 		m.ClearNotes()
 		return nil
+	case usersubscription.FieldPlanID:
+		m.ClearPlanID()
+		return nil
+	case usersubscription.FieldDailyLimitUsd:
+		m.ClearDailyLimitUsd()
+		return nil
+	case usersubscription.FieldWeeklyLimitUsd:
+		m.ClearWeeklyLimitUsd()
+		return nil
+	case usersubscription.FieldMonthlyLimitUsd:
+		m.ClearMonthlyLimitUsd()
+		return nil
+	case usersubscription.FieldQuotaUsage:
+		m.ClearQuotaUsage()
+		return nil
+	case usersubscription.FieldPausedAt:
+		m.ClearPausedAt()
+		return nil
 	}
 	return fmt.Errorf("unknown UserSubscription nullable field %s", name)
 }
@@ -19699,6 +25110,27 @@ func (m *UserSubscriptionMutation) ResetField(name string) error {
 	case usersubscription.FieldNotes:
 		m.ResetNotes()
 		return nil
+	case usersubscription.FieldPlanID:
+		m.ResetPlanID()
+		return nil
+	case usersubscription.FieldAutoRenew:
+		m.ResetAutoRenew()
+		return nil
+	case usersubscription.FieldDailyLimitUsd:
+		m.ResetDailyLimitUsd()
+		return nil
+	case usersubscription.FieldWeeklyLimitUsd:
+		m.ResetWeeklyLimitUsd()
+		return nil
+	case usersubscription.FieldMonthlyLimitUsd:
+		m.ResetMonthlyLimitUsd()
+		return nil
+	case usersubscription.FieldQuotaUsage:
+		m.ResetQuotaUsage()
+		return nil
+	case usersubscription.FieldPausedAt:
+		m.ResetPausedAt()
+		return nil
 	}
 	return fmt.Errorf("unknown UserSubscription field %s", name)
 }
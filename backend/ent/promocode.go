@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -21,6 +22,8 @@ type PromoCode struct {
 	Code string `json:"code,omitempty"`
 	// 赠送余额金额
 	BonusAmount float64 `json:"bonus_amount,omitempty"`
+	// 赠送余额的有效期（天），0 表示永不过期
+	CreditValidityDays int `json:"credit_validity_days,omitempty"`
 	// 最大使用次数，0表示无限制
 	MaxUses int `json:"max_uses,omitempty"`
 	// 已使用次数
@@ -31,6 +34,24 @@ type PromoCode struct {
 	ExpiresAt *time.Time `json:"expires_at,omitempty"`
 	// 备注
 	Notes *string `json:"notes,omitempty"`
+	// 使用场景: register, topup
+	Scope string `json:"scope,omitempty"`
+	// 充值赠送比例（%），仅 topup 场景
+	BonusPercent float64 `json:"bonus_percent,omitempty"`
+	// 单次赠送余额上限，null 表示不限
+	MaxBonusAmount *float64 `json:"max_bonus_amount,omitempty"`
+	// 赠送订阅试用的分组
+	TrialGroupID *int64 `json:"trial_group_id,omitempty"`
+	// 订阅试用天数
+	TrialDays int `json:"trial_days,omitempty"`
+	// 仅限注册 N 天内的用户使用，0 表示不限
+	NewUserDays int `json:"new_user_days,omitempty"`
+	// 仅限这些邮箱域名的用户使用，空表示不限
+	AllowedEmailDomains []string `json:"allowed_email_domains,omitempty"`
+	// 是否可与其他优惠码叠加使用
+	Stackable bool `json:"stackable,omitempty"`
+	// 每个用户最多使用次数，0表示无限制
+	MaxUsesPerUser int `json:"max_uses_per_user,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -64,11 +85,15 @@ func (*PromoCode) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case promocode.FieldBonusAmount:
+		case promocode.FieldAllowedEmailDomains:
+			values[i] = new([]byte)
+		case promocode.FieldStackable:
+			values[i] = new(sql.NullBool)
+		case promocode.FieldBonusAmount, promocode.FieldBonusPercent, promocode.FieldMaxBonusAmount:
 			values[i] = new(sql.NullFloat64)
-		case promocode.FieldID, promocode.FieldMaxUses, promocode.FieldUsedCount:
+		case promocode.FieldID, promocode.FieldCreditValidityDays, promocode.FieldMaxUses, promocode.FieldUsedCount, promocode.FieldTrialGroupID, promocode.FieldTrialDays, promocode.FieldNewUserDays, promocode.FieldMaxUsesPerUser:
 			values[i] = new(sql.NullInt64)
-		case promocode.FieldCode, promocode.FieldStatus, promocode.FieldNotes:
+		case promocode.FieldCode, promocode.FieldStatus, promocode.FieldNotes, promocode.FieldScope:
 			values[i] = new(sql.NullString)
 		case promocode.FieldExpiresAt, promocode.FieldCreatedAt, promocode.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -105,6 +130,12 @@ func (_m *PromoCode) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.BonusAmount = value.Float64
 			}
+		case promocode.FieldCreditValidityDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field credit_validity_days", values[i])
+			} else if value.Valid {
+				_m.CreditValidityDays = int(value.Int64)
+			}
 		case promocode.FieldMaxUses:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field max_uses", values[i])
@@ -137,6 +168,64 @@ func (_m *PromoCode) assignValues(columns []string, values []any) error {
 				_m.Notes = new(string)
 				*_m.Notes = value.String
 			}
+		case promocode.FieldScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope", values[i])
+			} else if value.Valid {
+				_m.Scope = value.String
+			}
+		case promocode.FieldBonusPercent:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field bonus_percent", values[i])
+			} else if value.Valid {
+				_m.BonusPercent = value.Float64
+			}
+		case promocode.FieldMaxBonusAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_bonus_amount", values[i])
+			} else if value.Valid {
+				_m.MaxBonusAmount = new(float64)
+				*_m.MaxBonusAmount = value.Float64
+			}
+		case promocode.FieldTrialGroupID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field trial_group_id", values[i])
+			} else if value.Valid {
+				_m.TrialGroupID = new(int64)
+				*_m.TrialGroupID = value.Int64
+			}
+		case promocode.FieldTrialDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field trial_days", values[i])
+			} else if value.Valid {
+				_m.TrialDays = int(value.Int64)
+			}
+		case promocode.FieldNewUserDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field new_user_days", values[i])
+			} else if value.Valid {
+				_m.NewUserDays = int(value.Int64)
+			}
+		case promocode.FieldAllowedEmailDomains:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field allowed_email_domains", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AllowedEmailDomains); err != nil {
+					return fmt.Errorf("unmarshal field allowed_email_domains: %w", err)
+				}
+			}
+		case promocode.FieldStackable:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field stackable", values[i])
+			} else if value.Valid {
+				_m.Stackable = value.Bool
+			}
+		case promocode.FieldMaxUsesPerUser:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_uses_per_user", values[i])
+			} else if value.Valid {
+				_m.MaxUsesPerUser = int(value.Int64)
+			}
 		case promocode.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -196,6 +285,9 @@ func (_m *PromoCode) String() string {
 	builder.WriteString("bonus_amount=")
 	builder.WriteString(fmt.Sprintf("%v", _m.BonusAmount))
 	builder.WriteString(", ")
+	builder.WriteString("credit_validity_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreditValidityDays))
+	builder.WriteString(", ")
 	builder.WriteString("max_uses=")
 	builder.WriteString(fmt.Sprintf("%v", _m.MaxUses))
 	builder.WriteString(", ")
@@ -215,6 +307,37 @@ func (_m *PromoCode) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	builder.WriteString("scope=")
+	builder.WriteString(_m.Scope)
+	builder.WriteString(", ")
+	builder.WriteString("bonus_percent=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BonusPercent))
+	builder.WriteString(", ")
+	if v := _m.MaxBonusAmount; v != nil {
+		builder.WriteString("max_bonus_amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TrialGroupID; v != nil {
+		builder.WriteString("trial_group_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("trial_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TrialDays))
+	builder.WriteString(", ")
+	builder.WriteString("new_user_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NewUserDays))
+	builder.WriteString(", ")
+	builder.WriteString("allowed_email_domains=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AllowedEmailDomains))
+	builder.WriteString(", ")
+	builder.WriteString("stackable=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Stackable))
+	builder.WriteString(", ")
+	builder.WriteString("max_uses_per_user=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxUsesPerUser))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -18,6 +18,8 @@ const (
 	FieldCode = "code"
 	// FieldBonusAmount holds the string denoting the bonus_amount field in the database.
 	FieldBonusAmount = "bonus_amount"
+	// FieldCreditValidityDays holds the string denoting the credit_validity_days field in the database.
+	FieldCreditValidityDays = "credit_validity_days"
 	// FieldMaxUses holds the string denoting the max_uses field in the database.
 	FieldMaxUses = "max_uses"
 	// FieldUsedCount holds the string denoting the used_count field in the database.
@@ -28,6 +30,24 @@ const (
 	FieldExpiresAt = "expires_at"
 	// FieldNotes holds the string denoting the notes field in the database.
 	FieldNotes = "notes"
+	// FieldScope holds the string denoting the scope field in the database.
+	FieldScope = "scope"
+	// FieldBonusPercent holds the string denoting the bonus_percent field in the database.
+	FieldBonusPercent = "bonus_percent"
+	// FieldMaxBonusAmount holds the string denoting the max_bonus_amount field in the database.
+	FieldMaxBonusAmount = "max_bonus_amount"
+	// FieldTrialGroupID holds the string denoting the trial_group_id field in the database.
+	FieldTrialGroupID = "trial_group_id"
+	// FieldTrialDays holds the string denoting the trial_days field in the database.
+	FieldTrialDays = "trial_days"
+	// FieldNewUserDays holds the string denoting the new_user_days field in the database.
+	FieldNewUserDays = "new_user_days"
+	// FieldAllowedEmailDomains holds the string denoting the allowed_email_domains field in the database.
+	FieldAllowedEmailDomains = "allowed_email_domains"
+	// FieldStackable holds the string denoting the stackable field in the database.
+	FieldStackable = "stackable"
+	// FieldMaxUsesPerUser holds the string denoting the max_uses_per_user field in the database.
+	FieldMaxUsesPerUser = "max_uses_per_user"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -50,11 +70,21 @@ var Columns = []string{
 	FieldID,
 	FieldCode,
 	FieldBonusAmount,
+	FieldCreditValidityDays,
 	FieldMaxUses,
 	FieldUsedCount,
 	FieldStatus,
 	FieldExpiresAt,
 	FieldNotes,
+	FieldScope,
+	FieldBonusPercent,
+	FieldMaxBonusAmount,
+	FieldTrialGroupID,
+	FieldTrialDays,
+	FieldNewUserDays,
+	FieldAllowedEmailDomains,
+	FieldStackable,
+	FieldMaxUsesPerUser,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -74,6 +104,8 @@ var (
 	CodeValidator func(string) error
 	// DefaultBonusAmount holds the default value on creation for the "bonus_amount" field.
 	DefaultBonusAmount float64
+	// DefaultCreditValidityDays holds the default value on creation for the "credit_validity_days" field.
+	DefaultCreditValidityDays int
 	// DefaultMaxUses holds the default value on creation for the "max_uses" field.
 	DefaultMaxUses int
 	// DefaultUsedCount holds the default value on creation for the "used_count" field.
@@ -82,6 +114,20 @@ var (
 	DefaultStatus string
 	// StatusValidator is a validator for the "status" field. It is called by the builders before save.
 	StatusValidator func(string) error
+	// DefaultScope holds the default value on creation for the "scope" field.
+	DefaultScope string
+	// ScopeValidator is a validator for the "scope" field. It is called by the builders before save.
+	ScopeValidator func(string) error
+	// DefaultBonusPercent holds the default value on creation for the "bonus_percent" field.
+	DefaultBonusPercent float64
+	// DefaultTrialDays holds the default value on creation for the "trial_days" field.
+	DefaultTrialDays int
+	// DefaultNewUserDays holds the default value on creation for the "new_user_days" field.
+	DefaultNewUserDays int
+	// DefaultStackable holds the default value on creation for the "stackable" field.
+	DefaultStackable bool
+	// DefaultMaxUsesPerUser holds the default value on creation for the "max_uses_per_user" field.
+	DefaultMaxUsesPerUser int
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -108,6 +154,11 @@ func ByBonusAmount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBonusAmount, opts...).ToFunc()
 }
 
+// ByCreditValidityDays orders the results by the credit_validity_days field.
+func ByCreditValidityDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreditValidityDays, opts...).ToFunc()
+}
+
 // ByMaxUses orders the results by the max_uses field.
 func ByMaxUses(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMaxUses, opts...).ToFunc()
@@ -133,6 +184,46 @@ func ByNotes(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldNotes, opts...).ToFunc()
 }
 
+// ByScope orders the results by the scope field.
+func ByScope(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScope, opts...).ToFunc()
+}
+
+// ByBonusPercent orders the results by the bonus_percent field.
+func ByBonusPercent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBonusPercent, opts...).ToFunc()
+}
+
+// ByMaxBonusAmount orders the results by the max_bonus_amount field.
+func ByMaxBonusAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxBonusAmount, opts...).ToFunc()
+}
+
+// ByTrialGroupID orders the results by the trial_group_id field.
+func ByTrialGroupID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrialGroupID, opts...).ToFunc()
+}
+
+// ByTrialDays orders the results by the trial_days field.
+func ByTrialDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrialDays, opts...).ToFunc()
+}
+
+// ByNewUserDays orders the results by the new_user_days field.
+func ByNewUserDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNewUserDays, opts...).ToFunc()
+}
+
+// ByStackable orders the results by the stackable field.
+func ByStackable(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStackable, opts...).ToFunc()
+}
+
+// ByMaxUsesPerUser orders the results by the max_uses_per_user field.
+func ByMaxUsesPerUser(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxUsesPerUser, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -65,6 +65,11 @@ func BonusAmount(v float64) predicate.PromoCode {
 	return predicate.PromoCode(sql.FieldEQ(FieldBonusAmount, v))
 }
 
+// CreditValidityDays applies equality check predicate on the "credit_validity_days" field. It's identical to CreditValidityDaysEQ.
+func CreditValidityDays(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldCreditValidityDays, v))
+}
+
 // MaxUses applies equality check predicate on the "max_uses" field. It's identical to MaxUsesEQ.
 func MaxUses(v int) predicate.PromoCode {
 	return predicate.PromoCode(sql.FieldEQ(FieldMaxUses, v))
@@ -90,6 +95,46 @@ func Notes(v string) predicate.PromoCode {
 	return predicate.PromoCode(sql.FieldEQ(FieldNotes, v))
 }
 
+// Scope applies equality check predicate on the "scope" field. It's identical to ScopeEQ.
+func Scope(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldScope, v))
+}
+
+// BonusPercent applies equality check predicate on the "bonus_percent" field. It's identical to BonusPercentEQ.
+func BonusPercent(v float64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldBonusPercent, v))
+}
+
+// MaxBonusAmount applies equality check predicate on the "max_bonus_amount" field. It's identical to MaxBonusAmountEQ.
+func MaxBonusAmount(v float64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldMaxBonusAmount, v))
+}
+
+// TrialGroupID applies equality check predicate on the "trial_group_id" field. It's identical to TrialGroupIDEQ.
+func TrialGroupID(v int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldTrialGroupID, v))
+}
+
+// TrialDays applies equality check predicate on the "trial_days" field. It's identical to TrialDaysEQ.
+func TrialDays(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldTrialDays, v))
+}
+
+// NewUserDays applies equality check predicate on the "new_user_days" field. It's identical to NewUserDaysEQ.
+func NewUserDays(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldNewUserDays, v))
+}
+
+// Stackable applies equality check predicate on the "stackable" field. It's identical to StackableEQ.
+func Stackable(v bool) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldStackable, v))
+}
+
+// MaxUsesPerUser applies equality check predicate on the "max_uses_per_user" field. It's identical to MaxUsesPerUserEQ.
+func MaxUsesPerUser(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldMaxUsesPerUser, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.PromoCode {
 	return predicate.PromoCode(sql.FieldEQ(FieldCreatedAt, v))
@@ -205,6 +250,46 @@ func BonusAmountLTE(v float64) predicate.PromoCode {
 	return predicate.PromoCode(sql.FieldLTE(FieldBonusAmount, v))
 }
 
+// CreditValidityDaysEQ applies the EQ predicate on the "credit_validity_days" field.
+func CreditValidityDaysEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldCreditValidityDays, v))
+}
+
+// CreditValidityDaysNEQ applies the NEQ predicate on the "credit_validity_days" field.
+func CreditValidityDaysNEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldCreditValidityDays, v))
+}
+
+// CreditValidityDaysIn applies the In predicate on the "credit_validity_days" field.
+func CreditValidityDaysIn(vs ...int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldCreditValidityDays, vs...))
+}
+
+// CreditValidityDaysNotIn applies the NotIn predicate on the "credit_validity_days" field.
+func CreditValidityDaysNotIn(vs ...int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldCreditValidityDays, vs...))
+}
+
+// CreditValidityDaysGT applies the GT predicate on the "credit_validity_days" field.
+func CreditValidityDaysGT(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldCreditValidityDays, v))
+}
+
+// CreditValidityDaysGTE applies the GTE predicate on the "credit_validity_days" field.
+func CreditValidityDaysGTE(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldCreditValidityDays, v))
+}
+
+// CreditValidityDaysLT applies the LT predicate on the "credit_validity_days" field.
+func CreditValidityDaysLT(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldCreditValidityDays, v))
+}
+
+// CreditValidityDaysLTE applies the LTE predicate on the "credit_validity_days" field.
+func CreditValidityDaysLTE(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldCreditValidityDays, v))
+}
+
 // MaxUsesEQ applies the EQ predicate on the "max_uses" field.
 func MaxUsesEQ(v int) predicate.PromoCode {
 	return predicate.PromoCode(sql.FieldEQ(FieldMaxUses, v))
@@ -475,6 +560,351 @@ func NotesContainsFold(v string) predicate.PromoCode {
 	return predicate.PromoCode(sql.FieldContainsFold(FieldNotes, v))
 }
 
+// ScopeEQ applies the EQ predicate on the "scope" field.
+func ScopeEQ(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldScope, v))
+}
+
+// ScopeNEQ applies the NEQ predicate on the "scope" field.
+func ScopeNEQ(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldScope, v))
+}
+
+// ScopeIn applies the In predicate on the "scope" field.
+func ScopeIn(vs ...string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldScope, vs...))
+}
+
+// ScopeNotIn applies the NotIn predicate on the "scope" field.
+func ScopeNotIn(vs ...string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldScope, vs...))
+}
+
+// ScopeGT applies the GT predicate on the "scope" field.
+func ScopeGT(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldScope, v))
+}
+
+// ScopeGTE applies the GTE predicate on the "scope" field.
+func ScopeGTE(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldScope, v))
+}
+
+// ScopeLT applies the LT predicate on the "scope" field.
+func ScopeLT(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldScope, v))
+}
+
+// ScopeLTE applies the LTE predicate on the "scope" field.
+func ScopeLTE(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldScope, v))
+}
+
+// ScopeContains applies the Contains predicate on the "scope" field.
+func ScopeContains(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldContains(FieldScope, v))
+}
+
+// ScopeHasPrefix applies the HasPrefix predicate on the "scope" field.
+func ScopeHasPrefix(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldHasPrefix(FieldScope, v))
+}
+
+// ScopeHasSuffix applies the HasSuffix predicate on the "scope" field.
+func ScopeHasSuffix(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldHasSuffix(FieldScope, v))
+}
+
+// ScopeEqualFold applies the EqualFold predicate on the "scope" field.
+func ScopeEqualFold(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEqualFold(FieldScope, v))
+}
+
+// ScopeContainsFold applies the ContainsFold predicate on the "scope" field.
+func ScopeContainsFold(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldContainsFold(FieldScope, v))
+}
+
+// BonusPercentEQ applies the EQ predicate on the "bonus_percent" field.
+func BonusPercentEQ(v float64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldBonusPercent, v))
+}
+
+// BonusPercentNEQ applies the NEQ predicate on the "bonus_percent" field.
+func BonusPercentNEQ(v float64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldBonusPercent, v))
+}
+
+// BonusPercentIn applies the In predicate on the "bonus_percent" field.
+func BonusPercentIn(vs ...float64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldBonusPercent, vs...))
+}
+
+// BonusPercentNotIn applies the NotIn predicate on the "bonus_percent" field.
+func BonusPercentNotIn(vs ...float64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldBonusPercent, vs...))
+}
+
+// BonusPercentGT applies the GT predicate on the "bonus_percent" field.
+func BonusPercentGT(v float64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldBonusPercent, v))
+}
+
+// BonusPercentGTE applies the GTE predicate on the "bonus_percent" field.
+func BonusPercentGTE(v float64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldBonusPercent, v))
+}
+
+// BonusPercentLT applies the LT predicate on the "bonus_percent" field.
+func BonusPercentLT(v float64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldBonusPercent, v))
+}
+
+// BonusPercentLTE applies the LTE predicate on the "bonus_percent" field.
+func BonusPercentLTE(v float64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldBonusPercent, v))
+}
+
+// MaxBonusAmountEQ applies the EQ predicate on the "max_bonus_amount" field.
+func MaxBonusAmountEQ(v float64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldMaxBonusAmount, v))
+}
+
+// MaxBonusAmountNEQ applies the NEQ predicate on the "max_bonus_amount" field.
+func MaxBonusAmountNEQ(v float64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldMaxBonusAmount, v))
+}
+
+// MaxBonusAmountIn applies the In predicate on the "max_bonus_amount" field.
+func MaxBonusAmountIn(vs ...float64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldMaxBonusAmount, vs...))
+}
+
+// MaxBonusAmountNotIn applies the NotIn predicate on the "max_bonus_amount" field.
+func MaxBonusAmountNotIn(vs ...float64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldMaxBonusAmount, vs...))
+}
+
+// MaxBonusAmountGT applies the GT predicate on the "max_bonus_amount" field.
+func MaxBonusAmountGT(v float64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldMaxBonusAmount, v))
+}
+
+// MaxBonusAmountGTE applies the GTE predicate on the "max_bonus_amount" field.
+func MaxBonusAmountGTE(v float64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldMaxBonusAmount, v))
+}
+
+// MaxBonusAmountLT applies the LT predicate on the "max_bonus_amount" field.
+func MaxBonusAmountLT(v float64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldMaxBonusAmount, v))
+}
+
+// MaxBonusAmountLTE applies the LTE predicate on the "max_bonus_amount" field.
+func MaxBonusAmountLTE(v float64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldMaxBonusAmount, v))
+}
+
+// MaxBonusAmountIsNil applies the IsNil predicate on the "max_bonus_amount" field.
+func MaxBonusAmountIsNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIsNull(FieldMaxBonusAmount))
+}
+
+// MaxBonusAmountNotNil applies the NotNil predicate on the "max_bonus_amount" field.
+func MaxBonusAmountNotNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotNull(FieldMaxBonusAmount))
+}
+
+// TrialGroupIDEQ applies the EQ predicate on the "trial_group_id" field.
+func TrialGroupIDEQ(v int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldTrialGroupID, v))
+}
+
+// TrialGroupIDNEQ applies the NEQ predicate on the "trial_group_id" field.
+func TrialGroupIDNEQ(v int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldTrialGroupID, v))
+}
+
+// TrialGroupIDIn applies the In predicate on the "trial_group_id" field.
+func TrialGroupIDIn(vs ...int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldTrialGroupID, vs...))
+}
+
+// TrialGroupIDNotIn applies the NotIn predicate on the "trial_group_id" field.
+func TrialGroupIDNotIn(vs ...int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldTrialGroupID, vs...))
+}
+
+// TrialGroupIDGT applies the GT predicate on the "trial_group_id" field.
+func TrialGroupIDGT(v int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldTrialGroupID, v))
+}
+
+// TrialGroupIDGTE applies the GTE predicate on the "trial_group_id" field.
+func TrialGroupIDGTE(v int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldTrialGroupID, v))
+}
+
+// TrialGroupIDLT applies the LT predicate on the "trial_group_id" field.
+func TrialGroupIDLT(v int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldTrialGroupID, v))
+}
+
+// TrialGroupIDLTE applies the LTE predicate on the "trial_group_id" field.
+func TrialGroupIDLTE(v int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldTrialGroupID, v))
+}
+
+// TrialGroupIDIsNil applies the IsNil predicate on the "trial_group_id" field.
+func TrialGroupIDIsNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIsNull(FieldTrialGroupID))
+}
+
+// TrialGroupIDNotNil applies the NotNil predicate on the "trial_group_id" field.
+func TrialGroupIDNotNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotNull(FieldTrialGroupID))
+}
+
+// TrialDaysEQ applies the EQ predicate on the "trial_days" field.
+func TrialDaysEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldTrialDays, v))
+}
+
+// TrialDaysNEQ applies the NEQ predicate on the "trial_days" field.
+func TrialDaysNEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldTrialDays, v))
+}
+
+// TrialDaysIn applies the In predicate on the "trial_days" field.
+func TrialDaysIn(vs ...int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldTrialDays, vs...))
+}
+
+// TrialDaysNotIn applies the NotIn predicate on the "trial_days" field.
+func TrialDaysNotIn(vs ...int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldTrialDays, vs...))
+}
+
+// TrialDaysGT applies the GT predicate on the "trial_days" field.
+func TrialDaysGT(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldTrialDays, v))
+}
+
+// TrialDaysGTE applies the GTE predicate on the "trial_days" field.
+func TrialDaysGTE(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldTrialDays, v))
+}
+
+// TrialDaysLT applies the LT predicate on the "trial_days" field.
+func TrialDaysLT(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldTrialDays, v))
+}
+
+// TrialDaysLTE applies the LTE predicate on the "trial_days" field.
+func TrialDaysLTE(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldTrialDays, v))
+}
+
+// NewUserDaysEQ applies the EQ predicate on the "new_user_days" field.
+func NewUserDaysEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldNewUserDays, v))
+}
+
+// NewUserDaysNEQ applies the NEQ predicate on the "new_user_days" field.
+func NewUserDaysNEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldNewUserDays, v))
+}
+
+// NewUserDaysIn applies the In predicate on the "new_user_days" field.
+func NewUserDaysIn(vs ...int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldNewUserDays, vs...))
+}
+
+// NewUserDaysNotIn applies the NotIn predicate on the "new_user_days" field.
+func NewUserDaysNotIn(vs ...int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldNewUserDays, vs...))
+}
+
+// NewUserDaysGT applies the GT predicate on the "new_user_days" field.
+func NewUserDaysGT(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldNewUserDays, v))
+}
+
+// NewUserDaysGTE applies the GTE predicate on the "new_user_days" field.
+func NewUserDaysGTE(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldNewUserDays, v))
+}
+
+// NewUserDaysLT applies the LT predicate on the "new_user_days" field.
+func NewUserDaysLT(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldNewUserDays, v))
+}
+
+// NewUserDaysLTE applies the LTE predicate on the "new_user_days" field.
+func NewUserDaysLTE(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldNewUserDays, v))
+}
+
+// AllowedEmailDomainsIsNil applies the IsNil predicate on the "allowed_email_domains" field.
+func AllowedEmailDomainsIsNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIsNull(FieldAllowedEmailDomains))
+}
+
+// AllowedEmailDomainsNotNil applies the NotNil predicate on the "allowed_email_domains" field.
+func AllowedEmailDomainsNotNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotNull(FieldAllowedEmailDomains))
+}
+
+// StackableEQ applies the EQ predicate on the "stackable" field.
+func StackableEQ(v bool) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldStackable, v))
+}
+
+// StackableNEQ applies the NEQ predicate on the "stackable" field.
+func StackableNEQ(v bool) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldStackable, v))
+}
+
+// MaxUsesPerUserEQ applies the EQ predicate on the "max_uses_per_user" field.
+func MaxUsesPerUserEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldMaxUsesPerUser, v))
+}
+
+// MaxUsesPerUserNEQ applies the NEQ predicate on the "max_uses_per_user" field.
+func MaxUsesPerUserNEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldMaxUsesPerUser, v))
+}
+
+// MaxUsesPerUserIn applies the In predicate on the "max_uses_per_user" field.
+func MaxUsesPerUserIn(vs ...int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldMaxUsesPerUser, vs...))
+}
+
+// MaxUsesPerUserNotIn applies the NotIn predicate on the "max_uses_per_user" field.
+func MaxUsesPerUserNotIn(vs ...int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldMaxUsesPerUser, vs...))
+}
+
+// MaxUsesPerUserGT applies the GT predicate on the "max_uses_per_user" field.
+func MaxUsesPerUserGT(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldMaxUsesPerUser, v))
+}
+
+// MaxUsesPerUserGTE applies the GTE predicate on the "max_uses_per_user" field.
+func MaxUsesPerUserGTE(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldMaxUsesPerUser, v))
+}
+
+// MaxUsesPerUserLT applies the LT predicate on the "max_uses_per_user" field.
+func MaxUsesPerUserLT(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldMaxUsesPerUser, v))
+}
+
+// MaxUsesPerUserLTE applies the LTE predicate on the "max_uses_per_user" field.
+func MaxUsesPerUserLTE(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldMaxUsesPerUser, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.PromoCode {
 	return predicate.PromoCode(sql.FieldEQ(FieldCreatedAt, v))
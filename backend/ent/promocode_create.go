@@ -43,6 +43,20 @@ func (_c *PromoCodeCreate) SetNillableBonusAmount(v *float64) *PromoCodeCreate {
 	return _c
 }
 
+// SetCreditValidityDays sets the "credit_validity_days" field.
+func (_c *PromoCodeCreate) SetCreditValidityDays(v int) *PromoCodeCreate {
+	_c.mutation.SetCreditValidityDays(v)
+	return _c
+}
+
+// SetNillableCreditValidityDays sets the "credit_validity_days" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableCreditValidityDays(v *int) *PromoCodeCreate {
+	if v != nil {
+		_c.SetCreditValidityDays(*v)
+	}
+	return _c
+}
+
 // SetMaxUses sets the "max_uses" field.
 func (_c *PromoCodeCreate) SetMaxUses(v int) *PromoCodeCreate {
 	_c.mutation.SetMaxUses(v)
@@ -113,6 +127,124 @@ func (_c *PromoCodeCreate) SetNillableNotes(v *string) *PromoCodeCreate {
 	return _c
 }
 
+// SetScope sets the "scope" field.
+func (_c *PromoCodeCreate) SetScope(v string) *PromoCodeCreate {
+	_c.mutation.SetScope(v)
+	return _c
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableScope(v *string) *PromoCodeCreate {
+	if v != nil {
+		_c.SetScope(*v)
+	}
+	return _c
+}
+
+// SetBonusPercent sets the "bonus_percent" field.
+func (_c *PromoCodeCreate) SetBonusPercent(v float64) *PromoCodeCreate {
+	_c.mutation.SetBonusPercent(v)
+	return _c
+}
+
+// SetNillableBonusPercent sets the "bonus_percent" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableBonusPercent(v *float64) *PromoCodeCreate {
+	if v != nil {
+		_c.SetBonusPercent(*v)
+	}
+	return _c
+}
+
+// SetMaxBonusAmount sets the "max_bonus_amount" field.
+func (_c *PromoCodeCreate) SetMaxBonusAmount(v float64) *PromoCodeCreate {
+	_c.mutation.SetMaxBonusAmount(v)
+	return _c
+}
+
+// SetNillableMaxBonusAmount sets the "max_bonus_amount" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableMaxBonusAmount(v *float64) *PromoCodeCreate {
+	if v != nil {
+		_c.SetMaxBonusAmount(*v)
+	}
+	return _c
+}
+
+// SetTrialGroupID sets the "trial_group_id" field.
+func (_c *PromoCodeCreate) SetTrialGroupID(v int64) *PromoCodeCreate {
+	_c.mutation.SetTrialGroupID(v)
+	return _c
+}
+
+// SetNillableTrialGroupID sets the "trial_group_id" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableTrialGroupID(v *int64) *PromoCodeCreate {
+	if v != nil {
+		_c.SetTrialGroupID(*v)
+	}
+	return _c
+}
+
+// SetTrialDays sets the "trial_days" field.
+func (_c *PromoCodeCreate) SetTrialDays(v int) *PromoCodeCreate {
+	_c.mutation.SetTrialDays(v)
+	return _c
+}
+
+// SetNillableTrialDays sets the "trial_days" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableTrialDays(v *int) *PromoCodeCreate {
+	if v != nil {
+		_c.SetTrialDays(*v)
+	}
+	return _c
+}
+
+// SetNewUserDays sets the "new_user_days" field.
+func (_c *PromoCodeCreate) SetNewUserDays(v int) *PromoCodeCreate {
+	_c.mutation.SetNewUserDays(v)
+	return _c
+}
+
+// SetNillableNewUserDays sets the "new_user_days" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableNewUserDays(v *int) *PromoCodeCreate {
+	if v != nil {
+		_c.SetNewUserDays(*v)
+	}
+	return _c
+}
+
+// SetAllowedEmailDomains sets the "allowed_email_domains" field.
+func (_c *PromoCodeCreate) SetAllowedEmailDomains(v []string) *PromoCodeCreate {
+	_c.mutation.SetAllowedEmailDomains(v)
+	return _c
+}
+
+// SetStackable sets the "stackable" field.
+func (_c *PromoCodeCreate) SetStackable(v bool) *PromoCodeCreate {
+	_c.mutation.SetStackable(v)
+	return _c
+}
+
+// SetNillableStackable sets the "stackable" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableStackable(v *bool) *PromoCodeCreate {
+	if v != nil {
+		_c.SetStackable(*v)
+	}
+	return _c
+}
+
+// SetMaxUsesPerUser sets the "max_uses_per_user" field.
+func (_c *PromoCodeCreate) SetMaxUsesPerUser(v int) *PromoCodeCreate {
+	_c.mutation.SetMaxUsesPerUser(v)
+	return _c
+}
+
+// SetNillableMaxUsesPerUser sets the "max_uses_per_user" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableMaxUsesPerUser(v *int) *PromoCodeCreate {
+	if v != nil {
+		_c.SetMaxUsesPerUser(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *PromoCodeCreate) SetCreatedAt(v time.Time) *PromoCodeCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -195,6 +327,10 @@ func (_c *PromoCodeCreate) defaults() {
 		v := promocode.DefaultBonusAmount
 		_c.mutation.SetBonusAmount(v)
 	}
+	if _, ok := _c.mutation.CreditValidityDays(); !ok {
+		v := promocode.DefaultCreditValidityDays
+		_c.mutation.SetCreditValidityDays(v)
+	}
 	if _, ok := _c.mutation.MaxUses(); !ok {
 		v := promocode.DefaultMaxUses
 		_c.mutation.SetMaxUses(v)
@@ -207,6 +343,30 @@ func (_c *PromoCodeCreate) defaults() {
 		v := promocode.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.Scope(); !ok {
+		v := promocode.DefaultScope
+		_c.mutation.SetScope(v)
+	}
+	if _, ok := _c.mutation.BonusPercent(); !ok {
+		v := promocode.DefaultBonusPercent
+		_c.mutation.SetBonusPercent(v)
+	}
+	if _, ok := _c.mutation.TrialDays(); !ok {
+		v := promocode.DefaultTrialDays
+		_c.mutation.SetTrialDays(v)
+	}
+	if _, ok := _c.mutation.NewUserDays(); !ok {
+		v := promocode.DefaultNewUserDays
+		_c.mutation.SetNewUserDays(v)
+	}
+	if _, ok := _c.mutation.Stackable(); !ok {
+		v := promocode.DefaultStackable
+		_c.mutation.SetStackable(v)
+	}
+	if _, ok := _c.mutation.MaxUsesPerUser(); !ok {
+		v := promocode.DefaultMaxUsesPerUser
+		_c.mutation.SetMaxUsesPerUser(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := promocode.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -230,6 +390,9 @@ func (_c *PromoCodeCreate) check() error {
 	if _, ok := _c.mutation.BonusAmount(); !ok {
 		return &ValidationError{Name: "bonus_amount", err: errors.New(`ent: missing required field "PromoCode.bonus_amount"`)}
 	}
+	if _, ok := _c.mutation.CreditValidityDays(); !ok {
+		return &ValidationError{Name: "credit_validity_days", err: errors.New(`ent: missing required field "PromoCode.credit_validity_days"`)}
+	}
 	if _, ok := _c.mutation.MaxUses(); !ok {
 		return &ValidationError{Name: "max_uses", err: errors.New(`ent: missing required field "PromoCode.max_uses"`)}
 	}
@@ -244,6 +407,29 @@ func (_c *PromoCodeCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PromoCode.status": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Scope(); !ok {
+		return &ValidationError{Name: "scope", err: errors.New(`ent: missing required field "PromoCode.scope"`)}
+	}
+	if v, ok := _c.mutation.Scope(); ok {
+		if err := promocode.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "PromoCode.scope": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BonusPercent(); !ok {
+		return &ValidationError{Name: "bonus_percent", err: errors.New(`ent: missing required field "PromoCode.bonus_percent"`)}
+	}
+	if _, ok := _c.mutation.TrialDays(); !ok {
+		return &ValidationError{Name: "trial_days", err: errors.New(`ent: missing required field "PromoCode.trial_days"`)}
+	}
+	if _, ok := _c.mutation.NewUserDays(); !ok {
+		return &ValidationError{Name: "new_user_days", err: errors.New(`ent: missing required field "PromoCode.new_user_days"`)}
+	}
+	if _, ok := _c.mutation.Stackable(); !ok {
+		return &ValidationError{Name: "stackable", err: errors.New(`ent: missing required field "PromoCode.stackable"`)}
+	}
+	if _, ok := _c.mutation.MaxUsesPerUser(); !ok {
+		return &ValidationError{Name: "max_uses_per_user", err: errors.New(`ent: missing required field "PromoCode.max_uses_per_user"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PromoCode.created_at"`)}
 	}
@@ -285,6 +471,10 @@ func (_c *PromoCodeCreate) createSpec() (*PromoCode, *sqlgraph.CreateSpec) {
 		_spec.SetField(promocode.FieldBonusAmount, field.TypeFloat64, value)
 		_node.BonusAmount = value
 	}
+	if value, ok := _c.mutation.CreditValidityDays(); ok {
+		_spec.SetField(promocode.FieldCreditValidityDays, field.TypeInt, value)
+		_node.CreditValidityDays = value
+	}
 	if value, ok := _c.mutation.MaxUses(); ok {
 		_spec.SetField(promocode.FieldMaxUses, field.TypeInt, value)
 		_node.MaxUses = value
@@ -305,6 +495,42 @@ func (_c *PromoCodeCreate) createSpec() (*PromoCode, *sqlgraph.CreateSpec) {
 		_spec.SetField(promocode.FieldNotes, field.TypeString, value)
 		_node.Notes = &value
 	}
+	if value, ok := _c.mutation.Scope(); ok {
+		_spec.SetField(promocode.FieldScope, field.TypeString, value)
+		_node.Scope = value
+	}
+	if value, ok := _c.mutation.BonusPercent(); ok {
+		_spec.SetField(promocode.FieldBonusPercent, field.TypeFloat64, value)
+		_node.BonusPercent = value
+	}
+	if value, ok := _c.mutation.MaxBonusAmount(); ok {
+		_spec.SetField(promocode.FieldMaxBonusAmount, field.TypeFloat64, value)
+		_node.MaxBonusAmount = &value
+	}
+	if value, ok := _c.mutation.TrialGroupID(); ok {
+		_spec.SetField(promocode.FieldTrialGroupID, field.TypeInt64, value)
+		_node.TrialGroupID = &value
+	}
+	if value, ok := _c.mutation.TrialDays(); ok {
+		_spec.SetField(promocode.FieldTrialDays, field.TypeInt, value)
+		_node.TrialDays = value
+	}
+	if value, ok := _c.mutation.NewUserDays(); ok {
+		_spec.SetField(promocode.FieldNewUserDays, field.TypeInt, value)
+		_node.NewUserDays = value
+	}
+	if value, ok := _c.mutation.AllowedEmailDomains(); ok {
+		_spec.SetField(promocode.FieldAllowedEmailDomains, field.TypeJSON, value)
+		_node.AllowedEmailDomains = value
+	}
+	if value, ok := _c.mutation.Stackable(); ok {
+		_spec.SetField(promocode.FieldStackable, field.TypeBool, value)
+		_node.Stackable = value
+	}
+	if value, ok := _c.mutation.MaxUsesPerUser(); ok {
+		_spec.SetField(promocode.FieldMaxUsesPerUser, field.TypeInt, value)
+		_node.MaxUsesPerUser = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(promocode.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -411,6 +637,24 @@ func (u *PromoCodeUpsert) AddBonusAmount(v float64) *PromoCodeUpsert {
 	return u
 }
 
+// SetCreditValidityDays sets the "credit_validity_days" field.
+func (u *PromoCodeUpsert) SetCreditValidityDays(v int) *PromoCodeUpsert {
+	u.Set(promocode.FieldCreditValidityDays, v)
+	return u
+}
+
+// UpdateCreditValidityDays sets the "credit_validity_days" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateCreditValidityDays() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldCreditValidityDays)
+	return u
+}
+
+// AddCreditValidityDays adds v to the "credit_validity_days" field.
+func (u *PromoCodeUpsert) AddCreditValidityDays(v int) *PromoCodeUpsert {
+	u.Add(promocode.FieldCreditValidityDays, v)
+	return u
+}
+
 // SetMaxUses sets the "max_uses" field.
 func (u *PromoCodeUpsert) SetMaxUses(v int) *PromoCodeUpsert {
 	u.Set(promocode.FieldMaxUses, v)
@@ -495,6 +739,168 @@ func (u *PromoCodeUpsert) ClearNotes() *PromoCodeUpsert {
 	return u
 }
 
+// SetScope sets the "scope" field.
+func (u *PromoCodeUpsert) SetScope(v string) *PromoCodeUpsert {
+	u.Set(promocode.FieldScope, v)
+	return u
+}
+
+// UpdateScope sets the "scope" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateScope() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldScope)
+	return u
+}
+
+// SetBonusPercent sets the "bonus_percent" field.
+func (u *PromoCodeUpsert) SetBonusPercent(v float64) *PromoCodeUpsert {
+	u.Set(promocode.FieldBonusPercent, v)
+	return u
+}
+
+// UpdateBonusPercent sets the "bonus_percent" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateBonusPercent() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldBonusPercent)
+	return u
+}
+
+// AddBonusPercent adds v to the "bonus_percent" field.
+func (u *PromoCodeUpsert) AddBonusPercent(v float64) *PromoCodeUpsert {
+	u.Add(promocode.FieldBonusPercent, v)
+	return u
+}
+
+// SetMaxBonusAmount sets the "max_bonus_amount" field.
+func (u *PromoCodeUpsert) SetMaxBonusAmount(v float64) *PromoCodeUpsert {
+	u.Set(promocode.FieldMaxBonusAmount, v)
+	return u
+}
+
+// UpdateMaxBonusAmount sets the "max_bonus_amount" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateMaxBonusAmount() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldMaxBonusAmount)
+	return u
+}
+
+// AddMaxBonusAmount adds v to the "max_bonus_amount" field.
+func (u *PromoCodeUpsert) AddMaxBonusAmount(v float64) *PromoCodeUpsert {
+	u.Add(promocode.FieldMaxBonusAmount, v)
+	return u
+}
+
+// ClearMaxBonusAmount clears the value of the "max_bonus_amount" field.
+func (u *PromoCodeUpsert) ClearMaxBonusAmount() *PromoCodeUpsert {
+	u.SetNull(promocode.FieldMaxBonusAmount)
+	return u
+}
+
+// SetTrialGroupID sets the "trial_group_id" field.
+func (u *PromoCodeUpsert) SetTrialGroupID(v int64) *PromoCodeUpsert {
+	u.Set(promocode.FieldTrialGroupID, v)
+	return u
+}
+
+// UpdateTrialGroupID sets the "trial_group_id" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateTrialGroupID() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldTrialGroupID)
+	return u
+}
+
+// AddTrialGroupID adds v to the "trial_group_id" field.
+func (u *PromoCodeUpsert) AddTrialGroupID(v int64) *PromoCodeUpsert {
+	u.Add(promocode.FieldTrialGroupID, v)
+	return u
+}
+
+// ClearTrialGroupID clears the value of the "trial_group_id" field.
+func (u *PromoCodeUpsert) ClearTrialGroupID() *PromoCodeUpsert {
+	u.SetNull(promocode.FieldTrialGroupID)
+	return u
+}
+
+// SetTrialDays sets the "trial_days" field.
+func (u *PromoCodeUpsert) SetTrialDays(v int) *PromoCodeUpsert {
+	u.Set(promocode.FieldTrialDays, v)
+	return u
+}
+
+// UpdateTrialDays sets the "trial_days" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateTrialDays() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldTrialDays)
+	return u
+}
+
+// AddTrialDays adds v to the "trial_days" field.
+func (u *PromoCodeUpsert) AddTrialDays(v int) *PromoCodeUpsert {
+	u.Add(promocode.FieldTrialDays, v)
+	return u
+}
+
+// SetNewUserDays sets the "new_user_days" field.
+func (u *PromoCodeUpsert) SetNewUserDays(v int) *PromoCodeUpsert {
+	u.Set(promocode.FieldNewUserDays, v)
+	return u
+}
+
+// UpdateNewUserDays sets the "new_user_days" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateNewUserDays() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldNewUserDays)
+	return u
+}
+
+// AddNewUserDays adds v to the "new_user_days" field.
+func (u *PromoCodeUpsert) AddNewUserDays(v int) *PromoCodeUpsert {
+	u.Add(promocode.FieldNewUserDays, v)
+	return u
+}
+
+// SetAllowedEmailDomains sets the "allowed_email_domains" field.
+func (u *PromoCodeUpsert) SetAllowedEmailDomains(v []string) *PromoCodeUpsert {
+	u.Set(promocode.FieldAllowedEmailDomains, v)
+	return u
+}
+
+// UpdateAllowedEmailDomains sets the "allowed_email_domains" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateAllowedEmailDomains() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldAllowedEmailDomains)
+	return u
+}
+
+// ClearAllowedEmailDomains clears the value of the "allowed_email_domains" field.
+func (u *PromoCodeUpsert) ClearAllowedEmailDomains() *PromoCodeUpsert {
+	u.SetNull(promocode.FieldAllowedEmailDomains)
+	return u
+}
+
+// SetStackable sets the "stackable" field.
+func (u *PromoCodeUpsert) SetStackable(v bool) *PromoCodeUpsert {
+	u.Set(promocode.FieldStackable, v)
+	return u
+}
+
+// UpdateStackable sets the "stackable" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateStackable() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldStackable)
+	return u
+}
+
+// SetMaxUsesPerUser sets the "max_uses_per_user" field.
+func (u *PromoCodeUpsert) SetMaxUsesPerUser(v int) *PromoCodeUpsert {
+	u.Set(promocode.FieldMaxUsesPerUser, v)
+	return u
+}
+
+// UpdateMaxUsesPerUser sets the "max_uses_per_user" field to the value that was provided on create.
+func (u *PromoCodeUpsert) UpdateMaxUsesPerUser() *PromoCodeUpsert {
+	u.SetExcluded(promocode.FieldMaxUsesPerUser)
+	return u
+}
+
+// AddMaxUsesPerUser adds v to the "max_uses_per_user" field.
+func (u *PromoCodeUpsert) AddMaxUsesPerUser(v int) *PromoCodeUpsert {
+	u.Add(promocode.FieldMaxUsesPerUser, v)
+	return u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *PromoCodeUpsert) SetUpdatedAt(v time.Time) *PromoCodeUpsert {
 	u.Set(promocode.FieldUpdatedAt, v)
@@ -587,6 +993,27 @@ func (u *PromoCodeUpsertOne) UpdateBonusAmount() *PromoCodeUpsertOne {
 	})
 }
 
+// SetCreditValidityDays sets the "credit_validity_days" field.
+func (u *PromoCodeUpsertOne) SetCreditValidityDays(v int) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetCreditValidityDays(v)
+	})
+}
+
+// AddCreditValidityDays adds v to the "credit_validity_days" field.
+func (u *PromoCodeUpsertOne) AddCreditValidityDays(v int) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.AddCreditValidityDays(v)
+	})
+}
+
+// UpdateCreditValidityDays sets the "credit_validity_days" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateCreditValidityDays() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateCreditValidityDays()
+	})
+}
+
 // SetMaxUses sets the "max_uses" field.
 func (u *PromoCodeUpsertOne) SetMaxUses(v int) *PromoCodeUpsertOne {
 	return u.Update(func(s *PromoCodeUpsert) {
@@ -685,6 +1112,195 @@ func (u *PromoCodeUpsertOne) ClearNotes() *PromoCodeUpsertOne {
 	})
 }
 
+// SetScope sets the "scope" field.
+func (u *PromoCodeUpsertOne) SetScope(v string) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetScope(v)
+	})
+}
+
+// UpdateScope sets the "scope" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateScope() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateScope()
+	})
+}
+
+// SetBonusPercent sets the "bonus_percent" field.
+func (u *PromoCodeUpsertOne) SetBonusPercent(v float64) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetBonusPercent(v)
+	})
+}
+
+// AddBonusPercent adds v to the "bonus_percent" field.
+func (u *PromoCodeUpsertOne) AddBonusPercent(v float64) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.AddBonusPercent(v)
+	})
+}
+
+// UpdateBonusPercent sets the "bonus_percent" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateBonusPercent() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateBonusPercent()
+	})
+}
+
+// SetMaxBonusAmount sets the "max_bonus_amount" field.
+func (u *PromoCodeUpsertOne) SetMaxBonusAmount(v float64) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetMaxBonusAmount(v)
+	})
+}
+
+// AddMaxBonusAmount adds v to the "max_bonus_amount" field.
+func (u *PromoCodeUpsertOne) AddMaxBonusAmount(v float64) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.AddMaxBonusAmount(v)
+	})
+}
+
+// UpdateMaxBonusAmount sets the "max_bonus_amount" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateMaxBonusAmount() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateMaxBonusAmount()
+	})
+}
+
+// ClearMaxBonusAmount clears the value of the "max_bonus_amount" field.
+func (u *PromoCodeUpsertOne) ClearMaxBonusAmount() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.ClearMaxBonusAmount()
+	})
+}
+
+// SetTrialGroupID sets the "trial_group_id" field.
+func (u *PromoCodeUpsertOne) SetTrialGroupID(v int64) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetTrialGroupID(v)
+	})
+}
+
+// AddTrialGroupID adds v to the "trial_group_id" field.
+func (u *PromoCodeUpsertOne) AddTrialGroupID(v int64) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.AddTrialGroupID(v)
+	})
+}
+
+// UpdateTrialGroupID sets the "trial_group_id" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateTrialGroupID() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateTrialGroupID()
+	})
+}
+
+// ClearTrialGroupID clears the value of the "trial_group_id" field.
+func (u *PromoCodeUpsertOne) ClearTrialGroupID() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.ClearTrialGroupID()
+	})
+}
+
+// SetTrialDays sets the "trial_days" field.
+func (u *PromoCodeUpsertOne) SetTrialDays(v int) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetTrialDays(v)
+	})
+}
+
+// AddTrialDays adds v to the "trial_days" field.
+func (u *PromoCodeUpsertOne) AddTrialDays(v int) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.AddTrialDays(v)
+	})
+}
+
+// UpdateTrialDays sets the "trial_days" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateTrialDays() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateTrialDays()
+	})
+}
+
+// SetNewUserDays sets the "new_user_days" field.
+func (u *PromoCodeUpsertOne) SetNewUserDays(v int) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetNewUserDays(v)
+	})
+}
+
+// AddNewUserDays adds v to the "new_user_days" field.
+func (u *PromoCodeUpsertOne) AddNewUserDays(v int) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.AddNewUserDays(v)
+	})
+}
+
+// UpdateNewUserDays sets the "new_user_days" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateNewUserDays() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateNewUserDays()
+	})
+}
+
+// SetAllowedEmailDomains sets the "allowed_email_domains" field.
+func (u *PromoCodeUpsertOne) SetAllowedEmailDomains(v []string) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetAllowedEmailDomains(v)
+	})
+}
+
+// UpdateAllowedEmailDomains sets the "allowed_email_domains" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateAllowedEmailDomains() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateAllowedEmailDomains()
+	})
+}
+
+// ClearAllowedEmailDomains clears the value of the "allowed_email_domains" field.
+func (u *PromoCodeUpsertOne) ClearAllowedEmailDomains() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.ClearAllowedEmailDomains()
+	})
+}
+
+// SetStackable sets the "stackable" field.
+func (u *PromoCodeUpsertOne) SetStackable(v bool) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetStackable(v)
+	})
+}
+
+// UpdateStackable sets the "stackable" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateStackable() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateStackable()
+	})
+}
+
+// SetMaxUsesPerUser sets the "max_uses_per_user" field.
+func (u *PromoCodeUpsertOne) SetMaxUsesPerUser(v int) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetMaxUsesPerUser(v)
+	})
+}
+
+// AddMaxUsesPerUser adds v to the "max_uses_per_user" field.
+func (u *PromoCodeUpsertOne) AddMaxUsesPerUser(v int) *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.AddMaxUsesPerUser(v)
+	})
+}
+
+// UpdateMaxUsesPerUser sets the "max_uses_per_user" field to the value that was provided on create.
+func (u *PromoCodeUpsertOne) UpdateMaxUsesPerUser() *PromoCodeUpsertOne {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateMaxUsesPerUser()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *PromoCodeUpsertOne) SetUpdatedAt(v time.Time) *PromoCodeUpsertOne {
 	return u.Update(func(s *PromoCodeUpsert) {
@@ -945,6 +1561,27 @@ func (u *PromoCodeUpsertBulk) UpdateBonusAmount() *PromoCodeUpsertBulk {
 	})
 }
 
+// SetCreditValidityDays sets the "credit_validity_days" field.
+func (u *PromoCodeUpsertBulk) SetCreditValidityDays(v int) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetCreditValidityDays(v)
+	})
+}
+
+// AddCreditValidityDays adds v to the "credit_validity_days" field.
+func (u *PromoCodeUpsertBulk) AddCreditValidityDays(v int) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.AddCreditValidityDays(v)
+	})
+}
+
+// UpdateCreditValidityDays sets the "credit_validity_days" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateCreditValidityDays() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateCreditValidityDays()
+	})
+}
+
 // SetMaxUses sets the "max_uses" field.
 func (u *PromoCodeUpsertBulk) SetMaxUses(v int) *PromoCodeUpsertBulk {
 	return u.Update(func(s *PromoCodeUpsert) {
@@ -1043,6 +1680,195 @@ func (u *PromoCodeUpsertBulk) ClearNotes() *PromoCodeUpsertBulk {
 	})
 }
 
+// SetScope sets the "scope" field.
+func (u *PromoCodeUpsertBulk) SetScope(v string) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetScope(v)
+	})
+}
+
+// UpdateScope sets the "scope" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateScope() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateScope()
+	})
+}
+
+// SetBonusPercent sets the "bonus_percent" field.
+func (u *PromoCodeUpsertBulk) SetBonusPercent(v float64) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetBonusPercent(v)
+	})
+}
+
+// AddBonusPercent adds v to the "bonus_percent" field.
+func (u *PromoCodeUpsertBulk) AddBonusPercent(v float64) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.AddBonusPercent(v)
+	})
+}
+
+// UpdateBonusPercent sets the "bonus_percent" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateBonusPercent() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateBonusPercent()
+	})
+}
+
+// SetMaxBonusAmount sets the "max_bonus_amount" field.
+func (u *PromoCodeUpsertBulk) SetMaxBonusAmount(v float64) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetMaxBonusAmount(v)
+	})
+}
+
+// AddMaxBonusAmount adds v to the "max_bonus_amount" field.
+func (u *PromoCodeUpsertBulk) AddMaxBonusAmount(v float64) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.AddMaxBonusAmount(v)
+	})
+}
+
+// UpdateMaxBonusAmount sets the "max_bonus_amount" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateMaxBonusAmount() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateMaxBonusAmount()
+	})
+}
+
+// ClearMaxBonusAmount clears the value of the "max_bonus_amount" field.
+func (u *PromoCodeUpsertBulk) ClearMaxBonusAmount() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.ClearMaxBonusAmount()
+	})
+}
+
+// SetTrialGroupID sets the "trial_group_id" field.
+func (u *PromoCodeUpsertBulk) SetTrialGroupID(v int64) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetTrialGroupID(v)
+	})
+}
+
+// AddTrialGroupID adds v to the "trial_group_id" field.
+func (u *PromoCodeUpsertBulk) AddTrialGroupID(v int64) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.AddTrialGroupID(v)
+	})
+}
+
+// UpdateTrialGroupID sets the "trial_group_id" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateTrialGroupID() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateTrialGroupID()
+	})
+}
+
+// ClearTrialGroupID clears the value of the "trial_group_id" field.
+func (u *PromoCodeUpsertBulk) ClearTrialGroupID() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.ClearTrialGroupID()
+	})
+}
+
+// SetTrialDays sets the "trial_days" field.
+func (u *PromoCodeUpsertBulk) SetTrialDays(v int) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetTrialDays(v)
+	})
+}
+
+// AddTrialDays adds v to the "trial_days" field.
+func (u *PromoCodeUpsertBulk) AddTrialDays(v int) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.AddTrialDays(v)
+	})
+}
+
+// UpdateTrialDays sets the "trial_days" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateTrialDays() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateTrialDays()
+	})
+}
+
+// SetNewUserDays sets the "new_user_days" field.
+func (u *PromoCodeUpsertBulk) SetNewUserDays(v int) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetNewUserDays(v)
+	})
+}
+
+// AddNewUserDays adds v to the "new_user_days" field.
+func (u *PromoCodeUpsertBulk) AddNewUserDays(v int) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.AddNewUserDays(v)
+	})
+}
+
+// UpdateNewUserDays sets the "new_user_days" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateNewUserDays() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateNewUserDays()
+	})
+}
+
+// SetAllowedEmailDomains sets the "allowed_email_domains" field.
+func (u *PromoCodeUpsertBulk) SetAllowedEmailDomains(v []string) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetAllowedEmailDomains(v)
+	})
+}
+
+// UpdateAllowedEmailDomains sets the "allowed_email_domains" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateAllowedEmailDomains() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateAllowedEmailDomains()
+	})
+}
+
+// ClearAllowedEmailDomains clears the value of the "allowed_email_domains" field.
+func (u *PromoCodeUpsertBulk) ClearAllowedEmailDomains() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.ClearAllowedEmailDomains()
+	})
+}
+
+// SetStackable sets the "stackable" field.
+func (u *PromoCodeUpsertBulk) SetStackable(v bool) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetStackable(v)
+	})
+}
+
+// UpdateStackable sets the "stackable" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateStackable() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateStackable()
+	})
+}
+
+// SetMaxUsesPerUser sets the "max_uses_per_user" field.
+func (u *PromoCodeUpsertBulk) SetMaxUsesPerUser(v int) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.SetMaxUsesPerUser(v)
+	})
+}
+
+// AddMaxUsesPerUser adds v to the "max_uses_per_user" field.
+func (u *PromoCodeUpsertBulk) AddMaxUsesPerUser(v int) *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.AddMaxUsesPerUser(v)
+	})
+}
+
+// UpdateMaxUsesPerUser sets the "max_uses_per_user" field to the value that was provided on create.
+func (u *PromoCodeUpsertBulk) UpdateMaxUsesPerUser() *PromoCodeUpsertBulk {
+	return u.Update(func(s *PromoCodeUpsert) {
+		s.UpdateMaxUsesPerUser()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *PromoCodeUpsertBulk) SetUpdatedAt(v time.Time) *PromoCodeUpsertBulk {
 	return u.Update(func(s *PromoCodeUpsert) {
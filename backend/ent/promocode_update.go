@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/Wei-Shaw/sub2api/ent/predicate"
 	"github.com/Wei-Shaw/sub2api/ent/promocode"
@@ -64,6 +65,27 @@ func (_u *PromoCodeUpdate) AddBonusAmount(v float64) *PromoCodeUpdate {
 	return _u
 }
 
+// SetCreditValidityDays sets the "credit_validity_days" field.
+func (_u *PromoCodeUpdate) SetCreditValidityDays(v int) *PromoCodeUpdate {
+	_u.mutation.ResetCreditValidityDays()
+	_u.mutation.SetCreditValidityDays(v)
+	return _u
+}
+
+// SetNillableCreditValidityDays sets the "credit_validity_days" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableCreditValidityDays(v *int) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetCreditValidityDays(*v)
+	}
+	return _u
+}
+
+// AddCreditValidityDays adds value to the "credit_validity_days" field.
+func (_u *PromoCodeUpdate) AddCreditValidityDays(v int) *PromoCodeUpdate {
+	_u.mutation.AddCreditValidityDays(v)
+	return _u
+}
+
 // SetMaxUses sets the "max_uses" field.
 func (_u *PromoCodeUpdate) SetMaxUses(v int) *PromoCodeUpdate {
 	_u.mutation.ResetMaxUses()
@@ -160,6 +182,190 @@ func (_u *PromoCodeUpdate) ClearNotes() *PromoCodeUpdate {
 	return _u
 }
 
+// SetScope sets the "scope" field.
+func (_u *PromoCodeUpdate) SetScope(v string) *PromoCodeUpdate {
+	_u.mutation.SetScope(v)
+	return _u
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableScope(v *string) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetScope(*v)
+	}
+	return _u
+}
+
+// SetBonusPercent sets the "bonus_percent" field.
+func (_u *PromoCodeUpdate) SetBonusPercent(v float64) *PromoCodeUpdate {
+	_u.mutation.ResetBonusPercent()
+	_u.mutation.SetBonusPercent(v)
+	return _u
+}
+
+// SetNillableBonusPercent sets the "bonus_percent" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableBonusPercent(v *float64) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetBonusPercent(*v)
+	}
+	return _u
+}
+
+// AddBonusPercent adds value to the "bonus_percent" field.
+func (_u *PromoCodeUpdate) AddBonusPercent(v float64) *PromoCodeUpdate {
+	_u.mutation.AddBonusPercent(v)
+	return _u
+}
+
+// SetMaxBonusAmount sets the "max_bonus_amount" field.
+func (_u *PromoCodeUpdate) SetMaxBonusAmount(v float64) *PromoCodeUpdate {
+	_u.mutation.ResetMaxBonusAmount()
+	_u.mutation.SetMaxBonusAmount(v)
+	return _u
+}
+
+// SetNillableMaxBonusAmount sets the "max_bonus_amount" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableMaxBonusAmount(v *float64) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetMaxBonusAmount(*v)
+	}
+	return _u
+}
+
+// AddMaxBonusAmount adds value to the "max_bonus_amount" field.
+func (_u *PromoCodeUpdate) AddMaxBonusAmount(v float64) *PromoCodeUpdate {
+	_u.mutation.AddMaxBonusAmount(v)
+	return _u
+}
+
+// ClearMaxBonusAmount clears the value of the "max_bonus_amount" field.
+func (_u *PromoCodeUpdate) ClearMaxBonusAmount() *PromoCodeUpdate {
+	_u.mutation.ClearMaxBonusAmount()
+	return _u
+}
+
+// SetTrialGroupID sets the "trial_group_id" field.
+func (_u *PromoCodeUpdate) SetTrialGroupID(v int64) *PromoCodeUpdate {
+	_u.mutation.ResetTrialGroupID()
+	_u.mutation.SetTrialGroupID(v)
+	return _u
+}
+
+// SetNillableTrialGroupID sets the "trial_group_id" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableTrialGroupID(v *int64) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetTrialGroupID(*v)
+	}
+	return _u
+}
+
+// AddTrialGroupID adds value to the "trial_group_id" field.
+func (_u *PromoCodeUpdate) AddTrialGroupID(v int64) *PromoCodeUpdate {
+	_u.mutation.AddTrialGroupID(v)
+	return _u
+}
+
+// ClearTrialGroupID clears the value of the "trial_group_id" field.
+func (_u *PromoCodeUpdate) ClearTrialGroupID() *PromoCodeUpdate {
+	_u.mutation.ClearTrialGroupID()
+	return _u
+}
+
+// SetTrialDays sets the "trial_days" field.
+func (_u *PromoCodeUpdate) SetTrialDays(v int) *PromoCodeUpdate {
+	_u.mutation.ResetTrialDays()
+	_u.mutation.SetTrialDays(v)
+	return _u
+}
+
+// SetNillableTrialDays sets the "trial_days" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableTrialDays(v *int) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetTrialDays(*v)
+	}
+	return _u
+}
+
+// AddTrialDays adds value to the "trial_days" field.
+func (_u *PromoCodeUpdate) AddTrialDays(v int) *PromoCodeUpdate {
+	_u.mutation.AddTrialDays(v)
+	return _u
+}
+
+// SetNewUserDays sets the "new_user_days" field.
+func (_u *PromoCodeUpdate) SetNewUserDays(v int) *PromoCodeUpdate {
+	_u.mutation.ResetNewUserDays()
+	_u.mutation.SetNewUserDays(v)
+	return _u
+}
+
+// SetNillableNewUserDays sets the "new_user_days" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableNewUserDays(v *int) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetNewUserDays(*v)
+	}
+	return _u
+}
+
+// AddNewUserDays adds value to the "new_user_days" field.
+func (_u *PromoCodeUpdate) AddNewUserDays(v int) *PromoCodeUpdate {
+	_u.mutation.AddNewUserDays(v)
+	return _u
+}
+
+// SetAllowedEmailDomains sets the "allowed_email_domains" field.
+func (_u *PromoCodeUpdate) SetAllowedEmailDomains(v []string) *PromoCodeUpdate {
+	_u.mutation.SetAllowedEmailDomains(v)
+	return _u
+}
+
+// AppendAllowedEmailDomains appends value to the "allowed_email_domains" field.
+func (_u *PromoCodeUpdate) AppendAllowedEmailDomains(v []string) *PromoCodeUpdate {
+	_u.mutation.AppendAllowedEmailDomains(v)
+	return _u
+}
+
+// ClearAllowedEmailDomains clears the value of the "allowed_email_domains" field.
+func (_u *PromoCodeUpdate) ClearAllowedEmailDomains() *PromoCodeUpdate {
+	_u.mutation.ClearAllowedEmailDomains()
+	return _u
+}
+
+// SetStackable sets the "stackable" field.
+func (_u *PromoCodeUpdate) SetStackable(v bool) *PromoCodeUpdate {
+	_u.mutation.SetStackable(v)
+	return _u
+}
+
+// SetNillableStackable sets the "stackable" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableStackable(v *bool) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetStackable(*v)
+	}
+	return _u
+}
+
+// SetMaxUsesPerUser sets the "max_uses_per_user" field.
+func (_u *PromoCodeUpdate) SetMaxUsesPerUser(v int) *PromoCodeUpdate {
+	_u.mutation.ResetMaxUsesPerUser()
+	_u.mutation.SetMaxUsesPerUser(v)
+	return _u
+}
+
+// SetNillableMaxUsesPerUser sets the "max_uses_per_user" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableMaxUsesPerUser(v *int) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetMaxUsesPerUser(*v)
+	}
+	return _u
+}
+
+// AddMaxUsesPerUser adds value to the "max_uses_per_user" field.
+func (_u *PromoCodeUpdate) AddMaxUsesPerUser(v int) *PromoCodeUpdate {
+	_u.mutation.AddMaxUsesPerUser(v)
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *PromoCodeUpdate) SetUpdatedAt(v time.Time) *PromoCodeUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -255,6 +461,11 @@ func (_u *PromoCodeUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PromoCode.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Scope(); ok {
+		if err := promocode.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "PromoCode.scope": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -279,6 +490,12 @@ func (_u *PromoCodeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedBonusAmount(); ok {
 		_spec.AddField(promocode.FieldBonusAmount, field.TypeFloat64, value)
 	}
+	if value, ok := _u.mutation.CreditValidityDays(); ok {
+		_spec.SetField(promocode.FieldCreditValidityDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreditValidityDays(); ok {
+		_spec.AddField(promocode.FieldCreditValidityDays, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.MaxUses(); ok {
 		_spec.SetField(promocode.FieldMaxUses, field.TypeInt, value)
 	}
@@ -306,6 +523,65 @@ func (_u *PromoCodeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.NotesCleared() {
 		_spec.ClearField(promocode.FieldNotes, field.TypeString)
 	}
+	if value, ok := _u.mutation.Scope(); ok {
+		_spec.SetField(promocode.FieldScope, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BonusPercent(); ok {
+		_spec.SetField(promocode.FieldBonusPercent, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBonusPercent(); ok {
+		_spec.AddField(promocode.FieldBonusPercent, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MaxBonusAmount(); ok {
+		_spec.SetField(promocode.FieldMaxBonusAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxBonusAmount(); ok {
+		_spec.AddField(promocode.FieldMaxBonusAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.MaxBonusAmountCleared() {
+		_spec.ClearField(promocode.FieldMaxBonusAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.TrialGroupID(); ok {
+		_spec.SetField(promocode.FieldTrialGroupID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTrialGroupID(); ok {
+		_spec.AddField(promocode.FieldTrialGroupID, field.TypeInt64, value)
+	}
+	if _u.mutation.TrialGroupIDCleared() {
+		_spec.ClearField(promocode.FieldTrialGroupID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.TrialDays(); ok {
+		_spec.SetField(promocode.FieldTrialDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTrialDays(); ok {
+		_spec.AddField(promocode.FieldTrialDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NewUserDays(); ok {
+		_spec.SetField(promocode.FieldNewUserDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNewUserDays(); ok {
+		_spec.AddField(promocode.FieldNewUserDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AllowedEmailDomains(); ok {
+		_spec.SetField(promocode.FieldAllowedEmailDomains, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedEmailDomains(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, promocode.FieldAllowedEmailDomains, value)
+		})
+	}
+	if _u.mutation.AllowedEmailDomainsCleared() {
+		_spec.ClearField(promocode.FieldAllowedEmailDomains, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Stackable(); ok {
+		_spec.SetField(promocode.FieldStackable, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MaxUsesPerUser(); ok {
+		_spec.SetField(promocode.FieldMaxUsesPerUser, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxUsesPerUser(); ok {
+		_spec.AddField(promocode.FieldMaxUsesPerUser, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(promocode.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -409,6 +685,27 @@ func (_u *PromoCodeUpdateOne) AddBonusAmount(v float64) *PromoCodeUpdateOne {
 	return _u
 }
 
+// SetCreditValidityDays sets the "credit_validity_days" field.
+func (_u *PromoCodeUpdateOne) SetCreditValidityDays(v int) *PromoCodeUpdateOne {
+	_u.mutation.ResetCreditValidityDays()
+	_u.mutation.SetCreditValidityDays(v)
+	return _u
+}
+
+// SetNillableCreditValidityDays sets the "credit_validity_days" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableCreditValidityDays(v *int) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetCreditValidityDays(*v)
+	}
+	return _u
+}
+
+// AddCreditValidityDays adds value to the "credit_validity_days" field.
+func (_u *PromoCodeUpdateOne) AddCreditValidityDays(v int) *PromoCodeUpdateOne {
+	_u.mutation.AddCreditValidityDays(v)
+	return _u
+}
+
 // SetMaxUses sets the "max_uses" field.
 func (_u *PromoCodeUpdateOne) SetMaxUses(v int) *PromoCodeUpdateOne {
 	_u.mutation.ResetMaxUses()
@@ -505,6 +802,190 @@ func (_u *PromoCodeUpdateOne) ClearNotes() *PromoCodeUpdateOne {
 	return _u
 }
 
+// SetScope sets the "scope" field.
+func (_u *PromoCodeUpdateOne) SetScope(v string) *PromoCodeUpdateOne {
+	_u.mutation.SetScope(v)
+	return _u
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableScope(v *string) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetScope(*v)
+	}
+	return _u
+}
+
+// SetBonusPercent sets the "bonus_percent" field.
+func (_u *PromoCodeUpdateOne) SetBonusPercent(v float64) *PromoCodeUpdateOne {
+	_u.mutation.ResetBonusPercent()
+	_u.mutation.SetBonusPercent(v)
+	return _u
+}
+
+// SetNillableBonusPercent sets the "bonus_percent" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableBonusPercent(v *float64) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetBonusPercent(*v)
+	}
+	return _u
+}
+
+// AddBonusPercent adds value to the "bonus_percent" field.
+func (_u *PromoCodeUpdateOne) AddBonusPercent(v float64) *PromoCodeUpdateOne {
+	_u.mutation.AddBonusPercent(v)
+	return _u
+}
+
+// SetMaxBonusAmount sets the "max_bonus_amount" field.
+func (_u *PromoCodeUpdateOne) SetMaxBonusAmount(v float64) *PromoCodeUpdateOne {
+	_u.mutation.ResetMaxBonusAmount()
+	_u.mutation.SetMaxBonusAmount(v)
+	return _u
+}
+
+// SetNillableMaxBonusAmount sets the "max_bonus_amount" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableMaxBonusAmount(v *float64) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetMaxBonusAmount(*v)
+	}
+	return _u
+}
+
+// AddMaxBonusAmount adds value to the "max_bonus_amount" field.
+func (_u *PromoCodeUpdateOne) AddMaxBonusAmount(v float64) *PromoCodeUpdateOne {
+	_u.mutation.AddMaxBonusAmount(v)
+	return _u
+}
+
+// ClearMaxBonusAmount clears the value of the "max_bonus_amount" field.
+func (_u *PromoCodeUpdateOne) ClearMaxBonusAmount() *PromoCodeUpdateOne {
+	_u.mutation.ClearMaxBonusAmount()
+	return _u
+}
+
+// SetTrialGroupID sets the "trial_group_id" field.
+func (_u *PromoCodeUpdateOne) SetTrialGroupID(v int64) *PromoCodeUpdateOne {
+	_u.mutation.ResetTrialGroupID()
+	_u.mutation.SetTrialGroupID(v)
+	return _u
+}
+
+// SetNillableTrialGroupID sets the "trial_group_id" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableTrialGroupID(v *int64) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetTrialGroupID(*v)
+	}
+	return _u
+}
+
+// AddTrialGroupID adds value to the "trial_group_id" field.
+func (_u *PromoCodeUpdateOne) AddTrialGroupID(v int64) *PromoCodeUpdateOne {
+	_u.mutation.AddTrialGroupID(v)
+	return _u
+}
+
+// ClearTrialGroupID clears the value of the "trial_group_id" field.
+func (_u *PromoCodeUpdateOne) ClearTrialGroupID() *PromoCodeUpdateOne {
+	_u.mutation.ClearTrialGroupID()
+	return _u
+}
+
+// SetTrialDays sets the "trial_days" field.
+func (_u *PromoCodeUpdateOne) SetTrialDays(v int) *PromoCodeUpdateOne {
+	_u.mutation.ResetTrialDays()
+	_u.mutation.SetTrialDays(v)
+	return _u
+}
+
+// SetNillableTrialDays sets the "trial_days" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableTrialDays(v *int) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetTrialDays(*v)
+	}
+	return _u
+}
+
+// AddTrialDays adds value to the "trial_days" field.
+func (_u *PromoCodeUpdateOne) AddTrialDays(v int) *PromoCodeUpdateOne {
+	_u.mutation.AddTrialDays(v)
+	return _u
+}
+
+// SetNewUserDays sets the "new_user_days" field.
+func (_u *PromoCodeUpdateOne) SetNewUserDays(v int) *PromoCodeUpdateOne {
+	_u.mutation.ResetNewUserDays()
+	_u.mutation.SetNewUserDays(v)
+	return _u
+}
+
+// SetNillableNewUserDays sets the "new_user_days" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableNewUserDays(v *int) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetNewUserDays(*v)
+	}
+	return _u
+}
+
+// AddNewUserDays adds value to the "new_user_days" field.
+func (_u *PromoCodeUpdateOne) AddNewUserDays(v int) *PromoCodeUpdateOne {
+	_u.mutation.AddNewUserDays(v)
+	return _u
+}
+
+// SetAllowedEmailDomains sets the "allowed_email_domains" field.
+func (_u *PromoCodeUpdateOne) SetAllowedEmailDomains(v []string) *PromoCodeUpdateOne {
+	_u.mutation.SetAllowedEmailDomains(v)
+	return _u
+}
+
+// AppendAllowedEmailDomains appends value to the "allowed_email_domains" field.
+func (_u *PromoCodeUpdateOne) AppendAllowedEmailDomains(v []string) *PromoCodeUpdateOne {
+	_u.mutation.AppendAllowedEmailDomains(v)
+	return _u
+}
+
+// ClearAllowedEmailDomains clears the value of the "allowed_email_domains" field.
+func (_u *PromoCodeUpdateOne) ClearAllowedEmailDomains() *PromoCodeUpdateOne {
+	_u.mutation.ClearAllowedEmailDomains()
+	return _u
+}
+
+// SetStackable sets the "stackable" field.
+func (_u *PromoCodeUpdateOne) SetStackable(v bool) *PromoCodeUpdateOne {
+	_u.mutation.SetStackable(v)
+	return _u
+}
+
+// SetNillableStackable sets the "stackable" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableStackable(v *bool) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetStackable(*v)
+	}
+	return _u
+}
+
+// SetMaxUsesPerUser sets the "max_uses_per_user" field.
+func (_u *PromoCodeUpdateOne) SetMaxUsesPerUser(v int) *PromoCodeUpdateOne {
+	_u.mutation.ResetMaxUsesPerUser()
+	_u.mutation.SetMaxUsesPerUser(v)
+	return _u
+}
+
+// SetNillableMaxUsesPerUser sets the "max_uses_per_user" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableMaxUsesPerUser(v *int) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetMaxUsesPerUser(*v)
+	}
+	return _u
+}
+
+// AddMaxUsesPerUser adds value to the "max_uses_per_user" field.
+func (_u *PromoCodeUpdateOne) AddMaxUsesPerUser(v int) *PromoCodeUpdateOne {
+	_u.mutation.AddMaxUsesPerUser(v)
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *PromoCodeUpdateOne) SetUpdatedAt(v time.Time) *PromoCodeUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -613,6 +1094,11 @@ func (_u *PromoCodeUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PromoCode.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Scope(); ok {
+		if err := promocode.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "PromoCode.scope": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -654,6 +1140,12 @@ func (_u *PromoCodeUpdateOne) sqlSave(ctx context.Context) (_node *PromoCode, er
 	if value, ok := _u.mutation.AddedBonusAmount(); ok {
 		_spec.AddField(promocode.FieldBonusAmount, field.TypeFloat64, value)
 	}
+	if value, ok := _u.mutation.CreditValidityDays(); ok {
+		_spec.SetField(promocode.FieldCreditValidityDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreditValidityDays(); ok {
+		_spec.AddField(promocode.FieldCreditValidityDays, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.MaxUses(); ok {
 		_spec.SetField(promocode.FieldMaxUses, field.TypeInt, value)
 	}
@@ -681,6 +1173,65 @@ func (_u *PromoCodeUpdateOne) sqlSave(ctx context.Context) (_node *PromoCode, er
 	if _u.mutation.NotesCleared() {
 		_spec.ClearField(promocode.FieldNotes, field.TypeString)
 	}
+	if value, ok := _u.mutation.Scope(); ok {
+		_spec.SetField(promocode.FieldScope, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BonusPercent(); ok {
+		_spec.SetField(promocode.FieldBonusPercent, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBonusPercent(); ok {
+		_spec.AddField(promocode.FieldBonusPercent, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MaxBonusAmount(); ok {
+		_spec.SetField(promocode.FieldMaxBonusAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxBonusAmount(); ok {
+		_spec.AddField(promocode.FieldMaxBonusAmount, field.TypeFloat64, value)
+	}
+	if _u.mutation.MaxBonusAmountCleared() {
+		_spec.ClearField(promocode.FieldMaxBonusAmount, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.TrialGroupID(); ok {
+		_spec.SetField(promocode.FieldTrialGroupID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTrialGroupID(); ok {
+		_spec.AddField(promocode.FieldTrialGroupID, field.TypeInt64, value)
+	}
+	if _u.mutation.TrialGroupIDCleared() {
+		_spec.ClearField(promocode.FieldTrialGroupID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.TrialDays(); ok {
+		_spec.SetField(promocode.FieldTrialDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTrialDays(); ok {
+		_spec.AddField(promocode.FieldTrialDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NewUserDays(); ok {
+		_spec.SetField(promocode.FieldNewUserDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNewUserDays(); ok {
+		_spec.AddField(promocode.FieldNewUserDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AllowedEmailDomains(); ok {
+		_spec.SetField(promocode.FieldAllowedEmailDomains, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedEmailDomains(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, promocode.FieldAllowedEmailDomains, value)
+		})
+	}
+	if _u.mutation.AllowedEmailDomainsCleared() {
+		_spec.ClearField(promocode.FieldAllowedEmailDomains, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Stackable(); ok {
+		_spec.SetField(promocode.FieldStackable, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MaxUsesPerUser(); ok {
+		_spec.SetField(promocode.FieldMaxUsesPerUser, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxUsesPerUser(); ok {
+		_spec.AddField(promocode.FieldMaxUsesPerUser, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(promocode.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -25,6 +25,14 @@ type PromoCodeUsage struct {
 	UserID int64 `json:"user_id,omitempty"`
 	// 实际赠送金额
 	BonusAmount float64 `json:"bonus_amount,omitempty"`
+	// 充值到账金额（topup 场景计算比例赠送的基数）
+	TopupAmount float64 `json:"topup_amount,omitempty"`
+	// 关联的充值订单
+	PaymentOrderID *int64 `json:"payment_order_id,omitempty"`
+	// 赠送订阅试用的分组
+	TrialGroupID *int64 `json:"trial_group_id,omitempty"`
+	// 赠送订阅试用天数
+	TrialDays int `json:"trial_days,omitempty"`
 	// 使用时间
 	UsedAt time.Time `json:"used_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -71,9 +79,9 @@ func (*PromoCodeUsage) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case promocodeusage.FieldBonusAmount:
+		case promocodeusage.FieldBonusAmount, promocodeusage.FieldTopupAmount:
 			values[i] = new(sql.NullFloat64)
-		case promocodeusage.FieldID, promocodeusage.FieldPromoCodeID, promocodeusage.FieldUserID:
+		case promocodeusage.FieldID, promocodeusage.FieldPromoCodeID, promocodeusage.FieldUserID, promocodeusage.FieldPaymentOrderID, promocodeusage.FieldTrialGroupID, promocodeusage.FieldTrialDays:
 			values[i] = new(sql.NullInt64)
 		case promocodeusage.FieldUsedAt:
 			values[i] = new(sql.NullTime)
@@ -116,6 +124,32 @@ func (_m *PromoCodeUsage) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.BonusAmount = value.Float64
 			}
+		case promocodeusage.FieldTopupAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field topup_amount", values[i])
+			} else if value.Valid {
+				_m.TopupAmount = value.Float64
+			}
+		case promocodeusage.FieldPaymentOrderID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_order_id", values[i])
+			} else if value.Valid {
+				_m.PaymentOrderID = new(int64)
+				*_m.PaymentOrderID = value.Int64
+			}
+		case promocodeusage.FieldTrialGroupID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field trial_group_id", values[i])
+			} else if value.Valid {
+				_m.TrialGroupID = new(int64)
+				*_m.TrialGroupID = value.Int64
+			}
+		case promocodeusage.FieldTrialDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field trial_days", values[i])
+			} else if value.Valid {
+				_m.TrialDays = int(value.Int64)
+			}
 		case promocodeusage.FieldUsedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field used_at", values[i])
@@ -177,6 +211,22 @@ func (_m *PromoCodeUsage) String() string {
 	builder.WriteString("bonus_amount=")
 	builder.WriteString(fmt.Sprintf("%v", _m.BonusAmount))
 	builder.WriteString(", ")
+	builder.WriteString("topup_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TopupAmount))
+	builder.WriteString(", ")
+	if v := _m.PaymentOrderID; v != nil {
+		builder.WriteString("payment_order_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TrialGroupID; v != nil {
+		builder.WriteString("trial_group_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("trial_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TrialDays))
+	builder.WriteString(", ")
 	builder.WriteString("used_at=")
 	builder.WriteString(_m.UsedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
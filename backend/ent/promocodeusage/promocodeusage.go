@@ -20,6 +20,14 @@ const (
 	FieldUserID = "user_id"
 	// FieldBonusAmount holds the string denoting the bonus_amount field in the database.
 	FieldBonusAmount = "bonus_amount"
+	// FieldTopupAmount holds the string denoting the topup_amount field in the database.
+	FieldTopupAmount = "topup_amount"
+	// FieldPaymentOrderID holds the string denoting the payment_order_id field in the database.
+	FieldPaymentOrderID = "payment_order_id"
+	// FieldTrialGroupID holds the string denoting the trial_group_id field in the database.
+	FieldTrialGroupID = "trial_group_id"
+	// FieldTrialDays holds the string denoting the trial_days field in the database.
+	FieldTrialDays = "trial_days"
 	// FieldUsedAt holds the string denoting the used_at field in the database.
 	FieldUsedAt = "used_at"
 	// EdgePromoCode holds the string denoting the promo_code edge name in mutations.
@@ -50,6 +58,10 @@ var Columns = []string{
 	FieldPromoCodeID,
 	FieldUserID,
 	FieldBonusAmount,
+	FieldTopupAmount,
+	FieldPaymentOrderID,
+	FieldTrialGroupID,
+	FieldTrialDays,
 	FieldUsedAt,
 }
 
@@ -64,6 +76,10 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultTopupAmount holds the default value on creation for the "topup_amount" field.
+	DefaultTopupAmount float64
+	// DefaultTrialDays holds the default value on creation for the "trial_days" field.
+	DefaultTrialDays int
 	// DefaultUsedAt holds the default value on creation for the "used_at" field.
 	DefaultUsedAt func() time.Time
 )
@@ -91,6 +107,26 @@ func ByBonusAmount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBonusAmount, opts...).ToFunc()
 }
 
+// ByTopupAmount orders the results by the topup_amount field.
+func ByTopupAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTopupAmount, opts...).ToFunc()
+}
+
+// ByPaymentOrderID orders the results by the payment_order_id field.
+func ByPaymentOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaymentOrderID, opts...).ToFunc()
+}
+
+// ByTrialGroupID orders the results by the trial_group_id field.
+func ByTrialGroupID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrialGroupID, opts...).ToFunc()
+}
+
+// ByTrialDays orders the results by the trial_days field.
+func ByTrialDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrialDays, opts...).ToFunc()
+}
+
 // ByUsedAt orders the results by the used_at field.
 func ByUsedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUsedAt, opts...).ToFunc()
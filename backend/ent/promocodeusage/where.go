@@ -70,6 +70,26 @@ func BonusAmount(v float64) predicate.PromoCodeUsage {
 	return predicate.PromoCodeUsage(sql.FieldEQ(FieldBonusAmount, v))
 }
 
+// TopupAmount applies equality check predicate on the "topup_amount" field. It's identical to TopupAmountEQ.
+func TopupAmount(v float64) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldEQ(FieldTopupAmount, v))
+}
+
+// PaymentOrderID applies equality check predicate on the "payment_order_id" field. It's identical to PaymentOrderIDEQ.
+func PaymentOrderID(v int64) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldEQ(FieldPaymentOrderID, v))
+}
+
+// TrialGroupID applies equality check predicate on the "trial_group_id" field. It's identical to TrialGroupIDEQ.
+func TrialGroupID(v int64) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldEQ(FieldTrialGroupID, v))
+}
+
+// TrialDays applies equality check predicate on the "trial_days" field. It's identical to TrialDaysEQ.
+func TrialDays(v int) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldEQ(FieldTrialDays, v))
+}
+
 // UsedAt applies equality check predicate on the "used_at" field. It's identical to UsedAtEQ.
 func UsedAt(v time.Time) predicate.PromoCodeUsage {
 	return predicate.PromoCodeUsage(sql.FieldEQ(FieldUsedAt, v))
@@ -155,6 +175,186 @@ func BonusAmountLTE(v float64) predicate.PromoCodeUsage {
 	return predicate.PromoCodeUsage(sql.FieldLTE(FieldBonusAmount, v))
 }
 
+// TopupAmountEQ applies the EQ predicate on the "topup_amount" field.
+func TopupAmountEQ(v float64) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldEQ(FieldTopupAmount, v))
+}
+
+// TopupAmountNEQ applies the NEQ predicate on the "topup_amount" field.
+func TopupAmountNEQ(v float64) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldNEQ(FieldTopupAmount, v))
+}
+
+// TopupAmountIn applies the In predicate on the "topup_amount" field.
+func TopupAmountIn(vs ...float64) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldIn(FieldTopupAmount, vs...))
+}
+
+// TopupAmountNotIn applies the NotIn predicate on the "topup_amount" field.
+func TopupAmountNotIn(vs ...float64) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldNotIn(FieldTopupAmount, vs...))
+}
+
+// TopupAmountGT applies the GT predicate on the "topup_amount" field.
+func TopupAmountGT(v float64) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldGT(FieldTopupAmount, v))
+}
+
+// TopupAmountGTE applies the GTE predicate on the "topup_amount" field.
+func TopupAmountGTE(v float64) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldGTE(FieldTopupAmount, v))
+}
+
+// TopupAmountLT applies the LT predicate on the "topup_amount" field.
+func TopupAmountLT(v float64) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldLT(FieldTopupAmount, v))
+}
+
+// TopupAmountLTE applies the LTE predicate on the "topup_amount" field.
+func TopupAmountLTE(v float64) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldLTE(FieldTopupAmount, v))
+}
+
+// PaymentOrderIDEQ applies the EQ predicate on the "payment_order_id" field.
+func PaymentOrderIDEQ(v int64) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldEQ(FieldPaymentOrderID, v))
+}
+
+// PaymentOrderIDNEQ applies the NEQ predicate on the "payment_order_id" field.
+func PaymentOrderIDNEQ(v int64) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldNEQ(FieldPaymentOrderID, v))
+}
+
+// PaymentOrderIDIn applies the In predicate on the "payment_order_id" field.
+func PaymentOrderIDIn(vs ...int64) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldIn(FieldPaymentOrderID, vs...))
+}
+
+// PaymentOrderIDNotIn applies the NotIn predicate on the "payment_order_id" field.
+func PaymentOrderIDNotIn(vs ...int64) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldNotIn(FieldPaymentOrderID, vs...))
+}
+
+// PaymentOrderIDGT applies the GT predicate on the "payment_order_id" field.
+func PaymentOrderIDGT(v int64) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldGT(FieldPaymentOrderID, v))
+}
+
+// PaymentOrderIDGTE applies the GTE predicate on the "payment_order_id" field.
+func PaymentOrderIDGTE(v int64) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldGTE(FieldPaymentOrderID, v))
+}
+
+// PaymentOrderIDLT applies the LT predicate on the "payment_order_id" field.
+func PaymentOrderIDLT(v int64) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldLT(FieldPaymentOrderID, v))
+}
+
+// PaymentOrderIDLTE applies the LTE predicate on the "payment_order_id" field.
+func PaymentOrderIDLTE(v int64) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldLTE(FieldPaymentOrderID, v))
+}
+
+// PaymentOrderIDIsNil applies the IsNil predicate on the "payment_order_id" field.
+func PaymentOrderIDIsNil() predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldIsNull(FieldPaymentOrderID))
+}
+
+// PaymentOrderIDNotNil applies the NotNil predicate on the "payment_order_id" field.
+func PaymentOrderIDNotNil() predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldNotNull(FieldPaymentOrderID))
+}
+
+// TrialGroupIDEQ applies the EQ predicate on the "trial_group_id" field.
+func TrialGroupIDEQ(v int64) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldEQ(FieldTrialGroupID, v))
+}
+
+// TrialGroupIDNEQ applies the NEQ predicate on the "trial_group_id" field.
+func TrialGroupIDNEQ(v int64) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldNEQ(FieldTrialGroupID, v))
+}
+
+// TrialGroupIDIn applies the In predicate on the "trial_group_id" field.
+func TrialGroupIDIn(vs ...int64) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldIn(FieldTrialGroupID, vs...))
+}
+
+// TrialGroupIDNotIn applies the NotIn predicate on the "trial_group_id" field.
+func TrialGroupIDNotIn(vs ...int64) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldNotIn(FieldTrialGroupID, vs...))
+}
+
+// TrialGroupIDGT applies the GT predicate on the "trial_group_id" field.
+func TrialGroupIDGT(v int64) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldGT(FieldTrialGroupID, v))
+}
+
+// TrialGroupIDGTE applies the GTE predicate on the "trial_group_id" field.
+func TrialGroupIDGTE(v int64) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldGTE(FieldTrialGroupID, v))
+}
+
+// TrialGroupIDLT applies the LT predicate on the "trial_group_id" field.
+func TrialGroupIDLT(v int64) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldLT(FieldTrialGroupID, v))
+}
+
+// TrialGroupIDLTE applies the LTE predicate on the "trial_group_id" field.
+func TrialGroupIDLTE(v int64) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldLTE(FieldTrialGroupID, v))
+}
+
+// TrialGroupIDIsNil applies the IsNil predicate on the "trial_group_id" field.
+func TrialGroupIDIsNil() predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldIsNull(FieldTrialGroupID))
+}
+
+// TrialGroupIDNotNil applies the NotNil predicate on the "trial_group_id" field.
+func TrialGroupIDNotNil() predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldNotNull(FieldTrialGroupID))
+}
+
+// TrialDaysEQ applies the EQ predicate on the "trial_days" field.
+func TrialDaysEQ(v int) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldEQ(FieldTrialDays, v))
+}
+
+// TrialDaysNEQ applies the NEQ predicate on the "trial_days" field.
+func TrialDaysNEQ(v int) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldNEQ(FieldTrialDays, v))
+}
+
+// TrialDaysIn applies the In predicate on the "trial_days" field.
+func TrialDaysIn(vs ...int) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldIn(FieldTrialDays, vs...))
+}
+
+// TrialDaysNotIn applies the NotIn predicate on the "trial_days" field.
+func TrialDaysNotIn(vs ...int) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldNotIn(FieldTrialDays, vs...))
+}
+
+// TrialDaysGT applies the GT predicate on the "trial_days" field.
+func TrialDaysGT(v int) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldGT(FieldTrialDays, v))
+}
+
+// TrialDaysGTE applies the GTE predicate on the "trial_days" field.
+func TrialDaysGTE(v int) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldGTE(FieldTrialDays, v))
+}
+
+// TrialDaysLT applies the LT predicate on the "trial_days" field.
+func TrialDaysLT(v int) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldLT(FieldTrialDays, v))
+}
+
+// TrialDaysLTE applies the LTE predicate on the "trial_days" field.
+func TrialDaysLTE(v int) predicate.PromoCodeUsage {
+	return predicate.PromoCodeUsage(sql.FieldLTE(FieldTrialDays, v))
+}
+
 // UsedAtEQ applies the EQ predicate on the "used_at" field.
 func UsedAtEQ(v time.Time) predicate.PromoCodeUsage {
 	return predicate.PromoCodeUsage(sql.FieldEQ(FieldUsedAt, v))
@@ -42,6 +42,62 @@ func (_c *PromoCodeUsageCreate) SetBonusAmount(v float64) *PromoCodeUsageCreate
 	return _c
 }
 
+// SetTopupAmount sets the "topup_amount" field.
+func (_c *PromoCodeUsageCreate) SetTopupAmount(v float64) *PromoCodeUsageCreate {
+	_c.mutation.SetTopupAmount(v)
+	return _c
+}
+
+// SetNillableTopupAmount sets the "topup_amount" field if the given value is not nil.
+func (_c *PromoCodeUsageCreate) SetNillableTopupAmount(v *float64) *PromoCodeUsageCreate {
+	if v != nil {
+		_c.SetTopupAmount(*v)
+	}
+	return _c
+}
+
+// SetPaymentOrderID sets the "payment_order_id" field.
+func (_c *PromoCodeUsageCreate) SetPaymentOrderID(v int64) *PromoCodeUsageCreate {
+	_c.mutation.SetPaymentOrderID(v)
+	return _c
+}
+
+// SetNillablePaymentOrderID sets the "payment_order_id" field if the given value is not nil.
+func (_c *PromoCodeUsageCreate) SetNillablePaymentOrderID(v *int64) *PromoCodeUsageCreate {
+	if v != nil {
+		_c.SetPaymentOrderID(*v)
+	}
+	return _c
+}
+
+// SetTrialGroupID sets the "trial_group_id" field.
+func (_c *PromoCodeUsageCreate) SetTrialGroupID(v int64) *PromoCodeUsageCreate {
+	_c.mutation.SetTrialGroupID(v)
+	return _c
+}
+
+// SetNillableTrialGroupID sets the "trial_group_id" field if the given value is not nil.
+func (_c *PromoCodeUsageCreate) SetNillableTrialGroupID(v *int64) *PromoCodeUsageCreate {
+	if v != nil {
+		_c.SetTrialGroupID(*v)
+	}
+	return _c
+}
+
+// SetTrialDays sets the "trial_days" field.
+func (_c *PromoCodeUsageCreate) SetTrialDays(v int) *PromoCodeUsageCreate {
+	_c.mutation.SetTrialDays(v)
+	return _c
+}
+
+// SetNillableTrialDays sets the "trial_days" field if the given value is not nil.
+func (_c *PromoCodeUsageCreate) SetNillableTrialDays(v *int) *PromoCodeUsageCreate {
+	if v != nil {
+		_c.SetTrialDays(*v)
+	}
+	return _c
+}
+
 // SetUsedAt sets the "used_at" field.
 func (_c *PromoCodeUsageCreate) SetUsedAt(v time.Time) *PromoCodeUsageCreate {
 	_c.mutation.SetUsedAt(v)
@@ -101,6 +157,14 @@ func (_c *PromoCodeUsageCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *PromoCodeUsageCreate) defaults() {
+	if _, ok := _c.mutation.TopupAmount(); !ok {
+		v := promocodeusage.DefaultTopupAmount
+		_c.mutation.SetTopupAmount(v)
+	}
+	if _, ok := _c.mutation.TrialDays(); !ok {
+		v := promocodeusage.DefaultTrialDays
+		_c.mutation.SetTrialDays(v)
+	}
 	if _, ok := _c.mutation.UsedAt(); !ok {
 		v := promocodeusage.DefaultUsedAt()
 		_c.mutation.SetUsedAt(v)
@@ -118,6 +182,12 @@ func (_c *PromoCodeUsageCreate) check() error {
 	if _, ok := _c.mutation.BonusAmount(); !ok {
 		return &ValidationError{Name: "bonus_amount", err: errors.New(`ent: missing required field "PromoCodeUsage.bonus_amount"`)}
 	}
+	if _, ok := _c.mutation.TopupAmount(); !ok {
+		return &ValidationError{Name: "topup_amount", err: errors.New(`ent: missing required field "PromoCodeUsage.topup_amount"`)}
+	}
+	if _, ok := _c.mutation.TrialDays(); !ok {
+		return &ValidationError{Name: "trial_days", err: errors.New(`ent: missing required field "PromoCodeUsage.trial_days"`)}
+	}
 	if _, ok := _c.mutation.UsedAt(); !ok {
 		return &ValidationError{Name: "used_at", err: errors.New(`ent: missing required field "PromoCodeUsage.used_at"`)}
 	}
@@ -158,6 +228,22 @@ func (_c *PromoCodeUsageCreate) createSpec() (*PromoCodeUsage, *sqlgraph.CreateS
 		_spec.SetField(promocodeusage.FieldBonusAmount, field.TypeFloat64, value)
 		_node.BonusAmount = value
 	}
+	if value, ok := _c.mutation.TopupAmount(); ok {
+		_spec.SetField(promocodeusage.FieldTopupAmount, field.TypeFloat64, value)
+		_node.TopupAmount = value
+	}
+	if value, ok := _c.mutation.PaymentOrderID(); ok {
+		_spec.SetField(promocodeusage.FieldPaymentOrderID, field.TypeInt64, value)
+		_node.PaymentOrderID = &value
+	}
+	if value, ok := _c.mutation.TrialGroupID(); ok {
+		_spec.SetField(promocodeusage.FieldTrialGroupID, field.TypeInt64, value)
+		_node.TrialGroupID = &value
+	}
+	if value, ok := _c.mutation.TrialDays(); ok {
+		_spec.SetField(promocodeusage.FieldTrialDays, field.TypeInt, value)
+		_node.TrialDays = value
+	}
 	if value, ok := _c.mutation.UsedAt(); ok {
 		_spec.SetField(promocodeusage.FieldUsedAt, field.TypeTime, value)
 		_node.UsedAt = value
@@ -290,6 +376,90 @@ func (u *PromoCodeUsageUpsert) AddBonusAmount(v float64) *PromoCodeUsageUpsert {
 	return u
 }
 
+// SetTopupAmount sets the "topup_amount" field.
+func (u *PromoCodeUsageUpsert) SetTopupAmount(v float64) *PromoCodeUsageUpsert {
+	u.Set(promocodeusage.FieldTopupAmount, v)
+	return u
+}
+
+// UpdateTopupAmount sets the "topup_amount" field to the value that was provided on create.
+func (u *PromoCodeUsageUpsert) UpdateTopupAmount() *PromoCodeUsageUpsert {
+	u.SetExcluded(promocodeusage.FieldTopupAmount)
+	return u
+}
+
+// AddTopupAmount adds v to the "topup_amount" field.
+func (u *PromoCodeUsageUpsert) AddTopupAmount(v float64) *PromoCodeUsageUpsert {
+	u.Add(promocodeusage.FieldTopupAmount, v)
+	return u
+}
+
+// SetPaymentOrderID sets the "payment_order_id" field.
+func (u *PromoCodeUsageUpsert) SetPaymentOrderID(v int64) *PromoCodeUsageUpsert {
+	u.Set(promocodeusage.FieldPaymentOrderID, v)
+	return u
+}
+
+// UpdatePaymentOrderID sets the "payment_order_id" field to the value that was provided on create.
+func (u *PromoCodeUsageUpsert) UpdatePaymentOrderID() *PromoCodeUsageUpsert {
+	u.SetExcluded(promocodeusage.FieldPaymentOrderID)
+	return u
+}
+
+// AddPaymentOrderID adds v to the "payment_order_id" field.
+func (u *PromoCodeUsageUpsert) AddPaymentOrderID(v int64) *PromoCodeUsageUpsert {
+	u.Add(promocodeusage.FieldPaymentOrderID, v)
+	return u
+}
+
+// ClearPaymentOrderID clears the value of the "payment_order_id" field.
+func (u *PromoCodeUsageUpsert) ClearPaymentOrderID() *PromoCodeUsageUpsert {
+	u.SetNull(promocodeusage.FieldPaymentOrderID)
+	return u
+}
+
+// SetTrialGroupID sets the "trial_group_id" field.
+func (u *PromoCodeUsageUpsert) SetTrialGroupID(v int64) *PromoCodeUsageUpsert {
+	u.Set(promocodeusage.FieldTrialGroupID, v)
+	return u
+}
+
+// UpdateTrialGroupID sets the "trial_group_id" field to the value that was provided on create.
+func (u *PromoCodeUsageUpsert) UpdateTrialGroupID() *PromoCodeUsageUpsert {
+	u.SetExcluded(promocodeusage.FieldTrialGroupID)
+	return u
+}
+
+// AddTrialGroupID adds v to the "trial_group_id" field.
+func (u *PromoCodeUsageUpsert) AddTrialGroupID(v int64) *PromoCodeUsageUpsert {
+	u.Add(promocodeusage.FieldTrialGroupID, v)
+	return u
+}
+
+// ClearTrialGroupID clears the value of the "trial_group_id" field.
+func (u *PromoCodeUsageUpsert) ClearTrialGroupID() *PromoCodeUsageUpsert {
+	u.SetNull(promocodeusage.FieldTrialGroupID)
+	return u
+}
+
+// SetTrialDays sets the "trial_days" field.
+func (u *PromoCodeUsageUpsert) SetTrialDays(v int) *PromoCodeUsageUpsert {
+	u.Set(promocodeusage.FieldTrialDays, v)
+	return u
+}
+
+// UpdateTrialDays sets the "trial_days" field to the value that was provided on create.
+func (u *PromoCodeUsageUpsert) UpdateTrialDays() *PromoCodeUsageUpsert {
+	u.SetExcluded(promocodeusage.FieldTrialDays)
+	return u
+}
+
+// AddTrialDays adds v to the "trial_days" field.
+func (u *PromoCodeUsageUpsert) AddTrialDays(v int) *PromoCodeUsageUpsert {
+	u.Add(promocodeusage.FieldTrialDays, v)
+	return u
+}
+
 // SetUsedAt sets the "used_at" field.
 func (u *PromoCodeUsageUpsert) SetUsedAt(v time.Time) *PromoCodeUsageUpsert {
 	u.Set(promocodeusage.FieldUsedAt, v)
@@ -391,6 +561,104 @@ func (u *PromoCodeUsageUpsertOne) UpdateBonusAmount() *PromoCodeUsageUpsertOne {
 	})
 }
 
+// SetTopupAmount sets the "topup_amount" field.
+func (u *PromoCodeUsageUpsertOne) SetTopupAmount(v float64) *PromoCodeUsageUpsertOne {
+	return u.Update(func(s *PromoCodeUsageUpsert) {
+		s.SetTopupAmount(v)
+	})
+}
+
+// AddTopupAmount adds v to the "topup_amount" field.
+func (u *PromoCodeUsageUpsertOne) AddTopupAmount(v float64) *PromoCodeUsageUpsertOne {
+	return u.Update(func(s *PromoCodeUsageUpsert) {
+		s.AddTopupAmount(v)
+	})
+}
+
+// UpdateTopupAmount sets the "topup_amount" field to the value that was provided on create.
+func (u *PromoCodeUsageUpsertOne) UpdateTopupAmount() *PromoCodeUsageUpsertOne {
+	return u.Update(func(s *PromoCodeUsageUpsert) {
+		s.UpdateTopupAmount()
+	})
+}
+
+// SetPaymentOrderID sets the "payment_order_id" field.
+func (u *PromoCodeUsageUpsertOne) SetPaymentOrderID(v int64) *PromoCodeUsageUpsertOne {
+	return u.Update(func(s *PromoCodeUsageUpsert) {
+		s.SetPaymentOrderID(v)
+	})
+}
+
+// AddPaymentOrderID adds v to the "payment_order_id" field.
+func (u *PromoCodeUsageUpsertOne) AddPaymentOrderID(v int64) *PromoCodeUsageUpsertOne {
+	return u.Update(func(s *PromoCodeUsageUpsert) {
+		s.AddPaymentOrderID(v)
+	})
+}
+
+// UpdatePaymentOrderID sets the "payment_order_id" field to the value that was provided on create.
+func (u *PromoCodeUsageUpsertOne) UpdatePaymentOrderID() *PromoCodeUsageUpsertOne {
+	return u.Update(func(s *PromoCodeUsageUpsert) {
+		s.UpdatePaymentOrderID()
+	})
+}
+
+// ClearPaymentOrderID clears the value of the "payment_order_id" field.
+func (u *PromoCodeUsageUpsertOne) ClearPaymentOrderID() *PromoCodeUsageUpsertOne {
+	return u.Update(func(s *PromoCodeUsageUpsert) {
+		s.ClearPaymentOrderID()
+	})
+}
+
+// SetTrialGroupID sets the "trial_group_id" field.
+func (u *PromoCodeUsageUpsertOne) SetTrialGroupID(v int64) *PromoCodeUsageUpsertOne {
+	return u.Update(func(s *PromoCodeUsageUpsert) {
+		s.SetTrialGroupID(v)
+	})
+}
+
+// AddTrialGroupID adds v to the "trial_group_id" field.
+func (u *PromoCodeUsageUpsertOne) AddTrialGroupID(v int64) *PromoCodeUsageUpsertOne {
+	return u.Update(func(s *PromoCodeUsageUpsert) {
+		s.AddTrialGroupID(v)
+	})
+}
+
+// UpdateTrialGroupID sets the "trial_group_id" field to the value that was provided on create.
+func (u *PromoCodeUsageUpsertOne) UpdateTrialGroupID() *PromoCodeUsageUpsertOne {
+	return u.Update(func(s *PromoCodeUsageUpsert) {
+		s.UpdateTrialGroupID()
+	})
+}
+
+// ClearTrialGroupID clears the value of the "trial_group_id" field.
+func (u *PromoCodeUsageUpsertOne) ClearTrialGroupID() *PromoCodeUsageUpsertOne {
+	return u.Update(func(s *PromoCodeUsageUpsert) {
+		s.ClearTrialGroupID()
+	})
+}
+
+// SetTrialDays sets the "trial_days" field.
+func (u *PromoCodeUsageUpsertOne) SetTrialDays(v int) *PromoCodeUsageUpsertOne {
+	return u.Update(func(s *PromoCodeUsageUpsert) {
+		s.SetTrialDays(v)
+	})
+}
+
+// AddTrialDays adds v to the "trial_days" field.
+func (u *PromoCodeUsageUpsertOne) AddTrialDays(v int) *PromoCodeUsageUpsertOne {
+	return u.Update(func(s *PromoCodeUsageUpsert) {
+		s.AddTrialDays(v)
+	})
+}
+
+// UpdateTrialDays sets the "trial_days" field to the value that was provided on create.
+func (u *PromoCodeUsageUpsertOne) UpdateTrialDays() *PromoCodeUsageUpsertOne {
+	return u.Update(func(s *PromoCodeUsageUpsert) {
+		s.UpdateTrialDays()
+	})
+}
+
 // SetUsedAt sets the "used_at" field.
 func (u *PromoCodeUsageUpsertOne) SetUsedAt(v time.Time) *PromoCodeUsageUpsertOne {
 	return u.Update(func(s *PromoCodeUsageUpsert) {
@@ -658,6 +926,104 @@ func (u *PromoCodeUsageUpsertBulk) UpdateBonusAmount() *PromoCodeUsageUpsertBulk
 	})
 }
 
+// SetTopupAmount sets the "topup_amount" field.
+func (u *PromoCodeUsageUpsertBulk) SetTopupAmount(v float64) *PromoCodeUsageUpsertBulk {
+	return u.Update(func(s *PromoCodeUsageUpsert) {
+		s.SetTopupAmount(v)
+	})
+}
+
+// AddTopupAmount adds v to the "topup_amount" field.
+func (u *PromoCodeUsageUpsertBulk) AddTopupAmount(v float64) *PromoCodeUsageUpsertBulk {
+	return u.Update(func(s *PromoCodeUsageUpsert) {
+		s.AddTopupAmount(v)
+	})
+}
+
+// UpdateTopupAmount sets the "topup_amount" field to the value that was provided on create.
+func (u *PromoCodeUsageUpsertBulk) UpdateTopupAmount() *PromoCodeUsageUpsertBulk {
+	return u.Update(func(s *PromoCodeUsageUpsert) {
+		s.UpdateTopupAmount()
+	})
+}
+
+// SetPaymentOrderID sets the "payment_order_id" field.
+func (u *PromoCodeUsageUpsertBulk) SetPaymentOrderID(v int64) *PromoCodeUsageUpsertBulk {
+	return u.Update(func(s *PromoCodeUsageUpsert) {
+		s.SetPaymentOrderID(v)
+	})
+}
+
+// AddPaymentOrderID adds v to the "payment_order_id" field.
+func (u *PromoCodeUsageUpsertBulk) AddPaymentOrderID(v int64) *PromoCodeUsageUpsertBulk {
+	return u.Update(func(s *PromoCodeUsageUpsert) {
+		s.AddPaymentOrderID(v)
+	})
+}
+
+// UpdatePaymentOrderID sets the "payment_order_id" field to the value that was provided on create.
+func (u *PromoCodeUsageUpsertBulk) UpdatePaymentOrderID() *PromoCodeUsageUpsertBulk {
+	return u.Update(func(s *PromoCodeUsageUpsert) {
+		s.UpdatePaymentOrderID()
+	})
+}
+
+// ClearPaymentOrderID clears the value of the "payment_order_id" field.
+func (u *PromoCodeUsageUpsertBulk) ClearPaymentOrderID() *PromoCodeUsageUpsertBulk {
+	return u.Update(func(s *PromoCodeUsageUpsert) {
+		s.ClearPaymentOrderID()
+	})
+}
+
+// SetTrialGroupID sets the "trial_group_id" field.
+func (u *PromoCodeUsageUpsertBulk) SetTrialGroupID(v int64) *PromoCodeUsageUpsertBulk {
+	return u.Update(func(s *PromoCodeUsageUpsert) {
+		s.SetTrialGroupID(v)
+	})
+}
+
+// AddTrialGroupID adds v to the "trial_group_id" field.
+func (u *PromoCodeUsageUpsertBulk) AddTrialGroupID(v int64) *PromoCodeUsageUpsertBulk {
+	return u.Update(func(s *PromoCodeUsageUpsert) {
+		s.AddTrialGroupID(v)
+	})
+}
+
+// UpdateTrialGroupID sets the "trial_group_id" field to the value that was provided on create.
+func (u *PromoCodeUsageUpsertBulk) UpdateTrialGroupID() *PromoCodeUsageUpsertBulk {
+	return u.Update(func(s *PromoCodeUsageUpsert) {
+		s.UpdateTrialGroupID()
+	})
+}
+
+// ClearTrialGroupID clears the value of the "trial_group_id" field.
+func (u *PromoCodeUsageUpsertBulk) ClearTrialGroupID() *PromoCodeUsageUpsertBulk {
+	return u.Update(func(s *PromoCodeUsageUpsert) {
+		s.ClearTrialGroupID()
+	})
+}
+
+// SetTrialDays sets the "trial_days" field.
+func (u *PromoCodeUsageUpsertBulk) SetTrialDays(v int) *PromoCodeUsageUpsertBulk {
+	return u.Update(func(s *PromoCodeUsageUpsert) {
+		s.SetTrialDays(v)
+	})
+}
+
+// AddTrialDays adds v to the "trial_days" field.
+func (u *PromoCodeUsageUpsertBulk) AddTrialDays(v int) *PromoCodeUsageUpsertBulk {
+	return u.Update(func(s *PromoCodeUsageUpsert) {
+		s.AddTrialDays(v)
+	})
+}
+
+// UpdateTrialDays sets the "trial_days" field to the value that was provided on create.
+func (u *PromoCodeUsageUpsertBulk) UpdateTrialDays() *PromoCodeUsageUpsertBulk {
+	return u.Update(func(s *PromoCodeUsageUpsert) {
+		s.UpdateTrialDays()
+	})
+}
+
 // SetUsedAt sets the "used_at" field.
 func (u *PromoCodeUsageUpsertBulk) SetUsedAt(v time.Time) *PromoCodeUsageUpsertBulk {
 	return u.Update(func(s *PromoCodeUsageUpsert) {
@@ -79,6 +79,102 @@ func (_u *PromoCodeUsageUpdate) AddBonusAmount(v float64) *PromoCodeUsageUpdate
 	return _u
 }
 
+// SetTopupAmount sets the "topup_amount" field.
+func (_u *PromoCodeUsageUpdate) SetTopupAmount(v float64) *PromoCodeUsageUpdate {
+	_u.mutation.ResetTopupAmount()
+	_u.mutation.SetTopupAmount(v)
+	return _u
+}
+
+// SetNillableTopupAmount sets the "topup_amount" field if the given value is not nil.
+func (_u *PromoCodeUsageUpdate) SetNillableTopupAmount(v *float64) *PromoCodeUsageUpdate {
+	if v != nil {
+		_u.SetTopupAmount(*v)
+	}
+	return _u
+}
+
+// AddTopupAmount adds value to the "topup_amount" field.
+func (_u *PromoCodeUsageUpdate) AddTopupAmount(v float64) *PromoCodeUsageUpdate {
+	_u.mutation.AddTopupAmount(v)
+	return _u
+}
+
+// SetPaymentOrderID sets the "payment_order_id" field.
+func (_u *PromoCodeUsageUpdate) SetPaymentOrderID(v int64) *PromoCodeUsageUpdate {
+	_u.mutation.ResetPaymentOrderID()
+	_u.mutation.SetPaymentOrderID(v)
+	return _u
+}
+
+// SetNillablePaymentOrderID sets the "payment_order_id" field if the given value is not nil.
+func (_u *PromoCodeUsageUpdate) SetNillablePaymentOrderID(v *int64) *PromoCodeUsageUpdate {
+	if v != nil {
+		_u.SetPaymentOrderID(*v)
+	}
+	return _u
+}
+
+// AddPaymentOrderID adds value to the "payment_order_id" field.
+func (_u *PromoCodeUsageUpdate) AddPaymentOrderID(v int64) *PromoCodeUsageUpdate {
+	_u.mutation.AddPaymentOrderID(v)
+	return _u
+}
+
+// ClearPaymentOrderID clears the value of the "payment_order_id" field.
+func (_u *PromoCodeUsageUpdate) ClearPaymentOrderID() *PromoCodeUsageUpdate {
+	_u.mutation.ClearPaymentOrderID()
+	return _u
+}
+
+// SetTrialGroupID sets the "trial_group_id" field.
+func (_u *PromoCodeUsageUpdate) SetTrialGroupID(v int64) *PromoCodeUsageUpdate {
+	_u.mutation.ResetTrialGroupID()
+	_u.mutation.SetTrialGroupID(v)
+	return _u
+}
+
+// SetNillableTrialGroupID sets the "trial_group_id" field if the given value is not nil.
+func (_u *PromoCodeUsageUpdate) SetNillableTrialGroupID(v *int64) *PromoCodeUsageUpdate {
+	if v != nil {
+		_u.SetTrialGroupID(*v)
+	}
+	return _u
+}
+
+// AddTrialGroupID adds value to the "trial_group_id" field.
+func (_u *PromoCodeUsageUpdate) AddTrialGroupID(v int64) *PromoCodeUsageUpdate {
+	_u.mutation.AddTrialGroupID(v)
+	return _u
+}
+
+// ClearTrialGroupID clears the value of the "trial_group_id" field.
+func (_u *PromoCodeUsageUpdate) ClearTrialGroupID() *PromoCodeUsageUpdate {
+	_u.mutation.ClearTrialGroupID()
+	return _u
+}
+
+// SetTrialDays sets the "trial_days" field.
+func (_u *PromoCodeUsageUpdate) SetTrialDays(v int) *PromoCodeUsageUpdate {
+	_u.mutation.ResetTrialDays()
+	_u.mutation.SetTrialDays(v)
+	return _u
+}
+
+// SetNillableTrialDays sets the "trial_days" field if the given value is not nil.
+func (_u *PromoCodeUsageUpdate) SetNillableTrialDays(v *int) *PromoCodeUsageUpdate {
+	if v != nil {
+		_u.SetTrialDays(*v)
+	}
+	return _u
+}
+
+// AddTrialDays adds value to the "trial_days" field.
+func (_u *PromoCodeUsageUpdate) AddTrialDays(v int) *PromoCodeUsageUpdate {
+	_u.mutation.AddTrialDays(v)
+	return _u
+}
+
 // SetUsedAt sets the "used_at" field.
 func (_u *PromoCodeUsageUpdate) SetUsedAt(v time.Time) *PromoCodeUsageUpdate {
 	_u.mutation.SetUsedAt(v)
@@ -176,6 +272,36 @@ func (_u *PromoCodeUsageUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if value, ok := _u.mutation.AddedBonusAmount(); ok {
 		_spec.AddField(promocodeusage.FieldBonusAmount, field.TypeFloat64, value)
 	}
+	if value, ok := _u.mutation.TopupAmount(); ok {
+		_spec.SetField(promocodeusage.FieldTopupAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTopupAmount(); ok {
+		_spec.AddField(promocodeusage.FieldTopupAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.PaymentOrderID(); ok {
+		_spec.SetField(promocodeusage.FieldPaymentOrderID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPaymentOrderID(); ok {
+		_spec.AddField(promocodeusage.FieldPaymentOrderID, field.TypeInt64, value)
+	}
+	if _u.mutation.PaymentOrderIDCleared() {
+		_spec.ClearField(promocodeusage.FieldPaymentOrderID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.TrialGroupID(); ok {
+		_spec.SetField(promocodeusage.FieldTrialGroupID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTrialGroupID(); ok {
+		_spec.AddField(promocodeusage.FieldTrialGroupID, field.TypeInt64, value)
+	}
+	if _u.mutation.TrialGroupIDCleared() {
+		_spec.ClearField(promocodeusage.FieldTrialGroupID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.TrialDays(); ok {
+		_spec.SetField(promocodeusage.FieldTrialDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTrialDays(); ok {
+		_spec.AddField(promocodeusage.FieldTrialDays, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.UsedAt(); ok {
 		_spec.SetField(promocodeusage.FieldUsedAt, field.TypeTime, value)
 	}
@@ -306,6 +432,102 @@ func (_u *PromoCodeUsageUpdateOne) AddBonusAmount(v float64) *PromoCodeUsageUpda
 	return _u
 }
 
+// SetTopupAmount sets the "topup_amount" field.
+func (_u *PromoCodeUsageUpdateOne) SetTopupAmount(v float64) *PromoCodeUsageUpdateOne {
+	_u.mutation.ResetTopupAmount()
+	_u.mutation.SetTopupAmount(v)
+	return _u
+}
+
+// SetNillableTopupAmount sets the "topup_amount" field if the given value is not nil.
+func (_u *PromoCodeUsageUpdateOne) SetNillableTopupAmount(v *float64) *PromoCodeUsageUpdateOne {
+	if v != nil {
+		_u.SetTopupAmount(*v)
+	}
+	return _u
+}
+
+// AddTopupAmount adds value to the "topup_amount" field.
+func (_u *PromoCodeUsageUpdateOne) AddTopupAmount(v float64) *PromoCodeUsageUpdateOne {
+	_u.mutation.AddTopupAmount(v)
+	return _u
+}
+
+// SetPaymentOrderID sets the "payment_order_id" field.
+func (_u *PromoCodeUsageUpdateOne) SetPaymentOrderID(v int64) *PromoCodeUsageUpdateOne {
+	_u.mutation.ResetPaymentOrderID()
+	_u.mutation.SetPaymentOrderID(v)
+	return _u
+}
+
+// SetNillablePaymentOrderID sets the "payment_order_id" field if the given value is not nil.
+func (_u *PromoCodeUsageUpdateOne) SetNillablePaymentOrderID(v *int64) *PromoCodeUsageUpdateOne {
+	if v != nil {
+		_u.SetPaymentOrderID(*v)
+	}
+	return _u
+}
+
+// AddPaymentOrderID adds value to the "payment_order_id" field.
+func (_u *PromoCodeUsageUpdateOne) AddPaymentOrderID(v int64) *PromoCodeUsageUpdateOne {
+	_u.mutation.AddPaymentOrderID(v)
+	return _u
+}
+
+// ClearPaymentOrderID clears the value of the "payment_order_id" field.
+func (_u *PromoCodeUsageUpdateOne) ClearPaymentOrderID() *PromoCodeUsageUpdateOne {
+	_u.mutation.ClearPaymentOrderID()
+	return _u
+}
+
+// SetTrialGroupID sets the "trial_group_id" field.
+func (_u *PromoCodeUsageUpdateOne) SetTrialGroupID(v int64) *PromoCodeUsageUpdateOne {
+	_u.mutation.ResetTrialGroupID()
+	_u.mutation.SetTrialGroupID(v)
+	return _u
+}
+
+// SetNillableTrialGroupID sets the "trial_group_id" field if the given value is not nil.
+func (_u *PromoCodeUsageUpdateOne) SetNillableTrialGroupID(v *int64) *PromoCodeUsageUpdateOne {
+	if v != nil {
+		_u.SetTrialGroupID(*v)
+	}
+	return _u
+}
+
+// AddTrialGroupID adds value to the "trial_group_id" field.
+func (_u *PromoCodeUsageUpdateOne) AddTrialGroupID(v int64) *PromoCodeUsageUpdateOne {
+	_u.mutation.AddTrialGroupID(v)
+	return _u
+}
+
+// ClearTrialGroupID clears the value of the "trial_group_id" field.
+func (_u *PromoCodeUsageUpdateOne) ClearTrialGroupID() *PromoCodeUsageUpdateOne {
+	_u.mutation.ClearTrialGroupID()
+	return _u
+}
+
+// SetTrialDays sets the "trial_days" field.
+func (_u *PromoCodeUsageUpdateOne) SetTrialDays(v int) *PromoCodeUsageUpdateOne {
+	_u.mutation.ResetTrialDays()
+	_u.mutation.SetTrialDays(v)
+	return _u
+}
+
+// SetNillableTrialDays sets the "trial_days" field if the given value is not nil.
+func (_u *PromoCodeUsageUpdateOne) SetNillableTrialDays(v *int) *PromoCodeUsageUpdateOne {
+	if v != nil {
+		_u.SetTrialDays(*v)
+	}
+	return _u
+}
+
+// AddTrialDays adds value to the "trial_days" field.
+func (_u *PromoCodeUsageUpdateOne) AddTrialDays(v int) *PromoCodeUsageUpdateOne {
+	_u.mutation.AddTrialDays(v)
+	return _u
+}
+
 // SetUsedAt sets the "used_at" field.
 func (_u *PromoCodeUsageUpdateOne) SetUsedAt(v time.Time) *PromoCodeUsageUpdateOne {
 	_u.mutation.SetUsedAt(v)
@@ -433,6 +655,36 @@ func (_u *PromoCodeUsageUpdateOne) sqlSave(ctx context.Context) (_node *PromoCod
 	if value, ok := _u.mutation.AddedBonusAmount(); ok {
 		_spec.AddField(promocodeusage.FieldBonusAmount, field.TypeFloat64, value)
 	}
+	if value, ok := _u.mutation.TopupAmount(); ok {
+		_spec.SetField(promocodeusage.FieldTopupAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTopupAmount(); ok {
+		_spec.AddField(promocodeusage.FieldTopupAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.PaymentOrderID(); ok {
+		_spec.SetField(promocodeusage.FieldPaymentOrderID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPaymentOrderID(); ok {
+		_spec.AddField(promocodeusage.FieldPaymentOrderID, field.TypeInt64, value)
+	}
+	if _u.mutation.PaymentOrderIDCleared() {
+		_spec.ClearField(promocodeusage.FieldPaymentOrderID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.TrialGroupID(); ok {
+		_spec.SetField(promocodeusage.FieldTrialGroupID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTrialGroupID(); ok {
+		_spec.AddField(promocodeusage.FieldTrialGroupID, field.TypeInt64, value)
+	}
+	if _u.mutation.TrialGroupIDCleared() {
+		_spec.ClearField(promocodeusage.FieldTrialGroupID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.TrialDays(); ok {
+		_spec.SetField(promocodeusage.FieldTrialDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTrialDays(); ok {
+		_spec.AddField(promocodeusage.FieldTrialDays, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.UsedAt(); ok {
 		_spec.SetField(promocodeusage.FieldUsedAt, field.TypeTime, value)
 	}
@@ -39,6 +39,14 @@ type RedeemCode struct {
 	GroupID *int64 `json:"group_id,omitempty"`
 	// ValidityDays holds the value of the "validity_days" field.
 	ValidityDays int `json:"validity_days,omitempty"`
+	// 余额类兑换码发放额度的有效期（天），0 表示永不过期
+	CreditValidityDays int `json:"credit_validity_days,omitempty"`
+	// BatchID holds the value of the "batch_id" field.
+	BatchID *int64 `json:"batch_id,omitempty"`
+	// MaxRedemptions holds the value of the "max_redemptions" field.
+	MaxRedemptions int `json:"max_redemptions,omitempty"`
+	// RedeemedCount holds the value of the "redeemed_count" field.
+	RedeemedCount int `json:"redeemed_count,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the RedeemCodeQuery when eager-loading is set.
 	Edges        RedeemCodeEdges `json:"edges"`
@@ -85,7 +93,7 @@ func (*RedeemCode) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case redeemcode.FieldValue:
 			values[i] = new(sql.NullFloat64)
-		case redeemcode.FieldID, redeemcode.FieldUsedBy, redeemcode.FieldGroupID, redeemcode.FieldValidityDays:
+		case redeemcode.FieldID, redeemcode.FieldUsedBy, redeemcode.FieldGroupID, redeemcode.FieldValidityDays, redeemcode.FieldCreditValidityDays, redeemcode.FieldBatchID, redeemcode.FieldMaxRedemptions, redeemcode.FieldRedeemedCount:
 			values[i] = new(sql.NullInt64)
 		case redeemcode.FieldCode, redeemcode.FieldType, redeemcode.FieldStatus, redeemcode.FieldNotes:
 			values[i] = new(sql.NullString)
@@ -176,6 +184,31 @@ func (_m *RedeemCode) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ValidityDays = int(value.Int64)
 			}
+		case redeemcode.FieldCreditValidityDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field credit_validity_days", values[i])
+			} else if value.Valid {
+				_m.CreditValidityDays = int(value.Int64)
+			}
+		case redeemcode.FieldBatchID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field batch_id", values[i])
+			} else if value.Valid {
+				_m.BatchID = new(int64)
+				*_m.BatchID = value.Int64
+			}
+		case redeemcode.FieldMaxRedemptions:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_redemptions", values[i])
+			} else if value.Valid {
+				_m.MaxRedemptions = int(value.Int64)
+			}
+		case redeemcode.FieldRedeemedCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field redeemed_count", values[i])
+			} else if value.Valid {
+				_m.RedeemedCount = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -259,6 +292,20 @@ func (_m *RedeemCode) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("validity_days=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ValidityDays))
+	builder.WriteString(", ")
+	builder.WriteString("credit_validity_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreditValidityDays))
+	builder.WriteString(", ")
+	if v := _m.BatchID; v != nil {
+		builder.WriteString("batch_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("max_redemptions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxRedemptions))
+	builder.WriteString(", ")
+	builder.WriteString("redeemed_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RedeemedCount))
 	builder.WriteByte(')')
 	return builder.String()
 }
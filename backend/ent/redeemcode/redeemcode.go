@@ -34,6 +34,14 @@ const (
 	FieldGroupID = "group_id"
 	// FieldValidityDays holds the string denoting the validity_days field in the database.
 	FieldValidityDays = "validity_days"
+	// FieldCreditValidityDays holds the string denoting the credit_validity_days field in the database.
+	FieldCreditValidityDays = "credit_validity_days"
+	// FieldBatchID holds the string denoting the batch_id field in the database.
+	FieldBatchID = "batch_id"
+	// FieldMaxRedemptions holds the string denoting the max_redemptions field in the database.
+	FieldMaxRedemptions = "max_redemptions"
+	// FieldRedeemedCount holds the string denoting the redeemed_count field in the database.
+	FieldRedeemedCount = "redeemed_count"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// EdgeGroup holds the string denoting the group edge name in mutations.
@@ -69,6 +77,10 @@ var Columns = []string{
 	FieldCreatedAt,
 	FieldGroupID,
 	FieldValidityDays,
+	FieldCreditValidityDays,
+	FieldBatchID,
+	FieldMaxRedemptions,
+	FieldRedeemedCount,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -98,6 +110,12 @@ var (
 	DefaultCreatedAt func() time.Time
 	// DefaultValidityDays holds the default value on creation for the "validity_days" field.
 	DefaultValidityDays int
+	// DefaultCreditValidityDays holds the default value on creation for the "credit_validity_days" field.
+	DefaultCreditValidityDays int
+	// DefaultMaxRedemptions holds the default value on creation for the "max_redemptions" field.
+	DefaultMaxRedemptions int
+	// DefaultRedeemedCount holds the default value on creation for the "redeemed_count" field.
+	DefaultRedeemedCount int
 )
 
 // OrderOption defines the ordering options for the RedeemCode queries.
@@ -158,6 +176,26 @@ func ByValidityDays(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldValidityDays, opts...).ToFunc()
 }
 
+// ByCreditValidityDays orders the results by the credit_validity_days field.
+func ByCreditValidityDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreditValidityDays, opts...).ToFunc()
+}
+
+// ByBatchID orders the results by the batch_id field.
+func ByBatchID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBatchID, opts...).ToFunc()
+}
+
+// ByMaxRedemptions orders the results by the max_redemptions field.
+func ByMaxRedemptions(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxRedemptions, opts...).ToFunc()
+}
+
+// ByRedeemedCount orders the results by the redeemed_count field.
+func ByRedeemedCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRedeemedCount, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
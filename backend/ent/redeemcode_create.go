@@ -156,6 +156,62 @@ func (_c *RedeemCodeCreate) SetNillableValidityDays(v *int) *RedeemCodeCreate {
 	return _c
 }
 
+// SetCreditValidityDays sets the "credit_validity_days" field.
+func (_c *RedeemCodeCreate) SetCreditValidityDays(v int) *RedeemCodeCreate {
+	_c.mutation.SetCreditValidityDays(v)
+	return _c
+}
+
+// SetNillableCreditValidityDays sets the "credit_validity_days" field if the given value is not nil.
+func (_c *RedeemCodeCreate) SetNillableCreditValidityDays(v *int) *RedeemCodeCreate {
+	if v != nil {
+		_c.SetCreditValidityDays(*v)
+	}
+	return _c
+}
+
+// SetBatchID sets the "batch_id" field.
+func (_c *RedeemCodeCreate) SetBatchID(v int64) *RedeemCodeCreate {
+	_c.mutation.SetBatchID(v)
+	return _c
+}
+
+// SetNillableBatchID sets the "batch_id" field if the given value is not nil.
+func (_c *RedeemCodeCreate) SetNillableBatchID(v *int64) *RedeemCodeCreate {
+	if v != nil {
+		_c.SetBatchID(*v)
+	}
+	return _c
+}
+
+// SetMaxRedemptions sets the "max_redemptions" field.
+func (_c *RedeemCodeCreate) SetMaxRedemptions(v int) *RedeemCodeCreate {
+	_c.mutation.SetMaxRedemptions(v)
+	return _c
+}
+
+// SetNillableMaxRedemptions sets the "max_redemptions" field if the given value is not nil.
+func (_c *RedeemCodeCreate) SetNillableMaxRedemptions(v *int) *RedeemCodeCreate {
+	if v != nil {
+		_c.SetMaxRedemptions(*v)
+	}
+	return _c
+}
+
+// SetRedeemedCount sets the "redeemed_count" field.
+func (_c *RedeemCodeCreate) SetRedeemedCount(v int) *RedeemCodeCreate {
+	_c.mutation.SetRedeemedCount(v)
+	return _c
+}
+
+// SetNillableRedeemedCount sets the "redeemed_count" field if the given value is not nil.
+func (_c *RedeemCodeCreate) SetNillableRedeemedCount(v *int) *RedeemCodeCreate {
+	if v != nil {
+		_c.SetRedeemedCount(*v)
+	}
+	return _c
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_c *RedeemCodeCreate) SetUserID(id int64) *RedeemCodeCreate {
 	_c.mutation.SetUserID(id)
@@ -235,6 +291,18 @@ func (_c *RedeemCodeCreate) defaults() {
 		v := redeemcode.DefaultValidityDays
 		_c.mutation.SetValidityDays(v)
 	}
+	if _, ok := _c.mutation.CreditValidityDays(); !ok {
+		v := redeemcode.DefaultCreditValidityDays
+		_c.mutation.SetCreditValidityDays(v)
+	}
+	if _, ok := _c.mutation.MaxRedemptions(); !ok {
+		v := redeemcode.DefaultMaxRedemptions
+		_c.mutation.SetMaxRedemptions(v)
+	}
+	if _, ok := _c.mutation.RedeemedCount(); !ok {
+		v := redeemcode.DefaultRedeemedCount
+		_c.mutation.SetRedeemedCount(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -272,6 +340,15 @@ func (_c *RedeemCodeCreate) check() error {
 	if _, ok := _c.mutation.ValidityDays(); !ok {
 		return &ValidationError{Name: "validity_days", err: errors.New(`ent: missing required field "RedeemCode.validity_days"`)}
 	}
+	if _, ok := _c.mutation.CreditValidityDays(); !ok {
+		return &ValidationError{Name: "credit_validity_days", err: errors.New(`ent: missing required field "RedeemCode.credit_validity_days"`)}
+	}
+	if _, ok := _c.mutation.MaxRedemptions(); !ok {
+		return &ValidationError{Name: "max_redemptions", err: errors.New(`ent: missing required field "RedeemCode.max_redemptions"`)}
+	}
+	if _, ok := _c.mutation.RedeemedCount(); !ok {
+		return &ValidationError{Name: "redeemed_count", err: errors.New(`ent: missing required field "RedeemCode.redeemed_count"`)}
+	}
 	return nil
 }
 
@@ -331,6 +408,22 @@ func (_c *RedeemCodeCreate) createSpec() (*RedeemCode, *sqlgraph.CreateSpec) {
 		_spec.SetField(redeemcode.FieldValidityDays, field.TypeInt, value)
 		_node.ValidityDays = value
 	}
+	if value, ok := _c.mutation.CreditValidityDays(); ok {
+		_spec.SetField(redeemcode.FieldCreditValidityDays, field.TypeInt, value)
+		_node.CreditValidityDays = value
+	}
+	if value, ok := _c.mutation.BatchID(); ok {
+		_spec.SetField(redeemcode.FieldBatchID, field.TypeInt64, value)
+		_node.BatchID = &value
+	}
+	if value, ok := _c.mutation.MaxRedemptions(); ok {
+		_spec.SetField(redeemcode.FieldMaxRedemptions, field.TypeInt, value)
+		_node.MaxRedemptions = value
+	}
+	if value, ok := _c.mutation.RedeemedCount(); ok {
+		_spec.SetField(redeemcode.FieldRedeemedCount, field.TypeInt, value)
+		_node.RedeemedCount = value
+	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -561,6 +654,84 @@ func (u *RedeemCodeUpsert) AddValidityDays(v int) *RedeemCodeUpsert {
 	return u
 }
 
+// SetCreditValidityDays sets the "credit_validity_days" field.
+func (u *RedeemCodeUpsert) SetCreditValidityDays(v int) *RedeemCodeUpsert {
+	u.Set(redeemcode.FieldCreditValidityDays, v)
+	return u
+}
+
+// UpdateCreditValidityDays sets the "credit_validity_days" field to the value that was provided on create.
+func (u *RedeemCodeUpsert) UpdateCreditValidityDays() *RedeemCodeUpsert {
+	u.SetExcluded(redeemcode.FieldCreditValidityDays)
+	return u
+}
+
+// AddCreditValidityDays adds v to the "credit_validity_days" field.
+func (u *RedeemCodeUpsert) AddCreditValidityDays(v int) *RedeemCodeUpsert {
+	u.Add(redeemcode.FieldCreditValidityDays, v)
+	return u
+}
+
+// SetBatchID sets the "batch_id" field.
+func (u *RedeemCodeUpsert) SetBatchID(v int64) *RedeemCodeUpsert {
+	u.Set(redeemcode.FieldBatchID, v)
+	return u
+}
+
+// UpdateBatchID sets the "batch_id" field to the value that was provided on create.
+func (u *RedeemCodeUpsert) UpdateBatchID() *RedeemCodeUpsert {
+	u.SetExcluded(redeemcode.FieldBatchID)
+	return u
+}
+
+// AddBatchID adds v to the "batch_id" field.
+func (u *RedeemCodeUpsert) AddBatchID(v int64) *RedeemCodeUpsert {
+	u.Add(redeemcode.FieldBatchID, v)
+	return u
+}
+
+// ClearBatchID clears the value of the "batch_id" field.
+func (u *RedeemCodeUpsert) ClearBatchID() *RedeemCodeUpsert {
+	u.SetNull(redeemcode.FieldBatchID)
+	return u
+}
+
+// SetMaxRedemptions sets the "max_redemptions" field.
+func (u *RedeemCodeUpsert) SetMaxRedemptions(v int) *RedeemCodeUpsert {
+	u.Set(redeemcode.FieldMaxRedemptions, v)
+	return u
+}
+
+// UpdateMaxRedemptions sets the "max_redemptions" field to the value that was provided on create.
+func (u *RedeemCodeUpsert) UpdateMaxRedemptions() *RedeemCodeUpsert {
+	u.SetExcluded(redeemcode.FieldMaxRedemptions)
+	return u
+}
+
+// AddMaxRedemptions adds v to the "max_redemptions" field.
+func (u *RedeemCodeUpsert) AddMaxRedemptions(v int) *RedeemCodeUpsert {
+	u.Add(redeemcode.FieldMaxRedemptions, v)
+	return u
+}
+
+// SetRedeemedCount sets the "redeemed_count" field.
+func (u *RedeemCodeUpsert) SetRedeemedCount(v int) *RedeemCodeUpsert {
+	u.Set(redeemcode.FieldRedeemedCount, v)
+	return u
+}
+
+// UpdateRedeemedCount sets the "redeemed_count" field to the value that was provided on create.
+func (u *RedeemCodeUpsert) UpdateRedeemedCount() *RedeemCodeUpsert {
+	u.SetExcluded(redeemcode.FieldRedeemedCount)
+	return u
+}
+
+// AddRedeemedCount adds v to the "redeemed_count" field.
+func (u *RedeemCodeUpsert) AddRedeemedCount(v int) *RedeemCodeUpsert {
+	u.Add(redeemcode.FieldRedeemedCount, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -774,6 +945,97 @@ func (u *RedeemCodeUpsertOne) UpdateValidityDays() *RedeemCodeUpsertOne {
 	})
 }
 
+// SetCreditValidityDays sets the "credit_validity_days" field.
+func (u *RedeemCodeUpsertOne) SetCreditValidityDays(v int) *RedeemCodeUpsertOne {
+	return u.Update(func(s *RedeemCodeUpsert) {
+		s.SetCreditValidityDays(v)
+	})
+}
+
+// AddCreditValidityDays adds v to the "credit_validity_days" field.
+func (u *RedeemCodeUpsertOne) AddCreditValidityDays(v int) *RedeemCodeUpsertOne {
+	return u.Update(func(s *RedeemCodeUpsert) {
+		s.AddCreditValidityDays(v)
+	})
+}
+
+// UpdateCreditValidityDays sets the "credit_validity_days" field to the value that was provided on create.
+func (u *RedeemCodeUpsertOne) UpdateCreditValidityDays() *RedeemCodeUpsertOne {
+	return u.Update(func(s *RedeemCodeUpsert) {
+		s.UpdateCreditValidityDays()
+	})
+}
+
+// SetBatchID sets the "batch_id" field.
+func (u *RedeemCodeUpsertOne) SetBatchID(v int64) *RedeemCodeUpsertOne {
+	return u.Update(func(s *RedeemCodeUpsert) {
+		s.SetBatchID(v)
+	})
+}
+
+// AddBatchID adds v to the "batch_id" field.
+func (u *RedeemCodeUpsertOne) AddBatchID(v int64) *RedeemCodeUpsertOne {
+	return u.Update(func(s *RedeemCodeUpsert) {
+		s.AddBatchID(v)
+	})
+}
+
+// UpdateBatchID sets the "batch_id" field to the value that was provided on create.
+func (u *RedeemCodeUpsertOne) UpdateBatchID() *RedeemCodeUpsertOne {
+	return u.Update(func(s *RedeemCodeUpsert) {
+		s.UpdateBatchID()
+	})
+}
+
+// ClearBatchID clears the value of the "batch_id" field.
+func (u *RedeemCodeUpsertOne) ClearBatchID() *RedeemCodeUpsertOne {
+	return u.Update(func(s *RedeemCodeUpsert) {
+		s.ClearBatchID()
+	})
+}
+
+// SetMaxRedemptions sets the "max_redemptions" field.
+func (u *RedeemCodeUpsertOne) SetMaxRedemptions(v int) *RedeemCodeUpsertOne {
+	return u.Update(func(s *RedeemCodeUpsert) {
+		s.SetMaxRedemptions(v)
+	})
+}
+
+// AddMaxRedemptions adds v to the "max_redemptions" field.
+func (u *RedeemCodeUpsertOne) AddMaxRedemptions(v int) *RedeemCodeUpsertOne {
+	return u.Update(func(s *RedeemCodeUpsert) {
+		s.AddMaxRedemptions(v)
+	})
+}
+
+// UpdateMaxRedemptions sets the "max_redemptions" field to the value that was provided on create.
+func (u *RedeemCodeUpsertOne) UpdateMaxRedemptions() *RedeemCodeUpsertOne {
+	return u.Update(func(s *RedeemCodeUpsert) {
+		s.UpdateMaxRedemptions()
+	})
+}
+
+// SetRedeemedCount sets the "redeemed_count" field.
+func (u *RedeemCodeUpsertOne) SetRedeemedCount(v int) *RedeemCodeUpsertOne {
+	return u.Update(func(s *RedeemCodeUpsert) {
+		s.SetRedeemedCount(v)
+	})
+}
+
+// AddRedeemedCount adds v to the "redeemed_count" field.
+func (u *RedeemCodeUpsertOne) AddRedeemedCount(v int) *RedeemCodeUpsertOne {
+	return u.Update(func(s *RedeemCodeUpsert) {
+		s.AddRedeemedCount(v)
+	})
+}
+
+// UpdateRedeemedCount sets the "redeemed_count" field to the value that was provided on create.
+func (u *RedeemCodeUpsertOne) UpdateRedeemedCount() *RedeemCodeUpsertOne {
+	return u.Update(func(s *RedeemCodeUpsert) {
+		s.UpdateRedeemedCount()
+	})
+}
+
 // Exec executes the query.
 func (u *RedeemCodeUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1153,6 +1415,97 @@ func (u *RedeemCodeUpsertBulk) UpdateValidityDays() *RedeemCodeUpsertBulk {
 	})
 }
 
+// SetCreditValidityDays sets the "credit_validity_days" field.
+func (u *RedeemCodeUpsertBulk) SetCreditValidityDays(v int) *RedeemCodeUpsertBulk {
+	return u.Update(func(s *RedeemCodeUpsert) {
+		s.SetCreditValidityDays(v)
+	})
+}
+
+// AddCreditValidityDays adds v to the "credit_validity_days" field.
+func (u *RedeemCodeUpsertBulk) AddCreditValidityDays(v int) *RedeemCodeUpsertBulk {
+	return u.Update(func(s *RedeemCodeUpsert) {
+		s.AddCreditValidityDays(v)
+	})
+}
+
+// UpdateCreditValidityDays sets the "credit_validity_days" field to the value that was provided on create.
+func (u *RedeemCodeUpsertBulk) UpdateCreditValidityDays() *RedeemCodeUpsertBulk {
+	return u.Update(func(s *RedeemCodeUpsert) {
+		s.UpdateCreditValidityDays()
+	})
+}
+
+// SetBatchID sets the "batch_id" field.
+func (u *RedeemCodeUpsertBulk) SetBatchID(v int64) *RedeemCodeUpsertBulk {
+	return u.Update(func(s *RedeemCodeUpsert) {
+		s.SetBatchID(v)
+	})
+}
+
+// AddBatchID adds v to the "batch_id" field.
+func (u *RedeemCodeUpsertBulk) AddBatchID(v int64) *RedeemCodeUpsertBulk {
+	return u.Update(func(s *RedeemCodeUpsert) {
+		s.AddBatchID(v)
+	})
+}
+
+// UpdateBatchID sets the "batch_id" field to the value that was provided on create.
+func (u *RedeemCodeUpsertBulk) UpdateBatchID() *RedeemCodeUpsertBulk {
+	return u.Update(func(s *RedeemCodeUpsert) {
+		s.UpdateBatchID()
+	})
+}
+
+// ClearBatchID clears the value of the "batch_id" field.
+func (u *RedeemCodeUpsertBulk) ClearBatchID() *RedeemCodeUpsertBulk {
+	return u.Update(func(s *RedeemCodeUpsert) {
+		s.ClearBatchID()
+	})
+}
+
+// SetMaxRedemptions sets the "max_redemptions" field.
+func (u *RedeemCodeUpsertBulk) SetMaxRedemptions(v int) *RedeemCodeUpsertBulk {
+	return u.Update(func(s *RedeemCodeUpsert) {
+		s.SetMaxRedemptions(v)
+	})
+}
+
+// AddMaxRedemptions adds v to the "max_redemptions" field.
+func (u *RedeemCodeUpsertBulk) AddMaxRedemptions(v int) *RedeemCodeUpsertBulk {
+	return u.Update(func(s *RedeemCodeUpsert) {
+		s.AddMaxRedemptions(v)
+	})
+}
+
+// UpdateMaxRedemptions sets the "max_redemptions" field to the value that was provided on create.
+func (u *RedeemCodeUpsertBulk) UpdateMaxRedemptions() *RedeemCodeUpsertBulk {
+	return u.Update(func(s *RedeemCodeUpsert) {
+		s.UpdateMaxRedemptions()
+	})
+}
+
+// SetRedeemedCount sets the "redeemed_count" field.
+func (u *RedeemCodeUpsertBulk) SetRedeemedCount(v int) *RedeemCodeUpsertBulk {
+	return u.Update(func(s *RedeemCodeUpsert) {
+		s.SetRedeemedCount(v)
+	})
+}
+
+// AddRedeemedCount adds v to the "redeemed_count" field.
+func (u *RedeemCodeUpsertBulk) AddRedeemedCount(v int) *RedeemCodeUpsertBulk {
+	return u.Update(func(s *RedeemCodeUpsert) {
+		s.AddRedeemedCount(v)
+	})
+}
+
+// UpdateRedeemedCount sets the "redeemed_count" field to the value that was provided on create.
+func (u *RedeemCodeUpsertBulk) UpdateRedeemedCount() *RedeemCodeUpsertBulk {
+	return u.Update(func(s *RedeemCodeUpsert) {
+		s.UpdateRedeemedCount()
+	})
+}
+
 // Exec executes the query.
 func (u *RedeemCodeUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
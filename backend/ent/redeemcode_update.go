@@ -194,6 +194,96 @@ func (_u *RedeemCodeUpdate) AddValidityDays(v int) *RedeemCodeUpdate {
 	return _u
 }
 
+// SetCreditValidityDays sets the "credit_validity_days" field.
+func (_u *RedeemCodeUpdate) SetCreditValidityDays(v int) *RedeemCodeUpdate {
+	_u.mutation.ResetCreditValidityDays()
+	_u.mutation.SetCreditValidityDays(v)
+	return _u
+}
+
+// SetNillableCreditValidityDays sets the "credit_validity_days" field if the given value is not nil.
+func (_u *RedeemCodeUpdate) SetNillableCreditValidityDays(v *int) *RedeemCodeUpdate {
+	if v != nil {
+		_u.SetCreditValidityDays(*v)
+	}
+	return _u
+}
+
+// AddCreditValidityDays adds value to the "credit_validity_days" field.
+func (_u *RedeemCodeUpdate) AddCreditValidityDays(v int) *RedeemCodeUpdate {
+	_u.mutation.AddCreditValidityDays(v)
+	return _u
+}
+
+// SetBatchID sets the "batch_id" field.
+func (_u *RedeemCodeUpdate) SetBatchID(v int64) *RedeemCodeUpdate {
+	_u.mutation.ResetBatchID()
+	_u.mutation.SetBatchID(v)
+	return _u
+}
+
+// SetNillableBatchID sets the "batch_id" field if the given value is not nil.
+func (_u *RedeemCodeUpdate) SetNillableBatchID(v *int64) *RedeemCodeUpdate {
+	if v != nil {
+		_u.SetBatchID(*v)
+	}
+	return _u
+}
+
+// AddBatchID adds value to the "batch_id" field.
+func (_u *RedeemCodeUpdate) AddBatchID(v int64) *RedeemCodeUpdate {
+	_u.mutation.AddBatchID(v)
+	return _u
+}
+
+// ClearBatchID clears the value of the "batch_id" field.
+func (_u *RedeemCodeUpdate) ClearBatchID() *RedeemCodeUpdate {
+	_u.mutation.ClearBatchID()
+	return _u
+}
+
+// SetMaxRedemptions sets the "max_redemptions" field.
+func (_u *RedeemCodeUpdate) SetMaxRedemptions(v int) *RedeemCodeUpdate {
+	_u.mutation.ResetMaxRedemptions()
+	_u.mutation.SetMaxRedemptions(v)
+	return _u
+}
+
+// SetNillableMaxRedemptions sets the "max_redemptions" field if the given value is not nil.
+func (_u *RedeemCodeUpdate) SetNillableMaxRedemptions(v *int) *RedeemCodeUpdate {
+	if v != nil {
+		_u.SetMaxRedemptions(*v)
+	}
+	return _u
+}
+
+// AddMaxRedemptions adds value to the "max_redemptions" field.
+func (_u *RedeemCodeUpdate) AddMaxRedemptions(v int) *RedeemCodeUpdate {
+	_u.mutation.AddMaxRedemptions(v)
+	return _u
+}
+
+// SetRedeemedCount sets the "redeemed_count" field.
+func (_u *RedeemCodeUpdate) SetRedeemedCount(v int) *RedeemCodeUpdate {
+	_u.mutation.ResetRedeemedCount()
+	_u.mutation.SetRedeemedCount(v)
+	return _u
+}
+
+// SetNillableRedeemedCount sets the "redeemed_count" field if the given value is not nil.
+func (_u *RedeemCodeUpdate) SetNillableRedeemedCount(v *int) *RedeemCodeUpdate {
+	if v != nil {
+		_u.SetRedeemedCount(*v)
+	}
+	return _u
+}
+
+// AddRedeemedCount adds value to the "redeemed_count" field.
+func (_u *RedeemCodeUpdate) AddRedeemedCount(v int) *RedeemCodeUpdate {
+	_u.mutation.AddRedeemedCount(v)
+	return _u
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_u *RedeemCodeUpdate) SetUserID(id int64) *RedeemCodeUpdate {
 	_u.mutation.SetUserID(id)
@@ -327,6 +417,33 @@ func (_u *RedeemCodeUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.AddedValidityDays(); ok {
 		_spec.AddField(redeemcode.FieldValidityDays, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.CreditValidityDays(); ok {
+		_spec.SetField(redeemcode.FieldCreditValidityDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreditValidityDays(); ok {
+		_spec.AddField(redeemcode.FieldCreditValidityDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BatchID(); ok {
+		_spec.SetField(redeemcode.FieldBatchID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedBatchID(); ok {
+		_spec.AddField(redeemcode.FieldBatchID, field.TypeInt64, value)
+	}
+	if _u.mutation.BatchIDCleared() {
+		_spec.ClearField(redeemcode.FieldBatchID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.MaxRedemptions(); ok {
+		_spec.SetField(redeemcode.FieldMaxRedemptions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxRedemptions(); ok {
+		_spec.AddField(redeemcode.FieldMaxRedemptions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RedeemedCount(); ok {
+		_spec.SetField(redeemcode.FieldRedeemedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRedeemedCount(); ok {
+		_spec.AddField(redeemcode.FieldRedeemedCount, field.TypeInt, value)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -569,6 +686,96 @@ func (_u *RedeemCodeUpdateOne) AddValidityDays(v int) *RedeemCodeUpdateOne {
 	return _u
 }
 
+// SetCreditValidityDays sets the "credit_validity_days" field.
+func (_u *RedeemCodeUpdateOne) SetCreditValidityDays(v int) *RedeemCodeUpdateOne {
+	_u.mutation.ResetCreditValidityDays()
+	_u.mutation.SetCreditValidityDays(v)
+	return _u
+}
+
+// SetNillableCreditValidityDays sets the "credit_validity_days" field if the given value is not nil.
+func (_u *RedeemCodeUpdateOne) SetNillableCreditValidityDays(v *int) *RedeemCodeUpdateOne {
+	if v != nil {
+		_u.SetCreditValidityDays(*v)
+	}
+	return _u
+}
+
+// AddCreditValidityDays adds value to the "credit_validity_days" field.
+func (_u *RedeemCodeUpdateOne) AddCreditValidityDays(v int) *RedeemCodeUpdateOne {
+	_u.mutation.AddCreditValidityDays(v)
+	return _u
+}
+
+// SetBatchID sets the "batch_id" field.
+func (_u *RedeemCodeUpdateOne) SetBatchID(v int64) *RedeemCodeUpdateOne {
+	_u.mutation.ResetBatchID()
+	_u.mutation.SetBatchID(v)
+	return _u
+}
+
+// SetNillableBatchID sets the "batch_id" field if the given value is not nil.
+func (_u *RedeemCodeUpdateOne) SetNillableBatchID(v *int64) *RedeemCodeUpdateOne {
+	if v != nil {
+		_u.SetBatchID(*v)
+	}
+	return _u
+}
+
+// AddBatchID adds value to the "batch_id" field.
+func (_u *RedeemCodeUpdateOne) AddBatchID(v int64) *RedeemCodeUpdateOne {
+	_u.mutation.AddBatchID(v)
+	return _u
+}
+
+// ClearBatchID clears the value of the "batch_id" field.
+func (_u *RedeemCodeUpdateOne) ClearBatchID() *RedeemCodeUpdateOne {
+	_u.mutation.ClearBatchID()
+	return _u
+}
+
+// SetMaxRedemptions sets the "max_redemptions" field.
+func (_u *RedeemCodeUpdateOne) SetMaxRedemptions(v int) *RedeemCodeUpdateOne {
+	_u.mutation.ResetMaxRedemptions()
+	_u.mutation.SetMaxRedemptions(v)
+	return _u
+}
+
+// SetNillableMaxRedemptions sets the "max_redemptions" field if the given value is not nil.
+func (_u *RedeemCodeUpdateOne) SetNillableMaxRedemptions(v *int) *RedeemCodeUpdateOne {
+	if v != nil {
+		_u.SetMaxRedemptions(*v)
+	}
+	return _u
+}
+
+// AddMaxRedemptions adds value to the "max_redemptions" field.
+func (_u *RedeemCodeUpdateOne) AddMaxRedemptions(v int) *RedeemCodeUpdateOne {
+	_u.mutation.AddMaxRedemptions(v)
+	return _u
+}
+
+// SetRedeemedCount sets the "redeemed_count" field.
+func (_u *RedeemCodeUpdateOne) SetRedeemedCount(v int) *RedeemCodeUpdateOne {
+	_u.mutation.ResetRedeemedCount()
+	_u.mutation.SetRedeemedCount(v)
+	return _u
+}
+
+// SetNillableRedeemedCount sets the "redeemed_count" field if the given value is not nil.
+func (_u *RedeemCodeUpdateOne) SetNillableRedeemedCount(v *int) *RedeemCodeUpdateOne {
+	if v != nil {
+		_u.SetRedeemedCount(*v)
+	}
+	return _u
+}
+
+// AddRedeemedCount adds value to the "redeemed_count" field.
+func (_u *RedeemCodeUpdateOne) AddRedeemedCount(v int) *RedeemCodeUpdateOne {
+	_u.mutation.AddRedeemedCount(v)
+	return _u
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_u *RedeemCodeUpdateOne) SetUserID(id int64) *RedeemCodeUpdateOne {
 	_u.mutation.SetUserID(id)
@@ -732,6 +939,33 @@ func (_u *RedeemCodeUpdateOne) sqlSave(ctx context.Context) (_node *RedeemCode,
 	if value, ok := _u.mutation.AddedValidityDays(); ok {
 		_spec.AddField(redeemcode.FieldValidityDays, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.CreditValidityDays(); ok {
+		_spec.SetField(redeemcode.FieldCreditValidityDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreditValidityDays(); ok {
+		_spec.AddField(redeemcode.FieldCreditValidityDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BatchID(); ok {
+		_spec.SetField(redeemcode.FieldBatchID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedBatchID(); ok {
+		_spec.AddField(redeemcode.FieldBatchID, field.TypeInt64, value)
+	}
+	if _u.mutation.BatchIDCleared() {
+		_spec.ClearField(redeemcode.FieldBatchID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.MaxRedemptions(); ok {
+		_spec.SetField(redeemcode.FieldMaxRedemptions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxRedemptions(); ok {
+		_spec.AddField(redeemcode.FieldMaxRedemptions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RedeemedCount(); ok {
+		_spec.SetField(redeemcode.FieldRedeemedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRedeemedCount(); ok {
+		_spec.AddField(redeemcode.FieldRedeemedCount, field.TypeInt, value)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -200,14 +200,24 @@ func init() {
 	accountDescSessionWindowStatus := accountFields[21].Descriptor()
 	// account.SessionWindowStatusValidator is a validator for the "session_window_status" field. It is called by the builders before save.
 	account.SessionWindowStatusValidator = accountDescSessionWindowStatus.Validators[0].(func(string) error)
+	// accountDescAvailabilityTimezone is the schema descriptor for availability_timezone field.
+	accountDescAvailabilityTimezone := accountFields[23].Descriptor()
+	// account.AvailabilityTimezoneValidator is a validator for the "availability_timezone" field. It is called by the builders before save.
+	account.AvailabilityTimezoneValidator = accountDescAvailabilityTimezone.Validators[0].(func(string) error)
 	accountgroupFields := schema.AccountGroup{}.Fields()
 	_ = accountgroupFields
 	// accountgroupDescPriority is the schema descriptor for priority field.
 	accountgroupDescPriority := accountgroupFields[2].Descriptor()
 	// accountgroup.DefaultPriority holds the default value on creation for the priority field.
 	accountgroup.DefaultPriority = accountgroupDescPriority.Default.(int)
+	// accountgroupDescPool is the schema descriptor for pool field.
+	accountgroupDescPool := accountgroupFields[3].Descriptor()
+	// accountgroup.DefaultPool holds the default value on creation for the pool field.
+	accountgroup.DefaultPool = accountgroupDescPool.Default.(string)
+	// accountgroup.PoolValidator is a validator for the "pool" field. It is called by the builders before save.
+	accountgroup.PoolValidator = accountgroupDescPool.Validators[0].(func(string) error)
 	// accountgroupDescCreatedAt is the schema descriptor for created_at field.
-	accountgroupDescCreatedAt := accountgroupFields[3].Descriptor()
+	accountgroupDescCreatedAt := accountgroupFields[4].Descriptor()
 	// accountgroup.DefaultCreatedAt holds the default value on creation for the created_at field.
 	accountgroup.DefaultCreatedAt = accountgroupDescCreatedAt.Default.(func() time.Time)
 	groupMixin := schema.Group{}.Mixin()
@@ -285,6 +295,22 @@ func init() {
 	groupDescModelRoutingEnabled := groupFields[17].Descriptor()
 	// group.DefaultModelRoutingEnabled holds the default value on creation for the model_routing_enabled field.
 	group.DefaultModelRoutingEnabled = groupDescModelRoutingEnabled.Default.(bool)
+	// groupDescPoolSplitEnabled is the schema descriptor for pool_split_enabled field.
+	groupDescPoolSplitEnabled := groupFields[18].Descriptor()
+	// group.DefaultPoolSplitEnabled holds the default value on creation for the pool_split_enabled field.
+	group.DefaultPoolSplitEnabled = groupDescPoolSplitEnabled.Default.(bool)
+	// groupDescOverageEnabled is the schema descriptor for overage_enabled field.
+	groupDescOverageEnabled := groupFields[24].Descriptor()
+	// group.DefaultOverageEnabled holds the default value on creation for the overage_enabled field.
+	group.DefaultOverageEnabled = groupDescOverageEnabled.Default.(bool)
+	// groupDescOverageRateMultiplier is the schema descriptor for overage_rate_multiplier field.
+	groupDescOverageRateMultiplier := groupFields[25].Descriptor()
+	// group.DefaultOverageRateMultiplier holds the default value on creation for the overage_rate_multiplier field.
+	group.DefaultOverageRateMultiplier = groupDescOverageRateMultiplier.Default.(float64)
+	// groupDescRollingWindowHours is the schema descriptor for rolling_window_hours field.
+	groupDescRollingWindowHours := groupFields[26].Descriptor()
+	// group.DefaultRollingWindowHours holds the default value on creation for the rolling_window_hours field.
+	group.DefaultRollingWindowHours = groupDescRollingWindowHours.Default.(int)
 	promocodeFields := schema.PromoCode{}.Fields()
 	_ = promocodeFields
 	// promocodeDescCode is the schema descriptor for code field.
@@ -309,34 +335,72 @@ func init() {
 	promocodeDescBonusAmount := promocodeFields[1].Descriptor()
 	// promocode.DefaultBonusAmount holds the default value on creation for the bonus_amount field.
 	promocode.DefaultBonusAmount = promocodeDescBonusAmount.Default.(float64)
+	// promocodeDescCreditValidityDays is the schema descriptor for credit_validity_days field.
+	promocodeDescCreditValidityDays := promocodeFields[2].Descriptor()
+	// promocode.DefaultCreditValidityDays holds the default value on creation for the credit_validity_days field.
+	promocode.DefaultCreditValidityDays = promocodeDescCreditValidityDays.Default.(int)
 	// promocodeDescMaxUses is the schema descriptor for max_uses field.
-	promocodeDescMaxUses := promocodeFields[2].Descriptor()
+	promocodeDescMaxUses := promocodeFields[3].Descriptor()
 	// promocode.DefaultMaxUses holds the default value on creation for the max_uses field.
 	promocode.DefaultMaxUses = promocodeDescMaxUses.Default.(int)
 	// promocodeDescUsedCount is the schema descriptor for used_count field.
-	promocodeDescUsedCount := promocodeFields[3].Descriptor()
+	promocodeDescUsedCount := promocodeFields[4].Descriptor()
 	// promocode.DefaultUsedCount holds the default value on creation for the used_count field.
 	promocode.DefaultUsedCount = promocodeDescUsedCount.Default.(int)
 	// promocodeDescStatus is the schema descriptor for status field.
-	promocodeDescStatus := promocodeFields[4].Descriptor()
+	promocodeDescStatus := promocodeFields[5].Descriptor()
 	// promocode.DefaultStatus holds the default value on creation for the status field.
 	promocode.DefaultStatus = promocodeDescStatus.Default.(string)
 	// promocode.StatusValidator is a validator for the "status" field. It is called by the builders before save.
 	promocode.StatusValidator = promocodeDescStatus.Validators[0].(func(string) error)
+	// promocodeDescScope is the schema descriptor for scope field.
+	promocodeDescScope := promocodeFields[8].Descriptor()
+	// promocode.DefaultScope holds the default value on creation for the scope field.
+	promocode.DefaultScope = promocodeDescScope.Default.(string)
+	// promocode.ScopeValidator is a validator for the "scope" field. It is called by the builders before save.
+	promocode.ScopeValidator = promocodeDescScope.Validators[0].(func(string) error)
+	// promocodeDescBonusPercent is the schema descriptor for bonus_percent field.
+	promocodeDescBonusPercent := promocodeFields[9].Descriptor()
+	// promocode.DefaultBonusPercent holds the default value on creation for the bonus_percent field.
+	promocode.DefaultBonusPercent = promocodeDescBonusPercent.Default.(float64)
+	// promocodeDescTrialDays is the schema descriptor for trial_days field.
+	promocodeDescTrialDays := promocodeFields[12].Descriptor()
+	// promocode.DefaultTrialDays holds the default value on creation for the trial_days field.
+	promocode.DefaultTrialDays = promocodeDescTrialDays.Default.(int)
+	// promocodeDescNewUserDays is the schema descriptor for new_user_days field.
+	promocodeDescNewUserDays := promocodeFields[13].Descriptor()
+	// promocode.DefaultNewUserDays holds the default value on creation for the new_user_days field.
+	promocode.DefaultNewUserDays = promocodeDescNewUserDays.Default.(int)
+	// promocodeDescStackable is the schema descriptor for stackable field.
+	promocodeDescStackable := promocodeFields[15].Descriptor()
+	// promocode.DefaultStackable holds the default value on creation for the stackable field.
+	promocode.DefaultStackable = promocodeDescStackable.Default.(bool)
+	// promocodeDescMaxUsesPerUser is the schema descriptor for max_uses_per_user field.
+	promocodeDescMaxUsesPerUser := promocodeFields[16].Descriptor()
+	// promocode.DefaultMaxUsesPerUser holds the default value on creation for the max_uses_per_user field.
+	promocode.DefaultMaxUsesPerUser = promocodeDescMaxUsesPerUser.Default.(int)
 	// promocodeDescCreatedAt is the schema descriptor for created_at field.
-	promocodeDescCreatedAt := promocodeFields[7].Descriptor()
+	promocodeDescCreatedAt := promocodeFields[17].Descriptor()
 	// promocode.DefaultCreatedAt holds the default value on creation for the created_at field.
 	promocode.DefaultCreatedAt = promocodeDescCreatedAt.Default.(func() time.Time)
 	// promocodeDescUpdatedAt is the schema descriptor for updated_at field.
-	promocodeDescUpdatedAt := promocodeFields[8].Descriptor()
+	promocodeDescUpdatedAt := promocodeFields[18].Descriptor()
 	// promocode.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	promocode.DefaultUpdatedAt = promocodeDescUpdatedAt.Default.(func() time.Time)
 	// promocode.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	promocode.UpdateDefaultUpdatedAt = promocodeDescUpdatedAt.UpdateDefault.(func() time.Time)
 	promocodeusageFields := schema.PromoCodeUsage{}.Fields()
 	_ = promocodeusageFields
+	// promocodeusageDescTopupAmount is the schema descriptor for topup_amount field.
+	promocodeusageDescTopupAmount := promocodeusageFields[3].Descriptor()
+	// promocodeusage.DefaultTopupAmount holds the default value on creation for the topup_amount field.
+	promocodeusage.DefaultTopupAmount = promocodeusageDescTopupAmount.Default.(float64)
+	// promocodeusageDescTrialDays is the schema descriptor for trial_days field.
+	promocodeusageDescTrialDays := promocodeusageFields[6].Descriptor()
+	// promocodeusage.DefaultTrialDays holds the default value on creation for the trial_days field.
+	promocodeusage.DefaultTrialDays = promocodeusageDescTrialDays.Default.(int)
 	// promocodeusageDescUsedAt is the schema descriptor for used_at field.
-	promocodeusageDescUsedAt := promocodeusageFields[3].Descriptor()
+	promocodeusageDescUsedAt := promocodeusageFields[7].Descriptor()
 	// promocodeusage.DefaultUsedAt holds the default value on creation for the used_at field.
 	promocodeusage.DefaultUsedAt = promocodeusageDescUsedAt.Default.(func() time.Time)
 	proxyMixin := schema.Proxy{}.Mixin()
@@ -470,6 +534,18 @@ func init() {
 	redeemcodeDescValidityDays := redeemcodeFields[9].Descriptor()
 	// redeemcode.DefaultValidityDays holds the default value on creation for the validity_days field.
 	redeemcode.DefaultValidityDays = redeemcodeDescValidityDays.Default.(int)
+	// redeemcodeDescCreditValidityDays is the schema descriptor for credit_validity_days field.
+	redeemcodeDescCreditValidityDays := redeemcodeFields[10].Descriptor()
+	// redeemcode.DefaultCreditValidityDays holds the default value on creation for the credit_validity_days field.
+	redeemcode.DefaultCreditValidityDays = redeemcodeDescCreditValidityDays.Default.(int)
+	// redeemcodeDescMaxRedemptions is the schema descriptor for max_redemptions field.
+	redeemcodeDescMaxRedemptions := redeemcodeFields[12].Descriptor()
+	// redeemcode.DefaultMaxRedemptions holds the default value on creation for the max_redemptions field.
+	redeemcode.DefaultMaxRedemptions = redeemcodeDescMaxRedemptions.Default.(int)
+	// redeemcodeDescRedeemedCount is the schema descriptor for redeemed_count field.
+	redeemcodeDescRedeemedCount := redeemcodeFields[13].Descriptor()
+	// redeemcode.DefaultRedeemedCount holds the default value on creation for the redeemed_count field.
+	redeemcode.DefaultRedeemedCount = redeemcodeDescRedeemedCount.Default.(int)
 	settingFields := schema.Setting{}.Fields()
 	_ = settingFields
 	// settingDescKey is the schema descriptor for key field.
@@ -571,84 +647,92 @@ func init() {
 			return nil
 		}
 	}()
+	// usagelogDescFallbackHop is the schema descriptor for fallback_hop field.
+	usagelogDescFallbackHop := usagelogFields[8].Descriptor()
+	// usagelog.DefaultFallbackHop holds the default value on creation for the fallback_hop field.
+	usagelog.DefaultFallbackHop = usagelogDescFallbackHop.Default.(int)
 	// usagelogDescInputTokens is the schema descriptor for input_tokens field.
-	usagelogDescInputTokens := usagelogFields[7].Descriptor()
+	usagelogDescInputTokens := usagelogFields[11].Descriptor()
 	// usagelog.DefaultInputTokens holds the default value on creation for the input_tokens field.
 	usagelog.DefaultInputTokens = usagelogDescInputTokens.Default.(int)
 	// usagelogDescOutputTokens is the schema descriptor for output_tokens field.
-	usagelogDescOutputTokens := usagelogFields[8].Descriptor()
+	usagelogDescOutputTokens := usagelogFields[12].Descriptor()
 	// usagelog.DefaultOutputTokens holds the default value on creation for the output_tokens field.
 	usagelog.DefaultOutputTokens = usagelogDescOutputTokens.Default.(int)
 	// usagelogDescCacheCreationTokens is the schema descriptor for cache_creation_tokens field.
-	usagelogDescCacheCreationTokens := usagelogFields[9].Descriptor()
+	usagelogDescCacheCreationTokens := usagelogFields[13].Descriptor()
 	// usagelog.DefaultCacheCreationTokens holds the default value on creation for the cache_creation_tokens field.
 	usagelog.DefaultCacheCreationTokens = usagelogDescCacheCreationTokens.Default.(int)
 	// usagelogDescCacheReadTokens is the schema descriptor for cache_read_tokens field.
-	usagelogDescCacheReadTokens := usagelogFields[10].Descriptor()
+	usagelogDescCacheReadTokens := usagelogFields[14].Descriptor()
 	// usagelog.DefaultCacheReadTokens holds the default value on creation for the cache_read_tokens field.
 	usagelog.DefaultCacheReadTokens = usagelogDescCacheReadTokens.Default.(int)
 	// usagelogDescCacheCreation5mTokens is the schema descriptor for cache_creation_5m_tokens field.
-	usagelogDescCacheCreation5mTokens := usagelogFields[11].Descriptor()
+	usagelogDescCacheCreation5mTokens := usagelogFields[15].Descriptor()
 	// usagelog.DefaultCacheCreation5mTokens holds the default value on creation for the cache_creation_5m_tokens field.
 	usagelog.DefaultCacheCreation5mTokens = usagelogDescCacheCreation5mTokens.Default.(int)
 	// usagelogDescCacheCreation1hTokens is the schema descriptor for cache_creation_1h_tokens field.
-	usagelogDescCacheCreation1hTokens := usagelogFields[12].Descriptor()
+	usagelogDescCacheCreation1hTokens := usagelogFields[16].Descriptor()
 	// usagelog.DefaultCacheCreation1hTokens holds the default value on creation for the cache_creation_1h_tokens field.
 	usagelog.DefaultCacheCreation1hTokens = usagelogDescCacheCreation1hTokens.Default.(int)
 	// usagelogDescInputCost is the schema descriptor for input_cost field.
-	usagelogDescInputCost := usagelogFields[13].Descriptor()
+	usagelogDescInputCost := usagelogFields[17].Descriptor()
 	// usagelog.DefaultInputCost holds the default value on creation for the input_cost field.
 	usagelog.DefaultInputCost = usagelogDescInputCost.Default.(float64)
 	// usagelogDescOutputCost is the schema descriptor for output_cost field.
-	usagelogDescOutputCost := usagelogFields[14].Descriptor()
+	usagelogDescOutputCost := usagelogFields[18].Descriptor()
 	// usagelog.DefaultOutputCost holds the default value on creation for the output_cost field.
 	usagelog.DefaultOutputCost = usagelogDescOutputCost.Default.(float64)
 	// usagelogDescCacheCreationCost is the schema descriptor for cache_creation_cost field.
-	usagelogDescCacheCreationCost := usagelogFields[15].Descriptor()
+	usagelogDescCacheCreationCost := usagelogFields[19].Descriptor()
 	// usagelog.DefaultCacheCreationCost holds the default value on creation for the cache_creation_cost field.
 	usagelog.DefaultCacheCreationCost = usagelogDescCacheCreationCost.Default.(float64)
 	// usagelogDescCacheReadCost is the schema descriptor for cache_read_cost field.
-	usagelogDescCacheReadCost := usagelogFields[16].Descriptor()
+	usagelogDescCacheReadCost := usagelogFields[20].Descriptor()
 	// usagelog.DefaultCacheReadCost holds the default value on creation for the cache_read_cost field.
 	usagelog.DefaultCacheReadCost = usagelogDescCacheReadCost.Default.(float64)
 	// usagelogDescTotalCost is the schema descriptor for total_cost field.
-	usagelogDescTotalCost := usagelogFields[17].Descriptor()
+	usagelogDescTotalCost := usagelogFields[21].Descriptor()
 	// usagelog.DefaultTotalCost holds the default value on creation for the total_cost field.
 	usagelog.DefaultTotalCost = usagelogDescTotalCost.Default.(float64)
 	// usagelogDescActualCost is the schema descriptor for actual_cost field.
-	usagelogDescActualCost := usagelogFields[18].Descriptor()
+	usagelogDescActualCost := usagelogFields[22].Descriptor()
 	// usagelog.DefaultActualCost holds the default value on creation for the actual_cost field.
 	usagelog.DefaultActualCost = usagelogDescActualCost.Default.(float64)
 	// usagelogDescRateMultiplier is the schema descriptor for rate_multiplier field.
-	usagelogDescRateMultiplier := usagelogFields[19].Descriptor()
+	usagelogDescRateMultiplier := usagelogFields[23].Descriptor()
 	// usagelog.DefaultRateMultiplier holds the default value on creation for the rate_multiplier field.
 	usagelog.DefaultRateMultiplier = usagelogDescRateMultiplier.Default.(float64)
+	// usagelogDescPricingVersion is the schema descriptor for pricing_version field.
+	usagelogDescPricingVersion := usagelogFields[24].Descriptor()
+	// usagelog.PricingVersionValidator is a validator for the "pricing_version" field. It is called by the builders before save.
+	usagelog.PricingVersionValidator = usagelogDescPricingVersion.Validators[0].(func(string) error)
 	// usagelogDescBillingType is the schema descriptor for billing_type field.
-	usagelogDescBillingType := usagelogFields[21].Descriptor()
+	usagelogDescBillingType := usagelogFields[35].Descriptor()
 	// usagelog.DefaultBillingType holds the default value on creation for the billing_type field.
 	usagelog.DefaultBillingType = usagelogDescBillingType.Default.(int8)
 	// usagelogDescStream is the schema descriptor for stream field.
-	usagelogDescStream := usagelogFields[22].Descriptor()
+	usagelogDescStream := usagelogFields[36].Descriptor()
 	// usagelog.DefaultStream holds the default value on creation for the stream field.
 	usagelog.DefaultStream = usagelogDescStream.Default.(bool)
 	// usagelogDescUserAgent is the schema descriptor for user_agent field.
-	usagelogDescUserAgent := usagelogFields[25].Descriptor()
+	usagelogDescUserAgent := usagelogFields[39].Descriptor()
 	// usagelog.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
 	usagelog.UserAgentValidator = usagelogDescUserAgent.Validators[0].(func(string) error)
 	// usagelogDescIPAddress is the schema descriptor for ip_address field.
-	usagelogDescIPAddress := usagelogFields[26].Descriptor()
+	usagelogDescIPAddress := usagelogFields[40].Descriptor()
 	// usagelog.IPAddressValidator is a validator for the "ip_address" field. It is called by the builders before save.
 	usagelog.IPAddressValidator = usagelogDescIPAddress.Validators[0].(func(string) error)
 	// usagelogDescImageCount is the schema descriptor for image_count field.
-	usagelogDescImageCount := usagelogFields[27].Descriptor()
+	usagelogDescImageCount := usagelogFields[41].Descriptor()
 	// usagelog.DefaultImageCount holds the default value on creation for the image_count field.
 	usagelog.DefaultImageCount = usagelogDescImageCount.Default.(int)
 	// usagelogDescImageSize is the schema descriptor for image_size field.
-	usagelogDescImageSize := usagelogFields[28].Descriptor()
+	usagelogDescImageSize := usagelogFields[42].Descriptor()
 	// usagelog.ImageSizeValidator is a validator for the "image_size" field. It is called by the builders before save.
 	usagelog.ImageSizeValidator = usagelogDescImageSize.Validators[0].(func(string) error)
 	// usagelogDescCreatedAt is the schema descriptor for created_at field.
-	usagelogDescCreatedAt := usagelogFields[29].Descriptor()
+	usagelogDescCreatedAt := usagelogFields[43].Descriptor()
 	// usagelog.DefaultCreatedAt holds the default value on creation for the created_at field.
 	usagelog.DefaultCreatedAt = usagelogDescCreatedAt.Default.(func() time.Time)
 	userMixin := schema.User{}.Mixin()
@@ -905,6 +989,10 @@ func init() {
 	usersubscriptionDescAssignedAt := usersubscriptionFields[12].Descriptor()
 	// usersubscription.DefaultAssignedAt holds the default value on creation for the assigned_at field.
 	usersubscription.DefaultAssignedAt = usersubscriptionDescAssignedAt.Default.(func() time.Time)
+	// usersubscriptionDescAutoRenew is the schema descriptor for auto_renew field.
+	usersubscriptionDescAutoRenew := usersubscriptionFields[15].Descriptor()
+	// usersubscription.DefaultAutoRenew holds the default value on creation for the auto_renew field.
+	usersubscription.DefaultAutoRenew = usersubscriptionDescAutoRenew.Default.(bool)
 }
 
 const (
@@ -178,6 +178,18 @@ func (Account) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			MaxLen(20),
+
+		// availability_*: 账户可用时间窗口（migrations/047_add_account_availability_windows.sql）
+		// 窗口外账户不参与调度，例如 "mon-fri 19:00-08:00"、"sat,sun"
+		field.JSON("availability_windows", []string{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}).
+			Comment("Recurring availability windows; empty means always available."),
+		field.String("availability_timezone").
+			Optional().
+			Nillable().
+			MaxLen(64).
+			Comment("IANA timezone of availability windows; NULL means server timezone."),
 	}
 }
 
@@ -32,6 +32,10 @@ func (AccountGroup) Fields() []ent.Field {
 		field.Int64("group_id"),
 		field.Int("priority").
 			Default(50),
+		// 分组内子池标签（用于灰度分流），默认池为 "default"
+		field.String("pool").
+			MaxLen(50).
+			Default("default"),
 		field.Time("created_at").
 			Immutable().
 			Default(time.Now).
@@ -56,5 +60,6 @@ func (AccountGroup) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("group_id"),
 		index.Fields("priority"),
+		index.Fields("group_id", "pool"),
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"github.com/Wei-Shaw/sub2api/internal/service"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
@@ -52,6 +53,32 @@ func (APIKey) Fields() []ent.Field {
 		field.JSON("ip_blacklist", []string{}).
 			Optional().
 			Comment("Blocked IPs/CIDRs"),
+
+		// 消费/请求次数限额 (added by migration 055)，NULL 表示不限制
+		field.Float("daily_limit_usd").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+		field.Float("monthly_limit_usd").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+		field.Float("total_limit_usd").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}).
+			Comment("累计消费限额"),
+		field.Int64("daily_request_limit").
+			Optional().
+			Nillable(),
+		field.Int64("monthly_request_limit").
+			Optional().
+			Nillable(),
+
+		// 绑定的组织 (added by migration 062)：非空时由组织计费账户付费
+		field.Int64("organization_id").
+			Optional().
+			Nillable(),
 	}
 }
 
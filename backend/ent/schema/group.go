@@ -2,6 +2,8 @@ package schema
 
 import (
 	"github.com/Wei-Shaw/sub2api/ent/schema/mixins"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/usagequota"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/volumetier"
 	"github.com/Wei-Shaw/sub2api/internal/service"
 
 	"entgo.io/ent"
@@ -106,6 +108,61 @@ func (Group) Fields() []ent.Field {
 		field.Bool("model_routing_enabled").
 			Default(false).
 			Comment("是否启用模型路由配置"),
+
+		// 子池灰度分流配置 (added by migration 045)
+		field.Bool("pool_split_enabled").
+			Default(false).
+			Comment("是否启用分组内子池流量分配"),
+		field.JSON("pool_weights", map[string]int{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}).
+			Comment("子池流量权重：池标签 -> 百分比"),
+		field.Float("canary_max_error_rate").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(5,4)"}).
+			Comment("灰度池错误率阈值（0-1），超过后自动回滚其流量"),
+
+		// 容量降级链 (added by migration 046)
+		field.JSON("capacity_fallback_group_ids", []int64{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}).
+			Comment("无可用账号或切换次数耗尽时按顺序尝试的降级分组ID列表"),
+		field.JSON("fallback_model_mapping", map[string]string{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}).
+			Comment("作为降级分组承接请求时的模型映射：请求模型 -> 本分组模型"),
+
+		// 阶梯折扣 (added by migration 054)
+		field.JSON("volume_tiers", []volumetier.Tier{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}).
+			Comment("按用户近 30 天消费分档的折扣倍率，叠加在分组倍率之上"),
+
+		// 订阅超额计费 (added by migration 058)
+		field.Bool("overage_enabled").
+			Default(false).
+			Comment("订阅限额用尽后是否按超额倍率从余额扣费"),
+		field.Float("overage_rate_multiplier").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(10,4)"}).
+			Default(1.0).
+			Comment("超额部分的计费倍率（作用于原始费用）"),
+
+		// 滚动窗口限额 (added by migration 059)
+		field.Int("rolling_window_hours").
+			Default(0).
+			Comment("滚动窗口时长（小时），0 表示不启用"),
+		field.Float("rolling_window_limit_usd").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}).
+			Comment("滚动窗口内的消费上限（USD，按原始费用计）"),
+
+		// 请求数/Token 配额 (added by migration 060)
+		field.JSON("usage_quotas", []usagequota.Quota{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}).
+			Comment("按日/周/月窗口与模型模式限制请求数和输入/输出 Token 数"),
 	}
 }
 
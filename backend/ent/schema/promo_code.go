@@ -16,7 +16,7 @@ import (
 
 // PromoCode holds the schema definition for the PromoCode entity.
 //
-// 注册优惠码：用户注册时使用，可获得赠送余额
+// 优惠码：注册时或在线充值时使用，可获得赠送余额（固定金额/充值比例）与订阅试用
 // 与 RedeemCode 不同，PromoCode 支持多次使用（有使用次数限制）
 //
 // 删除策略：硬删除
@@ -41,6 +41,9 @@ func (PromoCode) Fields() []ent.Field {
 			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}).
 			Default(0).
 			Comment("赠送余额金额"),
+		field.Int("credit_validity_days").
+			Default(0).
+			Comment("赠送余额的有效期（天），0 表示永不过期"),
 		field.Int("max_uses").
 			Default(0).
 			Comment("最大使用次数，0表示无限制"),
@@ -61,6 +64,42 @@ func (PromoCode) Fields() []ent.Field {
 			Nillable().
 			SchemaType(map[string]string{dialect.Postgres: "text"}).
 			Comment("备注"),
+
+		// 使用规则（migrations/065_add_promo_code_rules.sql）
+		field.String("scope").
+			MaxLen(20).
+			Default(service.PromoCodeScopeRegister).
+			Comment("使用场景: register, topup"),
+		field.Float("bonus_percent").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(10,4)"}).
+			Default(0).
+			Comment("充值赠送比例（%），仅 topup 场景"),
+		field.Float("max_bonus_amount").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}).
+			Comment("单次赠送余额上限，null 表示不限"),
+		field.Int64("trial_group_id").
+			Optional().
+			Nillable().
+			Comment("赠送订阅试用的分组"),
+		field.Int("trial_days").
+			Default(0).
+			Comment("订阅试用天数"),
+		field.Int("new_user_days").
+			Default(0).
+			Comment("仅限注册 N 天内的用户使用，0 表示不限"),
+		field.JSON("allowed_email_domains", []string{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}).
+			Comment("仅限这些邮箱域名的用户使用，空表示不限"),
+		field.Bool("stackable").
+			Default(false).
+			Comment("是否可与其他优惠码叠加使用"),
+		field.Int("max_uses_per_user").
+			Default(1).
+			Comment("每个用户最多使用次数，0表示无限制"),
+
 		field.Time("created_at").
 			Immutable().
 			Default(time.Now).
@@ -83,5 +122,6 @@ func (PromoCode) Indexes() []ent.Index {
 		// code 字段已在 Fields() 中声明 Unique()，无需重复索引
 		index.Fields("status"),
 		index.Fields("expires_at"),
+		index.Fields("scope"),
 	}
 }
@@ -34,6 +34,21 @@ func (PromoCodeUsage) Fields() []ent.Field {
 		field.Float("bonus_amount").
 			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}).
 			Comment("实际赠送金额"),
+		field.Float("topup_amount").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}).
+			Default(0).
+			Comment("充值到账金额（topup 场景计算比例赠送的基数）"),
+		field.Int64("payment_order_id").
+			Optional().
+			Nillable().
+			Comment("关联的充值订单"),
+		field.Int64("trial_group_id").
+			Optional().
+			Nillable().
+			Comment("赠送订阅试用的分组"),
+		field.Int("trial_days").
+			Default(0).
+			Comment("赠送订阅试用天数"),
 		field.Time("used_at").
 			Default(time.Now).
 			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}).
@@ -60,7 +75,8 @@ func (PromoCodeUsage) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("promo_code_id"),
 		index.Fields("user_id"),
-		// 每个用户每个优惠码只能使用一次
-		index.Fields("promo_code_id", "user_id").Unique(),
+		// 每个用户的使用次数由 promo_codes.max_uses_per_user 限制
+		index.Fields("promo_code_id", "user_id"),
+		index.Fields("used_at"),
 	}
 }
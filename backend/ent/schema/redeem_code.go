@@ -68,6 +68,18 @@ func (RedeemCode) Fields() []ent.Field {
 			Nillable(),
 		field.Int("validity_days").
 			Default(30),
+		field.Int("credit_validity_days").
+			Default(0).
+			Comment("余额类兑换码发放额度的有效期（天），0 表示永不过期"),
+
+		// 兑换码批次与多次使用 (added by migration 064)
+		field.Int64("batch_id").
+			Optional().
+			Nillable(),
+		field.Int("max_redemptions").
+			Default(1),
+		field.Int("redeemed_count").
+			Default(0),
 	}
 }
 
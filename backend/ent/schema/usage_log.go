@@ -47,6 +47,19 @@ func (UsageLog) Fields() []ent.Field {
 		field.Int64("subscription_id").
 			Optional().
 			Nillable(),
+		// 容量降级：original_group_id 为 API Key 所属分组，group_id 为实际承接的分组
+		field.Int64("original_group_id").
+			Optional().
+			Nillable(),
+		field.Int("fallback_hop").
+			Default(0),
+		// 组织计费 (added by migration 062)：user_id 为发起请求的成员，billing_user_id 为实际付费的组织计费账户
+		field.Int64("organization_id").
+			Optional().
+			Nillable(),
+		field.Int64("billing_user_id").
+			Optional().
+			Nillable(),
 
 		// Token 计数字段
 		field.Int("input_tokens").
@@ -85,6 +98,53 @@ func (UsageLog) Fields() []ent.Field {
 			Default(1).
 			SchemaType(map[string]string{dialect.Postgres: "decimal(10,4)"}),
 
+		// 计费价格快照：pricing_version 标识价格来源与单价哈希，
+		// 单价单位为 USD/百万 token（图片为 USD/张）；历史记录为 NULL
+		field.String("pricing_version").
+			MaxLen(64).
+			Optional().
+			Nillable(),
+		field.Float("input_unit_price").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,10)"}),
+		field.Float("output_unit_price").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,10)"}),
+		field.Float("cache_creation_unit_price").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,10)"}),
+		field.Float("cache_read_unit_price").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,10)"}),
+		field.Float("image_unit_price").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,10)"}),
+
+		// 阶梯折扣快照：命中档位的消费门槛与折扣倍率（rate_multiplier 已包含该倍率）；未命中为 NULL
+		field.Float("volume_tier_min_spend").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+		field.Float("volume_tier_multiplier").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(10,4)"}),
+
+		// 订阅超额计费：订阅承担的原始费用与按超额倍率从余额扣除的金额；未开启超额计费的分组为 NULL
+		field.Float("subscription_cost").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,10)"}),
+		field.Float("overage_cost").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,10)"}),
+
 		// account_rate_multiplier: 账号计费倍率快照（NULL 表示按 1.0 处理）
 		field.Float("account_rate_multiplier").
 			Optional().
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/Wei-Shaw/sub2api/ent/schema/mixins"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/usagequota"
 	"github.com/Wei-Shaw/sub2api/internal/service"
 
 	"entgo.io/ent"
@@ -79,6 +80,37 @@ func (UserSubscription) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			SchemaType(map[string]string{dialect.Postgres: "text"}),
+
+		// 套餐购买的订阅：记录套餐与购买时的限额快照（覆盖分组限额），支持到期前自动续费
+		field.Int64("plan_id").
+			Optional().
+			Nillable(),
+		field.Bool("auto_renew").
+			Default(false),
+		field.Float("daily_limit_usd").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+		field.Float("weekly_limit_usd").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+		field.Float("monthly_limit_usd").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+
+		// 请求数/Token 配额用量 (added by migration 060)
+		// key 为 "窗口:模型模式"，随对应 USD 窗口一起重置
+		field.JSON("quota_usage", map[string]usagequota.Counter{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+
+		// 暂停时间 (added by migration 061)：暂停期间有效期停止计时，恢复时顺延
+		field.Time("paused_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
 	}
 }
 
@@ -36,6 +36,14 @@ type UsageLog struct {
 	GroupID *int64 `json:"group_id,omitempty"`
 	// SubscriptionID holds the value of the "subscription_id" field.
 	SubscriptionID *int64 `json:"subscription_id,omitempty"`
+	// OriginalGroupID holds the value of the "original_group_id" field.
+	OriginalGroupID *int64 `json:"original_group_id,omitempty"`
+	// FallbackHop holds the value of the "fallback_hop" field.
+	FallbackHop int `json:"fallback_hop,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID *int64 `json:"organization_id,omitempty"`
+	// BillingUserID holds the value of the "billing_user_id" field.
+	BillingUserID *int64 `json:"billing_user_id,omitempty"`
 	// InputTokens holds the value of the "input_tokens" field.
 	InputTokens int `json:"input_tokens,omitempty"`
 	// OutputTokens holds the value of the "output_tokens" field.
@@ -62,6 +70,26 @@ type UsageLog struct {
 	ActualCost float64 `json:"actual_cost,omitempty"`
 	// RateMultiplier holds the value of the "rate_multiplier" field.
 	RateMultiplier float64 `json:"rate_multiplier,omitempty"`
+	// PricingVersion holds the value of the "pricing_version" field.
+	PricingVersion *string `json:"pricing_version,omitempty"`
+	// InputUnitPrice holds the value of the "input_unit_price" field.
+	InputUnitPrice *float64 `json:"input_unit_price,omitempty"`
+	// OutputUnitPrice holds the value of the "output_unit_price" field.
+	OutputUnitPrice *float64 `json:"output_unit_price,omitempty"`
+	// CacheCreationUnitPrice holds the value of the "cache_creation_unit_price" field.
+	CacheCreationUnitPrice *float64 `json:"cache_creation_unit_price,omitempty"`
+	// CacheReadUnitPrice holds the value of the "cache_read_unit_price" field.
+	CacheReadUnitPrice *float64 `json:"cache_read_unit_price,omitempty"`
+	// ImageUnitPrice holds the value of the "image_unit_price" field.
+	ImageUnitPrice *float64 `json:"image_unit_price,omitempty"`
+	// VolumeTierMinSpend holds the value of the "volume_tier_min_spend" field.
+	VolumeTierMinSpend *float64 `json:"volume_tier_min_spend,omitempty"`
+	// VolumeTierMultiplier holds the value of the "volume_tier_multiplier" field.
+	VolumeTierMultiplier *float64 `json:"volume_tier_multiplier,omitempty"`
+	// SubscriptionCost holds the value of the "subscription_cost" field.
+	SubscriptionCost *float64 `json:"subscription_cost,omitempty"`
+	// OverageCost holds the value of the "overage_cost" field.
+	OverageCost *float64 `json:"overage_cost,omitempty"`
 	// AccountRateMultiplier holds the value of the "account_rate_multiplier" field.
 	AccountRateMultiplier *float64 `json:"account_rate_multiplier,omitempty"`
 	// BillingType holds the value of the "billing_type" field.
@@ -167,11 +195,11 @@ func (*UsageLog) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case usagelog.FieldStream:
 			values[i] = new(sql.NullBool)
-		case usagelog.FieldInputCost, usagelog.FieldOutputCost, usagelog.FieldCacheCreationCost, usagelog.FieldCacheReadCost, usagelog.FieldTotalCost, usagelog.FieldActualCost, usagelog.FieldRateMultiplier, usagelog.FieldAccountRateMultiplier:
+		case usagelog.FieldInputCost, usagelog.FieldOutputCost, usagelog.FieldCacheCreationCost, usagelog.FieldCacheReadCost, usagelog.FieldTotalCost, usagelog.FieldActualCost, usagelog.FieldRateMultiplier, usagelog.FieldInputUnitPrice, usagelog.FieldOutputUnitPrice, usagelog.FieldCacheCreationUnitPrice, usagelog.FieldCacheReadUnitPrice, usagelog.FieldImageUnitPrice, usagelog.FieldVolumeTierMinSpend, usagelog.FieldVolumeTierMultiplier, usagelog.FieldSubscriptionCost, usagelog.FieldOverageCost, usagelog.FieldAccountRateMultiplier:
 			values[i] = new(sql.NullFloat64)
-		case usagelog.FieldID, usagelog.FieldUserID, usagelog.FieldAPIKeyID, usagelog.FieldAccountID, usagelog.FieldGroupID, usagelog.FieldSubscriptionID, usagelog.FieldInputTokens, usagelog.FieldOutputTokens, usagelog.FieldCacheCreationTokens, usagelog.FieldCacheReadTokens, usagelog.FieldCacheCreation5mTokens, usagelog.FieldCacheCreation1hTokens, usagelog.FieldBillingType, usagelog.FieldDurationMs, usagelog.FieldFirstTokenMs, usagelog.FieldImageCount:
+		case usagelog.FieldID, usagelog.FieldUserID, usagelog.FieldAPIKeyID, usagelog.FieldAccountID, usagelog.FieldGroupID, usagelog.FieldSubscriptionID, usagelog.FieldOriginalGroupID, usagelog.FieldFallbackHop, usagelog.FieldOrganizationID, usagelog.FieldBillingUserID, usagelog.FieldInputTokens, usagelog.FieldOutputTokens, usagelog.FieldCacheCreationTokens, usagelog.FieldCacheReadTokens, usagelog.FieldCacheCreation5mTokens, usagelog.FieldCacheCreation1hTokens, usagelog.FieldBillingType, usagelog.FieldDurationMs, usagelog.FieldFirstTokenMs, usagelog.FieldImageCount:
 			values[i] = new(sql.NullInt64)
-		case usagelog.FieldRequestID, usagelog.FieldModel, usagelog.FieldUserAgent, usagelog.FieldIPAddress, usagelog.FieldImageSize:
+		case usagelog.FieldRequestID, usagelog.FieldModel, usagelog.FieldPricingVersion, usagelog.FieldUserAgent, usagelog.FieldIPAddress, usagelog.FieldImageSize:
 			values[i] = new(sql.NullString)
 		case usagelog.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -240,6 +268,33 @@ func (_m *UsageLog) assignValues(columns []string, values []any) error {
 				_m.SubscriptionID = new(int64)
 				*_m.SubscriptionID = value.Int64
 			}
+		case usagelog.FieldOriginalGroupID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field original_group_id", values[i])
+			} else if value.Valid {
+				_m.OriginalGroupID = new(int64)
+				*_m.OriginalGroupID = value.Int64
+			}
+		case usagelog.FieldFallbackHop:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field fallback_hop", values[i])
+			} else if value.Valid {
+				_m.FallbackHop = int(value.Int64)
+			}
+		case usagelog.FieldOrganizationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value.Valid {
+				_m.OrganizationID = new(int64)
+				*_m.OrganizationID = value.Int64
+			}
+		case usagelog.FieldBillingUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field billing_user_id", values[i])
+			} else if value.Valid {
+				_m.BillingUserID = new(int64)
+				*_m.BillingUserID = value.Int64
+			}
 		case usagelog.FieldInputTokens:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field input_tokens", values[i])
@@ -318,6 +373,76 @@ func (_m *UsageLog) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.RateMultiplier = value.Float64
 			}
+		case usagelog.FieldPricingVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pricing_version", values[i])
+			} else if value.Valid {
+				_m.PricingVersion = new(string)
+				*_m.PricingVersion = value.String
+			}
+		case usagelog.FieldInputUnitPrice:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field input_unit_price", values[i])
+			} else if value.Valid {
+				_m.InputUnitPrice = new(float64)
+				*_m.InputUnitPrice = value.Float64
+			}
+		case usagelog.FieldOutputUnitPrice:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field output_unit_price", values[i])
+			} else if value.Valid {
+				_m.OutputUnitPrice = new(float64)
+				*_m.OutputUnitPrice = value.Float64
+			}
+		case usagelog.FieldCacheCreationUnitPrice:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cache_creation_unit_price", values[i])
+			} else if value.Valid {
+				_m.CacheCreationUnitPrice = new(float64)
+				*_m.CacheCreationUnitPrice = value.Float64
+			}
+		case usagelog.FieldCacheReadUnitPrice:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cache_read_unit_price", values[i])
+			} else if value.Valid {
+				_m.CacheReadUnitPrice = new(float64)
+				*_m.CacheReadUnitPrice = value.Float64
+			}
+		case usagelog.FieldImageUnitPrice:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field image_unit_price", values[i])
+			} else if value.Valid {
+				_m.ImageUnitPrice = new(float64)
+				*_m.ImageUnitPrice = value.Float64
+			}
+		case usagelog.FieldVolumeTierMinSpend:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field volume_tier_min_spend", values[i])
+			} else if value.Valid {
+				_m.VolumeTierMinSpend = new(float64)
+				*_m.VolumeTierMinSpend = value.Float64
+			}
+		case usagelog.FieldVolumeTierMultiplier:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field volume_tier_multiplier", values[i])
+			} else if value.Valid {
+				_m.VolumeTierMultiplier = new(float64)
+				*_m.VolumeTierMultiplier = value.Float64
+			}
+		case usagelog.FieldSubscriptionCost:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field subscription_cost", values[i])
+			} else if value.Valid {
+				_m.SubscriptionCost = new(float64)
+				*_m.SubscriptionCost = value.Float64
+			}
+		case usagelog.FieldOverageCost:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field overage_cost", values[i])
+			} else if value.Valid {
+				_m.OverageCost = new(float64)
+				*_m.OverageCost = value.Float64
+			}
 		case usagelog.FieldAccountRateMultiplier:
 			if value, ok := values[i].(*sql.NullFloat64); !ok {
 				return fmt.Errorf("unexpected type %T for field account_rate_multiplier", values[i])
@@ -470,6 +595,24 @@ func (_m *UsageLog) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	if v := _m.OriginalGroupID; v != nil {
+		builder.WriteString("original_group_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("fallback_hop=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FallbackHop))
+	builder.WriteString(", ")
+	if v := _m.OrganizationID; v != nil {
+		builder.WriteString("organization_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.BillingUserID; v != nil {
+		builder.WriteString("billing_user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("input_tokens=")
 	builder.WriteString(fmt.Sprintf("%v", _m.InputTokens))
 	builder.WriteString(", ")
@@ -509,6 +652,56 @@ func (_m *UsageLog) String() string {
 	builder.WriteString("rate_multiplier=")
 	builder.WriteString(fmt.Sprintf("%v", _m.RateMultiplier))
 	builder.WriteString(", ")
+	if v := _m.PricingVersion; v != nil {
+		builder.WriteString("pricing_version=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.InputUnitPrice; v != nil {
+		builder.WriteString("input_unit_price=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.OutputUnitPrice; v != nil {
+		builder.WriteString("output_unit_price=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CacheCreationUnitPrice; v != nil {
+		builder.WriteString("cache_creation_unit_price=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CacheReadUnitPrice; v != nil {
+		builder.WriteString("cache_read_unit_price=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ImageUnitPrice; v != nil {
+		builder.WriteString("image_unit_price=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.VolumeTierMinSpend; v != nil {
+		builder.WriteString("volume_tier_min_spend=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.VolumeTierMultiplier; v != nil {
+		builder.WriteString("volume_tier_multiplier=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.SubscriptionCost; v != nil {
+		builder.WriteString("subscription_cost=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.OverageCost; v != nil {
+		builder.WriteString("overage_cost=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	if v := _m.AccountRateMultiplier; v != nil {
 		builder.WriteString("account_rate_multiplier=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
@@ -28,6 +28,14 @@ const (
 	FieldGroupID = "group_id"
 	// FieldSubscriptionID holds the string denoting the subscription_id field in the database.
 	FieldSubscriptionID = "subscription_id"
+	// FieldOriginalGroupID holds the string denoting the original_group_id field in the database.
+	FieldOriginalGroupID = "original_group_id"
+	// FieldFallbackHop holds the string denoting the fallback_hop field in the database.
+	FieldFallbackHop = "fallback_hop"
+	// FieldOrganizationID holds the string denoting the organization_id field in the database.
+	FieldOrganizationID = "organization_id"
+	// FieldBillingUserID holds the string denoting the billing_user_id field in the database.
+	FieldBillingUserID = "billing_user_id"
 	// FieldInputTokens holds the string denoting the input_tokens field in the database.
 	FieldInputTokens = "input_tokens"
 	// FieldOutputTokens holds the string denoting the output_tokens field in the database.
@@ -54,6 +62,26 @@ const (
 	FieldActualCost = "actual_cost"
 	// FieldRateMultiplier holds the string denoting the rate_multiplier field in the database.
 	FieldRateMultiplier = "rate_multiplier"
+	// FieldPricingVersion holds the string denoting the pricing_version field in the database.
+	FieldPricingVersion = "pricing_version"
+	// FieldInputUnitPrice holds the string denoting the input_unit_price field in the database.
+	FieldInputUnitPrice = "input_unit_price"
+	// FieldOutputUnitPrice holds the string denoting the output_unit_price field in the database.
+	FieldOutputUnitPrice = "output_unit_price"
+	// FieldCacheCreationUnitPrice holds the string denoting the cache_creation_unit_price field in the database.
+	FieldCacheCreationUnitPrice = "cache_creation_unit_price"
+	// FieldCacheReadUnitPrice holds the string denoting the cache_read_unit_price field in the database.
+	FieldCacheReadUnitPrice = "cache_read_unit_price"
+	// FieldImageUnitPrice holds the string denoting the image_unit_price field in the database.
+	FieldImageUnitPrice = "image_unit_price"
+	// FieldVolumeTierMinSpend holds the string denoting the volume_tier_min_spend field in the database.
+	FieldVolumeTierMinSpend = "volume_tier_min_spend"
+	// FieldVolumeTierMultiplier holds the string denoting the volume_tier_multiplier field in the database.
+	FieldVolumeTierMultiplier = "volume_tier_multiplier"
+	// FieldSubscriptionCost holds the string denoting the subscription_cost field in the database.
+	FieldSubscriptionCost = "subscription_cost"
+	// FieldOverageCost holds the string denoting the overage_cost field in the database.
+	FieldOverageCost = "overage_cost"
 	// FieldAccountRateMultiplier holds the string denoting the account_rate_multiplier field in the database.
 	FieldAccountRateMultiplier = "account_rate_multiplier"
 	// FieldBillingType holds the string denoting the billing_type field in the database.
@@ -133,6 +161,10 @@ var Columns = []string{
 	FieldModel,
 	FieldGroupID,
 	FieldSubscriptionID,
+	FieldOriginalGroupID,
+	FieldFallbackHop,
+	FieldOrganizationID,
+	FieldBillingUserID,
 	FieldInputTokens,
 	FieldOutputTokens,
 	FieldCacheCreationTokens,
@@ -146,6 +178,16 @@ var Columns = []string{
 	FieldTotalCost,
 	FieldActualCost,
 	FieldRateMultiplier,
+	FieldPricingVersion,
+	FieldInputUnitPrice,
+	FieldOutputUnitPrice,
+	FieldCacheCreationUnitPrice,
+	FieldCacheReadUnitPrice,
+	FieldImageUnitPrice,
+	FieldVolumeTierMinSpend,
+	FieldVolumeTierMultiplier,
+	FieldSubscriptionCost,
+	FieldOverageCost,
 	FieldAccountRateMultiplier,
 	FieldBillingType,
 	FieldStream,
@@ -173,6 +215,8 @@ var (
 	RequestIDValidator func(string) error
 	// ModelValidator is a validator for the "model" field. It is called by the builders before save.
 	ModelValidator func(string) error
+	// DefaultFallbackHop holds the default value on creation for the "fallback_hop" field.
+	DefaultFallbackHop int
 	// DefaultInputTokens holds the default value on creation for the "input_tokens" field.
 	DefaultInputTokens int
 	// DefaultOutputTokens holds the default value on creation for the "output_tokens" field.
@@ -199,6 +243,8 @@ var (
 	DefaultActualCost float64
 	// DefaultRateMultiplier holds the default value on creation for the "rate_multiplier" field.
 	DefaultRateMultiplier float64
+	// PricingVersionValidator is a validator for the "pricing_version" field. It is called by the builders before save.
+	PricingVersionValidator func(string) error
 	// DefaultBillingType holds the default value on creation for the "billing_type" field.
 	DefaultBillingType int8
 	// DefaultStream holds the default value on creation for the "stream" field.
@@ -258,6 +304,26 @@ func BySubscriptionID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSubscriptionID, opts...).ToFunc()
 }
 
+// ByOriginalGroupID orders the results by the original_group_id field.
+func ByOriginalGroupID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOriginalGroupID, opts...).ToFunc()
+}
+
+// ByFallbackHop orders the results by the fallback_hop field.
+func ByFallbackHop(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFallbackHop, opts...).ToFunc()
+}
+
+// ByOrganizationID orders the results by the organization_id field.
+func ByOrganizationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrganizationID, opts...).ToFunc()
+}
+
+// ByBillingUserID orders the results by the billing_user_id field.
+func ByBillingUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBillingUserID, opts...).ToFunc()
+}
+
 // ByInputTokens orders the results by the input_tokens field.
 func ByInputTokens(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldInputTokens, opts...).ToFunc()
@@ -323,6 +389,56 @@ func ByRateMultiplier(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRateMultiplier, opts...).ToFunc()
 }
 
+// ByPricingVersion orders the results by the pricing_version field.
+func ByPricingVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPricingVersion, opts...).ToFunc()
+}
+
+// ByInputUnitPrice orders the results by the input_unit_price field.
+func ByInputUnitPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInputUnitPrice, opts...).ToFunc()
+}
+
+// ByOutputUnitPrice orders the results by the output_unit_price field.
+func ByOutputUnitPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutputUnitPrice, opts...).ToFunc()
+}
+
+// ByCacheCreationUnitPrice orders the results by the cache_creation_unit_price field.
+func ByCacheCreationUnitPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCacheCreationUnitPrice, opts...).ToFunc()
+}
+
+// ByCacheReadUnitPrice orders the results by the cache_read_unit_price field.
+func ByCacheReadUnitPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCacheReadUnitPrice, opts...).ToFunc()
+}
+
+// ByImageUnitPrice orders the results by the image_unit_price field.
+func ByImageUnitPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImageUnitPrice, opts...).ToFunc()
+}
+
+// ByVolumeTierMinSpend orders the results by the volume_tier_min_spend field.
+func ByVolumeTierMinSpend(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVolumeTierMinSpend, opts...).ToFunc()
+}
+
+// ByVolumeTierMultiplier orders the results by the volume_tier_multiplier field.
+func ByVolumeTierMultiplier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVolumeTierMultiplier, opts...).ToFunc()
+}
+
+// BySubscriptionCost orders the results by the subscription_cost field.
+func BySubscriptionCost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubscriptionCost, opts...).ToFunc()
+}
+
+// ByOverageCost orders the results by the overage_cost field.
+func ByOverageCost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOverageCost, opts...).ToFunc()
+}
+
 // ByAccountRateMultiplier orders the results by the account_rate_multiplier field.
 func ByAccountRateMultiplier(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAccountRateMultiplier, opts...).ToFunc()
@@ -90,6 +90,26 @@ func SubscriptionID(v int64) predicate.UsageLog {
 	return predicate.UsageLog(sql.FieldEQ(FieldSubscriptionID, v))
 }
 
+// OriginalGroupID applies equality check predicate on the "original_group_id" field. It's identical to OriginalGroupIDEQ.
+func OriginalGroupID(v int64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldOriginalGroupID, v))
+}
+
+// FallbackHop applies equality check predicate on the "fallback_hop" field. It's identical to FallbackHopEQ.
+func FallbackHop(v int) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldFallbackHop, v))
+}
+
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v int64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// BillingUserID applies equality check predicate on the "billing_user_id" field. It's identical to BillingUserIDEQ.
+func BillingUserID(v int64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldBillingUserID, v))
+}
+
 // InputTokens applies equality check predicate on the "input_tokens" field. It's identical to InputTokensEQ.
 func InputTokens(v int) predicate.UsageLog {
 	return predicate.UsageLog(sql.FieldEQ(FieldInputTokens, v))
@@ -155,6 +175,56 @@ func RateMultiplier(v float64) predicate.UsageLog {
 	return predicate.UsageLog(sql.FieldEQ(FieldRateMultiplier, v))
 }
 
+// PricingVersion applies equality check predicate on the "pricing_version" field. It's identical to PricingVersionEQ.
+func PricingVersion(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldPricingVersion, v))
+}
+
+// InputUnitPrice applies equality check predicate on the "input_unit_price" field. It's identical to InputUnitPriceEQ.
+func InputUnitPrice(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldInputUnitPrice, v))
+}
+
+// OutputUnitPrice applies equality check predicate on the "output_unit_price" field. It's identical to OutputUnitPriceEQ.
+func OutputUnitPrice(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldOutputUnitPrice, v))
+}
+
+// CacheCreationUnitPrice applies equality check predicate on the "cache_creation_unit_price" field. It's identical to CacheCreationUnitPriceEQ.
+func CacheCreationUnitPrice(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldCacheCreationUnitPrice, v))
+}
+
+// CacheReadUnitPrice applies equality check predicate on the "cache_read_unit_price" field. It's identical to CacheReadUnitPriceEQ.
+func CacheReadUnitPrice(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldCacheReadUnitPrice, v))
+}
+
+// ImageUnitPrice applies equality check predicate on the "image_unit_price" field. It's identical to ImageUnitPriceEQ.
+func ImageUnitPrice(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldImageUnitPrice, v))
+}
+
+// VolumeTierMinSpend applies equality check predicate on the "volume_tier_min_spend" field. It's identical to VolumeTierMinSpendEQ.
+func VolumeTierMinSpend(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldVolumeTierMinSpend, v))
+}
+
+// VolumeTierMultiplier applies equality check predicate on the "volume_tier_multiplier" field. It's identical to VolumeTierMultiplierEQ.
+func VolumeTierMultiplier(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldVolumeTierMultiplier, v))
+}
+
+// SubscriptionCost applies equality check predicate on the "subscription_cost" field. It's identical to SubscriptionCostEQ.
+func SubscriptionCost(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldSubscriptionCost, v))
+}
+
+// OverageCost applies equality check predicate on the "overage_cost" field. It's identical to OverageCostEQ.
+func OverageCost(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldOverageCost, v))
+}
+
 // AccountRateMultiplier applies equality check predicate on the "account_rate_multiplier" field. It's identical to AccountRateMultiplierEQ.
 func AccountRateMultiplier(v float64) predicate.UsageLog {
 	return predicate.UsageLog(sql.FieldEQ(FieldAccountRateMultiplier, v))
@@ -455,6 +525,196 @@ func SubscriptionIDNotNil() predicate.UsageLog {
 	return predicate.UsageLog(sql.FieldNotNull(FieldSubscriptionID))
 }
 
+// OriginalGroupIDEQ applies the EQ predicate on the "original_group_id" field.
+func OriginalGroupIDEQ(v int64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldOriginalGroupID, v))
+}
+
+// OriginalGroupIDNEQ applies the NEQ predicate on the "original_group_id" field.
+func OriginalGroupIDNEQ(v int64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNEQ(FieldOriginalGroupID, v))
+}
+
+// OriginalGroupIDIn applies the In predicate on the "original_group_id" field.
+func OriginalGroupIDIn(vs ...int64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIn(FieldOriginalGroupID, vs...))
+}
+
+// OriginalGroupIDNotIn applies the NotIn predicate on the "original_group_id" field.
+func OriginalGroupIDNotIn(vs ...int64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotIn(FieldOriginalGroupID, vs...))
+}
+
+// OriginalGroupIDGT applies the GT predicate on the "original_group_id" field.
+func OriginalGroupIDGT(v int64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGT(FieldOriginalGroupID, v))
+}
+
+// OriginalGroupIDGTE applies the GTE predicate on the "original_group_id" field.
+func OriginalGroupIDGTE(v int64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGTE(FieldOriginalGroupID, v))
+}
+
+// OriginalGroupIDLT applies the LT predicate on the "original_group_id" field.
+func OriginalGroupIDLT(v int64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLT(FieldOriginalGroupID, v))
+}
+
+// OriginalGroupIDLTE applies the LTE predicate on the "original_group_id" field.
+func OriginalGroupIDLTE(v int64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLTE(FieldOriginalGroupID, v))
+}
+
+// OriginalGroupIDIsNil applies the IsNil predicate on the "original_group_id" field.
+func OriginalGroupIDIsNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIsNull(FieldOriginalGroupID))
+}
+
+// OriginalGroupIDNotNil applies the NotNil predicate on the "original_group_id" field.
+func OriginalGroupIDNotNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotNull(FieldOriginalGroupID))
+}
+
+// FallbackHopEQ applies the EQ predicate on the "fallback_hop" field.
+func FallbackHopEQ(v int) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldFallbackHop, v))
+}
+
+// FallbackHopNEQ applies the NEQ predicate on the "fallback_hop" field.
+func FallbackHopNEQ(v int) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNEQ(FieldFallbackHop, v))
+}
+
+// FallbackHopIn applies the In predicate on the "fallback_hop" field.
+func FallbackHopIn(vs ...int) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIn(FieldFallbackHop, vs...))
+}
+
+// FallbackHopNotIn applies the NotIn predicate on the "fallback_hop" field.
+func FallbackHopNotIn(vs ...int) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotIn(FieldFallbackHop, vs...))
+}
+
+// FallbackHopGT applies the GT predicate on the "fallback_hop" field.
+func FallbackHopGT(v int) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGT(FieldFallbackHop, v))
+}
+
+// FallbackHopGTE applies the GTE predicate on the "fallback_hop" field.
+func FallbackHopGTE(v int) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGTE(FieldFallbackHop, v))
+}
+
+// FallbackHopLT applies the LT predicate on the "fallback_hop" field.
+func FallbackHopLT(v int) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLT(FieldFallbackHop, v))
+}
+
+// FallbackHopLTE applies the LTE predicate on the "fallback_hop" field.
+func FallbackHopLTE(v int) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLTE(FieldFallbackHop, v))
+}
+
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v int64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v int64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...int64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...int64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDGT applies the GT predicate on the "organization_id" field.
+func OrganizationIDGT(v int64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGT(FieldOrganizationID, v))
+}
+
+// OrganizationIDGTE applies the GTE predicate on the "organization_id" field.
+func OrganizationIDGTE(v int64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGTE(FieldOrganizationID, v))
+}
+
+// OrganizationIDLT applies the LT predicate on the "organization_id" field.
+func OrganizationIDLT(v int64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLT(FieldOrganizationID, v))
+}
+
+// OrganizationIDLTE applies the LTE predicate on the "organization_id" field.
+func OrganizationIDLTE(v int64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLTE(FieldOrganizationID, v))
+}
+
+// OrganizationIDIsNil applies the IsNil predicate on the "organization_id" field.
+func OrganizationIDIsNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIsNull(FieldOrganizationID))
+}
+
+// OrganizationIDNotNil applies the NotNil predicate on the "organization_id" field.
+func OrganizationIDNotNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotNull(FieldOrganizationID))
+}
+
+// BillingUserIDEQ applies the EQ predicate on the "billing_user_id" field.
+func BillingUserIDEQ(v int64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldBillingUserID, v))
+}
+
+// BillingUserIDNEQ applies the NEQ predicate on the "billing_user_id" field.
+func BillingUserIDNEQ(v int64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNEQ(FieldBillingUserID, v))
+}
+
+// BillingUserIDIn applies the In predicate on the "billing_user_id" field.
+func BillingUserIDIn(vs ...int64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIn(FieldBillingUserID, vs...))
+}
+
+// BillingUserIDNotIn applies the NotIn predicate on the "billing_user_id" field.
+func BillingUserIDNotIn(vs ...int64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotIn(FieldBillingUserID, vs...))
+}
+
+// BillingUserIDGT applies the GT predicate on the "billing_user_id" field.
+func BillingUserIDGT(v int64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGT(FieldBillingUserID, v))
+}
+
+// BillingUserIDGTE applies the GTE predicate on the "billing_user_id" field.
+func BillingUserIDGTE(v int64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGTE(FieldBillingUserID, v))
+}
+
+// BillingUserIDLT applies the LT predicate on the "billing_user_id" field.
+func BillingUserIDLT(v int64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLT(FieldBillingUserID, v))
+}
+
+// BillingUserIDLTE applies the LTE predicate on the "billing_user_id" field.
+func BillingUserIDLTE(v int64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLTE(FieldBillingUserID, v))
+}
+
+// BillingUserIDIsNil applies the IsNil predicate on the "billing_user_id" field.
+func BillingUserIDIsNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIsNull(FieldBillingUserID))
+}
+
+// BillingUserIDNotNil applies the NotNil predicate on the "billing_user_id" field.
+func BillingUserIDNotNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotNull(FieldBillingUserID))
+}
+
 // InputTokensEQ applies the EQ predicate on the "input_tokens" field.
 func InputTokensEQ(v int) predicate.UsageLog {
 	return predicate.UsageLog(sql.FieldEQ(FieldInputTokens, v))
@@ -975,6 +1235,531 @@ func RateMultiplierLTE(v float64) predicate.UsageLog {
 	return predicate.UsageLog(sql.FieldLTE(FieldRateMultiplier, v))
 }
 
+// PricingVersionEQ applies the EQ predicate on the "pricing_version" field.
+func PricingVersionEQ(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldPricingVersion, v))
+}
+
+// PricingVersionNEQ applies the NEQ predicate on the "pricing_version" field.
+func PricingVersionNEQ(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNEQ(FieldPricingVersion, v))
+}
+
+// PricingVersionIn applies the In predicate on the "pricing_version" field.
+func PricingVersionIn(vs ...string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIn(FieldPricingVersion, vs...))
+}
+
+// PricingVersionNotIn applies the NotIn predicate on the "pricing_version" field.
+func PricingVersionNotIn(vs ...string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotIn(FieldPricingVersion, vs...))
+}
+
+// PricingVersionGT applies the GT predicate on the "pricing_version" field.
+func PricingVersionGT(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGT(FieldPricingVersion, v))
+}
+
+// PricingVersionGTE applies the GTE predicate on the "pricing_version" field.
+func PricingVersionGTE(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGTE(FieldPricingVersion, v))
+}
+
+// PricingVersionLT applies the LT predicate on the "pricing_version" field.
+func PricingVersionLT(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLT(FieldPricingVersion, v))
+}
+
+// PricingVersionLTE applies the LTE predicate on the "pricing_version" field.
+func PricingVersionLTE(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLTE(FieldPricingVersion, v))
+}
+
+// PricingVersionContains applies the Contains predicate on the "pricing_version" field.
+func PricingVersionContains(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldContains(FieldPricingVersion, v))
+}
+
+// PricingVersionHasPrefix applies the HasPrefix predicate on the "pricing_version" field.
+func PricingVersionHasPrefix(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldHasPrefix(FieldPricingVersion, v))
+}
+
+// PricingVersionHasSuffix applies the HasSuffix predicate on the "pricing_version" field.
+func PricingVersionHasSuffix(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldHasSuffix(FieldPricingVersion, v))
+}
+
+// PricingVersionIsNil applies the IsNil predicate on the "pricing_version" field.
+func PricingVersionIsNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIsNull(FieldPricingVersion))
+}
+
+// PricingVersionNotNil applies the NotNil predicate on the "pricing_version" field.
+func PricingVersionNotNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotNull(FieldPricingVersion))
+}
+
+// PricingVersionEqualFold applies the EqualFold predicate on the "pricing_version" field.
+func PricingVersionEqualFold(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEqualFold(FieldPricingVersion, v))
+}
+
+// PricingVersionContainsFold applies the ContainsFold predicate on the "pricing_version" field.
+func PricingVersionContainsFold(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldContainsFold(FieldPricingVersion, v))
+}
+
+// InputUnitPriceEQ applies the EQ predicate on the "input_unit_price" field.
+func InputUnitPriceEQ(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldInputUnitPrice, v))
+}
+
+// InputUnitPriceNEQ applies the NEQ predicate on the "input_unit_price" field.
+func InputUnitPriceNEQ(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNEQ(FieldInputUnitPrice, v))
+}
+
+// InputUnitPriceIn applies the In predicate on the "input_unit_price" field.
+func InputUnitPriceIn(vs ...float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIn(FieldInputUnitPrice, vs...))
+}
+
+// InputUnitPriceNotIn applies the NotIn predicate on the "input_unit_price" field.
+func InputUnitPriceNotIn(vs ...float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotIn(FieldInputUnitPrice, vs...))
+}
+
+// InputUnitPriceGT applies the GT predicate on the "input_unit_price" field.
+func InputUnitPriceGT(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGT(FieldInputUnitPrice, v))
+}
+
+// InputUnitPriceGTE applies the GTE predicate on the "input_unit_price" field.
+func InputUnitPriceGTE(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGTE(FieldInputUnitPrice, v))
+}
+
+// InputUnitPriceLT applies the LT predicate on the "input_unit_price" field.
+func InputUnitPriceLT(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLT(FieldInputUnitPrice, v))
+}
+
+// InputUnitPriceLTE applies the LTE predicate on the "input_unit_price" field.
+func InputUnitPriceLTE(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLTE(FieldInputUnitPrice, v))
+}
+
+// InputUnitPriceIsNil applies the IsNil predicate on the "input_unit_price" field.
+func InputUnitPriceIsNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIsNull(FieldInputUnitPrice))
+}
+
+// InputUnitPriceNotNil applies the NotNil predicate on the "input_unit_price" field.
+func InputUnitPriceNotNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotNull(FieldInputUnitPrice))
+}
+
+// OutputUnitPriceEQ applies the EQ predicate on the "output_unit_price" field.
+func OutputUnitPriceEQ(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldOutputUnitPrice, v))
+}
+
+// OutputUnitPriceNEQ applies the NEQ predicate on the "output_unit_price" field.
+func OutputUnitPriceNEQ(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNEQ(FieldOutputUnitPrice, v))
+}
+
+// OutputUnitPriceIn applies the In predicate on the "output_unit_price" field.
+func OutputUnitPriceIn(vs ...float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIn(FieldOutputUnitPrice, vs...))
+}
+
+// OutputUnitPriceNotIn applies the NotIn predicate on the "output_unit_price" field.
+func OutputUnitPriceNotIn(vs ...float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotIn(FieldOutputUnitPrice, vs...))
+}
+
+// OutputUnitPriceGT applies the GT predicate on the "output_unit_price" field.
+func OutputUnitPriceGT(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGT(FieldOutputUnitPrice, v))
+}
+
+// OutputUnitPriceGTE applies the GTE predicate on the "output_unit_price" field.
+func OutputUnitPriceGTE(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGTE(FieldOutputUnitPrice, v))
+}
+
+// OutputUnitPriceLT applies the LT predicate on the "output_unit_price" field.
+func OutputUnitPriceLT(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLT(FieldOutputUnitPrice, v))
+}
+
+// OutputUnitPriceLTE applies the LTE predicate on the "output_unit_price" field.
+func OutputUnitPriceLTE(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLTE(FieldOutputUnitPrice, v))
+}
+
+// OutputUnitPriceIsNil applies the IsNil predicate on the "output_unit_price" field.
+func OutputUnitPriceIsNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIsNull(FieldOutputUnitPrice))
+}
+
+// OutputUnitPriceNotNil applies the NotNil predicate on the "output_unit_price" field.
+func OutputUnitPriceNotNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotNull(FieldOutputUnitPrice))
+}
+
+// CacheCreationUnitPriceEQ applies the EQ predicate on the "cache_creation_unit_price" field.
+func CacheCreationUnitPriceEQ(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldCacheCreationUnitPrice, v))
+}
+
+// CacheCreationUnitPriceNEQ applies the NEQ predicate on the "cache_creation_unit_price" field.
+func CacheCreationUnitPriceNEQ(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNEQ(FieldCacheCreationUnitPrice, v))
+}
+
+// CacheCreationUnitPriceIn applies the In predicate on the "cache_creation_unit_price" field.
+func CacheCreationUnitPriceIn(vs ...float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIn(FieldCacheCreationUnitPrice, vs...))
+}
+
+// CacheCreationUnitPriceNotIn applies the NotIn predicate on the "cache_creation_unit_price" field.
+func CacheCreationUnitPriceNotIn(vs ...float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotIn(FieldCacheCreationUnitPrice, vs...))
+}
+
+// CacheCreationUnitPriceGT applies the GT predicate on the "cache_creation_unit_price" field.
+func CacheCreationUnitPriceGT(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGT(FieldCacheCreationUnitPrice, v))
+}
+
+// CacheCreationUnitPriceGTE applies the GTE predicate on the "cache_creation_unit_price" field.
+func CacheCreationUnitPriceGTE(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGTE(FieldCacheCreationUnitPrice, v))
+}
+
+// CacheCreationUnitPriceLT applies the LT predicate on the "cache_creation_unit_price" field.
+func CacheCreationUnitPriceLT(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLT(FieldCacheCreationUnitPrice, v))
+}
+
+// CacheCreationUnitPriceLTE applies the LTE predicate on the "cache_creation_unit_price" field.
+func CacheCreationUnitPriceLTE(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLTE(FieldCacheCreationUnitPrice, v))
+}
+
+// CacheCreationUnitPriceIsNil applies the IsNil predicate on the "cache_creation_unit_price" field.
+func CacheCreationUnitPriceIsNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIsNull(FieldCacheCreationUnitPrice))
+}
+
+// CacheCreationUnitPriceNotNil applies the NotNil predicate on the "cache_creation_unit_price" field.
+func CacheCreationUnitPriceNotNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotNull(FieldCacheCreationUnitPrice))
+}
+
+// CacheReadUnitPriceEQ applies the EQ predicate on the "cache_read_unit_price" field.
+func CacheReadUnitPriceEQ(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldCacheReadUnitPrice, v))
+}
+
+// CacheReadUnitPriceNEQ applies the NEQ predicate on the "cache_read_unit_price" field.
+func CacheReadUnitPriceNEQ(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNEQ(FieldCacheReadUnitPrice, v))
+}
+
+// CacheReadUnitPriceIn applies the In predicate on the "cache_read_unit_price" field.
+func CacheReadUnitPriceIn(vs ...float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIn(FieldCacheReadUnitPrice, vs...))
+}
+
+// CacheReadUnitPriceNotIn applies the NotIn predicate on the "cache_read_unit_price" field.
+func CacheReadUnitPriceNotIn(vs ...float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotIn(FieldCacheReadUnitPrice, vs...))
+}
+
+// CacheReadUnitPriceGT applies the GT predicate on the "cache_read_unit_price" field.
+func CacheReadUnitPriceGT(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGT(FieldCacheReadUnitPrice, v))
+}
+
+// CacheReadUnitPriceGTE applies the GTE predicate on the "cache_read_unit_price" field.
+func CacheReadUnitPriceGTE(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGTE(FieldCacheReadUnitPrice, v))
+}
+
+// CacheReadUnitPriceLT applies the LT predicate on the "cache_read_unit_price" field.
+func CacheReadUnitPriceLT(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLT(FieldCacheReadUnitPrice, v))
+}
+
+// CacheReadUnitPriceLTE applies the LTE predicate on the "cache_read_unit_price" field.
+func CacheReadUnitPriceLTE(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLTE(FieldCacheReadUnitPrice, v))
+}
+
+// CacheReadUnitPriceIsNil applies the IsNil predicate on the "cache_read_unit_price" field.
+func CacheReadUnitPriceIsNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIsNull(FieldCacheReadUnitPrice))
+}
+
+// CacheReadUnitPriceNotNil applies the NotNil predicate on the "cache_read_unit_price" field.
+func CacheReadUnitPriceNotNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotNull(FieldCacheReadUnitPrice))
+}
+
+// ImageUnitPriceEQ applies the EQ predicate on the "image_unit_price" field.
+func ImageUnitPriceEQ(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldImageUnitPrice, v))
+}
+
+// ImageUnitPriceNEQ applies the NEQ predicate on the "image_unit_price" field.
+func ImageUnitPriceNEQ(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNEQ(FieldImageUnitPrice, v))
+}
+
+// ImageUnitPriceIn applies the In predicate on the "image_unit_price" field.
+func ImageUnitPriceIn(vs ...float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIn(FieldImageUnitPrice, vs...))
+}
+
+// ImageUnitPriceNotIn applies the NotIn predicate on the "image_unit_price" field.
+func ImageUnitPriceNotIn(vs ...float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotIn(FieldImageUnitPrice, vs...))
+}
+
+// ImageUnitPriceGT applies the GT predicate on the "image_unit_price" field.
+func ImageUnitPriceGT(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGT(FieldImageUnitPrice, v))
+}
+
+// ImageUnitPriceGTE applies the GTE predicate on the "image_unit_price" field.
+func ImageUnitPriceGTE(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGTE(FieldImageUnitPrice, v))
+}
+
+// ImageUnitPriceLT applies the LT predicate on the "image_unit_price" field.
+func ImageUnitPriceLT(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLT(FieldImageUnitPrice, v))
+}
+
+// ImageUnitPriceLTE applies the LTE predicate on the "image_unit_price" field.
+func ImageUnitPriceLTE(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLTE(FieldImageUnitPrice, v))
+}
+
+// ImageUnitPriceIsNil applies the IsNil predicate on the "image_unit_price" field.
+func ImageUnitPriceIsNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIsNull(FieldImageUnitPrice))
+}
+
+// ImageUnitPriceNotNil applies the NotNil predicate on the "image_unit_price" field.
+func ImageUnitPriceNotNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotNull(FieldImageUnitPrice))
+}
+
+// VolumeTierMinSpendEQ applies the EQ predicate on the "volume_tier_min_spend" field.
+func VolumeTierMinSpendEQ(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldVolumeTierMinSpend, v))
+}
+
+// VolumeTierMinSpendNEQ applies the NEQ predicate on the "volume_tier_min_spend" field.
+func VolumeTierMinSpendNEQ(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNEQ(FieldVolumeTierMinSpend, v))
+}
+
+// VolumeTierMinSpendIn applies the In predicate on the "volume_tier_min_spend" field.
+func VolumeTierMinSpendIn(vs ...float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIn(FieldVolumeTierMinSpend, vs...))
+}
+
+// VolumeTierMinSpendNotIn applies the NotIn predicate on the "volume_tier_min_spend" field.
+func VolumeTierMinSpendNotIn(vs ...float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotIn(FieldVolumeTierMinSpend, vs...))
+}
+
+// VolumeTierMinSpendGT applies the GT predicate on the "volume_tier_min_spend" field.
+func VolumeTierMinSpendGT(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGT(FieldVolumeTierMinSpend, v))
+}
+
+// VolumeTierMinSpendGTE applies the GTE predicate on the "volume_tier_min_spend" field.
+func VolumeTierMinSpendGTE(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGTE(FieldVolumeTierMinSpend, v))
+}
+
+// VolumeTierMinSpendLT applies the LT predicate on the "volume_tier_min_spend" field.
+func VolumeTierMinSpendLT(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLT(FieldVolumeTierMinSpend, v))
+}
+
+// VolumeTierMinSpendLTE applies the LTE predicate on the "volume_tier_min_spend" field.
+func VolumeTierMinSpendLTE(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLTE(FieldVolumeTierMinSpend, v))
+}
+
+// VolumeTierMinSpendIsNil applies the IsNil predicate on the "volume_tier_min_spend" field.
+func VolumeTierMinSpendIsNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIsNull(FieldVolumeTierMinSpend))
+}
+
+// VolumeTierMinSpendNotNil applies the NotNil predicate on the "volume_tier_min_spend" field.
+func VolumeTierMinSpendNotNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotNull(FieldVolumeTierMinSpend))
+}
+
+// VolumeTierMultiplierEQ applies the EQ predicate on the "volume_tier_multiplier" field.
+func VolumeTierMultiplierEQ(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldVolumeTierMultiplier, v))
+}
+
+// VolumeTierMultiplierNEQ applies the NEQ predicate on the "volume_tier_multiplier" field.
+func VolumeTierMultiplierNEQ(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNEQ(FieldVolumeTierMultiplier, v))
+}
+
+// VolumeTierMultiplierIn applies the In predicate on the "volume_tier_multiplier" field.
+func VolumeTierMultiplierIn(vs ...float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIn(FieldVolumeTierMultiplier, vs...))
+}
+
+// VolumeTierMultiplierNotIn applies the NotIn predicate on the "volume_tier_multiplier" field.
+func VolumeTierMultiplierNotIn(vs ...float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotIn(FieldVolumeTierMultiplier, vs...))
+}
+
+// VolumeTierMultiplierGT applies the GT predicate on the "volume_tier_multiplier" field.
+func VolumeTierMultiplierGT(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGT(FieldVolumeTierMultiplier, v))
+}
+
+// VolumeTierMultiplierGTE applies the GTE predicate on the "volume_tier_multiplier" field.
+func VolumeTierMultiplierGTE(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGTE(FieldVolumeTierMultiplier, v))
+}
+
+// VolumeTierMultiplierLT applies the LT predicate on the "volume_tier_multiplier" field.
+func VolumeTierMultiplierLT(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLT(FieldVolumeTierMultiplier, v))
+}
+
+// VolumeTierMultiplierLTE applies the LTE predicate on the "volume_tier_multiplier" field.
+func VolumeTierMultiplierLTE(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLTE(FieldVolumeTierMultiplier, v))
+}
+
+// VolumeTierMultiplierIsNil applies the IsNil predicate on the "volume_tier_multiplier" field.
+func VolumeTierMultiplierIsNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIsNull(FieldVolumeTierMultiplier))
+}
+
+// VolumeTierMultiplierNotNil applies the NotNil predicate on the "volume_tier_multiplier" field.
+func VolumeTierMultiplierNotNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotNull(FieldVolumeTierMultiplier))
+}
+
+// SubscriptionCostEQ applies the EQ predicate on the "subscription_cost" field.
+func SubscriptionCostEQ(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldSubscriptionCost, v))
+}
+
+// SubscriptionCostNEQ applies the NEQ predicate on the "subscription_cost" field.
+func SubscriptionCostNEQ(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNEQ(FieldSubscriptionCost, v))
+}
+
+// SubscriptionCostIn applies the In predicate on the "subscription_cost" field.
+func SubscriptionCostIn(vs ...float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIn(FieldSubscriptionCost, vs...))
+}
+
+// SubscriptionCostNotIn applies the NotIn predicate on the "subscription_cost" field.
+func SubscriptionCostNotIn(vs ...float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotIn(FieldSubscriptionCost, vs...))
+}
+
+// SubscriptionCostGT applies the GT predicate on the "subscription_cost" field.
+func SubscriptionCostGT(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGT(FieldSubscriptionCost, v))
+}
+
+// SubscriptionCostGTE applies the GTE predicate on the "subscription_cost" field.
+func SubscriptionCostGTE(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGTE(FieldSubscriptionCost, v))
+}
+
+// SubscriptionCostLT applies the LT predicate on the "subscription_cost" field.
+func SubscriptionCostLT(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLT(FieldSubscriptionCost, v))
+}
+
+// SubscriptionCostLTE applies the LTE predicate on the "subscription_cost" field.
+func SubscriptionCostLTE(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLTE(FieldSubscriptionCost, v))
+}
+
+// SubscriptionCostIsNil applies the IsNil predicate on the "subscription_cost" field.
+func SubscriptionCostIsNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIsNull(FieldSubscriptionCost))
+}
+
+// SubscriptionCostNotNil applies the NotNil predicate on the "subscription_cost" field.
+func SubscriptionCostNotNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotNull(FieldSubscriptionCost))
+}
+
+// OverageCostEQ applies the EQ predicate on the "overage_cost" field.
+func OverageCostEQ(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldOverageCost, v))
+}
+
+// OverageCostNEQ applies the NEQ predicate on the "overage_cost" field.
+func OverageCostNEQ(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNEQ(FieldOverageCost, v))
+}
+
+// OverageCostIn applies the In predicate on the "overage_cost" field.
+func OverageCostIn(vs ...float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIn(FieldOverageCost, vs...))
+}
+
+// OverageCostNotIn applies the NotIn predicate on the "overage_cost" field.
+func OverageCostNotIn(vs ...float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotIn(FieldOverageCost, vs...))
+}
+
+// OverageCostGT applies the GT predicate on the "overage_cost" field.
+func OverageCostGT(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGT(FieldOverageCost, v))
+}
+
+// OverageCostGTE applies the GTE predicate on the "overage_cost" field.
+func OverageCostGTE(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGTE(FieldOverageCost, v))
+}
+
+// OverageCostLT applies the LT predicate on the "overage_cost" field.
+func OverageCostLT(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLT(FieldOverageCost, v))
+}
+
+// OverageCostLTE applies the LTE predicate on the "overage_cost" field.
+func OverageCostLTE(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLTE(FieldOverageCost, v))
+}
+
+// OverageCostIsNil applies the IsNil predicate on the "overage_cost" field.
+func OverageCostIsNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIsNull(FieldOverageCost))
+}
+
+// OverageCostNotNil applies the NotNil predicate on the "overage_cost" field.
+func OverageCostNotNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotNull(FieldOverageCost))
+}
+
 // AccountRateMultiplierEQ applies the EQ predicate on the "account_rate_multiplier" field.
 func AccountRateMultiplierEQ(v float64) predicate.UsageLog {
 	return predicate.UsageLog(sql.FieldEQ(FieldAccountRateMultiplier, v))
@@ -85,6 +85,62 @@ func (_c *UsageLogCreate) SetNillableSubscriptionID(v *int64) *UsageLogCreate {
 	return _c
 }
 
+// SetOriginalGroupID sets the "original_group_id" field.
+func (_c *UsageLogCreate) SetOriginalGroupID(v int64) *UsageLogCreate {
+	_c.mutation.SetOriginalGroupID(v)
+	return _c
+}
+
+// SetNillableOriginalGroupID sets the "original_group_id" field if the given value is not nil.
+func (_c *UsageLogCreate) SetNillableOriginalGroupID(v *int64) *UsageLogCreate {
+	if v != nil {
+		_c.SetOriginalGroupID(*v)
+	}
+	return _c
+}
+
+// SetFallbackHop sets the "fallback_hop" field.
+func (_c *UsageLogCreate) SetFallbackHop(v int) *UsageLogCreate {
+	_c.mutation.SetFallbackHop(v)
+	return _c
+}
+
+// SetNillableFallbackHop sets the "fallback_hop" field if the given value is not nil.
+func (_c *UsageLogCreate) SetNillableFallbackHop(v *int) *UsageLogCreate {
+	if v != nil {
+		_c.SetFallbackHop(*v)
+	}
+	return _c
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_c *UsageLogCreate) SetOrganizationID(v int64) *UsageLogCreate {
+	_c.mutation.SetOrganizationID(v)
+	return _c
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_c *UsageLogCreate) SetNillableOrganizationID(v *int64) *UsageLogCreate {
+	if v != nil {
+		_c.SetOrganizationID(*v)
+	}
+	return _c
+}
+
+// SetBillingUserID sets the "billing_user_id" field.
+func (_c *UsageLogCreate) SetBillingUserID(v int64) *UsageLogCreate {
+	_c.mutation.SetBillingUserID(v)
+	return _c
+}
+
+// SetNillableBillingUserID sets the "billing_user_id" field if the given value is not nil.
+func (_c *UsageLogCreate) SetNillableBillingUserID(v *int64) *UsageLogCreate {
+	if v != nil {
+		_c.SetBillingUserID(*v)
+	}
+	return _c
+}
+
 // SetInputTokens sets the "input_tokens" field.
 func (_c *UsageLogCreate) SetInputTokens(v int) *UsageLogCreate {
 	_c.mutation.SetInputTokens(v)
@@ -267,6 +323,146 @@ func (_c *UsageLogCreate) SetNillableRateMultiplier(v *float64) *UsageLogCreate
 	return _c
 }
 
+// SetPricingVersion sets the "pricing_version" field.
+func (_c *UsageLogCreate) SetPricingVersion(v string) *UsageLogCreate {
+	_c.mutation.SetPricingVersion(v)
+	return _c
+}
+
+// SetNillablePricingVersion sets the "pricing_version" field if the given value is not nil.
+func (_c *UsageLogCreate) SetNillablePricingVersion(v *string) *UsageLogCreate {
+	if v != nil {
+		_c.SetPricingVersion(*v)
+	}
+	return _c
+}
+
+// SetInputUnitPrice sets the "input_unit_price" field.
+func (_c *UsageLogCreate) SetInputUnitPrice(v float64) *UsageLogCreate {
+	_c.mutation.SetInputUnitPrice(v)
+	return _c
+}
+
+// SetNillableInputUnitPrice sets the "input_unit_price" field if the given value is not nil.
+func (_c *UsageLogCreate) SetNillableInputUnitPrice(v *float64) *UsageLogCreate {
+	if v != nil {
+		_c.SetInputUnitPrice(*v)
+	}
+	return _c
+}
+
+// SetOutputUnitPrice sets the "output_unit_price" field.
+func (_c *UsageLogCreate) SetOutputUnitPrice(v float64) *UsageLogCreate {
+	_c.mutation.SetOutputUnitPrice(v)
+	return _c
+}
+
+// SetNillableOutputUnitPrice sets the "output_unit_price" field if the given value is not nil.
+func (_c *UsageLogCreate) SetNillableOutputUnitPrice(v *float64) *UsageLogCreate {
+	if v != nil {
+		_c.SetOutputUnitPrice(*v)
+	}
+	return _c
+}
+
+// SetCacheCreationUnitPrice sets the "cache_creation_unit_price" field.
+func (_c *UsageLogCreate) SetCacheCreationUnitPrice(v float64) *UsageLogCreate {
+	_c.mutation.SetCacheCreationUnitPrice(v)
+	return _c
+}
+
+// SetNillableCacheCreationUnitPrice sets the "cache_creation_unit_price" field if the given value is not nil.
+func (_c *UsageLogCreate) SetNillableCacheCreationUnitPrice(v *float64) *UsageLogCreate {
+	if v != nil {
+		_c.SetCacheCreationUnitPrice(*v)
+	}
+	return _c
+}
+
+// SetCacheReadUnitPrice sets the "cache_read_unit_price" field.
+func (_c *UsageLogCreate) SetCacheReadUnitPrice(v float64) *UsageLogCreate {
+	_c.mutation.SetCacheReadUnitPrice(v)
+	return _c
+}
+
+// SetNillableCacheReadUnitPrice sets the "cache_read_unit_price" field if the given value is not nil.
+func (_c *UsageLogCreate) SetNillableCacheReadUnitPrice(v *float64) *UsageLogCreate {
+	if v != nil {
+		_c.SetCacheReadUnitPrice(*v)
+	}
+	return _c
+}
+
+// SetImageUnitPrice sets the "image_unit_price" field.
+func (_c *UsageLogCreate) SetImageUnitPrice(v float64) *UsageLogCreate {
+	_c.mutation.SetImageUnitPrice(v)
+	return _c
+}
+
+// SetNillableImageUnitPrice sets the "image_unit_price" field if the given value is not nil.
+func (_c *UsageLogCreate) SetNillableImageUnitPrice(v *float64) *UsageLogCreate {
+	if v != nil {
+		_c.SetImageUnitPrice(*v)
+	}
+	return _c
+}
+
+// SetVolumeTierMinSpend sets the "volume_tier_min_spend" field.
+func (_c *UsageLogCreate) SetVolumeTierMinSpend(v float64) *UsageLogCreate {
+	_c.mutation.SetVolumeTierMinSpend(v)
+	return _c
+}
+
+// SetNillableVolumeTierMinSpend sets the "volume_tier_min_spend" field if the given value is not nil.
+func (_c *UsageLogCreate) SetNillableVolumeTierMinSpend(v *float64) *UsageLogCreate {
+	if v != nil {
+		_c.SetVolumeTierMinSpend(*v)
+	}
+	return _c
+}
+
+// SetVolumeTierMultiplier sets the "volume_tier_multiplier" field.
+func (_c *UsageLogCreate) SetVolumeTierMultiplier(v float64) *UsageLogCreate {
+	_c.mutation.SetVolumeTierMultiplier(v)
+	return _c
+}
+
+// SetNillableVolumeTierMultiplier sets the "volume_tier_multiplier" field if the given value is not nil.
+func (_c *UsageLogCreate) SetNillableVolumeTierMultiplier(v *float64) *UsageLogCreate {
+	if v != nil {
+		_c.SetVolumeTierMultiplier(*v)
+	}
+	return _c
+}
+
+// SetSubscriptionCost sets the "subscription_cost" field.
+func (_c *UsageLogCreate) SetSubscriptionCost(v float64) *UsageLogCreate {
+	_c.mutation.SetSubscriptionCost(v)
+	return _c
+}
+
+// SetNillableSubscriptionCost sets the "subscription_cost" field if the given value is not nil.
+func (_c *UsageLogCreate) SetNillableSubscriptionCost(v *float64) *UsageLogCreate {
+	if v != nil {
+		_c.SetSubscriptionCost(*v)
+	}
+	return _c
+}
+
+// SetOverageCost sets the "overage_cost" field.
+func (_c *UsageLogCreate) SetOverageCost(v float64) *UsageLogCreate {
+	_c.mutation.SetOverageCost(v)
+	return _c
+}
+
+// SetNillableOverageCost sets the "overage_cost" field if the given value is not nil.
+func (_c *UsageLogCreate) SetNillableOverageCost(v *float64) *UsageLogCreate {
+	if v != nil {
+		_c.SetOverageCost(*v)
+	}
+	return _c
+}
+
 // SetAccountRateMultiplier sets the "account_rate_multiplier" field.
 func (_c *UsageLogCreate) SetAccountRateMultiplier(v float64) *UsageLogCreate {
 	_c.mutation.SetAccountRateMultiplier(v)
@@ -467,6 +663,10 @@ func (_c *UsageLogCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *UsageLogCreate) defaults() {
+	if _, ok := _c.mutation.FallbackHop(); !ok {
+		v := usagelog.DefaultFallbackHop
+		_c.mutation.SetFallbackHop(v)
+	}
 	if _, ok := _c.mutation.InputTokens(); !ok {
 		v := usagelog.DefaultInputTokens
 		_c.mutation.SetInputTokens(v)
@@ -564,6 +764,9 @@ func (_c *UsageLogCreate) check() error {
 			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "UsageLog.model": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.FallbackHop(); !ok {
+		return &ValidationError{Name: "fallback_hop", err: errors.New(`ent: missing required field "UsageLog.fallback_hop"`)}
+	}
 	if _, ok := _c.mutation.InputTokens(); !ok {
 		return &ValidationError{Name: "input_tokens", err: errors.New(`ent: missing required field "UsageLog.input_tokens"`)}
 	}
@@ -603,6 +806,11 @@ func (_c *UsageLogCreate) check() error {
 	if _, ok := _c.mutation.RateMultiplier(); !ok {
 		return &ValidationError{Name: "rate_multiplier", err: errors.New(`ent: missing required field "UsageLog.rate_multiplier"`)}
 	}
+	if v, ok := _c.mutation.PricingVersion(); ok {
+		if err := usagelog.PricingVersionValidator(v); err != nil {
+			return &ValidationError{Name: "pricing_version", err: fmt.Errorf(`ent: validator failed for field "UsageLog.pricing_version": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.BillingType(); !ok {
 		return &ValidationError{Name: "billing_type", err: errors.New(`ent: missing required field "UsageLog.billing_type"`)}
 	}
@@ -674,6 +882,22 @@ func (_c *UsageLogCreate) createSpec() (*UsageLog, *sqlgraph.CreateSpec) {
 		_spec.SetField(usagelog.FieldModel, field.TypeString, value)
 		_node.Model = value
 	}
+	if value, ok := _c.mutation.OriginalGroupID(); ok {
+		_spec.SetField(usagelog.FieldOriginalGroupID, field.TypeInt64, value)
+		_node.OriginalGroupID = &value
+	}
+	if value, ok := _c.mutation.FallbackHop(); ok {
+		_spec.SetField(usagelog.FieldFallbackHop, field.TypeInt, value)
+		_node.FallbackHop = value
+	}
+	if value, ok := _c.mutation.OrganizationID(); ok {
+		_spec.SetField(usagelog.FieldOrganizationID, field.TypeInt64, value)
+		_node.OrganizationID = &value
+	}
+	if value, ok := _c.mutation.BillingUserID(); ok {
+		_spec.SetField(usagelog.FieldBillingUserID, field.TypeInt64, value)
+		_node.BillingUserID = &value
+	}
 	if value, ok := _c.mutation.InputTokens(); ok {
 		_spec.SetField(usagelog.FieldInputTokens, field.TypeInt, value)
 		_node.InputTokens = value
@@ -726,6 +950,46 @@ func (_c *UsageLogCreate) createSpec() (*UsageLog, *sqlgraph.CreateSpec) {
 		_spec.SetField(usagelog.FieldRateMultiplier, field.TypeFloat64, value)
 		_node.RateMultiplier = value
 	}
+	if value, ok := _c.mutation.PricingVersion(); ok {
+		_spec.SetField(usagelog.FieldPricingVersion, field.TypeString, value)
+		_node.PricingVersion = &value
+	}
+	if value, ok := _c.mutation.InputUnitPrice(); ok {
+		_spec.SetField(usagelog.FieldInputUnitPrice, field.TypeFloat64, value)
+		_node.InputUnitPrice = &value
+	}
+	if value, ok := _c.mutation.OutputUnitPrice(); ok {
+		_spec.SetField(usagelog.FieldOutputUnitPrice, field.TypeFloat64, value)
+		_node.OutputUnitPrice = &value
+	}
+	if value, ok := _c.mutation.CacheCreationUnitPrice(); ok {
+		_spec.SetField(usagelog.FieldCacheCreationUnitPrice, field.TypeFloat64, value)
+		_node.CacheCreationUnitPrice = &value
+	}
+	if value, ok := _c.mutation.CacheReadUnitPrice(); ok {
+		_spec.SetField(usagelog.FieldCacheReadUnitPrice, field.TypeFloat64, value)
+		_node.CacheReadUnitPrice = &value
+	}
+	if value, ok := _c.mutation.ImageUnitPrice(); ok {
+		_spec.SetField(usagelog.FieldImageUnitPrice, field.TypeFloat64, value)
+		_node.ImageUnitPrice = &value
+	}
+	if value, ok := _c.mutation.VolumeTierMinSpend(); ok {
+		_spec.SetField(usagelog.FieldVolumeTierMinSpend, field.TypeFloat64, value)
+		_node.VolumeTierMinSpend = &value
+	}
+	if value, ok := _c.mutation.VolumeTierMultiplier(); ok {
+		_spec.SetField(usagelog.FieldVolumeTierMultiplier, field.TypeFloat64, value)
+		_node.VolumeTierMultiplier = &value
+	}
+	if value, ok := _c.mutation.SubscriptionCost(); ok {
+		_spec.SetField(usagelog.FieldSubscriptionCost, field.TypeFloat64, value)
+		_node.SubscriptionCost = &value
+	}
+	if value, ok := _c.mutation.OverageCost(); ok {
+		_spec.SetField(usagelog.FieldOverageCost, field.TypeFloat64, value)
+		_node.OverageCost = &value
+	}
 	if value, ok := _c.mutation.AccountRateMultiplier(); ok {
 		_spec.SetField(usagelog.FieldAccountRateMultiplier, field.TypeFloat64, value)
 		_node.AccountRateMultiplier = &value
@@ -999,6 +1263,96 @@ func (u *UsageLogUpsert) ClearSubscriptionID() *UsageLogUpsert {
 	return u
 }
 
+// SetOriginalGroupID sets the "original_group_id" field.
+func (u *UsageLogUpsert) SetOriginalGroupID(v int64) *UsageLogUpsert {
+	u.Set(usagelog.FieldOriginalGroupID, v)
+	return u
+}
+
+// UpdateOriginalGroupID sets the "original_group_id" field to the value that was provided on create.
+func (u *UsageLogUpsert) UpdateOriginalGroupID() *UsageLogUpsert {
+	u.SetExcluded(usagelog.FieldOriginalGroupID)
+	return u
+}
+
+// AddOriginalGroupID adds v to the "original_group_id" field.
+func (u *UsageLogUpsert) AddOriginalGroupID(v int64) *UsageLogUpsert {
+	u.Add(usagelog.FieldOriginalGroupID, v)
+	return u
+}
+
+// ClearOriginalGroupID clears the value of the "original_group_id" field.
+func (u *UsageLogUpsert) ClearOriginalGroupID() *UsageLogUpsert {
+	u.SetNull(usagelog.FieldOriginalGroupID)
+	return u
+}
+
+// SetFallbackHop sets the "fallback_hop" field.
+func (u *UsageLogUpsert) SetFallbackHop(v int) *UsageLogUpsert {
+	u.Set(usagelog.FieldFallbackHop, v)
+	return u
+}
+
+// UpdateFallbackHop sets the "fallback_hop" field to the value that was provided on create.
+func (u *UsageLogUpsert) UpdateFallbackHop() *UsageLogUpsert {
+	u.SetExcluded(usagelog.FieldFallbackHop)
+	return u
+}
+
+// AddFallbackHop adds v to the "fallback_hop" field.
+func (u *UsageLogUpsert) AddFallbackHop(v int) *UsageLogUpsert {
+	u.Add(usagelog.FieldFallbackHop, v)
+	return u
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (u *UsageLogUpsert) SetOrganizationID(v int64) *UsageLogUpsert {
+	u.Set(usagelog.FieldOrganizationID, v)
+	return u
+}
+
+// UpdateOrganizationID sets the "organization_id" field to the value that was provided on create.
+func (u *UsageLogUpsert) UpdateOrganizationID() *UsageLogUpsert {
+	u.SetExcluded(usagelog.FieldOrganizationID)
+	return u
+}
+
+// AddOrganizationID adds v to the "organization_id" field.
+func (u *UsageLogUpsert) AddOrganizationID(v int64) *UsageLogUpsert {
+	u.Add(usagelog.FieldOrganizationID, v)
+	return u
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (u *UsageLogUpsert) ClearOrganizationID() *UsageLogUpsert {
+	u.SetNull(usagelog.FieldOrganizationID)
+	return u
+}
+
+// SetBillingUserID sets the "billing_user_id" field.
+func (u *UsageLogUpsert) SetBillingUserID(v int64) *UsageLogUpsert {
+	u.Set(usagelog.FieldBillingUserID, v)
+	return u
+}
+
+// UpdateBillingUserID sets the "billing_user_id" field to the value that was provided on create.
+func (u *UsageLogUpsert) UpdateBillingUserID() *UsageLogUpsert {
+	u.SetExcluded(usagelog.FieldBillingUserID)
+	return u
+}
+
+// AddBillingUserID adds v to the "billing_user_id" field.
+func (u *UsageLogUpsert) AddBillingUserID(v int64) *UsageLogUpsert {
+	u.Add(usagelog.FieldBillingUserID, v)
+	return u
+}
+
+// ClearBillingUserID clears the value of the "billing_user_id" field.
+func (u *UsageLogUpsert) ClearBillingUserID() *UsageLogUpsert {
+	u.SetNull(usagelog.FieldBillingUserID)
+	return u
+}
+
 // SetInputTokens sets the "input_tokens" field.
 func (u *UsageLogUpsert) SetInputTokens(v int) *UsageLogUpsert {
 	u.Set(usagelog.FieldInputTokens, v)
@@ -1233,165 +1587,399 @@ func (u *UsageLogUpsert) AddRateMultiplier(v float64) *UsageLogUpsert {
 	return u
 }
 
-// SetAccountRateMultiplier sets the "account_rate_multiplier" field.
-func (u *UsageLogUpsert) SetAccountRateMultiplier(v float64) *UsageLogUpsert {
-	u.Set(usagelog.FieldAccountRateMultiplier, v)
+// SetPricingVersion sets the "pricing_version" field.
+func (u *UsageLogUpsert) SetPricingVersion(v string) *UsageLogUpsert {
+	u.Set(usagelog.FieldPricingVersion, v)
 	return u
 }
 
-// UpdateAccountRateMultiplier sets the "account_rate_multiplier" field to the value that was provided on create.
-func (u *UsageLogUpsert) UpdateAccountRateMultiplier() *UsageLogUpsert {
-	u.SetExcluded(usagelog.FieldAccountRateMultiplier)
+// UpdatePricingVersion sets the "pricing_version" field to the value that was provided on create.
+func (u *UsageLogUpsert) UpdatePricingVersion() *UsageLogUpsert {
+	u.SetExcluded(usagelog.FieldPricingVersion)
 	return u
 }
 
-// AddAccountRateMultiplier adds v to the "account_rate_multiplier" field.
-func (u *UsageLogUpsert) AddAccountRateMultiplier(v float64) *UsageLogUpsert {
-	u.Add(usagelog.FieldAccountRateMultiplier, v)
+// ClearPricingVersion clears the value of the "pricing_version" field.
+func (u *UsageLogUpsert) ClearPricingVersion() *UsageLogUpsert {
+	u.SetNull(usagelog.FieldPricingVersion)
 	return u
 }
 
-// ClearAccountRateMultiplier clears the value of the "account_rate_multiplier" field.
-func (u *UsageLogUpsert) ClearAccountRateMultiplier() *UsageLogUpsert {
-	u.SetNull(usagelog.FieldAccountRateMultiplier)
+// SetInputUnitPrice sets the "input_unit_price" field.
+func (u *UsageLogUpsert) SetInputUnitPrice(v float64) *UsageLogUpsert {
+	u.Set(usagelog.FieldInputUnitPrice, v)
 	return u
 }
 
-// SetBillingType sets the "billing_type" field.
-func (u *UsageLogUpsert) SetBillingType(v int8) *UsageLogUpsert {
-	u.Set(usagelog.FieldBillingType, v)
+// UpdateInputUnitPrice sets the "input_unit_price" field to the value that was provided on create.
+func (u *UsageLogUpsert) UpdateInputUnitPrice() *UsageLogUpsert {
+	u.SetExcluded(usagelog.FieldInputUnitPrice)
 	return u
 }
 
-// UpdateBillingType sets the "billing_type" field to the value that was provided on create.
-func (u *UsageLogUpsert) UpdateBillingType() *UsageLogUpsert {
-	u.SetExcluded(usagelog.FieldBillingType)
+// AddInputUnitPrice adds v to the "input_unit_price" field.
+func (u *UsageLogUpsert) AddInputUnitPrice(v float64) *UsageLogUpsert {
+	u.Add(usagelog.FieldInputUnitPrice, v)
 	return u
 }
 
-// AddBillingType adds v to the "billing_type" field.
-func (u *UsageLogUpsert) AddBillingType(v int8) *UsageLogUpsert {
-	u.Add(usagelog.FieldBillingType, v)
+// ClearInputUnitPrice clears the value of the "input_unit_price" field.
+func (u *UsageLogUpsert) ClearInputUnitPrice() *UsageLogUpsert {
+	u.SetNull(usagelog.FieldInputUnitPrice)
 	return u
 }
 
-// SetStream sets the "stream" field.
-func (u *UsageLogUpsert) SetStream(v bool) *UsageLogUpsert {
-	u.Set(usagelog.FieldStream, v)
+// SetOutputUnitPrice sets the "output_unit_price" field.
+func (u *UsageLogUpsert) SetOutputUnitPrice(v float64) *UsageLogUpsert {
+	u.Set(usagelog.FieldOutputUnitPrice, v)
 	return u
 }
 
-// UpdateStream sets the "stream" field to the value that was provided on create.
-func (u *UsageLogUpsert) UpdateStream() *UsageLogUpsert {
-	u.SetExcluded(usagelog.FieldStream)
+// UpdateOutputUnitPrice sets the "output_unit_price" field to the value that was provided on create.
+func (u *UsageLogUpsert) UpdateOutputUnitPrice() *UsageLogUpsert {
+	u.SetExcluded(usagelog.FieldOutputUnitPrice)
 	return u
 }
 
-// SetDurationMs sets the "duration_ms" field.
-func (u *UsageLogUpsert) SetDurationMs(v int) *UsageLogUpsert {
-	u.Set(usagelog.FieldDurationMs, v)
+// AddOutputUnitPrice adds v to the "output_unit_price" field.
+func (u *UsageLogUpsert) AddOutputUnitPrice(v float64) *UsageLogUpsert {
+	u.Add(usagelog.FieldOutputUnitPrice, v)
 	return u
 }
 
-// UpdateDurationMs sets the "duration_ms" field to the value that was provided on create.
-func (u *UsageLogUpsert) UpdateDurationMs() *UsageLogUpsert {
-	u.SetExcluded(usagelog.FieldDurationMs)
+// ClearOutputUnitPrice clears the value of the "output_unit_price" field.
+func (u *UsageLogUpsert) ClearOutputUnitPrice() *UsageLogUpsert {
+	u.SetNull(usagelog.FieldOutputUnitPrice)
 	return u
 }
 
-// AddDurationMs adds v to the "duration_ms" field.
-func (u *UsageLogUpsert) AddDurationMs(v int) *UsageLogUpsert {
-	u.Add(usagelog.FieldDurationMs, v)
+// SetCacheCreationUnitPrice sets the "cache_creation_unit_price" field.
+func (u *UsageLogUpsert) SetCacheCreationUnitPrice(v float64) *UsageLogUpsert {
+	u.Set(usagelog.FieldCacheCreationUnitPrice, v)
 	return u
 }
 
-// ClearDurationMs clears the value of the "duration_ms" field.
-func (u *UsageLogUpsert) ClearDurationMs() *UsageLogUpsert {
-	u.SetNull(usagelog.FieldDurationMs)
+// UpdateCacheCreationUnitPrice sets the "cache_creation_unit_price" field to the value that was provided on create.
+func (u *UsageLogUpsert) UpdateCacheCreationUnitPrice() *UsageLogUpsert {
+	u.SetExcluded(usagelog.FieldCacheCreationUnitPrice)
 	return u
 }
 
-// SetFirstTokenMs sets the "first_token_ms" field.
-func (u *UsageLogUpsert) SetFirstTokenMs(v int) *UsageLogUpsert {
-	u.Set(usagelog.FieldFirstTokenMs, v)
+// AddCacheCreationUnitPrice adds v to the "cache_creation_unit_price" field.
+func (u *UsageLogUpsert) AddCacheCreationUnitPrice(v float64) *UsageLogUpsert {
+	u.Add(usagelog.FieldCacheCreationUnitPrice, v)
 	return u
 }
 
-// UpdateFirstTokenMs sets the "first_token_ms" field to the value that was provided on create.
-func (u *UsageLogUpsert) UpdateFirstTokenMs() *UsageLogUpsert {
-	u.SetExcluded(usagelog.FieldFirstTokenMs)
+// ClearCacheCreationUnitPrice clears the value of the "cache_creation_unit_price" field.
+func (u *UsageLogUpsert) ClearCacheCreationUnitPrice() *UsageLogUpsert {
+	u.SetNull(usagelog.FieldCacheCreationUnitPrice)
 	return u
 }
 
-// AddFirstTokenMs adds v to the "first_token_ms" field.
-func (u *UsageLogUpsert) AddFirstTokenMs(v int) *UsageLogUpsert {
-	u.Add(usagelog.FieldFirstTokenMs, v)
+// SetCacheReadUnitPrice sets the "cache_read_unit_price" field.
+func (u *UsageLogUpsert) SetCacheReadUnitPrice(v float64) *UsageLogUpsert {
+	u.Set(usagelog.FieldCacheReadUnitPrice, v)
 	return u
 }
 
-// ClearFirstTokenMs clears the value of the "first_token_ms" field.
-func (u *UsageLogUpsert) ClearFirstTokenMs() *UsageLogUpsert {
-	u.SetNull(usagelog.FieldFirstTokenMs)
+// UpdateCacheReadUnitPrice sets the "cache_read_unit_price" field to the value that was provided on create.
+func (u *UsageLogUpsert) UpdateCacheReadUnitPrice() *UsageLogUpsert {
+	u.SetExcluded(usagelog.FieldCacheReadUnitPrice)
 	return u
 }
 
-// SetUserAgent sets the "user_agent" field.
-func (u *UsageLogUpsert) SetUserAgent(v string) *UsageLogUpsert {
-	u.Set(usagelog.FieldUserAgent, v)
+// AddCacheReadUnitPrice adds v to the "cache_read_unit_price" field.
+func (u *UsageLogUpsert) AddCacheReadUnitPrice(v float64) *UsageLogUpsert {
+	u.Add(usagelog.FieldCacheReadUnitPrice, v)
 	return u
 }
 
-// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
-func (u *UsageLogUpsert) UpdateUserAgent() *UsageLogUpsert {
-	u.SetExcluded(usagelog.FieldUserAgent)
+// ClearCacheReadUnitPrice clears the value of the "cache_read_unit_price" field.
+func (u *UsageLogUpsert) ClearCacheReadUnitPrice() *UsageLogUpsert {
+	u.SetNull(usagelog.FieldCacheReadUnitPrice)
 	return u
 }
 
-// ClearUserAgent clears the value of the "user_agent" field.
-func (u *UsageLogUpsert) ClearUserAgent() *UsageLogUpsert {
-	u.SetNull(usagelog.FieldUserAgent)
+// SetImageUnitPrice sets the "image_unit_price" field.
+func (u *UsageLogUpsert) SetImageUnitPrice(v float64) *UsageLogUpsert {
+	u.Set(usagelog.FieldImageUnitPrice, v)
 	return u
 }
 
-// SetIPAddress sets the "ip_address" field.
-func (u *UsageLogUpsert) SetIPAddress(v string) *UsageLogUpsert {
-	u.Set(usagelog.FieldIPAddress, v)
+// UpdateImageUnitPrice sets the "image_unit_price" field to the value that was provided on create.
+func (u *UsageLogUpsert) UpdateImageUnitPrice() *UsageLogUpsert {
+	u.SetExcluded(usagelog.FieldImageUnitPrice)
 	return u
 }
 
-// UpdateIPAddress sets the "ip_address" field to the value that was provided on create.
-func (u *UsageLogUpsert) UpdateIPAddress() *UsageLogUpsert {
-	u.SetExcluded(usagelog.FieldIPAddress)
+// AddImageUnitPrice adds v to the "image_unit_price" field.
+func (u *UsageLogUpsert) AddImageUnitPrice(v float64) *UsageLogUpsert {
+	u.Add(usagelog.FieldImageUnitPrice, v)
 	return u
 }
 
-// ClearIPAddress clears the value of the "ip_address" field.
-func (u *UsageLogUpsert) ClearIPAddress() *UsageLogUpsert {
-	u.SetNull(usagelog.FieldIPAddress)
+// ClearImageUnitPrice clears the value of the "image_unit_price" field.
+func (u *UsageLogUpsert) ClearImageUnitPrice() *UsageLogUpsert {
+	u.SetNull(usagelog.FieldImageUnitPrice)
 	return u
 }
 
-// SetImageCount sets the "image_count" field.
-func (u *UsageLogUpsert) SetImageCount(v int) *UsageLogUpsert {
-	u.Set(usagelog.FieldImageCount, v)
+// SetVolumeTierMinSpend sets the "volume_tier_min_spend" field.
+func (u *UsageLogUpsert) SetVolumeTierMinSpend(v float64) *UsageLogUpsert {
+	u.Set(usagelog.FieldVolumeTierMinSpend, v)
 	return u
 }
 
-// UpdateImageCount sets the "image_count" field to the value that was provided on create.
-func (u *UsageLogUpsert) UpdateImageCount() *UsageLogUpsert {
-	u.SetExcluded(usagelog.FieldImageCount)
+// UpdateVolumeTierMinSpend sets the "volume_tier_min_spend" field to the value that was provided on create.
+func (u *UsageLogUpsert) UpdateVolumeTierMinSpend() *UsageLogUpsert {
+	u.SetExcluded(usagelog.FieldVolumeTierMinSpend)
 	return u
 }
 
-// AddImageCount adds v to the "image_count" field.
-func (u *UsageLogUpsert) AddImageCount(v int) *UsageLogUpsert {
-	u.Add(usagelog.FieldImageCount, v)
+// AddVolumeTierMinSpend adds v to the "volume_tier_min_spend" field.
+func (u *UsageLogUpsert) AddVolumeTierMinSpend(v float64) *UsageLogUpsert {
+	u.Add(usagelog.FieldVolumeTierMinSpend, v)
 	return u
 }
 
-// SetImageSize sets the "image_size" field.
-func (u *UsageLogUpsert) SetImageSize(v string) *UsageLogUpsert {
-	u.Set(usagelog.FieldImageSize, v)
+// ClearVolumeTierMinSpend clears the value of the "volume_tier_min_spend" field.
+func (u *UsageLogUpsert) ClearVolumeTierMinSpend() *UsageLogUpsert {
+	u.SetNull(usagelog.FieldVolumeTierMinSpend)
+	return u
+}
+
+// SetVolumeTierMultiplier sets the "volume_tier_multiplier" field.
+func (u *UsageLogUpsert) SetVolumeTierMultiplier(v float64) *UsageLogUpsert {
+	u.Set(usagelog.FieldVolumeTierMultiplier, v)
+	return u
+}
+
+// UpdateVolumeTierMultiplier sets the "volume_tier_multiplier" field to the value that was provided on create.
+func (u *UsageLogUpsert) UpdateVolumeTierMultiplier() *UsageLogUpsert {
+	u.SetExcluded(usagelog.FieldVolumeTierMultiplier)
+	return u
+}
+
+// AddVolumeTierMultiplier adds v to the "volume_tier_multiplier" field.
+func (u *UsageLogUpsert) AddVolumeTierMultiplier(v float64) *UsageLogUpsert {
+	u.Add(usagelog.FieldVolumeTierMultiplier, v)
+	return u
+}
+
+// ClearVolumeTierMultiplier clears the value of the "volume_tier_multiplier" field.
+func (u *UsageLogUpsert) ClearVolumeTierMultiplier() *UsageLogUpsert {
+	u.SetNull(usagelog.FieldVolumeTierMultiplier)
+	return u
+}
+
+// SetSubscriptionCost sets the "subscription_cost" field.
+func (u *UsageLogUpsert) SetSubscriptionCost(v float64) *UsageLogUpsert {
+	u.Set(usagelog.FieldSubscriptionCost, v)
+	return u
+}
+
+// UpdateSubscriptionCost sets the "subscription_cost" field to the value that was provided on create.
+func (u *UsageLogUpsert) UpdateSubscriptionCost() *UsageLogUpsert {
+	u.SetExcluded(usagelog.FieldSubscriptionCost)
+	return u
+}
+
+// AddSubscriptionCost adds v to the "subscription_cost" field.
+func (u *UsageLogUpsert) AddSubscriptionCost(v float64) *UsageLogUpsert {
+	u.Add(usagelog.FieldSubscriptionCost, v)
+	return u
+}
+
+// ClearSubscriptionCost clears the value of the "subscription_cost" field.
+func (u *UsageLogUpsert) ClearSubscriptionCost() *UsageLogUpsert {
+	u.SetNull(usagelog.FieldSubscriptionCost)
+	return u
+}
+
+// SetOverageCost sets the "overage_cost" field.
+func (u *UsageLogUpsert) SetOverageCost(v float64) *UsageLogUpsert {
+	u.Set(usagelog.FieldOverageCost, v)
+	return u
+}
+
+// UpdateOverageCost sets the "overage_cost" field to the value that was provided on create.
+func (u *UsageLogUpsert) UpdateOverageCost() *UsageLogUpsert {
+	u.SetExcluded(usagelog.FieldOverageCost)
+	return u
+}
+
+// AddOverageCost adds v to the "overage_cost" field.
+func (u *UsageLogUpsert) AddOverageCost(v float64) *UsageLogUpsert {
+	u.Add(usagelog.FieldOverageCost, v)
+	return u
+}
+
+// ClearOverageCost clears the value of the "overage_cost" field.
+func (u *UsageLogUpsert) ClearOverageCost() *UsageLogUpsert {
+	u.SetNull(usagelog.FieldOverageCost)
+	return u
+}
+
+// SetAccountRateMultiplier sets the "account_rate_multiplier" field.
+func (u *UsageLogUpsert) SetAccountRateMultiplier(v float64) *UsageLogUpsert {
+	u.Set(usagelog.FieldAccountRateMultiplier, v)
+	return u
+}
+
+// UpdateAccountRateMultiplier sets the "account_rate_multiplier" field to the value that was provided on create.
+func (u *UsageLogUpsert) UpdateAccountRateMultiplier() *UsageLogUpsert {
+	u.SetExcluded(usagelog.FieldAccountRateMultiplier)
+	return u
+}
+
+// AddAccountRateMultiplier adds v to the "account_rate_multiplier" field.
+func (u *UsageLogUpsert) AddAccountRateMultiplier(v float64) *UsageLogUpsert {
+	u.Add(usagelog.FieldAccountRateMultiplier, v)
+	return u
+}
+
+// ClearAccountRateMultiplier clears the value of the "account_rate_multiplier" field.
+func (u *UsageLogUpsert) ClearAccountRateMultiplier() *UsageLogUpsert {
+	u.SetNull(usagelog.FieldAccountRateMultiplier)
+	return u
+}
+
+// SetBillingType sets the "billing_type" field.
+func (u *UsageLogUpsert) SetBillingType(v int8) *UsageLogUpsert {
+	u.Set(usagelog.FieldBillingType, v)
+	return u
+}
+
+// UpdateBillingType sets the "billing_type" field to the value that was provided on create.
+func (u *UsageLogUpsert) UpdateBillingType() *UsageLogUpsert {
+	u.SetExcluded(usagelog.FieldBillingType)
+	return u
+}
+
+// AddBillingType adds v to the "billing_type" field.
+func (u *UsageLogUpsert) AddBillingType(v int8) *UsageLogUpsert {
+	u.Add(usagelog.FieldBillingType, v)
+	return u
+}
+
+// SetStream sets the "stream" field.
+func (u *UsageLogUpsert) SetStream(v bool) *UsageLogUpsert {
+	u.Set(usagelog.FieldStream, v)
+	return u
+}
+
+// UpdateStream sets the "stream" field to the value that was provided on create.
+func (u *UsageLogUpsert) UpdateStream() *UsageLogUpsert {
+	u.SetExcluded(usagelog.FieldStream)
+	return u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (u *UsageLogUpsert) SetDurationMs(v int) *UsageLogUpsert {
+	u.Set(usagelog.FieldDurationMs, v)
+	return u
+}
+
+// UpdateDurationMs sets the "duration_ms" field to the value that was provided on create.
+func (u *UsageLogUpsert) UpdateDurationMs() *UsageLogUpsert {
+	u.SetExcluded(usagelog.FieldDurationMs)
+	return u
+}
+
+// AddDurationMs adds v to the "duration_ms" field.
+func (u *UsageLogUpsert) AddDurationMs(v int) *UsageLogUpsert {
+	u.Add(usagelog.FieldDurationMs, v)
+	return u
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (u *UsageLogUpsert) ClearDurationMs() *UsageLogUpsert {
+	u.SetNull(usagelog.FieldDurationMs)
+	return u
+}
+
+// SetFirstTokenMs sets the "first_token_ms" field.
+func (u *UsageLogUpsert) SetFirstTokenMs(v int) *UsageLogUpsert {
+	u.Set(usagelog.FieldFirstTokenMs, v)
+	return u
+}
+
+// UpdateFirstTokenMs sets the "first_token_ms" field to the value that was provided on create.
+func (u *UsageLogUpsert) UpdateFirstTokenMs() *UsageLogUpsert {
+	u.SetExcluded(usagelog.FieldFirstTokenMs)
+	return u
+}
+
+// AddFirstTokenMs adds v to the "first_token_ms" field.
+func (u *UsageLogUpsert) AddFirstTokenMs(v int) *UsageLogUpsert {
+	u.Add(usagelog.FieldFirstTokenMs, v)
+	return u
+}
+
+// ClearFirstTokenMs clears the value of the "first_token_ms" field.
+func (u *UsageLogUpsert) ClearFirstTokenMs() *UsageLogUpsert {
+	u.SetNull(usagelog.FieldFirstTokenMs)
+	return u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *UsageLogUpsert) SetUserAgent(v string) *UsageLogUpsert {
+	u.Set(usagelog.FieldUserAgent, v)
+	return u
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *UsageLogUpsert) UpdateUserAgent() *UsageLogUpsert {
+	u.SetExcluded(usagelog.FieldUserAgent)
+	return u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (u *UsageLogUpsert) ClearUserAgent() *UsageLogUpsert {
+	u.SetNull(usagelog.FieldUserAgent)
+	return u
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (u *UsageLogUpsert) SetIPAddress(v string) *UsageLogUpsert {
+	u.Set(usagelog.FieldIPAddress, v)
+	return u
+}
+
+// UpdateIPAddress sets the "ip_address" field to the value that was provided on create.
+func (u *UsageLogUpsert) UpdateIPAddress() *UsageLogUpsert {
+	u.SetExcluded(usagelog.FieldIPAddress)
+	return u
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (u *UsageLogUpsert) ClearIPAddress() *UsageLogUpsert {
+	u.SetNull(usagelog.FieldIPAddress)
+	return u
+}
+
+// SetImageCount sets the "image_count" field.
+func (u *UsageLogUpsert) SetImageCount(v int) *UsageLogUpsert {
+	u.Set(usagelog.FieldImageCount, v)
+	return u
+}
+
+// UpdateImageCount sets the "image_count" field to the value that was provided on create.
+func (u *UsageLogUpsert) UpdateImageCount() *UsageLogUpsert {
+	u.SetExcluded(usagelog.FieldImageCount)
+	return u
+}
+
+// AddImageCount adds v to the "image_count" field.
+func (u *UsageLogUpsert) AddImageCount(v int) *UsageLogUpsert {
+	u.Add(usagelog.FieldImageCount, v)
+	return u
+}
+
+// SetImageSize sets the "image_size" field.
+func (u *UsageLogUpsert) SetImageSize(v string) *UsageLogUpsert {
+	u.Set(usagelog.FieldImageSize, v)
 	return u
 }
 
@@ -1564,6 +2152,111 @@ func (u *UsageLogUpsertOne) ClearSubscriptionID() *UsageLogUpsertOne {
 	})
 }
 
+// SetOriginalGroupID sets the "original_group_id" field.
+func (u *UsageLogUpsertOne) SetOriginalGroupID(v int64) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetOriginalGroupID(v)
+	})
+}
+
+// AddOriginalGroupID adds v to the "original_group_id" field.
+func (u *UsageLogUpsertOne) AddOriginalGroupID(v int64) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddOriginalGroupID(v)
+	})
+}
+
+// UpdateOriginalGroupID sets the "original_group_id" field to the value that was provided on create.
+func (u *UsageLogUpsertOne) UpdateOriginalGroupID() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateOriginalGroupID()
+	})
+}
+
+// ClearOriginalGroupID clears the value of the "original_group_id" field.
+func (u *UsageLogUpsertOne) ClearOriginalGroupID() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearOriginalGroupID()
+	})
+}
+
+// SetFallbackHop sets the "fallback_hop" field.
+func (u *UsageLogUpsertOne) SetFallbackHop(v int) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetFallbackHop(v)
+	})
+}
+
+// AddFallbackHop adds v to the "fallback_hop" field.
+func (u *UsageLogUpsertOne) AddFallbackHop(v int) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddFallbackHop(v)
+	})
+}
+
+// UpdateFallbackHop sets the "fallback_hop" field to the value that was provided on create.
+func (u *UsageLogUpsertOne) UpdateFallbackHop() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateFallbackHop()
+	})
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (u *UsageLogUpsertOne) SetOrganizationID(v int64) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetOrganizationID(v)
+	})
+}
+
+// AddOrganizationID adds v to the "organization_id" field.
+func (u *UsageLogUpsertOne) AddOrganizationID(v int64) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddOrganizationID(v)
+	})
+}
+
+// UpdateOrganizationID sets the "organization_id" field to the value that was provided on create.
+func (u *UsageLogUpsertOne) UpdateOrganizationID() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateOrganizationID()
+	})
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (u *UsageLogUpsertOne) ClearOrganizationID() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearOrganizationID()
+	})
+}
+
+// SetBillingUserID sets the "billing_user_id" field.
+func (u *UsageLogUpsertOne) SetBillingUserID(v int64) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetBillingUserID(v)
+	})
+}
+
+// AddBillingUserID adds v to the "billing_user_id" field.
+func (u *UsageLogUpsertOne) AddBillingUserID(v int64) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddBillingUserID(v)
+	})
+}
+
+// UpdateBillingUserID sets the "billing_user_id" field to the value that was provided on create.
+func (u *UsageLogUpsertOne) UpdateBillingUserID() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateBillingUserID()
+	})
+}
+
+// ClearBillingUserID clears the value of the "billing_user_id" field.
+func (u *UsageLogUpsertOne) ClearBillingUserID() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearBillingUserID()
+	})
+}
+
 // SetInputTokens sets the "input_tokens" field.
 func (u *UsageLogUpsertOne) SetInputTokens(v int) *UsageLogUpsertOne {
 	return u.Update(func(s *UsageLogUpsert) {
@@ -1742,98 +2435,371 @@ func (u *UsageLogUpsertOne) SetCacheCreationCost(v float64) *UsageLogUpsertOne {
 // AddCacheCreationCost adds v to the "cache_creation_cost" field.
 func (u *UsageLogUpsertOne) AddCacheCreationCost(v float64) *UsageLogUpsertOne {
 	return u.Update(func(s *UsageLogUpsert) {
-		s.AddCacheCreationCost(v)
+		s.AddCacheCreationCost(v)
+	})
+}
+
+// UpdateCacheCreationCost sets the "cache_creation_cost" field to the value that was provided on create.
+func (u *UsageLogUpsertOne) UpdateCacheCreationCost() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateCacheCreationCost()
+	})
+}
+
+// SetCacheReadCost sets the "cache_read_cost" field.
+func (u *UsageLogUpsertOne) SetCacheReadCost(v float64) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetCacheReadCost(v)
+	})
+}
+
+// AddCacheReadCost adds v to the "cache_read_cost" field.
+func (u *UsageLogUpsertOne) AddCacheReadCost(v float64) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddCacheReadCost(v)
+	})
+}
+
+// UpdateCacheReadCost sets the "cache_read_cost" field to the value that was provided on create.
+func (u *UsageLogUpsertOne) UpdateCacheReadCost() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateCacheReadCost()
+	})
+}
+
+// SetTotalCost sets the "total_cost" field.
+func (u *UsageLogUpsertOne) SetTotalCost(v float64) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetTotalCost(v)
+	})
+}
+
+// AddTotalCost adds v to the "total_cost" field.
+func (u *UsageLogUpsertOne) AddTotalCost(v float64) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddTotalCost(v)
+	})
+}
+
+// UpdateTotalCost sets the "total_cost" field to the value that was provided on create.
+func (u *UsageLogUpsertOne) UpdateTotalCost() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateTotalCost()
+	})
+}
+
+// SetActualCost sets the "actual_cost" field.
+func (u *UsageLogUpsertOne) SetActualCost(v float64) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetActualCost(v)
+	})
+}
+
+// AddActualCost adds v to the "actual_cost" field.
+func (u *UsageLogUpsertOne) AddActualCost(v float64) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddActualCost(v)
+	})
+}
+
+// UpdateActualCost sets the "actual_cost" field to the value that was provided on create.
+func (u *UsageLogUpsertOne) UpdateActualCost() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateActualCost()
+	})
+}
+
+// SetRateMultiplier sets the "rate_multiplier" field.
+func (u *UsageLogUpsertOne) SetRateMultiplier(v float64) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetRateMultiplier(v)
+	})
+}
+
+// AddRateMultiplier adds v to the "rate_multiplier" field.
+func (u *UsageLogUpsertOne) AddRateMultiplier(v float64) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddRateMultiplier(v)
+	})
+}
+
+// UpdateRateMultiplier sets the "rate_multiplier" field to the value that was provided on create.
+func (u *UsageLogUpsertOne) UpdateRateMultiplier() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateRateMultiplier()
+	})
+}
+
+// SetPricingVersion sets the "pricing_version" field.
+func (u *UsageLogUpsertOne) SetPricingVersion(v string) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetPricingVersion(v)
+	})
+}
+
+// UpdatePricingVersion sets the "pricing_version" field to the value that was provided on create.
+func (u *UsageLogUpsertOne) UpdatePricingVersion() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdatePricingVersion()
+	})
+}
+
+// ClearPricingVersion clears the value of the "pricing_version" field.
+func (u *UsageLogUpsertOne) ClearPricingVersion() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearPricingVersion()
+	})
+}
+
+// SetInputUnitPrice sets the "input_unit_price" field.
+func (u *UsageLogUpsertOne) SetInputUnitPrice(v float64) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetInputUnitPrice(v)
+	})
+}
+
+// AddInputUnitPrice adds v to the "input_unit_price" field.
+func (u *UsageLogUpsertOne) AddInputUnitPrice(v float64) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddInputUnitPrice(v)
+	})
+}
+
+// UpdateInputUnitPrice sets the "input_unit_price" field to the value that was provided on create.
+func (u *UsageLogUpsertOne) UpdateInputUnitPrice() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateInputUnitPrice()
+	})
+}
+
+// ClearInputUnitPrice clears the value of the "input_unit_price" field.
+func (u *UsageLogUpsertOne) ClearInputUnitPrice() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearInputUnitPrice()
+	})
+}
+
+// SetOutputUnitPrice sets the "output_unit_price" field.
+func (u *UsageLogUpsertOne) SetOutputUnitPrice(v float64) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetOutputUnitPrice(v)
+	})
+}
+
+// AddOutputUnitPrice adds v to the "output_unit_price" field.
+func (u *UsageLogUpsertOne) AddOutputUnitPrice(v float64) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddOutputUnitPrice(v)
+	})
+}
+
+// UpdateOutputUnitPrice sets the "output_unit_price" field to the value that was provided on create.
+func (u *UsageLogUpsertOne) UpdateOutputUnitPrice() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateOutputUnitPrice()
+	})
+}
+
+// ClearOutputUnitPrice clears the value of the "output_unit_price" field.
+func (u *UsageLogUpsertOne) ClearOutputUnitPrice() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearOutputUnitPrice()
+	})
+}
+
+// SetCacheCreationUnitPrice sets the "cache_creation_unit_price" field.
+func (u *UsageLogUpsertOne) SetCacheCreationUnitPrice(v float64) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetCacheCreationUnitPrice(v)
+	})
+}
+
+// AddCacheCreationUnitPrice adds v to the "cache_creation_unit_price" field.
+func (u *UsageLogUpsertOne) AddCacheCreationUnitPrice(v float64) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddCacheCreationUnitPrice(v)
+	})
+}
+
+// UpdateCacheCreationUnitPrice sets the "cache_creation_unit_price" field to the value that was provided on create.
+func (u *UsageLogUpsertOne) UpdateCacheCreationUnitPrice() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateCacheCreationUnitPrice()
+	})
+}
+
+// ClearCacheCreationUnitPrice clears the value of the "cache_creation_unit_price" field.
+func (u *UsageLogUpsertOne) ClearCacheCreationUnitPrice() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearCacheCreationUnitPrice()
+	})
+}
+
+// SetCacheReadUnitPrice sets the "cache_read_unit_price" field.
+func (u *UsageLogUpsertOne) SetCacheReadUnitPrice(v float64) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetCacheReadUnitPrice(v)
+	})
+}
+
+// AddCacheReadUnitPrice adds v to the "cache_read_unit_price" field.
+func (u *UsageLogUpsertOne) AddCacheReadUnitPrice(v float64) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddCacheReadUnitPrice(v)
+	})
+}
+
+// UpdateCacheReadUnitPrice sets the "cache_read_unit_price" field to the value that was provided on create.
+func (u *UsageLogUpsertOne) UpdateCacheReadUnitPrice() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateCacheReadUnitPrice()
+	})
+}
+
+// ClearCacheReadUnitPrice clears the value of the "cache_read_unit_price" field.
+func (u *UsageLogUpsertOne) ClearCacheReadUnitPrice() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearCacheReadUnitPrice()
+	})
+}
+
+// SetImageUnitPrice sets the "image_unit_price" field.
+func (u *UsageLogUpsertOne) SetImageUnitPrice(v float64) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetImageUnitPrice(v)
+	})
+}
+
+// AddImageUnitPrice adds v to the "image_unit_price" field.
+func (u *UsageLogUpsertOne) AddImageUnitPrice(v float64) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddImageUnitPrice(v)
+	})
+}
+
+// UpdateImageUnitPrice sets the "image_unit_price" field to the value that was provided on create.
+func (u *UsageLogUpsertOne) UpdateImageUnitPrice() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateImageUnitPrice()
+	})
+}
+
+// ClearImageUnitPrice clears the value of the "image_unit_price" field.
+func (u *UsageLogUpsertOne) ClearImageUnitPrice() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearImageUnitPrice()
+	})
+}
+
+// SetVolumeTierMinSpend sets the "volume_tier_min_spend" field.
+func (u *UsageLogUpsertOne) SetVolumeTierMinSpend(v float64) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetVolumeTierMinSpend(v)
+	})
+}
+
+// AddVolumeTierMinSpend adds v to the "volume_tier_min_spend" field.
+func (u *UsageLogUpsertOne) AddVolumeTierMinSpend(v float64) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddVolumeTierMinSpend(v)
+	})
+}
+
+// UpdateVolumeTierMinSpend sets the "volume_tier_min_spend" field to the value that was provided on create.
+func (u *UsageLogUpsertOne) UpdateVolumeTierMinSpend() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateVolumeTierMinSpend()
 	})
 }
 
-// UpdateCacheCreationCost sets the "cache_creation_cost" field to the value that was provided on create.
-func (u *UsageLogUpsertOne) UpdateCacheCreationCost() *UsageLogUpsertOne {
+// ClearVolumeTierMinSpend clears the value of the "volume_tier_min_spend" field.
+func (u *UsageLogUpsertOne) ClearVolumeTierMinSpend() *UsageLogUpsertOne {
 	return u.Update(func(s *UsageLogUpsert) {
-		s.UpdateCacheCreationCost()
+		s.ClearVolumeTierMinSpend()
 	})
 }
 
-// SetCacheReadCost sets the "cache_read_cost" field.
-func (u *UsageLogUpsertOne) SetCacheReadCost(v float64) *UsageLogUpsertOne {
+// SetVolumeTierMultiplier sets the "volume_tier_multiplier" field.
+func (u *UsageLogUpsertOne) SetVolumeTierMultiplier(v float64) *UsageLogUpsertOne {
 	return u.Update(func(s *UsageLogUpsert) {
-		s.SetCacheReadCost(v)
+		s.SetVolumeTierMultiplier(v)
 	})
 }
 
-// AddCacheReadCost adds v to the "cache_read_cost" field.
-func (u *UsageLogUpsertOne) AddCacheReadCost(v float64) *UsageLogUpsertOne {
+// AddVolumeTierMultiplier adds v to the "volume_tier_multiplier" field.
+func (u *UsageLogUpsertOne) AddVolumeTierMultiplier(v float64) *UsageLogUpsertOne {
 	return u.Update(func(s *UsageLogUpsert) {
-		s.AddCacheReadCost(v)
+		s.AddVolumeTierMultiplier(v)
 	})
 }
 
-// UpdateCacheReadCost sets the "cache_read_cost" field to the value that was provided on create.
-func (u *UsageLogUpsertOne) UpdateCacheReadCost() *UsageLogUpsertOne {
+// UpdateVolumeTierMultiplier sets the "volume_tier_multiplier" field to the value that was provided on create.
+func (u *UsageLogUpsertOne) UpdateVolumeTierMultiplier() *UsageLogUpsertOne {
 	return u.Update(func(s *UsageLogUpsert) {
-		s.UpdateCacheReadCost()
+		s.UpdateVolumeTierMultiplier()
 	})
 }
 
-// SetTotalCost sets the "total_cost" field.
-func (u *UsageLogUpsertOne) SetTotalCost(v float64) *UsageLogUpsertOne {
+// ClearVolumeTierMultiplier clears the value of the "volume_tier_multiplier" field.
+func (u *UsageLogUpsertOne) ClearVolumeTierMultiplier() *UsageLogUpsertOne {
 	return u.Update(func(s *UsageLogUpsert) {
-		s.SetTotalCost(v)
+		s.ClearVolumeTierMultiplier()
 	})
 }
 
-// AddTotalCost adds v to the "total_cost" field.
-func (u *UsageLogUpsertOne) AddTotalCost(v float64) *UsageLogUpsertOne {
+// SetSubscriptionCost sets the "subscription_cost" field.
+func (u *UsageLogUpsertOne) SetSubscriptionCost(v float64) *UsageLogUpsertOne {
 	return u.Update(func(s *UsageLogUpsert) {
-		s.AddTotalCost(v)
+		s.SetSubscriptionCost(v)
 	})
 }
 
-// UpdateTotalCost sets the "total_cost" field to the value that was provided on create.
-func (u *UsageLogUpsertOne) UpdateTotalCost() *UsageLogUpsertOne {
+// AddSubscriptionCost adds v to the "subscription_cost" field.
+func (u *UsageLogUpsertOne) AddSubscriptionCost(v float64) *UsageLogUpsertOne {
 	return u.Update(func(s *UsageLogUpsert) {
-		s.UpdateTotalCost()
+		s.AddSubscriptionCost(v)
 	})
 }
 
-// SetActualCost sets the "actual_cost" field.
-func (u *UsageLogUpsertOne) SetActualCost(v float64) *UsageLogUpsertOne {
+// UpdateSubscriptionCost sets the "subscription_cost" field to the value that was provided on create.
+func (u *UsageLogUpsertOne) UpdateSubscriptionCost() *UsageLogUpsertOne {
 	return u.Update(func(s *UsageLogUpsert) {
-		s.SetActualCost(v)
+		s.UpdateSubscriptionCost()
 	})
 }
 
-// AddActualCost adds v to the "actual_cost" field.
-func (u *UsageLogUpsertOne) AddActualCost(v float64) *UsageLogUpsertOne {
+// ClearSubscriptionCost clears the value of the "subscription_cost" field.
+func (u *UsageLogUpsertOne) ClearSubscriptionCost() *UsageLogUpsertOne {
 	return u.Update(func(s *UsageLogUpsert) {
-		s.AddActualCost(v)
+		s.ClearSubscriptionCost()
 	})
 }
 
-// UpdateActualCost sets the "actual_cost" field to the value that was provided on create.
-func (u *UsageLogUpsertOne) UpdateActualCost() *UsageLogUpsertOne {
+// SetOverageCost sets the "overage_cost" field.
+func (u *UsageLogUpsertOne) SetOverageCost(v float64) *UsageLogUpsertOne {
 	return u.Update(func(s *UsageLogUpsert) {
-		s.UpdateActualCost()
+		s.SetOverageCost(v)
 	})
 }
 
-// SetRateMultiplier sets the "rate_multiplier" field.
-func (u *UsageLogUpsertOne) SetRateMultiplier(v float64) *UsageLogUpsertOne {
+// AddOverageCost adds v to the "overage_cost" field.
+func (u *UsageLogUpsertOne) AddOverageCost(v float64) *UsageLogUpsertOne {
 	return u.Update(func(s *UsageLogUpsert) {
-		s.SetRateMultiplier(v)
+		s.AddOverageCost(v)
 	})
 }
 
-// AddRateMultiplier adds v to the "rate_multiplier" field.
-func (u *UsageLogUpsertOne) AddRateMultiplier(v float64) *UsageLogUpsertOne {
+// UpdateOverageCost sets the "overage_cost" field to the value that was provided on create.
+func (u *UsageLogUpsertOne) UpdateOverageCost() *UsageLogUpsertOne {
 	return u.Update(func(s *UsageLogUpsert) {
-		s.AddRateMultiplier(v)
+		s.UpdateOverageCost()
 	})
 }
 
-// UpdateRateMultiplier sets the "rate_multiplier" field to the value that was provided on create.
-func (u *UsageLogUpsertOne) UpdateRateMultiplier() *UsageLogUpsertOne {
+// ClearOverageCost clears the value of the "overage_cost" field.
+func (u *UsageLogUpsertOne) ClearOverageCost() *UsageLogUpsertOne {
 	return u.Update(func(s *UsageLogUpsert) {
-		s.UpdateRateMultiplier()
+		s.ClearOverageCost()
 	})
 }
 
@@ -2363,6 +3329,111 @@ func (u *UsageLogUpsertBulk) ClearSubscriptionID() *UsageLogUpsertBulk {
 	})
 }
 
+// SetOriginalGroupID sets the "original_group_id" field.
+func (u *UsageLogUpsertBulk) SetOriginalGroupID(v int64) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetOriginalGroupID(v)
+	})
+}
+
+// AddOriginalGroupID adds v to the "original_group_id" field.
+func (u *UsageLogUpsertBulk) AddOriginalGroupID(v int64) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddOriginalGroupID(v)
+	})
+}
+
+// UpdateOriginalGroupID sets the "original_group_id" field to the value that was provided on create.
+func (u *UsageLogUpsertBulk) UpdateOriginalGroupID() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateOriginalGroupID()
+	})
+}
+
+// ClearOriginalGroupID clears the value of the "original_group_id" field.
+func (u *UsageLogUpsertBulk) ClearOriginalGroupID() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearOriginalGroupID()
+	})
+}
+
+// SetFallbackHop sets the "fallback_hop" field.
+func (u *UsageLogUpsertBulk) SetFallbackHop(v int) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetFallbackHop(v)
+	})
+}
+
+// AddFallbackHop adds v to the "fallback_hop" field.
+func (u *UsageLogUpsertBulk) AddFallbackHop(v int) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddFallbackHop(v)
+	})
+}
+
+// UpdateFallbackHop sets the "fallback_hop" field to the value that was provided on create.
+func (u *UsageLogUpsertBulk) UpdateFallbackHop() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateFallbackHop()
+	})
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (u *UsageLogUpsertBulk) SetOrganizationID(v int64) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetOrganizationID(v)
+	})
+}
+
+// AddOrganizationID adds v to the "organization_id" field.
+func (u *UsageLogUpsertBulk) AddOrganizationID(v int64) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddOrganizationID(v)
+	})
+}
+
+// UpdateOrganizationID sets the "organization_id" field to the value that was provided on create.
+func (u *UsageLogUpsertBulk) UpdateOrganizationID() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateOrganizationID()
+	})
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (u *UsageLogUpsertBulk) ClearOrganizationID() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearOrganizationID()
+	})
+}
+
+// SetBillingUserID sets the "billing_user_id" field.
+func (u *UsageLogUpsertBulk) SetBillingUserID(v int64) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetBillingUserID(v)
+	})
+}
+
+// AddBillingUserID adds v to the "billing_user_id" field.
+func (u *UsageLogUpsertBulk) AddBillingUserID(v int64) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddBillingUserID(v)
+	})
+}
+
+// UpdateBillingUserID sets the "billing_user_id" field to the value that was provided on create.
+func (u *UsageLogUpsertBulk) UpdateBillingUserID() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateBillingUserID()
+	})
+}
+
+// ClearBillingUserID clears the value of the "billing_user_id" field.
+func (u *UsageLogUpsertBulk) ClearBillingUserID() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearBillingUserID()
+	})
+}
+
 // SetInputTokens sets the "input_tokens" field.
 func (u *UsageLogUpsertBulk) SetInputTokens(v int) *UsageLogUpsertBulk {
 	return u.Update(func(s *UsageLogUpsert) {
@@ -2636,6 +3707,279 @@ func (u *UsageLogUpsertBulk) UpdateRateMultiplier() *UsageLogUpsertBulk {
 	})
 }
 
+// SetPricingVersion sets the "pricing_version" field.
+func (u *UsageLogUpsertBulk) SetPricingVersion(v string) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetPricingVersion(v)
+	})
+}
+
+// UpdatePricingVersion sets the "pricing_version" field to the value that was provided on create.
+func (u *UsageLogUpsertBulk) UpdatePricingVersion() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdatePricingVersion()
+	})
+}
+
+// ClearPricingVersion clears the value of the "pricing_version" field.
+func (u *UsageLogUpsertBulk) ClearPricingVersion() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearPricingVersion()
+	})
+}
+
+// SetInputUnitPrice sets the "input_unit_price" field.
+func (u *UsageLogUpsertBulk) SetInputUnitPrice(v float64) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetInputUnitPrice(v)
+	})
+}
+
+// AddInputUnitPrice adds v to the "input_unit_price" field.
+func (u *UsageLogUpsertBulk) AddInputUnitPrice(v float64) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddInputUnitPrice(v)
+	})
+}
+
+// UpdateInputUnitPrice sets the "input_unit_price" field to the value that was provided on create.
+func (u *UsageLogUpsertBulk) UpdateInputUnitPrice() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateInputUnitPrice()
+	})
+}
+
+// ClearInputUnitPrice clears the value of the "input_unit_price" field.
+func (u *UsageLogUpsertBulk) ClearInputUnitPrice() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearInputUnitPrice()
+	})
+}
+
+// SetOutputUnitPrice sets the "output_unit_price" field.
+func (u *UsageLogUpsertBulk) SetOutputUnitPrice(v float64) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetOutputUnitPrice(v)
+	})
+}
+
+// AddOutputUnitPrice adds v to the "output_unit_price" field.
+func (u *UsageLogUpsertBulk) AddOutputUnitPrice(v float64) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddOutputUnitPrice(v)
+	})
+}
+
+// UpdateOutputUnitPrice sets the "output_unit_price" field to the value that was provided on create.
+func (u *UsageLogUpsertBulk) UpdateOutputUnitPrice() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateOutputUnitPrice()
+	})
+}
+
+// ClearOutputUnitPrice clears the value of the "output_unit_price" field.
+func (u *UsageLogUpsertBulk) ClearOutputUnitPrice() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearOutputUnitPrice()
+	})
+}
+
+// SetCacheCreationUnitPrice sets the "cache_creation_unit_price" field.
+func (u *UsageLogUpsertBulk) SetCacheCreationUnitPrice(v float64) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetCacheCreationUnitPrice(v)
+	})
+}
+
+// AddCacheCreationUnitPrice adds v to the "cache_creation_unit_price" field.
+func (u *UsageLogUpsertBulk) AddCacheCreationUnitPrice(v float64) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddCacheCreationUnitPrice(v)
+	})
+}
+
+// UpdateCacheCreationUnitPrice sets the "cache_creation_unit_price" field to the value that was provided on create.
+func (u *UsageLogUpsertBulk) UpdateCacheCreationUnitPrice() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateCacheCreationUnitPrice()
+	})
+}
+
+// ClearCacheCreationUnitPrice clears the value of the "cache_creation_unit_price" field.
+func (u *UsageLogUpsertBulk) ClearCacheCreationUnitPrice() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearCacheCreationUnitPrice()
+	})
+}
+
+// SetCacheReadUnitPrice sets the "cache_read_unit_price" field.
+func (u *UsageLogUpsertBulk) SetCacheReadUnitPrice(v float64) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetCacheReadUnitPrice(v)
+	})
+}
+
+// AddCacheReadUnitPrice adds v to the "cache_read_unit_price" field.
+func (u *UsageLogUpsertBulk) AddCacheReadUnitPrice(v float64) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddCacheReadUnitPrice(v)
+	})
+}
+
+// UpdateCacheReadUnitPrice sets the "cache_read_unit_price" field to the value that was provided on create.
+func (u *UsageLogUpsertBulk) UpdateCacheReadUnitPrice() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateCacheReadUnitPrice()
+	})
+}
+
+// ClearCacheReadUnitPrice clears the value of the "cache_read_unit_price" field.
+func (u *UsageLogUpsertBulk) ClearCacheReadUnitPrice() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearCacheReadUnitPrice()
+	})
+}
+
+// SetImageUnitPrice sets the "image_unit_price" field.
+func (u *UsageLogUpsertBulk) SetImageUnitPrice(v float64) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetImageUnitPrice(v)
+	})
+}
+
+// AddImageUnitPrice adds v to the "image_unit_price" field.
+func (u *UsageLogUpsertBulk) AddImageUnitPrice(v float64) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddImageUnitPrice(v)
+	})
+}
+
+// UpdateImageUnitPrice sets the "image_unit_price" field to the value that was provided on create.
+func (u *UsageLogUpsertBulk) UpdateImageUnitPrice() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateImageUnitPrice()
+	})
+}
+
+// ClearImageUnitPrice clears the value of the "image_unit_price" field.
+func (u *UsageLogUpsertBulk) ClearImageUnitPrice() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearImageUnitPrice()
+	})
+}
+
+// SetVolumeTierMinSpend sets the "volume_tier_min_spend" field.
+func (u *UsageLogUpsertBulk) SetVolumeTierMinSpend(v float64) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetVolumeTierMinSpend(v)
+	})
+}
+
+// AddVolumeTierMinSpend adds v to the "volume_tier_min_spend" field.
+func (u *UsageLogUpsertBulk) AddVolumeTierMinSpend(v float64) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddVolumeTierMinSpend(v)
+	})
+}
+
+// UpdateVolumeTierMinSpend sets the "volume_tier_min_spend" field to the value that was provided on create.
+func (u *UsageLogUpsertBulk) UpdateVolumeTierMinSpend() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateVolumeTierMinSpend()
+	})
+}
+
+// ClearVolumeTierMinSpend clears the value of the "volume_tier_min_spend" field.
+func (u *UsageLogUpsertBulk) ClearVolumeTierMinSpend() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearVolumeTierMinSpend()
+	})
+}
+
+// SetVolumeTierMultiplier sets the "volume_tier_multiplier" field.
+func (u *UsageLogUpsertBulk) SetVolumeTierMultiplier(v float64) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetVolumeTierMultiplier(v)
+	})
+}
+
+// AddVolumeTierMultiplier adds v to the "volume_tier_multiplier" field.
+func (u *UsageLogUpsertBulk) AddVolumeTierMultiplier(v float64) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddVolumeTierMultiplier(v)
+	})
+}
+
+// UpdateVolumeTierMultiplier sets the "volume_tier_multiplier" field to the value that was provided on create.
+func (u *UsageLogUpsertBulk) UpdateVolumeTierMultiplier() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateVolumeTierMultiplier()
+	})
+}
+
+// ClearVolumeTierMultiplier clears the value of the "volume_tier_multiplier" field.
+func (u *UsageLogUpsertBulk) ClearVolumeTierMultiplier() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearVolumeTierMultiplier()
+	})
+}
+
+// SetSubscriptionCost sets the "subscription_cost" field.
+func (u *UsageLogUpsertBulk) SetSubscriptionCost(v float64) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetSubscriptionCost(v)
+	})
+}
+
+// AddSubscriptionCost adds v to the "subscription_cost" field.
+func (u *UsageLogUpsertBulk) AddSubscriptionCost(v float64) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddSubscriptionCost(v)
+	})
+}
+
+// UpdateSubscriptionCost sets the "subscription_cost" field to the value that was provided on create.
+func (u *UsageLogUpsertBulk) UpdateSubscriptionCost() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateSubscriptionCost()
+	})
+}
+
+// ClearSubscriptionCost clears the value of the "subscription_cost" field.
+func (u *UsageLogUpsertBulk) ClearSubscriptionCost() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearSubscriptionCost()
+	})
+}
+
+// SetOverageCost sets the "overage_cost" field.
+func (u *UsageLogUpsertBulk) SetOverageCost(v float64) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetOverageCost(v)
+	})
+}
+
+// AddOverageCost adds v to the "overage_cost" field.
+func (u *UsageLogUpsertBulk) AddOverageCost(v float64) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddOverageCost(v)
+	})
+}
+
+// UpdateOverageCost sets the "overage_cost" field to the value that was provided on create.
+func (u *UsageLogUpsertBulk) UpdateOverageCost() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateOverageCost()
+	})
+}
+
+// ClearOverageCost clears the value of the "overage_cost" field.
+func (u *UsageLogUpsertBulk) ClearOverageCost() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearOverageCost()
+	})
+}
+
 // SetAccountRateMultiplier sets the "account_rate_multiplier" field.
 func (u *UsageLogUpsertBulk) SetAccountRateMultiplier(v float64) *UsageLogUpsertBulk {
 	return u.Update(func(s *UsageLogUpsert) {
@@ -142,6 +142,108 @@ func (_u *UsageLogUpdate) ClearSubscriptionID() *UsageLogUpdate {
 	return _u
 }
 
+// SetOriginalGroupID sets the "original_group_id" field.
+func (_u *UsageLogUpdate) SetOriginalGroupID(v int64) *UsageLogUpdate {
+	_u.mutation.ResetOriginalGroupID()
+	_u.mutation.SetOriginalGroupID(v)
+	return _u
+}
+
+// SetNillableOriginalGroupID sets the "original_group_id" field if the given value is not nil.
+func (_u *UsageLogUpdate) SetNillableOriginalGroupID(v *int64) *UsageLogUpdate {
+	if v != nil {
+		_u.SetOriginalGroupID(*v)
+	}
+	return _u
+}
+
+// AddOriginalGroupID adds value to the "original_group_id" field.
+func (_u *UsageLogUpdate) AddOriginalGroupID(v int64) *UsageLogUpdate {
+	_u.mutation.AddOriginalGroupID(v)
+	return _u
+}
+
+// ClearOriginalGroupID clears the value of the "original_group_id" field.
+func (_u *UsageLogUpdate) ClearOriginalGroupID() *UsageLogUpdate {
+	_u.mutation.ClearOriginalGroupID()
+	return _u
+}
+
+// SetFallbackHop sets the "fallback_hop" field.
+func (_u *UsageLogUpdate) SetFallbackHop(v int) *UsageLogUpdate {
+	_u.mutation.ResetFallbackHop()
+	_u.mutation.SetFallbackHop(v)
+	return _u
+}
+
+// SetNillableFallbackHop sets the "fallback_hop" field if the given value is not nil.
+func (_u *UsageLogUpdate) SetNillableFallbackHop(v *int) *UsageLogUpdate {
+	if v != nil {
+		_u.SetFallbackHop(*v)
+	}
+	return _u
+}
+
+// AddFallbackHop adds value to the "fallback_hop" field.
+func (_u *UsageLogUpdate) AddFallbackHop(v int) *UsageLogUpdate {
+	_u.mutation.AddFallbackHop(v)
+	return _u
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_u *UsageLogUpdate) SetOrganizationID(v int64) *UsageLogUpdate {
+	_u.mutation.ResetOrganizationID()
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *UsageLogUpdate) SetNillableOrganizationID(v *int64) *UsageLogUpdate {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// AddOrganizationID adds value to the "organization_id" field.
+func (_u *UsageLogUpdate) AddOrganizationID(v int64) *UsageLogUpdate {
+	_u.mutation.AddOrganizationID(v)
+	return _u
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (_u *UsageLogUpdate) ClearOrganizationID() *UsageLogUpdate {
+	_u.mutation.ClearOrganizationID()
+	return _u
+}
+
+// SetBillingUserID sets the "billing_user_id" field.
+func (_u *UsageLogUpdate) SetBillingUserID(v int64) *UsageLogUpdate {
+	_u.mutation.ResetBillingUserID()
+	_u.mutation.SetBillingUserID(v)
+	return _u
+}
+
+// SetNillableBillingUserID sets the "billing_user_id" field if the given value is not nil.
+func (_u *UsageLogUpdate) SetNillableBillingUserID(v *int64) *UsageLogUpdate {
+	if v != nil {
+		_u.SetBillingUserID(*v)
+	}
+	return _u
+}
+
+// AddBillingUserID adds value to the "billing_user_id" field.
+func (_u *UsageLogUpdate) AddBillingUserID(v int64) *UsageLogUpdate {
+	_u.mutation.AddBillingUserID(v)
+	return _u
+}
+
+// ClearBillingUserID clears the value of the "billing_user_id" field.
+func (_u *UsageLogUpdate) ClearBillingUserID() *UsageLogUpdate {
+	_u.mutation.ClearBillingUserID()
+	return _u
+}
+
 // SetInputTokens sets the "input_tokens" field.
 func (_u *UsageLogUpdate) SetInputTokens(v int) *UsageLogUpdate {
 	_u.mutation.ResetInputTokens()
@@ -415,6 +517,269 @@ func (_u *UsageLogUpdate) AddRateMultiplier(v float64) *UsageLogUpdate {
 	return _u
 }
 
+// SetPricingVersion sets the "pricing_version" field.
+func (_u *UsageLogUpdate) SetPricingVersion(v string) *UsageLogUpdate {
+	_u.mutation.SetPricingVersion(v)
+	return _u
+}
+
+// SetNillablePricingVersion sets the "pricing_version" field if the given value is not nil.
+func (_u *UsageLogUpdate) SetNillablePricingVersion(v *string) *UsageLogUpdate {
+	if v != nil {
+		_u.SetPricingVersion(*v)
+	}
+	return _u
+}
+
+// ClearPricingVersion clears the value of the "pricing_version" field.
+func (_u *UsageLogUpdate) ClearPricingVersion() *UsageLogUpdate {
+	_u.mutation.ClearPricingVersion()
+	return _u
+}
+
+// SetInputUnitPrice sets the "input_unit_price" field.
+func (_u *UsageLogUpdate) SetInputUnitPrice(v float64) *UsageLogUpdate {
+	_u.mutation.ResetInputUnitPrice()
+	_u.mutation.SetInputUnitPrice(v)
+	return _u
+}
+
+// SetNillableInputUnitPrice sets the "input_unit_price" field if the given value is not nil.
+func (_u *UsageLogUpdate) SetNillableInputUnitPrice(v *float64) *UsageLogUpdate {
+	if v != nil {
+		_u.SetInputUnitPrice(*v)
+	}
+	return _u
+}
+
+// AddInputUnitPrice adds value to the "input_unit_price" field.
+func (_u *UsageLogUpdate) AddInputUnitPrice(v float64) *UsageLogUpdate {
+	_u.mutation.AddInputUnitPrice(v)
+	return _u
+}
+
+// ClearInputUnitPrice clears the value of the "input_unit_price" field.
+func (_u *UsageLogUpdate) ClearInputUnitPrice() *UsageLogUpdate {
+	_u.mutation.ClearInputUnitPrice()
+	return _u
+}
+
+// SetOutputUnitPrice sets the "output_unit_price" field.
+func (_u *UsageLogUpdate) SetOutputUnitPrice(v float64) *UsageLogUpdate {
+	_u.mutation.ResetOutputUnitPrice()
+	_u.mutation.SetOutputUnitPrice(v)
+	return _u
+}
+
+// SetNillableOutputUnitPrice sets the "output_unit_price" field if the given value is not nil.
+func (_u *UsageLogUpdate) SetNillableOutputUnitPrice(v *float64) *UsageLogUpdate {
+	if v != nil {
+		_u.SetOutputUnitPrice(*v)
+	}
+	return _u
+}
+
+// AddOutputUnitPrice adds value to the "output_unit_price" field.
+func (_u *UsageLogUpdate) AddOutputUnitPrice(v float64) *UsageLogUpdate {
+	_u.mutation.AddOutputUnitPrice(v)
+	return _u
+}
+
+// ClearOutputUnitPrice clears the value of the "output_unit_price" field.
+func (_u *UsageLogUpdate) ClearOutputUnitPrice() *UsageLogUpdate {
+	_u.mutation.ClearOutputUnitPrice()
+	return _u
+}
+
+// SetCacheCreationUnitPrice sets the "cache_creation_unit_price" field.
+func (_u *UsageLogUpdate) SetCacheCreationUnitPrice(v float64) *UsageLogUpdate {
+	_u.mutation.ResetCacheCreationUnitPrice()
+	_u.mutation.SetCacheCreationUnitPrice(v)
+	return _u
+}
+
+// SetNillableCacheCreationUnitPrice sets the "cache_creation_unit_price" field if the given value is not nil.
+func (_u *UsageLogUpdate) SetNillableCacheCreationUnitPrice(v *float64) *UsageLogUpdate {
+	if v != nil {
+		_u.SetCacheCreationUnitPrice(*v)
+	}
+	return _u
+}
+
+// AddCacheCreationUnitPrice adds value to the "cache_creation_unit_price" field.
+func (_u *UsageLogUpdate) AddCacheCreationUnitPrice(v float64) *UsageLogUpdate {
+	_u.mutation.AddCacheCreationUnitPrice(v)
+	return _u
+}
+
+// ClearCacheCreationUnitPrice clears the value of the "cache_creation_unit_price" field.
+func (_u *UsageLogUpdate) ClearCacheCreationUnitPrice() *UsageLogUpdate {
+	_u.mutation.ClearCacheCreationUnitPrice()
+	return _u
+}
+
+// SetCacheReadUnitPrice sets the "cache_read_unit_price" field.
+func (_u *UsageLogUpdate) SetCacheReadUnitPrice(v float64) *UsageLogUpdate {
+	_u.mutation.ResetCacheReadUnitPrice()
+	_u.mutation.SetCacheReadUnitPrice(v)
+	return _u
+}
+
+// SetNillableCacheReadUnitPrice sets the "cache_read_unit_price" field if the given value is not nil.
+func (_u *UsageLogUpdate) SetNillableCacheReadUnitPrice(v *float64) *UsageLogUpdate {
+	if v != nil {
+		_u.SetCacheReadUnitPrice(*v)
+	}
+	return _u
+}
+
+// AddCacheReadUnitPrice adds value to the "cache_read_unit_price" field.
+func (_u *UsageLogUpdate) AddCacheReadUnitPrice(v float64) *UsageLogUpdate {
+	_u.mutation.AddCacheReadUnitPrice(v)
+	return _u
+}
+
+// ClearCacheReadUnitPrice clears the value of the "cache_read_unit_price" field.
+func (_u *UsageLogUpdate) ClearCacheReadUnitPrice() *UsageLogUpdate {
+	_u.mutation.ClearCacheReadUnitPrice()
+	return _u
+}
+
+// SetImageUnitPrice sets the "image_unit_price" field.
+func (_u *UsageLogUpdate) SetImageUnitPrice(v float64) *UsageLogUpdate {
+	_u.mutation.ResetImageUnitPrice()
+	_u.mutation.SetImageUnitPrice(v)
+	return _u
+}
+
+// SetNillableImageUnitPrice sets the "image_unit_price" field if the given value is not nil.
+func (_u *UsageLogUpdate) SetNillableImageUnitPrice(v *float64) *UsageLogUpdate {
+	if v != nil {
+		_u.SetImageUnitPrice(*v)
+	}
+	return _u
+}
+
+// AddImageUnitPrice adds value to the "image_unit_price" field.
+func (_u *UsageLogUpdate) AddImageUnitPrice(v float64) *UsageLogUpdate {
+	_u.mutation.AddImageUnitPrice(v)
+	return _u
+}
+
+// ClearImageUnitPrice clears the value of the "image_unit_price" field.
+func (_u *UsageLogUpdate) ClearImageUnitPrice() *UsageLogUpdate {
+	_u.mutation.ClearImageUnitPrice()
+	return _u
+}
+
+// SetVolumeTierMinSpend sets the "volume_tier_min_spend" field.
+func (_u *UsageLogUpdate) SetVolumeTierMinSpend(v float64) *UsageLogUpdate {
+	_u.mutation.ResetVolumeTierMinSpend()
+	_u.mutation.SetVolumeTierMinSpend(v)
+	return _u
+}
+
+// SetNillableVolumeTierMinSpend sets the "volume_tier_min_spend" field if the given value is not nil.
+func (_u *UsageLogUpdate) SetNillableVolumeTierMinSpend(v *float64) *UsageLogUpdate {
+	if v != nil {
+		_u.SetVolumeTierMinSpend(*v)
+	}
+	return _u
+}
+
+// AddVolumeTierMinSpend adds value to the "volume_tier_min_spend" field.
+func (_u *UsageLogUpdate) AddVolumeTierMinSpend(v float64) *UsageLogUpdate {
+	_u.mutation.AddVolumeTierMinSpend(v)
+	return _u
+}
+
+// ClearVolumeTierMinSpend clears the value of the "volume_tier_min_spend" field.
+func (_u *UsageLogUpdate) ClearVolumeTierMinSpend() *UsageLogUpdate {
+	_u.mutation.ClearVolumeTierMinSpend()
+	return _u
+}
+
+// SetVolumeTierMultiplier sets the "volume_tier_multiplier" field.
+func (_u *UsageLogUpdate) SetVolumeTierMultiplier(v float64) *UsageLogUpdate {
+	_u.mutation.ResetVolumeTierMultiplier()
+	_u.mutation.SetVolumeTierMultiplier(v)
+	return _u
+}
+
+// SetNillableVolumeTierMultiplier sets the "volume_tier_multiplier" field if the given value is not nil.
+func (_u *UsageLogUpdate) SetNillableVolumeTierMultiplier(v *float64) *UsageLogUpdate {
+	if v != nil {
+		_u.SetVolumeTierMultiplier(*v)
+	}
+	return _u
+}
+
+// AddVolumeTierMultiplier adds value to the "volume_tier_multiplier" field.
+func (_u *UsageLogUpdate) AddVolumeTierMultiplier(v float64) *UsageLogUpdate {
+	_u.mutation.AddVolumeTierMultiplier(v)
+	return _u
+}
+
+// ClearVolumeTierMultiplier clears the value of the "volume_tier_multiplier" field.
+func (_u *UsageLogUpdate) ClearVolumeTierMultiplier() *UsageLogUpdate {
+	_u.mutation.ClearVolumeTierMultiplier()
+	return _u
+}
+
+// SetSubscriptionCost sets the "subscription_cost" field.
+func (_u *UsageLogUpdate) SetSubscriptionCost(v float64) *UsageLogUpdate {
+	_u.mutation.ResetSubscriptionCost()
+	_u.mutation.SetSubscriptionCost(v)
+	return _u
+}
+
+// SetNillableSubscriptionCost sets the "subscription_cost" field if the given value is not nil.
+func (_u *UsageLogUpdate) SetNillableSubscriptionCost(v *float64) *UsageLogUpdate {
+	if v != nil {
+		_u.SetSubscriptionCost(*v)
+	}
+	return _u
+}
+
+// AddSubscriptionCost adds value to the "subscription_cost" field.
+func (_u *UsageLogUpdate) AddSubscriptionCost(v float64) *UsageLogUpdate {
+	_u.mutation.AddSubscriptionCost(v)
+	return _u
+}
+
+// ClearSubscriptionCost clears the value of the "subscription_cost" field.
+func (_u *UsageLogUpdate) ClearSubscriptionCost() *UsageLogUpdate {
+	_u.mutation.ClearSubscriptionCost()
+	return _u
+}
+
+// SetOverageCost sets the "overage_cost" field.
+func (_u *UsageLogUpdate) SetOverageCost(v float64) *UsageLogUpdate {
+	_u.mutation.ResetOverageCost()
+	_u.mutation.SetOverageCost(v)
+	return _u
+}
+
+// SetNillableOverageCost sets the "overage_cost" field if the given value is not nil.
+func (_u *UsageLogUpdate) SetNillableOverageCost(v *float64) *UsageLogUpdate {
+	if v != nil {
+		_u.SetOverageCost(*v)
+	}
+	return _u
+}
+
+// AddOverageCost adds value to the "overage_cost" field.
+func (_u *UsageLogUpdate) AddOverageCost(v float64) *UsageLogUpdate {
+	_u.mutation.AddOverageCost(v)
+	return _u
+}
+
+// ClearOverageCost clears the value of the "overage_cost" field.
+func (_u *UsageLogUpdate) ClearOverageCost() *UsageLogUpdate {
+	_u.mutation.ClearOverageCost()
+	return _u
+}
+
 // SetAccountRateMultiplier sets the "account_rate_multiplier" field.
 func (_u *UsageLogUpdate) SetAccountRateMultiplier(v float64) *UsageLogUpdate {
 	_u.mutation.ResetAccountRateMultiplier()
@@ -711,6 +1076,11 @@ func (_u *UsageLogUpdate) check() error {
 			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "UsageLog.model": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.PricingVersion(); ok {
+		if err := usagelog.PricingVersionValidator(v); err != nil {
+			return &ValidationError{Name: "pricing_version", err: fmt.Errorf(`ent: validator failed for field "UsageLog.pricing_version": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.UserAgent(); ok {
 		if err := usagelog.UserAgentValidator(v); err != nil {
 			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "UsageLog.user_agent": %w`, err)}
@@ -756,6 +1126,39 @@ func (_u *UsageLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Model(); ok {
 		_spec.SetField(usagelog.FieldModel, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.OriginalGroupID(); ok {
+		_spec.SetField(usagelog.FieldOriginalGroupID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOriginalGroupID(); ok {
+		_spec.AddField(usagelog.FieldOriginalGroupID, field.TypeInt64, value)
+	}
+	if _u.mutation.OriginalGroupIDCleared() {
+		_spec.ClearField(usagelog.FieldOriginalGroupID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.FallbackHop(); ok {
+		_spec.SetField(usagelog.FieldFallbackHop, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFallbackHop(); ok {
+		_spec.AddField(usagelog.FieldFallbackHop, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OrganizationID(); ok {
+		_spec.SetField(usagelog.FieldOrganizationID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOrganizationID(); ok {
+		_spec.AddField(usagelog.FieldOrganizationID, field.TypeInt64, value)
+	}
+	if _u.mutation.OrganizationIDCleared() {
+		_spec.ClearField(usagelog.FieldOrganizationID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.BillingUserID(); ok {
+		_spec.SetField(usagelog.FieldBillingUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedBillingUserID(); ok {
+		_spec.AddField(usagelog.FieldBillingUserID, field.TypeInt64, value)
+	}
+	if _u.mutation.BillingUserIDCleared() {
+		_spec.ClearField(usagelog.FieldBillingUserID, field.TypeInt64)
+	}
 	if value, ok := _u.mutation.InputTokens(); ok {
 		_spec.SetField(usagelog.FieldInputTokens, field.TypeInt, value)
 	}
@@ -834,6 +1237,93 @@ func (_u *UsageLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedRateMultiplier(); ok {
 		_spec.AddField(usagelog.FieldRateMultiplier, field.TypeFloat64, value)
 	}
+	if value, ok := _u.mutation.PricingVersion(); ok {
+		_spec.SetField(usagelog.FieldPricingVersion, field.TypeString, value)
+	}
+	if _u.mutation.PricingVersionCleared() {
+		_spec.ClearField(usagelog.FieldPricingVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.InputUnitPrice(); ok {
+		_spec.SetField(usagelog.FieldInputUnitPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedInputUnitPrice(); ok {
+		_spec.AddField(usagelog.FieldInputUnitPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.InputUnitPriceCleared() {
+		_spec.ClearField(usagelog.FieldInputUnitPrice, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.OutputUnitPrice(); ok {
+		_spec.SetField(usagelog.FieldOutputUnitPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOutputUnitPrice(); ok {
+		_spec.AddField(usagelog.FieldOutputUnitPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.OutputUnitPriceCleared() {
+		_spec.ClearField(usagelog.FieldOutputUnitPrice, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CacheCreationUnitPrice(); ok {
+		_spec.SetField(usagelog.FieldCacheCreationUnitPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCacheCreationUnitPrice(); ok {
+		_spec.AddField(usagelog.FieldCacheCreationUnitPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.CacheCreationUnitPriceCleared() {
+		_spec.ClearField(usagelog.FieldCacheCreationUnitPrice, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CacheReadUnitPrice(); ok {
+		_spec.SetField(usagelog.FieldCacheReadUnitPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCacheReadUnitPrice(); ok {
+		_spec.AddField(usagelog.FieldCacheReadUnitPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.CacheReadUnitPriceCleared() {
+		_spec.ClearField(usagelog.FieldCacheReadUnitPrice, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.ImageUnitPrice(); ok {
+		_spec.SetField(usagelog.FieldImageUnitPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedImageUnitPrice(); ok {
+		_spec.AddField(usagelog.FieldImageUnitPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.ImageUnitPriceCleared() {
+		_spec.ClearField(usagelog.FieldImageUnitPrice, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.VolumeTierMinSpend(); ok {
+		_spec.SetField(usagelog.FieldVolumeTierMinSpend, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedVolumeTierMinSpend(); ok {
+		_spec.AddField(usagelog.FieldVolumeTierMinSpend, field.TypeFloat64, value)
+	}
+	if _u.mutation.VolumeTierMinSpendCleared() {
+		_spec.ClearField(usagelog.FieldVolumeTierMinSpend, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.VolumeTierMultiplier(); ok {
+		_spec.SetField(usagelog.FieldVolumeTierMultiplier, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedVolumeTierMultiplier(); ok {
+		_spec.AddField(usagelog.FieldVolumeTierMultiplier, field.TypeFloat64, value)
+	}
+	if _u.mutation.VolumeTierMultiplierCleared() {
+		_spec.ClearField(usagelog.FieldVolumeTierMultiplier, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.SubscriptionCost(); ok {
+		_spec.SetField(usagelog.FieldSubscriptionCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSubscriptionCost(); ok {
+		_spec.AddField(usagelog.FieldSubscriptionCost, field.TypeFloat64, value)
+	}
+	if _u.mutation.SubscriptionCostCleared() {
+		_spec.ClearField(usagelog.FieldSubscriptionCost, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.OverageCost(); ok {
+		_spec.SetField(usagelog.FieldOverageCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOverageCost(); ok {
+		_spec.AddField(usagelog.FieldOverageCost, field.TypeFloat64, value)
+	}
+	if _u.mutation.OverageCostCleared() {
+		_spec.ClearField(usagelog.FieldOverageCost, field.TypeFloat64)
+	}
 	if value, ok := _u.mutation.AccountRateMultiplier(); ok {
 		_spec.SetField(usagelog.FieldAccountRateMultiplier, field.TypeFloat64, value)
 	}
@@ -1169,6 +1659,108 @@ func (_u *UsageLogUpdateOne) ClearSubscriptionID() *UsageLogUpdateOne {
 	return _u
 }
 
+// SetOriginalGroupID sets the "original_group_id" field.
+func (_u *UsageLogUpdateOne) SetOriginalGroupID(v int64) *UsageLogUpdateOne {
+	_u.mutation.ResetOriginalGroupID()
+	_u.mutation.SetOriginalGroupID(v)
+	return _u
+}
+
+// SetNillableOriginalGroupID sets the "original_group_id" field if the given value is not nil.
+func (_u *UsageLogUpdateOne) SetNillableOriginalGroupID(v *int64) *UsageLogUpdateOne {
+	if v != nil {
+		_u.SetOriginalGroupID(*v)
+	}
+	return _u
+}
+
+// AddOriginalGroupID adds value to the "original_group_id" field.
+func (_u *UsageLogUpdateOne) AddOriginalGroupID(v int64) *UsageLogUpdateOne {
+	_u.mutation.AddOriginalGroupID(v)
+	return _u
+}
+
+// ClearOriginalGroupID clears the value of the "original_group_id" field.
+func (_u *UsageLogUpdateOne) ClearOriginalGroupID() *UsageLogUpdateOne {
+	_u.mutation.ClearOriginalGroupID()
+	return _u
+}
+
+// SetFallbackHop sets the "fallback_hop" field.
+func (_u *UsageLogUpdateOne) SetFallbackHop(v int) *UsageLogUpdateOne {
+	_u.mutation.ResetFallbackHop()
+	_u.mutation.SetFallbackHop(v)
+	return _u
+}
+
+// SetNillableFallbackHop sets the "fallback_hop" field if the given value is not nil.
+func (_u *UsageLogUpdateOne) SetNillableFallbackHop(v *int) *UsageLogUpdateOne {
+	if v != nil {
+		_u.SetFallbackHop(*v)
+	}
+	return _u
+}
+
+// AddFallbackHop adds value to the "fallback_hop" field.
+func (_u *UsageLogUpdateOne) AddFallbackHop(v int) *UsageLogUpdateOne {
+	_u.mutation.AddFallbackHop(v)
+	return _u
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_u *UsageLogUpdateOne) SetOrganizationID(v int64) *UsageLogUpdateOne {
+	_u.mutation.ResetOrganizationID()
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *UsageLogUpdateOne) SetNillableOrganizationID(v *int64) *UsageLogUpdateOne {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// AddOrganizationID adds value to the "organization_id" field.
+func (_u *UsageLogUpdateOne) AddOrganizationID(v int64) *UsageLogUpdateOne {
+	_u.mutation.AddOrganizationID(v)
+	return _u
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (_u *UsageLogUpdateOne) ClearOrganizationID() *UsageLogUpdateOne {
+	_u.mutation.ClearOrganizationID()
+	return _u
+}
+
+// SetBillingUserID sets the "billing_user_id" field.
+func (_u *UsageLogUpdateOne) SetBillingUserID(v int64) *UsageLogUpdateOne {
+	_u.mutation.ResetBillingUserID()
+	_u.mutation.SetBillingUserID(v)
+	return _u
+}
+
+// SetNillableBillingUserID sets the "billing_user_id" field if the given value is not nil.
+func (_u *UsageLogUpdateOne) SetNillableBillingUserID(v *int64) *UsageLogUpdateOne {
+	if v != nil {
+		_u.SetBillingUserID(*v)
+	}
+	return _u
+}
+
+// AddBillingUserID adds value to the "billing_user_id" field.
+func (_u *UsageLogUpdateOne) AddBillingUserID(v int64) *UsageLogUpdateOne {
+	_u.mutation.AddBillingUserID(v)
+	return _u
+}
+
+// ClearBillingUserID clears the value of the "billing_user_id" field.
+func (_u *UsageLogUpdateOne) ClearBillingUserID() *UsageLogUpdateOne {
+	_u.mutation.ClearBillingUserID()
+	return _u
+}
+
 // SetInputTokens sets the "input_tokens" field.
 func (_u *UsageLogUpdateOne) SetInputTokens(v int) *UsageLogUpdateOne {
 	_u.mutation.ResetInputTokens()
@@ -1442,6 +2034,269 @@ func (_u *UsageLogUpdateOne) AddRateMultiplier(v float64) *UsageLogUpdateOne {
 	return _u
 }
 
+// SetPricingVersion sets the "pricing_version" field.
+func (_u *UsageLogUpdateOne) SetPricingVersion(v string) *UsageLogUpdateOne {
+	_u.mutation.SetPricingVersion(v)
+	return _u
+}
+
+// SetNillablePricingVersion sets the "pricing_version" field if the given value is not nil.
+func (_u *UsageLogUpdateOne) SetNillablePricingVersion(v *string) *UsageLogUpdateOne {
+	if v != nil {
+		_u.SetPricingVersion(*v)
+	}
+	return _u
+}
+
+// ClearPricingVersion clears the value of the "pricing_version" field.
+func (_u *UsageLogUpdateOne) ClearPricingVersion() *UsageLogUpdateOne {
+	_u.mutation.ClearPricingVersion()
+	return _u
+}
+
+// SetInputUnitPrice sets the "input_unit_price" field.
+func (_u *UsageLogUpdateOne) SetInputUnitPrice(v float64) *UsageLogUpdateOne {
+	_u.mutation.ResetInputUnitPrice()
+	_u.mutation.SetInputUnitPrice(v)
+	return _u
+}
+
+// SetNillableInputUnitPrice sets the "input_unit_price" field if the given value is not nil.
+func (_u *UsageLogUpdateOne) SetNillableInputUnitPrice(v *float64) *UsageLogUpdateOne {
+	if v != nil {
+		_u.SetInputUnitPrice(*v)
+	}
+	return _u
+}
+
+// AddInputUnitPrice adds value to the "input_unit_price" field.
+func (_u *UsageLogUpdateOne) AddInputUnitPrice(v float64) *UsageLogUpdateOne {
+	_u.mutation.AddInputUnitPrice(v)
+	return _u
+}
+
+// ClearInputUnitPrice clears the value of the "input_unit_price" field.
+func (_u *UsageLogUpdateOne) ClearInputUnitPrice() *UsageLogUpdateOne {
+	_u.mutation.ClearInputUnitPrice()
+	return _u
+}
+
+// SetOutputUnitPrice sets the "output_unit_price" field.
+func (_u *UsageLogUpdateOne) SetOutputUnitPrice(v float64) *UsageLogUpdateOne {
+	_u.mutation.ResetOutputUnitPrice()
+	_u.mutation.SetOutputUnitPrice(v)
+	return _u
+}
+
+// SetNillableOutputUnitPrice sets the "output_unit_price" field if the given value is not nil.
+func (_u *UsageLogUpdateOne) SetNillableOutputUnitPrice(v *float64) *UsageLogUpdateOne {
+	if v != nil {
+		_u.SetOutputUnitPrice(*v)
+	}
+	return _u
+}
+
+// AddOutputUnitPrice adds value to the "output_unit_price" field.
+func (_u *UsageLogUpdateOne) AddOutputUnitPrice(v float64) *UsageLogUpdateOne {
+	_u.mutation.AddOutputUnitPrice(v)
+	return _u
+}
+
+// ClearOutputUnitPrice clears the value of the "output_unit_price" field.
+func (_u *UsageLogUpdateOne) ClearOutputUnitPrice() *UsageLogUpdateOne {
+	_u.mutation.ClearOutputUnitPrice()
+	return _u
+}
+
+// SetCacheCreationUnitPrice sets the "cache_creation_unit_price" field.
+func (_u *UsageLogUpdateOne) SetCacheCreationUnitPrice(v float64) *UsageLogUpdateOne {
+	_u.mutation.ResetCacheCreationUnitPrice()
+	_u.mutation.SetCacheCreationUnitPrice(v)
+	return _u
+}
+
+// SetNillableCacheCreationUnitPrice sets the "cache_creation_unit_price" field if the given value is not nil.
+func (_u *UsageLogUpdateOne) SetNillableCacheCreationUnitPrice(v *float64) *UsageLogUpdateOne {
+	if v != nil {
+		_u.SetCacheCreationUnitPrice(*v)
+	}
+	return _u
+}
+
+// AddCacheCreationUnitPrice adds value to the "cache_creation_unit_price" field.
+func (_u *UsageLogUpdateOne) AddCacheCreationUnitPrice(v float64) *UsageLogUpdateOne {
+	_u.mutation.AddCacheCreationUnitPrice(v)
+	return _u
+}
+
+// ClearCacheCreationUnitPrice clears the value of the "cache_creation_unit_price" field.
+func (_u *UsageLogUpdateOne) ClearCacheCreationUnitPrice() *UsageLogUpdateOne {
+	_u.mutation.ClearCacheCreationUnitPrice()
+	return _u
+}
+
+// SetCacheReadUnitPrice sets the "cache_read_unit_price" field.
+func (_u *UsageLogUpdateOne) SetCacheReadUnitPrice(v float64) *UsageLogUpdateOne {
+	_u.mutation.ResetCacheReadUnitPrice()
+	_u.mutation.SetCacheReadUnitPrice(v)
+	return _u
+}
+
+// SetNillableCacheReadUnitPrice sets the "cache_read_unit_price" field if the given value is not nil.
+func (_u *UsageLogUpdateOne) SetNillableCacheReadUnitPrice(v *float64) *UsageLogUpdateOne {
+	if v != nil {
+		_u.SetCacheReadUnitPrice(*v)
+	}
+	return _u
+}
+
+// AddCacheReadUnitPrice adds value to the "cache_read_unit_price" field.
+func (_u *UsageLogUpdateOne) AddCacheReadUnitPrice(v float64) *UsageLogUpdateOne {
+	_u.mutation.AddCacheReadUnitPrice(v)
+	return _u
+}
+
+// ClearCacheReadUnitPrice clears the value of the "cache_read_unit_price" field.
+func (_u *UsageLogUpdateOne) ClearCacheReadUnitPrice() *UsageLogUpdateOne {
+	_u.mutation.ClearCacheReadUnitPrice()
+	return _u
+}
+
+// SetImageUnitPrice sets the "image_unit_price" field.
+func (_u *UsageLogUpdateOne) SetImageUnitPrice(v float64) *UsageLogUpdateOne {
+	_u.mutation.ResetImageUnitPrice()
+	_u.mutation.SetImageUnitPrice(v)
+	return _u
+}
+
+// SetNillableImageUnitPrice sets the "image_unit_price" field if the given value is not nil.
+func (_u *UsageLogUpdateOne) SetNillableImageUnitPrice(v *float64) *UsageLogUpdateOne {
+	if v != nil {
+		_u.SetImageUnitPrice(*v)
+	}
+	return _u
+}
+
+// AddImageUnitPrice adds value to the "image_unit_price" field.
+func (_u *UsageLogUpdateOne) AddImageUnitPrice(v float64) *UsageLogUpdateOne {
+	_u.mutation.AddImageUnitPrice(v)
+	return _u
+}
+
+// ClearImageUnitPrice clears the value of the "image_unit_price" field.
+func (_u *UsageLogUpdateOne) ClearImageUnitPrice() *UsageLogUpdateOne {
+	_u.mutation.ClearImageUnitPrice()
+	return _u
+}
+
+// SetVolumeTierMinSpend sets the "volume_tier_min_spend" field.
+func (_u *UsageLogUpdateOne) SetVolumeTierMinSpend(v float64) *UsageLogUpdateOne {
+	_u.mutation.ResetVolumeTierMinSpend()
+	_u.mutation.SetVolumeTierMinSpend(v)
+	return _u
+}
+
+// SetNillableVolumeTierMinSpend sets the "volume_tier_min_spend" field if the given value is not nil.
+func (_u *UsageLogUpdateOne) SetNillableVolumeTierMinSpend(v *float64) *UsageLogUpdateOne {
+	if v != nil {
+		_u.SetVolumeTierMinSpend(*v)
+	}
+	return _u
+}
+
+// AddVolumeTierMinSpend adds value to the "volume_tier_min_spend" field.
+func (_u *UsageLogUpdateOne) AddVolumeTierMinSpend(v float64) *UsageLogUpdateOne {
+	_u.mutation.AddVolumeTierMinSpend(v)
+	return _u
+}
+
+// ClearVolumeTierMinSpend clears the value of the "volume_tier_min_spend" field.
+func (_u *UsageLogUpdateOne) ClearVolumeTierMinSpend() *UsageLogUpdateOne {
+	_u.mutation.ClearVolumeTierMinSpend()
+	return _u
+}
+
+// SetVolumeTierMultiplier sets the "volume_tier_multiplier" field.
+func (_u *UsageLogUpdateOne) SetVolumeTierMultiplier(v float64) *UsageLogUpdateOne {
+	_u.mutation.ResetVolumeTierMultiplier()
+	_u.mutation.SetVolumeTierMultiplier(v)
+	return _u
+}
+
+// SetNillableVolumeTierMultiplier sets the "volume_tier_multiplier" field if the given value is not nil.
+func (_u *UsageLogUpdateOne) SetNillableVolumeTierMultiplier(v *float64) *UsageLogUpdateOne {
+	if v != nil {
+		_u.SetVolumeTierMultiplier(*v)
+	}
+	return _u
+}
+
+// AddVolumeTierMultiplier adds value to the "volume_tier_multiplier" field.
+func (_u *UsageLogUpdateOne) AddVolumeTierMultiplier(v float64) *UsageLogUpdateOne {
+	_u.mutation.AddVolumeTierMultiplier(v)
+	return _u
+}
+
+// ClearVolumeTierMultiplier clears the value of the "volume_tier_multiplier" field.
+func (_u *UsageLogUpdateOne) ClearVolumeTierMultiplier() *UsageLogUpdateOne {
+	_u.mutation.ClearVolumeTierMultiplier()
+	return _u
+}
+
+// SetSubscriptionCost sets the "subscription_cost" field.
+func (_u *UsageLogUpdateOne) SetSubscriptionCost(v float64) *UsageLogUpdateOne {
+	_u.mutation.ResetSubscriptionCost()
+	_u.mutation.SetSubscriptionCost(v)
+	return _u
+}
+
+// SetNillableSubscriptionCost sets the "subscription_cost" field if the given value is not nil.
+func (_u *UsageLogUpdateOne) SetNillableSubscriptionCost(v *float64) *UsageLogUpdateOne {
+	if v != nil {
+		_u.SetSubscriptionCost(*v)
+	}
+	return _u
+}
+
+// AddSubscriptionCost adds value to the "subscription_cost" field.
+func (_u *UsageLogUpdateOne) AddSubscriptionCost(v float64) *UsageLogUpdateOne {
+	_u.mutation.AddSubscriptionCost(v)
+	return _u
+}
+
+// ClearSubscriptionCost clears the value of the "subscription_cost" field.
+func (_u *UsageLogUpdateOne) ClearSubscriptionCost() *UsageLogUpdateOne {
+	_u.mutation.ClearSubscriptionCost()
+	return _u
+}
+
+// SetOverageCost sets the "overage_cost" field.
+func (_u *UsageLogUpdateOne) SetOverageCost(v float64) *UsageLogUpdateOne {
+	_u.mutation.ResetOverageCost()
+	_u.mutation.SetOverageCost(v)
+	return _u
+}
+
+// SetNillableOverageCost sets the "overage_cost" field if the given value is not nil.
+func (_u *UsageLogUpdateOne) SetNillableOverageCost(v *float64) *UsageLogUpdateOne {
+	if v != nil {
+		_u.SetOverageCost(*v)
+	}
+	return _u
+}
+
+// AddOverageCost adds value to the "overage_cost" field.
+func (_u *UsageLogUpdateOne) AddOverageCost(v float64) *UsageLogUpdateOne {
+	_u.mutation.AddOverageCost(v)
+	return _u
+}
+
+// ClearOverageCost clears the value of the "overage_cost" field.
+func (_u *UsageLogUpdateOne) ClearOverageCost() *UsageLogUpdateOne {
+	_u.mutation.ClearOverageCost()
+	return _u
+}
+
 // SetAccountRateMultiplier sets the "account_rate_multiplier" field.
 func (_u *UsageLogUpdateOne) SetAccountRateMultiplier(v float64) *UsageLogUpdateOne {
 	_u.mutation.ResetAccountRateMultiplier()
@@ -1751,6 +2606,11 @@ func (_u *UsageLogUpdateOne) check() error {
 			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "UsageLog.model": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.PricingVersion(); ok {
+		if err := usagelog.PricingVersionValidator(v); err != nil {
+			return &ValidationError{Name: "pricing_version", err: fmt.Errorf(`ent: validator failed for field "UsageLog.pricing_version": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.UserAgent(); ok {
 		if err := usagelog.UserAgentValidator(v); err != nil {
 			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "UsageLog.user_agent": %w`, err)}
@@ -1813,6 +2673,39 @@ func (_u *UsageLogUpdateOne) sqlSave(ctx context.Context) (_node *UsageLog, err
 	if value, ok := _u.mutation.Model(); ok {
 		_spec.SetField(usagelog.FieldModel, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.OriginalGroupID(); ok {
+		_spec.SetField(usagelog.FieldOriginalGroupID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOriginalGroupID(); ok {
+		_spec.AddField(usagelog.FieldOriginalGroupID, field.TypeInt64, value)
+	}
+	if _u.mutation.OriginalGroupIDCleared() {
+		_spec.ClearField(usagelog.FieldOriginalGroupID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.FallbackHop(); ok {
+		_spec.SetField(usagelog.FieldFallbackHop, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFallbackHop(); ok {
+		_spec.AddField(usagelog.FieldFallbackHop, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OrganizationID(); ok {
+		_spec.SetField(usagelog.FieldOrganizationID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOrganizationID(); ok {
+		_spec.AddField(usagelog.FieldOrganizationID, field.TypeInt64, value)
+	}
+	if _u.mutation.OrganizationIDCleared() {
+		_spec.ClearField(usagelog.FieldOrganizationID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.BillingUserID(); ok {
+		_spec.SetField(usagelog.FieldBillingUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedBillingUserID(); ok {
+		_spec.AddField(usagelog.FieldBillingUserID, field.TypeInt64, value)
+	}
+	if _u.mutation.BillingUserIDCleared() {
+		_spec.ClearField(usagelog.FieldBillingUserID, field.TypeInt64)
+	}
 	if value, ok := _u.mutation.InputTokens(); ok {
 		_spec.SetField(usagelog.FieldInputTokens, field.TypeInt, value)
 	}
@@ -1891,6 +2784,93 @@ func (_u *UsageLogUpdateOne) sqlSave(ctx context.Context) (_node *UsageLog, err
 	if value, ok := _u.mutation.AddedRateMultiplier(); ok {
 		_spec.AddField(usagelog.FieldRateMultiplier, field.TypeFloat64, value)
 	}
+	if value, ok := _u.mutation.PricingVersion(); ok {
+		_spec.SetField(usagelog.FieldPricingVersion, field.TypeString, value)
+	}
+	if _u.mutation.PricingVersionCleared() {
+		_spec.ClearField(usagelog.FieldPricingVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.InputUnitPrice(); ok {
+		_spec.SetField(usagelog.FieldInputUnitPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedInputUnitPrice(); ok {
+		_spec.AddField(usagelog.FieldInputUnitPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.InputUnitPriceCleared() {
+		_spec.ClearField(usagelog.FieldInputUnitPrice, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.OutputUnitPrice(); ok {
+		_spec.SetField(usagelog.FieldOutputUnitPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOutputUnitPrice(); ok {
+		_spec.AddField(usagelog.FieldOutputUnitPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.OutputUnitPriceCleared() {
+		_spec.ClearField(usagelog.FieldOutputUnitPrice, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CacheCreationUnitPrice(); ok {
+		_spec.SetField(usagelog.FieldCacheCreationUnitPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCacheCreationUnitPrice(); ok {
+		_spec.AddField(usagelog.FieldCacheCreationUnitPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.CacheCreationUnitPriceCleared() {
+		_spec.ClearField(usagelog.FieldCacheCreationUnitPrice, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CacheReadUnitPrice(); ok {
+		_spec.SetField(usagelog.FieldCacheReadUnitPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCacheReadUnitPrice(); ok {
+		_spec.AddField(usagelog.FieldCacheReadUnitPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.CacheReadUnitPriceCleared() {
+		_spec.ClearField(usagelog.FieldCacheReadUnitPrice, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.ImageUnitPrice(); ok {
+		_spec.SetField(usagelog.FieldImageUnitPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedImageUnitPrice(); ok {
+		_spec.AddField(usagelog.FieldImageUnitPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.ImageUnitPriceCleared() {
+		_spec.ClearField(usagelog.FieldImageUnitPrice, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.VolumeTierMinSpend(); ok {
+		_spec.SetField(usagelog.FieldVolumeTierMinSpend, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedVolumeTierMinSpend(); ok {
+		_spec.AddField(usagelog.FieldVolumeTierMinSpend, field.TypeFloat64, value)
+	}
+	if _u.mutation.VolumeTierMinSpendCleared() {
+		_spec.ClearField(usagelog.FieldVolumeTierMinSpend, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.VolumeTierMultiplier(); ok {
+		_spec.SetField(usagelog.FieldVolumeTierMultiplier, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedVolumeTierMultiplier(); ok {
+		_spec.AddField(usagelog.FieldVolumeTierMultiplier, field.TypeFloat64, value)
+	}
+	if _u.mutation.VolumeTierMultiplierCleared() {
+		_spec.ClearField(usagelog.FieldVolumeTierMultiplier, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.SubscriptionCost(); ok {
+		_spec.SetField(usagelog.FieldSubscriptionCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSubscriptionCost(); ok {
+		_spec.AddField(usagelog.FieldSubscriptionCost, field.TypeFloat64, value)
+	}
+	if _u.mutation.SubscriptionCostCleared() {
+		_spec.ClearField(usagelog.FieldSubscriptionCost, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.OverageCost(); ok {
+		_spec.SetField(usagelog.FieldOverageCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOverageCost(); ok {
+		_spec.AddField(usagelog.FieldOverageCost, field.TypeFloat64, value)
+	}
+	if _u.mutation.OverageCostCleared() {
+		_spec.ClearField(usagelog.FieldOverageCost, field.TypeFloat64)
+	}
 	if value, ok := _u.mutation.AccountRateMultiplier(); ok {
 		_spec.SetField(usagelog.FieldAccountRateMultiplier, field.TypeFloat64, value)
 	}
@@ -56,6 +56,12 @@ type Config struct {
 	Dashboard    DashboardCacheConfig       `mapstructure:"dashboard_cache"`
 	DashboardAgg DashboardAggregationConfig `mapstructure:"dashboard_aggregation"`
 	UsageCleanup UsageCleanupConfig         `mapstructure:"usage_cleanup"`
+	UsageRerate  UsageRerateConfig          `mapstructure:"usage_rerate"`
+	HealthProbe  AccountHealthProbeConfig   `mapstructure:"account_health_probe"`
+	PoolCanary   PoolCanaryConfig           `mapstructure:"pool_canary"`
+	Payment      PaymentConfig              `mapstructure:"payment"`
+	Statements   StatementConfig            `mapstructure:"statements"`
+	Referral     ReferralConfig             `mapstructure:"referral"`
 	Concurrency  ConcurrencyConfig          `mapstructure:"concurrency"`
 	TokenRefresh TokenRefreshConfig         `mapstructure:"token_refresh"`
 	RunMode      string                     `mapstructure:"run_mode" yaml:"run_mode"`
@@ -189,7 +195,20 @@ type ProxyProbeConfig struct {
 }
 
 type BillingConfig struct {
-	CircuitBreaker CircuitBreakerConfig `mapstructure:"circuit_breaker"`
+	CircuitBreaker CircuitBreakerConfig     `mapstructure:"circuit_breaker"`
+	Reservation    BalanceReservationConfig `mapstructure:"reservation"`
+}
+
+// BalanceReservationConfig 在途请求余额预留配置
+// 转发前按输入大小与 max_tokens 预估最大费用并预留，完成后按实际费用结算并释放剩余预留
+type BalanceReservationConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// HoldTTLSeconds: 预留最长保留时间（秒），超时未结算的预留自动释放（上限 3600）
+	HoldTTLSeconds int `mapstructure:"hold_ttl_seconds"`
+	// DefaultMaxTokens: 请求未指定 max_tokens 时用于预估的输出 token 数
+	DefaultMaxTokens int `mapstructure:"default_max_tokens"`
+	// MaxInputTokens: 预估输入 token 数上限（避免图片等 base64 内容导致预估过高）
+	MaxInputTokens int `mapstructure:"max_input_tokens"`
 }
 
 type CircuitBreakerConfig struct {
@@ -546,6 +565,130 @@ type UsageCleanupConfig struct {
 	TaskTimeoutSeconds int `mapstructure:"task_timeout_seconds"`
 }
 
+// UsageRerateConfig 使用记录重新计费任务配置
+type UsageRerateConfig struct {
+	// Enabled: 是否启用重新计费任务执行器
+	Enabled bool `mapstructure:"enabled"`
+	// MaxRangeDays: 单次任务允许的最大时间跨度（天）
+	MaxRangeDays int `mapstructure:"max_range_days"`
+	// BatchSize: 单批重新计费数量
+	BatchSize int `mapstructure:"batch_size"`
+	// WorkerIntervalSeconds: 后台任务轮询间隔（秒）
+	WorkerIntervalSeconds int `mapstructure:"worker_interval_seconds"`
+	// TaskTimeoutSeconds: 单次任务最大执行时长（秒）
+	TaskTimeoutSeconds int `mapstructure:"task_timeout_seconds"`
+}
+
+// AccountHealthProbeConfig 账号后台健康探测配置
+type AccountHealthProbeConfig struct {
+	// Enabled: 是否启用后台探测（会消耗少量上游额度，默认关闭）
+	Enabled bool `mapstructure:"enabled"`
+	// TickSeconds: 调度轮询间隔（秒）
+	TickSeconds int `mapstructure:"tick_seconds"`
+	// IdleMinutes: 账号最近使用超过该时长才会被探测（分钟）
+	IdleMinutes int `mapstructure:"idle_minutes"`
+	// MaxProbesPerTick: 单轮最多探测的账号数
+	MaxProbesPerTick int `mapstructure:"max_probes_per_tick"`
+	// ProbeTimeoutSeconds: 单次探测请求超时（秒）
+	ProbeTimeoutSeconds int `mapstructure:"probe_timeout_seconds"`
+	// RetentionDays: 探测历史保留天数
+	RetentionDays int `mapstructure:"retention_days"`
+	// Platforms: 各平台探测间隔与模型
+	Platforms AccountHealthProbePlatformsConfig `mapstructure:"platforms"`
+}
+
+// AccountHealthProbePlatformsConfig 各平台探测计划
+type AccountHealthProbePlatformsConfig struct {
+	Anthropic   AccountHealthProbePlatformConfig `mapstructure:"anthropic"`
+	OpenAI      AccountHealthProbePlatformConfig `mapstructure:"openai"`
+	Gemini      AccountHealthProbePlatformConfig `mapstructure:"gemini"`
+	Antigravity AccountHealthProbePlatformConfig `mapstructure:"antigravity"`
+}
+
+// AccountHealthProbePlatformConfig 单个平台的探测计划
+type AccountHealthProbePlatformConfig struct {
+	// IntervalMinutes: 同一账号两次探测的最小间隔（分钟），0 表示该平台不探测
+	IntervalMinutes int `mapstructure:"interval_minutes"`
+	// Model: 探测使用的模型，留空使用账号测试的默认模型
+	Model string `mapstructure:"model"`
+}
+
+// PoolCanaryConfig 分组子池灰度自动回滚配置
+// 仅对设置了 canary_max_error_rate 的分组生效；错误统计依赖运维监控的错误日志
+type PoolCanaryConfig struct {
+	// Enabled: 是否启用自动回滚检查
+	Enabled bool `mapstructure:"enabled"`
+	// CheckIntervalSeconds: 检查间隔（秒）
+	CheckIntervalSeconds int `mapstructure:"check_interval_seconds"`
+	// WindowMinutes: 错误率统计窗口（分钟）
+	WindowMinutes int `mapstructure:"window_minutes"`
+	// MinRequests: 窗口内灰度池请求数达到该值才参与判断，避免样本过少误回滚
+	MinRequests int `mapstructure:"min_requests"`
+}
+
+// PaymentConfig 在线支付充值配置
+type PaymentConfig struct {
+	// Enabled: 是否开放在线充值
+	Enabled bool `mapstructure:"enabled"`
+	// MinAmount/MaxAmount: 单笔支付金额范围（支付渠道货币）
+	MinAmount float64 `mapstructure:"min_amount"`
+	MaxAmount float64 `mapstructure:"max_amount"`
+	// OrderExpireMinutes: 订单未支付的过期时间（分钟）
+	OrderExpireMinutes int `mapstructure:"order_expire_minutes"`
+	// NotifyBaseURL: 支付回调的公网地址前缀，回调路径为 {notify_base_url}/api/v1/payment/notify/{provider}
+	NotifyBaseURL string `mapstructure:"notify_base_url"`
+	// ReturnURL: 支付完成或取消后浏览器跳转的前端地址（会附加 order_no 参数）
+	ReturnURL string              `mapstructure:"return_url"`
+	EPay      EPayPaymentConfig   `mapstructure:"epay"`
+	Stripe    StripePaymentConfig `mapstructure:"stripe"`
+}
+
+// EPayPaymentConfig 易支付（EPay/YiPay 协议）渠道配置
+type EPayPaymentConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// APIURL: 易支付网关地址，如 https://pay.example.com
+	APIURL string `mapstructure:"api_url"`
+	PID    string `mapstructure:"pid"`
+	Key    string `mapstructure:"key"`
+	// PayType: alipay / wxpay / qqpay
+	PayType  string `mapstructure:"pay_type"`
+	Currency string `mapstructure:"currency"`
+	// CreditRate: 每 1 单位支付货币到账的余额（美元）
+	CreditRate float64 `mapstructure:"credit_rate"`
+}
+
+// StripePaymentConfig Stripe Checkout 渠道配置
+type StripePaymentConfig struct {
+	Enabled       bool   `mapstructure:"enabled"`
+	APIBaseURL    string `mapstructure:"api_base_url"`
+	SecretKey     string `mapstructure:"secret_key"`
+	WebhookSecret string `mapstructure:"webhook_secret"`
+	Currency      string `mapstructure:"currency"`
+	// CreditRate: 每 1 单位支付货币到账的余额（美元）
+	CreditRate float64 `mapstructure:"credit_rate"`
+}
+
+// StatementConfig 月度账单配置
+type StatementConfig struct {
+	// Enabled: 是否每月自动为有用量的用户生成上月账单
+	Enabled bool `mapstructure:"enabled"`
+	// EmailEnabled: 自动生成后通过邮件发送给用户（需在系统设置中配置 SMTP）
+	EmailEnabled bool `mapstructure:"email_enabled"`
+}
+
+// ReferralConfig 邀请返佣配置
+// 返佣规则在被邀请人注册时快照，修改配置只影响之后注册的用户
+type ReferralConfig struct {
+	// Enabled: 是否开启邀请返佣（关闭时不再绑定新的邀请关系，也不再结算佣金）
+	Enabled bool `mapstructure:"enabled"`
+	// CommissionRate: 返佣比例（百分比），按被邀请人的实付消费计算
+	CommissionRate float64 `mapstructure:"commission_rate"`
+	// CommissionCapUSD: 单个被邀请人累计佣金上限（USD），0 表示不限制
+	CommissionCapUSD float64 `mapstructure:"commission_cap_usd"`
+	// DurationDays: 被邀请人注册后的返佣天数，0 表示永久
+	DurationDays int `mapstructure:"duration_days"`
+}
+
 func NormalizeRunMode(value string) string {
 	normalized := strings.ToLower(strings.TrimSpace(value))
 	switch normalized {
@@ -612,6 +755,14 @@ func Load() (*Config, error) {
 	cfg.LinuxDo.UserInfoIDPath = strings.TrimSpace(cfg.LinuxDo.UserInfoIDPath)
 	cfg.LinuxDo.UserInfoUsernamePath = strings.TrimSpace(cfg.LinuxDo.UserInfoUsernamePath)
 	cfg.Dashboard.KeyPrefix = strings.TrimSpace(cfg.Dashboard.KeyPrefix)
+	cfg.Payment.NotifyBaseURL = strings.TrimRight(strings.TrimSpace(cfg.Payment.NotifyBaseURL), "/")
+	cfg.Payment.ReturnURL = strings.TrimSpace(cfg.Payment.ReturnURL)
+	cfg.Payment.EPay.APIURL = strings.TrimRight(strings.TrimSpace(cfg.Payment.EPay.APIURL), "/")
+	cfg.Payment.EPay.PID = strings.TrimSpace(cfg.Payment.EPay.PID)
+	cfg.Payment.EPay.Key = strings.TrimSpace(cfg.Payment.EPay.Key)
+	cfg.Payment.Stripe.APIBaseURL = strings.TrimRight(strings.TrimSpace(cfg.Payment.Stripe.APIBaseURL), "/")
+	cfg.Payment.Stripe.SecretKey = strings.TrimSpace(cfg.Payment.Stripe.SecretKey)
+	cfg.Payment.Stripe.WebhookSecret = strings.TrimSpace(cfg.Payment.Stripe.WebhookSecret)
 	cfg.CORS.AllowedOrigins = normalizeStringSlice(cfg.CORS.AllowedOrigins)
 	cfg.Security.ResponseHeaders.AdditionalAllowed = normalizeStringSlice(cfg.Security.ResponseHeaders.AdditionalAllowed)
 	cfg.Security.ResponseHeaders.ForceRemove = normalizeStringSlice(cfg.Security.ResponseHeaders.ForceRemove)
@@ -695,6 +846,10 @@ func setDefaults() {
 	viper.SetDefault("billing.circuit_breaker.failure_threshold", 5)
 	viper.SetDefault("billing.circuit_breaker.reset_timeout_seconds", 30)
 	viper.SetDefault("billing.circuit_breaker.half_open_requests", 3)
+	viper.SetDefault("billing.reservation.enabled", true)
+	viper.SetDefault("billing.reservation.hold_ttl_seconds", 900)
+	viper.SetDefault("billing.reservation.default_max_tokens", 8192)
+	viper.SetDefault("billing.reservation.max_input_tokens", 200000)
 
 	// Turnstile
 	viper.SetDefault("turnstile.required", false)
@@ -813,6 +968,57 @@ func setDefaults() {
 	viper.SetDefault("usage_cleanup.worker_interval_seconds", 10)
 	viper.SetDefault("usage_cleanup.task_timeout_seconds", 1800)
 
+	// Usage rerate task
+	viper.SetDefault("usage_rerate.enabled", true)
+	viper.SetDefault("usage_rerate.max_range_days", 93)
+	viper.SetDefault("usage_rerate.batch_size", 1000)
+	viper.SetDefault("usage_rerate.worker_interval_seconds", 10)
+	viper.SetDefault("usage_rerate.task_timeout_seconds", 3600)
+
+	// Account health probe
+	viper.SetDefault("account_health_probe.enabled", false)
+	viper.SetDefault("account_health_probe.tick_seconds", 60)
+	viper.SetDefault("account_health_probe.idle_minutes", 30)
+	viper.SetDefault("account_health_probe.max_probes_per_tick", 10)
+	viper.SetDefault("account_health_probe.probe_timeout_seconds", 60)
+	viper.SetDefault("account_health_probe.retention_days", 14)
+	viper.SetDefault("account_health_probe.platforms.anthropic.interval_minutes", 60)
+	viper.SetDefault("account_health_probe.platforms.openai.interval_minutes", 60)
+	viper.SetDefault("account_health_probe.platforms.gemini.interval_minutes", 60)
+	viper.SetDefault("account_health_probe.platforms.antigravity.interval_minutes", 60)
+
+	// Pool canary
+	viper.SetDefault("pool_canary.enabled", true)
+	viper.SetDefault("pool_canary.check_interval_seconds", 60)
+	viper.SetDefault("pool_canary.window_minutes", 10)
+	viper.SetDefault("pool_canary.min_requests", 50)
+
+	// Payment
+	viper.SetDefault("payment.enabled", false)
+	viper.SetDefault("payment.min_amount", 1.0)
+	viper.SetDefault("payment.max_amount", 10000.0)
+	viper.SetDefault("payment.order_expire_minutes", 30)
+	viper.SetDefault("payment.notify_base_url", "")
+	viper.SetDefault("payment.return_url", "")
+	viper.SetDefault("payment.epay.enabled", false)
+	viper.SetDefault("payment.epay.pay_type", "alipay")
+	viper.SetDefault("payment.epay.currency", "CNY")
+	viper.SetDefault("payment.epay.credit_rate", 1.0)
+	viper.SetDefault("payment.stripe.enabled", false)
+	viper.SetDefault("payment.stripe.api_base_url", "https://api.stripe.com")
+	viper.SetDefault("payment.stripe.currency", "usd")
+	viper.SetDefault("payment.stripe.credit_rate", 1.0)
+
+	// Statements
+	viper.SetDefault("statements.enabled", true)
+	viper.SetDefault("statements.email_enabled", false)
+
+	// Referral
+	viper.SetDefault("referral.enabled", false)
+	viper.SetDefault("referral.commission_rate", 10.0)
+	viper.SetDefault("referral.commission_cap_usd", 0.0)
+	viper.SetDefault("referral.duration_days", 365)
+
 	// Gateway
 	viper.SetDefault("gateway.response_header_timeout", 600) // 600秒(10分钟)等待上游响应头，LLM高负载时可能排队较久
 	viper.SetDefault("gateway.log_upstream_error_body", true)
@@ -950,6 +1156,17 @@ func (c *Config) Validate() error {
 			return fmt.Errorf("billing.circuit_breaker.half_open_requests must be positive")
 		}
 	}
+	if c.Billing.Reservation.Enabled {
+		if c.Billing.Reservation.HoldTTLSeconds <= 0 || c.Billing.Reservation.HoldTTLSeconds > 3600 {
+			return fmt.Errorf("billing.reservation.hold_ttl_seconds must be between 1 and 3600")
+		}
+		if c.Billing.Reservation.DefaultMaxTokens <= 0 {
+			return fmt.Errorf("billing.reservation.default_max_tokens must be positive")
+		}
+		if c.Billing.Reservation.MaxInputTokens <= 0 {
+			return fmt.Errorf("billing.reservation.max_input_tokens must be positive")
+		}
+	}
 	if c.Database.MaxOpenConns <= 0 {
 		return fmt.Errorf("database.max_open_conns must be positive")
 	}
@@ -1082,6 +1299,106 @@ func (c *Config) Validate() error {
 			return fmt.Errorf("usage_cleanup.task_timeout_seconds must be non-negative")
 		}
 	}
+	if c.UsageRerate.Enabled {
+		if c.UsageRerate.MaxRangeDays <= 0 {
+			return fmt.Errorf("usage_rerate.max_range_days must be positive")
+		}
+		if c.UsageRerate.BatchSize <= 0 {
+			return fmt.Errorf("usage_rerate.batch_size must be positive")
+		}
+		if c.UsageRerate.WorkerIntervalSeconds <= 0 {
+			return fmt.Errorf("usage_rerate.worker_interval_seconds must be positive")
+		}
+		if c.UsageRerate.TaskTimeoutSeconds <= 0 {
+			return fmt.Errorf("usage_rerate.task_timeout_seconds must be positive")
+		}
+	}
+	if c.HealthProbe.Enabled {
+		if c.HealthProbe.TickSeconds <= 0 {
+			return fmt.Errorf("account_health_probe.tick_seconds must be positive")
+		}
+		if c.HealthProbe.MaxProbesPerTick <= 0 {
+			return fmt.Errorf("account_health_probe.max_probes_per_tick must be positive")
+		}
+		if c.HealthProbe.ProbeTimeoutSeconds <= 0 {
+			return fmt.Errorf("account_health_probe.probe_timeout_seconds must be positive")
+		}
+		if c.HealthProbe.RetentionDays <= 0 {
+			return fmt.Errorf("account_health_probe.retention_days must be positive")
+		}
+	}
+	if c.HealthProbe.IdleMinutes < 0 {
+		return fmt.Errorf("account_health_probe.idle_minutes must be non-negative")
+	}
+	probePlatforms := []struct {
+		name string
+		cfg  AccountHealthProbePlatformConfig
+	}{
+		{"anthropic", c.HealthProbe.Platforms.Anthropic},
+		{"openai", c.HealthProbe.Platforms.OpenAI},
+		{"gemini", c.HealthProbe.Platforms.Gemini},
+		{"antigravity", c.HealthProbe.Platforms.Antigravity},
+	}
+	for _, p := range probePlatforms {
+		if p.cfg.IntervalMinutes < 0 {
+			return fmt.Errorf("account_health_probe.platforms.%s.interval_minutes must be non-negative", p.name)
+		}
+	}
+	if c.PoolCanary.Enabled {
+		if c.PoolCanary.CheckIntervalSeconds <= 0 {
+			return fmt.Errorf("pool_canary.check_interval_seconds must be positive")
+		}
+		if c.PoolCanary.WindowMinutes <= 0 {
+			return fmt.Errorf("pool_canary.window_minutes must be positive")
+		}
+		if c.PoolCanary.MinRequests < 0 {
+			return fmt.Errorf("pool_canary.min_requests must be non-negative")
+		}
+	}
+	if c.Payment.Enabled {
+		if c.Payment.MinAmount <= 0 {
+			return fmt.Errorf("payment.min_amount must be positive")
+		}
+		if c.Payment.MaxAmount < c.Payment.MinAmount {
+			return fmt.Errorf("payment.max_amount must be >= payment.min_amount")
+		}
+		if c.Payment.OrderExpireMinutes <= 0 {
+			return fmt.Errorf("payment.order_expire_minutes must be positive")
+		}
+		if c.Payment.EPay.Enabled {
+			if c.Payment.EPay.APIURL == "" || c.Payment.EPay.PID == "" || c.Payment.EPay.Key == "" {
+				return fmt.Errorf("payment.epay.api_url, pid and key are required when epay is enabled")
+			}
+			if c.Payment.NotifyBaseURL == "" {
+				return fmt.Errorf("payment.notify_base_url is required when epay is enabled")
+			}
+			if c.Payment.EPay.CreditRate <= 0 {
+				return fmt.Errorf("payment.epay.credit_rate must be positive")
+			}
+		}
+		if c.Payment.Stripe.Enabled {
+			if c.Payment.Stripe.SecretKey == "" || c.Payment.Stripe.WebhookSecret == "" {
+				return fmt.Errorf("payment.stripe.secret_key and webhook_secret are required when stripe is enabled")
+			}
+			if c.Payment.ReturnURL == "" {
+				return fmt.Errorf("payment.return_url is required when stripe is enabled")
+			}
+			if c.Payment.Stripe.CreditRate <= 0 {
+				return fmt.Errorf("payment.stripe.credit_rate must be positive")
+			}
+		}
+	}
+	if c.Referral.Enabled {
+		if c.Referral.CommissionRate < 0 || c.Referral.CommissionRate > 100 {
+			return fmt.Errorf("referral.commission_rate must be between 0 and 100")
+		}
+		if c.Referral.CommissionCapUSD < 0 {
+			return fmt.Errorf("referral.commission_cap_usd must be non-negative")
+		}
+		if c.Referral.DurationDays < 0 {
+			return fmt.Errorf("referral.duration_days must be non-negative")
+		}
+	}
 	if c.Gateway.MaxBodySize <= 0 {
 		return fmt.Errorf("gateway.max_body_size must be positive")
 	}
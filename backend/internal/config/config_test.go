@@ -344,6 +344,74 @@ func TestValidateUsageCleanupConfigDisabled(t *testing.T) {
 	}
 }
 
+func TestLoadDefaultAccountHealthProbeConfig(t *testing.T) {
+	viper.Reset()
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+
+	if cfg.HealthProbe.Enabled {
+		t.Fatalf("HealthProbe.Enabled = true, want false")
+	}
+	if cfg.HealthProbe.TickSeconds != 60 {
+		t.Fatalf("HealthProbe.TickSeconds = %d, want 60", cfg.HealthProbe.TickSeconds)
+	}
+	if cfg.HealthProbe.IdleMinutes != 30 {
+		t.Fatalf("HealthProbe.IdleMinutes = %d, want 30", cfg.HealthProbe.IdleMinutes)
+	}
+	if cfg.HealthProbe.Platforms.Anthropic.IntervalMinutes != 60 {
+		t.Fatalf("HealthProbe.Platforms.Anthropic.IntervalMinutes = %d, want 60", cfg.HealthProbe.Platforms.Anthropic.IntervalMinutes)
+	}
+}
+
+func TestValidateAccountHealthProbeConfig(t *testing.T) {
+	viper.Reset()
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+
+	cfg.HealthProbe.Enabled = true
+	cfg.HealthProbe.TickSeconds = 0
+	err = cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "account_health_probe.tick_seconds") {
+		t.Fatalf("Validate() expected tick_seconds error, got: %v", err)
+	}
+
+	cfg.HealthProbe.TickSeconds = 60
+	cfg.HealthProbe.Platforms.Gemini.IntervalMinutes = -1
+	err = cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "account_health_probe.platforms.gemini.interval_minutes") {
+		t.Fatalf("Validate() expected gemini interval error, got: %v", err)
+	}
+}
+
+func TestValidatePoolCanaryConfig(t *testing.T) {
+	viper.Reset()
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if !cfg.PoolCanary.Enabled || cfg.PoolCanary.WindowMinutes != 10 || cfg.PoolCanary.MinRequests != 50 {
+		t.Fatalf("unexpected pool_canary defaults: %+v", cfg.PoolCanary)
+	}
+
+	cfg.PoolCanary.WindowMinutes = 0
+	err = cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "pool_canary.window_minutes") {
+		t.Fatalf("Validate() expected window_minutes error, got: %v", err)
+	}
+
+	cfg.PoolCanary.Enabled = false
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() with disabled pool_canary error: %v", err)
+	}
+}
+
 func TestConfigAddressHelpers(t *testing.T) {
 	server := ServerConfig{Host: "127.0.0.1", Port: 9000}
 	if server.Address() != "127.0.0.1:9000" {
@@ -12,6 +12,7 @@ import (
 	"github.com/Wei-Shaw/sub2api/internal/pkg/claude"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/geminicli"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/openai"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
 	"github.com/Wei-Shaw/sub2api/internal/service"
@@ -46,6 +47,8 @@ type AccountHandler struct {
 	crsSyncService          *service.CRSSyncService
 	sessionLimitCache       service.SessionLimitCache
 	tokenCacheInvalidator   service.TokenCacheInvalidator
+	healthProbeService      *service.AccountHealthProbeService
+	spendCapService         *service.AccountSpendCapService
 }
 
 // NewAccountHandler creates a new admin account handler
@@ -62,6 +65,8 @@ func NewAccountHandler(
 	crsSyncService *service.CRSSyncService,
 	sessionLimitCache service.SessionLimitCache,
 	tokenCacheInvalidator service.TokenCacheInvalidator,
+	healthProbeService *service.AccountHealthProbeService,
+	spendCapService *service.AccountSpendCapService,
 ) *AccountHandler {
 	return &AccountHandler{
 		adminService:            adminService,
@@ -76,6 +81,8 @@ func NewAccountHandler(
 		crsSyncService:          crsSyncService,
 		sessionLimitCache:       sessionLimitCache,
 		tokenCacheInvalidator:   tokenCacheInvalidator,
+		healthProbeService:      healthProbeService,
+		spendCapService:         spendCapService,
 	}
 }
 
@@ -94,6 +101,8 @@ type CreateAccountRequest struct {
 	GroupIDs                []int64        `json:"group_ids"`
 	ExpiresAt               *int64         `json:"expires_at"`
 	AutoPauseOnExpired      *bool          `json:"auto_pause_on_expired"`
+	AvailabilityWindows     []string       `json:"availability_windows"`       // 可用时间窗口，如 "mon-fri 19:00-08:00"
+	AvailabilityTimezone    string         `json:"availability_timezone"`      // IANA 时区，空表示服务器时区
 	ConfirmMixedChannelRisk *bool          `json:"confirm_mixed_channel_risk"` // 用户确认混合渠道风险
 }
 
@@ -113,6 +122,8 @@ type UpdateAccountRequest struct {
 	GroupIDs                *[]int64       `json:"group_ids"`
 	ExpiresAt               *int64         `json:"expires_at"`
 	AutoPauseOnExpired      *bool          `json:"auto_pause_on_expired"`
+	AvailabilityWindows     *[]string      `json:"availability_windows"` // 传入空数组表示全天可用
+	AvailabilityTimezone    *string        `json:"availability_timezone"`
 	ConfirmMixedChannelRisk *bool          `json:"confirm_mixed_channel_risk"` // 用户确认混合渠道风险
 }
 
@@ -139,6 +150,8 @@ type AccountWithConcurrency struct {
 	// 以下字段仅对 Anthropic OAuth/SetupToken 账号有效，且仅在启用相应功能时返回
 	CurrentWindowCost *float64 `json:"current_window_cost,omitempty"` // 当前窗口费用
 	ActiveSessions    *int     `json:"active_sessions,omitempty"`     // 当前活跃会话数
+	// 日/周/月消费上限进度（仅配置了上限的账号返回）
+	SpendCaps []service.AccountSpendCapStatus `json:"spend_caps,omitempty"`
 }
 
 // List handles listing all accounts with pagination
@@ -230,6 +243,28 @@ func (h *AccountHandler) List(c *gin.Context) {
 		_ = g.Wait()
 	}
 
+	// 获取消费上限进度（并行查询，缓存命中时不访问数据库）
+	spendCaps := make(map[int64][]service.AccountSpendCapStatus)
+	if h.spendCapService != nil {
+		var mu sync.Mutex
+		g, gctx := errgroup.WithContext(c.Request.Context())
+		g.SetLimit(10)
+		for i := range accounts {
+			acc := &accounts[i]
+			if !acc.HasSpendCaps() {
+				continue
+			}
+			g.Go(func() error {
+				statuses := h.spendCapService.GetSpendStatuses(gctx, acc)
+				mu.Lock()
+				spendCaps[acc.ID] = statuses
+				mu.Unlock()
+				return nil
+			})
+		}
+		_ = g.Wait()
+	}
+
 	// Build response with concurrency info
 	result := make([]AccountWithConcurrency, len(accounts))
 	for i := range accounts {
@@ -253,6 +288,8 @@ func (h *AccountHandler) List(c *gin.Context) {
 			}
 		}
 
+		item.SpendCaps = spendCaps[acc.ID]
+
 		result[i] = item
 	}
 
@@ -307,6 +344,8 @@ func (h *AccountHandler) Create(c *gin.Context) {
 		GroupIDs:              req.GroupIDs,
 		ExpiresAt:             req.ExpiresAt,
 		AutoPauseOnExpired:    req.AutoPauseOnExpired,
+		AvailabilityWindows:   req.AvailabilityWindows,
+		AvailabilityTimezone:  req.AvailabilityTimezone,
 		SkipMixedChannelCheck: skipCheck,
 	})
 	if err != nil {
@@ -371,6 +410,8 @@ func (h *AccountHandler) Update(c *gin.Context) {
 		GroupIDs:              req.GroupIDs,
 		ExpiresAt:             req.ExpiresAt,
 		AutoPauseOnExpired:    req.AutoPauseOnExpired,
+		AvailabilityWindows:   req.AvailabilityWindows,
+		AvailabilityTimezone:  req.AvailabilityTimezone,
 		SkipMixedChannelCheck: skipCheck,
 	})
 	if err != nil {
@@ -449,6 +490,48 @@ func (h *AccountHandler) Test(c *gin.Context) {
 	}
 }
 
+// Probe handles running a health probe against an account immediately
+// POST /api/v1/admin/accounts/:id/probe
+func (h *AccountHandler) Probe(c *gin.Context) {
+	accountID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid account ID")
+		return
+	}
+
+	entry, err := h.healthProbeService.ProbeNow(c.Request.Context(), accountID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, dto.AccountProbeLogFromService(entry))
+}
+
+// ListProbes handles listing health probe history of an account
+// GET /api/v1/admin/accounts/:id/probes
+func (h *AccountHandler) ListProbes(c *gin.Context) {
+	accountID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid account ID")
+		return
+	}
+
+	page, pageSize := response.ParsePagination(c)
+	params := pagination.PaginationParams{Page: page, PageSize: pageSize}
+	logs, result, err := h.healthProbeService.ListProbeLogs(c.Request.Context(), accountID, params)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	out := make([]dto.AccountProbeLog, 0, len(logs))
+	for i := range logs {
+		out = append(out, *dto.AccountProbeLogFromService(&logs[i]))
+	}
+	response.Paginated(c, out, result.Total, page, pageSize)
+}
+
 // SyncFromCRS handles syncing accounts from claude-relay-service (CRS)
 // POST /api/v1/admin/accounts/sync/crs
 func (h *AccountHandler) SyncFromCRS(c *gin.Context) {
@@ -16,8 +16,8 @@ func setupAdminRouter() (*gin.Engine, *stubAdminService) {
 	router := gin.New()
 	adminSvc := newStubAdminService()
 
-	userHandler := NewUserHandler(adminSvc)
-	groupHandler := NewGroupHandler(adminSvc)
+	userHandler := NewUserHandler(adminSvc, nil)
+	groupHandler := NewGroupHandler(adminSvc, nil)
 	proxyHandler := NewProxyHandler(adminSvc)
 	redeemHandler := NewRedeemHandler(adminSvc)
 
@@ -14,15 +14,23 @@ import (
 // GroupHandler handles admin group management
 type GroupHandler struct {
 	adminService service.AdminService
+	poolService  *service.GroupPoolService
 }
 
 // NewGroupHandler creates a new admin group handler
-func NewGroupHandler(adminService service.AdminService) *GroupHandler {
+func NewGroupHandler(adminService service.AdminService, poolService *service.GroupPoolService) *GroupHandler {
 	return &GroupHandler{
 		adminService: adminService,
+		poolService:  poolService,
 	}
 }
 
+// SetAccountPoolRequest represents set account pool request
+type SetAccountPoolRequest struct {
+	// 子池标签，留空表示默认池
+	Pool string `json:"pool"`
+}
+
 // CreateGroupRequest represents create group request
 type CreateGroupRequest struct {
 	Name             string   `json:"name" binding:"required"`
@@ -43,6 +51,23 @@ type CreateGroupRequest struct {
 	// 模型路由配置（仅 anthropic 平台使用）
 	ModelRouting        map[string][]int64 `json:"model_routing"`
 	ModelRoutingEnabled bool               `json:"model_routing_enabled"`
+	// 子池灰度分流配置
+	PoolSplitEnabled   bool           `json:"pool_split_enabled"`
+	PoolWeights        map[string]int `json:"pool_weights"`
+	CanaryMaxErrorRate *float64       `json:"canary_max_error_rate"`
+	// 容量降级链（按顺序尝试的分组 ID）及作为降级分组时的模型映射
+	CapacityFallbackGroupIDs []int64           `json:"capacity_fallback_group_ids"`
+	FallbackModelMapping     map[string]string `json:"fallback_model_mapping"`
+	// 阶梯折扣（按近 30 天消费分档，叠加在分组倍率之上）
+	VolumeTiers []service.VolumeTier `json:"volume_tiers"`
+	// 订阅超额计费（仅订阅分组生效，倍率留空默认 1）
+	OverageEnabled        bool     `json:"overage_enabled"`
+	OverageRateMultiplier *float64 `json:"overage_rate_multiplier"`
+	// 滚动窗口限额（仅订阅分组生效，小时数为 0 表示不启用，上限留空表示不限制）
+	RollingWindowHours    int      `json:"rolling_window_hours"`
+	RollingWindowLimitUSD *float64 `json:"rolling_window_limit_usd"`
+	// 请求数/Token 配额（仅订阅分组生效，模型留空表示所有模型）
+	UsageQuotas []service.UsageQuota `json:"usage_quotas"`
 }
 
 // UpdateGroupRequest represents update group request
@@ -66,6 +91,23 @@ type UpdateGroupRequest struct {
 	// 模型路由配置（仅 anthropic 平台使用）
 	ModelRouting        map[string][]int64 `json:"model_routing"`
 	ModelRoutingEnabled *bool              `json:"model_routing_enabled"`
+	// 子池灰度分流配置（canary_max_error_rate 传 0 表示关闭自动回滚）
+	PoolSplitEnabled   *bool          `json:"pool_split_enabled"`
+	PoolWeights        map[string]int `json:"pool_weights"`
+	CanaryMaxErrorRate *float64       `json:"canary_max_error_rate"`
+	// 容量降级链（按顺序尝试的分组 ID）及作为降级分组时的模型映射
+	CapacityFallbackGroupIDs []int64           `json:"capacity_fallback_group_ids"`
+	FallbackModelMapping     map[string]string `json:"fallback_model_mapping"`
+	// 阶梯折扣（传入空数组表示清除）
+	VolumeTiers []service.VolumeTier `json:"volume_tiers"`
+	// 订阅超额计费（仅订阅分组生效）
+	OverageEnabled        *bool    `json:"overage_enabled"`
+	OverageRateMultiplier *float64 `json:"overage_rate_multiplier"`
+	// 滚动窗口限额（仅订阅分组生效，小时数为 0 表示关闭）
+	RollingWindowHours    *int     `json:"rolling_window_hours"`
+	RollingWindowLimitUSD *float64 `json:"rolling_window_limit_usd"`
+	// 请求数/Token 配额（传入空数组表示清除）
+	UsageQuotas []service.UsageQuota `json:"usage_quotas"`
 }
 
 // List handles listing all groups with pagination
@@ -155,22 +197,33 @@ func (h *GroupHandler) Create(c *gin.Context) {
 	}
 
 	group, err := h.adminService.CreateGroup(c.Request.Context(), &service.CreateGroupInput{
-		Name:                req.Name,
-		Description:         req.Description,
-		Platform:            req.Platform,
-		RateMultiplier:      req.RateMultiplier,
-		IsExclusive:         req.IsExclusive,
-		SubscriptionType:    req.SubscriptionType,
-		DailyLimitUSD:       req.DailyLimitUSD,
-		WeeklyLimitUSD:      req.WeeklyLimitUSD,
-		MonthlyLimitUSD:     req.MonthlyLimitUSD,
-		ImagePrice1K:        req.ImagePrice1K,
-		ImagePrice2K:        req.ImagePrice2K,
-		ImagePrice4K:        req.ImagePrice4K,
-		ClaudeCodeOnly:      req.ClaudeCodeOnly,
-		FallbackGroupID:     req.FallbackGroupID,
-		ModelRouting:        req.ModelRouting,
-		ModelRoutingEnabled: req.ModelRoutingEnabled,
+		Name:                     req.Name,
+		Description:              req.Description,
+		Platform:                 req.Platform,
+		RateMultiplier:           req.RateMultiplier,
+		IsExclusive:              req.IsExclusive,
+		SubscriptionType:         req.SubscriptionType,
+		DailyLimitUSD:            req.DailyLimitUSD,
+		WeeklyLimitUSD:           req.WeeklyLimitUSD,
+		MonthlyLimitUSD:          req.MonthlyLimitUSD,
+		ImagePrice1K:             req.ImagePrice1K,
+		ImagePrice2K:             req.ImagePrice2K,
+		ImagePrice4K:             req.ImagePrice4K,
+		ClaudeCodeOnly:           req.ClaudeCodeOnly,
+		FallbackGroupID:          req.FallbackGroupID,
+		ModelRouting:             req.ModelRouting,
+		ModelRoutingEnabled:      req.ModelRoutingEnabled,
+		PoolSplitEnabled:         req.PoolSplitEnabled,
+		PoolWeights:              req.PoolWeights,
+		CanaryMaxErrorRate:       req.CanaryMaxErrorRate,
+		CapacityFallbackGroupIDs: req.CapacityFallbackGroupIDs,
+		FallbackModelMapping:     req.FallbackModelMapping,
+		VolumeTiers:              req.VolumeTiers,
+		OverageEnabled:           req.OverageEnabled,
+		OverageRateMultiplier:    req.OverageRateMultiplier,
+		RollingWindowHours:       req.RollingWindowHours,
+		RollingWindowLimitUSD:    req.RollingWindowLimitUSD,
+		UsageQuotas:              req.UsageQuotas,
 	})
 	if err != nil {
 		response.ErrorFrom(c, err)
@@ -196,23 +249,34 @@ func (h *GroupHandler) Update(c *gin.Context) {
 	}
 
 	group, err := h.adminService.UpdateGroup(c.Request.Context(), groupID, &service.UpdateGroupInput{
-		Name:                req.Name,
-		Description:         req.Description,
-		Platform:            req.Platform,
-		RateMultiplier:      req.RateMultiplier,
-		IsExclusive:         req.IsExclusive,
-		Status:              req.Status,
-		SubscriptionType:    req.SubscriptionType,
-		DailyLimitUSD:       req.DailyLimitUSD,
-		WeeklyLimitUSD:      req.WeeklyLimitUSD,
-		MonthlyLimitUSD:     req.MonthlyLimitUSD,
-		ImagePrice1K:        req.ImagePrice1K,
-		ImagePrice2K:        req.ImagePrice2K,
-		ImagePrice4K:        req.ImagePrice4K,
-		ClaudeCodeOnly:      req.ClaudeCodeOnly,
-		FallbackGroupID:     req.FallbackGroupID,
-		ModelRouting:        req.ModelRouting,
-		ModelRoutingEnabled: req.ModelRoutingEnabled,
+		Name:                     req.Name,
+		Description:              req.Description,
+		Platform:                 req.Platform,
+		RateMultiplier:           req.RateMultiplier,
+		IsExclusive:              req.IsExclusive,
+		Status:                   req.Status,
+		SubscriptionType:         req.SubscriptionType,
+		DailyLimitUSD:            req.DailyLimitUSD,
+		WeeklyLimitUSD:           req.WeeklyLimitUSD,
+		MonthlyLimitUSD:          req.MonthlyLimitUSD,
+		ImagePrice1K:             req.ImagePrice1K,
+		ImagePrice2K:             req.ImagePrice2K,
+		ImagePrice4K:             req.ImagePrice4K,
+		ClaudeCodeOnly:           req.ClaudeCodeOnly,
+		FallbackGroupID:          req.FallbackGroupID,
+		ModelRouting:             req.ModelRouting,
+		ModelRoutingEnabled:      req.ModelRoutingEnabled,
+		PoolSplitEnabled:         req.PoolSplitEnabled,
+		PoolWeights:              req.PoolWeights,
+		CanaryMaxErrorRate:       req.CanaryMaxErrorRate,
+		CapacityFallbackGroupIDs: req.CapacityFallbackGroupIDs,
+		FallbackModelMapping:     req.FallbackModelMapping,
+		VolumeTiers:              req.VolumeTiers,
+		OverageEnabled:           req.OverageEnabled,
+		OverageRateMultiplier:    req.OverageRateMultiplier,
+		RollingWindowHours:       req.RollingWindowHours,
+		RollingWindowLimitUSD:    req.RollingWindowLimitUSD,
+		UsageQuotas:              req.UsageQuotas,
 	})
 	if err != nil {
 		response.ErrorFrom(c, err)
@@ -282,3 +346,48 @@ func (h *GroupHandler) GetGroupAPIKeys(c *gin.Context) {
 	}
 	response.Paginated(c, outKeys, total, page, pageSize)
 }
+
+// ListPools handles listing sub-pools (canary pools) of a group
+// GET /api/v1/admin/groups/:id/pools
+func (h *GroupHandler) ListPools(c *gin.Context) {
+	groupID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid group ID")
+		return
+	}
+
+	pools, err := h.poolService.ListPools(c.Request.Context(), groupID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, pools)
+}
+
+// SetAccountPool handles assigning an account to a sub-pool within a group
+// PUT /api/v1/admin/groups/:id/accounts/:account_id/pool
+func (h *GroupHandler) SetAccountPool(c *gin.Context) {
+	groupID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid group ID")
+		return
+	}
+	accountID, err := strconv.ParseInt(c.Param("account_id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid account ID")
+		return
+	}
+
+	var req SetAccountPoolRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	pool, err := h.poolService.SetAccountPool(c.Request.Context(), groupID, accountID, req.Pool)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, gin.H{"group_id": groupID, "account_id": accountID, "pool": pool})
+}
@@ -0,0 +1,147 @@
+package admin
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PriceOverrideHandler handles admin model price override management
+type PriceOverrideHandler struct {
+	priceOverrideService *service.ModelPriceOverrideService
+}
+
+// NewPriceOverrideHandler creates a new admin price override handler
+func NewPriceOverrideHandler(priceOverrideService *service.ModelPriceOverrideService) *PriceOverrideHandler {
+	return &PriceOverrideHandler{
+		priceOverrideService: priceOverrideService,
+	}
+}
+
+// PriceOverrideRequest represents the create/update price override payload
+// token 类价格单位为 USD / 百万 token，image_price 为 USD / 张；留空表示沿用 LiteLLM 价格
+type PriceOverrideRequest struct {
+	Model             string     `json:"model" binding:"required,max=200"`
+	GroupID           *int64     `json:"group_id"`
+	InputPrice        *float64   `json:"input_price"`
+	OutputPrice       *float64   `json:"output_price"`
+	CacheWrite5mPrice *float64   `json:"cache_write_5m_price"`
+	CacheWrite1hPrice *float64   `json:"cache_write_1h_price"`
+	CacheReadPrice    *float64   `json:"cache_read_price"`
+	ImagePrice        *float64   `json:"image_price"`
+	EffectiveFrom     *time.Time `json:"effective_from"`
+	Notes             string     `json:"notes"`
+}
+
+func (r *PriceOverrideRequest) toInput() service.ModelPriceOverrideInput {
+	return service.ModelPriceOverrideInput{
+		Model:             r.Model,
+		GroupID:           r.GroupID,
+		InputPrice:        r.InputPrice,
+		OutputPrice:       r.OutputPrice,
+		CacheWrite5mPrice: r.CacheWrite5mPrice,
+		CacheWrite1hPrice: r.CacheWrite1hPrice,
+		CacheReadPrice:    r.CacheReadPrice,
+		ImagePrice:        r.ImagePrice,
+		EffectiveFrom:     r.EffectiveFrom,
+		Notes:             r.Notes,
+	}
+}
+
+// List lists price overrides
+// GET /api/v1/admin/pricing/overrides
+// Query: model (fuzzy), group_id (0 = global overrides only)
+func (h *PriceOverrideHandler) List(c *gin.Context) {
+	filters := service.ModelPriceOverrideFilters{Model: strings.TrimSpace(c.Query("model"))}
+	if groupIDStr := c.Query("group_id"); groupIDStr != "" {
+		groupID, err := strconv.ParseInt(groupIDStr, 10, 64)
+		if err != nil || groupID < 0 {
+			response.BadRequest(c, "Invalid group_id")
+			return
+		}
+		filters.GroupID = &groupID
+	}
+
+	page, pageSize := response.ParsePagination(c)
+	params := pagination.PaginationParams{Page: page, PageSize: pageSize}
+	items, result, err := h.priceOverrideService.List(c.Request.Context(), params, filters)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Paginated(c, items, result.Total, page, pageSize)
+}
+
+// GetByID returns a price override
+// GET /api/v1/admin/pricing/overrides/:id
+func (h *PriceOverrideHandler) GetByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid price override ID")
+		return
+	}
+	override, err := h.priceOverrideService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, override)
+}
+
+// Create creates a price override
+// POST /api/v1/admin/pricing/overrides
+func (h *PriceOverrideHandler) Create(c *gin.Context) {
+	var req PriceOverrideRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+	override, err := h.priceOverrideService.Create(c.Request.Context(), req.toInput())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, override)
+}
+
+// Update replaces a price override
+// PUT /api/v1/admin/pricing/overrides/:id
+func (h *PriceOverrideHandler) Update(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid price override ID")
+		return
+	}
+	var req PriceOverrideRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+	override, err := h.priceOverrideService.Update(c.Request.Context(), id, req.toInput())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, override)
+}
+
+// Delete deletes a price override
+// DELETE /api/v1/admin/pricing/overrides/:id
+func (h *PriceOverrideHandler) Delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid price override ID")
+		return
+	}
+	if err := h.priceOverrideService.Delete(c.Request.Context(), id); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, gin.H{"message": "Price override deleted successfully"})
+}
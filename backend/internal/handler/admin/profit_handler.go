@@ -0,0 +1,153 @@
+package admin
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ProfitHandler handles admin profit analytics and upstream account costs
+type ProfitHandler struct {
+	profitService *service.ProfitService
+}
+
+// NewProfitHandler creates a new admin profit handler
+func NewProfitHandler(profitService *service.ProfitService) *ProfitHandler {
+	return &ProfitHandler{
+		profitService: profitService,
+	}
+}
+
+// AccountCostRequest represents the create/update account cost payload
+// monthly_price 为上游订阅月费（USD），按 monthly_price × 12 / 365 摊销到每天
+type AccountCostRequest struct {
+	AccountID    int64      `json:"account_id" binding:"required,min=1"`
+	MonthlyPrice float64    `json:"monthly_price" binding:"min=0"`
+	PurchasedAt  time.Time  `json:"purchased_at" binding:"required"`
+	EndedAt      *time.Time `json:"ended_at"`
+	Notes        string     `json:"notes" binding:"max=500"`
+}
+
+func (r *AccountCostRequest) toInput() service.AccountCostInput {
+	return service.AccountCostInput{
+		AccountID:    r.AccountID,
+		MonthlyPrice: r.MonthlyPrice,
+		PurchasedAt:  r.PurchasedAt,
+		EndedAt:      r.EndedAt,
+		Notes:        r.Notes,
+	}
+}
+
+// GetReport returns profit summary, daily trend and per-dimension breakdown
+// GET /api/v1/admin/profit/report
+// Query: start_date, end_date, timezone, dimension (account|group|model), account_id, group_id, model
+func (h *ProfitHandler) GetReport(c *gin.Context) {
+	startTime, endTime := parseTimeRange(c)
+	query := service.ProfitQuery{
+		StartTime: startTime,
+		EndTime:   endTime,
+		Dimension: c.DefaultQuery("dimension", service.ProfitDimensionAccount),
+		Model:     strings.TrimSpace(c.Query("model")),
+	}
+	if accountIDStr := c.Query("account_id"); accountIDStr != "" {
+		accountID, err := strconv.ParseInt(accountIDStr, 10, 64)
+		if err != nil || accountID <= 0 {
+			response.BadRequest(c, "Invalid account_id")
+			return
+		}
+		query.AccountID = accountID
+	}
+	if groupIDStr := c.Query("group_id"); groupIDStr != "" {
+		groupID, err := strconv.ParseInt(groupIDStr, 10, 64)
+		if err != nil || groupID <= 0 {
+			response.BadRequest(c, "Invalid group_id")
+			return
+		}
+		query.GroupID = groupID
+	}
+
+	report, err := h.profitService.GetReport(c.Request.Context(), query)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, report)
+}
+
+// ListAccountCosts lists upstream account cost entries
+// GET /api/v1/admin/profit/account-costs
+// Query: account_id
+func (h *ProfitHandler) ListAccountCosts(c *gin.Context) {
+	var accountID int64
+	if accountIDStr := c.Query("account_id"); accountIDStr != "" {
+		id, err := strconv.ParseInt(accountIDStr, 10, 64)
+		if err != nil || id <= 0 {
+			response.BadRequest(c, "Invalid account_id")
+			return
+		}
+		accountID = id
+	}
+	items, err := h.profitService.ListAccountCosts(c.Request.Context(), accountID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, items)
+}
+
+// CreateAccountCost creates an upstream account cost entry
+// POST /api/v1/admin/profit/account-costs
+func (h *ProfitHandler) CreateAccountCost(c *gin.Context) {
+	var req AccountCostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+	cost, err := h.profitService.CreateAccountCost(c.Request.Context(), req.toInput())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, cost)
+}
+
+// UpdateAccountCost replaces an upstream account cost entry
+// PUT /api/v1/admin/profit/account-costs/:id
+func (h *ProfitHandler) UpdateAccountCost(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid account cost ID")
+		return
+	}
+	var req AccountCostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+	cost, err := h.profitService.UpdateAccountCost(c.Request.Context(), id, req.toInput())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, cost)
+}
+
+// DeleteAccountCost deletes an upstream account cost entry
+// DELETE /api/v1/admin/profit/account-costs/:id
+func (h *ProfitHandler) DeleteAccountCost(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid account cost ID")
+		return
+	}
+	if err := h.profitService.DeleteAccountCost(c.Request.Context(), id); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, gin.H{"message": "Account cost deleted successfully"})
+}
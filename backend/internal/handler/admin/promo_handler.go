@@ -32,6 +32,18 @@ type CreatePromoCodeRequest struct {
 	MaxUses     int     `json:"max_uses" binding:"min=0"`              // 最大使用次数，0=无限
 	ExpiresAt   *int64  `json:"expires_at"`                            // 过期时间戳（秒）
 	Notes       string  `json:"notes"`                                 // 备注
+
+	CreditValidityDays int `json:"credit_validity_days" binding:"omitempty,min=0,max=36500"` // 赠送额度有效天数，0=永不过期
+
+	Scope               string   `json:"scope" binding:"omitempty,oneof=register topup"`                // 使用场景，默认 register
+	BonusPercent        float64  `json:"bonus_percent" binding:"omitempty,min=0,max=1000"`              // 充值赠送比例（%），仅 topup
+	MaxBonusAmount      *float64 `json:"max_bonus_amount" binding:"omitempty,min=0"`                    // 单次赠送上限
+	TrialGroupID        *int64   `json:"trial_group_id"`                                                // 赠送订阅试用的分组
+	TrialDays           int      `json:"trial_days" binding:"omitempty,min=0,max=36500"`                // 订阅试用天数
+	NewUserDays         int      `json:"new_user_days" binding:"omitempty,min=0,max=36500"`             // 仅限注册 N 天内的用户，0=不限
+	AllowedEmailDomains []string `json:"allowed_email_domains" binding:"omitempty,max=50,dive,max=255"` // 邮箱域名白名单
+	Stackable           bool     `json:"stackable"`                                                     // 是否可与其他优惠码叠加
+	MaxUsesPerUser      *int     `json:"max_uses_per_user" binding:"omitempty,min=0"`                   // 每用户使用次数，默认 1，0=无限
 }
 
 // UpdatePromoCodeRequest represents update promo code request
@@ -42,6 +54,18 @@ type UpdatePromoCodeRequest struct {
 	Status      *string  `json:"status" binding:"omitempty,oneof=active disabled"`
 	ExpiresAt   *int64   `json:"expires_at"`
 	Notes       *string  `json:"notes"`
+
+	CreditValidityDays *int `json:"credit_validity_days" binding:"omitempty,min=0,max=36500"`
+
+	Scope               *string   `json:"scope" binding:"omitempty,oneof=register topup"`
+	BonusPercent        *float64  `json:"bonus_percent" binding:"omitempty,min=0,max=1000"`
+	MaxBonusAmount      *float64  `json:"max_bonus_amount"` // 负数表示清除上限
+	TrialGroupID        *int64    `json:"trial_group_id"`   // 0 表示清除订阅试用
+	TrialDays           *int      `json:"trial_days" binding:"omitempty,min=0,max=36500"`
+	NewUserDays         *int      `json:"new_user_days" binding:"omitempty,min=0,max=36500"`
+	AllowedEmailDomains *[]string `json:"allowed_email_domains" binding:"omitempty,max=50,dive,max=255"`
+	Stackable           *bool     `json:"stackable"`
+	MaxUsesPerUser      *int      `json:"max_uses_per_user" binding:"omitempty,min=0"`
 }
 
 // List handles listing all promo codes with pagination
@@ -104,6 +128,18 @@ func (h *PromoHandler) Create(c *gin.Context) {
 		BonusAmount: req.BonusAmount,
 		MaxUses:     req.MaxUses,
 		Notes:       req.Notes,
+
+		CreditValidityDays: req.CreditValidityDays,
+
+		Scope:               req.Scope,
+		BonusPercent:        req.BonusPercent,
+		MaxBonusAmount:      req.MaxBonusAmount,
+		TrialGroupID:        req.TrialGroupID,
+		TrialDays:           req.TrialDays,
+		NewUserDays:         req.NewUserDays,
+		AllowedEmailDomains: req.AllowedEmailDomains,
+		Stackable:           req.Stackable,
+		MaxUsesPerUser:      req.MaxUsesPerUser,
 	}
 
 	if req.ExpiresAt != nil {
@@ -141,6 +177,18 @@ func (h *PromoHandler) Update(c *gin.Context) {
 		MaxUses:     req.MaxUses,
 		Status:      req.Status,
 		Notes:       req.Notes,
+
+		CreditValidityDays: req.CreditValidityDays,
+
+		Scope:               req.Scope,
+		BonusPercent:        req.BonusPercent,
+		MaxBonusAmount:      req.MaxBonusAmount,
+		TrialGroupID:        req.TrialGroupID,
+		TrialDays:           req.TrialDays,
+		NewUserDays:         req.NewUserDays,
+		AllowedEmailDomains: req.AllowedEmailDomains,
+		Stackable:           req.Stackable,
+		MaxUsesPerUser:      req.MaxUsesPerUser,
 	}
 
 	if req.ExpiresAt != nil {
@@ -207,3 +255,26 @@ func (h *PromoHandler) GetUsages(c *gin.Context) {
 	}
 	response.Paginated(c, out, paginationResult.Total, page, pageSize)
 }
+
+// Report returns promo code usage in the time range, broken down by code
+// GET /api/v1/admin/promo-codes/report
+// Query: start_date, end_date, timezone
+func (h *PromoHandler) Report(c *gin.Context) {
+	startTime, endTime := parseTimeRange(c)
+
+	items, err := h.promoService.GetUsageReport(c.Request.Context(), startTime, endTime)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	out := make([]dto.PromoCodeReportItem, 0, len(items))
+	for i := range items {
+		out = append(out, *dto.PromoCodeReportItemFromService(&items[i]))
+	}
+	response.Success(c, gin.H{
+		"start_time": startTime,
+		"end_time":   endTime,
+		"items":      out,
+	})
+}
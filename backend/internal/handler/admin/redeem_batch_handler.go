@@ -0,0 +1,226 @@
+package admin
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RedeemBatchHandler handles admin redeem code batch management
+type RedeemBatchHandler struct {
+	batchService *service.RedeemBatchService
+}
+
+// NewRedeemBatchHandler creates a new admin redeem batch handler
+func NewRedeemBatchHandler(batchService *service.RedeemBatchService) *RedeemBatchHandler {
+	return &RedeemBatchHandler{
+		batchService: batchService,
+	}
+}
+
+// CreateRedeemBatchRequest represents the create batch request payload
+type CreateRedeemBatchRequest struct {
+	Name    string `json:"name" binding:"required,max=100"`
+	Channel string `json:"channel" binding:"max=50"`
+	Notes   string `json:"notes"`
+
+	Count        int     `json:"count" binding:"required,min=1,max=1000"`
+	Type         string  `json:"type" binding:"required,oneof=balance concurrency subscription"`
+	Value        float64 `json:"value" binding:"min=0"`
+	GroupID      *int64  `json:"group_id"`                                    // 订阅类型必填
+	ValidityDays int     `json:"validity_days" binding:"omitempty,max=36500"` // 订阅类型使用，默认30天
+
+	CreditValidityDays int `json:"credit_validity_days" binding:"omitempty,min=0,max=36500"` // 余额类型使用，0=永不过期
+	MaxRedemptions     int `json:"max_redemptions" binding:"omitempty,min=1,max=1000000"`    // 每个兑换码的最大兑换次数，默认 1
+}
+
+// UpdateRedeemBatchRequest represents the update batch request payload
+type UpdateRedeemBatchRequest struct {
+	Name    *string `json:"name" binding:"omitempty,max=100"`
+	Channel *string `json:"channel" binding:"omitempty,max=50"`
+	Notes   *string `json:"notes"`
+}
+
+// List lists redeem code batches with stats
+// GET /api/v1/admin/redeem-batches
+// Query: channel, type, status, search
+func (h *RedeemBatchHandler) List(c *gin.Context) {
+	page, pageSize := response.ParsePagination(c)
+	search := strings.TrimSpace(c.Query("search"))
+	if len(search) > 100 {
+		search = search[:100]
+	}
+	filters := service.RedeemBatchFilters{
+		Channel: strings.TrimSpace(c.Query("channel")),
+		Type:    c.Query("type"),
+		Status:  c.Query("status"),
+		Search:  search,
+	}
+
+	params := pagination.PaginationParams{Page: page, PageSize: pageSize}
+	batches, result, err := h.batchService.ListBatches(c.Request.Context(), params, filters)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	out := make([]dto.RedeemBatch, 0, len(batches))
+	for i := range batches {
+		out = append(out, *dto.RedeemBatchFromService(&batches[i]))
+	}
+	response.Paginated(c, out, result.Total, page, pageSize)
+}
+
+// GetByID returns a batch with stats
+// GET /api/v1/admin/redeem-batches/:id
+func (h *RedeemBatchHandler) GetByID(c *gin.Context) {
+	batchID, ok := parseRedeemBatchID(c)
+	if !ok {
+		return
+	}
+	batch, err := h.batchService.GetBatch(c.Request.Context(), batchID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, dto.RedeemBatchFromService(batch))
+}
+
+// Create creates a batch and generates its codes
+// POST /api/v1/admin/redeem-batches
+func (h *RedeemBatchHandler) Create(c *gin.Context) {
+	var req CreateRedeemBatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	batch, codes, err := h.batchService.CreateBatch(c.Request.Context(), &service.CreateRedeemBatchInput{
+		Name:    req.Name,
+		Channel: req.Channel,
+		Notes:   req.Notes,
+		GenerateRedeemCodesInput: service.GenerateRedeemCodesInput{
+			Count:        req.Count,
+			Type:         req.Type,
+			Value:        req.Value,
+			GroupID:      req.GroupID,
+			ValidityDays: req.ValidityDays,
+
+			CreditValidityDays: req.CreditValidityDays,
+			MaxRedemptions:     req.MaxRedemptions,
+		},
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	out := make([]dto.AdminRedeemCode, 0, len(codes))
+	for i := range codes {
+		out = append(out, *dto.RedeemCodeFromServiceAdmin(&codes[i]))
+	}
+	response.Success(c, gin.H{
+		"batch": dto.RedeemBatchFromService(batch),
+		"codes": out,
+	})
+}
+
+// Update updates batch name, channel and notes
+// PUT /api/v1/admin/redeem-batches/:id
+func (h *RedeemBatchHandler) Update(c *gin.Context) {
+	batchID, ok := parseRedeemBatchID(c)
+	if !ok {
+		return
+	}
+	var req UpdateRedeemBatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	batch, err := h.batchService.UpdateBatch(c.Request.Context(), batchID, &service.UpdateRedeemBatchInput{
+		Name:    req.Name,
+		Channel: req.Channel,
+		Notes:   req.Notes,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, dto.RedeemBatchFromService(batch))
+}
+
+// Disable disables every unused code in the batch
+// POST /api/v1/admin/redeem-batches/:id/disable
+func (h *RedeemBatchHandler) Disable(c *gin.Context) {
+	batchID, ok := parseRedeemBatchID(c)
+	if !ok {
+		return
+	}
+	disabled, err := h.batchService.DisableBatch(c.Request.Context(), batchID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, gin.H{"disabled": disabled})
+}
+
+// ListCodes lists codes in the batch
+// GET /api/v1/admin/redeem-batches/:id/codes
+func (h *RedeemBatchHandler) ListCodes(c *gin.Context) {
+	batchID, ok := parseRedeemBatchID(c)
+	if !ok {
+		return
+	}
+	page, pageSize := response.ParsePagination(c)
+	params := pagination.PaginationParams{Page: page, PageSize: pageSize}
+	codes, result, err := h.batchService.ListBatchCodes(c.Request.Context(), batchID, params)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	out := make([]dto.AdminRedeemCode, 0, len(codes))
+	for i := range codes {
+		out = append(out, *dto.RedeemCodeFromServiceAdmin(&codes[i]))
+	}
+	response.Paginated(c, out, result.Total, page, pageSize)
+}
+
+// Export exports all codes in the batch to CSV
+// GET /api/v1/admin/redeem-batches/:id/export
+func (h *RedeemBatchHandler) Export(c *gin.Context) {
+	batchID, ok := parseRedeemBatchID(c)
+	if !ok {
+		return
+	}
+	_, codes, err := h.batchService.ExportBatchCodes(c.Request.Context(), batchID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	data, err := redeemCodesCSV(codes)
+	if err != nil {
+		response.InternalError(c, "Failed to export redeem codes: "+err.Error())
+		return
+	}
+
+	c.Header("Content-Type", "text/csv")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=redeem_batch_%d.csv", batchID))
+	c.Data(200, "text/csv", data)
+}
+
+func parseRedeemBatchID(c *gin.Context) (int64, bool) {
+	batchID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid redeem batch ID")
+		return 0, false
+	}
+	return batchID, true
+}
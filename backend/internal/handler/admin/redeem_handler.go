@@ -33,6 +33,10 @@ type GenerateRedeemCodesRequest struct {
 	Value        float64 `json:"value" binding:"min=0"`
 	GroupID      *int64  `json:"group_id"`                                    // 订阅类型必填
 	ValidityDays int     `json:"validity_days" binding:"omitempty,max=36500"` // 订阅类型使用，默认30天，最大100年
+
+	CreditValidityDays int `json:"credit_validity_days" binding:"omitempty,min=0,max=36500"` // 余额类型使用，发放额度的有效天数，0=永不过期
+
+	MaxRedemptions int `json:"max_redemptions" binding:"omitempty,min=1,max=1000000"` // 最大兑换次数（每个用户限一次），默认 1
 }
 
 // List handles listing all redeem codes with pagination
@@ -94,6 +98,9 @@ func (h *RedeemHandler) Generate(c *gin.Context) {
 		Value:        req.Value,
 		GroupID:      req.GroupID,
 		ValidityDays: req.ValidityDays,
+
+		CreditValidityDays: req.CreditValidityDays,
+		MaxRedemptions:     req.MaxRedemptions,
 	})
 	if err != nil {
 		response.ErrorFrom(c, err)
@@ -197,17 +204,26 @@ func (h *RedeemHandler) Export(c *gin.Context) {
 		return
 	}
 
-	// Create CSV buffer
+	data, err := redeemCodesCSV(codes)
+	if err != nil {
+		response.InternalError(c, "Failed to export redeem codes: "+err.Error())
+		return
+	}
+
+	c.Header("Content-Type", "text/csv")
+	c.Header("Content-Disposition", "attachment; filename=redeem_codes.csv")
+	c.Data(200, "text/csv", data)
+}
+
+// redeemCodesCSV renders redeem codes as CSV (shared by code and batch exports)
+func redeemCodesCSV(codes []service.RedeemCode) ([]byte, error) {
 	var buf bytes.Buffer
 	writer := csv.NewWriter(&buf)
 
-	// Write header
-	if err := writer.Write([]string{"id", "code", "type", "value", "status", "used_by", "used_at", "created_at"}); err != nil {
-		response.InternalError(c, "Failed to export redeem codes: "+err.Error())
-		return
+	if err := writer.Write([]string{"id", "code", "type", "value", "status", "used_by", "used_at", "created_at", "batch_id", "max_redemptions", "redeemed_count"}); err != nil {
+		return nil, err
 	}
 
-	// Write data rows
 	for _, code := range codes {
 		usedBy := ""
 		if code.UsedBy != nil {
@@ -217,6 +233,10 @@ func (h *RedeemHandler) Export(c *gin.Context) {
 		if code.UsedAt != nil {
 			usedAt = code.UsedAt.Format("2006-01-02 15:04:05")
 		}
+		batchID := ""
+		if code.BatchID != nil {
+			batchID = fmt.Sprintf("%d", *code.BatchID)
+		}
 		if err := writer.Write([]string{
 			fmt.Sprintf("%d", code.ID),
 			code.Code,
@@ -226,19 +246,17 @@ func (h *RedeemHandler) Export(c *gin.Context) {
 			usedBy,
 			usedAt,
 			code.CreatedAt.Format("2006-01-02 15:04:05"),
+			batchID,
+			fmt.Sprintf("%d", code.MaxRedemptions),
+			fmt.Sprintf("%d", code.RedeemedCount),
 		}); err != nil {
-			response.InternalError(c, "Failed to export redeem codes: "+err.Error())
-			return
+			return nil, err
 		}
 	}
 
 	writer.Flush()
 	if err := writer.Error(); err != nil {
-		response.InternalError(c, "Failed to export redeem codes: "+err.Error())
-		return
+		return nil, err
 	}
-
-	c.Header("Content-Type", "text/csv")
-	c.Header("Content-Disposition", "attachment; filename=redeem_codes.csv")
-	c.Data(200, "text/csv", buf.Bytes())
+	return buf.Bytes(), nil
 }
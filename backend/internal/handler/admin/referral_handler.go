@@ -0,0 +1,92 @@
+package admin
+
+import (
+	"strconv"
+
+	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ReferralHandler handles admin referral commission reports
+type ReferralHandler struct {
+	referralService *service.ReferralService
+}
+
+// NewReferralHandler creates a new admin referral handler
+func NewReferralHandler(referralService *service.ReferralService) *ReferralHandler {
+	return &ReferralHandler{
+		referralService: referralService,
+	}
+}
+
+// ListCommissions lists settled commissions
+// GET /api/v1/admin/referrals/commissions
+// Query: referrer_id, referee_id, start_date, end_date, timezone
+func (h *ReferralHandler) ListCommissions(c *gin.Context) {
+	var filters service.ReferralCommissionFilters
+	for _, f := range []struct {
+		name string
+		dest *int64
+	}{
+		{"referrer_id", &filters.ReferrerID},
+		{"referee_id", &filters.RefereeID},
+	} {
+		raw := c.Query(f.name)
+		if raw == "" {
+			continue
+		}
+		id, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || id <= 0 {
+			response.BadRequest(c, "Invalid "+f.name)
+			return
+		}
+		*f.dest = id
+	}
+	if c.Query("start_date") != "" || c.Query("end_date") != "" {
+		startTime, endTime := parseTimeRange(c)
+		filters.StartTime = &startTime
+		filters.EndTime = &endTime
+	}
+
+	page, pageSize := response.ParsePagination(c)
+	params := pagination.PaginationParams{Page: page, PageSize: pageSize}
+	items, result, err := h.referralService.ListCommissions(c.Request.Context(), params, filters)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	out := make([]dto.ReferralCommission, 0, len(items))
+	for i := range items {
+		out = append(out, *dto.ReferralCommissionFromService(&items[i]))
+	}
+	response.Paginated(c, out, result.Total, page, pageSize)
+}
+
+// Report returns commissions settled in the time range, grouped by referrer
+// GET /api/v1/admin/referrals/report
+// Query: start_date, end_date, timezone
+func (h *ReferralHandler) Report(c *gin.Context) {
+	startTime, endTime := parseTimeRange(c)
+
+	report, err := h.referralService.GetSettlementReport(c.Request.Context(), startTime, endTime)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, dto.ReferralSettlementReportFromService(report))
+}
+
+// Settle runs commission settlement immediately
+// POST /api/v1/admin/referrals/settle
+func (h *ReferralHandler) Settle(c *gin.Context) {
+	count, err := h.referralService.SettleNow(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, gin.H{"settled": count})
+}
@@ -0,0 +1,81 @@
+package admin
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// StatementHandler handles admin statement management
+type StatementHandler struct {
+	statementService *service.StatementService
+}
+
+// NewStatementHandler creates a new admin statement handler
+func NewStatementHandler(statementService *service.StatementService) *StatementHandler {
+	return &StatementHandler{
+		statementService: statementService,
+	}
+}
+
+// RegenerateStatementsRequest represents the regenerate request payload
+type RegenerateStatementsRequest struct {
+	Period string `json:"period" binding:"required"` // YYYY-MM
+	UserID int64  `json:"user_id"`                   // 0 表示账期内所有有活动的用户
+}
+
+// List lists generated statements
+// GET /api/v1/admin/statements
+// Query: user_id, period
+func (h *StatementHandler) List(c *gin.Context) {
+	filters := service.StatementFilters{Period: strings.TrimSpace(c.Query("period"))}
+	if userIDStr := c.Query("user_id"); userIDStr != "" {
+		userID, err := strconv.ParseInt(userIDStr, 10, 64)
+		if err != nil || userID <= 0 {
+			response.BadRequest(c, "Invalid user_id")
+			return
+		}
+		filters.UserID = userID
+	}
+
+	page, pageSize := response.ParsePagination(c)
+	params := pagination.PaginationParams{Page: page, PageSize: pageSize}
+	items, result, err := h.statementService.ListStatements(c.Request.Context(), params, filters)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Paginated(c, items, result.Total, page, pageSize)
+}
+
+// Regenerate rebuilds statements for one user (synchronously) or a whole period (in background)
+// POST /api/v1/admin/statements/regenerate
+func (h *StatementHandler) Regenerate(c *gin.Context) {
+	var req RegenerateStatementsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+	period := strings.TrimSpace(req.Period)
+
+	if req.UserID > 0 {
+		statement, err := h.statementService.GenerateForUser(c.Request.Context(), req.UserID, period, false)
+		if err != nil {
+			response.ErrorFrom(c, err)
+			return
+		}
+		response.Success(c, statement)
+		return
+	}
+
+	if err := h.statementService.RegeneratePeriodAsync(period); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, gin.H{"period": period, "queued": true})
+}
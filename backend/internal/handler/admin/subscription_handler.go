@@ -1,6 +1,8 @@
 package admin
 
 import (
+	"errors"
+	"io"
 	"strconv"
 
 	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
@@ -58,6 +60,17 @@ type AdjustSubscriptionRequest struct {
 	Days int `json:"days" binding:"required,min=-36500,max=36500"` // negative to shorten, positive to extend
 }
 
+// SubscriptionLifecycleRequest represents the optional payload of pause/resume requests
+type SubscriptionLifecycleRequest struct {
+	Notes string `json:"notes"`
+}
+
+// TransferSubscriptionRequest represents transfer subscription request
+type TransferSubscriptionRequest struct {
+	UserID int64  `json:"user_id" binding:"required,min=1"`
+	Notes  string `json:"notes"`
+}
+
 // List handles listing all subscriptions with pagination and filters
 // GET /api/v1/admin/subscriptions
 func (h *SubscriptionHandler) List(c *gin.Context) {
@@ -222,6 +235,106 @@ func (h *SubscriptionHandler) Revoke(c *gin.Context) {
 	response.Success(c, gin.H{"message": "Subscription revoked successfully"})
 }
 
+// Pause handles pausing a subscription (stops the expiry clock)
+// POST /api/v1/admin/subscriptions/:id/pause
+func (h *SubscriptionHandler) Pause(c *gin.Context) {
+	subscriptionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid subscription ID")
+		return
+	}
+
+	// 请求体可省略
+	var req SubscriptionLifecycleRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	subscription, err := h.subscriptionService.PauseSubscription(c.Request.Context(), subscriptionID, h.adminActor(c, req.Notes))
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, dto.UserSubscriptionFromServiceAdmin(subscription))
+}
+
+// Resume handles resuming a paused subscription
+// POST /api/v1/admin/subscriptions/:id/resume
+func (h *SubscriptionHandler) Resume(c *gin.Context) {
+	subscriptionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid subscription ID")
+		return
+	}
+
+	// 请求体可省略
+	var req SubscriptionLifecycleRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	subscription, err := h.subscriptionService.ResumeSubscription(c.Request.Context(), subscriptionID, h.adminActor(c, req.Notes))
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, dto.UserSubscriptionFromServiceAdmin(subscription))
+}
+
+// Transfer handles transferring a subscription to another user
+// POST /api/v1/admin/subscriptions/:id/transfer
+func (h *SubscriptionHandler) Transfer(c *gin.Context) {
+	subscriptionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid subscription ID")
+		return
+	}
+
+	var req TransferSubscriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	subscription, err := h.subscriptionService.TransferSubscription(c.Request.Context(), subscriptionID, req.UserID, h.adminActor(c, req.Notes))
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, dto.UserSubscriptionFromServiceAdmin(subscription))
+}
+
+// ListEvents handles listing the pause/resume/transfer audit trail of a subscription
+// GET /api/v1/admin/subscriptions/:id/events
+func (h *SubscriptionHandler) ListEvents(c *gin.Context) {
+	subscriptionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid subscription ID")
+		return
+	}
+
+	events, err := h.subscriptionService.ListSubscriptionEvents(c.Request.Context(), subscriptionID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	out := make([]dto.SubscriptionEvent, 0, len(events))
+	for i := range events {
+		out = append(out, *dto.SubscriptionEventFromService(&events[i]))
+	}
+	response.Success(c, out)
+}
+
+func (h *SubscriptionHandler) adminActor(c *gin.Context, notes string) service.SubscriptionActor {
+	return service.SubscriptionActor{UserID: getAdminIDFromContext(c), Admin: true, Notes: notes}
+}
+
 // ListByGroup handles listing subscriptions for a specific group
 // GET /api/v1/admin/groups/:id/subscriptions
 func (h *SubscriptionHandler) ListByGroup(c *gin.Context) {
@@ -0,0 +1,136 @@
+package admin
+
+import (
+	"strconv"
+
+	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SubscriptionPlanHandler handles admin subscription plan management
+type SubscriptionPlanHandler struct {
+	planService *service.SubscriptionPlanService
+}
+
+// NewSubscriptionPlanHandler creates a new admin subscription plan handler
+func NewSubscriptionPlanHandler(planService *service.SubscriptionPlanService) *SubscriptionPlanHandler {
+	return &SubscriptionPlanHandler{
+		planService: planService,
+	}
+}
+
+// SubscriptionPlanRequest represents the create/update subscription plan payload
+// 限额单位为 USD，留空表示沿用分组限额；status 为空时默认 active
+type SubscriptionPlanRequest struct {
+	Name            string   `json:"name" binding:"required,max=100"`
+	Description     string   `json:"description"`
+	GroupID         int64    `json:"group_id" binding:"required,min=1"`
+	DurationDays    int      `json:"duration_days" binding:"required,min=1,max=36500"`
+	Price           float64  `json:"price" binding:"min=0"`
+	DailyLimitUSD   *float64 `json:"daily_limit_usd"`
+	WeeklyLimitUSD  *float64 `json:"weekly_limit_usd"`
+	MonthlyLimitUSD *float64 `json:"monthly_limit_usd"`
+	Status          string   `json:"status" binding:"omitempty,oneof=active disabled"`
+	SortOrder       int      `json:"sort_order"`
+}
+
+func (r *SubscriptionPlanRequest) toInput() service.SubscriptionPlanInput {
+	return service.SubscriptionPlanInput{
+		Name:            r.Name,
+		Description:     r.Description,
+		GroupID:         r.GroupID,
+		DurationDays:    r.DurationDays,
+		Price:           r.Price,
+		DailyLimitUSD:   r.DailyLimitUSD,
+		WeeklyLimitUSD:  r.WeeklyLimitUSD,
+		MonthlyLimitUSD: r.MonthlyLimitUSD,
+		Status:          r.Status,
+		SortOrder:       r.SortOrder,
+	}
+}
+
+// List lists all subscription plans, including disabled ones
+// GET /api/v1/admin/subscription-plans
+func (h *SubscriptionPlanHandler) List(c *gin.Context) {
+	plans, err := h.planService.List(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	out := make([]dto.SubscriptionPlan, 0, len(plans))
+	for i := range plans {
+		out = append(out, *dto.SubscriptionPlanFromService(&plans[i]))
+	}
+	response.Success(c, out)
+}
+
+// GetByID returns a subscription plan
+// GET /api/v1/admin/subscription-plans/:id
+func (h *SubscriptionPlanHandler) GetByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid subscription plan ID")
+		return
+	}
+	plan, err := h.planService.GetByID(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, dto.SubscriptionPlanFromService(plan))
+}
+
+// Create creates a subscription plan
+// POST /api/v1/admin/subscription-plans
+func (h *SubscriptionPlanHandler) Create(c *gin.Context) {
+	var req SubscriptionPlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+	plan, err := h.planService.Create(c.Request.Context(), req.toInput())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, dto.SubscriptionPlanFromService(plan))
+}
+
+// Update replaces a subscription plan
+// PUT /api/v1/admin/subscription-plans/:id
+func (h *SubscriptionPlanHandler) Update(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid subscription plan ID")
+		return
+	}
+	var req SubscriptionPlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+	plan, err := h.planService.Update(c.Request.Context(), id, req.toInput())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, dto.SubscriptionPlanFromService(plan))
+}
+
+// Delete deletes a subscription plan
+// DELETE /api/v1/admin/subscription-plans/:id
+func (h *SubscriptionPlanHandler) Delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid subscription plan ID")
+		return
+	}
+	if err := h.planService.Delete(c.Request.Context(), id); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, gin.H{"message": "Subscription plan deleted successfully"})
+}
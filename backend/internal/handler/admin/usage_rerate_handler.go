@@ -0,0 +1,188 @@
+package admin
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+	"github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// UsageRerateHandler handles usage re-rate task requests
+type UsageRerateHandler struct {
+	rerateService *service.UsageRerateService
+}
+
+// NewUsageRerateHandler creates a new usage re-rate handler
+func NewUsageRerateHandler(rerateService *service.UsageRerateService) *UsageRerateHandler {
+	return &UsageRerateHandler{rerateService: rerateService}
+}
+
+// CreateUsageRerateTaskRequest represents re-rate task creation request
+// Filters are the same as cleanup tasks; apply_changes writes new costs back to usage logs,
+// post_adjustments (requires apply_changes) posts balance adjustments for the differences.
+type CreateUsageRerateTaskRequest struct {
+	CreateUsageCleanupTaskRequest
+	ApplyChanges    bool `json:"apply_changes"`
+	PostAdjustments bool `json:"post_adjustments"`
+}
+
+// ListTasks handles listing re-rate tasks
+// GET /api/v1/admin/usage/rerate-tasks
+func (h *UsageRerateHandler) ListTasks(c *gin.Context) {
+	if h.rerateService == nil {
+		response.Error(c, http.StatusServiceUnavailable, "Usage rerate service unavailable")
+		return
+	}
+	page, pageSize := response.ParsePagination(c)
+	tasks, result, err := h.rerateService.ListTasks(c.Request.Context(), pagination.PaginationParams{Page: page, PageSize: pageSize})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	out := make([]dto.UsageRerateTask, 0, len(tasks))
+	for i := range tasks {
+		out = append(out, *dto.UsageRerateTaskFromService(&tasks[i]))
+	}
+	response.Paginated(c, out, result.Total, page, pageSize)
+}
+
+// GetTask handles getting a re-rate task
+// GET /api/v1/admin/usage/rerate-tasks/:id
+func (h *UsageRerateHandler) GetTask(c *gin.Context) {
+	if h.rerateService == nil {
+		response.Error(c, http.StatusServiceUnavailable, "Usage rerate service unavailable")
+		return
+	}
+	taskID, ok := parseRerateTaskID(c)
+	if !ok {
+		return
+	}
+	task, err := h.rerateService.GetTask(c.Request.Context(), taskID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, dto.UsageRerateTaskFromService(task))
+}
+
+// ListDiffs handles listing the per-user diff report of a re-rate task
+// GET /api/v1/admin/usage/rerate-tasks/:id/diffs
+func (h *UsageRerateHandler) ListDiffs(c *gin.Context) {
+	if h.rerateService == nil {
+		response.Error(c, http.StatusServiceUnavailable, "Usage rerate service unavailable")
+		return
+	}
+	taskID, ok := parseRerateTaskID(c)
+	if !ok {
+		return
+	}
+	page, pageSize := response.ParsePagination(c)
+	diffs, result, err := h.rerateService.ListDiffs(c.Request.Context(), taskID, pagination.PaginationParams{Page: page, PageSize: pageSize})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Paginated(c, diffs, result.Total, page, pageSize)
+}
+
+// CreateTask handles creating a re-rate task
+// POST /api/v1/admin/usage/rerate-tasks
+func (h *UsageRerateHandler) CreateTask(c *gin.Context) {
+	if h.rerateService == nil {
+		response.Error(c, http.StatusServiceUnavailable, "Usage rerate service unavailable")
+		return
+	}
+	subject, ok := middleware.GetAuthSubjectFromContext(c)
+	if !ok || subject.UserID <= 0 {
+		response.Unauthorized(c, "Unauthorized")
+		return
+	}
+
+	var req CreateUsageRerateTaskRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+	req.StartDate = strings.TrimSpace(req.StartDate)
+	req.EndDate = strings.TrimSpace(req.EndDate)
+	if req.StartDate == "" || req.EndDate == "" {
+		response.BadRequest(c, "start_date and end_date are required")
+		return
+	}
+	startTime, err := timezone.ParseInUserLocation("2006-01-02", req.StartDate, req.Timezone)
+	if err != nil {
+		response.BadRequest(c, "Invalid start_date format, use YYYY-MM-DD")
+		return
+	}
+	endTime, err := timezone.ParseInUserLocation("2006-01-02", req.EndDate, req.Timezone)
+	if err != nil {
+		response.BadRequest(c, "Invalid end_date format, use YYYY-MM-DD")
+		return
+	}
+	endTime = endTime.Add(24*time.Hour - time.Nanosecond)
+
+	input := service.UsageRerateTaskInput{
+		Filters: service.UsageCleanupFilters{
+			StartTime:   startTime,
+			EndTime:     endTime,
+			UserID:      req.UserID,
+			APIKeyID:    req.APIKeyID,
+			AccountID:   req.AccountID,
+			GroupID:     req.GroupID,
+			Model:       req.Model,
+			Stream:      req.Stream,
+			BillingType: req.BillingType,
+		},
+		ApplyChanges:    req.ApplyChanges,
+		PostAdjustments: req.PostAdjustments,
+	}
+	task, err := h.rerateService.CreateTask(c.Request.Context(), input, subject.UserID)
+	if err != nil {
+		log.Printf("[UsageRerate] 创建重新计费任务失败: operator=%d err=%v", subject.UserID, err)
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, dto.UsageRerateTaskFromService(task))
+}
+
+// CancelTask handles canceling a re-rate task
+// POST /api/v1/admin/usage/rerate-tasks/:id/cancel
+func (h *UsageRerateHandler) CancelTask(c *gin.Context) {
+	if h.rerateService == nil {
+		response.Error(c, http.StatusServiceUnavailable, "Usage rerate service unavailable")
+		return
+	}
+	subject, ok := middleware.GetAuthSubjectFromContext(c)
+	if !ok || subject.UserID <= 0 {
+		response.Unauthorized(c, "Unauthorized")
+		return
+	}
+	taskID, ok := parseRerateTaskID(c)
+	if !ok {
+		return
+	}
+	if err := h.rerateService.CancelTask(c.Request.Context(), taskID, subject.UserID); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, gin.H{"id": taskID, "status": service.UsageRerateStatusCanceled})
+}
+
+func parseRerateTaskID(c *gin.Context) (int64, bool) {
+	taskID, err := strconv.ParseInt(strings.TrimSpace(c.Param("id")), 10, 64)
+	if err != nil || taskID <= 0 {
+		response.BadRequest(c, "Invalid task id")
+		return 0, false
+	}
+	return taskID, true
+}
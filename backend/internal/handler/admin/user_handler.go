@@ -5,7 +5,9 @@ import (
 	"strings"
 
 	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
 	"github.com/Wei-Shaw/sub2api/internal/service"
 
 	"github.com/gin-gonic/gin"
@@ -13,13 +15,15 @@ import (
 
 // UserHandler handles admin user management
 type UserHandler struct {
-	adminService service.AdminService
+	adminService         service.AdminService
+	balanceLedgerService *service.BalanceLedgerService
 }
 
 // NewUserHandler creates a new admin user handler
-func NewUserHandler(adminService service.AdminService) *UserHandler {
+func NewUserHandler(adminService service.AdminService, balanceLedgerService *service.BalanceLedgerService) *UserHandler {
 	return &UserHandler{
-		adminService: adminService,
+		adminService:         adminService,
+		balanceLedgerService: balanceLedgerService,
 	}
 }
 
@@ -277,3 +281,69 @@ func (h *UserHandler) GetUserUsage(c *gin.Context) {
 
 	response.Success(c, stats)
 }
+
+// GetBalanceTransactions handles listing a user's balance ledger
+// GET /api/v1/admin/users/:id/balance-transactions
+func (h *UserHandler) GetBalanceTransactions(c *gin.Context) {
+	userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	filters := service.BalanceTransactionFilters{Type: strings.TrimSpace(c.Query("type"))}
+	userTZ := c.Query("timezone")
+	if startDateStr := c.Query("start_date"); startDateStr != "" {
+		t, err := timezone.ParseInUserLocation("2006-01-02", startDateStr, userTZ)
+		if err != nil {
+			response.BadRequest(c, "Invalid start_date format, use YYYY-MM-DD")
+			return
+		}
+		filters.StartTime = &t
+	}
+	if endDateStr := c.Query("end_date"); endDateStr != "" {
+		t, err := timezone.ParseInUserLocation("2006-01-02", endDateStr, userTZ)
+		if err != nil {
+			response.BadRequest(c, "Invalid end_date format, use YYYY-MM-DD")
+			return
+		}
+		t = t.AddDate(0, 0, 1)
+		filters.EndTime = &t
+	}
+
+	page, pageSize := response.ParsePagination(c)
+	params := pagination.PaginationParams{Page: page, PageSize: pageSize}
+	items, result, err := h.balanceLedgerService.ListUserTransactions(c.Request.Context(), userID, params, filters)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	out := make([]dto.AdminBalanceTransaction, 0, len(items))
+	for i := range items {
+		out = append(out, *dto.BalanceTransactionFromServiceAdmin(&items[i]))
+	}
+	response.Paginated(c, out, result.Total, page, pageSize)
+}
+
+// GetBalanceReconciliation returns the latest ledger reconciliation report
+// GET /api/v1/admin/balance-ledger/reconciliation
+func (h *UserHandler) GetBalanceReconciliation(c *gin.Context) {
+	report := h.balanceLedgerService.LastReconcileReport()
+	if report == nil {
+		response.Success(c, gin.H{"checked_at": nil, "drifts": []service.BalanceDrift{}})
+		return
+	}
+	response.Success(c, report)
+}
+
+// RunBalanceReconciliation runs a ledger reconciliation immediately
+// POST /api/v1/admin/balance-ledger/reconcile
+func (h *UserHandler) RunBalanceReconciliation(c *gin.Context) {
+	report, err := h.balanceLedgerService.Reconcile(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, report)
+}
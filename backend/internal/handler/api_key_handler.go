@@ -2,6 +2,7 @@
 package handler
 
 import (
+	"log"
 	"strconv"
 
 	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
@@ -15,13 +16,15 @@ import (
 
 // APIKeyHandler handles API key-related requests
 type APIKeyHandler struct {
-	apiKeyService *service.APIKeyService
+	apiKeyService       *service.APIKeyService
+	billingCacheService *service.BillingCacheService
 }
 
 // NewAPIKeyHandler creates a new APIKeyHandler
-func NewAPIKeyHandler(apiKeyService *service.APIKeyService) *APIKeyHandler {
+func NewAPIKeyHandler(apiKeyService *service.APIKeyService, billingCacheService *service.BillingCacheService) *APIKeyHandler {
 	return &APIKeyHandler{
-		apiKeyService: apiKeyService,
+		apiKeyService:       apiKeyService,
+		billingCacheService: billingCacheService,
 	}
 }
 
@@ -32,6 +35,13 @@ type CreateAPIKeyRequest struct {
 	CustomKey   *string  `json:"custom_key"`   // 可选的自定义key
 	IPWhitelist []string `json:"ip_whitelist"` // IP 白名单
 	IPBlacklist []string `json:"ip_blacklist"` // IP 黑名单
+
+	// 限额（不传或 <=0 表示无限制）
+	DailyLimitUSD       *float64 `json:"daily_limit_usd"`
+	MonthlyLimitUSD     *float64 `json:"monthly_limit_usd"`
+	TotalLimitUSD       *float64 `json:"total_limit_usd"`
+	DailyRequestLimit   *int64   `json:"daily_request_limit"`
+	MonthlyRequestLimit *int64   `json:"monthly_request_limit"`
 }
 
 // UpdateAPIKeyRequest represents the update API key request payload
@@ -41,6 +51,13 @@ type UpdateAPIKeyRequest struct {
 	Status      string   `json:"status" binding:"omitempty,oneof=active inactive"`
 	IPWhitelist []string `json:"ip_whitelist"` // IP 白名单
 	IPBlacklist []string `json:"ip_blacklist"` // IP 黑名单
+
+	// 限额（不传表示不修改，<=0 表示清除限额）
+	DailyLimitUSD       *float64 `json:"daily_limit_usd"`
+	MonthlyLimitUSD     *float64 `json:"monthly_limit_usd"`
+	TotalLimitUSD       *float64 `json:"total_limit_usd"`
+	DailyRequestLimit   *int64   `json:"daily_request_limit"`
+	MonthlyRequestLimit *int64   `json:"monthly_request_limit"`
 }
 
 // List handles listing user's API keys with pagination
@@ -63,7 +80,17 @@ func (h *APIKeyHandler) List(c *gin.Context) {
 
 	out := make([]dto.APIKey, 0, len(keys))
 	for i := range keys {
-		out = append(out, *dto.APIKeyFromService(&keys[i]))
+		item := dto.APIKeyFromService(&keys[i])
+		// 配置了限额的 Key 附带当前用量，便于展示 用量/限额
+		if keys[i].HasUsageLimits() && h.billingCacheService != nil {
+			usage, err := h.billingCacheService.GetAPIKeyLimitUsage(c.Request.Context(), keys[i].ID)
+			if err != nil {
+				log.Printf("Warning: get api key usage failed for api key %d: %v", keys[i].ID, err)
+			} else {
+				item.Usage = dto.APIKeyLimitUsageFromService(usage)
+			}
+		}
+		out = append(out, *item)
 	}
 	response.Paginated(c, out, result.Total, page, pageSize)
 }
@@ -119,6 +146,12 @@ func (h *APIKeyHandler) Create(c *gin.Context) {
 		CustomKey:   req.CustomKey,
 		IPWhitelist: req.IPWhitelist,
 		IPBlacklist: req.IPBlacklist,
+
+		DailyLimitUSD:       req.DailyLimitUSD,
+		MonthlyLimitUSD:     req.MonthlyLimitUSD,
+		TotalLimitUSD:       req.TotalLimitUSD,
+		DailyRequestLimit:   req.DailyRequestLimit,
+		MonthlyRequestLimit: req.MonthlyRequestLimit,
 	}
 	key, err := h.apiKeyService.Create(c.Request.Context(), subject.UserID, svcReq)
 	if err != nil {
@@ -153,6 +186,12 @@ func (h *APIKeyHandler) Update(c *gin.Context) {
 	svcReq := service.UpdateAPIKeyRequest{
 		IPWhitelist: req.IPWhitelist,
 		IPBlacklist: req.IPBlacklist,
+
+		DailyLimitUSD:       req.DailyLimitUSD,
+		MonthlyLimitUSD:     req.MonthlyLimitUSD,
+		TotalLimitUSD:       req.TotalLimitUSD,
+		DailyRequestLimit:   req.DailyRequestLimit,
+		MonthlyRequestLimit: req.MonthlyRequestLimit,
 	}
 	if req.Name != "" {
 		svcReq.Name = &req.Name
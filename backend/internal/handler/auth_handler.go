@@ -37,7 +37,8 @@ type RegisterRequest struct {
 	Password       string `json:"password" binding:"required,min=6"`
 	VerifyCode     string `json:"verify_code"`
 	TurnstileToken string `json:"turnstile_token"`
-	PromoCode      string `json:"promo_code"` // 注册优惠码
+	PromoCode      string `json:"promo_code"`    // 注册优惠码
+	ReferralCode   string `json:"referral_code"` // 邀请码
 }
 
 // SendVerifyCodeRequest 发送验证码请求
@@ -83,7 +84,7 @@ func (h *AuthHandler) Register(c *gin.Context) {
 		}
 	}
 
-	token, user, err := h.authService.RegisterWithVerification(c.Request.Context(), req.Email, req.Password, req.VerifyCode, req.PromoCode)
+	token, user, err := h.authService.RegisterWithVerification(c.Request.Context(), req.Email, req.Password, req.VerifyCode, req.PromoCode, req.ReferralCode)
 	if err != nil {
 		response.ErrorFrom(c, err)
 		return
@@ -190,6 +191,8 @@ type ValidatePromoCodeResponse struct {
 	BonusAmount float64 `json:"bonus_amount,omitempty"`
 	ErrorCode   string  `json:"error_code,omitempty"`
 	Message     string  `json:"message,omitempty"`
+
+	TrialDays int `json:"trial_days,omitempty"` // 赠送订阅试用天数
 }
 
 // ValidatePromoCode 验证优惠码（公开接口，注册前调用）
@@ -225,6 +228,8 @@ func (h *AuthHandler) ValidatePromoCode(c *gin.Context) {
 			errorCode = "PROMO_CODE_MAX_USED"
 		case service.ErrPromoCodeAlreadyUsed:
 			errorCode = "PROMO_CODE_ALREADY_USED"
+		case service.ErrPromoCodeNotApplicable:
+			errorCode = "PROMO_CODE_NOT_APPLICABLE"
 		}
 
 		response.Success(c, ValidatePromoCodeResponse{
@@ -242,8 +247,12 @@ func (h *AuthHandler) ValidatePromoCode(c *gin.Context) {
 		return
 	}
 
-	response.Success(c, ValidatePromoCodeResponse{
+	resp := ValidatePromoCodeResponse{
 		Valid:       true,
 		BonusAmount: promoCode.BonusAmount,
-	})
+	}
+	if promoCode.HasTrial() {
+		resp.TrialDays = promoCode.TrialDays
+	}
+	response.Success(c, resp)
 }
@@ -29,11 +29,13 @@ const (
 	linuxDoOAuthStateCookieName   = "linuxdo_oauth_state"
 	linuxDoOAuthVerifierCookie    = "linuxdo_oauth_verifier"
 	linuxDoOAuthRedirectCookie    = "linuxdo_oauth_redirect"
+	linuxDoOAuthReferralCookie    = "linuxdo_oauth_referral"
 	linuxDoOAuthCookieMaxAgeSec   = 10 * 60 // 10 minutes
 	linuxDoOAuthDefaultRedirectTo = "/dashboard"
 	linuxDoOAuthDefaultFrontendCB = "/auth/linuxdo/callback"
 
 	linuxDoOAuthMaxRedirectLen      = 2048
+	linuxDoOAuthMaxReferralCodeLen  = 32
 	linuxDoOAuthMaxFragmentValueLen = 512
 	linuxDoOAuthMaxSubjectLen       = 64 - len("linuxdo-")
 )
@@ -68,7 +70,7 @@ func (e *linuxDoTokenExchangeError) Error() string {
 }
 
 // LinuxDoOAuthStart 启动 LinuxDo Connect OAuth 登录流程。
-// GET /api/v1/auth/oauth/linuxdo/start?redirect=/dashboard
+// GET /api/v1/auth/oauth/linuxdo/start?redirect=/dashboard&referral_code=ABCD1234
 func (h *AuthHandler) LinuxDoOAuthStart(c *gin.Context) {
 	cfg, err := h.getLinuxDoOAuthConfig(c.Request.Context())
 	if err != nil {
@@ -90,6 +92,10 @@ func (h *AuthHandler) LinuxDoOAuthStart(c *gin.Context) {
 	secureCookie := isRequestHTTPS(c)
 	setCookie(c, linuxDoOAuthStateCookieName, encodeCookieValue(state), linuxDoOAuthCookieMaxAgeSec, secureCookie)
 	setCookie(c, linuxDoOAuthRedirectCookie, encodeCookieValue(redirectTo), linuxDoOAuthCookieMaxAgeSec, secureCookie)
+	// 邀请码仅在首次登录创建用户时生效
+	if referralCode := strings.TrimSpace(c.Query("referral_code")); referralCode != "" && len(referralCode) <= linuxDoOAuthMaxReferralCodeLen {
+		setCookie(c, linuxDoOAuthReferralCookie, encodeCookieValue(referralCode), linuxDoOAuthCookieMaxAgeSec, secureCookie)
+	}
 
 	codeChallenge := ""
 	if cfg.UsePKCE {
@@ -148,6 +154,7 @@ func (h *AuthHandler) LinuxDoOAuthCallback(c *gin.Context) {
 		clearCookie(c, linuxDoOAuthStateCookieName, secureCookie)
 		clearCookie(c, linuxDoOAuthVerifierCookie, secureCookie)
 		clearCookie(c, linuxDoOAuthRedirectCookie, secureCookie)
+		clearCookie(c, linuxDoOAuthReferralCookie, secureCookie)
 	}()
 
 	expectedState, err := readCookieDecoded(c, linuxDoOAuthStateCookieName)
@@ -211,7 +218,8 @@ func (h *AuthHandler) LinuxDoOAuthCallback(c *gin.Context) {
 		email = linuxDoSyntheticEmail(subject)
 	}
 
-	jwtToken, _, err := h.authService.LoginOrRegisterOAuth(c.Request.Context(), email, username)
+	referralCode, _ := readCookieDecoded(c, linuxDoOAuthReferralCookie)
+	jwtToken, _, err := h.authService.LoginOrRegisterOAuth(c.Request.Context(), email, username, referralCode)
 	if err != nil {
 		// 避免把内部细节泄露给客户端；给前端保留结构化原因与提示信息即可。
 		redirectOAuthError(c, frontendCallback, "login_failed", infraerrors.Reason(err), infraerrors.Message(err))
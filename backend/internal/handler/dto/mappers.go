@@ -78,8 +78,30 @@ func APIKeyFromService(k *service.APIKey) *APIKey {
 		IPBlacklist: k.IPBlacklist,
 		CreatedAt:   k.CreatedAt,
 		UpdatedAt:   k.UpdatedAt,
-		User:        UserFromServiceShallow(k.User),
-		Group:       GroupFromServiceShallow(k.Group),
+
+		DailyLimitUSD:       k.DailyLimitUSD,
+		MonthlyLimitUSD:     k.MonthlyLimitUSD,
+		TotalLimitUSD:       k.TotalLimitUSD,
+		DailyRequestLimit:   k.DailyRequestLimit,
+		MonthlyRequestLimit: k.MonthlyRequestLimit,
+
+		OrganizationID: k.OrganizationID,
+
+		User:  UserFromServiceShallow(k.User),
+		Group: GroupFromServiceShallow(k.Group),
+	}
+}
+
+func APIKeyLimitUsageFromService(u *service.APIKeyLimitUsage) *APIKeyLimitUsage {
+	if u == nil {
+		return nil
+	}
+	return &APIKeyLimitUsage{
+		DailyUsage:      u.DailyUsage,
+		MonthlyUsage:    u.MonthlyUsage,
+		TotalUsage:      u.TotalUsage,
+		DailyRequests:   u.DailyRequests,
+		MonthlyRequests: u.MonthlyRequests,
 	}
 }
 
@@ -105,10 +127,15 @@ func GroupFromServiceAdmin(g *service.Group) *AdminGroup {
 		return nil
 	}
 	out := &AdminGroup{
-		Group:               groupFromServiceBase(g),
-		ModelRouting:        g.ModelRouting,
-		ModelRoutingEnabled: g.ModelRoutingEnabled,
-		AccountCount:        g.AccountCount,
+		Group:                    groupFromServiceBase(g),
+		ModelRouting:             g.ModelRouting,
+		ModelRoutingEnabled:      g.ModelRoutingEnabled,
+		PoolSplitEnabled:         g.PoolSplitEnabled,
+		PoolWeights:              g.PoolWeights,
+		CanaryMaxErrorRate:       g.CanaryMaxErrorRate,
+		CapacityFallbackGroupIDs: g.CapacityFallbackGroupIDs,
+		FallbackModelMapping:     g.FallbackModelMapping,
+		AccountCount:             g.AccountCount,
 	}
 	if len(g.AccountGroups) > 0 {
 		out.AccountGroups = make([]AccountGroup, 0, len(g.AccountGroups))
@@ -138,11 +165,48 @@ func groupFromServiceBase(g *service.Group) Group {
 		ImagePrice4K:     g.ImagePrice4K,
 		ClaudeCodeOnly:   g.ClaudeCodeOnly,
 		FallbackGroupID:  g.FallbackGroupID,
+		VolumeTiers:      volumeTiersFromService(g.VolumeTiers),
 		CreatedAt:        g.CreatedAt,
 		UpdatedAt:        g.UpdatedAt,
+
+		OverageEnabled:        g.OverageEnabled,
+		OverageRateMultiplier: g.OverageRateMultiplier,
+
+		RollingWindowHours:    g.RollingWindowHours,
+		RollingWindowLimitUSD: g.RollingWindowLimitUSD,
+
+		UsageQuotas: usageQuotasFromService(g.UsageQuotas),
 	}
 }
 
+func volumeTiersFromService(tiers []service.VolumeTier) []VolumeTier {
+	if len(tiers) == 0 {
+		return nil
+	}
+	out := make([]VolumeTier, 0, len(tiers))
+	for _, t := range tiers {
+		out = append(out, VolumeTier{MinSpend: t.MinSpend, RateMultiplier: t.RateMultiplier})
+	}
+	return out
+}
+
+func usageQuotasFromService(quotas []service.UsageQuota) []UsageQuota {
+	if len(quotas) == 0 {
+		return nil
+	}
+	out := make([]UsageQuota, 0, len(quotas))
+	for _, q := range quotas {
+		out = append(out, UsageQuota{
+			Window:          q.Window,
+			Model:           q.Model,
+			MaxRequests:     q.MaxRequests,
+			MaxInputTokens:  q.MaxInputTokens,
+			MaxOutputTokens: q.MaxOutputTokens,
+		})
+	}
+	return out
+}
+
 func AccountFromServiceShallow(a *service.Account) *Account {
 	if a == nil {
 		return nil
@@ -175,9 +239,22 @@ func AccountFromServiceShallow(a *service.Account) *Account {
 		SessionWindowStart:      a.SessionWindowStart,
 		SessionWindowEnd:        a.SessionWindowEnd,
 		SessionWindowStatus:     a.SessionWindowStatus,
+		AvailabilityWindows:     a.AvailabilityWindows,
+		AvailabilityTimezone:    a.AvailabilityTimezone,
 		GroupIDs:                a.GroupIDs,
 	}
 
+	// 提取日/周/月消费上限配置
+	if limit := a.GetSpendCap(service.SpendCapPeriodDaily); limit > 0 {
+		out.DailySpendCap = &limit
+	}
+	if limit := a.GetSpendCap(service.SpendCapPeriodWeekly); limit > 0 {
+		out.WeeklySpendCap = &limit
+	}
+	if limit := a.GetSpendCap(service.SpendCapPeriodMonthly); limit > 0 {
+		out.MonthlySpendCap = &limit
+	}
+
 	// 提取 5h 窗口费用控制和会话数量控制配置（仅 Anthropic OAuth/SetupToken 账号有效）
 	if a.IsAnthropicOAuthOrSetupToken() {
 		if limit := a.GetWindowCostLimit(); limit > 0 {
@@ -245,6 +322,7 @@ func AccountGroupFromService(ag *service.AccountGroup) *AccountGroup {
 		AccountID: ag.AccountID,
 		GroupID:   ag.GroupID,
 		Priority:  ag.Priority,
+		Pool:      ag.Pool,
 		CreatedAt: ag.CreatedAt,
 		Account:   AccountFromServiceShallow(ag.Account),
 		Group:     GroupFromServiceShallow(ag.Group),
@@ -308,6 +386,66 @@ func RedeemCodeFromService(rc *service.RedeemCode) *RedeemCode {
 	return &out
 }
 
+func BalanceTransactionFromService(tx *service.BalanceTransaction) *BalanceTransaction {
+	if tx == nil {
+		return nil
+	}
+	return &BalanceTransaction{
+		ID:             tx.ID,
+		Type:           tx.Type,
+		Amount:         tx.Amount,
+		BalanceAfter:   tx.BalanceAfter,
+		UsageLogID:     tx.UsageLogID,
+		RedeemCodeID:   tx.RedeemCodeID,
+		PromoCodeID:    tx.PromoCodeID,
+		PaymentOrderID: tx.PaymentOrderID,
+		CreditLotID:    tx.CreditLotID,
+		CreatedAt:      tx.CreatedAt,
+	}
+}
+
+// BalanceTransactionFromServiceAdmin includes notes - user-facing endpoints must not use this.
+func BalanceTransactionFromServiceAdmin(tx *service.BalanceTransaction) *AdminBalanceTransaction {
+	if tx == nil {
+		return nil
+	}
+	return &AdminBalanceTransaction{
+		BalanceTransaction: *BalanceTransactionFromService(tx),
+		UserID:             tx.UserID,
+		Notes:              tx.Notes,
+	}
+}
+
+func PaymentOrderFromService(o *service.PaymentOrder) *PaymentOrder {
+	if o == nil {
+		return nil
+	}
+	return &PaymentOrder{
+		OrderNo:      o.OrderNo,
+		Provider:     o.Provider,
+		Amount:       o.Amount,
+		Currency:     o.Currency,
+		CreditAmount: o.CreditAmount,
+		Status:       o.Status,
+		PayURL:       o.PayURL,
+		PaidAt:       o.PaidAt,
+		ExpiresAt:    o.ExpiresAt,
+		CreatedAt:    o.CreatedAt,
+	}
+}
+
+func PaymentOrderFromServiceAdmin(o *service.PaymentOrder) *AdminPaymentOrder {
+	if o == nil {
+		return nil
+	}
+	return &AdminPaymentOrder{
+		PaymentOrder:    *PaymentOrderFromService(o),
+		ID:              o.ID,
+		UserID:          o.UserID,
+		ProviderTradeNo: o.ProviderTradeNo,
+	}
+}
+
 // RedeemCodeFromServiceAdmin converts a service RedeemCode to DTO for admin users.
 // It includes notes - user-facing endpoints must not use this.
 func RedeemCodeFromServiceAdmin(rc *service.RedeemCode) *AdminRedeemCode {
@@ -317,6 +455,10 @@ func RedeemCodeFromServiceAdmin(rc *service.RedeemCode) *AdminRedeemCode {
 	return &AdminRedeemCode{
 		RedeemCode: redeemCodeFromServiceBase(rc),
 		Notes:      rc.Notes,
+
+		BatchID:        rc.BatchID,
+		MaxRedemptions: rc.MaxRedemptions,
+		RedeemedCount:  rc.RedeemedCount,
 	}
 }
 
@@ -334,6 +476,8 @@ func redeemCodeFromServiceBase(rc *service.RedeemCode) RedeemCode {
 		ValidityDays: rc.ValidityDays,
 		User:         UserFromServiceShallow(rc.User),
 		Group:        GroupFromServiceShallow(rc.Group),
+
+		CreditValidityDays: rc.CreditValidityDays,
 	}
 }
 
@@ -373,6 +517,10 @@ func usageLogFromServiceUser(l *service.UsageLog) UsageLog {
 		TotalCost:             l.TotalCost,
 		ActualCost:            l.ActualCost,
 		RateMultiplier:        l.RateMultiplier,
+		VolumeTierMinSpend:    l.VolumeTierMinSpend,
+		VolumeTierMultiplier:  l.VolumeTierMultiplier,
+		SubscriptionCost:      l.SubscriptionCost,
+		OverageCost:           l.OverageCost,
 		BillingType:           l.BillingType,
 		Stream:                l.Stream,
 		DurationMs:            l.DurationMs,
@@ -405,10 +553,32 @@ func UsageLogFromServiceAdmin(l *service.UsageLog) *AdminUsageLog {
 		return nil
 	}
 	return &AdminUsageLog{
-		UsageLog:              usageLogFromServiceUser(l),
-		AccountRateMultiplier: l.AccountRateMultiplier,
-		IPAddress:             l.IPAddress,
-		Account:               AccountSummaryFromService(l.Account),
+		UsageLog:               usageLogFromServiceUser(l),
+		AccountRateMultiplier:  l.AccountRateMultiplier,
+		IPAddress:              l.IPAddress,
+		Account:                AccountSummaryFromService(l.Account),
+		OriginalGroupID:        l.OriginalGroupID,
+		FallbackHop:            l.FallbackHop,
+		PricingVersion:         l.PricingVersion,
+		InputUnitPrice:         l.InputUnitPrice,
+		OutputUnitPrice:        l.OutputUnitPrice,
+		CacheCreationUnitPrice: l.CacheCreationUnitPrice,
+		CacheReadUnitPrice:     l.CacheReadUnitPrice,
+		ImageUnitPrice:         l.ImageUnitPrice,
+	}
+}
+
+func usageCleanupFiltersFromService(f service.UsageCleanupFilters) UsageCleanupFilters {
+	return UsageCleanupFilters{
+		StartTime:   f.StartTime,
+		EndTime:     f.EndTime,
+		UserID:      f.UserID,
+		APIKeyID:    f.APIKeyID,
+		AccountID:   f.AccountID,
+		GroupID:     f.GroupID,
+		Model:       f.Model,
+		Stream:      f.Stream,
+		BillingType: f.BillingType,
 	}
 }
 
@@ -417,19 +587,9 @@ func UsageCleanupTaskFromService(task *service.UsageCleanupTask) *UsageCleanupTa
 		return nil
 	}
 	return &UsageCleanupTask{
-		ID:     task.ID,
-		Status: task.Status,
-		Filters: UsageCleanupFilters{
-			StartTime:   task.Filters.StartTime,
-			EndTime:     task.Filters.EndTime,
-			UserID:      task.Filters.UserID,
-			APIKeyID:    task.Filters.APIKeyID,
-			AccountID:   task.Filters.AccountID,
-			GroupID:     task.Filters.GroupID,
-			Model:       task.Filters.Model,
-			Stream:      task.Filters.Stream,
-			BillingType: task.Filters.BillingType,
-		},
+		ID:           task.ID,
+		Status:       task.Status,
+		Filters:      usageCleanupFiltersFromService(task.Filters),
 		CreatedBy:    task.CreatedBy,
 		DeletedRows:  task.DeletedRows,
 		ErrorMessage: task.ErrorMsg,
@@ -442,6 +602,52 @@ func UsageCleanupTaskFromService(task *service.UsageCleanupTask) *UsageCleanupTa
 	}
 }
 
+func UsageRerateTaskFromService(task *service.UsageRerateTask) *UsageRerateTask {
+	if task == nil {
+		return nil
+	}
+	return &UsageRerateTask{
+		ID:              task.ID,
+		Status:          task.Status,
+		Filters:         usageCleanupFiltersFromService(task.Filters),
+		ApplyChanges:    task.ApplyChanges,
+		PostAdjustments: task.PostAdjustments,
+		ProcessedRows:   task.ProcessedRows,
+		ChangedRows:     task.ChangedRows,
+		OldTotalCost:    task.OldTotalCost,
+		NewTotalCost:    task.NewTotalCost,
+		OldActualCost:   task.OldActualCost,
+		NewActualCost:   task.NewActualCost,
+		CreatedBy:       task.CreatedBy,
+		ErrorMessage:    task.ErrorMsg,
+		CanceledBy:      task.CanceledBy,
+		CanceledAt:      task.CanceledAt,
+		StartedAt:       task.StartedAt,
+		FinishedAt:      task.FinishedAt,
+		CreatedAt:       task.CreatedAt,
+		UpdatedAt:       task.UpdatedAt,
+	}
+}
+
+func AccountProbeLogFromService(entry *service.AccountProbeLog) *AccountProbeLog {
+	if entry == nil {
+		return nil
+	}
+	return &AccountProbeLog{
+		ID:           entry.ID,
+		AccountID:    entry.AccountID,
+		Platform:     entry.Platform,
+		Model:        entry.Model,
+		Trigger:      entry.Trigger,
+		Success:      entry.Success,
+		StatusCode:   entry.StatusCode,
+		LatencyMs:    entry.LatencyMs,
+		ErrorMessage: entry.ErrorMessage,
+		Action:       entry.Action,
+		CreatedAt:    entry.CreatedAt,
+	}
+}
+
 func SettingFromService(s *service.Setting) *Setting {
 	if s == nil {
 		return nil
@@ -491,6 +697,12 @@ func userSubscriptionFromServiceBase(sub *service.UserSubscription) UserSubscrip
 		DailyUsageUSD:      sub.DailyUsageUSD,
 		WeeklyUsageUSD:     sub.WeeklyUsageUSD,
 		MonthlyUsageUSD:    sub.MonthlyUsageUSD,
+		PlanID:             sub.PlanID,
+		AutoRenew:          sub.AutoRenew,
+		DailyLimitUSD:      sub.DailyLimitUSD,
+		WeeklyLimitUSD:     sub.WeeklyLimitUSD,
+		MonthlyLimitUSD:    sub.MonthlyLimitUSD,
+		PausedAt:           sub.PausedAt,
 		CreatedAt:          sub.CreatedAt,
 		UpdatedAt:          sub.UpdatedAt,
 		User:               UserFromServiceShallow(sub.User),
@@ -498,6 +710,59 @@ func userSubscriptionFromServiceBase(sub *service.UserSubscription) UserSubscrip
 	}
 }
 
+func SubscriptionEventFromService(e *service.SubscriptionEvent) *SubscriptionEvent {
+	if e == nil {
+		return nil
+	}
+	return &SubscriptionEvent{
+		ID:             e.ID,
+		SubscriptionID: e.SubscriptionID,
+		Action:         e.Action,
+		FromUserID:     e.FromUserID,
+		ToUserID:       e.ToUserID,
+		OperatorID:     e.OperatorID,
+		OperatorRole:   e.OperatorRole,
+		ExpiresAt:      e.ExpiresAt,
+		Notes:          e.Notes,
+		CreatedAt:      e.CreatedAt,
+	}
+}
+
+func SubscriptionPlanFromService(p *service.SubscriptionPlan) *SubscriptionPlan {
+	if p == nil {
+		return nil
+	}
+	return &SubscriptionPlan{
+		ID:              p.ID,
+		Name:            p.Name,
+		Description:     p.Description,
+		GroupID:         p.GroupID,
+		DurationDays:    p.DurationDays,
+		Price:           p.Price,
+		DailyLimitUSD:   p.DailyLimitUSD,
+		WeeklyLimitUSD:  p.WeeklyLimitUSD,
+		MonthlyLimitUSD: p.MonthlyLimitUSD,
+		Status:          p.Status,
+		SortOrder:       p.SortOrder,
+		CreatedAt:       p.CreatedAt,
+		UpdatedAt:       p.UpdatedAt,
+		Group:           GroupFromServiceShallow(p.Group),
+	}
+}
+
+func SubscriptionPurchaseResultFromService(r *service.SubscriptionPurchaseResult) *SubscriptionPurchaseResult {
+	if r == nil {
+		return nil
+	}
+	return &SubscriptionPurchaseResult{
+		Action:          r.Action,
+		Charged:         r.Charged,
+		ProrationCredit: r.ProrationCredit,
+		Subscription:    UserSubscriptionFromService(r.Subscription),
+		Plan:            SubscriptionPlanFromService(r.Plan),
+	}
+}
+
 func BulkAssignResultFromService(r *service.BulkAssignResult) *BulkAssignResult {
 	if r == nil {
 		return nil
@@ -529,6 +794,18 @@ func PromoCodeFromService(pc *service.PromoCode) *PromoCode {
 		Notes:       pc.Notes,
 		CreatedAt:   pc.CreatedAt,
 		UpdatedAt:   pc.UpdatedAt,
+
+		CreditValidityDays: pc.CreditValidityDays,
+
+		Scope:               pc.Scope,
+		BonusPercent:        pc.BonusPercent,
+		MaxBonusAmount:      pc.MaxBonusAmount,
+		TrialGroupID:        pc.TrialGroupID,
+		TrialDays:           pc.TrialDays,
+		NewUserDays:         pc.NewUserDays,
+		AllowedEmailDomains: pc.AllowedEmailDomains,
+		Stackable:           pc.Stackable,
+		MaxUsesPerUser:      pc.MaxUsesPerUser,
 	}
 }
 
@@ -543,5 +820,199 @@ func PromoCodeUsageFromService(u *service.PromoCodeUsage) *PromoCodeUsage {
 		BonusAmount: u.BonusAmount,
 		UsedAt:      u.UsedAt,
 		User:        UserFromServiceShallow(u.User),
+
+		TopupAmount:    u.TopupAmount,
+		PaymentOrderID: u.PaymentOrderID,
+		TrialGroupID:   u.TrialGroupID,
+		TrialDays:      u.TrialDays,
+	}
+}
+
+func PromoCodeReportItemFromService(item *service.PromoCodeReportItem) *PromoCodeReportItem {
+	if item == nil {
+		return nil
+	}
+	return &PromoCodeReportItem{
+		PromoCodeID: item.PromoCodeID,
+		Code:        item.Code,
+		Scope:       item.Scope,
+		Uses:        item.Uses,
+		Users:       item.Users,
+		BonusAmount: item.BonusAmount,
+		TopupAmount: item.TopupAmount,
+		TrialUses:   item.TrialUses,
+		TrialDays:   item.TrialDays,
+	}
+}
+
+func OrganizationFromService(o *service.Organization) *Organization {
+	if o == nil {
+		return nil
+	}
+	return &Organization{
+		ID:          o.ID,
+		Name:        o.Name,
+		Status:      o.Status,
+		Balance:     o.Balance,
+		MemberCount: o.MemberCount,
+		Role:        o.Role,
+		CreatedAt:   o.CreatedAt,
+		UpdatedAt:   o.UpdatedAt,
+	}
+}
+
+func OrganizationFromServiceAdmin(o *service.Organization) *AdminOrganization {
+	if o == nil {
+		return nil
+	}
+	return &AdminOrganization{
+		Organization:  *OrganizationFromService(o),
+		BillingUserID: o.BillingUserID,
+	}
+}
+
+func OrganizationMemberFromService(m *service.OrganizationMember) *OrganizationMember {
+	if m == nil {
+		return nil
+	}
+	return &OrganizationMember{
+		UserID:             m.UserID,
+		Email:              m.Email,
+		Username:           m.Username,
+		Role:               m.Role,
+		MonthlySpendCapUSD: m.MonthlySpendCapUSD,
+		CreatedAt:          m.CreatedAt,
+		UpdatedAt:          m.UpdatedAt,
+	}
+}
+
+func OrganizationUsageFromService(u *service.OrganizationUsage) *OrganizationUsage {
+	if u == nil {
+		return nil
+	}
+	members := make([]OrganizationMemberUsage, 0, len(u.Members))
+	for _, m := range u.Members {
+		members = append(members, OrganizationMemberUsage{
+			UserID:             m.UserID,
+			Email:              m.Email,
+			Username:           m.Username,
+			Role:               m.Role,
+			MonthlySpendCapUSD: m.MonthlySpendCapUSD,
+			Requests:           m.Requests,
+			TotalTokens:        m.TotalTokens,
+			TotalCost:          m.TotalCost,
+			ActualCost:         m.ActualCost,
+		})
+	}
+	return &OrganizationUsage{
+		StartTime:  u.StartTime,
+		EndTime:    u.EndTime,
+		Requests:   u.Requests,
+		TotalCost:  u.TotalCost,
+		ActualCost: u.ActualCost,
+		Members:    members,
+	}
+}
+
+func ReferralDashboardFromService(d *service.ReferralDashboard) *ReferralDashboard {
+	if d == nil {
+		return nil
+	}
+	return &ReferralDashboard{
+		Enabled:          d.Enabled,
+		Code:             d.Code,
+		CommissionRate:   d.CommissionRate,
+		CommissionCapUSD: d.CommissionCapUSD,
+		DurationDays:     d.DurationDays,
+		ReferralCount:    d.ReferralCount,
+		TotalSpend:       d.TotalSpend,
+		TotalCommission:  d.TotalCommission,
+	}
+}
+
+func ReferralFromService(r *service.Referral) *Referral {
+	if r == nil {
+		return nil
+	}
+	return &Referral{
+		ID:               r.ID,
+		RefereeID:        r.RefereeID,
+		RefereeEmail:     r.RefereeEmail,
+		CommissionRate:   r.CommissionRate,
+		CommissionCapUSD: r.CommissionCapUSD,
+		ExpiresAt:        r.ExpiresAt,
+		TotalSpend:       r.TotalSpend,
+		TotalCommission:  r.TotalCommission,
+		SettledUntil:     r.SettledUntil,
+		CreatedAt:        r.CreatedAt,
+	}
+}
+
+func ReferralCommissionFromService(c *service.ReferralCommission) *ReferralCommission {
+	if c == nil {
+		return nil
+	}
+	return &ReferralCommission{
+		ID:             c.ID,
+		ReferralID:     c.ReferralID,
+		ReferrerID:     c.ReferrerID,
+		ReferrerEmail:  c.ReferrerEmail,
+		RefereeID:      c.RefereeID,
+		RefereeEmail:   c.RefereeEmail,
+		PeriodStart:    c.PeriodStart,
+		PeriodEnd:      c.PeriodEnd,
+		Spend:          c.Spend,
+		CommissionRate: c.CommissionRate,
+		Amount:         c.Amount,
+		CreatedAt:      c.CreatedAt,
+	}
+}
+
+func ReferralSettlementReportFromService(r *service.ReferralSettlementReport) *ReferralSettlementReport {
+	if r == nil {
+		return nil
+	}
+	rows := make([]ReferralSettlementRow, 0, len(r.Rows))
+	for _, row := range r.Rows {
+		rows = append(rows, ReferralSettlementRow{
+			ReferrerID:    row.ReferrerID,
+			ReferrerEmail: row.ReferrerEmail,
+			Referees:      row.Referees,
+			Settlements:   row.Settlements,
+			Spend:         row.Spend,
+			Commission:    row.Commission,
+		})
+	}
+	return &ReferralSettlementReport{
+		StartTime:       r.StartTime,
+		EndTime:         r.EndTime,
+		TotalSpend:      r.TotalSpend,
+		TotalCommission: r.TotalCommission,
+		Rows:            rows,
+	}
+}
+
+func RedeemBatchFromService(b *service.RedeemBatch) *RedeemBatch {
+	if b == nil {
+		return nil
+	}
+	return &RedeemBatch{
+		ID:      b.ID,
+		Name:    b.Name,
+		Channel: b.Channel,
+		Type:    b.Type,
+		Status:  b.Status,
+		Notes:   b.Notes,
+		Stats: RedeemBatchStats{
+			TotalCodes:    b.Stats.TotalCodes,
+			UnusedCodes:   b.Stats.UnusedCodes,
+			UsedCodes:     b.Stats.UsedCodes,
+			DisabledCodes: b.Stats.DisabledCodes,
+			Redemptions:   b.Stats.Redemptions,
+			RedeemedUsers: b.Stats.RedeemedUsers,
+			RedeemedValue: b.Stats.RedeemedValue,
+		},
+		CreatedAt: b.CreatedAt,
+		UpdatedAt: b.UpdatedAt,
 	}
 }
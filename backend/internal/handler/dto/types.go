@@ -38,10 +38,32 @@ type APIKey struct {
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 
+	// 限额（nil 表示无限制）
+	DailyLimitUSD       *float64 `json:"daily_limit_usd"`
+	MonthlyLimitUSD     *float64 `json:"monthly_limit_usd"`
+	TotalLimitUSD       *float64 `json:"total_limit_usd"`
+	DailyRequestLimit   *int64   `json:"daily_request_limit"`
+	MonthlyRequestLimit *int64   `json:"monthly_request_limit"`
+
+	// 当前用量，仅在配置了限额的 Key 列表中返回
+	Usage *APIKeyLimitUsage `json:"usage,omitempty"`
+
+	// 绑定的组织（由组织计费账户付费）
+	OrganizationID *int64 `json:"organization_id,omitempty"`
+
 	User  *User  `json:"user,omitempty"`
 	Group *Group `json:"group,omitempty"`
 }
 
+// APIKeyLimitUsage API Key 限额统计口径下的当前用量
+type APIKeyLimitUsage struct {
+	DailyUsage      float64 `json:"daily_usage"`
+	MonthlyUsage    float64 `json:"monthly_usage"`
+	TotalUsage      float64 `json:"total_usage"`
+	DailyRequests   int64   `json:"daily_requests"`
+	MonthlyRequests int64   `json:"monthly_requests"`
+}
+
 type Group struct {
 	ID             int64   `json:"id"`
 	Name           string  `json:"name"`
@@ -65,10 +87,37 @@ type Group struct {
 	ClaudeCodeOnly  bool   `json:"claude_code_only"`
 	FallbackGroupID *int64 `json:"fallback_group_id"`
 
+	// 阶梯折扣：按近 30 天消费分档的折扣倍率
+	VolumeTiers []VolumeTier `json:"volume_tiers"`
+
+	// 订阅超额计费：限额用尽后超出部分按倍率从余额扣除
+	OverageEnabled        bool    `json:"overage_enabled"`
+	OverageRateMultiplier float64 `json:"overage_rate_multiplier"`
+
+	// 滚动窗口限额：最近 N 小时内的订阅用量上限，小时数为 0 表示不启用
+	RollingWindowHours    int      `json:"rolling_window_hours"`
+	RollingWindowLimitUSD *float64 `json:"rolling_window_limit_usd"`
+
+	// 请求数/Token 配额：按日/周/月窗口，可限定模型模式
+	UsageQuotas []UsageQuota `json:"usage_quotas"`
+
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
+type VolumeTier struct {
+	MinSpend       float64 `json:"min_spend"`
+	RateMultiplier float64 `json:"rate_multiplier"`
+}
+
+type UsageQuota struct {
+	Window          string `json:"window"`
+	Model           string `json:"model"`
+	MaxRequests     int64  `json:"max_requests"`
+	MaxInputTokens  int64  `json:"max_input_tokens"`
+	MaxOutputTokens int64  `json:"max_output_tokens"`
+}
+
 // AdminGroup 是管理员接口使用的 group DTO（包含敏感/内部字段）。
 // 注意：普通用户接口不得返回 model_routing/account_count/account_groups 等内部信息。
 type AdminGroup struct {
@@ -78,6 +127,15 @@ type AdminGroup struct {
 	ModelRouting        map[string][]int64 `json:"model_routing"`
 	ModelRoutingEnabled bool               `json:"model_routing_enabled"`
 
+	// 子池灰度分流配置
+	PoolSplitEnabled   bool           `json:"pool_split_enabled"`
+	PoolWeights        map[string]int `json:"pool_weights"`
+	CanaryMaxErrorRate *float64       `json:"canary_max_error_rate"`
+
+	// 容量降级链配置
+	CapacityFallbackGroupIDs []int64           `json:"capacity_fallback_group_ids"`
+	FallbackModelMapping     map[string]string `json:"fallback_model_mapping"`
+
 	AccountGroups []AccountGroup `json:"account_groups,omitempty"`
 	AccountCount  int64          `json:"account_count,omitempty"`
 }
@@ -115,6 +173,16 @@ type Account struct {
 	SessionWindowEnd    *time.Time `json:"session_window_end"`
 	SessionWindowStatus string     `json:"session_window_status"`
 
+	// 可用时间窗口（为空表示全天可用）
+	AvailabilityWindows  []string `json:"availability_windows,omitempty"`
+	AvailabilityTimezone string   `json:"availability_timezone,omitempty"`
+
+	// 日/周/月消费上限（美元，所有平台有效）
+	// 从 extra 字段提取，方便前端显示和编辑
+	DailySpendCap   *float64 `json:"daily_spend_cap,omitempty"`
+	WeeklySpendCap  *float64 `json:"weekly_spend_cap,omitempty"`
+	MonthlySpendCap *float64 `json:"monthly_spend_cap,omitempty"`
+
 	// 5h窗口费用控制（仅 Anthropic OAuth/SetupToken 账号有效）
 	// 从 extra 字段提取，方便前端显示和编辑
 	WindowCostLimit         *float64 `json:"window_cost_limit,omitempty"`
@@ -145,6 +213,7 @@ type AccountGroup struct {
 	AccountID int64     `json:"account_id"`
 	GroupID   int64     `json:"group_id"`
 	Priority  int       `json:"priority"`
+	Pool      string    `json:"pool"`
 	CreatedAt time.Time `json:"created_at"`
 
 	Account *Account `json:"account,omitempty"`
@@ -198,16 +267,65 @@ type RedeemCode struct {
 	GroupID      *int64 `json:"group_id"`
 	ValidityDays int    `json:"validity_days"`
 
+	CreditValidityDays int `json:"credit_validity_days"`
+
 	User  *User  `json:"user,omitempty"`
 	Group *Group `json:"group,omitempty"`
 }
 
+// BalanceTransaction 余额流水（用户接口，不含 notes）
+type BalanceTransaction struct {
+	ID             int64     `json:"id"`
+	Type           string    `json:"type"`
+	Amount         float64   `json:"amount"`
+	BalanceAfter   float64   `json:"balance_after"`
+	UsageLogID     *int64    `json:"usage_log_id,omitempty"`
+	RedeemCodeID   *int64    `json:"redeem_code_id,omitempty"`
+	PromoCodeID    *int64    `json:"promo_code_id,omitempty"`
+	PaymentOrderID *int64    `json:"payment_order_id,omitempty"`
+	CreditLotID    *int64    `json:"credit_lot_id,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// AdminBalanceTransaction 是管理员接口使用的余额流水 DTO（包含 user_id 与 notes）
+type AdminBalanceTransaction struct {
+	BalanceTransaction
+	UserID int64  `json:"user_id"`
+	Notes  string `json:"notes"`
+}
+
+// PaymentOrder 在线充值订单
+type PaymentOrder struct {
+	OrderNo      string     `json:"order_no"`
+	Provider     string     `json:"provider"`
+	Amount       float64    `json:"amount"`
+	Currency     string     `json:"currency"`
+	CreditAmount float64    `json:"credit_amount"`
+	Status       string     `json:"status"`
+	PayURL       string     `json:"pay_url,omitempty"`
+	PaidAt       *time.Time `json:"paid_at,omitempty"`
+	ExpiresAt    time.Time  `json:"expires_at"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// AdminPaymentOrder 是管理员接口使用的充值订单 DTO（包含 user_id 与渠道交易号）
+type AdminPaymentOrder struct {
+	PaymentOrder
+	ID              int64  `json:"id"`
+	UserID          int64  `json:"user_id"`
+	ProviderTradeNo string `json:"provider_trade_no"`
+}
+
 // AdminRedeemCode 是管理员接口使用的 redeem code DTO（包含 notes 等字段）。
 // 注意：普通用户接口不得返回 notes 等内部信息。
 type AdminRedeemCode struct {
 	RedeemCode
 
 	Notes string `json:"notes"`
+
+	BatchID        *int64 `json:"batch_id"`
+	MaxRedemptions int    `json:"max_redemptions"`
+	RedeemedCount  int    `json:"redeemed_count"`
 }
 
 // UsageLog 是普通用户接口使用的 usage log DTO（不包含管理员字段）。
@@ -238,6 +356,14 @@ type UsageLog struct {
 	ActualCost        float64 `json:"actual_cost"`
 	RateMultiplier    float64 `json:"rate_multiplier"`
 
+	// 阶梯折扣：命中档位的消费门槛与折扣倍率（rate_multiplier 已包含该倍率），未命中时为空
+	VolumeTierMinSpend   *float64 `json:"volume_tier_min_spend,omitempty"`
+	VolumeTierMultiplier *float64 `json:"volume_tier_multiplier,omitempty"`
+
+	// 订阅超额计费：订阅承担的原始费用与从余额扣除的金额，未开启超额计费时为空
+	SubscriptionCost *float64 `json:"subscription_cost,omitempty"`
+	OverageCost      *float64 `json:"overage_cost,omitempty"`
+
 	BillingType  int8 `json:"billing_type"`
 	Stream       bool `json:"stream"`
 	DurationMs   *int `json:"duration_ms"`
@@ -270,6 +396,18 @@ type AdminUsageLog struct {
 
 	// Account 最小账号信息（避免泄露敏感字段）
 	Account *AccountSummary `json:"account,omitempty"`
+
+	// 容量降级信息：group_id 为实际承接分组，original_group_id 为 API Key 所属分组
+	OriginalGroupID *int64 `json:"original_group_id,omitempty"`
+	FallbackHop     int    `json:"fallback_hop,omitempty"`
+
+	// 计费价格快照：单价单位为 USD/百万 token（图片为 USD/张），历史记录为空
+	PricingVersion         *string  `json:"pricing_version,omitempty"`
+	InputUnitPrice         *float64 `json:"input_unit_price,omitempty"`
+	OutputUnitPrice        *float64 `json:"output_unit_price,omitempty"`
+	CacheCreationUnitPrice *float64 `json:"cache_creation_unit_price,omitempty"`
+	CacheReadUnitPrice     *float64 `json:"cache_read_unit_price,omitempty"`
+	ImageUnitPrice         *float64 `json:"image_unit_price,omitempty"`
 }
 
 type UsageCleanupFilters struct {
@@ -299,6 +437,43 @@ type UsageCleanupTask struct {
 	UpdatedAt    time.Time           `json:"updated_at"`
 }
 
+type UsageRerateTask struct {
+	ID              int64               `json:"id"`
+	Status          string              `json:"status"`
+	Filters         UsageCleanupFilters `json:"filters"`
+	ApplyChanges    bool                `json:"apply_changes"`
+	PostAdjustments bool                `json:"post_adjustments"`
+	ProcessedRows   int64               `json:"processed_rows"`
+	ChangedRows     int64               `json:"changed_rows"`
+	OldTotalCost    float64             `json:"old_total_cost"`
+	NewTotalCost    float64             `json:"new_total_cost"`
+	OldActualCost   float64             `json:"old_actual_cost"`
+	NewActualCost   float64             `json:"new_actual_cost"`
+	CreatedBy       int64               `json:"created_by"`
+	ErrorMessage    *string             `json:"error_message,omitempty"`
+	CanceledBy      *int64              `json:"canceled_by,omitempty"`
+	CanceledAt      *time.Time          `json:"canceled_at,omitempty"`
+	StartedAt       *time.Time          `json:"started_at,omitempty"`
+	FinishedAt      *time.Time          `json:"finished_at,omitempty"`
+	CreatedAt       time.Time           `json:"created_at"`
+	UpdatedAt       time.Time           `json:"updated_at"`
+}
+
+// AccountProbeLog is a single health probe record of an account.
+type AccountProbeLog struct {
+	ID           int64     `json:"id"`
+	AccountID    int64     `json:"account_id"`
+	Platform     string    `json:"platform"`
+	Model        string    `json:"model"`
+	Trigger      string    `json:"trigger"`
+	Success      bool      `json:"success"`
+	StatusCode   *int      `json:"status_code,omitempty"`
+	LatencyMs    int       `json:"latency_ms"`
+	ErrorMessage *string   `json:"error_message,omitempty"`
+	Action       string    `json:"action"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
 // AccountSummary is a minimal account info for usage log display.
 // It intentionally excludes sensitive fields like Credentials, Proxy, etc.
 type AccountSummary struct {
@@ -330,6 +505,16 @@ type UserSubscription struct {
 	WeeklyUsageUSD  float64 `json:"weekly_usage_usd"`
 	MonthlyUsageUSD float64 `json:"monthly_usage_usd"`
 
+	// 套餐订阅：套餐 ID、自动续费开关与购买时的限额快照（为空时沿用分组限额）
+	PlanID          *int64   `json:"plan_id,omitempty"`
+	AutoRenew       bool     `json:"auto_renew"`
+	DailyLimitUSD   *float64 `json:"daily_limit_usd,omitempty"`
+	WeeklyLimitUSD  *float64 `json:"weekly_limit_usd,omitempty"`
+	MonthlyLimitUSD *float64 `json:"monthly_limit_usd,omitempty"`
+
+	// 暂停时间（仅 paused 状态），恢复时有效期顺延暂停时长
+	PausedAt *time.Time `json:"paused_at,omitempty"`
+
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 
@@ -349,6 +534,49 @@ type AdminUserSubscription struct {
 	AssignedByUser *User `json:"assigned_by_user,omitempty"`
 }
 
+// SubscriptionPlan 可购买的订阅套餐；限额为空表示沿用分组限额
+type SubscriptionPlan struct {
+	ID              int64    `json:"id"`
+	Name            string   `json:"name"`
+	Description     string   `json:"description"`
+	GroupID         int64    `json:"group_id"`
+	DurationDays    int      `json:"duration_days"`
+	Price           float64  `json:"price"`
+	DailyLimitUSD   *float64 `json:"daily_limit_usd"`
+	WeeklyLimitUSD  *float64 `json:"weekly_limit_usd"`
+	MonthlyLimitUSD *float64 `json:"monthly_limit_usd"`
+	Status          string   `json:"status"`
+	SortOrder       int      `json:"sort_order"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	Group *Group `json:"group,omitempty"`
+}
+
+// SubscriptionPurchaseResult 套餐购买结果
+type SubscriptionPurchaseResult struct {
+	Action          string            `json:"action"`
+	Charged         float64           `json:"charged"`
+	ProrationCredit float64           `json:"proration_credit"`
+	Subscription    *UserSubscription `json:"subscription"`
+	Plan            *SubscriptionPlan `json:"plan"`
+}
+
+// SubscriptionEvent 订阅暂停/恢复/转让审计记录
+type SubscriptionEvent struct {
+	ID             int64      `json:"id"`
+	SubscriptionID int64      `json:"subscription_id"`
+	Action         string     `json:"action"`
+	FromUserID     int64      `json:"from_user_id"`
+	ToUserID       *int64     `json:"to_user_id,omitempty"`
+	OperatorID     *int64     `json:"operator_id"`
+	OperatorRole   string     `json:"operator_role"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty"`
+	Notes          string     `json:"notes"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
 type BulkAssignResult struct {
 	SuccessCount  int                     `json:"success_count"`
 	FailedCount   int                     `json:"failed_count"`
@@ -356,7 +584,7 @@ type BulkAssignResult struct {
 	Errors        []string                `json:"errors"`
 }
 
-// PromoCode 注册优惠码
+// PromoCode 优惠码
 type PromoCode struct {
 	ID          int64      `json:"id"`
 	Code        string     `json:"code"`
@@ -368,6 +596,18 @@ type PromoCode struct {
 	Notes       string     `json:"notes"`
 	CreatedAt   time.Time  `json:"created_at"`
 	UpdatedAt   time.Time  `json:"updated_at"`
+
+	CreditValidityDays int `json:"credit_validity_days"`
+
+	Scope               string   `json:"scope"`
+	BonusPercent        float64  `json:"bonus_percent"`
+	MaxBonusAmount      *float64 `json:"max_bonus_amount"`
+	TrialGroupID        *int64   `json:"trial_group_id"`
+	TrialDays           int      `json:"trial_days"`
+	NewUserDays         int      `json:"new_user_days"`
+	AllowedEmailDomains []string `json:"allowed_email_domains"`
+	Stackable           bool     `json:"stackable"`
+	MaxUsesPerUser      int      `json:"max_uses_per_user"`
 }
 
 // PromoCodeUsage 优惠码使用记录
@@ -378,5 +618,160 @@ type PromoCodeUsage struct {
 	BonusAmount float64   `json:"bonus_amount"`
 	UsedAt      time.Time `json:"used_at"`
 
+	TopupAmount    float64 `json:"topup_amount"`
+	PaymentOrderID *int64  `json:"payment_order_id,omitempty"`
+	TrialGroupID   *int64  `json:"trial_group_id,omitempty"`
+	TrialDays      int     `json:"trial_days"`
+
 	User *User `json:"user,omitempty"`
 }
+
+// PromoCodeReportItem 按优惠码汇总的使用报表
+type PromoCodeReportItem struct {
+	PromoCodeID int64   `json:"promo_code_id"`
+	Code        string  `json:"code"`
+	Scope       string  `json:"scope"`
+	Uses        int64   `json:"uses"`
+	Users       int64   `json:"users"`
+	BonusAmount float64 `json:"bonus_amount"`
+	TopupAmount float64 `json:"topup_amount"`
+	TrialUses   int64   `json:"trial_uses"`
+	TrialDays   int64   `json:"trial_days"`
+}
+
+// Organization 组织
+type Organization struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	Status      string    `json:"status"`
+	Balance     float64   `json:"balance"`
+	MemberCount int64     `json:"member_count"`
+	Role        string    `json:"role,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// AdminOrganization 管理端组织（含计费账户 ID）
+type AdminOrganization struct {
+	Organization
+	BillingUserID int64 `json:"billing_user_id"`
+}
+
+// OrganizationMember 组织成员
+type OrganizationMember struct {
+	UserID             int64     `json:"user_id"`
+	Email              string    `json:"email"`
+	Username           string    `json:"username"`
+	Role               string    `json:"role"`
+	MonthlySpendCapUSD *float64  `json:"monthly_spend_cap_usd"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+// OrganizationMemberUsage 成员组织用量（role 为空表示已移除的成员）
+type OrganizationMemberUsage struct {
+	UserID             int64    `json:"user_id"`
+	Email              string   `json:"email"`
+	Username           string   `json:"username"`
+	Role               string   `json:"role"`
+	MonthlySpendCapUSD *float64 `json:"monthly_spend_cap_usd"`
+	Requests           int64    `json:"requests"`
+	TotalTokens        int64    `json:"total_tokens"`
+	TotalCost          float64  `json:"total_cost"`
+	ActualCost         float64  `json:"actual_cost"`
+}
+
+// OrganizationUsage 组织用量看板
+type OrganizationUsage struct {
+	StartTime  time.Time                 `json:"start_time"`
+	EndTime    time.Time                 `json:"end_time"`
+	Requests   int64                     `json:"requests"`
+	TotalCost  float64                   `json:"total_cost"`
+	ActualCost float64                   `json:"actual_cost"`
+	Members    []OrganizationMemberUsage `json:"members"`
+}
+
+// ReferralDashboard 用户邀请看板（规则为当前配置，已绑定的邀请关系按注册时快照结算）
+type ReferralDashboard struct {
+	Enabled          bool    `json:"enabled"`
+	Code             string  `json:"code"`
+	CommissionRate   float64 `json:"commission_rate"`
+	CommissionCapUSD float64 `json:"commission_cap_usd"`
+	DurationDays     int     `json:"duration_days"`
+	ReferralCount    int64   `json:"referral_count"`
+	TotalSpend       float64 `json:"total_spend"`
+	TotalCommission  float64 `json:"total_commission"`
+}
+
+// Referral 邀请关系
+type Referral struct {
+	ID               int64      `json:"id"`
+	RefereeID        int64      `json:"referee_id"`
+	RefereeEmail     string     `json:"referee_email"`
+	CommissionRate   float64    `json:"commission_rate"`
+	CommissionCapUSD *float64   `json:"commission_cap_usd"`
+	ExpiresAt        *time.Time `json:"expires_at"`
+	TotalSpend       float64    `json:"total_spend"`
+	TotalCommission  float64    `json:"total_commission"`
+	SettledUntil     time.Time  `json:"settled_until"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+// ReferralCommission 佣金结算记录
+type ReferralCommission struct {
+	ID             int64     `json:"id"`
+	ReferralID     int64     `json:"referral_id"`
+	ReferrerID     int64     `json:"referrer_id"`
+	ReferrerEmail  string    `json:"referrer_email,omitempty"`
+	RefereeID      int64     `json:"referee_id"`
+	RefereeEmail   string    `json:"referee_email"`
+	PeriodStart    time.Time `json:"period_start"`
+	PeriodEnd      time.Time `json:"period_end"`
+	Spend          float64   `json:"spend"`
+	CommissionRate float64   `json:"commission_rate"`
+	Amount         float64   `json:"amount"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// ReferralSettlementRow 结算报表行（按邀请人汇总）
+type ReferralSettlementRow struct {
+	ReferrerID    int64   `json:"referrer_id"`
+	ReferrerEmail string  `json:"referrer_email"`
+	Referees      int64   `json:"referees"`
+	Settlements   int64   `json:"settlements"`
+	Spend         float64 `json:"spend"`
+	Commission    float64 `json:"commission"`
+}
+
+// ReferralSettlementReport 佣金结算报表
+type ReferralSettlementReport struct {
+	StartTime       time.Time               `json:"start_time"`
+	EndTime         time.Time               `json:"end_time"`
+	TotalSpend      float64                 `json:"total_spend"`
+	TotalCommission float64                 `json:"total_commission"`
+	Rows            []ReferralSettlementRow `json:"rows"`
+}
+
+// RedeemBatchStats 兑换码批次统计
+type RedeemBatchStats struct {
+	TotalCodes    int64   `json:"total_codes"`
+	UnusedCodes   int64   `json:"unused_codes"`
+	UsedCodes     int64   `json:"used_codes"`
+	DisabledCodes int64   `json:"disabled_codes"`
+	Redemptions   int64   `json:"redemptions"`
+	RedeemedUsers int64   `json:"redeemed_users"`
+	RedeemedValue float64 `json:"redeemed_value"`
+}
+
+// RedeemBatch 兑换码批次（管理端）
+type RedeemBatch struct {
+	ID        int64            `json:"id"`
+	Name      string           `json:"name"`
+	Channel   string           `json:"channel"`
+	Type      string           `json:"type"`
+	Status    string           `json:"status"`
+	Notes     string           `json:"notes"`
+	Stats     RedeemBatchStats `json:"stats"`
+	CreatedAt time.Time        `json:"created_at"`
+	UpdatedAt time.Time        `json:"updated_at"`
+}
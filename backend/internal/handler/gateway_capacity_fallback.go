@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"context"
+	"log"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// messagesFallbackState 记录 /v1/messages 请求在分组容量降级链中的位置
+// hop 为 0 表示由 API Key 所属分组承接；降级后 group/groupID/platform/model/body 均切换为降级分组的值
+type messagesFallbackState struct {
+	chain   []int64
+	nextIdx int
+
+	hop       int
+	group     *service.Group
+	groupID   *int64
+	platform  string
+	model     string
+	body      []byte
+	parsedReq *service.ParsedRequest
+
+	originalGroupID int64
+	originalGroup   *service.Group
+	originalModel   string
+	originalBody    []byte
+	originalParsed  *service.ParsedRequest
+}
+
+// newMessagesFallbackState 初始化降级状态；enabled 为 false（如强制平台路由）时降级链为空
+func newMessagesFallbackState(apiKey *service.APIKey, platform string, body []byte, parsedReq *service.ParsedRequest, enabled bool) *messagesFallbackState {
+	st := &messagesFallbackState{
+		groupID:        apiKey.GroupID,
+		platform:       platform,
+		model:          parsedReq.Model,
+		body:           body,
+		parsedReq:      parsedReq,
+		originalModel:  parsedReq.Model,
+		originalBody:   body,
+		originalParsed: parsedReq,
+	}
+	if enabled && apiKey.Group != nil && service.IsCapacityFallbackPlatform(apiKey.Group.Platform) {
+		st.chain = apiKey.Group.CapacityFallbackGroupIDs
+		st.originalGroupID = apiKey.Group.ID
+		st.originalGroup = apiKey.Group
+	}
+	return st
+}
+
+// fallbackGroup 返回当前承接请求的降级分组（未降级时为 nil）
+func (st *messagesFallbackState) fallbackGroup() *service.Group {
+	if st.hop == 0 {
+		return nil
+	}
+	return st.group
+}
+
+// nextCapacityFallback 切换到降级链中的下一个可用分组，返回 false 表示降级链已耗尽
+// 模型按降级分组的 fallback_model_mapping 转换（基于原始请求模型），请求上下文中的分组同步替换
+func (h *GatewayHandler) nextCapacityFallback(c *gin.Context, st *messagesFallbackState) bool {
+	for st.nextIdx < len(st.chain) {
+		groupID := st.chain[st.nextIdx]
+		st.nextIdx++
+
+		group, err := h.gatewayService.ResolveCapacityFallbackGroup(c.Request.Context(), groupID, st.originalGroup)
+		if err != nil {
+			log.Printf("[CapacityFallback] skip fallback group: group=%d fallback=%d err=%v", st.originalGroupID, groupID, err)
+			continue
+		}
+
+		model := group.MapFallbackModel(st.originalModel)
+		body := st.originalBody
+		parsedReq := st.originalParsed
+		if model != st.originalModel {
+			body = h.gatewayService.ReplaceRequestModel(st.originalBody, model)
+			parsedReq, err = service.ParseGatewayRequest(body)
+			if err != nil {
+				log.Printf("[CapacityFallback] rewrite request failed: group=%d fallback=%d err=%v", st.originalGroupID, groupID, err)
+				continue
+			}
+		}
+
+		st.hop = st.nextIdx
+		st.group = group
+		st.groupID = &group.ID
+		st.platform = group.Platform
+		st.model = model
+		st.body = body
+		st.parsedReq = parsedReq
+
+		// 降级分组写入请求上下文，供账号选择（分组配置、子池分流）使用
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), ctxkey.Group, group))
+		setOpsRequestContext(c, model, parsedReq.Stream, body)
+
+		log.Printf("[CapacityFallback] group=%d -> fallback group=%d hop=%d platform=%s model=%s->%s",
+			st.originalGroupID, group.ID, st.hop, group.Platform, st.originalModel, model)
+		return true
+	}
+	return false
+}
@@ -30,6 +30,7 @@ type GatewayHandler struct {
 	antigravityGatewayService *service.AntigravityGatewayService
 	userService               *service.UserService
 	billingCacheService       *service.BillingCacheService
+	balanceReservationService *service.BalanceReservationService
 	concurrencyHelper         *ConcurrencyHelper
 	maxAccountSwitches        int
 	maxAccountSwitchesGemini  int
@@ -43,6 +44,7 @@ func NewGatewayHandler(
 	userService *service.UserService,
 	concurrencyService *service.ConcurrencyService,
 	billingCacheService *service.BillingCacheService,
+	balanceReservationService *service.BalanceReservationService,
 	cfg *config.Config,
 ) *GatewayHandler {
 	pingInterval := time.Duration(0)
@@ -63,6 +65,7 @@ func NewGatewayHandler(
 		antigravityGatewayService: antigravityGatewayService,
 		userService:               userService,
 		billingCacheService:       billingCacheService,
+		balanceReservationService: balanceReservationService,
 		concurrencyHelper:         NewConcurrencyHelper(concurrencyService, SSEPingFormatClaude, pingInterval),
 		maxAccountSwitches:        maxAccountSwitches,
 		maxAccountSwitchesGemini:  maxAccountSwitchesGemini,
@@ -168,37 +171,214 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 	}
 
 	// 2. 【新增】Wait后二次检查余额/订阅
-	if err := h.billingCacheService.CheckBillingEligibility(c.Request.Context(), apiKey.User, apiKey, apiKey.Group, subscription); err != nil {
+	if err := h.billingCacheService.CheckBillingEligibility(c.Request.Context(), apiKey.User, apiKey, apiKey.Group, subscription, reqModel); err != nil {
 		log.Printf("Billing eligibility check failed after wait: %v", err)
 		status, code, message := billingErrorDetails(err)
 		h.handleStreamingAwareError(c, status, code, message, streamStarted)
 		return
 	}
 
-	// 计算粘性会话hash
-	sessionHash := h.gatewayService.GenerateSessionHash(parsedReq)
+	// 预留余额：按输入大小与 max_tokens 预估最大费用，防止并发请求透支余额
+	reservation, err := h.balanceReservationService.Reserve(c.Request.Context(), service.BalanceReservationInput{
+		User:         apiKey.User,
+		APIKey:       apiKey,
+		Subscription: subscription,
+		Model:        reqModel,
+		Body:         body,
+	})
+	if err != nil {
+		status, code, message := billingErrorDetails(err)
+		h.handleStreamingAwareError(c, status, code, message, streamStarted)
+		return
+	}
+	// 未进入使用记录（转发失败、客户端断开等）时释放预留；进入使用记录后由 RecordUsage 结算
+	defer func() { reservation.Release() }()
 
 	// 获取平台：优先使用强制平台（/antigravity 路由，中间件已设置 request.Context），否则使用分组平台
 	platform := ""
-	if forcePlatform, ok := middleware2.GetForcePlatformFromContext(c); ok {
+	forcePlatform, hasForcePlatform := middleware2.GetForcePlatformFromContext(c)
+	if hasForcePlatform {
 		platform = forcePlatform
 	} else if apiKey.Group != nil {
 		platform = apiKey.Group.Platform
 	}
-	sessionKey := sessionHash
-	if platform == service.PlatformGemini && sessionHash != "" {
-		sessionKey = "gemini:" + sessionHash
-	}
 
-	if platform == service.PlatformGemini {
-		maxAccountSwitches := h.maxAccountSwitchesGemini
+	// 容量降级：当前分组无可调度账号或切换次数耗尽时，按分组降级链依次尝试下一个分组（强制平台路由不降级）
+	fallback := newMessagesFallbackState(apiKey, platform, body, parsedReq, !hasForcePlatform)
+
+fallbackLoop:
+	for {
+		groupID := fallback.groupID
+		platform = fallback.platform
+		reqModel = fallback.model
+		body = fallback.body
+		parsedReq = fallback.parsedReq
+		fallbackGroup, fallbackHop := fallback.fallbackGroup(), fallback.hop
+
+		// 计算粘性会话hash
+		sessionHash := h.gatewayService.GenerateSessionHash(parsedReq)
+		sessionKey := sessionHash
+		if platform == service.PlatformGemini && sessionHash != "" {
+			sessionKey = "gemini:" + sessionHash
+		}
+
+		if platform == service.PlatformGemini {
+			maxAccountSwitches := h.maxAccountSwitchesGemini
+			switchCount := 0
+			failedAccountIDs := make(map[int64]struct{})
+			lastFailoverStatus := 0
+
+			for {
+				selection, err := h.gatewayService.SelectAccountWithLoadAwareness(c.Request.Context(), groupID, sessionKey, reqModel, failedAccountIDs, "") // Gemini 不使用会话限制
+				if err != nil {
+					if h.nextCapacityFallback(c, fallback) {
+						continue fallbackLoop
+					}
+					if len(failedAccountIDs) == 0 {
+						h.handleStreamingAwareError(c, http.StatusServiceUnavailable, "api_error", "No available accounts: "+err.Error(), streamStarted)
+						return
+					}
+					h.handleFailoverExhausted(c, lastFailoverStatus, streamStarted)
+					return
+				}
+				account := selection.Account
+				setOpsSelectedAccount(c, account.ID)
+
+				// 检查预热请求拦截（在账号选择后、转发前检查）
+				if account.IsInterceptWarmupEnabled() && isWarmupRequest(body) {
+					if selection.Acquired && selection.ReleaseFunc != nil {
+						selection.ReleaseFunc()
+					}
+					if reqStream {
+						sendMockWarmupStream(c, reqModel)
+					} else {
+						sendMockWarmupResponse(c, reqModel)
+					}
+					return
+				}
+
+				// 3. 获取账号并发槽位
+				accountReleaseFunc := selection.ReleaseFunc
+				if !selection.Acquired {
+					if selection.WaitPlan == nil {
+						h.handleStreamingAwareError(c, http.StatusServiceUnavailable, "api_error", "No available accounts", streamStarted)
+						return
+					}
+					accountWaitCounted := false
+					canWait, err := h.concurrencyHelper.IncrementAccountWaitCount(c.Request.Context(), account.ID, selection.WaitPlan.MaxWaiting)
+					if err != nil {
+						log.Printf("Increment account wait count failed: %v", err)
+					} else if !canWait {
+						log.Printf("Account wait queue full: account=%d", account.ID)
+						h.handleStreamingAwareError(c, http.StatusTooManyRequests, "rate_limit_error", "Too many pending requests, please retry later", streamStarted)
+						return
+					}
+					if err == nil && canWait {
+						accountWaitCounted = true
+					}
+					// Ensure the wait counter is decremented if we exit before acquiring the slot.
+					defer func() {
+						if accountWaitCounted {
+							h.concurrencyHelper.DecrementAccountWaitCount(c.Request.Context(), account.ID)
+						}
+					}()
+
+					accountReleaseFunc, err = h.concurrencyHelper.AcquireAccountSlotWithWaitTimeout(
+						c,
+						account.ID,
+						selection.WaitPlan.MaxConcurrency,
+						selection.WaitPlan.Timeout,
+						reqStream,
+						&streamStarted,
+					)
+					if err != nil {
+						log.Printf("Account concurrency acquire failed: %v", err)
+						h.handleConcurrencyError(c, err, "account", streamStarted)
+						return
+					}
+					// Slot acquired: no longer waiting in queue.
+					if accountWaitCounted {
+						h.concurrencyHelper.DecrementAccountWaitCount(c.Request.Context(), account.ID)
+						accountWaitCounted = false
+					}
+					if err := h.gatewayService.BindStickySession(c.Request.Context(), groupID, sessionKey, account.ID); err != nil {
+						log.Printf("Bind sticky session failed: %v", err)
+					}
+				}
+				// 账号槽位/等待计数需要在超时或断开时安全回收
+				accountReleaseFunc = wrapReleaseOnDone(c.Request.Context(), accountReleaseFunc)
+
+				// 转发请求 - 根据账号平台分流
+				var result *service.ForwardResult
+				if account.Platform == service.PlatformAntigravity {
+					result, err = h.antigravityGatewayService.ForwardGemini(c.Request.Context(), c, account, reqModel, "generateContent", reqStream, body)
+				} else {
+					result, err = h.geminiCompatService.Forward(c.Request.Context(), c, account, body)
+				}
+				if accountReleaseFunc != nil {
+					accountReleaseFunc()
+				}
+				if err != nil {
+					var failoverErr *service.UpstreamFailoverError
+					if errors.As(err, &failoverErr) {
+						failedAccountIDs[account.ID] = struct{}{}
+						lastFailoverStatus = failoverErr.StatusCode
+						if switchCount >= maxAccountSwitches {
+							if h.nextCapacityFallback(c, fallback) {
+								continue fallbackLoop
+							}
+							h.handleFailoverExhausted(c, lastFailoverStatus, streamStarted)
+							return
+						}
+						switchCount++
+						log.Printf("Account %d: upstream error %d, switching account %d/%d", account.ID, failoverErr.StatusCode, switchCount, maxAccountSwitches)
+						continue
+					}
+					// 错误响应已在Forward中处理，这里只记录日志
+					log.Printf("Forward request failed: %v", err)
+					return
+				}
+
+				// 捕获请求信息（用于异步记录，避免在 goroutine 中访问 gin.Context）
+				userAgent := c.GetHeader("User-Agent")
+				clientIP := ip.GetClientIP(c)
+
+				// 异步记录使用量（subscription已在函数开头获取）
+				go func(result *service.ForwardResult, usedAccount *service.Account, ua, clientIP string, reservation *service.BalanceReservation) {
+					ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+					defer cancel()
+					if err := h.gatewayService.RecordUsage(ctx, &service.RecordUsageInput{
+						Result:       result,
+						APIKey:       apiKey,
+						User:         apiKey.User,
+						Account:      usedAccount,
+						Subscription: subscription,
+						UserAgent:    ua,
+						IPAddress:    clientIP,
+
+						FallbackGroup: fallbackGroup,
+						FallbackHop:   fallbackHop,
+						Reservation:   reservation,
+					}); err != nil {
+						log.Printf("Record usage failed: %v", err)
+					}
+				}(result, account, userAgent, clientIP, handoffReservation(&reservation))
+				return
+			}
+		}
+
+		maxAccountSwitches := h.maxAccountSwitches
 		switchCount := 0
 		failedAccountIDs := make(map[int64]struct{})
 		lastFailoverStatus := 0
 
 		for {
-			selection, err := h.gatewayService.SelectAccountWithLoadAwareness(c.Request.Context(), apiKey.GroupID, sessionKey, reqModel, failedAccountIDs, "") // Gemini 不使用会话限制
+			// 选择支持该模型的账号
+			selection, err := h.gatewayService.SelectAccountWithLoadAwareness(c.Request.Context(), groupID, sessionKey, reqModel, failedAccountIDs, parsedReq.MetadataUserID)
 			if err != nil {
+				if h.nextCapacityFallback(c, fallback) {
+					continue fallbackLoop
+				}
 				if len(failedAccountIDs) == 0 {
 					h.handleStreamingAwareError(c, http.StatusServiceUnavailable, "api_error", "No available accounts: "+err.Error(), streamStarted)
 					return
@@ -241,7 +421,6 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 				if err == nil && canWait {
 					accountWaitCounted = true
 				}
-				// Ensure the wait counter is decremented if we exit before acquiring the slot.
 				defer func() {
 					if accountWaitCounted {
 						h.concurrencyHelper.DecrementAccountWaitCount(c.Request.Context(), account.ID)
@@ -261,12 +440,11 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 					h.handleConcurrencyError(c, err, "account", streamStarted)
 					return
 				}
-				// Slot acquired: no longer waiting in queue.
 				if accountWaitCounted {
 					h.concurrencyHelper.DecrementAccountWaitCount(c.Request.Context(), account.ID)
 					accountWaitCounted = false
 				}
-				if err := h.gatewayService.BindStickySession(c.Request.Context(), apiKey.GroupID, sessionKey, account.ID); err != nil {
+				if err := h.gatewayService.BindStickySession(c.Request.Context(), groupID, sessionKey, account.ID); err != nil {
 					log.Printf("Bind sticky session failed: %v", err)
 				}
 			}
@@ -276,9 +454,9 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 			// 转发请求 - 根据账号平台分流
 			var result *service.ForwardResult
 			if account.Platform == service.PlatformAntigravity {
-				result, err = h.antigravityGatewayService.ForwardGemini(c.Request.Context(), c, account, reqModel, "generateContent", reqStream, body)
+				result, err = h.antigravityGatewayService.Forward(c.Request.Context(), c, account, body)
 			} else {
-				result, err = h.geminiCompatService.Forward(c.Request.Context(), c, account, body)
+				result, err = h.gatewayService.Forward(c.Request.Context(), c, account, parsedReq)
 			}
 			if accountReleaseFunc != nil {
 				accountReleaseFunc()
@@ -289,6 +467,9 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 					failedAccountIDs[account.ID] = struct{}{}
 					lastFailoverStatus = failoverErr.StatusCode
 					if switchCount >= maxAccountSwitches {
+						if h.nextCapacityFallback(c, fallback) {
+							continue fallbackLoop
+						}
 						h.handleFailoverExhausted(c, lastFailoverStatus, streamStarted)
 						return
 					}
@@ -297,7 +478,7 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 					continue
 				}
 				// 错误响应已在Forward中处理，这里只记录日志
-				log.Printf("Forward request failed: %v", err)
+				log.Printf("Account %d: Forward request failed: %v", account.ID, err)
 				return
 			}
 
@@ -306,7 +487,7 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 			clientIP := ip.GetClientIP(c)
 
 			// 异步记录使用量（subscription已在函数开头获取）
-			go func(result *service.ForwardResult, usedAccount *service.Account, ua, clientIP string) {
+			go func(result *service.ForwardResult, usedAccount *service.Account, ua, clientIP string, reservation *service.BalanceReservation) {
 				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 				defer cancel()
 				if err := h.gatewayService.RecordUsage(ctx, &service.RecordUsageInput{
@@ -317,144 +498,16 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 					Subscription: subscription,
 					UserAgent:    ua,
 					IPAddress:    clientIP,
+
+					FallbackGroup: fallbackGroup,
+					FallbackHop:   fallbackHop,
+					Reservation:   reservation,
 				}); err != nil {
 					log.Printf("Record usage failed: %v", err)
 				}
-			}(result, account, userAgent, clientIP)
-			return
-		}
-	}
-
-	maxAccountSwitches := h.maxAccountSwitches
-	switchCount := 0
-	failedAccountIDs := make(map[int64]struct{})
-	lastFailoverStatus := 0
-
-	for {
-		// 选择支持该模型的账号
-		selection, err := h.gatewayService.SelectAccountWithLoadAwareness(c.Request.Context(), apiKey.GroupID, sessionKey, reqModel, failedAccountIDs, parsedReq.MetadataUserID)
-		if err != nil {
-			if len(failedAccountIDs) == 0 {
-				h.handleStreamingAwareError(c, http.StatusServiceUnavailable, "api_error", "No available accounts: "+err.Error(), streamStarted)
-				return
-			}
-			h.handleFailoverExhausted(c, lastFailoverStatus, streamStarted)
-			return
-		}
-		account := selection.Account
-		setOpsSelectedAccount(c, account.ID)
-
-		// 检查预热请求拦截（在账号选择后、转发前检查）
-		if account.IsInterceptWarmupEnabled() && isWarmupRequest(body) {
-			if selection.Acquired && selection.ReleaseFunc != nil {
-				selection.ReleaseFunc()
-			}
-			if reqStream {
-				sendMockWarmupStream(c, reqModel)
-			} else {
-				sendMockWarmupResponse(c, reqModel)
-			}
-			return
-		}
-
-		// 3. 获取账号并发槽位
-		accountReleaseFunc := selection.ReleaseFunc
-		if !selection.Acquired {
-			if selection.WaitPlan == nil {
-				h.handleStreamingAwareError(c, http.StatusServiceUnavailable, "api_error", "No available accounts", streamStarted)
-				return
-			}
-			accountWaitCounted := false
-			canWait, err := h.concurrencyHelper.IncrementAccountWaitCount(c.Request.Context(), account.ID, selection.WaitPlan.MaxWaiting)
-			if err != nil {
-				log.Printf("Increment account wait count failed: %v", err)
-			} else if !canWait {
-				log.Printf("Account wait queue full: account=%d", account.ID)
-				h.handleStreamingAwareError(c, http.StatusTooManyRequests, "rate_limit_error", "Too many pending requests, please retry later", streamStarted)
-				return
-			}
-			if err == nil && canWait {
-				accountWaitCounted = true
-			}
-			defer func() {
-				if accountWaitCounted {
-					h.concurrencyHelper.DecrementAccountWaitCount(c.Request.Context(), account.ID)
-				}
-			}()
-
-			accountReleaseFunc, err = h.concurrencyHelper.AcquireAccountSlotWithWaitTimeout(
-				c,
-				account.ID,
-				selection.WaitPlan.MaxConcurrency,
-				selection.WaitPlan.Timeout,
-				reqStream,
-				&streamStarted,
-			)
-			if err != nil {
-				log.Printf("Account concurrency acquire failed: %v", err)
-				h.handleConcurrencyError(c, err, "account", streamStarted)
-				return
-			}
-			if accountWaitCounted {
-				h.concurrencyHelper.DecrementAccountWaitCount(c.Request.Context(), account.ID)
-				accountWaitCounted = false
-			}
-			if err := h.gatewayService.BindStickySession(c.Request.Context(), apiKey.GroupID, sessionKey, account.ID); err != nil {
-				log.Printf("Bind sticky session failed: %v", err)
-			}
-		}
-		// 账号槽位/等待计数需要在超时或断开时安全回收
-		accountReleaseFunc = wrapReleaseOnDone(c.Request.Context(), accountReleaseFunc)
-
-		// 转发请求 - 根据账号平台分流
-		var result *service.ForwardResult
-		if account.Platform == service.PlatformAntigravity {
-			result, err = h.antigravityGatewayService.Forward(c.Request.Context(), c, account, body)
-		} else {
-			result, err = h.gatewayService.Forward(c.Request.Context(), c, account, parsedReq)
-		}
-		if accountReleaseFunc != nil {
-			accountReleaseFunc()
-		}
-		if err != nil {
-			var failoverErr *service.UpstreamFailoverError
-			if errors.As(err, &failoverErr) {
-				failedAccountIDs[account.ID] = struct{}{}
-				lastFailoverStatus = failoverErr.StatusCode
-				if switchCount >= maxAccountSwitches {
-					h.handleFailoverExhausted(c, lastFailoverStatus, streamStarted)
-					return
-				}
-				switchCount++
-				log.Printf("Account %d: upstream error %d, switching account %d/%d", account.ID, failoverErr.StatusCode, switchCount, maxAccountSwitches)
-				continue
-			}
-			// 错误响应已在Forward中处理，这里只记录日志
-			log.Printf("Account %d: Forward request failed: %v", account.ID, err)
+			}(result, account, userAgent, clientIP, handoffReservation(&reservation))
 			return
 		}
-
-		// 捕获请求信息（用于异步记录，避免在 goroutine 中访问 gin.Context）
-		userAgent := c.GetHeader("User-Agent")
-		clientIP := ip.GetClientIP(c)
-
-		// 异步记录使用量（subscription已在函数开头获取）
-		go func(result *service.ForwardResult, usedAccount *service.Account, ua, clientIP string) {
-			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-			defer cancel()
-			if err := h.gatewayService.RecordUsage(ctx, &service.RecordUsageInput{
-				Result:       result,
-				APIKey:       apiKey,
-				User:         apiKey.User,
-				Account:      usedAccount,
-				Subscription: subscription,
-				UserAgent:    ua,
-				IPAddress:    clientIP,
-			}); err != nil {
-				log.Printf("Record usage failed: %v", err)
-			}
-		}(result, account, userAgent, clientIP)
-		return
 	}
 }
 
@@ -533,6 +586,12 @@ func (h *GatewayHandler) Usage(c *gin.Context) {
 		return
 	}
 
+	// 组织 Key 返回组织计费账户的订阅额度与余额
+	payerID := subject.UserID
+	if apiKey.OrganizationBilling != nil && apiKey.OrganizationBilling.Payer != nil {
+		payerID = apiKey.OrganizationBilling.Payer.ID
+	}
+
 	// 订阅模式：返回订阅限额信息
 	if apiKey.Group != nil && apiKey.Group.IsSubscriptionType() {
 		subscription, ok := middleware2.GetSubscriptionFromContext(c)
@@ -541,18 +600,38 @@ func (h *GatewayHandler) Usage(c *gin.Context) {
 			return
 		}
 
-		remaining := h.calculateSubscriptionRemaining(apiKey.Group, subscription)
-		c.JSON(http.StatusOK, gin.H{
+		// 滚动窗口用量（读取失败时仅按自然窗口计算剩余额度）
+		var rolling *service.RollingWindowUsage
+		if h.billingCacheService != nil {
+			var err error
+			rolling, err = h.billingCacheService.GetRollingWindowUsage(c.Request.Context(), payerID, apiKey.Group)
+			if err != nil {
+				log.Printf("Load rolling window usage failed: user=%d group=%d err=%v", payerID, apiKey.Group.ID, err)
+			}
+		}
+
+		remaining := h.calculateSubscriptionRemaining(apiKey.Group, subscription, rolling)
+		resp := gin.H{
 			"isValid":   true,
 			"planName":  apiKey.Group.Name,
 			"remaining": remaining,
 			"unit":      "USD",
-		})
+		}
+		if progress := rolling.Progress(time.Now()); progress != nil {
+			resp["rollingWindow"] = gin.H{
+				"windowHours":    progress.WindowHours,
+				"limit":          progress.LimitUSD,
+				"used":           progress.UsedUSD,
+				"remaining":      progress.RemainingUSD,
+				"freesInSeconds": progress.FreesInSeconds,
+			}
+		}
+		c.JSON(http.StatusOK, resp)
 		return
 	}
 
 	// 余额模式：返回钱包余额
-	latestUser, err := h.userService.GetByID(c.Request.Context(), subject.UserID)
+	latestUser, err := h.userService.GetByID(c.Request.Context(), payerID)
 	if err != nil {
 		h.errorResponse(c, http.StatusInternalServerError, "api_error", "Failed to get user info")
 		return
@@ -568,10 +647,11 @@ func (h *GatewayHandler) Usage(c *gin.Context) {
 
 // calculateSubscriptionRemaining 计算订阅剩余可用额度
 // 逻辑：
-// 1. 如果日/周/月任一限额达到100%，返回0
+// 1. 如果日/周/月或滚动窗口任一限额达到100%，返回0
 // 2. 否则返回所有已配置周期中剩余额度的最小值
-func (h *GatewayHandler) calculateSubscriptionRemaining(group *service.Group, sub *service.UserSubscription) float64 {
+func (h *GatewayHandler) calculateSubscriptionRemaining(group *service.Group, sub *service.UserSubscription, rolling *service.RollingWindowUsage) float64 {
 	var remainingValues []float64
+	group = sub.EffectiveGroup(group)
 
 	// 检查日限额
 	if group.HasDailyLimit() {
@@ -600,6 +680,15 @@ func (h *GatewayHandler) calculateSubscriptionRemaining(group *service.Group, su
 		remainingValues = append(remainingValues, remaining)
 	}
 
+	// 检查滚动窗口限额
+	if rolling != nil {
+		remaining := rolling.Remaining()
+		if remaining <= 0 {
+			return 0
+		}
+		remainingValues = append(remainingValues, remaining)
+	}
+
 	// 如果没有配置任何限额，返回-1表示无限制
 	if len(remainingValues) == 0 {
 		return -1
@@ -740,7 +829,7 @@ func (h *GatewayHandler) CountTokens(c *gin.Context) {
 
 	// 校验 billing eligibility（订阅/余额）
 	// 【注意】不计算并发，但需要校验订阅/余额
-	if err := h.billingCacheService.CheckBillingEligibility(c.Request.Context(), apiKey.User, apiKey, apiKey.Group, subscription); err != nil {
+	if err := h.billingCacheService.CheckBillingEligibility(c.Request.Context(), apiKey.User, apiKey, apiKey.Group, subscription, parsedReq.Model); err != nil {
 		status, code, message := billingErrorDetails(err)
 		h.errorResponse(c, status, code, message)
 		return
@@ -133,6 +133,13 @@ func wrapReleaseOnDone(ctx context.Context, releaseFunc func()) func() {
 	return release
 }
 
+// handoffReservation 将余额预留交由异步使用记录结算，并清空本地引用，避免请求返回时的 defer 提前释放
+func handoffReservation(reservation **service.BalanceReservation) *service.BalanceReservation {
+	r := *reservation
+	*reservation = nil
+	return r
+}
+
 // IncrementWaitCount increments the wait count for a user
 func (h *ConcurrencyHelper) IncrementWaitCount(ctx context.Context, userID int64, maxWait int) (bool, error) {
 	return h.concurrencyService.IncrementWaitCount(ctx, userID, maxWait)
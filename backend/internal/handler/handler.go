@@ -23,6 +23,15 @@ type AdminHandlers struct {
 	Subscription     *admin.SubscriptionHandler
 	Usage            *admin.UsageHandler
 	UserAttribute    *admin.UserAttributeHandler
+	Payment          *admin.PaymentHandler
+	Statement        *admin.StatementHandler
+	PriceOverride    *admin.PriceOverrideHandler
+	UsageRerate      *admin.UsageRerateHandler
+	SubscriptionPlan *admin.SubscriptionPlanHandler
+	Organization     *admin.OrganizationHandler
+	Referral         *admin.ReferralHandler
+	RedeemBatch      *admin.RedeemBatchHandler
+	Profit           *admin.ProfitHandler
 }
 
 // Handlers contains all HTTP handlers
@@ -33,6 +42,10 @@ type Handlers struct {
 	Usage         *UsageHandler
 	Redeem        *RedeemHandler
 	Subscription  *SubscriptionHandler
+	Payment       *PaymentHandler
+	Statement     *StatementHandler
+	Organization  *OrganizationHandler
+	Referral      *ReferralHandler
 	Admin         *AdminHandlers
 	Gateway       *GatewayHandler
 	OpenAIGateway *OpenAIGatewayHandler
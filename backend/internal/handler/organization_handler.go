@@ -0,0 +1,425 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// OrganizationHandler handles organization (team) requests
+type OrganizationHandler struct {
+	organizationService *service.OrganizationService
+}
+
+// NewOrganizationHandler creates a new OrganizationHandler
+func NewOrganizationHandler(organizationService *service.OrganizationService) *OrganizationHandler {
+	return &OrganizationHandler{
+		organizationService: organizationService,
+	}
+}
+
+// CreateOrganizationRequest represents the create/rename organization request payload
+type CreateOrganizationRequest struct {
+	Name string `json:"name" binding:"required"`
+}
+
+// AddOrganizationMemberRequest represents the add member request payload
+type AddOrganizationMemberRequest struct {
+	Email              string   `json:"email" binding:"required,email"`
+	Role               string   `json:"role"`
+	MonthlySpendCapUSD *float64 `json:"monthly_spend_cap_usd"`
+}
+
+// UpdateOrganizationMemberRequest represents the update member request payload
+// monthly_spend_cap_usd: 0 或负数表示清除限额
+type UpdateOrganizationMemberRequest struct {
+	Role               *string  `json:"role"`
+	MonthlySpendCapUSD *float64 `json:"monthly_spend_cap_usd"`
+}
+
+// FundOrganizationRequest represents the fund organization request payload
+type FundOrganizationRequest struct {
+	Amount float64 `json:"amount" binding:"required,gt=0"`
+}
+
+// PurchaseOrganizationPlanRequest represents the purchase plan request payload
+type PurchaseOrganizationPlanRequest struct {
+	PlanID    int64 `json:"plan_id" binding:"required"`
+	AutoRenew bool  `json:"auto_renew"`
+}
+
+// BindOrganizationAPIKeyRequest represents the bind API key request payload
+// group_id 为空时保留 Key 当前分组（需组织计费账户可用）
+type BindOrganizationAPIKeyRequest struct {
+	GroupID *int64 `json:"group_id"`
+}
+
+// List returns organizations the current user belongs to
+// GET /api/v1/organizations
+func (h *OrganizationHandler) List(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	orgs, err := h.organizationService.ListMyOrganizations(c.Request.Context(), subject.UserID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	out := make([]dto.Organization, 0, len(orgs))
+	for i := range orgs {
+		out = append(out, *dto.OrganizationFromService(&orgs[i]))
+	}
+	response.Success(c, out)
+}
+
+// Create creates an organization owned by the current user
+// POST /api/v1/organizations
+func (h *OrganizationHandler) Create(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	var req CreateOrganizationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	org, err := h.organizationService.CreateOrganization(c.Request.Context(), subject.UserID, req.Name)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	org.Role = service.OrganizationRoleOwner
+	response.Success(c, dto.OrganizationFromService(org))
+}
+
+// GetByID returns an organization the current user belongs to
+// GET /api/v1/organizations/:id
+func (h *OrganizationHandler) GetByID(c *gin.Context) {
+	subject, orgID, ok := h.parseOrganizationRequest(c)
+	if !ok {
+		return
+	}
+
+	org, member, err := h.organizationService.GetOrganization(c.Request.Context(), orgID, subject.UserID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	org.Role = member.Role
+	response.Success(c, dto.OrganizationFromService(org))
+}
+
+// Update renames an organization
+// PUT /api/v1/organizations/:id
+func (h *OrganizationHandler) Update(c *gin.Context) {
+	subject, orgID, ok := h.parseOrganizationRequest(c)
+	if !ok {
+		return
+	}
+
+	var req CreateOrganizationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	org, err := h.organizationService.RenameOrganization(c.Request.Context(), orgID, subject.UserID, req.Name)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, dto.OrganizationFromService(org))
+}
+
+// ListMembers returns organization members
+// GET /api/v1/organizations/:id/members
+func (h *OrganizationHandler) ListMembers(c *gin.Context) {
+	subject, orgID, ok := h.parseOrganizationRequest(c)
+	if !ok {
+		return
+	}
+
+	members, err := h.organizationService.ListMembers(c.Request.Context(), orgID, subject.UserID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, organizationMembersFromService(members))
+}
+
+// AddMember adds an existing user to the organization by email
+// POST /api/v1/organizations/:id/members
+func (h *OrganizationHandler) AddMember(c *gin.Context) {
+	subject, orgID, ok := h.parseOrganizationRequest(c)
+	if !ok {
+		return
+	}
+
+	var req AddOrganizationMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	member, err := h.organizationService.AddMember(c.Request.Context(), orgID, subject.UserID, service.OrganizationMemberInput{
+		Email:              req.Email,
+		Role:               req.Role,
+		MonthlySpendCapUSD: req.MonthlySpendCapUSD,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, dto.OrganizationMemberFromService(member))
+}
+
+// UpdateMember updates a member's role or monthly spend cap
+// PUT /api/v1/organizations/:id/members/:user_id
+func (h *OrganizationHandler) UpdateMember(c *gin.Context) {
+	subject, orgID, ok := h.parseOrganizationRequest(c)
+	if !ok {
+		return
+	}
+	userID, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	var req UpdateOrganizationMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	member, err := h.organizationService.UpdateMember(c.Request.Context(), orgID, subject.UserID, userID, service.UpdateOrganizationMemberInput{
+		Role:               req.Role,
+		MonthlySpendCapUSD: req.MonthlySpendCapUSD,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, dto.OrganizationMemberFromService(member))
+}
+
+// RemoveMember removes a member (members may remove themselves to leave)
+// DELETE /api/v1/organizations/:id/members/:user_id
+func (h *OrganizationHandler) RemoveMember(c *gin.Context) {
+	subject, orgID, ok := h.parseOrganizationRequest(c)
+	if !ok {
+		return
+	}
+	userID, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	if err := h.organizationService.RemoveMember(c.Request.Context(), orgID, subject.UserID, userID); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, gin.H{"message": "Member removed successfully"})
+}
+
+// Fund transfers balance from the current user to the organization's shared balance
+// POST /api/v1/organizations/:id/fund
+func (h *OrganizationHandler) Fund(c *gin.Context) {
+	subject, orgID, ok := h.parseOrganizationRequest(c)
+	if !ok {
+		return
+	}
+
+	var req FundOrganizationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	org, err := h.organizationService.Fund(c.Request.Context(), orgID, subject.UserID, req.Amount)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, dto.OrganizationFromService(org))
+}
+
+// ListSubscriptions returns the organization's shared subscriptions
+// GET /api/v1/organizations/:id/subscriptions
+func (h *OrganizationHandler) ListSubscriptions(c *gin.Context) {
+	subject, orgID, ok := h.parseOrganizationRequest(c)
+	if !ok {
+		return
+	}
+
+	subs, err := h.organizationService.ListSubscriptions(c.Request.Context(), orgID, subject.UserID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	out := make([]dto.UserSubscription, 0, len(subs))
+	for i := range subs {
+		out = append(out, *dto.UserSubscriptionFromService(&subs[i]))
+	}
+	response.Success(c, out)
+}
+
+// PurchasePlan purchases a subscription plan with the organization's shared balance
+// POST /api/v1/organizations/:id/subscriptions/purchase
+func (h *OrganizationHandler) PurchasePlan(c *gin.Context) {
+	subject, orgID, ok := h.parseOrganizationRequest(c)
+	if !ok {
+		return
+	}
+
+	var req PurchaseOrganizationPlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	result, err := h.organizationService.PurchasePlan(c.Request.Context(), orgID, subject.UserID, req.PlanID, req.AutoRenew)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, dto.SubscriptionPurchaseResultFromService(result))
+}
+
+// BindAPIKey binds one of the current user's API keys to the organization
+// POST /api/v1/organizations/:id/api-keys/:key_id
+func (h *OrganizationHandler) BindAPIKey(c *gin.Context) {
+	subject, orgID, ok := h.parseOrganizationRequest(c)
+	if !ok {
+		return
+	}
+	keyID, err := strconv.ParseInt(c.Param("key_id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid API key ID")
+		return
+	}
+
+	var req BindOrganizationAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	key, err := h.organizationService.BindAPIKey(c.Request.Context(), orgID, subject.UserID, keyID, req.GroupID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, dto.APIKeyFromService(key))
+}
+
+// UnbindAPIKey unbinds one of the current user's API keys from its organization
+// DELETE /api/v1/keys/:id/organization
+func (h *OrganizationHandler) UnbindAPIKey(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+	keyID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid API key ID")
+		return
+	}
+
+	key, err := h.organizationService.UnbindAPIKey(c.Request.Context(), subject.UserID, keyID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, dto.APIKeyFromService(key))
+}
+
+// Usage returns per-member organization spend
+// GET /api/v1/organizations/:id/usage?start_date=2006-01-02&end_date=2006-01-02&timezone=Asia/Shanghai
+func (h *OrganizationHandler) Usage(c *gin.Context) {
+	subject, orgID, ok := h.parseOrganizationRequest(c)
+	if !ok {
+		return
+	}
+	startTime, endTime, ok := parseOrganizationUsageRange(c)
+	if !ok {
+		return
+	}
+
+	usage, err := h.organizationService.GetUsage(c.Request.Context(), orgID, subject.UserID, startTime, endTime)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, dto.OrganizationUsageFromService(usage))
+}
+
+func (h *OrganizationHandler) parseOrganizationRequest(c *gin.Context) (middleware2.AuthSubject, int64, bool) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return subject, 0, false
+	}
+	orgID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid organization ID")
+		return subject, 0, false
+	}
+	return subject, orgID, true
+}
+
+func organizationMembersFromService(members []service.OrganizationMember) []dto.OrganizationMember {
+	out := make([]dto.OrganizationMember, 0, len(members))
+	for i := range members {
+		out = append(out, *dto.OrganizationMemberFromService(&members[i]))
+	}
+	return out
+}
+
+// parseOrganizationUsageRange 解析用量统计区间 [start_date, end_date]（按天，含结束日），默认本月至今
+func parseOrganizationUsageRange(c *gin.Context) (time.Time, time.Time, bool) {
+	userTZ := c.Query("timezone")
+	now := timezone.NowInUserLocation(userTZ)
+	startTime := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	endTime := now
+
+	if startDateStr := c.Query("start_date"); startDateStr != "" {
+		t, err := timezone.ParseInUserLocation("2006-01-02", startDateStr, userTZ)
+		if err != nil {
+			response.BadRequest(c, "Invalid start_date format, use YYYY-MM-DD")
+			return time.Time{}, time.Time{}, false
+		}
+		startTime = t
+	}
+	if endDateStr := c.Query("end_date"); endDateStr != "" {
+		t, err := timezone.ParseInUserLocation("2006-01-02", endDateStr, userTZ)
+		if err != nil {
+			response.BadRequest(c, "Invalid end_date format, use YYYY-MM-DD")
+			return time.Time{}, time.Time{}, false
+		}
+		endTime = t.AddDate(0, 0, 1)
+	}
+	if !endTime.After(startTime) {
+		response.BadRequest(c, "end_date must not be earlier than start_date")
+		return time.Time{}, time.Time{}, false
+	}
+	return startTime, endTime, true
+}
@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ip"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 支付回调请求体上限
+const paymentNotifyMaxBodySize = 1 << 20
+
+// PaymentHandler handles online balance top-up requests
+type PaymentHandler struct {
+	paymentService *service.PaymentService
+}
+
+// NewPaymentHandler creates a new PaymentHandler
+func NewPaymentHandler(paymentService *service.PaymentService) *PaymentHandler {
+	return &PaymentHandler{
+		paymentService: paymentService,
+	}
+}
+
+// CreatePaymentOrderRequest represents the create order request payload
+type CreatePaymentOrderRequest struct {
+	Provider string  `json:"provider" binding:"required"`
+	Amount   float64 `json:"amount" binding:"required,gt=0"`
+
+	PromoCodes []string `json:"promo_codes" binding:"omitempty,max=3,dive,max=32"` // 充值优惠码，可叠加
+}
+
+// GetOptions returns top-up limits and enabled providers
+// GET /api/v1/payment/options
+func (h *PaymentHandler) GetOptions(c *gin.Context) {
+	response.Success(c, h.paymentService.GetOptions())
+}
+
+// CreateOrder creates a top-up order and returns the provider's pay URL
+// POST /api/v1/payment/orders
+func (h *PaymentHandler) CreateOrder(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	var req CreatePaymentOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	order, err := h.paymentService.CreateOrder(c.Request.Context(), subject.UserID, &service.CreatePaymentOrderInput{
+		Provider: strings.TrimSpace(req.Provider),
+		Amount:   req.Amount,
+		ClientIP: ip.GetClientIP(c),
+
+		PromoCodes: req.PromoCodes,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, dto.PaymentOrderFromService(order))
+}
+
+// ListOrders returns the current user's top-up orders
+// GET /api/v1/payment/orders
+func (h *PaymentHandler) ListOrders(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	page, pageSize := response.ParsePagination(c)
+	params := pagination.PaginationParams{Page: page, PageSize: pageSize}
+	filters := service.PaymentOrderFilters{
+		UserID: subject.UserID,
+		Status: strings.TrimSpace(c.Query("status")),
+	}
+	orders, result, err := h.paymentService.ListOrders(c.Request.Context(), params, filters)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	out := make([]dto.PaymentOrder, 0, len(orders))
+	for i := range orders {
+		out = append(out, *dto.PaymentOrderFromService(&orders[i]))
+	}
+	response.Paginated(c, out, result.Total, page, pageSize)
+}
+
+// GetOrder returns one of the current user's top-up orders
+// GET /api/v1/payment/orders/:order_no
+func (h *PaymentHandler) GetOrder(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	order, err := h.paymentService.GetUserOrder(c.Request.Context(), subject.UserID, c.Param("order_no"))
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, dto.PaymentOrderFromService(order))
+}
+
+// Notify handles asynchronous payment notifications from providers (no auth; verified by signature)
+// GET/POST /api/v1/payment/notify/:provider
+func (h *PaymentHandler) Notify(c *gin.Context) {
+	body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, paymentNotifyMaxBodySize))
+	if err != nil {
+		response.BadRequest(c, "Invalid request body")
+		return
+	}
+	notification := &service.PaymentNotification{
+		Header: c.Request.Header,
+		Query:  c.Request.URL.Query(),
+		Body:   body,
+	}
+	if strings.HasPrefix(c.ContentType(), "application/x-www-form-urlencoded") {
+		if form, err := url.ParseQuery(string(body)); err == nil {
+			notification.Form = form
+		}
+	}
+
+	contentType, ack, err := h.paymentService.HandleNotify(c.Request.Context(), c.Param("provider"), notification)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	c.Data(http.StatusOK, contentType, ack)
+}
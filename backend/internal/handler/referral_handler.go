@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ReferralHandler handles the user referral dashboard
+type ReferralHandler struct {
+	referralService *service.ReferralService
+}
+
+// NewReferralHandler creates a new ReferralHandler
+func NewReferralHandler(referralService *service.ReferralService) *ReferralHandler {
+	return &ReferralHandler{
+		referralService: referralService,
+	}
+}
+
+// GetDashboard returns the user's referral code, current rules and totals
+// GET /api/v1/referral
+func (h *ReferralHandler) GetDashboard(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	dashboard, err := h.referralService.GetDashboard(c.Request.Context(), subject.UserID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, dto.ReferralDashboardFromService(dashboard))
+}
+
+// ListReferrals returns the users referred by the current user
+// GET /api/v1/referral/referees
+func (h *ReferralHandler) ListReferrals(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	page, pageSize := response.ParsePagination(c)
+	params := pagination.PaginationParams{Page: page, PageSize: pageSize}
+	items, result, err := h.referralService.ListReferrals(c.Request.Context(), subject.UserID, params)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	out := make([]dto.Referral, 0, len(items))
+	for i := range items {
+		out = append(out, *dto.ReferralFromService(&items[i]))
+	}
+	response.Paginated(c, out, result.Total, page, pageSize)
+}
+
+// ListCommissions returns commissions credited to the current user
+// GET /api/v1/referral/commissions
+func (h *ReferralHandler) ListCommissions(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	page, pageSize := response.ParsePagination(c)
+	params := pagination.PaginationParams{Page: page, PageSize: pageSize}
+	items, result, err := h.referralService.ListUserCommissions(c.Request.Context(), subject.UserID, params)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	out := make([]dto.ReferralCommission, 0, len(items))
+	for i := range items {
+		out = append(out, *dto.ReferralCommissionFromService(&items[i]))
+	}
+	response.Paginated(c, out, result.Total, page, pageSize)
+}
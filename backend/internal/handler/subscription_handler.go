@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"strconv"
+
 	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
 	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
@@ -33,15 +35,33 @@ type SubscriptionProgressInfo struct {
 // SubscriptionHandler handles user subscription operations
 type SubscriptionHandler struct {
 	subscriptionService *service.SubscriptionService
+	planService         *service.SubscriptionPlanService
 }
 
 // NewSubscriptionHandler creates a new user subscription handler
-func NewSubscriptionHandler(subscriptionService *service.SubscriptionService) *SubscriptionHandler {
+func NewSubscriptionHandler(subscriptionService *service.SubscriptionService, planService *service.SubscriptionPlanService) *SubscriptionHandler {
 	return &SubscriptionHandler{
 		subscriptionService: subscriptionService,
+		planService:         planService,
 	}
 }
 
+// PurchaseSubscriptionRequest represents the plan purchase payload
+type PurchaseSubscriptionRequest struct {
+	PlanID    int64 `json:"plan_id" binding:"required,min=1"`
+	AutoRenew bool  `json:"auto_renew"`
+}
+
+// TransferSubscriptionRequest represents the self-service transfer payload
+type TransferSubscriptionRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+// UpdateAutoRenewRequest represents the auto-renewal toggle payload
+type UpdateAutoRenewRequest struct {
+	AutoRenew *bool `json:"auto_renew" binding:"required"`
+}
+
 // List handles listing current user's subscriptions
 // GET /api/v1/subscriptions
 func (h *SubscriptionHandler) List(c *gin.Context) {
@@ -150,15 +170,16 @@ func (h *SubscriptionHandler) GetSummary(c *gin.Context) {
 
 		// Add group info if preloaded
 		if sub.Group != nil {
-			item.GroupName = sub.Group.Name
-			if sub.Group.DailyLimitUSD != nil {
-				item.DailyLimitUSD = *sub.Group.DailyLimitUSD
+			group := sub.EffectiveGroup(sub.Group)
+			item.GroupName = group.Name
+			if group.DailyLimitUSD != nil {
+				item.DailyLimitUSD = *group.DailyLimitUSD
 			}
-			if sub.Group.WeeklyLimitUSD != nil {
-				item.WeeklyLimitUSD = *sub.Group.WeeklyLimitUSD
+			if group.WeeklyLimitUSD != nil {
+				item.WeeklyLimitUSD = *group.WeeklyLimitUSD
 			}
-			if sub.Group.MonthlyLimitUSD != nil {
-				item.MonthlyLimitUSD = *sub.Group.MonthlyLimitUSD
+			if group.MonthlyLimitUSD != nil {
+				item.MonthlyLimitUSD = *group.MonthlyLimitUSD
 			}
 		}
 
@@ -186,3 +207,150 @@ func (h *SubscriptionHandler) GetSummary(c *gin.Context) {
 
 	response.Success(c, summary)
 }
+
+// ListPlans lists subscription plans available for purchase
+// GET /api/v1/subscriptions/plans
+func (h *SubscriptionHandler) ListPlans(c *gin.Context) {
+	plans, err := h.planService.ListAvailable(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	out := make([]dto.SubscriptionPlan, 0, len(plans))
+	for i := range plans {
+		out = append(out, *dto.SubscriptionPlanFromService(&plans[i]))
+	}
+	response.Success(c, out)
+}
+
+// Purchase buys a subscription plan with the current user's balance
+// POST /api/v1/subscriptions/purchase
+func (h *SubscriptionHandler) Purchase(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	var req PurchaseSubscriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	result, err := h.planService.Purchase(c.Request.Context(), service.PurchaseSubscriptionInput{
+		UserID:    subject.UserID,
+		PlanID:    req.PlanID,
+		AutoRenew: req.AutoRenew,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, dto.SubscriptionPurchaseResultFromService(result))
+}
+
+// UpdateAutoRenew toggles auto-renewal of a plan subscription
+// PUT /api/v1/subscriptions/:id/auto-renew
+func (h *SubscriptionHandler) UpdateAutoRenew(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid subscription ID")
+		return
+	}
+
+	var req UpdateAutoRenewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	sub, err := h.planService.SetAutoRenew(c.Request.Context(), subject.UserID, id, *req.AutoRenew)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, dto.UserSubscriptionFromService(sub))
+}
+
+// Pause handles pausing the current user's subscription
+// POST /api/v1/subscriptions/:id/pause
+func (h *SubscriptionHandler) Pause(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid subscription ID")
+		return
+	}
+
+	sub, err := h.subscriptionService.PauseSubscription(c.Request.Context(), id, service.SubscriptionActor{UserID: subject.UserID})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, dto.UserSubscriptionFromService(sub))
+}
+
+// Resume handles resuming the current user's paused subscription
+// POST /api/v1/subscriptions/:id/resume
+func (h *SubscriptionHandler) Resume(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid subscription ID")
+		return
+	}
+
+	sub, err := h.subscriptionService.ResumeSubscription(c.Request.Context(), id, service.SubscriptionActor{UserID: subject.UserID})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, dto.UserSubscriptionFromService(sub))
+}
+
+// Transfer handles transferring the current user's subscription to another user by email
+// POST /api/v1/subscriptions/:id/transfer
+func (h *SubscriptionHandler) Transfer(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid subscription ID")
+		return
+	}
+
+	var req TransferSubscriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	sub, err := h.subscriptionService.TransferSubscriptionByEmail(c.Request.Context(), id, req.Email, service.SubscriptionActor{UserID: subject.UserID})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, dto.UserSubscriptionFromService(sub))
+}
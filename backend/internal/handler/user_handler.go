@@ -1,8 +1,13 @@
 package handler
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
 	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
 	"github.com/Wei-Shaw/sub2api/internal/service"
 
@@ -11,13 +16,19 @@ import (
 
 // UserHandler handles user-related requests
 type UserHandler struct {
-	userService *service.UserService
+	userService          *service.UserService
+	balanceLedgerService *service.BalanceLedgerService
+	notificationService  *service.UserNotificationService
+	creditLotService     *service.CreditLotService
 }
 
 // NewUserHandler creates a new UserHandler
-func NewUserHandler(userService *service.UserService) *UserHandler {
+func NewUserHandler(userService *service.UserService, balanceLedgerService *service.BalanceLedgerService, notificationService *service.UserNotificationService, creditLotService *service.CreditLotService) *UserHandler {
 	return &UserHandler{
-		userService: userService,
+		userService:          userService,
+		balanceLedgerService: balanceLedgerService,
+		notificationService:  notificationService,
+		creditLotService:     creditLotService,
 	}
 }
 
@@ -32,6 +43,15 @@ type UpdateProfileRequest struct {
 	Username *string `json:"username"`
 }
 
+// UpdateNotificationSettingsRequest represents the notification settings payload
+// 阈值为 null 表示关闭对应通知
+type UpdateNotificationSettingsRequest struct {
+	BalanceThreshold         *float64 `json:"balance_threshold"`
+	SubscriptionUsagePercent *float64 `json:"subscription_usage_percent"`
+	EmailEnabled             bool     `json:"email_enabled"`
+	WebhookURL               string   `json:"webhook_url"`
+}
+
 // GetProfile handles getting user profile
 // GET /api/v1/users/me
 func (h *UserHandler) GetProfile(c *gin.Context) {
@@ -104,3 +124,117 @@ func (h *UserHandler) UpdateProfile(c *gin.Context) {
 
 	response.Success(c, dto.UserFromService(updatedUser))
 }
+
+// ListBalanceTransactions handles listing the current user's balance statement
+// GET /api/v1/user/balance-transactions
+func (h *UserHandler) ListBalanceTransactions(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	filters, err := parseBalanceTransactionFilters(c)
+	if err != nil {
+		response.BadRequest(c, err.Error())
+		return
+	}
+
+	page, pageSize := response.ParsePagination(c)
+	params := pagination.PaginationParams{Page: page, PageSize: pageSize}
+	items, result, err := h.balanceLedgerService.ListUserTransactions(c.Request.Context(), subject.UserID, params, filters)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	out := make([]dto.BalanceTransaction, 0, len(items))
+	for i := range items {
+		out = append(out, *dto.BalanceTransactionFromService(&items[i]))
+	}
+	response.Paginated(c, out, result.Total, page, pageSize)
+}
+
+// GetCreditLots returns the current user's open credit lots and upcoming expirations
+// GET /api/v1/user/credit-lots
+func (h *UserHandler) GetCreditLots(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	summary, err := h.creditLotService.GetUserSummary(c.Request.Context(), subject.UserID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, summary)
+}
+
+// parseBalanceTransactionFilters 解析余额流水过滤参数：type、start_date、end_date（YYYY-MM-DD，含当天）、timezone
+func parseBalanceTransactionFilters(c *gin.Context) (service.BalanceTransactionFilters, error) {
+	filters := service.BalanceTransactionFilters{Type: strings.TrimSpace(c.Query("type"))}
+	userTZ := c.Query("timezone")
+	if startDateStr := c.Query("start_date"); startDateStr != "" {
+		t, err := timezone.ParseInUserLocation("2006-01-02", startDateStr, userTZ)
+		if err != nil {
+			return filters, fmt.Errorf("invalid start_date format, use YYYY-MM-DD")
+		}
+		filters.StartTime = &t
+	}
+	if endDateStr := c.Query("end_date"); endDateStr != "" {
+		t, err := timezone.ParseInUserLocation("2006-01-02", endDateStr, userTZ)
+		if err != nil {
+			return filters, fmt.Errorf("invalid end_date format, use YYYY-MM-DD")
+		}
+		t = t.AddDate(0, 0, 1)
+		filters.EndTime = &t
+	}
+	return filters, nil
+}
+
+// GetNotificationSettings returns the current user's low-balance/quota notification settings
+// GET /api/v1/user/notification-settings
+func (h *UserHandler) GetNotificationSettings(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	settings, err := h.notificationService.GetSettings(c.Request.Context(), subject.UserID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, settings)
+}
+
+// UpdateNotificationSettings updates the current user's notification settings
+// PUT /api/v1/user/notification-settings
+func (h *UserHandler) UpdateNotificationSettings(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	var req UpdateNotificationSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	settings, err := h.notificationService.UpdateSettings(c.Request.Context(), subject.UserID, service.UpdateUserNotificationSettingsInput{
+		BalanceThreshold:         req.BalanceThreshold,
+		SubscriptionUsagePercent: req.SubscriptionUsagePercent,
+		EmailEnabled:             req.EmailEnabled,
+		WebhookURL:               strings.TrimSpace(req.WebhookURL),
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, settings)
+}
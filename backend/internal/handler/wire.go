@@ -26,6 +26,15 @@ func ProvideAdminHandlers(
 	subscriptionHandler *admin.SubscriptionHandler,
 	usageHandler *admin.UsageHandler,
 	userAttributeHandler *admin.UserAttributeHandler,
+	paymentHandler *admin.PaymentHandler,
+	statementHandler *admin.StatementHandler,
+	priceOverrideHandler *admin.PriceOverrideHandler,
+	usageRerateHandler *admin.UsageRerateHandler,
+	subscriptionPlanHandler *admin.SubscriptionPlanHandler,
+	organizationHandler *admin.OrganizationHandler,
+	referralHandler *admin.ReferralHandler,
+	redeemBatchHandler *admin.RedeemBatchHandler,
+	profitHandler *admin.ProfitHandler,
 ) *AdminHandlers {
 	return &AdminHandlers{
 		Dashboard:        dashboardHandler,
@@ -45,6 +54,15 @@ func ProvideAdminHandlers(
 		Subscription:     subscriptionHandler,
 		Usage:            usageHandler,
 		UserAttribute:    userAttributeHandler,
+		Payment:          paymentHandler,
+		Statement:        statementHandler,
+		PriceOverride:    priceOverrideHandler,
+		UsageRerate:      usageRerateHandler,
+		SubscriptionPlan: subscriptionPlanHandler,
+		Organization:     organizationHandler,
+		Referral:         referralHandler,
+		RedeemBatch:      redeemBatchHandler,
+		Profit:           profitHandler,
 	}
 }
 
@@ -66,6 +84,10 @@ func ProvideHandlers(
 	usageHandler *UsageHandler,
 	redeemHandler *RedeemHandler,
 	subscriptionHandler *SubscriptionHandler,
+	paymentHandler *PaymentHandler,
+	statementHandler *StatementHandler,
+	organizationHandler *OrganizationHandler,
+	referralHandler *ReferralHandler,
 	adminHandlers *AdminHandlers,
 	gatewayHandler *GatewayHandler,
 	openaiGatewayHandler *OpenAIGatewayHandler,
@@ -78,6 +100,10 @@ func ProvideHandlers(
 		Usage:         usageHandler,
 		Redeem:        redeemHandler,
 		Subscription:  subscriptionHandler,
+		Payment:       paymentHandler,
+		Statement:     statementHandler,
+		Organization:  organizationHandler,
+		Referral:      referralHandler,
 		Admin:         adminHandlers,
 		Gateway:       gatewayHandler,
 		OpenAIGateway: openaiGatewayHandler,
@@ -94,6 +120,10 @@ var ProviderSet = wire.NewSet(
 	NewUsageHandler,
 	NewRedeemHandler,
 	NewSubscriptionHandler,
+	NewPaymentHandler,
+	NewStatementHandler,
+	NewOrganizationHandler,
+	NewReferralHandler,
 	NewGatewayHandler,
 	NewOpenAIGatewayHandler,
 	ProvideSettingHandler,
@@ -116,6 +146,15 @@ var ProviderSet = wire.NewSet(
 	admin.NewSubscriptionHandler,
 	admin.NewUsageHandler,
 	admin.NewUserAttributeHandler,
+	admin.NewPaymentHandler,
+	admin.NewStatementHandler,
+	admin.NewPriceOverrideHandler,
+	admin.NewUsageRerateHandler,
+	admin.NewSubscriptionPlanHandler,
+	admin.NewOrganizationHandler,
+	admin.NewReferralHandler,
+	admin.NewRedeemBatchHandler,
+	admin.NewProfitHandler,
 
 	// AdminHandlers and Handlers constructors
 	ProvideAdminHandlers,
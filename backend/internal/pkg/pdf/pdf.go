@@ -0,0 +1,155 @@
+// Package pdf 提供生成纯文本 PDF 的最小实现。
+// 使用 PDF 内置的 Courier 等宽字体，按行排版并自动分页，适用于账单等表格类报表，
+// 无需引入外部依赖。仅支持 ASCII 字符，其他字符输出为 '?'。
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	pageWidth  = 595.0 // A4
+	pageHeight = 842.0
+	margin     = 50.0
+	fontSize   = 9.0
+	leading    = 13.0
+)
+
+// Line 一行文本
+type Line struct {
+	Text string
+	Bold bool
+}
+
+// Document 纯文本 PDF 文档
+type Document struct {
+	Title string
+	lines []Line
+}
+
+// New 创建文档
+func New(title string) *Document {
+	return &Document{Title: title}
+}
+
+// Text 追加普通文本行
+func (d *Document) Text(format string, args ...any) {
+	d.lines = append(d.lines, Line{Text: sprintf(format, args...)})
+}
+
+// Heading 追加加粗文本行
+func (d *Document) Heading(format string, args ...any) {
+	d.lines = append(d.lines, Line{Text: sprintf(format, args...), Bold: true})
+}
+
+// Blank 追加空行
+func (d *Document) Blank() {
+	d.lines = append(d.lines, Line{})
+}
+
+// LinesPerPage 每页可容纳的行数
+func LinesPerPage() int {
+	usable := pageHeight - 2*margin
+	return int(usable / leading)
+}
+
+// Bytes 输出 PDF 文件内容
+func (d *Document) Bytes() []byte {
+	perPage := LinesPerPage()
+	var pages [][]Line
+	for start := 0; start < len(d.lines); start += perPage {
+		end := start + perPage
+		if end > len(d.lines) {
+			end = len(d.lines)
+		}
+		pages = append(pages, d.lines[start:end])
+	}
+	if len(pages) == 0 {
+		pages = append(pages, nil)
+	}
+
+	// 对象编号：1 Catalog，2 Pages，3/4 字体，5 Info，之后每页依次为 Page 与内容流
+	const firstPageObj = 6
+	objects := make([]string, 0, firstPageObj-1+2*len(pages))
+	kids := make([]string, 0, len(pages))
+	for i := range pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", firstPageObj+2*i))
+	}
+	objects = append(objects,
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Courier-Bold /Encoding /WinAnsiEncoding >>",
+		fmt.Sprintf("<< /Title (%s) /Producer (sub2api) >>", escape(d.Title)),
+	)
+	for i, lines := range pages {
+		content := renderPage(lines)
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+				pageWidth, pageHeight, firstPageObj+2*i+1),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content),
+		)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R /Info 5 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return buf.Bytes()
+}
+
+func renderPage(lines []Line) string {
+	var sb strings.Builder
+	sb.WriteString("BT\n")
+	fmt.Fprintf(&sb, "%.0f TL\n", leading)
+	fmt.Fprintf(&sb, "%.0f %.0f Td\n", margin, pageHeight-margin)
+	currentFont := ""
+	for _, l := range lines {
+		font := "/F1"
+		if l.Bold {
+			font = "/F2"
+		}
+		if font != currentFont {
+			fmt.Fprintf(&sb, "%s %.0f Tf\n", font, fontSize)
+			currentFont = font
+		}
+		fmt.Fprintf(&sb, "(%s) Tj T*\n", escape(l.Text))
+	}
+	sb.WriteString("ET")
+	return sb.String()
+}
+
+func sprintf(format string, args ...any) string {
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// escape 转义 PDF 字符串中的特殊字符，非 ASCII 可打印字符替换为 '?'
+func escape(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			sb.WriteByte('\\')
+			sb.WriteRune(r)
+		case r < 0x20 || r > 0x7e:
+			sb.WriteByte('?')
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,47 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocumentBytes_ValidXref(t *testing.T) {
+	doc := New("Statement 2026-09")
+	doc.Heading("Monthly Statement")
+	doc.Text("Total: $%.2f", 12.5)
+	out := doc.Bytes()
+
+	require.True(t, bytes.HasPrefix(out, []byte("%PDF-1.4\n")))
+	require.True(t, bytes.HasSuffix(out, []byte("%%EOF\n")))
+
+	// xref 中记录的偏移量必须指向对应对象的起始位置
+	m := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(out)
+	require.NotNil(t, m)
+	xref, err := strconv.Atoi(string(m[1]))
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(out[xref:], []byte("xref\n")))
+
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(out[xref:], -1)
+	require.Len(t, entries, 7) // 5 个公共对象 + 1 页（Page + 内容流）
+	for i, e := range entries {
+		off, _ := strconv.Atoi(string(e[1]))
+		require.True(t, bytes.HasPrefix(out[off:], []byte(fmt.Sprintf("%d 0 obj\n", i+1))), "object %d", i+1)
+	}
+}
+
+func TestDocumentBytes_Paginates(t *testing.T) {
+	doc := New("long")
+	for i := 0; i < LinesPerPage()*2+1; i++ {
+		doc.Text("line %d", i)
+	}
+	require.Contains(t, string(doc.Bytes()), "/Count 3")
+}
+
+func TestEscape(t *testing.T) {
+	require.Equal(t, `a\(b\)\\c ?`, escape(`a(b)\c 中`))
+}
@@ -0,0 +1,20 @@
+// Package usagequota provides the usage quota types shared by the ent schema and the service layer.
+package usagequota
+
+// Quota 分组请求数/Token 配额
+// Window 为 daily / weekly / monthly，与订阅的 USD 窗口同步重置；
+// Model 为模型匹配模式（支持末尾 * 通配符），"*" 表示所有模型；各上限为 0 表示不限制
+type Quota struct {
+	Window          string `json:"window"`
+	Model           string `json:"model"`
+	MaxRequests     int64  `json:"max_requests,omitempty"`
+	MaxInputTokens  int64  `json:"max_input_tokens,omitempty"`
+	MaxOutputTokens int64  `json:"max_output_tokens,omitempty"`
+}
+
+// Counter 订阅在某个窗口、模型模式下的已用请求数与 Token 数
+type Counter struct {
+	Requests     int64 `json:"requests"`
+	InputTokens  int64 `json:"input_tokens"`
+	OutputTokens int64 `json:"output_tokens"`
+}
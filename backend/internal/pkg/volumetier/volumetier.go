@@ -0,0 +1,10 @@
+// Package volumetier provides the volume tier type shared by the ent schema and the service layer.
+package volumetier
+
+// Tier 分组阶梯折扣档位
+// 用户近 30 天实际消费（所有分组 actual_cost 之和）>= MinSpend 时命中，
+// RateMultiplier 作为折扣倍率叠加在分组倍率之上（如 0.9 表示九折）
+type Tier struct {
+	MinSpend       float64 `json:"min_spend"`
+	RateMultiplier float64 `json:"rate_multiplier"`
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type accountAvailabilityRepository struct {
+	sql sqlExecutor
+}
+
+func NewAccountAvailabilityRepository(sqlDB *sql.DB) service.AccountAvailabilityRepository {
+	return &accountAvailabilityRepository{sql: sqlDB}
+}
+
+func (r *accountAvailabilityRepository) ListAvailabilitySchedules(ctx context.Context) ([]service.AccountAvailabilityEntry, error) {
+	rows, err := r.sql.QueryContext(ctx, `
+		SELECT id, availability_windows, COALESCE(availability_timezone, '')
+		FROM accounts
+		WHERE deleted_at IS NULL
+			AND availability_windows IS NOT NULL
+			AND jsonb_array_length(availability_windows) > 0
+		ORDER BY id
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := make([]service.AccountAvailabilityEntry, 0)
+	for rows.Next() {
+		var (
+			entry service.AccountAvailabilityEntry
+			raw   []byte
+		)
+		if err := rows.Scan(&entry.AccountID, &raw, &entry.Timezone); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(raw, &entry.Windows); err != nil {
+			log.Printf("[AccountAvailability] skip invalid windows: account=%d err=%v", entry.AccountID, err)
+			continue
+		}
+		out = append(out, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *accountAvailabilityRepository) NotifyAvailabilityChanged(ctx context.Context, accountIDs []int64) error {
+	if len(accountIDs) == 0 {
+		return nil
+	}
+	payload := map[string]any{"account_ids": accountIDs}
+	return enqueueSchedulerOutbox(ctx, r.sql, service.SchedulerOutboxEventAccountBulkChanged, nil, nil, payload)
+}
+
+func (r *accountAvailabilityRepository) GetLastBoundaryCheck(ctx context.Context) (time.Time, error) {
+	var ts time.Time
+	query := "SELECT last_checked_at FROM account_availability_watermark WHERE id = 1"
+	if err := scanSingleRow(ctx, r.sql, query, nil, &ts); err != nil {
+		if err == sql.ErrNoRows {
+			return time.Time{}, nil
+		}
+		return time.Time{}, err
+	}
+	return ts.UTC(), nil
+}
+
+func (r *accountAvailabilityRepository) UpdateLastBoundaryCheck(ctx context.Context, checkedAt time.Time) error {
+	query := `
+		INSERT INTO account_availability_watermark (id, last_checked_at, updated_at)
+		VALUES (1, $1, NOW())
+		ON CONFLICT (id)
+		DO UPDATE SET last_checked_at = EXCLUDED.last_checked_at, updated_at = EXCLUDED.updated_at
+	`
+	_, err := r.sql.ExecContext(ctx, query, checkedAt.UTC())
+	return err
+}
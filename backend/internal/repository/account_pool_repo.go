@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type accountPoolRepository struct {
+	sql sqlExecutor
+}
+
+func NewAccountPoolRepository(sqlDB *sql.DB) service.AccountPoolRepository {
+	return &accountPoolRepository{sql: sqlDB}
+}
+
+func (r *accountPoolRepository) SetAccountPool(ctx context.Context, groupID, accountID int64, pool string) error {
+	res, err := r.sql.ExecContext(ctx,
+		"UPDATE account_groups SET pool = $1 WHERE account_id = $2 AND group_id = $3",
+		pool, accountID, groupID)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return service.ErrAccountNotInGroup
+	}
+	payload := buildSchedulerGroupPayload([]int64{groupID})
+	if err := enqueueSchedulerOutbox(ctx, r.sql, service.SchedulerOutboxEventAccountGroupsChanged, &accountID, nil, payload); err != nil {
+		log.Printf("[SchedulerOutbox] enqueue set account pool failed: account=%d group=%d err=%v", accountID, groupID, err)
+	}
+	return nil
+}
+
+func (r *accountPoolRepository) CountAccountsByPool(ctx context.Context, groupID int64) (map[string]int64, error) {
+	rows, err := r.sql.QueryContext(ctx, `
+		SELECT ag.pool, COUNT(*)
+		FROM account_groups ag
+		JOIN accounts a ON a.id = ag.account_id AND a.deleted_at IS NULL
+		WHERE ag.group_id = $1
+		GROUP BY ag.pool
+	`, groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := make(map[string]int64)
+	for rows.Next() {
+		var (
+			pool  string
+			count int64
+		)
+		if err := rows.Scan(&pool, &count); err != nil {
+			return nil, err
+		}
+		out[pool] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
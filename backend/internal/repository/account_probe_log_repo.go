@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type accountProbeRepository struct {
+	sql sqlExecutor
+}
+
+func NewAccountProbeRepository(sqlDB *sql.DB) service.AccountProbeRepository {
+	return &accountProbeRepository{sql: sqlDB}
+}
+
+func (r *accountProbeRepository) Create(ctx context.Context, entry *service.AccountProbeLog) error {
+	if entry == nil {
+		return nil
+	}
+	createdAt := entry.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+	query := `
+		INSERT INTO account_probe_logs
+			(account_id, platform, model, trigger_type, success, status_code, latency_ms, error_message, action, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id, created_at
+	`
+	args := []any{
+		entry.AccountID,
+		entry.Platform,
+		entry.Model,
+		entry.Trigger,
+		entry.Success,
+		nullInt(entry.StatusCode),
+		entry.LatencyMs,
+		nullString(entry.ErrorMessage),
+		entry.Action,
+		createdAt,
+	}
+	return scanSingleRow(ctx, r.sql, query, args, &entry.ID, &entry.CreatedAt)
+}
+
+func (r *accountProbeRepository) ListByAccount(ctx context.Context, accountID int64, params pagination.PaginationParams) ([]service.AccountProbeLog, *pagination.PaginationResult, error) {
+	var total int64
+	if err := scanSingleRow(ctx, r.sql, "SELECT COUNT(*) FROM account_probe_logs WHERE account_id = $1", []any{accountID}, &total); err != nil {
+		return nil, nil, err
+	}
+	if total == 0 {
+		return []service.AccountProbeLog{}, paginationResultFromTotal(0, params), nil
+	}
+
+	rows, err := r.sql.QueryContext(ctx, `
+		SELECT id, account_id, platform, model, trigger_type, success, status_code, latency_ms, error_message, action, created_at
+		FROM account_probe_logs
+		WHERE account_id = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2 OFFSET $3
+	`, accountID, params.Limit(), params.Offset())
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	logs := make([]service.AccountProbeLog, 0)
+	for rows.Next() {
+		var (
+			entry      service.AccountProbeLog
+			statusCode sql.NullInt64
+			errMsg     sql.NullString
+		)
+		if err := rows.Scan(
+			&entry.ID,
+			&entry.AccountID,
+			&entry.Platform,
+			&entry.Model,
+			&entry.Trigger,
+			&entry.Success,
+			&statusCode,
+			&entry.LatencyMs,
+			&errMsg,
+			&entry.Action,
+			&entry.CreatedAt,
+		); err != nil {
+			return nil, nil, err
+		}
+		if statusCode.Valid {
+			code := int(statusCode.Int64)
+			entry.StatusCode = &code
+		}
+		if errMsg.Valid {
+			msg := errMsg.String
+			entry.ErrorMessage = &msg
+		}
+		logs = append(logs, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+	return logs, paginationResultFromTotal(total, params), nil
+}
+
+func (r *accountProbeRepository) GetLastProbeTimes(ctx context.Context, since time.Time) (map[int64]time.Time, error) {
+	rows, err := r.sql.QueryContext(ctx, `
+		SELECT account_id, MAX(created_at)
+		FROM account_probe_logs
+		WHERE created_at >= $1
+		GROUP BY account_id
+	`, since)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := make(map[int64]time.Time)
+	for rows.Next() {
+		var (
+			accountID int64
+			lastAt    time.Time
+		)
+		if err := rows.Scan(&accountID, &lastAt); err != nil {
+			return nil, err
+		}
+		out[accountID] = lastAt
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *accountProbeRepository) DeleteBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	res, err := r.sql.ExecContext(ctx, "DELETE FROM account_probe_logs WHERE created_at < $1", cutoff)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
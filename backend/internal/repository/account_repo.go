@@ -86,6 +86,12 @@ func (r *accountRepository) Create(ctx context.Context, account *service.Account
 		SetSchedulable(account.Schedulable).
 		SetAutoPauseOnExpired(account.AutoPauseOnExpired)
 
+	if len(account.AvailabilityWindows) > 0 {
+		builder.SetAvailabilityWindows(account.AvailabilityWindows)
+	}
+	if account.AvailabilityTimezone != "" {
+		builder.SetAvailabilityTimezone(account.AvailabilityTimezone)
+	}
 	if account.RateMultiplier != nil {
 		builder.SetRateMultiplier(*account.RateMultiplier)
 	}
@@ -301,6 +307,18 @@ func (r *accountRepository) Update(ctx context.Context, account *service.Account
 		SetSchedulable(account.Schedulable).
 		SetAutoPauseOnExpired(account.AutoPauseOnExpired)
 
+	// 可用时间窗口：为空时清除
+	if len(account.AvailabilityWindows) > 0 {
+		builder.SetAvailabilityWindows(account.AvailabilityWindows)
+	} else {
+		builder.ClearAvailabilityWindows()
+	}
+	if account.AvailabilityTimezone != "" {
+		builder.SetAvailabilityTimezone(account.AvailabilityTimezone)
+	} else {
+		builder.ClearAvailabilityTimezone()
+	}
+
 	if account.RateMultiplier != nil {
 		builder.SetRateMultiplier(*account.RateMultiplier)
 	}
@@ -581,7 +599,14 @@ func (r *accountRepository) ClearError(ctx context.Context, id int64) error {
 		SetStatus(service.StatusActive).
 		SetErrorMessage("").
 		Save(ctx)
-	return err
+	if err != nil {
+		return err
+	}
+	if err := enqueueSchedulerOutbox(ctx, r.sql, service.SchedulerOutboxEventAccountChanged, &id, nil, nil); err != nil {
+		log.Printf("[SchedulerOutbox] enqueue clear error failed: account=%d err=%v", id, err)
+	}
+	r.syncSchedulerAccountSnapshot(ctx, id)
+	return nil
 }
 
 func (r *accountRepository) AddToGroup(ctx context.Context, accountID, groupID int64, priority int) error {
@@ -635,10 +660,20 @@ func (r *accountRepository) GetGroups(ctx context.Context, accountID int64) ([]s
 }
 
 func (r *accountRepository) BindGroups(ctx context.Context, accountID int64, groupIDs []int64) error {
-	existingGroupIDs, err := r.loadAccountGroupIDs(ctx, accountID)
+	existing, err := r.client.AccountGroup.
+		Query().
+		Where(dbaccountgroup.AccountIDEQ(accountID)).
+		All(ctx)
 	if err != nil {
 		return err
 	}
+	existingGroupIDs := make([]int64, 0, len(existing))
+	// 重新绑定时保留账号在原分组内的子池标签
+	existingPools := make(map[int64]string, len(existing))
+	for _, entry := range existing {
+		existingGroupIDs = append(existingGroupIDs, entry.GroupID)
+		existingPools[entry.GroupID] = entry.Pool
+	}
 	// 使用事务保证删除旧绑定与创建新绑定的原子性
 	tx, err := r.client.Tx(ctx)
 	if err != nil && !errors.Is(err, dbent.ErrTxStarted) {
@@ -667,11 +702,14 @@ func (r *accountRepository) BindGroups(ctx context.Context, accountID int64, gro
 
 	builders := make([]*dbent.AccountGroupCreate, 0, len(groupIDs))
 	for i, groupID := range groupIDs {
-		builders = append(builders, txClient.AccountGroup.Create().
+		builder := txClient.AccountGroup.Create().
 			SetAccountID(accountID).
 			SetGroupID(groupID).
-			SetPriority(i+1),
-		)
+			SetPriority(i + 1)
+		if pool, ok := existingPools[groupID]; ok && pool != "" {
+			builder = builder.SetPool(pool)
+		}
+		builders = append(builders, builder)
 	}
 
 	if _, err := txClient.AccountGroup.CreateBulk(builders...).Save(ctx); err != nil {
@@ -1424,6 +1462,7 @@ func (r *accountRepository) loadAccountGroups(ctx context.Context, accountIDs []
 			AccountID: ag.AccountID,
 			GroupID:   ag.GroupID,
 			Priority:  ag.Priority,
+			Pool:      ag.Pool,
 			CreatedAt: ag.CreatedAt,
 			Group:     groupSvc,
 		}
@@ -1493,31 +1532,33 @@ func accountEntityToService(m *dbent.Account) *service.Account {
 	rateMultiplier := m.RateMultiplier
 
 	return &service.Account{
-		ID:                  m.ID,
-		Name:                m.Name,
-		Notes:               m.Notes,
-		Platform:            m.Platform,
-		Type:                m.Type,
-		Credentials:         copyJSONMap(m.Credentials),
-		Extra:               copyJSONMap(m.Extra),
-		ProxyID:             m.ProxyID,
-		Concurrency:         m.Concurrency,
-		Priority:            m.Priority,
-		RateMultiplier:      &rateMultiplier,
-		Status:              m.Status,
-		ErrorMessage:        derefString(m.ErrorMessage),
-		LastUsedAt:          m.LastUsedAt,
-		ExpiresAt:           m.ExpiresAt,
-		AutoPauseOnExpired:  m.AutoPauseOnExpired,
-		CreatedAt:           m.CreatedAt,
-		UpdatedAt:           m.UpdatedAt,
-		Schedulable:         m.Schedulable,
-		RateLimitedAt:       m.RateLimitedAt,
-		RateLimitResetAt:    m.RateLimitResetAt,
-		OverloadUntil:       m.OverloadUntil,
-		SessionWindowStart:  m.SessionWindowStart,
-		SessionWindowEnd:    m.SessionWindowEnd,
-		SessionWindowStatus: derefString(m.SessionWindowStatus),
+		ID:                   m.ID,
+		Name:                 m.Name,
+		Notes:                m.Notes,
+		Platform:             m.Platform,
+		Type:                 m.Type,
+		Credentials:          copyJSONMap(m.Credentials),
+		Extra:                copyJSONMap(m.Extra),
+		ProxyID:              m.ProxyID,
+		Concurrency:          m.Concurrency,
+		Priority:             m.Priority,
+		RateMultiplier:       &rateMultiplier,
+		Status:               m.Status,
+		ErrorMessage:         derefString(m.ErrorMessage),
+		LastUsedAt:           m.LastUsedAt,
+		ExpiresAt:            m.ExpiresAt,
+		AutoPauseOnExpired:   m.AutoPauseOnExpired,
+		CreatedAt:            m.CreatedAt,
+		UpdatedAt:            m.UpdatedAt,
+		Schedulable:          m.Schedulable,
+		RateLimitedAt:        m.RateLimitedAt,
+		RateLimitResetAt:     m.RateLimitResetAt,
+		OverloadUntil:        m.OverloadUntil,
+		SessionWindowStart:   m.SessionWindowStart,
+		SessionWindowEnd:     m.SessionWindowEnd,
+		SessionWindowStatus:  derefString(m.SessionWindowStatus),
+		AvailabilityWindows:  m.AvailabilityWindows,
+		AvailabilityTimezone: derefString(m.AvailabilityTimezone),
 	}
 }
 
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/redis/go-redis/v9"
+)
+
+const accountSpendKeyPrefix = "account_spend:"
+
+// accountSpendIncrScript 仅在 key 存在时累加，避免在缓存缺失时以不完整的值开始计数
+var accountSpendIncrScript = redis.NewScript(`
+	if redis.call('EXISTS', KEYS[1]) == 0 then
+		return false
+	end
+	return redis.call('INCRBYFLOAT', KEYS[1], ARGV[1])
+`)
+
+type accountSpendCache struct {
+	rdb *redis.Client
+}
+
+func NewAccountSpendCache(rdb *redis.Client) service.AccountSpendCache {
+	return &accountSpendCache{rdb: rdb}
+}
+
+func accountSpendKey(accountID int64, period string, periodStart time.Time) string {
+	return fmt.Sprintf("%s%d:%s:%d", accountSpendKeyPrefix, accountID, period, periodStart.Unix())
+}
+
+func (c *accountSpendCache) GetAccountSpend(ctx context.Context, accountID int64, period string, periodStart time.Time) (float64, bool, error) {
+	val, err := c.rdb.Get(ctx, accountSpendKey(accountID, period, periodStart)).Float64()
+	if err == redis.Nil {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return val, true, nil
+}
+
+func (c *accountSpendCache) SetAccountSpend(ctx context.Context, accountID int64, period string, periodStart time.Time, spend float64, ttl time.Duration) error {
+	return c.rdb.Set(ctx, accountSpendKey(accountID, period, periodStart), spend, ttl).Err()
+}
+
+func (c *accountSpendCache) IncrAccountSpend(ctx context.Context, accountID int64, period string, periodStart time.Time, delta float64) (float64, bool, error) {
+	key := accountSpendKey(accountID, period, periodStart)
+	res, err := accountSpendIncrScript.Run(ctx, c.rdb, []string{key}, strconv.FormatFloat(delta, 'f', -1, 64)).Result()
+	if err == redis.Nil {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	str, ok := res.(string)
+	if !ok {
+		return 0, false, fmt.Errorf("unexpected incr result type %T", res)
+	}
+	val, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return 0, false, err
+	}
+	return val, true, nil
+}
@@ -33,7 +33,13 @@ func (r *apiKeyRepository) Create(ctx context.Context, key *service.APIKey) erro
 		SetKey(key.Key).
 		SetName(key.Name).
 		SetStatus(key.Status).
-		SetNillableGroupID(key.GroupID)
+		SetNillableGroupID(key.GroupID).
+		SetNillableDailyLimitUsd(key.DailyLimitUSD).
+		SetNillableMonthlyLimitUsd(key.MonthlyLimitUSD).
+		SetNillableTotalLimitUsd(key.TotalLimitUSD).
+		SetNillableDailyRequestLimit(key.DailyRequestLimit).
+		SetNillableMonthlyRequestLimit(key.MonthlyRequestLimit).
+		SetNillableOrganizationID(key.OrganizationID)
 
 	if len(key.IPWhitelist) > 0 {
 		builder.SetIPWhitelist(key.IPWhitelist)
@@ -110,6 +116,12 @@ func (r *apiKeyRepository) GetByKeyForAuth(ctx context.Context, key string) (*se
 			apikey.FieldStatus,
 			apikey.FieldIPWhitelist,
 			apikey.FieldIPBlacklist,
+			apikey.FieldDailyLimitUsd,
+			apikey.FieldMonthlyLimitUsd,
+			apikey.FieldTotalLimitUsd,
+			apikey.FieldDailyRequestLimit,
+			apikey.FieldMonthlyRequestLimit,
+			apikey.FieldOrganizationID,
 		).
 		WithUser(func(q *dbent.UserQuery) {
 			q.Select(
@@ -138,6 +150,16 @@ func (r *apiKeyRepository) GetByKeyForAuth(ctx context.Context, key string) (*se
 				group.FieldFallbackGroupID,
 				group.FieldModelRoutingEnabled,
 				group.FieldModelRouting,
+				group.FieldPoolSplitEnabled,
+				group.FieldPoolWeights,
+				group.FieldCapacityFallbackGroupIds,
+				group.FieldFallbackModelMapping,
+				group.FieldVolumeTiers,
+				group.FieldOverageEnabled,
+				group.FieldOverageRateMultiplier,
+				group.FieldRollingWindowHours,
+				group.FieldRollingWindowLimitUsd,
+				group.FieldUsageQuotas,
 			)
 		}).
 		Only(ctx)
@@ -180,6 +202,38 @@ func (r *apiKeyRepository) Update(ctx context.Context, key *service.APIKey) erro
 		builder.ClearIPBlacklist()
 	}
 
+	// 限额字段：nil 表示不限制
+	if key.DailyLimitUSD != nil {
+		builder.SetDailyLimitUsd(*key.DailyLimitUSD)
+	} else {
+		builder.ClearDailyLimitUsd()
+	}
+	if key.MonthlyLimitUSD != nil {
+		builder.SetMonthlyLimitUsd(*key.MonthlyLimitUSD)
+	} else {
+		builder.ClearMonthlyLimitUsd()
+	}
+	if key.TotalLimitUSD != nil {
+		builder.SetTotalLimitUsd(*key.TotalLimitUSD)
+	} else {
+		builder.ClearTotalLimitUsd()
+	}
+	if key.DailyRequestLimit != nil {
+		builder.SetDailyRequestLimit(*key.DailyRequestLimit)
+	} else {
+		builder.ClearDailyRequestLimit()
+	}
+	if key.MonthlyRequestLimit != nil {
+		builder.SetMonthlyRequestLimit(*key.MonthlyRequestLimit)
+	} else {
+		builder.ClearMonthlyRequestLimit()
+	}
+	if key.OrganizationID != nil {
+		builder.SetOrganizationID(*key.OrganizationID)
+	} else {
+		builder.ClearOrganizationID()
+	}
+
 	affected, err := builder.Save(ctx)
 	if err != nil {
 		return err
@@ -372,6 +426,14 @@ func apiKeyEntityToService(m *dbent.APIKey) *service.APIKey {
 		CreatedAt:   m.CreatedAt,
 		UpdatedAt:   m.UpdatedAt,
 		GroupID:     m.GroupID,
+
+		DailyLimitUSD:       m.DailyLimitUsd,
+		MonthlyLimitUSD:     m.MonthlyLimitUsd,
+		TotalLimitUSD:       m.TotalLimitUsd,
+		DailyRequestLimit:   m.DailyRequestLimit,
+		MonthlyRequestLimit: m.MonthlyRequestLimit,
+
+		OrganizationID: m.OrganizationID,
 	}
 	if m.Edges.User != nil {
 		out.User = userEntityToService(m.Edges.User)
@@ -406,28 +468,39 @@ func groupEntityToService(g *dbent.Group) *service.Group {
 		return nil
 	}
 	return &service.Group{
-		ID:                  g.ID,
-		Name:                g.Name,
-		Description:         derefString(g.Description),
-		Platform:            g.Platform,
-		RateMultiplier:      g.RateMultiplier,
-		IsExclusive:         g.IsExclusive,
-		Status:              g.Status,
-		Hydrated:            true,
-		SubscriptionType:    g.SubscriptionType,
-		DailyLimitUSD:       g.DailyLimitUsd,
-		WeeklyLimitUSD:      g.WeeklyLimitUsd,
-		MonthlyLimitUSD:     g.MonthlyLimitUsd,
-		ImagePrice1K:        g.ImagePrice1k,
-		ImagePrice2K:        g.ImagePrice2k,
-		ImagePrice4K:        g.ImagePrice4k,
-		DefaultValidityDays: g.DefaultValidityDays,
-		ClaudeCodeOnly:      g.ClaudeCodeOnly,
-		FallbackGroupID:     g.FallbackGroupID,
-		ModelRouting:        g.ModelRouting,
-		ModelRoutingEnabled: g.ModelRoutingEnabled,
-		CreatedAt:           g.CreatedAt,
-		UpdatedAt:           g.UpdatedAt,
+		ID:                       g.ID,
+		Name:                     g.Name,
+		Description:              derefString(g.Description),
+		Platform:                 g.Platform,
+		RateMultiplier:           g.RateMultiplier,
+		IsExclusive:              g.IsExclusive,
+		Status:                   g.Status,
+		Hydrated:                 true,
+		SubscriptionType:         g.SubscriptionType,
+		DailyLimitUSD:            g.DailyLimitUsd,
+		WeeklyLimitUSD:           g.WeeklyLimitUsd,
+		MonthlyLimitUSD:          g.MonthlyLimitUsd,
+		ImagePrice1K:             g.ImagePrice1k,
+		ImagePrice2K:             g.ImagePrice2k,
+		ImagePrice4K:             g.ImagePrice4k,
+		DefaultValidityDays:      g.DefaultValidityDays,
+		ClaudeCodeOnly:           g.ClaudeCodeOnly,
+		FallbackGroupID:          g.FallbackGroupID,
+		ModelRouting:             g.ModelRouting,
+		ModelRoutingEnabled:      g.ModelRoutingEnabled,
+		PoolSplitEnabled:         g.PoolSplitEnabled,
+		PoolWeights:              g.PoolWeights,
+		CanaryMaxErrorRate:       g.CanaryMaxErrorRate,
+		CapacityFallbackGroupIDs: g.CapacityFallbackGroupIds,
+		FallbackModelMapping:     g.FallbackModelMapping,
+		VolumeTiers:              g.VolumeTiers,
+		OverageEnabled:           g.OverageEnabled,
+		OverageRateMultiplier:    g.OverageRateMultiplier,
+		RollingWindowHours:       g.RollingWindowHours,
+		RollingWindowLimitUSD:    g.RollingWindowLimitUsd,
+		UsageQuotas:              g.UsageQuotas,
+		CreatedAt:                g.CreatedAt,
+		UpdatedAt:                g.UpdatedAt,
 	}
 }
 
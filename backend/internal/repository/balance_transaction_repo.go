@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type balanceTransactionRepository struct {
+	sql sqlExecutor
+}
+
+func NewBalanceTransactionRepository(sqlDB *sql.DB) service.BalanceTransactionRepository {
+	return &balanceTransactionRepository{sql: sqlDB}
+}
+
+func (r *balanceTransactionRepository) ListByUser(ctx context.Context, userID int64, params pagination.PaginationParams, filters service.BalanceTransactionFilters) ([]service.BalanceTransaction, *pagination.PaginationResult, error) {
+	conditions := []string{"user_id = $1"}
+	args := []any{userID}
+	if filters.Type != "" {
+		args = append(args, filters.Type)
+		conditions = append(conditions, fmt.Sprintf("type = $%d", len(args)))
+	}
+	if filters.StartTime != nil {
+		args = append(args, *filters.StartTime)
+		conditions = append(conditions, fmt.Sprintf("created_at >= $%d", len(args)))
+	}
+	if filters.EndTime != nil {
+		args = append(args, *filters.EndTime)
+		conditions = append(conditions, fmt.Sprintf("created_at < $%d", len(args)))
+	}
+	where := strings.Join(conditions, " AND ")
+
+	var total int64
+	if err := scanSingleRow(ctx, r.sql, "SELECT COUNT(*) FROM balance_transactions WHERE "+where, args, &total); err != nil {
+		return nil, nil, err
+	}
+	if total == 0 {
+		return []service.BalanceTransaction{}, paginationResultFromTotal(0, params), nil
+	}
+
+	query := fmt.Sprintf(`
+		SELECT id, user_id, type, amount, balance_after, usage_log_id, redeem_code_id, promo_code_id, payment_order_id, credit_lot_id, notes, created_at
+		FROM balance_transactions
+		WHERE %s
+		ORDER BY created_at DESC, id DESC
+		LIMIT $%d OFFSET $%d
+	`, where, len(args)+1, len(args)+2)
+	rows, err := r.sql.QueryContext(ctx, query, append(args, params.Limit(), params.Offset())...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := make([]service.BalanceTransaction, 0)
+	for rows.Next() {
+		var (
+			tx             service.BalanceTransaction
+			usageLogID     sql.NullInt64
+			redeemCodeID   sql.NullInt64
+			promoCodeID    sql.NullInt64
+			paymentOrderID sql.NullInt64
+			creditLotID    sql.NullInt64
+			notes          sql.NullString
+		)
+		if err := rows.Scan(
+			&tx.ID,
+			&tx.UserID,
+			&tx.Type,
+			&tx.Amount,
+			&tx.BalanceAfter,
+			&usageLogID,
+			&redeemCodeID,
+			&promoCodeID,
+			&paymentOrderID,
+			&creditLotID,
+			&notes,
+			&tx.CreatedAt,
+		); err != nil {
+			return nil, nil, err
+		}
+		tx.UsageLogID = nullInt64Ptr(usageLogID)
+		tx.RedeemCodeID = nullInt64Ptr(redeemCodeID)
+		tx.PromoCodeID = nullInt64Ptr(promoCodeID)
+		tx.PaymentOrderID = nullInt64Ptr(paymentOrderID)
+		tx.CreditLotID = nullInt64Ptr(creditLotID)
+		tx.Notes = notes.String
+		out = append(out, tx)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+	return out, paginationResultFromTotal(total, params), nil
+}
+
+func (r *balanceTransactionRepository) FindDrifts(ctx context.Context, tolerance float64, limit int) ([]service.BalanceDrift, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+	rows, err := r.sql.QueryContext(ctx, `
+		SELECT u.id, u.email, u.balance, COALESCE(l.total, 0) AS ledger_total
+		FROM users u
+		LEFT JOIN (
+			SELECT user_id, SUM(amount) AS total
+			FROM balance_transactions
+			GROUP BY user_id
+		) l ON l.user_id = u.id
+		WHERE u.deleted_at IS NULL
+			AND ABS(u.balance - COALESCE(l.total, 0)) > $1
+		ORDER BY ABS(u.balance - COALESCE(l.total, 0)) DESC, u.id
+		LIMIT $2
+	`, tolerance, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := make([]service.BalanceDrift, 0)
+	for rows.Next() {
+		var d service.BalanceDrift
+		if err := rows.Scan(&d.UserID, &d.Email, &d.Balance, &d.LedgerTotal); err != nil {
+			return nil, err
+		}
+		d.Drift = d.Balance - d.LedgerTotal
+		out = append(out, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -13,9 +13,15 @@ import (
 )
 
 const (
-	billingBalanceKeyPrefix = "billing:balance:"
-	billingSubKeyPrefix     = "billing:sub:"
-	billingCacheTTL         = 5 * time.Minute
+	billingBalanceKeyPrefix      = "billing:balance:"
+	billingSubKeyPrefix          = "billing:sub:"
+	billingRollingSpendKeyPrefix = "billing:spend30d:"
+	billingAPIKeyKeyPrefix       = "billing:apikey:"
+	billingHoldKeyPrefix         = "billing:holds:"
+	billingRollingWindowPrefix   = "billing:rolling:"
+	billingCacheTTL              = 5 * time.Minute
+	// 滚动消费仅用于阶梯折扣分档，允许一定延迟以避免每次请求聚合 usage_logs
+	billingRollingSpendCacheTTL = 10 * time.Minute
 )
 
 // billingBalanceKey generates the Redis key for user balance cache.
@@ -23,6 +29,26 @@ func billingBalanceKey(userID int64) string {
 	return fmt.Sprintf("%s%d", billingBalanceKeyPrefix, userID)
 }
 
+// billingRollingSpendKey generates the Redis key for rolling 30-day spend cache.
+func billingRollingSpendKey(userID int64) string {
+	return fmt.Sprintf("%s%d", billingRollingSpendKeyPrefix, userID)
+}
+
+// billingAPIKeyKey generates the Redis key for per-API-key usage cache.
+func billingAPIKeyKey(apiKeyID int64) string {
+	return fmt.Sprintf("%s%d", billingAPIKeyKeyPrefix, apiKeyID)
+}
+
+// billingHoldKey generates the Redis key for in-flight balance holds of a user.
+func billingHoldKey(userID int64) string {
+	return fmt.Sprintf("%s%d", billingHoldKeyPrefix, userID)
+}
+
+// billingRollingWindowKey generates the Redis key for bucketed rolling window usage of a subscription.
+func billingRollingWindowKey(userID, groupID int64) string {
+	return fmt.Sprintf("%s%d:%d", billingRollingWindowPrefix, userID, groupID)
+}
+
 // billingSubKey generates the Redis key for subscription cache.
 func billingSubKey(userID, groupID int64) string {
 	return fmt.Sprintf("%s%d:%d", billingSubKeyPrefix, userID, groupID)
@@ -37,6 +63,16 @@ const (
 	subFieldVersion      = "version"
 )
 
+const (
+	keyFieldDayStart        = "day_start"
+	keyFieldMonthStart      = "month_start"
+	keyFieldDailyUsage      = "daily_usage"
+	keyFieldMonthlyUsage    = "monthly_usage"
+	keyFieldTotalUsage      = "total_usage"
+	keyFieldDailyRequests   = "daily_requests"
+	keyFieldMonthlyRequests = "monthly_requests"
+)
+
 var (
 	deductBalanceScript = redis.NewScript(`
 		local current = redis.call('GET', KEYS[1])
@@ -61,6 +97,69 @@ var (
 		redis.call('EXPIRE', KEYS[1], ARGV[2])
 		return 1
 	`)
+
+	updateAPIKeyUsageScript = redis.NewScript(`
+		local exists = redis.call('EXISTS', KEYS[1])
+		if exists == 0 then
+			return 0
+		end
+		local cost = tonumber(ARGV[1])
+		redis.call('HINCRBYFLOAT', KEYS[1], 'daily_usage', cost)
+		redis.call('HINCRBYFLOAT', KEYS[1], 'monthly_usage', cost)
+		redis.call('HINCRBYFLOAT', KEYS[1], 'total_usage', cost)
+		redis.call('HINCRBY', KEYS[1], 'daily_requests', 1)
+		redis.call('HINCRBY', KEYS[1], 'monthly_requests', 1)
+		redis.call('EXPIRE', KEYS[1], ARGV[2])
+		return 1
+	`)
+
+	// reserveBalanceScript 预留余额：每个预留以 "金额:过期时间戳" 存于用户的 hash 中。
+	// 先清理已过期的预留（进程重启导致定时释放丢失时兜底），再检查 余额 - 已预留 是否足以覆盖本次预留；
+	// 无其他在途预留时只要余额为正即放行，保持单请求的行为与未启用预留时一致。
+	// ARGV: hold_id, balance, amount, now, expires_at, ttl_seconds
+	reserveBalanceScript = redis.NewScript(`
+		local now = tonumber(ARGV[4])
+		local entries = redis.call('HGETALL', KEYS[1])
+		local held = 0
+		for i = 1, #entries, 2 do
+			local value = entries[i + 1]
+			local sep = string.find(value, ':', 1, true)
+			local amount = tonumber(string.sub(value, 1, sep - 1))
+			local expiresAt = tonumber(string.sub(value, sep + 1))
+			if expiresAt <= now then
+				redis.call('HDEL', KEYS[1], entries[i])
+			else
+				held = held + amount
+			end
+		end
+		local balance = tonumber(ARGV[2])
+		local amount = tonumber(ARGV[3])
+		if balance <= 0 then
+			return 0
+		end
+		if held > 0 and balance - held < amount then
+			return 0
+		end
+		redis.call('HSET', KEYS[1], ARGV[1], ARGV[3] .. ':' .. ARGV[5])
+		redis.call('EXPIRE', KEYS[1], ARGV[6])
+		return 1
+	`)
+
+	// addRollingWindowUsageScript 累加滚动窗口分桶用量：field 为桶起始 Unix 秒，
+	// 同时清理起始时间不晚于 cutoff 的过期桶，key 在窗口结束后自动过期。
+	// ARGV: bucket_start, cost, cutoff, ttl_seconds
+	addRollingWindowUsageScript = redis.NewScript(`
+		local cutoff = tonumber(ARGV[3])
+		local fields = redis.call('HKEYS', KEYS[1])
+		for i = 1, #fields do
+			if tonumber(fields[i]) <= cutoff then
+				redis.call('HDEL', KEYS[1], fields[i])
+			end
+		end
+		redis.call('HINCRBYFLOAT', KEYS[1], ARGV[1], ARGV[2])
+		redis.call('EXPIRE', KEYS[1], ARGV[4])
+		return 1
+	`)
 )
 
 type billingCache struct {
@@ -181,3 +280,135 @@ func (c *billingCache) InvalidateSubscriptionCache(ctx context.Context, userID,
 	key := billingSubKey(userID, groupID)
 	return c.rdb.Del(ctx, key).Err()
 }
+
+func (c *billingCache) GetUserRollingSpend(ctx context.Context, userID int64) (float64, error) {
+	key := billingRollingSpendKey(userID)
+	val, err := c.rdb.Get(ctx, key).Result()
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(val, 64)
+}
+
+func (c *billingCache) SetUserRollingSpend(ctx context.Context, userID int64, spend float64) error {
+	key := billingRollingSpendKey(userID)
+	return c.rdb.Set(ctx, key, spend, billingRollingSpendCacheTTL).Err()
+}
+
+func (c *billingCache) GetAPIKeyUsageCache(ctx context.Context, apiKeyID int64) (*service.APIKeyLimitUsage, error) {
+	key := billingAPIKeyKey(apiKeyID)
+	result, err := c.rdb.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, redis.Nil
+	}
+	return c.parseAPIKeyUsageCache(result)
+}
+
+func (c *billingCache) parseAPIKeyUsageCache(data map[string]string) (*service.APIKeyLimitUsage, error) {
+	dayStart, err := strconv.ParseInt(data[keyFieldDayStart], 10, 64)
+	if err != nil {
+		return nil, errors.New("invalid cache: missing day_start")
+	}
+	monthStart, err := strconv.ParseInt(data[keyFieldMonthStart], 10, 64)
+	if err != nil {
+		return nil, errors.New("invalid cache: missing month_start")
+	}
+	result := &service.APIKeyLimitUsage{
+		DayStart:   time.Unix(dayStart, 0),
+		MonthStart: time.Unix(monthStart, 0),
+	}
+	result.DailyUsage, _ = strconv.ParseFloat(data[keyFieldDailyUsage], 64)
+	result.MonthlyUsage, _ = strconv.ParseFloat(data[keyFieldMonthlyUsage], 64)
+	result.TotalUsage, _ = strconv.ParseFloat(data[keyFieldTotalUsage], 64)
+	result.DailyRequests, _ = strconv.ParseInt(data[keyFieldDailyRequests], 10, 64)
+	result.MonthlyRequests, _ = strconv.ParseInt(data[keyFieldMonthlyRequests], 10, 64)
+	return result, nil
+}
+
+func (c *billingCache) SetAPIKeyUsageCache(ctx context.Context, apiKeyID int64, data *service.APIKeyLimitUsage) error {
+	if data == nil {
+		return nil
+	}
+
+	key := billingAPIKeyKey(apiKeyID)
+
+	fields := map[string]any{
+		keyFieldDayStart:        data.DayStart.Unix(),
+		keyFieldMonthStart:      data.MonthStart.Unix(),
+		keyFieldDailyUsage:      data.DailyUsage,
+		keyFieldMonthlyUsage:    data.MonthlyUsage,
+		keyFieldTotalUsage:      data.TotalUsage,
+		keyFieldDailyRequests:   data.DailyRequests,
+		keyFieldMonthlyRequests: data.MonthlyRequests,
+	}
+
+	pipe := c.rdb.Pipeline()
+	pipe.HSet(ctx, key, fields)
+	pipe.Expire(ctx, key, billingCacheTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (c *billingCache) UpdateAPIKeyUsage(ctx context.Context, apiKeyID int64, cost float64) error {
+	key := billingAPIKeyKey(apiKeyID)
+	_, err := updateAPIKeyUsageScript.Run(ctx, c.rdb, []string{key}, cost, int(billingCacheTTL.Seconds())).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		log.Printf("Warning: update api key usage cache failed for api key %d: %v", apiKeyID, err)
+	}
+	return nil
+}
+
+func (c *billingCache) ReserveBalance(ctx context.Context, userID int64, holdID string, amount, balance float64, ttl time.Duration) (bool, error) {
+	key := billingHoldKey(userID)
+	now := time.Now()
+	expiresAt := now.Add(ttl)
+	res, err := reserveBalanceScript.Run(ctx, c.rdb, []string{key},
+		holdID,
+		strconv.FormatFloat(balance, 'f', -1, 64),
+		strconv.FormatFloat(amount, 'f', -1, 64),
+		now.Unix(),
+		expiresAt.Unix(),
+		int(ttl.Seconds()),
+	).Int()
+	if err != nil {
+		return false, err
+	}
+	return res == 1, nil
+}
+
+func (c *billingCache) ReleaseBalanceHold(ctx context.Context, userID int64, holdID string) error {
+	return c.rdb.HDel(ctx, billingHoldKey(userID), holdID).Err()
+}
+
+func (c *billingCache) AddRollingWindowUsage(ctx context.Context, userID, groupID int64, bucketStart, cutoff time.Time, cost float64, window time.Duration) error {
+	key := billingRollingWindowKey(userID, groupID)
+	return addRollingWindowUsageScript.Run(ctx, c.rdb, []string{key},
+		bucketStart.Unix(),
+		strconv.FormatFloat(cost, 'f', -1, 64),
+		cutoff.Unix(),
+		int(window.Seconds()),
+	).Err()
+}
+
+func (c *billingCache) GetRollingWindowBuckets(ctx context.Context, userID, groupID int64) (map[int64]float64, error) {
+	result, err := c.rdb.HGetAll(ctx, billingRollingWindowKey(userID, groupID)).Result()
+	if err != nil {
+		return nil, err
+	}
+	buckets := make(map[int64]float64, len(result))
+	for field, value := range result {
+		start, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			continue
+		}
+		cost, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			continue
+		}
+		buckets[start] = cost
+	}
+	return buckets, nil
+}
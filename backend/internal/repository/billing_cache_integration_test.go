@@ -130,6 +130,98 @@ func (s *BillingCacheSuite) TestUserBalance() {
 	}
 }
 
+func (s *BillingCacheSuite) TestUserRollingSpend() {
+	rdb := testRedis(s.T())
+	cache := NewBillingCache(rdb)
+	ctx := context.Background()
+	userID := int64(301)
+
+	_, err := cache.GetUserRollingSpend(ctx, userID)
+	require.ErrorIs(s.T(), err, redis.Nil, "expected redis.Nil for missing rolling spend")
+
+	require.NoError(s.T(), cache.SetUserRollingSpend(ctx, userID, 123.45), "SetUserRollingSpend")
+	spend, err := cache.GetUserRollingSpend(ctx, userID)
+	require.NoError(s.T(), err, "GetUserRollingSpend")
+	require.Equal(s.T(), 123.45, spend)
+
+	ttl, err := rdb.TTL(ctx, billingRollingSpendKey(userID)).Result()
+	require.NoError(s.T(), err, "TTL")
+	s.AssertTTLWithin(ttl, 1*time.Second, billingRollingSpendCacheTTL)
+}
+
+func (s *BillingCacheSuite) TestBalanceHolds() {
+	rdb := testRedis(s.T())
+	cache := NewBillingCache(rdb)
+	ctx := context.Background()
+	userID := int64(302)
+	key := billingHoldKey(userID)
+
+	// 无在途预留时余额为正即放行，即使预估费用超过余额
+	ok, err := cache.ReserveBalance(ctx, userID, "h1", 2, 1, time.Minute)
+	require.NoError(s.T(), err, "ReserveBalance h1")
+	require.True(s.T(), ok, "first hold should be accepted")
+
+	// 余额 - 已预留 不足时拒绝
+	ok, err = cache.ReserveBalance(ctx, userID, "h2", 0.5, 1, time.Minute)
+	require.NoError(s.T(), err, "ReserveBalance h2")
+	require.False(s.T(), ok, "hold exceeding available balance should be rejected")
+
+	require.NoError(s.T(), cache.ReleaseBalanceHold(ctx, userID, "h1"), "ReleaseBalanceHold")
+	ok, err = cache.ReserveBalance(ctx, userID, "h2", 0.5, 1, time.Minute)
+	require.NoError(s.T(), err, "ReserveBalance h2 after release")
+	require.True(s.T(), ok)
+	ok, err = cache.ReserveBalance(ctx, userID, "h3", 0.5, 1, time.Minute)
+	require.NoError(s.T(), err, "ReserveBalance h3")
+	require.True(s.T(), ok, "hold fitting remaining balance should be accepted")
+
+	ttl, err := rdb.TTL(ctx, key).Result()
+	require.NoError(s.T(), err, "TTL")
+	s.AssertTTLWithin(ttl, 1*time.Second, time.Minute)
+
+	// 余额非正时拒绝
+	ok, err = cache.ReserveBalance(ctx, userID+1, "h4", 0.1, 0, time.Minute)
+	require.NoError(s.T(), err, "ReserveBalance non-positive balance")
+	require.False(s.T(), ok)
+
+	// 过期预留在下次预留时被清理
+	require.NoError(s.T(), rdb.HSet(ctx, key, "stale", fmt.Sprintf("100:%d", time.Now().Add(-time.Second).Unix())).Err(), "HSet stale")
+	require.NoError(s.T(), cache.ReleaseBalanceHold(ctx, userID, "h3"), "ReleaseBalanceHold h3")
+	ok, err = cache.ReserveBalance(ctx, userID, "h5", 0.5, 1, time.Minute)
+	require.NoError(s.T(), err, "ReserveBalance h5")
+	require.True(s.T(), ok, "expired holds should not count")
+	exists, err := rdb.HExists(ctx, key, "stale").Result()
+	require.NoError(s.T(), err, "HExists")
+	require.False(s.T(), exists, "expired hold should be removed")
+}
+
+func (s *BillingCacheSuite) TestRollingWindowUsage() {
+	rdb := testRedis(s.T())
+	cache := NewBillingCache(rdb)
+	ctx := context.Background()
+	userID, groupID := int64(303), int64(7)
+	window := 5 * time.Hour
+	now := time.Now().Truncate(time.Minute)
+
+	buckets, err := cache.GetRollingWindowBuckets(ctx, userID, groupID)
+	require.NoError(s.T(), err, "GetRollingWindowBuckets empty")
+	require.Empty(s.T(), buckets)
+
+	old := now.Add(-6 * time.Hour)
+	require.NoError(s.T(), cache.AddRollingWindowUsage(ctx, userID, groupID, old, old.Add(-window), 4, window), "AddRollingWindowUsage old")
+	require.NoError(s.T(), cache.AddRollingWindowUsage(ctx, userID, groupID, now, now.Add(-window), 1.5, window), "AddRollingWindowUsage 1")
+	require.NoError(s.T(), cache.AddRollingWindowUsage(ctx, userID, groupID, now, now.Add(-window), 2, window), "AddRollingWindowUsage 2")
+
+	// 同一桶累加，早于窗口的桶被清理
+	buckets, err = cache.GetRollingWindowBuckets(ctx, userID, groupID)
+	require.NoError(s.T(), err, "GetRollingWindowBuckets")
+	require.Len(s.T(), buckets, 1)
+	require.InDelta(s.T(), 3.5, buckets[now.Unix()], 1e-9)
+
+	ttl, err := rdb.TTL(ctx, billingRollingWindowKey(userID, groupID)).Result()
+	require.NoError(s.T(), err, "TTL")
+	s.AssertTTLWithin(ttl, 1*time.Second, window)
+}
+
 func (s *BillingCacheSuite) TestSubscriptionCache() {
 	tests := []struct {
 		name string
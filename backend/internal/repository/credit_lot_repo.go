@@ -0,0 +1,279 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type creditLotRepository struct {
+	client *dbent.Client
+	sql    sqlExecutor
+}
+
+func NewCreditLotRepository(client *dbent.Client, sqlDB *sql.DB) service.CreditLotRepository {
+	return &creditLotRepository{client: client, sql: sqlDB}
+}
+
+func (r *creditLotRepository) ListOpenByUser(ctx context.Context, userID int64) ([]service.CreditLot, error) {
+	rows, err := r.sql.QueryContext(ctx, `
+		SELECT id, user_id, source, amount, remaining, expires_at, expired_at, balance_transaction_id, created_at, updated_at
+		FROM credit_lots
+		WHERE user_id = $1 AND remaining > 0 AND expired_at IS NULL
+		ORDER BY expires_at ASC NULLS LAST, id ASC
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := make([]service.CreditLot, 0)
+	for rows.Next() {
+		var (
+			lot       service.CreditLot
+			expiresAt sql.NullTime
+			expiredAt sql.NullTime
+			txID      sql.NullInt64
+		)
+		if err := rows.Scan(
+			&lot.ID,
+			&lot.UserID,
+			&lot.Source,
+			&lot.Amount,
+			&lot.Remaining,
+			&expiresAt,
+			&expiredAt,
+			&txID,
+			&lot.CreatedAt,
+			&lot.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		if expiresAt.Valid {
+			t := expiresAt.Time
+			lot.ExpiresAt = &t
+		}
+		if expiredAt.Valid {
+			t := expiredAt.Time
+			lot.ExpiredAt = &t
+		}
+		lot.BalanceTransactionID = nullInt64Ptr(txID)
+		out = append(out, lot)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *creditLotRepository) ListDueIDs(ctx context.Context, now time.Time, limit int) ([]int64, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+	rows, err := r.sql.QueryContext(ctx, `
+		SELECT id FROM credit_lots
+		WHERE expires_at IS NOT NULL AND expires_at <= $1 AND expired_at IS NULL AND remaining > 0
+		ORDER BY expires_at ASC, id ASC
+		LIMIT $2
+	`, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	ids := make([]int64, 0)
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// Expire 清零到期批次剩余额度：先锁用户行再锁批次行（与余额变动的加锁顺序一致，避免死锁）
+func (r *creditLotRepository) Expire(ctx context.Context, lotID int64) (*service.BalanceTransaction, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil && !errors.Is(err, dbent.ErrTxStarted) {
+		return nil, err
+	}
+	exec := r.client
+	if err == nil {
+		defer func() { _ = tx.Rollback() }()
+		exec = tx.Client()
+	}
+	// err 为 dbent.ErrTxStarted 时，复用当前 client 参与同一事务。
+
+	var userID int64
+	if err := scanSingleRow(ctx, exec, `SELECT user_id FROM credit_lots WHERE id = $1`, []any{lotID}, &userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var userDeleted bool
+	if err := scanSingleRow(ctx, exec, `SELECT deleted_at IS NOT NULL FROM users WHERE id = $1 FOR UPDATE`, []any{userID}, &userDeleted); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var (
+		remaining float64
+		expiresAt sql.NullTime
+		expiredAt sql.NullTime
+	)
+	if err := scanSingleRow(ctx, exec, `SELECT remaining, expires_at, expired_at FROM credit_lots WHERE id = $1 FOR UPDATE`, []any{lotID}, &remaining, &expiresAt, &expiredAt); err != nil {
+		return nil, err
+	}
+	if expiredAt.Valid || !expiresAt.Valid || expiresAt.Time.After(time.Now()) {
+		return nil, nil
+	}
+
+	var ledgerTx *service.BalanceTransaction
+	if remaining > 0 && !userDeleted {
+		ledgerTx, err = applyBalanceChangeWithExec(ctx, exec, &service.BalanceChange{
+			UserID:      userID,
+			Type:        service.BalanceTxTypeExpiry,
+			Amount:      -remaining,
+			CreditLotID: &lotID,
+			Notes:       fmt.Sprintf("credit lot #%d expired", lotID),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := exec.ExecContext(ctx, `
+		UPDATE credit_lots SET remaining = 0, expired_at = NOW(), updated_at = NOW() WHERE id = $1
+	`, lotID); err != nil {
+		return nil, err
+	}
+
+	if tx != nil {
+		if err := tx.Commit(); err != nil {
+			return nil, err
+		}
+	}
+	return ledgerTx, nil
+}
+
+// applyBalanceChangeWithExec 在调用方事务内变更余额、写入流水并维护额度批次：
+// 入账形成新批次（透支时先抵扣欠款），扣减按到期时间从早到晚消耗批次。
+// UPDATE users 先执行并持有用户行锁，保证同一用户的批次变更串行化。
+func applyBalanceChangeWithExec(ctx context.Context, exec sqlExecutor, change *service.BalanceChange) (*service.BalanceTransaction, error) {
+	var balanceAfter float64
+	if err := scanSingleRow(ctx, exec, `
+		UPDATE users
+		SET balance = balance + $2, updated_at = NOW()
+		WHERE id = $1 AND deleted_at IS NULL AND (NOT $3::boolean OR balance + $2 >= 0)
+		RETURNING balance
+	`, []any{change.UserID, change.Amount, change.RequireSufficientBalance}, &balanceAfter); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		if change.RequireSufficientBalance {
+			var exists bool
+			if err := scanSingleRow(ctx, exec, `SELECT EXISTS(SELECT 1 FROM users WHERE id = $1 AND deleted_at IS NULL)`, []any{change.UserID}, &exists); err != nil {
+				return nil, err
+			}
+			if exists {
+				return nil, service.ErrInsufficientBalance
+			}
+		}
+		return nil, service.ErrUserNotFound
+	}
+
+	notes := change.Notes
+	tx := &service.BalanceTransaction{
+		UserID:         change.UserID,
+		Type:           change.Type,
+		Amount:         change.Amount,
+		BalanceAfter:   balanceAfter,
+		UsageLogID:     change.UsageLogID,
+		RedeemCodeID:   change.RedeemCodeID,
+		PromoCodeID:    change.PromoCodeID,
+		PaymentOrderID: change.PaymentOrderID,
+		CreditLotID:    change.CreditLotID,
+		Notes:          change.Notes,
+	}
+	if err := scanSingleRow(ctx, exec, `
+		INSERT INTO balance_transactions
+			(user_id, type, amount, balance_after, usage_log_id, redeem_code_id, promo_code_id, payment_order_id, credit_lot_id, notes, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NOW())
+		RETURNING id, created_at
+	`, []any{
+		change.UserID,
+		change.Type,
+		change.Amount,
+		balanceAfter,
+		nullInt64(change.UsageLogID),
+		nullInt64(change.RedeemCodeID),
+		nullInt64(change.PromoCodeID),
+		nullInt64(change.PaymentOrderID),
+		nullInt64(change.CreditLotID),
+		nullString(&notes),
+	}, &tx.ID, &tx.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case change.Amount > 0:
+		remaining := math.Min(change.Amount, math.Max(balanceAfter, 0))
+		if remaining > 0 {
+			if err := insertCreditLot(ctx, exec, change.UserID, change.Type, change.Amount, remaining, change.ExpiresAt, &tx.ID); err != nil {
+				return nil, err
+			}
+		}
+	case change.Amount < 0 && change.CreditLotID != nil:
+		if _, err := exec.ExecContext(ctx, `
+			UPDATE credit_lots SET remaining = GREATEST(remaining - $2::numeric, 0), updated_at = NOW()
+			WHERE id = $1 AND user_id = $3
+		`, *change.CreditLotID, -change.Amount, change.UserID); err != nil {
+			return nil, err
+		}
+	case change.Amount < 0:
+		if err := consumeCreditLots(ctx, exec, change.UserID, -change.Amount); err != nil {
+			return nil, err
+		}
+	}
+	return tx, nil
+}
+
+func insertCreditLot(ctx context.Context, exec sqlExecutor, userID int64, source string, amount, remaining float64, expiresAt *time.Time, balanceTxID *int64) error {
+	_, err := exec.ExecContext(ctx, `
+		INSERT INTO credit_lots (user_id, source, amount, remaining, expires_at, balance_transaction_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+	`, userID, source, amount, remaining, expiresAt, nullInt64(balanceTxID))
+	return err
+}
+
+// consumeCreditLots 按到期时间从早到晚（永不过期最后）扣减批次剩余额度；超出批次合计的部分为透支，不落在批次上
+func consumeCreditLots(ctx context.Context, exec sqlExecutor, userID int64, amount float64) error {
+	_, err := exec.ExecContext(ctx, `
+		WITH candidates AS (
+			SELECT id, remaining,
+				SUM(remaining) OVER (ORDER BY expires_at ASC NULLS LAST, id ASC) AS running
+			FROM credit_lots
+			WHERE user_id = $1 AND remaining > 0 AND expired_at IS NULL
+		)
+		UPDATE credit_lots l
+		SET remaining = l.remaining - LEAST(c.remaining, $2::numeric - (c.running - c.remaining)),
+			updated_at = NOW()
+		FROM candidates c
+		WHERE l.id = c.id AND c.running - c.remaining < $2::numeric
+	`, userID, amount)
+	return err
+}
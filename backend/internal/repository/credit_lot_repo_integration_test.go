@@ -0,0 +1,85 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreditLots_ConsumeAndExpire(t *testing.T) {
+	ctx := context.Background()
+	client := testEntClient(t)
+	userRepo := newUserRepositoryWithSQL(client, integrationDB)
+	lotRepo := NewCreditLotRepository(client, integrationDB)
+
+	user := &service.User{
+		Email:        fmt.Sprintf("credit-lots-%d@example.com", time.Now().UnixNano()),
+		PasswordHash: "test-password-hash",
+		Role:         service.RoleUser,
+		Status:       service.StatusActive,
+		Concurrency:  5,
+		Balance:      10,
+	}
+	require.NoError(t, userRepo.Create(ctx, user))
+	t.Cleanup(func() {
+		_, _ = integrationDB.ExecContext(ctx, "DELETE FROM credit_lots WHERE user_id = $1", user.ID)
+		_, _ = integrationDB.ExecContext(ctx, "DELETE FROM balance_transactions WHERE user_id = $1", user.ID)
+		_, _ = integrationDB.ExecContext(ctx, "DELETE FROM users WHERE id = $1", user.ID)
+	})
+
+	now := time.Now()
+	later := now.Add(time.Hour)
+	past := now.Add(-time.Minute)
+	_, err := userRepo.ApplyBalanceChange(ctx, &service.BalanceChange{UserID: user.ID, Type: service.BalanceTxTypeRedeem, Amount: 5, ExpiresAt: &later})
+	require.NoError(t, err)
+	_, err = userRepo.ApplyBalanceChange(ctx, &service.BalanceChange{UserID: user.ID, Type: service.BalanceTxTypePromo, Amount: 3, ExpiresAt: &past})
+	require.NoError(t, err)
+
+	// 扣减优先消耗最早到期的批次
+	require.NoError(t, userRepo.DeductBalance(ctx, user.ID, 2))
+	lots, err := lotRepo.ListOpenByUser(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, lots, 3)
+	require.Equal(t, service.BalanceTxTypePromo, lots[0].Source)
+	require.InDelta(t, 1, lots[0].Remaining, 1e-6)
+	require.InDelta(t, 5, lots[1].Remaining, 1e-6)
+	require.Equal(t, service.BalanceTxTypeOpening, lots[2].Source)
+	require.Nil(t, lots[2].ExpiresAt)
+
+	dueIDs, err := lotRepo.ListDueIDs(ctx, time.Now(), 100)
+	require.NoError(t, err)
+	require.Contains(t, dueIDs, lots[0].ID)
+
+	ledgerTx, err := lotRepo.Expire(ctx, lots[0].ID)
+	require.NoError(t, err)
+	require.NotNil(t, ledgerTx)
+	require.Equal(t, service.BalanceTxTypeExpiry, ledgerTx.Type)
+	require.InDelta(t, -1, ledgerTx.Amount, 1e-6)
+	require.InDelta(t, 15, ledgerTx.BalanceAfter, 1e-6)
+	require.Equal(t, lots[0].ID, *ledgerTx.CreditLotID)
+
+	// 已处理的批次不再重复过期
+	ledgerTx, err = lotRepo.Expire(ctx, lots[0].ID)
+	require.NoError(t, err)
+	require.Nil(t, ledgerTx)
+
+	// 透支后批次耗尽；后续入账先抵扣欠款
+	require.NoError(t, userRepo.DeductBalance(ctx, user.ID, 20))
+	lots, err = lotRepo.ListOpenByUser(ctx, user.ID)
+	require.NoError(t, err)
+	require.Empty(t, lots)
+
+	_, err = userRepo.ApplyBalanceChange(ctx, &service.BalanceChange{UserID: user.ID, Type: service.BalanceTxTypeAdmin, Amount: 8})
+	require.NoError(t, err)
+	lots, err = lotRepo.ListOpenByUser(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, lots, 1)
+	require.InDelta(t, 8, lots[0].Amount, 1e-6)
+	require.InDelta(t, 3, lots[0].Remaining, 1e-6)
+}
@@ -74,6 +74,12 @@ func (r *dashboardAggregationRepository) AggregateRange(ctx context.Context, sta
 	if err := r.upsertDailyAggregates(ctx, dayStart, dayEnd); err != nil {
 		return err
 	}
+	if err := r.upsertHourlyProfitAggregates(ctx, hourStart, hourEnd); err != nil {
+		return err
+	}
+	if err := r.upsertDailyProfitAggregates(ctx, dayStart, dayEnd); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -130,6 +136,12 @@ func (r *dashboardAggregationRepository) recomputeRangeInTx(ctx context.Context,
 	if _, err := r.sql.ExecContext(ctx, "DELETE FROM usage_dashboard_daily_users WHERE bucket_date >= $1::date AND bucket_date < $2::date", dayStart, dayEnd); err != nil {
 		return err
 	}
+	if _, err := r.sql.ExecContext(ctx, "DELETE FROM usage_dashboard_profit_hourly WHERE bucket_start >= $1 AND bucket_start < $2", hourStart, hourEnd); err != nil {
+		return err
+	}
+	if _, err := r.sql.ExecContext(ctx, "DELETE FROM usage_dashboard_profit_daily WHERE bucket_date >= $1::date AND bucket_date < $2::date", dayStart, dayEnd); err != nil {
+		return err
+	}
 
 	if err := r.insertHourlyActiveUsers(ctx, hourStart, hourEnd); err != nil {
 		return err
@@ -143,6 +155,12 @@ func (r *dashboardAggregationRepository) recomputeRangeInTx(ctx context.Context,
 	if err := r.upsertDailyAggregates(ctx, dayStart, dayEnd); err != nil {
 		return err
 	}
+	if err := r.upsertHourlyProfitAggregates(ctx, hourStart, hourEnd); err != nil {
+		return err
+	}
+	if err := r.upsertDailyProfitAggregates(ctx, dayStart, dayEnd); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -184,6 +202,12 @@ func (r *dashboardAggregationRepository) CleanupAggregates(ctx context.Context,
 	if _, err := r.sql.ExecContext(ctx, "DELETE FROM usage_dashboard_daily_users WHERE bucket_date < $1::date", dailyCutoffUTC); err != nil {
 		return err
 	}
+	if _, err := r.sql.ExecContext(ctx, "DELETE FROM usage_dashboard_profit_hourly WHERE bucket_start < $1", hourlyCutoffUTC); err != nil {
+		return err
+	}
+	if _, err := r.sql.ExecContext(ctx, "DELETE FROM usage_dashboard_profit_daily WHERE bucket_date < $1::date", dailyCutoffUTC); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -382,6 +406,85 @@ func (r *dashboardAggregationRepository) upsertDailyAggregates(ctx context.Conte
 	return err
 }
 
+// upsertHourlyProfitAggregates 按 账号/分组/模型 聚合小时级成本与收入（利润分析使用）。
+func (r *dashboardAggregationRepository) upsertHourlyProfitAggregates(ctx context.Context, start, end time.Time) error {
+	tzName := timezone.Name()
+	query := `
+		INSERT INTO usage_dashboard_profit_hourly (
+			bucket_start,
+			account_id,
+			group_id,
+			model,
+			total_requests,
+			total_cost,
+			account_cost,
+			actual_cost,
+			computed_at
+		)
+		SELECT
+			date_trunc('hour', created_at AT TIME ZONE $3) AT TIME ZONE $3 AS bucket_start,
+			account_id,
+			COALESCE(group_id, 0) AS group_id,
+			model,
+			COUNT(*) AS total_requests,
+			COALESCE(SUM(total_cost), 0) AS total_cost,
+			COALESCE(SUM(total_cost * COALESCE(account_rate_multiplier, 1)), 0) AS account_cost,
+			COALESCE(SUM(actual_cost), 0) AS actual_cost,
+			NOW()
+		FROM usage_logs
+		WHERE created_at >= $1 AND created_at < $2
+		GROUP BY 1, 2, 3, 4
+		ON CONFLICT (bucket_start, account_id, group_id, model)
+		DO UPDATE SET
+			total_requests = EXCLUDED.total_requests,
+			total_cost = EXCLUDED.total_cost,
+			account_cost = EXCLUDED.account_cost,
+			actual_cost = EXCLUDED.actual_cost,
+			computed_at = EXCLUDED.computed_at
+	`
+	_, err := r.sql.ExecContext(ctx, query, start, end, tzName)
+	return err
+}
+
+func (r *dashboardAggregationRepository) upsertDailyProfitAggregates(ctx context.Context, start, end time.Time) error {
+	tzName := timezone.Name()
+	query := `
+		INSERT INTO usage_dashboard_profit_daily (
+			bucket_date,
+			account_id,
+			group_id,
+			model,
+			total_requests,
+			total_cost,
+			account_cost,
+			actual_cost,
+			computed_at
+		)
+		SELECT
+			(bucket_start AT TIME ZONE $3)::date AS bucket_date,
+			account_id,
+			group_id,
+			model,
+			COALESCE(SUM(total_requests), 0) AS total_requests,
+			COALESCE(SUM(total_cost), 0) AS total_cost,
+			COALESCE(SUM(account_cost), 0) AS account_cost,
+			COALESCE(SUM(actual_cost), 0) AS actual_cost,
+			NOW()
+		FROM usage_dashboard_profit_hourly
+		WHERE bucket_start >= $1 AND bucket_start < $2
+		GROUP BY 1, 2, 3, 4
+		ON CONFLICT (bucket_date, account_id, group_id, model)
+		DO UPDATE SET
+			total_requests = EXCLUDED.total_requests,
+			total_cost = EXCLUDED.total_cost,
+			account_cost = EXCLUDED.account_cost,
+			actual_cost = EXCLUDED.actual_cost,
+			computed_at = EXCLUDED.computed_at
+	`
+	_, err := r.sql.ExecContext(ctx, query, start, end, tzName)
+	return err
+}
+
 func (r *dashboardAggregationRepository) isUsageLogsPartitioned(ctx context.Context) (bool, error) {
 	query := `
 		SELECT EXISTS(
@@ -30,6 +30,15 @@ func clientFromContext(ctx context.Context, defaultClient *dbent.Client) *dbent.
 	return defaultClient
 }
 
+// sqlExecutorFromContext 从 context 中获取事务连接，如果不存在则返回默认执行器。
+// 供使用原生 SQL 的 repository 与 ent 事务（如 withTx）共享同一连接。
+func sqlExecutorFromContext(ctx context.Context, defaultExec sqlExecutor) sqlExecutor {
+	if tx := dbent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+	return defaultExec
+}
+
 // translatePersistenceError 将数据库层错误翻译为业务层错误。
 //
 // 这是 Repository 层的核心错误处理函数，确保数据库细节不会泄露到业务层。
@@ -49,13 +49,36 @@ func (r *groupRepository) Create(ctx context.Context, groupIn *service.Group) er
 		SetNillableImagePrice4k(groupIn.ImagePrice4K).
 		SetDefaultValidityDays(groupIn.DefaultValidityDays).
 		SetClaudeCodeOnly(groupIn.ClaudeCodeOnly).
+		SetOverageEnabled(groupIn.OverageEnabled).
+		SetRollingWindowHours(groupIn.RollingWindowHours).
+		SetNillableRollingWindowLimitUsd(groupIn.RollingWindowLimitUSD).
 		SetNillableFallbackGroupID(groupIn.FallbackGroupID).
-		SetModelRoutingEnabled(groupIn.ModelRoutingEnabled)
+		SetModelRoutingEnabled(groupIn.ModelRoutingEnabled).
+		SetPoolSplitEnabled(groupIn.PoolSplitEnabled).
+		SetNillableCanaryMaxErrorRate(groupIn.CanaryMaxErrorRate)
 
 	// 设置模型路由配置
 	if groupIn.ModelRouting != nil {
 		builder = builder.SetModelRouting(groupIn.ModelRouting)
 	}
+	if groupIn.PoolWeights != nil {
+		builder = builder.SetPoolWeights(groupIn.PoolWeights)
+	}
+	if groupIn.CapacityFallbackGroupIDs != nil {
+		builder = builder.SetCapacityFallbackGroupIds(groupIn.CapacityFallbackGroupIDs)
+	}
+	if groupIn.FallbackModelMapping != nil {
+		builder = builder.SetFallbackModelMapping(groupIn.FallbackModelMapping)
+	}
+	if groupIn.VolumeTiers != nil {
+		builder = builder.SetVolumeTiers(groupIn.VolumeTiers)
+	}
+	if groupIn.UsageQuotas != nil {
+		builder = builder.SetUsageQuotas(groupIn.UsageQuotas)
+	}
+	if groupIn.OverageRateMultiplier > 0 {
+		builder = builder.SetOverageRateMultiplier(groupIn.OverageRateMultiplier)
+	}
 
 	created, err := builder.Save(ctx)
 	if err == nil {
@@ -108,7 +131,11 @@ func (r *groupRepository) Update(ctx context.Context, groupIn *service.Group) er
 		SetNillableImagePrice4k(groupIn.ImagePrice4K).
 		SetDefaultValidityDays(groupIn.DefaultValidityDays).
 		SetClaudeCodeOnly(groupIn.ClaudeCodeOnly).
-		SetModelRoutingEnabled(groupIn.ModelRoutingEnabled)
+		SetOverageEnabled(groupIn.OverageEnabled).
+		SetOverageRateMultiplier(groupIn.OverageRateMultiplier).
+		SetRollingWindowHours(groupIn.RollingWindowHours).
+		SetModelRoutingEnabled(groupIn.ModelRoutingEnabled).
+		SetPoolSplitEnabled(groupIn.PoolSplitEnabled)
 
 	// 处理 FallbackGroupID：nil 时清除，否则设置
 	if groupIn.FallbackGroupID != nil {
@@ -117,6 +144,13 @@ func (r *groupRepository) Update(ctx context.Context, groupIn *service.Group) er
 		builder = builder.ClearFallbackGroupID()
 	}
 
+	// 处理滚动窗口限额：nil 时清除，否则设置
+	if groupIn.RollingWindowLimitUSD != nil {
+		builder = builder.SetRollingWindowLimitUsd(*groupIn.RollingWindowLimitUSD)
+	} else {
+		builder = builder.ClearRollingWindowLimitUsd()
+	}
+
 	// 处理 ModelRouting：nil 时清除，否则设置
 	if groupIn.ModelRouting != nil {
 		builder = builder.SetModelRouting(groupIn.ModelRouting)
@@ -124,6 +158,40 @@ func (r *groupRepository) Update(ctx context.Context, groupIn *service.Group) er
 		builder = builder.ClearModelRouting()
 	}
 
+	// 处理子池分流配置：nil 时清除，否则设置
+	if groupIn.PoolWeights != nil {
+		builder = builder.SetPoolWeights(groupIn.PoolWeights)
+	} else {
+		builder = builder.ClearPoolWeights()
+	}
+	if groupIn.CanaryMaxErrorRate != nil {
+		builder = builder.SetCanaryMaxErrorRate(*groupIn.CanaryMaxErrorRate)
+	} else {
+		builder = builder.ClearCanaryMaxErrorRate()
+	}
+
+	// 处理容量降级链：nil 时清除，否则设置
+	if groupIn.CapacityFallbackGroupIDs != nil {
+		builder = builder.SetCapacityFallbackGroupIds(groupIn.CapacityFallbackGroupIDs)
+	} else {
+		builder = builder.ClearCapacityFallbackGroupIds()
+	}
+	if groupIn.FallbackModelMapping != nil {
+		builder = builder.SetFallbackModelMapping(groupIn.FallbackModelMapping)
+	} else {
+		builder = builder.ClearFallbackModelMapping()
+	}
+	if groupIn.VolumeTiers != nil {
+		builder = builder.SetVolumeTiers(groupIn.VolumeTiers)
+	} else {
+		builder = builder.ClearVolumeTiers()
+	}
+	if groupIn.UsageQuotas != nil {
+		builder = builder.SetUsageQuotas(groupIn.UsageQuotas)
+	} else {
+		builder = builder.ClearUsageQuotas()
+	}
+
 	updated, err := builder.Save(ctx)
 	if err != nil {
 		return translatePersistenceError(err, service.ErrGroupNotFound, service.ErrGroupExists)
@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+const modelPriceOverrideColumns = `id, model, group_id, input_price, output_price, cache_write_5m_price, cache_write_1h_price,
+	cache_read_price, image_price, effective_from, notes, created_at, updated_at`
+
+type modelPriceOverrideRepository struct {
+	sql sqlExecutor
+}
+
+func NewModelPriceOverrideRepository(sqlDB *sql.DB) service.ModelPriceOverrideRepository {
+	return &modelPriceOverrideRepository{sql: sqlDB}
+}
+
+func modelPriceOverrideArgs(o *service.ModelPriceOverride) []any {
+	return []any{
+		o.Model,
+		nullInt64(o.GroupID),
+		nullFloat64(o.InputPrice),
+		nullFloat64(o.OutputPrice),
+		nullFloat64(o.CacheWrite5mPrice),
+		nullFloat64(o.CacheWrite1hPrice),
+		nullFloat64(o.CacheReadPrice),
+		nullFloat64(o.ImagePrice),
+		o.EffectiveFrom,
+		o.Notes,
+	}
+}
+
+func (r *modelPriceOverrideRepository) Create(ctx context.Context, override *service.ModelPriceOverride) error {
+	query := `
+		INSERT INTO model_price_overrides (model, group_id, input_price, output_price, cache_write_5m_price,
+			cache_write_1h_price, cache_read_price, image_price, effective_from, notes)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id, created_at, updated_at
+	`
+	err := scanSingleRow(ctx, r.sql, query, modelPriceOverrideArgs(override), &override.ID, &override.CreatedAt, &override.UpdatedAt)
+	return translatePersistenceError(err, nil, service.ErrPriceOverrideExists)
+}
+
+func (r *modelPriceOverrideRepository) Update(ctx context.Context, override *service.ModelPriceOverride) error {
+	query := `
+		UPDATE model_price_overrides
+		SET model = $1, group_id = $2, input_price = $3, output_price = $4, cache_write_5m_price = $5,
+			cache_write_1h_price = $6, cache_read_price = $7, image_price = $8, effective_from = $9, notes = $10,
+			updated_at = NOW()
+		WHERE id = $11
+		RETURNING updated_at
+	`
+	args := append(modelPriceOverrideArgs(override), override.ID)
+	err := scanSingleRow(ctx, r.sql, query, args, &override.UpdatedAt)
+	return translatePersistenceError(err, service.ErrPriceOverrideNotFound, service.ErrPriceOverrideExists)
+}
+
+func (r *modelPriceOverrideRepository) Delete(ctx context.Context, id int64) error {
+	res, err := r.sql.ExecContext(ctx, "DELETE FROM model_price_overrides WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return service.ErrPriceOverrideNotFound
+	}
+	return nil
+}
+
+func (r *modelPriceOverrideRepository) GetByID(ctx context.Context, id int64) (*service.ModelPriceOverride, error) {
+	items, err := r.query(ctx, "SELECT "+modelPriceOverrideColumns+" FROM model_price_overrides WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, service.ErrPriceOverrideNotFound
+	}
+	return &items[0], nil
+}
+
+func (r *modelPriceOverrideRepository) List(ctx context.Context, params pagination.PaginationParams, filters service.ModelPriceOverrideFilters) ([]service.ModelPriceOverride, *pagination.PaginationResult, error) {
+	conditions := []string{"1 = 1"}
+	args := []any{}
+	if filters.Model != "" {
+		args = append(args, "%"+strings.ToLower(filters.Model)+"%")
+		conditions = append(conditions, fmt.Sprintf("model LIKE $%d", len(args)))
+	}
+	if filters.GroupID != nil {
+		if *filters.GroupID == 0 {
+			conditions = append(conditions, "group_id IS NULL")
+		} else {
+			args = append(args, *filters.GroupID)
+			conditions = append(conditions, fmt.Sprintf("group_id = $%d", len(args)))
+		}
+	}
+	where := strings.Join(conditions, " AND ")
+
+	var total int64
+	if err := scanSingleRow(ctx, r.sql, "SELECT COUNT(*) FROM model_price_overrides WHERE "+where, args, &total); err != nil {
+		return nil, nil, err
+	}
+	if total == 0 {
+		return []service.ModelPriceOverride{}, paginationResultFromTotal(0, params), nil
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM model_price_overrides
+		WHERE %s
+		ORDER BY model, group_id NULLS FIRST, effective_from DESC
+		LIMIT $%d OFFSET $%d
+	`, modelPriceOverrideColumns, where, len(args)+1, len(args)+2)
+	items, err := r.query(ctx, query, append(args, params.Limit(), params.Offset())...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return items, paginationResultFromTotal(total, params), nil
+}
+
+func (r *modelPriceOverrideRepository) ListAll(ctx context.Context) ([]service.ModelPriceOverride, error) {
+	return r.query(ctx, "SELECT "+modelPriceOverrideColumns+" FROM model_price_overrides ORDER BY id")
+}
+
+func (r *modelPriceOverrideRepository) query(ctx context.Context, query string, args ...any) ([]service.ModelPriceOverride, error) {
+	rows, err := r.sql.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := make([]service.ModelPriceOverride, 0)
+	for rows.Next() {
+		var (
+			o                                     service.ModelPriceOverride
+			groupID                               sql.NullInt64
+			input, output, write5m, write1h, read sql.NullFloat64
+			image                                 sql.NullFloat64
+		)
+		if err := rows.Scan(
+			&o.ID,
+			&o.Model,
+			&groupID,
+			&input,
+			&output,
+			&write5m,
+			&write1h,
+			&read,
+			&image,
+			&o.EffectiveFrom,
+			&o.Notes,
+			&o.CreatedAt,
+			&o.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		o.GroupID = nullInt64Ptr(groupID)
+		o.InputPrice = nullFloat64Ptr(input)
+		o.OutputPrice = nullFloat64Ptr(output)
+		o.CacheWrite5mPrice = nullFloat64Ptr(write5m)
+		o.CacheWrite1hPrice = nullFloat64Ptr(write1h)
+		o.CacheReadPrice = nullFloat64Ptr(read)
+		o.ImagePrice = nullFloat64Ptr(image)
+		out = append(out, o)
+	}
+	return out, rows.Err()
+}
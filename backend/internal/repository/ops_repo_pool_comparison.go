@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+func (r *opsRepository) GetPoolComparison(ctx context.Context, filter *service.OpsDashboardFilter, bucketSeconds int) (*service.OpsPoolComparisonResponse, error) {
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("nil ops repository")
+	}
+	if filter == nil || filter.GroupID == nil {
+		return nil, fmt.Errorf("group_id required")
+	}
+	if filter.StartTime.IsZero() || filter.EndTime.IsZero() {
+		return nil, fmt.Errorf("start_time/end_time required")
+	}
+
+	if bucketSeconds <= 0 {
+		bucketSeconds = 60
+	}
+	if bucketSeconds != 60 && bucketSeconds != 300 && bucketSeconds != 3600 {
+		bucketSeconds = 60
+	}
+
+	groupID := *filter.GroupID
+	start := filter.StartTime.UTC()
+	end := filter.EndTime.UTC()
+
+	// 账号所属子池按 account_groups 当前标签归属；已解绑的账号计入默认池。
+	usageQ := `
+SELECT
+  COALESCE(ag.pool, '` + service.AccountPoolDefault + `') AS pool,
+  ` + opsPoolBucketExpr(bucketSeconds, "ul.created_at") + ` AS bucket,
+  COUNT(*) AS success_count,
+  COALESCE(SUM(ul.duration_ms), 0) AS duration_sum,
+  COUNT(ul.duration_ms) AS duration_count
+FROM usage_logs ul
+LEFT JOIN account_groups ag ON ag.account_id = ul.account_id AND ag.group_id = ul.group_id
+WHERE ul.group_id = $1 AND ul.created_at >= $2 AND ul.created_at < $3
+GROUP BY 1, 2`
+
+	errorQ := `
+SELECT
+  COALESCE(ag.pool, '` + service.AccountPoolDefault + `') AS pool,
+  ` + opsPoolBucketExpr(bucketSeconds, "e.created_at") + ` AS bucket,
+  COUNT(*) AS error_count
+FROM ops_error_logs e
+LEFT JOIN account_groups ag ON ag.account_id = e.account_id AND ag.group_id = e.group_id
+WHERE e.group_id = $1 AND e.created_at >= $2 AND e.created_at < $3
+  AND e.account_id IS NOT NULL
+  AND e.is_count_tokens = FALSE
+  AND e.error_owner = 'provider'
+  AND NOT e.is_business_limited
+GROUP BY 1, 2`
+
+	type poolAcc struct {
+		item          *service.OpsPoolComparisonItem
+		points        map[int64]*service.OpsPoolComparisonPoint
+		durationSum   map[int64]int64
+		durationCount map[int64]int64
+	}
+	pools := make(map[string]*poolAcc)
+	getPool := func(pool string) *poolAcc {
+		acc, ok := pools[pool]
+		if !ok {
+			acc = &poolAcc{
+				item:          &service.OpsPoolComparisonItem{Pool: pool},
+				points:        make(map[int64]*service.OpsPoolComparisonPoint),
+				durationSum:   make(map[int64]int64),
+				durationCount: make(map[int64]int64),
+			}
+			pools[pool] = acc
+		}
+		return acc
+	}
+	getPoint := func(acc *poolAcc, bucket time.Time) *service.OpsPoolComparisonPoint {
+		key := bucket.UTC().Unix()
+		p, ok := acc.points[key]
+		if !ok {
+			p = &service.OpsPoolComparisonPoint{BucketStart: bucket.UTC()}
+			acc.points[key] = p
+		}
+		return p
+	}
+
+	usageRows, err := r.db.QueryContext(ctx, usageQ, groupID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = usageRows.Close() }()
+	for usageRows.Next() {
+		var (
+			pool                      string
+			bucket                    time.Time
+			success, durSum, durCount int64
+		)
+		if err := usageRows.Scan(&pool, &bucket, &success, &durSum, &durCount); err != nil {
+			return nil, err
+		}
+		acc := getPool(pool)
+		getPoint(acc, bucket).SuccessCount += success
+		acc.durationSum[bucket.UTC().Unix()] += durSum
+		acc.durationCount[bucket.UTC().Unix()] += durCount
+	}
+	if err := usageRows.Err(); err != nil {
+		return nil, err
+	}
+
+	errorRows, err := r.db.QueryContext(ctx, errorQ, groupID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = errorRows.Close() }()
+	for errorRows.Next() {
+		var (
+			pool   string
+			bucket time.Time
+			count  int64
+		)
+		if err := errorRows.Scan(&pool, &bucket, &count); err != nil {
+			return nil, err
+		}
+		getPoint(getPool(pool), bucket).ErrorCount += count
+	}
+	if err := errorRows.Err(); err != nil {
+		return nil, err
+	}
+
+	out := &service.OpsPoolComparisonResponse{
+		GroupID: groupID,
+		Bucket:  opsBucketLabel(bucketSeconds),
+		Pools:   make([]*service.OpsPoolComparisonItem, 0, len(pools)),
+	}
+	for _, acc := range pools {
+		var totalDurSum, totalDurCount int64
+		for key, p := range acc.points {
+			p.ErrorRate = opsPoolErrorRate(p.SuccessCount, p.ErrorCount)
+			if n := acc.durationCount[key]; n > 0 {
+				p.AvgLatencyMs = float64(acc.durationSum[key]) / float64(n)
+			}
+			acc.item.SuccessCount += p.SuccessCount
+			acc.item.ErrorCount += p.ErrorCount
+			totalDurSum += acc.durationSum[key]
+			totalDurCount += acc.durationCount[key]
+		}
+		acc.item.ErrorRate = opsPoolErrorRate(acc.item.SuccessCount, acc.item.ErrorCount)
+		if totalDurCount > 0 {
+			acc.item.AvgLatencyMs = float64(totalDurSum) / float64(totalDurCount)
+		}
+		acc.item.Points = fillOpsPoolComparisonBuckets(start, end, bucketSeconds, acc.points)
+		out.Pools = append(out.Pools, acc.item)
+	}
+	sort.Slice(out.Pools, func(i, j int) bool {
+		// 默认池排在最前，便于和灰度池对比
+		if (out.Pools[i].Pool == service.AccountPoolDefault) != (out.Pools[j].Pool == service.AccountPoolDefault) {
+			return out.Pools[i].Pool == service.AccountPoolDefault
+		}
+		return out.Pools[i].Pool < out.Pools[j].Pool
+	})
+	return out, nil
+}
+
+func opsPoolBucketExpr(bucketSeconds int, column string) string {
+	switch bucketSeconds {
+	case 3600:
+		return "date_trunc('hour', " + column + ")"
+	case 300:
+		return "to_timestamp(floor(extract(epoch from " + column + ") / 300) * 300)"
+	default:
+		return "date_trunc('minute', " + column + ")"
+	}
+}
+
+func opsPoolErrorRate(success, errors int64) float64 {
+	total := success + errors
+	if total <= 0 {
+		return 0
+	}
+	return float64(errors) / float64(total)
+}
+
+func fillOpsPoolComparisonBuckets(start, end time.Time, bucketSeconds int, existing map[int64]*service.OpsPoolComparisonPoint) []*service.OpsPoolComparisonPoint {
+	if !start.Before(end) {
+		return []*service.OpsPoolComparisonPoint{}
+	}
+	first := opsFloorToBucketStart(start, bucketSeconds)
+	last := opsFloorToBucketStart(end.Add(-time.Nanosecond), bucketSeconds)
+	step := time.Duration(bucketSeconds) * time.Second
+
+	out := make([]*service.OpsPoolComparisonPoint, 0, int(last.Sub(first)/step)+1)
+	for cursor := first; !cursor.After(last); cursor = cursor.Add(step) {
+		if p, ok := existing[cursor.Unix()]; ok && p != nil {
+			out = append(out, p)
+			continue
+		}
+		out = append(out, &service.OpsPoolComparisonPoint{BucketStart: cursor})
+	}
+	return out
+}
@@ -0,0 +1,368 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+// organizationSelect 组织字段 + 共享余额（计费账户余额）+ 成员数
+const organizationSelect = `
+	SELECT o.id, o.name, o.billing_user_id, o.status, o.created_at, o.updated_at,
+		COALESCE(u.balance, 0),
+		(SELECT COUNT(*) FROM organization_members om WHERE om.organization_id = o.id)
+	FROM organizations o
+	LEFT JOIN users u ON u.id = o.billing_user_id`
+
+const organizationMemberSelect = `
+	SELECT m.id, m.organization_id, m.user_id, m.role, m.monthly_spend_cap_usd, m.created_at, m.updated_at,
+		COALESCE(u.email, ''), COALESCE(u.username, '')
+	FROM organization_members m
+	LEFT JOIN users u ON u.id = m.user_id`
+
+type organizationRepository struct {
+	sql sqlExecutor
+}
+
+func NewOrganizationRepository(sqlDB *sql.DB) service.OrganizationRepository {
+	return &organizationRepository{sql: sqlDB}
+}
+
+func (r *organizationRepository) Create(ctx context.Context, org *service.Organization) error {
+	query := `
+		INSERT INTO organizations (name, billing_user_id, status)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at, updated_at
+	`
+	args := []any{org.Name, org.BillingUserID, org.Status}
+	return scanSingleRow(ctx, sqlExecutorFromContext(ctx, r.sql), query, args, &org.ID, &org.CreatedAt, &org.UpdatedAt)
+}
+
+func (r *organizationRepository) GetByID(ctx context.Context, id int64) (*service.Organization, error) {
+	rows, err := sqlExecutorFromContext(ctx, r.sql).QueryContext(ctx, organizationSelect+" WHERE o.id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		return nil, service.ErrOrganizationNotFound
+	}
+	org, err := scanOrganization(rows, false)
+	if err != nil {
+		return nil, err
+	}
+	return org, rows.Err()
+}
+
+func (r *organizationRepository) Update(ctx context.Context, org *service.Organization) error {
+	res, err := sqlExecutorFromContext(ctx, r.sql).ExecContext(ctx, `
+		UPDATE organizations SET name = $2, status = $3, updated_at = NOW()
+		WHERE id = $1
+	`, org.ID, org.Name, org.Status)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return service.ErrOrganizationNotFound
+	}
+	return nil
+}
+
+func (r *organizationRepository) List(ctx context.Context, params pagination.PaginationParams, search string) ([]service.Organization, *pagination.PaginationResult, error) {
+	where := "1 = 1"
+	args := []any{}
+	if search != "" {
+		args = append(args, "%"+search+"%")
+		where = "o.name ILIKE $1"
+	}
+
+	var total int64
+	if err := scanSingleRow(ctx, r.sql, "SELECT COUNT(*) FROM organizations o WHERE "+where, args, &total); err != nil {
+		return nil, nil, err
+	}
+	if total == 0 {
+		return []service.Organization{}, paginationResultFromTotal(0, params), nil
+	}
+
+	query := fmt.Sprintf("%s WHERE %s ORDER BY o.id DESC LIMIT $%d OFFSET $%d", organizationSelect, where, len(args)+1, len(args)+2)
+	rows, err := r.sql.QueryContext(ctx, query, append(args, params.Limit(), params.Offset())...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := make([]service.Organization, 0)
+	for rows.Next() {
+		org, err := scanOrganization(rows, false)
+		if err != nil {
+			return nil, nil, err
+		}
+		out = append(out, *org)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+	return out, paginationResultFromTotal(total, params), nil
+}
+
+func (r *organizationRepository) ListByUserID(ctx context.Context, userID int64) ([]service.Organization, error) {
+	query := `
+		SELECT o.id, o.name, o.billing_user_id, o.status, o.created_at, o.updated_at,
+			COALESCE(u.balance, 0),
+			(SELECT COUNT(*) FROM organization_members om WHERE om.organization_id = o.id),
+			m.role
+		FROM organization_members m
+		JOIN organizations o ON o.id = m.organization_id
+		LEFT JOIN users u ON u.id = o.billing_user_id
+		WHERE m.user_id = $1
+		ORDER BY o.id
+	`
+	rows, err := r.sql.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := make([]service.Organization, 0)
+	for rows.Next() {
+		org, err := scanOrganization(rows, true)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *org)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *organizationRepository) AddMember(ctx context.Context, member *service.OrganizationMember) error {
+	query := `
+		INSERT INTO organization_members (organization_id, user_id, role, monthly_spend_cap_usd)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at, updated_at
+	`
+	args := []any{member.OrganizationID, member.UserID, member.Role, nullFloat64(member.MonthlySpendCapUSD)}
+	err := scanSingleRow(ctx, sqlExecutorFromContext(ctx, r.sql), query, args, &member.ID, &member.CreatedAt, &member.UpdatedAt)
+	return translatePersistenceError(err, nil, service.ErrOrganizationMemberExists)
+}
+
+func (r *organizationRepository) GetMember(ctx context.Context, organizationID, userID int64) (*service.OrganizationMember, error) {
+	rows, err := sqlExecutorFromContext(ctx, r.sql).QueryContext(ctx, organizationMemberSelect+" WHERE m.organization_id = $1 AND m.user_id = $2", organizationID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		return nil, service.ErrOrganizationMemberNotFound
+	}
+	member, err := scanOrganizationMember(rows)
+	if err != nil {
+		return nil, err
+	}
+	return member, rows.Err()
+}
+
+func (r *organizationRepository) UpdateMember(ctx context.Context, member *service.OrganizationMember) error {
+	res, err := sqlExecutorFromContext(ctx, r.sql).ExecContext(ctx, `
+		UPDATE organization_members SET role = $3, monthly_spend_cap_usd = $4, updated_at = NOW()
+		WHERE organization_id = $1 AND user_id = $2
+	`, member.OrganizationID, member.UserID, member.Role, nullFloat64(member.MonthlySpendCapUSD))
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return service.ErrOrganizationMemberNotFound
+	}
+	return nil
+}
+
+// RemoveMember 删除成员并在同一语句中解绑其组织 Key
+func (r *organizationRepository) RemoveMember(ctx context.Context, organizationID, userID int64) error {
+	_, err := sqlExecutorFromContext(ctx, r.sql).ExecContext(ctx, `
+		WITH removed AS (
+			DELETE FROM organization_members
+			WHERE organization_id = $1 AND user_id = $2
+			RETURNING user_id
+		)
+		UPDATE api_keys SET organization_id = NULL, updated_at = NOW()
+		WHERE organization_id = $1 AND user_id IN (SELECT user_id FROM removed)
+	`, organizationID, userID)
+	return err
+}
+
+func (r *organizationRepository) ListMembers(ctx context.Context, organizationID int64) ([]service.OrganizationMember, error) {
+	rows, err := sqlExecutorFromContext(ctx, r.sql).QueryContext(ctx, organizationMemberSelect+`
+		WHERE m.organization_id = $1
+		ORDER BY CASE m.role WHEN 'owner' THEN 0 WHEN 'admin' THEN 1 ELSE 2 END, m.id
+	`, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := make([]service.OrganizationMember, 0)
+	for rows.Next() {
+		member, err := scanOrganizationMember(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *member)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *organizationRepository) SetAPIKeyBinding(ctx context.Context, apiKeyID int64, organizationID, groupID *int64) error {
+	res, err := sqlExecutorFromContext(ctx, r.sql).ExecContext(ctx, `
+		UPDATE api_keys SET organization_id = $2, group_id = $3, updated_at = NOW()
+		WHERE id = $1 AND deleted_at IS NULL
+	`, apiKeyID, nullInt64(organizationID), nullInt64(groupID))
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return service.ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+func (r *organizationRepository) GetMemberSpendSince(ctx context.Context, organizationID, userID int64, since time.Time) (float64, error) {
+	query := `
+		SELECT COALESCE(SUM(actual_cost), 0)
+		FROM usage_logs
+		WHERE organization_id = $1 AND user_id = $2 AND created_at >= $3
+	`
+	var spend float64
+	if err := scanSingleRow(ctx, r.sql, query, []any{organizationID, userID, since}, &spend); err != nil {
+		return 0, err
+	}
+	return spend, nil
+}
+
+// GetMemberUsage 当前成员（含无用量的成员）与区间内有用量的已移除成员（Role 为空）
+func (r *organizationRepository) GetMemberUsage(ctx context.Context, organizationID int64, startTime, endTime time.Time) ([]service.OrganizationMemberUsage, error) {
+	query := `
+		WITH usage AS (
+			SELECT user_id,
+				COUNT(*) AS requests,
+				SUM(input_tokens + output_tokens + cache_creation_tokens + cache_read_tokens) AS tokens,
+				SUM(total_cost) AS total_cost,
+				SUM(actual_cost) AS actual_cost
+			FROM usage_logs
+			WHERE organization_id = $1 AND created_at >= $2 AND created_at < $3
+			GROUP BY user_id
+		), members AS (
+			SELECT user_id, role, monthly_spend_cap_usd
+			FROM organization_members
+			WHERE organization_id = $1
+		)
+		SELECT COALESCE(m.user_id, us.user_id), COALESCE(u.email, ''), COALESCE(u.username, ''),
+			COALESCE(m.role, ''), m.monthly_spend_cap_usd,
+			COALESCE(us.requests, 0), COALESCE(us.tokens, 0), COALESCE(us.total_cost, 0), COALESCE(us.actual_cost, 0)
+		FROM members m
+		FULL OUTER JOIN usage us ON us.user_id = m.user_id
+		LEFT JOIN users u ON u.id = COALESCE(m.user_id, us.user_id)
+		ORDER BY COALESCE(us.actual_cost, 0) DESC, 1
+	`
+	rows, err := r.sql.QueryContext(ctx, query, organizationID, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := make([]service.OrganizationMemberUsage, 0)
+	for rows.Next() {
+		var (
+			item     service.OrganizationMemberUsage
+			spendCap sql.NullFloat64
+		)
+		if err := rows.Scan(
+			&item.UserID,
+			&item.Email,
+			&item.Username,
+			&item.Role,
+			&spendCap,
+			&item.Requests,
+			&item.TotalTokens,
+			&item.TotalCost,
+			&item.ActualCost,
+		); err != nil {
+			return nil, err
+		}
+		item.MonthlySpendCapUSD = nullFloat64Ptr(spendCap)
+		out = append(out, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func scanOrganization(rows *sql.Rows, withRole bool) (*service.Organization, error) {
+	var org service.Organization
+	dest := []any{
+		&org.ID,
+		&org.Name,
+		&org.BillingUserID,
+		&org.Status,
+		&org.CreatedAt,
+		&org.UpdatedAt,
+		&org.Balance,
+		&org.MemberCount,
+	}
+	if withRole {
+		dest = append(dest, &org.Role)
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return nil, err
+	}
+	return &org, nil
+}
+
+func scanOrganizationMember(rows *sql.Rows) (*service.OrganizationMember, error) {
+	var (
+		member   service.OrganizationMember
+		spendCap sql.NullFloat64
+	)
+	if err := rows.Scan(
+		&member.ID,
+		&member.OrganizationID,
+		&member.UserID,
+		&member.Role,
+		&spendCap,
+		&member.CreatedAt,
+		&member.UpdatedAt,
+		&member.Email,
+		&member.Username,
+	); err != nil {
+		return nil, err
+	}
+	member.MonthlySpendCapUSD = nullFloat64Ptr(spendCap)
+	return &member, nil
+}
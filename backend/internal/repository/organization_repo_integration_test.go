@@ -0,0 +1,75 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrganizationRepository_MembersAndKeyBinding(t *testing.T) {
+	ctx := context.Background()
+	client := testEntClient(t)
+	repo := NewOrganizationRepository(integrationDB)
+
+	suffix := time.Now().UnixNano()
+	billing := mustCreateUser(t, client, &service.User{Email: fmt.Sprintf("org-billing-%d@organization.invalid", suffix), Balance: 42})
+	owner := mustCreateUser(t, client, &service.User{Email: fmt.Sprintf("org-owner-%d@example.com", suffix)})
+	member := mustCreateUser(t, client, &service.User{Email: fmt.Sprintf("org-member-%d@example.com", suffix)})
+	key := mustCreateApiKey(t, client, &service.APIKey{UserID: member.ID, Key: fmt.Sprintf("sk-org-%d", suffix)})
+
+	org := &service.Organization{Name: "team", BillingUserID: billing.ID, Status: service.StatusActive}
+	require.NoError(t, repo.Create(ctx, org))
+	t.Cleanup(func() {
+		_, _ = integrationDB.ExecContext(ctx, "DELETE FROM organizations WHERE id = $1", org.ID)
+		_, _ = integrationDB.ExecContext(ctx, "DELETE FROM api_keys WHERE id = $1", key.ID)
+		_, _ = integrationDB.ExecContext(ctx, "DELETE FROM users WHERE id IN ($1, $2, $3)", billing.ID, owner.ID, member.ID)
+	})
+
+	require.NoError(t, repo.AddMember(ctx, &service.OrganizationMember{OrganizationID: org.ID, UserID: owner.ID, Role: service.OrganizationRoleOwner}))
+	spendCap := 15.0
+	require.NoError(t, repo.AddMember(ctx, &service.OrganizationMember{OrganizationID: org.ID, UserID: member.ID, Role: service.OrganizationRoleMember, MonthlySpendCapUSD: &spendCap}))
+	err := repo.AddMember(ctx, &service.OrganizationMember{OrganizationID: org.ID, UserID: member.ID, Role: service.OrganizationRoleMember})
+	require.ErrorIs(t, err, service.ErrOrganizationMemberExists)
+
+	got, err := repo.GetByID(ctx, org.ID)
+	require.NoError(t, err)
+	require.Equal(t, 42.0, got.Balance)
+	require.Equal(t, int64(2), got.MemberCount)
+
+	mine, err := repo.ListByUserID(ctx, member.ID)
+	require.NoError(t, err)
+	require.Len(t, mine, 1)
+	require.Equal(t, service.OrganizationRoleMember, mine[0].Role)
+
+	members, err := repo.ListMembers(ctx, org.ID)
+	require.NoError(t, err)
+	require.Len(t, members, 2)
+	require.Equal(t, owner.ID, members[0].UserID)
+	require.Equal(t, 15.0, *members[1].MonthlySpendCapUSD)
+
+	require.NoError(t, repo.SetAPIKeyBinding(ctx, key.ID, &org.ID, nil))
+	var boundOrg *int64
+	require.NoError(t, integrationDB.QueryRowContext(ctx, "SELECT organization_id FROM api_keys WHERE id = $1", key.ID).Scan(&boundOrg))
+	require.NotNil(t, boundOrg)
+	require.Equal(t, org.ID, *boundOrg)
+
+	usage, err := repo.GetMemberUsage(ctx, org.ID, time.Now().Add(-time.Hour), time.Now())
+	require.NoError(t, err)
+	require.Len(t, usage, 2)
+	spend, err := repo.GetMemberSpendSince(ctx, org.ID, member.ID, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Zero(t, spend)
+
+	// 移除成员同时解绑其组织 Key
+	require.NoError(t, repo.RemoveMember(ctx, org.ID, member.ID))
+	_, err = repo.GetMember(ctx, org.ID, member.ID)
+	require.ErrorIs(t, err, service.ErrOrganizationMemberNotFound)
+	require.NoError(t, integrationDB.QueryRowContext(ctx, "SELECT organization_id FROM api_keys WHERE id = $1", key.ID).Scan(&boundOrg))
+	require.Nil(t, boundOrg)
+}
@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+const epayTradeSuccess = "TRADE_SUCCESS"
+
+// epayProvider 易支付（EPay/YiPay）兼容网关
+// 下单走 mapi.php 接口；回调为 GET/POST 表单，按参数名排序拼接后加商户密钥做 MD5 签名。
+type epayProvider struct {
+	httpClient *http.Client
+	apiURL     string
+	pid        string
+	key        string
+	payType    string
+	currency   string
+	creditRate float64
+	notifyURL  string
+	returnURL  string
+}
+
+type epayCreateResponse struct {
+	Code    json.Number `json:"code"`
+	Msg     string      `json:"msg"`
+	TradeNo string      `json:"trade_no"`
+	PayURL  string      `json:"payurl"`
+	QRCode  string      `json:"qrcode"`
+}
+
+func newEPayProvider(cfg *config.PaymentConfig) *epayProvider {
+	return &epayProvider{
+		httpClient: newPaymentHTTPClient(),
+		apiURL:     cfg.EPay.APIURL,
+		pid:        cfg.EPay.PID,
+		key:        cfg.EPay.Key,
+		payType:    cfg.EPay.PayType,
+		currency:   cfg.EPay.Currency,
+		creditRate: cfg.EPay.CreditRate,
+		notifyURL:  paymentNotifyURL(cfg.NotifyBaseURL, service.PaymentProviderEPay),
+		returnURL:  cfg.ReturnURL,
+	}
+}
+
+func (p *epayProvider) Name() string        { return service.PaymentProviderEPay }
+func (p *epayProvider) Currency() string    { return p.currency }
+func (p *epayProvider) CreditRate() float64 { return p.creditRate }
+
+func (p *epayProvider) CreatePayment(ctx context.Context, order *service.PaymentOrder) (*service.PaymentCheckout, error) {
+	params := url.Values{}
+	params.Set("pid", p.pid)
+	params.Set("type", p.payType)
+	params.Set("out_trade_no", order.OrderNo)
+	params.Set("notify_url", p.notifyURL)
+	params.Set("return_url", paymentReturnURL(p.returnURL, order.OrderNo))
+	params.Set("name", "Balance top-up "+order.OrderNo)
+	params.Set("money", formatPaymentAmount(order.Amount))
+	params.Set("clientip", order.ClientIP)
+	params.Set("sign", epaySign(params, p.key))
+	params.Set("sign_type", "MD5")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.apiURL+"/mapi.php", strings.NewReader(params.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("epay returned status %d", resp.StatusCode)
+	}
+	var result epayCreateResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	if result.Code.String() != "1" {
+		return nil, fmt.Errorf("epay create failed: %s", result.Msg)
+	}
+	payURL := result.PayURL
+	if payURL == "" {
+		payURL = result.QRCode
+	}
+	if payURL == "" {
+		return nil, fmt.Errorf("epay response missing pay url")
+	}
+	return &service.PaymentCheckout{PayURL: payURL, ProviderTradeNo: result.TradeNo}, nil
+}
+
+func (p *epayProvider) VerifyNotification(_ context.Context, n *service.PaymentNotification) (*service.PaymentNotifyResult, error) {
+	// 易支付回调多为 GET 查询参数，部分实现使用 POST 表单
+	params := n.Query
+	if params.Get("sign") == "" && n.Form != nil {
+		params = n.Form
+	}
+	sign := params.Get("sign")
+	if sign == "" || params.Get("pid") != p.pid {
+		return nil, service.ErrPaymentSignatureInvalid
+	}
+	expected := epaySign(params, p.key)
+	if subtle.ConstantTimeCompare([]byte(strings.ToLower(sign)), []byte(expected)) != 1 {
+		return nil, service.ErrPaymentSignatureInvalid
+	}
+
+	amount, err := strconv.ParseFloat(params.Get("money"), 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse epay money: %w", err)
+	}
+	return &service.PaymentNotifyResult{
+		OrderNo:         params.Get("out_trade_no"),
+		ProviderTradeNo: params.Get("trade_no"),
+		Amount:          amount,
+		Paid:            params.Get("trade_status") == epayTradeSuccess,
+	}, nil
+}
+
+func (p *epayProvider) NotifyAck() (string, []byte) {
+	return "text/plain; charset=utf-8", []byte("success")
+}
+
+// epaySign 计算易支付签名：排除 sign/sign_type 与空值，按参数名升序拼接 k=v&...，末尾追加密钥后取 MD5（小写）
+func epaySign(params url.Values, key string) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		if k == "sign" || k == "sign_type" || params.Get(k) == "" {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	for i, k := range keys {
+		if i > 0 {
+			sb.WriteByte('&')
+		}
+		sb.WriteString(k)
+		sb.WriteByte('=')
+		sb.WriteString(params.Get(k))
+	}
+	sb.WriteString(key)
+	sum := md5.Sum([]byte(sb.String()))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,188 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/lib/pq"
+)
+
+const paymentOrderColumns = `id, order_no, user_id, provider, amount, currency, credit_amount, status,
+	provider_trade_no, pay_url, paid_at, expires_at, created_at, updated_at, promo_codes`
+
+type paymentOrderRepository struct {
+	sql sqlExecutor
+}
+
+func NewPaymentOrderRepository(sqlDB *sql.DB) service.PaymentOrderRepository {
+	return &paymentOrderRepository{sql: sqlDB}
+}
+
+func (r *paymentOrderRepository) Create(ctx context.Context, order *service.PaymentOrder) error {
+	query := `
+		INSERT INTO payment_orders (order_no, user_id, provider, amount, currency, credit_amount, status, expires_at, promo_codes)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, created_at, updated_at
+	`
+	promoCodes := order.PromoCodes
+	if promoCodes == nil {
+		promoCodes = []string{}
+	}
+	args := []any{order.OrderNo, order.UserID, order.Provider, order.Amount, order.Currency, order.CreditAmount, order.Status, order.ExpiresAt, pq.Array(promoCodes)}
+	return scanSingleRow(ctx, sqlExecutorFromContext(ctx, r.sql), query, args, &order.ID, &order.CreatedAt, &order.UpdatedAt)
+}
+
+func (r *paymentOrderRepository) GetByOrderNo(ctx context.Context, orderNo string) (*service.PaymentOrder, error) {
+	rows, err := sqlExecutorFromContext(ctx, r.sql).QueryContext(ctx, "SELECT "+paymentOrderColumns+" FROM payment_orders WHERE order_no = $1", orderNo)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		return nil, service.ErrPaymentOrderNotFound
+	}
+	order, err := scanPaymentOrder(rows)
+	if err != nil {
+		return nil, err
+	}
+	return order, rows.Err()
+}
+
+func (r *paymentOrderRepository) UpdateCheckout(ctx context.Context, id int64, payURL, providerTradeNo string) error {
+	_, err := sqlExecutorFromContext(ctx, r.sql).ExecContext(ctx, `
+		UPDATE payment_orders
+		SET pay_url = $2, provider_trade_no = COALESCE($3, provider_trade_no), updated_at = NOW()
+		WHERE id = $1
+	`, id, nullString(&payURL), nullString(&providerTradeNo))
+	return err
+}
+
+func (r *paymentOrderRepository) MarkPaid(ctx context.Context, id int64, providerTradeNo string, paidAt time.Time) (bool, error) {
+	res, err := sqlExecutorFromContext(ctx, r.sql).ExecContext(ctx, `
+		UPDATE payment_orders
+		SET status = $2, provider_trade_no = COALESCE($3, provider_trade_no), paid_at = $4, updated_at = NOW()
+		WHERE id = $1 AND status <> $2
+	`, id, service.PaymentOrderStatusPaid, nullString(&providerTradeNo), paidAt)
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (r *paymentOrderRepository) MarkFailed(ctx context.Context, id int64) error {
+	_, err := sqlExecutorFromContext(ctx, r.sql).ExecContext(ctx, `
+		UPDATE payment_orders SET status = $2, updated_at = NOW()
+		WHERE id = $1 AND status = $3
+	`, id, service.PaymentOrderStatusFailed, service.PaymentOrderStatusPending)
+	return err
+}
+
+func (r *paymentOrderRepository) ExpirePending(ctx context.Context, now time.Time) (int64, error) {
+	res, err := sqlExecutorFromContext(ctx, r.sql).ExecContext(ctx, `
+		UPDATE payment_orders SET status = $1, updated_at = NOW()
+		WHERE status = $2 AND expires_at < $3
+	`, service.PaymentOrderStatusExpired, service.PaymentOrderStatusPending, now)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+func (r *paymentOrderRepository) List(ctx context.Context, params pagination.PaginationParams, filters service.PaymentOrderFilters) ([]service.PaymentOrder, *pagination.PaginationResult, error) {
+	conditions := []string{"1 = 1"}
+	args := []any{}
+	if filters.UserID > 0 {
+		args = append(args, filters.UserID)
+		conditions = append(conditions, fmt.Sprintf("user_id = $%d", len(args)))
+	}
+	if filters.Status != "" {
+		args = append(args, filters.Status)
+		conditions = append(conditions, fmt.Sprintf("status = $%d", len(args)))
+	}
+	if filters.Provider != "" {
+		args = append(args, filters.Provider)
+		conditions = append(conditions, fmt.Sprintf("provider = $%d", len(args)))
+	}
+	where := strings.Join(conditions, " AND ")
+
+	var total int64
+	if err := scanSingleRow(ctx, r.sql, "SELECT COUNT(*) FROM payment_orders WHERE "+where, args, &total); err != nil {
+		return nil, nil, err
+	}
+	if total == 0 {
+		return []service.PaymentOrder{}, paginationResultFromTotal(0, params), nil
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM payment_orders
+		WHERE %s
+		ORDER BY created_at DESC, id DESC
+		LIMIT $%d OFFSET $%d
+	`, paymentOrderColumns, where, len(args)+1, len(args)+2)
+	rows, err := r.sql.QueryContext(ctx, query, append(args, params.Limit(), params.Offset())...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := make([]service.PaymentOrder, 0)
+	for rows.Next() {
+		order, err := scanPaymentOrder(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+		out = append(out, *order)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+	return out, paginationResultFromTotal(total, params), nil
+}
+
+func scanPaymentOrder(rows *sql.Rows) (*service.PaymentOrder, error) {
+	var (
+		order   service.PaymentOrder
+		tradeNo sql.NullString
+		payURL  sql.NullString
+		paidAt  sql.NullTime
+	)
+	if err := rows.Scan(
+		&order.ID,
+		&order.OrderNo,
+		&order.UserID,
+		&order.Provider,
+		&order.Amount,
+		&order.Currency,
+		&order.CreditAmount,
+		&order.Status,
+		&tradeNo,
+		&payURL,
+		&paidAt,
+		&order.ExpiresAt,
+		&order.CreatedAt,
+		&order.UpdatedAt,
+		pq.Array(&order.PromoCodes),
+	); err != nil {
+		return nil, err
+	}
+	order.ProviderTradeNo = tradeNo.String
+	order.PayURL = payURL.String
+	if paidAt.Valid {
+		t := paidAt.Time
+		order.PaidAt = &t
+	}
+	return &order, nil
+}
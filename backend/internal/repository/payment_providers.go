@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/httpclient"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+const paymentHTTPTimeout = 15 * time.Second
+
+// ProvidePaymentProviders 按配置创建已启用的支付渠道
+func ProvidePaymentProviders(cfg *config.Config) service.PaymentProviders {
+	if cfg == nil || !cfg.Payment.Enabled {
+		return nil
+	}
+	var providers service.PaymentProviders
+	if cfg.Payment.EPay.Enabled {
+		providers = append(providers, newEPayProvider(&cfg.Payment))
+	}
+	if cfg.Payment.Stripe.Enabled {
+		providers = append(providers, newStripeProvider(&cfg.Payment))
+	}
+	return providers
+}
+
+func newPaymentHTTPClient() *http.Client {
+	sharedClient, err := httpclient.GetClient(httpclient.Options{
+		Timeout:            paymentHTTPTimeout,
+		ValidateResolvedIP: true,
+	})
+	if err != nil {
+		sharedClient = &http.Client{Timeout: paymentHTTPTimeout}
+	}
+	return sharedClient
+}
+
+func paymentNotifyURL(baseURL, provider string) string {
+	return baseURL + "/api/v1/payment/notify/" + provider
+}
+
+// paymentReturnURL 在前端跳转地址上附加 order_no，便于支付完成后查询订单状态
+func paymentReturnURL(returnURL, orderNo string) string {
+	if returnURL == "" {
+		return ""
+	}
+	u, err := url.Parse(returnURL)
+	if err != nil {
+		return returnURL
+	}
+	q := u.Query()
+	q.Set("order_no", orderNo)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+func formatPaymentAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
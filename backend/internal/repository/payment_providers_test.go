@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPaymentConfig(apiURL string) *config.PaymentConfig {
+	return &config.PaymentConfig{
+		Enabled:       true,
+		NotifyBaseURL: "https://api.example.com",
+		ReturnURL:     "https://app.example.com/topup?tab=history",
+		EPay: config.EPayPaymentConfig{
+			Enabled:    true,
+			APIURL:     apiURL,
+			PID:        "1001",
+			Key:        "epay-secret",
+			PayType:    "alipay",
+			Currency:   "CNY",
+			CreditRate: 0.14,
+		},
+		Stripe: config.StripePaymentConfig{
+			Enabled:       true,
+			APIBaseURL:    apiURL,
+			SecretKey:     "sk_test_123",
+			WebhookSecret: "whsec_test",
+			Currency:      "USD",
+			CreditRate:    1,
+		},
+	}
+}
+
+func TestEPayProvider_CreatePaymentAgainstStubServer(t *testing.T) {
+	var received url.Values
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/mapi.php", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		received, _ = url.ParseQuery(string(body))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"code":1,"msg":"ok","trade_no":"EP123","payurl":"https://pay.example.com/cashier/EP123"}`))
+	}))
+	defer srv.Close()
+
+	p := newEPayProvider(newTestPaymentConfig(srv.URL))
+	p.httpClient = srv.Client()
+
+	checkout, err := p.CreatePayment(context.Background(), &service.PaymentOrder{OrderNo: "ORD1", Amount: 10, ClientIP: "1.2.3.4"})
+	require.NoError(t, err)
+	require.Equal(t, "https://pay.example.com/cashier/EP123", checkout.PayURL)
+	require.Equal(t, "EP123", checkout.ProviderTradeNo)
+
+	require.Equal(t, "1001", received.Get("pid"))
+	require.Equal(t, "10.00", received.Get("money"))
+	require.Equal(t, "https://api.example.com/api/v1/payment/notify/epay", received.Get("notify_url"))
+	require.Equal(t, "https://app.example.com/topup?order_no=ORD1&tab=history", received.Get("return_url"))
+	require.Equal(t, epaySign(received, "epay-secret"), received.Get("sign"))
+}
+
+func TestEPayProvider_CreatePaymentError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"code":-1,"msg":"merchant disabled"}`))
+	}))
+	defer srv.Close()
+
+	p := newEPayProvider(newTestPaymentConfig(srv.URL))
+	p.httpClient = srv.Client()
+
+	_, err := p.CreatePayment(context.Background(), &service.PaymentOrder{OrderNo: "ORD1", Amount: 10})
+	require.ErrorContains(t, err, "merchant disabled")
+}
+
+func TestEPayProvider_VerifyNotification(t *testing.T) {
+	p := newEPayProvider(newTestPaymentConfig("https://pay.example.com"))
+	params := url.Values{}
+	params.Set("pid", "1001")
+	params.Set("trade_no", "EP123")
+	params.Set("out_trade_no", "ORD1")
+	params.Set("type", "alipay")
+	params.Set("name", "Balance top-up ORD1")
+	params.Set("money", "10.00")
+	params.Set("trade_status", "TRADE_SUCCESS")
+	params.Set("sign", epaySign(params, "epay-secret"))
+	params.Set("sign_type", "MD5")
+
+	result, err := p.VerifyNotification(context.Background(), &service.PaymentNotification{Query: params})
+	require.NoError(t, err)
+	require.True(t, result.Paid)
+	require.Equal(t, "ORD1", result.OrderNo)
+	require.Equal(t, "EP123", result.ProviderTradeNo)
+	require.Equal(t, 10.0, result.Amount)
+
+	// POST 表单回调
+	result, err = p.VerifyNotification(context.Background(), &service.PaymentNotification{Query: url.Values{}, Form: params})
+	require.NoError(t, err)
+	require.True(t, result.Paid)
+
+	tampered := url.Values{}
+	for k, v := range params {
+		tampered[k] = v
+	}
+	tampered.Set("money", "100.00")
+	_, err = p.VerifyNotification(context.Background(), &service.PaymentNotification{Query: tampered})
+	require.ErrorIs(t, err, service.ErrPaymentSignatureInvalid)
+
+	otherMerchant := url.Values{}
+	for k, v := range params {
+		otherMerchant[k] = v
+	}
+	otherMerchant.Set("pid", "2002")
+	otherMerchant.Set("sign", epaySign(otherMerchant, "epay-secret"))
+	_, err = p.VerifyNotification(context.Background(), &service.PaymentNotification{Query: otherMerchant})
+	require.ErrorIs(t, err, service.ErrPaymentSignatureInvalid)
+}
+
+func TestStripeProvider_CreatePaymentAgainstStubServer(t *testing.T) {
+	var received url.Values
+	var auth, idempotencyKey string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/checkout/sessions", r.URL.Path)
+		auth = r.Header.Get("Authorization")
+		idempotencyKey = r.Header.Get("Idempotency-Key")
+		body, _ := io.ReadAll(r.Body)
+		received, _ = url.ParseQuery(string(body))
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": "cs_test_1", "url": "https://checkout.stripe.com/c/cs_test_1"})
+	}))
+	defer srv.Close()
+
+	p := newStripeProvider(newTestPaymentConfig(srv.URL))
+	p.httpClient = srv.Client()
+
+	checkout, err := p.CreatePayment(context.Background(), &service.PaymentOrder{OrderNo: "ORD2", Amount: 12.34, ExpiresAt: time.Now().Add(time.Hour)})
+	require.NoError(t, err)
+	require.Equal(t, "https://checkout.stripe.com/c/cs_test_1", checkout.PayURL)
+	require.Equal(t, "cs_test_1", checkout.ProviderTradeNo)
+
+	require.Equal(t, "Bearer sk_test_123", auth)
+	require.Equal(t, "ORD2", idempotencyKey)
+	require.Equal(t, "ORD2", received.Get("client_reference_id"))
+	require.Equal(t, "1234", received.Get("line_items[0][price_data][unit_amount]"))
+	require.Equal(t, "usd", received.Get("line_items[0][price_data][currency]"))
+}
+
+func TestStripeProvider_CreatePaymentError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":{"message":"Invalid API Key provided"}}`))
+	}))
+	defer srv.Close()
+
+	p := newStripeProvider(newTestPaymentConfig(srv.URL))
+	p.httpClient = srv.Client()
+
+	_, err := p.CreatePayment(context.Background(), &service.PaymentOrder{OrderNo: "ORD2", Amount: 5})
+	require.ErrorContains(t, err, "Invalid API Key provided")
+}
+
+func TestStripeProvider_VerifyNotification(t *testing.T) {
+	now := time.Unix(1_760_000_000, 0)
+	p := newStripeProvider(newTestPaymentConfig("https://api.stripe.com"))
+	p.now = func() time.Time { return now }
+
+	body := []byte(`{"id":"evt_1","type":"checkout.session.completed","data":{"object":{"id":"cs_test_1","client_reference_id":"ORD2","amount_total":1234,"payment_status":"paid"}}}`)
+	ts := strconv.FormatInt(now.Unix(), 10)
+	header := http.Header{}
+	header.Set(stripeSignatureHeader, "t="+ts+",v1="+stripeSign(ts, body, "whsec_test"))
+
+	result, err := p.VerifyNotification(context.Background(), &service.PaymentNotification{Header: header, Body: body})
+	require.NoError(t, err)
+	require.True(t, result.Paid)
+	require.Equal(t, "ORD2", result.OrderNo)
+	require.Equal(t, "cs_test_1", result.ProviderTradeNo)
+	require.InDelta(t, 12.34, result.Amount, 1e-9)
+
+	// 错误密钥签名
+	header.Set(stripeSignatureHeader, "t="+ts+",v1="+stripeSign(ts, body, "whsec_other"))
+	_, err = p.VerifyNotification(context.Background(), &service.PaymentNotification{Header: header, Body: body})
+	require.ErrorIs(t, err, service.ErrPaymentSignatureInvalid)
+
+	// 超出时间容差（重放）
+	stale := strconv.FormatInt(now.Add(-10*time.Minute).Unix(), 10)
+	header.Set(stripeSignatureHeader, "t="+stale+",v1="+stripeSign(stale, body, "whsec_test"))
+	_, err = p.VerifyNotification(context.Background(), &service.PaymentNotification{Header: header, Body: body})
+	require.ErrorIs(t, err, service.ErrPaymentSignatureInvalid)
+
+	// 异步支付尚未到账
+	pending := []byte(`{"id":"evt_2","type":"checkout.session.completed","data":{"object":{"id":"cs_test_1","client_reference_id":"ORD2","amount_total":1234,"payment_status":"unpaid"}}}`)
+	header.Set(stripeSignatureHeader, "t="+ts+",v1="+stripeSign(ts, pending, "whsec_test"))
+	result, err = p.VerifyNotification(context.Background(), &service.PaymentNotification{Header: header, Body: pending})
+	require.NoError(t, err)
+	require.False(t, result.Paid)
+}
+
+func TestProvidePaymentProviders(t *testing.T) {
+	cfg := &config.Config{Payment: *newTestPaymentConfig("https://pay.example.com")}
+	providers := ProvidePaymentProviders(cfg)
+	require.Len(t, providers, 2)
+	require.Equal(t, service.PaymentProviderEPay, providers[0].Name())
+	require.Equal(t, "usd", providers[1].Currency())
+
+	cfg.Payment.Enabled = false
+	require.Empty(t, ProvidePaymentProviders(cfg))
+}
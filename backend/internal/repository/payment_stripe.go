@@ -0,0 +1,215 @@
+package repository
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+const (
+	stripeSignatureHeader = "Stripe-Signature"
+	// stripeSignatureTolerance 回调时间戳与本地时间允许的偏差，防止重放
+	stripeSignatureTolerance = 5 * time.Minute
+)
+
+// stripeProvider Stripe Checkout
+// 下单创建 Checkout Session；回调为 webhook 事件，Stripe-Signature 头为 t=时间戳,v1=HMAC-SHA256(secret, "t.body")。
+type stripeProvider struct {
+	httpClient    *http.Client
+	apiBaseURL    string
+	secretKey     string
+	webhookSecret string
+	currency      string
+	creditRate    float64
+	returnURL     string
+	now           func() time.Time
+}
+
+type stripeCheckoutSession struct {
+	ID                string `json:"id"`
+	URL               string `json:"url"`
+	ClientReferenceID string `json:"client_reference_id"`
+	AmountTotal       int64  `json:"amount_total"`
+	PaymentStatus     string `json:"payment_status"`
+	PaymentIntent     string `json:"payment_intent"`
+}
+
+type stripeEvent struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Data struct {
+		Object stripeCheckoutSession `json:"object"`
+	} `json:"data"`
+}
+
+type stripeErrorResponse struct {
+	Error struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func newStripeProvider(cfg *config.PaymentConfig) *stripeProvider {
+	return &stripeProvider{
+		httpClient:    newPaymentHTTPClient(),
+		apiBaseURL:    cfg.Stripe.APIBaseURL,
+		secretKey:     cfg.Stripe.SecretKey,
+		webhookSecret: cfg.Stripe.WebhookSecret,
+		currency:      strings.ToLower(cfg.Stripe.Currency),
+		creditRate:    cfg.Stripe.CreditRate,
+		returnURL:     cfg.ReturnURL,
+		now:           time.Now,
+	}
+}
+
+func (p *stripeProvider) Name() string        { return service.PaymentProviderStripe }
+func (p *stripeProvider) Currency() string    { return p.currency }
+func (p *stripeProvider) CreditRate() float64 { return p.creditRate }
+
+func (p *stripeProvider) CreatePayment(ctx context.Context, order *service.PaymentOrder) (*service.PaymentCheckout, error) {
+	returnURL := paymentReturnURL(p.returnURL, order.OrderNo)
+	form := url.Values{}
+	form.Set("mode", "payment")
+	form.Set("success_url", returnURL)
+	form.Set("cancel_url", returnURL)
+	form.Set("client_reference_id", order.OrderNo)
+	form.Set("metadata[order_no]", order.OrderNo)
+	form.Set("line_items[0][quantity]", "1")
+	form.Set("line_items[0][price_data][currency]", p.currency)
+	form.Set("line_items[0][price_data][unit_amount]", strconv.FormatInt(stripeMinorUnits(order.Amount), 10))
+	form.Set("line_items[0][price_data][product_data][name]", "Balance top-up")
+	form.Set("expires_at", strconv.FormatInt(stripeSessionExpiresAt(order.ExpiresAt, p.now()), 10))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.apiBaseURL+"/v1/checkout/sessions", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+p.secretKey)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Idempotency-Key", order.OrderNo)
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var apiErr stripeErrorResponse
+		if json.Unmarshal(body, &apiErr) == nil && apiErr.Error.Message != "" {
+			return nil, fmt.Errorf("stripe returned status %d: %s", resp.StatusCode, apiErr.Error.Message)
+		}
+		return nil, fmt.Errorf("stripe returned status %d", resp.StatusCode)
+	}
+	var session stripeCheckoutSession
+	if err := json.Unmarshal(body, &session); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	if session.URL == "" {
+		return nil, fmt.Errorf("stripe response missing checkout url")
+	}
+	return &service.PaymentCheckout{PayURL: session.URL, ProviderTradeNo: session.ID}, nil
+}
+
+func (p *stripeProvider) VerifyNotification(_ context.Context, n *service.PaymentNotification) (*service.PaymentNotifyResult, error) {
+	if !verifyStripeSignature(n.Header.Get(stripeSignatureHeader), n.Body, p.webhookSecret, p.now()) {
+		return nil, service.ErrPaymentSignatureInvalid
+	}
+	var event stripeEvent
+	if err := json.Unmarshal(n.Body, &event); err != nil {
+		return nil, fmt.Errorf("decode stripe event: %w", err)
+	}
+	session := event.Data.Object
+	result := &service.PaymentNotifyResult{
+		OrderNo:         session.ClientReferenceID,
+		ProviderTradeNo: session.ID,
+		Amount:          float64(session.AmountTotal) / 100,
+	}
+	switch event.Type {
+	case "checkout.session.completed", "checkout.session.async_payment_succeeded":
+		// 异步支付方式在 completed 时仍为 unpaid，需等待 async_payment_succeeded
+		result.Paid = session.PaymentStatus == "paid"
+	}
+	return result, nil
+}
+
+func (p *stripeProvider) NotifyAck() (string, []byte) {
+	return "application/json", []byte(`{"received":true}`)
+}
+
+// verifyStripeSignature 校验 Stripe-Signature 头（可能包含多个 v1 签名，任一匹配即通过）
+func verifyStripeSignature(header string, body []byte, secret string, now time.Time) bool {
+	var timestamp string
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch k {
+		case "t":
+			timestamp = v
+		case "v1":
+			signatures = append(signatures, v)
+		}
+	}
+	if timestamp == "" || len(signatures) == 0 {
+		return false
+	}
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	if now.Sub(time.Unix(ts, 0)).Abs() > stripeSignatureTolerance {
+		return false
+	}
+	expected := stripeSign(timestamp, body, secret)
+	for _, sig := range signatures {
+		if hmac.Equal([]byte(sig), []byte(expected)) {
+			return true
+		}
+	}
+	return false
+}
+
+func stripeSign(timestamp string, body []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// stripeMinorUnits 转换为最小货币单位（分）；零小数位货币（如 JPY）需按渠道要求另行配置
+func stripeMinorUnits(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
+
+// stripeSessionExpiresAt Checkout Session 有效期须在 30 分钟到 24 小时之间
+func stripeSessionExpiresAt(expiresAt, now time.Time) int64 {
+	minExpires := now.Add(30 * time.Minute)
+	maxExpires := now.Add(24 * time.Hour)
+	if expiresAt.Before(minExpires) {
+		expiresAt = minExpires
+	}
+	if expiresAt.After(maxExpires) {
+		expiresAt = maxExpires
+	}
+	return expiresAt.Unix()
+}
@@ -0,0 +1,252 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/lib/pq"
+)
+
+const accountCostColumns = `c.id, c.account_id, COALESCE(a.name, ''), c.monthly_price, c.purchased_at, c.ended_at,
+	c.notes, c.created_at, c.updated_at`
+
+type profitRepository struct {
+	sql sqlExecutor
+}
+
+func NewProfitRepository(sqlDB *sql.DB) service.ProfitRepository {
+	return &profitRepository{sql: sqlDB}
+}
+
+func (r *profitRepository) CreateAccountCost(ctx context.Context, cost *service.AccountCost) error {
+	query := `
+		INSERT INTO account_costs (account_id, monthly_price, purchased_at, ended_at, notes)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at, updated_at
+	`
+	args := []any{cost.AccountID, cost.MonthlyPrice, cost.PurchasedAt, nullTimePtr(cost.EndedAt), cost.Notes}
+	return scanSingleRow(ctx, r.sql, query, args, &cost.ID, &cost.CreatedAt, &cost.UpdatedAt)
+}
+
+func (r *profitRepository) UpdateAccountCost(ctx context.Context, cost *service.AccountCost) error {
+	query := `
+		UPDATE account_costs
+		SET account_id = $1, monthly_price = $2, purchased_at = $3, ended_at = $4, notes = $5, updated_at = NOW()
+		WHERE id = $6
+		RETURNING updated_at
+	`
+	args := []any{cost.AccountID, cost.MonthlyPrice, cost.PurchasedAt, nullTimePtr(cost.EndedAt), cost.Notes, cost.ID}
+	err := scanSingleRow(ctx, r.sql, query, args, &cost.UpdatedAt)
+	return translatePersistenceError(err, service.ErrAccountCostNotFound, nil)
+}
+
+func (r *profitRepository) DeleteAccountCost(ctx context.Context, id int64) error {
+	res, err := r.sql.ExecContext(ctx, "DELETE FROM account_costs WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return service.ErrAccountCostNotFound
+	}
+	return nil
+}
+
+func (r *profitRepository) GetAccountCost(ctx context.Context, id int64) (*service.AccountCost, error) {
+	items, err := r.queryAccountCosts(ctx, "WHERE c.id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, service.ErrAccountCostNotFound
+	}
+	return &items[0], nil
+}
+
+func (r *profitRepository) ListAccountCosts(ctx context.Context, accountID int64) ([]service.AccountCost, error) {
+	if accountID > 0 {
+		return r.queryAccountCosts(ctx, "WHERE c.account_id = $1 ORDER BY c.purchased_at DESC, c.id DESC", accountID)
+	}
+	return r.queryAccountCosts(ctx, "ORDER BY c.account_id, c.purchased_at DESC, c.id DESC")
+}
+
+func (r *profitRepository) ListAccountCostsInRange(ctx context.Context, start, end time.Time, accountID int64) ([]service.AccountCost, error) {
+	where := "WHERE c.purchased_at < $2 AND (c.ended_at IS NULL OR c.ended_at > $1)"
+	args := []any{start, end}
+	if accountID > 0 {
+		where += " AND c.account_id = $3"
+		args = append(args, accountID)
+	}
+	return r.queryAccountCosts(ctx, where+" ORDER BY c.id", args...)
+}
+
+func (r *profitRepository) ListUsage(ctx context.Context, start, end time.Time, accountID int64, fromAggregates bool) ([]service.ProfitUsageRow, error) {
+	var (
+		query string
+		args  []any
+	)
+	if fromAggregates {
+		// 预聚合表按服务器时区的自然日分桶
+		loc := timezone.Location()
+		query = `
+			SELECT
+				to_char(bucket_date, 'YYYY-MM-DD') AS date,
+				account_id,
+				group_id,
+				model,
+				total_requests,
+				total_cost,
+				account_cost,
+				actual_cost
+			FROM usage_dashboard_profit_daily
+			WHERE bucket_date >= $1::date AND bucket_date < $2::date
+		`
+		args = []any{start.In(loc).Format("2006-01-02"), ceilToDay(end.In(loc)).Format("2006-01-02")}
+	} else {
+		query = `
+			SELECT
+				to_char(created_at AT TIME ZONE $3, 'YYYY-MM-DD') AS date,
+				account_id,
+				COALESCE(group_id, 0) AS group_id,
+				model,
+				COUNT(*) AS total_requests,
+				COALESCE(SUM(total_cost), 0) AS total_cost,
+				COALESCE(SUM(total_cost * COALESCE(account_rate_multiplier, 1)), 0) AS account_cost,
+				COALESCE(SUM(actual_cost), 0) AS actual_cost
+			FROM usage_logs
+			WHERE created_at >= $1 AND created_at < $2
+		`
+		args = []any{start, end, timezone.Name()}
+	}
+	if accountID > 0 {
+		args = append(args, accountID)
+		query += fmt.Sprintf(" AND account_id = $%d", len(args))
+	}
+	if !fromAggregates {
+		query += " GROUP BY 1, 2, 3, 4"
+	}
+
+	rows, err := r.sql.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := make([]service.ProfitUsageRow, 0)
+	for rows.Next() {
+		var row service.ProfitUsageRow
+		if err := rows.Scan(
+			&row.Date,
+			&row.AccountID,
+			&row.GroupID,
+			&row.Model,
+			&row.Requests,
+			&row.TotalCost,
+			&row.AccountCost,
+			&row.ActualCost,
+		); err != nil {
+			return nil, err
+		}
+		out = append(out, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *profitRepository) GetAccountNames(ctx context.Context, ids []int64) (map[int64]string, error) {
+	return r.queryNames(ctx, "SELECT id, name FROM accounts WHERE id = ANY($1)", ids)
+}
+
+func (r *profitRepository) GetGroupNames(ctx context.Context, ids []int64) (map[int64]string, error) {
+	return r.queryNames(ctx, "SELECT id, name FROM groups WHERE id = ANY($1)", ids)
+}
+
+func (r *profitRepository) queryNames(ctx context.Context, query string, ids []int64) (map[int64]string, error) {
+	out := make(map[int64]string, len(ids))
+	if len(ids) == 0 {
+		return out, nil
+	}
+	rows, err := r.sql.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	for rows.Next() {
+		var (
+			id   int64
+			name string
+		)
+		if err := rows.Scan(&id, &name); err != nil {
+			return nil, err
+		}
+		out[id] = name
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *profitRepository) queryAccountCosts(ctx context.Context, clause string, args ...any) ([]service.AccountCost, error) {
+	query := "SELECT " + accountCostColumns + " FROM account_costs c LEFT JOIN accounts a ON a.id = c.account_id " + clause
+	rows, err := r.sql.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := make([]service.AccountCost, 0)
+	for rows.Next() {
+		var (
+			c       service.AccountCost
+			endedAt sql.NullTime
+		)
+		if err := rows.Scan(
+			&c.ID,
+			&c.AccountID,
+			&c.AccountName,
+			&c.MonthlyPrice,
+			&c.PurchasedAt,
+			&endedAt,
+			&c.Notes,
+			&c.CreatedAt,
+			&c.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		if endedAt.Valid {
+			t := endedAt.Time
+			c.EndedAt = &t
+		}
+		out = append(out, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func nullTimePtr(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
+
+// ceilToDay 返回 t 所在自然日的结束边界（t 恰为零点时返回自身）
+func ceilToDay(t time.Time) time.Time {
+	day := truncateToDay(t)
+	if t.After(day) {
+		return day.AddDate(0, 0, 1)
+	}
+	return day
+}
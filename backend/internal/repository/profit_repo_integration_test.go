@@ -0,0 +1,122 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfitRepository_AccountCostsCRUD(t *testing.T) {
+	ctx := context.Background()
+	tx := testEntTx(t)
+	client := tx.Client()
+	repo := &profitRepository{sql: tx}
+
+	account := mustCreateAccount(t, client, &service.Account{Name: "profit-cost-acc"})
+	purchased := time.Date(2001, 3, 1, 0, 0, 0, 0, time.UTC)
+	cost := &service.AccountCost{AccountID: account.ID, MonthlyPrice: 200, PurchasedAt: purchased, Notes: "max plan"}
+	require.NoError(t, repo.CreateAccountCost(ctx, cost))
+	require.NotZero(t, cost.ID)
+
+	got, err := repo.GetAccountCost(ctx, cost.ID)
+	require.NoError(t, err)
+	require.Equal(t, "profit-cost-acc", got.AccountName)
+	require.InDelta(t, 200.0, got.MonthlyPrice, 1e-9)
+	require.Nil(t, got.EndedAt)
+
+	ended := purchased.AddDate(0, 1, 0)
+	got.EndedAt = &ended
+	require.NoError(t, repo.UpdateAccountCost(ctx, got))
+
+	inRange, err := repo.ListAccountCostsInRange(ctx, purchased.AddDate(0, 0, 10), purchased.AddDate(0, 0, 11), account.ID)
+	require.NoError(t, err)
+	require.Len(t, inRange, 1)
+	require.NotNil(t, inRange[0].EndedAt)
+
+	inRange, err = repo.ListAccountCostsInRange(ctx, ended, ended.AddDate(0, 0, 1), account.ID)
+	require.NoError(t, err)
+	require.Empty(t, inRange)
+
+	list, err := repo.ListAccountCosts(ctx, account.ID)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+
+	require.NoError(t, repo.DeleteAccountCost(ctx, cost.ID))
+	_, err = repo.GetAccountCost(ctx, cost.ID)
+	require.ErrorIs(t, err, service.ErrAccountCostNotFound)
+	require.ErrorIs(t, repo.DeleteAccountCost(ctx, cost.ID), service.ErrAccountCostNotFound)
+}
+
+func TestProfitRepository_ListUsageFromAggregates(t *testing.T) {
+	ctx := context.Background()
+	tx := testEntTx(t)
+	client := tx.Client()
+	usageRepo := newUsageLogRepositoryWithSQL(client, tx)
+	aggRepo := newDashboardAggregationRepositoryWithSQL(tx)
+	repo := &profitRepository{sql: tx}
+
+	user := mustCreateUser(t, client, &service.User{Email: "profit-usage@test.com"})
+	apiKey := mustCreateApiKey(t, client, &service.APIKey{UserID: user.ID, Key: "sk-profit-usage", Name: "k"})
+	account := mustCreateAccount(t, client, &service.Account{Name: "profit-usage-acc"})
+	group := mustCreateGroup(t, client, &service.Group{Name: "profit-usage-group"})
+
+	day := time.Date(2001, 3, 5, 0, 0, 0, 0, timezone.Location())
+	multiplier := 2.0
+	logs := []*service.UsageLog{
+		{Model: "claude-3", TotalCost: 1, ActualCost: 1.5, GroupID: &group.ID, AccountRateMultiplier: &multiplier, CreatedAt: day.Add(time.Hour)},
+		{Model: "claude-3", TotalCost: 0.5, ActualCost: 0.8, GroupID: &group.ID, CreatedAt: day.Add(3 * time.Hour)},
+		{Model: "claude-4", TotalCost: 0.2, ActualCost: 0.3, CreatedAt: day.Add(5 * time.Hour)},
+	}
+	for _, l := range logs {
+		l.UserID = user.ID
+		l.APIKeyID = apiKey.ID
+		l.AccountID = account.ID
+		l.RequestID = uuid.New().String()
+		_, err := usageRepo.Create(ctx, l)
+		require.NoError(t, err)
+	}
+
+	start, end := day, day.AddDate(0, 0, 1)
+	require.NoError(t, aggRepo.AggregateRange(ctx, start, end))
+
+	for _, fromAggregates := range []bool{true, false} {
+		rows, err := repo.ListUsage(ctx, start, end, account.ID, fromAggregates)
+		require.NoError(t, err)
+		require.Len(t, rows, 2, "fromAggregates=%v", fromAggregates)
+
+		byModel := map[string]service.ProfitUsageRow{}
+		for _, row := range rows {
+			require.Equal(t, "2001-03-05", row.Date)
+			byModel[row.Model] = row
+		}
+		grouped := byModel["claude-3"]
+		require.Equal(t, group.ID, grouped.GroupID)
+		require.Equal(t, int64(2), grouped.Requests)
+		require.InDelta(t, 1.5, grouped.TotalCost, 1e-9)
+		require.InDelta(t, 2.5, grouped.AccountCost, 1e-9)
+		require.InDelta(t, 2.3, grouped.ActualCost, 1e-9)
+
+		ungrouped := byModel["claude-4"]
+		require.Zero(t, ungrouped.GroupID)
+		require.InDelta(t, 0.2, ungrouped.AccountCost, 1e-9)
+	}
+
+	require.NoError(t, aggRepo.RecomputeRange(ctx, start, end))
+	rows, err := repo.ListUsage(ctx, start, end, account.ID, true)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+
+	names, err := repo.GetAccountNames(ctx, []int64{account.ID})
+	require.NoError(t, err)
+	require.Equal(t, "profit-usage-acc", names[account.ID])
+	groupNames, err := repo.GetGroupNames(ctx, []int64{group.ID})
+	require.NoError(t, err)
+	require.Equal(t, "profit-usage-group", groupNames[group.ID])
+}
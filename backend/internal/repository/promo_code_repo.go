@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	dbent "github.com/Wei-Shaw/sub2api/ent"
 	"github.com/Wei-Shaw/sub2api/ent/promocode"
@@ -23,10 +24,20 @@ func (r *promoCodeRepository) Create(ctx context.Context, code *service.PromoCod
 	builder := client.PromoCode.Create().
 		SetCode(code.Code).
 		SetBonusAmount(code.BonusAmount).
+		SetCreditValidityDays(code.CreditValidityDays).
 		SetMaxUses(code.MaxUses).
 		SetUsedCount(code.UsedCount).
 		SetStatus(code.Status).
-		SetNotes(code.Notes)
+		SetNotes(code.Notes).
+		SetScope(promoCodeScopeOrDefault(code.Scope)).
+		SetBonusPercent(code.BonusPercent).
+		SetNillableMaxBonusAmount(code.MaxBonusAmount).
+		SetNillableTrialGroupID(code.TrialGroupID).
+		SetTrialDays(code.TrialDays).
+		SetNewUserDays(code.NewUserDays).
+		SetAllowedEmailDomains(code.AllowedEmailDomains).
+		SetStackable(code.Stackable).
+		SetMaxUsesPerUser(code.MaxUsesPerUser)
 
 	if code.ExpiresAt != nil {
 		builder.SetExpiresAt(*code.ExpiresAt)
@@ -89,16 +100,34 @@ func (r *promoCodeRepository) Update(ctx context.Context, code *service.PromoCod
 	builder := client.PromoCode.UpdateOneID(code.ID).
 		SetCode(code.Code).
 		SetBonusAmount(code.BonusAmount).
+		SetCreditValidityDays(code.CreditValidityDays).
 		SetMaxUses(code.MaxUses).
 		SetUsedCount(code.UsedCount).
 		SetStatus(code.Status).
-		SetNotes(code.Notes)
+		SetNotes(code.Notes).
+		SetScope(promoCodeScopeOrDefault(code.Scope)).
+		SetBonusPercent(code.BonusPercent).
+		SetTrialDays(code.TrialDays).
+		SetNewUserDays(code.NewUserDays).
+		SetAllowedEmailDomains(code.AllowedEmailDomains).
+		SetStackable(code.Stackable).
+		SetMaxUsesPerUser(code.MaxUsesPerUser)
 
 	if code.ExpiresAt != nil {
 		builder.SetExpiresAt(*code.ExpiresAt)
 	} else {
 		builder.ClearExpiresAt()
 	}
+	if code.MaxBonusAmount != nil {
+		builder.SetMaxBonusAmount(*code.MaxBonusAmount)
+	} else {
+		builder.ClearMaxBonusAmount()
+	}
+	if code.TrialGroupID != nil {
+		builder.SetTrialGroupID(*code.TrialGroupID)
+	} else {
+		builder.ClearTrialGroupID()
+	}
 
 	updated, err := builder.Save(ctx)
 	if err != nil {
@@ -157,6 +186,10 @@ func (r *promoCodeRepository) CreateUsage(ctx context.Context, usage *service.Pr
 		SetPromoCodeID(usage.PromoCodeID).
 		SetUserID(usage.UserID).
 		SetBonusAmount(usage.BonusAmount).
+		SetTopupAmount(usage.TopupAmount).
+		SetNillablePaymentOrderID(usage.PaymentOrderID).
+		SetNillableTrialGroupID(usage.TrialGroupID).
+		SetTrialDays(usage.TrialDays).
 		SetUsedAt(usage.UsedAt).
 		Save(ctx)
 	if err != nil {
@@ -167,20 +200,14 @@ func (r *promoCodeRepository) CreateUsage(ctx context.Context, usage *service.Pr
 	return nil
 }
 
-func (r *promoCodeRepository) GetUsageByPromoCodeAndUser(ctx context.Context, promoCodeID, userID int64) (*service.PromoCodeUsage, error) {
-	m, err := r.client.PromoCodeUsage.Query().
+func (r *promoCodeRepository) CountUsagesByPromoCodeAndUser(ctx context.Context, promoCodeID, userID int64) (int, error) {
+	client := clientFromContext(ctx, r.client)
+	return client.PromoCodeUsage.Query().
 		Where(
 			promocodeusage.PromoCodeIDEQ(promoCodeID),
 			promocodeusage.UserIDEQ(userID),
 		).
-		Only(ctx)
-	if err != nil {
-		if dbent.IsNotFound(err) {
-			return nil, nil
-		}
-		return nil, err
-	}
-	return promoCodeUsageEntityToService(m), nil
+		Count(ctx)
 }
 
 func (r *promoCodeRepository) ListUsagesByPromoCode(ctx context.Context, promoCodeID int64, params pagination.PaginationParams) ([]service.PromoCodeUsage, *pagination.PaginationResult, error) {
@@ -207,6 +234,50 @@ func (r *promoCodeRepository) ListUsagesByPromoCode(ctx context.Context, promoCo
 	return outUsages, paginationResultFromTotal(int64(total), params), nil
 }
 
+func (r *promoCodeRepository) UsageReport(ctx context.Context, start, end time.Time) ([]service.PromoCodeReportItem, error) {
+	rows, err := r.client.QueryContext(ctx, `
+		SELECT p.id, p.code, p.scope,
+			COUNT(*),
+			COUNT(DISTINCT u.user_id),
+			COALESCE(SUM(u.bonus_amount), 0),
+			COALESCE(SUM(u.topup_amount), 0),
+			COUNT(*) FILTER (WHERE u.trial_days > 0),
+			COALESCE(SUM(u.trial_days), 0)
+		FROM promo_code_usages u
+		JOIN promo_codes p ON p.id = u.promo_code_id
+		WHERE u.used_at >= $1 AND u.used_at < $2
+		GROUP BY p.id, p.code, p.scope
+		ORDER BY COUNT(*) DESC, p.id DESC
+	`, start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := make([]service.PromoCodeReportItem, 0)
+	for rows.Next() {
+		var item service.PromoCodeReportItem
+		if err := rows.Scan(
+			&item.PromoCodeID,
+			&item.Code,
+			&item.Scope,
+			&item.Uses,
+			&item.Users,
+			&item.BonusAmount,
+			&item.TopupAmount,
+			&item.TrialUses,
+			&item.TrialDays,
+		); err != nil {
+			return nil, err
+		}
+		out = append(out, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (r *promoCodeRepository) IncrementUsedCount(ctx context.Context, id int64) error {
 	client := clientFromContext(ctx, r.client)
 	_, err := client.PromoCode.UpdateOneID(id).
@@ -232,7 +303,26 @@ func promoCodeEntityToService(m *dbent.PromoCode) *service.PromoCode {
 		Notes:       derefString(m.Notes),
 		CreatedAt:   m.CreatedAt,
 		UpdatedAt:   m.UpdatedAt,
+
+		CreditValidityDays: m.CreditValidityDays,
+
+		Scope:               m.Scope,
+		BonusPercent:        m.BonusPercent,
+		MaxBonusAmount:      m.MaxBonusAmount,
+		TrialGroupID:        m.TrialGroupID,
+		TrialDays:           m.TrialDays,
+		NewUserDays:         m.NewUserDays,
+		AllowedEmailDomains: m.AllowedEmailDomains,
+		Stackable:           m.Stackable,
+		MaxUsesPerUser:      m.MaxUsesPerUser,
+	}
+}
+
+func promoCodeScopeOrDefault(scope string) string {
+	if scope == "" {
+		return service.PromoCodeScopeRegister
 	}
+	return scope
 }
 
 func promoCodeEntitiesToService(models []*dbent.PromoCode) []service.PromoCode {
@@ -255,6 +345,11 @@ func promoCodeUsageEntityToService(m *dbent.PromoCodeUsage) *service.PromoCodeUs
 		UserID:      m.UserID,
 		BonusAmount: m.BonusAmount,
 		UsedAt:      m.UsedAt,
+
+		TopupAmount:    m.TopupAmount,
+		PaymentOrderID: m.PaymentOrderID,
+		TrialGroupID:   m.TrialGroupID,
+		TrialDays:      m.TrialDays,
 	}
 	if m.Edges.User != nil {
 		out.User = userEntityToService(m.Edges.User)
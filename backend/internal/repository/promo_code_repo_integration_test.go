@@ -0,0 +1,85 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromoCodeRepository_RulesUsagesAndReport(t *testing.T) {
+	ctx := context.Background()
+	tx := testEntTx(t)
+	client := tx.Client()
+	repo := NewPromoCodeRepository(client)
+
+	user, err := client.User.Create().
+		SetEmail(uniqueTestValue(t, "promo") + "@example.com").
+		SetPasswordHash("test-password-hash").
+		Save(ctx)
+	require.NoError(t, err)
+
+	maxBonus := 20.0
+	code := &service.PromoCode{
+		Code:                "IT-TOPUP-RULES",
+		Status:              service.PromoCodeStatusActive,
+		Scope:               service.PromoCodeScopeTopup,
+		BonusPercent:        10,
+		MaxBonusAmount:      &maxBonus,
+		NewUserDays:         7,
+		AllowedEmailDomains: []string{"example.com"},
+		Stackable:           true,
+		MaxUsesPerUser:      2,
+	}
+	require.NoError(t, repo.Create(ctx, code))
+
+	got, err := repo.GetByID(ctx, code.ID)
+	require.NoError(t, err)
+	require.Equal(t, service.PromoCodeScopeTopup, got.Scope)
+	require.InDelta(t, 10.0, got.BonusPercent, 1e-9)
+	require.NotNil(t, got.MaxBonusAmount)
+	require.InDelta(t, 20.0, *got.MaxBonusAmount, 1e-9)
+	require.Equal(t, []string{"example.com"}, got.AllowedEmailDomains)
+	require.True(t, got.Stackable)
+	require.Equal(t, 2, got.MaxUsesPerUser)
+
+	got.MaxBonusAmount = nil
+	require.NoError(t, repo.Update(ctx, got))
+	got, err = repo.GetByID(ctx, code.ID)
+	require.NoError(t, err)
+	require.Nil(t, got.MaxBonusAmount)
+
+	// 同一用户可多次使用（次数由 max_uses_per_user 控制）
+	now := time.Now()
+	for _, topup := range []float64{50, 30} {
+		require.NoError(t, repo.CreateUsage(ctx, &service.PromoCodeUsage{
+			PromoCodeID: code.ID,
+			UserID:      user.ID,
+			BonusAmount: topup / 10,
+			TopupAmount: topup,
+			UsedAt:      now,
+		}))
+	}
+	count, err := repo.CountUsagesByPromoCodeAndUser(ctx, code.ID, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	report, err := repo.UsageReport(ctx, now.Add(-time.Hour), now.Add(time.Hour))
+	require.NoError(t, err)
+	var item *service.PromoCodeReportItem
+	for i := range report {
+		if report[i].PromoCodeID == code.ID {
+			item = &report[i]
+		}
+	}
+	require.NotNil(t, item)
+	require.Equal(t, int64(2), item.Uses)
+	require.Equal(t, int64(1), item.Users)
+	require.InDelta(t, 8.0, item.BonusAmount, 1e-9)
+	require.InDelta(t, 80.0, item.TopupAmount, 1e-9)
+	require.Equal(t, service.PromoCodeScopeTopup, item.Scope)
+}
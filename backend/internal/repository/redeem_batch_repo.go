@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+// redeemBatchSelect 批次字段 + 兑换码统计
+const redeemBatchSelect = `
+	SELECT b.id, b.name, b.channel, b.type, b.status, COALESCE(b.notes, ''), b.created_at, b.updated_at,
+		COALESCE(s.total_codes, 0), COALESCE(s.unused_codes, 0), COALESCE(s.used_codes, 0), COALESCE(s.disabled_codes, 0),
+		COALESCE(s.redemptions, 0), COALESCE(s.redeemed_value, 0),
+		(SELECT COUNT(DISTINCT r.user_id)
+			FROM redeem_code_redemptions r
+			JOIN redeem_codes rc ON rc.id = r.redeem_code_id
+			WHERE rc.batch_id = b.id)
+	FROM redeem_code_batches b
+	LEFT JOIN LATERAL (
+		SELECT COUNT(*) AS total_codes,
+			COUNT(*) FILTER (WHERE c.status = 'unused') AS unused_codes,
+			COUNT(*) FILTER (WHERE c.status = 'used') AS used_codes,
+			COUNT(*) FILTER (WHERE c.status IN ('disabled', 'expired')) AS disabled_codes,
+			SUM(c.redeemed_count) AS redemptions,
+			SUM(c.value * c.redeemed_count) AS redeemed_value
+		FROM redeem_codes c
+		WHERE c.batch_id = b.id
+	) s ON TRUE`
+
+type redeemBatchRepository struct {
+	sql sqlExecutor
+}
+
+func NewRedeemBatchRepository(sqlDB *sql.DB) service.RedeemBatchRepository {
+	return &redeemBatchRepository{sql: sqlDB}
+}
+
+func (r *redeemBatchRepository) Create(ctx context.Context, batch *service.RedeemBatch) error {
+	query := `
+		INSERT INTO redeem_code_batches (name, channel, type, status, notes)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at, updated_at
+	`
+	args := []any{batch.Name, batch.Channel, batch.Type, batch.Status, batch.Notes}
+	return scanSingleRow(ctx, sqlExecutorFromContext(ctx, r.sql), query, args, &batch.ID, &batch.CreatedAt, &batch.UpdatedAt)
+}
+
+func (r *redeemBatchRepository) GetByID(ctx context.Context, id int64) (*service.RedeemBatch, error) {
+	items, err := r.query(ctx, redeemBatchSelect+" WHERE b.id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, service.ErrRedeemBatchNotFound
+	}
+	return &items[0], nil
+}
+
+func (r *redeemBatchRepository) Update(ctx context.Context, batch *service.RedeemBatch) error {
+	err := scanSingleRow(ctx, sqlExecutorFromContext(ctx, r.sql), `
+		UPDATE redeem_code_batches SET name = $2, channel = $3, notes = $4, updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at
+	`, []any{batch.ID, batch.Name, batch.Channel, batch.Notes}, &batch.UpdatedAt)
+	return translatePersistenceError(err, service.ErrRedeemBatchNotFound, nil)
+}
+
+func (r *redeemBatchRepository) List(ctx context.Context, params pagination.PaginationParams, filters service.RedeemBatchFilters) ([]service.RedeemBatch, *pagination.PaginationResult, error) {
+	conditions := []string{"1 = 1"}
+	args := []any{}
+	add := func(cond string, v any) {
+		args = append(args, v)
+		conditions = append(conditions, fmt.Sprintf(cond, len(args)))
+	}
+	if filters.Channel != "" {
+		add("b.channel = $%d", filters.Channel)
+	}
+	if filters.Type != "" {
+		add("b.type = $%d", filters.Type)
+	}
+	if filters.Status != "" {
+		add("b.status = $%d", filters.Status)
+	}
+	if filters.Search != "" {
+		add("b.name ILIKE $%d", "%"+filters.Search+"%")
+	}
+	where := strings.Join(conditions, " AND ")
+
+	var total int64
+	if err := scanSingleRow(ctx, r.sql, "SELECT COUNT(*) FROM redeem_code_batches b WHERE "+where, args, &total); err != nil {
+		return nil, nil, err
+	}
+	if total == 0 {
+		return []service.RedeemBatch{}, paginationResultFromTotal(0, params), nil
+	}
+
+	query := fmt.Sprintf("%s WHERE %s ORDER BY b.id DESC LIMIT $%d OFFSET $%d", redeemBatchSelect, where, len(args)+1, len(args)+2)
+	items, err := r.query(ctx, query, append(args, params.Limit(), params.Offset())...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return items, paginationResultFromTotal(total, params), nil
+}
+
+// Disable 单条语句停用批次及其中未使用的兑换码
+func (r *redeemBatchRepository) Disable(ctx context.Context, id int64) (int64, error) {
+	res, err := sqlExecutorFromContext(ctx, r.sql).ExecContext(ctx, `
+		WITH batch AS (
+			UPDATE redeem_code_batches SET status = $2, updated_at = NOW()
+			WHERE id = $1
+			RETURNING id
+		)
+		UPDATE redeem_codes SET status = $2
+		WHERE batch_id IN (SELECT id FROM batch) AND status = $3
+	`, id, service.StatusDisabled, service.StatusUnused)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+func (r *redeemBatchRepository) query(ctx context.Context, query string, args ...any) ([]service.RedeemBatch, error) {
+	rows, err := sqlExecutorFromContext(ctx, r.sql).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := make([]service.RedeemBatch, 0)
+	for rows.Next() {
+		var b service.RedeemBatch
+		if err := rows.Scan(
+			&b.ID,
+			&b.Name,
+			&b.Channel,
+			&b.Type,
+			&b.Status,
+			&b.Notes,
+			&b.CreatedAt,
+			&b.UpdatedAt,
+			&b.Stats.TotalCodes,
+			&b.Stats.UnusedCodes,
+			&b.Stats.UsedCodes,
+			&b.Stats.DisabledCodes,
+			&b.Stats.Redemptions,
+			&b.Stats.RedeemedValue,
+			&b.Stats.RedeemedUsers,
+		); err != nil {
+			return nil, err
+		}
+		out = append(out, b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,83 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedeemBatchRepository_CreateStatsAndDisable(t *testing.T) {
+	ctx := context.Background()
+	client := testEntClient(t)
+	repo := NewRedeemBatchRepository(integrationDB)
+	codeRepo := NewRedeemCodeRepository(client)
+
+	suffix := time.Now().UnixNano()
+	user := mustCreateUser(t, client, &service.User{Email: fmt.Sprintf("batch-%d@example.com", suffix)})
+
+	batch := &service.RedeemBatch{
+		Name:    fmt.Sprintf("batch-%d", suffix),
+		Channel: "partner",
+		Type:    service.RedeemTypeBalance,
+		Status:  service.StatusActive,
+	}
+	require.NoError(t, repo.Create(ctx, batch))
+	require.NotZero(t, batch.ID)
+	t.Cleanup(func() {
+		_, _ = integrationDB.ExecContext(ctx, "DELETE FROM redeem_codes WHERE batch_id = $1", batch.ID)
+		_, _ = integrationDB.ExecContext(ctx, "DELETE FROM redeem_code_batches WHERE id = $1", batch.ID)
+		_, _ = integrationDB.ExecContext(ctx, "DELETE FROM users WHERE id = $1", user.ID)
+	})
+
+	codes := make([]service.RedeemCode, 3)
+	for i := range codes {
+		codes[i] = service.RedeemCode{
+			Code:           fmt.Sprintf("B%d-%d", suffix%1e9, i),
+			Type:           service.RedeemTypeBalance,
+			Value:          10,
+			Status:         service.StatusUnused,
+			BatchID:        &batch.ID,
+			MaxRedemptions: 5,
+		}
+	}
+	require.NoError(t, codeRepo.CreateBatch(ctx, codes))
+	require.NoError(t, codeRepo.Use(ctx, codes[0].ID, user.ID))
+
+	got, err := repo.GetByID(ctx, batch.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), got.Stats.TotalCodes)
+	require.Equal(t, int64(3), got.Stats.UnusedCodes)
+	require.Equal(t, int64(1), got.Stats.Redemptions)
+	require.Equal(t, int64(1), got.Stats.RedeemedUsers)
+	require.InDelta(t, 10.0, got.Stats.RedeemedValue, 1e-9)
+
+	list, result, err := repo.List(ctx, pagination.PaginationParams{Page: 1, PageSize: 20}, service.RedeemBatchFilters{Search: batch.Name})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), result.Total)
+	require.Equal(t, batch.ID, list[0].ID)
+
+	inBatch, result, err := codeRepo.ListByBatch(ctx, batch.ID, pagination.PaginationParams{Page: 1, PageSize: 20})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), result.Total)
+	require.Len(t, inBatch, 3)
+
+	disabled, err := repo.Disable(ctx, batch.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), disabled)
+
+	got, err = repo.GetByID(ctx, batch.ID)
+	require.NoError(t, err)
+	require.Equal(t, service.StatusDisabled, got.Status)
+	require.Equal(t, int64(3), got.Stats.DisabledCodes)
+	require.Equal(t, int64(1), got.Stats.Redemptions, "已兑换记录不受停用影响")
+
+	_, err = repo.GetByID(ctx, batch.ID+1_000_000)
+	require.ErrorIs(t, err, service.ErrRedeemBatchNotFound)
+}
@@ -2,14 +2,21 @@ package repository
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	dbent "github.com/Wei-Shaw/sub2api/ent"
+	dbpredicate "github.com/Wei-Shaw/sub2api/ent/predicate"
 	"github.com/Wei-Shaw/sub2api/ent/redeemcode"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
 	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	entsql "entgo.io/ent/dialect/sql"
 )
 
+// redeemCodeRedeemedAtColumn ListByUser 附加查询的本人兑换时间列
+const redeemCodeRedeemedAtColumn = "redeemed_at"
+
 type redeemCodeRepository struct {
 	client *dbent.Client
 }
@@ -26,13 +33,18 @@ func (r *redeemCodeRepository) Create(ctx context.Context, code *service.RedeemC
 		SetStatus(code.Status).
 		SetNotes(code.Notes).
 		SetValidityDays(code.ValidityDays).
+		SetCreditValidityDays(code.CreditValidityDays).
 		SetNillableUsedBy(code.UsedBy).
 		SetNillableUsedAt(code.UsedAt).
 		SetNillableGroupID(code.GroupID).
+		SetNillableBatchID(code.BatchID).
+		SetMaxRedemptions(normalizeMaxRedemptions(code.MaxRedemptions)).
+		SetRedeemedCount(code.RedeemedCount).
 		Save(ctx)
 	if err == nil {
 		code.ID = created.ID
 		code.CreatedAt = created.CreatedAt
+		code.MaxRedemptions = created.MaxRedemptions
 	}
 	return err
 }
@@ -42,23 +54,37 @@ func (r *redeemCodeRepository) CreateBatch(ctx context.Context, codes []service.
 		return nil
 	}
 
+	client := clientFromContext(ctx, r.client)
 	builders := make([]*dbent.RedeemCodeCreate, 0, len(codes))
 	for i := range codes {
 		c := &codes[i]
-		b := r.client.RedeemCode.Create().
+		b := client.RedeemCode.Create().
 			SetCode(c.Code).
 			SetType(c.Type).
 			SetValue(c.Value).
 			SetStatus(c.Status).
 			SetNotes(c.Notes).
 			SetValidityDays(c.ValidityDays).
+			SetCreditValidityDays(c.CreditValidityDays).
 			SetNillableUsedBy(c.UsedBy).
 			SetNillableUsedAt(c.UsedAt).
-			SetNillableGroupID(c.GroupID)
+			SetNillableGroupID(c.GroupID).
+			SetNillableBatchID(c.BatchID).
+			SetMaxRedemptions(normalizeMaxRedemptions(c.MaxRedemptions)).
+			SetRedeemedCount(c.RedeemedCount)
 		builders = append(builders, b)
 	}
 
-	return r.client.RedeemCode.CreateBulk(builders...).Exec(ctx)
+	created, err := client.RedeemCode.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range created {
+		codes[i].ID = created[i].ID
+		codes[i].CreatedAt = created[i].CreatedAt
+		codes[i].MaxRedemptions = created[i].MaxRedemptions
+	}
+	return nil
 }
 
 func (r *redeemCodeRepository) GetByID(ctx context.Context, id int64) (*service.RedeemCode, error) {
@@ -137,7 +163,9 @@ func (r *redeemCodeRepository) Update(ctx context.Context, code *service.RedeemC
 		SetValue(code.Value).
 		SetStatus(code.Status).
 		SetNotes(code.Notes).
-		SetValidityDays(code.ValidityDays)
+		SetValidityDays(code.ValidityDays).
+		SetCreditValidityDays(code.CreditValidityDays).
+		SetMaxRedemptions(normalizeMaxRedemptions(code.MaxRedemptions))
 
 	if code.UsedBy != nil {
 		up.SetUsedBy(*code.UsedBy)
@@ -166,22 +194,67 @@ func (r *redeemCodeRepository) Update(ctx context.Context, code *service.RedeemC
 	return nil
 }
 
+// Use 记录一次兑换：兑换次数 +1（达到上限时标记为 used），并写入兑换记录。
+// 单条语句完成，status/次数条件作为乐观锁；used_by/used_at 记录最近一次兑换。
 func (r *redeemCodeRepository) Use(ctx context.Context, id, userID int64) error {
-	now := time.Now()
 	client := clientFromContext(ctx, r.client)
-	affected, err := client.RedeemCode.Update().
-		Where(redeemcode.IDEQ(id), redeemcode.StatusEQ(service.StatusUnused)).
-		SetStatus(service.StatusUsed).
-		SetUsedBy(userID).
-		SetUsedAt(now).
-		Save(ctx)
+	res, err := client.ExecContext(ctx, `
+		WITH used AS (
+			UPDATE redeem_codes
+			SET redeemed_count = redeemed_count + 1,
+				status = CASE WHEN redeemed_count + 1 >= max_redemptions THEN $3 ELSE status END,
+				used_by = $2,
+				used_at = NOW()
+			WHERE id = $1
+				AND status = $4
+				AND redeemed_count < max_redemptions
+				AND NOT EXISTS (
+					SELECT 1 FROM redeem_code_redemptions
+					WHERE redeem_code_id = $1 AND user_id = $2
+				)
+			RETURNING id
+		)
+		INSERT INTO redeem_code_redemptions (redeem_code_id, user_id)
+		SELECT id, $2 FROM used
+	`, id, userID, service.StatusUsed, service.StatusUnused)
+	if err != nil {
+		return translatePersistenceError(err, nil, service.ErrRedeemCodeAlreadyRedeemed)
+	}
+	affected, err := res.RowsAffected()
 	if err != nil {
 		return err
 	}
-	if affected == 0 {
-		return service.ErrRedeemCodeUsed
+	if affected > 0 {
+		return nil
 	}
-	return nil
+
+	// 区分「已兑换过」与「已用完/不可用」
+	var status string
+	var redeemed bool
+	rows, err := client.QueryContext(ctx, `
+		SELECT c.status, EXISTS (
+			SELECT 1 FROM redeem_code_redemptions r
+			WHERE r.redeem_code_id = c.id AND r.user_id = $2
+		)
+		FROM redeem_codes c
+		WHERE c.id = $1
+	`, id, userID)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rows.Close() }()
+	if rows.Next() {
+		if err := rows.Scan(&status, &redeemed); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if status == service.StatusUnused && redeemed {
+		return service.ErrRedeemCodeAlreadyRedeemed
+	}
+	return service.ErrRedeemCodeUsed
 }
 
 func (r *redeemCodeRepository) ListByUser(ctx context.Context, userID int64, limit int) ([]service.RedeemCode, error) {
@@ -189,17 +262,74 @@ func (r *redeemCodeRepository) ListByUser(ctx context.Context, userID int64, lim
 		limit = 10
 	}
 
+	// 多次使用兑换码的 used_by 只记录最近一次兑换，需关联本人的兑换记录查询；
+	// 兑换时间取兑换记录，无兑换记录（如管理员调整）时回退为 used_at
 	codes, err := r.client.RedeemCode.Query().
-		Where(redeemcode.UsedByEQ(userID)).
+		Where(dbpredicate.RedeemCode(func(s *entsql.Selector) {
+			t := entsql.Table("redeem_code_redemptions").As("r")
+			s.LeftJoin(t).OnP(entsql.And(
+				entsql.ColumnsEQ(t.C("redeem_code_id"), s.C(redeemcode.FieldID)),
+				entsql.EQ(t.C("user_id"), userID),
+			))
+			s.Where(entsql.Or(
+				entsql.EQ(s.C(redeemcode.FieldUsedBy), userID),
+				entsql.NotNull(t.C("id")),
+			))
+			s.AppendSelect(entsql.As(fmt.Sprintf("COALESCE(%s, %s)", t.C("created_at"), s.C(redeemcode.FieldUsedAt)), redeemCodeRedeemedAtColumn))
+		})).
 		WithGroup().
-		Order(dbent.Desc(redeemcode.FieldUsedAt)).
+		Order(func(s *entsql.Selector) {
+			s.OrderExpr(entsql.Expr(redeemCodeRedeemedAtColumn + " DESC NULLS LAST"))
+		}).
 		Limit(limit).
 		All(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return redeemCodeEntitiesToService(codes), nil
+	out := make([]service.RedeemCode, 0, len(codes))
+	for _, code := range codes {
+		item := redeemCodeEntityToService(code)
+		if item.IsMultiUse() {
+			// 用户视角：兑换人与兑换时间为本人
+			uid := userID
+			item.UsedBy = &uid
+			if v, err := code.GetValue(redeemCodeRedeemedAtColumn); err == nil {
+				if at, ok := v.(time.Time); ok {
+					item.UsedAt = &at
+				}
+			}
+		}
+		out = append(out, *item)
+	}
+	return out, nil
+}
+
+// ListByBatch 批次内的兑换码
+func (r *redeemCodeRepository) ListByBatch(ctx context.Context, batchID int64, params pagination.PaginationParams) ([]service.RedeemCode, *pagination.PaginationResult, error) {
+	q := r.client.RedeemCode.Query().Where(redeemcode.BatchIDEQ(batchID))
+	total, err := q.Count(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	codes, err := q.
+		WithUser().
+		WithGroup().
+		Offset(params.Offset()).
+		Limit(params.Limit()).
+		Order(dbent.Asc(redeemcode.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return redeemCodeEntitiesToService(codes), paginationResultFromTotal(int64(total), params), nil
+}
+
+func normalizeMaxRedemptions(v int) int {
+	if v <= 0 {
+		return 1
+	}
+	return v
 }
 
 func redeemCodeEntityToService(m *dbent.RedeemCode) *service.RedeemCode {
@@ -218,6 +348,12 @@ func redeemCodeEntityToService(m *dbent.RedeemCode) *service.RedeemCode {
 		CreatedAt:    m.CreatedAt,
 		GroupID:      m.GroupID,
 		ValidityDays: m.ValidityDays,
+
+		CreditValidityDays: m.CreditValidityDays,
+
+		BatchID:        m.BatchID,
+		MaxRedemptions: m.MaxRedemptions,
+		RedeemedCount:  m.RedeemedCount,
 	}
 	if m.Edges.User != nil {
 		out.User = userEntityToService(m.Edges.User)
@@ -258,6 +258,53 @@ func (s *RedeemCodeRepoSuite) TestUse_AlreadyUsed() {
 	s.Require().ErrorIs(err, service.ErrRedeemCodeUsed)
 }
 
+func (s *RedeemCodeRepoSuite) TestUse_MultiUse() {
+	u1 := s.createUser(uniqueTestValue(s.T(), "multi1") + "@example.com")
+	u2 := s.createUser(uniqueTestValue(s.T(), "multi2") + "@example.com")
+	u3 := s.createUser(uniqueTestValue(s.T(), "multi3") + "@example.com")
+	code := &service.RedeemCode{Code: "MULTI-USE", Type: service.RedeemTypeBalance, Value: 5, Status: service.StatusUnused, MaxRedemptions: 2}
+	s.Require().NoError(s.repo.Create(s.ctx, code))
+
+	s.Require().NoError(s.repo.Use(s.ctx, code.ID, u1.ID), "first user")
+
+	got, err := s.repo.GetByID(s.ctx, code.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(service.StatusUnused, got.Status, "still usable after first redemption")
+	s.Require().Equal(1, got.RedeemedCount)
+
+	// 同一用户不能重复兑换
+	err = s.repo.Use(s.ctx, code.ID, u1.ID)
+	s.Require().ErrorIs(err, service.ErrRedeemCodeAlreadyRedeemed)
+
+	s.Require().NoError(s.repo.Use(s.ctx, code.ID, u2.ID), "second user")
+	got, err = s.repo.GetByID(s.ctx, code.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(service.StatusUsed, got.Status, "exhausted after max redemptions")
+	s.Require().Equal(2, got.RedeemedCount)
+
+	err = s.repo.Use(s.ctx, code.ID, u3.ID)
+	s.Require().ErrorIs(err, service.ErrRedeemCodeUsed)
+
+	// 每个兑换过的用户都能在历史中看到该兑换码
+	codes, err := s.repo.ListByUser(s.ctx, u1.ID, 10)
+	s.Require().NoError(err)
+	s.Require().Len(codes, 1)
+	s.Require().Equal("MULTI-USE", codes[0].Code)
+	s.Require().NotNil(codes[0].UsedBy)
+	s.Require().Equal(u1.ID, *codes[0].UsedBy)
+
+	// 兑换时间取本人的兑换记录，而非最近一次兑换的 used_at
+	redeemedAt := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	_, err = s.client.ExecContext(s.ctx,
+		"UPDATE redeem_code_redemptions SET created_at = $1 WHERE redeem_code_id = $2 AND user_id = $3", redeemedAt, code.ID, u1.ID)
+	s.Require().NoError(err)
+	codes, err = s.repo.ListByUser(s.ctx, u1.ID, 10)
+	s.Require().NoError(err)
+	s.Require().Len(codes, 1)
+	s.Require().NotNil(codes[0].UsedAt)
+	s.Require().WithinDuration(redeemedAt, *codes[0].UsedAt, time.Microsecond)
+}
+
 // --- ListByUser ---
 
 func (s *RedeemCodeRepoSuite) TestListByUser() {
@@ -0,0 +1,356 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/lib/pq"
+)
+
+const referralSelect = `
+	SELECT r.id, r.referrer_id, r.referee_id, r.commission_rate, r.commission_cap_usd, r.expires_at,
+		r.total_spend, r.total_commission, r.settled_until, r.created_at,
+		COALESCE(u.email, '')
+	FROM referrals r
+	LEFT JOIN users u ON u.id = r.referee_id`
+
+const referralCommissionSelect = `
+	SELECT c.id, c.referral_id, c.referrer_id, c.referee_id, c.period_start, c.period_end,
+		c.spend, c.commission_rate, c.amount, c.created_at,
+		COALESCE(ru.email, ''), COALESCE(eu.email, '')
+	FROM referral_commissions c
+	LEFT JOIN users ru ON ru.id = c.referrer_id
+	LEFT JOIN users eu ON eu.id = c.referee_id`
+
+type referralRepository struct {
+	sql sqlExecutor
+}
+
+func NewReferralRepository(sqlDB *sql.DB) service.ReferralRepository {
+	return &referralRepository{sql: sqlDB}
+}
+
+func (r *referralRepository) GetCodeByUserID(ctx context.Context, userID int64) (string, error) {
+	var code string
+	err := scanSingleRow(ctx, r.sql, "SELECT code FROM referral_codes WHERE user_id = $1", []any{userID}, &code)
+	return code, translatePersistenceError(err, service.ErrReferralCodeNotFound, nil)
+}
+
+func (r *referralRepository) CreateCode(ctx context.Context, userID int64, code string) error {
+	_, err := r.sql.ExecContext(ctx, "INSERT INTO referral_codes (user_id, code) VALUES ($1, $2)", userID, code)
+	return translatePersistenceError(err, nil, service.ErrReferralCodeConflict)
+}
+
+func (r *referralRepository) GetUserIDByCode(ctx context.Context, code string) (int64, error) {
+	var userID int64
+	err := scanSingleRow(ctx, r.sql, "SELECT user_id FROM referral_codes WHERE code = $1", []any{code}, &userID)
+	return userID, translatePersistenceError(err, service.ErrReferralCodeNotFound, nil)
+}
+
+func (r *referralRepository) Create(ctx context.Context, referral *service.Referral) error {
+	query := `
+		INSERT INTO referrals (referrer_id, referee_id, commission_rate, commission_cap_usd, expires_at, settled_until)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at
+	`
+	args := []any{
+		referral.ReferrerID,
+		referral.RefereeID,
+		referral.CommissionRate,
+		nullFloat64(referral.CommissionCapUSD),
+		referral.ExpiresAt,
+		referral.SettledUntil,
+	}
+	err := scanSingleRow(ctx, sqlExecutorFromContext(ctx, r.sql), query, args, &referral.ID, &referral.CreatedAt)
+	return translatePersistenceError(err, nil, service.ErrReferralExists)
+}
+
+func (r *referralRepository) GetByRefereeID(ctx context.Context, refereeID int64) (*service.Referral, error) {
+	items, err := r.queryReferrals(ctx, sqlExecutorFromContext(ctx, r.sql), referralSelect+" WHERE r.referee_id = $1", refereeID)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, service.ErrReferralNotFound
+	}
+	return &items[0], nil
+}
+
+func (r *referralRepository) ListByReferrer(ctx context.Context, referrerID int64, params pagination.PaginationParams) ([]service.Referral, *pagination.PaginationResult, error) {
+	var total int64
+	if err := scanSingleRow(ctx, r.sql, "SELECT COUNT(*) FROM referrals WHERE referrer_id = $1", []any{referrerID}, &total); err != nil {
+		return nil, nil, err
+	}
+	if total == 0 {
+		return []service.Referral{}, paginationResultFromTotal(0, params), nil
+	}
+	items, err := r.queryReferrals(ctx, r.sql, referralSelect+`
+		WHERE r.referrer_id = $1
+		ORDER BY r.created_at DESC, r.id DESC
+		LIMIT $2 OFFSET $3
+	`, referrerID, params.Limit(), params.Offset())
+	if err != nil {
+		return nil, nil, err
+	}
+	return items, paginationResultFromTotal(total, params), nil
+}
+
+func (r *referralRepository) GetStats(ctx context.Context, referrerID int64) (*service.ReferralStats, error) {
+	var stats service.ReferralStats
+	err := scanSingleRow(ctx, r.sql, `
+		SELECT COUNT(*), COALESCE(SUM(total_spend), 0), COALESCE(SUM(total_commission), 0)
+		FROM referrals
+		WHERE referrer_id = $1
+	`, []any{referrerID}, &stats.ReferralCount, &stats.TotalSpend, &stats.TotalCommission)
+	if err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
+
+func (r *referralRepository) ListDueForSettlement(ctx context.Context, until time.Time, afterID int64, limit int) ([]service.Referral, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+	return r.queryReferrals(ctx, r.sql, referralSelect+`
+		WHERE r.settled_until < LEAST($1, COALESCE(r.expires_at, $1))
+			AND (r.commission_cap_usd IS NULL OR r.total_commission < r.commission_cap_usd)
+			AND r.id > $2
+		ORDER BY r.id
+		LIMIT $3
+	`, until, afterID, limit)
+}
+
+// GetRefereeSpend 按累计值计算：返佣基数累计不超过被邀请人累计的付费充值，
+// 因此优惠码赠送、兑换码、邀请返佣等非付费额度支撑的消费不计入返佣。
+// 实付消费扣除退款调整（如重新计费退还的差额），扣减后不低于 0。
+func (r *referralRepository) GetRefereeSpend(ctx context.Context, refereeID int64, start, end time.Time) (float64, error) {
+	var spend float64
+	err := scanSingleRow(ctx, r.sql, `
+		WITH totals AS (
+			SELECT
+				GREATEST(
+					COALESCE(-SUM(amount) FILTER (WHERE type = ANY($2) AND amount < 0 AND created_at < $3), 0)
+					- COALESCE(SUM(amount) FILTER (WHERE type = ANY($6) AND amount > 0 AND created_at < $3), 0),
+					0) AS spend_before,
+				GREATEST(
+					COALESCE(-SUM(amount) FILTER (WHERE type = ANY($2) AND amount < 0), 0)
+					- COALESCE(SUM(amount) FILTER (WHERE type = ANY($6) AND amount > 0), 0),
+					0) AS spend_until,
+				COALESCE(SUM(amount) FILTER (WHERE type = ANY($5) AND amount > 0 AND created_at < $3), 0) AS paid_before,
+				COALESCE(SUM(amount) FILTER (WHERE type = ANY($5) AND amount > 0), 0) AS paid_until
+			FROM balance_transactions
+			WHERE user_id = $1 AND created_at < $4
+		)
+		SELECT GREATEST(LEAST(spend_until, paid_until) - LEAST(spend_before, paid_before), 0)
+		FROM totals
+	`, []any{refereeID, pq.Array(service.ReferralSpendTxTypes), start, end, pq.Array(service.ReferralFundingTxTypes), pq.Array(service.ReferralRefundTxTypes)}, &spend)
+	if err != nil {
+		return 0, err
+	}
+	return spend, nil
+}
+
+// Settle 以 settled_until 作为乐观锁推进水位，避免多实例重复结算同一区间
+func (r *referralRepository) Settle(ctx context.Context, referral *service.Referral, end time.Time, spend, amount float64) (*service.ReferralCommission, error) {
+	exec := sqlExecutorFromContext(ctx, r.sql)
+	res, err := exec.ExecContext(ctx, `
+		UPDATE referrals
+		SET settled_until = $3,
+			total_spend = total_spend + $4,
+			total_commission = total_commission + $5
+		WHERE id = $1 AND settled_until = $2
+	`, referral.ID, referral.SettledUntil, end, spend, amount)
+	if err != nil {
+		return nil, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if affected == 0 {
+		return nil, service.ErrReferralSettlementConflict
+	}
+	if amount <= 0 {
+		return nil, nil
+	}
+
+	commission := &service.ReferralCommission{
+		ReferralID:     referral.ID,
+		ReferrerID:     referral.ReferrerID,
+		RefereeID:      referral.RefereeID,
+		PeriodStart:    referral.SettledUntil,
+		PeriodEnd:      end,
+		Spend:          spend,
+		CommissionRate: referral.CommissionRate,
+		Amount:         amount,
+	}
+	query := `
+		INSERT INTO referral_commissions
+			(referral_id, referrer_id, referee_id, period_start, period_end, spend, commission_rate, amount)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at
+	`
+	args := []any{
+		commission.ReferralID,
+		commission.ReferrerID,
+		commission.RefereeID,
+		commission.PeriodStart,
+		commission.PeriodEnd,
+		commission.Spend,
+		commission.CommissionRate,
+		commission.Amount,
+	}
+	if err := scanSingleRow(ctx, exec, query, args, &commission.ID, &commission.CreatedAt); err != nil {
+		return nil, err
+	}
+	return commission, nil
+}
+
+func (r *referralRepository) ListCommissions(ctx context.Context, params pagination.PaginationParams, filters service.ReferralCommissionFilters) ([]service.ReferralCommission, *pagination.PaginationResult, error) {
+	conditions := []string{"1 = 1"}
+	args := []any{}
+	add := func(cond string, v any) {
+		args = append(args, v)
+		conditions = append(conditions, fmt.Sprintf(cond, len(args)))
+	}
+	if filters.ReferrerID > 0 {
+		add("c.referrer_id = $%d", filters.ReferrerID)
+	}
+	if filters.RefereeID > 0 {
+		add("c.referee_id = $%d", filters.RefereeID)
+	}
+	if filters.StartTime != nil {
+		add("c.created_at >= $%d", *filters.StartTime)
+	}
+	if filters.EndTime != nil {
+		add("c.created_at < $%d", *filters.EndTime)
+	}
+	where := strings.Join(conditions, " AND ")
+
+	var total int64
+	if err := scanSingleRow(ctx, r.sql, "SELECT COUNT(*) FROM referral_commissions c WHERE "+where, args, &total); err != nil {
+		return nil, nil, err
+	}
+	if total == 0 {
+		return []service.ReferralCommission{}, paginationResultFromTotal(0, params), nil
+	}
+
+	query := fmt.Sprintf("%s WHERE %s ORDER BY c.created_at DESC, c.id DESC LIMIT $%d OFFSET $%d",
+		referralCommissionSelect, where, len(args)+1, len(args)+2)
+	rows, err := r.sql.QueryContext(ctx, query, append(args, params.Limit(), params.Offset())...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := make([]service.ReferralCommission, 0)
+	for rows.Next() {
+		var c service.ReferralCommission
+		if err := rows.Scan(
+			&c.ID,
+			&c.ReferralID,
+			&c.ReferrerID,
+			&c.RefereeID,
+			&c.PeriodStart,
+			&c.PeriodEnd,
+			&c.Spend,
+			&c.CommissionRate,
+			&c.Amount,
+			&c.CreatedAt,
+			&c.ReferrerEmail,
+			&c.RefereeEmail,
+		); err != nil {
+			return nil, nil, err
+		}
+		out = append(out, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+	return out, paginationResultFromTotal(total, params), nil
+}
+
+func (r *referralRepository) GetSettlementReport(ctx context.Context, startTime, endTime time.Time) ([]service.ReferralSettlementRow, error) {
+	rows, err := r.sql.QueryContext(ctx, `
+		SELECT c.referrer_id, COALESCE(u.email, ''),
+			COUNT(DISTINCT c.referee_id), COUNT(*),
+			COALESCE(SUM(c.spend), 0), COALESCE(SUM(c.amount), 0)
+		FROM referral_commissions c
+		LEFT JOIN users u ON u.id = c.referrer_id
+		WHERE c.created_at >= $1 AND c.created_at < $2
+		GROUP BY c.referrer_id, u.email
+		ORDER BY SUM(c.amount) DESC, c.referrer_id
+	`, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := make([]service.ReferralSettlementRow, 0)
+	for rows.Next() {
+		var row service.ReferralSettlementRow
+		if err := rows.Scan(
+			&row.ReferrerID,
+			&row.ReferrerEmail,
+			&row.Referees,
+			&row.Settlements,
+			&row.Spend,
+			&row.Commission,
+		); err != nil {
+			return nil, err
+		}
+		out = append(out, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *referralRepository) queryReferrals(ctx context.Context, q sqlExecutor, query string, args ...any) ([]service.Referral, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := make([]service.Referral, 0)
+	for rows.Next() {
+		var (
+			ref       service.Referral
+			capUSD    sql.NullFloat64
+			expiresAt sql.NullTime
+		)
+		if err := rows.Scan(
+			&ref.ID,
+			&ref.ReferrerID,
+			&ref.RefereeID,
+			&ref.CommissionRate,
+			&capUSD,
+			&expiresAt,
+			&ref.TotalSpend,
+			&ref.TotalCommission,
+			&ref.SettledUntil,
+			&ref.CreatedAt,
+			&ref.RefereeEmail,
+		); err != nil {
+			return nil, err
+		}
+		ref.CommissionCapUSD = nullFloat64Ptr(capUSD)
+		if expiresAt.Valid {
+			t := expiresAt.Time
+			ref.ExpiresAt = &t
+		}
+		out = append(out, ref)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
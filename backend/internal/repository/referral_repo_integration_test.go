@@ -0,0 +1,115 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReferralRepository_BindAndSettle(t *testing.T) {
+	ctx := context.Background()
+	client := testEntClient(t)
+	repo := NewReferralRepository(integrationDB)
+
+	suffix := time.Now().UnixNano()
+	referrer := mustCreateUser(t, client, &service.User{Email: fmt.Sprintf("referrer-%d@example.com", suffix)})
+	referee := mustCreateUser(t, client, &service.User{Email: fmt.Sprintf("referee-%d@example.com", suffix)})
+	t.Cleanup(func() {
+		_, _ = integrationDB.ExecContext(ctx, "DELETE FROM users WHERE id IN ($1, $2)", referrer.ID, referee.ID)
+	})
+
+	code := fmt.Sprintf("T%d", suffix%1e9)
+	_, err := repo.GetCodeByUserID(ctx, referrer.ID)
+	require.ErrorIs(t, err, service.ErrReferralCodeNotFound)
+	require.NoError(t, repo.CreateCode(ctx, referrer.ID, code))
+	require.ErrorIs(t, repo.CreateCode(ctx, referrer.ID, code+"X"), service.ErrReferralCodeConflict)
+	userID, err := repo.GetUserIDByCode(ctx, code)
+	require.NoError(t, err)
+	require.Equal(t, referrer.ID, userID)
+
+	start := time.Now().Add(-2 * time.Hour).Truncate(time.Microsecond)
+	capUSD := 1.5
+	ref := &service.Referral{ReferrerID: referrer.ID, RefereeID: referee.ID, CommissionRate: 10, CommissionCapUSD: &capUSD, SettledUntil: start}
+	require.NoError(t, repo.Create(ctx, ref))
+	require.ErrorIs(t, repo.Create(ctx, &service.Referral{ReferrerID: referrer.ID, RefereeID: referee.ID, SettledUntil: start}), service.ErrReferralExists)
+
+	// 只有 usage/subscription 扣减计入实付消费，扣除退款调整后累计不超过付费充值：
+	// 区间前已付费 15、消费 7；区间内消费 12、重新计费退款 5，净消费 7 均由付费充值支撑
+	_, err = integrationDB.ExecContext(ctx, `
+		INSERT INTO balance_transactions (user_id, type, amount, balance_after, created_at)
+		VALUES ($1, 'payment', 15, 0, $3), ($1, 'usage', -7, 0, $3),
+			($1, 'usage', -3, 0, $2), ($1, 'subscription', -5, 0, $2), ($1, 'redeem', 100, 0, $2),
+			($1, 'promo', 20, 0, $2), ($1, 'usage', -4, 0, $2), ($1, 'adjustment', 5, 0, $2)
+	`, referee.ID, start.Add(time.Hour), start.Add(-time.Hour))
+	require.NoError(t, err)
+
+	until := time.Now()
+	due, err := repo.ListDueForSettlement(ctx, until, 0, 1000)
+	require.NoError(t, err)
+	var found *service.Referral
+	for i := range due {
+		if due[i].ID == ref.ID {
+			found = &due[i]
+		}
+	}
+	require.NotNil(t, found)
+	require.Equal(t, 1.5, *found.CommissionCapUSD)
+
+	spend, err := repo.GetRefereeSpend(ctx, referee.ID, found.SettledUntil, until)
+	require.NoError(t, err)
+	require.Equal(t, 7.0, spend)
+
+	commission, err := repo.Settle(ctx, found, until, spend, found.Commission(spend))
+	require.NoError(t, err)
+	require.NotNil(t, commission)
+	require.Equal(t, 0.7, commission.Amount)
+
+	// 水位已推进：重复结算冲突
+	_, err = repo.Settle(ctx, found, until, spend, 0.7)
+	require.ErrorIs(t, err, service.ErrReferralSettlementConflict)
+
+	// 退款超过区间内消费：返佣基数不为负
+	_, err = integrationDB.ExecContext(ctx, `
+		INSERT INTO balance_transactions (user_id, type, amount, balance_after, created_at)
+		VALUES ($1, 'usage', -1, 0, $2), ($1, 'adjustment', 3, 0, $2)
+	`, referee.ID, until.Add(time.Minute))
+	require.NoError(t, err)
+	spend, err = repo.GetRefereeSpend(ctx, referee.ID, until, until.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 0.0, spend)
+
+	stats, err := repo.GetStats(ctx, referrer.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), stats.ReferralCount)
+	require.Equal(t, 7.0, stats.TotalSpend)
+	require.Equal(t, 0.7, stats.TotalCommission)
+
+	referrals, _, err := repo.ListByReferrer(ctx, referrer.ID, pagination.PaginationParams{Page: 1, PageSize: 10})
+	require.NoError(t, err)
+	require.Len(t, referrals, 1)
+	require.Equal(t, referee.Email, referrals[0].RefereeEmail)
+
+	commissions, result, err := repo.ListCommissions(ctx, pagination.PaginationParams{Page: 1, PageSize: 10}, service.ReferralCommissionFilters{ReferrerID: referrer.ID})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), result.Total)
+	require.Equal(t, referrer.Email, commissions[0].ReferrerEmail)
+
+	report, err := repo.GetSettlementReport(ctx, time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	var row *service.ReferralSettlementRow
+	for i := range report {
+		if report[i].ReferrerID == referrer.ID {
+			row = &report[i]
+		}
+	}
+	require.NotNil(t, row)
+	require.Equal(t, int64(1), row.Settlements)
+	require.Equal(t, 0.7, row.Commission)
+}
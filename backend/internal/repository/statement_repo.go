@@ -0,0 +1,330 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type statementRepository struct {
+	sql sqlExecutor
+}
+
+func NewStatementRepository(sqlDB *sql.DB) service.StatementRepository {
+	return &statementRepository{sql: sqlDB}
+}
+
+func (r *statementRepository) BuildSummary(ctx context.Context, userID int64, start, end time.Time) (*service.StatementSummary, error) {
+	summary := &service.StatementSummary{
+		Models:         []service.StatementLine{},
+		Groups:         []service.StatementLine{},
+		BalanceChanges: []service.StatementBalanceLine{},
+	}
+	const tokensExpr = "COALESCE(SUM(input_tokens + output_tokens + cache_creation_tokens + cache_read_tokens), 0)"
+
+	// 用量按付费账户统计：组织 Key 的请求计入组织计费账户的账单，与余额流水口径一致
+
+	modelRows, err := r.sql.QueryContext(ctx, `
+		SELECT model, COUNT(*), `+tokensExpr+`, COALESCE(SUM(actual_cost), 0),
+			COALESCE(SUM(CASE WHEN billing_type = $4 THEN actual_cost ELSE 0 END), 0)
+		FROM usage_logs
+		WHERE ((user_id = $1 AND billing_user_id IS NULL) OR billing_user_id = $1)
+			AND created_at >= $2 AND created_at < $3
+		GROUP BY model
+		ORDER BY SUM(actual_cost) DESC, model
+	`, userID, start, end, service.BillingTypeSubscription)
+	if err != nil {
+		return nil, err
+	}
+	if err := scanStatementLines(modelRows, &summary.Models, false); err != nil {
+		return nil, err
+	}
+
+	groupRows, err := r.sql.QueryContext(ctx, `
+		SELECT ul.group_id, COALESCE(g.name, ''), COUNT(*),
+			COALESCE(SUM(ul.input_tokens + ul.output_tokens + ul.cache_creation_tokens + ul.cache_read_tokens), 0),
+			COALESCE(SUM(ul.actual_cost), 0),
+			COALESCE(SUM(CASE WHEN ul.billing_type = $4 THEN ul.actual_cost ELSE 0 END), 0)
+		FROM usage_logs ul
+		LEFT JOIN groups g ON g.id = ul.group_id
+		WHERE ((ul.user_id = $1 AND ul.billing_user_id IS NULL) OR ul.billing_user_id = $1)
+			AND ul.created_at >= $2 AND ul.created_at < $3
+		GROUP BY ul.group_id, g.name
+		ORDER BY SUM(ul.actual_cost) DESC, ul.group_id
+	`, userID, start, end, service.BillingTypeSubscription)
+	if err != nil {
+		return nil, err
+	}
+	if err := scanStatementLines(groupRows, &summary.Groups, true); err != nil {
+		return nil, err
+	}
+
+	balanceRows, err := r.sql.QueryContext(ctx, `
+		SELECT type, COUNT(*), COALESCE(SUM(amount), 0)
+		FROM balance_transactions
+		WHERE user_id = $1 AND created_at >= $2 AND created_at < $3
+		GROUP BY type
+		ORDER BY type
+	`, userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = balanceRows.Close() }()
+	for balanceRows.Next() {
+		var line service.StatementBalanceLine
+		if err := balanceRows.Scan(&line.Type, &line.Count, &line.Amount); err != nil {
+			return nil, err
+		}
+		summary.BalanceChanges = append(summary.BalanceChanges, line)
+		switch line.Type {
+		case service.BalanceTxTypePayment, service.BalanceTxTypeRedeem, service.BalanceTxTypePromo:
+			summary.TopUps += line.Amount
+		}
+	}
+	if err := balanceRows.Err(); err != nil {
+		return nil, err
+	}
+
+	// 期初/期末余额取区间边界前最后一条流水的 balance_after
+	balanceQuery := `
+		SELECT COALESCE((
+			SELECT balance_after FROM balance_transactions
+			WHERE user_id = $1 AND created_at < $2
+			ORDER BY created_at DESC, id DESC
+			LIMIT 1
+		), 0)
+	`
+	if err := scanSingleRow(ctx, r.sql, balanceQuery, []any{userID, start}, &summary.OpeningBalance); err != nil {
+		return nil, err
+	}
+	if err := scanSingleRow(ctx, r.sql, balanceQuery, []any{userID, end}, &summary.ClosingBalance); err != nil {
+		return nil, err
+	}
+
+	for _, l := range summary.Models {
+		summary.TotalRequests += l.Requests
+		summary.TotalTokens += l.Tokens
+		summary.TotalCost += l.Cost
+		summary.SubscriptionCharges += l.SubscriptionCost
+	}
+	summary.BalanceCharges = summary.TotalCost - summary.SubscriptionCharges
+	return summary, nil
+}
+
+func scanStatementLines(rows *sql.Rows, out *[]service.StatementLine, withGroup bool) error {
+	defer func() { _ = rows.Close() }()
+	for rows.Next() {
+		var line service.StatementLine
+		var groupID sql.NullInt64
+		dest := []any{&line.Name, &line.Requests, &line.Tokens, &line.Cost, &line.SubscriptionCost}
+		if withGroup {
+			dest = append([]any{&groupID}, dest...)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return err
+		}
+		if withGroup {
+			line.GroupID = nullInt64Ptr(groupID)
+			if line.GroupID == nil {
+				line.Name = "-"
+			}
+		}
+		*out = append(*out, line)
+	}
+	return rows.Err()
+}
+
+func (r *statementRepository) ListActiveUserIDs(ctx context.Context, period string, start, end time.Time, afterID int64, limit int, onlyMissing bool) ([]int64, error) {
+	query := `
+		SELECT u.id
+		FROM users u
+		WHERE u.deleted_at IS NULL
+			AND u.id > $3
+			AND (
+				EXISTS (SELECT 1 FROM usage_logs ul WHERE ul.user_id = u.id AND ul.created_at >= $1 AND ul.created_at < $2)
+				OR EXISTS (SELECT 1 FROM balance_transactions bt WHERE bt.user_id = u.id AND bt.created_at >= $1 AND bt.created_at < $2)
+			)
+	`
+	args := []any{start, end, afterID, limit}
+	if onlyMissing {
+		args = append(args, period)
+		query += " AND NOT EXISTS (SELECT 1 FROM user_statements s WHERE s.user_id = u.id AND s.period = $5)"
+	}
+	query += " ORDER BY u.id LIMIT $4"
+
+	rows, err := r.sql.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := make([]int64, 0, limit)
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		out = append(out, id)
+	}
+	return out, rows.Err()
+}
+
+func (r *statementRepository) Upsert(ctx context.Context, statement *service.UserStatement) error {
+	summary, err := json.Marshal(statement.Summary)
+	if err != nil {
+		return fmt.Errorf("marshal statement summary: %w", err)
+	}
+	query := `
+		INSERT INTO user_statements (user_id, period, period_start, period_end, summary, html, pdf, generated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (user_id, period) DO UPDATE SET
+			period_start = EXCLUDED.period_start,
+			period_end = EXCLUDED.period_end,
+			summary = EXCLUDED.summary,
+			html = EXCLUDED.html,
+			pdf = EXCLUDED.pdf,
+			generated_at = EXCLUDED.generated_at
+		RETURNING id, emailed_at
+	`
+	var emailedAt sql.NullTime
+	args := []any{statement.UserID, statement.Period, statement.PeriodStart, statement.PeriodEnd, summary, string(statement.HTML), statement.PDF, statement.GeneratedAt}
+	if err := scanSingleRow(ctx, r.sql, query, args, &statement.ID, &emailedAt); err != nil {
+		return err
+	}
+	if emailedAt.Valid {
+		t := emailedAt.Time
+		statement.EmailedAt = &t
+	}
+	return nil
+}
+
+const statementColumns = "id, user_id, period, period_start, period_end, summary, generated_at, emailed_at"
+
+func (r *statementRepository) GetByID(ctx context.Context, id int64) (*service.UserStatement, error) {
+	rows, err := r.sql.QueryContext(ctx, "SELECT "+statementColumns+" FROM user_statements WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		return nil, service.ErrStatementNotFound
+	}
+	statement, err := scanUserStatement(rows)
+	if err != nil {
+		return nil, err
+	}
+	return statement, rows.Err()
+}
+
+func (r *statementRepository) GetContent(ctx context.Context, id int64, format string) ([]byte, error) {
+	var column string
+	switch format {
+	case service.StatementFormatHTML:
+		column = "html"
+	case service.StatementFormatPDF:
+		column = "pdf"
+	default:
+		return nil, service.ErrInvalidStatementFormat
+	}
+	var content []byte
+	if err := scanSingleRow(ctx, r.sql, "SELECT "+column+" FROM user_statements WHERE id = $1", []any{id}, &content); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, service.ErrStatementNotFound
+		}
+		return nil, err
+	}
+	return content, nil
+}
+
+func (r *statementRepository) List(ctx context.Context, params pagination.PaginationParams, filters service.StatementFilters) ([]service.UserStatement, *pagination.PaginationResult, error) {
+	conditions := []string{"1 = 1"}
+	args := []any{}
+	if filters.UserID > 0 {
+		args = append(args, filters.UserID)
+		conditions = append(conditions, fmt.Sprintf("user_id = $%d", len(args)))
+	}
+	if filters.Period != "" {
+		args = append(args, filters.Period)
+		conditions = append(conditions, fmt.Sprintf("period = $%d", len(args)))
+	}
+	where := strings.Join(conditions, " AND ")
+
+	var total int64
+	if err := scanSingleRow(ctx, r.sql, "SELECT COUNT(*) FROM user_statements WHERE "+where, args, &total); err != nil {
+		return nil, nil, err
+	}
+	if total == 0 {
+		return []service.UserStatement{}, paginationResultFromTotal(0, params), nil
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM user_statements
+		WHERE %s
+		ORDER BY period DESC, id DESC
+		LIMIT $%d OFFSET $%d
+	`, statementColumns, where, len(args)+1, len(args)+2)
+	rows, err := r.sql.QueryContext(ctx, query, append(args, params.Limit(), params.Offset())...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := make([]service.UserStatement, 0)
+	for rows.Next() {
+		statement, err := scanUserStatement(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+		out = append(out, *statement)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+	return out, paginationResultFromTotal(total, params), nil
+}
+
+func (r *statementRepository) MarkEmailed(ctx context.Context, id int64, at time.Time) error {
+	_, err := r.sql.ExecContext(ctx, "UPDATE user_statements SET emailed_at = $2 WHERE id = $1", id, at)
+	return err
+}
+
+func scanUserStatement(rows *sql.Rows) (*service.UserStatement, error) {
+	var (
+		statement service.UserStatement
+		summary   []byte
+		emailedAt sql.NullTime
+	)
+	if err := rows.Scan(
+		&statement.ID,
+		&statement.UserID,
+		&statement.Period,
+		&statement.PeriodStart,
+		&statement.PeriodEnd,
+		&summary,
+		&statement.GeneratedAt,
+		&emailedAt,
+	); err != nil {
+		return nil, err
+	}
+	if len(summary) > 0 {
+		if err := json.Unmarshal(summary, &statement.Summary); err != nil {
+			return nil, fmt.Errorf("unmarshal statement summary: %w", err)
+		}
+	}
+	if emailedAt.Valid {
+		t := emailedAt.Time
+		statement.EmailedAt = &t
+	}
+	return &statement, nil
+}
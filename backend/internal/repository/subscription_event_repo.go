@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type subscriptionEventRepository struct {
+	sql sqlExecutor
+}
+
+func NewSubscriptionEventRepository(sqlDB *sql.DB) service.SubscriptionEventRepository {
+	return &subscriptionEventRepository{sql: sqlDB}
+}
+
+func (r *subscriptionEventRepository) Create(ctx context.Context, event *service.SubscriptionEvent) error {
+	var expiresAt sql.NullTime
+	if event.ExpiresAt != nil {
+		expiresAt = sql.NullTime{Time: *event.ExpiresAt, Valid: true}
+	}
+	query := `
+		INSERT INTO subscription_events (subscription_id, action, from_user_id, to_user_id, operator_id, operator_role, expires_at, notes)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at
+	`
+	args := []any{
+		event.SubscriptionID,
+		event.Action,
+		event.FromUserID,
+		nullInt64(event.ToUserID),
+		nullInt64(event.OperatorID),
+		event.OperatorRole,
+		expiresAt,
+		nullString(&event.Notes),
+	}
+	return scanSingleRow(ctx, sqlExecutorFromContext(ctx, r.sql), query, args, &event.ID, &event.CreatedAt)
+}
+
+func (r *subscriptionEventRepository) ListBySubscriptionID(ctx context.Context, subscriptionID int64) ([]service.SubscriptionEvent, error) {
+	rows, err := sqlExecutorFromContext(ctx, r.sql).QueryContext(ctx, `
+		SELECT id, subscription_id, action, from_user_id, to_user_id, operator_id, operator_role, expires_at, notes, created_at
+		FROM subscription_events
+		WHERE subscription_id = $1
+		ORDER BY created_at DESC, id DESC
+	`, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := make([]service.SubscriptionEvent, 0)
+	for rows.Next() {
+		var (
+			event      service.SubscriptionEvent
+			toUserID   sql.NullInt64
+			operatorID sql.NullInt64
+			expiresAt  sql.NullTime
+			notes      sql.NullString
+		)
+		if err := rows.Scan(
+			&event.ID,
+			&event.SubscriptionID,
+			&event.Action,
+			&event.FromUserID,
+			&toUserID,
+			&operatorID,
+			&event.OperatorRole,
+			&expiresAt,
+			&notes,
+			&event.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		event.ToUserID = nullInt64Ptr(toUserID)
+		event.OperatorID = nullInt64Ptr(operatorID)
+		if expiresAt.Valid {
+			t := expiresAt.Time
+			event.ExpiresAt = &t
+		}
+		event.Notes = notes.String
+		out = append(out, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+const subscriptionPlanColumns = `id, name, description, group_id, duration_days, price, daily_limit_usd, weekly_limit_usd,
+	monthly_limit_usd, status, sort_order, created_at, updated_at`
+
+type subscriptionPlanRepository struct {
+	sql sqlExecutor
+}
+
+func NewSubscriptionPlanRepository(sqlDB *sql.DB) service.SubscriptionPlanRepository {
+	return &subscriptionPlanRepository{sql: sqlDB}
+}
+
+func subscriptionPlanArgs(p *service.SubscriptionPlan) []any {
+	return []any{
+		p.Name,
+		p.Description,
+		p.GroupID,
+		p.DurationDays,
+		p.Price,
+		nullFloat64(p.DailyLimitUSD),
+		nullFloat64(p.WeeklyLimitUSD),
+		nullFloat64(p.MonthlyLimitUSD),
+		p.Status,
+		p.SortOrder,
+	}
+}
+
+func (r *subscriptionPlanRepository) Create(ctx context.Context, plan *service.SubscriptionPlan) error {
+	query := `
+		INSERT INTO subscription_plans (name, description, group_id, duration_days, price, daily_limit_usd,
+			weekly_limit_usd, monthly_limit_usd, status, sort_order)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id, created_at, updated_at
+	`
+	return scanSingleRow(ctx, r.sql, query, subscriptionPlanArgs(plan), &plan.ID, &plan.CreatedAt, &plan.UpdatedAt)
+}
+
+func (r *subscriptionPlanRepository) Update(ctx context.Context, plan *service.SubscriptionPlan) error {
+	query := `
+		UPDATE subscription_plans
+		SET name = $1, description = $2, group_id = $3, duration_days = $4, price = $5, daily_limit_usd = $6,
+			weekly_limit_usd = $7, monthly_limit_usd = $8, status = $9, sort_order = $10, updated_at = NOW()
+		WHERE id = $11
+		RETURNING updated_at
+	`
+	args := append(subscriptionPlanArgs(plan), plan.ID)
+	err := scanSingleRow(ctx, r.sql, query, args, &plan.UpdatedAt)
+	return translatePersistenceError(err, service.ErrSubscriptionPlanNotFound, nil)
+}
+
+func (r *subscriptionPlanRepository) Delete(ctx context.Context, id int64) error {
+	res, err := r.sql.ExecContext(ctx, "DELETE FROM subscription_plans WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return service.ErrSubscriptionPlanNotFound
+	}
+	return nil
+}
+
+func (r *subscriptionPlanRepository) GetByID(ctx context.Context, id int64) (*service.SubscriptionPlan, error) {
+	items, err := r.query(ctx, "SELECT "+subscriptionPlanColumns+" FROM subscription_plans WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, service.ErrSubscriptionPlanNotFound
+	}
+	return &items[0], nil
+}
+
+func (r *subscriptionPlanRepository) List(ctx context.Context, activeOnly bool) ([]service.SubscriptionPlan, error) {
+	return r.query(ctx, `
+		SELECT `+subscriptionPlanColumns+`
+		FROM subscription_plans
+		WHERE NOT $1::boolean OR status = $2
+		ORDER BY sort_order ASC, id ASC
+	`, activeOnly, service.SubscriptionPlanStatusActive)
+}
+
+func (r *subscriptionPlanRepository) ListRenewalDueSubscriptionIDs(ctx context.Context, now, before time.Time, afterID int64, limit int) ([]int64, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+	rows, err := r.sql.QueryContext(ctx, `
+		SELECT id FROM user_subscriptions
+		WHERE auto_renew = TRUE AND deleted_at IS NULL AND plan_id IS NOT NULL
+			AND status = $1 AND expires_at > $2 AND expires_at <= $3 AND id > $4
+		ORDER BY id ASC
+		LIMIT $5
+	`, service.SubscriptionStatusActive, now, before, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	ids := make([]int64, 0)
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (r *subscriptionPlanRepository) query(ctx context.Context, query string, args ...any) ([]service.SubscriptionPlan, error) {
+	rows, err := r.sql.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	out := make([]service.SubscriptionPlan, 0)
+	for rows.Next() {
+		var (
+			p                      service.SubscriptionPlan
+			daily, weekly, monthly sql.NullFloat64
+		)
+		if err := rows.Scan(
+			&p.ID,
+			&p.Name,
+			&p.Description,
+			&p.GroupID,
+			&p.DurationDays,
+			&p.Price,
+			&daily,
+			&weekly,
+			&monthly,
+			&p.Status,
+			&p.SortOrder,
+			&p.CreatedAt,
+			&p.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		p.DailyLimitUSD = nullFloat64Ptr(daily)
+		p.WeeklyLimitUSD = nullFloat64Ptr(weekly)
+		p.MonthlyLimitUSD = nullFloat64Ptr(monthly)
+		out = append(out, p)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,134 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscriptionPlanRepository_CRUDAndRenewalDue(t *testing.T) {
+	ctx := context.Background()
+	client := testEntClient(t)
+	repo := NewSubscriptionPlanRepository(integrationDB)
+	subRepo := NewUserSubscriptionRepository(client)
+
+	suffix := time.Now().UnixNano()
+	group := mustCreateGroup(t, client, &service.Group{
+		Name:             fmt.Sprintf("plan-group-%d", suffix),
+		SubscriptionType: service.SubscriptionTypeSubscription,
+	})
+	user := mustCreateUser(t, client, &service.User{Email: fmt.Sprintf("plan-user-%d@example.com", suffix)})
+	t.Cleanup(func() {
+		_, _ = integrationDB.ExecContext(ctx, "DELETE FROM user_subscriptions WHERE user_id = $1", user.ID)
+		_, _ = integrationDB.ExecContext(ctx, "DELETE FROM subscription_plans WHERE group_id = $1", group.ID)
+		_, _ = integrationDB.ExecContext(ctx, "DELETE FROM users WHERE id = $1", user.ID)
+		_, _ = integrationDB.ExecContext(ctx, "DELETE FROM groups WHERE id = $1", group.ID)
+	})
+
+	daily := 3.5
+	plan := &service.SubscriptionPlan{
+		Name:          "Pro",
+		GroupID:       group.ID,
+		DurationDays:  30,
+		Price:         20,
+		DailyLimitUSD: &daily,
+		Status:        service.SubscriptionPlanStatusActive,
+	}
+	require.NoError(t, repo.Create(ctx, plan))
+	require.NotZero(t, plan.ID)
+
+	plan.Status = service.SubscriptionPlanStatusDisabled
+	plan.DailyLimitUSD = nil
+	require.NoError(t, repo.Update(ctx, plan))
+
+	got, err := repo.GetByID(ctx, plan.ID)
+	require.NoError(t, err)
+	require.Equal(t, service.SubscriptionPlanStatusDisabled, got.Status)
+	require.Nil(t, got.DailyLimitUSD)
+
+	active, err := repo.List(ctx, true)
+	require.NoError(t, err)
+	for _, p := range active {
+		require.NotEqual(t, plan.ID, p.ID)
+	}
+
+	now := time.Now()
+	sub := mustCreateSubscription(t, client, &service.UserSubscription{
+		UserID:    user.ID,
+		GroupID:   group.ID,
+		ExpiresAt: now.Add(12 * time.Hour),
+	})
+	sub.PlanID = &plan.ID
+	sub.AutoRenew = true
+	sub.DailyLimitUSD = &daily
+	require.NoError(t, subRepo.ApplyPlan(ctx, sub))
+
+	ids, err := repo.ListRenewalDueSubscriptionIDs(ctx, now, now.Add(24*time.Hour), 0, 100)
+	require.NoError(t, err)
+	require.Contains(t, ids, sub.ID)
+
+	ids, err = repo.ListRenewalDueSubscriptionIDs(ctx, now, now.Add(24*time.Hour), sub.ID, 100)
+	require.NoError(t, err)
+	require.NotContains(t, ids, sub.ID)
+
+	require.NoError(t, subRepo.UpdateAutoRenew(ctx, sub.ID, false))
+	ids, err = repo.ListRenewalDueSubscriptionIDs(ctx, now, now.Add(24*time.Hour), 0, 100)
+	require.NoError(t, err)
+	require.NotContains(t, ids, sub.ID)
+
+	require.NoError(t, repo.Delete(ctx, plan.ID))
+	_, err = repo.GetByID(ctx, plan.ID)
+	require.ErrorIs(t, err, service.ErrSubscriptionPlanNotFound)
+}
+
+func TestApplyBalanceChange_RequireSufficientBalance(t *testing.T) {
+	ctx := context.Background()
+	client := testEntClient(t)
+	userRepo := newUserRepositoryWithSQL(client, integrationDB)
+
+	user := &service.User{
+		Email:        fmt.Sprintf("plan-balance-%d@example.com", time.Now().UnixNano()),
+		PasswordHash: "test-password-hash",
+		Role:         service.RoleUser,
+		Status:       service.StatusActive,
+		Concurrency:  5,
+		Balance:      10,
+	}
+	require.NoError(t, userRepo.Create(ctx, user))
+	t.Cleanup(func() {
+		_, _ = integrationDB.ExecContext(ctx, "DELETE FROM credit_lots WHERE user_id = $1", user.ID)
+		_, _ = integrationDB.ExecContext(ctx, "DELETE FROM balance_transactions WHERE user_id = $1", user.ID)
+		_, _ = integrationDB.ExecContext(ctx, "DELETE FROM users WHERE id = $1", user.ID)
+	})
+
+	_, err := userRepo.ApplyBalanceChange(ctx, &service.BalanceChange{
+		UserID:                   user.ID,
+		Type:                     service.BalanceTxTypeSubscription,
+		Amount:                   -15,
+		RequireSufficientBalance: true,
+	})
+	require.ErrorIs(t, err, service.ErrInsufficientBalance)
+
+	tx, err := userRepo.ApplyBalanceChange(ctx, &service.BalanceChange{
+		UserID:                   user.ID,
+		Type:                     service.BalanceTxTypeSubscription,
+		Amount:                   -10,
+		RequireSufficientBalance: true,
+	})
+	require.NoError(t, err)
+	require.InDelta(t, 0, tx.BalanceAfter, 1e-6)
+
+	_, err = userRepo.ApplyBalanceChange(ctx, &service.BalanceChange{
+		UserID:                   -1,
+		Type:                     service.BalanceTxTypeSubscription,
+		Amount:                   -1,
+		RequireSufficientBalance: true,
+	})
+	require.ErrorIs(t, err, service.ErrUserNotFound)
+}
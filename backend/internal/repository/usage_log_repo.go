@@ -22,7 +22,7 @@ import (
 	"github.com/lib/pq"
 )
 
-const usageLogSelectColumns = "id, user_id, api_key_id, account_id, request_id, model, group_id, subscription_id, input_tokens, output_tokens, cache_creation_tokens, cache_read_tokens, cache_creation_5m_tokens, cache_creation_1h_tokens, input_cost, output_cost, cache_creation_cost, cache_read_cost, total_cost, actual_cost, rate_multiplier, account_rate_multiplier, billing_type, stream, duration_ms, first_token_ms, user_agent, ip_address, image_count, image_size, created_at"
+const usageLogSelectColumns = "id, user_id, api_key_id, account_id, request_id, model, group_id, subscription_id, input_tokens, output_tokens, cache_creation_tokens, cache_read_tokens, cache_creation_5m_tokens, cache_creation_1h_tokens, input_cost, output_cost, cache_creation_cost, cache_read_cost, total_cost, actual_cost, rate_multiplier, account_rate_multiplier, billing_type, stream, duration_ms, first_token_ms, user_agent, ip_address, image_count, image_size, original_group_id, fallback_hop, pricing_version, input_unit_price, output_unit_price, cache_creation_unit_price, cache_read_unit_price, image_unit_price, volume_tier_min_spend, volume_tier_multiplier, subscription_cost, overage_cost, organization_id, billing_user_id, created_at"
 
 type usageLogRepository struct {
 	client *dbent.Client
@@ -114,6 +114,20 @@ func (r *usageLogRepository) Create(ctx context.Context, log *service.UsageLog)
 			ip_address,
 			image_count,
 			image_size,
+			original_group_id,
+			fallback_hop,
+			pricing_version,
+			input_unit_price,
+			output_unit_price,
+			cache_creation_unit_price,
+			cache_read_unit_price,
+			image_unit_price,
+			volume_tier_min_spend,
+			volume_tier_multiplier,
+			subscription_cost,
+			overage_cost,
+			organization_id,
+			billing_user_id,
 			created_at
 		) VALUES (
 			$1, $2, $3, $4, $5,
@@ -121,7 +135,12 @@ func (r *usageLogRepository) Create(ctx context.Context, log *service.UsageLog)
 			$8, $9, $10, $11,
 			$12, $13,
 			$14, $15, $16, $17, $18, $19,
-			$20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30
+			$20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31,
+			$32, $33, $34, $35, $36, $37,
+			$38, $39,
+			$40, $41,
+			$42, $43,
+			$44
 		)
 		ON CONFLICT (request_id, api_key_id) DO NOTHING
 		RETURNING id, created_at
@@ -134,6 +153,8 @@ func (r *usageLogRepository) Create(ctx context.Context, log *service.UsageLog)
 	userAgent := nullString(log.UserAgent)
 	ipAddress := nullString(log.IPAddress)
 	imageSize := nullString(log.ImageSize)
+	originalGroupID := nullInt64(log.OriginalGroupID)
+	pricingVersion := nullString(log.PricingVersion)
 
 	var requestIDArg any
 	if requestID != "" {
@@ -170,6 +191,20 @@ func (r *usageLogRepository) Create(ctx context.Context, log *service.UsageLog)
 		ipAddress,
 		log.ImageCount,
 		imageSize,
+		originalGroupID,
+		log.FallbackHop,
+		pricingVersion,
+		nullFloat64(log.InputUnitPrice),
+		nullFloat64(log.OutputUnitPrice),
+		nullFloat64(log.CacheCreationUnitPrice),
+		nullFloat64(log.CacheReadUnitPrice),
+		nullFloat64(log.ImageUnitPrice),
+		nullFloat64(log.VolumeTierMinSpend),
+		nullFloat64(log.VolumeTierMultiplier),
+		nullFloat64(log.SubscriptionCost),
+		nullFloat64(log.OverageCost),
+		nullInt64(log.OrganizationID),
+		nullInt64(log.BillingUserID),
 		createdAt,
 	}
 	if err := scanSingleRow(ctx, sqlq, query, args, &log.ID, &log.CreatedAt); err != nil {
@@ -891,6 +926,50 @@ func (r *usageLogRepository) GetAccountWindowStats(ctx context.Context, accountI
 	return stats, nil
 }
 
+// GetUserSpendSince 获取用户自 since 起的实际消费（按付费账户统计：组织 Key 的消费计入组织计费账户）
+func (r *usageLogRepository) GetUserSpendSince(ctx context.Context, userID int64, since time.Time) (float64, error) {
+	query := `
+		SELECT COALESCE(SUM(actual_cost), 0)
+		FROM usage_logs
+		WHERE created_at >= $2
+			AND ((user_id = $1 AND billing_user_id IS NULL) OR billing_user_id = $1)
+	`
+	var spend float64
+	if err := scanSingleRow(ctx, r.sql, query, []any{userID, since}, &spend); err != nil {
+		return 0, err
+	}
+	return spend, nil
+}
+
+// GetAPIKeyLimitUsage 获取 API Key 在日/月窗口内及累计的消费与请求数
+func (r *usageLogRepository) GetAPIKeyLimitUsage(ctx context.Context, apiKeyID int64, dayStart, monthStart time.Time) (*service.APIKeyLimitUsage, error) {
+	query := `
+		SELECT
+			COALESCE(SUM(actual_cost) FILTER (WHERE created_at >= $2), 0) as daily_cost,
+			COUNT(*) FILTER (WHERE created_at >= $2) as daily_requests,
+			COALESCE(SUM(actual_cost) FILTER (WHERE created_at >= $3), 0) as monthly_cost,
+			COUNT(*) FILTER (WHERE created_at >= $3) as monthly_requests,
+			COALESCE(SUM(actual_cost), 0) as total_cost
+		FROM usage_logs
+		WHERE api_key_id = $1
+	`
+	usage := &service.APIKeyLimitUsage{DayStart: dayStart, MonthStart: monthStart}
+	if err := scanSingleRow(
+		ctx,
+		r.sql,
+		query,
+		[]any{apiKeyID, dayStart, monthStart},
+		&usage.DailyUsage,
+		&usage.DailyRequests,
+		&usage.MonthlyUsage,
+		&usage.MonthlyRequests,
+		&usage.TotalUsage,
+	); err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
+
 // TrendDataPoint represents a single point in trend data
 type TrendDataPoint = usagestats.TrendDataPoint
 
@@ -2090,6 +2169,20 @@ func scanUsageLog(scanner interface{ Scan(...any) error }) (*service.UsageLog, e
 		ipAddress             sql.NullString
 		imageCount            int
 		imageSize             sql.NullString
+		originalGroupID       sql.NullInt64
+		fallbackHop           int
+		pricingVersion        sql.NullString
+		inputUnitPrice        sql.NullFloat64
+		outputUnitPrice       sql.NullFloat64
+		cacheCreationPrice    sql.NullFloat64
+		cacheReadPrice        sql.NullFloat64
+		imageUnitPrice        sql.NullFloat64
+		volumeTierMinSpend    sql.NullFloat64
+		volumeTierMultiplier  sql.NullFloat64
+		subscriptionCost      sql.NullFloat64
+		overageCost           sql.NullFloat64
+		organizationID        sql.NullInt64
+		billingUserID         sql.NullInt64
 		createdAt             time.Time
 	)
 
@@ -2124,35 +2217,61 @@ func scanUsageLog(scanner interface{ Scan(...any) error }) (*service.UsageLog, e
 		&ipAddress,
 		&imageCount,
 		&imageSize,
+		&originalGroupID,
+		&fallbackHop,
+		&pricingVersion,
+		&inputUnitPrice,
+		&outputUnitPrice,
+		&cacheCreationPrice,
+		&cacheReadPrice,
+		&imageUnitPrice,
+		&volumeTierMinSpend,
+		&volumeTierMultiplier,
+		&subscriptionCost,
+		&overageCost,
+		&organizationID,
+		&billingUserID,
 		&createdAt,
 	); err != nil {
 		return nil, err
 	}
 
 	log := &service.UsageLog{
-		ID:                    id,
-		UserID:                userID,
-		APIKeyID:              apiKeyID,
-		AccountID:             accountID,
-		Model:                 model,
-		InputTokens:           inputTokens,
-		OutputTokens:          outputTokens,
-		CacheCreationTokens:   cacheCreationTokens,
-		CacheReadTokens:       cacheReadTokens,
-		CacheCreation5mTokens: cacheCreation5m,
-		CacheCreation1hTokens: cacheCreation1h,
-		InputCost:             inputCost,
-		OutputCost:            outputCost,
-		CacheCreationCost:     cacheCreationCost,
-		CacheReadCost:         cacheReadCost,
-		TotalCost:             totalCost,
-		ActualCost:            actualCost,
-		RateMultiplier:        rateMultiplier,
-		AccountRateMultiplier: nullFloat64Ptr(accountRateMultiplier),
-		BillingType:           int8(billingType),
-		Stream:                stream,
-		ImageCount:            imageCount,
-		CreatedAt:             createdAt,
+		ID:                     id,
+		UserID:                 userID,
+		APIKeyID:               apiKeyID,
+		AccountID:              accountID,
+		Model:                  model,
+		InputTokens:            inputTokens,
+		OutputTokens:           outputTokens,
+		CacheCreationTokens:    cacheCreationTokens,
+		CacheReadTokens:        cacheReadTokens,
+		CacheCreation5mTokens:  cacheCreation5m,
+		CacheCreation1hTokens:  cacheCreation1h,
+		InputCost:              inputCost,
+		OutputCost:             outputCost,
+		CacheCreationCost:      cacheCreationCost,
+		CacheReadCost:          cacheReadCost,
+		TotalCost:              totalCost,
+		ActualCost:             actualCost,
+		RateMultiplier:         rateMultiplier,
+		AccountRateMultiplier:  nullFloat64Ptr(accountRateMultiplier),
+		BillingType:            int8(billingType),
+		InputUnitPrice:         nullFloat64Ptr(inputUnitPrice),
+		OutputUnitPrice:        nullFloat64Ptr(outputUnitPrice),
+		CacheCreationUnitPrice: nullFloat64Ptr(cacheCreationPrice),
+		CacheReadUnitPrice:     nullFloat64Ptr(cacheReadPrice),
+		ImageUnitPrice:         nullFloat64Ptr(imageUnitPrice),
+		VolumeTierMinSpend:     nullFloat64Ptr(volumeTierMinSpend),
+		VolumeTierMultiplier:   nullFloat64Ptr(volumeTierMultiplier),
+		SubscriptionCost:       nullFloat64Ptr(subscriptionCost),
+		OverageCost:            nullFloat64Ptr(overageCost),
+		OrganizationID:         nullInt64Ptr(organizationID),
+		BillingUserID:          nullInt64Ptr(billingUserID),
+		Stream:                 stream,
+		ImageCount:             imageCount,
+		FallbackHop:            fallbackHop,
+		CreatedAt:              createdAt,
 	}
 
 	if requestID.Valid {
@@ -2183,6 +2302,13 @@ func scanUsageLog(scanner interface{ Scan(...any) error }) (*service.UsageLog, e
 	if imageSize.Valid {
 		log.ImageSize = &imageSize.String
 	}
+	if originalGroupID.Valid {
+		value := originalGroupID.Int64
+		log.OriginalGroupID = &value
+	}
+	if pricingVersion.Valid {
+		log.PricingVersion = &pricingVersion.String
+	}
 
 	return log, nil
 }
@@ -2248,6 +2374,13 @@ func nullInt64(v *int64) sql.NullInt64 {
 	return sql.NullInt64{Int64: *v, Valid: true}
 }
 
+func nullFloat64(v *float64) sql.NullFloat64 {
+	if v == nil {
+		return sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Float64: *v, Valid: true}
+}
+
 func nullInt(v *int) sql.NullInt64 {
 	if v == nil {
 		return sql.NullInt64{}
@@ -2255,6 +2388,14 @@ func nullInt(v *int) sql.NullInt64 {
 	return sql.NullInt64{Int64: int64(*v), Valid: true}
 }
 
+func nullInt64Ptr(v sql.NullInt64) *int64 {
+	if !v.Valid {
+		return nil
+	}
+	out := v.Int64
+	return &out
+}
+
 func nullFloat64Ptr(v sql.NullFloat64) *float64 {
 	if !v.Valid {
 		return nil
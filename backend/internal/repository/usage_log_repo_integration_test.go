@@ -844,6 +844,45 @@ func (s *UsageLogRepoSuite) TestGetAccountWindowStats() {
 	s.Require().Equal(int64(70), stats.Tokens) // (10+20) + (15+25)
 }
 
+// --- GetUserSpendSince ---
+
+func (s *UsageLogRepoSuite) TestGetUserSpendSince() {
+	user := mustCreateUser(s.T(), s.client, &service.User{Email: "spendsince@test.com"})
+	apiKey := mustCreateApiKey(s.T(), s.client, &service.APIKey{UserID: user.ID, Key: "sk-spendsince", Name: "k"})
+	account := mustCreateAccount(s.T(), s.client, &service.Account{Name: "acc-spendsince"})
+
+	now := time.Now()
+	s.createUsageLog(user, apiKey, account, 10, 20, 1.5, now.Add(-24*time.Hour))
+	s.createUsageLog(user, apiKey, account, 10, 20, 2.5, now.Add(-10*24*time.Hour))
+	s.createUsageLog(user, apiKey, account, 10, 20, 9, now.Add(-40*24*time.Hour)) // outside window
+
+	spend, err := s.repo.GetUserSpendSince(s.ctx, user.ID, now.Add(-30*24*time.Hour))
+	s.Require().NoError(err, "GetUserSpendSince")
+	s.Require().InDelta(4.0, spend, 1e-9)
+}
+
+// --- GetAPIKeyLimitUsage ---
+
+func (s *UsageLogRepoSuite) TestGetAPIKeyLimitUsage() {
+	user := mustCreateUser(s.T(), s.client, &service.User{Email: "keylimit@test.com"})
+	apiKey := mustCreateApiKey(s.T(), s.client, &service.APIKey{UserID: user.ID, Key: "sk-keylimit", Name: "k"})
+	account := mustCreateAccount(s.T(), s.client, &service.Account{Name: "acc-keylimit"})
+
+	dayStart := time.Date(2025, 3, 20, 0, 0, 0, 0, time.UTC)
+	monthStart := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	s.createUsageLog(user, apiKey, account, 10, 20, 1, dayStart.Add(2*time.Hour))
+	s.createUsageLog(user, apiKey, account, 10, 20, 2, dayStart.Add(-24*time.Hour))
+	s.createUsageLog(user, apiKey, account, 10, 20, 4, monthStart.Add(-24*time.Hour))
+
+	usage, err := s.repo.GetAPIKeyLimitUsage(s.ctx, apiKey.ID, dayStart, monthStart)
+	s.Require().NoError(err, "GetAPIKeyLimitUsage")
+	s.Require().InDelta(1.0, usage.DailyUsage, 1e-9)
+	s.Require().Equal(int64(1), usage.DailyRequests)
+	s.Require().InDelta(3.0, usage.MonthlyUsage, 1e-9)
+	s.Require().Equal(int64(2), usage.MonthlyRequests)
+	s.Require().InDelta(7.0, usage.TotalUsage, 1e-9)
+}
+
 // --- GetUserUsageTrendByUserID ---
 
 func (s *UsageLogRepoSuite) TestGetUserUsageTrendByUserID() {
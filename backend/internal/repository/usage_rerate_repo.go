@@ -0,0 +1,480 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+const usageRerateTaskColumns = "id, status, filters, apply_changes, post_adjustments, last_usage_log_id, processed_rows, changed_rows, old_total_cost, new_total_cost, old_actual_cost, new_actual_cost, error_message, created_by, canceled_by, canceled_at, started_at, finished_at, created_at, updated_at"
+
+const usageRerateDiffColumns = "task_id, user_id, row_count, changed_rows, old_total_cost, new_total_cost, old_actual_cost, new_actual_cost, balance_delta, adjustment_tx_id, adjusted_at"
+
+type usageRerateRepository struct {
+	sql sqlExecutor
+}
+
+func NewUsageRerateRepository(sqlDB *sql.DB) service.UsageRerateRepository {
+	return &usageRerateRepository{sql: sqlDB}
+}
+
+func (r *usageRerateRepository) CreateTask(ctx context.Context, task *service.UsageRerateTask) error {
+	if task == nil {
+		return nil
+	}
+	filtersJSON, err := json.Marshal(task.Filters)
+	if err != nil {
+		return fmt.Errorf("marshal rerate filters: %w", err)
+	}
+	query := `
+		INSERT INTO usage_rerate_tasks (status, filters, apply_changes, post_adjustments, created_by)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at, updated_at
+	`
+	args := []any{task.Status, filtersJSON, task.ApplyChanges, task.PostAdjustments, task.CreatedBy}
+	return scanSingleRow(ctx, sqlExecutorFromContext(ctx, r.sql), query, args, &task.ID, &task.CreatedAt, &task.UpdatedAt)
+}
+
+func (r *usageRerateRepository) ListTasks(ctx context.Context, params pagination.PaginationParams) ([]service.UsageRerateTask, *pagination.PaginationResult, error) {
+	var total int64
+	if err := scanSingleRow(ctx, r.sql, "SELECT COUNT(*) FROM usage_rerate_tasks", nil, &total); err != nil {
+		return nil, nil, err
+	}
+	if total == 0 {
+		return []service.UsageRerateTask{}, paginationResultFromTotal(0, params), nil
+	}
+	rows, err := r.sql.QueryContext(ctx, `
+		SELECT `+usageRerateTaskColumns+`
+		FROM usage_rerate_tasks
+		ORDER BY created_at DESC, id DESC
+		LIMIT $1 OFFSET $2
+	`, params.Limit(), params.Offset())
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	tasks := make([]service.UsageRerateTask, 0)
+	for rows.Next() {
+		task, err := scanUsageRerateTask(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, *task)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+	return tasks, paginationResultFromTotal(total, params), nil
+}
+
+func (r *usageRerateRepository) GetTask(ctx context.Context, taskID int64) (*service.UsageRerateTask, error) {
+	rows, err := r.sql.QueryContext(ctx, "SELECT "+usageRerateTaskColumns+" FROM usage_rerate_tasks WHERE id = $1", taskID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		return nil, sql.ErrNoRows
+	}
+	task, err := scanUsageRerateTask(rows)
+	if err != nil {
+		return nil, err
+	}
+	return task, rows.Err()
+}
+
+// ClaimNextPendingTask 抢占下一条可执行任务
+// 回写类任务（apply_changes）串行执行：已有未超时的回写任务在运行时不抢占新的回写任务，
+// 避免两个任务基于同一批旧费用重复计算差额
+func (r *usageRerateRepository) ClaimNextPendingTask(ctx context.Context, staleRunningAfterSeconds int64) (*service.UsageRerateTask, error) {
+	if staleRunningAfterSeconds <= 0 {
+		staleRunningAfterSeconds = 3600
+	}
+	returning := "tasks." + strings.ReplaceAll(usageRerateTaskColumns, ", ", ", tasks.")
+	query := `
+		WITH next AS (
+			SELECT t.id
+			FROM usage_rerate_tasks t
+			WHERE (
+					t.status = $1
+					OR (
+						t.status = $2
+						AND t.started_at IS NOT NULL
+						AND t.started_at < NOW() - ($3 * interval '1 second')
+					)
+				)
+				AND NOT (
+					t.apply_changes
+					AND EXISTS (
+						SELECT 1
+						FROM usage_rerate_tasks o
+						WHERE o.id <> t.id
+							AND o.apply_changes
+							AND o.status = $2
+							AND o.started_at >= NOW() - ($3 * interval '1 second')
+					)
+				)
+			ORDER BY t.created_at ASC, t.id ASC
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE usage_rerate_tasks AS tasks
+		SET status = $2,
+			started_at = NOW(),
+			finished_at = NULL,
+			error_message = NULL,
+			updated_at = NOW()
+		FROM next
+		WHERE tasks.id = next.id
+		RETURNING ` + returning
+	rows, err := r.sql.QueryContext(ctx, query, service.UsageRerateStatusPending, service.UsageRerateStatusRunning, staleRunningAfterSeconds)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	task, err := scanUsageRerateTask(rows)
+	if err != nil {
+		return nil, err
+	}
+	return task, rows.Err()
+}
+
+func (r *usageRerateRepository) GetTaskStatus(ctx context.Context, taskID int64) (string, error) {
+	var status string
+	if err := scanSingleRow(ctx, r.sql, "SELECT status FROM usage_rerate_tasks WHERE id = $1", []any{taskID}, &status); err != nil {
+		return "", err
+	}
+	return status, nil
+}
+
+func (r *usageRerateRepository) CancelTask(ctx context.Context, taskID int64, canceledBy int64) (bool, error) {
+	query := `
+		UPDATE usage_rerate_tasks
+		SET status = $1,
+			canceled_by = $3,
+			canceled_at = NOW(),
+			finished_at = NOW(),
+			error_message = NULL,
+			updated_at = NOW()
+		WHERE id = $2
+			AND status IN ($4, $5)
+		RETURNING id
+	`
+	var id int64
+	err := scanSingleRow(ctx, r.sql, query, []any{
+		service.UsageRerateStatusCanceled,
+		taskID,
+		canceledBy,
+		service.UsageRerateStatusPending,
+		service.UsageRerateStatusRunning,
+	}, &id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *usageRerateRepository) MarkTaskSucceeded(ctx context.Context, taskID int64) error {
+	_, err := r.sql.ExecContext(ctx, `
+		UPDATE usage_rerate_tasks
+		SET status = $1, finished_at = NOW(), updated_at = NOW()
+		WHERE id = $2 AND status = $3
+	`, service.UsageRerateStatusSucceeded, taskID, service.UsageRerateStatusRunning)
+	return err
+}
+
+func (r *usageRerateRepository) MarkTaskFailed(ctx context.Context, taskID int64, errorMsg string) error {
+	_, err := r.sql.ExecContext(ctx, `
+		UPDATE usage_rerate_tasks
+		SET status = $1, error_message = $2, finished_at = NOW(), updated_at = NOW()
+		WHERE id = $3 AND status = $4
+	`, service.UsageRerateStatusFailed, errorMsg, taskID, service.UsageRerateStatusRunning)
+	return err
+}
+
+func (r *usageRerateRepository) ListUsageLogsBatch(ctx context.Context, filters service.UsageCleanupFilters, afterID int64, limit int) ([]service.UsageLog, error) {
+	whereClause, args := buildUsageCleanupWhere(filters)
+	if filters.StartTime.IsZero() || filters.EndTime.IsZero() || whereClause == "" {
+		return nil, fmt.Errorf("rerate filters missing time range")
+	}
+	args = append(args, afterID, limit)
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM usage_logs
+		WHERE %s AND id > $%d
+		ORDER BY id ASC
+		LIMIT $%d
+	`, usageLogSelectColumns, whereClause, len(args)-1, len(args))
+
+	rows, err := sqlExecutorFromContext(ctx, r.sql).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	logs := make([]service.UsageLog, 0, limit)
+	for rows.Next() {
+		usageLog, err := scanUsageLog(rows)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, *usageLog)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
+
+func (r *usageRerateRepository) ApplyBatch(ctx context.Context, taskID int64, updates []service.UsageRerateUpdate, diffs []service.UsageRerateDiff, progress service.UsageRerateProgress) error {
+	exec := sqlExecutorFromContext(ctx, r.sql)
+
+	for _, u := range updates {
+		if u.Cost == nil {
+			continue
+		}
+		pricing := u.Cost.Pricing
+		if pricing == nil {
+			pricing = &service.AppliedPricing{}
+		}
+		var subscriptionCost, overageCost *float64
+		if u.Overage != nil {
+			subscriptionCost, overageCost = &u.Overage.SubscriptionCost, &u.Overage.OverageCost
+		}
+		if _, err := exec.ExecContext(ctx, `
+			UPDATE usage_logs
+			SET input_cost = $2,
+				output_cost = $3,
+				cache_creation_cost = $4,
+				cache_read_cost = $5,
+				total_cost = $6,
+				actual_cost = $7,
+				pricing_version = $8,
+				input_unit_price = $9,
+				output_unit_price = $10,
+				cache_creation_unit_price = $11,
+				cache_read_unit_price = $12,
+				image_unit_price = $13,
+				subscription_cost = COALESCE($14, subscription_cost),
+				overage_cost = COALESCE($15, overage_cost)
+			WHERE id = $1
+		`,
+			u.UsageLogID,
+			u.Cost.InputCost,
+			u.Cost.OutputCost,
+			u.Cost.CacheCreationCost,
+			u.Cost.CacheReadCost,
+			u.Cost.TotalCost,
+			u.Cost.ActualCost,
+			nullString(&pricing.Version),
+			nullFloat64(pricing.InputPrice),
+			nullFloat64(pricing.OutputPrice),
+			nullFloat64(pricing.CacheCreationPrice),
+			nullFloat64(pricing.CacheReadPrice),
+			nullFloat64(pricing.ImagePrice),
+			nullFloat64(subscriptionCost),
+			nullFloat64(overageCost),
+		); err != nil {
+			return fmt.Errorf("update usage log %d: %w", u.UsageLogID, err)
+		}
+	}
+
+	for _, d := range diffs {
+		if _, err := exec.ExecContext(ctx, `
+			INSERT INTO usage_rerate_diffs (
+				task_id, user_id, row_count, changed_rows,
+				old_total_cost, new_total_cost, old_actual_cost, new_actual_cost, balance_delta
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+			ON CONFLICT (task_id, user_id) DO UPDATE SET
+				row_count = usage_rerate_diffs.row_count + EXCLUDED.row_count,
+				changed_rows = usage_rerate_diffs.changed_rows + EXCLUDED.changed_rows,
+				old_total_cost = usage_rerate_diffs.old_total_cost + EXCLUDED.old_total_cost,
+				new_total_cost = usage_rerate_diffs.new_total_cost + EXCLUDED.new_total_cost,
+				old_actual_cost = usage_rerate_diffs.old_actual_cost + EXCLUDED.old_actual_cost,
+				new_actual_cost = usage_rerate_diffs.new_actual_cost + EXCLUDED.new_actual_cost,
+				balance_delta = usage_rerate_diffs.balance_delta + EXCLUDED.balance_delta
+		`, taskID, d.UserID, d.Rows, d.ChangedRows, d.OldTotalCost, d.NewTotalCost, d.OldActualCost, d.NewActualCost, d.BalanceDelta); err != nil {
+			return fmt.Errorf("upsert rerate diff for user %d: %w", d.UserID, err)
+		}
+	}
+
+	_, err := exec.ExecContext(ctx, `
+		UPDATE usage_rerate_tasks
+		SET last_usage_log_id = $2,
+			processed_rows = $3,
+			changed_rows = $4,
+			old_total_cost = $5,
+			new_total_cost = $6,
+			old_actual_cost = $7,
+			new_actual_cost = $8,
+			updated_at = NOW()
+		WHERE id = $1
+	`, taskID, progress.LastUsageLogID, progress.ProcessedRows, progress.ChangedRows,
+		progress.OldTotalCost, progress.NewTotalCost, progress.OldActualCost, progress.NewActualCost)
+	return err
+}
+
+func (r *usageRerateRepository) ListDiffs(ctx context.Context, taskID int64, params pagination.PaginationParams) ([]service.UsageRerateDiff, *pagination.PaginationResult, error) {
+	var total int64
+	if err := scanSingleRow(ctx, r.sql, "SELECT COUNT(*) FROM usage_rerate_diffs WHERE task_id = $1", []any{taskID}, &total); err != nil {
+		return nil, nil, err
+	}
+	if total == 0 {
+		return []service.UsageRerateDiff{}, paginationResultFromTotal(0, params), nil
+	}
+	diffs, err := r.queryDiffs(ctx, `
+		SELECT `+usageRerateDiffColumns+`
+		FROM usage_rerate_diffs
+		WHERE task_id = $1
+		ORDER BY ABS(new_actual_cost - old_actual_cost) DESC, user_id ASC
+		LIMIT $2 OFFSET $3
+	`, taskID, params.Limit(), params.Offset())
+	if err != nil {
+		return nil, nil, err
+	}
+	return diffs, paginationResultFromTotal(total, params), nil
+}
+
+func (r *usageRerateRepository) ListPendingAdjustments(ctx context.Context, taskID int64, minAbsDelta float64, limit int) ([]service.UsageRerateDiff, error) {
+	return r.queryDiffs(ctx, `
+		SELECT `+usageRerateDiffColumns+`
+		FROM usage_rerate_diffs
+		WHERE task_id = $1 AND adjusted_at IS NULL AND ABS(balance_delta) >= $2
+		ORDER BY user_id ASC
+		LIMIT $3
+	`, taskID, minAbsDelta, limit)
+}
+
+func (r *usageRerateRepository) MarkDiffAdjusted(ctx context.Context, taskID, userID int64, txID *int64) error {
+	res, err := sqlExecutorFromContext(ctx, r.sql).ExecContext(ctx, `
+		UPDATE usage_rerate_diffs
+		SET adjustment_tx_id = $3, adjusted_at = NOW()
+		WHERE task_id = $1 AND user_id = $2 AND adjusted_at IS NULL
+	`, taskID, userID, nullInt64(txID))
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		// 已被其他执行者调整：返回错误使调用方回滚本次余额变动
+		return fmt.Errorf("rerate diff already adjusted: task=%d user=%d", taskID, userID)
+	}
+	return nil
+}
+
+func (r *usageRerateRepository) queryDiffs(ctx context.Context, query string, args ...any) ([]service.UsageRerateDiff, error) {
+	rows, err := r.sql.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	diffs := make([]service.UsageRerateDiff, 0)
+	for rows.Next() {
+		var d service.UsageRerateDiff
+		var txID sql.NullInt64
+		var adjustedAt sql.NullTime
+		if err := rows.Scan(
+			&d.TaskID,
+			&d.UserID,
+			&d.Rows,
+			&d.ChangedRows,
+			&d.OldTotalCost,
+			&d.NewTotalCost,
+			&d.OldActualCost,
+			&d.NewActualCost,
+			&d.BalanceDelta,
+			&txID,
+			&adjustedAt,
+		); err != nil {
+			return nil, err
+		}
+		if txID.Valid {
+			v := txID.Int64
+			d.AdjustmentTxID = &v
+		}
+		if adjustedAt.Valid {
+			d.AdjustedAt = &adjustedAt.Time
+		}
+		diffs = append(diffs, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return diffs, nil
+}
+
+func scanUsageRerateTask(scanner interface{ Scan(...any) error }) (*service.UsageRerateTask, error) {
+	var (
+		task        service.UsageRerateTask
+		filtersJSON []byte
+		errMsg      sql.NullString
+		canceledBy  sql.NullInt64
+		canceledAt  sql.NullTime
+		startedAt   sql.NullTime
+		finishedAt  sql.NullTime
+	)
+	if err := scanner.Scan(
+		&task.ID,
+		&task.Status,
+		&filtersJSON,
+		&task.ApplyChanges,
+		&task.PostAdjustments,
+		&task.LastUsageLogID,
+		&task.ProcessedRows,
+		&task.ChangedRows,
+		&task.OldTotalCost,
+		&task.NewTotalCost,
+		&task.OldActualCost,
+		&task.NewActualCost,
+		&errMsg,
+		&task.CreatedBy,
+		&canceledBy,
+		&canceledAt,
+		&startedAt,
+		&finishedAt,
+		&task.CreatedAt,
+		&task.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(filtersJSON, &task.Filters); err != nil {
+		return nil, fmt.Errorf("parse rerate filters: %w", err)
+	}
+	if errMsg.Valid {
+		task.ErrorMsg = &errMsg.String
+	}
+	if canceledBy.Valid {
+		v := canceledBy.Int64
+		task.CanceledBy = &v
+	}
+	if canceledAt.Valid {
+		task.CanceledAt = &canceledAt.Time
+	}
+	if startedAt.Valid {
+		task.StartedAt = &startedAt.Time
+	}
+	if finishedAt.Valid {
+		task.FinishedAt = &finishedAt.Time
+	}
+	return &task, nil
+}
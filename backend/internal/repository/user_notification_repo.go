@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type userNotificationRepository struct {
+	sql sqlExecutor
+}
+
+func NewUserNotificationRepository(sqlDB *sql.DB) service.UserNotificationRepository {
+	return &userNotificationRepository{sql: sqlDB}
+}
+
+func (r *userNotificationRepository) GetSettings(ctx context.Context, userID int64) (*service.UserNotificationSettings, error) {
+	var (
+		settings         service.UserNotificationSettings
+		balanceThreshold sql.NullFloat64
+		usagePercent     sql.NullFloat64
+	)
+	err := scanSingleRow(ctx, r.sql, `
+		SELECT user_id, balance_threshold, subscription_usage_percent, email_enabled, webhook_url, updated_at
+		FROM user_notification_settings
+		WHERE user_id = $1
+	`, []any{userID},
+		&settings.UserID,
+		&balanceThreshold,
+		&usagePercent,
+		&settings.EmailEnabled,
+		&settings.WebhookURL,
+		&settings.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	settings.BalanceThreshold = nullFloat64Ptr(balanceThreshold)
+	settings.SubscriptionUsagePercent = nullFloat64Ptr(usagePercent)
+	return &settings, nil
+}
+
+func (r *userNotificationRepository) UpsertSettings(ctx context.Context, settings *service.UserNotificationSettings) error {
+	return scanSingleRow(ctx, r.sql, `
+		INSERT INTO user_notification_settings (user_id, balance_threshold, subscription_usage_percent, email_enabled, webhook_url)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id) DO UPDATE SET
+			balance_threshold = EXCLUDED.balance_threshold,
+			subscription_usage_percent = EXCLUDED.subscription_usage_percent,
+			email_enabled = EXCLUDED.email_enabled,
+			webhook_url = EXCLUDED.webhook_url,
+			updated_at = NOW()
+		RETURNING updated_at
+	`, []any{
+		settings.UserID,
+		nullFloat64(settings.BalanceThreshold),
+		nullFloat64(settings.SubscriptionUsagePercent),
+		settings.EmailEnabled,
+		settings.WebhookURL,
+	}, &settings.UpdatedAt)
+}
+
+func (r *userNotificationRepository) ClaimEvent(ctx context.Context, userID int64, kind, dedupKey string) (bool, error) {
+	res, err := r.sql.ExecContext(ctx, `
+		INSERT INTO user_notification_events (user_id, kind, dedup_key)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, kind, dedup_key) DO NOTHING
+	`, userID, kind, dedupKey)
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/httpclient"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+const userNotificationWebhookTimeout = 10 * time.Second
+
+type userNotificationWebhookSender struct {
+	httpClient *http.Client
+	initErr    error
+}
+
+// NewUserNotificationWebhookSender 用户 webhook 地址不可信，请求时校验解析后的 IP 以防 SSRF/DNS Rebinding
+// 无法创建带 IP 校验的客户端时禁用投递（fail closed），不回退到不校验的默认客户端
+func NewUserNotificationWebhookSender() service.UserNotificationWebhookSender {
+	sharedClient, err := httpclient.GetClient(httpclient.Options{
+		Timeout:            userNotificationWebhookTimeout,
+		ValidateResolvedIP: true,
+	})
+	if err != nil {
+		slog.Error("user_notification_webhook_disabled", "error", err)
+		return &userNotificationWebhookSender{initErr: err}
+	}
+	return &userNotificationWebhookSender{httpClient: sharedClient}
+}
+
+func (s *userNotificationWebhookSender) Send(ctx context.Context, url string, payload *service.UserNotificationPayload) error {
+	if s.httpClient == nil {
+		return fmt.Errorf("webhook delivery disabled: %w", s.initErr)
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("marshal payload: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Sub2API-Notification/1.0")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
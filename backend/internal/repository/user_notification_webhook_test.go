@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserNotificationWebhookSender_Send(t *testing.T) {
+	var received service.UserNotificationPayload
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	sender := &userNotificationWebhookSender{httpClient: srv.Client()}
+	payload := &service.UserNotificationPayload{
+		Event:     service.UserNotificationLowBalance,
+		UserID:    42,
+		Value:     0.5,
+		Threshold: 1,
+		Timestamp: time.Now(),
+	}
+	require.NoError(t, sender.Send(context.Background(), srv.URL, payload))
+	require.Equal(t, service.UserNotificationLowBalance, received.Event)
+	require.Equal(t, int64(42), received.UserID)
+
+	status = http.StatusInternalServerError
+	require.Error(t, sender.Send(context.Background(), srv.URL, payload))
+}
+
+func TestUserNotificationWebhookSender_DisabledWithoutClient(t *testing.T) {
+	var hits int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+	}))
+	defer srv.Close()
+
+	sender := &userNotificationWebhookSender{initErr: errors.New("client init failed")}
+	err := sender.Send(context.Background(), srv.URL, &service.UserNotificationPayload{Event: service.UserNotificationLowBalance})
+	require.ErrorContains(t, err, "webhook delivery disabled")
+	require.Zero(t, hits)
+}
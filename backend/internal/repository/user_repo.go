@@ -68,6 +68,23 @@ func (r *userRepository) Create(ctx context.Context, userIn *service.User) error
 		return err
 	}
 
+	// 初始余额（注册赠送 / 管理员创建时指定）作为期初流水写入，正余额同时形成永不过期的期初批次
+	if userIn.Balance != 0 {
+		var openingTxID int64
+		if err := scanSingleRow(ctx, txClient, `
+			INSERT INTO balance_transactions (user_id, type, amount, balance_after, created_at)
+			VALUES ($1, $2, $3, $3, NOW())
+			RETURNING id
+		`, []any{created.ID, service.BalanceTxTypeOpening, userIn.Balance}, &openingTxID); err != nil {
+			return err
+		}
+		if userIn.Balance > 0 {
+			if err := insertCreditLot(ctx, txClient, created.ID, service.BalanceTxTypeOpening, userIn.Balance, userIn.Balance, nil, &openingTxID); err != nil {
+				return err
+			}
+		}
+	}
+
 	if tx != nil {
 		if err := tx.Commit(); err != nil {
 			return err
@@ -132,13 +149,14 @@ func (r *userRepository) Update(ctx context.Context, userIn *service.User) error
 		txClient = r.client
 	}
 
+	// 余额只能通过 ApplyBalanceChange 变更（同时写入余额流水），这里不回写 balance，
+	// 避免读-改-写覆盖并发扣费
 	updated, err := txClient.User.UpdateOneID(userIn.ID).
 		SetEmail(userIn.Email).
 		SetUsername(userIn.Username).
 		SetNotes(userIn.Notes).
 		SetPasswordHash(userIn.PasswordHash).
 		SetRole(userIn.Role).
-		SetBalance(userIn.Balance).
 		SetConcurrency(userIn.Concurrency).
 		SetStatus(userIn.Status).
 		Save(ctx)
@@ -318,33 +336,61 @@ func (r *userRepository) filterUsersByAttributes(ctx context.Context, attrs map[
 }
 
 func (r *userRepository) UpdateBalance(ctx context.Context, id int64, amount float64) error {
-	client := clientFromContext(ctx, r.client)
-	n, err := client.User.Update().Where(dbuser.IDEQ(id)).AddBalance(amount).Save(ctx)
-	if err != nil {
-		return translatePersistenceError(err, service.ErrUserNotFound, nil)
-	}
-	if n == 0 {
-		return service.ErrUserNotFound
-	}
-	return nil
+	_, err := r.ApplyBalanceChange(ctx, &service.BalanceChange{
+		UserID: id,
+		Type:   service.BalanceTxTypeAdjustment,
+		Amount: amount,
+	})
+	return err
 }
 
 // DeductBalance 扣除用户余额
 // 透支策略：允许余额变为负数，确保当前请求能够完成
 // 中间件会阻止余额 <= 0 的用户发起后续请求
 func (r *userRepository) DeductBalance(ctx context.Context, id int64, amount float64) error {
-	client := clientFromContext(ctx, r.client)
-	n, err := client.User.Update().
-		Where(dbuser.IDEQ(id)).
-		AddBalance(-amount).
-		Save(ctx)
+	_, err := r.ApplyBalanceChange(ctx, &service.BalanceChange{
+		UserID: id,
+		Type:   service.BalanceTxTypeUsage,
+		Amount: -amount,
+	})
+	return err
+}
+
+// ApplyBalanceChange 变更用户余额并写入余额流水，同时维护额度批次
+// 余额更新、流水写入与批次增减在同一事务中完成，保证三者原子一致；
+// 处于事务上下文时复用事务连接，与调用方的其他写入一同提交或回滚。
+func (r *userRepository) ApplyBalanceChange(ctx context.Context, change *service.BalanceChange) (*service.BalanceTransaction, error) {
+	if change == nil {
+		return nil, fmt.Errorf("nil balance change")
+	}
+	if tx := dbent.TxFromContext(ctx); tx != nil {
+		return applyBalanceChangeWithExec(ctx, tx.Client(), change)
+	}
+	if r.client == nil {
+		if r.sql == nil {
+			return nil, fmt.Errorf("sql executor is not configured")
+		}
+		return applyBalanceChangeWithExec(ctx, r.sql, change)
+	}
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil && !errors.Is(err, dbent.ErrTxStarted) {
+		return nil, err
+	}
 	if err != nil {
-		return err
+		// 已处于外部事务中（ErrTxStarted），复用当前 client 并由调用方负责提交/回滚。
+		return applyBalanceChangeWithExec(ctx, r.client, change)
 	}
-	if n == 0 {
-		return service.ErrUserNotFound
+	defer func() { _ = tx.Rollback() }()
+
+	out, err := applyBalanceChangeWithExec(ctx, tx.Client(), change)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
 func (r *userRepository) UpdateConcurrency(ctx context.Context, id int64, amount int) error {
@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	dbent "github.com/Wei-Shaw/sub2api/ent"
@@ -34,7 +36,12 @@ func (r *userSubscriptionRepository) Create(ctx context.Context, sub *service.Us
 		SetDailyUsageUsd(sub.DailyUsageUSD).
 		SetWeeklyUsageUsd(sub.WeeklyUsageUSD).
 		SetMonthlyUsageUsd(sub.MonthlyUsageUSD).
-		SetNillableAssignedBy(sub.AssignedBy)
+		SetNillableAssignedBy(sub.AssignedBy).
+		SetNillablePlanID(sub.PlanID).
+		SetAutoRenew(sub.AutoRenew).
+		SetNillableDailyLimitUsd(sub.DailyLimitUSD).
+		SetNillableWeeklyLimitUsd(sub.WeeklyLimitUSD).
+		SetNillableMonthlyLimitUsd(sub.MonthlyLimitUSD)
 
 	if sub.StartsAt.IsZero() {
 		builder.SetStartsAt(time.Now())
@@ -120,7 +127,29 @@ func (r *userSubscriptionRepository) Update(ctx context.Context, sub *service.Us
 		SetMonthlyUsageUsd(sub.MonthlyUsageUSD).
 		SetNillableAssignedBy(sub.AssignedBy).
 		SetAssignedAt(sub.AssignedAt).
-		SetNotes(sub.Notes)
+		SetNotes(sub.Notes).
+		SetAutoRenew(sub.AutoRenew)
+
+	if sub.PlanID != nil {
+		builder.SetPlanID(*sub.PlanID)
+	} else {
+		builder.ClearPlanID()
+	}
+	if sub.DailyLimitUSD != nil {
+		builder.SetDailyLimitUsd(*sub.DailyLimitUSD)
+	} else {
+		builder.ClearDailyLimitUsd()
+	}
+	if sub.WeeklyLimitUSD != nil {
+		builder.SetWeeklyLimitUsd(*sub.WeeklyLimitUSD)
+	} else {
+		builder.ClearWeeklyLimitUsd()
+	}
+	if sub.MonthlyLimitUSD != nil {
+		builder.SetMonthlyLimitUsd(*sub.MonthlyLimitUSD)
+	} else {
+		builder.ClearMonthlyLimitUsd()
+	}
 
 	updated, err := builder.Save(ctx)
 	if err == nil {
@@ -254,6 +283,95 @@ func (r *userSubscriptionRepository) UpdateNotes(ctx context.Context, subscripti
 	return translatePersistenceError(err, service.ErrSubscriptionNotFound, nil)
 }
 
+func (r *userSubscriptionRepository) Pause(ctx context.Context, subscriptionID int64, pausedAt time.Time) (bool, error) {
+	client := clientFromContext(ctx, r.client)
+	n, err := client.UserSubscription.Update().
+		Where(
+			usersubscription.IDEQ(subscriptionID),
+			usersubscription.StatusEQ(service.SubscriptionStatusActive),
+			usersubscription.DeletedAtIsNil(),
+		).
+		SetStatus(service.SubscriptionStatusPaused).
+		SetPausedAt(pausedAt).
+		Save(ctx)
+	return n > 0, err
+}
+
+func (r *userSubscriptionRepository) Resume(ctx context.Context, subscriptionID int64, expiresAt time.Time) (bool, error) {
+	client := clientFromContext(ctx, r.client)
+	n, err := client.UserSubscription.Update().
+		Where(
+			usersubscription.IDEQ(subscriptionID),
+			usersubscription.StatusEQ(service.SubscriptionStatusPaused),
+			usersubscription.DeletedAtIsNil(),
+		).
+		SetStatus(service.SubscriptionStatusActive).
+		SetExpiresAt(expiresAt).
+		ClearPausedAt().
+		Save(ctx)
+	return n > 0, err
+}
+
+func (r *userSubscriptionRepository) Transfer(ctx context.Context, subscriptionID, fromUserID, toUserID int64) (bool, error) {
+	client := clientFromContext(ctx, r.client)
+	n, err := client.UserSubscription.Update().
+		Where(
+			usersubscription.IDEQ(subscriptionID),
+			usersubscription.UserIDEQ(fromUserID),
+			usersubscription.DeletedAtIsNil(),
+		).
+		SetUserID(toUserID).
+		SetAutoRenew(false).
+		Save(ctx)
+	if err != nil {
+		// 接收人已有该分组订阅时违反 (user_id, group_id) 部分唯一索引
+		return false, translatePersistenceError(err, nil, service.ErrSubscriptionAlreadyExists)
+	}
+	return n > 0, nil
+}
+
+func (r *userSubscriptionRepository) ApplyPlan(ctx context.Context, sub *service.UserSubscription) error {
+	if sub == nil {
+		return service.ErrSubscriptionNilInput
+	}
+	client := clientFromContext(ctx, r.client)
+	builder := client.UserSubscription.UpdateOneID(sub.ID).
+		SetStartsAt(sub.StartsAt).
+		SetExpiresAt(sub.ExpiresAt).
+		SetStatus(sub.Status).
+		SetNillablePlanID(sub.PlanID).
+		SetAutoRenew(sub.AutoRenew)
+	if sub.DailyLimitUSD != nil {
+		builder.SetDailyLimitUsd(*sub.DailyLimitUSD)
+	} else {
+		builder.ClearDailyLimitUsd()
+	}
+	if sub.WeeklyLimitUSD != nil {
+		builder.SetWeeklyLimitUsd(*sub.WeeklyLimitUSD)
+	} else {
+		builder.ClearWeeklyLimitUsd()
+	}
+	if sub.MonthlyLimitUSD != nil {
+		builder.SetMonthlyLimitUsd(*sub.MonthlyLimitUSD)
+	} else {
+		builder.ClearMonthlyLimitUsd()
+	}
+	updated, err := builder.Save(ctx)
+	if err != nil {
+		return translatePersistenceError(err, service.ErrSubscriptionNotFound, nil)
+	}
+	sub.UpdatedAt = updated.UpdatedAt
+	return nil
+}
+
+func (r *userSubscriptionRepository) UpdateAutoRenew(ctx context.Context, subscriptionID int64, autoRenew bool) error {
+	client := clientFromContext(ctx, r.client)
+	_, err := client.UserSubscription.UpdateOneID(subscriptionID).
+		SetAutoRenew(autoRenew).
+		Save(ctx)
+	return translatePersistenceError(err, service.ErrSubscriptionNotFound, nil)
+}
+
 func (r *userSubscriptionRepository) ActivateWindows(ctx context.Context, id int64, start time.Time) error {
 	client := clientFromContext(ctx, r.client)
 	_, err := client.UserSubscription.UpdateOneID(id).
@@ -265,30 +383,47 @@ func (r *userSubscriptionRepository) ActivateWindows(ctx context.Context, id int
 }
 
 func (r *userSubscriptionRepository) ResetDailyUsage(ctx context.Context, id int64, newWindowStart time.Time) error {
-	client := clientFromContext(ctx, r.client)
-	_, err := client.UserSubscription.UpdateOneID(id).
-		SetDailyUsageUsd(0).
-		SetDailyWindowStart(newWindowStart).
-		Save(ctx)
-	return translatePersistenceError(err, service.ErrSubscriptionNotFound, nil)
+	return r.resetWindowUsage(ctx, id, service.QuotaWindowDaily, newWindowStart)
 }
 
 func (r *userSubscriptionRepository) ResetWeeklyUsage(ctx context.Context, id int64, newWindowStart time.Time) error {
-	client := clientFromContext(ctx, r.client)
-	_, err := client.UserSubscription.UpdateOneID(id).
-		SetWeeklyUsageUsd(0).
-		SetWeeklyWindowStart(newWindowStart).
-		Save(ctx)
-	return translatePersistenceError(err, service.ErrSubscriptionNotFound, nil)
+	return r.resetWindowUsage(ctx, id, service.QuotaWindowWeekly, newWindowStart)
 }
 
 func (r *userSubscriptionRepository) ResetMonthlyUsage(ctx context.Context, id int64, newWindowStart time.Time) error {
+	return r.resetWindowUsage(ctx, id, service.QuotaWindowMonthly, newWindowStart)
+}
+
+// resetWindowUsage 重置指定窗口的 USD 用量与窗口起点，并在同一条 UPDATE 中清除该窗口的请求数/Token 配额用量
+// window 只取 daily / weekly / monthly 常量，用于拼接列名
+func (r *userSubscriptionRepository) resetWindowUsage(ctx context.Context, id int64, window string, newWindowStart time.Time) error {
+	query := fmt.Sprintf(`
+		UPDATE user_subscriptions
+		SET
+			%[1]s_usage_usd = 0,
+			%[1]s_window_start = $1,
+			quota_usage = (
+				SELECT jsonb_object_agg(key, value)
+				FROM jsonb_each(COALESCE(quota_usage, '{}'::jsonb))
+				WHERE key NOT LIKE $2
+			),
+			updated_at = NOW()
+		WHERE id = $3 AND deleted_at IS NULL
+	`, window)
+
 	client := clientFromContext(ctx, r.client)
-	_, err := client.UserSubscription.UpdateOneID(id).
-		SetMonthlyUsageUsd(0).
-		SetMonthlyWindowStart(newWindowStart).
-		Save(ctx)
-	return translatePersistenceError(err, service.ErrSubscriptionNotFound, nil)
+	result, err := client.ExecContext(ctx, query, newWindowStart, service.QuotaUsageKey(window, "%"), id)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return service.ErrSubscriptionNotFound
+	}
+	return nil
 }
 
 // IncrementUsage 原子性地累加订阅用量。
@@ -328,6 +463,47 @@ func (r *userSubscriptionRepository) IncrementUsage(ctx context.Context, id int6
 	return service.ErrSubscriptionNotFound
 }
 
+// IncrementQuotaUsage 原子性地累加请求数/Token 配额用量：每个模型模式的日/周/月三个窗口同时累加
+func (r *userSubscriptionRepository) IncrementQuotaUsage(ctx context.Context, id int64, models []string, delta service.QuotaCounter) error {
+	if len(models) == 0 {
+		return nil
+	}
+	args := []any{delta.Requests, delta.InputTokens, delta.OutputTokens, id}
+	var merge strings.Builder
+	for _, window := range []string{service.QuotaWindowDaily, service.QuotaWindowWeekly, service.QuotaWindowMonthly} {
+		for _, model := range models {
+			args = append(args, service.QuotaUsageKey(window, model))
+			fmt.Fprintf(&merge, `
+				|| jsonb_build_object($%[1]d::text, jsonb_build_object(
+					'requests', COALESCE((quota_usage->$%[1]d::text->>'requests')::bigint, 0) + $1,
+					'input_tokens', COALESCE((quota_usage->$%[1]d::text->>'input_tokens')::bigint, 0) + $2,
+					'output_tokens', COALESCE((quota_usage->$%[1]d::text->>'output_tokens')::bigint, 0) + $3
+				))`, len(args))
+		}
+	}
+	query := `
+		UPDATE user_subscriptions
+		SET
+			quota_usage = COALESCE(quota_usage, '{}'::jsonb)` + merge.String() + `,
+			updated_at = NOW()
+		WHERE id = $4 AND deleted_at IS NULL
+	`
+
+	client := clientFromContext(ctx, r.client)
+	result, err := client.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return service.ErrSubscriptionNotFound
+	}
+	return nil
+}
+
 func (r *userSubscriptionRepository) BatchUpdateExpiredStatus(ctx context.Context) (int64, error) {
 	client := clientFromContext(ctx, r.client)
 	n, err := client.UserSubscription.Update().
@@ -400,6 +576,13 @@ func userSubscriptionEntityToService(m *dbent.UserSubscription) *service.UserSub
 		AssignedBy:         m.AssignedBy,
 		AssignedAt:         m.AssignedAt,
 		Notes:              derefString(m.Notes),
+		PlanID:             m.PlanID,
+		AutoRenew:          m.AutoRenew,
+		DailyLimitUSD:      m.DailyLimitUsd,
+		WeeklyLimitUSD:     m.WeeklyLimitUsd,
+		MonthlyLimitUSD:    m.MonthlyLimitUsd,
+		QuotaUsage:         m.QuotaUsage,
+		PausedAt:           m.PausedAt,
 		CreatedAt:          m.CreatedAt,
 		UpdatedAt:          m.UpdatedAt,
 	}
@@ -431,6 +431,82 @@ func (s *UserSubscriptionRepoSuite) TestResetMonthlyUsage() {
 	s.Require().WithinDuration(resetAt, *got.MonthlyWindowStart, time.Microsecond)
 }
 
+func (s *UserSubscriptionRepoSuite) TestIncrementQuotaUsage_ResetClearsWindow() {
+	user := s.mustCreateUser("quota@test.com", service.RoleUser)
+	group := s.mustCreateGroup("g-quota")
+	sub := s.mustCreateSubscription(user.ID, group.ID, nil)
+
+	models := []string{service.QuotaModelAll, "claude-opus-*"}
+	s.Require().NoError(s.repo.IncrementQuotaUsage(s.ctx, sub.ID, models, service.QuotaCounter{Requests: 1, InputTokens: 100, OutputTokens: 20}))
+	s.Require().NoError(s.repo.IncrementQuotaUsage(s.ctx, sub.ID, models[:1], service.QuotaCounter{Requests: 1, InputTokens: 50, OutputTokens: 5}))
+
+	got, err := s.repo.GetByID(s.ctx, sub.ID)
+	s.Require().NoError(err)
+	s.Require().Len(got.QuotaUsage, 6)
+	s.Require().Equal(service.QuotaCounter{Requests: 2, InputTokens: 150, OutputTokens: 25}, got.QuotaUsage[service.QuotaUsageKey(service.QuotaWindowDaily, service.QuotaModelAll)])
+	s.Require().Equal(service.QuotaCounter{Requests: 1, InputTokens: 100, OutputTokens: 20}, got.QuotaUsage[service.QuotaUsageKey(service.QuotaWindowMonthly, "claude-opus-*")])
+
+	s.Require().NoError(s.repo.ResetDailyUsage(s.ctx, sub.ID, time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)))
+
+	got, err = s.repo.GetByID(s.ctx, sub.ID)
+	s.Require().NoError(err)
+	s.Require().Len(got.QuotaUsage, 4)
+	s.Require().NotContains(got.QuotaUsage, service.QuotaUsageKey(service.QuotaWindowDaily, service.QuotaModelAll))
+	s.Require().Contains(got.QuotaUsage, service.QuotaUsageKey(service.QuotaWindowWeekly, service.QuotaModelAll))
+
+	err = s.repo.IncrementQuotaUsage(s.ctx, 999999, models, service.QuotaCounter{Requests: 1})
+	s.Require().ErrorIs(err, service.ErrSubscriptionNotFound)
+}
+
+func (s *UserSubscriptionRepoSuite) TestPauseResumeTransfer() {
+	owner := s.mustCreateUser("pause-owner@test.com", service.RoleUser)
+	target := s.mustCreateUser("pause-target@test.com", service.RoleUser)
+	other := s.mustCreateUser("pause-other@test.com", service.RoleUser)
+	group := s.mustCreateGroup("g-pause")
+	sub := s.mustCreateSubscription(owner.ID, group.ID, func(c *dbent.UserSubscriptionCreate) {
+		c.SetAutoRenew(true)
+	})
+	s.mustCreateSubscription(other.ID, group.ID, nil)
+
+	pausedAt := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	ok, err := s.repo.Pause(s.ctx, sub.ID, pausedAt)
+	s.Require().NoError(err)
+	s.Require().True(ok)
+	ok, err = s.repo.Pause(s.ctx, sub.ID, pausedAt)
+	s.Require().NoError(err)
+	s.Require().False(ok, "already paused")
+
+	got, err := s.repo.GetByID(s.ctx, sub.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(service.SubscriptionStatusPaused, got.Status)
+	s.Require().NotNil(got.PausedAt)
+	s.Require().WithinDuration(pausedAt, *got.PausedAt, time.Microsecond)
+
+	// 接收人已持有同分组订阅
+	_, err = s.repo.Transfer(s.ctx, sub.ID, owner.ID, other.ID)
+	s.Require().ErrorIs(err, service.ErrSubscriptionAlreadyExists)
+
+	ok, err = s.repo.Transfer(s.ctx, sub.ID, owner.ID, target.ID)
+	s.Require().NoError(err)
+	s.Require().True(ok)
+	ok, err = s.repo.Transfer(s.ctx, sub.ID, owner.ID, target.ID)
+	s.Require().NoError(err)
+	s.Require().False(ok, "owner changed")
+
+	expiresAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ok, err = s.repo.Resume(s.ctx, sub.ID, expiresAt)
+	s.Require().NoError(err)
+	s.Require().True(ok)
+
+	got, err = s.repo.GetByID(s.ctx, sub.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(target.ID, got.UserID)
+	s.Require().False(got.AutoRenew, "transfer stops auto renew")
+	s.Require().Equal(service.SubscriptionStatusActive, got.Status)
+	s.Require().Nil(got.PausedAt)
+	s.Require().WithinDuration(expiresAt, got.ExpiresAt, time.Microsecond)
+}
+
 // --- UpdateStatus / ExtendExpiry / UpdateNotes ---
 
 func (s *UserSubscriptionRepoSuite) TestUpdateStatus() {
@@ -55,10 +55,27 @@ var ProviderSet = wire.NewSet(
 	NewAccountRepository,
 	NewProxyRepository,
 	NewRedeemCodeRepository,
+	NewRedeemBatchRepository,
 	NewPromoCodeRepository,
 	NewUsageLogRepository,
 	NewUsageCleanupRepository,
+	NewUsageRerateRepository,
+	NewAccountProbeRepository,
+	NewAccountPoolRepository,
+	NewAccountAvailabilityRepository,
+	NewBalanceTransactionRepository,
+	NewCreditLotRepository,
+	NewSubscriptionPlanRepository,
+	NewPaymentOrderRepository,
+	NewSubscriptionEventRepository,
+	NewOrganizationRepository,
+	NewReferralRepository,
+	NewStatementRepository,
+	NewUserNotificationRepository,
+	NewUserNotificationWebhookSender,
+	NewModelPriceOverrideRepository,
 	NewDashboardAggregationRepository,
+	NewProfitRepository,
 	NewSettingRepository,
 	NewOpsRepository,
 	NewUserSubscriptionRepository,
@@ -82,9 +99,11 @@ var ProviderSet = wire.NewSet(
 	NewSchedulerCache,
 	NewSchedulerOutboxRepository,
 	NewProxyLatencyCache,
+	NewAccountSpendCache,
 
 	// HTTP service ports (DI Strategy A: return interface directly)
 	NewTurnstileVerifier,
+	ProvidePaymentProviders,
 	ProvidePricingRemoteClient,
 	ProvideGitHubReleaseClient,
 	NewProxyExitInfoProber,
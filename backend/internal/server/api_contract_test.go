@@ -84,7 +84,12 @@ func TestAPIContracts(t *testing.T) {
 					"ip_whitelist": null,
 					"ip_blacklist": null,
 					"created_at": "2025-01-02T03:04:05Z",
-					"updated_at": "2025-01-02T03:04:05Z"
+					"updated_at": "2025-01-02T03:04:05Z",
+					"daily_limit_usd": null,
+					"monthly_limit_usd": null,
+					"total_limit_usd": null,
+					"daily_request_limit": null,
+					"monthly_request_limit": null
 				}
 			}`,
 		},
@@ -120,7 +125,12 @@ func TestAPIContracts(t *testing.T) {
 							"ip_whitelist": null,
 							"ip_blacklist": null,
 							"created_at": "2025-01-02T03:04:05Z",
-							"updated_at": "2025-01-02T03:04:05Z"
+							"updated_at": "2025-01-02T03:04:05Z",
+							"daily_limit_usd": null,
+							"monthly_limit_usd": null,
+							"total_limit_usd": null,
+							"daily_request_limit": null,
+							"monthly_request_limit": null
 						}
 					],
 					"total": 1,
@@ -180,8 +190,14 @@ func TestAPIContracts(t *testing.T) {
 						"image_price_4k": null,
 						"claude_code_only": false,
 						"fallback_group_id": null,
+						"volume_tiers": null,
 						"created_at": "2025-01-02T03:04:05Z",
-						"updated_at": "2025-01-02T03:04:05Z"
+						"updated_at": "2025-01-02T03:04:05Z",
+						"overage_enabled": false,
+						"overage_rate_multiplier": 0,
+						"rolling_window_hours": 0,
+						"rolling_window_limit_usd": null,
+						"usage_quotas": null
 					}
 				]
 			}`,
@@ -230,6 +246,7 @@ func TestAPIContracts(t *testing.T) {
 						"daily_usage_usd": 1.23,
 						"weekly_usage_usd": 2.34,
 						"monthly_usage_usd": 3.45,
+						"auto_renew": false,
 						"created_at": "2025-01-02T03:04:05Z",
 						"updated_at": "2025-01-02T03:04:05Z"
 					}
@@ -272,7 +289,8 @@ func TestAPIContracts(t *testing.T) {
 						"used_at": "2025-01-02T03:04:05Z",
 						"created_at": "2025-01-02T03:04:05Z",
 						"group_id": null,
-						"validity_days": 0
+						"validity_days": 0,
+						"credit_validity_days": 0
 					}
 				]
 			}`,
@@ -585,8 +603,8 @@ func newContractDeps(t *testing.T) *contractDeps {
 	usageRepo := newStubUsageLogRepo()
 	usageService := service.NewUsageService(usageRepo, userRepo, nil, nil)
 
-	subscriptionService := service.NewSubscriptionService(groupRepo, userSubRepo, nil)
-	subscriptionHandler := handler.NewSubscriptionHandler(subscriptionService)
+	subscriptionService := service.NewSubscriptionService(groupRepo, userSubRepo, nil, nil, nil, nil, nil)
+	subscriptionHandler := handler.NewSubscriptionHandler(subscriptionService, nil)
 
 	redeemService := service.NewRedeemService(redeemRepo, userRepo, subscriptionService, nil, nil, nil, nil)
 	redeemHandler := handler.NewRedeemHandler(redeemService)
@@ -596,10 +614,10 @@ func newContractDeps(t *testing.T) *contractDeps {
 
 	adminService := service.NewAdminService(userRepo, groupRepo, &accountRepo, proxyRepo, apiKeyRepo, redeemRepo, nil, nil, nil, nil)
 	authHandler := handler.NewAuthHandler(cfg, nil, userService, settingService, nil)
-	apiKeyHandler := handler.NewAPIKeyHandler(apiKeyService)
+	apiKeyHandler := handler.NewAPIKeyHandler(apiKeyService, nil)
 	usageHandler := handler.NewUsageHandler(usageService, apiKeyService)
 	adminSettingHandler := adminhandler.NewSettingHandler(settingService, nil, nil, nil)
-	adminAccountHandler := adminhandler.NewAccountHandler(adminService, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	adminAccountHandler := adminhandler.NewAccountHandler(adminService, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	jwtAuth := func(c *gin.Context) {
 		c.Set(string(middleware.ContextKeyUser), middleware.AuthSubject{
@@ -742,6 +760,10 @@ func (r *stubUserRepo) DeductBalance(ctx context.Context, id int64, amount float
 	return errors.New("not implemented")
 }
 
+func (r *stubUserRepo) ApplyBalanceChange(ctx context.Context, change *service.BalanceChange) (*service.BalanceTransaction, error) {
+	return nil, errors.New("not implemented")
+}
+
 func (r *stubUserRepo) UpdateConcurrency(ctx context.Context, id int64, amount int) error {
 	return errors.New("not implemented")
 }
@@ -1113,6 +1135,10 @@ func (stubRedeemCodeRepo) ListWithFilters(ctx context.Context, params pagination
 	return nil, nil, errors.New("not implemented")
 }
 
+func (stubRedeemCodeRepo) ListByBatch(ctx context.Context, batchID int64, params pagination.PaginationParams) ([]service.RedeemCode, *pagination.PaginationResult, error) {
+	return nil, nil, errors.New("not implemented")
+}
+
 func (r *stubRedeemCodeRepo) ListByUser(ctx context.Context, userID int64, limit int) ([]service.RedeemCode, error) {
 	if r.byUser == nil {
 		return nil, nil
@@ -1206,6 +1232,24 @@ func (stubUserSubscriptionRepo) ResetMonthlyUsage(ctx context.Context, id int64,
 func (stubUserSubscriptionRepo) IncrementUsage(ctx context.Context, id int64, costUSD float64) error {
 	return errors.New("not implemented")
 }
+func (stubUserSubscriptionRepo) ApplyPlan(ctx context.Context, sub *service.UserSubscription) error {
+	return errors.New("not implemented")
+}
+func (stubUserSubscriptionRepo) UpdateAutoRenew(ctx context.Context, subscriptionID int64, autoRenew bool) error {
+	return errors.New("not implemented")
+}
+func (stubUserSubscriptionRepo) Pause(ctx context.Context, subscriptionID int64, pausedAt time.Time) (bool, error) {
+	return false, errors.New("not implemented")
+}
+func (stubUserSubscriptionRepo) Resume(ctx context.Context, subscriptionID int64, expiresAt time.Time) (bool, error) {
+	return false, errors.New("not implemented")
+}
+func (stubUserSubscriptionRepo) Transfer(ctx context.Context, subscriptionID, fromUserID, toUserID int64) (bool, error) {
+	return false, errors.New("not implemented")
+}
+func (stubUserSubscriptionRepo) IncrementQuotaUsage(ctx context.Context, id int64, models []string, delta service.QuotaCounter) error {
+	return errors.New("not implemented")
+}
 func (stubUserSubscriptionRepo) BatchUpdateExpiredStatus(ctx context.Context) (int64, error) {
 	return 0, errors.New("not implemented")
 }
@@ -1472,6 +1516,14 @@ func (r *stubUsageLogRepo) GetAccountTodayStats(ctx context.Context, accountID i
 	return nil, errors.New("not implemented")
 }
 
+func (r *stubUsageLogRepo) GetUserSpendSince(ctx context.Context, userID int64, since time.Time) (float64, error) {
+	return 0, errors.New("not implemented")
+}
+
+func (r *stubUsageLogRepo) GetAPIKeyLimitUsage(ctx context.Context, apiKeyID int64, dayStart, monthStart time.Time) (*service.APIKeyLimitUsage, error) {
+	return nil, errors.New("not implemented")
+}
+
 func (r *stubUsageLogRepo) GetDashboardStats(ctx context.Context) (*usagestats.DashboardStats, error) {
 	return nil, errors.New("not implemented")
 }
@@ -110,6 +110,13 @@ func apiKeyAuthWithSubscription(apiKeyService *service.APIKeyService, subscripti
 			return
 		}
 
+		// 组织 Key：解析组织计费上下文，订阅与余额均由组织计费账户提供
+		if err := apiKeyService.ResolveOrganizationBilling(c.Request.Context(), apiKey); err != nil {
+			AbortWithError(c, 403, "ORGANIZATION_INVALID", "Organization billing is unavailable for this API key")
+			return
+		}
+		payer := apiKey.Payer(apiKey.User)
+
 		// 判断计费方式：订阅模式 vs 余额模式
 		isSubscriptionType := apiKey.Group != nil && apiKey.Group.IsSubscriptionType()
 
@@ -117,7 +124,7 @@ func apiKeyAuthWithSubscription(apiKeyService *service.APIKeyService, subscripti
 			// 订阅模式：验证订阅
 			subscription, err := subscriptionService.GetActiveSubscription(
 				c.Request.Context(),
-				apiKey.User.ID,
+				payer.ID,
 				apiKey.Group.ID,
 			)
 			if err != nil {
@@ -150,8 +157,8 @@ func apiKeyAuthWithSubscription(apiKeyService *service.APIKeyService, subscripti
 			// 将订阅信息存入上下文
 			c.Set(string(ContextKeySubscription), subscription)
 		} else {
-			// 余额模式：检查用户余额
-			if apiKey.User.Balance <= 0 {
+			// 余额模式：检查付费账户余额
+			if payer.Balance <= 0 {
 				AbortWithError(c, 403, "INSUFFICIENT_BALANCE", "Insufficient account balance")
 				return
 			}
@@ -68,11 +68,17 @@ func APIKeyAuthWithSubscriptionGoogle(apiKeyService *service.APIKeyService, subs
 			return
 		}
 
+		if err := apiKeyService.ResolveOrganizationBilling(c.Request.Context(), apiKey); err != nil {
+			abortWithGoogleError(c, 403, "Organization billing is unavailable for this API key")
+			return
+		}
+		payer := apiKey.Payer(apiKey.User)
+
 		isSubscriptionType := apiKey.Group != nil && apiKey.Group.IsSubscriptionType()
 		if isSubscriptionType && subscriptionService != nil {
 			subscription, err := subscriptionService.GetActiveSubscription(
 				c.Request.Context(),
-				apiKey.User.ID,
+				payer.ID,
 				apiKey.Group.ID,
 			)
 			if err != nil {
@@ -91,7 +97,7 @@ func APIKeyAuthWithSubscriptionGoogle(apiKeyService *service.APIKeyService, subs
 			}
 			c.Set(string(ContextKeySubscription), subscription)
 		} else {
-			if apiKey.User.Balance <= 0 {
+			if payer.Balance <= 0 {
 				abortWithGoogleError(c, 403, "Insufficient account balance")
 				return
 			}
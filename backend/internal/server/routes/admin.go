@@ -43,6 +43,7 @@ func RegisterAdminRoutes(
 
 		// 卡密管理
 		registerRedeemCodeRoutes(admin, h)
+		registerRedeemBatchRoutes(admin, h)
 
 		// 优惠码管理
 		registerPromoCodeRoutes(admin, h)
@@ -64,6 +65,27 @@ func RegisterAdminRoutes(
 
 		// 用户属性管理
 		registerUserAttributeRoutes(admin, h)
+
+		// 在线充值订单
+		registerPaymentRoutes(admin, h)
+
+		// 月度账单
+		registerStatementRoutes(admin, h)
+
+		// 模型价格覆盖
+		registerPriceOverrideRoutes(admin, h)
+
+		// 订阅套餐
+		registerSubscriptionPlanRoutes(admin, h)
+
+		// 组织管理
+		registerOrganizationRoutes(admin, h)
+
+		// 邀请返佣
+		registerReferralRoutes(admin, h)
+
+		// 利润分析
+		registerProfitRoutes(admin, h)
 	}
 }
 
@@ -143,6 +165,7 @@ func registerOpsRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 		ops.GET("/dashboard/latency-histogram", h.Admin.Ops.GetDashboardLatencyHistogram)
 		ops.GET("/dashboard/error-trend", h.Admin.Ops.GetDashboardErrorTrend)
 		ops.GET("/dashboard/error-distribution", h.Admin.Ops.GetDashboardErrorDistribution)
+		ops.GET("/dashboard/pool-comparison", h.Admin.Ops.GetDashboardPoolComparison)
 	}
 }
 
@@ -172,11 +195,19 @@ func registerUserManagementRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 		users.POST("/:id/balance", h.Admin.User.UpdateBalance)
 		users.GET("/:id/api-keys", h.Admin.User.GetUserAPIKeys)
 		users.GET("/:id/usage", h.Admin.User.GetUserUsage)
+		users.GET("/:id/balance-transactions", h.Admin.User.GetBalanceTransactions)
 
 		// User attribute values
 		users.GET("/:id/attributes", h.Admin.UserAttribute.GetUserAttributes)
 		users.PUT("/:id/attributes", h.Admin.UserAttribute.UpdateUserAttributes)
 	}
+
+	// 余额流水对账
+	balanceLedger := admin.Group("/balance-ledger")
+	{
+		balanceLedger.GET("/reconciliation", h.Admin.User.GetBalanceReconciliation)
+		balanceLedger.POST("/reconcile", h.Admin.User.RunBalanceReconciliation)
+	}
 }
 
 func registerGroupRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
@@ -190,6 +221,8 @@ func registerGroupRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 		groups.DELETE("/:id", h.Admin.Group.Delete)
 		groups.GET("/:id/stats", h.Admin.Group.GetStats)
 		groups.GET("/:id/api-keys", h.Admin.Group.GetGroupAPIKeys)
+		groups.GET("/:id/pools", h.Admin.Group.ListPools)
+		groups.PUT("/:id/accounts/:account_id/pool", h.Admin.Group.SetAccountPool)
 	}
 }
 
@@ -203,6 +236,8 @@ func registerAccountRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 		accounts.PUT("/:id", h.Admin.Account.Update)
 		accounts.DELETE("/:id", h.Admin.Account.Delete)
 		accounts.POST("/:id/test", h.Admin.Account.Test)
+		accounts.POST("/:id/probe", h.Admin.Account.Probe)
+		accounts.GET("/:id/probes", h.Admin.Account.ListProbes)
 		accounts.POST("/:id/refresh", h.Admin.Account.Refresh)
 		accounts.POST("/:id/refresh-tier", h.Admin.Account.RefreshTier)
 		accounts.GET("/:id/stats", h.Admin.Account.GetStats)
@@ -274,6 +309,63 @@ func registerProxyRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 	}
 }
 
+func registerPaymentRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	payment := admin.Group("/payment")
+	{
+		payment.GET("/orders", h.Admin.Payment.ListOrders)
+	}
+}
+
+func registerStatementRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	statements := admin.Group("/statements")
+	{
+		statements.GET("", h.Admin.Statement.List)
+		statements.POST("/regenerate", h.Admin.Statement.Regenerate)
+	}
+}
+
+func registerPriceOverrideRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	overrides := admin.Group("/pricing/overrides")
+	{
+		overrides.GET("", h.Admin.PriceOverride.List)
+		overrides.GET("/:id", h.Admin.PriceOverride.GetByID)
+		overrides.POST("", h.Admin.PriceOverride.Create)
+		overrides.PUT("/:id", h.Admin.PriceOverride.Update)
+		overrides.DELETE("/:id", h.Admin.PriceOverride.Delete)
+	}
+}
+
+func registerSubscriptionPlanRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	plans := admin.Group("/subscription-plans")
+	{
+		plans.GET("", h.Admin.SubscriptionPlan.List)
+		plans.GET("/:id", h.Admin.SubscriptionPlan.GetByID)
+		plans.POST("", h.Admin.SubscriptionPlan.Create)
+		plans.PUT("/:id", h.Admin.SubscriptionPlan.Update)
+		plans.DELETE("/:id", h.Admin.SubscriptionPlan.Delete)
+	}
+}
+
+func registerOrganizationRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	organizations := admin.Group("/organizations")
+	{
+		organizations.GET("", h.Admin.Organization.List)
+		organizations.GET("/:id", h.Admin.Organization.GetByID)
+		organizations.PUT("/:id/status", h.Admin.Organization.UpdateStatus)
+		organizations.GET("/:id/members", h.Admin.Organization.ListMembers)
+		organizations.GET("/:id/usage", h.Admin.Organization.Usage)
+	}
+}
+
+func registerReferralRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	referrals := admin.Group("/referrals")
+	{
+		referrals.GET("/commissions", h.Admin.Referral.ListCommissions)
+		referrals.GET("/report", h.Admin.Referral.Report)
+		referrals.POST("/settle", h.Admin.Referral.Settle)
+	}
+}
+
 func registerRedeemCodeRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 	codes := admin.Group("/redeem-codes")
 	{
@@ -288,10 +380,24 @@ func registerRedeemCodeRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 	}
 }
 
+func registerRedeemBatchRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	batches := admin.Group("/redeem-batches")
+	{
+		batches.GET("", h.Admin.RedeemBatch.List)
+		batches.POST("", h.Admin.RedeemBatch.Create)
+		batches.GET("/:id", h.Admin.RedeemBatch.GetByID)
+		batches.PUT("/:id", h.Admin.RedeemBatch.Update)
+		batches.POST("/:id/disable", h.Admin.RedeemBatch.Disable)
+		batches.GET("/:id/codes", h.Admin.RedeemBatch.ListCodes)
+		batches.GET("/:id/export", h.Admin.RedeemBatch.Export)
+	}
+}
+
 func registerPromoCodeRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 	promoCodes := admin.Group("/promo-codes")
 	{
 		promoCodes.GET("", h.Admin.Promo.List)
+		promoCodes.GET("/report", h.Admin.Promo.Report)
 		promoCodes.GET("/:id", h.Admin.Promo.GetByID)
 		promoCodes.POST("", h.Admin.Promo.Create)
 		promoCodes.PUT("/:id", h.Admin.Promo.Update)
@@ -337,6 +443,10 @@ func registerSubscriptionRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 		subscriptions.POST("/assign", h.Admin.Subscription.Assign)
 		subscriptions.POST("/bulk-assign", h.Admin.Subscription.BulkAssign)
 		subscriptions.POST("/:id/extend", h.Admin.Subscription.Extend)
+		subscriptions.POST("/:id/pause", h.Admin.Subscription.Pause)
+		subscriptions.POST("/:id/resume", h.Admin.Subscription.Resume)
+		subscriptions.POST("/:id/transfer", h.Admin.Subscription.Transfer)
+		subscriptions.GET("/:id/events", h.Admin.Subscription.ListEvents)
 		subscriptions.DELETE("/:id", h.Admin.Subscription.Revoke)
 	}
 
@@ -357,6 +467,11 @@ func registerUsageRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 		usage.GET("/cleanup-tasks", h.Admin.Usage.ListCleanupTasks)
 		usage.POST("/cleanup-tasks", h.Admin.Usage.CreateCleanupTask)
 		usage.POST("/cleanup-tasks/:id/cancel", h.Admin.Usage.CancelCleanupTask)
+		usage.GET("/rerate-tasks", h.Admin.UsageRerate.ListTasks)
+		usage.POST("/rerate-tasks", h.Admin.UsageRerate.CreateTask)
+		usage.GET("/rerate-tasks/:id", h.Admin.UsageRerate.GetTask)
+		usage.GET("/rerate-tasks/:id/diffs", h.Admin.UsageRerate.ListDiffs)
+		usage.POST("/rerate-tasks/:id/cancel", h.Admin.UsageRerate.CancelTask)
 	}
 }
 
@@ -371,3 +486,14 @@ func registerUserAttributeRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 		attrs.DELETE("/:id", h.Admin.UserAttribute.DeleteDefinition)
 	}
 }
+
+func registerProfitRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	profit := admin.Group("/profit")
+	{
+		profit.GET("/report", h.Admin.Profit.GetReport)
+		profit.GET("/account-costs", h.Admin.Profit.ListAccountCosts)
+		profit.POST("/account-costs", h.Admin.Profit.CreateAccountCost)
+		profit.PUT("/account-costs/:id", h.Admin.Profit.UpdateAccountCost)
+		profit.DELETE("/account-costs/:id", h.Admin.Profit.DeleteAccountCost)
+	}
+}
@@ -13,6 +13,13 @@ func RegisterUserRoutes(
 	h *handler.Handlers,
 	jwtAuth middleware.JWTAuthMiddleware,
 ) {
+	// 支付渠道回调（无需认证，由渠道签名校验）
+	paymentNotify := v1.Group("/payment/notify")
+	{
+		paymentNotify.GET("/:provider", h.Payment.Notify)
+		paymentNotify.POST("/:provider", h.Payment.Notify)
+	}
+
 	authenticated := v1.Group("")
 	authenticated.Use(gin.HandlerFunc(jwtAuth))
 	{
@@ -22,6 +29,10 @@ func RegisterUserRoutes(
 			user.GET("/profile", h.User.GetProfile)
 			user.PUT("/password", h.User.ChangePassword)
 			user.PUT("", h.User.UpdateProfile)
+			user.GET("/balance-transactions", h.User.ListBalanceTransactions)
+			user.GET("/credit-lots", h.User.GetCreditLots)
+			user.GET("/notification-settings", h.User.GetNotificationSettings)
+			user.PUT("/notification-settings", h.User.UpdateNotificationSettings)
 		}
 
 		// API Key管理
@@ -32,6 +43,7 @@ func RegisterUserRoutes(
 			keys.POST("", h.APIKey.Create)
 			keys.PUT("/:id", h.APIKey.Update)
 			keys.DELETE("/:id", h.APIKey.Delete)
+			keys.DELETE("/:id/organization", h.Organization.UnbindAPIKey)
 		}
 
 		// 用户可用分组（非管理员接口）
@@ -60,6 +72,23 @@ func RegisterUserRoutes(
 			redeem.GET("/history", h.Redeem.GetHistory)
 		}
 
+		// 在线充值
+		payment := authenticated.Group("/payment")
+		{
+			payment.GET("/options", h.Payment.GetOptions)
+			payment.POST("/orders", h.Payment.CreateOrder)
+			payment.GET("/orders", h.Payment.ListOrders)
+			payment.GET("/orders/:order_no", h.Payment.GetOrder)
+		}
+
+		// 月度账单
+		statements := authenticated.Group("/statements")
+		{
+			statements.GET("", h.Statement.List)
+			statements.GET("/:id", h.Statement.GetByID)
+			statements.GET("/:id/download", h.Statement.Download)
+		}
+
 		// 用户订阅
 		subscriptions := authenticated.Group("/subscriptions")
 		{
@@ -67,6 +96,38 @@ func RegisterUserRoutes(
 			subscriptions.GET("/active", h.Subscription.GetActive)
 			subscriptions.GET("/progress", h.Subscription.GetProgress)
 			subscriptions.GET("/summary", h.Subscription.GetSummary)
+			subscriptions.GET("/plans", h.Subscription.ListPlans)
+			subscriptions.POST("/purchase", h.Subscription.Purchase)
+			subscriptions.PUT("/:id/auto-renew", h.Subscription.UpdateAutoRenew)
+			subscriptions.POST("/:id/pause", h.Subscription.Pause)
+			subscriptions.POST("/:id/resume", h.Subscription.Resume)
+			subscriptions.POST("/:id/transfer", h.Subscription.Transfer)
+		}
+
+		// 组织（团队共享余额与订阅）
+		organizations := authenticated.Group("/organizations")
+		{
+			organizations.GET("", h.Organization.List)
+			organizations.POST("", h.Organization.Create)
+			organizations.GET("/:id", h.Organization.GetByID)
+			organizations.PUT("/:id", h.Organization.Update)
+			organizations.GET("/:id/members", h.Organization.ListMembers)
+			organizations.POST("/:id/members", h.Organization.AddMember)
+			organizations.PUT("/:id/members/:user_id", h.Organization.UpdateMember)
+			organizations.DELETE("/:id/members/:user_id", h.Organization.RemoveMember)
+			organizations.POST("/:id/fund", h.Organization.Fund)
+			organizations.GET("/:id/subscriptions", h.Organization.ListSubscriptions)
+			organizations.POST("/:id/subscriptions/purchase", h.Organization.PurchasePlan)
+			organizations.POST("/:id/api-keys/:key_id", h.Organization.BindAPIKey)
+			organizations.GET("/:id/usage", h.Organization.Usage)
+		}
+
+		// 邀请返佣
+		referral := authenticated.Group("/referral")
+		{
+			referral.GET("", h.Referral.GetDashboard)
+			referral.GET("/referees", h.Referral.ListReferrals)
+			referral.GET("/commissions", h.Referral.ListCommissions)
 		}
 	}
 }
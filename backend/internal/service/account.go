@@ -43,6 +43,10 @@ type Account struct {
 	SessionWindowEnd    *time.Time
 	SessionWindowStatus string
 
+	// 可用时间窗口（为空表示全天可用），见 account_availability.go
+	AvailabilityWindows  []string
+	AvailabilityTimezone string
+
 	Proxy         *Proxy
 	AccountGroups []AccountGroup
 	GroupIDs      []int64
@@ -91,7 +95,7 @@ func (a *Account) IsSchedulable() bool {
 	if a.TempUnschedulableUntil != nil && now.Before(*a.TempUnschedulableUntil) {
 		return false
 	}
-	return true
+	return a.IsWithinAvailabilityWindow(now)
 }
 
 func (a *Account) IsRateLimited() bool {
@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+)
+
+// 账号可用时间窗口
+//
+// 每个窗口格式为 "<days> [HH:MM-HH:MM]"：
+//   - days: "*"（每天）、单日 "mon"、区间 "mon-fri"（支持跨周 "fri-mon"）或逗号组合 "sat,sun"
+//   - 时间段可省略（表示全天）；结束时间可为 24:00；结束早于开始表示跨午夜（如 "mon-fri 19:00-08:00"）
+//
+// 多个窗口取并集；未配置窗口表示全天可用。时间按账号配置的时区计算，未配置时使用服务器时区。
+
+const (
+	maxAvailabilityWindows       = 20
+	availabilityMinutesPerDay    = 24 * 60
+	availabilityScheduleCacheMax = 1024
+)
+
+var ErrInvalidAvailabilityWindow = infraerrors.BadRequest("INVALID_AVAILABILITY_WINDOW", "invalid availability window")
+
+var availabilityWeekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// AccountAvailabilityEntry 配置了可用时间窗口的账号（供边界检查使用）
+type AccountAvailabilityEntry struct {
+	AccountID int64
+	Windows   []string
+	Timezone  string
+}
+
+// AccountAvailabilityRepository 可用时间窗口持久层接口
+type AccountAvailabilityRepository interface {
+	// ListAvailabilitySchedules 返回所有配置了可用时间窗口的未删除账号
+	ListAvailabilitySchedules(ctx context.Context) ([]AccountAvailabilityEntry, error)
+	// NotifyAvailabilityChanged 写入调度 outbox，触发相关调度快照重建
+	NotifyAvailabilityChanged(ctx context.Context, accountIDs []int64) error
+	// GetLastBoundaryCheck 返回上次边界检查时间，从未检查时返回零值
+	GetLastBoundaryCheck(ctx context.Context) (time.Time, error)
+	UpdateLastBoundaryCheck(ctx context.Context, checkedAt time.Time) error
+}
+
+type availabilityWindow struct {
+	days  [7]bool
+	start int // 自零点起的分钟数
+	end   int // 小于等于 start 时表示跨午夜
+}
+
+type availabilitySchedule struct {
+	loc     *time.Location
+	windows []availabilityWindow
+}
+
+var (
+	availabilityScheduleCache sync.Map // key -> *availabilitySchedule
+	availabilityScheduleCount int64
+	availabilityScheduleMu    sync.Mutex
+	availabilityLocationCache sync.Map // tz -> *time.Location
+)
+
+// IsWithinAvailabilityWindow 判断账号在给定时间是否处于可用时间窗口内；未配置窗口时始终可用
+func (a *Account) IsWithinAvailabilityWindow(now time.Time) bool {
+	if a == nil || len(a.AvailabilityWindows) == 0 {
+		return true
+	}
+	schedule, err := cachedAvailabilitySchedule(a.AvailabilityTimezone, a.AvailabilityWindows)
+	if err != nil {
+		// 写入时已校验，解析失败只可能来自历史脏数据：不限制调度，避免账号被静默下线
+		return true
+	}
+	return schedule.contains(now)
+}
+
+// HasAvailabilityWindows 是否配置了可用时间窗口
+func (a *Account) HasAvailabilityWindows() bool {
+	return a != nil && len(a.AvailabilityWindows) > 0
+}
+
+// NormalizeAvailabilityWindows 校验并规范化可用时间窗口配置，返回去空白后的窗口列表
+// windows 为空时返回 nil（表示全天可用）
+func NormalizeAvailabilityWindows(windows []string, tz string) ([]string, string, error) {
+	tz = strings.TrimSpace(tz)
+	if tz != "" {
+		if _, err := availabilityLocation(tz); err != nil {
+			return nil, "", infraerrors.BadRequest("INVALID_AVAILABILITY_TIMEZONE", fmt.Sprintf("invalid timezone %q", tz))
+		}
+	}
+	out := make([]string, 0, len(windows))
+	for _, raw := range windows {
+		spec := strings.Join(strings.Fields(strings.ToLower(raw)), " ")
+		if spec == "" {
+			continue
+		}
+		if _, err := parseAvailabilityWindow(spec); err != nil {
+			return nil, "", infraerrors.BadRequest("INVALID_AVAILABILITY_WINDOW", fmt.Sprintf("invalid availability window %q: %v", raw, err))
+		}
+		out = append(out, spec)
+	}
+	if len(out) > maxAvailabilityWindows {
+		return nil, "", infraerrors.BadRequest("INVALID_AVAILABILITY_WINDOW", fmt.Sprintf("at most %d availability windows are allowed", maxAvailabilityWindows))
+	}
+	if len(out) == 0 {
+		return nil, tz, nil
+	}
+	return out, tz, nil
+}
+
+func cachedAvailabilitySchedule(tz string, windows []string) (*availabilitySchedule, error) {
+	key := tz + "|" + strings.Join(windows, ";")
+	if cached, ok := availabilityScheduleCache.Load(key); ok {
+		return cached.(*availabilitySchedule), nil
+	}
+	schedule, err := parseAvailabilitySchedule(tz, windows)
+	if err != nil {
+		return nil, err
+	}
+	// 配置种类有限，超过上限时整体清空，避免异常数据导致缓存无限增长
+	availabilityScheduleMu.Lock()
+	if availabilityScheduleCount >= availabilityScheduleCacheMax {
+		availabilityScheduleCache.Range(func(k, _ any) bool {
+			availabilityScheduleCache.Delete(k)
+			return true
+		})
+		availabilityScheduleCount = 0
+	}
+	if _, loaded := availabilityScheduleCache.LoadOrStore(key, schedule); !loaded {
+		availabilityScheduleCount++
+	}
+	availabilityScheduleMu.Unlock()
+	return schedule, nil
+}
+
+func parseAvailabilitySchedule(tz string, windows []string) (*availabilitySchedule, error) {
+	loc, err := availabilityLocation(tz)
+	if err != nil {
+		return nil, err
+	}
+	schedule := &availabilitySchedule{loc: loc, windows: make([]availabilityWindow, 0, len(windows))}
+	for _, spec := range windows {
+		w, err := parseAvailabilityWindow(spec)
+		if err != nil {
+			return nil, err
+		}
+		schedule.windows = append(schedule.windows, w)
+	}
+	return schedule, nil
+}
+
+func availabilityLocation(tz string) (*time.Location, error) {
+	if tz == "" {
+		return timezone.Location(), nil
+	}
+	if cached, ok := availabilityLocationCache.Load(tz); ok {
+		return cached.(*time.Location), nil
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil, err
+	}
+	availabilityLocationCache.Store(tz, loc)
+	return loc, nil
+}
+
+func parseAvailabilityWindow(spec string) (availabilityWindow, error) {
+	var w availabilityWindow
+	fields := strings.Fields(strings.ToLower(spec))
+	if len(fields) == 0 || len(fields) > 2 {
+		return w, fmt.Errorf("expected \"<days> [HH:MM-HH:MM]\"")
+	}
+	days, err := parseAvailabilityDays(fields[0])
+	if err != nil {
+		return w, err
+	}
+	w.days = days
+	w.start, w.end = 0, availabilityMinutesPerDay
+	if len(fields) == 2 {
+		startRaw, endRaw, ok := strings.Cut(fields[1], "-")
+		if !ok {
+			return w, fmt.Errorf("time range must be HH:MM-HH:MM")
+		}
+		if w.start, err = parseAvailabilityClock(startRaw, false); err != nil {
+			return w, err
+		}
+		if w.end, err = parseAvailabilityClock(endRaw, true); err != nil {
+			return w, err
+		}
+		if w.start == w.end {
+			return w, fmt.Errorf("empty time range")
+		}
+	}
+	return w, nil
+}
+
+func parseAvailabilityDays(raw string) ([7]bool, error) {
+	var days [7]bool
+	if raw == "*" || raw == "daily" {
+		for i := range days {
+			days[i] = true
+		}
+		return days, nil
+	}
+	for _, part := range strings.Split(raw, ",") {
+		fromRaw, toRaw, isRange := strings.Cut(part, "-")
+		from, ok := availabilityWeekdays[fromRaw]
+		if !ok {
+			return days, fmt.Errorf("unknown weekday %q", fromRaw)
+		}
+		if !isRange {
+			days[from] = true
+			continue
+		}
+		to, ok := availabilityWeekdays[toRaw]
+		if !ok {
+			return days, fmt.Errorf("unknown weekday %q", toRaw)
+		}
+		for d := from; ; d = (d + 1) % 7 {
+			days[d] = true
+			if d == to {
+				break
+			}
+		}
+	}
+	return days, nil
+}
+
+func parseAvailabilityClock(raw string, allowEndOfDay bool) (int, error) {
+	hourRaw, minuteRaw, ok := strings.Cut(raw, ":")
+	if !ok {
+		return 0, fmt.Errorf("invalid time %q", raw)
+	}
+	hour, err := strconv.Atoi(hourRaw)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q", raw)
+	}
+	minute, err := strconv.Atoi(minuteRaw)
+	if err != nil || minute < 0 || minute > 59 {
+		return 0, fmt.Errorf("invalid time %q", raw)
+	}
+	if hour == 24 && minute == 0 && allowEndOfDay {
+		return availabilityMinutesPerDay, nil
+	}
+	if hour < 0 || hour > 23 {
+		return 0, fmt.Errorf("invalid time %q", raw)
+	}
+	return hour*60 + minute, nil
+}
+
+func (s *availabilitySchedule) contains(now time.Time) bool {
+	local := now.In(s.loc)
+	minute := local.Hour()*60 + local.Minute()
+	weekday := local.Weekday()
+	for _, w := range s.windows {
+		if w.contains(weekday, minute) {
+			return true
+		}
+	}
+	return false
+}
+
+func (w availabilityWindow) contains(weekday time.Weekday, minute int) bool {
+	if w.start < w.end {
+		return w.days[weekday] && minute >= w.start && minute < w.end
+	}
+	// 跨午夜：开始日的 [start, 24:00) 以及次日的 [00:00, end)
+	if w.days[weekday] && minute >= w.start {
+		return true
+	}
+	previous := (weekday + 6) % 7
+	return w.days[previous] && minute < w.end
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	accountAvailabilityWorkerName    = "account_availability_boundary_worker"
+	accountAvailabilityLeaderLockKey = "account:availability:leader"
+	accountAvailabilityTickInterval  = 30 * time.Second
+	accountAvailabilityTickTimeout   = 20 * time.Second
+)
+
+// AccountAvailabilityService 在账号可用时间窗口的边界处触发调度快照重建：
+// 每个 tick 比较上次检查时间与当前时间的窗口状态，状态翻转的账号写入调度 outbox。
+// 上次检查时间持久化在数据库中，重启或主节点切换后仍能补发期间跨越的边界。
+// 调度时的实时判断由 Account.IsSchedulable 完成，这里只保证快照桶及时补入/剔除账号。
+type AccountAvailabilityService struct {
+	repo        AccountAvailabilityRepository
+	timingWheel *TimingWheelService
+	db          *sql.DB
+
+	running   int32
+	startOnce sync.Once
+	stopOnce  sync.Once
+}
+
+func NewAccountAvailabilityService(repo AccountAvailabilityRepository, timingWheel *TimingWheelService, db *sql.DB) *AccountAvailabilityService {
+	return &AccountAvailabilityService{
+		repo:        repo,
+		timingWheel: timingWheel,
+		db:          db,
+	}
+}
+
+func (s *AccountAvailabilityService) Start() {
+	if s == nil {
+		return
+	}
+	if s.repo == nil || s.timingWheel == nil {
+		log.Printf("[AccountAvailability] not started (missing deps)")
+		return
+	}
+	s.startOnce.Do(func() {
+		s.timingWheel.ScheduleRecurring(accountAvailabilityWorkerName, accountAvailabilityTickInterval, s.runOnce)
+		log.Printf("[AccountAvailability] started (tick=%s)", accountAvailabilityTickInterval)
+	})
+}
+
+func (s *AccountAvailabilityService) Stop() {
+	if s == nil {
+		return
+	}
+	s.stopOnce.Do(func() {
+		if s.timingWheel != nil {
+			s.timingWheel.Cancel(accountAvailabilityWorkerName)
+		}
+		log.Printf("[AccountAvailability] stopped")
+	})
+}
+
+func (s *AccountAvailabilityService) runOnce() {
+	if !atomic.CompareAndSwapInt32(&s.running, 0, 1) {
+		return
+	}
+	defer atomic.StoreInt32(&s.running, 0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), accountAvailabilityTickTimeout)
+	defer cancel()
+
+	// 多实例部署时只允许一个节点写入 outbox，避免重复重建
+	if s.db != nil {
+		release, ok := tryAcquireDBAdvisoryLock(ctx, s.db, hashAdvisoryLockID(accountAvailabilityLeaderLockKey))
+		if !ok {
+			return
+		}
+		defer release()
+	}
+
+	if _, err := s.checkBoundaries(ctx, time.Now()); err != nil {
+		log.Printf("[AccountAvailability] check boundaries failed: %v", err)
+	}
+}
+
+// checkBoundaries 找出自上次检查以来可用状态发生翻转的账号并通知调度快照重建
+// 从未检查过时以 now - tick 间隔作为上次检查时间，保证首个 tick 之前跨越的边界也会通知
+func (s *AccountAvailabilityService) checkBoundaries(ctx context.Context, now time.Time) ([]int64, error) {
+	last, err := s.repo.GetLastBoundaryCheck(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if last.IsZero() || !last.Before(now) {
+		last = now.Add(-accountAvailabilityTickInterval)
+	}
+
+	entries, err := s.repo.ListAvailabilitySchedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var changed []int64
+	for _, entry := range entries {
+		account := &Account{AvailabilityWindows: entry.Windows, AvailabilityTimezone: entry.Timezone}
+		if account.IsWithinAvailabilityWindow(last) != account.IsWithinAvailabilityWindow(now) {
+			changed = append(changed, entry.AccountID)
+		}
+	}
+	if len(changed) > 0 {
+		if err := s.repo.NotifyAvailabilityChanged(ctx, changed); err != nil {
+			return nil, err
+		}
+		log.Printf("[AccountAvailability] availability changed: accounts=%v", changed)
+	}
+	if err := s.repo.UpdateLastBoundaryCheck(ctx, now); err != nil {
+		return changed, err
+	}
+	return changed, nil
+}
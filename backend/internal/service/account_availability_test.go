@@ -0,0 +1,142 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountAvailabilityWindow(t *testing.T) {
+	account := &Account{
+		AvailabilityWindows:  []string{"mon-fri 19:00-08:00", "sat,sun"},
+		AvailabilityTimezone: "Asia/Shanghai",
+	}
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	require.NoError(t, err)
+
+	cases := []struct {
+		name string
+		at   time.Time
+		want bool
+	}{
+		{"weekday office hours", time.Date(2026, 3, 4, 10, 0, 0, 0, loc), false}, // Wed
+		{"weekday evening", time.Date(2026, 3, 4, 19, 0, 0, 0, loc), true},
+		{"after midnight continues previous window", time.Date(2026, 3, 5, 7, 59, 0, 0, loc), true},
+		{"window end is exclusive", time.Date(2026, 3, 5, 8, 0, 0, 0, loc), false},
+		{"saturday all day", time.Date(2026, 3, 7, 12, 0, 0, 0, loc), true},
+		{"monday morning is not covered by sunday", time.Date(2026, 3, 9, 7, 0, 0, 0, loc), false},
+		{"friday night spills into saturday", time.Date(2026, 3, 6, 23, 0, 0, 0, loc), true},
+		{"other timezone is converted", time.Date(2026, 3, 4, 2, 0, 0, 0, time.UTC), false}, // 10:00 Shanghai
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, account.IsWithinAvailabilityWindow(tc.at))
+		})
+	}
+
+	require.True(t, (&Account{}).IsWithinAvailabilityWindow(time.Now()), "no windows means always available")
+}
+
+func TestAccountIsSchedulableHonorsAvailabilityWindow(t *testing.T) {
+	now := time.Now().UTC()
+	closed := now.Add(time.Hour)
+	account := &Account{
+		Status:               StatusActive,
+		Schedulable:          true,
+		AvailabilityTimezone: "UTC",
+		AvailabilityWindows:  []string{"* " + closed.Format("15:04") + "-" + closed.Add(time.Hour).Format("15:04")},
+	}
+	require.False(t, account.IsSchedulable())
+
+	account.AvailabilityWindows = nil
+	require.True(t, account.IsSchedulable())
+}
+
+func TestNormalizeAvailabilityWindows(t *testing.T) {
+	windows, tz, err := NormalizeAvailabilityWindows([]string{"  Mon-Fri   19:00-08:00 ", "", "SAT,sun"}, " UTC ")
+	require.NoError(t, err)
+	require.Equal(t, []string{"mon-fri 19:00-08:00", "sat,sun"}, windows)
+	require.Equal(t, "UTC", tz)
+
+	windows, _, err = NormalizeAvailabilityWindows([]string{" "}, "")
+	require.NoError(t, err)
+	require.Nil(t, windows)
+
+	for _, bad := range []string{"funday", "mon 25:00-26:00", "mon 08:00", "mon 08:00-08:00", "mon 08:00-09:00 extra"} {
+		_, _, err := NormalizeAvailabilityWindows([]string{bad}, "")
+		require.Error(t, err, bad)
+	}
+	_, _, err = NormalizeAvailabilityWindows([]string{"mon"}, "Mars/Olympus")
+	require.Error(t, err)
+}
+
+type accountAvailabilityRepoStub struct {
+	entries   []AccountAvailabilityEntry
+	notified  [][]int64
+	lastCheck time.Time
+}
+
+func (s *accountAvailabilityRepoStub) ListAvailabilitySchedules(context.Context) ([]AccountAvailabilityEntry, error) {
+	return s.entries, nil
+}
+
+func (s *accountAvailabilityRepoStub) NotifyAvailabilityChanged(_ context.Context, ids []int64) error {
+	s.notified = append(s.notified, ids)
+	return nil
+}
+
+func (s *accountAvailabilityRepoStub) GetLastBoundaryCheck(context.Context) (time.Time, error) {
+	return s.lastCheck, nil
+}
+
+func (s *accountAvailabilityRepoStub) UpdateLastBoundaryCheck(_ context.Context, checkedAt time.Time) error {
+	s.lastCheck = checkedAt
+	return nil
+}
+
+func TestAccountAvailabilityServiceNotifiesOnBoundary(t *testing.T) {
+	repo := &accountAvailabilityRepoStub{entries: []AccountAvailabilityEntry{
+		{AccountID: 1, Windows: []string{"* 19:00-08:00"}, Timezone: "UTC"},
+		{AccountID: 2, Windows: []string{"*"}, Timezone: "UTC"},
+	}}
+	svc := NewAccountAvailabilityService(repo, nil, nil)
+	ctx := context.Background()
+
+	before := time.Date(2026, 3, 4, 18, 59, 30, 0, time.UTC)
+	changed, err := svc.checkBoundaries(ctx, before)
+	require.NoError(t, err)
+	require.Empty(t, changed)
+	require.Equal(t, before, repo.lastCheck)
+
+	changed, err = svc.checkBoundaries(ctx, before.Add(30*time.Second))
+	require.NoError(t, err)
+	require.Equal(t, []int64{1}, changed)
+	require.Equal(t, [][]int64{{1}}, repo.notified)
+
+	changed, err = svc.checkBoundaries(ctx, before.Add(time.Minute))
+	require.NoError(t, err)
+	require.Empty(t, changed)
+	require.Len(t, repo.notified, 1)
+}
+
+func TestAccountAvailabilityServiceFirstRunAfterRestart(t *testing.T) {
+	entries := []AccountAvailabilityEntry{{AccountID: 1, Windows: []string{"* 19:00-08:00"}, Timezone: "UTC"}}
+	ctx := context.Background()
+
+	// 重启后首个 tick：持久化的上次检查时间在边界之前，期间跨越的边界需要补发
+	repo := &accountAvailabilityRepoStub{entries: entries, lastCheck: time.Date(2026, 3, 4, 18, 50, 0, 0, time.UTC)}
+	changed, err := NewAccountAvailabilityService(repo, nil, nil).checkBoundaries(ctx, time.Date(2026, 3, 4, 19, 5, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Equal(t, []int64{1}, changed)
+
+	// 从未检查过：以一个 tick 间隔之前作为上次检查时间
+	repo = &accountAvailabilityRepoStub{entries: entries}
+	changed, err = NewAccountAvailabilityService(repo, nil, nil).checkBoundaries(ctx, time.Date(2026, 3, 4, 19, 0, 10, 0, time.UTC))
+	require.NoError(t, err)
+	require.Equal(t, []int64{1}, changed)
+	require.Equal(t, time.Date(2026, 3, 4, 19, 0, 10, 0, time.UTC), repo.lastCheck)
+}
@@ -6,6 +6,7 @@ type AccountGroup struct {
 	AccountID int64
 	GroupID   int64
 	Priority  int
+	Pool      string // 分组内子池标签，默认 AccountPoolDefault
 	CreatedAt time.Time
 
 	Account *Account
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+const (
+	AccountProbeTriggerScheduled = "scheduled"
+	AccountProbeTriggerManual    = "manual"
+)
+
+const (
+	AccountProbeActionNone        = "none"
+	AccountProbeActionMarkedError = "marked_error"
+	AccountProbeActionRateLimited = "rate_limited"
+	AccountProbeActionTempUnsched = "temp_unschedulable"
+	AccountProbeActionRecovered   = "recovered"
+)
+
+// AccountProbeLog 账号健康探测记录
+// Action 表示探测后对账号状态做的处理：none/marked_error/rate_limited/temp_unschedulable/recovered
+type AccountProbeLog struct {
+	ID           int64
+	AccountID    int64
+	Platform     string
+	Model        string
+	Trigger      string
+	Success      bool
+	StatusCode   *int
+	LatencyMs    int
+	ErrorMessage *string
+	Action       string
+	CreatedAt    time.Time
+}
+
+// AccountProbeRepository 定义健康探测历史持久层接口
+type AccountProbeRepository interface {
+	Create(ctx context.Context, log *AccountProbeLog) error
+	ListByAccount(ctx context.Context, accountID int64, params pagination.PaginationParams) ([]AccountProbeLog, *pagination.PaginationResult, error)
+	// GetLastProbeTimes 返回 since 之后每个账号最近一次探测时间
+	GetLastProbeTimes(ctx context.Context, since time.Time) (map[int64]time.Time, error)
+	DeleteBefore(ctx context.Context, cutoff time.Time) (int64, error)
+}
@@ -0,0 +1,387 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+const (
+	accountHealthProbeWorkerName        = "account_health_probe_worker"
+	accountHealthProbeLeaderLockKey     = "account:health_probe:leader"
+	accountHealthProbeRetentionInterval = 6 * time.Hour
+	accountHealthProbeErrorListPageSize = 100
+	accountHealthProbeMaxErrorLength    = 1024
+)
+
+// AccountHealthProbeService 定期对空闲账号发送最小测试请求：
+// - 鉴权/额度类失败复用 RateLimitService 的处理逻辑（标记 error / 限流 / 临时不可调度）
+// - 探测成功时自动恢复处于 error 状态的账号
+// - 每次探测都会写入 account_probe_logs
+type AccountHealthProbeService struct {
+	accountRepo      AccountRepository
+	probeRepo        AccountProbeRepository
+	testService      *AccountTestService
+	rateLimitService *RateLimitService
+	timingWheel      *TimingWheelService
+	db               *sql.DB
+	cfg              config.AccountHealthProbeConfig
+
+	// probe 执行单次探测，默认使用 AccountTestService.ProbeAccount，测试中可替换
+	probe func(ctx context.Context, account *Account, modelID string) *AccountProbeOutcome
+
+	running              int32
+	lastRetentionCleanup atomic.Value // time.Time
+	startOnce            sync.Once
+	stopOnce             sync.Once
+
+	workerCtx    context.Context
+	workerCancel context.CancelFunc
+}
+
+func NewAccountHealthProbeService(
+	accountRepo AccountRepository,
+	probeRepo AccountProbeRepository,
+	testService *AccountTestService,
+	rateLimitService *RateLimitService,
+	timingWheel *TimingWheelService,
+	db *sql.DB,
+	cfg *config.Config,
+) *AccountHealthProbeService {
+	var probeCfg config.AccountHealthProbeConfig
+	if cfg != nil {
+		probeCfg = cfg.HealthProbe
+	}
+	workerCtx, workerCancel := context.WithCancel(context.Background())
+	svc := &AccountHealthProbeService{
+		accountRepo:      accountRepo,
+		probeRepo:        probeRepo,
+		testService:      testService,
+		rateLimitService: rateLimitService,
+		timingWheel:      timingWheel,
+		db:               db,
+		cfg:              probeCfg,
+		workerCtx:        workerCtx,
+		workerCancel:     workerCancel,
+	}
+	if testService != nil {
+		svc.probe = testService.ProbeAccount
+	}
+	return svc
+}
+
+func (s *AccountHealthProbeService) Start() {
+	if s == nil {
+		return
+	}
+	if !s.cfg.Enabled {
+		log.Printf("[AccountHealthProbe] not started (disabled)")
+		return
+	}
+	if s.accountRepo == nil || s.probeRepo == nil || s.probe == nil || s.timingWheel == nil {
+		log.Printf("[AccountHealthProbe] not started (missing deps)")
+		return
+	}
+
+	interval := time.Duration(s.cfg.TickSeconds) * time.Second
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	s.startOnce.Do(func() {
+		s.timingWheel.ScheduleRecurring(accountHealthProbeWorkerName, interval, s.runOnce)
+		log.Printf("[AccountHealthProbe] started (tick=%s idle=%dm max_per_tick=%d)", interval, s.cfg.IdleMinutes, s.maxProbesPerTick())
+	})
+}
+
+func (s *AccountHealthProbeService) Stop() {
+	if s == nil {
+		return
+	}
+	s.stopOnce.Do(func() {
+		if s.workerCancel != nil {
+			s.workerCancel()
+		}
+		if s.timingWheel != nil {
+			s.timingWheel.Cancel(accountHealthProbeWorkerName)
+		}
+		log.Printf("[AccountHealthProbe] stopped")
+	})
+}
+
+// ListProbeLogs 分页查询账号的探测历史
+func (s *AccountHealthProbeService) ListProbeLogs(ctx context.Context, accountID int64, params pagination.PaginationParams) ([]AccountProbeLog, *pagination.PaginationResult, error) {
+	if s == nil || s.probeRepo == nil {
+		return nil, nil, ErrServiceUnavailable
+	}
+	return s.probeRepo.ListByAccount(ctx, accountID, params)
+}
+
+// ProbeNow 立即探测指定账号（管理员手动触发），结果与定时探测一样写入历史并处理账号状态
+func (s *AccountHealthProbeService) ProbeNow(ctx context.Context, accountID int64) (*AccountProbeLog, error) {
+	if s == nil || s.accountRepo == nil || s.probe == nil {
+		return nil, ErrServiceUnavailable
+	}
+	account, err := s.accountRepo.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	return s.probeAccount(ctx, account, AccountProbeTriggerManual), nil
+}
+
+func (s *AccountHealthProbeService) runOnce() {
+	if !atomic.CompareAndSwapInt32(&s.running, 0, 1) {
+		return
+	}
+	defer atomic.StoreInt32(&s.running, 0)
+
+	parent := context.Background()
+	if s.workerCtx != nil {
+		parent = s.workerCtx
+	}
+	ctx, cancel := context.WithTimeout(parent, time.Duration(s.maxProbesPerTick())*s.probeTimeout()+30*time.Second)
+	defer cancel()
+
+	// 多实例部署时只允许一个节点执行探测，避免重复消耗上游额度
+	if s.db != nil {
+		release, ok := tryAcquireDBAdvisoryLock(ctx, s.db, hashAdvisoryLockID(accountHealthProbeLeaderLockKey))
+		if !ok {
+			return
+		}
+		defer release()
+	}
+
+	now := time.Now()
+	s.maybeCleanupHistory(ctx, now)
+
+	candidates, err := s.selectDueAccounts(ctx, now)
+	if err != nil {
+		log.Printf("[AccountHealthProbe] select due accounts failed: %v", err)
+		return
+	}
+	for i := range candidates {
+		if ctx.Err() != nil {
+			return
+		}
+		s.probeAccount(ctx, &candidates[i], AccountProbeTriggerScheduled)
+	}
+}
+
+// selectDueAccounts 挑选本轮需要探测的账号：
+// - 平台已配置探测间隔，且距离上次探测已超过间隔
+// - active 账号需空闲超过 idle_minutes；error 账号总是参与（用于自动恢复）
+// - 管理员手动停止调度、限流/过载/临时不可调度中、已过期自动暂停的账号跳过（这些状态会自行恢复）
+// - error 账号优先，其次按上次探测时间升序
+func (s *AccountHealthProbeService) selectDueAccounts(ctx context.Context, now time.Time) ([]Account, error) {
+	active, err := s.accountRepo.ListActive(ctx)
+	if err != nil {
+		return nil, err
+	}
+	errored, err := s.listErroredAccounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lastProbes, err := s.probeRepo.GetLastProbeTimes(ctx, now.Add(-s.maxInterval()))
+	if err != nil {
+		return nil, err
+	}
+
+	idle := time.Duration(s.cfg.IdleMinutes) * time.Minute
+	due := make([]Account, 0)
+	for _, account := range append(errored, active...) {
+		interval := s.platformInterval(account.Platform)
+		if interval <= 0 || !account.Schedulable {
+			continue
+		}
+		if account.IsRateLimited() || account.IsOverloaded() {
+			continue
+		}
+		if account.TempUnschedulableUntil != nil && now.Before(*account.TempUnschedulableUntil) {
+			continue
+		}
+		if account.AutoPauseOnExpired && account.ExpiresAt != nil && !now.Before(*account.ExpiresAt) {
+			continue
+		}
+		if account.Status == StatusActive && account.LastUsedAt != nil && now.Sub(*account.LastUsedAt) < idle {
+			continue
+		}
+		if last, ok := lastProbes[account.ID]; ok && now.Sub(last) < interval {
+			continue
+		}
+		due = append(due, account)
+	}
+
+	sort.SliceStable(due, func(i, j int) bool {
+		ei, ej := due[i].Status == StatusError, due[j].Status == StatusError
+		if ei != ej {
+			return ei
+		}
+		return lastProbes[due[i].ID].Before(lastProbes[due[j].ID])
+	})
+	if limit := s.maxProbesPerTick(); len(due) > limit {
+		due = due[:limit]
+	}
+	return due, nil
+}
+
+func (s *AccountHealthProbeService) listErroredAccounts(ctx context.Context) ([]Account, error) {
+	var out []Account
+	for page := 1; ; page++ {
+		params := pagination.PaginationParams{Page: page, PageSize: accountHealthProbeErrorListPageSize}
+		accounts, result, err := s.accountRepo.ListWithFilters(ctx, params, "", "", StatusError, "")
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, accounts...)
+		if result == nil || page >= result.Pages || len(accounts) == 0 {
+			return out, nil
+		}
+	}
+}
+
+func (s *AccountHealthProbeService) probeAccount(ctx context.Context, account *Account, trigger string) *AccountProbeLog {
+	probeCtx, cancel := context.WithTimeout(ctx, s.probeTimeout())
+	outcome := s.probe(probeCtx, account, s.platformModel(account.Platform))
+	cancel()
+	if outcome == nil {
+		outcome = &AccountProbeOutcome{ErrorMessage: "probe returned no result"}
+	}
+
+	entry := &AccountProbeLog{
+		AccountID: account.ID,
+		Platform:  account.Platform,
+		Model:     outcome.Model,
+		Trigger:   trigger,
+		Success:   outcome.Success,
+		LatencyMs: int(outcome.Latency.Milliseconds()),
+		Action:    s.applyOutcome(ctx, account, outcome),
+		CreatedAt: time.Now(),
+	}
+	if outcome.StatusCode > 0 {
+		code := outcome.StatusCode
+		entry.StatusCode = &code
+	}
+	if outcome.ErrorMessage != "" {
+		msg := truncateForLog([]byte(outcome.ErrorMessage), accountHealthProbeMaxErrorLength)
+		entry.ErrorMessage = &msg
+	}
+
+	if err := s.probeRepo.Create(ctx, entry); err != nil {
+		log.Printf("[AccountHealthProbe] save probe log failed: account=%d err=%v", account.ID, err)
+	}
+	log.Printf("[AccountHealthProbe] probed: account=%d platform=%s trigger=%s success=%t status=%d latency_ms=%d action=%s",
+		account.ID, account.Platform, trigger, entry.Success, outcome.StatusCode, entry.LatencyMs, entry.Action)
+	return entry
+}
+
+// applyOutcome 根据探测结果调整账号状态，返回执行的动作
+func (s *AccountHealthProbeService) applyOutcome(ctx context.Context, account *Account, outcome *AccountProbeOutcome) string {
+	if outcome.Success {
+		if account.Status != StatusError {
+			return AccountProbeActionNone
+		}
+		if err := s.accountRepo.ClearError(ctx, account.ID); err != nil {
+			log.Printf("[AccountHealthProbe] recover account failed: account=%d err=%v", account.ID, err)
+			return AccountProbeActionNone
+		}
+		return AccountProbeActionRecovered
+	}
+
+	// 网络错误、流读取失败等没有上游状态码的结果不足以判定账号异常，只记录不处理
+	if outcome.StatusCode == 0 || s.rateLimitService == nil {
+		return AccountProbeActionNone
+	}
+	s.rateLimitService.HandleUpstreamError(ctx, account, outcome.StatusCode, http.Header{}, outcome.ResponseBody)
+
+	updated, err := s.accountRepo.GetByID(ctx, account.ID)
+	if err != nil {
+		log.Printf("[AccountHealthProbe] reload account failed: account=%d err=%v", account.ID, err)
+		return AccountProbeActionNone
+	}
+	now := time.Now()
+	switch {
+	case updated.Status == StatusError:
+		return AccountProbeActionMarkedError
+	case updated.IsRateLimited():
+		return AccountProbeActionRateLimited
+	case updated.TempUnschedulableUntil != nil && now.Before(*updated.TempUnschedulableUntil):
+		return AccountProbeActionTempUnsched
+	default:
+		return AccountProbeActionNone
+	}
+}
+
+func (s *AccountHealthProbeService) maybeCleanupHistory(ctx context.Context, now time.Time) {
+	if s.cfg.RetentionDays <= 0 {
+		return
+	}
+	if last, ok := s.lastRetentionCleanup.Load().(time.Time); ok && now.Sub(last) < accountHealthProbeRetentionInterval {
+		return
+	}
+	s.lastRetentionCleanup.Store(now)
+
+	cutoff := now.AddDate(0, 0, -s.cfg.RetentionDays)
+	deleted, err := s.probeRepo.DeleteBefore(ctx, cutoff)
+	if err != nil {
+		log.Printf("[AccountHealthProbe] cleanup history failed: %v", err)
+		return
+	}
+	if deleted > 0 {
+		log.Printf("[AccountHealthProbe] cleaned up %d probe logs before %s", deleted, cutoff.Format(time.RFC3339))
+	}
+}
+
+func (s *AccountHealthProbeService) platformConfig(platform string) config.AccountHealthProbePlatformConfig {
+	switch platform {
+	case PlatformAnthropic:
+		return s.cfg.Platforms.Anthropic
+	case PlatformOpenAI:
+		return s.cfg.Platforms.OpenAI
+	case PlatformGemini:
+		return s.cfg.Platforms.Gemini
+	case PlatformAntigravity:
+		return s.cfg.Platforms.Antigravity
+	default:
+		return config.AccountHealthProbePlatformConfig{}
+	}
+}
+
+func (s *AccountHealthProbeService) platformInterval(platform string) time.Duration {
+	return time.Duration(s.platformConfig(platform).IntervalMinutes) * time.Minute
+}
+
+func (s *AccountHealthProbeService) platformModel(platform string) string {
+	return s.platformConfig(platform).Model
+}
+
+func (s *AccountHealthProbeService) maxInterval() time.Duration {
+	maxInterval := time.Duration(0)
+	for _, platform := range []string{PlatformAnthropic, PlatformOpenAI, PlatformGemini, PlatformAntigravity} {
+		if interval := s.platformInterval(platform); interval > maxInterval {
+			maxInterval = interval
+		}
+	}
+	return maxInterval
+}
+
+func (s *AccountHealthProbeService) maxProbesPerTick() int {
+	if s.cfg.MaxProbesPerTick <= 0 {
+		return 10
+	}
+	return s.cfg.MaxProbesPerTick
+}
+
+func (s *AccountHealthProbeService) probeTimeout() time.Duration {
+	if s.cfg.ProbeTimeoutSeconds <= 0 {
+		return time.Minute
+	}
+	return time.Duration(s.cfg.ProbeTimeoutSeconds) * time.Second
+}
@@ -0,0 +1,176 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/stretchr/testify/require"
+)
+
+type probeAccountRepoStub struct {
+	AccountRepository
+
+	active   []Account
+	errored  []Account
+	byID     map[int64]*Account
+	setError map[int64]string
+	cleared  []int64
+}
+
+func (r *probeAccountRepoStub) ListActive(ctx context.Context) ([]Account, error) {
+	return r.active, nil
+}
+
+func (r *probeAccountRepoStub) ListWithFilters(ctx context.Context, params pagination.PaginationParams, platform, accountType, status, search string) ([]Account, *pagination.PaginationResult, error) {
+	return r.errored, &pagination.PaginationResult{Total: int64(len(r.errored)), Page: params.Page, PageSize: params.PageSize, Pages: 1}, nil
+}
+
+func (r *probeAccountRepoStub) GetByID(ctx context.Context, id int64) (*Account, error) {
+	account, ok := r.byID[id]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	clone := *account
+	return &clone, nil
+}
+
+func (r *probeAccountRepoStub) SetError(ctx context.Context, id int64, errorMsg string) error {
+	if r.setError == nil {
+		r.setError = map[int64]string{}
+	}
+	r.setError[id] = errorMsg
+	if account, ok := r.byID[id]; ok {
+		account.Status = StatusError
+		account.ErrorMessage = errorMsg
+	}
+	return nil
+}
+
+func (r *probeAccountRepoStub) ClearError(ctx context.Context, id int64) error {
+	r.cleared = append(r.cleared, id)
+	return nil
+}
+
+type probeLogRepoStub struct {
+	created    []*AccountProbeLog
+	lastProbes map[int64]time.Time
+}
+
+func (r *probeLogRepoStub) Create(ctx context.Context, entry *AccountProbeLog) error {
+	r.created = append(r.created, entry)
+	return nil
+}
+
+func (r *probeLogRepoStub) ListByAccount(ctx context.Context, accountID int64, params pagination.PaginationParams) ([]AccountProbeLog, *pagination.PaginationResult, error) {
+	return nil, nil, nil
+}
+
+func (r *probeLogRepoStub) GetLastProbeTimes(ctx context.Context, since time.Time) (map[int64]time.Time, error) {
+	return r.lastProbes, nil
+}
+
+func (r *probeLogRepoStub) DeleteBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	return 0, nil
+}
+
+func newTestHealthProbeService(accountRepo AccountRepository, probeRepo AccountProbeRepository, outcome *AccountProbeOutcome) *AccountHealthProbeService {
+	cfg := &config.Config{HealthProbe: config.AccountHealthProbeConfig{
+		Enabled:          true,
+		IdleMinutes:      30,
+		MaxProbesPerTick: 10,
+		Platforms: config.AccountHealthProbePlatformsConfig{
+			Anthropic: config.AccountHealthProbePlatformConfig{IntervalMinutes: 60},
+		},
+	}}
+	rateLimitService := NewRateLimitService(accountRepo, nil, cfg, nil, nil)
+	svc := NewAccountHealthProbeService(accountRepo, probeRepo, nil, rateLimitService, nil, nil, cfg)
+	svc.probe = func(ctx context.Context, account *Account, modelID string) *AccountProbeOutcome {
+		return outcome
+	}
+	return svc
+}
+
+func TestAccountHealthProbeSelectDueAccounts(t *testing.T) {
+	now := time.Now()
+	recentlyUsed := now.Add(-5 * time.Minute)
+	longIdle := now.Add(-2 * time.Hour)
+	rateLimitedUntil := now.Add(10 * time.Minute)
+
+	repo := &probeAccountRepoStub{
+		active: []Account{
+			{ID: 1, Platform: PlatformAnthropic, Status: StatusActive, Schedulable: true, LastUsedAt: &longIdle},
+			{ID: 2, Platform: PlatformAnthropic, Status: StatusActive, Schedulable: true, LastUsedAt: &recentlyUsed},
+			{ID: 3, Platform: PlatformAnthropic, Status: StatusActive, Schedulable: false},
+			{ID: 4, Platform: PlatformAnthropic, Status: StatusActive, Schedulable: true, RateLimitResetAt: &rateLimitedUntil},
+			{ID: 5, Platform: PlatformOpenAI, Status: StatusActive, Schedulable: true},
+			{ID: 6, Platform: PlatformAnthropic, Status: StatusActive, Schedulable: true},
+		},
+		errored: []Account{
+			{ID: 7, Platform: PlatformAnthropic, Status: StatusError, Schedulable: true, LastUsedAt: &recentlyUsed},
+		},
+	}
+	probeRepo := &probeLogRepoStub{lastProbes: map[int64]time.Time{6: now.Add(-10 * time.Minute)}}
+	svc := newTestHealthProbeService(repo, probeRepo, nil)
+
+	due, err := svc.selectDueAccounts(context.Background(), now)
+	require.NoError(t, err)
+
+	ids := make([]int64, 0, len(due))
+	for _, account := range due {
+		ids = append(ids, account.ID)
+	}
+	require.Equal(t, []int64{7, 1}, ids)
+}
+
+func TestAccountHealthProbeRecoversErroredAccount(t *testing.T) {
+	account := &Account{ID: 7, Platform: PlatformAnthropic, Type: AccountTypeAPIKey, Status: StatusError, Schedulable: true}
+	repo := &probeAccountRepoStub{byID: map[int64]*Account{7: account}}
+	probeRepo := &probeLogRepoStub{}
+	svc := newTestHealthProbeService(repo, probeRepo, &AccountProbeOutcome{Success: true, Model: "claude-sonnet-4-5"})
+
+	entry := svc.probeAccount(context.Background(), account, AccountProbeTriggerScheduled)
+
+	require.Equal(t, AccountProbeActionRecovered, entry.Action)
+	require.Equal(t, []int64{7}, repo.cleared)
+	require.Len(t, probeRepo.created, 1)
+	require.True(t, probeRepo.created[0].Success)
+}
+
+func TestAccountHealthProbeMarksAuthFailure(t *testing.T) {
+	account := &Account{ID: 1, Platform: PlatformAnthropic, Type: AccountTypeAPIKey, Status: StatusActive, Schedulable: true}
+	repo := &probeAccountRepoStub{byID: map[int64]*Account{1: account}}
+	probeRepo := &probeLogRepoStub{}
+	svc := newTestHealthProbeService(repo, probeRepo, &AccountProbeOutcome{
+		StatusCode:   401,
+		ErrorMessage: "API returned 401: invalid x-api-key",
+		ResponseBody: []byte(`{"error":{"message":"invalid x-api-key"}}`),
+	})
+
+	entry := svc.probeAccount(context.Background(), account, AccountProbeTriggerManual)
+
+	require.Equal(t, AccountProbeActionMarkedError, entry.Action)
+	require.Contains(t, repo.setError[1], "401")
+	require.NotNil(t, entry.StatusCode)
+	require.Equal(t, 401, *entry.StatusCode)
+	require.Empty(t, repo.cleared)
+}
+
+func TestAccountHealthProbeIgnoresInconclusiveFailure(t *testing.T) {
+	account := &Account{ID: 1, Platform: PlatformAnthropic, Type: AccountTypeAPIKey, Status: StatusError, Schedulable: true}
+	repo := &probeAccountRepoStub{byID: map[int64]*Account{1: account}}
+	probeRepo := &probeLogRepoStub{}
+	svc := newTestHealthProbeService(repo, probeRepo, &AccountProbeOutcome{ErrorMessage: "Request failed: dial tcp: i/o timeout"})
+
+	entry := svc.probeAccount(context.Background(), account, AccountProbeTriggerScheduled)
+
+	require.Equal(t, AccountProbeActionNone, entry.Action)
+	require.Nil(t, entry.StatusCode)
+	require.Empty(t, repo.setError)
+	require.Empty(t, repo.cleared)
+}
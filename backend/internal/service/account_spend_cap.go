@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+)
+
+// 账号消费上限（适用于所有平台）
+//
+// 配置存放在 account.extra 中（单位：美元，<=0 或缺省表示不限制）：
+//   - daily_spend_cap:   自然日（服务器时区 00:00 起）
+//   - weekly_spend_cap:  自然周（周一 00:00 起）
+//   - monthly_spend_cap: 自然月（1 日 00:00 起）
+//
+// 消费按 usage_logs 中的账号成本（total_cost * account_rate_multiplier）统计，
+// 超出上限的账号被临时移出调度，直至对应周期重置。
+
+const (
+	SpendCapPeriodDaily   = "daily"
+	SpendCapPeriodWeekly  = "weekly"
+	SpendCapPeriodMonthly = "monthly"
+)
+
+// SpendCapPeriods 按周期从短到长排列
+var SpendCapPeriods = []string{SpendCapPeriodDaily, SpendCapPeriodWeekly, SpendCapPeriodMonthly}
+
+var spendCapExtraKeys = map[string]string{
+	SpendCapPeriodDaily:   "daily_spend_cap",
+	SpendCapPeriodWeekly:  "weekly_spend_cap",
+	SpendCapPeriodMonthly: "monthly_spend_cap",
+}
+
+// AccountSpendCapStatus 账号某个周期的消费进度（供管理端展示进度条）
+type AccountSpendCapStatus struct {
+	Period   string    `json:"period"`
+	Cap      float64   `json:"cap"`
+	Spent    float64   `json:"spent"`
+	Percent  float64   `json:"percent"`
+	Exceeded bool      `json:"exceeded"`
+	ResetsAt time.Time `json:"resets_at"`
+}
+
+// AccountSpendCache 账号周期消费缓存
+//
+// Key 格式: account_spend:{accountID}:{period}:{periodStartUnix}
+// 周期起点编码在 key 中，周期切换后自然落到新 key，旧 key 由 TTL 回收
+type AccountSpendCache interface {
+	// GetAccountSpend 获取缓存的周期消费，未命中时返回 (0, false, nil)
+	GetAccountSpend(ctx context.Context, accountID int64, period string, periodStart time.Time) (float64, bool, error)
+	// SetAccountSpend 写入周期消费（通常来自 usage_logs 聚合）
+	SetAccountSpend(ctx context.Context, accountID int64, period string, periodStart time.Time, spend float64, ttl time.Duration) error
+	// IncrAccountSpend 仅在缓存存在时累加并返回新值；缓存不存在时返回 (0, false, nil)
+	IncrAccountSpend(ctx context.Context, accountID int64, period string, periodStart time.Time, delta float64) (float64, bool, error)
+}
+
+// GetSpendCap 获取指定周期的消费上限（美元），返回 0 表示未启用
+func (a *Account) GetSpendCap(period string) float64 {
+	if a == nil || a.Extra == nil {
+		return 0
+	}
+	key, ok := spendCapExtraKeys[period]
+	if !ok {
+		return 0
+	}
+	if v, ok := a.Extra[key]; ok {
+		if limit := parseExtraFloat64(v); limit > 0 {
+			return limit
+		}
+	}
+	return 0
+}
+
+// HasSpendCaps 是否配置了任一周期的消费上限
+func (a *Account) HasSpendCaps() bool {
+	for _, period := range SpendCapPeriods {
+		if a.GetSpendCap(period) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// spendCapPeriodBounds 返回 now 所在周期的 [start, end)
+func spendCapPeriodBounds(period string, now time.Time) (time.Time, time.Time) {
+	switch period {
+	case SpendCapPeriodWeekly:
+		start := timezone.StartOfWeek(now)
+		return start, start.AddDate(0, 0, 7)
+	case SpendCapPeriodMonthly:
+		start := timezone.StartOfMonth(now)
+		return start, start.AddDate(0, 1, 0)
+	default:
+		start := timezone.StartOfDay(now)
+		return start, start.AddDate(0, 0, 1)
+	}
+}
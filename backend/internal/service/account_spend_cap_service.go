@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// 周期消费缓存 TTL 上限：到期后从 usage_logs 重新聚合，修正并发累加可能产生的偏差
+	accountSpendCacheMaxTTL = 30 * time.Minute
+
+	spendCapAlertSeverity = "P1"
+)
+
+// AccountSpendCapService 账号日/周/月消费上限
+//
+// 每次记录用量后累加账号在各周期的消费（Redis 缓存，未命中时从 usage_logs 聚合），
+// 达到上限时将账号临时设为不可调度直至周期重置，并写入一条运维告警事件。
+type AccountSpendCapService struct {
+	accountRepo      AccountRepository
+	usageLogRepo     UsageLogRepository
+	cache            AccountSpendCache
+	tempUnschedCache TempUnschedCache
+	opsRepo          OpsRepository
+
+	// 已告警的周期：key=accountID|period，value=周期起点，同一周期只告警一次
+	alerted sync.Map
+}
+
+func NewAccountSpendCapService(
+	accountRepo AccountRepository,
+	usageLogRepo UsageLogRepository,
+	cache AccountSpendCache,
+	tempUnschedCache TempUnschedCache,
+	opsRepo OpsRepository,
+) *AccountSpendCapService {
+	return &AccountSpendCapService{
+		accountRepo:      accountRepo,
+		usageLogRepo:     usageLogRepo,
+		cache:            cache,
+		tempUnschedCache: tempUnschedCache,
+		opsRepo:          opsRepo,
+	}
+}
+
+// RecordSpend 在用量日志写入后调用，累加账号各周期消费并在超限时暂停账号
+// cost 为账号成本（total_cost * account_rate_multiplier）
+func (s *AccountSpendCapService) RecordSpend(ctx context.Context, account *Account, cost float64) {
+	if s == nil || account == nil || !account.HasSpendCaps() {
+		return
+	}
+	now := time.Now()
+	for _, period := range SpendCapPeriods {
+		limit := account.GetSpendCap(period)
+		if limit <= 0 {
+			continue
+		}
+		start, end := spendCapPeriodBounds(period, now)
+		spent, err := s.addPeriodSpend(ctx, account.ID, period, start, end, cost, now)
+		if err != nil {
+			slog.Warn("account_spend_cap_record_failed", "account_id", account.ID, "period", period, "error", err)
+			continue
+		}
+		if spent >= limit {
+			s.pauseAccount(ctx, account, period, spent, limit, end, now)
+		}
+	}
+}
+
+// GetSpendStatuses 返回账号已配置上限的各周期消费进度
+func (s *AccountSpendCapService) GetSpendStatuses(ctx context.Context, account *Account) []AccountSpendCapStatus {
+	if s == nil || account == nil || !account.HasSpendCaps() {
+		return nil
+	}
+	now := time.Now()
+	out := make([]AccountSpendCapStatus, 0, len(SpendCapPeriods))
+	for _, period := range SpendCapPeriods {
+		limit := account.GetSpendCap(period)
+		if limit <= 0 {
+			continue
+		}
+		start, end := spendCapPeriodBounds(period, now)
+		spent, err := s.getPeriodSpend(ctx, account.ID, period, start, end, now)
+		if err != nil {
+			slog.Warn("account_spend_cap_query_failed", "account_id", account.ID, "period", period, "error", err)
+			continue
+		}
+		out = append(out, AccountSpendCapStatus{
+			Period:   period,
+			Cap:      limit,
+			Spent:    spent,
+			Percent:  math.Round(spent/limit*10000) / 100,
+			Exceeded: spent >= limit,
+			ResetsAt: end,
+		})
+	}
+	return out
+}
+
+func (s *AccountSpendCapService) addPeriodSpend(ctx context.Context, accountID int64, period string, start, end time.Time, delta float64, now time.Time) (float64, error) {
+	if s.cache != nil && delta > 0 {
+		spent, ok, err := s.cache.IncrAccountSpend(ctx, accountID, period, start, delta)
+		if err == nil && ok {
+			return spent, nil
+		}
+	}
+	// 缓存未命中：从 usage_logs 聚合（调用方已写入本次用量日志，聚合结果已包含 delta）
+	return s.loadPeriodSpend(ctx, accountID, period, start, end, now)
+}
+
+func (s *AccountSpendCapService) getPeriodSpend(ctx context.Context, accountID int64, period string, start, end time.Time, now time.Time) (float64, error) {
+	if s.cache != nil {
+		spent, ok, err := s.cache.GetAccountSpend(ctx, accountID, period, start)
+		if err == nil && ok {
+			return spent, nil
+		}
+	}
+	return s.loadPeriodSpend(ctx, accountID, period, start, end, now)
+}
+
+func (s *AccountSpendCapService) loadPeriodSpend(ctx context.Context, accountID int64, period string, start, end time.Time, now time.Time) (float64, error) {
+	if s.usageLogRepo == nil {
+		return 0, fmt.Errorf("usage log repository not available")
+	}
+	stats, err := s.usageLogRepo.GetAccountWindowStats(ctx, accountID, start)
+	if err != nil {
+		return 0, err
+	}
+	spent := 0.0
+	if stats != nil {
+		spent = stats.Cost
+	}
+	if s.cache != nil {
+		ttl := end.Sub(now)
+		if ttl > accountSpendCacheMaxTTL {
+			ttl = accountSpendCacheMaxTTL
+		}
+		if ttl > 0 {
+			if err := s.cache.SetAccountSpend(ctx, accountID, period, start, spent, ttl); err != nil {
+				slog.Warn("account_spend_cache_set_failed", "account_id", accountID, "period", period, "error", err)
+			}
+		}
+	}
+	return spent, nil
+}
+
+// pauseAccount 将账号临时设为不可调度直至周期结束；调度快照中的账号已暂停到该时间时跳过
+func (s *AccountSpendCapService) pauseAccount(ctx context.Context, account *Account, period string, spent, limit float64, until, now time.Time) {
+	if account.TempUnschedulableUntil != nil && !account.TempUnschedulableUntil.Before(until) {
+		return
+	}
+	if s.accountRepo == nil {
+		return
+	}
+
+	state := &TempUnschedState{
+		UntilUnix:       until.Unix(),
+		TriggeredAtUnix: now.Unix(),
+		MatchedKeyword:  "spend_cap_" + period,
+		RuleIndex:       -1, // 系统级规则
+		ErrorMessage:    fmt.Sprintf("%s spend cap reached: $%.4f / $%.4f", period, spent, limit),
+	}
+	reason := state.ErrorMessage
+	if raw, err := json.Marshal(state); err == nil {
+		reason = string(raw)
+	}
+
+	if err := s.accountRepo.SetTempUnschedulable(ctx, account.ID, until, reason); err != nil {
+		slog.Warn("account_spend_cap_pause_failed", "account_id", account.ID, "period", period, "error", err)
+		return
+	}
+	if s.tempUnschedCache != nil {
+		if err := s.tempUnschedCache.SetTempUnsched(ctx, account.ID, state); err != nil {
+			slog.Warn("temp_unsched_cache_set_failed", "account_id", account.ID, "error", err)
+		}
+	}
+	slog.Info("account_spend_cap_reached", "account_id", account.ID, "period", period, "spent", spent, "cap", limit, "until", until)
+
+	s.fireAlert(ctx, account, period, spent, limit, until, now)
+}
+
+func (s *AccountSpendCapService) fireAlert(ctx context.Context, account *Account, period string, spent, limit float64, until, now time.Time) {
+	if s.opsRepo == nil {
+		return
+	}
+	start, _ := spendCapPeriodBounds(period, now)
+	key := strconv.FormatInt(account.ID, 10) + "|" + period
+	if prev, loaded := s.alerted.Swap(key, start.Unix()); loaded && prev.(int64) == start.Unix() {
+		return
+	}
+
+	event := &OpsAlertEvent{
+		Severity:       spendCapAlertSeverity,
+		Status:         OpsAlertStatusFiring,
+		Title:          fmt.Sprintf("%s: account %s reached %s spend cap", spendCapAlertSeverity, account.Name, period),
+		Description:    fmt.Sprintf("Account #%d (%s) spent $%.4f against a %s cap of $%.4f; paused until %s.", account.ID, account.Platform, spent, period, limit, until.Format(time.RFC3339)),
+		MetricValue:    &spent,
+		ThresholdValue: &limit,
+		Dimensions: map[string]any{
+			"account_id": account.ID,
+			"platform":   account.Platform,
+			"period":     period,
+		},
+		FiredAt:   now,
+		CreatedAt: now,
+	}
+	if _, err := s.opsRepo.CreateAlertEvent(ctx, event); err != nil {
+		slog.Warn("account_spend_cap_alert_failed", "account_id", account.ID, "period", period, "error", err)
+	}
+}
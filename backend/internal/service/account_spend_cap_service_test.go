@@ -0,0 +1,143 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/usagestats"
+	"github.com/stretchr/testify/require"
+)
+
+type spendCapCacheStub struct {
+	values map[string]float64
+}
+
+func (s *spendCapCacheStub) key(accountID int64, period string, start time.Time) string {
+	return fmt.Sprintf("%d:%s:%d", accountID, period, start.Unix())
+}
+
+func (s *spendCapCacheStub) GetAccountSpend(_ context.Context, accountID int64, period string, start time.Time) (float64, bool, error) {
+	v, ok := s.values[s.key(accountID, period, start)]
+	return v, ok, nil
+}
+
+func (s *spendCapCacheStub) SetAccountSpend(_ context.Context, accountID int64, period string, start time.Time, spend float64, _ time.Duration) error {
+	s.values[s.key(accountID, period, start)] = spend
+	return nil
+}
+
+func (s *spendCapCacheStub) IncrAccountSpend(_ context.Context, accountID int64, period string, start time.Time, delta float64) (float64, bool, error) {
+	k := s.key(accountID, period, start)
+	v, ok := s.values[k]
+	if !ok {
+		return 0, false, nil
+	}
+	s.values[k] = v + delta
+	return v + delta, true, nil
+}
+
+type spendCapUsageRepoStub struct {
+	UsageLogRepository
+	cost  float64
+	calls int
+}
+
+func (s *spendCapUsageRepoStub) GetAccountWindowStats(context.Context, int64, time.Time) (*usagestats.AccountStats, error) {
+	s.calls++
+	return &usagestats.AccountStats{Cost: s.cost}, nil
+}
+
+type spendCapAccountRepoStub struct {
+	AccountRepository
+	pausedUntil map[int64]time.Time
+}
+
+func (s *spendCapAccountRepoStub) SetTempUnschedulable(_ context.Context, id int64, until time.Time, _ string) error {
+	s.pausedUntil[id] = until
+	return nil
+}
+
+type spendCapOpsRepoStub struct {
+	OpsRepository
+	events []*OpsAlertEvent
+}
+
+func (s *spendCapOpsRepoStub) CreateAlertEvent(_ context.Context, event *OpsAlertEvent) (*OpsAlertEvent, error) {
+	s.events = append(s.events, event)
+	return event, nil
+}
+
+func TestAccountGetSpendCap(t *testing.T) {
+	account := &Account{Extra: map[string]any{
+		"daily_spend_cap":   "12.5",
+		"weekly_spend_cap":  float64(50),
+		"monthly_spend_cap": -1,
+	}}
+	require.Equal(t, 12.5, account.GetSpendCap(SpendCapPeriodDaily))
+	require.Equal(t, 50.0, account.GetSpendCap(SpendCapPeriodWeekly))
+	require.Zero(t, account.GetSpendCap(SpendCapPeriodMonthly))
+	require.True(t, account.HasSpendCaps())
+	require.False(t, (&Account{}).HasSpendCaps())
+}
+
+func TestSpendCapPeriodBounds(t *testing.T) {
+	now := time.Now()
+	for _, period := range SpendCapPeriods {
+		start, end := spendCapPeriodBounds(period, now)
+		require.False(t, now.Before(start), period)
+		require.True(t, now.Before(end), period)
+	}
+}
+
+func TestAccountSpendCapService_RecordSpendPausesOnce(t *testing.T) {
+	cache := &spendCapCacheStub{values: map[string]float64{}}
+	usageRepo := &spendCapUsageRepoStub{cost: 8}
+	accountRepo := &spendCapAccountRepoStub{pausedUntil: map[int64]time.Time{}}
+	opsRepo := &spendCapOpsRepoStub{}
+	svc := NewAccountSpendCapService(accountRepo, usageRepo, cache, nil, opsRepo)
+	ctx := context.Background()
+
+	account := &Account{ID: 7, Name: "acc", Platform: PlatformGemini, Extra: map[string]any{"daily_spend_cap": 10}}
+
+	// 首次缓存未命中，从 usage_logs 聚合（已包含本次用量）
+	svc.RecordSpend(ctx, account, 1)
+	require.Equal(t, 1, usageRepo.calls)
+	require.Empty(t, accountRepo.pausedUntil)
+
+	// 后续累加走缓存
+	svc.RecordSpend(ctx, account, 1.5)
+	require.Equal(t, 1, usageRepo.calls)
+	require.Empty(t, accountRepo.pausedUntil)
+
+	svc.RecordSpend(ctx, account, 0.5)
+	_, end := spendCapPeriodBounds(SpendCapPeriodDaily, time.Now())
+	require.Equal(t, end, accountRepo.pausedUntil[7])
+	require.Len(t, opsRepo.events, 1)
+	require.Equal(t, 10.0, *opsRepo.events[0].ThresholdValue)
+
+	// 同一周期内不重复告警
+	svc.RecordSpend(ctx, account, 1)
+	require.Len(t, opsRepo.events, 1)
+
+	statuses := svc.GetSpendStatuses(ctx, account)
+	require.Len(t, statuses, 1)
+	require.Equal(t, SpendCapPeriodDaily, statuses[0].Period)
+	require.Equal(t, 11.0, statuses[0].Spent)
+	require.Equal(t, 110.0, statuses[0].Percent)
+	require.True(t, statuses[0].Exceeded)
+}
+
+func TestAccountSpendCapService_SkipsAlreadyPausedAccount(t *testing.T) {
+	cache := &spendCapCacheStub{values: map[string]float64{}}
+	accountRepo := &spendCapAccountRepoStub{pausedUntil: map[int64]time.Time{}}
+	svc := NewAccountSpendCapService(accountRepo, &spendCapUsageRepoStub{cost: 20}, cache, nil, nil)
+
+	_, end := spendCapPeriodBounds(SpendCapPeriodDaily, time.Now())
+	account := &Account{ID: 1, TempUnschedulableUntil: &end, Extra: map[string]any{"daily_spend_cap": 10}}
+	svc.RecordSpend(context.Background(), account, 1)
+	require.Empty(t, accountRepo.pausedUntil)
+}
@@ -14,6 +14,7 @@ import (
 	"net/http"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/Wei-Shaw/sub2api/internal/config"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/claude"
@@ -42,6 +43,26 @@ type TestEvent struct {
 	Error   string `json:"error,omitempty"`
 }
 
+// AccountTestUpstreamError is returned when the upstream answers a test request with a non-200 status
+type AccountTestUpstreamError struct {
+	StatusCode int
+	Body       []byte
+}
+
+func (e *AccountTestUpstreamError) Error() string {
+	return fmt.Sprintf("API returned %d: %s", e.StatusCode, string(e.Body))
+}
+
+// AccountProbeOutcome is the result of a test request executed without an SSE client
+type AccountProbeOutcome struct {
+	Success      bool
+	Model        string
+	StatusCode   int
+	ErrorMessage string
+	ResponseBody []byte
+	Latency      time.Duration
+}
+
 // AccountTestService handles account testing operations
 type AccountTestService struct {
 	accountRepo               AccountRepository
@@ -150,6 +171,70 @@ func (s *AccountTestService) TestAccountConnection(c *gin.Context, accountID int
 		return s.sendErrorAndEnd(c, "Account not found")
 	}
 
+	return s.testAccount(c, account, modelID)
+}
+
+// ProbeAccount runs the same minimal test request as TestAccountConnection without a client attached.
+// The SSE events are captured in memory, so background health checks share the exact request shape
+// used by the admin "test" button.
+func (s *AccountTestService) ProbeAccount(ctx context.Context, account *Account, modelID string) *AccountProbeOutcome {
+	recorder := newProbeResponseWriter()
+	c, _ := gin.CreateTestContext(recorder)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/internal/account-probe", nil)
+	if err != nil {
+		return &AccountProbeOutcome{ErrorMessage: err.Error()}
+	}
+	c.Request = req
+
+	start := time.Now()
+	err = s.testAccount(c, account, modelID)
+	outcome := &AccountProbeOutcome{
+		Success: err == nil,
+		Latency: time.Since(start),
+	}
+	scanner := bufio.NewScanner(&recorder.body)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !sseDataPrefix.MatchString(line) {
+			continue
+		}
+		var event TestEvent
+		if json.Unmarshal([]byte(sseDataPrefix.ReplaceAllString(line, "")), &event) != nil {
+			continue
+		}
+		if event.Type == "test_start" && event.Model != "" {
+			outcome.Model = event.Model
+		}
+	}
+	if err != nil {
+		outcome.ErrorMessage = err.Error()
+		var upstreamErr *AccountTestUpstreamError
+		if errors.As(err, &upstreamErr) {
+			outcome.StatusCode = upstreamErr.StatusCode
+			outcome.ResponseBody = upstreamErr.Body
+		}
+	}
+	return outcome
+}
+
+// probeResponseWriter captures the SSE events of a probe in memory
+type probeResponseWriter struct {
+	header http.Header
+	body   bytes.Buffer
+	status int
+}
+
+func newProbeResponseWriter() *probeResponseWriter {
+	return &probeResponseWriter{header: http.Header{}, status: http.StatusOK}
+}
+
+func (w *probeResponseWriter) Header() http.Header         { return w.header }
+func (w *probeResponseWriter) Write(b []byte) (int, error) { return w.body.Write(b) }
+func (w *probeResponseWriter) WriteHeader(statusCode int)  { w.status = statusCode }
+func (w *probeResponseWriter) Flush()                      {}
+
+// testAccount routes the test request to the platform-specific implementation
+func (s *AccountTestService) testAccount(c *gin.Context, account *Account, modelID string) error {
 	// Route to platform-specific test method
 	if account.IsOpenAI() {
 		return s.testOpenAIAccountConnection(c, account, modelID)
@@ -273,7 +358,7 @@ func (s *AccountTestService) testClaudeAccountConnection(c *gin.Context, account
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return s.sendErrorAndEnd(c, fmt.Sprintf("API returned %d: %s", resp.StatusCode, string(body)))
+		return s.sendUpstreamErrorAndEnd(c, resp.StatusCode, body)
 	}
 
 	// Process SSE stream
@@ -383,7 +468,7 @@ func (s *AccountTestService) testOpenAIAccountConnection(c *gin.Context, account
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return s.sendErrorAndEnd(c, fmt.Sprintf("API returned %d: %s", resp.StatusCode, string(body)))
+		return s.sendUpstreamErrorAndEnd(c, resp.StatusCode, body)
 	}
 
 	// Process SSE stream
@@ -454,7 +539,7 @@ func (s *AccountTestService) testGeminiAccountConnection(c *gin.Context, account
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return s.sendErrorAndEnd(c, fmt.Sprintf("API returned %d: %s", resp.StatusCode, string(body)))
+		return s.sendUpstreamErrorAndEnd(c, resp.StatusCode, body)
 	}
 
 	// Process SSE stream
@@ -489,6 +574,10 @@ func (s *AccountTestService) testAntigravityAccountConnection(c *gin.Context, ac
 	// 调用 AntigravityGatewayService.TestConnection（复用协议转换逻辑）
 	result, err := s.antigravityGatewayService.TestConnection(ctx, account, testModelID)
 	if err != nil {
+		var upstreamErr *AccountTestUpstreamError
+		if errors.As(err, &upstreamErr) {
+			return s.sendUpstreamErrorAndEnd(c, upstreamErr.StatusCode, upstreamErr.Body)
+		}
 		return s.sendErrorAndEnd(c, err.Error())
 	}
 
@@ -839,6 +928,14 @@ func (s *AccountTestService) sendEvent(c *gin.Context, event TestEvent) {
 	c.Writer.Flush()
 }
 
+// sendUpstreamErrorAndEnd sends a non-200 upstream response as an error event and ends the stream
+func (s *AccountTestService) sendUpstreamErrorAndEnd(c *gin.Context, statusCode int, body []byte) error {
+	err := &AccountTestUpstreamError{StatusCode: statusCode, Body: body}
+	log.Printf("Account test error: %s", err.Error())
+	s.sendEvent(c, TestEvent{Type: "error", Error: err.Error()})
+	return err
+}
+
 // sendErrorAndEnd sends an error event and ends the stream
 func (s *AccountTestService) sendErrorAndEnd(c *gin.Context, errorMsg string) error {
 	log.Printf("Account test error: %s", errorMsg)
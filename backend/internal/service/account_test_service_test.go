@@ -0,0 +1,71 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/antigravity"
+	"github.com/stretchr/testify/require"
+)
+
+type antigravityProbeUpstreamStub struct {
+	status int
+	body   string
+}
+
+func (s *antigravityProbeUpstreamStub) Do(*http.Request, string, int64, int) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: s.status,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(s.body)),
+	}, nil
+}
+
+func (s *antigravityProbeUpstreamStub) DoWithTLS(req *http.Request, proxyURL string, accountID int64, accountConcurrency int, _ bool) (*http.Response, error) {
+	return s.Do(req, proxyURL, accountID, accountConcurrency)
+}
+
+func TestAccountTestService_ProbeAntigravityReportsUpstreamStatus(t *testing.T) {
+	oldBaseURLs := append([]string(nil), antigravity.BaseURLs...)
+	oldAvailability := antigravity.DefaultURLAvailability
+	defer func() {
+		antigravity.BaseURLs = oldBaseURLs
+		antigravity.DefaultURLAvailability = oldAvailability
+	}()
+	antigravity.BaseURLs = []string{"https://ag-probe.test"}
+	antigravity.DefaultURLAvailability = antigravity.NewURLAvailability(time.Minute)
+
+	upstream := &antigravityProbeUpstreamStub{status: http.StatusUnauthorized, body: `{"error":"invalid token"}`}
+	svc := &AccountTestService{
+		antigravityGatewayService: &AntigravityGatewayService{
+			tokenProvider: &AntigravityTokenProvider{},
+			httpUpstream:  upstream,
+		},
+	}
+	account := &Account{
+		ID:          1,
+		Name:        "ag-probe",
+		Platform:    PlatformAntigravity,
+		Type:        AccountTypeOAuth,
+		Concurrency: 1,
+		Credentials: map[string]any{"access_token": "token"},
+	}
+
+	outcome := svc.ProbeAccount(context.Background(), account, "")
+	require.False(t, outcome.Success)
+	require.Equal(t, http.StatusUnauthorized, outcome.StatusCode)
+	require.Equal(t, upstream.body, string(outcome.ResponseBody))
+	require.Equal(t, "claude-sonnet-4-5", outcome.Model)
+
+	upstream.status = http.StatusOK
+	upstream.body = "data: {}\n\n"
+	outcome = svc.ProbeAccount(context.Background(), account, "")
+	require.True(t, outcome.Success)
+	require.Zero(t, outcome.StatusCode)
+}
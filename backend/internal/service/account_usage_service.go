@@ -29,6 +29,10 @@ type UsageLogRepository interface {
 
 	GetAccountWindowStats(ctx context.Context, accountID int64, startTime time.Time) (*usagestats.AccountStats, error)
 	GetAccountTodayStats(ctx context.Context, accountID int64) (*usagestats.AccountStats, error)
+	// GetUserSpendSince 返回用户自 since 起的实际消费（SUM(actual_cost)，按付费账户统计），用于阶梯折扣
+	GetUserSpendSince(ctx context.Context, userID int64, since time.Time) (float64, error)
+	// GetAPIKeyLimitUsage 返回 API Key 在日/月窗口内及累计的消费与请求数，用于 Key 级限额
+	GetAPIKeyLimitUsage(ctx context.Context, apiKeyID int64, dayStart, monthStart time.Time) (*APIKeyLimitUsage, error)
 
 	// Admin dashboard stats
 	GetDashboardStats(ctx context.Context) (*usagestats.DashboardStats, error)
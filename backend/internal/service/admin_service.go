@@ -110,6 +110,23 @@ type CreateGroupInput struct {
 	// 模型路由配置（仅 anthropic 平台使用）
 	ModelRouting        map[string][]int64
 	ModelRoutingEnabled bool // 是否启用模型路由
+	// 子池灰度分流配置
+	PoolSplitEnabled   bool
+	PoolWeights        map[string]int
+	CanaryMaxErrorRate *float64 // 灰度池错误率阈值（0-1），0 或 nil 表示不自动回滚
+	// 容量降级链配置
+	CapacityFallbackGroupIDs []int64
+	FallbackModelMapping     map[string]string
+	// 阶梯折扣档位
+	VolumeTiers []VolumeTier
+	// 订阅超额计费：倍率为 nil 时默认 1
+	OverageEnabled        bool
+	OverageRateMultiplier *float64
+	// 滚动窗口限额：小时数为 0 表示不启用，上限为 nil 或非正数表示不限制
+	RollingWindowHours    int
+	RollingWindowLimitUSD *float64
+	// 请求数/Token 配额
+	UsageQuotas []UsageQuota
 }
 
 type UpdateGroupInput struct {
@@ -132,6 +149,23 @@ type UpdateGroupInput struct {
 	// 模型路由配置（仅 anthropic 平台使用）
 	ModelRouting        map[string][]int64
 	ModelRoutingEnabled *bool // 是否启用模型路由
+	// 子池灰度分流配置
+	PoolSplitEnabled   *bool
+	PoolWeights        map[string]int
+	CanaryMaxErrorRate *float64 // 传入 0 或负数表示关闭自动回滚
+	// 容量降级链配置：传入空数组/空对象表示清除
+	CapacityFallbackGroupIDs []int64
+	FallbackModelMapping     map[string]string
+	// 阶梯折扣档位：传入空数组表示清除
+	VolumeTiers []VolumeTier
+	// 订阅超额计费
+	OverageEnabled        *bool
+	OverageRateMultiplier *float64
+	// 滚动窗口限额
+	RollingWindowHours    *int
+	RollingWindowLimitUSD *float64
+	// 请求数/Token 配额：传入空数组表示清除
+	UsageQuotas []UsageQuota
 }
 
 type CreateAccountInput struct {
@@ -148,6 +182,9 @@ type CreateAccountInput struct {
 	GroupIDs           []int64
 	ExpiresAt          *int64
 	AutoPauseOnExpired *bool
+	// 可用时间窗口（为空表示全天可用），时区为空表示服务器时区
+	AvailabilityWindows  []string
+	AvailabilityTimezone string
 	// SkipMixedChannelCheck skips the mixed channel risk check when binding groups.
 	// This should only be set when the caller has explicitly confirmed the risk.
 	SkipMixedChannelCheck bool
@@ -167,6 +204,8 @@ type UpdateAccountInput struct {
 	GroupIDs              *[]int64
 	ExpiresAt             *int64
 	AutoPauseOnExpired    *bool
+	AvailabilityWindows   *[]string // 传入空数组表示清除（全天可用）
+	AvailabilityTimezone  *string
 	SkipMixedChannelCheck bool // 跳过混合渠道检查（用户已确认风险）
 }
 
@@ -229,6 +268,11 @@ type GenerateRedeemCodesInput struct {
 	Value        float64
 	GroupID      *int64 // 订阅类型专用：关联的分组ID
 	ValidityDays int    // 订阅类型专用：有效天数
+
+	CreditValidityDays int // 余额类型专用：发放额度的有效天数，0 表示永不过期
+
+	MaxRedemptions int    // 最大兑换次数（每个用户限一次），0 表示 1
+	BatchID        *int64 // 所属批次
 }
 
 type ProxyBatchDeleteResult struct {
@@ -444,24 +488,35 @@ func (s *adminServiceImpl) UpdateUserBalance(ctx context.Context, userID int64,
 	}
 
 	oldBalance := user.Balance
+	newBalance := oldBalance
 
 	switch operation {
 	case "set":
-		user.Balance = balance
+		newBalance = balance
 	case "add":
-		user.Balance += balance
+		newBalance += balance
 	case "subtract":
-		user.Balance -= balance
+		newBalance -= balance
 	}
 
-	if user.Balance < 0 {
-		return nil, fmt.Errorf("balance cannot be negative, current balance: %.2f, requested operation would result in: %.2f", oldBalance, user.Balance)
+	if newBalance < 0 {
+		return nil, fmt.Errorf("balance cannot be negative, current balance: %.2f, requested operation would result in: %.2f", oldBalance, newBalance)
 	}
 
-	if err := s.userRepo.Update(ctx, user); err != nil {
-		return nil, err
+	// 以差额形式变更余额并写入流水，避免整行覆盖与并发扣费互相冲掉
+	balanceDiff := newBalance - oldBalance
+	if balanceDiff != 0 {
+		tx, err := s.userRepo.ApplyBalanceChange(ctx, &BalanceChange{
+			UserID: userID,
+			Type:   BalanceTxTypeAdmin,
+			Amount: balanceDiff,
+			Notes:  notes,
+		})
+		if err != nil {
+			return nil, err
+		}
+		user.Balance = tx.BalanceAfter
 	}
-	balanceDiff := user.Balance - oldBalance
 	if s.authCacheInvalidator != nil && balanceDiff != 0 {
 		s.authCacheInvalidator.InvalidateAuthCacheByUserID(ctx, userID)
 	}
@@ -572,6 +627,34 @@ func (s *adminServiceImpl) CreateGroup(ctx context.Context, input *CreateGroupIn
 		}
 	}
 
+	poolWeights, err := normalizePoolWeights(input.PoolWeights)
+	if err != nil {
+		return nil, err
+	}
+	canaryMaxErrorRate, err := normalizeCanaryMaxErrorRate(input.CanaryMaxErrorRate)
+	if err != nil {
+		return nil, err
+	}
+	capacityFallbackIDs, err := s.validateCapacityFallbackChain(ctx, 0, platform, subscriptionType, input.CapacityFallbackGroupIDs)
+	if err != nil {
+		return nil, err
+	}
+	volumeTiers, err := normalizeVolumeTiers(input.VolumeTiers)
+	if err != nil {
+		return nil, err
+	}
+	overageRateMultiplier, err := normalizeOverageRateMultiplier(input.OverageRateMultiplier)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateRollingWindowHours(input.RollingWindowHours); err != nil {
+		return nil, err
+	}
+	usageQuotas, err := normalizeUsageQuotas(input.UsageQuotas)
+	if err != nil {
+		return nil, err
+	}
+
 	group := &Group{
 		Name:             input.Name,
 		Description:      input.Description,
@@ -589,6 +672,23 @@ func (s *adminServiceImpl) CreateGroup(ctx context.Context, input *CreateGroupIn
 		ClaudeCodeOnly:   input.ClaudeCodeOnly,
 		FallbackGroupID:  input.FallbackGroupID,
 		ModelRouting:     input.ModelRouting,
+
+		PoolSplitEnabled:   input.PoolSplitEnabled,
+		PoolWeights:        poolWeights,
+		CanaryMaxErrorRate: canaryMaxErrorRate,
+
+		CapacityFallbackGroupIDs: capacityFallbackIDs,
+		FallbackModelMapping:     normalizeFallbackModelMapping(input.FallbackModelMapping),
+
+		VolumeTiers: volumeTiers,
+
+		OverageEnabled:        input.OverageEnabled,
+		OverageRateMultiplier: overageRateMultiplier,
+
+		RollingWindowHours:    input.RollingWindowHours,
+		RollingWindowLimitUSD: normalizeLimit(input.RollingWindowLimitUSD),
+
+		UsageQuotas: usageQuotas,
 	}
 	if err := s.groupRepo.Create(ctx, group); err != nil {
 		return nil, err
@@ -725,6 +825,74 @@ func (s *adminServiceImpl) UpdateGroup(ctx context.Context, id int64, input *Upd
 		group.ModelRoutingEnabled = *input.ModelRoutingEnabled
 	}
 
+	// 子池灰度分流配置
+	if input.PoolSplitEnabled != nil {
+		group.PoolSplitEnabled = *input.PoolSplitEnabled
+	}
+	if input.PoolWeights != nil {
+		poolWeights, err := normalizePoolWeights(input.PoolWeights)
+		if err != nil {
+			return nil, err
+		}
+		group.PoolWeights = poolWeights
+	}
+	if input.CanaryMaxErrorRate != nil {
+		rate, err := normalizeCanaryMaxErrorRate(input.CanaryMaxErrorRate)
+		if err != nil {
+			return nil, err
+		}
+		group.CanaryMaxErrorRate = rate
+	}
+
+	// 容量降级链配置（平台或计费类型变更后也需要重新校验已有链）
+	if input.CapacityFallbackGroupIDs != nil || ((input.Platform != "" || input.SubscriptionType != "") && len(group.CapacityFallbackGroupIDs) > 0) {
+		chain := group.CapacityFallbackGroupIDs
+		if input.CapacityFallbackGroupIDs != nil {
+			chain = input.CapacityFallbackGroupIDs
+		}
+		ids, err := s.validateCapacityFallbackChain(ctx, id, group.Platform, group.SubscriptionType, chain)
+		if err != nil {
+			return nil, err
+		}
+		group.CapacityFallbackGroupIDs = ids
+	}
+	if input.FallbackModelMapping != nil {
+		group.FallbackModelMapping = normalizeFallbackModelMapping(input.FallbackModelMapping)
+	}
+	if input.VolumeTiers != nil {
+		volumeTiers, err := normalizeVolumeTiers(input.VolumeTiers)
+		if err != nil {
+			return nil, err
+		}
+		group.VolumeTiers = volumeTiers
+	}
+	if input.OverageEnabled != nil {
+		group.OverageEnabled = *input.OverageEnabled
+	}
+	if input.OverageRateMultiplier != nil {
+		rate, err := normalizeOverageRateMultiplier(input.OverageRateMultiplier)
+		if err != nil {
+			return nil, err
+		}
+		group.OverageRateMultiplier = rate
+	}
+	if input.RollingWindowHours != nil {
+		if err := validateRollingWindowHours(*input.RollingWindowHours); err != nil {
+			return nil, err
+		}
+		group.RollingWindowHours = *input.RollingWindowHours
+	}
+	if input.RollingWindowLimitUSD != nil {
+		group.RollingWindowLimitUSD = normalizeLimit(input.RollingWindowLimitUSD)
+	}
+	if input.UsageQuotas != nil {
+		usageQuotas, err := normalizeUsageQuotas(input.UsageQuotas)
+		if err != nil {
+			return nil, err
+		}
+		group.UsageQuotas = usageQuotas
+	}
+
 	if err := s.groupRepo.Update(ctx, group); err != nil {
 		return nil, err
 	}
@@ -858,6 +1026,12 @@ func (s *adminServiceImpl) CreateAccount(ctx context.Context, input *CreateAccou
 		}
 		account.RateMultiplier = input.RateMultiplier
 	}
+	windows, tz, err := NormalizeAvailabilityWindows(input.AvailabilityWindows, input.AvailabilityTimezone)
+	if err != nil {
+		return nil, err
+	}
+	account.AvailabilityWindows = windows
+	account.AvailabilityTimezone = tz
 	if err := s.accountRepo.Create(ctx, account); err != nil {
 		return nil, err
 	}
@@ -930,6 +1104,21 @@ func (s *adminServiceImpl) UpdateAccount(ctx context.Context, id int64, input *U
 	if input.AutoPauseOnExpired != nil {
 		account.AutoPauseOnExpired = *input.AutoPauseOnExpired
 	}
+	if input.AvailabilityWindows != nil || input.AvailabilityTimezone != nil {
+		windows, tz := account.AvailabilityWindows, account.AvailabilityTimezone
+		if input.AvailabilityWindows != nil {
+			windows = *input.AvailabilityWindows
+		}
+		if input.AvailabilityTimezone != nil {
+			tz = *input.AvailabilityTimezone
+		}
+		windows, tz, err = NormalizeAvailabilityWindows(windows, tz)
+		if err != nil {
+			return nil, err
+		}
+		account.AvailabilityWindows = windows
+		account.AvailabilityTimezone = tz
+	}
 
 	// 先验证分组是否存在（在任何写操作之前）
 	if input.GroupIDs != nil {
@@ -1271,13 +1460,27 @@ func (s *adminServiceImpl) GetRedeemCode(ctx context.Context, id int64) (*Redeem
 }
 
 func (s *adminServiceImpl) GenerateRedeemCodes(ctx context.Context, input *GenerateRedeemCodesInput) ([]RedeemCode, error) {
+	codes, err := buildRedeemCodes(ctx, s.groupRepo, input)
+	if err != nil {
+		return nil, err
+	}
+	for i := range codes {
+		if err := s.redeemCodeRepo.Create(ctx, &codes[i]); err != nil {
+			return nil, err
+		}
+	}
+	return codes, nil
+}
+
+// buildRedeemCodes 校验生成参数并构造兑换码（未持久化）
+func buildRedeemCodes(ctx context.Context, groupRepo GroupRepository, input *GenerateRedeemCodesInput) ([]RedeemCode, error) {
 	// 如果是订阅类型，验证必须有 GroupID
 	if input.Type == RedeemTypeSubscription {
 		if input.GroupID == nil {
 			return nil, errors.New("group_id is required for subscription type")
 		}
 		// 验证分组存在且为订阅类型
-		group, err := s.groupRepo.GetByID(ctx, *input.GroupID)
+		group, err := groupRepo.GetByID(ctx, *input.GroupID)
 		if err != nil {
 			return nil, fmt.Errorf("group not found: %w", err)
 		}
@@ -1285,6 +1488,9 @@ func (s *adminServiceImpl) GenerateRedeemCodes(ctx context.Context, input *Gener
 			return nil, errors.New("group must be subscription type")
 		}
 	}
+	if input.MaxRedemptions < 0 {
+		return nil, errors.New("max_redemptions must not be negative")
+	}
 
 	codes := make([]RedeemCode, 0, input.Count)
 	for i := 0; i < input.Count; i++ {
@@ -1293,10 +1499,15 @@ func (s *adminServiceImpl) GenerateRedeemCodes(ctx context.Context, input *Gener
 			return nil, err
 		}
 		code := RedeemCode{
-			Code:   codeValue,
-			Type:   input.Type,
-			Value:  input.Value,
-			Status: StatusUnused,
+			Code:           codeValue,
+			Type:           input.Type,
+			Value:          input.Value,
+			Status:         StatusUnused,
+			BatchID:        input.BatchID,
+			MaxRedemptions: input.MaxRedemptions,
+		}
+		if code.MaxRedemptions <= 0 {
+			code.MaxRedemptions = 1
 		}
 		// 订阅类型专用字段
 		if input.Type == RedeemTypeSubscription {
@@ -1306,8 +1517,8 @@ func (s *adminServiceImpl) GenerateRedeemCodes(ctx context.Context, input *Gener
 				code.ValidityDays = 30 // 默认30天
 			}
 		}
-		if err := s.redeemCodeRepo.Create(ctx, &code); err != nil {
-			return nil, err
+		if input.Type == RedeemTypeBalance && input.CreditValidityDays > 0 {
+			code.CreditValidityDays = input.CreditValidityDays
 		}
 		codes = append(codes, code)
 	}
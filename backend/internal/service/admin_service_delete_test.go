@@ -78,6 +78,10 @@ func (s *userRepoStub) DeductBalance(ctx context.Context, id int64, amount float
 	panic("unexpected DeductBalance call")
 }
 
+func (s *userRepoStub) ApplyBalanceChange(ctx context.Context, change *BalanceChange) (*BalanceTransaction, error) {
+	panic("unexpected ApplyBalanceChange call")
+}
+
 func (s *userRepoStub) UpdateConcurrency(ctx context.Context, id int64, amount int) error {
 	panic("unexpected UpdateConcurrency call")
 }
@@ -262,6 +266,10 @@ func (s *redeemRepoStub) ListByUser(ctx context.Context, userID int64, limit int
 	panic("unexpected ListByUser call")
 }
 
+func (s *redeemRepoStub) ListByBatch(ctx context.Context, batchID int64, params pagination.PaginationParams) ([]RedeemCode, *pagination.PaginationResult, error) {
+	panic("unexpected ListByBatch call")
+}
+
 type subscriptionInvalidateCall struct {
 	userID  int64
 	groupID int64
@@ -308,6 +316,42 @@ func (s *billingCacheStub) InvalidateSubscriptionCache(ctx context.Context, user
 	return nil
 }
 
+func (s *billingCacheStub) GetAPIKeyUsageCache(ctx context.Context, apiKeyID int64) (*APIKeyLimitUsage, error) {
+	panic("unexpected GetAPIKeyUsageCache call")
+}
+
+func (s *billingCacheStub) SetAPIKeyUsageCache(ctx context.Context, apiKeyID int64, data *APIKeyLimitUsage) error {
+	panic("unexpected SetAPIKeyUsageCache call")
+}
+
+func (s *billingCacheStub) UpdateAPIKeyUsage(ctx context.Context, apiKeyID int64, cost float64) error {
+	panic("unexpected UpdateAPIKeyUsage call")
+}
+
+func (s *billingCacheStub) GetUserRollingSpend(ctx context.Context, userID int64) (float64, error) {
+	panic("unexpected GetUserRollingSpend call")
+}
+
+func (s *billingCacheStub) SetUserRollingSpend(ctx context.Context, userID int64, spend float64) error {
+	panic("unexpected SetUserRollingSpend call")
+}
+
+func (s *billingCacheStub) ReserveBalance(ctx context.Context, userID int64, holdID string, amount, balance float64, ttl time.Duration) (bool, error) {
+	panic("unexpected ReserveBalance call")
+}
+
+func (s *billingCacheStub) ReleaseBalanceHold(ctx context.Context, userID int64, holdID string) error {
+	panic("unexpected ReleaseBalanceHold call")
+}
+
+func (s *billingCacheStub) AddRollingWindowUsage(ctx context.Context, userID, groupID int64, bucketStart, cutoff time.Time, cost float64, window time.Duration) error {
+	panic("unexpected AddRollingWindowUsage call")
+}
+
+func (s *billingCacheStub) GetRollingWindowBuckets(ctx context.Context, userID, groupID int64) (map[int64]float64, error) {
+	panic("unexpected GetRollingWindowBuckets call")
+}
+
 func waitForInvalidations(t *testing.T, ch <-chan subscriptionInvalidateCall, expected int) []subscriptionInvalidateCall {
 	t.Helper()
 	calls := make([]subscriptionInvalidateCall, 0, expected)
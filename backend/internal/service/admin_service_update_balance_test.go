@@ -12,22 +12,20 @@ import (
 type balanceUserRepoStub struct {
 	*userRepoStub
 	updateErr error
-	updated   []*User
+	changes   []BalanceChange
 }
 
-func (s *balanceUserRepoStub) Update(ctx context.Context, user *User) error {
+func (s *balanceUserRepoStub) ApplyBalanceChange(ctx context.Context, change *BalanceChange) (*BalanceTransaction, error) {
 	if s.updateErr != nil {
-		return s.updateErr
+		return nil, s.updateErr
 	}
-	if user == nil {
-		return nil
-	}
-	clone := *user
-	s.updated = append(s.updated, &clone)
-	if s.userRepoStub != nil {
-		s.userRepoStub.user = &clone
+	s.changes = append(s.changes, *change)
+	balance := change.Amount
+	if s.userRepoStub != nil && s.userRepoStub.user != nil {
+		s.userRepoStub.user.Balance += change.Amount
+		balance = s.userRepoStub.user.Balance
 	}
-	return nil
+	return &BalanceTransaction{UserID: change.UserID, Type: change.Type, Amount: change.Amount, BalanceAfter: balance}, nil
 }
 
 type balanceRedeemRepoStub struct {
@@ -73,10 +71,30 @@ func TestAdminService_UpdateUserBalance_InvalidatesAuthCache(t *testing.T) {
 		authCacheInvalidator: invalidator,
 	}
 
-	_, err := svc.UpdateUserBalance(context.Background(), 7, 5, "add", "")
+	user, err := svc.UpdateUserBalance(context.Background(), 7, 5, "add", "")
 	require.NoError(t, err)
 	require.Equal(t, []int64{7}, invalidator.userIDs)
 	require.Len(t, redeemRepo.created, 1)
+	require.Equal(t, 15.0, user.Balance)
+	require.Len(t, repo.changes, 1)
+	require.Equal(t, BalanceTxTypeAdmin, repo.changes[0].Type)
+	require.Equal(t, 5.0, repo.changes[0].Amount)
+}
+
+func TestAdminService_UpdateUserBalance_SetWritesDifference(t *testing.T) {
+	baseRepo := &userRepoStub{user: &User{ID: 7, Balance: 10}}
+	repo := &balanceUserRepoStub{userRepoStub: baseRepo}
+	svc := &adminServiceImpl{
+		userRepo:       repo,
+		redeemCodeRepo: &balanceRedeemRepoStub{redeemRepoStub: &redeemRepoStub{}},
+	}
+
+	user, err := svc.UpdateUserBalance(context.Background(), 7, 4, "set", "refund")
+	require.NoError(t, err)
+	require.Equal(t, 4.0, user.Balance)
+	require.Len(t, repo.changes, 1)
+	require.Equal(t, -6.0, repo.changes[0].Amount)
+	require.Equal(t, "refund", repo.changes[0].Notes)
 }
 
 func TestAdminService_UpdateUserBalance_NoChangeNoInvalidate(t *testing.T) {
@@ -94,4 +112,5 @@ func TestAdminService_UpdateUserBalance_NoChangeNoInvalidate(t *testing.T) {
 	require.NoError(t, err)
 	require.Empty(t, invalidator.userIDs)
 	require.Empty(t, redeemRepo.created)
+	require.Empty(t, repo.changes)
 }
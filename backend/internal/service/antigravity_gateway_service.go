@@ -460,7 +460,8 @@ func (s *AntigravityGatewayService) TestConnection(ctx context.Context, account
 		}
 
 		if resp.StatusCode >= 400 {
-			return nil, fmt.Errorf("API 返回 %d: %s", resp.StatusCode, string(respBody))
+			// 保留状态码，供健康探测按上游错误处理账号状态
+			return nil, &AccountTestUpstreamError{StatusCode: resp.StatusCode, Body: respBody}
 		}
 
 		// 解析流式响应，提取文本
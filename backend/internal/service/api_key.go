@@ -11,12 +11,39 @@ type APIKey struct {
 	Status      string
 	IPWhitelist []string
 	IPBlacklist []string
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	User        *User
-	Group       *Group
+
+	// 消费限额（USD，按 actual_cost 统计）与请求次数限额，nil 表示不限制
+	DailyLimitUSD       *float64
+	MonthlyLimitUSD     *float64
+	TotalLimitUSD       *float64
+	DailyRequestLimit   *int64
+	MonthlyRequestLimit *int64
+
+	// OrganizationID 绑定的组织，非空时由组织计费账户付费
+	OrganizationID *int64
+	// OrganizationBilling 组织计费上下文，由认证中间件按请求解析
+	OrganizationBilling *OrganizationBilling
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	User      *User
+	Group     *Group
 }
 
 func (k *APIKey) IsActive() bool {
 	return k.Status == StatusActive
 }
+
+// HasUsageLimits 是否配置了任一消费或请求次数限额
+func (k *APIKey) HasUsageLimits() bool {
+	return k != nil && (k.DailyLimitUSD != nil || k.MonthlyLimitUSD != nil || k.TotalLimitUSD != nil ||
+		k.DailyRequestLimit != nil || k.MonthlyRequestLimit != nil)
+}
+
+// Payer 实际付费用户：组织 Key 为组织计费账户，其余为 Key 所属用户
+func (k *APIKey) Payer(user *User) *User {
+	if k != nil && k.OrganizationBilling != nil && k.OrganizationBilling.Payer != nil {
+		return k.OrganizationBilling.Payer
+	}
+	return user
+}
@@ -10,6 +10,16 @@ type APIKeyAuthSnapshot struct {
 	IPBlacklist []string                 `json:"ip_blacklist,omitempty"`
 	User        APIKeyAuthUserSnapshot   `json:"user"`
 	Group       *APIKeyAuthGroupSnapshot `json:"group,omitempty"`
+
+	// Key 级限额在 CheckBillingEligibility 中检查，需随快照缓存
+	DailyLimitUSD       *float64 `json:"daily_limit_usd,omitempty"`
+	MonthlyLimitUSD     *float64 `json:"monthly_limit_usd,omitempty"`
+	TotalLimitUSD       *float64 `json:"total_limit_usd,omitempty"`
+	DailyRequestLimit   *int64   `json:"daily_request_limit,omitempty"`
+	MonthlyRequestLimit *int64   `json:"monthly_request_limit,omitempty"`
+
+	// 组织 Key 的计费上下文在认证时实时解析，快照只记录绑定关系
+	OrganizationID *int64 `json:"organization_id,omitempty"`
 }
 
 // APIKeyAuthUserSnapshot 用户快照
@@ -42,6 +52,27 @@ type APIKeyAuthGroupSnapshot struct {
 	// Only anthropic groups use these fields; others may leave them empty.
 	ModelRouting        map[string][]int64 `json:"model_routing,omitempty"`
 	ModelRoutingEnabled bool               `json:"model_routing_enabled"`
+
+	// Pool split is also used by gateway account selection (canary traffic splitting).
+	PoolSplitEnabled bool           `json:"pool_split_enabled"`
+	PoolWeights      map[string]int `json:"pool_weights,omitempty"`
+
+	// Capacity fallback chain is consulted by the gateway handler when the group runs out of accounts.
+	CapacityFallbackGroupIDs []int64 `json:"capacity_fallback_group_ids,omitempty"`
+
+	// Volume tiers are applied to the rate multiplier when usage is recorded.
+	VolumeTiers []VolumeTier `json:"volume_tiers,omitempty"`
+
+	// Overage settings decide whether exhausted subscriptions fall back to balance billing.
+	OverageEnabled        bool    `json:"overage_enabled,omitempty"`
+	OverageRateMultiplier float64 `json:"overage_rate_multiplier,omitempty"`
+
+	// Rolling window limits are enforced alongside the calendar windows.
+	RollingWindowHours    int      `json:"rolling_window_hours,omitempty"`
+	RollingWindowLimitUSD *float64 `json:"rolling_window_limit_usd,omitempty"`
+
+	// Usage quotas cap request and token counts per window.
+	UsageQuotas []UsageQuota `json:"usage_quotas,omitempty"`
 }
 
 // APIKeyAuthCacheEntry 缓存条目，支持负缓存
@@ -213,6 +213,13 @@ func (s *APIKeyService) snapshotFromAPIKey(apiKey *APIKey) *APIKeyAuthSnapshot {
 		Status:      apiKey.Status,
 		IPWhitelist: apiKey.IPWhitelist,
 		IPBlacklist: apiKey.IPBlacklist,
+
+		DailyLimitUSD:       apiKey.DailyLimitUSD,
+		MonthlyLimitUSD:     apiKey.MonthlyLimitUSD,
+		TotalLimitUSD:       apiKey.TotalLimitUSD,
+		DailyRequestLimit:   apiKey.DailyRequestLimit,
+		MonthlyRequestLimit: apiKey.MonthlyRequestLimit,
+		OrganizationID:      apiKey.OrganizationID,
 		User: APIKeyAuthUserSnapshot{
 			ID:          apiKey.User.ID,
 			Status:      apiKey.User.Status,
@@ -223,22 +230,31 @@ func (s *APIKeyService) snapshotFromAPIKey(apiKey *APIKey) *APIKeyAuthSnapshot {
 	}
 	if apiKey.Group != nil {
 		snapshot.Group = &APIKeyAuthGroupSnapshot{
-			ID:                  apiKey.Group.ID,
-			Name:                apiKey.Group.Name,
-			Platform:            apiKey.Group.Platform,
-			Status:              apiKey.Group.Status,
-			SubscriptionType:    apiKey.Group.SubscriptionType,
-			RateMultiplier:      apiKey.Group.RateMultiplier,
-			DailyLimitUSD:       apiKey.Group.DailyLimitUSD,
-			WeeklyLimitUSD:      apiKey.Group.WeeklyLimitUSD,
-			MonthlyLimitUSD:     apiKey.Group.MonthlyLimitUSD,
-			ImagePrice1K:        apiKey.Group.ImagePrice1K,
-			ImagePrice2K:        apiKey.Group.ImagePrice2K,
-			ImagePrice4K:        apiKey.Group.ImagePrice4K,
-			ClaudeCodeOnly:      apiKey.Group.ClaudeCodeOnly,
-			FallbackGroupID:     apiKey.Group.FallbackGroupID,
-			ModelRouting:        apiKey.Group.ModelRouting,
-			ModelRoutingEnabled: apiKey.Group.ModelRoutingEnabled,
+			ID:                       apiKey.Group.ID,
+			Name:                     apiKey.Group.Name,
+			Platform:                 apiKey.Group.Platform,
+			Status:                   apiKey.Group.Status,
+			SubscriptionType:         apiKey.Group.SubscriptionType,
+			RateMultiplier:           apiKey.Group.RateMultiplier,
+			DailyLimitUSD:            apiKey.Group.DailyLimitUSD,
+			WeeklyLimitUSD:           apiKey.Group.WeeklyLimitUSD,
+			MonthlyLimitUSD:          apiKey.Group.MonthlyLimitUSD,
+			ImagePrice1K:             apiKey.Group.ImagePrice1K,
+			ImagePrice2K:             apiKey.Group.ImagePrice2K,
+			ImagePrice4K:             apiKey.Group.ImagePrice4K,
+			ClaudeCodeOnly:           apiKey.Group.ClaudeCodeOnly,
+			FallbackGroupID:          apiKey.Group.FallbackGroupID,
+			ModelRouting:             apiKey.Group.ModelRouting,
+			ModelRoutingEnabled:      apiKey.Group.ModelRoutingEnabled,
+			PoolSplitEnabled:         apiKey.Group.PoolSplitEnabled,
+			PoolWeights:              apiKey.Group.PoolWeights,
+			CapacityFallbackGroupIDs: apiKey.Group.CapacityFallbackGroupIDs,
+			VolumeTiers:              apiKey.Group.VolumeTiers,
+			OverageEnabled:           apiKey.Group.OverageEnabled,
+			OverageRateMultiplier:    apiKey.Group.OverageRateMultiplier,
+			RollingWindowHours:       apiKey.Group.RollingWindowHours,
+			RollingWindowLimitUSD:    apiKey.Group.RollingWindowLimitUSD,
+			UsageQuotas:              apiKey.Group.UsageQuotas,
 		}
 	}
 	return snapshot
@@ -256,6 +272,13 @@ func (s *APIKeyService) snapshotToAPIKey(key string, snapshot *APIKeyAuthSnapsho
 		Status:      snapshot.Status,
 		IPWhitelist: snapshot.IPWhitelist,
 		IPBlacklist: snapshot.IPBlacklist,
+
+		DailyLimitUSD:       snapshot.DailyLimitUSD,
+		MonthlyLimitUSD:     snapshot.MonthlyLimitUSD,
+		TotalLimitUSD:       snapshot.TotalLimitUSD,
+		DailyRequestLimit:   snapshot.DailyRequestLimit,
+		MonthlyRequestLimit: snapshot.MonthlyRequestLimit,
+		OrganizationID:      snapshot.OrganizationID,
 		User: &User{
 			ID:          snapshot.User.ID,
 			Status:      snapshot.User.Status,
@@ -266,23 +289,32 @@ func (s *APIKeyService) snapshotToAPIKey(key string, snapshot *APIKeyAuthSnapsho
 	}
 	if snapshot.Group != nil {
 		apiKey.Group = &Group{
-			ID:                  snapshot.Group.ID,
-			Name:                snapshot.Group.Name,
-			Platform:            snapshot.Group.Platform,
-			Status:              snapshot.Group.Status,
-			Hydrated:            true,
-			SubscriptionType:    snapshot.Group.SubscriptionType,
-			RateMultiplier:      snapshot.Group.RateMultiplier,
-			DailyLimitUSD:       snapshot.Group.DailyLimitUSD,
-			WeeklyLimitUSD:      snapshot.Group.WeeklyLimitUSD,
-			MonthlyLimitUSD:     snapshot.Group.MonthlyLimitUSD,
-			ImagePrice1K:        snapshot.Group.ImagePrice1K,
-			ImagePrice2K:        snapshot.Group.ImagePrice2K,
-			ImagePrice4K:        snapshot.Group.ImagePrice4K,
-			ClaudeCodeOnly:      snapshot.Group.ClaudeCodeOnly,
-			FallbackGroupID:     snapshot.Group.FallbackGroupID,
-			ModelRouting:        snapshot.Group.ModelRouting,
-			ModelRoutingEnabled: snapshot.Group.ModelRoutingEnabled,
+			ID:                       snapshot.Group.ID,
+			Name:                     snapshot.Group.Name,
+			Platform:                 snapshot.Group.Platform,
+			Status:                   snapshot.Group.Status,
+			Hydrated:                 true,
+			SubscriptionType:         snapshot.Group.SubscriptionType,
+			RateMultiplier:           snapshot.Group.RateMultiplier,
+			DailyLimitUSD:            snapshot.Group.DailyLimitUSD,
+			WeeklyLimitUSD:           snapshot.Group.WeeklyLimitUSD,
+			MonthlyLimitUSD:          snapshot.Group.MonthlyLimitUSD,
+			ImagePrice1K:             snapshot.Group.ImagePrice1K,
+			ImagePrice2K:             snapshot.Group.ImagePrice2K,
+			ImagePrice4K:             snapshot.Group.ImagePrice4K,
+			ClaudeCodeOnly:           snapshot.Group.ClaudeCodeOnly,
+			FallbackGroupID:          snapshot.Group.FallbackGroupID,
+			ModelRouting:             snapshot.Group.ModelRouting,
+			ModelRoutingEnabled:      snapshot.Group.ModelRoutingEnabled,
+			PoolSplitEnabled:         snapshot.Group.PoolSplitEnabled,
+			PoolWeights:              snapshot.Group.PoolWeights,
+			CapacityFallbackGroupIDs: snapshot.Group.CapacityFallbackGroupIDs,
+			VolumeTiers:              snapshot.Group.VolumeTiers,
+			OverageEnabled:           snapshot.Group.OverageEnabled,
+			OverageRateMultiplier:    snapshot.Group.OverageRateMultiplier,
+			RollingWindowHours:       snapshot.Group.RollingWindowHours,
+			RollingWindowLimitUSD:    snapshot.Group.RollingWindowLimitUSD,
+			UsageQuotas:              snapshot.Group.UsageQuotas,
 		}
 	}
 	return apiKey
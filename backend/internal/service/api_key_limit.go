@@ -0,0 +1,59 @@
+package service
+
+import (
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+)
+
+var (
+	ErrAPIKeyDailyLimitExceeded          = infraerrors.TooManyRequests("API_KEY_DAILY_LIMIT_EXCEEDED", "api key daily usage limit exceeded")
+	ErrAPIKeyMonthlyLimitExceeded        = infraerrors.TooManyRequests("API_KEY_MONTHLY_LIMIT_EXCEEDED", "api key monthly usage limit exceeded")
+	ErrAPIKeyTotalLimitExceeded          = infraerrors.TooManyRequests("API_KEY_TOTAL_LIMIT_EXCEEDED", "api key total usage limit exceeded")
+	ErrAPIKeyDailyRequestLimitExceeded   = infraerrors.TooManyRequests("API_KEY_DAILY_REQUEST_LIMIT_EXCEEDED", "api key daily request limit exceeded")
+	ErrAPIKeyMonthlyRequestLimitExceeded = infraerrors.TooManyRequests("API_KEY_MONTHLY_REQUEST_LIMIT_EXCEEDED", "api key monthly request limit exceeded")
+)
+
+// APIKeyLimitUsage API Key 限额统计口径下的用量
+// DayStart/MonthStart 为统计窗口起点（系统时区自然日/自然月），窗口切换后缓存数据视为失效；
+// TotalUsage 为该 Key 全部使用记录的 actual_cost 之和
+type APIKeyLimitUsage struct {
+	DayStart        time.Time
+	MonthStart      time.Time
+	DailyUsage      float64
+	MonthlyUsage    float64
+	TotalUsage      float64
+	DailyRequests   int64
+	MonthlyRequests int64
+}
+
+// CheckLimits 检查用量是否已达到 API Key 的任一限额
+func (u *APIKeyLimitUsage) CheckLimits(k *APIKey) error {
+	if u == nil || k == nil {
+		return nil
+	}
+	if k.DailyLimitUSD != nil && u.DailyUsage >= *k.DailyLimitUSD {
+		return ErrAPIKeyDailyLimitExceeded
+	}
+	if k.MonthlyLimitUSD != nil && u.MonthlyUsage >= *k.MonthlyLimitUSD {
+		return ErrAPIKeyMonthlyLimitExceeded
+	}
+	if k.TotalLimitUSD != nil && u.TotalUsage >= *k.TotalLimitUSD {
+		return ErrAPIKeyTotalLimitExceeded
+	}
+	if k.DailyRequestLimit != nil && u.DailyRequests >= *k.DailyRequestLimit {
+		return ErrAPIKeyDailyRequestLimitExceeded
+	}
+	if k.MonthlyRequestLimit != nil && u.MonthlyRequests >= *k.MonthlyRequestLimit {
+		return ErrAPIKeyMonthlyRequestLimitExceeded
+	}
+	return nil
+}
+
+// normalizeRequestLimit 将 0 或负数转换为 nil（表示无限制）
+func normalizeRequestLimit(limit *int64) *int64 {
+	if limit == nil || *limit <= 0 {
+		return nil
+	}
+	return limit
+}
@@ -0,0 +1,92 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+type apiKeyLimitUsageRepoStub struct {
+	UsageLogRepository
+	usage      APIKeyLimitUsage
+	err        error
+	dayStart   time.Time
+	monthStart time.Time
+	calls      int
+}
+
+func (s *apiKeyLimitUsageRepoStub) GetAPIKeyLimitUsage(_ context.Context, _ int64, dayStart, monthStart time.Time) (*APIKeyLimitUsage, error) {
+	s.calls++
+	s.dayStart = dayStart
+	s.monthStart = monthStart
+	if s.err != nil {
+		return nil, s.err
+	}
+	usage := s.usage
+	usage.DayStart = dayStart
+	usage.MonthStart = monthStart
+	return &usage, nil
+}
+
+func TestAPIKeyLimitUsage_CheckLimits(t *testing.T) {
+	limit := func(v float64) *float64 { return &v }
+	count := func(v int64) *int64 { return &v }
+	usage := &APIKeyLimitUsage{DailyUsage: 5, MonthlyUsage: 50, TotalUsage: 500, DailyRequests: 10, MonthlyRequests: 100}
+
+	require.NoError(t, usage.CheckLimits(&APIKey{}))
+	require.NoError(t, usage.CheckLimits(&APIKey{DailyLimitUSD: limit(6), MonthlyLimitUSD: limit(60), TotalLimitUSD: limit(600), DailyRequestLimit: count(11), MonthlyRequestLimit: count(101)}))
+
+	require.ErrorIs(t, usage.CheckLimits(&APIKey{DailyLimitUSD: limit(5)}), ErrAPIKeyDailyLimitExceeded)
+	require.ErrorIs(t, usage.CheckLimits(&APIKey{MonthlyLimitUSD: limit(49)}), ErrAPIKeyMonthlyLimitExceeded)
+	require.ErrorIs(t, usage.CheckLimits(&APIKey{TotalLimitUSD: limit(500)}), ErrAPIKeyTotalLimitExceeded)
+	require.ErrorIs(t, usage.CheckLimits(&APIKey{DailyRequestLimit: count(10)}), ErrAPIKeyDailyRequestLimitExceeded)
+	require.ErrorIs(t, usage.CheckLimits(&APIKey{MonthlyRequestLimit: count(100)}), ErrAPIKeyMonthlyRequestLimitExceeded)
+}
+
+func TestBillingCacheService_CheckAPIKeyLimits(t *testing.T) {
+	cache := &billingCacheWorkerStub{}
+	usageRepo := &apiKeyLimitUsageRepoStub{usage: APIKeyLimitUsage{DailyUsage: 3, DailyRequests: 2}}
+	svc := NewBillingCacheService(cache, nil, nil, usageRepo, &config.Config{})
+	t.Cleanup(svc.Stop)
+
+	ctx := context.Background()
+	limit := 3.0
+	apiKey := &APIKey{ID: 9, DailyLimitUSD: &limit}
+	group := &Group{ID: 1, SubscriptionType: SubscriptionTypeSubscription}
+	sub := &UserSubscription{}
+
+	// 限额检查先于订阅检查执行
+	err := svc.CheckBillingEligibility(ctx, &User{ID: 1}, apiKey, group, sub, "claude-sonnet-4")
+	require.ErrorIs(t, err, ErrAPIKeyDailyLimitExceeded)
+	require.Equal(t, 1, usageRepo.calls)
+	require.True(t, usageRepo.dayStart.Before(time.Now()))
+	require.False(t, usageRepo.monthStart.After(usageRepo.dayStart))
+
+	// 缓存未命中时回源并异步写入缓存
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt64(&cache.apiKeyUsageUpdates) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// 统计失败时拒绝请求
+	usageRepo.err = errors.New("db down")
+	err = svc.CheckBillingEligibility(ctx, &User{ID: 1}, apiKey, group, sub, "claude-sonnet-4")
+	require.ErrorIs(t, err, ErrBillingServiceUnavailable)
+}
+
+func TestBillingCacheService_QueueUpdateAPIKeyUsage(t *testing.T) {
+	cache := &billingCacheWorkerStub{}
+	svc := NewBillingCacheService(cache, nil, nil, nil, &config.Config{})
+	t.Cleanup(svc.Stop)
+
+	svc.QueueUpdateAPIKeyUsage(9, 1.5)
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt64(&cache.apiKeyUsageUpdates) == 1
+	}, time.Second, 10*time.Millisecond)
+}
@@ -85,6 +85,13 @@ type CreateAPIKeyRequest struct {
 	CustomKey   *string  `json:"custom_key"`   // 可选的自定义key
 	IPWhitelist []string `json:"ip_whitelist"` // IP 白名单
 	IPBlacklist []string `json:"ip_blacklist"` // IP 黑名单
+
+	// 消费/请求次数限额（0 或负数表示不限制）
+	DailyLimitUSD       *float64 `json:"daily_limit_usd"`
+	MonthlyLimitUSD     *float64 `json:"monthly_limit_usd"`
+	TotalLimitUSD       *float64 `json:"total_limit_usd"`
+	DailyRequestLimit   *int64   `json:"daily_request_limit"`
+	MonthlyRequestLimit *int64   `json:"monthly_request_limit"`
 }
 
 // UpdateAPIKeyRequest 更新API Key请求
@@ -94,6 +101,13 @@ type UpdateAPIKeyRequest struct {
 	Status      *string  `json:"status"`
 	IPWhitelist []string `json:"ip_whitelist"` // IP 白名单（空数组清空）
 	IPBlacklist []string `json:"ip_blacklist"` // IP 黑名单（空数组清空）
+
+	// 消费/请求次数限额（nil 表示不修改，0 或负数表示清除）
+	DailyLimitUSD       *float64 `json:"daily_limit_usd"`
+	MonthlyLimitUSD     *float64 `json:"monthly_limit_usd"`
+	TotalLimitUSD       *float64 `json:"total_limit_usd"`
+	DailyRequestLimit   *int64   `json:"daily_request_limit"`
+	MonthlyRequestLimit *int64   `json:"monthly_request_limit"`
 }
 
 // APIKeyService API Key服务
@@ -107,6 +121,14 @@ type APIKeyService struct {
 	authCacheL1 *ristretto.Cache
 	authCfg     apiKeyAuthCacheConfig
 	authGroup   singleflight.Group
+
+	// orgBilling 组织 Key 计费解析（由 OrganizationService 注入，未注入时组织 Key 按个人 Key 计费）
+	orgBilling OrganizationBillingResolver
+}
+
+// OrganizationBillingResolver 解析组织 Key 的计费上下文
+type OrganizationBillingResolver interface {
+	ResolveAPIKeyBilling(ctx context.Context, apiKey *APIKey) error
 }
 
 // NewAPIKeyService 创建API Key服务实例
@@ -130,6 +152,19 @@ func NewAPIKeyService(
 	return svc
 }
 
+// SetOrganizationBillingResolver 注入组织计费解析器
+func (s *APIKeyService) SetOrganizationBillingResolver(resolver OrganizationBillingResolver) {
+	s.orgBilling = resolver
+}
+
+// ResolveOrganizationBilling 为组织 Key 填充 OrganizationBilling（认证中间件按请求调用，个人 Key 直接返回）
+func (s *APIKeyService) ResolveOrganizationBilling(ctx context.Context, apiKey *APIKey) error {
+	if s.orgBilling == nil || apiKey == nil || apiKey.OrganizationID == nil {
+		return nil
+	}
+	return s.orgBilling.ResolveAPIKeyBilling(ctx, apiKey)
+}
+
 // GenerateKey 生成随机API Key
 func (s *APIKeyService) GenerateKey() (string, error) {
 	// 生成32字节随机数据
@@ -289,6 +324,12 @@ func (s *APIKeyService) Create(ctx context.Context, userID int64, req CreateAPIK
 		Status:      StatusActive,
 		IPWhitelist: req.IPWhitelist,
 		IPBlacklist: req.IPBlacklist,
+
+		DailyLimitUSD:       normalizeLimit(req.DailyLimitUSD),
+		MonthlyLimitUSD:     normalizeLimit(req.MonthlyLimitUSD),
+		TotalLimitUSD:       normalizeLimit(req.TotalLimitUSD),
+		DailyRequestLimit:   normalizeRequestLimit(req.DailyRequestLimit),
+		MonthlyRequestLimit: normalizeRequestLimit(req.MonthlyRequestLimit),
 	}
 
 	if err := s.apiKeyRepo.Create(ctx, apiKey); err != nil {
@@ -409,6 +450,14 @@ func (s *APIKeyService) Update(ctx context.Context, id int64, userID int64, req
 		apiKey.Name = *req.Name
 	}
 
+	// 组织 Key 的分组按组织计费账户校验，只能通过组织接口修改
+	if req.GroupID != nil && apiKey.OrganizationID != nil {
+		if apiKey.GroupID == nil || *apiKey.GroupID != *req.GroupID {
+			return nil, ErrAPIKeyOrganizationBound
+		}
+		req.GroupID = nil
+	}
+
 	if req.GroupID != nil {
 		// 验证分组权限
 		user, err := s.userRepo.GetByID(ctx, userID)
@@ -440,6 +489,23 @@ func (s *APIKeyService) Update(ctx context.Context, id int64, userID int64, req
 	apiKey.IPWhitelist = req.IPWhitelist
 	apiKey.IPBlacklist = req.IPBlacklist
 
+	// 更新限额（未传入的字段保持不变）
+	if req.DailyLimitUSD != nil {
+		apiKey.DailyLimitUSD = normalizeLimit(req.DailyLimitUSD)
+	}
+	if req.MonthlyLimitUSD != nil {
+		apiKey.MonthlyLimitUSD = normalizeLimit(req.MonthlyLimitUSD)
+	}
+	if req.TotalLimitUSD != nil {
+		apiKey.TotalLimitUSD = normalizeLimit(req.TotalLimitUSD)
+	}
+	if req.DailyRequestLimit != nil {
+		apiKey.DailyRequestLimit = normalizeRequestLimit(req.DailyRequestLimit)
+	}
+	if req.MonthlyRequestLimit != nil {
+		apiKey.MonthlyRequestLimit = normalizeRequestLimit(req.MonthlyRequestLimit)
+	}
+
 	if err := s.apiKeyRepo.Update(ctx, apiKey); err != nil {
 		return nil, fmt.Errorf("update api key: %w", err)
 	}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+// 余额流水类型
+const (
+	BalanceTxTypeOpening      = "opening"      // 期初余额（迁移时按当时余额写入）
+	BalanceTxTypeUsage        = "usage"        // 网关请求扣费
+	BalanceTxTypeRedeem       = "redeem"       // 兑换码充值
+	BalanceTxTypePromo        = "promo"        // 优惠码赠送
+	BalanceTxTypePayment      = "payment"      // 在线支付充值
+	BalanceTxTypeAdmin        = "admin"        // 管理员调整
+	BalanceTxTypeAdjustment   = "adjustment"   // 其他系统调整
+	BalanceTxTypeExpiry       = "expiry"       // 额度批次到期清零
+	BalanceTxTypeSubscription = "subscription" // 余额购买/续费订阅套餐
+	BalanceTxTypeOrganization = "organization" // 成员与组织计费账户之间的额度划转
+	BalanceTxTypeReferral     = "referral"     // 邀请返佣
+)
+
+// BalanceChange 一次余额变动请求；Amount 为正表示增加，为负表示扣减
+type BalanceChange struct {
+	UserID         int64
+	Type           string
+	Amount         float64
+	UsageLogID     *int64
+	RedeemCodeID   *int64
+	PromoCodeID    *int64
+	PaymentOrderID *int64
+	Notes          string
+
+	// ExpiresAt 入账（Amount > 0）形成的额度批次到期时间，nil 表示永不过期
+	ExpiresAt *time.Time
+	// CreditLotID 扣减（Amount < 0）指定批次而非按到期顺序扣减，用于批次到期清零
+	CreditLotID *int64
+	// RequireSufficientBalance 扣减后余额不得为负（不允许透支），不足时返回 ErrInsufficientBalance
+	RequireSufficientBalance bool
+}
+
+// BalanceTransaction 余额流水记录（只增不改）
+type BalanceTransaction struct {
+	ID             int64     `json:"id"`
+	UserID         int64     `json:"user_id"`
+	Type           string    `json:"type"`
+	Amount         float64   `json:"amount"`
+	BalanceAfter   float64   `json:"balance_after"`
+	UsageLogID     *int64    `json:"usage_log_id,omitempty"`
+	RedeemCodeID   *int64    `json:"redeem_code_id,omitempty"`
+	PromoCodeID    *int64    `json:"promo_code_id,omitempty"`
+	PaymentOrderID *int64    `json:"payment_order_id,omitempty"`
+	CreditLotID    *int64    `json:"credit_lot_id,omitempty"`
+	Notes          string    `json:"notes,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// BalanceTransactionFilters 流水查询过滤条件
+type BalanceTransactionFilters struct {
+	Type      string
+	StartTime *time.Time
+	EndTime   *time.Time
+}
+
+// BalanceDrift 流水合计与 users.balance 不一致的用户
+type BalanceDrift struct {
+	UserID      int64   `json:"user_id"`
+	Email       string  `json:"email"`
+	Balance     float64 `json:"balance"`
+	LedgerTotal float64 `json:"ledger_total"`
+	Drift       float64 `json:"drift"`
+}
+
+// BalanceTransactionRepository 余额流水查询接口
+// 写入由 UserRepository.ApplyBalanceChange 与余额更新在同一语句中完成
+type BalanceTransactionRepository interface {
+	ListByUser(ctx context.Context, userID int64, params pagination.PaginationParams, filters BalanceTransactionFilters) ([]BalanceTransaction, *pagination.PaginationResult, error)
+	// FindDrifts 返回 |balance - SUM(amount)| 超过 tolerance 的用户
+	FindDrifts(ctx context.Context, tolerance float64, limit int) ([]BalanceDrift, error)
+}
+
+// IsValidBalanceTxType 校验流水类型（用于查询过滤）
+func IsValidBalanceTxType(t string) bool {
+	switch t {
+	case BalanceTxTypeOpening, BalanceTxTypeUsage, BalanceTxTypeRedeem, BalanceTxTypePromo, BalanceTxTypePayment, BalanceTxTypeAdmin, BalanceTxTypeAdjustment, BalanceTxTypeExpiry, BalanceTxTypeSubscription, BalanceTxTypeOrganization, BalanceTxTypeReferral:
+		return true
+	}
+	return false
+}
+
+// usageBalanceChange 构造请求扣费的余额变动，关联对应的使用记录
+func usageBalanceChange(userID int64, usageLog *UsageLog, cost float64) *BalanceChange {
+	change := &BalanceChange{
+		UserID: userID,
+		Type:   BalanceTxTypeUsage,
+		Amount: -cost,
+	}
+	if usageLog != nil && usageLog.ID > 0 {
+		id := usageLog.ID
+		change.UsageLogID = &id
+		change.Notes = usageLog.Model
+	}
+	return change
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+const (
+	balanceReconcileWorkerName    = "balance_ledger_reconcile_worker"
+	balanceReconcileLeaderLockKey = "balance:ledger:reconcile:leader"
+	balanceReconcileInterval      = time.Hour
+	balanceReconcileTimeout       = 5 * time.Minute
+
+	// 余额与流水均为 DECIMAL(20,8)，差额超过该值视为漂移
+	balanceDriftTolerance = 0.000001
+	balanceDriftMaxReport = 100
+)
+
+var ErrInvalidBalanceTxType = infraerrors.BadRequest("INVALID_BALANCE_TX_TYPE", "invalid balance transaction type")
+
+// BalanceLedgerService 余额流水查询与对账
+// 对账任务定期比较每个用户的流水合计与 users.balance，发现漂移时记录日志并写入运维告警事件。
+type BalanceLedgerService struct {
+	txRepo      BalanceTransactionRepository
+	opsRepo     OpsRepository
+	timingWheel *TimingWheelService
+	db          *sql.DB
+
+	mu         sync.RWMutex
+	lastDrifts []BalanceDrift
+	lastRunAt  time.Time
+
+	running   int32
+	startOnce sync.Once
+	stopOnce  sync.Once
+}
+
+// BalanceReconcileReport 最近一次对账结果
+type BalanceReconcileReport struct {
+	CheckedAt time.Time      `json:"checked_at"`
+	Drifts    []BalanceDrift `json:"drifts"`
+}
+
+func NewBalanceLedgerService(txRepo BalanceTransactionRepository, opsRepo OpsRepository, timingWheel *TimingWheelService, db *sql.DB) *BalanceLedgerService {
+	return &BalanceLedgerService{
+		txRepo:      txRepo,
+		opsRepo:     opsRepo,
+		timingWheel: timingWheel,
+		db:          db,
+	}
+}
+
+func (s *BalanceLedgerService) Start() {
+	if s == nil {
+		return
+	}
+	if s.txRepo == nil || s.timingWheel == nil {
+		log.Printf("[BalanceLedger] reconcile worker not started (missing deps)")
+		return
+	}
+	s.startOnce.Do(func() {
+		s.timingWheel.ScheduleRecurring(balanceReconcileWorkerName, balanceReconcileInterval, s.runOnce)
+		log.Printf("[BalanceLedger] reconcile worker started (interval=%s)", balanceReconcileInterval)
+	})
+}
+
+func (s *BalanceLedgerService) Stop() {
+	if s == nil {
+		return
+	}
+	s.stopOnce.Do(func() {
+		if s.timingWheel != nil {
+			s.timingWheel.Cancel(balanceReconcileWorkerName)
+		}
+		log.Printf("[BalanceLedger] reconcile worker stopped")
+	})
+}
+
+// ListUserTransactions 分页查询用户余额流水（按时间倒序）
+func (s *BalanceLedgerService) ListUserTransactions(ctx context.Context, userID int64, params pagination.PaginationParams, filters BalanceTransactionFilters) ([]BalanceTransaction, *pagination.PaginationResult, error) {
+	if filters.Type != "" && !IsValidBalanceTxType(filters.Type) {
+		return nil, nil, ErrInvalidBalanceTxType
+	}
+	items, result, err := s.txRepo.ListByUser(ctx, userID, params, filters)
+	if err != nil {
+		return nil, nil, fmt.Errorf("list balance transactions: %w", err)
+	}
+	return items, result, nil
+}
+
+// Reconcile 立即执行一次对账并返回漂移用户列表
+func (s *BalanceLedgerService) Reconcile(ctx context.Context) (*BalanceReconcileReport, error) {
+	drifts, err := s.txRepo.FindDrifts(ctx, balanceDriftTolerance, balanceDriftMaxReport)
+	if err != nil {
+		return nil, fmt.Errorf("find balance drifts: %w", err)
+	}
+	now := time.Now()
+	s.mu.Lock()
+	s.lastDrifts = drifts
+	s.lastRunAt = now
+	s.mu.Unlock()
+	return &BalanceReconcileReport{CheckedAt: now, Drifts: drifts}, nil
+}
+
+// LastReconcileReport 返回最近一次对账结果；尚未执行过时返回 nil
+func (s *BalanceLedgerService) LastReconcileReport() *BalanceReconcileReport {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.lastRunAt.IsZero() {
+		return nil
+	}
+	return &BalanceReconcileReport{CheckedAt: s.lastRunAt, Drifts: s.lastDrifts}
+}
+
+func (s *BalanceLedgerService) runOnce() {
+	if !atomic.CompareAndSwapInt32(&s.running, 0, 1) {
+		return
+	}
+	defer atomic.StoreInt32(&s.running, 0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), balanceReconcileTimeout)
+	defer cancel()
+
+	if s.db != nil {
+		release, ok := tryAcquireDBAdvisoryLock(ctx, s.db, hashAdvisoryLockID(balanceReconcileLeaderLockKey))
+		if !ok {
+			return
+		}
+		defer release()
+	}
+
+	previous := s.LastReconcileReport()
+	report, err := s.Reconcile(ctx)
+	if err != nil {
+		log.Printf("[BalanceLedger] reconcile failed: %v", err)
+		return
+	}
+	if len(report.Drifts) == 0 {
+		return
+	}
+	log.Printf("[BalanceLedger] balance drift detected: users=%d", len(report.Drifts))
+	// 漂移用户集合未变化时不重复告警
+	if previous != nil && sameDriftUsers(previous.Drifts, report.Drifts) {
+		return
+	}
+	s.fireDriftAlert(ctx, report)
+}
+
+func sameDriftUsers(a, b []BalanceDrift) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	seen := make(map[int64]struct{}, len(a))
+	for _, d := range a {
+		seen[d.UserID] = struct{}{}
+	}
+	for _, d := range b {
+		if _, ok := seen[d.UserID]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *BalanceLedgerService) fireDriftAlert(ctx context.Context, report *BalanceReconcileReport) {
+	if s.opsRepo == nil {
+		return
+	}
+	userIDs := make([]int64, 0, len(report.Drifts))
+	maxDrift := 0.0
+	for _, d := range report.Drifts {
+		userIDs = append(userIDs, d.UserID)
+		maxDrift = math.Max(maxDrift, math.Abs(d.Drift))
+	}
+	threshold := balanceDriftTolerance
+	event := &OpsAlertEvent{
+		Severity:       "P1",
+		Status:         OpsAlertStatusFiring,
+		Title:          fmt.Sprintf("P1: balance ledger drift on %d user(s)", len(report.Drifts)),
+		Description:    fmt.Sprintf("users.balance differs from the balance_transactions total for %d user(s); max drift $%.8f.", len(report.Drifts), maxDrift),
+		MetricValue:    &maxDrift,
+		ThresholdValue: &threshold,
+		Dimensions:     map[string]any{"user_ids": userIDs},
+		FiredAt:        report.CheckedAt,
+		CreatedAt:      report.CheckedAt,
+	}
+	if _, err := s.opsRepo.CreateAlertEvent(ctx, event); err != nil {
+		log.Printf("[BalanceLedger] create drift alert failed: %v", err)
+	}
+}
@@ -0,0 +1,75 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/stretchr/testify/require"
+)
+
+type balanceTxRepoStub struct {
+	drifts      []BalanceDrift
+	listFilters []BalanceTransactionFilters
+}
+
+func (s *balanceTxRepoStub) ListByUser(_ context.Context, _ int64, params pagination.PaginationParams, filters BalanceTransactionFilters) ([]BalanceTransaction, *pagination.PaginationResult, error) {
+	s.listFilters = append(s.listFilters, filters)
+	return []BalanceTransaction{}, &pagination.PaginationResult{Page: params.Page, PageSize: params.PageSize}, nil
+}
+
+func (s *balanceTxRepoStub) FindDrifts(context.Context, float64, int) ([]BalanceDrift, error) {
+	return s.drifts, nil
+}
+
+func TestBalanceLedgerService_ListRejectsUnknownType(t *testing.T) {
+	repo := &balanceTxRepoStub{}
+	svc := NewBalanceLedgerService(repo, nil, nil, nil)
+	params := pagination.PaginationParams{Page: 1, PageSize: 20}
+
+	_, _, err := svc.ListUserTransactions(context.Background(), 1, params, BalanceTransactionFilters{Type: "bogus"})
+	require.ErrorIs(t, err, ErrInvalidBalanceTxType)
+	require.Empty(t, repo.listFilters)
+
+	_, _, err = svc.ListUserTransactions(context.Background(), 1, params, BalanceTransactionFilters{Type: BalanceTxTypeUsage})
+	require.NoError(t, err)
+	require.Len(t, repo.listFilters, 1)
+}
+
+func TestBalanceLedgerService_ReconcileAlertsOncePerDriftSet(t *testing.T) {
+	repo := &balanceTxRepoStub{drifts: []BalanceDrift{{UserID: 3, Balance: 10, LedgerTotal: 9.5, Drift: 0.5}}}
+	opsRepo := &spendCapOpsRepoStub{}
+	svc := NewBalanceLedgerService(repo, opsRepo, nil, nil)
+	require.Nil(t, svc.LastReconcileReport())
+
+	svc.runOnce()
+	require.Len(t, opsRepo.events, 1)
+	require.Equal(t, 0.5, *opsRepo.events[0].MetricValue)
+	require.Len(t, svc.LastReconcileReport().Drifts, 1)
+
+	// 漂移用户未变化，不重复告警
+	svc.runOnce()
+	require.Len(t, opsRepo.events, 1)
+
+	repo.drifts = append(repo.drifts, BalanceDrift{UserID: 4, Drift: -1})
+	svc.runOnce()
+	require.Len(t, opsRepo.events, 2)
+
+	repo.drifts = nil
+	svc.runOnce()
+	require.Len(t, opsRepo.events, 2)
+	require.Empty(t, svc.LastReconcileReport().Drifts)
+}
+
+func TestUsageBalanceChange(t *testing.T) {
+	change := usageBalanceChange(9, &UsageLog{ID: 42, Model: "claude-sonnet-4-5"}, 1.25)
+	require.Equal(t, BalanceTxTypeUsage, change.Type)
+	require.Equal(t, -1.25, change.Amount)
+	require.Equal(t, int64(42), *change.UsageLogID)
+
+	// 使用记录写入失败时仍然扣费，但不关联使用记录
+	change = usageBalanceChange(9, &UsageLog{}, 1)
+	require.Nil(t, change.UsageLogID)
+}
@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/google/uuid"
+	"github.com/tidwall/gjson"
+)
+
+// ErrBalanceReserved 余额已被其他在途请求预留，剩余可用余额不足以覆盖本次请求的预估费用
+var ErrBalanceReserved = infraerrors.Forbidden("BALANCE_RESERVED", "insufficient available balance: remaining balance is reserved by in-flight requests")
+
+const balanceHoldReleaseTimeout = 2 * time.Second
+
+// BalanceReservationInput 预留余额所需的请求信息
+type BalanceReservationInput struct {
+	User         *User
+	APIKey       *APIKey
+	Subscription *UserSubscription
+	Model        string
+	Body         []byte // 原始请求体，用于预估输入 token 数与读取 max_tokens
+}
+
+// BalanceReservation 一次在途请求的余额预留
+// 结算（Settle）与释放（Release）均为幂等操作，超时未结算时由时间轮自动释放
+type BalanceReservation struct {
+	ID     string
+	UserID int64
+	Amount float64
+
+	svc  *BalanceReservationService
+	once sync.Once
+}
+
+// BalanceReservationService 在途请求余额预留：转发前预留预估最大费用，完成后结算实际费用并释放剩余部分
+type BalanceReservationService struct {
+	cache               BillingCache
+	billingCacheService *BillingCacheService
+	billingService      *BillingService
+	timingWheel         *TimingWheelService
+	cfg                 *config.Config
+}
+
+// NewBalanceReservationService creates a new BalanceReservationService
+func NewBalanceReservationService(
+	cache BillingCache,
+	billingCacheService *BillingCacheService,
+	billingService *BillingService,
+	timingWheel *TimingWheelService,
+	cfg *config.Config,
+) *BalanceReservationService {
+	return &BalanceReservationService{
+		cache:               cache,
+		billingCacheService: billingCacheService,
+		billingService:      billingService,
+		timingWheel:         timingWheel,
+		cfg:                 cfg,
+	}
+}
+
+func (s *BalanceReservationService) enabled() bool {
+	if s == nil || s.cache == nil || s.billingCacheService == nil || s.billingService == nil || s.cfg == nil {
+		return false
+	}
+	return s.cfg.RunMode != config.RunModeSimple && s.cfg.Billing.Reservation.Enabled
+}
+
+func (s *BalanceReservationService) holdTTL() time.Duration {
+	return time.Duration(s.cfg.Billing.Reservation.HoldTTLSeconds) * time.Second
+}
+
+// Reserve 为余额计费的请求预留预估最大费用
+// 订阅计费、未启用预留或无法预估费用时返回 nil；缓存异常时放行（资格检查已在此前完成）。
+func (s *BalanceReservationService) Reserve(ctx context.Context, input BalanceReservationInput) (*BalanceReservation, error) {
+	if !s.enabled() || input.User == nil || input.APIKey == nil {
+		return nil, nil
+	}
+	group := input.APIKey.Group
+	if input.Subscription != nil && group != nil && group.IsSubscriptionType() {
+		return nil, nil
+	}
+
+	amount := s.EstimateMaxCost(input)
+	if amount <= 0 {
+		return nil, nil
+	}
+
+	// 组织 Key 在组织计费账户上预留
+	payer := input.APIKey.Payer(input.User)
+	balance, err := s.billingCacheService.GetUserBalance(ctx, payer.ID)
+	if err != nil {
+		log.Printf("Warning: balance reservation skipped for user %d: get balance failed: %v", payer.ID, err)
+		return nil, nil
+	}
+
+	reservation := &BalanceReservation{
+		ID:     uuid.NewString(),
+		UserID: payer.ID,
+		Amount: amount,
+		svc:    s,
+	}
+	ttl := s.holdTTL()
+	ok, err := s.cache.ReserveBalance(ctx, reservation.UserID, reservation.ID, amount, balance, ttl)
+	if err != nil {
+		log.Printf("Warning: balance reservation skipped for user %d: %v", payer.ID, err)
+		return nil, nil
+	}
+	if !ok {
+		return nil, ErrBalanceReserved
+	}
+
+	// 孤儿预留（请求未走到结算，如进程内 goroutine 异常）到期自动释放
+	if s.timingWheel != nil {
+		s.timingWheel.Schedule(reservation.timerName(), ttl, func() {
+			reservation.release(true)
+		})
+	}
+	return reservation, nil
+}
+
+// EstimateMaxCost 按输入大小与 max_tokens 预估本次请求的最大费用（已应用分组倍率）
+func (s *BalanceReservationService) EstimateMaxCost(input BalanceReservationInput) float64 {
+	if s == nil || s.billingService == nil || s.cfg == nil || input.Model == "" {
+		return 0
+	}
+	reservationCfg := s.cfg.Billing.Reservation
+
+	inputTokens := estimateTokensForText(string(input.Body))
+	if reservationCfg.MaxInputTokens > 0 && inputTokens > reservationCfg.MaxInputTokens {
+		inputTokens = reservationCfg.MaxInputTokens
+	}
+	outputTokens := ExtractMaxOutputTokens(input.Body)
+	if outputTokens <= 0 {
+		outputTokens = reservationCfg.DefaultMaxTokens
+	}
+
+	multiplier := s.cfg.Default.RateMultiplier
+	var groupID *int64
+	if input.APIKey != nil && input.APIKey.GroupID != nil && input.APIKey.Group != nil {
+		multiplier = input.APIKey.Group.RateMultiplier
+		groupID = input.APIKey.GroupID
+	}
+
+	cost, err := s.billingService.CalculateCostForGroup(input.Model, groupID, UsageTokens{
+		InputTokens:  inputTokens,
+		OutputTokens: outputTokens,
+	}, multiplier)
+	if err != nil {
+		return 0
+	}
+	return cost.ActualCost
+}
+
+// ExtractMaxOutputTokens 从请求体读取输出 token 上限（兼容 Claude / OpenAI / Gemini 请求格式），未指定时返回 0
+func ExtractMaxOutputTokens(body []byte) int {
+	for _, path := range []string{"max_tokens", "max_output_tokens", "max_completion_tokens", "generationConfig.maxOutputTokens"} {
+		if v := gjson.GetBytes(body, path); v.Exists() && v.Int() > 0 {
+			return int(v.Int())
+		}
+	}
+	return 0
+}
+
+// Settle 按实际费用结算：余额已由使用记录扣除，此处释放全部预留
+func (r *BalanceReservation) Settle(actualCost float64) {
+	if r == nil {
+		return
+	}
+	if actualCost > r.Amount {
+		log.Printf("Balance reservation underestimated: user=%d reserved=%.6f actual=%.6f", r.UserID, r.Amount, actualCost)
+	}
+	r.Release()
+}
+
+// Release 释放预留（请求失败或未产生用量时调用）
+func (r *BalanceReservation) Release() {
+	if r == nil {
+		return
+	}
+	r.release(false)
+}
+
+func (r *BalanceReservation) release(expired bool) {
+	r.once.Do(func() {
+		s := r.svc
+		if s == nil {
+			return
+		}
+		if !expired && s.timingWheel != nil {
+			s.timingWheel.Cancel(r.timerName())
+		}
+		if expired {
+			log.Printf("Balance reservation expired without settlement: user=%d hold=%s amount=%.6f", r.UserID, r.ID, r.Amount)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), balanceHoldReleaseTimeout)
+		defer cancel()
+		if err := s.cache.ReleaseBalanceHold(ctx, r.UserID, r.ID); err != nil {
+			log.Printf("Warning: release balance hold failed: user=%d hold=%s: %v", r.UserID, r.ID, err)
+		}
+	})
+}
+
+func (r *BalanceReservation) timerName() string {
+	return "balance_hold:" + r.ID
+}
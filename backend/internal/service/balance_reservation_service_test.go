@@ -0,0 +1,147 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+// balanceHoldCacheStub 模拟 Redis 预留脚本：无在途预留时余额为正即放行，否则要求 余额 - 已预留 >= 本次预留
+type balanceHoldCacheStub struct {
+	billingCacheWorkerStub
+	mu      sync.Mutex
+	balance float64
+	holds   map[string]float64
+}
+
+func (b *balanceHoldCacheStub) GetUserBalance(ctx context.Context, userID int64) (float64, error) {
+	return b.balance, nil
+}
+
+func (b *balanceHoldCacheStub) ReserveBalance(ctx context.Context, userID int64, holdID string, amount, balance float64, ttl time.Duration) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	held := 0.0
+	for _, v := range b.holds {
+		held += v
+	}
+	if balance <= 0 || (held > 0 && balance-held < amount) {
+		return false, nil
+	}
+	b.holds[holdID] = amount
+	return true, nil
+}
+
+func (b *balanceHoldCacheStub) ReleaseBalanceHold(ctx context.Context, userID int64, holdID string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.holds, holdID)
+	return nil
+}
+
+func (b *balanceHoldCacheStub) holdCount() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.holds)
+}
+
+func newBalanceReservationTestService(t *testing.T, cache *balanceHoldCacheStub, enabled bool) *BalanceReservationService {
+	t.Helper()
+	cfg := &config.Config{
+		Default: config.DefaultConfig{RateMultiplier: 1},
+		Billing: config.BillingConfig{Reservation: config.BalanceReservationConfig{
+			Enabled:          enabled,
+			HoldTTLSeconds:   60,
+			DefaultMaxTokens: 1000,
+			MaxInputTokens:   1000,
+		}},
+	}
+	billingCache := NewBillingCacheService(cache, nil, nil, nil, cfg)
+	t.Cleanup(billingCache.Stop)
+	return NewBalanceReservationService(cache, billingCache, NewBillingService(cfg, nil, nil), nil, cfg)
+}
+
+func TestExtractMaxOutputTokens(t *testing.T) {
+	require.Equal(t, 1024, ExtractMaxOutputTokens([]byte(`{"model":"claude","max_tokens":1024}`)))
+	require.Equal(t, 2048, ExtractMaxOutputTokens([]byte(`{"model":"gpt","max_output_tokens":2048}`)))
+	require.Equal(t, 4096, ExtractMaxOutputTokens([]byte(`{"generationConfig":{"maxOutputTokens":4096}}`)))
+	require.Equal(t, 0, ExtractMaxOutputTokens([]byte(`{"model":"claude"}`)))
+	require.Equal(t, 0, ExtractMaxOutputTokens([]byte(`not json`)))
+}
+
+func TestBalanceReservationService_EstimateMaxCost(t *testing.T) {
+	svc := newBalanceReservationTestService(t, &balanceHoldCacheStub{holds: map[string]float64{}}, true)
+
+	// claude-sonnet-4 回退价格：输出 $15/M；40000 输出 token = $0.6
+	body := []byte(`{"model":"claude-sonnet-4","max_tokens":40000}`)
+	cost := svc.EstimateMaxCost(BalanceReservationInput{Model: "claude-sonnet-4", Body: body})
+	require.InDelta(t, 0.6, cost, 0.001)
+
+	// 分组倍率参与预估
+	groupID := int64(1)
+	apiKey := &APIKey{GroupID: &groupID, Group: &Group{ID: groupID, RateMultiplier: 2}}
+	cost = svc.EstimateMaxCost(BalanceReservationInput{APIKey: apiKey, Model: "claude-sonnet-4", Body: body})
+	require.InDelta(t, 1.2, cost, 0.002)
+
+	// 未指定 max_tokens 时使用默认值（1000 输出 token = $0.015）
+	cost = svc.EstimateMaxCost(BalanceReservationInput{Model: "claude-sonnet-4", Body: []byte(`{"model":"claude-sonnet-4"}`)})
+	require.InDelta(t, 0.015, cost, 0.001)
+
+	// 未识别模型名时不预留
+	require.Zero(t, svc.EstimateMaxCost(BalanceReservationInput{Body: body}))
+}
+
+func TestBalanceReservationService_ReserveAndRelease(t *testing.T) {
+	cache := &balanceHoldCacheStub{balance: 1, holds: map[string]float64{}}
+	svc := newBalanceReservationTestService(t, cache, true)
+	ctx := context.Background()
+
+	input := BalanceReservationInput{
+		User:   &User{ID: 1},
+		APIKey: &APIKey{ID: 1},
+		Model:  "claude-sonnet-4",
+		Body:   []byte(`{"model":"claude-sonnet-4","max_tokens":40000}`),
+	}
+
+	first, err := svc.Reserve(ctx, input)
+	require.NoError(t, err)
+	require.NotNil(t, first)
+	require.InDelta(t, 0.6, first.Amount, 0.001)
+
+	// 剩余可用余额 0.4 不足以覆盖第二个并发请求
+	_, err = svc.Reserve(ctx, input)
+	require.ErrorIs(t, err, ErrBalanceReserved)
+
+	// 结算后释放预留，且重复释放无副作用
+	first.Settle(0.1)
+	first.Release()
+	require.Equal(t, 0, cache.holdCount())
+
+	second, err := svc.Reserve(ctx, input)
+	require.NoError(t, err)
+	require.NotNil(t, second)
+	second.Release()
+
+	// 订阅计费不预留
+	subInput := input
+	subInput.APIKey = &APIKey{ID: 1, Group: &Group{SubscriptionType: SubscriptionTypeSubscription}}
+	subInput.Subscription = &UserSubscription{}
+	reservation, err := svc.Reserve(ctx, subInput)
+	require.NoError(t, err)
+	require.Nil(t, reservation)
+
+	// 未启用时不预留；nil 预留的结算与释放为空操作
+	disabled := newBalanceReservationTestService(t, cache, false)
+	reservation, err = disabled.Reserve(ctx, input)
+	require.NoError(t, err)
+	require.Nil(t, reservation)
+	reservation.Settle(1)
+	reservation.Release()
+	require.Equal(t, 0, cache.holdCount())
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/Wei-Shaw/sub2api/internal/config"
 	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
 )
 
 // 错误定义
@@ -38,6 +39,10 @@ const (
 	cacheWriteSetSubscription
 	cacheWriteUpdateSubscriptionUsage
 	cacheWriteDeductBalance
+	cacheWriteSetRollingSpend
+	cacheWriteSetAPIKeyUsage
+	cacheWriteUpdateAPIKeyUsage
+	cacheWriteAddRollingWindowUsage
 )
 
 // 异步缓存写入工作池配置
@@ -68,6 +73,9 @@ type cacheWriteTask struct {
 	balance          float64
 	amount           float64
 	subscriptionData *subscriptionCacheData
+	apiKeyID         int64
+	apiKeyUsage      *APIKeyLimitUsage
+	rollingWindow    time.Duration
 }
 
 // BillingCacheService 计费缓存服务
@@ -76,6 +84,7 @@ type BillingCacheService struct {
 	cache          BillingCache
 	userRepo       UserRepository
 	subRepo        UserSubscriptionRepository
+	usageLogRepo   UsageLogRepository
 	cfg            *config.Config
 	circuitBreaker *billingCircuitBreaker
 
@@ -90,12 +99,13 @@ type BillingCacheService struct {
 }
 
 // NewBillingCacheService 创建计费缓存服务
-func NewBillingCacheService(cache BillingCache, userRepo UserRepository, subRepo UserSubscriptionRepository, cfg *config.Config) *BillingCacheService {
+func NewBillingCacheService(cache BillingCache, userRepo UserRepository, subRepo UserSubscriptionRepository, usageLogRepo UsageLogRepository, cfg *config.Config) *BillingCacheService {
 	svc := &BillingCacheService{
-		cache:    cache,
-		userRepo: userRepo,
-		subRepo:  subRepo,
-		cfg:      cfg,
+		cache:        cache,
+		userRepo:     userRepo,
+		subRepo:      subRepo,
+		usageLogRepo: usageLogRepo,
+		cfg:          cfg,
 	}
 	svc.circuitBreaker = newBillingCircuitBreaker(cfg.Billing.CircuitBreaker)
 	svc.startCacheWriteWorkers()
@@ -165,6 +175,26 @@ func (s *BillingCacheService) cacheWriteWorker() {
 					log.Printf("Warning: deduct balance cache failed for user %d: %v", task.userID, err)
 				}
 			}
+		case cacheWriteSetAPIKeyUsage:
+			if s.cache != nil && task.apiKeyUsage != nil {
+				if err := s.cache.SetAPIKeyUsageCache(ctx, task.apiKeyID, task.apiKeyUsage); err != nil {
+					log.Printf("Warning: set api key usage cache failed for api key %d: %v", task.apiKeyID, err)
+				}
+			}
+		case cacheWriteUpdateAPIKeyUsage:
+			if s.cache != nil {
+				if err := s.cache.UpdateAPIKeyUsage(ctx, task.apiKeyID, task.amount); err != nil {
+					log.Printf("Warning: update api key usage cache failed for api key %d: %v", task.apiKeyID, err)
+				}
+			}
+		case cacheWriteAddRollingWindowUsage:
+			s.addRollingWindowUsage(ctx, task)
+		case cacheWriteSetRollingSpend:
+			if s.cache != nil {
+				if err := s.cache.SetUserRollingSpend(ctx, task.userID, task.amount); err != nil {
+					log.Printf("Warning: set rolling spend cache failed for user %d: %v", task.userID, err)
+				}
+			}
 		}
 		cancel()
 	}
@@ -181,6 +211,14 @@ func cacheWriteKindName(kind cacheWriteKind) string {
 		return "update_subscription_usage"
 	case cacheWriteDeductBalance:
 		return "deduct_balance"
+	case cacheWriteSetRollingSpend:
+		return "set_rolling_spend"
+	case cacheWriteSetAPIKeyUsage:
+		return "set_api_key_usage"
+	case cacheWriteUpdateAPIKeyUsage:
+		return "update_api_key_usage"
+	case cacheWriteAddRollingWindowUsage:
+		return "add_rolling_window_usage"
 	default:
 		return "unknown"
 	}
@@ -441,14 +479,121 @@ func (s *BillingCacheService) InvalidateSubscription(ctx context.Context, userID
 	return nil
 }
 
+// ============================================
+// API Key 限额方法
+// ============================================
+
+// GetAPIKeyLimitUsage 获取 API Key 当前日/月窗口及累计用量（优先从缓存读取）
+// 缓存的统计窗口与当前窗口不一致（跨日/跨月）时视为未命中，从数据库重新统计
+func (s *BillingCacheService) GetAPIKeyLimitUsage(ctx context.Context, apiKeyID int64) (*APIKeyLimitUsage, error) {
+	now := timezone.Now()
+	dayStart := timezone.StartOfDay(now)
+	monthStart := timezone.StartOfMonth(now)
+
+	if s.cache != nil {
+		cached, err := s.cache.GetAPIKeyUsageCache(ctx, apiKeyID)
+		if err == nil && cached != nil && cached.DayStart.Equal(dayStart) && cached.MonthStart.Equal(monthStart) {
+			return cached, nil
+		}
+	}
+
+	if s.usageLogRepo == nil {
+		return nil, fmt.Errorf("get api key usage: usage log repository unavailable")
+	}
+	usage, err := s.usageLogRepo.GetAPIKeyLimitUsage(ctx, apiKeyID, dayStart, monthStart)
+	if err != nil {
+		return nil, fmt.Errorf("get api key usage: %w", err)
+	}
+
+	// 异步建立缓存
+	_ = s.enqueueCacheWrite(cacheWriteTask{
+		kind:        cacheWriteSetAPIKeyUsage,
+		apiKeyID:    apiKeyID,
+		apiKeyUsage: usage,
+	})
+
+	return usage, nil
+}
+
+// QueueUpdateAPIKeyUsage 异步累加 API Key 用量缓存（消费与请求数）
+func (s *BillingCacheService) QueueUpdateAPIKeyUsage(apiKeyID int64, cost float64) {
+	if s.cache == nil {
+		return
+	}
+	// 队列满时同步回退，确保限额计数及时更新。
+	if s.enqueueCacheWrite(cacheWriteTask{
+		kind:     cacheWriteUpdateAPIKeyUsage,
+		apiKeyID: apiKeyID,
+		amount:   cost,
+	}) {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), cacheWriteTimeout)
+	defer cancel()
+	if err := s.cache.UpdateAPIKeyUsage(ctx, apiKeyID, cost); err != nil {
+		log.Printf("Warning: update api key usage cache fallback failed for api key %d: %v", apiKeyID, err)
+	}
+}
+
+// ============================================
+// 阶梯折扣方法
+// ============================================
+
+// GetUserRollingSpend 获取用户近 30 天实际消费（优先从缓存读取）
+// 缓存有效期内新增的消费不会立即反映到分档中，档位升降存在最多一个缓存周期的延迟
+func (s *BillingCacheService) GetUserRollingSpend(ctx context.Context, userID int64) (float64, error) {
+	if s.cache != nil {
+		if spend, err := s.cache.GetUserRollingSpend(ctx, userID); err == nil {
+			return spend, nil
+		}
+	}
+	if s.usageLogRepo == nil {
+		return 0, fmt.Errorf("get user rolling spend: usage log repository unavailable")
+	}
+	spend, err := s.usageLogRepo.GetUserSpendSince(ctx, userID, time.Now().Add(-VolumeTierWindow))
+	if err != nil {
+		return 0, fmt.Errorf("get user rolling spend: %w", err)
+	}
+	if s.cache != nil {
+		_ = s.enqueueCacheWrite(cacheWriteTask{
+			kind:   cacheWriteSetRollingSpend,
+			userID: userID,
+			amount: spend,
+		})
+	}
+	return spend, nil
+}
+
+// ResolveVolumeTier 返回用户在分组下命中的阶梯折扣档位
+// 分组未配置档位、简易模式或统计失败时返回 nil（按分组原倍率计费，不阻断请求）
+func (s *BillingCacheService) ResolveVolumeTier(ctx context.Context, userID int64, group *Group) *AppliedVolumeTier {
+	if s == nil || !group.HasVolumeTiers() {
+		return nil
+	}
+	if s.cfg != nil && s.cfg.RunMode == config.RunModeSimple {
+		return nil
+	}
+	spend, err := s.GetUserRollingSpend(ctx, userID)
+	if err != nil {
+		log.Printf("Warning: resolve volume tier failed for user %d group %d: %v", userID, group.ID, err)
+		return nil
+	}
+	tier := group.VolumeTierFor(spend)
+	if tier == nil {
+		return nil
+	}
+	return &AppliedVolumeTier{VolumeTier: *tier, Spend: spend}
+}
+
 // ============================================
 // 统一检查方法
 // ============================================
 
 // CheckBillingEligibility 检查用户是否有资格发起请求
+// 组织 Key 按组织计费账户检查余额/订阅，并检查成员月度限额
 // 余额模式：检查缓存余额 > 0
-// 订阅模式：检查缓存用量未超过限额（Group限额从参数传入）
-func (s *BillingCacheService) CheckBillingEligibility(ctx context.Context, user *User, apiKey *APIKey, group *Group, subscription *UserSubscription) error {
+// 订阅模式：检查缓存用量未超过限额（Group限额从参数传入），以及请求模型的请求数/Token 配额
+func (s *BillingCacheService) CheckBillingEligibility(ctx context.Context, user *User, apiKey *APIKey, group *Group, subscription *UserSubscription, model string) error {
 	// 简易模式：跳过所有计费检查
 	if s.cfg.RunMode == config.RunModeSimple {
 		return nil
@@ -457,14 +602,49 @@ func (s *BillingCacheService) CheckBillingEligibility(ctx context.Context, user
 		return ErrBillingServiceUnavailable
 	}
 
+	// Key 级限额独立于余额/订阅模式
+	if apiKey.HasUsageLimits() {
+		if err := s.checkAPIKeyEligibility(ctx, apiKey); err != nil {
+			return err
+		}
+	}
+
+	// 组织 Key：先检查成员月度限额，余额与订阅按组织计费账户检查
+	if apiKey != nil {
+		if err := apiKey.OrganizationBilling.CheckSpendCap(); err != nil {
+			return err
+		}
+	}
+	payer := apiKey.Payer(user)
+
 	// 判断计费模式
 	isSubscriptionMode := group != nil && group.IsSubscriptionType() && subscription != nil
 
 	if isSubscriptionMode {
-		return s.checkSubscriptionEligibility(ctx, user.ID, group, subscription)
+		// 配额为硬限制，超额计费不适用
+		if err := checkUsageQuotas(group, subscription, model); err != nil {
+			return err
+		}
+		return s.checkSubscriptionEligibility(ctx, payer.ID, group, subscription)
 	}
 
-	return s.checkBalanceEligibility(ctx, user.ID)
+	return s.checkBalanceEligibility(ctx, payer.ID)
+}
+
+// checkAPIKeyEligibility 检查 API Key 消费/请求次数限额
+func (s *BillingCacheService) checkAPIKeyEligibility(ctx context.Context, apiKey *APIKey) error {
+	usage, err := s.GetAPIKeyLimitUsage(ctx, apiKey.ID)
+	if err != nil {
+		if s.circuitBreaker != nil {
+			s.circuitBreaker.OnFailure(err)
+		}
+		log.Printf("ALERT: billing api key limit check failed for api key %d: %v", apiKey.ID, err)
+		return ErrBillingServiceUnavailable.WithCause(err)
+	}
+	if s.circuitBreaker != nil {
+		s.circuitBreaker.OnSuccess()
+	}
+	return usage.CheckLimits(apiKey)
 }
 
 // checkBalanceEligibility 检查余额模式资格
@@ -513,20 +693,38 @@ func (s *BillingCacheService) checkSubscriptionEligibility(ctx context.Context,
 		return ErrSubscriptionInvalid
 	}
 
-	// 检查限额（使用传入的Group限额配置）
-	if group.HasDailyLimit() && subData.DailyUsage >= *group.DailyLimitUSD {
-		return ErrDailyLimitExceeded
-	}
-
-	if group.HasWeeklyLimit() && subData.WeeklyUsage >= *group.WeeklyLimitUSD {
-		return ErrWeeklyLimitExceeded
+	// 检查限额（使用传入的Group限额配置，套餐订阅的限额快照优先）
+	effective := subscription.EffectiveGroup(group)
+	var limitErr error
+	switch {
+	case effective.HasDailyLimit() && subData.DailyUsage >= *effective.DailyLimitUSD:
+		limitErr = ErrDailyLimitExceeded
+	case effective.HasWeeklyLimit() && subData.WeeklyUsage >= *effective.WeeklyLimitUSD:
+		limitErr = ErrWeeklyLimitExceeded
+	case effective.HasMonthlyLimit() && subData.MonthlyUsage >= *effective.MonthlyLimitUSD:
+		limitErr = ErrMonthlyLimitExceeded
+	}
+
+	// 滚动窗口限额：用量仅存于 Redis 分桶，读取失败时与其他计费检查一样拒绝请求
+	if limitErr == nil && effective.HasRollingWindowLimit() {
+		usage, err := s.GetRollingWindowUsage(ctx, userID, effective)
+		if err != nil {
+			if s.circuitBreaker != nil {
+				s.circuitBreaker.OnFailure(err)
+			}
+			log.Printf("ALERT: billing rolling window check failed for user %d group %d: %v", userID, group.ID, err)
+			return ErrBillingServiceUnavailable.WithCause(err)
+		}
+		if usage.Exceeded() {
+			limitErr = ErrRollingWindowLimitExceeded
+		}
 	}
 
-	if group.HasMonthlyLimit() && subData.MonthlyUsage >= *group.MonthlyLimitUSD {
-		return ErrMonthlyLimitExceeded
+	// 超额计费：限额用尽后改为检查余额，超出部分从余额扣费
+	if limitErr != nil && group.AllowsOverage() {
+		return s.checkBalanceEligibility(ctx, userID)
 	}
-
-	return nil
+	return limitErr
 }
 
 type billingCircuitBreakerState int
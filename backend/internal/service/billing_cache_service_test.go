@@ -14,6 +14,9 @@ import (
 type billingCacheWorkerStub struct {
 	balanceUpdates      int64
 	subscriptionUpdates int64
+	rollingSpendUpdates int64
+	apiKeyUsageUpdates  int64
+	rollingWindowAdds   int64
 }
 
 func (b *billingCacheWorkerStub) GetUserBalance(ctx context.Context, userID int64) (float64, error) {
@@ -52,9 +55,49 @@ func (b *billingCacheWorkerStub) InvalidateSubscriptionCache(ctx context.Context
 	return nil
 }
 
+func (b *billingCacheWorkerStub) GetAPIKeyUsageCache(ctx context.Context, apiKeyID int64) (*APIKeyLimitUsage, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (b *billingCacheWorkerStub) SetAPIKeyUsageCache(ctx context.Context, apiKeyID int64, data *APIKeyLimitUsage) error {
+	atomic.AddInt64(&b.apiKeyUsageUpdates, 1)
+	return nil
+}
+
+func (b *billingCacheWorkerStub) UpdateAPIKeyUsage(ctx context.Context, apiKeyID int64, cost float64) error {
+	atomic.AddInt64(&b.apiKeyUsageUpdates, 1)
+	return nil
+}
+
+func (b *billingCacheWorkerStub) GetUserRollingSpend(ctx context.Context, userID int64) (float64, error) {
+	return 0, errors.New("not implemented")
+}
+
+func (b *billingCacheWorkerStub) SetUserRollingSpend(ctx context.Context, userID int64, spend float64) error {
+	atomic.AddInt64(&b.rollingSpendUpdates, 1)
+	return nil
+}
+
+func (b *billingCacheWorkerStub) ReserveBalance(ctx context.Context, userID int64, holdID string, amount, balance float64, ttl time.Duration) (bool, error) {
+	return true, nil
+}
+
+func (b *billingCacheWorkerStub) ReleaseBalanceHold(ctx context.Context, userID int64, holdID string) error {
+	return nil
+}
+
+func (b *billingCacheWorkerStub) AddRollingWindowUsage(ctx context.Context, userID, groupID int64, bucketStart, cutoff time.Time, cost float64, window time.Duration) error {
+	atomic.AddInt64(&b.rollingWindowAdds, 1)
+	return nil
+}
+
+func (b *billingCacheWorkerStub) GetRollingWindowBuckets(ctx context.Context, userID, groupID int64) (map[int64]float64, error) {
+	return nil, nil
+}
+
 func TestBillingCacheServiceQueueHighLoad(t *testing.T) {
 	cache := &billingCacheWorkerStub{}
-	svc := NewBillingCacheService(cache, nil, nil, &config.Config{})
+	svc := NewBillingCacheService(cache, nil, nil, nil, &config.Config{})
 	t.Cleanup(svc.Stop)
 
 	start := time.Now()
@@ -64,6 +107,8 @@ func TestBillingCacheServiceQueueHighLoad(t *testing.T) {
 	require.Less(t, time.Since(start), 2*time.Second)
 
 	svc.QueueUpdateSubscriptionUsage(1, 2, 1.5)
+	rollingLimit := 10.0
+	svc.QueueAddRollingWindowUsage(1, &Group{ID: 2, RollingWindowHours: 5, RollingWindowLimitUSD: &rollingLimit}, 1.5)
 
 	require.Eventually(t, func() bool {
 		return atomic.LoadInt64(&cache.balanceUpdates) > 0
@@ -72,4 +117,7 @@ func TestBillingCacheServiceQueueHighLoad(t *testing.T) {
 	require.Eventually(t, func() bool {
 		return atomic.LoadInt64(&cache.subscriptionUpdates) > 0
 	}, 2*time.Second, 10*time.Millisecond)
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt64(&cache.rollingWindowAdds) > 0
+	}, 2*time.Second, 10*time.Millisecond)
 }
@@ -2,10 +2,14 @@ package service
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"math"
 
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Wei-Shaw/sub2api/internal/config"
 )
@@ -23,6 +27,26 @@ type BillingCache interface {
 	SetSubscriptionCache(ctx context.Context, userID, groupID int64, data *SubscriptionCacheData) error
 	UpdateSubscriptionUsage(ctx context.Context, userID, groupID int64, cost float64) error
 	InvalidateSubscriptionCache(ctx context.Context, userID, groupID int64) error
+
+	// API key usage operations（Key 级限额使用的日/月/累计用量）
+	GetAPIKeyUsageCache(ctx context.Context, apiKeyID int64) (*APIKeyLimitUsage, error)
+	SetAPIKeyUsageCache(ctx context.Context, apiKeyID int64, data *APIKeyLimitUsage) error
+	UpdateAPIKeyUsage(ctx context.Context, apiKeyID int64, cost float64) error
+
+	// Rolling spend operations（阶梯折扣分档使用的近 30 天消费）
+	GetUserRollingSpend(ctx context.Context, userID int64) (float64, error)
+	SetUserRollingSpend(ctx context.Context, userID int64, spend float64) error
+
+	// Balance reservation operations（在途请求的余额预留）
+	// ReserveBalance 在 balance 扣除未过期预留后仍足以覆盖 amount 时写入预留并返回 true
+	ReserveBalance(ctx context.Context, userID int64, holdID string, amount, balance float64, ttl time.Duration) (bool, error)
+	ReleaseBalanceHold(ctx context.Context, userID int64, holdID string) error
+
+	// Rolling window operations（订阅滚动窗口限额的分桶用量）
+	// AddRollingWindowUsage 累加 bucketStart 所在桶的用量，并清理起始时间不晚于 cutoff 的过期桶
+	AddRollingWindowUsage(ctx context.Context, userID, groupID int64, bucketStart, cutoff time.Time, cost float64, window time.Duration) error
+	// GetRollingWindowBuckets 返回分桶用量，key 为桶起始 Unix 秒
+	GetRollingWindowBuckets(ctx context.Context, userID, groupID int64) (map[int64]float64, error)
 }
 
 // ModelPricing 模型价格配置（per-token价格，与LiteLLM格式一致）
@@ -34,6 +58,7 @@ type ModelPricing struct {
 	CacheCreation5mPrice       float64 // 5分钟缓存创建价格（每百万token）- 仅用于硬编码回退
 	CacheCreation1hPrice       float64 // 1小时缓存创建价格（每百万token）- 仅用于硬编码回退
 	SupportsCacheBreakdown     bool    // 是否支持详细的缓存分类
+	Source                     string  // 价格来源：litellm / fallback / override:<id>
 }
 
 // UsageTokens 使用的token数量
@@ -54,20 +79,85 @@ type CostBreakdown struct {
 	CacheReadCost     float64
 	TotalCost         float64
 	ActualCost        float64 // 应用倍率后的实际费用
+
+	Pricing *AppliedPricing // 计费时使用的价格快照
+}
+
+// 价格来源
+const (
+	PricingSourceLiteLLM  = "litellm"
+	PricingSourceFallback = "fallback"
+	PricingSourceGroup    = "group"   // 分组配置的图片价格
+	PricingSourceDefault  = "default" // 图片硬编码默认价格
+)
+
+// AppliedPricing 计费时实际使用的单价（token 类为 USD/百万 token，图片为 USD/张）
+// Version 由价格来源与单价哈希组成，价格调整后随之变化，用于定位按旧价格计费的用量记录
+type AppliedPricing struct {
+	Version            string
+	InputPrice         *float64
+	OutputPrice        *float64
+	CacheCreationPrice *float64
+	CacheReadPrice     *float64
+	ImagePrice         *float64
+}
+
+func newTokenAppliedPricing(p *ModelPricing) *AppliedPricing {
+	input := perMillion(p.InputPricePerToken)
+	output := perMillion(p.OutputPricePerToken)
+	cacheCreation := perMillion(p.CacheCreationPricePerToken)
+	cacheRead := perMillion(p.CacheReadPricePerToken)
+	prices := []float64{input, output, cacheCreation, cacheRead}
+	if p.SupportsCacheBreakdown {
+		prices = append(prices, p.CacheCreation5mPrice, p.CacheCreation1hPrice)
+	}
+	return &AppliedPricing{
+		Version:            pricingVersion(p.Source, prices...),
+		InputPrice:         &input,
+		OutputPrice:        &output,
+		CacheCreationPrice: &cacheCreation,
+		CacheReadPrice:     &cacheRead,
+	}
+}
+
+func newImageAppliedPricing(source string, unitPrice float64) *AppliedPricing {
+	return &AppliedPricing{
+		Version:    pricingVersion(source, unitPrice),
+		ImagePrice: &unitPrice,
+	}
+}
+
+// perMillion 将 per-token 价格换算为每百万 token 价格，并消除浮点误差
+func perMillion(perToken float64) float64 {
+	return math.Round(perToken*1_000_000*1e10) / 1e10
+}
+
+// pricingVersion 生成价格版本：<来源>:<单价哈希前 12 位>
+func pricingVersion(source string, prices ...float64) string {
+	if source == "" {
+		source = "unknown"
+	}
+	h := sha256.New()
+	for _, p := range prices {
+		_, _ = fmt.Fprintf(h, "%.10f|", p)
+	}
+	return source + ":" + hex.EncodeToString(h.Sum(nil))[:12]
 }
 
 // BillingService 计费服务
 type BillingService struct {
 	cfg            *config.Config
 	pricingService *PricingService
-	fallbackPrices map[string]*ModelPricing // 硬编码回退价格
+	priceOverrides *ModelPriceOverrideService // 管理员价格覆盖（优先级最高）
+	fallbackPrices map[string]*ModelPricing   // 硬编码回退价格
 }
 
 // NewBillingService 创建计费服务实例
-func NewBillingService(cfg *config.Config, pricingService *PricingService) *BillingService {
+func NewBillingService(cfg *config.Config, pricingService *PricingService, priceOverrides *ModelPriceOverrideService) *BillingService {
 	s := &BillingService{
 		cfg:            cfg,
 		pricingService: pricingService,
+		priceOverrides: priceOverrides,
 		fallbackPrices: make(map[string]*ModelPricing),
 	}
 
@@ -163,8 +253,30 @@ func (s *BillingService) getFallbackPricing(model string) *ModelPricing {
 	return s.fallbackPrices["claude-sonnet-4"]
 }
 
-// GetModelPricing 获取模型价格配置
+// GetModelPricing 获取模型价格配置（仅匹配全局价格覆盖）
 func (s *BillingService) GetModelPricing(model string) (*ModelPricing, error) {
+	return s.GetModelPricingForGroup(model, nil)
+}
+
+// GetModelPricingForGroup 获取模型在指定分组下的价格配置
+// 管理员价格覆盖优先（分组级优先于全局），未覆盖的字段沿用动态价格或硬编码回退价格
+func (s *BillingService) GetModelPricingForGroup(model string, groupID *int64) (*ModelPricing, error) {
+	return s.getModelPricingAt(model, groupID, time.Now())
+}
+
+// getModelPricingAt 获取模型在 at 时刻生效的价格配置（价格覆盖按生效时间匹配）
+func (s *BillingService) getModelPricingAt(model string, groupID *int64, at time.Time) (*ModelPricing, error) {
+	pricing, err := s.getBaseModelPricing(model)
+	if override := s.priceOverrides.Resolve(model, groupID, at); override != nil {
+		if pricing == nil {
+			pricing = &ModelPricing{}
+		}
+		return override.apply(pricing), nil
+	}
+	return pricing, err
+}
+
+func (s *BillingService) getBaseModelPricing(model string) (*ModelPricing, error) {
 	// 标准化模型名称（转小写）
 	model = strings.ToLower(model)
 
@@ -178,6 +290,7 @@ func (s *BillingService) GetModelPricing(model string) (*ModelPricing, error) {
 				CacheCreationPricePerToken: litellmPricing.CacheCreationInputTokenCost,
 				CacheReadPricePerToken:     litellmPricing.CacheReadInputTokenCost,
 				SupportsCacheBreakdown:     false,
+				Source:                     PricingSourceLiteLLM,
 			}, nil
 		}
 	}
@@ -186,7 +299,9 @@ func (s *BillingService) GetModelPricing(model string) (*ModelPricing, error) {
 	fallback := s.getFallbackPricing(model)
 	if fallback != nil {
 		log.Printf("[Billing] Using fallback pricing for model: %s", model)
-		return fallback, nil
+		pricing := *fallback
+		pricing.Source = PricingSourceFallback
+		return &pricing, nil
 	}
 
 	return nil, fmt.Errorf("pricing not found for model: %s", model)
@@ -194,12 +309,22 @@ func (s *BillingService) GetModelPricing(model string) (*ModelPricing, error) {
 
 // CalculateCost 计算使用费用
 func (s *BillingService) CalculateCost(model string, tokens UsageTokens, rateMultiplier float64) (*CostBreakdown, error) {
-	pricing, err := s.GetModelPricing(model)
+	return s.CalculateCostForGroup(model, nil, tokens, rateMultiplier)
+}
+
+// CalculateCostForGroup 按分组价格覆盖计算使用费用（groupID 为 nil 时仅使用全局覆盖）
+func (s *BillingService) CalculateCostForGroup(model string, groupID *int64, tokens UsageTokens, rateMultiplier float64) (*CostBreakdown, error) {
+	return s.CalculateCostAt(model, groupID, tokens, rateMultiplier, time.Now())
+}
+
+// CalculateCostAt 按 at 时刻生效的价格计算使用费用（用于按用量发生时间重新计费）
+func (s *BillingService) CalculateCostAt(model string, groupID *int64, tokens UsageTokens, rateMultiplier float64, at time.Time) (*CostBreakdown, error) {
+	pricing, err := s.getModelPricingAt(model, groupID, at)
 	if err != nil {
 		return nil, err
 	}
 
-	breakdown := &CostBreakdown{}
+	breakdown := &CostBreakdown{Pricing: newTokenAppliedPricing(pricing)}
 
 	// 计算输入token费用（使用per-token价格）
 	breakdown.InputCost = float64(tokens.InputTokens) * pricing.InputPricePerToken
@@ -208,7 +333,8 @@ func (s *BillingService) CalculateCost(model string, tokens UsageTokens, rateMul
 	breakdown.OutputCost = float64(tokens.OutputTokens) * pricing.OutputPricePerToken
 
 	// 计算缓存费用
-	if pricing.SupportsCacheBreakdown && (pricing.CacheCreation5mPrice > 0 || pricing.CacheCreation1hPrice > 0) {
+	hasCacheBreakdownTokens := tokens.CacheCreation5mTokens > 0 || tokens.CacheCreation1hTokens > 0
+	if pricing.SupportsCacheBreakdown && hasCacheBreakdownTokens && (pricing.CacheCreation5mPrice > 0 || pricing.CacheCreation1hPrice > 0) {
 		// 支持详细缓存分类的模型（5分钟/1小时缓存）
 		breakdown.CacheCreationCost = float64(tokens.CacheCreation5mTokens)/1_000_000*pricing.CacheCreation5mPrice +
 			float64(tokens.CacheCreation1hTokens)/1_000_000*pricing.CacheCreation1hPrice
@@ -301,6 +427,7 @@ type ImagePriceConfig struct {
 	Price1K *float64 // 1K 尺寸价格（nil 表示使用默认值）
 	Price2K *float64 // 2K 尺寸价格（nil 表示使用默认值）
 	Price4K *float64 // 4K 尺寸价格（nil 表示使用默认值）
+	GroupID *int64   // 分组 ID（用于匹配分组级价格覆盖）
 }
 
 // CalculateImageCost 计算图片生成费用
@@ -310,12 +437,17 @@ type ImagePriceConfig struct {
 // groupConfig: 分组配置的价格（可能为 nil，表示使用默认值）
 // rateMultiplier: 费率倍数
 func (s *BillingService) CalculateImageCost(model string, imageSize string, imageCount int, groupConfig *ImagePriceConfig, rateMultiplier float64) *CostBreakdown {
+	return s.CalculateImageCostAt(model, imageSize, imageCount, groupConfig, rateMultiplier, time.Now())
+}
+
+// CalculateImageCostAt 按 at 时刻生效的价格计算图片生成费用
+func (s *BillingService) CalculateImageCostAt(model string, imageSize string, imageCount int, groupConfig *ImagePriceConfig, rateMultiplier float64, at time.Time) *CostBreakdown {
 	if imageCount <= 0 {
 		return &CostBreakdown{}
 	}
 
 	// 获取单价
-	unitPrice := s.getImageUnitPrice(model, imageSize, groupConfig)
+	unitPrice, source := s.getImageUnitPrice(model, imageSize, groupConfig, at)
 
 	// 计算总费用
 	totalCost := unitPrice * float64(imageCount)
@@ -329,54 +461,68 @@ func (s *BillingService) CalculateImageCost(model string, imageSize string, imag
 	return &CostBreakdown{
 		TotalCost:  totalCost,
 		ActualCost: actualCost,
+		Pricing:    newImageAppliedPricing(source, unitPrice),
 	}
 }
 
-// getImageUnitPrice 获取图片单价
-func (s *BillingService) getImageUnitPrice(model string, imageSize string, groupConfig *ImagePriceConfig) float64 {
+// getImageUnitPrice 获取图片单价及价格来源
+func (s *BillingService) getImageUnitPrice(model string, imageSize string, groupConfig *ImagePriceConfig, at time.Time) (float64, string) {
 	// 优先使用分组配置的价格
 	if groupConfig != nil {
 		switch imageSize {
 		case "1K":
 			if groupConfig.Price1K != nil {
-				return *groupConfig.Price1K
+				return *groupConfig.Price1K, PricingSourceGroup
 			}
 		case "2K":
 			if groupConfig.Price2K != nil {
-				return *groupConfig.Price2K
+				return *groupConfig.Price2K, PricingSourceGroup
 			}
 		case "4K":
 			if groupConfig.Price4K != nil {
-				return *groupConfig.Price4K
+				return *groupConfig.Price4K, PricingSourceGroup
 			}
 		}
 	}
 
-	// 回退到 LiteLLM 默认价格
-	return s.getDefaultImagePrice(model, imageSize)
+	// 回退到价格覆盖 / LiteLLM 默认价格
+	var groupID *int64
+	if groupConfig != nil {
+		groupID = groupConfig.GroupID
+	}
+	return s.getDefaultImagePrice(model, imageSize, groupID, at)
 }
 
-// getDefaultImagePrice 获取 LiteLLM 默认图片价格
-func (s *BillingService) getDefaultImagePrice(model string, imageSize string) float64 {
+// getDefaultImagePrice 获取默认图片价格（管理员价格覆盖优先，其次 LiteLLM）
+func (s *BillingService) getDefaultImagePrice(model string, imageSize string, groupID *int64, at time.Time) (float64, string) {
 	basePrice := 0.0
+	source := PricingSourceDefault
 
-	// 从 PricingService 获取 output_cost_per_image
-	if s.pricingService != nil {
-		pricing := s.pricingService.GetModelPricing(model)
-		if pricing != nil && pricing.OutputCostPerImage > 0 {
-			basePrice = pricing.OutputCostPerImage
+	// 管理员覆盖的图片价格可以为 0（免费），此时不再回退到默认值
+	override := s.priceOverrides.Resolve(model, groupID, at)
+	if override != nil && override.ImagePrice != nil {
+		basePrice = *override.ImagePrice
+		source = override.pricingSource()
+	} else {
+		// 从 PricingService 获取 output_cost_per_image
+		if s.pricingService != nil {
+			pricing := s.pricingService.GetModelPricing(model)
+			if pricing != nil && pricing.OutputCostPerImage > 0 {
+				basePrice = pricing.OutputCostPerImage
+				source = PricingSourceLiteLLM
+			}
 		}
-	}
 
-	// 如果没有找到价格，使用硬编码默认值（$0.134，来自 gemini-3-pro-image-preview）
-	if basePrice <= 0 {
-		basePrice = 0.134
+		// 如果没有找到价格，使用硬编码默认值（$0.134，来自 gemini-3-pro-image-preview）
+		if basePrice <= 0 {
+			basePrice = 0.134
+		}
 	}
 
 	// 4K 尺寸翻倍
 	if imageSize == "4K" {
-		return basePrice * 2
+		return basePrice * 2, source
 	}
 
-	return basePrice
+	return basePrice, source
 }
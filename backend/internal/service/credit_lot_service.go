@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	creditExpiryWorkerName    = "credit_lot_expiry_worker"
+	creditExpiryLeaderLockKey = "credit:lots:expiry:leader"
+	creditExpiryInterval      = 5 * time.Minute
+	creditExpiryTimeout       = 2 * time.Minute
+	creditExpiryBatchSize     = 200
+
+	// CreditExpiringSoonWindow 用户接口中“即将过期”额度的统计窗口
+	CreditExpiringSoonWindow = 30 * 24 * time.Hour
+)
+
+// CreditLot 余额额度批次
+// 每笔入账形成一个批次，消费优先扣减最早到期的批次；到期后剩余额度由过期任务清零并写入 expiry 流水。
+type CreditLot struct {
+	ID                   int64      `json:"id"`
+	UserID               int64      `json:"user_id"`
+	Source               string     `json:"source"` // 产生该批次的流水类型
+	Amount               float64    `json:"amount"`
+	Remaining            float64    `json:"remaining"`
+	ExpiresAt            *time.Time `json:"expires_at,omitempty"`
+	ExpiredAt            *time.Time `json:"expired_at,omitempty"`
+	BalanceTransactionID *int64     `json:"balance_transaction_id,omitempty"`
+	CreatedAt            time.Time  `json:"created_at"`
+	UpdatedAt            time.Time  `json:"updated_at"`
+}
+
+// CreditLotSummary 用户的有效额度批次及到期概览
+type CreditLotSummary struct {
+	Lots          []CreditLot `json:"lots"`
+	NextExpiresAt *time.Time  `json:"next_expires_at,omitempty"`
+	ExpiringSoon  float64     `json:"expiring_soon"` // CreditExpiringSoonWindow 内到期的剩余额度
+	NonExpiring   float64     `json:"non_expiring"`  // 永不过期的剩余额度
+}
+
+// CreditLotRepository 额度批次存储
+// 批次的创建与扣减由 UserRepository.ApplyBalanceChange 在余额变动的同一事务中完成
+type CreditLotRepository interface {
+	// ListOpenByUser 返回有剩余额度且未过期处理的批次，按扣减顺序（到期时间升序，永不过期在后）
+	ListOpenByUser(ctx context.Context, userID int64) ([]CreditLot, error)
+	// ListDueIDs 返回到期未处理且有剩余额度的批次 ID
+	ListDueIDs(ctx context.Context, now time.Time, limit int) ([]int64, error)
+	// Expire 清零到期批次的剩余额度并写入 expiry 流水；批次无需处理时返回 nil
+	Expire(ctx context.Context, lotID int64) (*BalanceTransaction, error)
+}
+
+// creditExpiresAt 按有效天数计算额度批次到期时间，<=0 表示永不过期
+func creditExpiresAt(now time.Time, validityDays int) *time.Time {
+	if validityDays <= 0 {
+		return nil
+	}
+	t := now.AddDate(0, 0, validityDays)
+	return &t
+}
+
+// CreditLotService 额度批次查询与到期处理
+type CreditLotService struct {
+	repo                 CreditLotRepository
+	billingCacheService  *BillingCacheService
+	authCacheInvalidator APIKeyAuthCacheInvalidator
+	timingWheel          *TimingWheelService
+	db                   *sql.DB
+
+	running   int32
+	startOnce sync.Once
+	stopOnce  sync.Once
+}
+
+func NewCreditLotService(
+	repo CreditLotRepository,
+	billingCacheService *BillingCacheService,
+	authCacheInvalidator APIKeyAuthCacheInvalidator,
+	timingWheel *TimingWheelService,
+	db *sql.DB,
+) *CreditLotService {
+	return &CreditLotService{
+		repo:                 repo,
+		billingCacheService:  billingCacheService,
+		authCacheInvalidator: authCacheInvalidator,
+		timingWheel:          timingWheel,
+		db:                   db,
+	}
+}
+
+func (s *CreditLotService) Start() {
+	if s == nil {
+		return
+	}
+	if s.repo == nil || s.timingWheel == nil {
+		log.Printf("[CreditLot] expiry worker not started (missing deps)")
+		return
+	}
+	s.startOnce.Do(func() {
+		s.timingWheel.ScheduleRecurring(creditExpiryWorkerName, creditExpiryInterval, s.runOnce)
+		log.Printf("[CreditLot] expiry worker started (interval=%s)", creditExpiryInterval)
+	})
+}
+
+func (s *CreditLotService) Stop() {
+	if s == nil {
+		return
+	}
+	s.stopOnce.Do(func() {
+		if s.timingWheel != nil {
+			s.timingWheel.Cancel(creditExpiryWorkerName)
+		}
+		log.Printf("[CreditLot] expiry worker stopped")
+	})
+}
+
+// GetUserSummary 返回用户的有效额度批次与即将到期的额度
+func (s *CreditLotService) GetUserSummary(ctx context.Context, userID int64) (*CreditLotSummary, error) {
+	lots, err := s.repo.ListOpenByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list credit lots: %w", err)
+	}
+	return summarizeCreditLots(lots, time.Now()), nil
+}
+
+func summarizeCreditLots(lots []CreditLot, now time.Time) *CreditLotSummary {
+	summary := &CreditLotSummary{Lots: lots}
+	if summary.Lots == nil {
+		summary.Lots = []CreditLot{}
+	}
+	soon := now.Add(CreditExpiringSoonWindow)
+	for i := range lots {
+		lot := &lots[i]
+		if lot.ExpiresAt == nil {
+			summary.NonExpiring += lot.Remaining
+			continue
+		}
+		if summary.NextExpiresAt == nil || lot.ExpiresAt.Before(*summary.NextExpiresAt) {
+			t := *lot.ExpiresAt
+			summary.NextExpiresAt = &t
+		}
+		if !lot.ExpiresAt.After(soon) {
+			summary.ExpiringSoon += lot.Remaining
+		}
+	}
+	return summary
+}
+
+// ExpireDueLots 处理到期批次，返回清零的批次数
+func (s *CreditLotService) ExpireDueLots(ctx context.Context) (int, error) {
+	expired := 0
+	for {
+		ids, err := s.repo.ListDueIDs(ctx, time.Now(), creditExpiryBatchSize)
+		if err != nil {
+			return expired, fmt.Errorf("list due credit lots: %w", err)
+		}
+		if len(ids) == 0 {
+			return expired, nil
+		}
+		progressed := false
+		for _, id := range ids {
+			if err := ctx.Err(); err != nil {
+				return expired, err
+			}
+			tx, err := s.repo.Expire(ctx, id)
+			if err != nil {
+				log.Printf("[CreditLot] expire lot %d failed: %v", id, err)
+				continue
+			}
+			progressed = true
+			if tx == nil {
+				continue
+			}
+			expired++
+			s.invalidateUserCaches(ctx, tx.UserID)
+		}
+		// 整批失败时避免重复处理同一批次
+		if !progressed || len(ids) < creditExpiryBatchSize {
+			return expired, nil
+		}
+	}
+}
+
+func (s *CreditLotService) invalidateUserCaches(ctx context.Context, userID int64) {
+	if s.authCacheInvalidator != nil {
+		s.authCacheInvalidator.InvalidateAuthCacheByUserID(ctx, userID)
+	}
+	if s.billingCacheService != nil {
+		if err := s.billingCacheService.InvalidateUserBalance(ctx, userID); err != nil {
+			log.Printf("[CreditLot] invalidate balance cache for user %d failed: %v", userID, err)
+		}
+	}
+}
+
+func (s *CreditLotService) runOnce() {
+	if !atomic.CompareAndSwapInt32(&s.running, 0, 1) {
+		return
+	}
+	defer atomic.StoreInt32(&s.running, 0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), creditExpiryTimeout)
+	defer cancel()
+
+	if s.db != nil {
+		release, ok := tryAcquireDBAdvisoryLock(ctx, s.db, hashAdvisoryLockID(creditExpiryLeaderLockKey))
+		if !ok {
+			return
+		}
+		defer release()
+	}
+
+	expired, err := s.ExpireDueLots(ctx)
+	if err != nil {
+		log.Printf("[CreditLot] expiry run failed: %v", err)
+	}
+	if expired > 0 {
+		log.Printf("[CreditLot] expired %d credit lot(s)", expired)
+	}
+}
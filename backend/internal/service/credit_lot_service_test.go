@@ -0,0 +1,120 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type creditLotRepoStub struct {
+	lots     []CreditLot
+	due      []int64
+	expired  []int64
+	failIDs  map[int64]bool
+	listErr  error
+	listDues int
+}
+
+func (s *creditLotRepoStub) ListOpenByUser(ctx context.Context, userID int64) ([]CreditLot, error) {
+	return s.lots, s.listErr
+}
+
+func (s *creditLotRepoStub) ListDueIDs(ctx context.Context, now time.Time, limit int) ([]int64, error) {
+	s.listDues++
+	n := len(s.due)
+	if n > limit {
+		n = limit
+	}
+	return append([]int64(nil), s.due[:n]...), nil
+}
+
+func (s *creditLotRepoStub) Expire(ctx context.Context, lotID int64) (*BalanceTransaction, error) {
+	if s.failIDs[lotID] {
+		return nil, errors.New("lock timeout")
+	}
+	for i, id := range s.due {
+		if id == lotID {
+			s.due = append(s.due[:i], s.due[i+1:]...)
+			break
+		}
+	}
+	if lotID%2 == 0 {
+		// 偶数 ID 模拟已无剩余额度的批次
+		return nil, nil
+	}
+	s.expired = append(s.expired, lotID)
+	return &BalanceTransaction{UserID: 100 + lotID, Type: BalanceTxTypeExpiry, CreditLotID: &lotID}, nil
+}
+
+type creditLotAuthInvalidatorStub struct {
+	userIDs []int64
+}
+
+func (s *creditLotAuthInvalidatorStub) InvalidateAuthCacheByKey(ctx context.Context, key string) {}
+func (s *creditLotAuthInvalidatorStub) InvalidateAuthCacheByUserID(ctx context.Context, userID int64) {
+	s.userIDs = append(s.userIDs, userID)
+}
+func (s *creditLotAuthInvalidatorStub) InvalidateAuthCacheByGroupID(ctx context.Context, groupID int64) {
+}
+
+func TestCreditLotService_ExpireDueLots(t *testing.T) {
+	repo := &creditLotRepoStub{due: []int64{1, 2, 3}}
+	invalidator := &creditLotAuthInvalidatorStub{}
+	svc := NewCreditLotService(repo, nil, invalidator, nil, nil)
+
+	expired, err := svc.ExpireDueLots(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, expired)
+	require.Equal(t, []int64{1, 3}, repo.expired)
+	require.Equal(t, []int64{101, 103}, invalidator.userIDs)
+
+	// 全部处理失败时本轮结束，等待下次调度重试
+	repo = &creditLotRepoStub{due: []int64{5}, failIDs: map[int64]bool{5: true}}
+	svc = NewCreditLotService(repo, nil, nil, nil, nil)
+	expired, err = svc.ExpireDueLots(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, expired)
+	require.Equal(t, 1, repo.listDues)
+}
+
+func TestCreditLotService_GetUserSummary(t *testing.T) {
+	now := time.Now()
+	soon := now.Add(7 * 24 * time.Hour)
+	later := now.Add(90 * 24 * time.Hour)
+	repo := &creditLotRepoStub{lots: []CreditLot{
+		{ID: 1, Remaining: 2, ExpiresAt: &soon},
+		{ID: 2, Remaining: 3, ExpiresAt: &later},
+		{ID: 3, Remaining: 10},
+	}}
+	svc := NewCreditLotService(repo, nil, nil, nil, nil)
+
+	summary, err := svc.GetUserSummary(context.Background(), 1)
+	require.NoError(t, err)
+	require.Len(t, summary.Lots, 3)
+	require.NotNil(t, summary.NextExpiresAt)
+	require.True(t, summary.NextExpiresAt.Equal(soon))
+	require.InDelta(t, 2, summary.ExpiringSoon, 1e-9)
+	require.InDelta(t, 10, summary.NonExpiring, 1e-9)
+
+	// 无批次时返回空列表
+	repo.lots = nil
+	summary, err = svc.GetUserSummary(context.Background(), 1)
+	require.NoError(t, err)
+	require.NotNil(t, summary.Lots)
+	require.Empty(t, summary.Lots)
+	require.Nil(t, summary.NextExpiresAt)
+}
+
+func TestCreditExpiresAt(t *testing.T) {
+	now := time.Date(2026, 1, 31, 12, 0, 0, 0, time.UTC)
+	require.Nil(t, creditExpiresAt(now, 0))
+	require.Nil(t, creditExpiresAt(now, -1))
+	got := creditExpiresAt(now, 30)
+	require.NotNil(t, got)
+	require.True(t, got.Equal(now.AddDate(0, 0, 30)))
+}
@@ -44,6 +44,12 @@ const (
 	PromoCodeStatusDisabled = "disabled"
 )
 
+// PromoCode scope constants
+const (
+	PromoCodeScopeRegister = "register" // 注册时使用
+	PromoCodeScopeTopup    = "topup"    // 在线充值时使用
+)
+
 // Admin adjustment type constants
 const (
 	AdjustmentTypeAdminBalance     = "admin_balance"     // 管理员调整余额
@@ -61,6 +67,7 @@ const (
 	SubscriptionStatusActive    = "active"
 	SubscriptionStatusExpired   = "expired"
 	SubscriptionStatusSuspended = "suspended"
+	SubscriptionStatusPaused    = "paused"
 )
 
 // LinuxDoConnectSyntheticEmailDomain 是 LinuxDo Connect 用户的合成邮箱后缀（RFC 保留域名）。
@@ -12,7 +12,11 @@ import (
 type EmailTask struct {
 	Email    string
 	SiteName string
-	TaskType string // "verify_code"
+	TaskType string // "verify_code" / "statement" / "notification"
+
+	// Subject/Body 用于直接发送已渲染内容的任务（如月度账单、阈值通知）
+	Subject string
+	Body    string
 }
 
 // EmailQueueService 异步邮件队列服务
@@ -79,6 +83,12 @@ func (s *EmailQueueService) processTask(workerID int, task EmailTask) {
 		} else {
 			log.Printf("[EmailQueue] Worker %d sent verify code to %s", workerID, task.Email)
 		}
+	case "statement", "notification":
+		if err := s.emailService.SendEmail(ctx, task.Email, task.Subject, task.Body); err != nil {
+			log.Printf("[EmailQueue] Worker %d failed to send %s to %s: %v", workerID, task.TaskType, task.Email, err)
+		} else {
+			log.Printf("[EmailQueue] Worker %d sent %s to %s", workerID, task.TaskType, task.Email)
+		}
 	default:
 		log.Printf("[EmailQueue] Worker %d unknown task type: %s", workerID, task.TaskType)
 	}
@@ -101,6 +111,40 @@ func (s *EmailQueueService) EnqueueVerifyCode(email, siteName string) error {
 	}
 }
 
+// EnqueueStatement 将月度账单邮件加入队列（body 为 HTML）
+func (s *EmailQueueService) EnqueueStatement(email, subject, body string) error {
+	task := EmailTask{
+		Email:    email,
+		TaskType: "statement",
+		Subject:  subject,
+		Body:     body,
+	}
+
+	select {
+	case s.taskChan <- task:
+		return nil
+	default:
+		return fmt.Errorf("email queue is full")
+	}
+}
+
+// EnqueueNotification 将阈值通知邮件加入队列（body 为 HTML）
+func (s *EmailQueueService) EnqueueNotification(email, subject, body string) error {
+	task := EmailTask{
+		Email:    email,
+		TaskType: "notification",
+		Subject:  subject,
+		Body:     body,
+	}
+
+	select {
+	case s.taskChan <- task:
+		return nil
+	default:
+		return fmt.Errorf("email queue is full")
+	}
+}
+
 // Stop 停止队列服务
 func (s *EmailQueueService) Stop() {
 	close(s.stopChan)
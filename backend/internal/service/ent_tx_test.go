@@ -0,0 +1,40 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithTx_JoinsExistingTransaction(t *testing.T) {
+	client := newPaymentTestEntClient(t)
+	ctx := context.Background()
+
+	err := withTx(ctx, client, func(txCtx context.Context) error {
+		outer := dbent.TxFromContext(txCtx)
+		require.NotNil(t, outer)
+		return withTx(txCtx, client, func(innerCtx context.Context) error {
+			require.Same(t, outer, dbent.TxFromContext(innerCtx))
+			return nil
+		})
+	})
+	require.NoError(t, err)
+
+	sentinel := errors.New("boom")
+	require.ErrorIs(t, withTx(ctx, client, func(context.Context) error { return sentinel }), sentinel)
+}
+
+func TestWithTx_NilClientRunsDirectly(t *testing.T) {
+	called := false
+	require.NoError(t, withTx(context.Background(), nil, func(txCtx context.Context) error {
+		called = true
+		require.Nil(t, dbent.TxFromContext(txCtx))
+		return nil
+	}))
+	require.True(t, called)
+}
@@ -214,6 +214,8 @@ type GatewayService struct {
 	concurrencyService  *ConcurrencyService
 	claudeTokenProvider *ClaudeTokenProvider
 	sessionLimitCache   SessionLimitCache // 会话数量限制缓存（仅 Anthropic OAuth/SetupToken）
+	spendCapService     *AccountSpendCapService
+	notificationService *UserNotificationService
 }
 
 // NewGatewayService creates a new GatewayService
@@ -235,6 +237,8 @@ func NewGatewayService(
 	deferredService *DeferredService,
 	claudeTokenProvider *ClaudeTokenProvider,
 	sessionLimitCache SessionLimitCache,
+	spendCapService *AccountSpendCapService,
+	notificationService *UserNotificationService,
 ) *GatewayService {
 	return &GatewayService{
 		accountRepo:         accountRepo,
@@ -254,6 +258,8 @@ func NewGatewayService(
 		deferredService:     deferredService,
 		claudeTokenProvider: claudeTokenProvider,
 		sessionLimitCache:   sessionLimitCache,
+		spendCapService:     spendCapService,
+		notificationService: notificationService,
 	}
 }
 
@@ -637,6 +643,11 @@ func (s *GatewayService) SelectAccountWithLoadAwareness(ctx context.Context, gro
 			routingCandidates = append(routingCandidates, account)
 		}
 
+		// 分组子池分流同样作用于路由账号；路由账号全部位于权重为 0 的子池时回退到 Layer 2
+		if routingCandidates, err = applyGroupPoolSplit(ctx, groupID, routingCandidates); err != nil {
+			routingCandidates = nil
+		}
+
 		if s.debugModelRoutingEnabled() {
 			log.Printf("[ModelRoutingDebug] routed candidates: group_id=%v model=%s routed=%d candidates=%d filtered(excluded=%d missing=%d unsched=%d platform=%d model_scope=%d model_mapping=%d window_cost=%d)",
 				derefGroupID(groupID), requestedModel, len(routingAccountIDs), len(routingCandidates),
@@ -650,7 +661,10 @@ func (s *GatewayService) SelectAccountWithLoadAwareness(ctx context.Context, gro
 				if err == nil && stickyAccountID > 0 && containsInt64(routingAccountIDs, stickyAccountID) && !isExcluded(stickyAccountID) {
 					// 粘性账号在路由列表中，优先使用
 					if stickyAccount, ok := accountByID[stickyAccountID]; ok {
-						if stickyAccount.IsSchedulable() &&
+						if !isAccountInWeightedPool(ctx, groupID, stickyAccount) {
+							// 粘性账号所在子池已回滚，解除绑定
+							_ = s.cache.DeleteSessionAccountID(ctx, derefGroupID(groupID), sessionHash)
+						} else if stickyAccount.IsSchedulable() &&
 							s.isAccountAllowedForPlatform(stickyAccount, platform, useMixed) &&
 							stickyAccount.IsSchedulableForModel(requestedModel) &&
 							(requestedModel == "" || s.isModelSupportedByAccount(stickyAccount, requestedModel)) &&
@@ -804,7 +818,7 @@ func (s *GatewayService) SelectAccountWithLoadAwareness(ctx context.Context, gro
 			if ok {
 				// 检查账户是否需要清理粘性会话绑定
 				// Check if the account needs sticky session cleanup
-				clearSticky := shouldClearStickySession(account)
+				clearSticky := shouldClearStickySession(account) || !isAccountInWeightedPool(ctx, groupID, account)
 				if clearSticky {
 					_ = s.cache.DeleteSessionAccountID(ctx, derefGroupID(groupID), sessionHash)
 				}
@@ -886,6 +900,12 @@ func (s *GatewayService) SelectAccountWithLoadAwareness(ctx context.Context, gro
 		return nil, errors.New("no available accounts")
 	}
 
+	// 分组子池分流：按权重选定本次请求的子池（灰度池）
+	candidates, err = applyGroupPoolSplit(ctx, groupID, candidates)
+	if err != nil {
+		return nil, err
+	}
+
 	accountLoads := make([]AccountWithConcurrency, 0, len(candidates))
 	for _, acc := range candidates {
 		accountLoads = append(accountLoads, AccountWithConcurrency{
@@ -1467,7 +1487,7 @@ func (s *GatewayService) selectAccountForModelWithPlatform(ctx context.Context,
 					account, err := s.getSchedulableAccount(ctx, accountID)
 					// 检查账号分组归属和平台匹配（确保粘性会话不会跨分组或跨平台）
 					if err == nil {
-						clearSticky := shouldClearStickySession(account)
+						clearSticky := shouldClearStickySession(account) || !isAccountInWeightedPool(ctx, groupID, account)
 						if clearSticky {
 							_ = s.cache.DeleteSessionAccountID(ctx, derefGroupID(groupID), sessionHash)
 						}
@@ -1505,6 +1525,7 @@ func (s *GatewayService) selectAccountForModelWithPlatform(ctx context.Context,
 		}
 
 		var selected *Account
+		var candidates []*Account
 		for i := range accounts {
 			acc := &accounts[i]
 			if _, ok := routingSet[acc.ID]; !ok {
@@ -1524,6 +1545,14 @@ func (s *GatewayService) selectAccountForModelWithPlatform(ctx context.Context,
 			if requestedModel != "" && !s.isModelSupportedByAccount(acc, requestedModel) {
 				continue
 			}
+			candidates = append(candidates, acc)
+		}
+
+		// 分组子池分流：路由账号全部位于权重为 0 的子池时回退到常规选择
+		if candidates, err = applyGroupPoolSplit(ctx, groupID, candidates); err != nil {
+			candidates = nil
+		}
+		for _, acc := range candidates {
 			if selected == nil {
 				selected = acc
 				continue
@@ -1570,7 +1599,7 @@ func (s *GatewayService) selectAccountForModelWithPlatform(ctx context.Context,
 				account, err := s.getSchedulableAccount(ctx, accountID)
 				// 检查账号分组归属和平台匹配（确保粘性会话不会跨分组或跨平台）
 				if err == nil {
-					clearSticky := shouldClearStickySession(account)
+					clearSticky := shouldClearStickySession(account) || !isAccountInWeightedPool(ctx, groupID, account)
 					if clearSticky {
 						_ = s.cache.DeleteSessionAccountID(ctx, derefGroupID(groupID), sessionHash)
 					}
@@ -1600,6 +1629,7 @@ func (s *GatewayService) selectAccountForModelWithPlatform(ctx context.Context,
 
 	// 3. 按优先级+最久未用选择（考虑模型支持）
 	var selected *Account
+	var candidates []*Account
 	for i := range accounts {
 		acc := &accounts[i]
 		if _, excluded := excludedIDs[acc.ID]; excluded {
@@ -1616,6 +1646,15 @@ func (s *GatewayService) selectAccountForModelWithPlatform(ctx context.Context,
 		if requestedModel != "" && !s.isModelSupportedByAccount(acc, requestedModel) {
 			continue
 		}
+		candidates = append(candidates, acc)
+	}
+
+	// 分组子池分流：按权重选定本次请求的子池（灰度池）
+	candidates, err := applyGroupPoolSplit(ctx, groupID, candidates)
+	if err != nil {
+		return nil, err
+	}
+	for _, acc := range candidates {
 		if selected == nil {
 			selected = acc
 			continue
@@ -1680,7 +1719,7 @@ func (s *GatewayService) selectAccountWithMixedScheduling(ctx context.Context, g
 					account, err := s.getSchedulableAccount(ctx, accountID)
 					// 检查账号分组归属和有效性：原生平台直接匹配，antigravity 需要启用混合调度
 					if err == nil {
-						clearSticky := shouldClearStickySession(account)
+						clearSticky := shouldClearStickySession(account) || !isAccountInWeightedPool(ctx, groupID, account)
 						if clearSticky {
 							_ = s.cache.DeleteSessionAccountID(ctx, derefGroupID(groupID), sessionHash)
 						}
@@ -1716,6 +1755,7 @@ func (s *GatewayService) selectAccountWithMixedScheduling(ctx context.Context, g
 		}
 
 		var selected *Account
+		var candidates []*Account
 		for i := range accounts {
 			acc := &accounts[i]
 			if _, ok := routingSet[acc.ID]; !ok {
@@ -1739,6 +1779,14 @@ func (s *GatewayService) selectAccountWithMixedScheduling(ctx context.Context, g
 			if requestedModel != "" && !s.isModelSupportedByAccount(acc, requestedModel) {
 				continue
 			}
+			candidates = append(candidates, acc)
+		}
+
+		// 分组子池分流：路由账号全部位于权重为 0 的子池时回退到常规选择
+		if candidates, err = applyGroupPoolSplit(ctx, groupID, candidates); err != nil {
+			candidates = nil
+		}
+		for _, acc := range candidates {
 			if selected == nil {
 				selected = acc
 				continue
@@ -1785,7 +1833,7 @@ func (s *GatewayService) selectAccountWithMixedScheduling(ctx context.Context, g
 				account, err := s.getSchedulableAccount(ctx, accountID)
 				// 检查账号分组归属和有效性：原生平台直接匹配，antigravity 需要启用混合调度
 				if err == nil {
-					clearSticky := shouldClearStickySession(account)
+					clearSticky := shouldClearStickySession(account) || !isAccountInWeightedPool(ctx, groupID, account)
 					if clearSticky {
 						_ = s.cache.DeleteSessionAccountID(ctx, derefGroupID(groupID), sessionHash)
 					}
@@ -1813,6 +1861,7 @@ func (s *GatewayService) selectAccountWithMixedScheduling(ctx context.Context, g
 
 	// 3. 按优先级+最久未用选择（考虑模型支持和混合调度）
 	var selected *Account
+	var candidates []*Account
 	for i := range accounts {
 		acc := &accounts[i]
 		if _, excluded := excludedIDs[acc.ID]; excluded {
@@ -1833,6 +1882,15 @@ func (s *GatewayService) selectAccountWithMixedScheduling(ctx context.Context, g
 		if requestedModel != "" && !s.isModelSupportedByAccount(acc, requestedModel) {
 			continue
 		}
+		candidates = append(candidates, acc)
+	}
+
+	// 分组子池分流：按权重选定本次请求的子池（灰度池）
+	candidates, err := applyGroupPoolSplit(ctx, groupID, candidates)
+	if err != nil {
+		return nil, err
+	}
+	for _, acc := range candidates {
 		if selected == nil {
 			selected = acc
 			continue
@@ -3442,6 +3500,13 @@ type RecordUsageInput struct {
 	Subscription *UserSubscription // 可选：订阅信息
 	UserAgent    string            // 请求的 User-Agent
 	IPAddress    string            // 请求的客户端 IP 地址
+
+	// 容量降级：请求由降级分组承接时按降级分组的倍率/图片价格计费，并在使用记录中标记
+	FallbackGroup *Group
+	FallbackHop   int
+
+	// 转发前的余额预留（可选）：扣费后结算，任何提前返回也会释放
+	Reservation *BalanceReservation
 }
 
 // RecordUsage 记录使用量并扣费（或更新订阅用量）
@@ -3449,26 +3514,56 @@ func (s *GatewayService) RecordUsage(ctx context.Context, input *RecordUsageInpu
 	result := input.Result
 	apiKey := input.APIKey
 	user := input.User
+	// 组织 Key 由组织计费账户付费，使用记录仍归属发起请求的成员
+	payer := apiKey.Payer(user)
 	account := input.Account
 	subscription := input.Subscription
+	defer input.Reservation.Release()
 
-	// 获取费率倍数
+	// 获取费率倍数（容量降级时使用实际承接分组的倍率）
 	multiplier := s.cfg.Default.RateMultiplier
-	if apiKey.GroupID != nil && apiKey.Group != nil {
+	billingGroup := apiKey.Group
+	if input.FallbackGroup != nil {
+		billingGroup = input.FallbackGroup
+		multiplier = billingGroup.RateMultiplier
+	} else if apiKey.GroupID != nil && apiKey.Group != nil {
 		multiplier = apiKey.Group.RateMultiplier
 	}
 
+	// 判断计费方式：订阅模式 vs 余额模式
+	// 订阅属于 API Key 所属分组；容量降级链只允许计费类型相同的分组（见 validateCapacityFallbackChain），
+	// 因此降级承接时订阅用量仍记在原分组的订阅上
+	isSubscriptionBilling := subscription != nil && apiKey.Group != nil && apiKey.Group.IsSubscriptionType()
+	billingType := BillingTypeBalance
+	if isSubscriptionBilling {
+		billingType = BillingTypeSubscription
+	}
+
+	// 阶梯折扣：余额模式下按用户近 30 天消费叠加折扣倍率（订阅模式按原始费用计量，不受倍率影响）
+	var volumeTier *AppliedVolumeTier
+	if !isSubscriptionBilling {
+		volumeTier = s.billingCacheService.ResolveVolumeTier(ctx, payer.ID, billingGroup)
+		if volumeTier != nil {
+			multiplier *= volumeTier.RateMultiplier
+		}
+	}
+
 	var cost *CostBreakdown
+	var billingGroupID *int64
+	if billingGroup != nil {
+		billingGroupID = &billingGroup.ID
+	}
 
 	// 根据请求类型选择计费方式
 	if result.ImageCount > 0 {
 		// 图片生成计费
 		var groupConfig *ImagePriceConfig
-		if apiKey.Group != nil {
+		if billingGroup != nil {
 			groupConfig = &ImagePriceConfig{
-				Price1K: apiKey.Group.ImagePrice1K,
-				Price2K: apiKey.Group.ImagePrice2K,
-				Price4K: apiKey.Group.ImagePrice4K,
+				Price1K: billingGroup.ImagePrice1K,
+				Price2K: billingGroup.ImagePrice2K,
+				Price4K: billingGroup.ImagePrice4K,
+				GroupID: billingGroupID,
 			}
 		}
 		cost = s.billingService.CalculateImageCost(result.Model, result.ImageSize, result.ImageCount, groupConfig, multiplier)
@@ -3481,20 +3576,13 @@ func (s *GatewayService) RecordUsage(ctx context.Context, input *RecordUsageInpu
 			CacheReadTokens:     result.Usage.CacheReadInputTokens,
 		}
 		var err error
-		cost, err = s.billingService.CalculateCost(result.Model, tokens, multiplier)
+		cost, err = s.billingService.CalculateCostForGroup(result.Model, billingGroupID, tokens, multiplier)
 		if err != nil {
 			log.Printf("Calculate cost failed: %v", err)
 			cost = &CostBreakdown{ActualCost: 0}
 		}
 	}
 
-	// 判断计费方式：订阅模式 vs 余额模式
-	isSubscriptionBilling := subscription != nil && apiKey.Group != nil && apiKey.Group.IsSubscriptionType()
-	billingType := BillingTypeBalance
-	if isSubscriptionBilling {
-		billingType = BillingTypeSubscription
-	}
-
 	// 创建使用日志
 	durationMs := int(result.Duration.Milliseconds())
 	var imageSize *string
@@ -3528,6 +3616,16 @@ func (s *GatewayService) RecordUsage(ctx context.Context, input *RecordUsageInpu
 		ImageSize:             imageSize,
 		CreatedAt:             time.Now(),
 	}
+	usageLog.ApplyPricing(cost.Pricing)
+	usageLog.ApplyVolumeTier(volumeTier)
+	usageLog.ApplyOrganization(apiKey.OrganizationBilling)
+
+	// 订阅超额计费：超出订阅剩余额度的部分按超额倍率从余额扣除
+	var overage *SubscriptionCostSplit
+	if isSubscriptionBilling {
+		overage = s.billingCacheService.SplitSubscriptionCost(ctx, payer.ID, apiKey.Group, subscription, cost.TotalCost)
+	}
+	usageLog.ApplyOverage(overage)
 
 	// 添加 UserAgent
 	if input.UserAgent != "" {
@@ -3543,6 +3641,12 @@ func (s *GatewayService) RecordUsage(ctx context.Context, input *RecordUsageInpu
 	if apiKey.GroupID != nil {
 		usageLog.GroupID = apiKey.GroupID
 	}
+	if input.FallbackGroup != nil {
+		fallbackGroupID := input.FallbackGroup.ID
+		usageLog.GroupID = &fallbackGroupID
+		usageLog.OriginalGroupID = apiKey.GroupID
+		usageLog.FallbackHop = input.FallbackHop
+	}
 	if subscription != nil {
 		usageLog.SubscriptionID = &subscription.ID
 	}
@@ -3551,6 +3655,9 @@ func (s *GatewayService) RecordUsage(ctx context.Context, input *RecordUsageInpu
 	if err != nil {
 		log.Printf("Create usage log failed: %v", err)
 	}
+	if inserted {
+		s.spendCapService.RecordSpend(ctx, account, cost.TotalCost*accountRateMultiplier)
+	}
 
 	if s.cfg != nil && s.cfg.RunMode == config.RunModeSimple {
 		log.Printf("[SIMPLE MODE] Usage recorded (not billed): user=%d, tokens=%d", usageLog.UserID, usageLog.TotalTokens())
@@ -3563,22 +3670,57 @@ func (s *GatewayService) RecordUsage(ctx context.Context, input *RecordUsageInpu
 	// 根据计费类型执行扣费
 	if isSubscriptionBilling {
 		// 订阅模式：更新订阅用量（使用 TotalCost 原始费用，不考虑倍率）
-		if shouldBill && cost.TotalCost > 0 {
-			if err := s.userSubRepo.IncrementUsage(ctx, subscription.ID, cost.TotalCost); err != nil {
+		subscriptionCost := cost.TotalCost
+		if overage != nil {
+			subscriptionCost = overage.SubscriptionCost
+		}
+		if shouldBill && subscriptionCost > 0 {
+			if err := s.userSubRepo.IncrementUsage(ctx, subscription.ID, subscriptionCost); err != nil {
 				log.Printf("Increment subscription usage failed: %v", err)
 			}
 			// 异步更新订阅缓存
-			s.billingCacheService.QueueUpdateSubscriptionUsage(user.ID, *apiKey.GroupID, cost.TotalCost)
+			s.billingCacheService.QueueUpdateSubscriptionUsage(payer.ID, *apiKey.GroupID, subscriptionCost)
+			s.billingCacheService.QueueAddRollingWindowUsage(payer.ID, apiKey.Group, subscriptionCost)
+			s.notificationService.CheckAfterUsage(UsageNotificationInput{
+				UserID:           payer.ID,
+				Subscription:     subscription,
+				Group:            apiKey.Group,
+				SubscriptionCost: subscriptionCost,
+			})
+		}
+		// 超额部分从余额扣除
+		if shouldBill && overage != nil && overage.OverageCost > 0 {
+			tx, err := s.userRepo.ApplyBalanceChange(ctx, usageBalanceChange(payer.ID, usageLog, overage.OverageCost))
+			if err != nil {
+				log.Printf("Deduct overage balance failed: %v", err)
+			} else if tx != nil {
+				s.notificationService.CheckAfterUsage(UsageNotificationInput{UserID: payer.ID, BalanceAfter: &tx.BalanceAfter})
+			}
+			s.billingCacheService.QueueDeductBalance(payer.ID, overage.OverageCost)
+		}
+		// 请求数/Token 配额用量（与费用无关，每次计费请求都累加）
+		if shouldBill && apiKey.Group.HasUsageQuotas() {
+			recordSubscriptionQuotaUsage(ctx, s.userSubRepo, apiKey.Group, subscription, usageLog)
 		}
 	} else {
 		// 余额模式：扣除用户余额（使用 ActualCost 考虑倍率后的费用）
 		if shouldBill && cost.ActualCost > 0 {
-			if err := s.userRepo.DeductBalance(ctx, user.ID, cost.ActualCost); err != nil {
+			tx, err := s.userRepo.ApplyBalanceChange(ctx, usageBalanceChange(payer.ID, usageLog, cost.ActualCost))
+			if err != nil {
 				log.Printf("Deduct balance failed: %v", err)
+			} else if tx != nil {
+				s.notificationService.CheckAfterUsage(UsageNotificationInput{UserID: payer.ID, BalanceAfter: &tx.BalanceAfter})
 			}
 			// 异步更新余额缓存
-			s.billingCacheService.QueueDeductBalance(user.ID, cost.ActualCost)
+			s.billingCacheService.QueueDeductBalance(payer.ID, cost.ActualCost)
 		}
+		// 实际费用已扣除，释放预留的剩余部分
+		input.Reservation.Settle(cost.ActualCost)
+	}
+
+	// 更新 API Key 限额用量缓存（请求数与 actual_cost，与数据库统计口径一致）
+	if shouldBill && apiKey.HasUsageLimits() {
+		s.billingCacheService.QueueUpdateAPIKeyUsage(apiKey.ID, cost.ActualCost)
 	}
 
 	// Schedule batch update for account last_used_at
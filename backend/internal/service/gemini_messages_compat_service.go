@@ -113,8 +113,10 @@ func (s *GeminiMessagesCompatService) SelectAccountForModelWithExclusions(ctx co
 
 	// 4. 按优先级 + LRU 选择最佳账号
 	// Select best account by priority + LRU
-	selected := s.selectBestGeminiAccount(ctx, accounts, requestedModel, excludedIDs, platform, useMixedScheduling)
-
+	selected, err := s.selectBestGeminiAccount(ctx, groupID, accounts, requestedModel, excludedIDs, platform, useMixedScheduling)
+	if err != nil {
+		return nil, err
+	}
 	if selected == nil {
 		if requestedModel != "" {
 			return nil, fmt.Errorf("no available Gemini accounts supporting model: %s", requestedModel)
@@ -195,7 +197,7 @@ func (s *GeminiMessagesCompatService) tryStickySessionHit(
 
 	// 检查账号是否需要清理粘性会话
 	// Check if sticky session should be cleared
-	if shouldClearStickySession(account) {
+	if shouldClearStickySession(account) || !isAccountInWeightedPool(ctx, groupID, account) {
 		_ = s.cache.DeleteSessionAccountID(ctx, derefGroupID(groupID), cacheKey)
 		return nil
 	}
@@ -288,14 +290,14 @@ func (s *GeminiMessagesCompatService) passesRateLimitPreCheck(ctx context.Contex
 // Returns nil if no available account.
 func (s *GeminiMessagesCompatService) selectBestGeminiAccount(
 	ctx context.Context,
+	groupID *int64,
 	accounts []Account,
 	requestedModel string,
 	excludedIDs map[int64]struct{},
 	platform string,
 	useMixedScheduling bool,
-) *Account {
-	var selected *Account
-
+) (*Account, error) {
+	var candidates []*Account
 	for i := range accounts {
 		acc := &accounts[i]
 
@@ -309,18 +311,23 @@ func (s *GeminiMessagesCompatService) selectBestGeminiAccount(
 			continue
 		}
 
-		// 选择最佳账号
-		if selected == nil {
-			selected = acc
-			continue
-		}
+		candidates = append(candidates, acc)
+	}
+
+	// 分组子池分流：按权重选定本次请求的子池
+	candidates, err := applyGroupPoolSplit(ctx, groupID, candidates)
+	if err != nil {
+		return nil, err
+	}
 
-		if s.isBetterGeminiAccount(acc, selected) {
+	// 选择最佳账号
+	var selected *Account
+	for _, acc := range candidates {
+		if selected == nil || s.isBetterGeminiAccount(acc, selected) {
 			selected = acc
 		}
 	}
-
-	return selected
+	return selected, nil
 }
 
 // isBetterGeminiAccount 判断 candidate 是否比 current 更优。
@@ -36,6 +36,33 @@ type Group struct {
 	ModelRouting        map[string][]int64
 	ModelRoutingEnabled bool
 
+	// 子池灰度分流配置
+	// PoolWeights key: 池标签（对应 account_groups.pool），value: 流量百分比
+	// CanaryMaxErrorRate 为 nil 表示不自动回滚
+	PoolSplitEnabled   bool
+	PoolWeights        map[string]int
+	CanaryMaxErrorRate *float64
+
+	// 容量降级链配置
+	// CapacityFallbackGroupIDs: 本分组无可调度账号或切换次数耗尽时，按顺序尝试的降级分组
+	// FallbackModelMapping: 本分组作为降级分组承接请求时的模型映射（key 支持 "*" 兜底）
+	CapacityFallbackGroupIDs []int64
+	FallbackModelMapping     map[string]string
+
+	// 阶梯折扣：按用户近 30 天实际消费分档，命中档位的倍率叠加在 RateMultiplier 之上
+	VolumeTiers []VolumeTier
+
+	// 订阅超额计费：限额用尽后超出部分按 OverageRateMultiplier（作用于原始费用）从余额扣除
+	OverageEnabled        bool
+	OverageRateMultiplier float64
+
+	// 滚动窗口限额：最近 RollingWindowHours 小时内的订阅用量（原始费用）不超过 RollingWindowLimitUSD
+	RollingWindowHours    int
+	RollingWindowLimitUSD *float64
+
+	// 请求数/Token 配额：按日/周/月窗口（可按模型模式）限制订阅请求数与输入/输出 Token 数
+	UsageQuotas []UsageQuota
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+)
+
+// MaxCapacityFallbackHops 容量降级链的最大长度
+const MaxCapacityFallbackHops = 5
+
+// capacityFallbackWildcard 降级模型映射中的兜底 key
+const capacityFallbackWildcard = "*"
+
+var ErrCapacityFallbackGroupUnavailable = infraerrors.ServiceUnavailable("FALLBACK_GROUP_UNAVAILABLE", "capacity fallback group is unavailable")
+
+// IsCapacityFallbackPlatform 容量降级仅在 /v1/messages 入口生效，降级链中的分组必须是该入口可服务的平台
+func IsCapacityFallbackPlatform(platform string) bool {
+	switch platform {
+	case PlatformAnthropic, PlatformAntigravity, PlatformGemini:
+		return true
+	default:
+		return false
+	}
+}
+
+// MapFallbackModel 返回本分组作为降级分组承接请求时使用的模型
+// 优先精确匹配，其次使用 "*" 兜底；均未配置时保持原模型
+func (g *Group) MapFallbackModel(model string) string {
+	if g == nil || len(g.FallbackModelMapping) == 0 {
+		return model
+	}
+	if mapped, ok := g.FallbackModelMapping[model]; ok && mapped != "" {
+		return mapped
+	}
+	if mapped, ok := g.FallbackModelMapping[capacityFallbackWildcard]; ok && mapped != "" {
+		return mapped
+	}
+	return model
+}
+
+// normalizeFallbackModelMapping 去除空白及空映射，结果为空时返回 nil（表示清除）
+func normalizeFallbackModelMapping(mapping map[string]string) map[string]string {
+	if len(mapping) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(mapping))
+	for from, to := range mapping {
+		from = strings.TrimSpace(from)
+		to = strings.TrimSpace(to)
+		if from == "" || to == "" {
+			continue
+		}
+		out[from] = to
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+// validateCapacityFallbackChain 校验容量降级链
+// currentGroupID: 当前分组 ID（新建时为 0）；platform/subscriptionType: 当前分组平台与计费类型
+// 降级链不递归展开（只使用当前分组自己的链），因此只需检查自引用和重复。
+// 降级分组必须与当前分组计费类型一致：订阅用量记在 API Key 所属分组的订阅上，
+// 混用会导致订阅 Key 在标准分组中按订阅计量、余额 Key 进入订阅分组按余额扣费。
+func (s *adminServiceImpl) validateCapacityFallbackChain(ctx context.Context, currentGroupID int64, platform, subscriptionType string, ids []int64) ([]int64, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	if !IsCapacityFallbackPlatform(platform) {
+		return nil, infraerrors.BadRequest("INVALID_CAPACITY_FALLBACK", fmt.Sprintf("capacity fallback is not supported for %s groups", platform))
+	}
+	if len(ids) > MaxCapacityFallbackHops {
+		return nil, infraerrors.BadRequest("INVALID_CAPACITY_FALLBACK", fmt.Sprintf("capacity fallback chain must not exceed %d groups", MaxCapacityFallbackHops))
+	}
+
+	seen := make(map[int64]struct{}, len(ids))
+	out := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if id <= 0 {
+			return nil, infraerrors.BadRequest("INVALID_CAPACITY_FALLBACK", "invalid fallback group id")
+		}
+		if currentGroupID > 0 && id == currentGroupID {
+			return nil, infraerrors.BadRequest("INVALID_CAPACITY_FALLBACK", "cannot use self as capacity fallback group")
+		}
+		if _, ok := seen[id]; ok {
+			return nil, infraerrors.BadRequest("INVALID_CAPACITY_FALLBACK", fmt.Sprintf("duplicate fallback group %d", id))
+		}
+		seen[id] = struct{}{}
+
+		fallbackGroup, err := s.groupRepo.GetByIDLite(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if !IsCapacityFallbackPlatform(fallbackGroup.Platform) {
+			return nil, infraerrors.BadRequest("INVALID_CAPACITY_FALLBACK", fmt.Sprintf("fallback group %d has unsupported platform %s", id, fallbackGroup.Platform))
+		}
+		if !sameSubscriptionType(fallbackGroup.SubscriptionType, subscriptionType) {
+			return nil, infraerrors.BadRequest("INVALID_CAPACITY_FALLBACK", fmt.Sprintf("fallback group %d must have the same subscription type as this group", id))
+		}
+		out = append(out, id)
+	}
+	return out, nil
+}
+
+// ResolveCapacityFallbackGroup 加载容量降级链中的分组
+// 分组不存在、已停用、平台不受支持，或计费类型与原分组不一致（配置后分组类型被修改）时
+// 返回 ErrCapacityFallbackGroupUnavailable，调用方应跳过该分组
+func (s *GatewayService) ResolveCapacityFallbackGroup(ctx context.Context, groupID int64, original *Group) (*Group, error) {
+	group, err := s.groupRepo.GetByIDLite(ctx, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCapacityFallbackGroupUnavailable, err)
+	}
+	if !group.IsActive() || !IsCapacityFallbackPlatform(group.Platform) {
+		return nil, ErrCapacityFallbackGroupUnavailable
+	}
+	if original != nil && !sameSubscriptionType(group.SubscriptionType, original.SubscriptionType) {
+		return nil, fmt.Errorf("%w: subscription type %q differs from original group", ErrCapacityFallbackGroupUnavailable, group.SubscriptionType)
+	}
+	return group, nil
+}
+
+// sameSubscriptionType 比较计费类型，空值视为标准（余额）分组
+func sameSubscriptionType(a, b string) bool {
+	if a == "" {
+		a = SubscriptionTypeStandard
+	}
+	if b == "" {
+		b = SubscriptionTypeStandard
+	}
+	return a == b
+}
+
+// ReplaceRequestModel 替换请求体中的 model 字段（容量降级跨平台时使用）
+func (s *GatewayService) ReplaceRequestModel(body []byte, model string) []byte {
+	return s.replaceModelInBody(body, model)
+}
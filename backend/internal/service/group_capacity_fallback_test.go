@@ -0,0 +1,137 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupMapFallbackModel(t *testing.T) {
+	g := &Group{FallbackModelMapping: map[string]string{
+		"claude-sonnet-4-5": "gemini-2.5-pro",
+		"*":                 "gemini-2.5-flash",
+	}}
+	require.Equal(t, "gemini-2.5-pro", g.MapFallbackModel("claude-sonnet-4-5"))
+	require.Equal(t, "gemini-2.5-flash", g.MapFallbackModel("claude-haiku-4-5"))
+
+	noMapping := &Group{}
+	require.Equal(t, "claude-haiku-4-5", noMapping.MapFallbackModel("claude-haiku-4-5"))
+}
+
+func TestNormalizeFallbackModelMapping(t *testing.T) {
+	require.Nil(t, normalizeFallbackModelMapping(nil))
+	require.Nil(t, normalizeFallbackModelMapping(map[string]string{" ": "x"}))
+	require.Equal(t, map[string]string{"a": "b"}, normalizeFallbackModelMapping(map[string]string{" a ": " b ", "c": ""}))
+}
+
+func TestAdminService_ValidateCapacityFallbackChain(t *testing.T) {
+	repo := &groupRepoStubForFallbackCycle{
+		groups: map[int64]*Group{
+			2: {ID: 2, Platform: PlatformGemini, Status: StatusActive},
+			3: {ID: 3, Platform: PlatformAntigravity, Status: StatusActive},
+			4: {ID: 4, Platform: PlatformOpenAI, Status: StatusActive},
+		},
+	}
+	svc := &adminServiceImpl{groupRepo: repo}
+	ctx := context.Background()
+
+	ids, err := svc.validateCapacityFallbackChain(ctx, 1, PlatformAnthropic, SubscriptionTypeStandard, []int64{2, 3})
+	require.NoError(t, err)
+	require.Equal(t, []int64{2, 3}, ids)
+
+	ids, err = svc.validateCapacityFallbackChain(ctx, 1, PlatformAnthropic, SubscriptionTypeStandard, []int64{})
+	require.NoError(t, err)
+	require.Nil(t, ids)
+
+	_, err = svc.validateCapacityFallbackChain(ctx, 1, PlatformAnthropic, SubscriptionTypeStandard, []int64{2, 1})
+	require.Error(t, err, "self reference")
+
+	_, err = svc.validateCapacityFallbackChain(ctx, 1, PlatformAnthropic, SubscriptionTypeStandard, []int64{2, 2})
+	require.Error(t, err, "duplicate")
+
+	_, err = svc.validateCapacityFallbackChain(ctx, 1, PlatformAnthropic, SubscriptionTypeStandard, []int64{4})
+	require.Error(t, err, "openai group cannot serve /v1/messages")
+
+	_, err = svc.validateCapacityFallbackChain(ctx, 1, PlatformOpenAI, SubscriptionTypeStandard, []int64{2})
+	require.Error(t, err, "openai group cannot have a chain")
+
+	_, err = svc.validateCapacityFallbackChain(ctx, 1, PlatformAnthropic, SubscriptionTypeStandard, []int64{99})
+	require.ErrorIs(t, err, ErrGroupNotFound)
+}
+
+func TestAdminService_ValidateCapacityFallbackChain_SubscriptionType(t *testing.T) {
+	repo := &groupRepoStubForFallbackCycle{
+		groups: map[int64]*Group{
+			2: {ID: 2, Platform: PlatformAnthropic, Status: StatusActive, SubscriptionType: SubscriptionTypeStandard},
+			3: {ID: 3, Platform: PlatformGemini, Status: StatusActive, SubscriptionType: SubscriptionTypeSubscription},
+			4: {ID: 4, Platform: PlatformGemini, Status: StatusActive},
+		},
+	}
+	svc := &adminServiceImpl{groupRepo: repo}
+	ctx := context.Background()
+
+	// 订阅分组降级到标准分组：会按订阅计量而不是按降级分组倍率扣余额，拒绝
+	_, err := svc.validateCapacityFallbackChain(ctx, 1, PlatformAnthropic, SubscriptionTypeSubscription, []int64{3, 2})
+	require.Error(t, err)
+
+	// 标准分组降级到订阅分组：余额 Key 不能进入订阅分组，拒绝
+	_, err = svc.validateCapacityFallbackChain(ctx, 1, PlatformAnthropic, SubscriptionTypeStandard, []int64{3})
+	require.Error(t, err)
+
+	ids, err := svc.validateCapacityFallbackChain(ctx, 1, PlatformAnthropic, SubscriptionTypeSubscription, []int64{3})
+	require.NoError(t, err)
+	require.Equal(t, []int64{3}, ids)
+
+	// 空计费类型视为标准分组
+	ids, err = svc.validateCapacityFallbackChain(ctx, 1, PlatformAnthropic, SubscriptionTypeStandard, []int64{2, 4})
+	require.NoError(t, err)
+	require.Equal(t, []int64{2, 4}, ids)
+}
+
+func TestGatewayService_ResolveCapacityFallbackGroup(t *testing.T) {
+	repo := &groupRepoStubForFallbackCycle{
+		groups: map[int64]*Group{
+			2: {ID: 2, Platform: PlatformGemini, Status: StatusActive},
+			3: {ID: 3, Platform: PlatformAnthropic, Status: StatusDisabled},
+		},
+	}
+	svc := &GatewayService{groupRepo: repo}
+	ctx := context.Background()
+
+	group, err := svc.ResolveCapacityFallbackGroup(ctx, 2, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), group.ID)
+
+	_, err = svc.ResolveCapacityFallbackGroup(ctx, 3, nil)
+	require.True(t, errors.Is(err, ErrCapacityFallbackGroupUnavailable))
+
+	_, err = svc.ResolveCapacityFallbackGroup(ctx, 99, nil)
+	require.True(t, errors.Is(err, ErrCapacityFallbackGroupUnavailable))
+}
+
+func TestGatewayService_ResolveCapacityFallbackGroup_SubscriptionToStandard(t *testing.T) {
+	// 配置后降级分组被改为标准分组：订阅 Key 请求时跳过该分组
+	repo := &groupRepoStubForFallbackCycle{
+		groups: map[int64]*Group{
+			2: {ID: 2, Platform: PlatformGemini, Status: StatusActive, SubscriptionType: SubscriptionTypeStandard},
+			3: {ID: 3, Platform: PlatformGemini, Status: StatusActive, SubscriptionType: SubscriptionTypeSubscription},
+		},
+	}
+	svc := &GatewayService{groupRepo: repo}
+	ctx := context.Background()
+	original := &Group{ID: 1, Platform: PlatformAnthropic, SubscriptionType: SubscriptionTypeSubscription}
+
+	_, err := svc.ResolveCapacityFallbackGroup(ctx, 2, original)
+	require.ErrorIs(t, err, ErrCapacityFallbackGroupUnavailable)
+
+	group, err := svc.ResolveCapacityFallbackGroup(ctx, 3, original)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), group.ID)
+
+	_, err = svc.ResolveCapacityFallbackGroup(ctx, 3, &Group{ID: 5, Platform: PlatformAnthropic})
+	require.ErrorIs(t, err, ErrCapacityFallbackGroupUnavailable)
+}
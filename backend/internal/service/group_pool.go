@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	mathrand "math/rand"
+	"sort"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+)
+
+// AccountPoolDefault 分组内默认子池标签（未打标签的账号均属于该池）
+const AccountPoolDefault = "default"
+
+const accountPoolMaxLen = 50
+
+var (
+	ErrAccountNotInGroup  = infraerrors.NotFound("ACCOUNT_NOT_IN_GROUP", "account is not bound to this group")
+	ErrInvalidAccountPool = infraerrors.BadRequest("INVALID_ACCOUNT_POOL", "pool label must be 1-50 characters")
+)
+
+// ErrNoWeightedPoolAccounts 可用账号均位于权重为 0 的子池（例如已回滚的灰度池）
+var ErrNoWeightedPoolAccounts = errors.New("no available accounts: remaining accounts belong to pools with zero traffic weight")
+
+// AccountPoolRepository 分组子池标签持久层接口
+type AccountPoolRepository interface {
+	// SetAccountPool 设置账号在分组内的子池标签，账号未绑定该分组时返回 ErrAccountNotInGroup
+	SetAccountPool(ctx context.Context, groupID, accountID int64, pool string) error
+	// CountAccountsByPool 返回分组内各子池的账号数量
+	CountAccountsByPool(ctx context.Context, groupID int64) (map[string]int64, error)
+}
+
+// NormalizeAccountPool 规范化子池标签，空值视为默认池
+func NormalizeAccountPool(pool string) (string, error) {
+	pool = strings.TrimSpace(pool)
+	if pool == "" {
+		return AccountPoolDefault, nil
+	}
+	if len(pool) > accountPoolMaxLen {
+		return "", ErrInvalidAccountPool
+	}
+	return pool, nil
+}
+
+// normalizePoolWeights 校验子池流量权重
+// 权重为百分比（0-100），总和不得超过 100；未配置 default 池时 default 池获得剩余流量
+func normalizePoolWeights(weights map[string]int) (map[string]int, error) {
+	if weights == nil {
+		return nil, nil
+	}
+	out := make(map[string]int, len(weights))
+	total := 0
+	for pool, weight := range weights {
+		normalized, err := NormalizeAccountPool(pool)
+		if err != nil {
+			return nil, err
+		}
+		if weight < 0 || weight > 100 {
+			return nil, infraerrors.BadRequest("INVALID_POOL_WEIGHTS", fmt.Sprintf("weight of pool %q must be between 0 and 100", normalized))
+		}
+		out[normalized] += weight
+		total += weight
+	}
+	if total > 100 {
+		return nil, infraerrors.BadRequest("INVALID_POOL_WEIGHTS", "sum of pool weights must not exceed 100")
+	}
+	return out, nil
+}
+
+// normalizeCanaryMaxErrorRate 校验灰度池错误率阈值，0 或负数表示关闭自动回滚
+func normalizeCanaryMaxErrorRate(rate *float64) (*float64, error) {
+	if rate == nil || *rate <= 0 {
+		return nil, nil
+	}
+	if *rate > 1 {
+		return nil, infraerrors.BadRequest("INVALID_CANARY_ERROR_RATE", "canary_max_error_rate must be between 0 and 1")
+	}
+	return rate, nil
+}
+
+// PoolWeight 返回子池的有效流量权重
+// default 池未显式配置时取 100 减去其他池权重之和
+func (g *Group) PoolWeight(pool string) int {
+	if weight, ok := g.PoolWeights[pool]; ok {
+		return weight
+	}
+	if pool != AccountPoolDefault {
+		return 0
+	}
+	remaining := 100
+	for _, weight := range g.PoolWeights {
+		remaining -= weight
+	}
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// IsPoolSplitActive 是否启用了子池分流
+func (g *Group) IsPoolSplitActive() bool {
+	return g != nil && g.PoolSplitEnabled && len(g.PoolWeights) > 0
+}
+
+// PoolInGroup 返回账号在指定分组内的子池标签
+func (a *Account) PoolInGroup(groupID int64) string {
+	for _, ag := range a.AccountGroups {
+		if ag.GroupID == groupID {
+			if ag.Pool == "" {
+				return AccountPoolDefault
+			}
+			return ag.Pool
+		}
+	}
+	return AccountPoolDefault
+}
+
+// applyGroupPoolSplit 按分组的子池权重挑选本次请求使用的子池，返回该池内的候选账号
+// 分组来自请求上下文（由 API Key 认证中间件注入）；未启用分流时返回原列表。
+// 候选账号全部位于权重为 0 的子池时返回 ErrNoWeightedPoolAccounts，不会把流量切回已回滚的灰度池。
+func applyGroupPoolSplit(ctx context.Context, groupID *int64, candidates []*Account) ([]*Account, error) {
+	if len(candidates) == 0 {
+		return candidates, nil
+	}
+	group := poolSplitGroup(ctx, groupID)
+	if group == nil {
+		return candidates, nil
+	}
+	selected := selectPoolCandidates(group, candidates, mathrand.Intn)
+	if len(selected) == 0 {
+		return nil, ErrNoWeightedPoolAccounts
+	}
+	return selected, nil
+}
+
+// isAccountInWeightedPool 账号所在子池的流量权重是否大于 0，未启用分流时恒为 true
+// 粘性会话绑定的账号所在子池被回滚（权重为 0）后不再命中，调用方应解除绑定。
+func isAccountInWeightedPool(ctx context.Context, groupID *int64, account *Account) bool {
+	if account == nil {
+		return true
+	}
+	group := poolSplitGroup(ctx, groupID)
+	if group == nil {
+		return true
+	}
+	return group.PoolWeight(account.PoolInGroup(group.ID)) > 0
+}
+
+// poolSplitGroup 返回请求上下文中启用了子池分流的分组，否则返回 nil
+func poolSplitGroup(ctx context.Context, groupID *int64) *Group {
+	if groupID == nil {
+		return nil
+	}
+	group, ok := ctx.Value(ctxkey.Group).(*Group)
+	if !ok || !IsGroupContextValid(group) || group.ID != *groupID || !group.IsPoolSplitActive() {
+		return nil
+	}
+	return group
+}
+
+// selectPoolCandidates 按权重随机选出一个子池并返回其候选账号；
+// 权重为 0 的子池不参与选择，没有任何可选子池时返回 nil
+func selectPoolCandidates(group *Group, candidates []*Account, randIntn func(n int) int) []*Account {
+	if !group.IsPoolSplitActive() {
+		return candidates
+	}
+
+	byPool := make(map[string][]*Account)
+	for _, acc := range candidates {
+		pool := acc.PoolInGroup(group.ID)
+		byPool[pool] = append(byPool[pool], acc)
+	}
+
+	pools := make([]string, 0, len(byPool))
+	total := 0
+	for pool := range byPool {
+		if weight := group.PoolWeight(pool); weight > 0 {
+			pools = append(pools, pool)
+			total += weight
+		}
+	}
+	if total == 0 {
+		return nil
+	}
+	if len(pools) == 1 {
+		return byPool[pools[0]]
+	}
+	sort.Strings(pools)
+
+	n := randIntn(total)
+	for _, pool := range pools {
+		n -= group.PoolWeight(pool)
+		if n < 0 {
+			return byPool[pool]
+		}
+	}
+	return byPool[pools[len(pools)-1]]
+}
@@ -0,0 +1,312 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+)
+
+const (
+	poolCanaryWorkerName      = "pool_canary_rollback_worker"
+	poolCanaryLeaderLockKey   = "group:pool_canary:leader"
+	poolCanaryBucketSeconds   = 300
+	poolCanaryEvaluateTimeout = 30 * time.Second
+)
+
+// GroupPoolStatus 分组内单个子池的当前配置
+type GroupPoolStatus struct {
+	Pool         string `json:"pool"`
+	Weight       int    `json:"weight"`
+	AccountCount int64  `json:"account_count"`
+}
+
+// GroupPoolService 管理分组内子池（灰度池）：
+// - 设置账号所属子池
+// - 定期检查灰度池错误率，超过分组阈值时将其权重置 0（自动回滚）
+type GroupPoolService struct {
+	groupRepo            GroupRepository
+	poolRepo             AccountPoolRepository
+	opsService           *OpsService
+	authCacheInvalidator APIKeyAuthCacheInvalidator
+	timingWheel          *TimingWheelService
+	db                   *sql.DB
+	cfg                  config.PoolCanaryConfig
+
+	// poolStats 返回分组在时间范围内的子池统计，默认使用 OpsService.GetPoolComparison，测试中可替换
+	poolStats func(ctx context.Context, filter *OpsDashboardFilter, bucketSeconds int) (*OpsPoolComparisonResponse, error)
+	// createAlert 记录自动回滚告警事件，默认使用 OpsService.CreateAlertEvent，测试中可替换
+	createAlert func(ctx context.Context, event *OpsAlertEvent) (*OpsAlertEvent, error)
+
+	running   int32
+	startOnce sync.Once
+	stopOnce  sync.Once
+}
+
+func NewGroupPoolService(
+	groupRepo GroupRepository,
+	poolRepo AccountPoolRepository,
+	opsService *OpsService,
+	authCacheInvalidator APIKeyAuthCacheInvalidator,
+	timingWheel *TimingWheelService,
+	db *sql.DB,
+	cfg *config.Config,
+) *GroupPoolService {
+	var canaryCfg config.PoolCanaryConfig
+	if cfg != nil {
+		canaryCfg = cfg.PoolCanary
+	}
+	svc := &GroupPoolService{
+		groupRepo:            groupRepo,
+		poolRepo:             poolRepo,
+		opsService:           opsService,
+		authCacheInvalidator: authCacheInvalidator,
+		timingWheel:          timingWheel,
+		db:                   db,
+		cfg:                  canaryCfg,
+	}
+	if opsService != nil {
+		svc.poolStats = opsService.GetPoolComparison
+		svc.createAlert = opsService.CreateAlertEvent
+	}
+	return svc
+}
+
+func (s *GroupPoolService) Start() {
+	if s == nil {
+		return
+	}
+	if !s.cfg.Enabled {
+		log.Printf("[PoolCanary] not started (disabled)")
+		return
+	}
+	if s.groupRepo == nil || s.poolStats == nil || s.timingWheel == nil {
+		log.Printf("[PoolCanary] not started (missing deps)")
+		return
+	}
+
+	interval := time.Duration(s.cfg.CheckIntervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	s.startOnce.Do(func() {
+		s.timingWheel.ScheduleRecurring(poolCanaryWorkerName, interval, s.runOnce)
+		log.Printf("[PoolCanary] started (interval=%s window=%dm min_requests=%d)", interval, s.cfg.WindowMinutes, s.cfg.MinRequests)
+	})
+}
+
+func (s *GroupPoolService) Stop() {
+	if s == nil {
+		return
+	}
+	s.stopOnce.Do(func() {
+		if s.timingWheel != nil {
+			s.timingWheel.Cancel(poolCanaryWorkerName)
+		}
+		log.Printf("[PoolCanary] stopped")
+	})
+}
+
+// SetAccountPool 设置账号在分组内的子池标签，空标签表示默认池
+func (s *GroupPoolService) SetAccountPool(ctx context.Context, groupID, accountID int64, pool string) (string, error) {
+	if s == nil || s.poolRepo == nil {
+		return "", ErrServiceUnavailable
+	}
+	normalized, err := NormalizeAccountPool(pool)
+	if err != nil {
+		return "", err
+	}
+	if _, err := s.groupRepo.GetByIDLite(ctx, groupID); err != nil {
+		return "", err
+	}
+	if err := s.poolRepo.SetAccountPool(ctx, groupID, accountID, normalized); err != nil {
+		return "", err
+	}
+	return normalized, nil
+}
+
+// ListPools 返回分组内各子池的有效权重与账号数（包括已配置权重但暂无账号的池）
+// 权重仅在分组启用 pool_split_enabled 时生效
+func (s *GroupPoolService) ListPools(ctx context.Context, groupID int64) ([]GroupPoolStatus, error) {
+	if s == nil || s.poolRepo == nil {
+		return nil, ErrServiceUnavailable
+	}
+	group, err := s.groupRepo.GetByIDLite(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := s.poolRepo.CountAccountsByPool(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]struct{}, len(counts)+len(group.PoolWeights)+1)
+	names[AccountPoolDefault] = struct{}{}
+	for pool := range counts {
+		names[pool] = struct{}{}
+	}
+	for pool := range group.PoolWeights {
+		names[pool] = struct{}{}
+	}
+
+	out := make([]GroupPoolStatus, 0, len(names))
+	for pool := range names {
+		out = append(out, GroupPoolStatus{Pool: pool, Weight: group.PoolWeight(pool), AccountCount: counts[pool]})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if (out[i].Pool == AccountPoolDefault) != (out[j].Pool == AccountPoolDefault) {
+			return out[i].Pool == AccountPoolDefault
+		}
+		return out[i].Pool < out[j].Pool
+	})
+	return out, nil
+}
+
+func (s *GroupPoolService) runOnce() {
+	if !atomic.CompareAndSwapInt32(&s.running, 0, 1) {
+		return
+	}
+	defer atomic.StoreInt32(&s.running, 0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), poolCanaryEvaluateTimeout)
+	defer cancel()
+
+	if s.opsService != nil && !s.opsService.IsMonitoringEnabled(ctx) {
+		return
+	}
+
+	// 多实例部署时只允许一个节点执行回滚检查
+	if s.db != nil {
+		release, ok := tryAcquireDBAdvisoryLock(ctx, s.db, hashAdvisoryLockID(poolCanaryLeaderLockKey))
+		if !ok {
+			return
+		}
+		defer release()
+	}
+
+	groups, err := s.groupRepo.ListActive(ctx)
+	if err != nil {
+		log.Printf("[PoolCanary] list groups failed: %v", err)
+		return
+	}
+	now := time.Now()
+	for i := range groups {
+		if ctx.Err() != nil {
+			return
+		}
+		group := &groups[i]
+		if !group.IsPoolSplitActive() || group.CanaryMaxErrorRate == nil {
+			continue
+		}
+		if err := s.evaluateGroup(ctx, group, now); err != nil {
+			log.Printf("[PoolCanary] evaluate group failed: group=%d err=%v", group.ID, err)
+		}
+	}
+}
+
+// evaluateGroup 检查分组内非默认池的上游错误率，超过阈值的池权重置 0
+func (s *GroupPoolService) evaluateGroup(ctx context.Context, group *Group, now time.Time) error {
+	window := time.Duration(s.cfg.WindowMinutes) * time.Minute
+	if window <= 0 {
+		window = 10 * time.Minute
+	}
+	groupID := group.ID
+	stats, err := s.poolStats(ctx, &OpsDashboardFilter{
+		StartTime: now.Add(-window),
+		EndTime:   now,
+		GroupID:   &groupID,
+	}, poolCanaryBucketSeconds)
+	if err != nil {
+		return err
+	}
+	if stats == nil {
+		return nil
+	}
+
+	threshold := *group.CanaryMaxErrorRate
+	var rollback []*OpsPoolComparisonItem
+	for _, item := range stats.Pools {
+		if item == nil || item.Pool == AccountPoolDefault || group.PoolWeight(item.Pool) <= 0 {
+			continue
+		}
+		if item.SuccessCount+item.ErrorCount < int64(s.cfg.MinRequests) {
+			continue
+		}
+		if item.ErrorRate > threshold {
+			log.Printf("[PoolCanary] rolling back pool: group=%d pool=%s error_rate=%.4f threshold=%.4f requests=%d",
+				group.ID, item.Pool, item.ErrorRate, threshold, item.SuccessCount+item.ErrorCount)
+			rollback = append(rollback, item)
+		}
+	}
+	if len(rollback) == 0 {
+		return nil
+	}
+	pools := make([]string, 0, len(rollback))
+	for _, item := range rollback {
+		pools = append(pools, item.Pool)
+	}
+	if err := s.rollbackPools(ctx, group.ID, pools); err != nil {
+		return err
+	}
+	for _, item := range rollback {
+		s.emitRollbackAlert(ctx, group, item, threshold, window, now)
+	}
+	return nil
+}
+
+// emitRollbackAlert 为自动回滚记录一条告警事件（不关联告警规则），便于在运维告警列表中追踪
+func (s *GroupPoolService) emitRollbackAlert(ctx context.Context, group *Group, item *OpsPoolComparisonItem, threshold float64, window time.Duration, now time.Time) {
+	if s.createAlert == nil {
+		return
+	}
+	requests := item.SuccessCount + item.ErrorCount
+	errorRate := item.ErrorRate
+	event := &OpsAlertEvent{
+		Severity: "warning",
+		Status:   OpsAlertStatusFiring,
+		Title:    fmt.Sprintf("Pool canary rolled back: %s / %s", group.Name, item.Pool),
+		Description: fmt.Sprintf("Pool %q in group %q (id=%d) was rolled back to weight 0: upstream error rate %.2f%% over %d requests in the last %s exceeded %.2f%%.",
+			item.Pool, group.Name, group.ID, errorRate*100, requests, window, threshold*100),
+		MetricValue:    &errorRate,
+		ThresholdValue: &threshold,
+		Dimensions: map[string]any{
+			"group_id": group.ID,
+			"pool":     item.Pool,
+			"requests": requests,
+		},
+		FiredAt:   now,
+		CreatedAt: now,
+	}
+	if _, err := s.createAlert(ctx, event); err != nil {
+		log.Printf("[PoolCanary] create rollback alert failed: group=%d pool=%s err=%v", group.ID, item.Pool, err)
+	}
+}
+
+func (s *GroupPoolService) rollbackPools(ctx context.Context, groupID int64, pools []string) error {
+	// 重新读取分组，避免覆盖管理员在检查期间做的修改
+	group, err := s.groupRepo.GetByIDLite(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	weights := make(map[string]int, len(group.PoolWeights))
+	for pool, weight := range group.PoolWeights {
+		weights[pool] = weight
+	}
+	for _, pool := range pools {
+		weights[pool] = 0
+	}
+	group.PoolWeights = weights
+	if err := s.groupRepo.Update(ctx, group); err != nil {
+		return err
+	}
+	if s.authCacheInvalidator != nil {
+		s.authCacheInvalidator.InvalidateAuthCacheByGroupID(ctx, groupID)
+	}
+	return nil
+}
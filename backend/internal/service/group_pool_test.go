@@ -0,0 +1,196 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	"github.com/stretchr/testify/require"
+)
+
+func poolAccount(id, groupID int64, pool string) *Account {
+	return &Account{ID: id, AccountGroups: []AccountGroup{{AccountID: id, GroupID: groupID, Pool: pool}}}
+}
+
+func accountIDs(accounts []*Account) []int64 {
+	ids := make([]int64, 0, len(accounts))
+	for _, acc := range accounts {
+		ids = append(ids, acc.ID)
+	}
+	return ids
+}
+
+func TestGroupPoolWeightDefaultTakesRemainder(t *testing.T) {
+	group := &Group{PoolSplitEnabled: true, PoolWeights: map[string]int{"canary": 5}}
+	require.Equal(t, 95, group.PoolWeight(AccountPoolDefault))
+	require.Equal(t, 5, group.PoolWeight("canary"))
+	require.Equal(t, 0, group.PoolWeight("unknown"))
+}
+
+func TestNormalizePoolWeights(t *testing.T) {
+	weights, err := normalizePoolWeights(map[string]int{" canary ": 5, "": 95})
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"canary": 5, AccountPoolDefault: 95}, weights)
+
+	_, err = normalizePoolWeights(map[string]int{"a": 60, "b": 50})
+	require.Error(t, err)
+
+	_, err = normalizePoolWeights(map[string]int{"a": -1})
+	require.Error(t, err)
+}
+
+func TestSelectPoolCandidates(t *testing.T) {
+	group := &Group{ID: 1, PoolSplitEnabled: true, PoolWeights: map[string]int{"canary": 5}}
+	candidates := []*Account{
+		poolAccount(1, 1, AccountPoolDefault),
+		poolAccount(2, 1, "canary"),
+		poolAccount(3, 1, ""),
+	}
+
+	// pools 按名称排序：canary(5) 在前，default(95) 在后
+	canary := selectPoolCandidates(group, candidates, func(n int) int { return 4 })
+	require.Equal(t, []int64{2}, accountIDs(canary))
+
+	stable := selectPoolCandidates(group, candidates, func(n int) int { return 5 })
+	require.Equal(t, []int64{1, 3}, accountIDs(stable))
+
+	// 灰度池回滚后（权重为 0）不再分配流量
+	group.PoolWeights["canary"] = 0
+	for i := 0; i < 100; i++ {
+		selected := selectPoolCandidates(group, candidates, func(n int) int { return i % n })
+		require.Equal(t, []int64{1, 3}, accountIDs(selected))
+	}
+
+	// 只剩权重为 0 的池（已回滚的灰度池）时不返回候选，避免流量切回灰度池
+	onlyCanary := []*Account{poolAccount(2, 1, "canary"), poolAccount(4, 1, "canary")}
+	require.Empty(t, selectPoolCandidates(group, onlyCanary, func(n int) int { return 0 }))
+	require.Empty(t, selectPoolCandidates(group, onlyCanary[:1], func(n int) int { return 0 }))
+
+	// 未启用分流时不过滤
+	group.PoolSplitEnabled = false
+	require.Len(t, selectPoolCandidates(group, candidates, func(n int) int { return 0 }), 3)
+}
+
+type poolGroupRepoStub struct {
+	GroupRepository
+
+	groups  map[int64]*Group
+	updated []*Group
+}
+
+func (r *poolGroupRepoStub) GetByIDLite(ctx context.Context, id int64) (*Group, error) {
+	group, ok := r.groups[id]
+	if !ok {
+		return nil, ErrGroupNotFound
+	}
+	clone := *group
+	return &clone, nil
+}
+
+func (r *poolGroupRepoStub) Update(ctx context.Context, group *Group) error {
+	r.updated = append(r.updated, group)
+	return nil
+}
+
+func TestGroupPoolServiceRollsBackFailingCanary(t *testing.T) {
+	rate := 0.2
+	group := &Group{
+		ID:                 1,
+		PoolSplitEnabled:   true,
+		PoolWeights:        map[string]int{"canary": 5, "beta": 10},
+		CanaryMaxErrorRate: &rate,
+	}
+	repo := &poolGroupRepoStub{groups: map[int64]*Group{1: group}}
+	svc := NewGroupPoolService(repo, nil, nil, nil, nil, nil, &config.Config{PoolCanary: config.PoolCanaryConfig{
+		Enabled:       true,
+		WindowMinutes: 10,
+		MinRequests:   20,
+	}})
+	svc.poolStats = func(ctx context.Context, filter *OpsDashboardFilter, bucketSeconds int) (*OpsPoolComparisonResponse, error) {
+		return &OpsPoolComparisonResponse{Pools: []*OpsPoolComparisonItem{
+			{Pool: AccountPoolDefault, SuccessCount: 100, ErrorCount: 50, ErrorRate: 0.33},
+			{Pool: "canary", SuccessCount: 20, ErrorCount: 10, ErrorRate: 0.33},
+			{Pool: "beta", SuccessCount: 5, ErrorCount: 5, ErrorRate: 0.5},
+		}}, nil
+	}
+
+	var alerts []*OpsAlertEvent
+	svc.createAlert = func(ctx context.Context, event *OpsAlertEvent) (*OpsAlertEvent, error) {
+		alerts = append(alerts, event)
+		return event, nil
+	}
+
+	require.NoError(t, svc.evaluateGroup(context.Background(), group, time.Now()))
+
+	// default 池不回滚，beta 样本不足不回滚
+	require.Len(t, repo.updated, 1)
+	require.Equal(t, map[string]int{"canary": 0, "beta": 10}, repo.updated[0].PoolWeights)
+
+	require.Len(t, alerts, 1)
+	require.Equal(t, OpsAlertStatusFiring, alerts[0].Status)
+	require.Equal(t, "canary", alerts[0].Dimensions["pool"])
+	require.Equal(t, int64(1), alerts[0].Dimensions["group_id"])
+	require.InDelta(t, 0.2, *alerts[0].ThresholdValue, 1e-9)
+}
+
+func TestApplyGroupPoolSplitRejectsRolledBackPoolsOnly(t *testing.T) {
+	group := &Group{ID: 1, Platform: PlatformAnthropic, Status: StatusActive, Hydrated: true, PoolSplitEnabled: true, PoolWeights: map[string]int{"canary": 0}}
+	ctx := context.WithValue(context.Background(), ctxkey.Group, group)
+	groupID := int64(1)
+
+	_, err := applyGroupPoolSplit(ctx, &groupID, []*Account{poolAccount(2, 1, "canary")})
+	require.ErrorIs(t, err, ErrNoWeightedPoolAccounts)
+
+	selected, err := applyGroupPoolSplit(ctx, &groupID, []*Account{poolAccount(2, 1, "canary"), poolAccount(3, 1, AccountPoolDefault)})
+	require.NoError(t, err)
+	require.Equal(t, []int64{3}, accountIDs(selected))
+}
+
+func newRolledBackPoolGatewayService(t *testing.T, sessionBindings map[string]int64) (*GatewayService, *mockGatewayCacheForPlatform, context.Context) {
+	t.Helper()
+	group := &Group{ID: 1, Platform: PlatformAnthropic, Status: StatusActive, Hydrated: true, PoolSplitEnabled: true, PoolWeights: map[string]int{"canary": 0}}
+	ctx := context.WithValue(context.Background(), ctxkey.Group, group)
+
+	// 灰度池账号优先级更高，分流未生效时会被优先选中
+	canary := *poolAccount(1, 1, "canary")
+	canary.Platform, canary.Priority, canary.Status, canary.Schedulable = PlatformAnthropic, 1, StatusActive, true
+	stable := *poolAccount(2, 1, AccountPoolDefault)
+	stable.Platform, stable.Priority, stable.Status, stable.Schedulable = PlatformAnthropic, 2, StatusActive, true
+	repo := &mockAccountRepoForPlatform{accounts: []Account{canary, stable}, accountsByID: map[int64]*Account{}}
+	for i := range repo.accounts {
+		repo.accountsByID[repo.accounts[i].ID] = &repo.accounts[i]
+	}
+	cache := &mockGatewayCacheForPlatform{sessionBindings: sessionBindings}
+	return &GatewayService{accountRepo: repo, cache: cache, cfg: testConfig()}, cache, ctx
+}
+
+func TestGatewayService_LegacySelectionSkipsRolledBackPool(t *testing.T) {
+	svc, _, ctx := newRolledBackPoolGatewayService(t, nil)
+	groupID := int64(1)
+
+	acc, err := svc.selectAccountWithMixedScheduling(ctx, &groupID, "", "", nil, PlatformAnthropic)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), acc.ID)
+
+	acc, err = svc.selectAccountForModelWithPlatform(ctx, &groupID, "", "", nil, PlatformAnthropic)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), acc.ID)
+
+	_, err = svc.selectAccountForModelWithPlatform(ctx, &groupID, "", "", map[int64]struct{}{2: {}}, PlatformAnthropic)
+	require.ErrorIs(t, err, ErrNoWeightedPoolAccounts)
+}
+
+func TestGatewayService_StickySessionDroppedForRolledBackPool(t *testing.T) {
+	svc, cache, ctx := newRolledBackPoolGatewayService(t, map[string]int64{"session-1": 1})
+	groupID := int64(1)
+
+	acc, err := svc.selectAccountWithMixedScheduling(ctx, &groupID, "session-1", "", nil, PlatformAnthropic)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), acc.ID)
+	require.Equal(t, 1, cache.deletedSessions["session-1"], "binding to the rolled-back pool is dropped")
+	require.Equal(t, int64(2), cache.sessionBindings["session-1"])
+}
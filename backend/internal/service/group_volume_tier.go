@@ -0,0 +1,75 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/volumetier"
+)
+
+// VolumeTierWindow 阶梯折扣按用户该时间窗口内的实际消费分档
+const VolumeTierWindow = 30 * 24 * time.Hour
+
+// MaxVolumeTiers 单个分组最多配置的档位数
+const MaxVolumeTiers = 10
+
+// VolumeTier 分组阶梯折扣档位（定义在 volumetier 包中，供 ent schema 引用）
+type VolumeTier = volumetier.Tier
+
+// AppliedVolumeTier 计费时命中的档位及用于分档的滚动消费
+type AppliedVolumeTier struct {
+	VolumeTier
+	Spend float64
+}
+
+// HasVolumeTiers 分组是否配置了阶梯折扣
+func (g *Group) HasVolumeTiers() bool {
+	return g != nil && len(g.VolumeTiers) > 0
+}
+
+// VolumeTierFor 返回消费额命中的档位（MinSpend 不超过 spend 的最高档），未命中返回 nil
+func (g *Group) VolumeTierFor(spend float64) *VolumeTier {
+	if !g.HasVolumeTiers() {
+		return nil
+	}
+	var matched *VolumeTier
+	for i := range g.VolumeTiers {
+		tier := &g.VolumeTiers[i]
+		if spend < tier.MinSpend {
+			continue
+		}
+		if matched == nil || tier.MinSpend > matched.MinSpend {
+			matched = tier
+		}
+	}
+	return matched
+}
+
+// normalizeVolumeTiers 校验档位并按 MinSpend 升序排列，空列表返回 nil（表示清除）
+func normalizeVolumeTiers(tiers []VolumeTier) ([]VolumeTier, error) {
+	if len(tiers) == 0 {
+		return nil, nil
+	}
+	if len(tiers) > MaxVolumeTiers {
+		return nil, infraerrors.BadRequest("INVALID_VOLUME_TIERS", fmt.Sprintf("volume tiers must not exceed %d entries", MaxVolumeTiers))
+	}
+	out := make([]VolumeTier, len(tiers))
+	copy(out, tiers)
+	sort.Slice(out, func(i, j int) bool { return out[i].MinSpend < out[j].MinSpend })
+
+	for i, tier := range out {
+		if math.IsNaN(tier.MinSpend) || math.IsInf(tier.MinSpend, 0) || tier.MinSpend < 0 {
+			return nil, infraerrors.BadRequest("INVALID_VOLUME_TIERS", "volume tier min_spend must be a non-negative number")
+		}
+		if math.IsNaN(tier.RateMultiplier) || math.IsInf(tier.RateMultiplier, 0) || tier.RateMultiplier <= 0 {
+			return nil, infraerrors.BadRequest("INVALID_VOLUME_TIERS", "volume tier rate_multiplier must be greater than 0")
+		}
+		if i > 0 && tier.MinSpend == out[i-1].MinSpend {
+			return nil, infraerrors.BadRequest("INVALID_VOLUME_TIERS", fmt.Sprintf("duplicate volume tier min_spend %g", tier.MinSpend))
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,98 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+type volumeTierUsageRepoStub struct {
+	UsageLogRepository
+	spend float64
+	since time.Time
+	calls int
+}
+
+func (s *volumeTierUsageRepoStub) GetUserSpendSince(_ context.Context, _ int64, since time.Time) (float64, error) {
+	s.calls++
+	s.since = since
+	return s.spend, nil
+}
+
+func TestGroupVolumeTierFor(t *testing.T) {
+	g := &Group{VolumeTiers: []VolumeTier{
+		{MinSpend: 1000, RateMultiplier: 0.8},
+		{MinSpend: 0, RateMultiplier: 1},
+		{MinSpend: 100, RateMultiplier: 0.9},
+	}}
+
+	require.Equal(t, 1.0, g.VolumeTierFor(0).RateMultiplier)
+	require.Equal(t, 1.0, g.VolumeTierFor(99.99).RateMultiplier)
+	require.Equal(t, 0.9, g.VolumeTierFor(100).RateMultiplier)
+	require.Equal(t, 0.8, g.VolumeTierFor(5000).RateMultiplier)
+
+	// 最低档门槛大于 0 时，未达到门槛不命中
+	g = &Group{VolumeTiers: []VolumeTier{{MinSpend: 100, RateMultiplier: 0.9}}}
+	require.Nil(t, g.VolumeTierFor(50))
+	require.Nil(t, (&Group{}).VolumeTierFor(50))
+}
+
+func TestNormalizeVolumeTiers(t *testing.T) {
+	tiers, err := normalizeVolumeTiers(nil)
+	require.NoError(t, err)
+	require.Nil(t, tiers)
+
+	tiers, err = normalizeVolumeTiers([]VolumeTier{{MinSpend: 1000, RateMultiplier: 0.8}, {MinSpend: 0, RateMultiplier: 1}})
+	require.NoError(t, err)
+	require.Equal(t, []VolumeTier{{MinSpend: 0, RateMultiplier: 1}, {MinSpend: 1000, RateMultiplier: 0.8}}, tiers)
+
+	_, err = normalizeVolumeTiers([]VolumeTier{{MinSpend: 100, RateMultiplier: 0.9}, {MinSpend: 100, RateMultiplier: 0.8}})
+	require.ErrorContains(t, err, "duplicate volume tier")
+
+	_, err = normalizeVolumeTiers([]VolumeTier{{MinSpend: -1, RateMultiplier: 0.9}})
+	require.ErrorContains(t, err, "min_spend")
+
+	_, err = normalizeVolumeTiers([]VolumeTier{{MinSpend: 0, RateMultiplier: 0}})
+	require.ErrorContains(t, err, "rate_multiplier")
+}
+
+func TestBillingCacheService_ResolveVolumeTier(t *testing.T) {
+	cache := &billingCacheWorkerStub{}
+	usageRepo := &volumeTierUsageRepoStub{spend: 250}
+	svc := NewBillingCacheService(cache, nil, nil, usageRepo, &config.Config{})
+	t.Cleanup(svc.Stop)
+
+	group := &Group{ID: 1, VolumeTiers: []VolumeTier{
+		{MinSpend: 0, RateMultiplier: 1},
+		{MinSpend: 100, RateMultiplier: 0.9},
+		{MinSpend: 1000, RateMultiplier: 0.8},
+	}}
+
+	tier := svc.ResolveVolumeTier(context.Background(), 7, group)
+	require.NotNil(t, tier)
+	require.Equal(t, 0.9, tier.RateMultiplier)
+	require.Equal(t, 100.0, tier.MinSpend)
+	require.Equal(t, 250.0, tier.Spend)
+	require.Equal(t, 1, usageRepo.calls)
+	require.WithinDuration(t, time.Now().Add(-VolumeTierWindow), usageRepo.since, time.Minute)
+
+	// 缓存未命中时回源并异步写入缓存
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt64(&cache.rollingSpendUpdates) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// 未配置档位的分组不统计消费
+	require.Nil(t, svc.ResolveVolumeTier(context.Background(), 7, &Group{ID: 2}))
+	require.Equal(t, 1, usageRepo.calls)
+
+	// 简易模式不启用阶梯折扣
+	simple := NewBillingCacheService(cache, nil, nil, usageRepo, &config.Config{RunMode: config.RunModeSimple})
+	t.Cleanup(simple.Stop)
+	require.Nil(t, simple.ResolveVolumeTier(context.Background(), 7, group))
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+var (
+	ErrPriceOverrideNotFound = infraerrors.NotFound("PRICE_OVERRIDE_NOT_FOUND", "price override not found")
+	ErrPriceOverrideExists   = infraerrors.Conflict("PRICE_OVERRIDE_EXISTS", "a price override with the same model, group and effective time already exists")
+	ErrInvalidPriceOverride  = infraerrors.BadRequest("INVALID_PRICE_OVERRIDE", "invalid price override")
+)
+
+// ModelPriceOverride 管理员维护的模型价格覆盖
+// token 类价格单位为 USD / 百万 token，ImagePrice 为 USD / 张；nil 表示沿用 LiteLLM/回退价格
+type ModelPriceOverride struct {
+	ID                int64     `json:"id"`
+	Model             string    `json:"model"`
+	GroupID           *int64    `json:"group_id"`
+	InputPrice        *float64  `json:"input_price"`
+	OutputPrice       *float64  `json:"output_price"`
+	CacheWrite5mPrice *float64  `json:"cache_write_5m_price"`
+	CacheWrite1hPrice *float64  `json:"cache_write_1h_price"`
+	CacheReadPrice    *float64  `json:"cache_read_price"`
+	ImagePrice        *float64  `json:"image_price"`
+	EffectiveFrom     time.Time `json:"effective_from"`
+	Notes             string    `json:"notes"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// apply 将覆盖价格叠加到基础价格上（基础价格不会被修改）
+func (o *ModelPriceOverride) apply(base *ModelPricing) *ModelPricing {
+	out := *base
+	out.Source = o.pricingSource()
+	if o.InputPrice != nil {
+		out.InputPricePerToken = *o.InputPrice / 1_000_000
+	}
+	if o.OutputPrice != nil {
+		out.OutputPricePerToken = *o.OutputPrice / 1_000_000
+	}
+	if o.CacheWrite5mPrice != nil {
+		// 上游未区分缓存 TTL 时按 5 分钟缓存价格计费
+		out.CacheCreationPricePerToken = *o.CacheWrite5mPrice / 1_000_000
+		out.CacheCreation5mPrice = *o.CacheWrite5mPrice
+	}
+	if o.CacheWrite1hPrice != nil {
+		out.CacheCreation1hPrice = *o.CacheWrite1hPrice
+		if out.CacheCreation5mPrice <= 0 {
+			out.CacheCreation5mPrice = out.CacheCreationPricePerToken * 1_000_000
+		}
+		out.SupportsCacheBreakdown = true
+	}
+	if o.CacheReadPrice != nil {
+		out.CacheReadPricePerToken = *o.CacheReadPrice / 1_000_000
+	}
+	return &out
+}
+
+// pricingSource 价格版本中的来源标识
+func (o *ModelPriceOverride) pricingSource() string {
+	return fmt.Sprintf("override:%d", o.ID)
+}
+
+// ModelPriceOverrideFilters 价格覆盖查询过滤条件
+type ModelPriceOverrideFilters struct {
+	Model   string
+	GroupID *int64
+}
+
+// ModelPriceOverrideInput 创建/更新价格覆盖的输入（更新为整体替换）
+type ModelPriceOverrideInput struct {
+	Model             string
+	GroupID           *int64
+	InputPrice        *float64
+	OutputPrice       *float64
+	CacheWrite5mPrice *float64
+	CacheWrite1hPrice *float64
+	CacheReadPrice    *float64
+	ImagePrice        *float64
+	EffectiveFrom     *time.Time
+	Notes             string
+}
+
+type ModelPriceOverrideRepository interface {
+	Create(ctx context.Context, override *ModelPriceOverride) error
+	Update(ctx context.Context, override *ModelPriceOverride) error
+	Delete(ctx context.Context, id int64) error
+	GetByID(ctx context.Context, id int64) (*ModelPriceOverride, error)
+	List(ctx context.Context, params pagination.PaginationParams, filters ModelPriceOverrideFilters) ([]ModelPriceOverride, *pagination.PaginationResult, error)
+	// ListAll 返回全部覆盖记录，用于构建内存快照
+	ListAll(ctx context.Context) ([]ModelPriceOverride, error)
+}
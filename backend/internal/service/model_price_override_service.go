@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+const (
+	priceOverrideRefreshWorkerName = "model_price_override_refresh"
+	// 多实例部署时其他节点的修改最迟在一个刷新周期后生效
+	priceOverrideRefreshInterval = time.Minute
+	priceOverrideRefreshTimeout  = 10 * time.Second
+)
+
+// priceOverrideSnapshot 按模型名（小写）索引的覆盖记录，同一模型内按生效时间倒序
+type priceOverrideSnapshot map[string][]ModelPriceOverride
+
+// ModelPriceOverrideService 模型价格覆盖
+//
+// 覆盖记录保存在数据库中，计费热路径只读取内存快照：
+// 本节点增删改后立即重建快照，并定期刷新以同步其他节点的修改。
+type ModelPriceOverrideService struct {
+	repo        ModelPriceOverrideRepository
+	groupRepo   GroupRepository
+	timingWheel *TimingWheelService
+
+	snapshot  atomic.Pointer[priceOverrideSnapshot]
+	startOnce sync.Once
+	stopOnce  sync.Once
+}
+
+func NewModelPriceOverrideService(repo ModelPriceOverrideRepository, groupRepo GroupRepository, timingWheel *TimingWheelService) *ModelPriceOverrideService {
+	return &ModelPriceOverrideService{
+		repo:        repo,
+		groupRepo:   groupRepo,
+		timingWheel: timingWheel,
+	}
+}
+
+func (s *ModelPriceOverrideService) Start() {
+	if s == nil || s.repo == nil {
+		return
+	}
+	s.startOnce.Do(func() {
+		s.refresh()
+		if s.timingWheel != nil {
+			s.timingWheel.ScheduleRecurring(priceOverrideRefreshWorkerName, priceOverrideRefreshInterval, s.refresh)
+		}
+	})
+}
+
+func (s *ModelPriceOverrideService) Stop() {
+	if s == nil {
+		return
+	}
+	s.stopOnce.Do(func() {
+		if s.timingWheel != nil {
+			s.timingWheel.Cancel(priceOverrideRefreshWorkerName)
+		}
+	})
+}
+
+func (s *ModelPriceOverrideService) refresh() {
+	ctx, cancel := context.WithTimeout(context.Background(), priceOverrideRefreshTimeout)
+	defer cancel()
+	if err := s.reload(ctx); err != nil {
+		log.Printf("[PriceOverride] refresh failed: %v", err)
+	}
+}
+
+func (s *ModelPriceOverrideService) reload(ctx context.Context) error {
+	items, err := s.repo.ListAll(ctx)
+	if err != nil {
+		return err
+	}
+	s.setSnapshot(items)
+	return nil
+}
+
+func (s *ModelPriceOverrideService) setSnapshot(items []ModelPriceOverride) {
+	snap := make(priceOverrideSnapshot)
+	for _, item := range items {
+		key := strings.ToLower(item.Model)
+		snap[key] = append(snap[key], item)
+	}
+	for _, list := range snap {
+		sort.SliceStable(list, func(i, j int) bool {
+			return list[i].EffectiveFrom.After(list[j].EffectiveFrom)
+		})
+	}
+	s.snapshot.Store(&snap)
+}
+
+// Resolve 返回模型在 at 时刻生效的覆盖：分组级覆盖优先，其次全局覆盖；无覆盖时返回 nil
+func (s *ModelPriceOverrideService) Resolve(model string, groupID *int64, at time.Time) *ModelPriceOverride {
+	if s == nil {
+		return nil
+	}
+	snap := s.snapshot.Load()
+	if snap == nil {
+		return nil
+	}
+	list := (*snap)[strings.ToLower(model)]
+	if len(list) == 0 {
+		return nil
+	}
+	var global *ModelPriceOverride
+	for i := range list {
+		o := &list[i]
+		if o.EffectiveFrom.After(at) {
+			continue
+		}
+		if o.GroupID == nil {
+			if global == nil {
+				global = o
+			}
+			continue
+		}
+		if groupID != nil && *o.GroupID == *groupID {
+			return o
+		}
+	}
+	return global
+}
+
+// List 分页查询价格覆盖
+func (s *ModelPriceOverrideService) List(ctx context.Context, params pagination.PaginationParams, filters ModelPriceOverrideFilters) ([]ModelPriceOverride, *pagination.PaginationResult, error) {
+	items, result, err := s.repo.List(ctx, params, filters)
+	if err != nil {
+		return nil, nil, fmt.Errorf("list price overrides: %w", err)
+	}
+	return items, result, nil
+}
+
+// GetByID 查询价格覆盖
+func (s *ModelPriceOverrideService) GetByID(ctx context.Context, id int64) (*ModelPriceOverride, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+// Create 创建价格覆盖
+func (s *ModelPriceOverrideService) Create(ctx context.Context, input ModelPriceOverrideInput) (*ModelPriceOverride, error) {
+	override := &ModelPriceOverride{}
+	if err := s.applyInput(ctx, override, input); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(ctx, override); err != nil {
+		return nil, err
+	}
+	s.reloadAfterWrite(ctx)
+	return override, nil
+}
+
+// Update 整体替换价格覆盖
+func (s *ModelPriceOverrideService) Update(ctx context.Context, id int64, input ModelPriceOverrideInput) (*ModelPriceOverride, error) {
+	override, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyInput(ctx, override, input); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(ctx, override); err != nil {
+		return nil, err
+	}
+	s.reloadAfterWrite(ctx)
+	return override, nil
+}
+
+// Delete 删除价格覆盖
+func (s *ModelPriceOverrideService) Delete(ctx context.Context, id int64) error {
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.reloadAfterWrite(ctx)
+	return nil
+}
+
+func (s *ModelPriceOverrideService) reloadAfterWrite(ctx context.Context) {
+	if err := s.reload(ctx); err != nil {
+		// 写入已成功，快照由定时刷新兜底
+		log.Printf("[PriceOverride] reload after write failed: %v", err)
+	}
+}
+
+func (s *ModelPriceOverrideService) applyInput(ctx context.Context, override *ModelPriceOverride, input ModelPriceOverrideInput) error {
+	model := strings.ToLower(strings.TrimSpace(input.Model))
+	if model == "" {
+		return ErrInvalidPriceOverride.WithCause(fmt.Errorf("model is required"))
+	}
+	prices := []*float64{input.InputPrice, input.OutputPrice, input.CacheWrite5mPrice, input.CacheWrite1hPrice, input.CacheReadPrice, input.ImagePrice}
+	hasPrice := false
+	for _, p := range prices {
+		if p == nil {
+			continue
+		}
+		if *p < 0 {
+			return ErrInvalidPriceOverride.WithCause(fmt.Errorf("price must not be negative"))
+		}
+		hasPrice = true
+	}
+	if !hasPrice {
+		return ErrInvalidPriceOverride.WithCause(fmt.Errorf("at least one price is required"))
+	}
+	if input.GroupID != nil && s.groupRepo != nil {
+		if _, err := s.groupRepo.GetByID(ctx, *input.GroupID); err != nil {
+			return err
+		}
+	}
+
+	override.Model = model
+	override.GroupID = input.GroupID
+	override.InputPrice = input.InputPrice
+	override.OutputPrice = input.OutputPrice
+	override.CacheWrite5mPrice = input.CacheWrite5mPrice
+	override.CacheWrite1hPrice = input.CacheWrite1hPrice
+	override.CacheReadPrice = input.CacheReadPrice
+	override.ImagePrice = input.ImagePrice
+	override.Notes = strings.TrimSpace(input.Notes)
+	if input.EffectiveFrom != nil {
+		override.EffectiveFrom = *input.EffectiveFrom
+	} else if override.EffectiveFrom.IsZero() {
+		override.EffectiveFrom = time.Now()
+	}
+	return nil
+}
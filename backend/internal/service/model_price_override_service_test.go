@@ -0,0 +1,142 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/stretchr/testify/require"
+)
+
+type priceOverrideRepoStub struct {
+	items  []ModelPriceOverride
+	nextID int64
+}
+
+func (s *priceOverrideRepoStub) Create(_ context.Context, o *ModelPriceOverride) error {
+	s.nextID++
+	o.ID = s.nextID
+	s.items = append(s.items, *o)
+	return nil
+}
+
+func (s *priceOverrideRepoStub) Update(_ context.Context, o *ModelPriceOverride) error {
+	for i := range s.items {
+		if s.items[i].ID == o.ID {
+			s.items[i] = *o
+			return nil
+		}
+	}
+	return ErrPriceOverrideNotFound
+}
+
+func (s *priceOverrideRepoStub) Delete(_ context.Context, id int64) error {
+	for i := range s.items {
+		if s.items[i].ID == id {
+			s.items = append(s.items[:i], s.items[i+1:]...)
+			return nil
+		}
+	}
+	return ErrPriceOverrideNotFound
+}
+
+func (s *priceOverrideRepoStub) GetByID(_ context.Context, id int64) (*ModelPriceOverride, error) {
+	for i := range s.items {
+		if s.items[i].ID == id {
+			o := s.items[i]
+			return &o, nil
+		}
+	}
+	return nil, ErrPriceOverrideNotFound
+}
+
+func (s *priceOverrideRepoStub) List(context.Context, pagination.PaginationParams, ModelPriceOverrideFilters) ([]ModelPriceOverride, *pagination.PaginationResult, error) {
+	return s.items, &pagination.PaginationResult{Total: int64(len(s.items))}, nil
+}
+
+func (s *priceOverrideRepoStub) ListAll(context.Context) ([]ModelPriceOverride, error) {
+	return append([]ModelPriceOverride(nil), s.items...), nil
+}
+
+func priceOverrideFloat(v float64) *float64 { return &v }
+
+func priceOverrideTime(v time.Time) *time.Time { return &v }
+
+func TestModelPriceOverrideService_ResolvePrecedence(t *testing.T) {
+	repo := &priceOverrideRepoStub{}
+	svc := NewModelPriceOverrideService(repo, nil, nil)
+	ctx := context.Background()
+	now := time.Now()
+	groupID := int64(7)
+
+	_, err := svc.Create(ctx, ModelPriceOverrideInput{Model: "Claude-New", InputPrice: priceOverrideFloat(2), EffectiveFrom: priceOverrideTime(now.Add(-2 * time.Hour))})
+	require.NoError(t, err)
+	_, err = svc.Create(ctx, ModelPriceOverrideInput{Model: "claude-new", InputPrice: priceOverrideFloat(3), EffectiveFrom: priceOverrideTime(now.Add(-time.Hour))})
+	require.NoError(t, err)
+	_, err = svc.Create(ctx, ModelPriceOverrideInput{Model: "claude-new", InputPrice: priceOverrideFloat(9), EffectiveFrom: priceOverrideTime(now.Add(time.Hour))})
+	require.NoError(t, err)
+	_, err = svc.Create(ctx, ModelPriceOverrideInput{Model: "claude-new", GroupID: &groupID, InputPrice: priceOverrideFloat(1), EffectiveFrom: priceOverrideTime(now.Add(-time.Minute))})
+	require.NoError(t, err)
+
+	// 最近生效的全局覆盖；未来生效的记录不参与
+	o := svc.Resolve("CLAUDE-NEW", nil, now)
+	require.NotNil(t, o)
+	require.Equal(t, 3.0, *o.InputPrice)
+
+	// 分组级覆盖优先
+	o = svc.Resolve("claude-new", &groupID, now)
+	require.NotNil(t, o)
+	require.Equal(t, 1.0, *o.InputPrice)
+
+	otherGroup := int64(8)
+	require.Equal(t, 3.0, *svc.Resolve("claude-new", &otherGroup, now).InputPrice)
+	require.Equal(t, 9.0, *svc.Resolve("claude-new", nil, now.Add(2*time.Hour)).InputPrice)
+	require.Nil(t, svc.Resolve("claude-other", nil, now))
+}
+
+func TestModelPriceOverrideService_Validation(t *testing.T) {
+	svc := NewModelPriceOverrideService(&priceOverrideRepoStub{}, nil, nil)
+	ctx := context.Background()
+
+	_, err := svc.Create(ctx, ModelPriceOverrideInput{Model: " ", InputPrice: priceOverrideFloat(1)})
+	require.ErrorIs(t, err, ErrInvalidPriceOverride)
+	_, err = svc.Create(ctx, ModelPriceOverrideInput{Model: "m"})
+	require.ErrorIs(t, err, ErrInvalidPriceOverride)
+	_, err = svc.Create(ctx, ModelPriceOverrideInput{Model: "m", OutputPrice: priceOverrideFloat(-1)})
+	require.ErrorIs(t, err, ErrInvalidPriceOverride)
+}
+
+func TestBillingService_PriceOverrideApplied(t *testing.T) {
+	repo := &priceOverrideRepoStub{}
+	overrides := NewModelPriceOverrideService(repo, nil, nil)
+	billing := NewBillingService(&config.Config{}, nil, overrides)
+	ctx := context.Background()
+
+	// 仅覆盖输出价格，其余字段沿用回退价格（claude-sonnet-4: $3 / $15）
+	_, err := overrides.Create(ctx, ModelPriceOverrideInput{Model: "claude-sonnet-4-20250514", OutputPrice: priceOverrideFloat(20), CacheReadPrice: priceOverrideFloat(0)})
+	require.NoError(t, err)
+
+	cost, err := billing.CalculateCost("claude-sonnet-4-20250514", UsageTokens{InputTokens: 1_000_000, OutputTokens: 1_000_000, CacheReadTokens: 1_000_000}, 1)
+	require.NoError(t, err)
+	require.InDelta(t, 3.0, cost.InputCost, 1e-9)
+	require.InDelta(t, 20.0, cost.OutputCost, 1e-9)
+	require.InDelta(t, 0.0, cost.CacheReadCost, 1e-9)
+
+	// 缓存写入覆盖：未拆分 5m/1h token 时按 5 分钟价格计费
+	groupID := int64(3)
+	_, err = overrides.Create(ctx, ModelPriceOverrideInput{Model: "claude-sonnet-4-20250514", GroupID: &groupID, CacheWrite5mPrice: priceOverrideFloat(4), CacheWrite1hPrice: priceOverrideFloat(6), ImagePrice: priceOverrideFloat(0.5)})
+	require.NoError(t, err)
+	cost, err = billing.CalculateCostForGroup("claude-sonnet-4-20250514", &groupID, UsageTokens{CacheCreationTokens: 1_000_000}, 1)
+	require.NoError(t, err)
+	require.InDelta(t, 4.0, cost.CacheCreationCost, 1e-9)
+	cost, err = billing.CalculateCostForGroup("claude-sonnet-4-20250514", &groupID, UsageTokens{CacheCreation5mTokens: 1_000_000, CacheCreation1hTokens: 1_000_000}, 1)
+	require.NoError(t, err)
+	require.InDelta(t, 10.0, cost.CacheCreationCost, 1e-9)
+
+	image := billing.CalculateImageCost("claude-sonnet-4-20250514", "4K", 2, &ImagePriceConfig{GroupID: &groupID}, 1)
+	require.InDelta(t, 2.0, image.TotalCost, 1e-9)
+}
@@ -95,6 +95,8 @@ type OpenAIGatewayService struct {
 	deferredService     *DeferredService
 	openAITokenProvider *OpenAITokenProvider
 	toolCorrector       *CodexToolCorrector
+	spendCapService     *AccountSpendCapService
+	notificationService *UserNotificationService
 }
 
 // NewOpenAIGatewayService creates a new OpenAIGatewayService
@@ -113,6 +115,8 @@ func NewOpenAIGatewayService(
 	httpUpstream HTTPUpstream,
 	deferredService *DeferredService,
 	openAITokenProvider *OpenAITokenProvider,
+	spendCapService *AccountSpendCapService,
+	notificationService *UserNotificationService,
 ) *OpenAIGatewayService {
 	return &OpenAIGatewayService{
 		accountRepo:         accountRepo,
@@ -130,6 +134,8 @@ func NewOpenAIGatewayService(
 		deferredService:     deferredService,
 		openAITokenProvider: openAITokenProvider,
 		toolCorrector:       NewCodexToolCorrector(),
+		spendCapService:     spendCapService,
+		notificationService: notificationService,
 	}
 }
 
@@ -199,8 +205,10 @@ func (s *OpenAIGatewayService) SelectAccountForModelWithExclusions(ctx context.C
 
 	// 3. 按优先级 + LRU 选择最佳账号
 	// Select by priority + LRU
-	selected := s.selectBestAccount(accounts, requestedModel, excludedIDs)
-
+	selected, err := s.selectBestAccount(ctx, groupID, accounts, requestedModel, excludedIDs)
+	if err != nil {
+		return nil, err
+	}
 	if selected == nil {
 		if requestedModel != "" {
 			return nil, fmt.Errorf("no available OpenAI accounts supporting model: %s", requestedModel)
@@ -243,7 +251,7 @@ func (s *OpenAIGatewayService) tryStickySessionHit(ctx context.Context, groupID
 
 	// 检查账号是否需要清理粘性会话
 	// Check if sticky session should be cleared
-	if shouldClearStickySession(account) {
+	if shouldClearStickySession(account) || !isAccountInWeightedPool(ctx, groupID, account) {
 		_ = s.cache.DeleteSessionAccountID(ctx, derefGroupID(groupID), cacheKey)
 		return nil
 	}
@@ -263,14 +271,13 @@ func (s *OpenAIGatewayService) tryStickySessionHit(ctx context.Context, groupID
 	return account
 }
 
-// selectBestAccount 从候选账号中选择最佳账号（优先级 + LRU）。
+// selectBestAccount 从候选账号中选择最佳账号（优先级 + LRU），先按分组子池权重选定子池。
 // 返回 nil 表示无可用账号。
 //
-// selectBestAccount selects the best account from candidates (priority + LRU).
+// selectBestAccount selects the best account from candidates (priority + LRU) within the weighted sub-pool.
 // Returns nil if no available account.
-func (s *OpenAIGatewayService) selectBestAccount(accounts []Account, requestedModel string, excludedIDs map[int64]struct{}) *Account {
-	var selected *Account
-
+func (s *OpenAIGatewayService) selectBestAccount(ctx context.Context, groupID *int64, accounts []Account, requestedModel string, excludedIDs map[int64]struct{}) (*Account, error) {
+	var candidates []*Account
 	for i := range accounts {
 		acc := &accounts[i]
 
@@ -292,19 +299,25 @@ func (s *OpenAIGatewayService) selectBestAccount(accounts []Account, requestedMo
 			continue
 		}
 
-		// 选择优先级最高且最久未使用的账号
-		// Select highest priority and least recently used
-		if selected == nil {
-			selected = acc
-			continue
-		}
+		candidates = append(candidates, acc)
+	}
 
-		if s.isBetterAccount(acc, selected) {
+	// 分组子池分流：按权重选定本次请求的子池
+	// Pool split: pick the sub-pool for this request by group weights
+	candidates, err := applyGroupPoolSplit(ctx, groupID, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	// 选择优先级最高且最久未使用的账号
+	// Select highest priority and least recently used
+	var selected *Account
+	for _, acc := range candidates {
+		if selected == nil || s.isBetterAccount(acc, selected) {
 			selected = acc
 		}
 	}
-
-	return selected
+	return selected, nil
 }
 
 // isBetterAccount 判断 candidate 是否比 current 更优。
@@ -409,7 +422,7 @@ func (s *OpenAIGatewayService) SelectAccountWithLoadAwareness(ctx context.Contex
 		if err == nil && accountID > 0 && !isExcluded(accountID) {
 			account, err := s.getSchedulableAccount(ctx, accountID)
 			if err == nil {
-				clearSticky := shouldClearStickySession(account)
+				clearSticky := shouldClearStickySession(account) || !isAccountInWeightedPool(ctx, groupID, account)
 				if clearSticky {
 					_ = s.cache.DeleteSessionAccountID(ctx, derefGroupID(groupID), "openai:"+sessionHash)
 				}
@@ -465,6 +478,12 @@ func (s *OpenAIGatewayService) SelectAccountWithLoadAwareness(ctx context.Contex
 		return nil, errors.New("no available accounts")
 	}
 
+	// Pool split: pick the sub-pool (e.g. canary) for this request by group weights.
+	candidates, err = applyGroupPoolSplit(ctx, groupID, candidates)
+	if err != nil {
+		return nil, err
+	}
+
 	accountLoads := make([]AccountWithConcurrency, 0, len(candidates))
 	for _, acc := range candidates {
 		accountLoads = append(accountLoads, AccountWithConcurrency{
@@ -1549,6 +1568,9 @@ type OpenAIRecordUsageInput struct {
 	Subscription *UserSubscription
 	UserAgent    string // 请求的 User-Agent
 	IPAddress    string // 请求的客户端 IP 地址
+
+	// Balance reservation made before forwarding (optional); settled after deduction, released on any early return
+	Reservation *BalanceReservation
 }
 
 // RecordUsage records usage and deducts balance
@@ -1556,8 +1578,11 @@ func (s *OpenAIGatewayService) RecordUsage(ctx context.Context, input *OpenAIRec
 	result := input.Result
 	apiKey := input.APIKey
 	user := input.User
+	// 组织 Key 由组织计费账户付费，使用记录仍归属发起请求的成员
+	payer := apiKey.Payer(user)
 	account := input.Account
 	subscription := input.Subscription
+	defer input.Reservation.Release()
 
 	// 计算实际的新输入token（减去缓存读取的token）
 	// 因为 input_tokens 包含了 cache_read_tokens，而缓存读取的token不应按输入价格计费
@@ -1580,11 +1605,6 @@ func (s *OpenAIGatewayService) RecordUsage(ctx context.Context, input *OpenAIRec
 		multiplier = apiKey.Group.RateMultiplier
 	}
 
-	cost, err := s.billingService.CalculateCost(result.Model, tokens, multiplier)
-	if err != nil {
-		cost = &CostBreakdown{ActualCost: 0}
-	}
-
 	// Determine billing type
 	isSubscriptionBilling := subscription != nil && apiKey.Group != nil && apiKey.Group.IsSubscriptionType()
 	billingType := BillingTypeBalance
@@ -1592,6 +1612,20 @@ func (s *OpenAIGatewayService) RecordUsage(ctx context.Context, input *OpenAIRec
 		billingType = BillingTypeSubscription
 	}
 
+	// Volume tier discount (balance billing only)
+	var volumeTier *AppliedVolumeTier
+	if !isSubscriptionBilling {
+		volumeTier = s.billingCacheService.ResolveVolumeTier(ctx, payer.ID, apiKey.Group)
+		if volumeTier != nil {
+			multiplier *= volumeTier.RateMultiplier
+		}
+	}
+
+	cost, err := s.billingService.CalculateCostForGroup(result.Model, apiKey.GroupID, tokens, multiplier)
+	if err != nil {
+		cost = &CostBreakdown{ActualCost: 0}
+	}
+
 	// Create usage log
 	durationMs := int(result.Duration.Milliseconds())
 	accountRateMultiplier := account.BillingRateMultiplier()
@@ -1619,6 +1653,16 @@ func (s *OpenAIGatewayService) RecordUsage(ctx context.Context, input *OpenAIRec
 		FirstTokenMs:          result.FirstTokenMs,
 		CreatedAt:             time.Now(),
 	}
+	usageLog.ApplyPricing(cost.Pricing)
+	usageLog.ApplyVolumeTier(volumeTier)
+	usageLog.ApplyOrganization(apiKey.OrganizationBilling)
+
+	// 订阅超额计费：超出订阅剩余额度的部分按超额倍率从余额扣除
+	var overage *SubscriptionCostSplit
+	if isSubscriptionBilling {
+		overage = s.billingCacheService.SplitSubscriptionCost(ctx, payer.ID, apiKey.Group, subscription, cost.TotalCost)
+	}
+	usageLog.ApplyOverage(overage)
 
 	// 添加 UserAgent
 	if input.UserAgent != "" {
@@ -1638,6 +1682,9 @@ func (s *OpenAIGatewayService) RecordUsage(ctx context.Context, input *OpenAIRec
 	}
 
 	inserted, err := s.usageLogRepo.Create(ctx, usageLog)
+	if inserted {
+		s.spendCapService.RecordSpend(ctx, account, cost.TotalCost*accountRateMultiplier)
+	}
 	if s.cfg != nil && s.cfg.RunMode == config.RunModeSimple {
 		log.Printf("[SIMPLE MODE] Usage recorded (not billed): user=%d, tokens=%d", usageLog.UserID, usageLog.TotalTokens())
 		s.deferredService.ScheduleLastUsedUpdate(account.ID)
@@ -1648,15 +1695,46 @@ func (s *OpenAIGatewayService) RecordUsage(ctx context.Context, input *OpenAIRec
 
 	// Deduct based on billing type
 	if isSubscriptionBilling {
-		if shouldBill && cost.TotalCost > 0 {
-			_ = s.userSubRepo.IncrementUsage(ctx, subscription.ID, cost.TotalCost)
-			s.billingCacheService.QueueUpdateSubscriptionUsage(user.ID, *apiKey.GroupID, cost.TotalCost)
+		subscriptionCost := cost.TotalCost
+		if overage != nil {
+			subscriptionCost = overage.SubscriptionCost
+		}
+		if shouldBill && subscriptionCost > 0 {
+			_ = s.userSubRepo.IncrementUsage(ctx, subscription.ID, subscriptionCost)
+			s.billingCacheService.QueueUpdateSubscriptionUsage(payer.ID, *apiKey.GroupID, subscriptionCost)
+			s.billingCacheService.QueueAddRollingWindowUsage(payer.ID, apiKey.Group, subscriptionCost)
+			s.notificationService.CheckAfterUsage(UsageNotificationInput{
+				UserID:           payer.ID,
+				Subscription:     subscription,
+				Group:            apiKey.Group,
+				SubscriptionCost: subscriptionCost,
+			})
+		}
+		// Overage beyond subscription limits is billed from balance
+		if shouldBill && overage != nil && overage.OverageCost > 0 {
+			if tx, err := s.userRepo.ApplyBalanceChange(ctx, usageBalanceChange(payer.ID, usageLog, overage.OverageCost)); err == nil && tx != nil {
+				s.notificationService.CheckAfterUsage(UsageNotificationInput{UserID: payer.ID, BalanceAfter: &tx.BalanceAfter})
+			}
+			s.billingCacheService.QueueDeductBalance(payer.ID, overage.OverageCost)
+		}
+		// Request/token quotas count every billed request regardless of cost
+		if shouldBill && apiKey.Group.HasUsageQuotas() {
+			recordSubscriptionQuotaUsage(ctx, s.userSubRepo, apiKey.Group, subscription, usageLog)
 		}
 	} else {
 		if shouldBill && cost.ActualCost > 0 {
-			_ = s.userRepo.DeductBalance(ctx, user.ID, cost.ActualCost)
-			s.billingCacheService.QueueDeductBalance(user.ID, cost.ActualCost)
+			if tx, err := s.userRepo.ApplyBalanceChange(ctx, usageBalanceChange(payer.ID, usageLog, cost.ActualCost)); err == nil && tx != nil {
+				s.notificationService.CheckAfterUsage(UsageNotificationInput{UserID: payer.ID, BalanceAfter: &tx.BalanceAfter})
+			}
+			s.billingCacheService.QueueDeductBalance(payer.ID, cost.ActualCost)
 		}
+		// Actual cost deducted; release the rest of the reservation
+		input.Reservation.Settle(cost.ActualCost)
+	}
+
+	// Update API key limit usage cache
+	if shouldBill && apiKey.HasUsageLimits() {
+		s.billingCacheService.QueueUpdateAPIKeyUsage(apiKey.ID, cost.ActualCost)
 	}
 
 	// Schedule batch update for account last_used_at
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+)
+
+// OpsPoolComparisonPoint 单个子池在一个时间桶内的请求表现
+type OpsPoolComparisonPoint struct {
+	BucketStart  time.Time `json:"bucket_start"`
+	SuccessCount int64     `json:"success_count"`
+	ErrorCount   int64     `json:"error_count"`
+	ErrorRate    float64   `json:"error_rate"`
+	AvgLatencyMs float64   `json:"avg_latency_ms"`
+}
+
+// OpsPoolComparisonItem 单个子池在时间范围内的汇总与趋势
+// 错误仅统计上游（provider）错误，不含业务限流与 count_tokens 请求
+type OpsPoolComparisonItem struct {
+	Pool         string  `json:"pool"`
+	SuccessCount int64   `json:"success_count"`
+	ErrorCount   int64   `json:"error_count"`
+	ErrorRate    float64 `json:"error_rate"`
+	AvgLatencyMs float64 `json:"avg_latency_ms"`
+
+	Points []*OpsPoolComparisonPoint `json:"points"`
+}
+
+type OpsPoolComparisonResponse struct {
+	GroupID int64                    `json:"group_id"`
+	Bucket  string                   `json:"bucket"`
+	Pools   []*OpsPoolComparisonItem `json:"pools"`
+}
+
+// GetPoolComparison 返回分组内各子池（灰度池/默认池）的请求量、错误率与延迟对比
+func (s *OpsService) GetPoolComparison(ctx context.Context, filter *OpsDashboardFilter, bucketSeconds int) (*OpsPoolComparisonResponse, error) {
+	if err := s.RequireMonitoringEnabled(ctx); err != nil {
+		return nil, err
+	}
+	if s.opsRepo == nil {
+		return nil, infraerrors.ServiceUnavailable("OPS_REPO_UNAVAILABLE", "Ops repository not available")
+	}
+	if filter == nil {
+		return nil, infraerrors.BadRequest("OPS_FILTER_REQUIRED", "filter is required")
+	}
+	if filter.GroupID == nil || *filter.GroupID <= 0 {
+		return nil, infraerrors.BadRequest("OPS_GROUP_REQUIRED", "group_id is required")
+	}
+	if filter.StartTime.IsZero() || filter.EndTime.IsZero() {
+		return nil, infraerrors.BadRequest("OPS_TIME_RANGE_REQUIRED", "start_time/end_time are required")
+	}
+	if filter.StartTime.After(filter.EndTime) {
+		return nil, infraerrors.BadRequest("OPS_TIME_RANGE_INVALID", "start_time must be <= end_time")
+	}
+	return s.opsRepo.GetPoolComparison(ctx, filter, bucketSeconds)
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+// 组织（团队）共享计费
+// 每个组织对应一个计费账户（users 表中的专用用户）：共享余额即计费账户余额，共享订阅即分配给计费账户的订阅。
+// 成员的 API Key 绑定到组织后由计费账户付费，使用记录仍归属成员，并按成员统计用量与月度限额。
+
+// 组织成员角色
+const (
+	OrganizationRoleOwner  = "owner"
+	OrganizationRoleAdmin  = "admin"
+	OrganizationRoleMember = "member"
+)
+
+// organizationBillingEmailDomain 计费账户使用的保留域名邮箱，不可接收邮件也无法通过注册占用
+const organizationBillingEmailDomain = "organization.invalid"
+
+var (
+	ErrOrganizationNotFound        = infraerrors.NotFound("ORGANIZATION_NOT_FOUND", "organization not found")
+	ErrOrganizationDisabled        = infraerrors.Forbidden("ORGANIZATION_DISABLED", "organization is disabled")
+	ErrOrganizationMemberNotFound  = infraerrors.NotFound("ORGANIZATION_MEMBER_NOT_FOUND", "organization member not found")
+	ErrOrganizationMemberExists    = infraerrors.Conflict("ORGANIZATION_MEMBER_EXISTS", "user is already a member of this organization")
+	ErrOrganizationForbidden       = infraerrors.Forbidden("ORGANIZATION_FORBIDDEN", "insufficient organization permissions")
+	ErrOrganizationInvalidRole     = infraerrors.BadRequest("ORGANIZATION_INVALID_ROLE", "role must be admin or member")
+	ErrOrganizationOwnerImmutable  = infraerrors.BadRequest("ORGANIZATION_OWNER_IMMUTABLE", "the organization owner cannot be removed or demoted")
+	ErrOrganizationInvalidName     = infraerrors.BadRequest("ORGANIZATION_INVALID_NAME", "organization name must be 1-100 characters")
+	ErrOrganizationInvalidAmount   = infraerrors.BadRequest("ORGANIZATION_INVALID_AMOUNT", "amount must be greater than 0")
+	ErrOrganizationInvalidStatus   = infraerrors.BadRequest("ORGANIZATION_INVALID_STATUS", "status must be active or disabled")
+	ErrOrganizationSpendCapReached = infraerrors.TooManyRequests("ORGANIZATION_SPEND_CAP_EXCEEDED", "monthly organization spend cap exceeded for this member")
+	ErrAPIKeyOrganizationBound     = infraerrors.BadRequest("API_KEY_ORGANIZATION_BOUND", "api key is bound to an organization, change its group through the organization")
+)
+
+// Organization 组织
+type Organization struct {
+	ID            int64
+	Name          string
+	BillingUserID int64
+	Status        string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+
+	// 列表/详情查询时填充
+	Balance     float64
+	MemberCount int64
+	// Role 当前用户在组织中的角色（仅“我的组织”列表填充）
+	Role string
+}
+
+func (o *Organization) IsActive() bool {
+	return o.Status == StatusActive
+}
+
+// OrganizationMember 组织成员
+type OrganizationMember struct {
+	ID             int64
+	OrganizationID int64
+	UserID         int64
+	Role           string
+	// MonthlySpendCapUSD 成员每自然月可消耗的组织额度（actual_cost），nil 表示不限制
+	MonthlySpendCapUSD *float64
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+
+	Email    string
+	Username string
+}
+
+// CanManage 是否可管理成员与组织 Key
+func (m *OrganizationMember) CanManage() bool {
+	return m.Role == OrganizationRoleOwner || m.Role == OrganizationRoleAdmin
+}
+
+// OrganizationMemberUsage 成员在统计区间内消耗的组织额度
+type OrganizationMemberUsage struct {
+	UserID             int64
+	Email              string
+	Username           string
+	Role               string
+	MonthlySpendCapUSD *float64
+	Requests           int64
+	TotalTokens        int64
+	TotalCost          float64
+	ActualCost         float64
+}
+
+// OrganizationUsage 组织用量看板
+type OrganizationUsage struct {
+	StartTime  time.Time
+	EndTime    time.Time
+	Requests   int64
+	TotalCost  float64
+	ActualCost float64
+	Members    []OrganizationMemberUsage
+}
+
+// OrganizationBilling 组织 Key 的计费上下文
+// 由认证中间件按请求解析（不进入认证缓存，成员移除、组织停用即时生效）
+type OrganizationBilling struct {
+	OrganizationID int64
+	Member         *OrganizationMember
+	// Payer 组织计费账户（实时余额）
+	Payer *User
+	// MonthlySpend 成员本月已消耗的组织额度，仅在成员设置了月度限额时统计
+	MonthlySpend float64
+}
+
+// CheckSpendCap 检查成员是否已达到本月组织额度限额
+func (b *OrganizationBilling) CheckSpendCap() error {
+	if b == nil || b.Member == nil || b.Member.MonthlySpendCapUSD == nil {
+		return nil
+	}
+	if b.MonthlySpend >= *b.Member.MonthlySpendCapUSD {
+		return ErrOrganizationSpendCapReached
+	}
+	return nil
+}
+
+type OrganizationRepository interface {
+	Create(ctx context.Context, org *Organization) error
+	GetByID(ctx context.Context, id int64) (*Organization, error)
+	Update(ctx context.Context, org *Organization) error
+	List(ctx context.Context, params pagination.PaginationParams, search string) ([]Organization, *pagination.PaginationResult, error)
+	// ListByUserID 用户所在的组织（填充 Role）
+	ListByUserID(ctx context.Context, userID int64) ([]Organization, error)
+
+	AddMember(ctx context.Context, member *OrganizationMember) error
+	GetMember(ctx context.Context, organizationID, userID int64) (*OrganizationMember, error)
+	UpdateMember(ctx context.Context, member *OrganizationMember) error
+	// RemoveMember 移除成员并解绑其绑定到该组织的 API Key
+	RemoveMember(ctx context.Context, organizationID, userID int64) error
+	ListMembers(ctx context.Context, organizationID int64) ([]OrganizationMember, error)
+
+	// SetAPIKeyBinding 设置 API Key 绑定的组织与分组（organizationID 为 nil 表示解绑）
+	SetAPIKeyBinding(ctx context.Context, apiKeyID int64, organizationID, groupID *int64) error
+
+	// GetMemberSpendSince 成员自 since 起消耗的组织额度（actual_cost）
+	GetMemberSpendSince(ctx context.Context, organizationID, userID int64, since time.Time) (float64, error)
+	// GetMemberUsage 按成员汇总统计区间内的组织用量（包含无用量的成员）
+	GetMemberUsage(ctx context.Context, organizationID int64, startTime, endTime time.Time) ([]OrganizationMemberUsage, error)
+}
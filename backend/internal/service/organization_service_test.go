@@ -0,0 +1,180 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type orgRepoStub struct {
+	OrganizationRepository
+	orgs    map[int64]*Organization
+	members map[int64]map[int64]*OrganizationMember
+	spend   float64
+	removed []int64
+}
+
+func newOrgRepoStub() *orgRepoStub {
+	return &orgRepoStub{
+		orgs:    make(map[int64]*Organization),
+		members: make(map[int64]map[int64]*OrganizationMember),
+	}
+}
+
+func (r *orgRepoStub) addMember(orgID, userID int64, role string) {
+	if r.members[orgID] == nil {
+		r.members[orgID] = make(map[int64]*OrganizationMember)
+	}
+	r.members[orgID][userID] = &OrganizationMember{OrganizationID: orgID, UserID: userID, Role: role}
+}
+
+func (r *orgRepoStub) GetByID(ctx context.Context, id int64) (*Organization, error) {
+	org, ok := r.orgs[id]
+	if !ok {
+		return nil, ErrOrganizationNotFound
+	}
+	cp := *org
+	return &cp, nil
+}
+
+func (r *orgRepoStub) GetMember(ctx context.Context, organizationID, userID int64) (*OrganizationMember, error) {
+	member, ok := r.members[organizationID][userID]
+	if !ok {
+		return nil, ErrOrganizationMemberNotFound
+	}
+	cp := *member
+	return &cp, nil
+}
+
+func (r *orgRepoStub) UpdateMember(ctx context.Context, member *OrganizationMember) error {
+	cp := *member
+	r.members[member.OrganizationID][member.UserID] = &cp
+	return nil
+}
+
+func (r *orgRepoStub) RemoveMember(ctx context.Context, organizationID, userID int64) error {
+	delete(r.members[organizationID], userID)
+	r.removed = append(r.removed, userID)
+	return nil
+}
+
+func (r *orgRepoStub) GetMemberSpendSince(ctx context.Context, organizationID, userID int64, since time.Time) (float64, error) {
+	return r.spend, nil
+}
+
+type orgAPIKeyRepoStub struct {
+	APIKeyRepository
+}
+
+func (r *orgAPIKeyRepoStub) ListKeysByUserID(ctx context.Context, userID int64) ([]string, error) {
+	return nil, nil
+}
+
+// 组织 1：计费账户 100，所有者 1、管理员 2、成员 3/4
+func newOrganizationTestService() (*OrganizationService, *orgRepoStub) {
+	repo := newOrgRepoStub()
+	repo.orgs[1] = &Organization{ID: 1, Name: "team", BillingUserID: 100, Status: StatusActive}
+	repo.addMember(1, 1, OrganizationRoleOwner)
+	repo.addMember(1, 2, OrganizationRoleAdmin)
+	repo.addMember(1, 3, OrganizationRoleMember)
+	repo.addMember(1, 4, OrganizationRoleMember)
+	userRepo := &lifecycleUserRepoStub{users: map[int64]*User{
+		1:   {ID: 1, Status: StatusActive, Balance: 5},
+		100: {ID: 100, Status: StatusActive, Balance: 50},
+	}}
+	apiKeyService := &APIKeyService{apiKeyRepo: &orgAPIKeyRepoStub{}}
+	svc := NewOrganizationService(repo, userRepo, nil, nil, apiKeyService, nil, nil, nil)
+	return svc, repo
+}
+
+func TestResolveAPIKeyBilling(t *testing.T) {
+	ctx := context.Background()
+	svc, repo := newOrganizationTestService()
+	member := &User{ID: 3, Status: StatusActive, Balance: 1}
+
+	// 个人 Key 不解析
+	personal := &APIKey{ID: 1, UserID: 3, User: member}
+	require.NoError(t, svc.ResolveAPIKeyBilling(ctx, personal))
+	require.Nil(t, personal.OrganizationBilling)
+	require.Same(t, member, personal.Payer(member))
+
+	orgID := int64(1)
+	key := &APIKey{ID: 2, UserID: 3, User: member, OrganizationID: &orgID}
+	require.NoError(t, svc.ResolveAPIKeyBilling(ctx, key))
+	require.NotNil(t, key.OrganizationBilling)
+	require.Equal(t, int64(100), key.Payer(member).ID)
+	require.Equal(t, 50.0, key.Payer(member).Balance)
+	require.NoError(t, key.OrganizationBilling.CheckSpendCap())
+
+	// 月度限额：已消耗达到限额后拒绝
+	spendCap := 10.0
+	repo.members[1][3].MonthlySpendCapUSD = &spendCap
+	repo.spend = 10
+	key.OrganizationBilling = nil
+	require.NoError(t, svc.ResolveAPIKeyBilling(ctx, key))
+	require.Equal(t, 10.0, key.OrganizationBilling.MonthlySpend)
+	require.ErrorIs(t, key.OrganizationBilling.CheckSpendCap(), ErrOrganizationSpendCapReached)
+
+	// 组织停用
+	repo.orgs[1].Status = StatusDisabled
+	require.ErrorIs(t, svc.ResolveAPIKeyBilling(ctx, key), ErrOrganizationDisabled)
+	repo.orgs[1].Status = StatusActive
+
+	// 成员已移除
+	delete(repo.members[1], 3)
+	require.ErrorIs(t, svc.ResolveAPIKeyBilling(ctx, key), ErrOrganizationMemberNotFound)
+}
+
+func TestOrganizationSpendCapNilSafe(t *testing.T) {
+	var billing *OrganizationBilling
+	require.NoError(t, billing.CheckSpendCap())
+	require.NoError(t, (&OrganizationBilling{Member: &OrganizationMember{}}).CheckSpendCap())
+}
+
+func TestOrganizationMemberRoles(t *testing.T) {
+	ctx := context.Background()
+	svc, repo := newOrganizationTestService()
+	admin := OrganizationRoleAdmin
+	member := OrganizationRoleMember
+	owner := OrganizationRoleOwner
+
+	// 普通成员不能管理
+	_, err := svc.UpdateMember(ctx, 1, 3, 4, UpdateOrganizationMemberInput{Role: &admin})
+	require.ErrorIs(t, err, ErrOrganizationForbidden)
+
+	// 管理员不能提升为管理员，也不能管理其他管理员
+	_, err = svc.UpdateMember(ctx, 1, 2, 3, UpdateOrganizationMemberInput{Role: &admin})
+	require.ErrorIs(t, err, ErrOrganizationForbidden)
+	require.ErrorIs(t, svc.RemoveMember(ctx, 1, 2, 1), ErrOrganizationOwnerImmutable)
+
+	// 不能分配 owner，所有者不可降级
+	_, err = svc.UpdateMember(ctx, 1, 1, 3, UpdateOrganizationMemberInput{Role: &owner})
+	require.ErrorIs(t, err, ErrOrganizationInvalidRole)
+	_, err = svc.UpdateMember(ctx, 1, 1, 1, UpdateOrganizationMemberInput{Role: &member})
+	require.ErrorIs(t, err, ErrOrganizationOwnerImmutable)
+
+	// 管理员可设置成员限额，0 表示清除
+	spendCap := 20.0
+	updated, err := svc.UpdateMember(ctx, 1, 2, 3, UpdateOrganizationMemberInput{MonthlySpendCapUSD: &spendCap})
+	require.NoError(t, err)
+	require.Equal(t, 20.0, *updated.MonthlySpendCapUSD)
+	zero := 0.0
+	updated, err = svc.UpdateMember(ctx, 1, 2, 3, UpdateOrganizationMemberInput{MonthlySpendCapUSD: &zero})
+	require.NoError(t, err)
+	require.Nil(t, updated.MonthlySpendCapUSD)
+
+	// 所有者可提升管理员
+	updated, err = svc.UpdateMember(ctx, 1, 1, 3, UpdateOrganizationMemberInput{Role: &admin})
+	require.NoError(t, err)
+	require.Equal(t, OrganizationRoleAdmin, updated.Role)
+
+	// 成员可自行退出；非成员视为组织不存在
+	require.NoError(t, svc.RemoveMember(ctx, 1, 4, 4))
+	require.Equal(t, []int64{4}, repo.removed)
+	_, _, err = svc.GetOrganization(ctx, 1, 4)
+	require.ErrorIs(t, err, ErrOrganizationNotFound)
+}
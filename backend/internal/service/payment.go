@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+// 支付订单状态
+const (
+	PaymentOrderStatusPending = "pending"
+	PaymentOrderStatusPaid    = "paid"
+	PaymentOrderStatusFailed  = "failed"
+	PaymentOrderStatusExpired = "expired"
+)
+
+// 内置支付渠道
+const (
+	PaymentProviderEPay   = "epay"
+	PaymentProviderStripe = "stripe"
+)
+
+var (
+	ErrPaymentDisabled          = infraerrors.Forbidden("PAYMENT_DISABLED", "online payment is disabled")
+	ErrPaymentProviderNotFound  = infraerrors.BadRequest("PAYMENT_PROVIDER_NOT_FOUND", "payment provider is not available")
+	ErrPaymentAmountOutOfRange  = infraerrors.BadRequest("PAYMENT_AMOUNT_OUT_OF_RANGE", "payment amount is out of range")
+	ErrPaymentOrderNotFound     = infraerrors.NotFound("PAYMENT_ORDER_NOT_FOUND", "payment order not found")
+	ErrPaymentSignatureInvalid  = infraerrors.BadRequest("PAYMENT_SIGNATURE_INVALID", "payment notification signature is invalid")
+	ErrPaymentAmountMismatch    = infraerrors.BadRequest("PAYMENT_AMOUNT_MISMATCH", "paid amount does not match the order")
+	ErrPaymentProviderRequest   = infraerrors.ServiceUnavailable("PAYMENT_PROVIDER_ERROR", "payment provider request failed")
+	ErrInvalidPaymentOrderState = infraerrors.BadRequest("INVALID_PAYMENT_ORDER_STATUS", "invalid payment order status")
+)
+
+// PaymentOrder 在线充值订单
+// Amount 为渠道货币金额，CreditAmount 为支付成功后到账的余额（美元）
+type PaymentOrder struct {
+	ID              int64      `json:"id"`
+	OrderNo         string     `json:"order_no"`
+	UserID          int64      `json:"user_id"`
+	Provider        string     `json:"provider"`
+	Amount          float64    `json:"amount"`
+	Currency        string     `json:"currency"`
+	CreditAmount    float64    `json:"credit_amount"`
+	Status          string     `json:"status"`
+	ProviderTradeNo string     `json:"provider_trade_no,omitempty"`
+	PayURL          string     `json:"pay_url,omitempty"`
+	PaidAt          *time.Time `json:"paid_at,omitempty"`
+	ExpiresAt       time.Time  `json:"expires_at"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+
+	// PromoCodes 下单时使用的充值优惠码，支付成功入账时发放赠送
+	PromoCodes []string `json:"promo_codes,omitempty"`
+
+	// ClientIP 下单用户 IP，仅用于请求渠道，不落库
+	ClientIP string `json:"-"`
+}
+
+// PaymentOrderFilters 订单查询过滤条件
+type PaymentOrderFilters struct {
+	UserID   int64
+	Status   string
+	Provider string
+}
+
+// PaymentCheckout 渠道下单结果
+type PaymentCheckout struct {
+	PayURL          string
+	ProviderTradeNo string
+}
+
+// PaymentNotification 渠道回调请求的原始内容，由渠道自行解析与验签
+type PaymentNotification struct {
+	Header http.Header
+	Query  url.Values
+	Form   url.Values
+	Body   []byte
+}
+
+// PaymentNotifyResult 验签通过后解析出的支付结果
+type PaymentNotifyResult struct {
+	OrderNo         string
+	ProviderTradeNo string
+	// Amount 渠道实际收款金额（渠道货币）
+	Amount float64
+	Paid   bool
+}
+
+// PaymentProvider 支付渠道
+// 新渠道只需实现该接口并在 repository.ProvidePaymentProviders 中注册。
+type PaymentProvider interface {
+	Name() string
+	// Currency 渠道收款货币
+	Currency() string
+	// CreditRate 每 1 单位渠道货币到账的余额（美元）
+	CreditRate() float64
+	// CreatePayment 在渠道侧创建支付，返回用户跳转的支付地址
+	CreatePayment(ctx context.Context, order *PaymentOrder) (*PaymentCheckout, error)
+	// VerifyNotification 校验回调签名并解析支付结果；签名无效时返回 ErrPaymentSignatureInvalid
+	VerifyNotification(ctx context.Context, notification *PaymentNotification) (*PaymentNotifyResult, error)
+	// NotifyAck 回调处理成功后返回给渠道的响应
+	NotifyAck() (contentType string, body []byte)
+}
+
+// PaymentProviders 已启用的支付渠道
+type PaymentProviders []PaymentProvider
+
+// PaymentOrderRepository 充值订单存储
+type PaymentOrderRepository interface {
+	Create(ctx context.Context, order *PaymentOrder) error
+	GetByOrderNo(ctx context.Context, orderNo string) (*PaymentOrder, error)
+	// UpdateCheckout 记录渠道下单结果
+	UpdateCheckout(ctx context.Context, id int64, payURL, providerTradeNo string) error
+	// MarkPaid 将订单置为 paid；订单已是 paid 时返回 false（用于幂等入账）。
+	// 渠道已收款的订单即使本地已过期也需入账，因此不限制 pending。
+	MarkPaid(ctx context.Context, id int64, providerTradeNo string, paidAt time.Time) (bool, error)
+	MarkFailed(ctx context.Context, id int64) error
+	// ExpirePending 将已过期的 pending 订单置为 expired，返回影响行数
+	ExpirePending(ctx context.Context, now time.Time) (int64, error)
+	List(ctx context.Context, params pagination.PaginationParams, filters PaymentOrderFilters) ([]PaymentOrder, *pagination.PaginationResult, error)
+}
+
+// IsValidPaymentOrderStatus 校验订单状态（用于查询过滤）
+func IsValidPaymentOrderStatus(status string) bool {
+	switch status {
+	case PaymentOrderStatusPending, PaymentOrderStatusPaid, PaymentOrderStatusFailed, PaymentOrderStatusExpired:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,384 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+const (
+	// 渠道回调金额与订单金额允许的误差（渠道金额精确到分）
+	paymentAmountTolerance = 0.005
+
+	paymentExpiryWorkerName    = "payment_order_expiry_worker"
+	paymentExpiryLeaderLockKey = "payment:orders:expiry:leader"
+	paymentExpiryInterval      = time.Minute
+	paymentExpiryTimeout       = 30 * time.Second
+)
+
+// CreatePaymentOrderInput 用户下单参数
+type CreatePaymentOrderInput struct {
+	Provider string
+	Amount   float64
+	ClientIP string
+
+	// PromoCodes 充值优惠码（可叠加的优惠码最多 3 个）
+	PromoCodes []string
+}
+
+// PaymentProviderInfo 前端展示的渠道信息
+type PaymentProviderInfo struct {
+	Name       string  `json:"name"`
+	Currency   string  `json:"currency"`
+	CreditRate float64 `json:"credit_rate"`
+}
+
+// PaymentOptions 充值页面配置
+type PaymentOptions struct {
+	Enabled   bool                  `json:"enabled"`
+	MinAmount float64               `json:"min_amount"`
+	MaxAmount float64               `json:"max_amount"`
+	Providers []PaymentProviderInfo `json:"providers"`
+}
+
+// PaymentService 在线支付充值
+// 下单时在渠道侧创建支付；渠道回调验签通过后，在同一事务中将订单置为 paid 并写入余额流水，
+// 订单状态的条件更新保证同一订单重复回调只入账一次。
+type PaymentService struct {
+	orderRepo            PaymentOrderRepository
+	userRepo             UserRepository
+	providers            map[string]PaymentProvider
+	providerOrder        []string
+	cfg                  *config.Config
+	entClient            *dbent.Client
+	billingCacheService  *BillingCacheService
+	authCacheInvalidator APIKeyAuthCacheInvalidator
+	promoService         *PromoService
+	timingWheel          *TimingWheelService
+	db                   *sql.DB
+
+	running   int32
+	startOnce sync.Once
+	stopOnce  sync.Once
+}
+
+// NewPaymentService 创建支付服务实例
+func NewPaymentService(
+	orderRepo PaymentOrderRepository,
+	userRepo UserRepository,
+	providers PaymentProviders,
+	cfg *config.Config,
+	entClient *dbent.Client,
+	billingCacheService *BillingCacheService,
+	authCacheInvalidator APIKeyAuthCacheInvalidator,
+	promoService *PromoService,
+	timingWheel *TimingWheelService,
+	db *sql.DB,
+) *PaymentService {
+	s := &PaymentService{
+		orderRepo:            orderRepo,
+		userRepo:             userRepo,
+		providers:            make(map[string]PaymentProvider, len(providers)),
+		cfg:                  cfg,
+		entClient:            entClient,
+		billingCacheService:  billingCacheService,
+		authCacheInvalidator: authCacheInvalidator,
+		promoService:         promoService,
+		timingWheel:          timingWheel,
+		db:                   db,
+	}
+	for _, p := range providers {
+		if p == nil {
+			continue
+		}
+		s.providers[p.Name()] = p
+		s.providerOrder = append(s.providerOrder, p.Name())
+	}
+	return s
+}
+
+func (s *PaymentService) Start() {
+	if s == nil {
+		return
+	}
+	if s.orderRepo == nil || s.timingWheel == nil {
+		log.Printf("[Payment] order expiry worker not started (missing deps)")
+		return
+	}
+	s.startOnce.Do(func() {
+		s.timingWheel.ScheduleRecurring(paymentExpiryWorkerName, paymentExpiryInterval, s.runOnce)
+		log.Printf("[Payment] order expiry worker started (interval=%s)", paymentExpiryInterval)
+	})
+}
+
+func (s *PaymentService) Stop() {
+	if s == nil {
+		return
+	}
+	s.stopOnce.Do(func() {
+		if s.timingWheel != nil {
+			s.timingWheel.Cancel(paymentExpiryWorkerName)
+		}
+		log.Printf("[Payment] order expiry worker stopped")
+	})
+}
+
+func (s *PaymentService) enabled() bool {
+	return s.cfg != nil && s.cfg.Payment.Enabled && len(s.providers) > 0
+}
+
+// GetOptions 返回充值页面所需的配置与可用渠道
+func (s *PaymentService) GetOptions() *PaymentOptions {
+	opts := &PaymentOptions{Enabled: s.enabled(), Providers: []PaymentProviderInfo{}}
+	if !opts.Enabled {
+		return opts
+	}
+	opts.MinAmount = s.cfg.Payment.MinAmount
+	opts.MaxAmount = s.cfg.Payment.MaxAmount
+	for _, name := range s.providerOrder {
+		p := s.providers[name]
+		opts.Providers = append(opts.Providers, PaymentProviderInfo{
+			Name:       name,
+			Currency:   p.Currency(),
+			CreditRate: p.CreditRate(),
+		})
+	}
+	return opts
+}
+
+// CreateOrder 创建充值订单并在渠道侧下单
+func (s *PaymentService) CreateOrder(ctx context.Context, userID int64, input *CreatePaymentOrderInput) (*PaymentOrder, error) {
+	if !s.enabled() {
+		return nil, ErrPaymentDisabled
+	}
+	provider, ok := s.providers[input.Provider]
+	if !ok {
+		return nil, ErrPaymentProviderNotFound
+	}
+	// 渠道金额精确到分
+	amount := math.Round(input.Amount*100) / 100
+	if amount < s.cfg.Payment.MinAmount || amount > s.cfg.Payment.MaxAmount {
+		return nil, ErrPaymentAmountOutOfRange
+	}
+
+	var promoCodes []string
+	if len(input.PromoCodes) > 0 && s.promoService != nil {
+		codes, err := s.promoService.ValidateTopupPromoCodes(ctx, userID, input.PromoCodes)
+		if err != nil {
+			return nil, err
+		}
+		promoCodes = codes
+	}
+
+	orderNo, err := generatePaymentOrderNo()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	order := &PaymentOrder{
+		OrderNo:      orderNo,
+		UserID:       userID,
+		Provider:     provider.Name(),
+		Amount:       amount,
+		Currency:     provider.Currency(),
+		CreditAmount: math.Round(amount*provider.CreditRate()*1e8) / 1e8,
+		Status:       PaymentOrderStatusPending,
+		ExpiresAt:    now.Add(time.Duration(s.cfg.Payment.OrderExpireMinutes) * time.Minute),
+		PromoCodes:   promoCodes,
+		ClientIP:     input.ClientIP,
+	}
+	if err := s.orderRepo.Create(ctx, order); err != nil {
+		return nil, fmt.Errorf("create payment order: %w", err)
+	}
+
+	checkout, err := provider.CreatePayment(ctx, order)
+	if err != nil {
+		log.Printf("[Payment] create payment failed: provider=%s order=%s err=%v", order.Provider, order.OrderNo, err)
+		if markErr := s.orderRepo.MarkFailed(ctx, order.ID); markErr != nil {
+			log.Printf("[Payment] mark order failed: order=%s err=%v", order.OrderNo, markErr)
+		}
+		return nil, ErrPaymentProviderRequest.WithCause(err)
+	}
+	if err := s.orderRepo.UpdateCheckout(ctx, order.ID, checkout.PayURL, checkout.ProviderTradeNo); err != nil {
+		return nil, fmt.Errorf("update payment order: %w", err)
+	}
+	order.PayURL = checkout.PayURL
+	order.ProviderTradeNo = checkout.ProviderTradeNo
+	return order, nil
+}
+
+// HandleNotify 处理渠道回调：验签、核对金额并幂等入账
+// 返回值为应答渠道的响应；返回错误时渠道会按其策略重试。
+func (s *PaymentService) HandleNotify(ctx context.Context, providerName string, notification *PaymentNotification) (string, []byte, error) {
+	provider, ok := s.providers[providerName]
+	if !ok {
+		return "", nil, ErrPaymentProviderNotFound
+	}
+	result, err := provider.VerifyNotification(ctx, notification)
+	if err != nil {
+		log.Printf("[Payment] notification rejected: provider=%s err=%v", providerName, err)
+		return "", nil, err
+	}
+	contentType, ack := provider.NotifyAck()
+	if !result.Paid {
+		return contentType, ack, nil
+	}
+
+	order, err := s.orderRepo.GetByOrderNo(ctx, result.OrderNo)
+	if err != nil {
+		return "", nil, err
+	}
+	if order.Provider != providerName {
+		return "", nil, ErrPaymentOrderNotFound
+	}
+	if math.Abs(result.Amount-order.Amount) > paymentAmountTolerance {
+		log.Printf("[Payment] amount mismatch: order=%s expected=%.2f paid=%.2f", order.OrderNo, order.Amount, result.Amount)
+		return "", nil, ErrPaymentAmountMismatch
+	}
+	if order.Status == PaymentOrderStatusPaid {
+		return contentType, ack, nil
+	}
+
+	credited, err := s.creditOrder(ctx, order, result.ProviderTradeNo)
+	if err != nil {
+		return "", nil, err
+	}
+	if credited {
+		log.Printf("[Payment] order credited: order=%s user=%d credit=%.8f", order.OrderNo, order.UserID, order.CreditAmount)
+		s.invalidateBalanceCaches(ctx, order.UserID)
+	}
+	return contentType, ack, nil
+}
+
+// creditOrder 在事务中标记订单已支付并增加余额；订单已被其他回调处理时返回 false
+func (s *PaymentService) creditOrder(ctx context.Context, order *PaymentOrder, providerTradeNo string) (bool, error) {
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	txCtx := dbent.NewTxContext(ctx, tx)
+
+	updated, err := s.orderRepo.MarkPaid(txCtx, order.ID, providerTradeNo, time.Now())
+	if err != nil {
+		return false, fmt.Errorf("mark payment order paid: %w", err)
+	}
+	if !updated {
+		return false, nil
+	}
+	orderID := order.ID
+	if _, err := s.userRepo.ApplyBalanceChange(txCtx, &BalanceChange{
+		UserID:         order.UserID,
+		Type:           BalanceTxTypePayment,
+		Amount:         order.CreditAmount,
+		PaymentOrderID: &orderID,
+		Notes:          order.OrderNo,
+	}); err != nil {
+		return false, fmt.Errorf("credit balance: %w", err)
+	}
+	if s.promoService != nil {
+		bonus, err := s.promoService.ApplyTopupPromoCodes(txCtx, order)
+		if err != nil {
+			return false, fmt.Errorf("apply promo codes: %w", err)
+		}
+		if bonus > 0 {
+			log.Printf("[Payment] promo bonus credited: order=%s bonus=%.8f", order.OrderNo, bonus)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("commit transaction: %w", err)
+	}
+	return true, nil
+}
+
+func (s *PaymentService) invalidateBalanceCaches(ctx context.Context, userID int64) {
+	if s.authCacheInvalidator != nil {
+		s.authCacheInvalidator.InvalidateAuthCacheByUserID(ctx, userID)
+	}
+	if s.billingCacheService == nil {
+		return
+	}
+	go func() {
+		cacheCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = s.billingCacheService.InvalidateUserBalance(cacheCtx, userID)
+	}()
+}
+
+// GetUserOrder 查询用户自己的订单
+func (s *PaymentService) GetUserOrder(ctx context.Context, userID int64, orderNo string) (*PaymentOrder, error) {
+	order, err := s.orderRepo.GetByOrderNo(ctx, orderNo)
+	if err != nil {
+		return nil, err
+	}
+	if order.UserID != userID {
+		return nil, ErrPaymentOrderNotFound
+	}
+	return order, nil
+}
+
+// ListOrders 分页查询订单；filters.UserID 为 0 时查询全部用户（管理员）
+func (s *PaymentService) ListOrders(ctx context.Context, params pagination.PaginationParams, filters PaymentOrderFilters) ([]PaymentOrder, *pagination.PaginationResult, error) {
+	if filters.Status != "" && !IsValidPaymentOrderStatus(filters.Status) {
+		return nil, nil, ErrInvalidPaymentOrderState
+	}
+	orders, result, err := s.orderRepo.List(ctx, params, filters)
+	if err != nil {
+		return nil, nil, fmt.Errorf("list payment orders: %w", err)
+	}
+	// 到期但尚未被后台任务处理的订单仅在展示时视为 expired，不在读请求中写库
+	now := time.Now()
+	for i := range orders {
+		if orders[i].Status == PaymentOrderStatusPending && !orders[i].ExpiresAt.After(now) {
+			orders[i].Status = PaymentOrderStatusExpired
+		}
+	}
+	return orders, result, nil
+}
+
+// runOnce 将超时未支付的 pending 订单置为 expired；多实例部署时仅 leader 执行
+func (s *PaymentService) runOnce() {
+	if !atomic.CompareAndSwapInt32(&s.running, 0, 1) {
+		return
+	}
+	defer atomic.StoreInt32(&s.running, 0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), paymentExpiryTimeout)
+	defer cancel()
+
+	if s.db != nil {
+		release, ok := tryAcquireDBAdvisoryLock(ctx, s.db, hashAdvisoryLockID(paymentExpiryLeaderLockKey))
+		if !ok {
+			return
+		}
+		defer release()
+	}
+
+	expired, err := s.orderRepo.ExpirePending(ctx, time.Now())
+	if err != nil {
+		log.Printf("[Payment] expire pending orders failed: %v", err)
+		return
+	}
+	if expired > 0 {
+		log.Printf("[Payment] expired %d pending order(s)", expired)
+	}
+}
+
+func generatePaymentOrderNo() (string, error) {
+	buf := make([]byte, 6)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate order no: %w", err)
+	}
+	return time.Now().UTC().Format("20060102150405") + hex.EncodeToString(buf), nil
+}
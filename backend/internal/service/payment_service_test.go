@@ -0,0 +1,249 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/stretchr/testify/require"
+
+	_ "modernc.org/sqlite"
+)
+
+type paymentOrderRepoStub struct {
+	orders      map[string]*PaymentOrder
+	nextID      int64
+	expireCalls int
+}
+
+func newPaymentOrderRepoStub() *paymentOrderRepoStub {
+	return &paymentOrderRepoStub{orders: map[string]*PaymentOrder{}}
+}
+
+func (s *paymentOrderRepoStub) byID(id int64) *PaymentOrder {
+	for _, o := range s.orders {
+		if o.ID == id {
+			return o
+		}
+	}
+	return nil
+}
+
+func (s *paymentOrderRepoStub) Create(_ context.Context, order *PaymentOrder) error {
+	s.nextID++
+	order.ID = s.nextID
+	clone := *order
+	s.orders[order.OrderNo] = &clone
+	return nil
+}
+
+func (s *paymentOrderRepoStub) GetByOrderNo(_ context.Context, orderNo string) (*PaymentOrder, error) {
+	o, ok := s.orders[orderNo]
+	if !ok {
+		return nil, ErrPaymentOrderNotFound
+	}
+	clone := *o
+	return &clone, nil
+}
+
+func (s *paymentOrderRepoStub) UpdateCheckout(_ context.Context, id int64, payURL, tradeNo string) error {
+	o := s.byID(id)
+	o.PayURL = payURL
+	o.ProviderTradeNo = tradeNo
+	return nil
+}
+
+func (s *paymentOrderRepoStub) MarkPaid(_ context.Context, id int64, tradeNo string, paidAt time.Time) (bool, error) {
+	o := s.byID(id)
+	if o.Status == PaymentOrderStatusPaid {
+		return false, nil
+	}
+	o.Status = PaymentOrderStatusPaid
+	o.ProviderTradeNo = tradeNo
+	o.PaidAt = &paidAt
+	return true, nil
+}
+
+func (s *paymentOrderRepoStub) MarkFailed(_ context.Context, id int64) error {
+	s.byID(id).Status = PaymentOrderStatusFailed
+	return nil
+}
+
+func (s *paymentOrderRepoStub) ExpirePending(_ context.Context, now time.Time) (int64, error) {
+	s.expireCalls++
+	var n int64
+	for _, o := range s.orders {
+		if o.Status == PaymentOrderStatusPending && !o.ExpiresAt.After(now) {
+			o.Status = PaymentOrderStatusExpired
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (s *paymentOrderRepoStub) List(context.Context, pagination.PaginationParams, PaymentOrderFilters) ([]PaymentOrder, *pagination.PaginationResult, error) {
+	out := make([]PaymentOrder, 0, len(s.orders))
+	for _, o := range s.orders {
+		out = append(out, *o)
+	}
+	return out, &pagination.PaginationResult{Total: int64(len(out))}, nil
+}
+
+type paymentProviderStub struct {
+	result    *PaymentNotifyResult
+	verifyErr error
+	createErr error
+}
+
+func (p *paymentProviderStub) Name() string        { return "stub" }
+func (p *paymentProviderStub) Currency() string    { return "CNY" }
+func (p *paymentProviderStub) CreditRate() float64 { return 0.14 }
+
+func (p *paymentProviderStub) CreatePayment(_ context.Context, order *PaymentOrder) (*PaymentCheckout, error) {
+	if p.createErr != nil {
+		return nil, p.createErr
+	}
+	return &PaymentCheckout{PayURL: "https://pay.example.com/" + order.OrderNo}, nil
+}
+
+func (p *paymentProviderStub) VerifyNotification(context.Context, *PaymentNotification) (*PaymentNotifyResult, error) {
+	if p.verifyErr != nil {
+		return nil, p.verifyErr
+	}
+	clone := *p.result
+	return &clone, nil
+}
+
+func (p *paymentProviderStub) NotifyAck() (string, []byte) {
+	return "text/plain", []byte("success")
+}
+
+func newPaymentTestEntClient(t *testing.T) *dbent.Client {
+	t.Helper()
+	db, err := sql.Open("sqlite", "file:payment_service?mode=memory&cache=shared")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	// 仓储均为桩实现，这里只需要一个可开启事务的客户端
+	client := dbent.NewClient(dbent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+func newPaymentTestService(t *testing.T, provider *paymentProviderStub) (*PaymentService, *paymentOrderRepoStub, *balanceUserRepoStub, *authCacheInvalidatorStub) {
+	orderRepo := newPaymentOrderRepoStub()
+	userRepo := &balanceUserRepoStub{userRepoStub: &userRepoStub{user: &User{ID: 7, Balance: 1}}}
+	invalidator := &authCacheInvalidatorStub{}
+	cfg := &config.Config{Payment: config.PaymentConfig{Enabled: true, MinAmount: 1, MaxAmount: 1000, OrderExpireMinutes: 30}}
+	svc := NewPaymentService(orderRepo, userRepo, PaymentProviders{provider}, cfg, newPaymentTestEntClient(t), nil, invalidator, nil, nil, nil)
+	return svc, orderRepo, userRepo, invalidator
+}
+
+func TestPaymentService_CreateOrder(t *testing.T) {
+	provider := &paymentProviderStub{}
+	svc, orderRepo, _, _ := newPaymentTestService(t, provider)
+	ctx := context.Background()
+
+	_, err := svc.CreateOrder(ctx, 7, &CreatePaymentOrderInput{Provider: "stub", Amount: 0.5})
+	require.ErrorIs(t, err, ErrPaymentAmountOutOfRange)
+	_, err = svc.CreateOrder(ctx, 7, &CreatePaymentOrderInput{Provider: "other", Amount: 10})
+	require.ErrorIs(t, err, ErrPaymentProviderNotFound)
+
+	order, err := svc.CreateOrder(ctx, 7, &CreatePaymentOrderInput{Provider: "stub", Amount: 100})
+	require.NoError(t, err)
+	require.Equal(t, PaymentOrderStatusPending, order.Status)
+	require.Equal(t, "CNY", order.Currency)
+	require.InDelta(t, 14.0, order.CreditAmount, 1e-9)
+	require.Equal(t, "https://pay.example.com/"+order.OrderNo, orderRepo.orders[order.OrderNo].PayURL)
+
+	provider.createErr = errors.New("gateway down")
+	_, err = svc.CreateOrder(ctx, 7, &CreatePaymentOrderInput{Provider: "stub", Amount: 100})
+	require.ErrorIs(t, err, ErrPaymentProviderRequest)
+	require.Len(t, orderRepo.orders, 2)
+	for _, o := range orderRepo.orders {
+		if o.OrderNo != order.OrderNo {
+			require.Equal(t, PaymentOrderStatusFailed, o.Status)
+		}
+	}
+}
+
+func TestPaymentService_HandleNotifyCreditsOnce(t *testing.T) {
+	provider := &paymentProviderStub{}
+	svc, orderRepo, userRepo, invalidator := newPaymentTestService(t, provider)
+	ctx := context.Background()
+
+	order, err := svc.CreateOrder(ctx, 7, &CreatePaymentOrderInput{Provider: "stub", Amount: 100})
+	require.NoError(t, err)
+	provider.result = &PaymentNotifyResult{OrderNo: order.OrderNo, ProviderTradeNo: "T1", Amount: 100, Paid: true}
+
+	for i := 0; i < 3; i++ {
+		_, ack, err := svc.HandleNotify(ctx, "stub", &PaymentNotification{})
+		require.NoError(t, err)
+		require.Equal(t, "success", string(ack))
+	}
+
+	require.Len(t, userRepo.changes, 1)
+	require.Equal(t, BalanceTxTypePayment, userRepo.changes[0].Type)
+	require.InDelta(t, 14.0, userRepo.changes[0].Amount, 1e-9)
+	require.Equal(t, order.ID, *userRepo.changes[0].PaymentOrderID)
+	require.InDelta(t, 15.0, userRepo.user.Balance, 1e-9)
+	require.Equal(t, []int64{7}, invalidator.userIDs)
+	require.Equal(t, PaymentOrderStatusPaid, orderRepo.orders[order.OrderNo].Status)
+}
+
+func TestPaymentService_HandleNotifyRejects(t *testing.T) {
+	provider := &paymentProviderStub{}
+	svc, _, userRepo, _ := newPaymentTestService(t, provider)
+	ctx := context.Background()
+
+	order, err := svc.CreateOrder(ctx, 7, &CreatePaymentOrderInput{Provider: "stub", Amount: 100})
+	require.NoError(t, err)
+
+	provider.verifyErr = ErrPaymentSignatureInvalid
+	_, _, err = svc.HandleNotify(ctx, "stub", &PaymentNotification{})
+	require.ErrorIs(t, err, ErrPaymentSignatureInvalid)
+
+	provider.verifyErr = nil
+	provider.result = &PaymentNotifyResult{OrderNo: order.OrderNo, Amount: 1, Paid: true}
+	_, _, err = svc.HandleNotify(ctx, "stub", &PaymentNotification{})
+	require.ErrorIs(t, err, ErrPaymentAmountMismatch)
+
+	// 未支付的通知只应答，不入账
+	provider.result = &PaymentNotifyResult{OrderNo: order.OrderNo, Amount: 100, Paid: false}
+	_, _, err = svc.HandleNotify(ctx, "stub", &PaymentNotification{})
+	require.NoError(t, err)
+
+	_, _, err = svc.HandleNotify(ctx, "unknown", &PaymentNotification{})
+	require.ErrorIs(t, err, ErrPaymentProviderNotFound)
+	require.Empty(t, userRepo.changes)
+}
+
+func TestPaymentService_ListOrdersDoesNotWrite(t *testing.T) {
+	provider := &paymentProviderStub{}
+	svc, orderRepo, _, _ := newPaymentTestService(t, provider)
+	ctx := context.Background()
+
+	order, err := svc.CreateOrder(ctx, 7, &CreatePaymentOrderInput{Provider: "stub", Amount: 100})
+	require.NoError(t, err)
+	orderRepo.orders[order.OrderNo].ExpiresAt = time.Now().Add(-time.Minute)
+
+	orders, _, err := svc.ListOrders(ctx, pagination.PaginationParams{Page: 1, PageSize: 20}, PaymentOrderFilters{UserID: 7})
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+	require.Equal(t, PaymentOrderStatusExpired, orders[0].Status, "overdue orders display as expired")
+	require.Zero(t, orderRepo.expireCalls)
+	require.Equal(t, PaymentOrderStatusPending, orderRepo.orders[order.OrderNo].Status)
+
+	// 过期落库由后台任务完成
+	svc.runOnce()
+	require.Equal(t, 1, orderRepo.expireCalls)
+	require.Equal(t, PaymentOrderStatusExpired, orderRepo.orders[order.OrderNo].Status)
+}
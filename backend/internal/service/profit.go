@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+)
+
+// 利润分析：对比上游成本（采购成本摊销，或 total_cost × account_rate_multiplier）与收入（actual_cost）
+
+var (
+	ErrAccountCostNotFound = infraerrors.NotFound("ACCOUNT_COST_NOT_FOUND", "account cost not found")
+	ErrInvalidAccountCost  = infraerrors.BadRequest("INVALID_ACCOUNT_COST", "invalid account cost")
+	ErrInvalidProfitQuery  = infraerrors.BadRequest("INVALID_PROFIT_QUERY", "invalid profit query")
+)
+
+const (
+	ProfitDimensionAccount = "account"
+	ProfitDimensionGroup   = "group"
+	ProfitDimensionModel   = "model"
+)
+
+// AccountCost 上游账号采购成本（按月订阅价格）
+// 采购期间内按 monthly_price × 12 / 365 摊销到每天，不足一天按覆盖时长比例计算
+type AccountCost struct {
+	ID           int64      `json:"id"`
+	AccountID    int64      `json:"account_id"`
+	AccountName  string     `json:"account_name"`
+	MonthlyPrice float64    `json:"monthly_price"`
+	PurchasedAt  time.Time  `json:"purchased_at"`
+	EndedAt      *time.Time `json:"ended_at"`
+	Notes        string     `json:"notes"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// DailyCost 每日摊销成本
+func (c *AccountCost) DailyCost() float64 {
+	return c.MonthlyPrice * 12 / 365
+}
+
+// amortizedIn 返回 [start, end) 内的摊销成本；dayLength 为所在自然日的长度（兼容夏令时）。
+// 第二个返回值表示采购期间是否与区间重叠。
+func (c *AccountCost) amortizedIn(start, end time.Time, dayLength time.Duration) (float64, bool) {
+	from := start
+	if c.PurchasedAt.After(from) {
+		from = c.PurchasedAt
+	}
+	to := end
+	if c.EndedAt != nil && c.EndedAt.Before(to) {
+		to = *c.EndedAt
+	}
+	if !to.After(from) || dayLength <= 0 {
+		return 0, false
+	}
+	return c.DailyCost() * float64(to.Sub(from)) / float64(dayLength), true
+}
+
+// AccountCostInput 创建/更新采购成本的输入（更新为整体替换）
+type AccountCostInput struct {
+	AccountID    int64
+	MonthlyPrice float64
+	PurchasedAt  time.Time
+	EndedAt      *time.Time
+	Notes        string
+}
+
+// ProfitQuery 利润报表查询条件
+// GroupID/Model 过滤时无法归属到分组/模型的闲置摊销成本不计入
+type ProfitQuery struct {
+	StartTime time.Time
+	EndTime   time.Time
+	Dimension string
+	AccountID int64
+	GroupID   int64
+	Model     string
+}
+
+// ProfitUsageRow 按 日期/账号/分组/模型 聚合的用量成本与收入
+type ProfitUsageRow struct {
+	Date      string // YYYY-MM-DD（服务器时区）
+	AccountID int64
+	GroupID   int64 // 0 表示无分组
+	Model     string
+	Requests  int64
+	TotalCost float64
+	// AccountCost total_cost × account_rate_multiplier（倍率为空时按 1）
+	AccountCost float64
+	ActualCost  float64
+}
+
+// ProfitMetrics 成本、收入与利润
+type ProfitMetrics struct {
+	Requests int64   `json:"requests"`
+	Revenue  float64 `json:"revenue"` // actual_cost
+	// UsageCost total_cost × account_rate_multiplier
+	UsageCost float64 `json:"usage_cost"`
+	// AmortizedCost 采购成本摊销（仅统计配置了采购成本的账号）
+	AmortizedCost float64 `json:"amortized_cost"`
+	// UpstreamCost 上游成本：有采购成本的账号取摊销，其余取 UsageCost
+	UpstreamCost float64 `json:"upstream_cost"`
+	Profit       float64 `json:"profit"`
+	// Margin 利润率 profit / revenue，收入为 0 时为 0
+	Margin float64 `json:"margin"`
+}
+
+// ProfitTrendPoint 按天的利润趋势
+type ProfitTrendPoint struct {
+	Date string `json:"date"`
+	ProfitMetrics
+}
+
+// ProfitBreakdownItem 按维度（账号/分组/模型）拆分的利润
+type ProfitBreakdownItem struct {
+	Key  string `json:"key"` // account_id / group_id（0 表示无分组）/ model
+	Name string `json:"name"`
+	ProfitMetrics
+	Trend []ProfitTrendPoint `json:"trend"`
+}
+
+// ProfitReport 利润报表
+type ProfitReport struct {
+	StartTime time.Time             `json:"start_time"`
+	EndTime   time.Time             `json:"end_time"`
+	Dimension string                `json:"dimension"`
+	Summary   ProfitMetrics         `json:"summary"`
+	Trend     []ProfitTrendPoint    `json:"trend"`
+	Items     []ProfitBreakdownItem `json:"items"`
+	// IdleCost 账号当天无任何用量时的摊销成本。
+	// 账号维度计入对应账号；分组/模型维度无法分摊，仅计入汇总与趋势。
+	IdleCost float64 `json:"idle_cost"`
+}
+
+type ProfitRepository interface {
+	CreateAccountCost(ctx context.Context, cost *AccountCost) error
+	UpdateAccountCost(ctx context.Context, cost *AccountCost) error
+	DeleteAccountCost(ctx context.Context, id int64) error
+	GetAccountCost(ctx context.Context, id int64) (*AccountCost, error)
+	// ListAccountCosts 返回采购成本记录，accountID 为 0 时返回全部
+	ListAccountCosts(ctx context.Context, accountID int64) ([]AccountCost, error)
+	// ListAccountCostsInRange 返回采购期间与 [start, end) 重叠的记录
+	ListAccountCostsInRange(ctx context.Context, start, end time.Time, accountID int64) ([]AccountCost, error)
+	// ListUsage 返回 [start, end) 内按 日期/账号/分组/模型 聚合的用量；
+	// fromAggregates 为 true 时读取 usage_dashboard_profit_daily 预聚合表，否则直接聚合 usage_logs
+	ListUsage(ctx context.Context, start, end time.Time, accountID int64, fromAggregates bool) ([]ProfitUsageRow, error)
+	GetAccountNames(ctx context.Context, ids []int64) (map[int64]string, error)
+	GetGroupNames(ctx context.Context, ids []int64) (map[int64]string, error)
+}
@@ -0,0 +1,373 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+)
+
+const profitMaxRangeDays = 366
+
+// ProfitService 利润分析
+//
+// 用量数据优先读取 DashboardAggregationService 维护的 usage_dashboard_profit_daily 预聚合表
+// （未启用预聚合时直接聚合 usage_logs），采购成本按天摊销后在 Go 中分摊到分组/模型。
+type ProfitService struct {
+	repo        ProfitRepository
+	accountRepo AccountRepository
+	aggEnabled  bool
+}
+
+func NewProfitService(repo ProfitRepository, accountRepo AccountRepository, aggRepo DashboardAggregationRepository, cfg *config.Config) *ProfitService {
+	aggEnabled := aggRepo != nil
+	if cfg != nil && !cfg.DashboardAgg.Enabled {
+		aggEnabled = false
+	}
+	return &ProfitService{
+		repo:        repo,
+		accountRepo: accountRepo,
+		aggEnabled:  aggEnabled,
+	}
+}
+
+// ListAccountCosts 列出采购成本记录，accountID 为 0 时返回全部
+func (s *ProfitService) ListAccountCosts(ctx context.Context, accountID int64) ([]AccountCost, error) {
+	return s.repo.ListAccountCosts(ctx, accountID)
+}
+
+// CreateAccountCost 新增采购成本记录
+func (s *ProfitService) CreateAccountCost(ctx context.Context, input AccountCostInput) (*AccountCost, error) {
+	cost := &AccountCost{}
+	if err := s.applyAccountCostInput(ctx, cost, input); err != nil {
+		return nil, err
+	}
+	if err := s.repo.CreateAccountCost(ctx, cost); err != nil {
+		return nil, err
+	}
+	return cost, nil
+}
+
+// UpdateAccountCost 整体替换采购成本记录
+func (s *ProfitService) UpdateAccountCost(ctx context.Context, id int64, input AccountCostInput) (*AccountCost, error) {
+	cost, err := s.repo.GetAccountCost(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyAccountCostInput(ctx, cost, input); err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateAccountCost(ctx, cost); err != nil {
+		return nil, err
+	}
+	return cost, nil
+}
+
+// DeleteAccountCost 删除采购成本记录
+func (s *ProfitService) DeleteAccountCost(ctx context.Context, id int64) error {
+	return s.repo.DeleteAccountCost(ctx, id)
+}
+
+func (s *ProfitService) applyAccountCostInput(ctx context.Context, cost *AccountCost, input AccountCostInput) error {
+	if input.AccountID <= 0 {
+		return ErrInvalidAccountCost.WithCause(fmt.Errorf("account_id is required"))
+	}
+	if input.MonthlyPrice < 0 {
+		return ErrInvalidAccountCost.WithCause(fmt.Errorf("monthly price must not be negative"))
+	}
+	if input.PurchasedAt.IsZero() {
+		return ErrInvalidAccountCost.WithCause(fmt.Errorf("purchased_at is required"))
+	}
+	if input.EndedAt != nil && !input.EndedAt.After(input.PurchasedAt) {
+		return ErrInvalidAccountCost.WithCause(fmt.Errorf("ended_at must be after purchased_at"))
+	}
+	if s.accountRepo != nil {
+		account, err := s.accountRepo.GetByID(ctx, input.AccountID)
+		if err != nil {
+			return err
+		}
+		cost.AccountName = account.Name
+	}
+
+	cost.AccountID = input.AccountID
+	cost.MonthlyPrice = input.MonthlyPrice
+	cost.PurchasedAt = input.PurchasedAt
+	cost.EndedAt = input.EndedAt
+	cost.Notes = strings.TrimSpace(input.Notes)
+	return nil
+}
+
+// GetReport 生成利润报表：汇总、按天趋势，以及按维度拆分的明细（含各自趋势）
+func (s *ProfitService) GetReport(ctx context.Context, q ProfitQuery) (*ProfitReport, error) {
+	if q.Dimension == "" {
+		q.Dimension = ProfitDimensionAccount
+	}
+	switch q.Dimension {
+	case ProfitDimensionAccount, ProfitDimensionGroup, ProfitDimensionModel:
+	default:
+		return nil, ErrInvalidProfitQuery.WithCause(fmt.Errorf("unsupported dimension %q", q.Dimension))
+	}
+	if !q.EndTime.After(q.StartTime) {
+		return nil, ErrInvalidProfitQuery.WithCause(fmt.Errorf("end time must be after start time"))
+	}
+	if q.EndTime.Sub(q.StartTime) > profitMaxRangeDays*24*time.Hour {
+		return nil, ErrInvalidProfitQuery.WithCause(fmt.Errorf("time range must not exceed %d days", profitMaxRangeDays))
+	}
+
+	rows, err := s.repo.ListUsage(ctx, q.StartTime, q.EndTime, q.AccountID, s.aggEnabled)
+	if err != nil {
+		return nil, fmt.Errorf("list profit usage: %w", err)
+	}
+	costs, err := s.repo.ListAccountCostsInRange(ctx, q.StartTime, q.EndTime, q.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("list account costs: %w", err)
+	}
+
+	report := buildProfitReport(q, rows, costs, time.Now())
+	if err := s.fillProfitItemNames(ctx, report); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func (s *ProfitService) fillProfitItemNames(ctx context.Context, report *ProfitReport) error {
+	if len(report.Items) == 0 {
+		return nil
+	}
+	switch report.Dimension {
+	case ProfitDimensionModel:
+		for i := range report.Items {
+			report.Items[i].Name = report.Items[i].Key
+		}
+		return nil
+	case ProfitDimensionAccount, ProfitDimensionGroup:
+	default:
+		return nil
+	}
+
+	ids := make([]int64, 0, len(report.Items))
+	for _, item := range report.Items {
+		if id, err := strconv.ParseInt(item.Key, 10, 64); err == nil && id > 0 {
+			ids = append(ids, id)
+		}
+	}
+	var (
+		names map[int64]string
+		err   error
+	)
+	if report.Dimension == ProfitDimensionAccount {
+		names, err = s.repo.GetAccountNames(ctx, ids)
+	} else {
+		names, err = s.repo.GetGroupNames(ctx, ids)
+	}
+	if err != nil {
+		return fmt.Errorf("load profit item names: %w", err)
+	}
+	for i := range report.Items {
+		id, _ := strconv.ParseInt(report.Items[i].Key, 10, 64)
+		report.Items[i].Name = names[id]
+	}
+	return nil
+}
+
+type profitAccountDay struct {
+	accountID int64
+	date      string
+}
+
+// buildProfitReport 计算利润报表（纯函数，便于测试）。
+//
+// 上游成本规则（按 账号 × 天）：
+//   - 当天处于采购期间的账号：上游成本为当天的摊销成本，按各分组/模型的 usage_cost 占比分摊
+//     （usage_cost 全为 0 时按请求数占比）；当天无用量时计入 IdleCost
+//   - 其余账号：上游成本为 total_cost × account_rate_multiplier
+func buildProfitReport(q ProfitQuery, rows []ProfitUsageRow, costs []AccountCost, now time.Time) *ProfitReport {
+	loc := timezone.Location()
+	report := &ProfitReport{
+		StartTime: q.StartTime,
+		EndTime:   q.EndTime,
+		Dimension: q.Dimension,
+		Trend:     []ProfitTrendPoint{},
+		Items:     []ProfitBreakdownItem{},
+	}
+
+	// 摊销截止到当前时间，未来的日期不计成本
+	amortizeEnd := q.EndTime
+	if now.Before(amortizeEnd) {
+		amortizeEnd = now
+	}
+
+	var dates []string
+	amortized := make(map[profitAccountDay]float64)
+	for day := startOfDayIn(q.StartTime, loc); day.Before(q.EndTime); {
+		next := time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, loc)
+		date := day.Format("2006-01-02")
+		dates = append(dates, date)
+
+		from, to := day, next
+		if q.StartTime.After(from) {
+			from = q.StartTime
+		}
+		if amortizeEnd.Before(to) {
+			to = amortizeEnd
+		}
+		if to.After(from) {
+			for i := range costs {
+				if amount, ok := costs[i].amortizedIn(from, to, next.Sub(day)); ok {
+					amortized[profitAccountDay{accountID: costs[i].AccountID, date: date}] += amount
+				}
+			}
+		}
+		day = next
+	}
+
+	type usageTotal struct {
+		requests    int64
+		accountCost float64
+	}
+	totals := make(map[profitAccountDay]*usageTotal)
+	for _, row := range rows {
+		key := profitAccountDay{accountID: row.AccountID, date: row.Date}
+		t := totals[key]
+		if t == nil {
+			t = &usageTotal{}
+			totals[key] = t
+		}
+		t.requests += row.Requests
+		t.accountCost += row.AccountCost
+	}
+
+	summary := &ProfitMetrics{}
+	trend := make(map[string]*ProfitMetrics, len(dates))
+	items := make(map[string]*ProfitBreakdownItem)
+	itemTrends := make(map[string]map[string]*ProfitMetrics)
+
+	add := func(key, date string, m ProfitMetrics, toItem bool) {
+		summary.add(m)
+		if trend[date] == nil {
+			trend[date] = &ProfitMetrics{}
+		}
+		trend[date].add(m)
+		if !toItem {
+			return
+		}
+		item := items[key]
+		if item == nil {
+			item = &ProfitBreakdownItem{Key: key}
+			items[key] = item
+			itemTrends[key] = make(map[string]*ProfitMetrics)
+		}
+		item.add(m)
+		if itemTrends[key][date] == nil {
+			itemTrends[key][date] = &ProfitMetrics{}
+		}
+		itemTrends[key][date].add(m)
+	}
+
+	for _, row := range rows {
+		if q.GroupID > 0 && row.GroupID != q.GroupID {
+			continue
+		}
+		if q.Model != "" && row.Model != q.Model {
+			continue
+		}
+
+		m := ProfitMetrics{
+			Requests:     row.Requests,
+			Revenue:      row.ActualCost,
+			UsageCost:    row.AccountCost,
+			UpstreamCost: row.AccountCost,
+		}
+		accountDay := profitAccountDay{accountID: row.AccountID, date: row.Date}
+		if amount, ok := amortized[accountDay]; ok {
+			total := totals[accountDay]
+			share := 0.0
+			switch {
+			case total.accountCost > 0:
+				share = row.AccountCost / total.accountCost
+			case total.requests > 0:
+				share = float64(row.Requests) / float64(total.requests)
+			}
+			m.AmortizedCost = amount * share
+			m.UpstreamCost = m.AmortizedCost
+		}
+		add(profitDimensionKey(q.Dimension, row), row.Date, m, true)
+	}
+
+	// 闲置摊销：按分组/模型过滤时无法归属，不计入
+	if q.GroupID <= 0 && q.Model == "" {
+		for accountDay, amount := range amortized {
+			if _, used := totals[accountDay]; used {
+				continue
+			}
+			report.IdleCost += amount
+			m := ProfitMetrics{AmortizedCost: amount, UpstreamCost: amount}
+			add(strconv.FormatInt(accountDay.accountID, 10), accountDay.date, m, q.Dimension == ProfitDimensionAccount)
+		}
+	}
+
+	summary.finalize()
+	report.Summary = *summary
+	for _, date := range dates {
+		point := ProfitTrendPoint{Date: date}
+		if m := trend[date]; m != nil {
+			m.finalize()
+			point.ProfitMetrics = *m
+		}
+		report.Trend = append(report.Trend, point)
+	}
+	for key, item := range items {
+		item.finalize()
+		item.Trend = make([]ProfitTrendPoint, 0, len(itemTrends[key]))
+		for date, m := range itemTrends[key] {
+			m.finalize()
+			item.Trend = append(item.Trend, ProfitTrendPoint{Date: date, ProfitMetrics: *m})
+		}
+		sort.Slice(item.Trend, func(i, j int) bool { return item.Trend[i].Date < item.Trend[j].Date })
+		report.Items = append(report.Items, *item)
+	}
+	sort.Slice(report.Items, func(i, j int) bool {
+		if report.Items[i].Revenue != report.Items[j].Revenue {
+			return report.Items[i].Revenue > report.Items[j].Revenue
+		}
+		return report.Items[i].Key < report.Items[j].Key
+	})
+	return report
+}
+
+func profitDimensionKey(dimension string, row ProfitUsageRow) string {
+	switch dimension {
+	case ProfitDimensionGroup:
+		return strconv.FormatInt(row.GroupID, 10)
+	case ProfitDimensionModel:
+		return row.Model
+	default:
+		return strconv.FormatInt(row.AccountID, 10)
+	}
+}
+
+func startOfDayIn(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
+
+func (m *ProfitMetrics) add(o ProfitMetrics) {
+	m.Requests += o.Requests
+	m.Revenue += o.Revenue
+	m.UsageCost += o.UsageCost
+	m.AmortizedCost += o.AmortizedCost
+	m.UpstreamCost += o.UpstreamCost
+}
+
+func (m *ProfitMetrics) finalize() {
+	m.Profit = m.Revenue - m.UpstreamCost
+	m.Margin = 0
+	if m.Revenue > 0 {
+		m.Margin = m.Profit / m.Revenue
+	}
+}
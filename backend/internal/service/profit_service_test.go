@@ -0,0 +1,135 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+	"github.com/stretchr/testify/require"
+)
+
+func findProfitItem(t *testing.T, report *ProfitReport, key string) ProfitBreakdownItem {
+	t.Helper()
+	for _, item := range report.Items {
+		if item.Key == key {
+			return item
+		}
+	}
+	t.Fatalf("profit item %q not found", key)
+	return ProfitBreakdownItem{}
+}
+
+func profitTestData() (time.Time, []ProfitUsageRow, []AccountCost) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, timezone.Location())
+	rows := []ProfitUsageRow{
+		{Date: "2026-01-01", AccountID: 1, GroupID: 10, Model: "model-a", Requests: 3, AccountCost: 0.3, ActualCost: 1.0},
+		{Date: "2026-01-01", AccountID: 1, GroupID: 20, Model: "model-b", Requests: 1, AccountCost: 0.1, ActualCost: 0.5},
+		{Date: "2026-01-01", AccountID: 2, GroupID: 10, Model: "model-a", Requests: 2, AccountCost: 0.4, ActualCost: 0.6},
+		{Date: "2026-01-02", AccountID: 2, GroupID: 20, Model: "model-a", Requests: 1, AccountCost: 0.2, ActualCost: 0.5},
+	}
+	// 账号 1 月费 36.5 → 每天摊销 1.2；账号 2 无采购成本，按 total_cost × 倍率计
+	costs := []AccountCost{{AccountID: 1, MonthlyPrice: 36.5, PurchasedAt: start.AddDate(0, 0, -10)}}
+	return start, rows, costs
+}
+
+func TestBuildProfitReport_GroupDimensionAllocatesAmortizedCost(t *testing.T) {
+	start, rows, costs := profitTestData()
+	q := ProfitQuery{StartTime: start, EndTime: start.AddDate(0, 0, 2), Dimension: ProfitDimensionGroup}
+
+	report := buildProfitReport(q, rows, costs, start.AddDate(0, 0, 3))
+
+	// 账号 1 当天摊销 1.2 按 usage_cost 占比分摊：分组 10 占 0.75，分组 20 占 0.25
+	g10 := findProfitItem(t, report, "10")
+	require.InDelta(t, 1.3, g10.UpstreamCost, 1e-9)
+	require.InDelta(t, 0.9, g10.AmortizedCost, 1e-9)
+	require.InDelta(t, 1.6, g10.Revenue, 1e-9)
+	require.InDelta(t, 0.3, g10.Profit, 1e-9)
+	require.Equal(t, int64(5), g10.Requests)
+
+	g20 := findProfitItem(t, report, "20")
+	require.InDelta(t, 0.5, g20.UpstreamCost, 1e-9)
+	require.InDelta(t, 1.0, g20.Revenue, 1e-9)
+	require.Len(t, g20.Trend, 2)
+
+	// 账号 1 第二天无用量：摊销计入闲置成本，仅体现在汇总与趋势中
+	require.InDelta(t, 1.2, report.IdleCost, 1e-9)
+	require.InDelta(t, 3.0, report.Summary.UpstreamCost, 1e-9)
+	require.InDelta(t, 2.6, report.Summary.Revenue, 1e-9)
+	require.InDelta(t, -0.4, report.Summary.Profit, 1e-9)
+	require.InDelta(t, -0.4/2.6, report.Summary.Margin, 1e-9)
+
+	require.Len(t, report.Trend, 2)
+	require.Equal(t, "2026-01-02", report.Trend[1].Date)
+	require.InDelta(t, 1.4, report.Trend[1].UpstreamCost, 1e-9)
+	require.InDelta(t, 0.5, report.Trend[1].Revenue, 1e-9)
+}
+
+func TestBuildProfitReport_AccountDimensionIncludesIdleCost(t *testing.T) {
+	start, rows, costs := profitTestData()
+	q := ProfitQuery{StartTime: start, EndTime: start.AddDate(0, 0, 2), Dimension: ProfitDimensionAccount}
+
+	report := buildProfitReport(q, rows, costs, start.AddDate(0, 0, 3))
+
+	require.Len(t, report.Items, 2)
+	a1 := findProfitItem(t, report, "1")
+	require.InDelta(t, 2.4, a1.UpstreamCost, 1e-9)
+	require.InDelta(t, 2.4, a1.AmortizedCost, 1e-9)
+	require.InDelta(t, 0.4, a1.UsageCost, 1e-9)
+	require.InDelta(t, 1.5, a1.Revenue, 1e-9)
+
+	a2 := findProfitItem(t, report, "2")
+	require.InDelta(t, 0.6, a2.UpstreamCost, 1e-9)
+	require.Zero(t, a2.AmortizedCost)
+	require.InDelta(t, 1.1, a2.Revenue, 1e-9)
+	require.Equal(t, "2", report.Items[1].Key, "items sorted by revenue desc")
+}
+
+func TestBuildProfitReport_ModelFilterExcludesIdleCost(t *testing.T) {
+	start, rows, costs := profitTestData()
+	q := ProfitQuery{StartTime: start, EndTime: start.AddDate(0, 0, 2), Dimension: ProfitDimensionGroup, Model: "model-a"}
+
+	report := buildProfitReport(q, rows, costs, start.AddDate(0, 0, 3))
+
+	require.Zero(t, report.IdleCost)
+	require.InDelta(t, 1.3, findProfitItem(t, report, "10").UpstreamCost, 1e-9)
+	require.InDelta(t, 0.2, findProfitItem(t, report, "20").UpstreamCost, 1e-9)
+	require.InDelta(t, 1.5, report.Summary.UpstreamCost, 1e-9)
+}
+
+func TestBuildProfitReport_AmortizationStopsAtNowAndEndedAt(t *testing.T) {
+	start, _, _ := profitTestData()
+	ended := start.Add(30 * time.Hour)
+	costs := []AccountCost{
+		{AccountID: 1, MonthlyPrice: 36.5, PurchasedAt: start.AddDate(0, 0, -1)},
+		{AccountID: 2, MonthlyPrice: 36.5, PurchasedAt: start.Add(12 * time.Hour), EndedAt: &ended},
+	}
+	q := ProfitQuery{StartTime: start, EndTime: start.AddDate(0, 0, 3), Dimension: ProfitDimensionAccount}
+
+	// 当前时间为第二天 12:00，之后的日期不计摊销
+	report := buildProfitReport(q, nil, costs, start.Add(36*time.Hour))
+
+	require.InDelta(t, 1.8, findProfitItem(t, report, "1").UpstreamCost, 1e-9)
+	require.InDelta(t, 0.6+0.3, findProfitItem(t, report, "2").UpstreamCost, 1e-9)
+	require.Len(t, report.Trend, 3)
+	require.Zero(t, report.Trend[2].UpstreamCost)
+}
+
+func TestProfitService_Validation(t *testing.T) {
+	svc := &ProfitService{}
+	ctx := context.Background()
+	now := time.Now()
+
+	_, err := svc.GetReport(ctx, ProfitQuery{StartTime: now.AddDate(0, 0, -1), EndTime: now, Dimension: "user"})
+	require.ErrorIs(t, err, ErrInvalidProfitQuery)
+	_, err = svc.GetReport(ctx, ProfitQuery{StartTime: now.AddDate(-2, 0, 0), EndTime: now})
+	require.ErrorIs(t, err, ErrInvalidProfitQuery)
+
+	_, err = svc.CreateAccountCost(ctx, AccountCostInput{AccountID: 1, MonthlyPrice: -1, PurchasedAt: now})
+	require.ErrorIs(t, err, ErrInvalidAccountCost)
+	ended := now.Add(-time.Hour)
+	_, err = svc.CreateAccountCost(ctx, AccountCostInput{AccountID: 1, MonthlyPrice: 20, PurchasedAt: now, EndedAt: &ended})
+	require.ErrorIs(t, err, ErrInvalidAccountCost)
+}
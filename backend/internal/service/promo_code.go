@@ -1,10 +1,12 @@
 package service
 
 import (
+	"math"
+	"strings"
 	"time"
 )
 
-// PromoCode 注册优惠码
+// PromoCode 优惠码（注册/充值场景）
 type PromoCode struct {
 	ID          int64
 	Code        string
@@ -17,6 +19,20 @@ type PromoCode struct {
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 
+	// CreditValidityDays 赠送额度的有效天数，0 表示永不过期
+	CreditValidityDays int
+
+	// 使用规则
+	Scope               string   // register / topup
+	BonusPercent        float64  // 充值赠送比例（%），仅 topup 场景
+	MaxBonusAmount      *float64 // 单次赠送余额上限，nil 表示不限
+	TrialGroupID        *int64   // 赠送订阅试用的分组
+	TrialDays           int
+	NewUserDays         int      // 仅限注册 N 天内的用户，0 表示不限
+	AllowedEmailDomains []string // 邮箱域名白名单，空表示不限
+	Stackable           bool     // 同一充值订单中可与其他 stackable 优惠码叠加
+	MaxUsesPerUser      int      // 每个用户最多使用次数，0 表示不限
+
 	// 关联
 	UsageRecords []PromoCodeUsage
 }
@@ -29,6 +45,11 @@ type PromoCodeUsage struct {
 	BonusAmount float64
 	UsedAt      time.Time
 
+	TopupAmount    float64
+	PaymentOrderID *int64
+	TrialGroupID   *int64
+	TrialDays      int
+
 	// 关联
 	PromoCode *PromoCode
 	User      *User
@@ -53,21 +74,123 @@ func (p *PromoCode) IsExpired() bool {
 	return p.ExpiresAt != nil && time.Now().After(*p.ExpiresAt)
 }
 
+// HasTrial 是否赠送订阅试用
+func (p *PromoCode) HasTrial() bool {
+	return p.TrialGroupID != nil && p.TrialDays > 0
+}
+
+// BonusFor 计算赠送余额：固定金额 + 充值金额 × 比例，受单次上限约束
+func (p *PromoCode) BonusFor(topupAmount float64) float64 {
+	bonus := p.BonusAmount
+	if p.BonusPercent > 0 && topupAmount > 0 {
+		bonus += topupAmount * p.BonusPercent / 100
+	}
+	if p.MaxBonusAmount != nil && bonus > *p.MaxBonusAmount {
+		bonus = *p.MaxBonusAmount
+	}
+	return math.Round(bonus*1e8) / 1e8
+}
+
+// checkEligibility 校验使用场景、新用户与邮箱域名限制
+func (p *PromoCode) checkEligibility(user *User, scope string, now time.Time) error {
+	codeScope := p.Scope
+	if codeScope == "" {
+		codeScope = PromoCodeScopeRegister
+	}
+	if codeScope != scope {
+		return ErrPromoCodeNotApplicable
+	}
+	if p.NewUserDays > 0 && user.CreatedAt.Before(now.AddDate(0, 0, -p.NewUserDays)) {
+		return ErrPromoCodeNewUsersOnly
+	}
+	if len(p.AllowedEmailDomains) > 0 {
+		domain := ""
+		if at := strings.LastIndex(user.Email, "@"); at >= 0 {
+			domain = strings.ToLower(user.Email[at+1:])
+		}
+		allowed := false
+		for _, d := range p.AllowedEmailDomains {
+			if d == domain {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return ErrPromoCodeEmailDomain
+		}
+	}
+	return nil
+}
+
+// normalizeEmailDomains 统一为小写并去掉前导 @，去重
+func normalizeEmailDomains(domains []string) []string {
+	out := make([]string, 0, len(domains))
+	seen := make(map[string]struct{}, len(domains))
+	for _, d := range domains {
+		d = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(d)), "@")
+		if d == "" {
+			continue
+		}
+		if _, ok := seen[d]; ok {
+			continue
+		}
+		seen[d] = struct{}{}
+		out = append(out, d)
+	}
+	return out
+}
+
 // CreatePromoCodeInput 创建优惠码输入
 type CreatePromoCodeInput struct {
-	Code        string
-	BonusAmount float64
-	MaxUses     int
-	ExpiresAt   *time.Time
-	Notes       string
+	Code               string
+	BonusAmount        float64
+	CreditValidityDays int
+	MaxUses            int
+	ExpiresAt          *time.Time
+	Notes              string
+
+	Scope               string
+	BonusPercent        float64
+	MaxBonusAmount      *float64
+	TrialGroupID        *int64
+	TrialDays           int
+	NewUserDays         int
+	AllowedEmailDomains []string
+	Stackable           bool
+	MaxUsesPerUser      *int // nil 时默认 1
 }
 
 // UpdatePromoCodeInput 更新优惠码输入
 type UpdatePromoCodeInput struct {
-	Code        *string
-	BonusAmount *float64
-	MaxUses     *int
-	Status      *string
-	ExpiresAt   *time.Time
-	Notes       *string
+	Code               *string
+	BonusAmount        *float64
+	CreditValidityDays *int
+	MaxUses            *int
+	Status             *string
+	ExpiresAt          *time.Time
+	Notes              *string
+
+	Scope               *string
+	BonusPercent        *float64
+	MaxBonusAmount      *float64 // 负数表示清除上限
+	TrialGroupID        *int64   // 0 表示清除订阅试用
+	TrialDays           *int
+	NewUserDays         *int
+	AllowedEmailDomains *[]string
+	Stackable           *bool
+	MaxUsesPerUser      *int
+}
+
+// PromoCodeReportItem 按优惠码汇总的使用报表
+type PromoCodeReportItem struct {
+	PromoCodeID int64
+	Code        string
+	Scope       string
+	Uses        int64
+	Users       int64
+	BonusAmount float64
+	// TopupAmount 使用优惠码的充值订单到账金额合计
+	TopupAmount float64
+	TrialUses   int64
+	TrialDays   int64
 }
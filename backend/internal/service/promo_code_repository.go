@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
 )
@@ -22,8 +23,10 @@ type PromoCodeRepository interface {
 
 	// 使用记录
 	CreateUsage(ctx context.Context, usage *PromoCodeUsage) error
-	GetUsageByPromoCodeAndUser(ctx context.Context, promoCodeID, userID int64) (*PromoCodeUsage, error)
+	CountUsagesByPromoCodeAndUser(ctx context.Context, promoCodeID, userID int64) (int, error)
 	ListUsagesByPromoCode(ctx context.Context, promoCodeID int64, params pagination.PaginationParams) ([]PromoCodeUsage, *pagination.PaginationResult, error)
+	// UsageReport 按优惠码汇总 [start, end) 内的使用记录
+	UsageReport(ctx context.Context, start, end time.Time) ([]PromoCodeReportItem, error)
 
 	// 计数操作
 	IncrementUsedCount(ctx context.Context, id int64) error
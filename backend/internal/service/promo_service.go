@@ -4,7 +4,9 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"log"
 	"strings"
 	"time"
 
@@ -20,8 +22,43 @@ var (
 	ErrPromoCodeMaxUsed     = infraerrors.BadRequest("PROMO_CODE_MAX_USED", "promo code has reached maximum uses")
 	ErrPromoCodeAlreadyUsed = infraerrors.Conflict("PROMO_CODE_ALREADY_USED", "you have already used this promo code")
 	ErrPromoCodeInvalid     = infraerrors.BadRequest("PROMO_CODE_INVALID", "invalid promo code")
+
+	ErrPromoCodeNotApplicable = infraerrors.BadRequest("PROMO_CODE_NOT_APPLICABLE", "promo code cannot be used here")
+	ErrPromoCodeNewUsersOnly  = infraerrors.BadRequest("PROMO_CODE_NEW_USERS_ONLY", "promo code is only available to new users")
+	ErrPromoCodeEmailDomain   = infraerrors.BadRequest("PROMO_CODE_EMAIL_DOMAIN", "promo code is not available for your email domain")
+	ErrPromoCodeNotStackable  = infraerrors.BadRequest("PROMO_CODE_NOT_STACKABLE", "promo codes cannot be combined")
+	ErrPromoCodeRuleInvalid   = infraerrors.BadRequest("PROMO_CODE_RULE_INVALID", "invalid promo code rules")
+)
+
+const (
+	// promoMaxStackedCodes 单个充值订单最多叠加的优惠码数量
+	promoMaxStackedCodes = 3
+	promoMaxBonusPercent = 1000
 )
 
+// promoRuleErrors 优惠码规则校验失败（支付入账时跳过该优惠码，不影响充值到账）
+var promoRuleErrors = []error{
+	ErrPromoCodeNotFound,
+	ErrPromoCodeExpired,
+	ErrPromoCodeDisabled,
+	ErrPromoCodeMaxUsed,
+	ErrPromoCodeAlreadyUsed,
+	ErrPromoCodeInvalid,
+	ErrPromoCodeNotApplicable,
+	ErrPromoCodeNewUsersOnly,
+	ErrPromoCodeEmailDomain,
+	ErrPromoCodeNotStackable,
+}
+
+func isPromoRuleError(err error) bool {
+	for _, target := range promoRuleErrors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
 // PromoService 优惠码服务
 type PromoService struct {
 	promoRepo            PromoCodeRepository
@@ -29,6 +66,8 @@ type PromoService struct {
 	billingCacheService  *BillingCacheService
 	entClient            *dbent.Client
 	authCacheInvalidator APIKeyAuthCacheInvalidator
+	subscriptionService  *SubscriptionService
+	groupRepo            GroupRepository
 }
 
 // NewPromoService 创建优惠码服务实例
@@ -38,6 +77,8 @@ func NewPromoService(
 	billingCacheService *BillingCacheService,
 	entClient *dbent.Client,
 	authCacheInvalidator APIKeyAuthCacheInvalidator,
+	subscriptionService *SubscriptionService,
+	groupRepo GroupRepository,
 ) *PromoService {
 	return &PromoService{
 		promoRepo:            promoRepo,
@@ -45,6 +86,8 @@ func NewPromoService(
 		billingCacheService:  billingCacheService,
 		entClient:            entClient,
 		authCacheInvalidator: authCacheInvalidator,
+		subscriptionService:  subscriptionService,
+		groupRepo:            groupRepo,
 	}
 }
 
@@ -65,6 +108,10 @@ func (s *PromoService) ValidatePromoCode(ctx context.Context, code string) (*Pro
 	if err := s.validatePromoCodeStatus(promoCode); err != nil {
 		return nil, err
 	}
+	// 充值优惠码不能在注册时使用；邮箱域名等用户相关限制在注册成功后应用时校验
+	if promoCode.Scope == PromoCodeScopeTopup {
+		return nil, ErrPromoCodeNotApplicable
+	}
 
 	return promoCode, nil
 }
@@ -103,63 +150,192 @@ func (s *PromoService) ApplyPromoCode(ctx context.Context, userID int64, code st
 
 	txCtx := dbent.NewTxContext(ctx, tx)
 
+	user, err := s.userRepo.GetByID(txCtx, userID)
+	if err != nil {
+		return fmt.Errorf("get user: %w", err)
+	}
+
 	// 在事务中获取并锁定优惠码记录（FOR UPDATE）
 	promoCode, err := s.promoRepo.GetByCodeForUpdate(txCtx, code)
 	if err != nil {
 		return err
 	}
 
-	// 在事务中验证优惠码状态
-	if err := s.validatePromoCodeStatus(promoCode); err != nil {
+	usage, err := s.applyLocked(txCtx, promoCode, user, PromoCodeScopeRegister, 0, nil, false)
+	if err != nil {
 		return err
 	}
 
-	// 在事务中检查用户是否已使用过此优惠码
-	existing, err := s.promoRepo.GetUsageByPromoCodeAndUser(txCtx, promoCode.ID, userID)
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	s.invalidatePromoCaches(ctx, userID, usage.BonusAmount)
+
+	// 失效余额缓存
+	if s.billingCacheService != nil {
+		go func() {
+			cacheCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			_ = s.billingCacheService.InvalidateUserBalance(cacheCtx, userID)
+		}()
+	}
+
+	return nil
+}
+
+// ValidateTopupPromoCodes 下单时校验充值优惠码，返回规范化后的优惠码
+// 多个优惠码叠加时每个优惠码都必须允许叠加。
+func (s *PromoService) ValidateTopupPromoCodes(ctx context.Context, userID int64, codes []string) ([]string, error) {
+	normalized := make([]string, 0, len(codes))
+	seen := make(map[string]struct{}, len(codes))
+	for _, code := range codes {
+		code = strings.ToUpper(strings.TrimSpace(code))
+		if code == "" {
+			continue
+		}
+		if _, ok := seen[code]; ok {
+			continue
+		}
+		seen[code] = struct{}{}
+		normalized = append(normalized, code)
+	}
+	if len(normalized) == 0 {
+		return nil, nil
+	}
+	if len(normalized) > promoMaxStackedCodes {
+		return nil, ErrPromoCodeNotStackable.WithMetadata(map[string]string{"max_codes": fmt.Sprint(promoMaxStackedCodes)})
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
 	if err != nil {
-		return fmt.Errorf("check existing usage: %w", err)
+		return nil, err
 	}
-	if existing != nil {
-		return ErrPromoCodeAlreadyUsed
+	stacked := len(normalized) > 1
+	now := time.Now()
+	for i, code := range normalized {
+		promoCode, err := s.promoRepo.GetByCode(ctx, code)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.checkRules(ctx, promoCode, user, PromoCodeScopeTopup, stacked, now); err != nil {
+			return nil, err
+		}
+		normalized[i] = promoCode.Code
 	}
+	return normalized, nil
+}
 
-	// 增加用户余额
-	if err := s.userRepo.UpdateBalance(txCtx, userID, promoCode.BonusAmount); err != nil {
-		return fmt.Errorf("update user balance: %w", err)
+// ApplyTopupPromoCodes 在充值入账事务中发放订单上优惠码的赠送，返回赠送余额合计
+// 下单后优惠码失效（停用、达到次数等）时跳过该优惠码，不影响充值本身到账。
+func (s *PromoService) ApplyTopupPromoCodes(txCtx context.Context, order *PaymentOrder) (float64, error) {
+	if len(order.PromoCodes) == 0 {
+		return 0, nil
+	}
+	user, err := s.userRepo.GetByID(txCtx, order.UserID)
+	if err != nil {
+		return 0, fmt.Errorf("get user: %w", err)
+	}
+	orderID := order.ID
+	stacked := len(order.PromoCodes) > 1
+	total := 0.0
+	for _, code := range order.PromoCodes {
+		promoCode, err := s.promoRepo.GetByCodeForUpdate(txCtx, code)
+		if err == nil {
+			var usage *PromoCodeUsage
+			usage, err = s.applyLocked(txCtx, promoCode, user, PromoCodeScopeTopup, order.CreditAmount, &orderID, stacked)
+			if err == nil {
+				total += usage.BonusAmount
+				continue
+			}
+		}
+		if !isPromoRuleError(err) {
+			return 0, err
+		}
+		log.Printf("[Promo] skip promo code on payment: order=%s code=%s err=%v", order.OrderNo, code, err)
 	}
+	return total, nil
+}
 
-	// 创建使用记录
-	usage := &PromoCodeUsage{
-		PromoCodeID: promoCode.ID,
-		UserID:      userID,
-		BonusAmount: promoCode.BonusAmount,
-		UsedAt:      time.Now(),
+// checkRules 校验优惠码状态、适用用户与每用户使用次数
+func (s *PromoService) checkRules(ctx context.Context, promoCode *PromoCode, user *User, scope string, stacked bool, now time.Time) error {
+	if err := s.validatePromoCodeStatus(promoCode); err != nil {
+		return err
 	}
-	if err := s.promoRepo.CreateUsage(txCtx, usage); err != nil {
-		return fmt.Errorf("create usage record: %w", err)
+	if err := promoCode.checkEligibility(user, scope, now); err != nil {
+		return err
 	}
+	if stacked && !promoCode.Stackable {
+		return ErrPromoCodeNotStackable
+	}
+	if promoCode.MaxUsesPerUser > 0 {
+		used, err := s.promoRepo.CountUsagesByPromoCodeAndUser(ctx, promoCode.ID, user.ID)
+		if err != nil {
+			return fmt.Errorf("check existing usage: %w", err)
+		}
+		if used >= promoCode.MaxUsesPerUser {
+			return ErrPromoCodeAlreadyUsed
+		}
+	}
+	return nil
+}
 
-	// 增加使用次数
-	if err := s.promoRepo.IncrementUsedCount(txCtx, promoCode.ID); err != nil {
-		return fmt.Errorf("increment used count: %w", err)
+// applyLocked 在事务中（优惠码已加行锁）校验并发放赠送余额与订阅试用，写入使用记录
+func (s *PromoService) applyLocked(txCtx context.Context, promoCode *PromoCode, user *User, scope string, topupAmount float64, paymentOrderID *int64, stacked bool) (*PromoCodeUsage, error) {
+	now := time.Now()
+	if err := s.checkRules(txCtx, promoCode, user, scope, stacked, now); err != nil {
+		return nil, err
 	}
 
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("commit transaction: %w", err)
+	usage := &PromoCodeUsage{
+		PromoCodeID:    promoCode.ID,
+		UserID:         user.ID,
+		BonusAmount:    promoCode.BonusFor(topupAmount),
+		UsedAt:         now,
+		TopupAmount:    topupAmount,
+		PaymentOrderID: paymentOrderID,
 	}
 
-	s.invalidatePromoCaches(ctx, userID, promoCode.BonusAmount)
+	// 增加用户余额
+	if usage.BonusAmount > 0 {
+		if _, err := s.userRepo.ApplyBalanceChange(txCtx, &BalanceChange{
+			UserID:         user.ID,
+			Type:           BalanceTxTypePromo,
+			Amount:         usage.BonusAmount,
+			PromoCodeID:    &promoCode.ID,
+			PaymentOrderID: paymentOrderID,
+			Notes:          promoCode.Code,
+			ExpiresAt:      creditExpiresAt(now, promoCode.CreditValidityDays),
+		}); err != nil {
+			return nil, fmt.Errorf("update user balance: %w", err)
+		}
+	}
 
-	// 失效余额缓存
-	if s.billingCacheService != nil {
-		go func() {
-			cacheCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-			defer cancel()
-			_ = s.billingCacheService.InvalidateUserBalance(cacheCtx, userID)
-		}()
+	// 赠送订阅试用（已有订阅时顺延）
+	if promoCode.HasTrial() && s.subscriptionService != nil {
+		if _, _, err := s.subscriptionService.AssignOrExtendSubscription(txCtx, &AssignSubscriptionInput{
+			UserID:       user.ID,
+			GroupID:      *promoCode.TrialGroupID,
+			ValidityDays: promoCode.TrialDays,
+			AssignedBy:   0, // 系统分配
+			Notes:        fmt.Sprintf("通过优惠码 %s 获得试用", promoCode.Code),
+		}); err != nil {
+			return nil, fmt.Errorf("assign trial subscription: %w", err)
+		}
+		usage.TrialGroupID = promoCode.TrialGroupID
+		usage.TrialDays = promoCode.TrialDays
 	}
 
-	return nil
+	// 创建使用记录
+	if err := s.promoRepo.CreateUsage(txCtx, usage); err != nil {
+		return nil, fmt.Errorf("create usage record: %w", err)
+	}
+
+	// 增加使用次数
+	if err := s.promoRepo.IncrementUsedCount(txCtx, promoCode.ID); err != nil {
+		return nil, fmt.Errorf("increment used count: %w", err)
+	}
+	return usage, nil
 }
 
 func (s *PromoService) invalidatePromoCaches(ctx context.Context, userID int64, bonusAmount float64) {
@@ -191,13 +367,30 @@ func (s *PromoService) Create(ctx context.Context, input *CreatePromoCodeInput)
 	}
 
 	promoCode := &PromoCode{
-		Code:        strings.ToUpper(code),
-		BonusAmount: input.BonusAmount,
-		MaxUses:     input.MaxUses,
-		UsedCount:   0,
-		Status:      PromoCodeStatusActive,
-		ExpiresAt:   input.ExpiresAt,
-		Notes:       input.Notes,
+		Code:               strings.ToUpper(code),
+		BonusAmount:        input.BonusAmount,
+		CreditValidityDays: input.CreditValidityDays,
+		MaxUses:            input.MaxUses,
+		UsedCount:          0,
+		Status:             PromoCodeStatusActive,
+		ExpiresAt:          input.ExpiresAt,
+		Notes:              input.Notes,
+
+		Scope:               input.Scope,
+		BonusPercent:        input.BonusPercent,
+		MaxBonusAmount:      input.MaxBonusAmount,
+		TrialGroupID:        input.TrialGroupID,
+		TrialDays:           input.TrialDays,
+		NewUserDays:         input.NewUserDays,
+		AllowedEmailDomains: input.AllowedEmailDomains,
+		Stackable:           input.Stackable,
+		MaxUsesPerUser:      1,
+	}
+	if input.MaxUsesPerUser != nil {
+		promoCode.MaxUsesPerUser = *input.MaxUsesPerUser
+	}
+	if err := s.normalizeRules(ctx, promoCode); err != nil {
+		return nil, err
 	}
 
 	if err := s.promoRepo.Create(ctx, promoCode); err != nil {
@@ -229,6 +422,9 @@ func (s *PromoService) Update(ctx context.Context, id int64, input *UpdatePromoC
 	if input.BonusAmount != nil {
 		promoCode.BonusAmount = *input.BonusAmount
 	}
+	if input.CreditValidityDays != nil {
+		promoCode.CreditValidityDays = *input.CreditValidityDays
+	}
 	if input.MaxUses != nil {
 		promoCode.MaxUses = *input.MaxUses
 	}
@@ -241,6 +437,46 @@ func (s *PromoService) Update(ctx context.Context, id int64, input *UpdatePromoC
 	if input.Notes != nil {
 		promoCode.Notes = *input.Notes
 	}
+	if input.Scope != nil {
+		promoCode.Scope = *input.Scope
+	}
+	if input.BonusPercent != nil {
+		promoCode.BonusPercent = *input.BonusPercent
+	}
+	if input.MaxBonusAmount != nil {
+		if *input.MaxBonusAmount < 0 {
+			promoCode.MaxBonusAmount = nil
+		} else {
+			v := *input.MaxBonusAmount
+			promoCode.MaxBonusAmount = &v
+		}
+	}
+	if input.TrialGroupID != nil {
+		if *input.TrialGroupID == 0 {
+			promoCode.TrialGroupID = nil
+		} else {
+			v := *input.TrialGroupID
+			promoCode.TrialGroupID = &v
+		}
+	}
+	if input.TrialDays != nil {
+		promoCode.TrialDays = *input.TrialDays
+	}
+	if input.NewUserDays != nil {
+		promoCode.NewUserDays = *input.NewUserDays
+	}
+	if input.AllowedEmailDomains != nil {
+		promoCode.AllowedEmailDomains = *input.AllowedEmailDomains
+	}
+	if input.Stackable != nil {
+		promoCode.Stackable = *input.Stackable
+	}
+	if input.MaxUsesPerUser != nil {
+		promoCode.MaxUsesPerUser = *input.MaxUsesPerUser
+	}
+	if err := s.normalizeRules(ctx, promoCode); err != nil {
+		return nil, err
+	}
 
 	if err := s.promoRepo.Update(ctx, promoCode); err != nil {
 		return nil, fmt.Errorf("update promo code: %w", err)
@@ -249,6 +485,42 @@ func (s *PromoService) Update(ctx context.Context, id int64, input *UpdatePromoC
 	return promoCode, nil
 }
 
+// normalizeRules 校验并规范化使用规则
+func (s *PromoService) normalizeRules(ctx context.Context, p *PromoCode) error {
+	if p.Scope == "" {
+		p.Scope = PromoCodeScopeRegister
+	}
+	if p.Scope != PromoCodeScopeRegister && p.Scope != PromoCodeScopeTopup {
+		return ErrPromoCodeRuleInvalid
+	}
+	// 注册场景没有充值金额，比例赠送无意义
+	if p.BonusPercent < 0 || p.BonusPercent > promoMaxBonusPercent || (p.BonusPercent > 0 && p.Scope != PromoCodeScopeTopup) {
+		return ErrPromoCodeRuleInvalid
+	}
+	if p.MaxBonusAmount != nil && *p.MaxBonusAmount < 0 {
+		return ErrPromoCodeRuleInvalid
+	}
+	if p.NewUserDays < 0 || p.MaxUsesPerUser < 0 {
+		return ErrPromoCodeRuleInvalid
+	}
+	if p.TrialGroupID == nil {
+		p.TrialDays = 0
+	} else {
+		if p.TrialDays <= 0 || p.TrialDays > MaxValidityDays {
+			return ErrPromoCodeRuleInvalid
+		}
+		group, err := s.groupRepo.GetByID(ctx, *p.TrialGroupID)
+		if err != nil {
+			return err
+		}
+		if !group.IsSubscriptionType() {
+			return ErrGroupNotSubscriptionType
+		}
+	}
+	p.AllowedEmailDomains = normalizeEmailDomains(p.AllowedEmailDomains)
+	return nil
+}
+
 // Delete 删除优惠码
 func (s *PromoService) Delete(ctx context.Context, id int64) error {
 	if err := s.promoRepo.Delete(ctx, id); err != nil {
@@ -266,3 +538,8 @@ func (s *PromoService) List(ctx context.Context, params pagination.PaginationPar
 func (s *PromoService) ListUsages(ctx context.Context, promoCodeID int64, params pagination.PaginationParams) ([]PromoCodeUsage, *pagination.PaginationResult, error) {
 	return s.promoRepo.ListUsagesByPromoCode(ctx, promoCodeID, params)
 }
+
+// GetUsageReport 按优惠码汇总 [start, end) 内的使用情况
+func (s *PromoService) GetUsageReport(ctx context.Context, start, end time.Time) ([]PromoCodeReportItem, error) {
+	return s.promoRepo.UsageReport(ctx, start, end)
+}
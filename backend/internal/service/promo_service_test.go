@@ -0,0 +1,237 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/stretchr/testify/require"
+)
+
+type promoRepoStub struct {
+	codes  map[string]*PromoCode
+	usages []PromoCodeUsage
+}
+
+func newPromoRepoStub(codes ...*PromoCode) *promoRepoStub {
+	s := &promoRepoStub{codes: map[string]*PromoCode{}}
+	for i, c := range codes {
+		c.ID = int64(i + 1)
+		s.codes[strings.ToUpper(c.Code)] = c
+	}
+	return s
+}
+
+func (s *promoRepoStub) Create(ctx context.Context, code *PromoCode) error {
+	code.ID = int64(len(s.codes) + 1)
+	s.codes[code.Code] = code
+	return nil
+}
+
+func (s *promoRepoStub) GetByID(ctx context.Context, id int64) (*PromoCode, error) {
+	for _, c := range s.codes {
+		if c.ID == id {
+			clone := *c
+			return &clone, nil
+		}
+	}
+	return nil, ErrPromoCodeNotFound
+}
+
+func (s *promoRepoStub) GetByCode(ctx context.Context, code string) (*PromoCode, error) {
+	c, ok := s.codes[strings.ToUpper(code)]
+	if !ok {
+		return nil, ErrPromoCodeNotFound
+	}
+	clone := *c
+	return &clone, nil
+}
+
+func (s *promoRepoStub) GetByCodeForUpdate(ctx context.Context, code string) (*PromoCode, error) {
+	return s.GetByCode(ctx, code)
+}
+
+func (s *promoRepoStub) Update(ctx context.Context, code *PromoCode) error {
+	s.codes[code.Code] = code
+	return nil
+}
+
+func (s *promoRepoStub) Delete(ctx context.Context, id int64) error {
+	panic("unexpected Delete call")
+}
+
+func (s *promoRepoStub) List(ctx context.Context, params pagination.PaginationParams) ([]PromoCode, *pagination.PaginationResult, error) {
+	panic("unexpected List call")
+}
+
+func (s *promoRepoStub) ListWithFilters(ctx context.Context, params pagination.PaginationParams, status, search string) ([]PromoCode, *pagination.PaginationResult, error) {
+	panic("unexpected ListWithFilters call")
+}
+
+func (s *promoRepoStub) CreateUsage(ctx context.Context, usage *PromoCodeUsage) error {
+	usage.ID = int64(len(s.usages) + 1)
+	s.usages = append(s.usages, *usage)
+	return nil
+}
+
+func (s *promoRepoStub) CountUsagesByPromoCodeAndUser(ctx context.Context, promoCodeID, userID int64) (int, error) {
+	n := 0
+	for _, u := range s.usages {
+		if u.PromoCodeID == promoCodeID && u.UserID == userID {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (s *promoRepoStub) ListUsagesByPromoCode(ctx context.Context, promoCodeID int64, params pagination.PaginationParams) ([]PromoCodeUsage, *pagination.PaginationResult, error) {
+	panic("unexpected ListUsagesByPromoCode call")
+}
+
+func (s *promoRepoStub) UsageReport(ctx context.Context, start, end time.Time) ([]PromoCodeReportItem, error) {
+	panic("unexpected UsageReport call")
+}
+
+func (s *promoRepoStub) IncrementUsedCount(ctx context.Context, id int64) error {
+	for _, c := range s.codes {
+		if c.ID == id {
+			c.UsedCount++
+		}
+	}
+	return nil
+}
+
+func newPromoTestEntClient(t *testing.T) *dbent.Client {
+	t.Helper()
+	db, err := sql.Open("sqlite", "file:promo_service?mode=memory&cache=shared")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	client := dbent.NewClient(dbent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+func TestPromoCode_BonusFor(t *testing.T) {
+	code := &PromoCode{BonusAmount: 1, BonusPercent: 10}
+	require.InDelta(t, 6.0, code.BonusFor(50), 1e-9)
+
+	limit := 3.0
+	code.MaxBonusAmount = &limit
+	require.InDelta(t, 3.0, code.BonusFor(50), 1e-9)
+	require.InDelta(t, 1.0, code.BonusFor(0), 1e-9)
+}
+
+func TestPromoCode_CheckEligibility(t *testing.T) {
+	now := time.Now()
+	user := &User{ID: 1, Email: "alice@Corp.example.com", CreatedAt: now.AddDate(0, 0, -10)}
+
+	code := &PromoCode{Scope: PromoCodeScopeTopup}
+	require.ErrorIs(t, code.checkEligibility(user, PromoCodeScopeRegister, now), ErrPromoCodeNotApplicable)
+	require.NoError(t, code.checkEligibility(user, PromoCodeScopeTopup, now))
+
+	// 旧数据 scope 为空时视为注册优惠码
+	require.NoError(t, (&PromoCode{}).checkEligibility(user, PromoCodeScopeRegister, now))
+
+	code.NewUserDays = 7
+	require.ErrorIs(t, code.checkEligibility(user, PromoCodeScopeTopup, now), ErrPromoCodeNewUsersOnly)
+	code.NewUserDays = 30
+	require.NoError(t, code.checkEligibility(user, PromoCodeScopeTopup, now))
+
+	code.AllowedEmailDomains = []string{"example.com"}
+	require.ErrorIs(t, code.checkEligibility(user, PromoCodeScopeTopup, now), ErrPromoCodeEmailDomain)
+	code.AllowedEmailDomains = []string{"example.com", "corp.example.com"}
+	require.NoError(t, code.checkEligibility(user, PromoCodeScopeTopup, now))
+}
+
+func TestPromoService_NormalizeRules(t *testing.T) {
+	svc := &PromoService{}
+	ctx := context.Background()
+
+	register := &PromoCode{BonusPercent: 10}
+	require.ErrorIs(t, svc.normalizeRules(ctx, register), ErrPromoCodeRuleInvalid)
+
+	topup := &PromoCode{Scope: PromoCodeScopeTopup, BonusPercent: 10, TrialDays: 7, AllowedEmailDomains: []string{" @Example.COM", "example.com", ""}}
+	require.NoError(t, svc.normalizeRules(ctx, topup))
+	require.Equal(t, []string{"example.com"}, topup.AllowedEmailDomains)
+	require.Zero(t, topup.TrialDays, "trial days cleared without trial group")
+
+	require.ErrorIs(t, svc.normalizeRules(ctx, &PromoCode{Scope: "invite"}), ErrPromoCodeRuleInvalid)
+}
+
+func TestPromoService_ValidateTopupPromoCodes_Stacking(t *testing.T) {
+	repo := newPromoRepoStub(
+		&PromoCode{Code: "TOPUP10", Status: PromoCodeStatusActive, Scope: PromoCodeScopeTopup, BonusPercent: 10, Stackable: true, MaxUsesPerUser: 1},
+		&PromoCode{Code: "TOPUP5", Status: PromoCodeStatusActive, Scope: PromoCodeScopeTopup, BonusAmount: 5, Stackable: true, MaxUsesPerUser: 1},
+		&PromoCode{Code: "SOLO", Status: PromoCodeStatusActive, Scope: PromoCodeScopeTopup, BonusAmount: 5, MaxUsesPerUser: 1},
+		&PromoCode{Code: "WELCOME", Status: PromoCodeStatusActive, BonusAmount: 5, MaxUsesPerUser: 1},
+	)
+	userRepo := &userRepoStub{user: &User{ID: 7, Email: "u@example.com", CreatedAt: time.Now()}}
+	svc := NewPromoService(repo, userRepo, nil, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	codes, err := svc.ValidateTopupPromoCodes(ctx, 7, []string{" topup10 ", "TOPUP5", "topup10", ""})
+	require.NoError(t, err)
+	require.Equal(t, []string{"TOPUP10", "TOPUP5"}, codes)
+
+	_, err = svc.ValidateTopupPromoCodes(ctx, 7, []string{"TOPUP10", "SOLO"})
+	require.ErrorIs(t, err, ErrPromoCodeNotStackable)
+
+	codes, err = svc.ValidateTopupPromoCodes(ctx, 7, []string{"solo"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"SOLO"}, codes)
+
+	_, err = svc.ValidateTopupPromoCodes(ctx, 7, []string{"WELCOME"})
+	require.ErrorIs(t, err, ErrPromoCodeNotApplicable)
+}
+
+func TestPaymentService_TopupPromoBonus(t *testing.T) {
+	repo := newPromoRepoStub(
+		&PromoCode{Code: "TOPUP10", Status: PromoCodeStatusActive, Scope: PromoCodeScopeTopup, BonusPercent: 10, Stackable: true, MaxUsesPerUser: 1},
+		&PromoCode{Code: "EXTRA", Status: PromoCodeStatusActive, Scope: PromoCodeScopeTopup, BonusAmount: 2, Stackable: true, MaxUsesPerUser: 0},
+	)
+	userRepo := &balanceUserRepoStub{userRepoStub: &userRepoStub{user: &User{ID: 7, Email: "u@example.com", Balance: 1, CreatedAt: time.Now()}}}
+	entClient := newPromoTestEntClient(t)
+	promoSvc := NewPromoService(repo, userRepo, nil, entClient, nil, nil, nil)
+
+	provider := &paymentProviderStub{}
+	orderRepo := newPaymentOrderRepoStub()
+	cfg := &config.Config{Payment: config.PaymentConfig{Enabled: true, MinAmount: 1, MaxAmount: 1000, OrderExpireMinutes: 30}}
+	svc := NewPaymentService(orderRepo, userRepo, PaymentProviders{provider}, cfg, entClient, nil, nil, promoSvc, nil, nil)
+	ctx := context.Background()
+
+	order, err := svc.CreateOrder(ctx, 7, &CreatePaymentOrderInput{Provider: "stub", Amount: 100, PromoCodes: []string{"topup10", "extra"}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"TOPUP10", "EXTRA"}, orderRepo.orders[order.OrderNo].PromoCodes)
+
+	// 下单后优惠码被停用：支付仍然到账，跳过该优惠码
+	repo.codes["EXTRA"].Status = PromoCodeStatusDisabled
+
+	provider.result = &PaymentNotifyResult{OrderNo: order.OrderNo, ProviderTradeNo: "T1", Amount: 100, Paid: true}
+	_, _, err = svc.HandleNotify(ctx, "stub", &PaymentNotification{})
+	require.NoError(t, err)
+
+	require.Len(t, userRepo.changes, 2)
+	require.Equal(t, BalanceTxTypePayment, userRepo.changes[0].Type)
+	require.Equal(t, BalanceTxTypePromo, userRepo.changes[1].Type)
+	require.InDelta(t, 1.4, userRepo.changes[1].Amount, 1e-9)
+	require.Equal(t, order.ID, *userRepo.changes[1].PaymentOrderID)
+	require.InDelta(t, 16.4, userRepo.user.Balance, 1e-9)
+
+	require.Len(t, repo.usages, 1)
+	require.Equal(t, order.ID, *repo.usages[0].PaymentOrderID)
+	require.InDelta(t, 14.0, repo.usages[0].TopupAmount, 1e-9)
+	require.Equal(t, 1, repo.codes["TOPUP10"].UsedCount)
+
+	// 每用户限用一次
+	_, err = svc.CreateOrder(ctx, 7, &CreatePaymentOrderInput{Provider: "stub", Amount: 100, PromoCodes: []string{"TOPUP10"}})
+	require.ErrorIs(t, err, ErrPromoCodeAlreadyUsed)
+}
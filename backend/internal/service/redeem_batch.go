@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+// 兑换码批次：一次活动/渠道生成的一批兑换码，用于统计、批量停用与导出
+
+var (
+	ErrRedeemBatchNotFound = infraerrors.NotFound("REDEEM_BATCH_NOT_FOUND", "redeem code batch not found")
+	ErrRedeemBatchInvalid  = infraerrors.BadRequest("REDEEM_BATCH_INVALID", "invalid redeem code batch")
+)
+
+// RedeemBatch 兑换码批次
+type RedeemBatch struct {
+	ID        int64
+	Name      string
+	Channel   string
+	Type      string
+	Status    string
+	Notes     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	Stats RedeemBatchStats
+}
+
+func (b *RedeemBatch) IsActive() bool {
+	return b.Status == StatusActive
+}
+
+// RedeemBatchStats 批次统计
+type RedeemBatchStats struct {
+	TotalCodes    int64
+	UnusedCodes   int64
+	UsedCodes     int64
+	DisabledCodes int64 // 已停用或已过期
+	Redemptions   int64
+	RedeemedUsers int64
+	// RedeemedValue 已兑换面值合计（value × 兑换次数）
+	RedeemedValue float64
+}
+
+// RedeemBatchFilters 批次列表过滤条件
+type RedeemBatchFilters struct {
+	Channel string
+	Type    string
+	Status  string
+	Search  string
+}
+
+// CreateRedeemBatchInput 创建批次并生成兑换码
+type CreateRedeemBatchInput struct {
+	Name    string
+	Channel string
+	Notes   string
+	GenerateRedeemCodesInput
+}
+
+// UpdateRedeemBatchInput 更新批次信息（生成参数不可修改）
+type UpdateRedeemBatchInput struct {
+	Name    *string
+	Channel *string
+	Notes   *string
+}
+
+type RedeemBatchRepository interface {
+	Create(ctx context.Context, batch *RedeemBatch) error
+	GetByID(ctx context.Context, id int64) (*RedeemBatch, error)
+	Update(ctx context.Context, batch *RedeemBatch) error
+	List(ctx context.Context, params pagination.PaginationParams, filters RedeemBatchFilters) ([]RedeemBatch, *pagination.PaginationResult, error)
+	// Disable 停用批次及其中未使用的兑换码，返回停用的兑换码数量
+	Disable(ctx context.Context, id int64) (int64, error)
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+const (
+	redeemBatchMaxCodes       = 1000
+	redeemBatchExportPageSize = 100
+	redeemBatchMaxNameLen     = 100
+	redeemBatchMaxChannelLen  = 50
+)
+
+// RedeemBatchService 兑换码批次管理
+type RedeemBatchService struct {
+	batchRepo  RedeemBatchRepository
+	redeemRepo RedeemCodeRepository
+	groupRepo  GroupRepository
+	entClient  *dbent.Client
+}
+
+func NewRedeemBatchService(
+	batchRepo RedeemBatchRepository,
+	redeemRepo RedeemCodeRepository,
+	groupRepo GroupRepository,
+	entClient *dbent.Client,
+) *RedeemBatchService {
+	return &RedeemBatchService{
+		batchRepo:  batchRepo,
+		redeemRepo: redeemRepo,
+		groupRepo:  groupRepo,
+		entClient:  entClient,
+	}
+}
+
+// CreateBatch 创建批次并生成兑换码；批次与兑换码同事务写入
+func (s *RedeemBatchService) CreateBatch(ctx context.Context, input *CreateRedeemBatchInput) (*RedeemBatch, []RedeemCode, error) {
+	name := strings.TrimSpace(input.Name)
+	channel := strings.TrimSpace(input.Channel)
+	if name == "" || len(name) > redeemBatchMaxNameLen || len(channel) > redeemBatchMaxChannelLen {
+		return nil, nil, ErrRedeemBatchInvalid
+	}
+	if input.Count <= 0 || input.Count > redeemBatchMaxCodes {
+		return nil, nil, ErrRedeemBatchInvalid.WithMetadata(map[string]string{"max_count": fmt.Sprint(redeemBatchMaxCodes)})
+	}
+
+	gen := input.GenerateRedeemCodesInput
+	gen.BatchID = nil
+	codes, err := buildRedeemCodes(ctx, s.groupRepo, &gen)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	batch := &RedeemBatch{
+		Name:    name,
+		Channel: channel,
+		Type:    input.Type,
+		Status:  StatusActive,
+		Notes:   strings.TrimSpace(input.Notes),
+	}
+	err = withTx(ctx, s.entClient, func(txCtx context.Context) error {
+		if err := s.batchRepo.Create(txCtx, batch); err != nil {
+			return err
+		}
+		for i := range codes {
+			codes[i].BatchID = &batch.ID
+		}
+		return s.redeemRepo.CreateBatch(txCtx, codes)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	batch.Stats = RedeemBatchStats{TotalCodes: int64(len(codes)), UnusedCodes: int64(len(codes))}
+	return batch, codes, nil
+}
+
+func (s *RedeemBatchService) GetBatch(ctx context.Context, id int64) (*RedeemBatch, error) {
+	return s.batchRepo.GetByID(ctx, id)
+}
+
+func (s *RedeemBatchService) ListBatches(ctx context.Context, params pagination.PaginationParams, filters RedeemBatchFilters) ([]RedeemBatch, *pagination.PaginationResult, error) {
+	return s.batchRepo.List(ctx, params, filters)
+}
+
+// UpdateBatch 修改批次名称、渠道与备注
+func (s *RedeemBatchService) UpdateBatch(ctx context.Context, id int64, input *UpdateRedeemBatchInput) (*RedeemBatch, error) {
+	batch, err := s.batchRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if input.Name != nil {
+		name := strings.TrimSpace(*input.Name)
+		if name == "" || len(name) > redeemBatchMaxNameLen {
+			return nil, ErrRedeemBatchInvalid
+		}
+		batch.Name = name
+	}
+	if input.Channel != nil {
+		channel := strings.TrimSpace(*input.Channel)
+		if len(channel) > redeemBatchMaxChannelLen {
+			return nil, ErrRedeemBatchInvalid
+		}
+		batch.Channel = channel
+	}
+	if input.Notes != nil {
+		batch.Notes = strings.TrimSpace(*input.Notes)
+	}
+	if err := s.batchRepo.Update(ctx, batch); err != nil {
+		return nil, err
+	}
+	return batch, nil
+}
+
+// DisableBatch 批量停用批次内未使用的兑换码（已兑换的权益不受影响）
+func (s *RedeemBatchService) DisableBatch(ctx context.Context, id int64) (int64, error) {
+	if _, err := s.batchRepo.GetByID(ctx, id); err != nil {
+		return 0, err
+	}
+	return s.batchRepo.Disable(ctx, id)
+}
+
+// ListBatchCodes 批次内的兑换码
+func (s *RedeemBatchService) ListBatchCodes(ctx context.Context, id int64, params pagination.PaginationParams) ([]RedeemCode, *pagination.PaginationResult, error) {
+	if _, err := s.batchRepo.GetByID(ctx, id); err != nil {
+		return nil, nil, err
+	}
+	return s.redeemRepo.ListByBatch(ctx, id, params)
+}
+
+// ExportBatchCodes 导出批次内的全部兑换码
+func (s *RedeemBatchService) ExportBatchCodes(ctx context.Context, id int64) (*RedeemBatch, []RedeemCode, error) {
+	batch, err := s.batchRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	codes := make([]RedeemCode, 0)
+	for page := 1; ; page++ {
+		items, result, err := s.redeemRepo.ListByBatch(ctx, id, pagination.PaginationParams{Page: page, PageSize: redeemBatchExportPageSize})
+		if err != nil {
+			return nil, nil, err
+		}
+		codes = append(codes, items...)
+		if len(items) == 0 || int64(len(codes)) >= result.Total {
+			return batch, codes, nil
+		}
+	}
+}
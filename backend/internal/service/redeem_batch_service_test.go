@@ -0,0 +1,159 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/stretchr/testify/require"
+)
+
+type redeemBatchRepoStub struct {
+	nextID   int64
+	created  []*RedeemBatch
+	batches  map[int64]*RedeemBatch
+	disabled []int64
+}
+
+func (s *redeemBatchRepoStub) Create(ctx context.Context, batch *RedeemBatch) error {
+	s.nextID++
+	batch.ID = s.nextID
+	s.created = append(s.created, batch)
+	return nil
+}
+
+func (s *redeemBatchRepoStub) GetByID(ctx context.Context, id int64) (*RedeemBatch, error) {
+	if b, ok := s.batches[id]; ok {
+		cp := *b
+		return &cp, nil
+	}
+	return nil, ErrRedeemBatchNotFound
+}
+
+func (s *redeemBatchRepoStub) Update(ctx context.Context, batch *RedeemBatch) error {
+	s.batches[batch.ID] = batch
+	return nil
+}
+
+func (s *redeemBatchRepoStub) List(ctx context.Context, params pagination.PaginationParams, filters RedeemBatchFilters) ([]RedeemBatch, *pagination.PaginationResult, error) {
+	panic("unexpected List call")
+}
+
+func (s *redeemBatchRepoStub) Disable(ctx context.Context, id int64) (int64, error) {
+	s.disabled = append(s.disabled, id)
+	return 3, nil
+}
+
+type redeemBatchCodeRepoStub struct {
+	redeemRepoStub
+	created []RedeemCode
+	byBatch []RedeemCode
+}
+
+func (s *redeemBatchCodeRepoStub) CreateBatch(ctx context.Context, codes []RedeemCode) error {
+	s.created = append(s.created, codes...)
+	return nil
+}
+
+func (s *redeemBatchCodeRepoStub) ListByBatch(ctx context.Context, batchID int64, params pagination.PaginationParams) ([]RedeemCode, *pagination.PaginationResult, error) {
+	start := params.Offset()
+	if start > len(s.byBatch) {
+		start = len(s.byBatch)
+	}
+	end := start + params.Limit()
+	if end > len(s.byBatch) {
+		end = len(s.byBatch)
+	}
+	return s.byBatch[start:end], &pagination.PaginationResult{Total: int64(len(s.byBatch)), Page: params.Page, PageSize: params.Limit()}, nil
+}
+
+func TestRedeemBatchService_CreateBatch_AssignsBatchID(t *testing.T) {
+	batchRepo := &redeemBatchRepoStub{nextID: 41}
+	codeRepo := &redeemBatchCodeRepoStub{}
+	svc := NewRedeemBatchService(batchRepo, codeRepo, nil, nil)
+
+	batch, codes, err := svc.CreateBatch(context.Background(), &CreateRedeemBatchInput{
+		Name:    "  双十一活动 ",
+		Channel: "wechat",
+		GenerateRedeemCodesInput: GenerateRedeemCodesInput{
+			Count:          3,
+			Type:           RedeemTypeBalance,
+			Value:          10,
+			MaxRedemptions: 50,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(42), batch.ID)
+	require.Equal(t, "双十一活动", batch.Name)
+	require.Equal(t, StatusActive, batch.Status)
+	require.Equal(t, int64(3), batch.Stats.TotalCodes)
+	require.Len(t, codes, 3)
+	require.Len(t, codeRepo.created, 3)
+	for _, code := range codeRepo.created {
+		require.NotNil(t, code.BatchID)
+		require.Equal(t, int64(42), *code.BatchID)
+		require.Equal(t, 50, code.MaxRedemptions)
+		require.True(t, code.IsMultiUse())
+	}
+}
+
+func TestRedeemBatchService_CreateBatch_Validation(t *testing.T) {
+	svc := NewRedeemBatchService(&redeemBatchRepoStub{}, &redeemBatchCodeRepoStub{}, nil, nil)
+	ctx := context.Background()
+
+	_, _, err := svc.CreateBatch(ctx, &CreateRedeemBatchInput{
+		Name:                     " ",
+		GenerateRedeemCodesInput: GenerateRedeemCodesInput{Count: 1, Type: RedeemTypeBalance, Value: 1},
+	})
+	require.ErrorIs(t, err, ErrRedeemBatchInvalid)
+
+	_, _, err = svc.CreateBatch(ctx, &CreateRedeemBatchInput{
+		Name:                     "too many",
+		GenerateRedeemCodesInput: GenerateRedeemCodesInput{Count: redeemBatchMaxCodes + 1, Type: RedeemTypeBalance, Value: 1},
+	})
+	require.ErrorIs(t, err, ErrRedeemBatchInvalid)
+
+	_, _, err = svc.CreateBatch(ctx, &CreateRedeemBatchInput{
+		Name:                     "negative",
+		GenerateRedeemCodesInput: GenerateRedeemCodesInput{Count: 1, Type: RedeemTypeBalance, Value: 1, MaxRedemptions: -1},
+	})
+	require.Error(t, err)
+}
+
+func TestRedeemBatchService_DisableBatch_NotFound(t *testing.T) {
+	batchRepo := &redeemBatchRepoStub{batches: map[int64]*RedeemBatch{}}
+	svc := NewRedeemBatchService(batchRepo, &redeemBatchCodeRepoStub{}, nil, nil)
+
+	_, err := svc.DisableBatch(context.Background(), 7)
+	require.ErrorIs(t, err, ErrRedeemBatchNotFound)
+	require.Empty(t, batchRepo.disabled)
+}
+
+func TestRedeemBatchService_ExportBatchCodes_Paginates(t *testing.T) {
+	codeRepo := &redeemBatchCodeRepoStub{byBatch: make([]RedeemCode, 250)}
+	for i := range codeRepo.byBatch {
+		codeRepo.byBatch[i].ID = int64(i + 1)
+	}
+	batchRepo := &redeemBatchRepoStub{batches: map[int64]*RedeemBatch{1: {ID: 1, Name: "b"}}}
+	svc := NewRedeemBatchService(batchRepo, codeRepo, nil, nil)
+
+	_, codes, err := svc.ExportBatchCodes(context.Background(), 1)
+	require.NoError(t, err)
+	require.Len(t, codes, 250)
+	require.Equal(t, int64(250), codes[249].ID)
+}
+
+func TestRedeemCode_CanUse_MultiUse(t *testing.T) {
+	code := &RedeemCode{Status: StatusUnused, MaxRedemptions: 2, RedeemedCount: 1}
+	require.True(t, code.IsMultiUse())
+	require.True(t, code.CanUse())
+
+	code.RedeemedCount = 2
+	require.False(t, code.CanUse())
+
+	single := &RedeemCode{Status: StatusUnused, MaxRedemptions: 1}
+	require.False(t, single.IsMultiUse())
+	require.True(t, single.CanUse())
+}
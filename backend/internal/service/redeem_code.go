@@ -20,6 +20,15 @@ type RedeemCode struct {
 	GroupID      *int64
 	ValidityDays int
 
+	// CreditValidityDays 余额类型专用：发放额度的有效天数，0 表示永不过期
+	CreditValidityDays int
+
+	// BatchID 所属批次，nil 表示单独生成
+	BatchID *int64
+	// MaxRedemptions 最大兑换次数（每个用户限一次），RedeemedCount 达到上限后状态变为 used
+	MaxRedemptions int
+	RedeemedCount  int
+
 	User  *User
 	Group *Group
 }
@@ -29,7 +38,12 @@ func (r *RedeemCode) IsUsed() bool {
 }
 
 func (r *RedeemCode) CanUse() bool {
-	return r.Status == StatusUnused
+	return r.Status == StatusUnused && (r.MaxRedemptions <= 0 || r.RedeemedCount < r.MaxRedemptions)
+}
+
+// IsMultiUse 是否为可被多个用户兑换的兑换码
+func (r *RedeemCode) IsMultiUse() bool {
+	return r.MaxRedemptions > 1
 }
 
 func GenerateRedeemCode() (string, error) {
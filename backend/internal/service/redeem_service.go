@@ -20,6 +20,8 @@ var (
 	ErrInsufficientBalance = infraerrors.BadRequest("INSUFFICIENT_BALANCE", "insufficient balance")
 	ErrRedeemRateLimited   = infraerrors.TooManyRequests("REDEEM_RATE_LIMITED", "too many failed attempts, please try again later")
 	ErrRedeemCodeLocked    = infraerrors.Conflict("REDEEM_CODE_LOCKED", "redeem code is being processed, please try again")
+
+	ErrRedeemCodeAlreadyRedeemed = infraerrors.Conflict("REDEEM_CODE_ALREADY_REDEEMED", "you have already redeemed this code")
 )
 
 const (
@@ -44,11 +46,13 @@ type RedeemCodeRepository interface {
 	GetByCode(ctx context.Context, code string) (*RedeemCode, error)
 	Update(ctx context.Context, code *RedeemCode) error
 	Delete(ctx context.Context, id int64) error
+	// Use 记录一次兑换；用完或不可用返回 ErrRedeemCodeUsed，该用户已兑换过返回 ErrRedeemCodeAlreadyRedeemed
 	Use(ctx context.Context, id, userID int64) error
 
 	List(ctx context.Context, params pagination.PaginationParams) ([]RedeemCode, *pagination.PaginationResult, error)
 	ListWithFilters(ctx context.Context, params pagination.PaginationParams, codeType, status, search string) ([]RedeemCode, *pagination.PaginationResult, error)
 	ListByUser(ctx context.Context, userID int64, limit int) ([]RedeemCode, error)
+	ListByBatch(ctx context.Context, batchID int64, params pagination.PaginationParams) ([]RedeemCode, *pagination.PaginationResult, error)
 }
 
 // GenerateCodesRequest 生成兑换码请求
@@ -266,8 +270,12 @@ func (s *RedeemService) Redeem(ctx context.Context, userID int64, code string) (
 	txCtx := dbent.NewTxContext(ctx, tx)
 
 	// 【关键】先标记兑换码为已使用，确保并发安全
-	// 利用数据库乐观锁（WHERE status = 'unused'）保证原子性
+	// 利用数据库乐观锁（WHERE status = 'unused' 且未达兑换上限）保证原子性；
+	// 多次使用兑换码由兑换记录的 (redeem_code_id, user_id) 唯一约束保证每个用户只兑换一次
 	if err := s.redeemRepo.Use(txCtx, redeemCode.ID, userID); err != nil {
+		if errors.Is(err, ErrRedeemCodeAlreadyRedeemed) {
+			return nil, ErrRedeemCodeAlreadyRedeemed
+		}
 		if errors.Is(err, ErrRedeemCodeNotFound) || errors.Is(err, ErrRedeemCodeUsed) {
 			return nil, ErrRedeemCodeUsed
 		}
@@ -278,7 +286,14 @@ func (s *RedeemService) Redeem(ctx context.Context, userID int64, code string) (
 	switch redeemCode.Type {
 	case RedeemTypeBalance:
 		// 增加用户余额
-		if err := s.userRepo.UpdateBalance(txCtx, userID, redeemCode.Value); err != nil {
+		if _, err := s.userRepo.ApplyBalanceChange(txCtx, &BalanceChange{
+			UserID:       userID,
+			Type:         BalanceTxTypeRedeem,
+			Amount:       redeemCode.Value,
+			RedeemCodeID: &redeemCode.ID,
+			Notes:        redeemCode.Code,
+			ExpiresAt:    creditExpiresAt(time.Now(), redeemCode.CreditValidityDays),
+		}); err != nil {
 			return nil, fmt.Errorf("update user balance: %w", err)
 		}
 
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+// 邀请返佣
+// 被邀请人的实付消费 = 余额流水中 usage（网关扣费）与 subscription（余额购买/续费套餐）的扣减合计，
+// 且累计不超过其付费充值（payment）合计，赠送类额度支撑的消费不返佣；
+// 由结算任务按 settled_until 水位增量统计，按注册时快照的比例/上限/期限发放到邀请人余额。
+
+// ReferralSpendTxTypes 计入实付消费的余额流水类型
+var ReferralSpendTxTypes = []string{BalanceTxTypeUsage, BalanceTxTypeSubscription}
+
+// ReferralRefundTxTypes 退还消费的余额流水类型（如重新计费退款），其正数金额从实付消费中扣减
+var ReferralRefundTxTypes = []string{BalanceTxTypeAdjustment}
+
+// ReferralFundingTxTypes 视为付费充值的余额流水类型，作为返佣基数的累计上限
+var ReferralFundingTxTypes = []string{BalanceTxTypePayment}
+
+var (
+	ErrReferralDisabled           = infraerrors.Forbidden("REFERRAL_DISABLED", "referral program is disabled")
+	ErrReferralCodeInvalid        = infraerrors.BadRequest("REFERRAL_CODE_INVALID", "invalid referral code")
+	ErrReferralCodeNotFound       = infraerrors.NotFound("REFERRAL_CODE_NOT_FOUND", "referral code not found")
+	ErrReferralCodeConflict       = infraerrors.Conflict("REFERRAL_CODE_CONFLICT", "referral code already exists")
+	ErrReferralExists             = infraerrors.Conflict("REFERRAL_EXISTS", "user has already been referred")
+	ErrReferralNotFound           = infraerrors.NotFound("REFERRAL_NOT_FOUND", "referral not found")
+	ErrReferralSettlementConflict = infraerrors.Conflict("REFERRAL_SETTLEMENT_CONFLICT", "referral was settled concurrently")
+)
+
+// Referral 邀请关系（返佣规则为注册时快照）
+type Referral struct {
+	ID             int64
+	ReferrerID     int64
+	RefereeID      int64
+	CommissionRate float64
+	// CommissionCapUSD 累计佣金上限，nil 表示不限制
+	CommissionCapUSD *float64
+	// ExpiresAt 返佣截止时间，nil 表示永久
+	ExpiresAt       *time.Time
+	TotalSpend      float64
+	TotalCommission float64
+	SettledUntil    time.Time
+	CreatedAt       time.Time
+
+	// 列表查询时填充
+	RefereeEmail string
+}
+
+// IsActiveAt 在 t 时刻是否仍在返佣期内且未达上限
+func (r *Referral) IsActiveAt(t time.Time) bool {
+	if r.ExpiresAt != nil && !t.Before(*r.ExpiresAt) {
+		return false
+	}
+	return r.CommissionCapUSD == nil || r.TotalCommission < *r.CommissionCapUSD
+}
+
+// SettleEnd 本次结算的截止时间：不超过返佣截止时间
+func (r *Referral) SettleEnd(until time.Time) time.Time {
+	if r.ExpiresAt != nil && r.ExpiresAt.Before(until) {
+		return *r.ExpiresAt
+	}
+	return until
+}
+
+// Commission 按快照比例计算佣金，并截断到剩余上限
+func (r *Referral) Commission(spend float64) float64 {
+	if spend <= 0 || r.CommissionRate <= 0 {
+		return 0
+	}
+	amount := roundReferralAmount(spend * r.CommissionRate / 100)
+	if r.CommissionCapUSD != nil {
+		remaining := roundReferralAmount(*r.CommissionCapUSD - r.TotalCommission)
+		if remaining <= 0 {
+			return 0
+		}
+		if amount > remaining {
+			amount = remaining
+		}
+	}
+	return amount
+}
+
+// roundReferralAmount 金额保留 8 位小数（与 DECIMAL(20,8) 一致）
+func roundReferralAmount(v float64) float64 {
+	return math.Round(v*1e8) / 1e8
+}
+
+// ReferralCommission 佣金结算记录
+type ReferralCommission struct {
+	ID             int64
+	ReferralID     int64
+	ReferrerID     int64
+	RefereeID      int64
+	PeriodStart    time.Time
+	PeriodEnd      time.Time
+	Spend          float64
+	CommissionRate float64
+	Amount         float64
+	CreatedAt      time.Time
+
+	// 列表查询时填充
+	ReferrerEmail string
+	RefereeEmail  string
+}
+
+// ReferralCommissionFilters 佣金记录过滤条件
+type ReferralCommissionFilters struct {
+	ReferrerID int64
+	RefereeID  int64
+	StartTime  *time.Time
+	EndTime    *time.Time
+}
+
+// ReferralStats 邀请人累计数据
+type ReferralStats struct {
+	ReferralCount   int64
+	TotalSpend      float64
+	TotalCommission float64
+}
+
+// ReferralDashboard 用户邀请看板
+type ReferralDashboard struct {
+	Enabled bool
+	Code    string
+	// 当前规则（新邀请的用户适用）
+	CommissionRate   float64
+	CommissionCapUSD float64
+	DurationDays     int
+	ReferralStats
+}
+
+// ReferralSettlementRow 结算报表中单个邀请人的汇总
+type ReferralSettlementRow struct {
+	ReferrerID    int64
+	ReferrerEmail string
+	Referees      int64
+	Settlements   int64
+	Spend         float64
+	Commission    float64
+}
+
+// ReferralSettlementReport 统计区间内（按结算时间）的佣金结算报表
+type ReferralSettlementReport struct {
+	StartTime       time.Time
+	EndTime         time.Time
+	TotalSpend      float64
+	TotalCommission float64
+	Rows            []ReferralSettlementRow
+}
+
+type ReferralRepository interface {
+	GetCodeByUserID(ctx context.Context, userID int64) (string, error)
+	// CreateCode 用户已有邀请码或邀请码冲突时返回 ErrReferralCodeConflict
+	CreateCode(ctx context.Context, userID int64, code string) error
+	GetUserIDByCode(ctx context.Context, code string) (int64, error)
+
+	// Create 被邀请人已绑定时返回 ErrReferralExists
+	Create(ctx context.Context, referral *Referral) error
+	GetByRefereeID(ctx context.Context, refereeID int64) (*Referral, error)
+	ListByReferrer(ctx context.Context, referrerID int64, params pagination.PaginationParams) ([]Referral, *pagination.PaginationResult, error)
+	GetStats(ctx context.Context, referrerID int64) (*ReferralStats, error)
+
+	// ListDueForSettlement 水位早于 until、仍在返佣期内且未达上限的邀请关系（按 ID 游标分页）
+	ListDueForSettlement(ctx context.Context, until time.Time, afterID int64, limit int) ([]Referral, error)
+	// GetRefereeSpend 被邀请人在 [start, end) 内由付费充值支撑的实付消费（已扣除退款调整）
+	GetRefereeSpend(ctx context.Context, refereeID int64, start, end time.Time) (float64, error)
+	// Settle 推进水位并累计消费/佣金，amount > 0 时写入结算记录；水位已被推进时返回 ErrReferralSettlementConflict
+	Settle(ctx context.Context, referral *Referral, end time.Time, spend, amount float64) (*ReferralCommission, error)
+
+	ListCommissions(ctx context.Context, params pagination.PaginationParams, filters ReferralCommissionFilters) ([]ReferralCommission, *pagination.PaginationResult, error)
+	GetSettlementReport(ctx context.Context, startTime, endTime time.Time) ([]ReferralSettlementRow, error)
+}
@@ -0,0 +1,412 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+const (
+	referralWorkerName    = "referral_settlement_worker"
+	referralLeaderLockKey = "referral:settlement:leader"
+	referralInterval      = time.Hour
+	referralRunTimeout    = 30 * time.Minute
+	referralBatchSize     = 200
+	// referralSettleLag 结算截止时间滞后于当前时间，避免遗漏尚未提交的扣费流水
+	referralSettleLag = 5 * time.Minute
+
+	referralCodeLength   = 8
+	referralCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	referralCodeAttempts = 5
+)
+
+// ReferralService 邀请返佣：邀请码、注册绑定、佣金结算与报表
+type ReferralService struct {
+	repo                 ReferralRepository
+	userRepo             UserRepository
+	billingCacheService  *BillingCacheService
+	authCacheInvalidator APIKeyAuthCacheInvalidator
+	entClient            *dbent.Client
+	cfg                  *config.Config
+	timingWheel          *TimingWheelService
+	db                   *sql.DB
+
+	running   int32
+	startOnce sync.Once
+	stopOnce  sync.Once
+}
+
+func NewReferralService(
+	repo ReferralRepository,
+	userRepo UserRepository,
+	billingCacheService *BillingCacheService,
+	authCacheInvalidator APIKeyAuthCacheInvalidator,
+	entClient *dbent.Client,
+	cfg *config.Config,
+	timingWheel *TimingWheelService,
+	db *sql.DB,
+) *ReferralService {
+	return &ReferralService{
+		repo:                 repo,
+		userRepo:             userRepo,
+		billingCacheService:  billingCacheService,
+		authCacheInvalidator: authCacheInvalidator,
+		entClient:            entClient,
+		cfg:                  cfg,
+		timingWheel:          timingWheel,
+		db:                   db,
+	}
+}
+
+func (s *ReferralService) Start() {
+	if s == nil {
+		return
+	}
+	if !s.enabled() {
+		log.Printf("[Referral] settlement worker disabled by config")
+		return
+	}
+	if s.repo == nil || s.timingWheel == nil {
+		log.Printf("[Referral] settlement worker not started (missing deps)")
+		return
+	}
+	s.startOnce.Do(func() {
+		s.timingWheel.ScheduleRecurring(referralWorkerName, referralInterval, s.runOnce)
+		log.Printf("[Referral] settlement worker started (interval=%s)", referralInterval)
+	})
+}
+
+func (s *ReferralService) Stop() {
+	if s == nil {
+		return
+	}
+	s.stopOnce.Do(func() {
+		if s.timingWheel != nil {
+			s.timingWheel.Cancel(referralWorkerName)
+		}
+		log.Printf("[Referral] settlement worker stopped")
+	})
+}
+
+func (s *ReferralService) enabled() bool {
+	return s.cfg != nil && s.cfg.Referral.Enabled
+}
+
+// ============================================
+// 邀请码与绑定
+// ============================================
+
+// GetOrCreateCode 获取用户邀请码，首次访问时生成
+func (s *ReferralService) GetOrCreateCode(ctx context.Context, userID int64) (string, error) {
+	code, err := s.repo.GetCodeByUserID(ctx, userID)
+	if err == nil {
+		return code, nil
+	}
+	if !errors.Is(err, ErrReferralCodeNotFound) {
+		return "", err
+	}
+
+	for i := 0; i < referralCodeAttempts; i++ {
+		code, err = generateReferralCode()
+		if err != nil {
+			return "", err
+		}
+		err = s.repo.CreateCode(ctx, userID, code)
+		if err == nil {
+			return code, nil
+		}
+		if !errors.Is(err, ErrReferralCodeConflict) {
+			return "", err
+		}
+		// 并发请求已为该用户生成邀请码
+		if existing, getErr := s.repo.GetCodeByUserID(ctx, userID); getErr == nil {
+			return existing, nil
+		}
+	}
+	return "", fmt.Errorf("generate referral code: %w", err)
+}
+
+// BindReferral 注册时绑定邀请人；返佣规则按当前配置快照
+func (s *ReferralService) BindReferral(ctx context.Context, refereeID int64, code string) error {
+	code = normalizeReferralCode(code)
+	if code == "" {
+		return nil
+	}
+	if !s.enabled() {
+		return ErrReferralDisabled
+	}
+	referrerID, err := s.repo.GetUserIDByCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, ErrReferralCodeNotFound) {
+			return ErrReferralCodeInvalid
+		}
+		return err
+	}
+	if referrerID == refereeID {
+		return ErrReferralCodeInvalid
+	}
+	referrer, err := s.userRepo.GetByID(ctx, referrerID)
+	if err != nil {
+		return err
+	}
+	if !referrer.IsActive() {
+		return ErrReferralCodeInvalid
+	}
+
+	now := time.Now()
+	rule := s.cfg.Referral
+	referral := &Referral{
+		ReferrerID:     referrerID,
+		RefereeID:      refereeID,
+		CommissionRate: rule.CommissionRate,
+		SettledUntil:   now,
+	}
+	if rule.CommissionCapUSD > 0 {
+		capUSD := rule.CommissionCapUSD
+		referral.CommissionCapUSD = &capUSD
+	}
+	if rule.DurationDays > 0 {
+		expiresAt := now.AddDate(0, 0, rule.DurationDays)
+		referral.ExpiresAt = &expiresAt
+	}
+	return s.repo.Create(ctx, referral)
+}
+
+// ============================================
+// 用户看板
+// ============================================
+
+// GetDashboard 用户邀请看板：邀请码、当前规则与累计数据
+func (s *ReferralService) GetDashboard(ctx context.Context, userID int64) (*ReferralDashboard, error) {
+	dashboard := &ReferralDashboard{Enabled: s.enabled()}
+	if s.cfg != nil {
+		dashboard.CommissionRate = s.cfg.Referral.CommissionRate
+		dashboard.CommissionCapUSD = s.cfg.Referral.CommissionCapUSD
+		dashboard.DurationDays = s.cfg.Referral.DurationDays
+	}
+	if dashboard.Enabled {
+		code, err := s.GetOrCreateCode(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		dashboard.Code = code
+	}
+	stats, err := s.repo.GetStats(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	dashboard.ReferralStats = *stats
+	return dashboard, nil
+}
+
+// ListReferrals 用户邀请的用户（邮箱脱敏）
+func (s *ReferralService) ListReferrals(ctx context.Context, userID int64, params pagination.PaginationParams) ([]Referral, *pagination.PaginationResult, error) {
+	items, result, err := s.repo.ListByReferrer(ctx, userID, params)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range items {
+		items[i].RefereeEmail = maskReferralEmail(items[i].RefereeEmail)
+	}
+	return items, result, nil
+}
+
+// ListUserCommissions 用户获得的佣金记录（邮箱脱敏）
+func (s *ReferralService) ListUserCommissions(ctx context.Context, userID int64, params pagination.PaginationParams) ([]ReferralCommission, *pagination.PaginationResult, error) {
+	items, result, err := s.repo.ListCommissions(ctx, params, ReferralCommissionFilters{ReferrerID: userID})
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range items {
+		items[i].ReferrerEmail = ""
+		items[i].RefereeEmail = maskReferralEmail(items[i].RefereeEmail)
+	}
+	return items, result, nil
+}
+
+// ============================================
+// 管理端
+// ============================================
+
+// ListCommissions 佣金结算记录
+func (s *ReferralService) ListCommissions(ctx context.Context, params pagination.PaginationParams, filters ReferralCommissionFilters) ([]ReferralCommission, *pagination.PaginationResult, error) {
+	return s.repo.ListCommissions(ctx, params, filters)
+}
+
+// GetSettlementReport 按邀请人汇总 [startTime, endTime) 内结算的佣金
+func (s *ReferralService) GetSettlementReport(ctx context.Context, startTime, endTime time.Time) (*ReferralSettlementReport, error) {
+	rows, err := s.repo.GetSettlementReport(ctx, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+	report := &ReferralSettlementReport{StartTime: startTime, EndTime: endTime, Rows: rows}
+	for _, row := range rows {
+		report.TotalSpend += row.Spend
+		report.TotalCommission += row.Commission
+	}
+	report.TotalSpend = roundReferralAmount(report.TotalSpend)
+	report.TotalCommission = roundReferralAmount(report.TotalCommission)
+	return report, nil
+}
+
+// SettleNow 管理员手动触发结算（与定时任务通过水位乐观锁互斥）
+func (s *ReferralService) SettleNow(ctx context.Context) (int, error) {
+	return s.SettleAll(ctx, time.Now().Add(-referralSettleLag))
+}
+
+// ============================================
+// 结算
+// ============================================
+
+// SettleAll 结算所有到期的邀请关系至 until，返回发放佣金的笔数
+func (s *ReferralService) SettleAll(ctx context.Context, until time.Time) (int, error) {
+	count := 0
+	var afterID int64
+	for {
+		batch, err := s.repo.ListDueForSettlement(ctx, until, afterID, referralBatchSize)
+		if err != nil {
+			return count, fmt.Errorf("list due referrals: %w", err)
+		}
+		for i := range batch {
+			afterID = batch[i].ID
+			commission, err := s.settleOne(ctx, &batch[i], until)
+			if err != nil {
+				if errors.Is(err, ErrReferralSettlementConflict) {
+					continue
+				}
+				log.Printf("[Referral] settle referral %d failed: %v", batch[i].ID, err)
+				continue
+			}
+			if commission != nil {
+				count++
+			}
+		}
+		if len(batch) < referralBatchSize {
+			return count, nil
+		}
+	}
+}
+
+// settleOne 统计被邀请人 [settled_until, end) 的实付消费并发放佣金；水位推进、结算记录与余额入账同事务提交
+func (s *ReferralService) settleOne(ctx context.Context, referral *Referral, until time.Time) (*ReferralCommission, error) {
+	end := referral.SettleEnd(until)
+	if !end.After(referral.SettledUntil) {
+		return nil, nil
+	}
+	spend, err := s.repo.GetRefereeSpend(ctx, referral.RefereeID, referral.SettledUntil, end)
+	if err != nil {
+		return nil, fmt.Errorf("get referee spend: %w", err)
+	}
+	spend = roundReferralAmount(spend)
+	amount := referral.Commission(spend)
+
+	var commission *ReferralCommission
+	err = withTx(ctx, s.entClient, func(txCtx context.Context) error {
+		var err error
+		commission, err = s.repo.Settle(txCtx, referral, end, spend, amount)
+		if err != nil {
+			return err
+		}
+		if commission == nil {
+			return nil
+		}
+		_, err = s.userRepo.ApplyBalanceChange(txCtx, &BalanceChange{
+			UserID: referral.ReferrerID,
+			Type:   BalanceTxTypeReferral,
+			Amount: amount,
+			Notes:  fmt.Sprintf("referral commission #%d", commission.ID),
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if commission != nil {
+		s.invalidateBalanceCaches(ctx, referral.ReferrerID)
+	}
+	return commission, nil
+}
+
+func (s *ReferralService) runOnce() {
+	if !atomic.CompareAndSwapInt32(&s.running, 0, 1) {
+		return
+	}
+	defer atomic.StoreInt32(&s.running, 0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), referralRunTimeout)
+	defer cancel()
+
+	if s.db != nil {
+		release, ok := tryAcquireDBAdvisoryLock(ctx, s.db, hashAdvisoryLockID(referralLeaderLockKey))
+		if !ok {
+			return
+		}
+		defer release()
+	}
+
+	count, err := s.SettleNow(ctx)
+	if err != nil {
+		log.Printf("[Referral] settlement failed: %v", err)
+		return
+	}
+	if count > 0 {
+		log.Printf("[Referral] settled commissions: count=%d", count)
+	}
+}
+
+func (s *ReferralService) invalidateBalanceCaches(ctx context.Context, userID int64) {
+	if s.authCacheInvalidator != nil {
+		s.authCacheInvalidator.InvalidateAuthCacheByUserID(ctx, userID)
+	}
+	if s.billingCacheService == nil {
+		return
+	}
+	go func() {
+		cacheCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = s.billingCacheService.InvalidateUserBalance(cacheCtx, userID)
+	}()
+}
+
+func normalizeReferralCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func generateReferralCode() (string, error) {
+	var b strings.Builder
+	max := big.NewInt(int64(len(referralCodeAlphabet)))
+	for i := 0; i < referralCodeLength; i++ {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("generate referral code: %w", err)
+		}
+		b.WriteByte(referralCodeAlphabet[n.Int64()])
+	}
+	return b.String(), nil
+}
+
+// maskReferralEmail 邀请人只能看到被邀请人脱敏后的邮箱，如 ab***@example.com
+func maskReferralEmail(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at <= 0 {
+		return email
+	}
+	local := []rune(email[:at])
+	keep := 2
+	if len(local) <= keep {
+		keep = 1
+	}
+	return string(local[:keep]) + "***" + email[at:]
+}
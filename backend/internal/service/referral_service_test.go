@@ -0,0 +1,162 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+type referralRepoStub struct {
+	ReferralRepository
+	codes     map[string]int64
+	created   []*Referral
+	due       []Referral
+	spend     float64
+	settled   []float64
+	conflicts map[int64]bool
+}
+
+func (r *referralRepoStub) GetUserIDByCode(ctx context.Context, code string) (int64, error) {
+	userID, ok := r.codes[code]
+	if !ok {
+		return 0, ErrReferralCodeNotFound
+	}
+	return userID, nil
+}
+
+func (r *referralRepoStub) Create(ctx context.Context, referral *Referral) error {
+	r.created = append(r.created, referral)
+	return nil
+}
+
+func (r *referralRepoStub) ListDueForSettlement(ctx context.Context, until time.Time, afterID int64, limit int) ([]Referral, error) {
+	out := make([]Referral, 0)
+	for _, ref := range r.due {
+		if ref.ID > afterID {
+			out = append(out, ref)
+		}
+	}
+	return out, nil
+}
+
+func (r *referralRepoStub) GetRefereeSpend(ctx context.Context, refereeID int64, start, end time.Time) (float64, error) {
+	return r.spend, nil
+}
+
+func (r *referralRepoStub) Settle(ctx context.Context, referral *Referral, end time.Time, spend, amount float64) (*ReferralCommission, error) {
+	if r.conflicts[referral.ID] {
+		return nil, ErrReferralSettlementConflict
+	}
+	if amount <= 0 {
+		return nil, nil
+	}
+	r.settled = append(r.settled, amount)
+	return &ReferralCommission{ID: int64(len(r.settled)), ReferralID: referral.ID, Amount: amount}, nil
+}
+
+type referralUserRepoStub struct {
+	lifecycleUserRepoStub
+	changes []BalanceChange
+}
+
+func (r *referralUserRepoStub) ApplyBalanceChange(ctx context.Context, change *BalanceChange) (*BalanceTransaction, error) {
+	r.changes = append(r.changes, *change)
+	return &BalanceTransaction{}, nil
+}
+
+func newReferralTestService(repo *referralRepoStub) (*ReferralService, *referralUserRepoStub) {
+	userRepo := &referralUserRepoStub{lifecycleUserRepoStub: lifecycleUserRepoStub{users: map[int64]*User{
+		1: {ID: 1, Status: StatusActive},
+		2: {ID: 2, Status: StatusDisabled},
+	}}}
+	cfg := &config.Config{Referral: config.ReferralConfig{Enabled: true, CommissionRate: 10, CommissionCapUSD: 50, DurationDays: 30}}
+	return NewReferralService(repo, userRepo, nil, nil, nil, cfg, nil, nil), userRepo
+}
+
+func TestReferralCommission(t *testing.T) {
+	now := time.Now()
+	capUSD := 5.0
+	expiresAt := now.Add(time.Hour)
+	ref := &Referral{CommissionRate: 10, CommissionCapUSD: &capUSD, ExpiresAt: &expiresAt, TotalCommission: 4.5}
+
+	require.Equal(t, 0.0, ref.Commission(0))
+	require.Equal(t, 0.3, ref.Commission(3))
+	// 截断到剩余上限
+	require.Equal(t, 0.5, ref.Commission(100))
+	ref.TotalCommission = 5
+	require.Equal(t, 0.0, ref.Commission(100))
+	require.False(t, ref.IsActiveAt(now))
+
+	// 结算截止不超过返佣截止时间
+	require.Equal(t, expiresAt, ref.SettleEnd(now.Add(2*time.Hour)))
+	require.Equal(t, now, ref.SettleEnd(now))
+	ref.TotalCommission = 0
+	require.True(t, ref.IsActiveAt(now))
+	require.False(t, ref.IsActiveAt(expiresAt))
+}
+
+func TestReferralBind(t *testing.T) {
+	ctx := context.Background()
+	repo := &referralRepoStub{codes: map[string]int64{"ABCD2345": 1, "DISABLED": 2}}
+	svc, _ := newReferralTestService(repo)
+
+	require.NoError(t, svc.BindReferral(ctx, 10, ""))
+	require.ErrorIs(t, svc.BindReferral(ctx, 10, "NOPE"), ErrReferralCodeInvalid)
+	require.ErrorIs(t, svc.BindReferral(ctx, 1, "ABCD2345"), ErrReferralCodeInvalid)
+	require.ErrorIs(t, svc.BindReferral(ctx, 10, "DISABLED"), ErrReferralCodeInvalid)
+	require.Empty(t, repo.created)
+
+	// 邀请码大小写不敏感，规则按配置快照
+	require.NoError(t, svc.BindReferral(ctx, 10, " abcd2345 "))
+	require.Len(t, repo.created, 1)
+	ref := repo.created[0]
+	require.Equal(t, int64(1), ref.ReferrerID)
+	require.Equal(t, 10.0, ref.CommissionRate)
+	require.Equal(t, 50.0, *ref.CommissionCapUSD)
+	require.NotNil(t, ref.ExpiresAt)
+	require.WithinDuration(t, ref.SettledUntil.AddDate(0, 0, 30), *ref.ExpiresAt, time.Second)
+
+	svc.cfg.Referral.Enabled = false
+	require.ErrorIs(t, svc.BindReferral(ctx, 11, "ABCD2345"), ErrReferralDisabled)
+}
+
+func TestReferralSettleAll(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	repo := &referralRepoStub{
+		due: []Referral{
+			{ID: 1, ReferrerID: 1, RefereeID: 10, CommissionRate: 10, SettledUntil: now.Add(-time.Hour)},
+			{ID: 2, ReferrerID: 1, RefereeID: 11, CommissionRate: 10, SettledUntil: now.Add(-time.Hour)},
+			{ID: 3, ReferrerID: 3, RefereeID: 12, CommissionRate: 10, SettledUntil: now.Add(-time.Hour)},
+		},
+		spend:     20,
+		conflicts: map[int64]bool{2: true},
+	}
+	svc, userRepo := newReferralTestService(repo)
+
+	count, err := svc.SettleAll(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	require.Equal(t, []float64{2, 2}, repo.settled)
+	require.Len(t, userRepo.changes, 2)
+	require.Equal(t, BalanceTxTypeReferral, userRepo.changes[0].Type)
+	require.Equal(t, int64(3), userRepo.changes[1].UserID)
+
+	// 无消费时只推进水位，不入账
+	repo.spend = 0
+	count, err = svc.SettleAll(ctx, now)
+	require.NoError(t, err)
+	require.Zero(t, count)
+	require.Len(t, userRepo.changes, 2)
+}
+
+func TestMaskReferralEmail(t *testing.T) {
+	require.Equal(t, "al***@example.com", maskReferralEmail("alice@example.com"))
+	require.Equal(t, "b***@example.com", maskReferralEmail("bo@example.com"))
+	require.Equal(t, "invalid", maskReferralEmail("invalid"))
+}
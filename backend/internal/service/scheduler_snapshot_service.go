@@ -544,13 +544,33 @@ func (s *SchedulerSnapshotService) loadAccountsFromDB(ctx context.Context, bucke
 			}
 			filtered = append(filtered, acc)
 		}
-		return filtered, nil
+		return filterAvailableAccounts(filtered, time.Now()), nil
 	}
 
+	var accounts []Account
+	var err error
 	if groupID > 0 {
-		return s.accountRepo.ListSchedulableByGroupIDAndPlatform(ctx, groupID, bucket.Platform)
+		accounts, err = s.accountRepo.ListSchedulableByGroupIDAndPlatform(ctx, groupID, bucket.Platform)
+	} else {
+		accounts, err = s.accountRepo.ListSchedulableByPlatform(ctx, bucket.Platform)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return filterAvailableAccounts(accounts, time.Now()), nil
+}
+
+// filterAvailableAccounts 剔除当前处于可用时间窗口之外的账号
+// 窗口边界到达时由 AccountAvailabilityService 写入 outbox 触发快照重建
+func filterAvailableAccounts(accounts []Account, now time.Time) []Account {
+	filtered := accounts[:0]
+	for _, acc := range accounts {
+		if !acc.IsWithinAvailabilityWindow(now) {
+			continue
+		}
+		filtered = append(filtered, acc)
 	}
-	return s.accountRepo.ListSchedulableByPlatform(ctx, bucket.Platform)
+	return filtered
 }
 
 func (s *SchedulerSnapshotService) bucketFor(groupID *int64, platform string, mode string) SchedulerBucket {
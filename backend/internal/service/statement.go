@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+)
+
+// 账单下载格式
+const (
+	StatementFormatHTML = "html"
+	StatementFormatPDF  = "pdf"
+)
+
+// statementPeriodLayout 账期格式：YYYY-MM
+const statementPeriodLayout = "2006-01"
+
+var (
+	ErrStatementNotFound      = infraerrors.NotFound("STATEMENT_NOT_FOUND", "statement not found")
+	ErrInvalidStatementPeriod = infraerrors.BadRequest("INVALID_STATEMENT_PERIOD", "period must be a past or current month in YYYY-MM format")
+	ErrInvalidStatementFormat = infraerrors.BadRequest("INVALID_STATEMENT_FORMAT", "format must be html or pdf")
+)
+
+// StatementLine 账单明细行（按模型或分组汇总）
+type StatementLine struct {
+	GroupID  *int64  `json:"group_id,omitempty"`
+	Name     string  `json:"name"`
+	Requests int64   `json:"requests"`
+	Tokens   int64   `json:"tokens"`
+	Cost     float64 `json:"cost"`
+	// SubscriptionCost 由订阅额度承担的费用（其余从余额扣除）
+	SubscriptionCost float64 `json:"subscription_cost"`
+}
+
+// StatementBalanceLine 按流水类型汇总的余额变动
+type StatementBalanceLine struct {
+	Type   string  `json:"type"`
+	Count  int64   `json:"count"`
+	Amount float64 `json:"amount"`
+}
+
+// StatementSummary 账单汇总数据（金额单位：美元）
+type StatementSummary struct {
+	OpeningBalance      float64                `json:"opening_balance"`
+	ClosingBalance      float64                `json:"closing_balance"`
+	TotalRequests       int64                  `json:"total_requests"`
+	TotalTokens         int64                  `json:"total_tokens"`
+	TotalCost           float64                `json:"total_cost"`
+	BalanceCharges      float64                `json:"balance_charges"`
+	SubscriptionCharges float64                `json:"subscription_charges"`
+	TopUps              float64                `json:"top_ups"`
+	Models              []StatementLine        `json:"models"`
+	Groups              []StatementLine        `json:"groups"`
+	BalanceChanges      []StatementBalanceLine `json:"balance_changes"`
+}
+
+// UserStatement 用户月度账单
+// HTML/PDF 内容单独存储，仅在下载时读取。
+type UserStatement struct {
+	ID          int64            `json:"id"`
+	UserID      int64            `json:"user_id"`
+	Period      string           `json:"period"`
+	PeriodStart time.Time        `json:"period_start"`
+	PeriodEnd   time.Time        `json:"period_end"`
+	Summary     StatementSummary `json:"summary"`
+	GeneratedAt time.Time        `json:"generated_at"`
+	EmailedAt   *time.Time       `json:"emailed_at,omitempty"`
+
+	HTML []byte `json:"-"`
+	PDF  []byte `json:"-"`
+}
+
+// StatementFilters 账单查询过滤条件
+type StatementFilters struct {
+	UserID int64
+	Period string
+}
+
+// StatementRepository 账单存储与汇总查询
+type StatementRepository interface {
+	// BuildSummary 汇总用户在 [start, end) 内的用量与余额变动
+	BuildSummary(ctx context.Context, userID int64, start, end time.Time) (*StatementSummary, error)
+	// ListActiveUserIDs 返回区间内有用量或余额变动的用户（ID 升序，afterID 之后）；
+	// onlyMissing 为 true 时排除已生成该账期账单的用户
+	ListActiveUserIDs(ctx context.Context, period string, start, end time.Time, afterID int64, limit int, onlyMissing bool) ([]int64, error)
+	// Upsert 按 (user_id, period) 写入或覆盖账单
+	Upsert(ctx context.Context, statement *UserStatement) error
+	GetByID(ctx context.Context, id int64) (*UserStatement, error)
+	GetContent(ctx context.Context, id int64, format string) ([]byte, error)
+	List(ctx context.Context, params pagination.PaginationParams, filters StatementFilters) ([]UserStatement, *pagination.PaginationResult, error)
+	MarkEmailed(ctx context.Context, id int64, at time.Time) error
+}
+
+// ParseStatementPeriod 解析账期（YYYY-MM，按系统时区），返回 [start, end)；不允许未来月份
+func ParseStatementPeriod(period string) (time.Time, time.Time, error) {
+	start, err := timezone.ParseInLocation(statementPeriodLayout, period)
+	if err != nil {
+		return time.Time{}, time.Time{}, ErrInvalidStatementPeriod
+	}
+	if start.After(timezone.Now()) {
+		return time.Time{}, time.Time{}, ErrInvalidStatementPeriod
+	}
+	return start, start.AddDate(0, 1, 0), nil
+}
+
+// previousStatementPeriod 返回 now 所在月份的上一个账期
+func previousStatementPeriod(now time.Time) string {
+	return timezone.StartOfMonth(now).AddDate(0, -1, 0).Format(statementPeriodLayout)
+}
+
+// statementFileName 下载文件名
+func statementFileName(period, format string) string {
+	return fmt.Sprintf("statement-%s.%s", period, format)
+}
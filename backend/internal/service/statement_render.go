@@ -0,0 +1,136 @@
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pdf"
+)
+
+// statementView 账单渲染数据
+type statementView struct {
+	SiteName  string
+	Email     string
+	Period    string
+	Start     time.Time
+	End       time.Time
+	Generated time.Time
+	Summary   *StatementSummary
+}
+
+var statementHTMLTemplate = template.Must(template.New("statement").Funcs(template.FuncMap{
+	"usd": func(v float64) string { return fmt.Sprintf("$%.4f", v) },
+	"day": func(t time.Time) string { return t.Format("2006-01-02") },
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>{{.SiteName}} Statement {{.Period}}</title>
+    <style>
+        body { font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, Oxygen, Ubuntu, sans-serif; color: #333; margin: 0; padding: 24px; }
+        .container { max-width: 760px; margin: 0 auto; }
+        h1 { font-size: 22px; margin-bottom: 4px; }
+        h2 { font-size: 16px; margin-top: 28px; border-bottom: 1px solid #eee; padding-bottom: 6px; }
+        .meta { color: #888; font-size: 13px; }
+        table { width: 100%; border-collapse: collapse; font-size: 13px; }
+        th, td { padding: 6px 8px; border-bottom: 1px solid #f0f0f0; text-align: right; }
+        th:first-child, td:first-child { text-align: left; }
+        th { background: #fafafa; }
+    </style>
+</head>
+<body>
+<div class="container">
+    <h1>{{.SiteName}} Monthly Statement</h1>
+    <div class="meta">{{.Email}} &middot; {{day .Start}} ~ {{day .End}} &middot; generated {{.Generated.Format "2006-01-02 15:04"}}</div>
+
+    <h2>Summary</h2>
+    <table>
+        <tr><td>Opening balance</td><td>{{usd .Summary.OpeningBalance}}</td></tr>
+        <tr><td>Top-ups</td><td>{{usd .Summary.TopUps}}</td></tr>
+        <tr><td>Charged to balance</td><td>{{usd .Summary.BalanceCharges}}</td></tr>
+        <tr><td>Charged to subscriptions</td><td>{{usd .Summary.SubscriptionCharges}}</td></tr>
+        <tr><td>Closing balance</td><td>{{usd .Summary.ClosingBalance}}</td></tr>
+        <tr><td>Requests / tokens</td><td>{{.Summary.TotalRequests}} / {{.Summary.TotalTokens}}</td></tr>
+    </table>
+
+    <h2>Usage by model</h2>
+    <table>
+        <tr><th>Model</th><th>Requests</th><th>Tokens</th><th>Cost</th></tr>
+        {{range .Summary.Models}}<tr><td>{{.Name}}</td><td>{{.Requests}}</td><td>{{.Tokens}}</td><td>{{usd .Cost}}</td></tr>
+        {{else}}<tr><td colspan="4">No usage</td></tr>{{end}}
+    </table>
+
+    <h2>Usage by group</h2>
+    <table>
+        <tr><th>Group</th><th>Requests</th><th>Tokens</th><th>Cost</th><th>Subscription</th></tr>
+        {{range .Summary.Groups}}<tr><td>{{.Name}}</td><td>{{.Requests}}</td><td>{{.Tokens}}</td><td>{{usd .Cost}}</td><td>{{usd .SubscriptionCost}}</td></tr>
+        {{else}}<tr><td colspan="5">No usage</td></tr>{{end}}
+    </table>
+
+    <h2>Balance changes</h2>
+    <table>
+        <tr><th>Type</th><th>Count</th><th>Amount</th></tr>
+        {{range .Summary.BalanceChanges}}<tr><td>{{.Type}}</td><td>{{.Count}}</td><td>{{usd .Amount}}</td></tr>
+        {{else}}<tr><td colspan="3">No balance changes</td></tr>{{end}}
+    </table>
+</div>
+</body>
+</html>
+`))
+
+func renderStatementHTML(v *statementView) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := statementHTMLTemplate.Execute(&buf, v); err != nil {
+		return nil, fmt.Errorf("render statement html: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func renderStatementPDF(v *statementView) []byte {
+	s := v.Summary
+	doc := pdf.New(fmt.Sprintf("%s Statement %s", v.SiteName, v.Period))
+	doc.Heading("%s Monthly Statement", v.SiteName)
+	doc.Text("%s  %s ~ %s", v.Email, v.Start.Format("2006-01-02"), v.End.Format("2006-01-02"))
+	doc.Text("Generated %s", v.Generated.Format("2006-01-02 15:04"))
+	doc.Blank()
+
+	doc.Heading("Summary")
+	doc.Text("%-32s %16s", "Opening balance", fmt.Sprintf("$%.4f", s.OpeningBalance))
+	doc.Text("%-32s %16s", "Top-ups", fmt.Sprintf("$%.4f", s.TopUps))
+	doc.Text("%-32s %16s", "Charged to balance", fmt.Sprintf("$%.4f", s.BalanceCharges))
+	doc.Text("%-32s %16s", "Charged to subscriptions", fmt.Sprintf("$%.4f", s.SubscriptionCharges))
+	doc.Text("%-32s %16s", "Closing balance", fmt.Sprintf("$%.4f", s.ClosingBalance))
+	doc.Text("%-32s %16s", "Requests / tokens", fmt.Sprintf("%d / %d", s.TotalRequests, s.TotalTokens))
+	doc.Blank()
+
+	doc.Heading("Usage by model")
+	doc.Heading("%-40s %10s %14s %14s", "Model", "Requests", "Tokens", "Cost")
+	for _, l := range s.Models {
+		doc.Text("%-40s %10d %14d %14s", truncateStatementName(l.Name, 40), l.Requests, l.Tokens, fmt.Sprintf("$%.4f", l.Cost))
+	}
+	doc.Blank()
+
+	doc.Heading("Usage by group")
+	doc.Heading("%-26s %10s %14s %14s %14s", "Group", "Requests", "Tokens", "Cost", "Subscription")
+	for _, l := range s.Groups {
+		doc.Text("%-26s %10d %14d %14s %14s", truncateStatementName(l.Name, 26), l.Requests, l.Tokens,
+			fmt.Sprintf("$%.4f", l.Cost), fmt.Sprintf("$%.4f", l.SubscriptionCost))
+	}
+	doc.Blank()
+
+	doc.Heading("Balance changes")
+	doc.Heading("%-26s %10s %16s", "Type", "Count", "Amount")
+	for _, l := range s.BalanceChanges {
+		doc.Text("%-26s %10d %16s", l.Type, l.Count, fmt.Sprintf("$%.4f", l.Amount))
+	}
+	return doc.Bytes()
+}
+
+func truncateStatementName(name string, max int) string {
+	if len(name) <= max {
+		return name
+	}
+	return name[:max-3] + "..."
+}
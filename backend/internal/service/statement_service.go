@@ -0,0 +1,276 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+const (
+	statementWorkerName    = "monthly_statement_worker"
+	statementLeaderLockKey = "statement:monthly:leader"
+	statementInterval      = time.Hour
+	statementRunTimeout    = 30 * time.Minute
+	statementBatchSize     = 200
+)
+
+// StatementService 月度账单
+// 每小时检查上一个账期，为有用量或余额变动但尚未生成账单的用户生成 HTML/PDF 账单，
+// 并在配置开启时通过邮件队列发送给用户。
+type StatementService struct {
+	repo           StatementRepository
+	userRepo       UserRepository
+	settingService *SettingService
+	emailQueue     *EmailQueueService
+	cfg            *config.Config
+	timingWheel    *TimingWheelService
+	db             *sql.DB
+
+	running   int32
+	startOnce sync.Once
+	stopOnce  sync.Once
+}
+
+func NewStatementService(
+	repo StatementRepository,
+	userRepo UserRepository,
+	settingService *SettingService,
+	emailQueue *EmailQueueService,
+	cfg *config.Config,
+	timingWheel *TimingWheelService,
+	db *sql.DB,
+) *StatementService {
+	return &StatementService{
+		repo:           repo,
+		userRepo:       userRepo,
+		settingService: settingService,
+		emailQueue:     emailQueue,
+		cfg:            cfg,
+		timingWheel:    timingWheel,
+		db:             db,
+	}
+}
+
+func (s *StatementService) Start() {
+	if s == nil {
+		return
+	}
+	if s.cfg != nil && !s.cfg.Statements.Enabled {
+		log.Printf("[Statement] monthly worker disabled by config")
+		return
+	}
+	if s.repo == nil || s.timingWheel == nil {
+		log.Printf("[Statement] monthly worker not started (missing deps)")
+		return
+	}
+	s.startOnce.Do(func() {
+		s.timingWheel.ScheduleRecurring(statementWorkerName, statementInterval, s.runOnce)
+		log.Printf("[Statement] monthly worker started (interval=%s)", statementInterval)
+	})
+}
+
+func (s *StatementService) Stop() {
+	if s == nil {
+		return
+	}
+	s.stopOnce.Do(func() {
+		if s.timingWheel != nil {
+			s.timingWheel.Cancel(statementWorkerName)
+		}
+		log.Printf("[Statement] monthly worker stopped")
+	})
+}
+
+func (s *StatementService) emailEnabled() bool {
+	return s.cfg != nil && s.cfg.Statements.EmailEnabled && s.emailQueue != nil
+}
+
+// GenerateForUser 生成（或覆盖）用户指定账期的账单
+func (s *StatementService) GenerateForUser(ctx context.Context, userID int64, period string, sendEmail bool) (*UserStatement, error) {
+	start, end, err := ParseStatementPeriod(period)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	summary, err := s.repo.BuildSummary(ctx, userID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("build statement summary: %w", err)
+	}
+
+	now := time.Now()
+	view := &statementView{
+		SiteName:  s.siteName(ctx),
+		Email:     user.Email,
+		Period:    period,
+		Start:     start,
+		End:       end.AddDate(0, 0, -1),
+		Generated: now,
+		Summary:   summary,
+	}
+	html, err := renderStatementHTML(view)
+	if err != nil {
+		return nil, err
+	}
+	statement := &UserStatement{
+		UserID:      userID,
+		Period:      period,
+		PeriodStart: start,
+		PeriodEnd:   end,
+		Summary:     *summary,
+		GeneratedAt: now,
+		HTML:        html,
+		PDF:         renderStatementPDF(view),
+	}
+	if err := s.repo.Upsert(ctx, statement); err != nil {
+		return nil, fmt.Errorf("save statement: %w", err)
+	}
+
+	if sendEmail && s.emailEnabled() && user.Email != "" {
+		subject := fmt.Sprintf("[%s] Monthly Statement %s", view.SiteName, period)
+		if err := s.emailQueue.EnqueueStatement(user.Email, subject, string(html)); err != nil {
+			log.Printf("[Statement] enqueue email failed: user=%d period=%s err=%v", userID, period, err)
+		} else if err := s.repo.MarkEmailed(ctx, statement.ID, now); err != nil {
+			log.Printf("[Statement] mark emailed failed: statement=%d err=%v", statement.ID, err)
+		} else {
+			statement.EmailedAt = &now
+		}
+	}
+	return statement, nil
+}
+
+// GeneratePeriod 为账期内有活动的用户批量生成账单，返回生成数量
+// onlyMissing 为 true 时跳过已生成的账单；否则全部重新生成（不重复发送邮件）。
+func (s *StatementService) GeneratePeriod(ctx context.Context, period string, onlyMissing bool) (int, error) {
+	start, end, err := ParseStatementPeriod(period)
+	if err != nil {
+		return 0, err
+	}
+	generated := 0
+	var afterID int64
+	for {
+		userIDs, err := s.repo.ListActiveUserIDs(ctx, period, start, end, afterID, statementBatchSize, onlyMissing)
+		if err != nil {
+			return generated, fmt.Errorf("list statement users: %w", err)
+		}
+		for _, userID := range userIDs {
+			if ctx.Err() != nil {
+				return generated, ctx.Err()
+			}
+			if _, err := s.GenerateForUser(ctx, userID, period, onlyMissing); err != nil {
+				log.Printf("[Statement] generate failed: user=%d period=%s err=%v", userID, period, err)
+				continue
+			}
+			generated++
+		}
+		if len(userIDs) < statementBatchSize {
+			return generated, nil
+		}
+		afterID = userIDs[len(userIDs)-1]
+	}
+}
+
+// RegeneratePeriodAsync 后台重新生成整个账期的账单（管理员操作）
+func (s *StatementService) RegeneratePeriodAsync(period string) error {
+	if _, _, err := ParseStatementPeriod(period); err != nil {
+		return err
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), statementRunTimeout)
+		defer cancel()
+		count, err := s.GeneratePeriod(ctx, period, false)
+		if err != nil {
+			log.Printf("[Statement] regenerate period %s failed after %d statements: %v", period, count, err)
+			return
+		}
+		log.Printf("[Statement] regenerated period %s: statements=%d", period, count)
+	}()
+	return nil
+}
+
+// ListStatements 分页查询账单（不含文件内容）
+func (s *StatementService) ListStatements(ctx context.Context, params pagination.PaginationParams, filters StatementFilters) ([]UserStatement, *pagination.PaginationResult, error) {
+	items, result, err := s.repo.List(ctx, params, filters)
+	if err != nil {
+		return nil, nil, fmt.Errorf("list statements: %w", err)
+	}
+	return items, result, nil
+}
+
+// GetUserStatement 查询用户自己的账单
+func (s *StatementService) GetUserStatement(ctx context.Context, userID, id int64) (*UserStatement, error) {
+	statement, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if statement.UserID != userID {
+		return nil, ErrStatementNotFound
+	}
+	return statement, nil
+}
+
+// GetUserStatementFile 读取用户账单文件，返回内容、Content-Type 与文件名
+func (s *StatementService) GetUserStatementFile(ctx context.Context, userID, id int64, format string) ([]byte, string, string, error) {
+	var contentType string
+	switch format {
+	case StatementFormatHTML:
+		contentType = "text/html; charset=utf-8"
+	case StatementFormatPDF:
+		contentType = "application/pdf"
+	default:
+		return nil, "", "", ErrInvalidStatementFormat
+	}
+	statement, err := s.GetUserStatement(ctx, userID, id)
+	if err != nil {
+		return nil, "", "", err
+	}
+	content, err := s.repo.GetContent(ctx, id, format)
+	if err != nil {
+		return nil, "", "", err
+	}
+	return content, contentType, statementFileName(statement.Period, format), nil
+}
+
+func (s *StatementService) siteName(ctx context.Context) string {
+	if s.settingService == nil {
+		return "Sub2API"
+	}
+	return s.settingService.GetSiteName(ctx)
+}
+
+func (s *StatementService) runOnce() {
+	if !atomic.CompareAndSwapInt32(&s.running, 0, 1) {
+		return
+	}
+	defer atomic.StoreInt32(&s.running, 0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), statementRunTimeout)
+	defer cancel()
+
+	if s.db != nil {
+		release, ok := tryAcquireDBAdvisoryLock(ctx, s.db, hashAdvisoryLockID(statementLeaderLockKey))
+		if !ok {
+			return
+		}
+		defer release()
+	}
+
+	period := previousStatementPeriod(time.Now())
+	count, err := s.GeneratePeriod(ctx, period, true)
+	if err != nil {
+		log.Printf("[Statement] generate period %s failed: %v", period, err)
+		return
+	}
+	if count > 0 {
+		log.Printf("[Statement] generated statements: period=%s count=%d", period, count)
+	}
+}
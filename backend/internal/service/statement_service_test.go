@@ -0,0 +1,130 @@
+//go:build unit
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+	"github.com/stretchr/testify/require"
+)
+
+type statementRepoStub struct {
+	activeUsers []int64
+	listCalls   int
+	upserts     []*UserStatement
+	emailed     []int64
+}
+
+func (s *statementRepoStub) BuildSummary(_ context.Context, userID int64, _, _ time.Time) (*StatementSummary, error) {
+	return &StatementSummary{
+		TotalRequests: 3,
+		TotalCost:     1.5,
+		Models:        []StatementLine{{Name: "claude-sonnet", Requests: 3, Tokens: 1200, Cost: 1.5}},
+		Groups:        []StatementLine{{Name: "default", Requests: 3, Tokens: 1200, Cost: 1.5}},
+	}, nil
+}
+
+func (s *statementRepoStub) ListActiveUserIDs(_ context.Context, _ string, _, _ time.Time, afterID int64, limit int, _ bool) ([]int64, error) {
+	s.listCalls++
+	out := make([]int64, 0, limit)
+	for _, id := range s.activeUsers {
+		if id > afterID && len(out) < limit {
+			out = append(out, id)
+		}
+	}
+	return out, nil
+}
+
+func (s *statementRepoStub) Upsert(_ context.Context, statement *UserStatement) error {
+	statement.ID = int64(len(s.upserts) + 1)
+	s.upserts = append(s.upserts, statement)
+	return nil
+}
+
+func (s *statementRepoStub) GetByID(_ context.Context, id int64) (*UserStatement, error) {
+	for _, st := range s.upserts {
+		if st.ID == id {
+			return st, nil
+		}
+	}
+	return nil, ErrStatementNotFound
+}
+
+func (s *statementRepoStub) GetContent(_ context.Context, id int64, format string) ([]byte, error) {
+	st, err := s.GetByID(context.Background(), id)
+	if err != nil {
+		return nil, err
+	}
+	if format == StatementFormatHTML {
+		return st.HTML, nil
+	}
+	return st.PDF, nil
+}
+
+func (s *statementRepoStub) List(context.Context, pagination.PaginationParams, StatementFilters) ([]UserStatement, *pagination.PaginationResult, error) {
+	return nil, nil, nil
+}
+
+func (s *statementRepoStub) MarkEmailed(_ context.Context, id int64, _ time.Time) error {
+	s.emailed = append(s.emailed, id)
+	return nil
+}
+
+func TestParseStatementPeriod(t *testing.T) {
+	start, end, err := ParseStatementPeriod("2024-02")
+	require.NoError(t, err)
+	require.Equal(t, 2, int(start.Month()))
+	require.Equal(t, 3, int(end.Month()))
+
+	for _, period := range []string{"", "2024-13", "2024/02", "202402"} {
+		_, _, err := ParseStatementPeriod(period)
+		require.ErrorIs(t, err, ErrInvalidStatementPeriod, period)
+	}
+
+	future := timezone.Now().AddDate(0, 2, 0).Format("2006-01")
+	_, _, err = ParseStatementPeriod(future)
+	require.ErrorIs(t, err, ErrInvalidStatementPeriod)
+}
+
+func TestStatementService_GenerateForUserRendersFiles(t *testing.T) {
+	repo := &statementRepoStub{}
+	userRepo := &userRepoStub{user: &User{ID: 7, Email: "user@example.com"}}
+	svc := NewStatementService(repo, userRepo, nil, nil, nil, nil, nil)
+
+	statement, err := svc.GenerateForUser(context.Background(), 7, "2024-02", true)
+	require.NoError(t, err)
+	require.Len(t, repo.upserts, 1)
+	require.Nil(t, statement.EmailedAt, "email disabled without queue")
+	require.Contains(t, string(statement.HTML), "claude-sonnet")
+	require.True(t, bytes.HasPrefix(statement.PDF, []byte("%PDF-")))
+
+	content, contentType, fileName, err := svc.GetUserStatementFile(context.Background(), 7, statement.ID, StatementFormatPDF)
+	require.NoError(t, err)
+	require.Equal(t, "application/pdf", contentType)
+	require.Equal(t, "statement-2024-02.pdf", fileName)
+	require.Equal(t, statement.PDF, content)
+
+	_, _, _, err = svc.GetUserStatementFile(context.Background(), 8, statement.ID, StatementFormatHTML)
+	require.ErrorIs(t, err, ErrStatementNotFound)
+	_, _, _, err = svc.GetUserStatementFile(context.Background(), 7, statement.ID, "docx")
+	require.ErrorIs(t, err, ErrInvalidStatementFormat)
+}
+
+func TestStatementService_GeneratePeriodPagesThroughUsers(t *testing.T) {
+	repo := &statementRepoStub{}
+	for i := int64(1); i <= statementBatchSize+5; i++ {
+		repo.activeUsers = append(repo.activeUsers, i)
+	}
+	svc := NewStatementService(repo, &userRepoStub{user: &User{ID: 1}}, nil, nil, nil, nil, nil)
+
+	count, err := svc.GeneratePeriod(context.Background(), "2024-02", false)
+	require.NoError(t, err)
+	require.Equal(t, statementBatchSize+5, count)
+	require.Equal(t, 2, repo.listCalls)
+	require.Empty(t, repo.emailed)
+}
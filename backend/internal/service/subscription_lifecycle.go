@@ -0,0 +1,275 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+)
+
+// 订阅暂停/恢复/转让
+// 暂停期间订阅不可用且有效期停止计时：恢复时 expires_at 顺延暂停时长；
+// 转让将订阅（含有效期、用量与窗口）整体移交给另一用户。所有操作写入 subscription_events 审计表。
+
+// 订阅事件类型
+const (
+	SubscriptionEventPause    = "pause"
+	SubscriptionEventResume   = "resume"
+	SubscriptionEventTransfer = "transfer"
+)
+
+var (
+	ErrSubscriptionPaused         = infraerrors.Forbidden("SUBSCRIPTION_PAUSED", "subscription is paused")
+	ErrSubscriptionNotActive      = infraerrors.BadRequest("SUBSCRIPTION_NOT_ACTIVE", "only active subscriptions can be paused or transferred")
+	ErrSubscriptionNotPaused      = infraerrors.BadRequest("SUBSCRIPTION_NOT_PAUSED", "subscription is not paused")
+	ErrSubscriptionTransferSelf   = infraerrors.BadRequest("SUBSCRIPTION_TRANSFER_SELF", "subscription already belongs to this user")
+	ErrSubscriptionTransferTarget = infraerrors.BadRequest("SUBSCRIPTION_TRANSFER_TARGET_INVALID", "transfer target user is not available")
+	ErrSubscriptionChanged        = infraerrors.Conflict("SUBSCRIPTION_CHANGED", "subscription was modified concurrently, please retry")
+)
+
+// SubscriptionEvent 订阅操作审计记录
+type SubscriptionEvent struct {
+	ID             int64
+	SubscriptionID int64
+	Action         string
+	// FromUserID 操作时的订阅持有人；ToUserID 仅转让时为接收人
+	FromUserID int64
+	ToUserID   *int64
+	// OperatorID 操作人，OperatorRole 为 admin 或 user（持有人自助操作）
+	OperatorID   *int64
+	OperatorRole string
+	// ExpiresAt 恢复时为顺延后的到期时间
+	ExpiresAt *time.Time
+	Notes     string
+	CreatedAt time.Time
+}
+
+type SubscriptionEventRepository interface {
+	Create(ctx context.Context, event *SubscriptionEvent) error
+	ListBySubscriptionID(ctx context.Context, subscriptionID int64) ([]SubscriptionEvent, error)
+}
+
+// SubscriptionActor 订阅操作发起人
+// 非管理员只能操作自己持有的订阅
+type SubscriptionActor struct {
+	UserID int64
+	Admin  bool
+	Notes  string
+}
+
+func (a SubscriptionActor) role() string {
+	if a.Admin {
+		return RoleAdmin
+	}
+	return RoleUser
+}
+
+// PauseSubscription 暂停订阅：有效期停止计时，暂停期间请求被拒绝
+func (s *SubscriptionService) PauseSubscription(ctx context.Context, subscriptionID int64, actor SubscriptionActor) (*UserSubscription, error) {
+	sub, err := s.getOwnedSubscription(ctx, subscriptionID, actor)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if sub.Status != SubscriptionStatusActive || !sub.ExpiresAt.After(now) {
+		return nil, ErrSubscriptionNotActive
+	}
+
+	err = withTx(ctx, s.entClient, func(txCtx context.Context) error {
+		ok, err := s.userSubRepo.Pause(txCtx, sub.ID, now)
+		if err != nil {
+			return fmt.Errorf("pause subscription: %w", err)
+		}
+		if !ok {
+			return ErrSubscriptionChanged
+		}
+		return s.recordSubscriptionEvent(txCtx, &SubscriptionEvent{
+			SubscriptionID: sub.ID,
+			Action:         SubscriptionEventPause,
+			FromUserID:     sub.UserID,
+		}, actor)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidateSubscriptionCaches(ctx, sub.GroupID, sub.UserID)
+	return s.userSubRepo.GetByID(ctx, sub.ID)
+}
+
+// ResumeSubscription 恢复暂停的订阅，到期时间顺延暂停时长
+func (s *SubscriptionService) ResumeSubscription(ctx context.Context, subscriptionID int64, actor SubscriptionActor) (*UserSubscription, error) {
+	sub, err := s.getOwnedSubscription(ctx, subscriptionID, actor)
+	if err != nil {
+		return nil, err
+	}
+	if sub.Status != SubscriptionStatusPaused {
+		return nil, ErrSubscriptionNotPaused
+	}
+	expiresAt := resumedExpiresAt(sub, time.Now())
+
+	err = withTx(ctx, s.entClient, func(txCtx context.Context) error {
+		ok, err := s.userSubRepo.Resume(txCtx, sub.ID, expiresAt)
+		if err != nil {
+			return fmt.Errorf("resume subscription: %w", err)
+		}
+		if !ok {
+			return ErrSubscriptionChanged
+		}
+		return s.recordSubscriptionEvent(txCtx, &SubscriptionEvent{
+			SubscriptionID: sub.ID,
+			Action:         SubscriptionEventResume,
+			FromUserID:     sub.UserID,
+			ExpiresAt:      &expiresAt,
+		}, actor)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidateSubscriptionCaches(ctx, sub.GroupID, sub.UserID)
+	return s.userSubRepo.GetByID(ctx, sub.ID)
+}
+
+// TransferSubscription 将订阅转让给另一用户（有效期、用量与窗口随订阅一起移交）
+// 暂停中的订阅可以转让，接收人恢复后继续计时；接收人不能已持有该分组的订阅
+// 转让后自动续费关闭，需接收人自行开启，避免到期时从接收人余额扣费
+func (s *SubscriptionService) TransferSubscription(ctx context.Context, subscriptionID, toUserID int64, actor SubscriptionActor) (*UserSubscription, error) {
+	sub, err := s.getOwnedSubscription(ctx, subscriptionID, actor)
+	if err != nil {
+		return nil, err
+	}
+	if sub.UserID == toUserID {
+		return nil, ErrSubscriptionTransferSelf
+	}
+	if sub.Status != SubscriptionStatusActive && sub.Status != SubscriptionStatusPaused {
+		return nil, ErrSubscriptionNotActive
+	}
+	if sub.Status == SubscriptionStatusActive && !sub.ExpiresAt.After(time.Now()) {
+		return nil, ErrSubscriptionNotActive
+	}
+
+	target, err := s.userRepo.GetByID(ctx, toUserID)
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return nil, ErrSubscriptionTransferTarget
+		}
+		return nil, fmt.Errorf("get transfer target: %w", err)
+	}
+	if !target.IsActive() {
+		return nil, ErrSubscriptionTransferTarget
+	}
+	exists, err := s.userSubRepo.ExistsByUserIDAndGroupID(ctx, toUserID, sub.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("check target subscription: %w", err)
+	}
+	if exists {
+		return nil, ErrSubscriptionAlreadyExists
+	}
+
+	err = withTx(ctx, s.entClient, func(txCtx context.Context) error {
+		ok, err := s.userSubRepo.Transfer(txCtx, sub.ID, sub.UserID, toUserID)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return ErrSubscriptionChanged
+		}
+		return s.recordSubscriptionEvent(txCtx, &SubscriptionEvent{
+			SubscriptionID: sub.ID,
+			Action:         SubscriptionEventTransfer,
+			FromUserID:     sub.UserID,
+			ToUserID:       &toUserID,
+		}, actor)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidateSubscriptionCaches(ctx, sub.GroupID, sub.UserID, toUserID)
+	return s.userSubRepo.GetByID(ctx, sub.ID)
+}
+
+// TransferSubscriptionByEmail 自助转让：按邮箱查找接收人
+func (s *SubscriptionService) TransferSubscriptionByEmail(ctx context.Context, subscriptionID int64, email string, actor SubscriptionActor) (*UserSubscription, error) {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return nil, ErrSubscriptionTransferTarget
+	}
+	target, err := s.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return nil, ErrSubscriptionTransferTarget
+		}
+		return nil, fmt.Errorf("get transfer target: %w", err)
+	}
+	return s.TransferSubscription(ctx, subscriptionID, target.ID, actor)
+}
+
+// ListSubscriptionEvents 订阅操作审计记录（按时间倒序）
+func (s *SubscriptionService) ListSubscriptionEvents(ctx context.Context, subscriptionID int64) ([]SubscriptionEvent, error) {
+	if _, err := s.userSubRepo.GetByID(ctx, subscriptionID); err != nil {
+		return nil, err
+	}
+	return s.eventRepo.ListBySubscriptionID(ctx, subscriptionID)
+}
+
+// getOwnedSubscription 获取订阅；非管理员操作他人订阅时视为不存在
+func (s *SubscriptionService) getOwnedSubscription(ctx context.Context, subscriptionID int64, actor SubscriptionActor) (*UserSubscription, error) {
+	sub, err := s.userSubRepo.GetByID(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if !actor.Admin && sub.UserID != actor.UserID {
+		return nil, ErrSubscriptionNotFound
+	}
+	return sub, nil
+}
+
+// resumedExpiresAt 恢复后的到期时间：原到期时间顺延暂停时长
+func resumedExpiresAt(sub *UserSubscription, now time.Time) time.Time {
+	expiresAt := sub.ExpiresAt
+	if sub.PausedAt != nil && now.After(*sub.PausedAt) {
+		expiresAt = expiresAt.Add(now.Sub(*sub.PausedAt))
+	}
+	if expiresAt.After(MaxExpiresAt) {
+		expiresAt = MaxExpiresAt
+	}
+	return expiresAt
+}
+
+func (s *SubscriptionService) recordSubscriptionEvent(ctx context.Context, event *SubscriptionEvent, actor SubscriptionActor) error {
+	if s.eventRepo == nil {
+		return nil
+	}
+	operatorID := actor.UserID
+	event.OperatorID = &operatorID
+	event.OperatorRole = actor.role()
+	event.Notes = strings.TrimSpace(actor.Notes)
+	if err := s.eventRepo.Create(ctx, event); err != nil {
+		return fmt.Errorf("record subscription event: %w", err)
+	}
+	return nil
+}
+
+// invalidateSubscriptionCaches 失效受影响用户的订阅缓存与认证缓存
+func (s *SubscriptionService) invalidateSubscriptionCaches(ctx context.Context, groupID int64, userIDs ...int64) {
+	if s.authCacheInvalidator != nil {
+		for _, userID := range userIDs {
+			s.authCacheInvalidator.InvalidateAuthCacheByUserID(ctx, userID)
+		}
+	}
+	if s.billingCacheService == nil {
+		return
+	}
+	go func() {
+		cacheCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		for _, userID := range userIDs {
+			_ = s.billingCacheService.InvalidateSubscription(cacheCtx, userID, groupID)
+		}
+	}()
+}
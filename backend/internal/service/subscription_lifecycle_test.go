@@ -0,0 +1,252 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type lifecycleSubRepoStub struct {
+	UserSubscriptionRepository
+	subs map[int64]*UserSubscription
+}
+
+func (r *lifecycleSubRepoStub) GetByID(ctx context.Context, id int64) (*UserSubscription, error) {
+	sub, ok := r.subs[id]
+	if !ok {
+		return nil, ErrSubscriptionNotFound
+	}
+	cp := *sub
+	return &cp, nil
+}
+
+func (r *lifecycleSubRepoStub) ExistsByUserIDAndGroupID(ctx context.Context, userID, groupID int64) (bool, error) {
+	for _, sub := range r.subs {
+		if sub.UserID == userID && sub.GroupID == groupID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *lifecycleSubRepoStub) Pause(ctx context.Context, id int64, pausedAt time.Time) (bool, error) {
+	sub := r.subs[id]
+	if sub.Status != SubscriptionStatusActive {
+		return false, nil
+	}
+	sub.Status = SubscriptionStatusPaused
+	sub.PausedAt = &pausedAt
+	return true, nil
+}
+
+func (r *lifecycleSubRepoStub) Resume(ctx context.Context, id int64, expiresAt time.Time) (bool, error) {
+	sub := r.subs[id]
+	if sub.Status != SubscriptionStatusPaused {
+		return false, nil
+	}
+	sub.Status = SubscriptionStatusActive
+	sub.ExpiresAt = expiresAt
+	sub.PausedAt = nil
+	return true, nil
+}
+
+func (r *lifecycleSubRepoStub) Transfer(ctx context.Context, id, fromUserID, toUserID int64) (bool, error) {
+	sub := r.subs[id]
+	if sub.UserID != fromUserID {
+		return false, nil
+	}
+	sub.UserID = toUserID
+	sub.AutoRenew = false
+	return true, nil
+}
+
+type lifecycleUserRepoStub struct {
+	UserRepository
+	users map[int64]*User
+}
+
+func (r *lifecycleUserRepoStub) GetByID(ctx context.Context, id int64) (*User, error) {
+	user, ok := r.users[id]
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+	return user, nil
+}
+
+type lifecycleEventRepoStub struct {
+	events []SubscriptionEvent
+}
+
+func (r *lifecycleEventRepoStub) Create(ctx context.Context, event *SubscriptionEvent) error {
+	r.events = append(r.events, *event)
+	return nil
+}
+
+func (r *lifecycleEventRepoStub) ListBySubscriptionID(ctx context.Context, subscriptionID int64) ([]SubscriptionEvent, error) {
+	return r.events, nil
+}
+
+type lifecycleAuthInvalidatorStub struct {
+	userIDs []int64
+}
+
+func (s *lifecycleAuthInvalidatorStub) InvalidateAuthCacheByKey(ctx context.Context, key string) {}
+
+func (s *lifecycleAuthInvalidatorStub) InvalidateAuthCacheByUserID(ctx context.Context, userID int64) {
+	s.userIDs = append(s.userIDs, userID)
+}
+
+func (s *lifecycleAuthInvalidatorStub) InvalidateAuthCacheByGroupID(ctx context.Context, groupID int64) {
+}
+
+func newLifecycleTestService(subs ...*UserSubscription) (*SubscriptionService, *lifecycleSubRepoStub, *lifecycleEventRepoStub, *lifecycleAuthInvalidatorStub) {
+	subRepo := &lifecycleSubRepoStub{subs: make(map[int64]*UserSubscription)}
+	for _, sub := range subs {
+		subRepo.subs[sub.ID] = sub
+	}
+	userRepo := &lifecycleUserRepoStub{users: map[int64]*User{
+		1: {ID: 1, Status: StatusActive},
+		2: {ID: 2, Status: StatusActive},
+		3: {ID: 3, Status: StatusDisabled},
+	}}
+	eventRepo := &lifecycleEventRepoStub{}
+	invalidator := &lifecycleAuthInvalidatorStub{}
+	svc := NewSubscriptionService(nil, subRepo, userRepo, eventRepo, nil, nil, invalidator)
+	return svc, subRepo, eventRepo, invalidator
+}
+
+func TestPauseResumeSubscription(t *testing.T) {
+	ctx := context.Background()
+	expiresAt := time.Now().Add(10 * 24 * time.Hour)
+	svc, subRepo, eventRepo, invalidator := newLifecycleTestService(&UserSubscription{
+		ID: 7, UserID: 1, GroupID: 10, Status: SubscriptionStatusActive, ExpiresAt: expiresAt,
+	})
+
+	// 非持有人不能操作
+	_, err := svc.PauseSubscription(ctx, 7, SubscriptionActor{UserID: 2})
+	require.ErrorIs(t, err, ErrSubscriptionNotFound)
+
+	sub, err := svc.PauseSubscription(ctx, 7, SubscriptionActor{UserID: 1})
+	require.NoError(t, err)
+	require.Equal(t, SubscriptionStatusPaused, sub.Status)
+	require.NotNil(t, sub.PausedAt)
+	require.ErrorIs(t, svc.ValidateSubscription(ctx, sub), ErrSubscriptionPaused)
+
+	_, err = svc.PauseSubscription(ctx, 7, SubscriptionActor{UserID: 1})
+	require.ErrorIs(t, err, ErrSubscriptionNotActive)
+
+	// 模拟已暂停 3 天：恢复后到期时间顺延 3 天
+	pausedAt := time.Now().Add(-72 * time.Hour)
+	subRepo.subs[7].PausedAt = &pausedAt
+	sub, err = svc.ResumeSubscription(ctx, 7, SubscriptionActor{UserID: 99, Admin: true, Notes: "holiday over"})
+	require.NoError(t, err)
+	require.Equal(t, SubscriptionStatusActive, sub.Status)
+	require.Nil(t, sub.PausedAt)
+	require.WithinDuration(t, expiresAt.Add(72*time.Hour), sub.ExpiresAt, time.Second)
+
+	_, err = svc.ResumeSubscription(ctx, 7, SubscriptionActor{UserID: 1})
+	require.ErrorIs(t, err, ErrSubscriptionNotPaused)
+
+	require.Len(t, eventRepo.events, 2)
+	require.Equal(t, SubscriptionEventPause, eventRepo.events[0].Action)
+	require.Equal(t, RoleUser, eventRepo.events[0].OperatorRole)
+	require.Equal(t, SubscriptionEventResume, eventRepo.events[1].Action)
+	require.Equal(t, RoleAdmin, eventRepo.events[1].OperatorRole)
+	require.Equal(t, int64(99), *eventRepo.events[1].OperatorID)
+	require.Equal(t, "holiday over", eventRepo.events[1].Notes)
+	require.NotNil(t, eventRepo.events[1].ExpiresAt)
+	require.Equal(t, []int64{1, 1}, invalidator.userIDs)
+}
+
+func TestTransferSubscription(t *testing.T) {
+	ctx := context.Background()
+	future := time.Now().Add(24 * time.Hour)
+	svc, subRepo, eventRepo, invalidator := newLifecycleTestService(
+		&UserSubscription{ID: 7, UserID: 1, GroupID: 10, Status: SubscriptionStatusActive, ExpiresAt: future},
+		&UserSubscription{ID: 8, UserID: 2, GroupID: 20, Status: SubscriptionStatusActive, ExpiresAt: future},
+	)
+	owner := SubscriptionActor{UserID: 1}
+
+	_, err := svc.TransferSubscription(ctx, 7, 1, owner)
+	require.ErrorIs(t, err, ErrSubscriptionTransferSelf)
+	_, err = svc.TransferSubscription(ctx, 7, 3, owner)
+	require.ErrorIs(t, err, ErrSubscriptionTransferTarget)
+	_, err = svc.TransferSubscription(ctx, 7, 404, owner)
+	require.ErrorIs(t, err, ErrSubscriptionTransferTarget)
+
+	// 接收人已持有同分组订阅
+	subRepo.subs[8].GroupID = 10
+	_, err = svc.TransferSubscription(ctx, 7, 2, owner)
+	require.ErrorIs(t, err, ErrSubscriptionAlreadyExists)
+	subRepo.subs[8].GroupID = 20
+
+	sub, err := svc.TransferSubscription(ctx, 7, 2, owner)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), sub.UserID)
+
+	// 原持有人已无权操作
+	_, err = svc.TransferSubscription(ctx, 7, 1, owner)
+	require.ErrorIs(t, err, ErrSubscriptionNotFound)
+
+	require.Len(t, eventRepo.events, 1)
+	require.Equal(t, SubscriptionEventTransfer, eventRepo.events[0].Action)
+	require.Equal(t, int64(1), eventRepo.events[0].FromUserID)
+	require.Equal(t, int64(2), *eventRepo.events[0].ToUserID)
+	require.Equal(t, []int64{1, 2}, invalidator.userIDs)
+}
+
+func TestTransferSubscription_RejectsExpired(t *testing.T) {
+	svc, _, _, _ := newLifecycleTestService(&UserSubscription{
+		ID: 7, UserID: 1, GroupID: 10, Status: SubscriptionStatusActive, ExpiresAt: time.Now().Add(-time.Hour),
+	})
+	_, err := svc.TransferSubscription(context.Background(), 7, 2, SubscriptionActor{UserID: 1})
+	require.ErrorIs(t, err, ErrSubscriptionNotActive)
+}
+
+type lifecyclePlanRepoStub struct {
+	SubscriptionPlanRepository
+	subRepo *lifecycleSubRepoStub
+	plan    *SubscriptionPlan
+}
+
+func (r *lifecyclePlanRepoStub) GetByID(ctx context.Context, id int64) (*SubscriptionPlan, error) {
+	return r.plan, nil
+}
+
+func (r *lifecyclePlanRepoStub) ListRenewalDueSubscriptionIDs(ctx context.Context, now, before time.Time, afterID int64, limit int) ([]int64, error) {
+	var ids []int64
+	for id, sub := range r.subRepo.subs {
+		if sub.AutoRenew && sub.ExpiresAt.After(now) && !sub.ExpiresAt.After(before) && id > afterID {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func TestTransferSubscription_StopsAutoRenew(t *testing.T) {
+	ctx := context.Background()
+	planID := int64(5)
+	svc, subRepo, _, _ := newLifecycleTestService(&UserSubscription{
+		ID: 7, UserID: 1, GroupID: 10, Status: SubscriptionStatusActive, ExpiresAt: time.Now().Add(time.Hour),
+		PlanID: &planID, AutoRenew: true,
+	})
+
+	sub, err := svc.TransferSubscription(ctx, 7, 2, SubscriptionActor{UserID: 1})
+	require.NoError(t, err)
+	require.False(t, sub.AutoRenew)
+
+	// 到期续费时不从接收人余额扣费
+	recipient := &User{ID: 2, Status: StatusActive, Balance: 100}
+	userRepo := &balanceUserRepoStub{userRepoStub: &userRepoStub{user: recipient}}
+	planRepo := &lifecyclePlanRepoStub{subRepo: subRepo, plan: &SubscriptionPlan{ID: planID, GroupID: 10, DurationDays: 30, Price: 20, Status: SubscriptionPlanStatusActive}}
+	planSvc := NewSubscriptionPlanService(planRepo, nil, subRepo, userRepo, nil, nil, nil, nil, nil, nil)
+	renewed, err := planSvc.RenewDueSubscriptions(ctx)
+	require.NoError(t, err)
+	require.Zero(t, renewed)
+	require.Empty(t, userRepo.changes)
+	require.Equal(t, 100.0, recipient.Balance)
+}
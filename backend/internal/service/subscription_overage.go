@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"log"
+	"math"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+)
+
+// SubscriptionCostSplit 订阅超额计费时单次请求的费用拆分
+type SubscriptionCostSplit struct {
+	SubscriptionCost float64 // 计入订阅用量的原始费用
+	OverageBaseCost  float64 // 超出订阅剩余额度的原始费用
+	OverageCost      float64 // 按超额倍率从余额扣除的金额
+}
+
+// AllowsOverage 订阅限额用尽后是否按余额超额计费（仅订阅分组生效）
+func (g *Group) AllowsOverage() bool {
+	return g != nil && g.IsSubscriptionType() && g.OverageEnabled
+}
+
+// normalizeOverageRateMultiplier 超额倍率：未设置时为 1，必须为正数
+func normalizeOverageRateMultiplier(rate *float64) (float64, error) {
+	if rate == nil {
+		return 1, nil
+	}
+	if *rate <= 0 {
+		return 0, infraerrors.BadRequest("INVALID_OVERAGE_RATE_MULTIPLIER", "overage_rate_multiplier must be greater than 0")
+	}
+	return *rate, nil
+}
+
+// splitSubscriptionCost 按订阅剩余额度（日/周/月及滚动窗口限额中最小的剩余值）拆分原始费用
+// group 应为已应用订阅级限额覆盖的分组
+func splitSubscriptionCost(group *Group, dailyUsage, weeklyUsage, monthlyUsage, rollingUsage, cost float64) *SubscriptionCostSplit {
+	remaining := math.Inf(1)
+	if group.HasDailyLimit() {
+		remaining = math.Min(remaining, *group.DailyLimitUSD-dailyUsage)
+	}
+	if group.HasWeeklyLimit() {
+		remaining = math.Min(remaining, *group.WeeklyLimitUSD-weeklyUsage)
+	}
+	if group.HasMonthlyLimit() {
+		remaining = math.Min(remaining, *group.MonthlyLimitUSD-monthlyUsage)
+	}
+	if group.HasRollingWindowLimit() {
+		remaining = math.Min(remaining, *group.RollingWindowLimitUSD-rollingUsage)
+	}
+	remaining = math.Max(remaining, 0)
+
+	split := &SubscriptionCostSplit{SubscriptionCost: math.Min(cost, remaining)}
+	split.OverageBaseCost = cost - split.SubscriptionCost
+	if split.OverageBaseCost > 0 {
+		multiplier := group.OverageRateMultiplier
+		if multiplier <= 0 {
+			multiplier = 1
+		}
+		split.OverageCost = split.OverageBaseCost * multiplier
+	}
+	return split
+}
+
+// SplitSubscriptionCost 计算订阅请求中由订阅承担与由余额承担的部分；分组未开启超额计费时返回 nil
+// 用量优先取订阅缓存（与准入检查口径一致），读取失败时退回请求开始时的订阅快照
+func (s *BillingCacheService) SplitSubscriptionCost(ctx context.Context, userID int64, group *Group, subscription *UserSubscription, cost float64) *SubscriptionCostSplit {
+	if !group.AllowsOverage() || subscription == nil {
+		return nil
+	}
+	daily, weekly, monthly := subscription.DailyUsageUSD, subscription.WeeklyUsageUSD, subscription.MonthlyUsageUSD
+	var rolling float64
+	if s != nil {
+		if data, err := s.GetSubscriptionStatus(ctx, userID, group.ID); err == nil {
+			daily, weekly, monthly = data.DailyUsage, data.WeeklyUsage, data.MonthlyUsage
+		} else {
+			log.Printf("Warning: load subscription usage for overage split failed: user=%d group=%d err=%v", userID, group.ID, err)
+		}
+		if usage, err := s.GetRollingWindowUsage(ctx, userID, group); err == nil && usage != nil {
+			rolling = usage.UsedUSD
+		} else if err != nil {
+			log.Printf("Warning: load rolling window usage for overage split failed: user=%d group=%d err=%v", userID, group.ID, err)
+		}
+	}
+	return splitSubscriptionCost(subscription.EffectiveGroup(group), daily, weekly, monthly, rolling, cost)
+}
+
+// resplitSubscriptionCost 重新计费时拆分新的原始费用：订阅承担额沿用记录中的值（即当时的剩余额度），
+// 超额倍率取记录中 OverageCost 与超额原始费用之比；记录未开启超额计费时返回 nil
+func resplitSubscriptionCost(usageLog *UsageLog, cost float64) *SubscriptionCostSplit {
+	if usageLog.SubscriptionCost == nil || usageLog.OverageCost == nil {
+		return nil
+	}
+	subscriptionCost := *usageLog.SubscriptionCost
+	oldBase := usageLog.TotalCost - subscriptionCost
+	if oldBase <= usageRerateCostEpsilon {
+		// 原记录未超额，当时的剩余额度未知，新费用全部计入订阅
+		return &SubscriptionCostSplit{SubscriptionCost: cost}
+	}
+	split := &SubscriptionCostSplit{SubscriptionCost: math.Min(cost, subscriptionCost)}
+	split.OverageBaseCost = cost - split.SubscriptionCost
+	split.OverageCost = split.OverageBaseCost * (*usageLog.OverageCost / oldBase)
+	return split
+}
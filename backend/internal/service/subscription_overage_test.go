@@ -0,0 +1,105 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitSubscriptionCost(t *testing.T) {
+	daily, monthly := 5.0, 100.0
+	group := &Group{
+		SubscriptionType:      SubscriptionTypeSubscription,
+		OverageEnabled:        true,
+		OverageRateMultiplier: 1.5,
+		DailyLimitUSD:         &daily,
+		MonthlyLimitUSD:       &monthly,
+	}
+
+	// 额度充足：全部计入订阅
+	split := splitSubscriptionCost(group, 1, 0, 10, 0, 2)
+	require.InDelta(t, 2, split.SubscriptionCost, 1e-9)
+	require.Zero(t, split.OverageBaseCost)
+	require.Zero(t, split.OverageCost)
+
+	// 跨越日限额：剩余 1 计入订阅，超出 2 按 1.5 倍从余额扣除
+	split = splitSubscriptionCost(group, 4, 0, 10, 0, 3)
+	require.InDelta(t, 1, split.SubscriptionCost, 1e-9)
+	require.InDelta(t, 2, split.OverageBaseCost, 1e-9)
+	require.InDelta(t, 3, split.OverageCost, 1e-9)
+
+	// 取最紧的限额；已超限时剩余按 0 处理
+	split = splitSubscriptionCost(group, 0, 0, 101, 0, 2)
+	require.Zero(t, split.SubscriptionCost)
+	require.InDelta(t, 3, split.OverageCost, 1e-9)
+
+	// 倍率未设置时按 1 计算
+	group.OverageRateMultiplier = 0
+	split = splitSubscriptionCost(group, 5, 0, 0, 0, 2)
+	require.InDelta(t, 2, split.OverageCost, 1e-9)
+
+	// 无限额：全部计入订阅
+	split = splitSubscriptionCost(&Group{SubscriptionType: SubscriptionTypeSubscription}, 50, 50, 50, 0, 2)
+	require.InDelta(t, 2, split.SubscriptionCost, 1e-9)
+	require.Zero(t, split.OverageCost)
+}
+
+func TestSplitSubscriptionCost_Disabled(t *testing.T) {
+	daily := 5.0
+	group := &Group{SubscriptionType: SubscriptionTypeSubscription, DailyLimitUSD: &daily}
+	sub := &UserSubscription{DailyUsageUSD: 10}
+
+	var s *BillingCacheService
+	require.Nil(t, s.SplitSubscriptionCost(context.Background(), 1, group, sub, 2))
+
+	group.OverageEnabled = true
+	require.Nil(t, s.SplitSubscriptionCost(context.Background(), 1, group, nil, 2))
+
+	split := s.SplitSubscriptionCost(context.Background(), 1, group, sub, 2)
+	require.NotNil(t, split)
+	require.InDelta(t, 2, split.OverageCost, 1e-9)
+
+	// 标准分组不支持超额计费
+	require.False(t, (&Group{OverageEnabled: true}).AllowsOverage())
+}
+
+func TestUsageLogApplyOverage(t *testing.T) {
+	log := &UsageLog{}
+	log.ApplyOverage(nil)
+	require.Nil(t, log.SubscriptionCost)
+	require.Nil(t, log.OverageCost)
+
+	log.ApplyOverage(&SubscriptionCostSplit{SubscriptionCost: 1, OverageBaseCost: 2, OverageCost: 3})
+	require.InDelta(t, 1, *log.SubscriptionCost, 1e-9)
+	require.InDelta(t, 3, *log.OverageCost, 1e-9)
+}
+
+func TestNormalizeOverageRateMultiplier(t *testing.T) {
+	rate, err := normalizeOverageRateMultiplier(nil)
+	require.NoError(t, err)
+	require.Equal(t, 1.0, rate)
+
+	v := 2.5
+	rate, err = normalizeOverageRateMultiplier(&v)
+	require.NoError(t, err)
+	require.Equal(t, 2.5, rate)
+
+	v = 0
+	_, err = normalizeOverageRateMultiplier(&v)
+	require.Error(t, err)
+}
+
+func TestCheckUsageLimits_AllowsOverage(t *testing.T) {
+	daily := 5.0
+	group := &Group{SubscriptionType: SubscriptionTypeSubscription, DailyLimitUSD: &daily}
+	sub := &UserSubscription{DailyUsageUSD: 6}
+	svc := &SubscriptionService{}
+
+	require.ErrorIs(t, svc.CheckUsageLimits(context.Background(), sub, group, 0), ErrDailyLimitExceeded)
+
+	group.OverageEnabled = true
+	require.NoError(t, svc.CheckUsageLimits(context.Background(), sub, group, 0))
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+)
+
+// 订阅套餐状态
+const (
+	SubscriptionPlanStatusActive   = "active"
+	SubscriptionPlanStatusDisabled = "disabled"
+)
+
+// 套餐购买方式
+const (
+	SubscriptionPurchaseNew     = "new"     // 新开通（或已过期订阅重新开通）
+	SubscriptionPurchaseExtend  = "extend"  // 同套餐续期：从当前到期时间累加
+	SubscriptionPurchaseUpgrade = "upgrade" // 切换到更高价套餐：按剩余时长折算抵扣，立即生效并重新计算到期时间
+	SubscriptionPurchaseRenew   = "renew"   // 到期前自动续费
+)
+
+var (
+	ErrSubscriptionPlanNotFound    = infraerrors.NotFound("SUBSCRIPTION_PLAN_NOT_FOUND", "subscription plan not found")
+	ErrSubscriptionPlanUnavailable = infraerrors.BadRequest("SUBSCRIPTION_PLAN_UNAVAILABLE", "subscription plan is not available for purchase")
+	ErrSubscriptionPlanInvalid     = infraerrors.BadRequest("SUBSCRIPTION_PLAN_INVALID", "subscription plan requires a subscription group, a positive duration and a non-negative price")
+	ErrSubscriptionPlanDowngrade   = infraerrors.Conflict("SUBSCRIPTION_PLAN_DOWNGRADE", "cannot switch to a cheaper plan while the current subscription is active")
+	ErrSubscriptionPurchaseBusy    = infraerrors.Conflict("SUBSCRIPTION_PURCHASE_CONFLICT", "subscription changed during purchase, please retry")
+	ErrSubscriptionNotRenewable    = infraerrors.BadRequest("SUBSCRIPTION_NOT_RENEWABLE", "auto-renewal is only available for subscriptions purchased from a plan")
+	ErrSubscriptionNotPlanManaged  = infraerrors.Conflict("SUBSCRIPTION_NOT_PLAN_MANAGED", "the active subscription was not purchased from a plan, please wait until it expires")
+)
+
+// SubscriptionPlan 可购买的订阅套餐
+// 限额为空时沿用分组限额；购买/续费时限额快照写入订阅，后续修改套餐不影响已购订阅的当前周期。
+type SubscriptionPlan struct {
+	ID              int64
+	Name            string
+	Description     string
+	GroupID         int64
+	DurationDays    int
+	Price           float64
+	DailyLimitUSD   *float64
+	WeeklyLimitUSD  *float64
+	MonthlyLimitUSD *float64
+	Status          string
+	SortOrder       int
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+
+	Group *Group
+}
+
+func (p *SubscriptionPlan) IsActive() bool {
+	return p != nil && p.Status == SubscriptionPlanStatusActive
+}
+
+// Duration 套餐单个周期时长
+func (p *SubscriptionPlan) Duration() time.Duration {
+	return time.Duration(p.DurationDays) * 24 * time.Hour
+}
+
+// SubscriptionPlanInput 创建/更新套餐输入（更新时整体替换，限额为 nil 表示沿用分组限额）
+type SubscriptionPlanInput struct {
+	Name            string
+	Description     string
+	GroupID         int64
+	DurationDays    int
+	Price           float64
+	DailyLimitUSD   *float64
+	WeeklyLimitUSD  *float64
+	MonthlyLimitUSD *float64
+	Status          string
+	SortOrder       int
+}
+
+// PurchaseSubscriptionInput 用户购买套餐输入
+type PurchaseSubscriptionInput struct {
+	UserID    int64
+	PlanID    int64
+	AutoRenew bool
+}
+
+// SubscriptionPurchaseResult 购买结果
+type SubscriptionPurchaseResult struct {
+	Subscription    *UserSubscription
+	Plan            *SubscriptionPlan
+	Action          string
+	Charged         float64 // 实际扣除余额
+	ProrationCredit float64 // 升级时原套餐剩余时长折算的抵扣金额
+}
+
+type SubscriptionPlanRepository interface {
+	Create(ctx context.Context, plan *SubscriptionPlan) error
+	Update(ctx context.Context, plan *SubscriptionPlan) error
+	Delete(ctx context.Context, id int64) error
+	GetByID(ctx context.Context, id int64) (*SubscriptionPlan, error)
+	// List 按 sort_order、id 排序；activeOnly 时仅返回上架套餐
+	List(ctx context.Context, activeOnly bool) ([]SubscriptionPlan, error)
+	// ListRenewalDueSubscriptionIDs 返回开启自动续费、状态正常且在 (now, before] 内到期的订阅 ID（id > afterID，按 id 升序）
+	ListRenewalDueSubscriptionIDs(ctx context.Context, now, before time.Time, afterID int64, limit int) ([]int64, error)
+}
+
+// planProrationCredit 原套餐剩余时长按原套餐价格折算的未消耗金额
+func planProrationCredit(current *SubscriptionPlan, sub *UserSubscription, now time.Time) float64 {
+	if current == nil || sub == nil || current.DurationDays <= 0 || current.Price <= 0 {
+		return 0
+	}
+	remaining := sub.ExpiresAt.Sub(now)
+	if remaining <= 0 {
+		return 0
+	}
+	return current.Price * remaining.Seconds() / current.Duration().Seconds()
+}
@@ -0,0 +1,554 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+)
+
+const (
+	subscriptionRenewalWorkerName    = "subscription_renewal_worker"
+	subscriptionRenewalLeaderLockKey = "subscription:renewal:leader"
+	subscriptionRenewalInterval      = 30 * time.Minute
+	subscriptionRenewalTimeout       = 5 * time.Minute
+	subscriptionRenewalBatchSize     = 200
+
+	// SubscriptionRenewalLeadTime 自动续费提前量：到期前该时间窗口内尝试续费，余额不足时在窗口内持续重试
+	SubscriptionRenewalLeadTime = 24 * time.Hour
+)
+
+// 续费失败原因（写入通知）
+const (
+	subscriptionRenewalReasonInsufficientBalance = "insufficient balance"
+	subscriptionRenewalReasonPlanUnavailable     = "plan is no longer available"
+)
+
+// SubscriptionPlanService 订阅套餐管理、余额购买与自动续费
+type SubscriptionPlanService struct {
+	planRepo             SubscriptionPlanRepository
+	groupRepo            GroupRepository
+	userSubRepo          UserSubscriptionRepository
+	userRepo             UserRepository
+	entClient            *dbent.Client
+	billingCacheService  *BillingCacheService
+	authCacheInvalidator APIKeyAuthCacheInvalidator
+	notificationService  *UserNotificationService
+	timingWheel          *TimingWheelService
+	db                   *sql.DB
+
+	running   int32
+	startOnce sync.Once
+	stopOnce  sync.Once
+}
+
+func NewSubscriptionPlanService(
+	planRepo SubscriptionPlanRepository,
+	groupRepo GroupRepository,
+	userSubRepo UserSubscriptionRepository,
+	userRepo UserRepository,
+	entClient *dbent.Client,
+	billingCacheService *BillingCacheService,
+	authCacheInvalidator APIKeyAuthCacheInvalidator,
+	notificationService *UserNotificationService,
+	timingWheel *TimingWheelService,
+	db *sql.DB,
+) *SubscriptionPlanService {
+	return &SubscriptionPlanService{
+		planRepo:             planRepo,
+		groupRepo:            groupRepo,
+		userSubRepo:          userSubRepo,
+		userRepo:             userRepo,
+		entClient:            entClient,
+		billingCacheService:  billingCacheService,
+		authCacheInvalidator: authCacheInvalidator,
+		notificationService:  notificationService,
+		timingWheel:          timingWheel,
+		db:                   db,
+	}
+}
+
+func (s *SubscriptionPlanService) Start() {
+	if s == nil {
+		return
+	}
+	if s.planRepo == nil || s.entClient == nil || s.timingWheel == nil {
+		log.Printf("[SubscriptionPlan] renewal worker not started (missing deps)")
+		return
+	}
+	s.startOnce.Do(func() {
+		s.timingWheel.ScheduleRecurring(subscriptionRenewalWorkerName, subscriptionRenewalInterval, s.runOnce)
+		log.Printf("[SubscriptionPlan] renewal worker started (interval=%s lead=%s)", subscriptionRenewalInterval, SubscriptionRenewalLeadTime)
+	})
+}
+
+func (s *SubscriptionPlanService) Stop() {
+	if s == nil {
+		return
+	}
+	s.stopOnce.Do(func() {
+		if s.timingWheel != nil {
+			s.timingWheel.Cancel(subscriptionRenewalWorkerName)
+		}
+		log.Printf("[SubscriptionPlan] renewal worker stopped")
+	})
+}
+
+// List 管理端套餐列表（含下架套餐）
+func (s *SubscriptionPlanService) List(ctx context.Context) ([]SubscriptionPlan, error) {
+	return s.list(ctx, false)
+}
+
+// ListAvailable 用户可购买的套餐（仅上架且分组可用）
+func (s *SubscriptionPlanService) ListAvailable(ctx context.Context) ([]SubscriptionPlan, error) {
+	plans, err := s.list(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]SubscriptionPlan, 0, len(plans))
+	for _, plan := range plans {
+		if plan.Group == nil || !plan.Group.IsActive() || !plan.Group.IsSubscriptionType() {
+			continue
+		}
+		out = append(out, plan)
+	}
+	return out, nil
+}
+
+func (s *SubscriptionPlanService) list(ctx context.Context, activeOnly bool) ([]SubscriptionPlan, error) {
+	plans, err := s.planRepo.List(ctx, activeOnly)
+	if err != nil {
+		return nil, fmt.Errorf("list subscription plans: %w", err)
+	}
+	groups := make(map[int64]*Group)
+	for i := range plans {
+		group, ok := groups[plans[i].GroupID]
+		if !ok {
+			group, err = s.groupRepo.GetByID(ctx, plans[i].GroupID)
+			if err != nil && !errors.Is(err, ErrGroupNotFound) {
+				return nil, fmt.Errorf("get group: %w", err)
+			}
+			groups[plans[i].GroupID] = group
+		}
+		plans[i].Group = group
+	}
+	return plans, nil
+}
+
+// GetByID 查询套餐
+func (s *SubscriptionPlanService) GetByID(ctx context.Context, id int64) (*SubscriptionPlan, error) {
+	return s.planRepo.GetByID(ctx, id)
+}
+
+// Create 创建套餐
+func (s *SubscriptionPlanService) Create(ctx context.Context, input SubscriptionPlanInput) (*SubscriptionPlan, error) {
+	plan := &SubscriptionPlan{}
+	if err := s.applyInput(ctx, plan, input); err != nil {
+		return nil, err
+	}
+	if err := s.planRepo.Create(ctx, plan); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// Update 整体替换套餐；已购订阅的限额快照在下次续费/续期时刷新
+func (s *SubscriptionPlanService) Update(ctx context.Context, id int64, input SubscriptionPlanInput) (*SubscriptionPlan, error) {
+	plan, err := s.planRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyInput(ctx, plan, input); err != nil {
+		return nil, err
+	}
+	if err := s.planRepo.Update(ctx, plan); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// Delete 删除套餐；关联订阅保留，到期后不再自动续费
+func (s *SubscriptionPlanService) Delete(ctx context.Context, id int64) error {
+	return s.planRepo.Delete(ctx, id)
+}
+
+func (s *SubscriptionPlanService) applyInput(ctx context.Context, plan *SubscriptionPlan, input SubscriptionPlanInput) error {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return ErrSubscriptionPlanInvalid.WithCause(fmt.Errorf("name is required"))
+	}
+	if input.DurationDays <= 0 || input.DurationDays > MaxValidityDays {
+		return ErrSubscriptionPlanInvalid.WithCause(fmt.Errorf("duration_days must be between 1 and %d", MaxValidityDays))
+	}
+	if input.Price < 0 {
+		return ErrSubscriptionPlanInvalid.WithCause(fmt.Errorf("price must not be negative"))
+	}
+	for _, limit := range []*float64{input.DailyLimitUSD, input.WeeklyLimitUSD, input.MonthlyLimitUSD} {
+		if limit != nil && *limit < 0 {
+			return ErrSubscriptionPlanInvalid.WithCause(fmt.Errorf("limits must not be negative"))
+		}
+	}
+	status := input.Status
+	if status == "" {
+		status = SubscriptionPlanStatusActive
+	}
+	if status != SubscriptionPlanStatusActive && status != SubscriptionPlanStatusDisabled {
+		return ErrSubscriptionPlanInvalid.WithCause(fmt.Errorf("invalid status %q", status))
+	}
+	group, err := s.groupRepo.GetByID(ctx, input.GroupID)
+	if err != nil {
+		return err
+	}
+	if !group.IsSubscriptionType() {
+		return ErrGroupNotSubscriptionType
+	}
+
+	plan.Name = name
+	plan.Description = strings.TrimSpace(input.Description)
+	plan.GroupID = input.GroupID
+	plan.DurationDays = input.DurationDays
+	plan.Price = input.Price
+	plan.DailyLimitUSD = input.DailyLimitUSD
+	plan.WeeklyLimitUSD = input.WeeklyLimitUSD
+	plan.MonthlyLimitUSD = input.MonthlyLimitUSD
+	plan.Status = status
+	plan.SortOrder = input.SortOrder
+	plan.Group = group
+	return nil
+}
+
+// Purchase 用余额购买套餐
+//   - 无订阅或订阅已过期：新开通
+//   - 同套餐（或管理员分配的订阅）：从当前到期时间续期
+//   - 更高价套餐：原套餐剩余时长按价格折算抵扣，立即切换并从现在起计算有效期
+//   - 更低价套餐：当前订阅到期前不允许切换
+func (s *SubscriptionPlanService) Purchase(ctx context.Context, input PurchaseSubscriptionInput) (*SubscriptionPurchaseResult, error) {
+	plan, err := s.planRepo.GetByID(ctx, input.PlanID)
+	if err != nil {
+		return nil, err
+	}
+	if !plan.IsActive() {
+		return nil, ErrSubscriptionPlanUnavailable
+	}
+	group, err := s.groupRepo.GetByID(ctx, plan.GroupID)
+	if err != nil {
+		if errors.Is(err, ErrGroupNotFound) {
+			return nil, ErrSubscriptionPlanUnavailable
+		}
+		return nil, fmt.Errorf("get group: %w", err)
+	}
+	if !group.IsActive() || !group.IsSubscriptionType() {
+		return nil, ErrSubscriptionPlanUnavailable
+	}
+	plan.Group = group
+
+	result, err := s.purchase(ctx, input.UserID, plan, input.AutoRenew, false)
+	if err != nil {
+		return nil, err
+	}
+	s.invalidateCaches(ctx, input.UserID, plan.GroupID, result.Charged > 0)
+	return result, nil
+}
+
+// purchase 在事务内完成扣费与订阅变更；renewal 为自动续费路径
+func (s *SubscriptionPlanService) purchase(ctx context.Context, userID int64, plan *SubscriptionPlan, autoRenew, renewal bool) (*SubscriptionPurchaseResult, error) {
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	txCtx := dbent.NewTxContext(ctx, tx)
+
+	existing, err := s.getExisting(txCtx, userID, plan.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	var currentPlan *SubscriptionPlan
+	if existing != nil && existing.PlanID != nil && *existing.PlanID != plan.ID {
+		currentPlan, err = s.planRepo.GetByID(txCtx, *existing.PlanID)
+		if err != nil && !errors.Is(err, ErrSubscriptionPlanNotFound) {
+			return nil, fmt.Errorf("get current plan: %w", err)
+		}
+	}
+
+	now := time.Now()
+	action, charge, credit, err := quotePlanPurchase(existing, currentPlan, plan, renewal, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if charge > 0 {
+		// 扣费持有用户行锁，同一用户的并发购买在此串行化
+		if _, err := s.userRepo.ApplyBalanceChange(txCtx, &BalanceChange{
+			UserID:                   userID,
+			Type:                     BalanceTxTypeSubscription,
+			Amount:                   -charge,
+			Notes:                    fmt.Sprintf("subscription plan #%d %s (%s)", plan.ID, plan.Name, action),
+			RequireSufficientBalance: true,
+		}); err != nil {
+			return nil, err
+		}
+		// 加锁前读取的订阅可能已被并发请求修改，报价失效时由调用方重试
+		latest, err := s.getExisting(txCtx, userID, plan.GroupID)
+		if err != nil {
+			return nil, err
+		}
+		if subscriptionChanged(existing, latest) {
+			return nil, ErrSubscriptionPurchaseBusy
+		}
+	}
+
+	sub := existing
+	if sub == nil {
+		sub = &UserSubscription{
+			UserID:     userID,
+			GroupID:    plan.GroupID,
+			AssignedAt: now,
+			Notes:      fmt.Sprintf("通过套餐 %s 购买", plan.Name),
+		}
+	}
+	switch action {
+	case SubscriptionPurchaseExtend, SubscriptionPurchaseRenew:
+		sub.ExpiresAt = sub.ExpiresAt.AddDate(0, 0, plan.DurationDays)
+	default:
+		sub.StartsAt = now
+		sub.ExpiresAt = now.AddDate(0, 0, plan.DurationDays)
+	}
+	if sub.ExpiresAt.After(MaxExpiresAt) {
+		sub.ExpiresAt = MaxExpiresAt
+	}
+	sub.Status = SubscriptionStatusActive
+	sub.PlanID = &plan.ID
+	sub.AutoRenew = autoRenew
+	sub.DailyLimitUSD = plan.DailyLimitUSD
+	sub.WeeklyLimitUSD = plan.WeeklyLimitUSD
+	sub.MonthlyLimitUSD = plan.MonthlyLimitUSD
+
+	if existing == nil {
+		err = s.userSubRepo.Create(txCtx, sub)
+	} else {
+		err = s.userSubRepo.ApplyPlan(txCtx, sub)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("save subscription: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	if updated, err := s.userSubRepo.GetByID(ctx, sub.ID); err == nil {
+		sub = updated
+	}
+	return &SubscriptionPurchaseResult{
+		Subscription:    sub,
+		Plan:            plan,
+		Action:          action,
+		Charged:         charge,
+		ProrationCredit: credit,
+	}, nil
+}
+
+func (s *SubscriptionPlanService) getExisting(ctx context.Context, userID, groupID int64) (*UserSubscription, error) {
+	sub, err := s.userSubRepo.GetByUserIDAndGroupID(ctx, userID, groupID)
+	if err != nil {
+		if errors.Is(err, ErrSubscriptionNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get subscription: %w", err)
+	}
+	return sub, nil
+}
+
+func subscriptionChanged(before, after *UserSubscription) bool {
+	if before == nil || after == nil {
+		return before != after
+	}
+	if !before.ExpiresAt.Equal(after.ExpiresAt) || before.Status != after.Status || before.AutoRenew != after.AutoRenew {
+		return true
+	}
+	if (before.PlanID == nil) != (after.PlanID == nil) {
+		return true
+	}
+	return before.PlanID != nil && *before.PlanID != *after.PlanID
+}
+
+// quotePlanPurchase 计算购买方式与应付金额
+func quotePlanPurchase(existing *UserSubscription, currentPlan, plan *SubscriptionPlan, renewal bool, now time.Time) (action string, charge, credit float64, err error) {
+	if existing != nil && existing.Status == SubscriptionStatusSuspended {
+		return "", 0, 0, ErrSubscriptionSuspended
+	}
+	if existing != nil && existing.Status == SubscriptionStatusPaused {
+		return "", 0, 0, ErrSubscriptionPaused
+	}
+	if existing == nil || existing.Status == SubscriptionStatusExpired || !existing.ExpiresAt.After(now) {
+		return SubscriptionPurchaseNew, plan.Price, 0, nil
+	}
+	if renewal {
+		return SubscriptionPurchaseRenew, plan.Price, 0, nil
+	}
+	// 管理员分配或兑换码获得的订阅有自己的期限与限额，不能被套餐累加或覆盖
+	if existing.PlanID == nil {
+		return "", 0, 0, ErrSubscriptionNotPlanManaged
+	}
+	if *existing.PlanID == plan.ID || currentPlan == nil {
+		return SubscriptionPurchaseExtend, plan.Price, 0, nil
+	}
+	if plan.Price < currentPlan.Price {
+		return "", 0, 0, ErrSubscriptionPlanDowngrade
+	}
+	credit = planProrationCredit(currentPlan, existing, now)
+	charge = math.Max(plan.Price-credit, 0)
+	return SubscriptionPurchaseUpgrade, charge, credit, nil
+}
+
+// SetAutoRenew 开启/关闭订阅自动续费
+func (s *SubscriptionPlanService) SetAutoRenew(ctx context.Context, userID, subscriptionID int64, enabled bool) (*UserSubscription, error) {
+	sub, err := s.userSubRepo.GetByID(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if sub.UserID != userID {
+		return nil, ErrSubscriptionNotFound
+	}
+	if enabled && sub.PlanID == nil {
+		return nil, ErrSubscriptionNotRenewable
+	}
+	if sub.AutoRenew == enabled {
+		return sub, nil
+	}
+	if err := s.userSubRepo.UpdateAutoRenew(ctx, subscriptionID, enabled); err != nil {
+		return nil, err
+	}
+	sub.AutoRenew = enabled
+	return sub, nil
+}
+
+// RenewDueSubscriptions 为即将到期且开启自动续费的订阅续费，返回成功续费数
+func (s *SubscriptionPlanService) RenewDueSubscriptions(ctx context.Context) (int, error) {
+	renewed := 0
+	var afterID int64
+	for {
+		now := time.Now()
+		ids, err := s.planRepo.ListRenewalDueSubscriptionIDs(ctx, now, now.Add(SubscriptionRenewalLeadTime), afterID, subscriptionRenewalBatchSize)
+		if err != nil {
+			return renewed, fmt.Errorf("list renewal due subscriptions: %w", err)
+		}
+		for _, id := range ids {
+			if err := ctx.Err(); err != nil {
+				return renewed, err
+			}
+			ok, err := s.renewOne(ctx, id)
+			if err != nil {
+				log.Printf("[SubscriptionPlan] renew subscription %d failed: %v", id, err)
+				continue
+			}
+			if ok {
+				renewed++
+			}
+		}
+		if len(ids) < subscriptionRenewalBatchSize {
+			return renewed, nil
+		}
+		afterID = ids[len(ids)-1]
+	}
+}
+
+func (s *SubscriptionPlanService) renewOne(ctx context.Context, subscriptionID int64) (bool, error) {
+	sub, err := s.userSubRepo.GetByID(ctx, subscriptionID)
+	if err != nil {
+		if errors.Is(err, ErrSubscriptionNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	now := time.Now()
+	if !sub.AutoRenew || sub.PlanID == nil || sub.Status != SubscriptionStatusActive ||
+		!sub.ExpiresAt.After(now) || sub.ExpiresAt.After(now.Add(SubscriptionRenewalLeadTime)) {
+		return false, nil
+	}
+
+	plan, err := s.planRepo.GetByID(ctx, *sub.PlanID)
+	if err != nil && !errors.Is(err, ErrSubscriptionPlanNotFound) {
+		return false, err
+	}
+	if plan == nil || !plan.IsActive() || plan.GroupID != sub.GroupID {
+		// 套餐已下架：关闭自动续费，订阅到期后正常过期
+		s.notifyRenewalFailed(ctx, sub, plan, subscriptionRenewalReasonPlanUnavailable)
+		if err := s.userSubRepo.UpdateAutoRenew(ctx, sub.ID, false); err != nil {
+			return false, err
+		}
+		return false, nil
+	}
+	plan.Group = sub.Group
+
+	result, err := s.purchase(ctx, sub.UserID, plan, true, true)
+	if err != nil {
+		if errors.Is(err, ErrInsufficientBalance) {
+			// 余额不足：通知用户（同一到期时间只通知一次），续费窗口内继续重试
+			s.notifyRenewalFailed(ctx, sub, plan, subscriptionRenewalReasonInsufficientBalance)
+			return false, nil
+		}
+		return false, err
+	}
+	s.invalidateCaches(ctx, sub.UserID, sub.GroupID, result.Charged > 0)
+	return true, nil
+}
+
+func (s *SubscriptionPlanService) notifyRenewalFailed(ctx context.Context, sub *UserSubscription, plan *SubscriptionPlan, reason string) {
+	if s.notificationService == nil {
+		return
+	}
+	s.notificationService.NotifySubscriptionRenewalFailed(ctx, sub, plan, reason)
+}
+
+func (s *SubscriptionPlanService) invalidateCaches(ctx context.Context, userID, groupID int64, balanceChanged bool) {
+	if balanceChanged && s.authCacheInvalidator != nil {
+		s.authCacheInvalidator.InvalidateAuthCacheByUserID(ctx, userID)
+	}
+	if s.billingCacheService == nil {
+		return
+	}
+	go func() {
+		cacheCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if balanceChanged {
+			_ = s.billingCacheService.InvalidateUserBalance(cacheCtx, userID)
+		}
+		_ = s.billingCacheService.InvalidateSubscription(cacheCtx, userID, groupID)
+	}()
+}
+
+func (s *SubscriptionPlanService) runOnce() {
+	if !atomic.CompareAndSwapInt32(&s.running, 0, 1) {
+		return
+	}
+	defer atomic.StoreInt32(&s.running, 0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), subscriptionRenewalTimeout)
+	defer cancel()
+
+	if s.db != nil {
+		release, ok := tryAcquireDBAdvisoryLock(ctx, s.db, hashAdvisoryLockID(subscriptionRenewalLeaderLockKey))
+		if !ok {
+			return
+		}
+		defer release()
+	}
+
+	renewed, err := s.RenewDueSubscriptions(ctx)
+	if err != nil {
+		log.Printf("[SubscriptionPlan] renewal run failed: %v", err)
+	}
+	if renewed > 0 {
+		log.Printf("[SubscriptionPlan] renewed %d subscription(s)", renewed)
+	}
+}
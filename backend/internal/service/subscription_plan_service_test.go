@@ -0,0 +1,103 @@
+//go:build unit
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuotePlanPurchase(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	basicID, proID := int64(1), int64(2)
+	basic := &SubscriptionPlan{ID: basicID, GroupID: 10, DurationDays: 30, Price: 30}
+	pro := &SubscriptionPlan{ID: proID, GroupID: 10, DurationDays: 30, Price: 90}
+
+	// 无订阅 / 已过期：新开通，全价
+	action, charge, credit, err := quotePlanPurchase(nil, nil, pro, false, now)
+	require.NoError(t, err)
+	require.Equal(t, SubscriptionPurchaseNew, action)
+	require.InDelta(t, 90, charge, 1e-9)
+	require.Zero(t, credit)
+
+	expired := &UserSubscription{Status: SubscriptionStatusActive, ExpiresAt: now.Add(-time.Hour), PlanID: &basicID}
+	action, _, _, err = quotePlanPurchase(expired, basic, pro, false, now)
+	require.NoError(t, err)
+	require.Equal(t, SubscriptionPurchaseNew, action)
+
+	// 暂停的订阅不允许购买
+	suspended := &UserSubscription{Status: SubscriptionStatusSuspended, ExpiresAt: now.Add(time.Hour)}
+	_, _, _, err = quotePlanPurchase(suspended, nil, pro, false, now)
+	require.ErrorIs(t, err, ErrSubscriptionSuspended)
+
+	// 同套餐：续期，全价
+	active := &UserSubscription{Status: SubscriptionStatusActive, ExpiresAt: now.AddDate(0, 0, 10), PlanID: &basicID}
+	action, charge, _, err = quotePlanPurchase(active, nil, basic, false, now)
+	require.NoError(t, err)
+	require.Equal(t, SubscriptionPurchaseExtend, action)
+	require.InDelta(t, 30, charge, 1e-9)
+
+	// 管理员分配或兑换的有效订阅：拒绝购买；过期后可重新开通
+	assigned := &UserSubscription{Status: SubscriptionStatusActive, ExpiresAt: now.AddDate(0, 0, 10)}
+	_, _, _, err = quotePlanPurchase(assigned, nil, pro, false, now)
+	require.ErrorIs(t, err, ErrSubscriptionNotPlanManaged)
+	assigned.ExpiresAt = now.Add(-time.Hour)
+	action, _, _, err = quotePlanPurchase(assigned, nil, pro, false, now)
+	require.NoError(t, err)
+	require.Equal(t, SubscriptionPurchaseNew, action)
+
+	// 自动续费
+	action, charge, _, err = quotePlanPurchase(active, nil, basic, true, now)
+	require.NoError(t, err)
+	require.Equal(t, SubscriptionPurchaseRenew, action)
+	require.InDelta(t, 30, charge, 1e-9)
+
+	// 升级：剩余 10 天按原套餐 30/30 天折算抵扣 10
+	action, charge, credit, err = quotePlanPurchase(active, basic, pro, false, now)
+	require.NoError(t, err)
+	require.Equal(t, SubscriptionPurchaseUpgrade, action)
+	require.InDelta(t, 10, credit, 1e-9)
+	require.InDelta(t, 80, charge, 1e-9)
+
+	// 降级：到期前不允许
+	onPro := &UserSubscription{Status: SubscriptionStatusActive, ExpiresAt: now.AddDate(0, 0, 10), PlanID: &proID}
+	_, _, _, err = quotePlanPurchase(onPro, pro, basic, false, now)
+	require.ErrorIs(t, err, ErrSubscriptionPlanDowngrade)
+}
+
+func TestPlanProrationCredit(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	plan := &SubscriptionPlan{DurationDays: 30, Price: 60}
+
+	sub := &UserSubscription{ExpiresAt: now.AddDate(0, 0, 15)}
+	require.InDelta(t, 30, planProrationCredit(plan, sub, now), 1e-9)
+
+	sub.ExpiresAt = now.Add(-time.Minute)
+	require.Zero(t, planProrationCredit(plan, sub, now))
+	require.Zero(t, planProrationCredit(nil, sub, now))
+	require.Zero(t, planProrationCredit(&SubscriptionPlan{DurationDays: 30}, &UserSubscription{ExpiresAt: now.AddDate(0, 0, 15)}, now))
+}
+
+func TestUserSubscriptionEffectiveGroup(t *testing.T) {
+	groupDaily, groupWeekly := 5.0, 20.0
+	group := &Group{ID: 10, DailyLimitUSD: &groupDaily, WeeklyLimitUSD: &groupWeekly}
+
+	// 无快照时沿用分组
+	sub := &UserSubscription{GroupID: 10}
+	require.Same(t, group, sub.EffectiveGroup(group))
+
+	planDaily := 8.0
+	sub.DailyLimitUSD = &planDaily
+	effective := sub.EffectiveGroup(group)
+	require.NotSame(t, group, effective)
+	require.InDelta(t, 8, *effective.DailyLimitUSD, 1e-9)
+	require.InDelta(t, 20, *effective.WeeklyLimitUSD, 1e-9)
+	// 原分组不被修改
+	require.InDelta(t, 5, *group.DailyLimitUSD, 1e-9)
+
+	sub.DailyUsageUSD = 6
+	require.True(t, sub.CheckDailyLimit(group, 1))
+	require.False(t, sub.CheckDailyLimit(group, 3))
+}
@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/usagequota"
+)
+
+// 请求数/Token 配额窗口，与订阅 USD 窗口一一对应并同步重置
+const (
+	QuotaWindowDaily   = "daily"
+	QuotaWindowWeekly  = "weekly"
+	QuotaWindowMonthly = "monthly"
+)
+
+// QuotaModelAll 匹配所有模型的配额模式
+const QuotaModelAll = "*"
+
+// MaxUsageQuotas 单个分组最多配置的配额条目数
+const MaxUsageQuotas = 20
+
+var (
+	ErrRequestQuotaExceeded = infraerrors.TooManyRequests("REQUEST_QUOTA_EXCEEDED", "request quota exceeded")
+	ErrTokenQuotaExceeded   = infraerrors.TooManyRequests("TOKEN_QUOTA_EXCEEDED", "token quota exceeded")
+)
+
+// UsageQuota 分组请求数/Token 配额（定义在 usagequota 包中，供 ent schema 引用）
+type UsageQuota = usagequota.Quota
+
+// QuotaCounter 订阅配额用量计数
+type QuotaCounter = usagequota.Counter
+
+// QuotaUsageKey 订阅配额用量的存储 key："窗口:模型模式"
+func QuotaUsageKey(window, model string) string {
+	return window + ":" + model
+}
+
+// HasUsageQuotas 分组是否配置了请求数/Token 配额
+func (g *Group) HasUsageQuotas() bool {
+	return g != nil && len(g.UsageQuotas) > 0
+}
+
+// QuotaModelsFor 返回与请求模型匹配的配额模型模式（去重），用于累加配额用量
+func (g *Group) QuotaModelsFor(model string) []string {
+	if !g.HasUsageQuotas() {
+		return nil
+	}
+	seen := make(map[string]struct{}, len(g.UsageQuotas))
+	models := make([]string, 0, len(g.UsageQuotas))
+	for _, quota := range g.UsageQuotas {
+		if !matchModelPattern(quota.Model, model) {
+			continue
+		}
+		if _, ok := seen[quota.Model]; ok {
+			continue
+		}
+		seen[quota.Model] = struct{}{}
+		models = append(models, quota.Model)
+	}
+	return models
+}
+
+// QuotaUsageFor 订阅在指定窗口与模型模式下的用量（窗口已过期时视为 0）
+func (s *UserSubscription) QuotaUsageFor(window, model string) QuotaCounter {
+	switch window {
+	case QuotaWindowDaily:
+		if s.NeedsDailyReset() {
+			return QuotaCounter{}
+		}
+	case QuotaWindowWeekly:
+		if s.NeedsWeeklyReset() {
+			return QuotaCounter{}
+		}
+	case QuotaWindowMonthly:
+		if s.NeedsMonthlyReset() {
+			return QuotaCounter{}
+		}
+	}
+	return s.QuotaUsage[QuotaUsageKey(window, model)]
+}
+
+// clearQuotaUsage 清除指定窗口的配额用量（窗口重置后同步内存中的订阅）
+func (s *UserSubscription) clearQuotaUsage(window string) {
+	prefix := window + ":"
+	for key := range s.QuotaUsage {
+		if strings.HasPrefix(key, prefix) {
+			delete(s.QuotaUsage, key)
+		}
+	}
+}
+
+// checkUsageQuotas 检查订阅在请求模型上的请求数/Token 配额
+// 配额为硬限制：与 USD 限额不同，超额计费不适用于配额
+func checkUsageQuotas(group *Group, sub *UserSubscription, model string) error {
+	if !group.HasUsageQuotas() || sub == nil {
+		return nil
+	}
+	for _, quota := range group.UsageQuotas {
+		if !matchModelPattern(quota.Model, model) {
+			continue
+		}
+		used := sub.QuotaUsageFor(quota.Window, quota.Model)
+		if quota.MaxRequests > 0 && used.Requests >= quota.MaxRequests {
+			return ErrRequestQuotaExceeded
+		}
+		if quota.MaxInputTokens > 0 && used.InputTokens >= quota.MaxInputTokens {
+			return ErrTokenQuotaExceeded
+		}
+		if quota.MaxOutputTokens > 0 && used.OutputTokens >= quota.MaxOutputTokens {
+			return ErrTokenQuotaExceeded
+		}
+	}
+	return nil
+}
+
+// normalizeUsageQuotas 校验配额配置，模型模式为空时表示所有模型；空列表返回 nil（表示清除）
+func normalizeUsageQuotas(quotas []UsageQuota) ([]UsageQuota, error) {
+	if len(quotas) == 0 {
+		return nil, nil
+	}
+	if len(quotas) > MaxUsageQuotas {
+		return nil, infraerrors.BadRequest("INVALID_USAGE_QUOTAS", fmt.Sprintf("usage quotas must not exceed %d entries", MaxUsageQuotas))
+	}
+	out := make([]UsageQuota, len(quotas))
+	seen := make(map[string]struct{}, len(quotas))
+	for i, quota := range quotas {
+		switch quota.Window {
+		case QuotaWindowDaily, QuotaWindowWeekly, QuotaWindowMonthly:
+		default:
+			return nil, infraerrors.BadRequest("INVALID_USAGE_QUOTAS", "usage quota window must be daily, weekly or monthly")
+		}
+		quota.Model = strings.TrimSpace(quota.Model)
+		if quota.Model == "" {
+			quota.Model = QuotaModelAll
+		}
+		if quota.MaxRequests < 0 || quota.MaxInputTokens < 0 || quota.MaxOutputTokens < 0 {
+			return nil, infraerrors.BadRequest("INVALID_USAGE_QUOTAS", "usage quota limits must not be negative")
+		}
+		if quota.MaxRequests == 0 && quota.MaxInputTokens == 0 && quota.MaxOutputTokens == 0 {
+			return nil, infraerrors.BadRequest("INVALID_USAGE_QUOTAS", "usage quota must set at least one limit")
+		}
+		key := QuotaUsageKey(quota.Window, quota.Model)
+		if _, ok := seen[key]; ok {
+			return nil, infraerrors.BadRequest("INVALID_USAGE_QUOTAS", fmt.Sprintf("duplicate usage quota %s", key))
+		}
+		seen[key] = struct{}{}
+		out[i] = quota
+	}
+	return out, nil
+}
+
+// recordSubscriptionQuotaUsage 累加订阅在请求模型上的配额用量（请求数与输入/输出 Token）
+func recordSubscriptionQuotaUsage(ctx context.Context, repo UserSubscriptionRepository, group *Group, sub *UserSubscription, usageLog *UsageLog) {
+	models := group.QuotaModelsFor(usageLog.Model)
+	if len(models) == 0 {
+		return
+	}
+	delta := QuotaCounter{
+		Requests:     1,
+		InputTokens:  int64(usageLog.InputTokens),
+		OutputTokens: int64(usageLog.OutputTokens),
+	}
+	if err := repo.IncrementQuotaUsage(ctx, sub.ID, models, delta); err != nil {
+		log.Printf("Increment subscription quota usage failed: subscription=%d err=%v", sub.ID, err)
+	}
+}
+
+// UsageQuotaProgress 配额进度
+type UsageQuotaProgress struct {
+	Window           string     `json:"window"`
+	Model            string     `json:"model"`
+	MaxRequests      int64      `json:"max_requests,omitempty"`
+	UsedRequests     int64      `json:"used_requests"`
+	MaxInputTokens   int64      `json:"max_input_tokens,omitempty"`
+	UsedInputTokens  int64      `json:"used_input_tokens"`
+	MaxOutputTokens  int64      `json:"max_output_tokens,omitempty"`
+	UsedOutputTokens int64      `json:"used_output_tokens"`
+	ResetsAt         *time.Time `json:"resets_at,omitempty"`
+	ResetsInSeconds  int64      `json:"resets_in_seconds"`
+}
+
+// usageQuotaProgress 计算订阅各配额的用量与重置时间（窗口未激活时无重置时间）
+func usageQuotaProgress(group *Group, sub *UserSubscription, now time.Time) []UsageQuotaProgress {
+	if !group.HasUsageQuotas() {
+		return nil
+	}
+	out := make([]UsageQuotaProgress, 0, len(group.UsageQuotas))
+	for _, quota := range group.UsageQuotas {
+		used := sub.QuotaUsageFor(quota.Window, quota.Model)
+		item := UsageQuotaProgress{
+			Window:           quota.Window,
+			Model:            quota.Model,
+			MaxRequests:      quota.MaxRequests,
+			UsedRequests:     used.Requests,
+			MaxInputTokens:   quota.MaxInputTokens,
+			UsedInputTokens:  used.InputTokens,
+			MaxOutputTokens:  quota.MaxOutputTokens,
+			UsedOutputTokens: used.OutputTokens,
+		}
+		var windowStart *time.Time
+		var length time.Duration
+		switch quota.Window {
+		case QuotaWindowDaily:
+			windowStart, length = sub.DailyWindowStart, 24*time.Hour
+		case QuotaWindowWeekly:
+			windowStart, length = sub.WeeklyWindowStart, 7*24*time.Hour
+		case QuotaWindowMonthly:
+			windowStart, length = sub.MonthlyWindowStart, 30*24*time.Hour
+		}
+		if windowStart != nil {
+			resetsAt := windowStart.Add(length)
+			item.ResetsAt = &resetsAt
+			if resetsAt.After(now) {
+				item.ResetsInSeconds = int64(resetsAt.Sub(now).Seconds())
+			}
+		}
+		out = append(out, item)
+	}
+	return out
+}
@@ -0,0 +1,116 @@
+//go:build unit
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeUsageQuotas(t *testing.T) {
+	quotas, err := normalizeUsageQuotas(nil)
+	require.NoError(t, err)
+	require.Nil(t, quotas)
+
+	quotas, err = normalizeUsageQuotas([]UsageQuota{
+		{Window: QuotaWindowDaily, MaxRequests: 100},
+		{Window: QuotaWindowDaily, Model: " claude-opus-* ", MaxOutputTokens: 5000},
+	})
+	require.NoError(t, err)
+	require.Equal(t, QuotaModelAll, quotas[0].Model)
+	require.Equal(t, "claude-opus-*", quotas[1].Model)
+
+	_, err = normalizeUsageQuotas([]UsageQuota{{Window: "hourly", MaxRequests: 1}})
+	require.Error(t, err)
+	_, err = normalizeUsageQuotas([]UsageQuota{{Window: QuotaWindowWeekly}})
+	require.Error(t, err)
+	_, err = normalizeUsageQuotas([]UsageQuota{{Window: QuotaWindowWeekly, MaxRequests: -1}})
+	require.Error(t, err)
+	_, err = normalizeUsageQuotas([]UsageQuota{
+		{Window: QuotaWindowMonthly, MaxRequests: 1},
+		{Window: QuotaWindowMonthly, Model: "*", MaxInputTokens: 1},
+	})
+	require.Error(t, err)
+}
+
+func TestCheckUsageQuotas(t *testing.T) {
+	start := time.Now().Add(-time.Hour)
+	group := &Group{UsageQuotas: []UsageQuota{
+		{Window: QuotaWindowDaily, Model: QuotaModelAll, MaxRequests: 10},
+		{Window: QuotaWindowWeekly, Model: "claude-opus-*", MaxOutputTokens: 1000},
+	}}
+	sub := &UserSubscription{
+		DailyWindowStart:  &start,
+		WeeklyWindowStart: &start,
+		QuotaUsage: map[string]QuotaCounter{
+			QuotaUsageKey(QuotaWindowDaily, QuotaModelAll):    {Requests: 5},
+			QuotaUsageKey(QuotaWindowWeekly, "claude-opus-*"): {Requests: 5, OutputTokens: 1000},
+		},
+	}
+
+	require.Equal(t, []string{QuotaModelAll, "claude-opus-*"}, group.QuotaModelsFor("claude-opus-4"))
+	require.Equal(t, []string{QuotaModelAll}, group.QuotaModelsFor("claude-sonnet-4"))
+
+	require.NoError(t, checkUsageQuotas(group, sub, "claude-sonnet-4"))
+	require.ErrorIs(t, checkUsageQuotas(group, sub, "claude-opus-4"), ErrTokenQuotaExceeded)
+
+	sub.QuotaUsage[QuotaUsageKey(QuotaWindowDaily, QuotaModelAll)] = QuotaCounter{Requests: 10}
+	require.ErrorIs(t, checkUsageQuotas(group, sub, "claude-sonnet-4"), ErrRequestQuotaExceeded)
+
+	// 日窗口已过期：用量视为 0，周窗口仍生效
+	expired := time.Now().Add(-25 * time.Hour)
+	sub.DailyWindowStart = &expired
+	require.NoError(t, checkUsageQuotas(group, sub, "claude-sonnet-4"))
+	require.ErrorIs(t, checkUsageQuotas(group, sub, "claude-opus-4"), ErrTokenQuotaExceeded)
+
+	// 未配置配额的分组不限制
+	require.NoError(t, checkUsageQuotas(&Group{}, sub, "claude-opus-4"))
+}
+
+func TestNormalizeExpiredWindowsClearsQuotaUsage(t *testing.T) {
+	expired := time.Now().Add(-25 * time.Hour)
+	subs := []UserSubscription{{
+		DailyWindowStart:  &expired,
+		WeeklyWindowStart: &expired,
+		QuotaUsage: map[string]QuotaCounter{
+			QuotaUsageKey(QuotaWindowDaily, QuotaModelAll):  {Requests: 3},
+			QuotaUsageKey(QuotaWindowWeekly, QuotaModelAll): {Requests: 7},
+		},
+	}}
+
+	normalizeExpiredWindows(subs)
+
+	require.Equal(t, map[string]QuotaCounter{
+		QuotaUsageKey(QuotaWindowWeekly, QuotaModelAll): {Requests: 7},
+	}, subs[0].QuotaUsage)
+}
+
+func TestUsageQuotaProgress(t *testing.T) {
+	now := time.Now()
+	start := now.Add(-20 * time.Hour)
+	group := &Group{UsageQuotas: []UsageQuota{
+		{Window: QuotaWindowDaily, Model: QuotaModelAll, MaxRequests: 10, MaxInputTokens: 2000},
+		{Window: QuotaWindowMonthly, Model: QuotaModelAll, MaxRequests: 100},
+	}}
+	sub := &UserSubscription{
+		DailyWindowStart: &start,
+		QuotaUsage: map[string]QuotaCounter{
+			QuotaUsageKey(QuotaWindowDaily, QuotaModelAll): {Requests: 4, InputTokens: 800, OutputTokens: 90},
+		},
+	}
+
+	progress := usageQuotaProgress(group, sub, now)
+	require.Len(t, progress, 2)
+	require.Equal(t, int64(4), progress[0].UsedRequests)
+	require.Equal(t, int64(800), progress[0].UsedInputTokens)
+	require.Equal(t, int64(90), progress[0].UsedOutputTokens)
+	require.NotNil(t, progress[0].ResetsAt)
+	require.InDelta(t, 4*3600, progress[0].ResetsInSeconds, 1)
+	// 月窗口未激活：无重置时间
+	require.Zero(t, progress[1].UsedRequests)
+	require.Nil(t, progress[1].ResetsAt)
+
+	require.Nil(t, usageQuotaProgress(&Group{}, sub, now))
+}
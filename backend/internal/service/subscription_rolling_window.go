@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+)
+
+// 滚动窗口限额
+// 用量按时间分桶累加在 Redis 中（每个窗口约 rollingWindowBucketCount 个桶），
+// 窗口用量为起始时间落在最近 N 小时内的桶之和；桶在起始时间 + 窗口时长后移出窗口。
+const (
+	MaxRollingWindowHours    = 168
+	rollingWindowBucketCount = 60
+)
+
+var ErrRollingWindowLimitExceeded = infraerrors.TooManyRequests("ROLLING_WINDOW_LIMIT_EXCEEDED", "rolling window usage limit exceeded")
+
+// RollingWindowUsage 滚动窗口用量
+type RollingWindowUsage struct {
+	WindowHours int
+	LimitUSD    float64
+	UsedUSD     float64
+	// FreesAt 已达上限时为用量回落到上限以下的时间；未达上限时为最早一笔用量移出窗口的时间；窗口内无用量时为 nil
+	FreesAt *time.Time
+}
+
+// Exceeded 窗口用量是否已达上限
+func (u *RollingWindowUsage) Exceeded() bool {
+	return u != nil && u.UsedUSD >= u.LimitUSD
+}
+
+// Remaining 窗口剩余额度（不小于 0）
+func (u *RollingWindowUsage) Remaining() float64 {
+	if u.UsedUSD >= u.LimitUSD {
+		return 0
+	}
+	return u.LimitUSD - u.UsedUSD
+}
+
+// Progress 转换为订阅进度展示结构
+func (u *RollingWindowUsage) Progress(now time.Time) *RollingWindowProgress {
+	if u == nil {
+		return nil
+	}
+	progress := &RollingWindowProgress{
+		WindowHours:  u.WindowHours,
+		LimitUSD:     u.LimitUSD,
+		UsedUSD:      u.UsedUSD,
+		RemainingUSD: u.Remaining(),
+		Percentage:   math.Min(u.UsedUSD/u.LimitUSD*100, 100),
+		FreesAt:      u.FreesAt,
+	}
+	if u.FreesAt != nil && u.FreesAt.After(now) {
+		progress.FreesInSeconds = int64(u.FreesAt.Sub(now).Seconds())
+	}
+	return progress
+}
+
+// HasRollingWindowLimit 是否配置了滚动窗口限额
+func (g *Group) HasRollingWindowLimit() bool {
+	return g != nil && g.RollingWindowHours > 0 && g.RollingWindowLimitUSD != nil && *g.RollingWindowLimitUSD > 0
+}
+
+// RollingWindow 滚动窗口时长
+func (g *Group) RollingWindow() time.Duration {
+	return time.Duration(g.RollingWindowHours) * time.Hour
+}
+
+// validateRollingWindowHours 滚动窗口时长：0 表示不启用，最长 7 天
+func validateRollingWindowHours(hours int) error {
+	if hours < 0 || hours > MaxRollingWindowHours {
+		return infraerrors.BadRequest("INVALID_ROLLING_WINDOW_HOURS", fmt.Sprintf("rolling_window_hours must be between 0 and %d", MaxRollingWindowHours))
+	}
+	return nil
+}
+
+// rollingWindowBucketSize 分桶粒度：窗口时长的 1/60，按分钟取整且不小于 1 分钟
+func rollingWindowBucketSize(window time.Duration) time.Duration {
+	size := (window / rollingWindowBucketCount).Truncate(time.Minute)
+	if size < time.Minute {
+		return time.Minute
+	}
+	return size
+}
+
+// computeRollingWindowUsage 根据分桶用量（key 为桶起始 Unix 秒）计算当前窗口用量与额度释放时间
+func computeRollingWindowUsage(buckets map[int64]float64, window time.Duration, limit float64, now time.Time) *RollingWindowUsage {
+	usage := &RollingWindowUsage{
+		WindowHours: int(window / time.Hour),
+		LimitUSD:    limit,
+	}
+
+	cutoff := now.Add(-window).Unix()
+	starts := make([]int64, 0, len(buckets))
+	for start, cost := range buckets {
+		if start <= cutoff || cost <= 0 {
+			continue
+		}
+		starts = append(starts, start)
+		usage.UsedUSD += cost
+	}
+	if len(starts) == 0 {
+		return usage
+	}
+	sort.Slice(starts, func(i, j int) bool { return starts[i] < starts[j] })
+
+	freesAt := time.Unix(starts[0], 0).Add(window)
+	if usage.Exceeded() {
+		remaining := usage.UsedUSD
+		for _, start := range starts {
+			remaining -= buckets[start]
+			freesAt = time.Unix(start, 0).Add(window)
+			if remaining < limit {
+				break
+			}
+		}
+	}
+	usage.FreesAt = &freesAt
+	return usage
+}
+
+// GetRollingWindowUsage 获取用户在分组下的滚动窗口用量；分组未配置滚动窗口限额时返回 nil
+func (s *BillingCacheService) GetRollingWindowUsage(ctx context.Context, userID int64, group *Group) (*RollingWindowUsage, error) {
+	if !group.HasRollingWindowLimit() {
+		return nil, nil
+	}
+	var buckets map[int64]float64
+	if s.cache != nil {
+		var err error
+		buckets, err = s.cache.GetRollingWindowBuckets(ctx, userID, group.ID)
+		if err != nil {
+			return nil, fmt.Errorf("get rolling window usage: %w", err)
+		}
+	}
+	return computeRollingWindowUsage(buckets, group.RollingWindow(), *group.RollingWindowLimitUSD, time.Now()), nil
+}
+
+// QueueAddRollingWindowUsage 异步累加滚动窗口用量（订阅原始费用）
+func (s *BillingCacheService) QueueAddRollingWindowUsage(userID int64, group *Group, costUSD float64) {
+	if s.cache == nil || !group.HasRollingWindowLimit() || costUSD <= 0 {
+		return
+	}
+	task := cacheWriteTask{
+		kind:          cacheWriteAddRollingWindowUsage,
+		userID:        userID,
+		groupID:       group.ID,
+		amount:        costUSD,
+		rollingWindow: group.RollingWindow(),
+	}
+	// 队列满时同步回退，确保窗口用量及时累加。
+	if s.enqueueCacheWrite(task) {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), cacheWriteTimeout)
+	defer cancel()
+	s.addRollingWindowUsage(ctx, task)
+}
+
+func (s *BillingCacheService) addRollingWindowUsage(ctx context.Context, task cacheWriteTask) {
+	if s.cache == nil {
+		return
+	}
+	now := time.Now()
+	bucketStart := now.Truncate(rollingWindowBucketSize(task.rollingWindow))
+	if err := s.cache.AddRollingWindowUsage(ctx, task.userID, task.groupID, bucketStart, now.Add(-task.rollingWindow), task.amount, task.rollingWindow); err != nil {
+		log.Printf("Warning: add rolling window usage failed for user %d group %d: %v", task.userID, task.groupID, err)
+	}
+}
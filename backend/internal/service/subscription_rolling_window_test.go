@@ -0,0 +1,97 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+type rollingWindowCacheStub struct {
+	billingCacheWorkerStub
+	sub     *SubscriptionCacheData
+	buckets map[int64]float64
+}
+
+func (s *rollingWindowCacheStub) GetSubscriptionCache(ctx context.Context, userID, groupID int64) (*SubscriptionCacheData, error) {
+	return s.sub, nil
+}
+
+func (s *rollingWindowCacheStub) GetRollingWindowBuckets(ctx context.Context, userID, groupID int64) (map[int64]float64, error) {
+	return s.buckets, nil
+}
+
+func TestComputeRollingWindowUsage(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	window := 5 * time.Hour
+	at := func(d time.Duration) int64 { return now.Add(-d).Unix() }
+
+	// 无用量
+	usage := computeRollingWindowUsage(nil, window, 10, now)
+	require.Zero(t, usage.UsedUSD)
+	require.Nil(t, usage.FreesAt)
+	require.Equal(t, 5, usage.WindowHours)
+
+	buckets := map[int64]float64{
+		at(6 * time.Hour): 100, // 已移出窗口
+		at(4 * time.Hour): 4,
+		at(2 * time.Hour): 3,
+		at(time.Hour):     5,
+	}
+
+	// 未达上限：释放时间为最早一笔用量移出窗口的时间
+	usage = computeRollingWindowUsage(buckets, window, 20, now)
+	require.InDelta(t, 12, usage.UsedUSD, 1e-9)
+	require.False(t, usage.Exceeded())
+	require.True(t, now.Add(time.Hour).Equal(*usage.FreesAt))
+
+	// 已达上限：需等到 4h 与 2h 前的用量都移出窗口，用量才回落到 10 以下
+	usage = computeRollingWindowUsage(buckets, window, 6, now)
+	require.True(t, usage.Exceeded())
+	require.True(t, now.Add(3*time.Hour).Equal(*usage.FreesAt))
+}
+
+func TestRollingWindowBucketSize(t *testing.T) {
+	require.Equal(t, 5*time.Minute, rollingWindowBucketSize(5*time.Hour))
+	require.Equal(t, time.Minute, rollingWindowBucketSize(time.Hour))
+	require.Equal(t, 168*time.Minute, rollingWindowBucketSize(168*time.Hour))
+}
+
+func TestValidateRollingWindowHours(t *testing.T) {
+	require.NoError(t, validateRollingWindowHours(0))
+	require.NoError(t, validateRollingWindowHours(MaxRollingWindowHours))
+	require.Error(t, validateRollingWindowHours(-1))
+	require.Error(t, validateRollingWindowHours(MaxRollingWindowHours+1))
+}
+
+func TestBillingCacheService_CheckRollingWindowLimit(t *testing.T) {
+	now := time.Now()
+	cache := &rollingWindowCacheStub{
+		sub:     &SubscriptionCacheData{Status: SubscriptionStatusActive, ExpiresAt: now.Add(24 * time.Hour)},
+		buckets: map[int64]float64{now.Add(-time.Hour).Unix(): 8},
+	}
+	svc := NewBillingCacheService(cache, nil, nil, nil, &config.Config{})
+	t.Cleanup(svc.Stop)
+
+	ctx := context.Background()
+	limit := 10.0
+	group := &Group{ID: 1, SubscriptionType: SubscriptionTypeSubscription, RollingWindowHours: 5, RollingWindowLimitUSD: &limit}
+	sub := &UserSubscription{GroupID: 1}
+
+	require.NoError(t, svc.CheckBillingEligibility(ctx, &User{ID: 1}, &APIKey{}, group, sub, "claude-sonnet-4"))
+
+	cache.buckets[now.Add(-2*time.Hour).Unix()] = 3
+	err := svc.CheckBillingEligibility(ctx, &User{ID: 1}, &APIKey{}, group, sub, "claude-sonnet-4")
+	require.ErrorIs(t, err, ErrRollingWindowLimitExceeded)
+
+	// 滚动窗口用量计入超额拆分
+	group.OverageEnabled = true
+	split := svc.SplitSubscriptionCost(ctx, 1, group, sub, 2)
+	require.NotNil(t, split)
+	require.Zero(t, split.SubscriptionCost)
+	require.InDelta(t, 2, split.OverageCost, 1e-9)
+}
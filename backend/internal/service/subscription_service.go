@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	dbent "github.com/Wei-Shaw/sub2api/ent"
 	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
 )
@@ -32,17 +33,33 @@ var (
 
 // SubscriptionService 订阅服务
 type SubscriptionService struct {
-	groupRepo           GroupRepository
-	userSubRepo         UserSubscriptionRepository
-	billingCacheService *BillingCacheService
+	groupRepo            GroupRepository
+	userSubRepo          UserSubscriptionRepository
+	userRepo             UserRepository
+	eventRepo            SubscriptionEventRepository
+	entClient            *dbent.Client
+	billingCacheService  *BillingCacheService
+	authCacheInvalidator APIKeyAuthCacheInvalidator
 }
 
 // NewSubscriptionService 创建订阅服务
-func NewSubscriptionService(groupRepo GroupRepository, userSubRepo UserSubscriptionRepository, billingCacheService *BillingCacheService) *SubscriptionService {
+func NewSubscriptionService(
+	groupRepo GroupRepository,
+	userSubRepo UserSubscriptionRepository,
+	userRepo UserRepository,
+	eventRepo SubscriptionEventRepository,
+	entClient *dbent.Client,
+	billingCacheService *BillingCacheService,
+	authCacheInvalidator APIKeyAuthCacheInvalidator,
+) *SubscriptionService {
 	return &SubscriptionService{
-		groupRepo:           groupRepo,
-		userSubRepo:         userSubRepo,
-		billingCacheService: billingCacheService,
+		groupRepo:            groupRepo,
+		userSubRepo:          userSubRepo,
+		userRepo:             userRepo,
+		eventRepo:            eventRepo,
+		entClient:            entClient,
+		billingCacheService:  billingCacheService,
+		authCacheInvalidator: authCacheInvalidator,
 	}
 }
 
@@ -129,8 +146,8 @@ func (s *SubscriptionService) AssignOrExtendSubscription(ctx context.Context, in
 		now := time.Now()
 		var newExpiresAt time.Time
 
-		if existingSub.ExpiresAt.After(now) {
-			// 未过期：从当前过期时间累加
+		if existingSub.ExpiresAt.After(now) || existingSub.Status == SubscriptionStatusPaused {
+			// 未过期（或已暂停，剩余时长冻结）：从当前过期时间累加
 			newExpiresAt = existingSub.ExpiresAt.AddDate(0, 0, validityDays)
 		} else {
 			// 已过期：从当前时间开始计算
@@ -147,8 +164,8 @@ func (s *SubscriptionService) AssignOrExtendSubscription(ctx context.Context, in
 			return nil, false, fmt.Errorf("extend subscription: %w", err)
 		}
 
-		// 如果订阅已过期或被暂停，恢复为active状态
-		if existingSub.Status != SubscriptionStatusActive {
+		// 如果订阅已过期或被停用，恢复为active状态（用户主动暂停的订阅保持暂停，由用户自行恢复）
+		if existingSub.Status != SubscriptionStatusActive && existingSub.Status != SubscriptionStatusPaused {
 			if err := s.userSubRepo.UpdateStatus(ctx, existingSub.ID, SubscriptionStatusActive); err != nil {
 				return nil, false, fmt.Errorf("update subscription status: %w", err)
 			}
@@ -330,9 +347,12 @@ func (s *SubscriptionService) ExtendSubscription(ctx context.Context, subscripti
 		newExpiresAt = MaxExpiresAt
 	}
 
-	// 如果是缩短（负数），检查新的过期时间必须大于当前时间
+	// 如果是缩短（负数），检查新的过期时间必须大于当前时间（暂停中的订阅以暂停时间为准）
 	if days < 0 {
 		now := time.Now()
+		if sub.Status == SubscriptionStatusPaused && sub.PausedAt != nil {
+			now = *sub.PausedAt
+		}
 		if !newExpiresAt.After(now) {
 			return nil, ErrAdjustWouldExpire
 		}
@@ -427,16 +447,19 @@ func normalizeExpiredWindows(subs []UserSubscription) {
 		if sub.NeedsDailyReset() {
 			sub.DailyWindowStart = nil
 			sub.DailyUsageUSD = 0
+			sub.clearQuotaUsage(QuotaWindowDaily)
 		}
 		// 周窗口过期：清零展示数据
 		if sub.NeedsWeeklyReset() {
 			sub.WeeklyWindowStart = nil
 			sub.WeeklyUsageUSD = 0
+			sub.clearQuotaUsage(QuotaWindowWeekly)
 		}
 		// 月窗口过期：清零展示数据
 		if sub.NeedsMonthlyReset() {
 			sub.MonthlyWindowStart = nil
 			sub.MonthlyUsageUSD = 0
+			sub.clearQuotaUsage(QuotaWindowMonthly)
 		}
 	}
 }
@@ -470,6 +493,7 @@ func (s *SubscriptionService) CheckAndResetWindows(ctx context.Context, sub *Use
 		}
 		sub.DailyWindowStart = &windowStart
 		sub.DailyUsageUSD = 0
+		sub.clearQuotaUsage(QuotaWindowDaily)
 		needsInvalidateCache = true
 	}
 
@@ -480,6 +504,7 @@ func (s *SubscriptionService) CheckAndResetWindows(ctx context.Context, sub *Use
 		}
 		sub.WeeklyWindowStart = &windowStart
 		sub.WeeklyUsageUSD = 0
+		sub.clearQuotaUsage(QuotaWindowWeekly)
 		needsInvalidateCache = true
 	}
 
@@ -490,6 +515,7 @@ func (s *SubscriptionService) CheckAndResetWindows(ctx context.Context, sub *Use
 		}
 		sub.MonthlyWindowStart = &windowStart
 		sub.MonthlyUsageUSD = 0
+		sub.clearQuotaUsage(QuotaWindowMonthly)
 		needsInvalidateCache = true
 	}
 
@@ -503,7 +529,11 @@ func (s *SubscriptionService) CheckAndResetWindows(ctx context.Context, sub *Use
 
 // CheckUsageLimits 检查使用限额（返回错误如果超限）
 // 用于中间件的快速预检查，additionalCost 通常为 0
+// 开启超额计费的分组限额用尽后改为余额计费，由计费资格检查负责校验余额，此处放行
 func (s *SubscriptionService) CheckUsageLimits(ctx context.Context, sub *UserSubscription, group *Group, additionalCost float64) error {
+	if group.AllowsOverage() {
+		return nil
+	}
 	if !sub.CheckDailyLimit(group, additionalCost) {
 		return ErrDailyLimitExceeded
 	}
@@ -530,6 +560,11 @@ type SubscriptionProgress struct {
 	Daily         *UsageWindowProgress `json:"daily,omitempty"`
 	Weekly        *UsageWindowProgress `json:"weekly,omitempty"`
 	Monthly       *UsageWindowProgress `json:"monthly,omitempty"`
+
+	// 滚动窗口限额进度（分组未配置时为空）
+	Rolling *RollingWindowProgress `json:"rolling,omitempty"`
+	// 请求数/Token 配额进度（分组未配置时为空）
+	Quotas []UsageQuotaProgress `json:"quotas,omitempty"`
 }
 
 // UsageWindowProgress 使用窗口进度
@@ -543,6 +578,18 @@ type UsageWindowProgress struct {
 	ResetsInSeconds int64     `json:"resets_in_seconds"`
 }
 
+// RollingWindowProgress 滚动窗口进度
+// FreesAt 为额度释放时间：已达上限时为恢复可用的时间，否则为最早一笔用量移出窗口的时间
+type RollingWindowProgress struct {
+	WindowHours    int        `json:"window_hours"`
+	LimitUSD       float64    `json:"limit_usd"`
+	UsedUSD        float64    `json:"used_usd"`
+	RemainingUSD   float64    `json:"remaining_usd"`
+	Percentage     float64    `json:"percentage"`
+	FreesAt        *time.Time `json:"frees_at,omitempty"`
+	FreesInSeconds int64      `json:"frees_in_seconds"`
+}
+
 // GetSubscriptionProgress 获取订阅使用进度
 func (s *SubscriptionService) GetSubscriptionProgress(ctx context.Context, subscriptionID int64) (*SubscriptionProgress, error) {
 	sub, err := s.userSubRepo.GetByID(ctx, subscriptionID)
@@ -557,6 +604,7 @@ func (s *SubscriptionService) GetSubscriptionProgress(ctx context.Context, subsc
 			return nil, err
 		}
 	}
+	group = sub.EffectiveGroup(group)
 
 	progress := &SubscriptionProgress{
 		ID:            sub.ID,
@@ -637,6 +685,18 @@ func (s *SubscriptionService) GetSubscriptionProgress(ctx context.Context, subsc
 		}
 	}
 
+	// 滚动窗口进度（用量来自 Redis 分桶，读取失败时不展示）
+	if group.HasRollingWindowLimit() && s.billingCacheService != nil {
+		usage, err := s.billingCacheService.GetRollingWindowUsage(ctx, sub.UserID, group)
+		if err != nil {
+			log.Printf("Warning: load rolling window usage failed for subscription %d: %v", sub.ID, err)
+		} else {
+			progress.Rolling = usage.Progress(time.Now())
+		}
+	}
+
+	progress.Quotas = usageQuotaProgress(group, sub, time.Now())
+
 	return progress, nil
 }
 
@@ -672,6 +732,9 @@ func (s *SubscriptionService) ValidateSubscription(ctx context.Context, sub *Use
 	if sub.Status == SubscriptionStatusSuspended {
 		return ErrSubscriptionSuspended
 	}
+	if sub.Status == SubscriptionStatusPaused {
+		return ErrSubscriptionPaused
+	}
 	if sub.IsExpired() {
 		// 更新状态
 		_ = s.userSubRepo.UpdateStatus(ctx, sub.ID, SubscriptionStatusExpired)
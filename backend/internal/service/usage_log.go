@@ -18,6 +18,11 @@ type UsageLog struct {
 	GroupID        *int64
 	SubscriptionID *int64
 
+	// 容量降级：请求由降级分组承接时 GroupID 为实际承接分组，
+	// OriginalGroupID 为 API Key 所属分组，FallbackHop 为降级链中的序号（从 1 开始，0 表示未降级）
+	OriginalGroupID *int64
+	FallbackHop     int
+
 	InputTokens         int
 	OutputTokens        int
 	CacheCreationTokens int
@@ -47,6 +52,27 @@ type UsageLog struct {
 	ImageCount int
 	ImageSize  *string
 
+	// 计费价格快照：价格版本与计费时的单价（USD/百万 token，图片为 USD/张），历史数据为 nil
+	PricingVersion         *string
+	InputUnitPrice         *float64
+	OutputUnitPrice        *float64
+	CacheCreationUnitPrice *float64
+	CacheReadUnitPrice     *float64
+	ImageUnitPrice         *float64
+
+	// 阶梯折扣快照：命中档位的消费门槛与折扣倍率（RateMultiplier 已包含该倍率），未命中为 nil
+	VolumeTierMinSpend   *float64
+	VolumeTierMultiplier *float64
+
+	// 订阅超额计费拆分：SubscriptionCost 为计入订阅用量的原始费用，OverageCost 为从余额扣除的金额；
+	// 分组未开启超额计费时均为 nil
+	SubscriptionCost *float64
+	OverageCost      *float64
+
+	// 组织计费：OrganizationID 为付费组织，BillingUserID 为实际扣费的组织计费账户；个人 Key 均为 nil
+	OrganizationID *int64
+	BillingUserID  *int64
+
 	CreatedAt time.Time
 
 	User         *User
@@ -56,6 +82,61 @@ type UsageLog struct {
 	Subscription *UserSubscription
 }
 
+// ApplyPricing 记录计费时使用的价格快照
+func (u *UsageLog) ApplyPricing(p *AppliedPricing) {
+	if p == nil {
+		return
+	}
+	version := p.Version
+	u.PricingVersion = &version
+	u.InputUnitPrice = p.InputPrice
+	u.OutputUnitPrice = p.OutputPrice
+	u.CacheCreationUnitPrice = p.CacheCreationPrice
+	u.CacheReadUnitPrice = p.CacheReadPrice
+	u.ImageUnitPrice = p.ImagePrice
+}
+
+// ApplyVolumeTier 记录计费时命中的阶梯折扣档位
+func (u *UsageLog) ApplyVolumeTier(t *AppliedVolumeTier) {
+	if t == nil {
+		return
+	}
+	minSpend := t.MinSpend
+	multiplier := t.RateMultiplier
+	u.VolumeTierMinSpend = &minSpend
+	u.VolumeTierMultiplier = &multiplier
+}
+
+// ApplyOverage 记录订阅超额计费的费用拆分
+func (u *UsageLog) ApplyOverage(split *SubscriptionCostSplit) {
+	if split == nil {
+		return
+	}
+	subscriptionCost := split.SubscriptionCost
+	overageCost := split.OverageCost
+	u.SubscriptionCost = &subscriptionCost
+	u.OverageCost = &overageCost
+}
+
+// ApplyOrganization 记录组织 Key 的付费组织与计费账户
+func (u *UsageLog) ApplyOrganization(b *OrganizationBilling) {
+	if b == nil || b.Payer == nil {
+		return
+	}
+	organizationID := b.OrganizationID
+	billingUserID := b.Payer.ID
+	u.OrganizationID = &organizationID
+	u.BillingUserID = &billingUserID
+}
+
+// PayerID 实际扣费的用户：组织 Key 为组织计费账户，其余为发起请求的用户
+func (u *UsageLog) PayerID() int64 {
+	if u.BillingUserID != nil {
+		return *u.BillingUserID
+	}
+	return u.UserID
+}
+
 func (u *UsageLog) TotalTokens() int {
 	return u.InputTokens + u.OutputTokens + u.CacheCreationTokens + u.CacheReadTokens
 }
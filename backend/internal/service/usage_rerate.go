@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+const (
+	UsageRerateStatusPending   = "pending"
+	UsageRerateStatusRunning   = "running"
+	UsageRerateStatusSucceeded = "succeeded"
+	UsageRerateStatusFailed    = "failed"
+	UsageRerateStatusCanceled  = "canceled"
+)
+
+// UsageRerateTask 使用记录重新计费任务
+//
+// 按过滤条件（与清理任务相同）逐批读取使用记录，按记录发生时刻生效的当前价格配置重新计算费用，
+// 并按用户汇总新旧费用差异：
+// - ApplyChanges 为 true 时将新费用与价格快照回写 usage_logs
+// - PostAdjustments 为 true 时（要求 ApplyChanges），按余额计费记录的差额为每个用户写入一条 adjustment 余额流水
+type UsageRerateTask struct {
+	ID              int64
+	Status          string
+	Filters         UsageCleanupFilters
+	ApplyChanges    bool
+	PostAdjustments bool
+	// LastUsageLogID 已处理到的 usage_logs.id，任务中断后从此处续跑
+	LastUsageLogID int64
+	ProcessedRows  int64
+	ChangedRows    int64
+	OldTotalCost   float64
+	NewTotalCost   float64
+	OldActualCost  float64
+	NewActualCost  float64
+	ErrorMsg       *string
+	CreatedBy      int64
+	CanceledBy     *int64
+	CanceledAt     *time.Time
+	StartedAt      *time.Time
+	FinishedAt     *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// UsageRerateProgress 单批处理后的累计进度
+type UsageRerateProgress struct {
+	LastUsageLogID int64
+	ProcessedRows  int64
+	ChangedRows    int64
+	OldTotalCost   float64
+	NewTotalCost   float64
+	OldActualCost  float64
+	NewActualCost  float64
+}
+
+// UsageRerateDiff 按用户汇总的重新计费差异
+// BalanceDelta 为余额计费记录的 旧实际费用 - 新实际费用（订阅记录取超额扣费之差）：正数表示多扣（应退还），负数表示少扣
+type UsageRerateDiff struct {
+	TaskID         int64      `json:"task_id"`
+	UserID         int64      `json:"user_id"`
+	Rows           int64      `json:"rows"`
+	ChangedRows    int64      `json:"changed_rows"`
+	OldTotalCost   float64    `json:"old_total_cost"`
+	NewTotalCost   float64    `json:"new_total_cost"`
+	OldActualCost  float64    `json:"old_actual_cost"`
+	NewActualCost  float64    `json:"new_actual_cost"`
+	BalanceDelta   float64    `json:"balance_delta"`
+	AdjustmentTxID *int64     `json:"adjustment_tx_id,omitempty"`
+	AdjustedAt     *time.Time `json:"adjusted_at,omitempty"`
+}
+
+// UsageRerateUpdate 回写到 usage_logs 的重算结果
+type UsageRerateUpdate struct {
+	UsageLogID int64
+	Cost       *CostBreakdown
+	Overage    *SubscriptionCostSplit // 订阅超额计费记录的重新拆分，其余记录为 nil
+}
+
+// UsageRerateRepository 重新计费任务持久层接口
+// ApplyBatch/MarkDiffAdjusted 在调用方事务上下文中执行，保证回写、差异与进度原子一致
+type UsageRerateRepository interface {
+	CreateTask(ctx context.Context, task *UsageRerateTask) error
+	ListTasks(ctx context.Context, params pagination.PaginationParams) ([]UsageRerateTask, *pagination.PaginationResult, error)
+	// GetTask 查询任务；不存在时返回 sql.ErrNoRows
+	GetTask(ctx context.Context, taskID int64) (*UsageRerateTask, error)
+	// ClaimNextPendingTask 抢占下一条 pending 任务，或 running 超过 staleRunningAfterSeconds 的任务
+	ClaimNextPendingTask(ctx context.Context, staleRunningAfterSeconds int64) (*UsageRerateTask, error)
+	// GetTaskStatus 查询任务状态；不存在时返回 sql.ErrNoRows
+	GetTaskStatus(ctx context.Context, taskID int64) (string, error)
+	CancelTask(ctx context.Context, taskID int64, canceledBy int64) (bool, error)
+	MarkTaskSucceeded(ctx context.Context, taskID int64) error
+	MarkTaskFailed(ctx context.Context, taskID int64, errorMsg string) error
+
+	// ListUsageLogsBatch 按 id 递增读取 id > afterID 的匹配记录
+	ListUsageLogsBatch(ctx context.Context, filters UsageCleanupFilters, afterID int64, limit int) ([]UsageLog, error)
+	// ApplyBatch 回写重算结果（updates 可为空）、累加用户差异并保存任务进度
+	ApplyBatch(ctx context.Context, taskID int64, updates []UsageRerateUpdate, diffs []UsageRerateDiff, progress UsageRerateProgress) error
+	ListDiffs(ctx context.Context, taskID int64, params pagination.PaginationParams) ([]UsageRerateDiff, *pagination.PaginationResult, error)
+	// ListPendingAdjustments 返回 |余额差额| >= minAbsDelta 且尚未调整的用户差异
+	ListPendingAdjustments(ctx context.Context, taskID int64, minAbsDelta float64, limit int) ([]UsageRerateDiff, error)
+	// MarkDiffAdjusted 标记用户差异已调整；txID 为 nil 表示无需调整（如用户已删除）
+	MarkDiffAdjusted(ctx context.Context, taskID, userID int64, txID *int64) error
+}
@@ -0,0 +1,585 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+const (
+	usageRerateWorkerName = "usage_rerate_worker"
+	// 费用比较精度，与 usage_logs 费用列 decimal(20,10) 一致
+	usageRerateCostEpsilon = 1e-10
+	// 低于该金额的余额差额不写入调整流水
+	usageRerateMinAdjustment   = 1e-8
+	usageRerateAdjustmentBatch = 100
+)
+
+// UsageRerateTaskInput 创建重新计费任务的参数
+type UsageRerateTaskInput struct {
+	Filters         UsageCleanupFilters
+	ApplyChanges    bool
+	PostAdjustments bool
+}
+
+// UsageRerateService 负责创建与执行使用记录重新计费任务
+//
+// 执行方式与 UsageCleanupService 一致：后台轮询抢占任务，按 id 分批处理并记录进度，
+// 中断后由 stale reclaim 从上次进度续跑。每批的回写、差异累加与进度更新在同一事务中提交。
+type UsageRerateService struct {
+	repo                 UsageRerateRepository
+	billingService       *BillingService
+	groupRepo            GroupRepository
+	userRepo             UserRepository
+	entClient            *dbent.Client
+	billingCacheService  *BillingCacheService
+	authCacheInvalidator APIKeyAuthCacheInvalidator
+	timingWheel          *TimingWheelService
+	dashboard            *DashboardAggregationService
+	cfg                  *config.Config
+
+	running   int32
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	workerCtx    context.Context
+	workerCancel context.CancelFunc
+}
+
+func NewUsageRerateService(
+	repo UsageRerateRepository,
+	billingService *BillingService,
+	groupRepo GroupRepository,
+	userRepo UserRepository,
+	entClient *dbent.Client,
+	billingCacheService *BillingCacheService,
+	authCacheInvalidator APIKeyAuthCacheInvalidator,
+	timingWheel *TimingWheelService,
+	dashboard *DashboardAggregationService,
+	cfg *config.Config,
+) *UsageRerateService {
+	workerCtx, workerCancel := context.WithCancel(context.Background())
+	return &UsageRerateService{
+		repo:                 repo,
+		billingService:       billingService,
+		groupRepo:            groupRepo,
+		userRepo:             userRepo,
+		entClient:            entClient,
+		billingCacheService:  billingCacheService,
+		authCacheInvalidator: authCacheInvalidator,
+		timingWheel:          timingWheel,
+		dashboard:            dashboard,
+		cfg:                  cfg,
+		workerCtx:            workerCtx,
+		workerCancel:         workerCancel,
+	}
+}
+
+func (s *UsageRerateService) Start() {
+	if s == nil {
+		return
+	}
+	if s.cfg != nil && !s.cfg.UsageRerate.Enabled {
+		log.Printf("[UsageRerate] not started (disabled)")
+		return
+	}
+	if s.repo == nil || s.billingService == nil || s.timingWheel == nil {
+		log.Printf("[UsageRerate] not started (missing deps)")
+		return
+	}
+
+	interval := s.workerInterval()
+	s.startOnce.Do(func() {
+		s.timingWheel.ScheduleRecurring(usageRerateWorkerName, interval, s.runOnce)
+		log.Printf("[UsageRerate] started (interval=%s max_range_days=%d batch_size=%d task_timeout=%s)", interval, s.maxRangeDays(), s.batchSize(), s.taskTimeout())
+	})
+}
+
+func (s *UsageRerateService) Stop() {
+	if s == nil {
+		return
+	}
+	s.stopOnce.Do(func() {
+		if s.workerCancel != nil {
+			s.workerCancel()
+		}
+		if s.timingWheel != nil {
+			s.timingWheel.Cancel(usageRerateWorkerName)
+		}
+		log.Printf("[UsageRerate] stopped")
+	})
+}
+
+func (s *UsageRerateService) ListTasks(ctx context.Context, params pagination.PaginationParams) ([]UsageRerateTask, *pagination.PaginationResult, error) {
+	if s == nil || s.repo == nil {
+		return nil, nil, fmt.Errorf("rerate service not ready")
+	}
+	return s.repo.ListTasks(ctx, params)
+}
+
+// GetTask 查询任务
+func (s *UsageRerateService) GetTask(ctx context.Context, taskID int64) (*UsageRerateTask, error) {
+	if s == nil || s.repo == nil {
+		return nil, fmt.Errorf("rerate service not ready")
+	}
+	task, err := s.repo.GetTask(ctx, taskID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, infraerrors.New(http.StatusNotFound, "USAGE_RERATE_TASK_NOT_FOUND", "rerate task not found")
+		}
+		return nil, err
+	}
+	return task, nil
+}
+
+// ListDiffs 查询任务的按用户差异报告
+func (s *UsageRerateService) ListDiffs(ctx context.Context, taskID int64, params pagination.PaginationParams) ([]UsageRerateDiff, *pagination.PaginationResult, error) {
+	if _, err := s.GetTask(ctx, taskID); err != nil {
+		return nil, nil, err
+	}
+	return s.repo.ListDiffs(ctx, taskID, params)
+}
+
+func (s *UsageRerateService) CreateTask(ctx context.Context, input UsageRerateTaskInput, createdBy int64) (*UsageRerateTask, error) {
+	if s == nil || s.repo == nil {
+		return nil, fmt.Errorf("rerate service not ready")
+	}
+	if s.cfg != nil && !s.cfg.UsageRerate.Enabled {
+		return nil, infraerrors.New(http.StatusServiceUnavailable, "USAGE_RERATE_DISABLED", "usage rerate is disabled")
+	}
+	if createdBy <= 0 {
+		return nil, infraerrors.BadRequest("USAGE_RERATE_INVALID_CREATOR", "invalid creator")
+	}
+	if input.PostAdjustments && !input.ApplyChanges {
+		return nil, infraerrors.BadRequest("USAGE_RERATE_ADJUST_REQUIRES_APPLY", "post_adjustments requires apply_changes")
+	}
+
+	filters := input.Filters
+	sanitizeUsageCleanupFilters(&filters)
+	if err := s.validateFilters(filters); err != nil {
+		log.Printf("[UsageRerate] create_task rejected: operator=%d err=%v %s", createdBy, err, describeUsageCleanupFilters(filters))
+		return nil, err
+	}
+
+	task := &UsageRerateTask{
+		Status:          UsageRerateStatusPending,
+		Filters:         filters,
+		ApplyChanges:    input.ApplyChanges,
+		PostAdjustments: input.PostAdjustments,
+		CreatedBy:       createdBy,
+	}
+	if err := s.repo.CreateTask(ctx, task); err != nil {
+		return nil, fmt.Errorf("create rerate task: %w", err)
+	}
+	log.Printf("[UsageRerate] create_task persisted: task=%d operator=%d apply=%t adjust=%t %s", task.ID, createdBy, task.ApplyChanges, task.PostAdjustments, describeUsageCleanupFilters(filters))
+	go s.runOnce()
+	return task, nil
+}
+
+func (s *UsageRerateService) CancelTask(ctx context.Context, taskID int64, canceledBy int64) error {
+	if s == nil || s.repo == nil {
+		return fmt.Errorf("rerate service not ready")
+	}
+	if canceledBy <= 0 {
+		return infraerrors.BadRequest("USAGE_RERATE_INVALID_CANCELLER", "invalid canceller")
+	}
+	status, err := s.repo.GetTaskStatus(ctx, taskID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return infraerrors.New(http.StatusNotFound, "USAGE_RERATE_TASK_NOT_FOUND", "rerate task not found")
+		}
+		return err
+	}
+	if status != UsageRerateStatusPending && status != UsageRerateStatusRunning {
+		return infraerrors.New(http.StatusConflict, "USAGE_RERATE_CANCEL_CONFLICT", "rerate task cannot be canceled in current status")
+	}
+	ok, err := s.repo.CancelTask(ctx, taskID, canceledBy)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		// 状态可能并发改变
+		return infraerrors.New(http.StatusConflict, "USAGE_RERATE_CANCEL_CONFLICT", "rerate task cannot be canceled in current status")
+	}
+	log.Printf("[UsageRerate] cancel_task done: task=%d operator=%d", taskID, canceledBy)
+	return nil
+}
+
+func (s *UsageRerateService) runOnce() {
+	if s == nil {
+		return
+	}
+	if !atomic.CompareAndSwapInt32(&s.running, 0, 1) {
+		return
+	}
+	defer atomic.StoreInt32(&s.running, 0)
+
+	parent := context.Background()
+	if s.workerCtx != nil {
+		parent = s.workerCtx
+	}
+	ctx, cancel := context.WithTimeout(parent, s.taskTimeout())
+	defer cancel()
+
+	task, err := s.repo.ClaimNextPendingTask(ctx, int64(s.taskTimeout().Seconds()))
+	if err != nil {
+		log.Printf("[UsageRerate] claim pending task failed: %v", err)
+		return
+	}
+	if task == nil {
+		return
+	}
+	log.Printf("[UsageRerate] task claimed: task=%d last_usage_log_id=%d processed_rows=%d apply=%t adjust=%t %s",
+		task.ID, task.LastUsageLogID, task.ProcessedRows, task.ApplyChanges, task.PostAdjustments, describeUsageCleanupFilters(task.Filters))
+	s.executeTask(ctx, task)
+}
+
+func (s *UsageRerateService) executeTask(ctx context.Context, task *UsageRerateTask) {
+	batchSize := s.batchSize()
+	progress := UsageRerateProgress{
+		LastUsageLogID: task.LastUsageLogID,
+		ProcessedRows:  task.ProcessedRows,
+		ChangedRows:    task.ChangedRows,
+		OldTotalCost:   task.OldTotalCost,
+		NewTotalCost:   task.NewTotalCost,
+		OldActualCost:  task.OldActualCost,
+		NewActualCost:  task.NewActualCost,
+	}
+	groups := make(map[int64]*Group)
+	start := time.Now()
+
+	for {
+		if ctx.Err() != nil {
+			log.Printf("[UsageRerate] task interrupted: task=%d err=%v", task.ID, ctx.Err())
+			return
+		}
+		canceled, err := s.isTaskCanceled(task.ID)
+		if err != nil {
+			s.markTaskFailed(task.ID, err)
+			return
+		}
+		if canceled {
+			// 已提交的批次保留，取消的任务不写入余额调整
+			log.Printf("[UsageRerate] task canceled: task=%d processed_rows=%d", task.ID, progress.ProcessedRows)
+			return
+		}
+
+		logs, err := s.repo.ListUsageLogsBatch(ctx, task.Filters, progress.LastUsageLogID, batchSize)
+		if err == nil && len(logs) > 0 {
+			err = s.processBatch(ctx, task, logs, groups, &progress)
+		}
+		if err != nil {
+			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+				// 保持 running 状态，后续通过 stale reclaim 从已提交的进度续跑
+				log.Printf("[UsageRerate] task interrupted: task=%d err=%v", task.ID, err)
+				return
+			}
+			s.markTaskFailed(task.ID, err)
+			return
+		}
+		if len(logs) < batchSize {
+			break
+		}
+	}
+
+	if task.PostAdjustments {
+		if err := s.postAdjustments(ctx, task.ID); err != nil {
+			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+				log.Printf("[UsageRerate] task interrupted while posting adjustments: task=%d err=%v", task.ID, err)
+				return
+			}
+			s.markTaskFailed(task.ID, err)
+			return
+		}
+	}
+
+	updateCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.repo.MarkTaskSucceeded(updateCtx, task.ID); err != nil {
+		log.Printf("[UsageRerate] update task succeeded failed: task=%d err=%v", task.ID, err)
+	} else {
+		log.Printf("[UsageRerate] task succeeded: task=%d processed_rows=%d changed_rows=%d old_actual=%.6f new_actual=%.6f duration=%s",
+			task.ID, progress.ProcessedRows, progress.ChangedRows, progress.OldActualCost, progress.NewActualCost, time.Since(start))
+	}
+
+	if task.ApplyChanges && s.dashboard != nil {
+		if err := s.dashboard.TriggerRecomputeRange(task.Filters.StartTime, task.Filters.EndTime); err != nil {
+			log.Printf("[UsageRerate] trigger dashboard recompute failed: task=%d err=%v", task.ID, err)
+		}
+	}
+}
+
+// processBatch 重算一批记录，并在同一事务中回写结果、累加差异与保存进度
+func (s *UsageRerateService) processBatch(ctx context.Context, task *UsageRerateTask, logs []UsageLog, groups map[int64]*Group, progress *UsageRerateProgress) error {
+	next := *progress
+	diffs := make(map[int64]*UsageRerateDiff)
+	var updates []UsageRerateUpdate
+
+	for i := range logs {
+		usageLog := &logs[i]
+		cost, err := s.rerateUsageLog(ctx, usageLog, groups)
+		if err != nil {
+			return fmt.Errorf("rerate usage log %d: %w", usageLog.ID, err)
+		}
+		changed := math.Abs(cost.TotalCost-usageLog.TotalCost) > usageRerateCostEpsilon ||
+			math.Abs(cost.ActualCost-usageLog.ActualCost) > usageRerateCostEpsilon
+		var overage *SubscriptionCostSplit
+		if usageLog.BillingType == BillingTypeSubscription {
+			overage = resplitSubscriptionCost(usageLog, cost.TotalCost)
+		}
+
+		// 差额按实际扣费的账户汇总（组织 Key 调整组织计费账户余额）
+		payerID := usageLog.PayerID()
+		diff := diffs[payerID]
+		if diff == nil {
+			diff = &UsageRerateDiff{TaskID: task.ID, UserID: payerID}
+			diffs[payerID] = diff
+		}
+		diff.Rows++
+		diff.OldTotalCost += usageLog.TotalCost
+		diff.NewTotalCost += cost.TotalCost
+		diff.OldActualCost += usageLog.ActualCost
+		diff.NewActualCost += cost.ActualCost
+		if changed {
+			diff.ChangedRows++
+			next.ChangedRows++
+			// 订阅计费仅超额部分从余额扣除，其余差额只体现在报告中
+			if usageLog.BillingType == BillingTypeBalance {
+				diff.BalanceDelta += usageLog.ActualCost - cost.ActualCost
+			} else if overage != nil {
+				diff.BalanceDelta += *usageLog.OverageCost - overage.OverageCost
+			}
+		}
+
+		if task.ApplyChanges && (changed || pricingVersionChanged(usageLog, cost)) {
+			updates = append(updates, UsageRerateUpdate{UsageLogID: usageLog.ID, Cost: cost, Overage: overage})
+		}
+
+		next.LastUsageLogID = usageLog.ID
+		next.ProcessedRows++
+		next.OldTotalCost += usageLog.TotalCost
+		next.NewTotalCost += cost.TotalCost
+		next.OldActualCost += usageLog.ActualCost
+		next.NewActualCost += cost.ActualCost
+	}
+
+	diffList := make([]UsageRerateDiff, 0, len(diffs))
+	for _, diff := range diffs {
+		diffList = append(diffList, *diff)
+	}
+	if err := withTx(ctx, s.entClient, func(txCtx context.Context) error {
+		return s.repo.ApplyBatch(txCtx, task.ID, updates, diffList, next)
+	}); err != nil {
+		return err
+	}
+	*progress = next
+	return nil
+}
+
+// rerateUsageLog 按记录发生时刻生效的价格重新计算费用，沿用记录中的倍率快照
+func (s *UsageRerateService) rerateUsageLog(ctx context.Context, usageLog *UsageLog, groups map[int64]*Group) (*CostBreakdown, error) {
+	if usageLog.ImageCount > 0 {
+		var groupConfig *ImagePriceConfig
+		if usageLog.GroupID != nil {
+			group, err := s.lookupGroup(ctx, *usageLog.GroupID, groups)
+			if err != nil {
+				return nil, err
+			}
+			groupConfig = &ImagePriceConfig{GroupID: usageLog.GroupID}
+			if group != nil {
+				groupConfig.Price1K = group.ImagePrice1K
+				groupConfig.Price2K = group.ImagePrice2K
+				groupConfig.Price4K = group.ImagePrice4K
+			}
+		}
+		imageSize := ""
+		if usageLog.ImageSize != nil {
+			imageSize = *usageLog.ImageSize
+		}
+		return s.billingService.CalculateImageCostAt(usageLog.Model, imageSize, usageLog.ImageCount, groupConfig, usageLog.RateMultiplier, usageLog.CreatedAt), nil
+	}
+
+	tokens := UsageTokens{
+		InputTokens:           usageLog.InputTokens,
+		OutputTokens:          usageLog.OutputTokens,
+		CacheCreationTokens:   usageLog.CacheCreationTokens,
+		CacheReadTokens:       usageLog.CacheReadTokens,
+		CacheCreation5mTokens: usageLog.CacheCreation5mTokens,
+		CacheCreation1hTokens: usageLog.CacheCreation1hTokens,
+	}
+	return s.billingService.CalculateCostAt(usageLog.Model, usageLog.GroupID, tokens, usageLog.RateMultiplier, usageLog.CreatedAt)
+}
+
+func (s *UsageRerateService) lookupGroup(ctx context.Context, groupID int64, groups map[int64]*Group) (*Group, error) {
+	if group, ok := groups[groupID]; ok {
+		return group, nil
+	}
+	if s.groupRepo == nil {
+		return nil, nil
+	}
+	group, err := s.groupRepo.GetByIDLite(ctx, groupID)
+	if err != nil {
+		if !errors.Is(err, ErrGroupNotFound) {
+			return nil, err
+		}
+		// 分组已删除：按默认图片价格计算
+		group = nil
+	}
+	groups[groupID] = group
+	return group, nil
+}
+
+func pricingVersionChanged(usageLog *UsageLog, cost *CostBreakdown) bool {
+	if cost.Pricing == nil {
+		return false
+	}
+	return usageLog.PricingVersion == nil || *usageLog.PricingVersion != cost.Pricing.Version
+}
+
+// postAdjustments 为余额差额非零的用户写入调整流水，每个用户一个事务，重复执行不会重复调整
+func (s *UsageRerateService) postAdjustments(ctx context.Context, taskID int64) error {
+	if s.userRepo == nil {
+		return fmt.Errorf("user repository not configured")
+	}
+	for {
+		diffs, err := s.repo.ListPendingAdjustments(ctx, taskID, usageRerateMinAdjustment, usageRerateAdjustmentBatch)
+		if err != nil {
+			return err
+		}
+		if len(diffs) == 0 {
+			return nil
+		}
+		for i := range diffs {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := s.postAdjustment(ctx, &diffs[i]); err != nil {
+				return fmt.Errorf("adjust balance for user %d: %w", diffs[i].UserID, err)
+			}
+		}
+	}
+}
+
+func (s *UsageRerateService) postAdjustment(ctx context.Context, diff *UsageRerateDiff) error {
+	var txID *int64
+	err := withTx(ctx, s.entClient, func(txCtx context.Context) error {
+		tx, err := s.userRepo.ApplyBalanceChange(txCtx, &BalanceChange{
+			UserID: diff.UserID,
+			Type:   BalanceTxTypeAdjustment,
+			Amount: diff.BalanceDelta,
+			Notes:  fmt.Sprintf("usage rerate task #%d", diff.TaskID),
+		})
+		if err != nil {
+			if !errors.Is(err, ErrUserNotFound) {
+				return err
+			}
+			log.Printf("[UsageRerate] skip adjustment for missing user: task=%d user=%d delta=%.10f", diff.TaskID, diff.UserID, diff.BalanceDelta)
+		} else {
+			txID = &tx.ID
+		}
+		return s.repo.MarkDiffAdjusted(txCtx, diff.TaskID, diff.UserID, txID)
+	})
+	if err != nil {
+		return err
+	}
+	if txID != nil {
+		log.Printf("[UsageRerate] balance adjusted: task=%d user=%d delta=%.10f tx=%d", diff.TaskID, diff.UserID, diff.BalanceDelta, *txID)
+		s.invalidateBalanceCaches(ctx, diff.UserID)
+	}
+	return nil
+}
+
+func (s *UsageRerateService) invalidateBalanceCaches(ctx context.Context, userID int64) {
+	if s.authCacheInvalidator != nil {
+		s.authCacheInvalidator.InvalidateAuthCacheByUserID(ctx, userID)
+	}
+	if s.billingCacheService == nil {
+		return
+	}
+	go func() {
+		cacheCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = s.billingCacheService.InvalidateUserBalance(cacheCtx, userID)
+	}()
+}
+
+func (s *UsageRerateService) markTaskFailed(taskID int64, err error) {
+	msg := strings.TrimSpace(err.Error())
+	if len(msg) > 500 {
+		msg = msg[:500]
+	}
+	log.Printf("[UsageRerate] task failed: task=%d err=%s", taskID, msg)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if updateErr := s.repo.MarkTaskFailed(ctx, taskID, msg); updateErr != nil {
+		log.Printf("[UsageRerate] update task failed failed: task=%d err=%v", taskID, updateErr)
+	}
+}
+
+func (s *UsageRerateService) isTaskCanceled(taskID int64) (bool, error) {
+	checkCtx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	status, err := s.repo.GetTaskStatus(checkCtx, taskID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+	return status == UsageRerateStatusCanceled, nil
+}
+
+func (s *UsageRerateService) validateFilters(filters UsageCleanupFilters) error {
+	if filters.StartTime.IsZero() || filters.EndTime.IsZero() {
+		return infraerrors.BadRequest("USAGE_RERATE_MISSING_RANGE", "start_date and end_date are required")
+	}
+	if filters.EndTime.Before(filters.StartTime) {
+		return infraerrors.BadRequest("USAGE_RERATE_INVALID_RANGE", "end_date must be after start_date")
+	}
+	maxDays := s.maxRangeDays()
+	if maxDays > 0 && filters.EndTime.Sub(filters.StartTime) > time.Duration(maxDays)*24*time.Hour {
+		return infraerrors.BadRequest("USAGE_RERATE_RANGE_TOO_LARGE", fmt.Sprintf("date range exceeds %d days", maxDays))
+	}
+	return nil
+}
+
+func (s *UsageRerateService) maxRangeDays() int {
+	if s == nil || s.cfg == nil || s.cfg.UsageRerate.MaxRangeDays <= 0 {
+		return 93
+	}
+	return s.cfg.UsageRerate.MaxRangeDays
+}
+
+func (s *UsageRerateService) batchSize() int {
+	if s == nil || s.cfg == nil || s.cfg.UsageRerate.BatchSize <= 0 {
+		return 1000
+	}
+	return s.cfg.UsageRerate.BatchSize
+}
+
+func (s *UsageRerateService) workerInterval() time.Duration {
+	if s == nil || s.cfg == nil || s.cfg.UsageRerate.WorkerIntervalSeconds <= 0 {
+		return 10 * time.Second
+	}
+	return time.Duration(s.cfg.UsageRerate.WorkerIntervalSeconds) * time.Second
+}
+
+func (s *UsageRerateService) taskTimeout() time.Duration {
+	if s == nil || s.cfg == nil || s.cfg.UsageRerate.TaskTimeoutSeconds <= 0 {
+		return time.Hour
+	}
+	return time.Duration(s.cfg.UsageRerate.TaskTimeoutSeconds) * time.Second
+}
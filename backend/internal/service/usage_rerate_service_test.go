@@ -0,0 +1,248 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/stretchr/testify/require"
+)
+
+type usageRerateRepoStub struct {
+	task      *UsageRerateTask
+	logs      []UsageLog
+	updates   []UsageRerateUpdate
+	diffs     map[int64]*UsageRerateDiff
+	succeeded bool
+	failedMsg string
+}
+
+func (s *usageRerateRepoStub) CreateTask(_ context.Context, task *UsageRerateTask) error {
+	task.ID = 1
+	s.task = task
+	return nil
+}
+
+func (s *usageRerateRepoStub) ListTasks(context.Context, pagination.PaginationParams) ([]UsageRerateTask, *pagination.PaginationResult, error) {
+	return nil, nil, nil
+}
+
+func (s *usageRerateRepoStub) GetTask(_ context.Context, taskID int64) (*UsageRerateTask, error) {
+	if s.task == nil || s.task.ID != taskID {
+		return nil, sql.ErrNoRows
+	}
+	return s.task, nil
+}
+
+func (s *usageRerateRepoStub) ClaimNextPendingTask(context.Context, int64) (*UsageRerateTask, error) {
+	return nil, nil
+}
+
+func (s *usageRerateRepoStub) GetTaskStatus(_ context.Context, taskID int64) (string, error) {
+	if s.task == nil || s.task.ID != taskID {
+		return "", sql.ErrNoRows
+	}
+	return s.task.Status, nil
+}
+
+func (s *usageRerateRepoStub) CancelTask(context.Context, int64, int64) (bool, error) {
+	return true, nil
+}
+
+func (s *usageRerateRepoStub) MarkTaskSucceeded(context.Context, int64) error {
+	s.succeeded = true
+	return nil
+}
+
+func (s *usageRerateRepoStub) MarkTaskFailed(_ context.Context, _ int64, errorMsg string) error {
+	s.failedMsg = errorMsg
+	return nil
+}
+
+func (s *usageRerateRepoStub) ListUsageLogsBatch(_ context.Context, _ UsageCleanupFilters, afterID int64, limit int) ([]UsageLog, error) {
+	out := make([]UsageLog, 0, limit)
+	for _, l := range s.logs {
+		if l.ID > afterID && len(out) < limit {
+			out = append(out, l)
+		}
+	}
+	return out, nil
+}
+
+func (s *usageRerateRepoStub) ApplyBatch(_ context.Context, _ int64, updates []UsageRerateUpdate, diffs []UsageRerateDiff, progress UsageRerateProgress) error {
+	s.updates = append(s.updates, updates...)
+	if s.diffs == nil {
+		s.diffs = make(map[int64]*UsageRerateDiff)
+	}
+	for _, d := range diffs {
+		cur := s.diffs[d.UserID]
+		if cur == nil {
+			d := d
+			s.diffs[d.UserID] = &d
+			continue
+		}
+		cur.Rows += d.Rows
+		cur.ChangedRows += d.ChangedRows
+		cur.OldActualCost += d.OldActualCost
+		cur.NewActualCost += d.NewActualCost
+		cur.BalanceDelta += d.BalanceDelta
+	}
+	s.task.LastUsageLogID = progress.LastUsageLogID
+	s.task.ProcessedRows = progress.ProcessedRows
+	s.task.ChangedRows = progress.ChangedRows
+	return nil
+}
+
+func (s *usageRerateRepoStub) ListDiffs(context.Context, int64, pagination.PaginationParams) ([]UsageRerateDiff, *pagination.PaginationResult, error) {
+	return nil, nil, nil
+}
+
+func (s *usageRerateRepoStub) ListPendingAdjustments(_ context.Context, _ int64, minAbsDelta float64, limit int) ([]UsageRerateDiff, error) {
+	var out []UsageRerateDiff
+	for _, d := range s.diffs {
+		if d.AdjustedAt == nil && (d.BalanceDelta >= minAbsDelta || d.BalanceDelta <= -minAbsDelta) && len(out) < limit {
+			out = append(out, *d)
+		}
+	}
+	return out, nil
+}
+
+func (s *usageRerateRepoStub) MarkDiffAdjusted(_ context.Context, _, userID int64, txID *int64) error {
+	now := time.Now()
+	s.diffs[userID].AdjustmentTxID = txID
+	s.diffs[userID].AdjustedAt = &now
+	return nil
+}
+
+func TestUsageRerateService_CreateTaskValidation(t *testing.T) {
+	svc := NewUsageRerateService(&usageRerateRepoStub{}, nil, nil, nil, nil, nil, nil, nil, nil, &config.Config{UsageRerate: config.UsageRerateConfig{Enabled: true, MaxRangeDays: 31}})
+	ctx := context.Background()
+	now := time.Now()
+
+	_, err := svc.CreateTask(ctx, UsageRerateTaskInput{
+		Filters:         UsageCleanupFilters{StartTime: now.Add(-time.Hour), EndTime: now},
+		PostAdjustments: true,
+	}, 1)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "post_adjustments requires apply_changes")
+
+	_, err = svc.CreateTask(ctx, UsageRerateTaskInput{
+		Filters: UsageCleanupFilters{StartTime: now.AddDate(0, 0, -40), EndTime: now},
+	}, 1)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "date range exceeds 31 days")
+}
+
+func TestUsageRerateService_ExecuteTaskAppliesAndAdjusts(t *testing.T) {
+	overrides := NewModelPriceOverrideService(&priceOverrideRepoStub{}, nil, nil)
+	billing := NewBillingService(&config.Config{}, nil, overrides)
+	ctx := context.Background()
+	created := time.Now().Add(-time.Hour)
+
+	// 原价 claude-sonnet-4 输入 $3/M；修正为 $2/M，生效时间早于用量记录
+	_, err := overrides.Create(ctx, ModelPriceOverrideInput{Model: "claude-sonnet-4", InputPrice: priceOverrideFloat(2), EffectiveFrom: priceOverrideTime(created.Add(-time.Hour))})
+	require.NoError(t, err)
+
+	oldVersion := "fallback:old"
+	repo := &usageRerateRepoStub{logs: []UsageLog{
+		// 余额计费，倍率 2：旧费用 3 / 6，新费用 2 / 4
+		{ID: 1, UserID: 10, Model: "claude-sonnet-4", InputTokens: 1_000_000, TotalCost: 3, ActualCost: 6, RateMultiplier: 2, BillingType: BillingTypeBalance, PricingVersion: &oldVersion, CreatedAt: created},
+		// 订阅计费：差额只体现在报告中
+		{ID: 2, UserID: 11, Model: "claude-sonnet-4", InputTokens: 1_000_000, TotalCost: 3, ActualCost: 3, RateMultiplier: 1, BillingType: BillingTypeSubscription, CreatedAt: created},
+		// 价格未变化的记录
+		{ID: 3, UserID: 10, Model: "claude-sonnet-4", OutputTokens: 1_000_000, TotalCost: 15, ActualCost: 15, RateMultiplier: 1, BillingType: BillingTypeBalance, CreatedAt: created},
+	}}
+	userRepo := &balanceUserRepoStub{userRepoStub: &userRepoStub{user: &User{ID: 10}}}
+	cfg := &config.Config{UsageRerate: config.UsageRerateConfig{Enabled: true, MaxRangeDays: 31, BatchSize: 2}}
+	svc := NewUsageRerateService(repo, billing, nil, userRepo, nil, nil, nil, nil, nil, cfg)
+
+	task, err := svc.CreateTask(ctx, UsageRerateTaskInput{
+		Filters:         UsageCleanupFilters{StartTime: created.Add(-time.Minute), EndTime: created.Add(time.Minute)},
+		ApplyChanges:    true,
+		PostAdjustments: true,
+	}, 1)
+	require.NoError(t, err)
+	task.Status = UsageRerateStatusRunning
+	svc.executeTask(ctx, task)
+
+	require.Empty(t, repo.failedMsg)
+	require.True(t, repo.succeeded)
+	require.Equal(t, int64(3), task.ProcessedRows)
+	require.Equal(t, int64(2), task.ChangedRows)
+	require.Equal(t, int64(3), task.LastUsageLogID)
+
+	// 变化的记录与缺少价格版本的记录都会回写
+	require.Len(t, repo.updates, 3)
+	require.InDelta(t, 4.0, repo.updates[0].Cost.ActualCost, 1e-9)
+	require.NotNil(t, repo.updates[0].Cost.Pricing)
+	require.Contains(t, repo.updates[0].Cost.Pricing.Version, "override:")
+	require.InDelta(t, 2.0, *repo.updates[0].Cost.Pricing.InputPrice, 1e-9)
+
+	require.InDelta(t, 2.0, repo.diffs[10].BalanceDelta, 1e-9)
+	require.Equal(t, int64(2), repo.diffs[10].Rows)
+	require.InDelta(t, 0.0, repo.diffs[11].BalanceDelta, 1e-9)
+	require.InDelta(t, 1.0, repo.diffs[11].OldActualCost-repo.diffs[11].NewActualCost, 1e-9)
+
+	// 仅余额计费用户写入一次调整流水
+	require.Len(t, userRepo.changes, 1)
+	require.Equal(t, BalanceTxTypeAdjustment, userRepo.changes[0].Type)
+	require.Equal(t, int64(10), userRepo.changes[0].UserID)
+	require.InDelta(t, 2.0, userRepo.changes[0].Amount, 1e-9)
+	require.NotNil(t, repo.diffs[10].AdjustedAt)
+	require.Nil(t, repo.diffs[11].AdjustedAt)
+}
+
+func TestUsageRerateService_ExecuteTaskAdjustsSubscriptionOverage(t *testing.T) {
+	overrides := NewModelPriceOverrideService(&priceOverrideRepoStub{}, nil, nil)
+	billing := NewBillingService(&config.Config{}, nil, overrides)
+	ctx := context.Background()
+	created := time.Now().Add(-time.Hour)
+
+	_, err := overrides.Create(ctx, ModelPriceOverrideInput{Model: "claude-sonnet-4", InputPrice: priceOverrideFloat(2), EffectiveFrom: priceOverrideTime(created.Add(-time.Hour))})
+	require.NoError(t, err)
+
+	// 订阅剩余额度 1，超额倍率 2：旧费用 3 拆为订阅 1 + 超额 2×2=4；新费用 2 拆为订阅 1 + 超额 1×2=2
+	subscriptionCost, overageCost := 1.0, 4.0
+	// 未超额的订阅记录：差额只体现在报告中
+	noOverage, zero := 3.0, 0.0
+	repo := &usageRerateRepoStub{logs: []UsageLog{
+		{ID: 1, UserID: 12, Model: "claude-sonnet-4", InputTokens: 1_000_000, TotalCost: 3, ActualCost: 3, RateMultiplier: 1, BillingType: BillingTypeSubscription, SubscriptionCost: &subscriptionCost, OverageCost: &overageCost, CreatedAt: created},
+		{ID: 2, UserID: 13, Model: "claude-sonnet-4", InputTokens: 1_000_000, TotalCost: 3, ActualCost: 3, RateMultiplier: 1, BillingType: BillingTypeSubscription, SubscriptionCost: &noOverage, OverageCost: &zero, CreatedAt: created},
+	}}
+	userRepo := &balanceUserRepoStub{userRepoStub: &userRepoStub{user: &User{ID: 12}}}
+	cfg := &config.Config{UsageRerate: config.UsageRerateConfig{Enabled: true, MaxRangeDays: 31, BatchSize: 10}}
+	svc := NewUsageRerateService(repo, billing, nil, userRepo, nil, nil, nil, nil, nil, cfg)
+
+	task, err := svc.CreateTask(ctx, UsageRerateTaskInput{
+		Filters:         UsageCleanupFilters{StartTime: created.Add(-time.Minute), EndTime: created.Add(time.Minute)},
+		ApplyChanges:    true,
+		PostAdjustments: true,
+	}, 1)
+	require.NoError(t, err)
+	task.Status = UsageRerateStatusRunning
+	svc.executeTask(ctx, task)
+
+	require.Empty(t, repo.failedMsg)
+	require.True(t, repo.succeeded)
+
+	require.Len(t, repo.updates, 2)
+	require.NotNil(t, repo.updates[0].Overage)
+	require.InDelta(t, 1.0, repo.updates[0].Overage.SubscriptionCost, 1e-9)
+	require.InDelta(t, 2.0, repo.updates[0].Overage.OverageCost, 1e-9)
+	require.NotNil(t, repo.updates[1].Overage)
+	require.InDelta(t, 2.0, repo.updates[1].Overage.SubscriptionCost, 1e-9)
+	require.InDelta(t, 0.0, repo.updates[1].Overage.OverageCost, 1e-9)
+
+	require.InDelta(t, 2.0, repo.diffs[12].BalanceDelta, 1e-9)
+	require.InDelta(t, 0.0, repo.diffs[13].BalanceDelta, 1e-9)
+
+	// 仅超额部分退还到余额
+	require.Len(t, userRepo.changes, 1)
+	require.Equal(t, int64(12), userRepo.changes[0].UserID)
+	require.InDelta(t, 2.0, userRepo.changes[0].Amount, 1e-9)
+}
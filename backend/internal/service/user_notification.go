@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+)
+
+// 用户通知事件类型
+const (
+	UserNotificationLowBalance          = "low_balance"
+	UserNotificationSubscriptionDaily   = "subscription_daily"
+	UserNotificationSubscriptionWeekly  = "subscription_weekly"
+	UserNotificationSubscriptionMonthly = "subscription_monthly"
+	// 订阅自动续费失败（余额不足或套餐已下架），不受阈值配置影响
+	UserNotificationSubscriptionRenewalFailed = "subscription_renewal_failed"
+)
+
+var (
+	ErrInvalidNotificationThreshold = infraerrors.BadRequest("INVALID_NOTIFICATION_THRESHOLD", "invalid notification threshold")
+	ErrInvalidNotificationWebhook   = infraerrors.BadRequest("INVALID_NOTIFICATION_WEBHOOK", "notification webhook url must be a public https url")
+)
+
+// UserNotificationSettings 用户通知阈值配置
+type UserNotificationSettings struct {
+	UserID int64 `json:"user_id"`
+	// BalanceThreshold 余额低于该值时通知，nil 表示不启用
+	BalanceThreshold *float64 `json:"balance_threshold"`
+	// SubscriptionUsagePercent 订阅任一周期用量达到限额百分比时通知，nil 表示不启用
+	SubscriptionUsagePercent *float64  `json:"subscription_usage_percent"`
+	EmailEnabled             bool      `json:"email_enabled"`
+	WebhookURL               string    `json:"webhook_url"`
+	UpdatedAt                time.Time `json:"updated_at"`
+}
+
+func (s *UserNotificationSettings) hasThresholds() bool {
+	return s != nil && (s.BalanceThreshold != nil || s.SubscriptionUsagePercent != nil)
+}
+
+// UpdateUserNotificationSettingsInput 用户更新通知配置
+type UpdateUserNotificationSettingsInput struct {
+	BalanceThreshold         *float64
+	SubscriptionUsagePercent *float64
+	EmailEnabled             bool
+	WebhookURL               string
+}
+
+// UserNotificationPayload 通知内容（同时作为 webhook 请求体）
+type UserNotificationPayload struct {
+	Event     string     `json:"event"`
+	UserID    int64      `json:"user_id"`
+	Value     float64    `json:"value"`
+	Threshold float64    `json:"threshold"`
+	Limit     *float64   `json:"limit,omitempty"`
+	GroupID   *int64     `json:"group_id,omitempty"`
+	GroupName string     `json:"group_name,omitempty"`
+	Reason    string     `json:"reason,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	Timestamp time.Time  `json:"timestamp"`
+}
+
+// UsageNotificationInput 用量记录后的阈值检查输入
+type UsageNotificationInput struct {
+	UserID int64
+	// BalanceAfter 余额扣费后的余额，nil 表示本次未扣余额
+	BalanceAfter *float64
+	// Subscription/Group 订阅计费时的订阅快照及分组（快照用量不含本次费用）
+	Subscription     *UserSubscription
+	Group            *Group
+	SubscriptionCost float64
+}
+
+type UserNotificationRepository interface {
+	// GetSettings 返回用户通知配置，未配置时返回 nil, nil
+	GetSettings(ctx context.Context, userID int64) (*UserNotificationSettings, error)
+	UpsertSettings(ctx context.Context, settings *UserNotificationSettings) error
+	// ClaimEvent 记录一次通知事件；同一 (user, kind, dedupKey) 已记录过时返回 false
+	ClaimEvent(ctx context.Context, userID int64, kind, dedupKey string) (bool, error)
+}
+
+// UserNotificationWebhookSender 向用户配置的 webhook 投递通知
+type UserNotificationWebhookSender interface {
+	Send(ctx context.Context, url string, payload *UserNotificationPayload) error
+}
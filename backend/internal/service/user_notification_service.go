@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+	"github.com/Wei-Shaw/sub2api/internal/util/urlvalidator"
+)
+
+const (
+	userNotificationWorkerCount = 2
+	userNotificationBufferSize  = 1000
+	userNotificationTaskTimeout = 15 * time.Second
+	// 配置缓存 TTL：未配置的用户同样缓存，避免每次请求查库
+	userNotificationSettingsTTL = time.Minute
+)
+
+type cachedUserNotificationSettings struct {
+	settings  *UserNotificationSettings
+	expiresAt time.Time
+}
+
+// UserNotificationService 用户余额/订阅用量阈值通知
+//
+// 网关记录用量后异步检查用户配置的阈值（余额低于 X、订阅日/周/月用量超过 Y%），
+// 触发时通过邮件队列和用户配置的 webhook 通知；同一周期（余额按自然日，订阅按用量窗口）只通知一次。
+type UserNotificationService struct {
+	repo           UserNotificationRepository
+	webhookSender  UserNotificationWebhookSender
+	userRepo       UserRepository
+	emailQueue     *EmailQueueService
+	settingService *SettingService
+
+	settingsCache sync.Map // userID -> cachedUserNotificationSettings
+
+	taskChan chan UsageNotificationInput
+	wg       sync.WaitGroup
+	stopOnce sync.Once
+}
+
+func NewUserNotificationService(
+	repo UserNotificationRepository,
+	webhookSender UserNotificationWebhookSender,
+	userRepo UserRepository,
+	emailQueue *EmailQueueService,
+	settingService *SettingService,
+) *UserNotificationService {
+	return &UserNotificationService{
+		repo:           repo,
+		webhookSender:  webhookSender,
+		userRepo:       userRepo,
+		emailQueue:     emailQueue,
+		settingService: settingService,
+	}
+}
+
+// Start 启动检查工作池
+func (s *UserNotificationService) Start() {
+	if s == nil || s.repo == nil || s.taskChan != nil {
+		return
+	}
+	s.taskChan = make(chan UsageNotificationInput, userNotificationBufferSize)
+	for i := 0; i < userNotificationWorkerCount; i++ {
+		s.wg.Add(1)
+		go s.worker()
+	}
+}
+
+// Stop 关闭工作池并等待队列中的检查完成
+func (s *UserNotificationService) Stop() {
+	if s == nil {
+		return
+	}
+	s.stopOnce.Do(func() {
+		if s.taskChan == nil {
+			return
+		}
+		close(s.taskChan)
+		s.wg.Wait()
+	})
+}
+
+func (s *UserNotificationService) worker() {
+	defer s.wg.Done()
+	for input := range s.taskChan {
+		ctx, cancel := context.WithTimeout(context.Background(), userNotificationTaskTimeout)
+		s.Evaluate(ctx, input)
+		cancel()
+	}
+}
+
+// CheckAfterUsage 在用量记录并扣费后调用，异步检查阈值；队列满时直接丢弃，不阻塞请求
+func (s *UserNotificationService) CheckAfterUsage(input UsageNotificationInput) {
+	if s == nil || s.taskChan == nil || input.UserID <= 0 {
+		return
+	}
+	if input.BalanceAfter == nil && input.Subscription == nil {
+		return
+	}
+	defer func() {
+		// 服务关闭后通道已关闭，忽略此时的检查
+		_ = recover()
+	}()
+	select {
+	case s.taskChan <- input:
+	default:
+		slog.Warn("user_notification_queue_full", "user_id", input.UserID)
+	}
+}
+
+// Evaluate 同步检查阈值并发送通知
+func (s *UserNotificationService) Evaluate(ctx context.Context, input UsageNotificationInput) {
+	settings, err := s.getCachedSettings(ctx, input.UserID)
+	if err != nil {
+		slog.Warn("user_notification_settings_load_failed", "user_id", input.UserID, "error", err)
+		return
+	}
+	if !settings.hasThresholds() {
+		return
+	}
+	now := time.Now()
+
+	if input.BalanceAfter != nil && settings.BalanceThreshold != nil && *input.BalanceAfter < *settings.BalanceThreshold {
+		s.notify(ctx, settings, UserNotificationLowBalance, timezone.StartOfDay(now).Format("2006-01-02"), &UserNotificationPayload{
+			Event:     UserNotificationLowBalance,
+			UserID:    input.UserID,
+			Value:     *input.BalanceAfter,
+			Threshold: *settings.BalanceThreshold,
+			Timestamp: now,
+		})
+	}
+
+	sub, group := input.Subscription, input.Group
+	if sub == nil || group == nil || settings.SubscriptionUsagePercent == nil {
+		return
+	}
+	group = sub.EffectiveGroup(group)
+	windows := []struct {
+		kind        string
+		limit       *float64
+		usage       float64
+		windowStart *time.Time
+		needsReset  bool
+	}{
+		{UserNotificationSubscriptionDaily, group.DailyLimitUSD, sub.DailyUsageUSD, sub.DailyWindowStart, sub.NeedsDailyReset()},
+		{UserNotificationSubscriptionWeekly, group.WeeklyLimitUSD, sub.WeeklyUsageUSD, sub.WeeklyWindowStart, sub.NeedsWeeklyReset()},
+		{UserNotificationSubscriptionMonthly, group.MonthlyLimitUSD, sub.MonthlyUsageUSD, sub.MonthlyWindowStart, sub.NeedsMonthlyReset()},
+	}
+	for _, w := range windows {
+		// 窗口未激活或即将重置时快照用量已失效，跳过
+		if w.limit == nil || *w.limit <= 0 || w.windowStart == nil || w.needsReset {
+			continue
+		}
+		percent := (w.usage + input.SubscriptionCost) / *w.limit * 100
+		if percent < *settings.SubscriptionUsagePercent {
+			continue
+		}
+		groupID := group.ID
+		dedupKey := fmt.Sprintf("%d:%d", sub.ID, w.windowStart.Unix())
+		s.notify(ctx, settings, w.kind, dedupKey, &UserNotificationPayload{
+			Event:     w.kind,
+			UserID:    input.UserID,
+			Value:     percent,
+			Threshold: *settings.SubscriptionUsagePercent,
+			Limit:     w.limit,
+			GroupID:   &groupID,
+			GroupName: group.Name,
+			Timestamp: now,
+		})
+	}
+}
+
+// NotifySubscriptionRenewalFailed 订阅自动续费失败通知；同一订阅的同一到期时间只通知一次
+func (s *UserNotificationService) NotifySubscriptionRenewalFailed(ctx context.Context, sub *UserSubscription, plan *SubscriptionPlan, reason string) {
+	if s == nil || s.repo == nil || sub == nil {
+		return
+	}
+	settings, err := s.GetSettings(ctx, sub.UserID)
+	if err != nil {
+		slog.Warn("user_notification_settings_load_failed", "user_id", sub.UserID, "error", err)
+		return
+	}
+	payload := &UserNotificationPayload{
+		Event:     UserNotificationSubscriptionRenewalFailed,
+		UserID:    sub.UserID,
+		GroupID:   &sub.GroupID,
+		Reason:    reason,
+		ExpiresAt: &sub.ExpiresAt,
+		Timestamp: time.Now(),
+	}
+	if plan != nil {
+		payload.Value = plan.Price
+	}
+	if sub.Group != nil {
+		payload.GroupName = sub.Group.Name
+	}
+	dedupKey := fmt.Sprintf("%d:%d", sub.ID, sub.ExpiresAt.Unix())
+	s.notify(ctx, settings, UserNotificationSubscriptionRenewalFailed, dedupKey, payload)
+}
+
+func (s *UserNotificationService) notify(ctx context.Context, settings *UserNotificationSettings, kind, dedupKey string, payload *UserNotificationPayload) {
+	claimed, err := s.repo.ClaimEvent(ctx, settings.UserID, kind, dedupKey)
+	if err != nil {
+		slog.Warn("user_notification_claim_failed", "user_id", settings.UserID, "kind", kind, "error", err)
+		return
+	}
+	if !claimed {
+		return
+	}
+
+	if settings.EmailEnabled && s.emailQueue != nil && s.userRepo != nil {
+		user, err := s.userRepo.GetByID(ctx, settings.UserID)
+		if err != nil {
+			slog.Warn("user_notification_user_load_failed", "user_id", settings.UserID, "error", err)
+		} else if user.Email != "" {
+			subject, body := s.renderEmail(ctx, payload)
+			if err := s.emailQueue.EnqueueNotification(user.Email, subject, body); err != nil {
+				slog.Warn("user_notification_email_enqueue_failed", "user_id", settings.UserID, "kind", kind, "error", err)
+			}
+		}
+	}
+
+	if settings.WebhookURL != "" && s.webhookSender != nil {
+		if err := s.webhookSender.Send(ctx, settings.WebhookURL, payload); err != nil {
+			slog.Warn("user_notification_webhook_failed", "user_id", settings.UserID, "kind", kind, "error", err)
+		}
+	}
+}
+
+func (s *UserNotificationService) renderEmail(ctx context.Context, payload *UserNotificationPayload) (string, string) {
+	siteName := "Sub2API"
+	if s.settingService != nil {
+		siteName = s.settingService.GetSiteName(ctx)
+	}
+	var subject, text string
+	switch payload.Event {
+	case UserNotificationLowBalance:
+		subject = fmt.Sprintf("[%s] Low balance alert", siteName)
+		text = fmt.Sprintf("Your balance is $%.4f, below your alert threshold of $%.4f. Please top up to avoid service interruption.", payload.Value, payload.Threshold)
+	case UserNotificationSubscriptionRenewalFailed:
+		subject = fmt.Sprintf("[%s] Subscription renewal failed", siteName)
+		text = fmt.Sprintf("Automatic renewal of subscription %q (price $%.4f) failed: %s.", payload.GroupName, payload.Value, payload.Reason)
+		if payload.ExpiresAt != nil {
+			text += fmt.Sprintf(" The subscription expires at %s.", payload.ExpiresAt.UTC().Format(time.RFC3339))
+		}
+	default:
+		period := map[string]string{
+			UserNotificationSubscriptionDaily:   "daily",
+			UserNotificationSubscriptionWeekly:  "weekly",
+			UserNotificationSubscriptionMonthly: "monthly",
+		}[payload.Event]
+		subject = fmt.Sprintf("[%s] Subscription usage alert", siteName)
+		text = fmt.Sprintf("Your %s usage of subscription %q has reached %.1f%% of its limit (alert threshold %.1f%%).", period, payload.GroupName, payload.Value, payload.Threshold)
+	}
+	return subject, "<p>" + html.EscapeString(text) + "</p>"
+}
+
+// GetSettings 查询用户通知配置，未配置时返回默认值（仅开启邮件渠道，无阈值）
+func (s *UserNotificationService) GetSettings(ctx context.Context, userID int64) (*UserNotificationSettings, error) {
+	settings, err := s.repo.GetSettings(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get notification settings: %w", err)
+	}
+	if settings == nil {
+		settings = &UserNotificationSettings{UserID: userID, EmailEnabled: true}
+	}
+	return settings, nil
+}
+
+// UpdateSettings 更新用户通知配置
+func (s *UserNotificationService) UpdateSettings(ctx context.Context, userID int64, input UpdateUserNotificationSettingsInput) (*UserNotificationSettings, error) {
+	if input.BalanceThreshold != nil && *input.BalanceThreshold < 0 {
+		return nil, ErrInvalidNotificationThreshold
+	}
+	if input.SubscriptionUsagePercent != nil && (*input.SubscriptionUsagePercent <= 0 || *input.SubscriptionUsagePercent > 100) {
+		return nil, ErrInvalidNotificationThreshold
+	}
+	webhookURL := ""
+	if input.WebhookURL != "" {
+		normalized, err := urlvalidator.ValidateHTTPSURL(input.WebhookURL, urlvalidator.ValidationOptions{})
+		if err != nil {
+			return nil, ErrInvalidNotificationWebhook.WithCause(err)
+		}
+		webhookURL = normalized
+	}
+
+	settings := &UserNotificationSettings{
+		UserID:                   userID,
+		BalanceThreshold:         input.BalanceThreshold,
+		SubscriptionUsagePercent: input.SubscriptionUsagePercent,
+		EmailEnabled:             input.EmailEnabled,
+		WebhookURL:               webhookURL,
+	}
+	if err := s.repo.UpsertSettings(ctx, settings); err != nil {
+		return nil, fmt.Errorf("update notification settings: %w", err)
+	}
+	s.settingsCache.Delete(userID)
+	return settings, nil
+}
+
+func (s *UserNotificationService) getCachedSettings(ctx context.Context, userID int64) (*UserNotificationSettings, error) {
+	now := time.Now()
+	if v, ok := s.settingsCache.Load(userID); ok {
+		if cached := v.(cachedUserNotificationSettings); now.Before(cached.expiresAt) {
+			return cached.settings, nil
+		}
+	}
+	settings, err := s.repo.GetSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	s.settingsCache.Store(userID, cachedUserNotificationSettings{settings: settings, expiresAt: now.Add(userNotificationSettingsTTL)})
+	return settings, nil
+}
@@ -0,0 +1,127 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type userNotificationRepoStub struct {
+	settings *UserNotificationSettings
+	claimed  map[string]bool
+	gets     int
+}
+
+func (s *userNotificationRepoStub) GetSettings(context.Context, int64) (*UserNotificationSettings, error) {
+	s.gets++
+	return s.settings, nil
+}
+
+func (s *userNotificationRepoStub) UpsertSettings(_ context.Context, settings *UserNotificationSettings) error {
+	s.settings = settings
+	return nil
+}
+
+func (s *userNotificationRepoStub) ClaimEvent(_ context.Context, userID int64, kind, dedupKey string) (bool, error) {
+	if s.claimed == nil {
+		s.claimed = map[string]bool{}
+	}
+	key := fmt.Sprintf("%d|%s|%s", userID, kind, dedupKey)
+	if s.claimed[key] {
+		return false, nil
+	}
+	s.claimed[key] = true
+	return true, nil
+}
+
+type userNotificationWebhookStub struct {
+	sent []*UserNotificationPayload
+}
+
+func (s *userNotificationWebhookStub) Send(_ context.Context, _ string, payload *UserNotificationPayload) error {
+	s.sent = append(s.sent, payload)
+	return nil
+}
+
+func TestUserNotificationService_LowBalanceDedupedPerDay(t *testing.T) {
+	threshold := 5.0
+	repo := &userNotificationRepoStub{settings: &UserNotificationSettings{
+		UserID:           1,
+		BalanceThreshold: &threshold,
+		WebhookURL:       "https://hooks.example.com/notify",
+	}}
+	webhook := &userNotificationWebhookStub{}
+	svc := NewUserNotificationService(repo, webhook, nil, nil, nil)
+
+	above, below := 10.0, 3.0
+	svc.Evaluate(context.Background(), UsageNotificationInput{UserID: 1, BalanceAfter: &above})
+	require.Empty(t, webhook.sent)
+
+	svc.Evaluate(context.Background(), UsageNotificationInput{UserID: 1, BalanceAfter: &below})
+	svc.Evaluate(context.Background(), UsageNotificationInput{UserID: 1, BalanceAfter: &below})
+	require.Len(t, webhook.sent, 1)
+	require.Equal(t, UserNotificationLowBalance, webhook.sent[0].Event)
+	require.Equal(t, below, webhook.sent[0].Value)
+	require.Equal(t, 1, repo.gets, "settings should be cached")
+}
+
+func TestUserNotificationService_SubscriptionUsagePercent(t *testing.T) {
+	percent := 80.0
+	repo := &userNotificationRepoStub{settings: &UserNotificationSettings{
+		UserID:                   1,
+		SubscriptionUsagePercent: &percent,
+		WebhookURL:               "https://hooks.example.com/notify",
+	}}
+	webhook := &userNotificationWebhookStub{}
+	svc := NewUserNotificationService(repo, webhook, nil, nil, nil)
+
+	daily, weekly := 10.0, 100.0
+	windowStart := time.Now().Add(-time.Hour)
+	group := &Group{ID: 3, Name: "pro", DailyLimitUSD: &daily, WeeklyLimitUSD: &weekly}
+	sub := &UserSubscription{ID: 9, DailyWindowStart: &windowStart, WeeklyWindowStart: &windowStart, DailyUsageUSD: 7.5, WeeklyUsageUSD: 7.5}
+
+	svc.Evaluate(context.Background(), UsageNotificationInput{UserID: 1, Subscription: sub, Group: group, SubscriptionCost: 1})
+	require.Len(t, webhook.sent, 1)
+	require.Equal(t, UserNotificationSubscriptionDaily, webhook.sent[0].Event)
+	require.InDelta(t, 85.0, webhook.sent[0].Value, 1e-9)
+
+	// 同一窗口不重复通知；窗口重置后重新计算
+	svc.Evaluate(context.Background(), UsageNotificationInput{UserID: 1, Subscription: sub, Group: group, SubscriptionCost: 1})
+	require.Len(t, webhook.sent, 1)
+
+	newWindow := windowStart.Add(time.Minute)
+	sub.DailyWindowStart = &newWindow
+	svc.Evaluate(context.Background(), UsageNotificationInput{UserID: 1, Subscription: sub, Group: group, SubscriptionCost: 1})
+	require.Len(t, webhook.sent, 2)
+}
+
+func TestUserNotificationService_UpdateSettingsValidation(t *testing.T) {
+	repo := &userNotificationRepoStub{}
+	svc := NewUserNotificationService(repo, nil, nil, nil, nil)
+
+	negative, over := -1.0, 120.0
+	_, err := svc.UpdateSettings(context.Background(), 1, UpdateUserNotificationSettingsInput{BalanceThreshold: &negative})
+	require.ErrorIs(t, err, ErrInvalidNotificationThreshold)
+	_, err = svc.UpdateSettings(context.Background(), 1, UpdateUserNotificationSettingsInput{SubscriptionUsagePercent: &over})
+	require.ErrorIs(t, err, ErrInvalidNotificationThreshold)
+
+	for _, url := range []string{"http://hooks.example.com", "https://127.0.0.1/hook", "https://localhost/hook"} {
+		_, err = svc.UpdateSettings(context.Background(), 1, UpdateUserNotificationSettingsInput{WebhookURL: url})
+		require.ErrorIs(t, err, ErrInvalidNotificationWebhook, url)
+	}
+
+	threshold := 2.0
+	settings, err := svc.UpdateSettings(context.Background(), 1, UpdateUserNotificationSettingsInput{
+		BalanceThreshold: &threshold,
+		EmailEnabled:     true,
+		WebhookURL:       "https://hooks.example.com/notify/",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "https://hooks.example.com/notify", settings.WebhookURL)
+	require.Same(t, settings, repo.settings)
+}
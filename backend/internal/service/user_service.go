@@ -35,6 +35,8 @@ type UserRepository interface {
 
 	UpdateBalance(ctx context.Context, id int64, amount float64) error
 	DeductBalance(ctx context.Context, id int64, amount float64) error
+	// ApplyBalanceChange 变更余额并原子写入余额流水（带类型与关联引用）
+	ApplyBalanceChange(ctx context.Context, change *BalanceChange) (*BalanceTransaction, error)
 	UpdateConcurrency(ctx context.Context, id int64, amount int) error
 	ExistsByEmail(ctx context.Context, email string) (bool, error)
 	RemoveGroupFromAllowedGroups(ctx context.Context, groupID int64) (int64, error)
@@ -172,7 +174,7 @@ func (s *UserService) List(ctx context.Context, params pagination.PaginationPara
 
 // UpdateBalance 更新用户余额（管理员功能）
 func (s *UserService) UpdateBalance(ctx context.Context, userID int64, amount float64) error {
-	if err := s.userRepo.UpdateBalance(ctx, userID, amount); err != nil {
+	if _, err := s.userRepo.ApplyBalanceChange(ctx, &BalanceChange{UserID: userID, Type: BalanceTxTypeAdmin, Amount: amount}); err != nil {
 		return fmt.Errorf("update balance: %w", err)
 	}
 	if s.authCacheInvalidator != nil {
@@ -23,6 +23,19 @@ type UserSubscription struct {
 	AssignedAt time.Time
 	Notes      string
 
+	// PlanID 通过套餐购买时关联的套餐；限额字段为购买时的套餐限额快照，非空时覆盖分组限额
+	PlanID          *int64
+	AutoRenew       bool
+	DailyLimitUSD   *float64
+	WeeklyLimitUSD  *float64
+	MonthlyLimitUSD *float64
+
+	// QuotaUsage 请求数/Token 配额用量，key 见 QuotaUsageKey
+	QuotaUsage map[string]QuotaCounter
+
+	// PausedAt 暂停时间（仅 paused 状态非空），恢复时按暂停时长顺延 ExpiresAt
+	PausedAt *time.Time
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 
@@ -95,7 +108,26 @@ func (s *UserSubscription) MonthlyResetTime() *time.Time {
 	return &t
 }
 
+// EffectiveGroup 返回应用订阅级限额覆盖后的分组；无覆盖时直接返回原分组
+func (s *UserSubscription) EffectiveGroup(group *Group) *Group {
+	if s == nil || group == nil || (s.DailyLimitUSD == nil && s.WeeklyLimitUSD == nil && s.MonthlyLimitUSD == nil) {
+		return group
+	}
+	out := *group
+	if s.DailyLimitUSD != nil {
+		out.DailyLimitUSD = s.DailyLimitUSD
+	}
+	if s.WeeklyLimitUSD != nil {
+		out.WeeklyLimitUSD = s.WeeklyLimitUSD
+	}
+	if s.MonthlyLimitUSD != nil {
+		out.MonthlyLimitUSD = s.MonthlyLimitUSD
+	}
+	return &out
+}
+
 func (s *UserSubscription) CheckDailyLimit(group *Group, additionalCost float64) bool {
+	group = s.EffectiveGroup(group)
 	if !group.HasDailyLimit() {
 		return true
 	}
@@ -103,6 +135,7 @@ func (s *UserSubscription) CheckDailyLimit(group *Group, additionalCost float64)
 }
 
 func (s *UserSubscription) CheckWeeklyLimit(group *Group, additionalCost float64) bool {
+	group = s.EffectiveGroup(group)
 	if !group.HasWeeklyLimit() {
 		return true
 	}
@@ -110,6 +143,7 @@ func (s *UserSubscription) CheckWeeklyLimit(group *Group, additionalCost float64
 }
 
 func (s *UserSubscription) CheckMonthlyLimit(group *Group, additionalCost float64) bool {
+	group = s.EffectiveGroup(group)
 	if !group.HasMonthlyLimit() {
 		return true
 	}
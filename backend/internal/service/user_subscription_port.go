@@ -24,12 +24,23 @@ type UserSubscriptionRepository interface {
 	ExtendExpiry(ctx context.Context, subscriptionID int64, newExpiresAt time.Time) error
 	UpdateStatus(ctx context.Context, subscriptionID int64, status string) error
 	UpdateNotes(ctx context.Context, subscriptionID int64, notes string) error
+	// ApplyPlan 写入套餐购买/续费结果（有效期、状态、套餐、限额快照与自动续费开关），不修改用量字段
+	ApplyPlan(ctx context.Context, sub *UserSubscription) error
+	UpdateAutoRenew(ctx context.Context, subscriptionID int64, autoRenew bool) error
+	// Pause 将 active 订阅标记为暂停；订阅已不是 active 状态时返回 false
+	Pause(ctx context.Context, subscriptionID int64, pausedAt time.Time) (bool, error)
+	// Resume 恢复 paused 订阅并写入顺延后的到期时间；订阅已不是 paused 状态时返回 false
+	Resume(ctx context.Context, subscriptionID int64, expiresAt time.Time) (bool, error)
+	// Transfer 将订阅移交给 toUserID 并关闭自动续费（避免向接收人扣费）；持有人已不是 fromUserID 时返回 false
+	Transfer(ctx context.Context, subscriptionID, fromUserID, toUserID int64) (bool, error)
 
 	ActivateWindows(ctx context.Context, id int64, start time.Time) error
 	ResetDailyUsage(ctx context.Context, id int64, newWindowStart time.Time) error
 	ResetWeeklyUsage(ctx context.Context, id int64, newWindowStart time.Time) error
 	ResetMonthlyUsage(ctx context.Context, id int64, newWindowStart time.Time) error
 	IncrementUsage(ctx context.Context, id int64, costUSD float64) error
+	// IncrementQuotaUsage 累加请求数/Token 配额用量（models 为与请求模型匹配的配额模型模式）
+	IncrementQuotaUsage(ctx context.Context, id int64, models []string, delta QuotaCounter) error
 
 	BatchUpdateExpiredStatus(ctx context.Context) (int64, error)
 }
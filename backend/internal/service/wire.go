@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"time"
 
+	dbent "github.com/Wei-Shaw/sub2api/ent"
 	"github.com/Wei-Shaw/sub2api/internal/config"
 	"github.com/google/wire"
 	"github.com/redis/go-redis/v9"
@@ -65,6 +66,181 @@ func ProvideUsageCleanupService(repo UsageCleanupRepository, timingWheel *Timing
 	return svc
 }
 
+// ProvideUsageRerateService 创建并启动使用记录重新计费任务服务
+func ProvideUsageRerateService(
+	repo UsageRerateRepository,
+	billingService *BillingService,
+	groupRepo GroupRepository,
+	userRepo UserRepository,
+	entClient *dbent.Client,
+	billingCacheService *BillingCacheService,
+	authCacheInvalidator APIKeyAuthCacheInvalidator,
+	timingWheel *TimingWheelService,
+	dashboardAgg *DashboardAggregationService,
+	cfg *config.Config,
+) *UsageRerateService {
+	svc := NewUsageRerateService(repo, billingService, groupRepo, userRepo, entClient, billingCacheService, authCacheInvalidator, timingWheel, dashboardAgg, cfg)
+	svc.Start()
+	return svc
+}
+
+// ProvideAccountHealthProbeService 创建并启动账号后台健康探测服务
+func ProvideAccountHealthProbeService(
+	accountRepo AccountRepository,
+	probeRepo AccountProbeRepository,
+	testService *AccountTestService,
+	rateLimitService *RateLimitService,
+	timingWheel *TimingWheelService,
+	db *sql.DB,
+	cfg *config.Config,
+) *AccountHealthProbeService {
+	svc := NewAccountHealthProbeService(accountRepo, probeRepo, testService, rateLimitService, timingWheel, db, cfg)
+	svc.Start()
+	return svc
+}
+
+// ProvideGroupPoolService 创建并启动分组子池灰度回滚检查
+func ProvideGroupPoolService(
+	groupRepo GroupRepository,
+	poolRepo AccountPoolRepository,
+	opsService *OpsService,
+	authCacheInvalidator APIKeyAuthCacheInvalidator,
+	timingWheel *TimingWheelService,
+	db *sql.DB,
+	cfg *config.Config,
+) *GroupPoolService {
+	svc := NewGroupPoolService(groupRepo, poolRepo, opsService, authCacheInvalidator, timingWheel, db, cfg)
+	svc.Start()
+	return svc
+}
+
+// ProvideAccountAvailabilityService 创建并启动账号可用时间窗口边界检查
+func ProvideAccountAvailabilityService(
+	repo AccountAvailabilityRepository,
+	timingWheel *TimingWheelService,
+	db *sql.DB,
+) *AccountAvailabilityService {
+	svc := NewAccountAvailabilityService(repo, timingWheel, db)
+	svc.Start()
+	return svc
+}
+
+// ProvideBalanceLedgerService 创建余额流水服务并启动对账任务
+func ProvideBalanceLedgerService(
+	txRepo BalanceTransactionRepository,
+	opsRepo OpsRepository,
+	timingWheel *TimingWheelService,
+	db *sql.DB,
+) *BalanceLedgerService {
+	svc := NewBalanceLedgerService(txRepo, opsRepo, timingWheel, db)
+	svc.Start()
+	return svc
+}
+
+// ProvidePaymentService 创建支付服务并启动订单过期处理任务
+func ProvidePaymentService(
+	orderRepo PaymentOrderRepository,
+	userRepo UserRepository,
+	providers PaymentProviders,
+	cfg *config.Config,
+	entClient *dbent.Client,
+	billingCacheService *BillingCacheService,
+	authCacheInvalidator APIKeyAuthCacheInvalidator,
+	promoService *PromoService,
+	timingWheel *TimingWheelService,
+	db *sql.DB,
+) *PaymentService {
+	svc := NewPaymentService(orderRepo, userRepo, providers, cfg, entClient, billingCacheService, authCacheInvalidator, promoService, timingWheel, db)
+	svc.Start()
+	return svc
+}
+
+// ProvideCreditLotService 创建额度批次服务并启动到期处理任务
+func ProvideCreditLotService(
+	repo CreditLotRepository,
+	billingCacheService *BillingCacheService,
+	authCacheInvalidator APIKeyAuthCacheInvalidator,
+	timingWheel *TimingWheelService,
+	db *sql.DB,
+) *CreditLotService {
+	svc := NewCreditLotService(repo, billingCacheService, authCacheInvalidator, timingWheel, db)
+	svc.Start()
+	return svc
+}
+
+// ProvideSubscriptionPlanService 创建订阅套餐服务并启动自动续费任务
+func ProvideSubscriptionPlanService(
+	planRepo SubscriptionPlanRepository,
+	groupRepo GroupRepository,
+	userSubRepo UserSubscriptionRepository,
+	userRepo UserRepository,
+	entClient *dbent.Client,
+	billingCacheService *BillingCacheService,
+	authCacheInvalidator APIKeyAuthCacheInvalidator,
+	notificationService *UserNotificationService,
+	timingWheel *TimingWheelService,
+	db *sql.DB,
+) *SubscriptionPlanService {
+	svc := NewSubscriptionPlanService(planRepo, groupRepo, userSubRepo, userRepo, entClient, billingCacheService, authCacheInvalidator, notificationService, timingWheel, db)
+	svc.Start()
+	return svc
+}
+
+// ProvideUserNotificationService 创建用户阈值通知服务并启动检查工作池
+func ProvideUserNotificationService(
+	repo UserNotificationRepository,
+	webhookSender UserNotificationWebhookSender,
+	userRepo UserRepository,
+	emailQueue *EmailQueueService,
+	settingService *SettingService,
+) *UserNotificationService {
+	svc := NewUserNotificationService(repo, webhookSender, userRepo, emailQueue, settingService)
+	svc.Start()
+	return svc
+}
+
+// ProvideModelPriceOverrideService 创建模型价格覆盖服务并加载快照
+func ProvideModelPriceOverrideService(
+	repo ModelPriceOverrideRepository,
+	groupRepo GroupRepository,
+	timingWheel *TimingWheelService,
+) *ModelPriceOverrideService {
+	svc := NewModelPriceOverrideService(repo, groupRepo, timingWheel)
+	svc.Start()
+	return svc
+}
+
+// ProvideStatementService 创建月度账单服务并启动生成任务
+func ProvideStatementService(
+	repo StatementRepository,
+	userRepo UserRepository,
+	settingService *SettingService,
+	emailQueue *EmailQueueService,
+	cfg *config.Config,
+	timingWheel *TimingWheelService,
+	db *sql.DB,
+) *StatementService {
+	svc := NewStatementService(repo, userRepo, settingService, emailQueue, cfg, timingWheel, db)
+	svc.Start()
+	return svc
+}
+
+// ProvideReferralService 创建邀请返佣服务并启动佣金结算任务
+func ProvideReferralService(
+	repo ReferralRepository,
+	userRepo UserRepository,
+	billingCacheService *BillingCacheService,
+	authCacheInvalidator APIKeyAuthCacheInvalidator,
+	entClient *dbent.Client,
+	cfg *config.Config,
+	timingWheel *TimingWheelService,
+	db *sql.DB,
+) *ReferralService {
+	svc := NewReferralService(repo, userRepo, billingCacheService, authCacheInvalidator, entClient, cfg, timingWheel, db)
+	svc.Start()
+	return svc
+}
+
 // ProvideAccountExpiryService creates and starts AccountExpiryService.
 func ProvideAccountExpiryService(accountRepo AccountRepository) *AccountExpiryService {
 	svc := NewAccountExpiryService(accountRepo, time.Minute)
@@ -129,6 +305,23 @@ func ProvideRateLimitService(
 	return svc
 }
 
+// ProvideOrganizationService creates OrganizationService and registers it as the
+// organization billing resolver for API key authentication.
+func ProvideOrganizationService(
+	orgRepo OrganizationRepository,
+	userRepo UserRepository,
+	groupRepo GroupRepository,
+	userSubRepo UserSubscriptionRepository,
+	apiKeyService *APIKeyService,
+	planService *SubscriptionPlanService,
+	billingCacheService *BillingCacheService,
+	entClient *dbent.Client,
+) *OrganizationService {
+	svc := NewOrganizationService(orgRepo, userRepo, groupRepo, userSubRepo, apiKeyService, planService, billingCacheService, entClient)
+	apiKeyService.SetOrganizationBillingResolver(svc)
+	return svc
+}
+
 // ProvideOpsMetricsCollector creates and starts OpsMetricsCollector.
 func ProvideOpsMetricsCollector(
 	opsRepo OpsRepository,
@@ -213,13 +406,17 @@ var ProviderSet = wire.NewSet(
 	NewAccountService,
 	NewProxyService,
 	NewRedeemService,
+	NewRedeemBatchService,
 	NewPromoService,
+	NewProfitService,
 	NewUsageService,
 	NewDashboardService,
 	ProvidePricingService,
 	NewBillingService,
 	NewBillingCacheService,
+	NewBalanceReservationService,
 	NewAdminService,
+	NewAccountSpendCapService,
 	NewGatewayService,
 	NewOpenAIGatewayService,
 	NewOAuthService,
@@ -256,9 +453,22 @@ var ProviderSet = wire.NewSet(
 	ProvideUpdateService,
 	ProvideTokenRefreshService,
 	ProvideAccountExpiryService,
+	ProvideAccountHealthProbeService,
+	ProvideBalanceLedgerService,
+	ProvideCreditLotService,
+	ProvideSubscriptionPlanService,
+	ProvidePaymentService,
+	ProvideOrganizationService,
+	ProvideStatementService,
+	ProvideReferralService,
+	ProvideUserNotificationService,
+	ProvideModelPriceOverrideService,
+	ProvideGroupPoolService,
+	ProvideAccountAvailabilityService,
 	ProvideTimingWheelService,
 	ProvideDashboardAggregationService,
 	ProvideUsageCleanupService,
+	ProvideUsageRerateService,
 	ProvideDeferredService,
 	NewAntigravityQuotaFetcher,
 	NewUserAttributeService,
@@ -0,0 +1,23 @@
+-- 044_add_account_probe_logs.sql
+-- 账号后台健康探测历史
+
+CREATE TABLE IF NOT EXISTS account_probe_logs (
+    id BIGSERIAL PRIMARY KEY,
+    account_id BIGINT NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    platform VARCHAR(50) NOT NULL,
+    model VARCHAR(100) NOT NULL DEFAULT '',
+    trigger_type VARCHAR(20) NOT NULL DEFAULT 'scheduled',
+    success BOOLEAN NOT NULL,
+    status_code INT,
+    latency_ms INT NOT NULL DEFAULT 0,
+    error_message TEXT,
+    -- action: none/marked_error/rate_limited/temp_unschedulable/recovered
+    action VARCHAR(20) NOT NULL DEFAULT 'none',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_account_probe_logs_account_created_at
+    ON account_probe_logs(account_id, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_account_probe_logs_created_at
+    ON account_probe_logs(created_at);
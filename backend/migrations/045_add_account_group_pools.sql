@@ -0,0 +1,14 @@
+-- 045_add_account_group_pools.sql
+-- 分组内子池（灰度池）流量分配
+
+-- 账号在分组内所属的子池标签
+ALTER TABLE account_groups ADD COLUMN IF NOT EXISTS pool VARCHAR(50) NOT NULL DEFAULT 'default';
+
+CREATE INDEX IF NOT EXISTS accountgroup_group_id_pool
+    ON account_groups(group_id, pool);
+
+-- 分组级分流配置
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS pool_split_enabled BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS pool_weights JSONB;
+-- 灰度池错误率阈值（0-1），为空表示不自动回滚
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS canary_max_error_rate DECIMAL(5,4);
@@ -0,0 +1,10 @@
+-- 046_add_group_capacity_fallback.sql
+-- 分组容量降级链：无可调度账号或切换次数耗尽时按顺序尝试降级分组
+
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS capacity_fallback_group_ids JSONB;
+-- 作为降级分组承接请求时的模型映射（跨平台降级时用于模型转换）
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS fallback_model_mapping JSONB;
+
+-- 使用记录中的降级信息：group_id 为实际承接分组，original_group_id 为 API Key 所属分组
+ALTER TABLE usage_logs ADD COLUMN IF NOT EXISTS original_group_id BIGINT;
+ALTER TABLE usage_logs ADD COLUMN IF NOT EXISTS fallback_hop INT NOT NULL DEFAULT 0;
@@ -0,0 +1,7 @@
+-- 047_add_account_availability_windows.sql
+-- 账号可用时间窗口：窗口外账号不参与调度（例如仅夜间和周末对网关开放）
+
+-- 窗口列表，例如 ["mon-fri 19:00-08:00", "sat,sun"]；为空表示全天可用
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS availability_windows JSONB;
+-- 窗口使用的 IANA 时区，为空表示服务器时区
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS availability_timezone VARCHAR(64);
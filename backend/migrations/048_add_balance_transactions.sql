@@ -0,0 +1,30 @@
+-- 048_add_balance_transactions.sql
+-- 余额流水账本：users.balance 的每次变动都在同一语句中追加一条不可变记录
+
+CREATE TABLE IF NOT EXISTS balance_transactions (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    -- type: opening/usage/redeem/promo/admin/adjustment
+    type VARCHAR(20) NOT NULL,
+    amount DECIMAL(20, 8) NOT NULL,
+    balance_after DECIMAL(20, 8) NOT NULL,
+    usage_log_id BIGINT,
+    redeem_code_id BIGINT,
+    promo_code_id BIGINT,
+    notes TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_balance_transactions_user_created_at
+    ON balance_transactions(user_id, created_at DESC, id DESC);
+
+CREATE INDEX IF NOT EXISTS idx_balance_transactions_usage_log_id
+    ON balance_transactions(usage_log_id)
+    WHERE usage_log_id IS NOT NULL;
+
+-- 期初余额：为已有余额的用户写入一条 opening 记录，使流水合计与 users.balance 对齐
+INSERT INTO balance_transactions (user_id, type, amount, balance_after, notes, created_at)
+SELECT u.id, 'opening', u.balance, u.balance, 'opening balance', NOW()
+FROM users u
+WHERE u.balance <> 0
+  AND NOT EXISTS (SELECT 1 FROM balance_transactions bt WHERE bt.user_id = u.id);
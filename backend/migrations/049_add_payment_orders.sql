@@ -0,0 +1,32 @@
+-- 049_add_payment_orders.sql
+-- 在线支付充值订单：渠道回调验签通过后将订单置为 paid 并写入 payment 类型余额流水
+
+CREATE TABLE IF NOT EXISTS payment_orders (
+    id BIGSERIAL PRIMARY KEY,
+    order_no VARCHAR(64) NOT NULL UNIQUE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    -- provider: epay/stripe
+    provider VARCHAR(20) NOT NULL,
+    -- amount: 支付金额（渠道货币）；credit_amount: 到账余额（美元）
+    amount DECIMAL(20, 8) NOT NULL,
+    currency VARCHAR(10) NOT NULL,
+    credit_amount DECIMAL(20, 8) NOT NULL,
+    -- status: pending/paid/failed/expired
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    provider_trade_no VARCHAR(128),
+    pay_url TEXT,
+    paid_at TIMESTAMPTZ,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_payment_orders_user_created_at
+    ON payment_orders(user_id, created_at DESC, id DESC);
+
+CREATE INDEX IF NOT EXISTS idx_payment_orders_pending_expires_at
+    ON payment_orders(expires_at)
+    WHERE status = 'pending';
+
+ALTER TABLE balance_transactions
+    ADD COLUMN IF NOT EXISTS payment_order_id BIGINT;
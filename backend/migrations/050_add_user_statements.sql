@@ -0,0 +1,20 @@
+-- 050_add_user_statements.sql
+-- 月度账单：按用户与账期（YYYY-MM）存储汇总数据及渲染后的 HTML/PDF
+
+CREATE TABLE IF NOT EXISTS user_statements (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    period VARCHAR(7) NOT NULL,
+    period_start TIMESTAMPTZ NOT NULL,
+    period_end TIMESTAMPTZ NOT NULL,
+    summary JSONB NOT NULL DEFAULT '{}'::jsonb,
+    html TEXT NOT NULL,
+    pdf BYTEA NOT NULL,
+    generated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    emailed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (user_id, period)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_statements_period
+    ON user_statements(period);
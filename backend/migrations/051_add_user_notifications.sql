@@ -0,0 +1,28 @@
+-- 051_add_user_notifications.sql
+-- 用户余额/订阅用量阈值通知：用户配置阈值与通知渠道，已发送事件按周期去重
+
+CREATE TABLE IF NOT EXISTS user_notification_settings (
+    user_id BIGINT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    -- balance_threshold: 余额低于该值时通知；NULL 表示不启用
+    balance_threshold DECIMAL(20, 8),
+    -- subscription_usage_percent: 订阅日/周/月用量达到限额的百分比时通知；NULL 表示不启用
+    subscription_usage_percent DECIMAL(5, 2),
+    email_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    webhook_url TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS user_notification_events (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    -- kind: low_balance/subscription_daily/subscription_weekly/subscription_monthly
+    kind VARCHAR(32) NOT NULL,
+    -- dedup_key: 周期标识（余额为自然日，订阅为订阅 ID + 窗口起点）
+    dedup_key VARCHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (user_id, kind, dedup_key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_notification_events_created_at
+    ON user_notification_events(created_at);
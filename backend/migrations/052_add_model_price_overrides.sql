@@ -0,0 +1,23 @@
+-- 052_add_model_price_overrides.sql
+-- 管理员维护的模型价格覆盖：优先于 LiteLLM 远程价格与硬编码回退价格
+-- 价格单位：token 类为 USD / 百万 token，图片为 USD / 张；NULL 表示沿用上游价格
+
+CREATE TABLE IF NOT EXISTS model_price_overrides (
+    id BIGSERIAL PRIMARY KEY,
+    model VARCHAR(200) NOT NULL,
+    -- group_id 为 NULL 表示全局覆盖；分组级覆盖优先于全局覆盖
+    group_id BIGINT REFERENCES groups(id) ON DELETE CASCADE,
+    input_price DECIMAL(20, 8),
+    output_price DECIMAL(20, 8),
+    cache_write_5m_price DECIMAL(20, 8),
+    cache_write_1h_price DECIMAL(20, 8),
+    cache_read_price DECIMAL(20, 8),
+    image_price DECIMAL(20, 8),
+    effective_from TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    notes TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_model_price_overrides_scope_effective
+    ON model_price_overrides(model, COALESCE(group_id, 0), effective_from);
@@ -0,0 +1,56 @@
+-- 053_add_usage_pricing_snapshot_and_rerate.sql
+-- 1) usage_logs 记录计费时的价格版本与单价，便于价格修正后定位按旧价格计费的记录
+-- 2) 重新计费任务：按过滤条件在新价格下重算费用，生成按用户汇总的差异报告，可选回写并调整余额
+
+-- 单价单位：token 类为 USD / 百万 token，图片为 USD / 张；历史记录为 NULL
+ALTER TABLE usage_logs ADD COLUMN IF NOT EXISTS pricing_version VARCHAR(64);
+ALTER TABLE usage_logs ADD COLUMN IF NOT EXISTS input_unit_price DECIMAL(20, 10);
+ALTER TABLE usage_logs ADD COLUMN IF NOT EXISTS output_unit_price DECIMAL(20, 10);
+ALTER TABLE usage_logs ADD COLUMN IF NOT EXISTS cache_creation_unit_price DECIMAL(20, 10);
+ALTER TABLE usage_logs ADD COLUMN IF NOT EXISTS cache_read_unit_price DECIMAL(20, 10);
+ALTER TABLE usage_logs ADD COLUMN IF NOT EXISTS image_unit_price DECIMAL(20, 10);
+
+CREATE TABLE IF NOT EXISTS usage_rerate_tasks (
+    id BIGSERIAL PRIMARY KEY,
+    status VARCHAR(20) NOT NULL,
+    filters JSONB NOT NULL,
+    -- apply_changes: 是否将重算结果回写 usage_logs；为 false 时仅生成差异报告
+    apply_changes BOOLEAN NOT NULL DEFAULT FALSE,
+    -- post_adjustments: 是否按余额计费差额写入余额调整流水（要求 apply_changes）
+    post_adjustments BOOLEAN NOT NULL DEFAULT FALSE,
+    -- last_usage_log_id: 已处理到的 usage_logs.id（按 id 递增分批，用于断点续跑）
+    last_usage_log_id BIGINT NOT NULL DEFAULT 0,
+    processed_rows BIGINT NOT NULL DEFAULT 0,
+    changed_rows BIGINT NOT NULL DEFAULT 0,
+    old_total_cost DECIMAL(20, 10) NOT NULL DEFAULT 0,
+    new_total_cost DECIMAL(20, 10) NOT NULL DEFAULT 0,
+    old_actual_cost DECIMAL(20, 10) NOT NULL DEFAULT 0,
+    new_actual_cost DECIMAL(20, 10) NOT NULL DEFAULT 0,
+    error_message TEXT,
+    created_by BIGINT NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    canceled_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    canceled_at TIMESTAMPTZ,
+    started_at TIMESTAMPTZ,
+    finished_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_usage_rerate_tasks_status_created_at
+    ON usage_rerate_tasks(status, created_at);
+
+-- 差异报告：按用户汇总；balance_delta 为余额计费记录的 旧实际费用 - 新实际费用（正数表示应退还）
+CREATE TABLE IF NOT EXISTS usage_rerate_diffs (
+    task_id BIGINT NOT NULL REFERENCES usage_rerate_tasks(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+    row_count BIGINT NOT NULL DEFAULT 0,
+    changed_rows BIGINT NOT NULL DEFAULT 0,
+    old_total_cost DECIMAL(20, 10) NOT NULL DEFAULT 0,
+    new_total_cost DECIMAL(20, 10) NOT NULL DEFAULT 0,
+    old_actual_cost DECIMAL(20, 10) NOT NULL DEFAULT 0,
+    new_actual_cost DECIMAL(20, 10) NOT NULL DEFAULT 0,
+    balance_delta DECIMAL(20, 10) NOT NULL DEFAULT 0,
+    adjustment_tx_id BIGINT,
+    adjusted_at TIMESTAMPTZ,
+    PRIMARY KEY (task_id, user_id)
+);
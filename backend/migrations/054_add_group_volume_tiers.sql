@@ -0,0 +1,8 @@
+-- 054_add_group_volume_tiers.sql
+-- 分组阶梯折扣：按用户近 30 天消费（actual_cost）分档，对分组倍率叠加折扣倍率
+-- volume_tiers 示例：[{"min_spend":0,"rate_multiplier":1},{"min_spend":100,"rate_multiplier":0.9},{"min_spend":1000,"rate_multiplier":0.8}]
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS volume_tiers JSONB;
+
+-- usage_logs 记录命中的档位（rate_multiplier 为叠加折扣后的实际倍率）；未命中为 NULL
+ALTER TABLE usage_logs ADD COLUMN IF NOT EXISTS volume_tier_min_spend DECIMAL(20, 8);
+ALTER TABLE usage_logs ADD COLUMN IF NOT EXISTS volume_tier_multiplier DECIMAL(10, 4);
@@ -0,0 +1,8 @@
+-- 055_add_api_key_limits.sql
+-- API Key 级别的消费与请求次数限额（NULL 表示不限制）
+-- 消费按 usage_logs.actual_cost 统计；日/月窗口按系统时区自然日/自然月计算，累计限额按该 Key 全部使用记录计算
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS daily_limit_usd DECIMAL(20, 8);
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS monthly_limit_usd DECIMAL(20, 8);
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS total_limit_usd DECIMAL(20, 8);
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS daily_request_limit BIGINT;
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS monthly_request_limit BIGINT;
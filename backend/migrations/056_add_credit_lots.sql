@@ -0,0 +1,45 @@
+-- 056_add_credit_lots.sql
+-- 余额额度批次：每笔入账形成一个批次（来源 + 到期时间），消费优先扣减最早到期的批次。
+-- users.balance 仍为余额权威值；未过期批次剩余额度合计 = GREATEST(users.balance, 0)
+-- （透支时批次已耗尽，后续入账先抵扣欠款，剩余部分计入新批次）。
+
+CREATE TABLE IF NOT EXISTS credit_lots (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    -- source: 与产生该批次的余额流水类型一致（opening/redeem/promo/payment/admin/adjustment）
+    source VARCHAR(20) NOT NULL,
+    amount DECIMAL(20, 8) NOT NULL,
+    remaining DECIMAL(20, 8) NOT NULL,
+    -- NULL 表示永不过期
+    expires_at TIMESTAMPTZ,
+    -- 过期任务处理时间（剩余额度已通过 expiry 流水扣除）
+    expired_at TIMESTAMPTZ,
+    balance_transaction_id BIGINT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- 消费扣减：按用户查找有剩余额度的批次
+CREATE INDEX IF NOT EXISTS idx_credit_lots_user_open
+    ON credit_lots(user_id, expires_at, id)
+    WHERE remaining > 0 AND expired_at IS NULL;
+
+-- 过期任务：查找已到期未处理的批次
+CREATE INDEX IF NOT EXISTS idx_credit_lots_due
+    ON credit_lots(expires_at)
+    WHERE expires_at IS NOT NULL AND expired_at IS NULL AND remaining > 0;
+
+-- 过期流水关联的批次
+ALTER TABLE balance_transactions ADD COLUMN IF NOT EXISTS credit_lot_id BIGINT;
+
+-- 兑换码 / 优惠码发放额度的有效期（天），0 表示永不过期
+ALTER TABLE redeem_codes ADD COLUMN IF NOT EXISTS credit_validity_days INT NOT NULL DEFAULT 0;
+ALTER TABLE promo_codes ADD COLUMN IF NOT EXISTS credit_validity_days INT NOT NULL DEFAULT 0;
+
+-- 期初批次：已有正余额的用户写入一个永不过期的 opening 批次
+INSERT INTO credit_lots (user_id, source, amount, remaining, created_at, updated_at)
+SELECT u.id, 'opening', u.balance, u.balance, NOW(), NOW()
+FROM users u
+WHERE u.balance > 0
+  AND u.deleted_at IS NULL
+  AND NOT EXISTS (SELECT 1 FROM credit_lots cl WHERE cl.user_id = u.id);
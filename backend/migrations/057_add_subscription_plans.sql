@@ -0,0 +1,34 @@
+-- 057_add_subscription_plans.sql
+-- 可购买的订阅套餐：用户用余额购买（/api/v1/subscriptions/purchase），支持到期前自动续费与升级折算。
+-- 套餐限额为空时沿用分组限额；购买时将限额快照写入 user_subscriptions，后续修改套餐不影响已购订阅（续费时刷新）。
+
+CREATE TABLE IF NOT EXISTS subscription_plans (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    group_id BIGINT NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+    duration_days INT NOT NULL,
+    price DECIMAL(20, 8) NOT NULL,
+    daily_limit_usd DECIMAL(20, 8),
+    weekly_limit_usd DECIMAL(20, 8),
+    monthly_limit_usd DECIMAL(20, 8),
+    -- status: active（可购买、可续费）/ disabled（下架，已开启自动续费的订阅到期后不再续费）
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    sort_order INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_subscription_plans_group_id ON subscription_plans(group_id);
+CREATE INDEX IF NOT EXISTS idx_subscription_plans_status ON subscription_plans(status, sort_order);
+
+ALTER TABLE user_subscriptions ADD COLUMN IF NOT EXISTS plan_id BIGINT;
+ALTER TABLE user_subscriptions ADD COLUMN IF NOT EXISTS auto_renew BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE user_subscriptions ADD COLUMN IF NOT EXISTS daily_limit_usd DECIMAL(20, 8);
+ALTER TABLE user_subscriptions ADD COLUMN IF NOT EXISTS weekly_limit_usd DECIMAL(20, 8);
+ALTER TABLE user_subscriptions ADD COLUMN IF NOT EXISTS monthly_limit_usd DECIMAL(20, 8);
+
+-- 自动续费任务：查找即将到期且开启自动续费的订阅
+CREATE INDEX IF NOT EXISTS idx_user_subscriptions_auto_renew
+    ON user_subscriptions(expires_at)
+    WHERE auto_renew = TRUE AND deleted_at IS NULL;
@@ -0,0 +1,9 @@
+-- 058_add_subscription_overage.sql
+-- 订阅超额计费：分组开启后，订阅日/周/月限额用尽的部分按超额倍率（作用于原始费用）从用户余额扣除，而不是直接拒绝请求。
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS overage_enabled BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS overage_rate_multiplier DECIMAL(10, 4) NOT NULL DEFAULT 1;
+
+-- usage_logs 记录拆分结果：subscription_cost 为计入订阅用量的原始费用，overage_cost 为从余额扣除的金额
+-- 未开启超额计费的分组两列均为 NULL
+ALTER TABLE usage_logs ADD COLUMN IF NOT EXISTS subscription_cost DECIMAL(20, 10);
+ALTER TABLE usage_logs ADD COLUMN IF NOT EXISTS overage_cost DECIMAL(20, 10);
@@ -0,0 +1,5 @@
+-- 059_add_rolling_window_limits.sql
+-- 订阅滚动窗口限额：在日/周/月自然窗口之外，按最近 N 小时的滑动窗口限制订阅用量（如 5 小时窗口），
+-- 避免用户在自然窗口重置边界附近短时间内耗尽整日额度。窗口用量按时间分桶存于 Redis。
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS rolling_window_hours INT NOT NULL DEFAULT 0;
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS rolling_window_limit_usd DECIMAL(20, 8);
@@ -0,0 +1,8 @@
+-- 060_add_usage_quotas.sql
+-- 请求数/Token 配额：分组按日/周/月窗口（可按模型模式）限制订阅的请求数与输入/输出 Token 数，
+-- 用于按次数售卖的套餐（如每日 500 次 Opus 请求）。
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS usage_quotas JSONB;
+
+-- 订阅的配额用量：key 为 "窗口:模型模式"（如 "daily:claude-opus-*"），value 为请求数与 Token 数，
+-- 窗口重置时与对应的 daily/weekly/monthly_usage_usd 在同一条 UPDATE 中清零
+ALTER TABLE user_subscriptions ADD COLUMN IF NOT EXISTS quota_usage JSONB;
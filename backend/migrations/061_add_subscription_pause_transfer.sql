@@ -0,0 +1,30 @@
+-- 061_add_subscription_pause_transfer.sql
+-- 订阅暂停/恢复/转让：暂停期间有效期停止计时（恢复时按暂停时长顺延 expires_at），
+-- 转让将订阅（含用量与窗口）移交给另一用户；所有操作记录到 subscription_events 审计表。
+
+ALTER TABLE user_subscriptions ADD COLUMN IF NOT EXISTS paused_at TIMESTAMPTZ;
+
+CREATE TABLE IF NOT EXISTS subscription_events (
+    id BIGSERIAL PRIMARY KEY,
+    subscription_id BIGINT NOT NULL,
+    -- action: pause / resume / transfer
+    action VARCHAR(20) NOT NULL,
+    -- 操作时的订阅持有人；转让时 to_user_id 为接收人
+    from_user_id BIGINT NOT NULL,
+    to_user_id BIGINT,
+    -- 操作人（管理员或订阅持有人本人）
+    operator_id BIGINT,
+    operator_role VARCHAR(20) NOT NULL,
+    -- 恢复时记录顺延后的到期时间
+    expires_at TIMESTAMPTZ,
+    notes TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_subscription_events_subscription
+    ON subscription_events(subscription_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_subscription_events_from_user
+    ON subscription_events(from_user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_subscription_events_to_user
+    ON subscription_events(to_user_id, created_at DESC)
+    WHERE to_user_id IS NOT NULL;
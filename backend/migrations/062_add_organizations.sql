@@ -0,0 +1,46 @@
+-- 062_add_organizations.sql
+-- 组织（团队）：成员共享余额与订阅。每个组织对应一个计费账户（billing_user_id 指向 users 表中的专用用户），
+-- 共享余额即计费账户余额，共享订阅即分配给计费账户的订阅；绑定到组织的成员 API Key 由计费账户付费。
+
+CREATE TABLE IF NOT EXISTS organizations (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    billing_user_id BIGINT NOT NULL UNIQUE REFERENCES users(id),
+    -- status: active / disabled（停用后组织 Key 无法发起请求）
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS organization_members (
+    id BIGSERIAL PRIMARY KEY,
+    organization_id BIGINT NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id),
+    -- role: owner / admin / member
+    role VARCHAR(20) NOT NULL DEFAULT 'member',
+    -- 成员每自然月可消耗的组织额度（USD，按 actual_cost 统计），NULL 表示不限制
+    monthly_spend_cap_usd DECIMAL(20, 8),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (organization_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_organization_members_user
+    ON organization_members(user_id);
+
+-- 绑定到组织的 API Key（Key 仍归成员所有，由组织计费账户付费）
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS organization_id BIGINT;
+CREATE INDEX IF NOT EXISTS idx_api_keys_organization_id
+    ON api_keys(organization_id)
+    WHERE organization_id IS NOT NULL;
+
+-- usage_logs.user_id 仍为发起请求的成员；organization_id / billing_user_id 记录实际付费的组织与计费账户
+ALTER TABLE usage_logs ADD COLUMN IF NOT EXISTS organization_id BIGINT;
+ALTER TABLE usage_logs ADD COLUMN IF NOT EXISTS billing_user_id BIGINT;
+CREATE INDEX IF NOT EXISTS idx_usage_logs_organization_user_created
+    ON usage_logs(organization_id, user_id, created_at)
+    WHERE organization_id IS NOT NULL;
+-- 阶梯折扣按付费账户统计近 30 天消费
+CREATE INDEX IF NOT EXISTS idx_usage_logs_billing_user_created
+    ON usage_logs(billing_user_id, created_at)
+    WHERE billing_user_id IS NOT NULL;
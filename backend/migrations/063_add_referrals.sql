@@ -0,0 +1,48 @@
+-- 063_add_referrals.sql
+-- 邀请返佣：每个用户拥有一个邀请码，被邀请人（referee）注册时记录邀请人（referrer）。
+-- 结算任务按被邀请人的实付消费（余额扣费与余额购买套餐）定期向邀请人余额发放佣金。
+
+CREATE TABLE IF NOT EXISTS referral_codes (
+    user_id BIGINT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    code VARCHAR(32) NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS referrals (
+    id BIGSERIAL PRIMARY KEY,
+    referrer_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    referee_id BIGINT NOT NULL UNIQUE REFERENCES users(id) ON DELETE CASCADE,
+    -- 注册时的返佣规则快照（后续修改配置不影响已绑定的邀请关系）
+    commission_rate DECIMAL(10, 4) NOT NULL,
+    -- 单个被邀请人累计佣金上限（USD），NULL 表示不限制
+    commission_cap_usd DECIMAL(20, 8),
+    -- 返佣截止时间，NULL 表示永久
+    expires_at TIMESTAMPTZ,
+    total_spend DECIMAL(20, 8) NOT NULL DEFAULT 0,
+    total_commission DECIMAL(20, 8) NOT NULL DEFAULT 0,
+    -- 已结算至该时间点（不含），初始为注册时间
+    settled_until TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_referrals_referrer
+    ON referrals(referrer_id, created_at DESC);
+
+-- 佣金结算记录（每次结算一个被邀请人的一个区间，仅记录佣金大于 0 的结算）
+CREATE TABLE IF NOT EXISTS referral_commissions (
+    id BIGSERIAL PRIMARY KEY,
+    referral_id BIGINT NOT NULL REFERENCES referrals(id) ON DELETE CASCADE,
+    referrer_id BIGINT NOT NULL,
+    referee_id BIGINT NOT NULL,
+    period_start TIMESTAMPTZ NOT NULL,
+    period_end TIMESTAMPTZ NOT NULL,
+    spend DECIMAL(20, 8) NOT NULL,
+    commission_rate DECIMAL(10, 4) NOT NULL,
+    amount DECIMAL(20, 8) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_referral_commissions_referrer_created
+    ON referral_commissions(referrer_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_referral_commissions_created
+    ON referral_commissions(created_at);
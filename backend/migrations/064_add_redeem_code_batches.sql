@@ -0,0 +1,57 @@
+-- 064_add_redeem_code_batches.sql
+-- 兑换码批次：按活动/渠道管理一批兑换码（统计、批量停用、按批次导出）。
+-- 多次使用兑换码：max_redemptions 限制总兑换次数，每个用户对同一兑换码只能兑换一次。
+
+CREATE TABLE IF NOT EXISTS redeem_code_batches (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    -- 发放渠道（如 twitter / partner-x），用于活动归因
+    channel VARCHAR(50) NOT NULL DEFAULT '',
+    -- 批次内兑换码的类型：balance / concurrency / subscription
+    type VARCHAR(20) NOT NULL,
+    -- status: active / disabled（停用时批次内未使用的兑换码同时停用）
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    notes TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_redeem_code_batches_channel
+    ON redeem_code_batches(channel);
+
+ALTER TABLE redeem_codes ADD COLUMN IF NOT EXISTS batch_id BIGINT REFERENCES redeem_code_batches(id) ON DELETE SET NULL;
+-- 最大兑换次数（默认 1 即一次性兑换码）；redeemed_count 达到上限后 status 变为 used
+ALTER TABLE redeem_codes ADD COLUMN IF NOT EXISTS max_redemptions INT NOT NULL DEFAULT 1;
+ALTER TABLE redeem_codes ADD COLUMN IF NOT EXISTS redeemed_count INT NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_redeem_codes_batch_id
+    ON redeem_codes(batch_id)
+    WHERE batch_id IS NOT NULL;
+
+-- 兑换记录：(redeem_code_id, user_id) 唯一，保证每个用户对同一兑换码只兑换一次
+CREATE TABLE IF NOT EXISTS redeem_code_redemptions (
+    id BIGSERIAL PRIMARY KEY,
+    redeem_code_id BIGINT NOT NULL REFERENCES redeem_codes(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (redeem_code_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_redeem_code_redemptions_user
+    ON redeem_code_redemptions(user_id, created_at DESC);
+
+-- 回填历史兑换（管理员调整记录不是兑换，不回填）
+UPDATE redeem_codes
+SET redeemed_count = 1
+WHERE status = 'used'
+  AND used_by IS NOT NULL
+  AND type IN ('balance', 'concurrency', 'subscription')
+  AND redeemed_count = 0;
+
+INSERT INTO redeem_code_redemptions (redeem_code_id, user_id, created_at)
+SELECT id, used_by, COALESCE(used_at, created_at)
+FROM redeem_codes
+WHERE status = 'used'
+  AND used_by IS NOT NULL
+  AND type IN ('balance', 'concurrency', 'subscription')
+ON CONFLICT (redeem_code_id, user_id) DO NOTHING;
@@ -0,0 +1,45 @@
+-- 065_add_promo_code_rules.sql
+-- 优惠码规则：充值场景的比例赠送、订阅试用、新用户/邮箱域名限制、叠加规则与每用户使用次数。
+-- 使用记录不再限制每个用户每个优惠码仅一次，改由 promo_codes.max_uses_per_user 控制（默认 1，与原行为一致）。
+
+ALTER TABLE promo_codes
+    -- scope: register（注册时使用）/ topup（在线充值时使用）
+    ADD COLUMN IF NOT EXISTS scope VARCHAR(20) NOT NULL DEFAULT 'register',
+    -- bonus_percent: 充值赠送比例（%），按订单到账金额计算，与 bonus_amount 累加
+    ADD COLUMN IF NOT EXISTS bonus_percent DECIMAL(10,4) NOT NULL DEFAULT 0,
+    -- max_bonus_amount: 单次赠送余额上限，NULL 表示不限
+    ADD COLUMN IF NOT EXISTS max_bonus_amount DECIMAL(20,8),
+    ADD COLUMN IF NOT EXISTS trial_group_id BIGINT REFERENCES groups(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS trial_days INT NOT NULL DEFAULT 0,
+    -- new_user_days: 仅限注册 N 天内的用户使用，0 表示不限
+    ADD COLUMN IF NOT EXISTS new_user_days INT NOT NULL DEFAULT 0,
+    -- allowed_email_domains: 邮箱域名白名单（JSON 数组），空表示不限
+    ADD COLUMN IF NOT EXISTS allowed_email_domains JSONB,
+    -- stackable: 同一充值订单中可与其他 stackable 优惠码叠加
+    ADD COLUMN IF NOT EXISTS stackable BOOLEAN NOT NULL DEFAULT FALSE,
+    -- max_uses_per_user: 每个用户最多使用次数，0 表示不限
+    ADD COLUMN IF NOT EXISTS max_uses_per_user INT NOT NULL DEFAULT 1;
+
+CREATE INDEX IF NOT EXISTS idx_promo_codes_scope ON promo_codes(scope);
+
+ALTER TABLE promo_code_usages
+    ADD COLUMN IF NOT EXISTS topup_amount DECIMAL(20,8) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS payment_order_id BIGINT REFERENCES payment_orders(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS trial_group_id BIGINT REFERENCES groups(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS trial_days INT NOT NULL DEFAULT 0;
+
+ALTER TABLE promo_code_usages
+    DROP CONSTRAINT IF EXISTS promo_code_usages_promo_code_id_user_id_key;
+DROP INDEX IF EXISTS promocodeusage_promo_code_id_user_id;
+
+CREATE INDEX IF NOT EXISTS idx_promo_code_usages_code_user
+    ON promo_code_usages(promo_code_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_promo_code_usages_used_at
+    ON promo_code_usages(used_at);
+CREATE INDEX IF NOT EXISTS idx_promo_code_usages_payment_order_id
+    ON promo_code_usages(payment_order_id)
+    WHERE payment_order_id IS NOT NULL;
+
+-- 充值订单上记录下单时使用的优惠码，支付成功入账时在同一事务中发放赠送
+ALTER TABLE payment_orders
+    ADD COLUMN IF NOT EXISTS promo_codes TEXT[] NOT NULL DEFAULT '{}';
@@ -0,0 +1,56 @@
+-- 066_add_account_costs_and_profit_aggregates.sql
+-- 利润分析：上游账号采购成本 + 按 账号/分组/模型 维度的预聚合表。
+-- 预聚合表由 DashboardAggregationService 与 usage_dashboard_hourly/daily 同步维护；
+-- 历史数据需通过仪表盘聚合的 backfill/recompute 重建。
+
+-- 上游账号采购成本（按月订阅价格摊销到每天：monthly_price * 12 / 365）
+CREATE TABLE IF NOT EXISTS account_costs (
+    id BIGSERIAL PRIMARY KEY,
+    account_id BIGINT NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    monthly_price DECIMAL(20, 8) NOT NULL DEFAULT 0,
+    purchased_at TIMESTAMPTZ NOT NULL,
+    -- ended_at: 停止续费时间，NULL 表示仍在使用
+    ended_at TIMESTAMPTZ,
+    notes TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_account_costs_account_purchased
+    ON account_costs (account_id, purchased_at);
+
+-- Hourly profit aggregates (group_id = 0 表示无分组).
+CREATE TABLE IF NOT EXISTS usage_dashboard_profit_hourly (
+    bucket_start TIMESTAMPTZ NOT NULL,
+    account_id BIGINT NOT NULL,
+    group_id BIGINT NOT NULL DEFAULT 0,
+    model VARCHAR(100) NOT NULL,
+    total_requests BIGINT NOT NULL DEFAULT 0,
+    total_cost DECIMAL(20, 10) NOT NULL DEFAULT 0,
+    -- account_cost: total_cost * COALESCE(account_rate_multiplier, 1)
+    account_cost DECIMAL(20, 10) NOT NULL DEFAULT 0,
+    actual_cost DECIMAL(20, 10) NOT NULL DEFAULT 0,
+    computed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (bucket_start, account_id, group_id, model)
+);
+
+COMMENT ON TABLE usage_dashboard_profit_hourly IS 'Pre-aggregated hourly cost/revenue per account, group and model.';
+
+-- Daily profit aggregates.
+CREATE TABLE IF NOT EXISTS usage_dashboard_profit_daily (
+    bucket_date DATE NOT NULL,
+    account_id BIGINT NOT NULL,
+    group_id BIGINT NOT NULL DEFAULT 0,
+    model VARCHAR(100) NOT NULL,
+    total_requests BIGINT NOT NULL DEFAULT 0,
+    total_cost DECIMAL(20, 10) NOT NULL DEFAULT 0,
+    account_cost DECIMAL(20, 10) NOT NULL DEFAULT 0,
+    actual_cost DECIMAL(20, 10) NOT NULL DEFAULT 0,
+    computed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (bucket_date, account_id, group_id, model)
+);
+
+CREATE INDEX IF NOT EXISTS idx_usage_dashboard_profit_daily_account
+    ON usage_dashboard_profit_daily (account_id, bucket_date);
+
+COMMENT ON TABLE usage_dashboard_profit_daily IS 'Pre-aggregated daily cost/revenue per account, group and model.';
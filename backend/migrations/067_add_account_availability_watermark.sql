@@ -0,0 +1,9 @@
+-- 067_add_account_availability_watermark.sql
+-- 账号可用时间窗口边界检查的水位：记录上次检查时间，
+-- 避免重启或主节点切换后丢失期间跨越的窗口边界（导致调度快照未及时重建）。
+
+CREATE TABLE IF NOT EXISTS account_availability_watermark (
+    id INT PRIMARY KEY,
+    last_checked_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);